@@ -2,6 +2,7 @@ package datumlogin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -77,7 +78,15 @@ func login(ctx context.Context) (*oauth2.Token, error) {
 
 	tokens, err := datumclient.Login(dc, ctx, login)
 	if err != nil {
-		return nil, err
+		mfaErr := &datumclient.MFARequiredError{}
+		if !errors.As(err, &mfaErr) {
+			return nil, err
+		}
+
+		// complete the login with a one-time passcode
+		if tokens, err = loginMFA(ctx, dc, mfaErr.MFAToken); err != nil {
+			return nil, err
+		}
 	}
 
 	fmt.Println("\nAuthentication Successful!")
@@ -90,3 +99,27 @@ func login(ctx context.Context) (*oauth2.Token, error) {
 
 	return tokens, nil
 }
+
+// loginMFA prompts for a one-time passcode, unless set in the environment, to complete the
+// login for users with multi-factor authentication enabled
+func loginMFA(ctx context.Context, dc *datumclient.Client, mfaToken string) (*oauth2.Token, error) {
+	code := os.Getenv("DATUM_MFA_CODE")
+
+	if code == "" {
+		fmt.Print("\nOne-time passcode or recovery code: ")
+
+		bytecode, err := term.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return nil, err
+		}
+
+		code = string(bytecode)
+	}
+
+	login := handlers.MFALoginRequest{
+		MFAToken: mfaToken,
+		Code:     code,
+	}
+
+	return datumclient.LoginMFA(dc, ctx, login)
+}
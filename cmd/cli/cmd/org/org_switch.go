@@ -0,0 +1,73 @@
+package datumorg
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+var orgSwitchCmd = &cobra.Command{
+	Use:   "switch",
+	Short: "Switch the datum org the auth token is scoped to",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return switchOrg(cmd.Context())
+	},
+}
+
+func init() {
+	orgCmd.AddCommand(orgSwitchCmd)
+
+	orgSwitchCmd.Flags().StringP("id", "i", "", "org id to switch to")
+	datum.ViperBindFlag("org.switch.id", orgSwitchCmd.Flags().Lookup("id"))
+}
+
+func switchOrg(ctx context.Context) error {
+	oID := viper.GetString("org.switch.id")
+	if oID == "" {
+		return datum.NewRequiredFieldMissingError("organization id")
+	}
+
+	// get the current access token, refreshing it if expired
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// setup datum http client
+	h := &http.Client{}
+
+	// set options
+	opt := &clientv2.Options{}
+
+	// new client with params
+	c := datumclient.NewClient(h, datum.DatumHost, opt, nil)
+
+	// this allows the use of the graph client to be used for the REST endpoints
+	dc := c.(*datumclient.Client)
+
+	req := handlers.SwitchOrganizationRequest{
+		TargetOrganizationID: oID,
+	}
+
+	tokens, err := datumclient.Switch(dc, ctx, req, cli.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	if err := datum.StoreToken(tokens); err != nil {
+		return err
+	}
+
+	fmt.Printf("switched to organization %s, auth token successfully stored in keychain\n", oID)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package datumsession is our cobra/viper cli for the sessions of the authenticated user
+package datumsession
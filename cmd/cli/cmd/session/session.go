@@ -0,0 +1,17 @@
+package datumsession
+
+import (
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+// sessionCmd represents the base session command when called without any subcommands
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "The subcommands for managing the sessions of the authenticated datum user",
+}
+
+func init() {
+	datum.RootCmd.AddCommand(sessionCmd)
+}
@@ -0,0 +1,43 @@
+package datumsession
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var sessionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the active sessions of the authenticated datum user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return sessions(cmd.Context())
+	},
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionListCmd)
+}
+
+func sessions(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	o, err := cli.Client.MySessions(ctx, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
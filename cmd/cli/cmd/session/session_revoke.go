@@ -0,0 +1,52 @@
+package datumsession
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var sessionRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke a session of the authenticated datum user, the tokens of the session are rejected afterwards",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return revokeSession(cmd.Context())
+	},
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionRevokeCmd)
+
+	sessionRevokeCmd.Flags().StringP("id", "i", "", "session id to revoke")
+	datum.ViperBindFlag("session.revoke.id", sessionRevokeCmd.Flags().Lookup("id"))
+}
+
+func revokeSession(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	sID := viper.GetString("session.revoke.id")
+	if sID == "" {
+		return datum.NewRequiredFieldMissingError("session id")
+	}
+
+	o, err := cli.Client.RevokeSession(ctx, sID, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,17 @@
+package datumtokens
+
+import (
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+// apiKeyCmd represents the base apiKeyCmd command when called without any subcommands
+var apiKeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "The subcommands for working with organization api keys",
+}
+
+func init() {
+	datum.RootCmd.AddCommand(apiKeyCmd)
+}
@@ -0,0 +1,92 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/internal/datumclient"
+)
+
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new datum organization api key",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createAPIKey(cmd.Context())
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyCreateCmd)
+
+	apiKeyCreateCmd.Flags().StringP("name", "n", "", "name of the api key")
+	datum.ViperBindFlag("apikey.create.name", apiKeyCreateCmd.Flags().Lookup("name"))
+
+	apiKeyCreateCmd.Flags().StringP("description", "d", "", "description of the api key")
+	datum.ViperBindFlag("apikey.create.description", apiKeyCreateCmd.Flags().Lookup("description"))
+
+	apiKeyCreateCmd.Flags().StringP("owner-id", "o", "", "the organization that owns the api key")
+	datum.ViperBindFlag("apikey.create.owner-id", apiKeyCreateCmd.Flags().Lookup("owner-id"))
+
+	apiKeyCreateCmd.Flags().StringSlice("scopes", []string{}, "scopes the api key is restricted to, e.g. org:read,group:write")
+	datum.ViperBindFlag("apikey.create.scopes", apiKeyCreateCmd.Flags().Lookup("scopes"))
+
+	apiKeyCreateCmd.Flags().Duration("expiration", 0, "how long the api key is valid for, e.g. 720h; the key does not expire when not set")
+	datum.ViperBindFlag("apikey.create.expiration", apiKeyCreateCmd.Flags().Lookup("expiration"))
+}
+
+func createAPIKey(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	name := viper.GetString("apikey.create.name")
+	if name == "" {
+		return datum.NewRequiredFieldMissingError("api key name")
+	}
+
+	owner := viper.GetString("apikey.create.owner-id")
+	if owner == "" {
+		return datum.NewRequiredFieldMissingError("organization id")
+	}
+
+	scopes := viper.GetStringSlice("apikey.create.scopes")
+	if len(scopes) == 0 {
+		return datum.NewRequiredFieldMissingError("scopes")
+	}
+
+	input := datumclient.CreateAPIKeyInput{
+		Name:      name,
+		OwnerID:   owner,
+		Abilities: scopes,
+	}
+
+	if description := viper.GetString("apikey.create.description"); description != "" {
+		input.Description = &description
+	}
+
+	if expiration := viper.GetDuration("apikey.create.expiration"); expiration > 0 {
+		expiresAt := time.Now().Add(expiration)
+		input.ExpiresAt = &expiresAt
+	}
+
+	o, err := cli.Client.CreateAPIKey(ctx, input, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
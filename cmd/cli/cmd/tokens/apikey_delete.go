@@ -0,0 +1,53 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var apiKeyDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an existing datum organization api key",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return deleteAPIKey(cmd.Context())
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyDeleteCmd)
+
+	apiKeyDeleteCmd.Flags().StringP("id", "i", "", "api key id to delete")
+	datum.ViperBindFlag("apikey.delete.id", apiKeyDeleteCmd.Flags().Lookup("id"))
+}
+
+func deleteAPIKey(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	kID := viper.GetString("apikey.delete.id")
+	if kID == "" {
+		return datum.NewRequiredFieldMissingError("api key id")
+	}
+
+	o, err := cli.Client.DeleteAPIKey(ctx, kID, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
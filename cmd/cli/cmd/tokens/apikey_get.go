@@ -0,0 +1,63 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var apiKeyGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get details of existing datum organization api keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return apiKeys(cmd.Context())
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyGetCmd)
+
+	apiKeyGetCmd.Flags().StringP("id", "i", "", "api key id to query")
+	datum.ViperBindFlag("apikey.get.id", apiKeyGetCmd.Flags().Lookup("id"))
+}
+
+func apiKeys(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	kID := viper.GetString("apikey.get.id")
+
+	var s []byte
+
+	// if an api key ID is provided, filter on that key, otherwise get all
+	if kID == "" {
+		keys, err := cli.Client.GetAPIKeys(ctx, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(keys)
+		if err != nil {
+			return err
+		}
+	} else {
+		key, err := cli.Client.GetAPIKeyByID(ctx, kID, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return datum.JSONPrint(s)
+}
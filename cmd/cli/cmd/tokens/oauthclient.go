@@ -0,0 +1,17 @@
+package datumtokens
+
+import (
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+// oauthClientCmd represents the base oauthClientCmd command when called without any subcommands
+var oauthClientCmd = &cobra.Command{
+	Use:   "oauthclient",
+	Short: "The subcommands for working with organization oauth clients",
+}
+
+func init() {
+	datum.RootCmd.AddCommand(oauthClientCmd)
+}
@@ -0,0 +1,98 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/internal/datumclient"
+)
+
+var oauthClientCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Register a new oauth client for a datum organization",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createOauthClient(cmd.Context())
+	},
+}
+
+func init() {
+	oauthClientCmd.AddCommand(oauthClientCreateCmd)
+
+	oauthClientCreateCmd.Flags().StringP("name", "n", "", "name of the oauth client shown to users")
+	datum.ViperBindFlag("oauthclient.create.name", oauthClientCreateCmd.Flags().Lookup("name"))
+
+	oauthClientCreateCmd.Flags().StringP("description", "d", "", "description of the oauth client")
+	datum.ViperBindFlag("oauthclient.create.description", oauthClientCreateCmd.Flags().Lookup("description"))
+
+	oauthClientCreateCmd.Flags().StringP("owner-id", "o", "", "the organization that owns the oauth client")
+	datum.ViperBindFlag("oauthclient.create.owner-id", oauthClientCreateCmd.Flags().Lookup("owner-id"))
+
+	oauthClientCreateCmd.Flags().StringSlice("redirect-uris", []string{}, "urls users can be redirected to after authorizing the client")
+	datum.ViperBindFlag("oauthclient.create.redirect-uris", oauthClientCreateCmd.Flags().Lookup("redirect-uris"))
+
+	oauthClientCreateCmd.Flags().StringSlice("scopes", []string{}, "scopes the client can request, e.g. openid,email,org:read")
+	datum.ViperBindFlag("oauthclient.create.scopes", oauthClientCreateCmd.Flags().Lookup("scopes"))
+
+	oauthClientCreateCmd.Flags().Bool("public", false, "public clients, such as single page and native apps, are not issued a secret and must use PKCE")
+	datum.ViperBindFlag("oauthclient.create.public", oauthClientCreateCmd.Flags().Lookup("public"))
+}
+
+func createOauthClient(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	name := viper.GetString("oauthclient.create.name")
+	if name == "" {
+		return datum.NewRequiredFieldMissingError("oauth client name")
+	}
+
+	owner := viper.GetString("oauthclient.create.owner-id")
+	if owner == "" {
+		return datum.NewRequiredFieldMissingError("organization id")
+	}
+
+	redirectURIs := viper.GetStringSlice("oauthclient.create.redirect-uris")
+	if len(redirectURIs) == 0 {
+		return datum.NewRequiredFieldMissingError("redirect uris")
+	}
+
+	scopes := viper.GetStringSlice("oauthclient.create.scopes")
+	if len(scopes) == 0 {
+		return datum.NewRequiredFieldMissingError("scopes")
+	}
+
+	public := viper.GetBool("oauthclient.create.public")
+
+	input := datumclient.CreateOauthClientInput{
+		Name:         name,
+		OwnerID:      owner,
+		RedirectUris: redirectURIs,
+		Scopes:       scopes,
+		Public:       &public,
+	}
+
+	if description := viper.GetString("oauthclient.create.description"); description != "" {
+		input.Description = &description
+	}
+
+	o, err := cli.Client.CreateOauthClient(ctx, input, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,53 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var oauthClientDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an existing datum organization oauth client",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return deleteOauthClient(cmd.Context())
+	},
+}
+
+func init() {
+	oauthClientCmd.AddCommand(oauthClientDeleteCmd)
+
+	oauthClientDeleteCmd.Flags().StringP("id", "i", "", "oauth client id to delete")
+	datum.ViperBindFlag("oauthclient.delete.id", oauthClientDeleteCmd.Flags().Lookup("id"))
+}
+
+func deleteOauthClient(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	cID := viper.GetString("oauthclient.delete.id")
+	if cID == "" {
+		return datum.NewRequiredFieldMissingError("oauth client id")
+	}
+
+	o, err := cli.Client.DeleteOauthClient(ctx, cID, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,63 @@
+package datumtokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var oauthClientGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get details of existing datum organization oauth clients",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return oauthClients(cmd.Context())
+	},
+}
+
+func init() {
+	oauthClientCmd.AddCommand(oauthClientGetCmd)
+
+	oauthClientGetCmd.Flags().StringP("id", "i", "", "oauth client id to query")
+	datum.ViperBindFlag("oauthclient.get.id", oauthClientGetCmd.Flags().Lookup("id"))
+}
+
+func oauthClients(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	cID := viper.GetString("oauthclient.get.id")
+
+	var s []byte
+
+	// if an oauth client ID is provided, filter on that client, otherwise get all
+	if cID == "" {
+		clients, err := cli.Client.GetOauthClients(ctx, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(clients)
+		if err != nil {
+			return err
+		}
+	} else {
+		client, err := cli.Client.GetOauthClientByID(ctx, cID, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(client)
+		if err != nil {
+			return err
+		}
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -31,6 +31,9 @@ func init() {
 
 	patCreateCmd.Flags().StringP("owner-id", "o", "", "the owner of the personal access token")
 	datum.ViperBindFlag("pat.create.owner-id", patCreateCmd.Flags().Lookup("owner-id"))
+
+	patCreateCmd.Flags().StringSlice("scopes", []string{}, "scopes the token is restricted to, e.g. org:read,user:write; the token has full access when empty")
+	datum.ViperBindFlag("pat.create.scopes", patCreateCmd.Flags().Lookup("scopes"))
 }
 
 func createPat(ctx context.Context) error {
@@ -55,8 +58,9 @@ func createPat(ctx context.Context) error {
 	description := viper.GetString("pat.create.description")
 
 	input := datumclient.CreatePersonalAccessTokenInput{
-		Name:    name,
-		OwnerID: owner,
+		Name:      name,
+		OwnerID:   owner,
+		Abilities: viper.GetStringSlice("pat.create.scopes"),
 	}
 
 	if description != "" {
@@ -0,0 +1,15 @@
+package datumuser
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// userPasskeyCmd represents the base passkey command when called without any subcommands
+var userPasskeyCmd = &cobra.Command{
+	Use:   "passkey",
+	Short: "The subcommands for managing the passkeys registered by the authenticated datum user",
+}
+
+func init() {
+	userCmd.AddCommand(userPasskeyCmd)
+}
@@ -0,0 +1,54 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userPasskeyDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Revoke a passkey registered by the authenticated datum user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return deletePasskey(cmd.Context())
+	},
+}
+
+func init() {
+	userPasskeyCmd.AddCommand(userPasskeyDeleteCmd)
+
+	userPasskeyDeleteCmd.Flags().StringP("id", "i", "", "passkey id to delete")
+	datum.ViperBindFlag("user.passkey.delete.id", userPasskeyDeleteCmd.Flags().Lookup("id"))
+}
+
+func deletePasskey(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	pID := viper.GetString("user.passkey.delete.id")
+	if pID == "" {
+		return datum.NewRequiredFieldMissingError("passkey id")
+	}
+
+	o, err := cli.Client.DeleteWebauthnCredential(ctx, pID, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
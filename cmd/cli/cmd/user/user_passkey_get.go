@@ -0,0 +1,63 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userPasskeyGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get the passkeys registered by the authenticated datum user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return passkeys(cmd.Context())
+	},
+}
+
+func init() {
+	userPasskeyCmd.AddCommand(userPasskeyGetCmd)
+
+	userPasskeyGetCmd.Flags().StringP("id", "i", "", "passkey id to query")
+	datum.ViperBindFlag("user.passkey.get.id", userPasskeyGetCmd.Flags().Lookup("id"))
+}
+
+func passkeys(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	// if a passkey ID is provided, filter on that passkey, otherwise get all
+	pID := viper.GetString("user.passkey.get.id")
+	if pID == "" {
+		creds, err := cli.Client.GetWebauthnCredentials(ctx, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(creds)
+		if err != nil {
+			return err
+		}
+	} else {
+		cred, err := cli.Client.GetWebauthnCredentialByID(ctx, pID, cli.Interceptor)
+		if err != nil {
+			return err
+		}
+
+		s, err = json.Marshal(cred)
+		if err != nil {
+			return err
+		}
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,15 @@
+package datumuser
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// userTFACmd represents the base tfa command when called without any subcommands
+var userTFACmd = &cobra.Command{
+	Use:   "tfa",
+	Short: "The subcommands for managing multi-factor authentication for the authenticated datum user",
+}
+
+func init() {
+	userCmd.AddCommand(userTFACmd)
+}
@@ -0,0 +1,54 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userTFAConfirmCmd = &cobra.Command{
+	Use:   "confirm",
+	Short: "Confirm enrollment in multi-factor authentication, returns one-time recovery codes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return confirmTFA(cmd.Context())
+	},
+}
+
+func init() {
+	userTFACmd.AddCommand(userTFAConfirmCmd)
+
+	userTFAConfirmCmd.Flags().StringP("code", "c", "", "one-time passcode from the authenticator app")
+	datum.ViperBindFlag("user.tfa.confirm.code", userTFAConfirmCmd.Flags().Lookup("code"))
+}
+
+func confirmTFA(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	code := viper.GetString("user.tfa.confirm.code")
+	if code == "" {
+		return datum.NewRequiredFieldMissingError("code")
+	}
+
+	o, err := cli.Client.ConfirmTfa(ctx, code, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,54 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userTFADisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable multi-factor authentication for the authenticated user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return disableTFA(cmd.Context())
+	},
+}
+
+func init() {
+	userTFACmd.AddCommand(userTFADisableCmd)
+
+	userTFADisableCmd.Flags().StringP("code", "c", "", "one-time passcode from the authenticator app or an unused recovery code")
+	datum.ViperBindFlag("user.tfa.disable.code", userTFADisableCmd.Flags().Lookup("code"))
+}
+
+func disableTFA(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	code := viper.GetString("user.tfa.disable.code")
+	if code == "" {
+		return datum.NewRequiredFieldMissingError("code")
+	}
+
+	o, err := cli.Client.DisableTfa(ctx, code, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
@@ -0,0 +1,45 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userTFAEnrollCmd = &cobra.Command{
+	Use:   "enroll",
+	Short: "Begin enrollment in multi-factor authentication, the returned uri can be added to an authenticator app",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return enrollTFA(cmd.Context())
+	},
+}
+
+func init() {
+	userTFACmd.AddCommand(userTFAEnrollCmd)
+}
+
+func enrollTFA(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	o, err := cli.Client.EnrollTfa(ctx, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
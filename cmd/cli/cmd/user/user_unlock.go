@@ -0,0 +1,54 @@
+package datumuser
+
+import (
+	"context"
+	"encoding/json"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var userUnlockCmd = &cobra.Command{
+	Use:   "unlock",
+	Short: "Unlock a datum user locked after repeated failed logins",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return unlockUser(cmd.Context())
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userUnlockCmd)
+
+	userUnlockCmd.Flags().StringP("id", "i", "", "user id to unlock")
+	datum.ViperBindFlag("user.unlock.id", userUnlockCmd.Flags().Lookup("id"))
+}
+
+func unlockUser(ctx context.Context) error {
+	// setup datum http client
+	cli, err := datum.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var s []byte
+
+	userID := viper.GetString("user.unlock.id")
+	if userID == "" {
+		return datum.NewRequiredFieldMissingError("user id")
+	}
+
+	o, err := cli.Client.UnlockUser(ctx, userID, cli.Interceptor)
+	if err != nil {
+		return err
+	}
+
+	s, err = json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return datum.JSONPrint(s)
+}
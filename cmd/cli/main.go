@@ -10,6 +10,7 @@ import (
 	_ "github.com/datumforge/datum/cmd/cli/cmd/org"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/register"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/reset"
+	_ "github.com/datumforge/datum/cmd/cli/cmd/session"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/tokens"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/user"
 )
@@ -60,6 +60,9 @@ func serve(ctx context.Context) error {
 
 	so := serveropts.NewServerOptions(serverOpts)
 
+	// the password policy is enforced by the user hooks
+	entOpts = append(entOpts, ent.PasswordPolicy(so.Config.Server.Handler.PasswordPolicy))
+
 	// Create keys for development
 	if so.Config.Server.Dev {
 		so.AddServerOptions(serveropts.WithGeneratedKeys())
@@ -76,11 +79,6 @@ func serve(ctx context.Context) error {
 
 		// add client as ent dependency
 		entOpts = append(entOpts, ent.Authz(*fgaClient))
-
-		// add auth middleware
-		authMiddleware := authmw.Authenticate()
-
-		mw = append(mw, authMiddleware)
 	}
 
 	// Setup DB connection
@@ -96,9 +94,24 @@ func serve(ctx context.Context) error {
 	// add ready checks
 	so.AddServerOptions(serveropts.WithReadyChecks(dbConfig, fgaClient))
 
+	// persist the sessions in the database, this must come after the database setup
+	so.AddServerOptions(serveropts.WithSessionStore(entdbClient))
+
 	// Add Driver to the Handlers Config
 	so.Config.Server.Handler.DBClient = entdbClient
 
+	// add auth middleware, this must come after the database setup because
+	// revoked tokens, personal access tokens and api keys are checked against the database
+	if so.Config.Authz.Enabled {
+		authMiddleware := authmw.Authenticate(
+			authmw.WithRevocationChecker(&so.Config.Server.Handler),
+			authmw.WithPersonalAccessTokenValidator(&so.Config.Server.Handler),
+			authmw.WithAPIKeyValidator(&so.Config.Server.Handler),
+		)
+
+		mw = append(mw, authMiddleware)
+	}
+
 	srv := server.NewServer(so.Config.Server, so.Config.Logger)
 
 	// Setup Graph API Handlers
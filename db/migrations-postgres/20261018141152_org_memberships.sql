@@ -0,0 +1,13 @@
+-- Postgres version of db/migrations/20261018141152_org_memberships.sql. Postgres databases are created by the
+-- automatic schema migration at startup, which creates "org_memberships" but does not copy the memberships in
+-- "user_organizations"; run this file once after upgrading. It can be run before or after the server starts.
+-- Create "org_memberships" table
+CREATE TABLE IF NOT EXISTS "org_memberships" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "role" character varying NOT NULL DEFAULT 'MEMBER', "organization_id" character varying NOT NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "org_memberships_organizations_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION, CONSTRAINT "org_memberships_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- Create index "orgmembership_user_id_organization_id" to table: "org_memberships"
+CREATE UNIQUE INDEX IF NOT EXISTS "orgmembership_user_id_organization_id" ON "org_memberships" ("user_id", "organization_id");
+-- Copy the existing memberships to "org_memberships", users are the owners of their personal organizations and of the organizations they created
+INSERT INTO "org_memberships" ("id", "created_at", "updated_at", "role", "organization_id", "user_id") SELECT '00' || upper(substr(md5(random()::text || clock_timestamp()::text), 1, 24)), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CASE WHEN "organizations"."personal_org" OR "organizations"."created_by" = "user_organizations"."user_id" THEN 'OWNER' ELSE 'MEMBER' END, "user_organizations"."organization_id", "user_organizations"."user_id" FROM "user_organizations" JOIN "organizations" ON "organizations"."id" = "user_organizations"."organization_id" ON CONFLICT ("user_id", "organization_id") DO NOTHING;
+-- Add the creators of organizations that were not members of them as owners to "org_memberships"
+INSERT INTO "org_memberships" ("id", "created_at", "updated_at", "role", "organization_id", "user_id") SELECT '00' || upper(substr(md5(random()::text || clock_timestamp()::text), 1, 24)), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'OWNER', "organizations"."id", "organizations"."created_by" FROM "organizations" JOIN "users" ON "users"."id" = "organizations"."created_by" ON CONFLICT ("user_id", "organization_id") DO NOTHING;
+-- Drop "user_organizations" table
+DROP TABLE "user_organizations";
@@ -0,0 +1,4 @@
+-- Create "webauthn_credentials" table
+CREATE TABLE `webauthn_credentials` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `name` text NULL DEFAULT (''), `credential_id` blob NOT NULL, `public_key` blob NOT NULL, `attestation_type` text NULL, `aaguid` text NULL, `sign_count` integer NOT NULL DEFAULT (0), `transports` json NULL, `backup_eligible` bool NOT NULL DEFAULT (false), `backup_state` bool NOT NULL DEFAULT (false), `last_used_at` datetime NULL, `owner_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `webauthn_credentials_users_webauthn_credentials` FOREIGN KEY (`owner_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "webauthncredential_credential_id" to table: "webauthn_credentials"
+CREATE UNIQUE INDEX `webauthncredential_credential_id` ON `webauthn_credentials` (`credential_id`) WHERE deleted_at is NULL;
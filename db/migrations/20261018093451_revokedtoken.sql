@@ -0,0 +1,6 @@
+-- Create "revoked_tokens" table
+CREATE TABLE `revoked_tokens` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `jti` text NOT NULL, `user_id` text NULL, `reason` text NULL, `expires_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "revoked_tokens_jti_key" to table: "revoked_tokens"
+CREATE UNIQUE INDEX `revoked_tokens_jti_key` ON `revoked_tokens` (`jti`);
+-- Create index "revokedtoken_expires_at" to table: "revoked_tokens"
+CREATE INDEX `revokedtoken_expires_at` ON `revoked_tokens` (`expires_at`);
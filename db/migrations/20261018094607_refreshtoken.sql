@@ -0,0 +1,18 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_refresh_tokens" table
+CREATE TABLE `new_refresh_tokens` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `jti` text NOT NULL, `family_id` text NOT NULL, `parent_jti` text NULL, `user_id` text NOT NULL, `used_at` datetime NULL, `expires_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "refresh_tokens" to new temporary table "new_refresh_tokens"
+INSERT INTO `new_refresh_tokens` (`id`, `user_id`, `expires_at`) SELECT `id`, `user_id`, `expires_at` FROM `refresh_tokens`;
+-- Drop "refresh_tokens" table after copying rows
+DROP TABLE `refresh_tokens`;
+-- Rename temporary table "new_refresh_tokens" to "refresh_tokens"
+ALTER TABLE `new_refresh_tokens` RENAME TO `refresh_tokens`;
+-- Create index "refresh_tokens_jti_key" to table: "refresh_tokens"
+CREATE UNIQUE INDEX `refresh_tokens_jti_key` ON `refresh_tokens` (`jti`);
+-- Create index "refreshtoken_family_id" to table: "refresh_tokens"
+CREATE INDEX `refreshtoken_family_id` ON `refresh_tokens` (`family_id`);
+-- Create index "refreshtoken_user_id" to table: "refresh_tokens"
+CREATE INDEX `refreshtoken_user_id` ON `refresh_tokens` (`user_id`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,4 @@
+-- Create "api_keys" table
+CREATE TABLE `api_keys` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `name` text NOT NULL, `key_prefix` text NOT NULL, `key_hash` text NOT NULL, `abilities` json NOT NULL, `expires_at` datetime NULL, `description` text NULL DEFAULT (''), `last_used_at` datetime NULL, `owner_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `api_keys_organizations_api_keys` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION);
+-- Create index "apikey_key_prefix" to table: "api_keys"
+CREATE UNIQUE INDEX `apikey_key_prefix` ON `api_keys` (`key_prefix`) WHERE deleted_at is NULL;
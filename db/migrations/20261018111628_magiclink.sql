@@ -0,0 +1,6 @@
+-- Create "magic_link_tokens" table
+CREATE TABLE `magic_link_tokens` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `token` text NOT NULL, `ttl` datetime NOT NULL, `email` text NOT NULL, `secret` blob NOT NULL, `user_magic_link_tokens` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `magic_link_tokens_users_magic_link_tokens` FOREIGN KEY (`user_magic_link_tokens`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "magic_link_tokens_token_key" to table: "magic_link_tokens"
+CREATE UNIQUE INDEX `magic_link_tokens_token_key` ON `magic_link_tokens` (`token`);
+-- Create index "magiclinktoken_token" to table: "magic_link_tokens"
+CREATE UNIQUE INDEX `magiclinktoken_token` ON `magic_link_tokens` (`token`) WHERE deleted_at is NULL;
@@ -0,0 +1,6 @@
+-- Create "session_data" table
+CREATE TABLE `session_data` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `token` text NOT NULL, `data` blob NOT NULL, `expiry` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "session_data_token_key" to table: "session_data"
+CREATE UNIQUE INDEX `session_data_token_key` ON `session_data` (`token`);
+-- Create index "sessiondata_expiry" to table: "session_data"
+CREATE INDEX `sessiondata_expiry` ON `session_data` (`expiry`);
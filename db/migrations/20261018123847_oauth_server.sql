@@ -0,0 +1,20 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_oh_auth_too_tokens" table
+CREATE TABLE `new_oh_auth_too_tokens` (`id` text NOT NULL, `client_id` text NOT NULL, `scopes` json NULL, `nonce` text NULL, `claims_user_id` text NOT NULL, `claims_username` text NOT NULL, `claims_email` text NOT NULL, `claims_email_verified` bool NOT NULL, `claims_groups` json NULL, `claims_preferred_username` text NOT NULL, `connector_id` text NOT NULL, `connector_data` json NULL, `last_used` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "oh_auth_too_tokens" to new temporary table "new_oh_auth_too_tokens"
+INSERT INTO `new_oh_auth_too_tokens` (`id`, `client_id`, `scopes`, `nonce`, `claims_user_id`, `claims_username`, `claims_email`, `claims_email_verified`, `claims_groups`, `claims_preferred_username`, `connector_id`, `connector_data`, `last_used`) SELECT `id`, `client_id`, `scopes`, `nonce`, `claims_user_id`, `claims_username`, `claims_email`, `claims_email_verified`, `claims_groups`, `claims_preferred_username`, `connector_id`, `connector_data`, `last_used` FROM `oh_auth_too_tokens`;
+-- Drop "oh_auth_too_tokens" table after copying rows
+DROP TABLE `oh_auth_too_tokens`;
+-- Rename temporary table "new_oh_auth_too_tokens" to "oh_auth_too_tokens"
+ALTER TABLE `new_oh_auth_too_tokens` RENAME TO `oh_auth_too_tokens`;
+-- Create "oauth_authorization_codes" table
+CREATE TABLE `oauth_authorization_codes` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `code_hash` text NOT NULL, `client_id` text NOT NULL, `user_id` text NOT NULL, `redirect_uri` text NOT NULL, `scopes` json NOT NULL, `nonce` text NULL, `code_challenge` text NULL, `code_challenge_method` text NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, PRIMARY KEY (`id`));
+-- Create index "oauth_authorization_codes_code_hash_key" to table: "oauth_authorization_codes"
+CREATE UNIQUE INDEX `oauth_authorization_codes_code_hash_key` ON `oauth_authorization_codes` (`code_hash`);
+-- Create "oauth_clients" table
+CREATE TABLE `oauth_clients` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `name` text NOT NULL, `client_id` text NOT NULL, `client_secret_hash` text NULL, `redirect_uris` json NOT NULL, `scopes` json NOT NULL, `public` bool NOT NULL DEFAULT (false), `description` text NULL DEFAULT (''), `owner_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `oauth_clients_organizations_oauth_clients` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION);
+-- Create index "oauthclient_client_id" to table: "oauth_clients"
+CREATE UNIQUE INDEX `oauthclient_client_id` ON `oauth_clients` (`client_id`) WHERE deleted_at is NULL;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
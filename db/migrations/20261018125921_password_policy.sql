@@ -0,0 +1,4 @@
+-- Add column "password_history" to table: "users"
+ALTER TABLE `users` ADD COLUMN `password_history` json NULL;
+-- Add column "password_changed_at" to table: "users"
+ALTER TABLE `users` ADD COLUMN `password_changed_at` datetime NULL;
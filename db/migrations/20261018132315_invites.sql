@@ -0,0 +1,8 @@
+-- Create "invites" table
+CREATE TABLE `invites` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `token` text NOT NULL, `expires` datetime NOT NULL, `recipient` text NOT NULL, `status` text NOT NULL DEFAULT ('INVITATION_SENT'), `role` text NOT NULL DEFAULT ('MEMBER'), `requestor_id` text NULL, `secret` blob NOT NULL, `owner_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `invites_organizations_invites` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION);
+-- Create index "invites_token_key" to table: "invites"
+CREATE UNIQUE INDEX `invites_token_key` ON `invites` (`token`);
+-- Create index "invite_token" to table: "invites"
+CREATE UNIQUE INDEX `invite_token` ON `invites` (`token`) WHERE deleted_at is NULL;
+-- Create index "invite_recipient_owner_id" to table: "invites"
+CREATE INDEX `invite_recipient_owner_id` ON `invites` (`recipient`, `owner_id`);
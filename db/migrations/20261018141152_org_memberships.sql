@@ -0,0 +1,10 @@
+-- Create "org_memberships" table
+CREATE TABLE `org_memberships` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `role` text NOT NULL DEFAULT ('MEMBER'), `organization_id` text NOT NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `org_memberships_organizations_organization` FOREIGN KEY (`organization_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION, CONSTRAINT `org_memberships_users_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "orgmembership_user_id_organization_id" to table: "org_memberships"
+CREATE UNIQUE INDEX `orgmembership_user_id_organization_id` ON `org_memberships` (`user_id`, `organization_id`);
+-- Copy the existing memberships to "org_memberships", users are the owners of their personal organizations and of the organizations they created
+INSERT INTO `org_memberships` (`id`, `created_at`, `updated_at`, `role`, `organization_id`, `user_id`) SELECT '00' || upper(hex(randomblob(12))), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CASE WHEN `organizations`.`personal_org` OR `organizations`.`created_by` = `user_organizations`.`user_id` THEN 'OWNER' ELSE 'MEMBER' END, `user_organizations`.`organization_id`, `user_organizations`.`user_id` FROM `user_organizations` JOIN `organizations` ON `organizations`.`id` = `user_organizations`.`organization_id`;
+-- Add the creators of organizations that were not members of them as owners to "org_memberships"
+INSERT INTO `org_memberships` (`id`, `created_at`, `updated_at`, `role`, `organization_id`, `user_id`) SELECT '00' || upper(hex(randomblob(12))), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'OWNER', `organizations`.`id`, `organizations`.`created_by` FROM `organizations` JOIN `users` ON `users`.`id` = `organizations`.`created_by` WHERE NOT EXISTS (SELECT 1 FROM `user_organizations` WHERE `user_organizations`.`organization_id` = `organizations`.`id` AND `user_organizations`.`user_id` = `organizations`.`created_by`);
+-- Drop "user_organizations" table
+DROP TABLE `user_organizations`;
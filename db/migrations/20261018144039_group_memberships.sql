@@ -0,0 +1,14 @@
+-- Create "group_join_requests" table
+CREATE TABLE `group_join_requests` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `message` text NULL, `status` text NOT NULL DEFAULT ('PENDING'), `reviewer_id` text NULL, `group_id` text NOT NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `group_join_requests_groups_group` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE NO ACTION, CONSTRAINT `group_join_requests_users_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "groupjoinrequest_group_id_status" to table: "group_join_requests"
+CREATE INDEX `groupjoinrequest_group_id_status` ON `group_join_requests` (`group_id`, `status`);
+-- Create index "groupjoinrequest_user_id_group_id" to table: "group_join_requests"
+CREATE INDEX `groupjoinrequest_user_id_group_id` ON `group_join_requests` (`user_id`, `group_id`);
+-- Create "group_memberships" table
+CREATE TABLE `group_memberships` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `role` text NOT NULL DEFAULT ('MEMBER'), `group_id` text NOT NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `group_memberships_groups_group` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE NO ACTION, CONSTRAINT `group_memberships_users_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "groupmembership_user_id_group_id" to table: "group_memberships"
+CREATE UNIQUE INDEX `groupmembership_user_id_group_id` ON `group_memberships` (`user_id`, `group_id`);
+-- Copy the existing group members to "group_memberships"
+INSERT INTO `group_memberships` (`id`, `created_at`, `updated_at`, `role`, `group_id`, `user_id`) SELECT '00' || upper(hex(randomblob(12))), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'MEMBER', `group_id`, `user_id` FROM `group_users`;
+-- Drop "group_users" table
+DROP TABLE `group_users`;
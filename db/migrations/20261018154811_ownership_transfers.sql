@@ -0,0 +1,6 @@
+-- Create "ownership_transfers" table
+CREATE TABLE `ownership_transfers` (`id` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NULL, `updated_by` text NULL, `status` text NOT NULL DEFAULT ('PENDING'), `token` text NOT NULL, `expires` datetime NOT NULL, `secret` blob NOT NULL, `owner_id` text NOT NULL, `group_id` text NULL, `requestor_id` text NOT NULL, `recipient_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `ownership_transfers_organizations_owner` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION, CONSTRAINT `ownership_transfers_groups_group` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE SET NULL, CONSTRAINT `ownership_transfers_users_requestor` FOREIGN KEY (`requestor_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `ownership_transfers_users_recipient` FOREIGN KEY (`recipient_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- Create index "ownership_transfers_token_key" to table: "ownership_transfers"
+CREATE UNIQUE INDEX `ownership_transfers_token_key` ON `ownership_transfers` (`token`);
+-- Create index "ownershiptransfer_owner_id_status" to table: "ownership_transfers"
+CREATE INDEX `ownershiptransfer_owner_id_status` ON `ownership_transfers` (`owner_id`, `status`);
@@ -46,3 +46,21 @@ func newRequestError(statusCode int, body string) *RequestError {
 		Body:       body,
 	}
 }
+
+// MFARequiredError is returned on login when the user must complete multi-factor authentication
+type MFARequiredError struct {
+	// MFAToken is used along with a one-time passcode to complete the login
+	MFAToken string
+}
+
+// Error returns the MFARequiredError in string format
+func (e *MFARequiredError) Error() string {
+	return "multi-factor authentication is required to complete login"
+}
+
+// newMFARequiredError returns an error when a one-time passcode is required to complete the login
+func newMFARequiredError(token string) *MFARequiredError {
+	return &MFARequiredError{
+		MFAToken: token,
+	}
+}
@@ -9,35 +9,87 @@ import (
 
 	"github.com/Yamashou/gqlgenc/clientv2"
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 )
 
 type DatumClient interface {
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput, interceptors ...clientv2.RequestInterceptor) (*CreateAPIKey, error)
+	GetAPIKeyByID(ctx context.Context, apiKeyID string, interceptors ...clientv2.RequestInterceptor) (*GetAPIKeyByID, error)
+	GetAPIKeys(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAPIKeys, error)
+	DeleteAPIKey(ctx context.Context, deleteAPIKeyID string, interceptors ...clientv2.RequestInterceptor) (*DeleteAPIKey, error)
 	GetGroupByID(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupByID, error)
 	GroupsWhere(ctx context.Context, where *GroupWhereInput, interceptors ...clientv2.RequestInterceptor) (*GroupsWhere, error)
 	GetAllGroups(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllGroups, error)
 	CreateGroup(ctx context.Context, input CreateGroupInput, interceptors ...clientv2.RequestInterceptor) (*CreateGroup, error)
 	UpdateGroup(ctx context.Context, updateGroupID string, input UpdateGroupInput, interceptors ...clientv2.RequestInterceptor) (*UpdateGroup, error)
 	DeleteGroup(ctx context.Context, deleteGroupID string, interceptors ...clientv2.RequestInterceptor) (*DeleteGroup, error)
+	RequestToJoinGroup(ctx context.Context, input CreateGroupJoinRequestInput, interceptors ...clientv2.RequestInterceptor) (*RequestToJoinGroup, error)
+	ApproveGroupJoinRequest(ctx context.Context, approveGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*ApproveGroupJoinRequest, error)
+	DeclineGroupJoinRequest(ctx context.Context, declineGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*DeclineGroupJoinRequest, error)
+	DeleteGroupJoinRequest(ctx context.Context, deleteGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*DeleteGroupJoinRequest, error)
+	GetGroupJoinRequestByID(ctx context.Context, groupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupJoinRequestByID, error)
+	AddUserToGroup(ctx context.Context, input CreateGroupMembershipInput, interceptors ...clientv2.RequestInterceptor) (*AddUserToGroup, error)
+	JoinGroup(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*JoinGroup, error)
+	UpdateGroupMemberRole(ctx context.Context, updateGroupMemberRoleID string, role groupmembership.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateGroupMemberRole, error)
+	RemoveUserFromGroup(ctx context.Context, removeUserFromGroupID string, interceptors ...clientv2.RequestInterceptor) (*RemoveUserFromGroup, error)
+	GetGroupMembershipByID(ctx context.Context, groupMembershipID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupMembershipByID, error)
+	GetGroupMembers(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupMembers, error)
 	GetGroupSetting(ctx context.Context, groupSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupSetting, error)
+	CreateInvite(ctx context.Context, input CreateInviteInput, interceptors ...clientv2.RequestInterceptor) (*CreateInvite, error)
+	RevokeInvite(ctx context.Context, revokeInviteID string, interceptors ...clientv2.RequestInterceptor) (*RevokeInvite, error)
+	GetInviteByID(ctx context.Context, inviteID string, interceptors ...clientv2.RequestInterceptor) (*GetInviteByID, error)
+	GetInvites(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetInvites, error)
+	CreateOauthClient(ctx context.Context, input CreateOauthClientInput, interceptors ...clientv2.RequestInterceptor) (*CreateOauthClient, error)
+	UpdateOauthClient(ctx context.Context, updateOauthClientID string, input UpdateOauthClientInput, interceptors ...clientv2.RequestInterceptor) (*UpdateOauthClient, error)
+	GetOauthClientByID(ctx context.Context, oauthClientID string, interceptors ...clientv2.RequestInterceptor) (*GetOauthClientByID, error)
+	GetOauthClients(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetOauthClients, error)
+	DeleteOauthClient(ctx context.Context, deleteOauthClientID string, interceptors ...clientv2.RequestInterceptor) (*DeleteOauthClient, error)
 	GetOrganizationByID(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationByID, error)
 	GetAllOrganizations(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllOrganizations, error)
 	OrganizationsWhere(ctx context.Context, where *OrganizationWhereInput, interceptors ...clientv2.RequestInterceptor) (*OrganizationsWhere, error)
+	GetOrganizationAncestors(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationAncestors, error)
+	GetOrganizationDescendants(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationDescendants, error)
 	CreateOrganization(ctx context.Context, input CreateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*CreateOrganization, error)
 	UpdateOrganization(ctx context.Context, updateOrganizationID string, input UpdateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*UpdateOrganization, error)
+	MoveOrganization(ctx context.Context, moveOrganizationID string, parentID string, interceptors ...clientv2.RequestInterceptor) (*MoveOrganization, error)
 	DeleteOrganization(ctx context.Context, deleteOrganizationID string, interceptors ...clientv2.RequestInterceptor) (*DeleteOrganization, error)
 	GetOrganizationSetting(ctx context.Context, organizationSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationSetting, error)
+	AddUserToOrganization(ctx context.Context, input CreateOrgMembershipInput, interceptors ...clientv2.RequestInterceptor) (*AddUserToOrganization, error)
+	UpdateOrgMemberRole(ctx context.Context, updateOrgMemberRoleID string, role orgmembership.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateOrgMemberRole, error)
+	RemoveUserFromOrganization(ctx context.Context, removeUserFromOrganizationID string, interceptors ...clientv2.RequestInterceptor) (*RemoveUserFromOrganization, error)
+	GetOrgMembershipByID(ctx context.Context, orgMembershipID string, interceptors ...clientv2.RequestInterceptor) (*GetOrgMembershipByID, error)
+	GetOrgMembers(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrgMembers, error)
+	TransferOrganizationOwnership(ctx context.Context, organizationID string, recipientID string, interceptors ...clientv2.RequestInterceptor) (*TransferOrganizationOwnership, error)
+	TransferGroupOwnership(ctx context.Context, groupID string, recipientID string, interceptors ...clientv2.RequestInterceptor) (*TransferGroupOwnership, error)
+	CancelOwnershipTransfer(ctx context.Context, cancelOwnershipTransferID string, interceptors ...clientv2.RequestInterceptor) (*CancelOwnershipTransfer, error)
+	GetOwnershipTransferByID(ctx context.Context, ownershipTransferID string, interceptors ...clientv2.RequestInterceptor) (*GetOwnershipTransferByID, error)
+	GetOwnershipTransfers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetOwnershipTransfers, error)
 	CreatePersonalAccessToken(ctx context.Context, input CreatePersonalAccessTokenInput, interceptors ...clientv2.RequestInterceptor) (*CreatePersonalAccessToken, error)
 	GetPersonalAccessTokenByID(ctx context.Context, personalAccessTokenID string, interceptors ...clientv2.RequestInterceptor) (*GetPersonalAccessTokenByID, error)
 	DeletePersonalAccessToken(ctx context.Context, deletePersonalAccessTokenID string, interceptors ...clientv2.RequestInterceptor) (*DeletePersonalAccessToken, error)
+	UpdatePersonalAccessToken(ctx context.Context, updatePersonalAccessTokenID string, input UpdatePersonalAccessTokenInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePersonalAccessToken, error)
+	EnrollTfa(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*EnrollTfa, error)
+	ConfirmTfa(ctx context.Context, code string, interceptors ...clientv2.RequestInterceptor) (*ConfirmTfa, error)
+	DisableTfa(ctx context.Context, code string, interceptors ...clientv2.RequestInterceptor) (*DisableTfa, error)
 	GetUserByID(ctx context.Context, userID string, interceptors ...clientv2.RequestInterceptor) (*GetUserByID, error)
 	GetUserByIDWithOrgs(ctx context.Context, userID string, interceptors ...clientv2.RequestInterceptor) (*GetUserByIDWithOrgs, error)
 	GetAllUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllUsers, error)
 	CreateUser(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (*CreateUser, error)
 	UpdateUser(ctx context.Context, updateUserID string, input UpdateUserInput, interceptors ...clientv2.RequestInterceptor) (*UpdateUser, error)
 	DeleteUser(ctx context.Context, deleteUserID string, interceptors ...clientv2.RequestInterceptor) (*DeleteUser, error)
+	UnlockUser(ctx context.Context, unlockUserID string, interceptors ...clientv2.RequestInterceptor) (*UnlockUser, error)
+	MySessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MySessions, error)
+	RevokeSession(ctx context.Context, revokeSessionID string, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error)
 	GetUserSettingByID(ctx context.Context, userSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetUserSettingByID, error)
+	GetWebauthnCredentials(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetWebauthnCredentials, error)
+	GetWebauthnCredentialByID(ctx context.Context, webauthnCredentialID string, interceptors ...clientv2.RequestInterceptor) (*GetWebauthnCredentialByID, error)
+	DeleteWebauthnCredential(ctx context.Context, deleteWebauthnCredentialID string, interceptors ...clientv2.RequestInterceptor) (*DeleteWebauthnCredential, error)
 }
 
 type Client struct {
@@ -49,71 +101,460 @@ func NewClient(cli *http.Client, baseURL string, options *clientv2.Options, inte
 }
 
 type Query struct {
-	Node                 generated.Noder               "json:\"node,omitempty\" graphql:\"node\""
-	Nodes                []generated.Noder             "json:\"nodes\" graphql:\"nodes\""
-	Entitlements         EntitlementConnection         "json:\"entitlements\" graphql:\"entitlements\""
-	Groups               GroupConnection               "json:\"groups\" graphql:\"groups\""
-	GroupSettings        GroupSettingConnection        "json:\"groupSettings\" graphql:\"groupSettings\""
-	Integrations         IntegrationConnection         "json:\"integrations\" graphql:\"integrations\""
-	OauthProviders       OauthProviderConnection       "json:\"oauthProviders\" graphql:\"oauthProviders\""
-	OhAuthTooTokens      OhAuthTooTokenConnection      "json:\"ohAuthTooTokens\" graphql:\"ohAuthTooTokens\""
-	Organizations        OrganizationConnection        "json:\"organizations\" graphql:\"organizations\""
-	OrganizationSettings OrganizationSettingConnection "json:\"organizationSettings\" graphql:\"organizationSettings\""
-	PersonalAccessTokens PersonalAccessTokenConnection "json:\"personalAccessTokens\" graphql:\"personalAccessTokens\""
-	Sessions             SessionConnection             "json:\"sessions\" graphql:\"sessions\""
-	Users                UserConnection                "json:\"users\" graphql:\"users\""
-	UserSettings         UserSettingConnection         "json:\"userSettings\" graphql:\"userSettings\""
-	Entitlement          Entitlement                   "json:\"entitlement\" graphql:\"entitlement\""
-	Group                Group                         "json:\"group\" graphql:\"group\""
-	GroupSetting         GroupSetting                  "json:\"groupSetting\" graphql:\"groupSetting\""
-	Integration          Integration                   "json:\"integration\" graphql:\"integration\""
-	OauthProvider        OauthProvider                 "json:\"oauthProvider\" graphql:\"oauthProvider\""
-	OhAuthTooToken       OhAuthTooToken                "json:\"ohAuthTooToken\" graphql:\"ohAuthTooToken\""
-	Organization         Organization                  "json:\"organization\" graphql:\"organization\""
-	OrganizationSetting  OrganizationSetting           "json:\"organizationSetting\" graphql:\"organizationSetting\""
-	PersonalAccessToken  PersonalAccessToken           "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
-	Session              Session                       "json:\"session\" graphql:\"session\""
-	User                 User                          "json:\"user\" graphql:\"user\""
-	UserSetting          UserSetting                   "json:\"userSetting\" graphql:\"userSetting\""
+	Node                    generated.Noder               "json:\"node,omitempty\" graphql:\"node\""
+	Nodes                   []generated.Noder             "json:\"nodes\" graphql:\"nodes\""
+	APIKeys                 APIKeyConnection              "json:\"apiKeys\" graphql:\"apiKeys\""
+	Entitlements            EntitlementConnection         "json:\"entitlements\" graphql:\"entitlements\""
+	Groups                  GroupConnection               "json:\"groups\" graphql:\"groups\""
+	GroupJoinRequests       GroupJoinRequestConnection    "json:\"groupJoinRequests\" graphql:\"groupJoinRequests\""
+	GroupMemberships        GroupMembershipConnection     "json:\"groupMemberships\" graphql:\"groupMemberships\""
+	GroupSettings           GroupSettingConnection        "json:\"groupSettings\" graphql:\"groupSettings\""
+	Integrations            IntegrationConnection         "json:\"integrations\" graphql:\"integrations\""
+	Invites                 InviteConnection              "json:\"invites\" graphql:\"invites\""
+	OauthClients            OauthClientConnection         "json:\"oauthClients\" graphql:\"oauthClients\""
+	OauthProviders          OauthProviderConnection       "json:\"oauthProviders\" graphql:\"oauthProviders\""
+	OhAuthTooTokens         OhAuthTooTokenConnection      "json:\"ohAuthTooTokens\" graphql:\"ohAuthTooTokens\""
+	OrgMemberships          OrgMembershipConnection       "json:\"orgMemberships\" graphql:\"orgMemberships\""
+	Organizations           OrganizationConnection        "json:\"organizations\" graphql:\"organizations\""
+	OrganizationSettings    OrganizationSettingConnection "json:\"organizationSettings\" graphql:\"organizationSettings\""
+	OwnershipTransfers      OwnershipTransferConnection   "json:\"ownershipTransfers\" graphql:\"ownershipTransfers\""
+	PersonalAccessTokens    PersonalAccessTokenConnection "json:\"personalAccessTokens\" graphql:\"personalAccessTokens\""
+	Sessions                SessionConnection             "json:\"sessions\" graphql:\"sessions\""
+	Users                   UserConnection                "json:\"users\" graphql:\"users\""
+	UserSettings            UserSettingConnection         "json:\"userSettings\" graphql:\"userSettings\""
+	WebauthnCredentials     WebauthnCredentialConnection  "json:\"webauthnCredentials\" graphql:\"webauthnCredentials\""
+	APIKey                  APIKey                        "json:\"apiKey\" graphql:\"apiKey\""
+	Entitlement             Entitlement                   "json:\"entitlement\" graphql:\"entitlement\""
+	Group                   Group                         "json:\"group\" graphql:\"group\""
+	GroupJoinRequest        GroupJoinRequest              "json:\"groupJoinRequest\" graphql:\"groupJoinRequest\""
+	GroupMembership         GroupMembership               "json:\"groupMembership\" graphql:\"groupMembership\""
+	GroupSetting            GroupSetting                  "json:\"groupSetting\" graphql:\"groupSetting\""
+	Integration             Integration                   "json:\"integration\" graphql:\"integration\""
+	Invite                  Invite                        "json:\"invite\" graphql:\"invite\""
+	OauthClient             OauthClient                   "json:\"oauthClient\" graphql:\"oauthClient\""
+	OauthProvider           OauthProvider                 "json:\"oauthProvider\" graphql:\"oauthProvider\""
+	OhAuthTooToken          OhAuthTooToken                "json:\"ohAuthTooToken\" graphql:\"ohAuthTooToken\""
+	Organization            Organization                  "json:\"organization\" graphql:\"organization\""
+	OrganizationAncestors   []*Organization               "json:\"organizationAncestors\" graphql:\"organizationAncestors\""
+	OrganizationDescendants []*Organization               "json:\"organizationDescendants\" graphql:\"organizationDescendants\""
+	OrganizationSetting     OrganizationSetting           "json:\"organizationSetting\" graphql:\"organizationSetting\""
+	OrgMembership           OrgMembership                 "json:\"orgMembership\" graphql:\"orgMembership\""
+	OwnershipTransfer       OwnershipTransfer             "json:\"ownershipTransfer\" graphql:\"ownershipTransfer\""
+	PersonalAccessToken     PersonalAccessToken           "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+	Session                 Session                       "json:\"session\" graphql:\"session\""
+	User                    User                          "json:\"user\" graphql:\"user\""
+	MySessions              []*Session                    "json:\"mySessions\" graphql:\"mySessions\""
+	UserSetting             UserSetting                   "json:\"userSetting\" graphql:\"userSetting\""
+	WebauthnCredential      WebauthnCredential            "json:\"webauthnCredential\" graphql:\"webauthnCredential\""
 }
 type Mutation struct {
-	CreateEntitlement         EntitlementCreatePayload         "json:\"createEntitlement\" graphql:\"createEntitlement\""
-	UpdateEntitlement         EntitlementUpdatePayload         "json:\"updateEntitlement\" graphql:\"updateEntitlement\""
-	DeleteEntitlement         EntitlementDeletePayload         "json:\"deleteEntitlement\" graphql:\"deleteEntitlement\""
-	CreateGroup               GroupCreatePayload               "json:\"createGroup\" graphql:\"createGroup\""
-	UpdateGroup               GroupUpdatePayload               "json:\"updateGroup\" graphql:\"updateGroup\""
-	DeleteGroup               GroupDeletePayload               "json:\"deleteGroup\" graphql:\"deleteGroup\""
-	CreateGroupSetting        GroupSettingCreatePayload        "json:\"createGroupSetting\" graphql:\"createGroupSetting\""
-	UpdateGroupSetting        GroupSettingUpdatePayload        "json:\"updateGroupSetting\" graphql:\"updateGroupSetting\""
-	DeleteGroupSetting        GroupSettingDeletePayload        "json:\"deleteGroupSetting\" graphql:\"deleteGroupSetting\""
-	CreateIntegration         IntegrationCreatePayload         "json:\"createIntegration\" graphql:\"createIntegration\""
-	UpdateIntegration         IntegrationUpdatePayload         "json:\"updateIntegration\" graphql:\"updateIntegration\""
-	DeleteIntegration         IntegrationDeletePayload         "json:\"deleteIntegration\" graphql:\"deleteIntegration\""
-	CreateOauthProvider       OauthProviderCreatePayload       "json:\"createOauthProvider\" graphql:\"createOauthProvider\""
-	UpdateOauthProvider       OauthProviderUpdatePayload       "json:\"updateOauthProvider\" graphql:\"updateOauthProvider\""
-	DeleteOauthProvider       OauthProviderDeletePayload       "json:\"deleteOauthProvider\" graphql:\"deleteOauthProvider\""
-	CreateOhAuthTooToken      OhAuthTooTokenCreatePayload      "json:\"createOhAuthTooToken\" graphql:\"createOhAuthTooToken\""
-	UpdateOhAuthTooToken      OhAuthTooTokenUpdatePayload      "json:\"updateOhAuthTooToken\" graphql:\"updateOhAuthTooToken\""
-	DeleteOhAuthTooToken      OhAuthTooTokenDeletePayload      "json:\"deleteOhAuthTooToken\" graphql:\"deleteOhAuthTooToken\""
-	CreateOrganization        OrganizationCreatePayload        "json:\"createOrganization\" graphql:\"createOrganization\""
-	UpdateOrganization        OrganizationUpdatePayload        "json:\"updateOrganization\" graphql:\"updateOrganization\""
-	DeleteOrganization        OrganizationDeletePayload        "json:\"deleteOrganization\" graphql:\"deleteOrganization\""
-	CreateOrganizationSetting OrganizationSettingCreatePayload "json:\"createOrganizationSetting\" graphql:\"createOrganizationSetting\""
-	UpdateOrganizationSetting OrganizationSettingUpdatePayload "json:\"updateOrganizationSetting\" graphql:\"updateOrganizationSetting\""
-	DeleteOrganizationSetting OrganizationSettingDeletePayload "json:\"deleteOrganizationSetting\" graphql:\"deleteOrganizationSetting\""
-	CreatePersonalAccessToken PersonalAccessTokenCreatePayload "json:\"createPersonalAccessToken\" graphql:\"createPersonalAccessToken\""
-	UpdatePersonalAccessToken PersonalAccessTokenUpdatePayload "json:\"updatePersonalAccessToken\" graphql:\"updatePersonalAccessToken\""
-	DeletePersonalAccessToken PersonalAccessTokenDeletePayload "json:\"deletePersonalAccessToken\" graphql:\"deletePersonalAccessToken\""
-	CreateSession             SessionCreatePayload             "json:\"createSession\" graphql:\"createSession\""
-	UpdateSession             SessionUpdatePayload             "json:\"updateSession\" graphql:\"updateSession\""
-	DeleteSession             SessionDeletePayload             "json:\"deleteSession\" graphql:\"deleteSession\""
-	CreateUser                UserCreatePayload                "json:\"createUser\" graphql:\"createUser\""
-	UpdateUser                UserUpdatePayload                "json:\"updateUser\" graphql:\"updateUser\""
-	DeleteUser                UserDeletePayload                "json:\"deleteUser\" graphql:\"deleteUser\""
-	CreateUserSetting         UserSettingCreatePayload         "json:\"createUserSetting\" graphql:\"createUserSetting\""
-	UpdateUserSetting         UserSettingUpdatePayload         "json:\"updateUserSetting\" graphql:\"updateUserSetting\""
-	DeleteUserSetting         UserSettingDeletePayload         "json:\"deleteUserSetting\" graphql:\"deleteUserSetting\""
+	CreateAPIKey                  APIKeyCreatePayload              "json:\"createAPIKey\" graphql:\"createAPIKey\""
+	UpdateAPIKey                  APIKeyUpdatePayload              "json:\"updateAPIKey\" graphql:\"updateAPIKey\""
+	DeleteAPIKey                  APIKeyDeletePayload              "json:\"deleteAPIKey\" graphql:\"deleteAPIKey\""
+	CreateEntitlement             EntitlementCreatePayload         "json:\"createEntitlement\" graphql:\"createEntitlement\""
+	UpdateEntitlement             EntitlementUpdatePayload         "json:\"updateEntitlement\" graphql:\"updateEntitlement\""
+	DeleteEntitlement             EntitlementDeletePayload         "json:\"deleteEntitlement\" graphql:\"deleteEntitlement\""
+	CreateGroup                   GroupCreatePayload               "json:\"createGroup\" graphql:\"createGroup\""
+	UpdateGroup                   GroupUpdatePayload               "json:\"updateGroup\" graphql:\"updateGroup\""
+	DeleteGroup                   GroupDeletePayload               "json:\"deleteGroup\" graphql:\"deleteGroup\""
+	RequestToJoinGroup            GroupJoinRequestCreatePayload    "json:\"requestToJoinGroup\" graphql:\"requestToJoinGroup\""
+	ApproveGroupJoinRequest       GroupJoinRequestUpdatePayload    "json:\"approveGroupJoinRequest\" graphql:\"approveGroupJoinRequest\""
+	DeclineGroupJoinRequest       GroupJoinRequestUpdatePayload    "json:\"declineGroupJoinRequest\" graphql:\"declineGroupJoinRequest\""
+	DeleteGroupJoinRequest        GroupJoinRequestDeletePayload    "json:\"deleteGroupJoinRequest\" graphql:\"deleteGroupJoinRequest\""
+	AddUserToGroup                GroupMembershipCreatePayload     "json:\"addUserToGroup\" graphql:\"addUserToGroup\""
+	JoinGroup                     GroupMembershipCreatePayload     "json:\"joinGroup\" graphql:\"joinGroup\""
+	UpdateGroupMemberRole         GroupMembershipUpdatePayload     "json:\"updateGroupMemberRole\" graphql:\"updateGroupMemberRole\""
+	RemoveUserFromGroup           GroupMembershipDeletePayload     "json:\"removeUserFromGroup\" graphql:\"removeUserFromGroup\""
+	CreateGroupSetting            GroupSettingCreatePayload        "json:\"createGroupSetting\" graphql:\"createGroupSetting\""
+	UpdateGroupSetting            GroupSettingUpdatePayload        "json:\"updateGroupSetting\" graphql:\"updateGroupSetting\""
+	DeleteGroupSetting            GroupSettingDeletePayload        "json:\"deleteGroupSetting\" graphql:\"deleteGroupSetting\""
+	CreateIntegration             IntegrationCreatePayload         "json:\"createIntegration\" graphql:\"createIntegration\""
+	UpdateIntegration             IntegrationUpdatePayload         "json:\"updateIntegration\" graphql:\"updateIntegration\""
+	DeleteIntegration             IntegrationDeletePayload         "json:\"deleteIntegration\" graphql:\"deleteIntegration\""
+	CreateInvite                  InviteCreatePayload              "json:\"createInvite\" graphql:\"createInvite\""
+	RevokeInvite                  InviteRevokePayload              "json:\"revokeInvite\" graphql:\"revokeInvite\""
+	CreateOauthClient             OauthClientCreatePayload         "json:\"createOauthClient\" graphql:\"createOauthClient\""
+	UpdateOauthClient             OauthClientUpdatePayload         "json:\"updateOauthClient\" graphql:\"updateOauthClient\""
+	DeleteOauthClient             OauthClientDeletePayload         "json:\"deleteOauthClient\" graphql:\"deleteOauthClient\""
+	CreateOauthProvider           OauthProviderCreatePayload       "json:\"createOauthProvider\" graphql:\"createOauthProvider\""
+	UpdateOauthProvider           OauthProviderUpdatePayload       "json:\"updateOauthProvider\" graphql:\"updateOauthProvider\""
+	DeleteOauthProvider           OauthProviderDeletePayload       "json:\"deleteOauthProvider\" graphql:\"deleteOauthProvider\""
+	CreateOhAuthTooToken          OhAuthTooTokenCreatePayload      "json:\"createOhAuthTooToken\" graphql:\"createOhAuthTooToken\""
+	UpdateOhAuthTooToken          OhAuthTooTokenUpdatePayload      "json:\"updateOhAuthTooToken\" graphql:\"updateOhAuthTooToken\""
+	DeleteOhAuthTooToken          OhAuthTooTokenDeletePayload      "json:\"deleteOhAuthTooToken\" graphql:\"deleteOhAuthTooToken\""
+	CreateOrganization            OrganizationCreatePayload        "json:\"createOrganization\" graphql:\"createOrganization\""
+	UpdateOrganization            OrganizationUpdatePayload        "json:\"updateOrganization\" graphql:\"updateOrganization\""
+	MoveOrganization              OrganizationUpdatePayload        "json:\"moveOrganization\" graphql:\"moveOrganization\""
+	DeleteOrganization            OrganizationDeletePayload        "json:\"deleteOrganization\" graphql:\"deleteOrganization\""
+	CreateOrganizationSetting     OrganizationSettingCreatePayload "json:\"createOrganizationSetting\" graphql:\"createOrganizationSetting\""
+	UpdateOrganizationSetting     OrganizationSettingUpdatePayload "json:\"updateOrganizationSetting\" graphql:\"updateOrganizationSetting\""
+	DeleteOrganizationSetting     OrganizationSettingDeletePayload "json:\"deleteOrganizationSetting\" graphql:\"deleteOrganizationSetting\""
+	AddUserToOrganization         OrgMembershipCreatePayload       "json:\"addUserToOrganization\" graphql:\"addUserToOrganization\""
+	UpdateOrgMemberRole           OrgMembershipUpdatePayload       "json:\"updateOrgMemberRole\" graphql:\"updateOrgMemberRole\""
+	RemoveUserFromOrganization    OrgMembershipDeletePayload       "json:\"removeUserFromOrganization\" graphql:\"removeUserFromOrganization\""
+	TransferOrganizationOwnership OwnershipTransferCreatePayload   "json:\"transferOrganizationOwnership\" graphql:\"transferOrganizationOwnership\""
+	TransferGroupOwnership        OwnershipTransferCreatePayload   "json:\"transferGroupOwnership\" graphql:\"transferGroupOwnership\""
+	CancelOwnershipTransfer       OwnershipTransferCancelPayload   "json:\"cancelOwnershipTransfer\" graphql:\"cancelOwnershipTransfer\""
+	CreatePersonalAccessToken     PersonalAccessTokenCreatePayload "json:\"createPersonalAccessToken\" graphql:\"createPersonalAccessToken\""
+	UpdatePersonalAccessToken     PersonalAccessTokenUpdatePayload "json:\"updatePersonalAccessToken\" graphql:\"updatePersonalAccessToken\""
+	DeletePersonalAccessToken     PersonalAccessTokenDeletePayload "json:\"deletePersonalAccessToken\" graphql:\"deletePersonalAccessToken\""
+	CreateSession                 SessionCreatePayload             "json:\"createSession\" graphql:\"createSession\""
+	UpdateSession                 SessionUpdatePayload             "json:\"updateSession\" graphql:\"updateSession\""
+	DeleteSession                 SessionDeletePayload             "json:\"deleteSession\" graphql:\"deleteSession\""
+	EnrollTfa                     TFAEnrollPayload                 "json:\"enrollTFA\" graphql:\"enrollTFA\""
+	ConfirmTfa                    TFAConfirmPayload                "json:\"confirmTFA\" graphql:\"confirmTFA\""
+	DisableTfa                    UserSettingUpdatePayload         "json:\"disableTFA\" graphql:\"disableTFA\""
+	CreateUser                    UserCreatePayload                "json:\"createUser\" graphql:\"createUser\""
+	UpdateUser                    UserUpdatePayload                "json:\"updateUser\" graphql:\"updateUser\""
+	DeleteUser                    UserDeletePayload                "json:\"deleteUser\" graphql:\"deleteUser\""
+	UnlockUser                    UserSettingUpdatePayload         "json:\"unlockUser\" graphql:\"unlockUser\""
+	RevokeSession                 SessionUpdatePayload             "json:\"revokeSession\" graphql:\"revokeSession\""
+	CreateUserSetting             UserSettingCreatePayload         "json:\"createUserSetting\" graphql:\"createUserSetting\""
+	UpdateUserSetting             UserSettingUpdatePayload         "json:\"updateUserSetting\" graphql:\"updateUserSetting\""
+	DeleteUserSetting             UserSettingDeletePayload         "json:\"deleteUserSetting\" graphql:\"deleteUserSetting\""
+	DeleteWebauthnCredential      WebauthnCredentialDeletePayload  "json:\"deleteWebauthnCredential\" graphql:\"deleteWebauthnCredential\""
+}
+type CreateAPIKey_CreateAPIKey_APIKey_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *CreateAPIKey_CreateAPIKey_APIKey_Owner) GetID() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey_Owner{}
+	}
+	return t.ID
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey_Owner) GetName() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey_Owner{}
+	}
+	return t.Name
+}
+
+type CreateAPIKey_CreateAPIKey_APIKey struct {
+	ID          string                                 "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                              "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                              "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name        string                                 "json:\"name\" graphql:\"name\""
+	KeyPrefix   string                                 "json:\"keyPrefix\" graphql:\"keyPrefix\""
+	Abilities   []string                               "json:\"abilities\" graphql:\"abilities\""
+	ExpiresAt   *time.Time                             "json:\"expiresAt,omitempty\" graphql:\"expiresAt\""
+	Description *string                                "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                             "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       CreateAPIKey_CreateAPIKey_APIKey_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetID() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.ID
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return &t.CreatedAt
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return &t.UpdatedAt
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetCreatedBy() *string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.CreatedBy
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetUpdatedBy() *string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.UpdatedBy
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetName() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.Name
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetKeyPrefix() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.KeyPrefix
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetAbilities() []string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.Abilities
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.ExpiresAt
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetDescription() *string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.Description
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return t.LastUsedAt
+}
+func (t *CreateAPIKey_CreateAPIKey_APIKey) GetOwner() *CreateAPIKey_CreateAPIKey_APIKey_Owner {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey_APIKey{}
+	}
+	return &t.Owner
+}
+
+type CreateAPIKey_CreateAPIKey struct {
+	APIKey CreateAPIKey_CreateAPIKey_APIKey "json:\"apiKey\" graphql:\"apiKey\""
+	Key    string                           "json:\"key\" graphql:\"key\""
+}
+
+func (t *CreateAPIKey_CreateAPIKey) GetAPIKey() *CreateAPIKey_CreateAPIKey_APIKey {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey{}
+	}
+	return &t.APIKey
+}
+func (t *CreateAPIKey_CreateAPIKey) GetKey() string {
+	if t == nil {
+		t = &CreateAPIKey_CreateAPIKey{}
+	}
+	return t.Key
+}
+
+type GetAPIKeyByID_APIKey_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetAPIKeyByID_APIKey_Owner) GetID() string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey_Owner{}
+	}
+	return t.ID
+}
+func (t *GetAPIKeyByID_APIKey_Owner) GetName() string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey_Owner{}
+	}
+	return t.Name
+}
+
+type GetAPIKeyByID_APIKey struct {
+	ID          string                     "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                  "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                  "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                    "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                    "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name        string                     "json:\"name\" graphql:\"name\""
+	KeyPrefix   string                     "json:\"keyPrefix\" graphql:\"keyPrefix\""
+	Abilities   []string                   "json:\"abilities\" graphql:\"abilities\""
+	ExpiresAt   *time.Time                 "json:\"expiresAt,omitempty\" graphql:\"expiresAt\""
+	Description *string                    "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                 "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       GetAPIKeyByID_APIKey_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetAPIKeyByID_APIKey) GetID() string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.ID
+}
+func (t *GetAPIKeyByID_APIKey) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetAPIKeyByID_APIKey) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetAPIKeyByID_APIKey) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.CreatedBy
+}
+func (t *GetAPIKeyByID_APIKey) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetAPIKeyByID_APIKey) GetName() string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.Name
+}
+func (t *GetAPIKeyByID_APIKey) GetKeyPrefix() string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.KeyPrefix
+}
+func (t *GetAPIKeyByID_APIKey) GetAbilities() []string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.Abilities
+}
+func (t *GetAPIKeyByID_APIKey) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.ExpiresAt
+}
+func (t *GetAPIKeyByID_APIKey) GetDescription() *string {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.Description
+}
+func (t *GetAPIKeyByID_APIKey) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return t.LastUsedAt
+}
+func (t *GetAPIKeyByID_APIKey) GetOwner() *GetAPIKeyByID_APIKey_Owner {
+	if t == nil {
+		t = &GetAPIKeyByID_APIKey{}
+	}
+	return &t.Owner
+}
+
+type GetAPIKeys_APIKeys_Edges_Node_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetAPIKeys_APIKeys_Edges_Node_Owner) GetID() string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node_Owner{}
+	}
+	return t.ID
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node_Owner) GetName() string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node_Owner{}
+	}
+	return t.Name
+}
+
+type GetAPIKeys_APIKeys_Edges_Node struct {
+	ID          string                              "json:\"id\" graphql:\"id\""
+	Name        string                              "json:\"name\" graphql:\"name\""
+	KeyPrefix   string                              "json:\"keyPrefix\" graphql:\"keyPrefix\""
+	Abilities   []string                            "json:\"abilities\" graphql:\"abilities\""
+	ExpiresAt   *time.Time                          "json:\"expiresAt,omitempty\" graphql:\"expiresAt\""
+	Description *string                             "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                          "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       GetAPIKeys_APIKeys_Edges_Node_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetID() string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetName() string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.Name
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetKeyPrefix() string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.KeyPrefix
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetAbilities() []string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.Abilities
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.ExpiresAt
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetDescription() *string {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.Description
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return t.LastUsedAt
+}
+func (t *GetAPIKeys_APIKeys_Edges_Node) GetOwner() *GetAPIKeys_APIKeys_Edges_Node_Owner {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges_Node{}
+	}
+	return &t.Owner
+}
+
+type GetAPIKeys_APIKeys_Edges struct {
+	Node *GetAPIKeys_APIKeys_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetAPIKeys_APIKeys_Edges) GetNode() *GetAPIKeys_APIKeys_Edges_Node {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys_Edges{}
+	}
+	return t.Node
+}
+
+type GetAPIKeys_APIKeys struct {
+	Edges []*GetAPIKeys_APIKeys_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetAPIKeys_APIKeys) GetEdges() []*GetAPIKeys_APIKeys_Edges {
+	if t == nil {
+		t = &GetAPIKeys_APIKeys{}
+	}
+	return t.Edges
+}
+
+type DeleteAPIKey_DeleteAPIKey struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
+
+func (t *DeleteAPIKey_DeleteAPIKey) GetDeletedID() string {
+	if t == nil {
+		t = &DeleteAPIKey_DeleteAPIKey{}
+	}
+	return t.DeletedID
+}
+
 type GetGroupByID_Group_Owner struct {
 	ID string "json:\"id\" graphql:\"id\""
 }
@@ -901,3090 +1342,7577 @@ func (t *DeleteGroup_DeleteGroup) GetDeletedID() string {
 	return t.DeletedID
 }
 
-type GetGroupSetting_GroupSetting_Group struct {
-	ID string "json:\"id\" graphql:\"id\""
-}
-
-func (t *GetGroupSetting_GroupSetting_Group) GetID() string {
-	if t == nil {
-		t = &GetGroupSetting_GroupSetting_Group{}
-	}
-	return t.ID
-}
-
-type GetGroupSetting_GroupSetting struct {
-	ID           string                              "json:\"id\" graphql:\"id\""
-	CreatedAt    time.Time                           "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt    time.Time                           "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy    *string                             "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy    *string                             "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Visibility   groupsetting.Visibility             "json:\"visibility\" graphql:\"visibility\""
-	JoinPolicy   groupsetting.JoinPolicy             "json:\"joinPolicy\" graphql:\"joinPolicy\""
-	Tags         []string                            "json:\"tags\" graphql:\"tags\""
-	SyncToSlack  bool                                "json:\"syncToSlack\" graphql:\"syncToSlack\""
-	SyncToGithub bool                                "json:\"syncToGithub\" graphql:\"syncToGithub\""
-	Group        *GetGroupSetting_GroupSetting_Group "json:\"group,omitempty\" graphql:\"group\""
+type RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest struct {
+	ID         string                  "json:\"id\" graphql:\"id\""
+	CreatedAt  time.Time               "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt  time.Time               "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy  *string                 "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy  *string                 "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	GroupID    string                  "json:\"groupID\" graphql:\"groupID\""
+	UserID     string                  "json:\"userID\" graphql:\"userID\""
+	Message    *string                 "json:\"message,omitempty\" graphql:\"message\""
+	Status     groupjoinrequest.Status "json:\"status\" graphql:\"status\""
+	ReviewerID *string                 "json:\"reviewerID,omitempty\" graphql:\"reviewerID\""
 }
 
-func (t *GetGroupSetting_GroupSetting) GetID() string {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetID() string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
 	return t.ID
 }
-func (t *GetGroupSetting_GroupSetting) GetCreatedAt() *time.Time {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
 	return &t.CreatedAt
 }
-func (t *GetGroupSetting_GroupSetting) GetUpdatedAt() *time.Time {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
 	return &t.UpdatedAt
 }
-func (t *GetGroupSetting_GroupSetting) GetCreatedBy() *string {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
 	return t.CreatedBy
 }
-func (t *GetGroupSetting_GroupSetting) GetUpdatedBy() *string {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
 	return t.UpdatedBy
 }
-func (t *GetGroupSetting_GroupSetting) GetVisibility() *groupsetting.Visibility {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetGroupID() string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
-	return &t.Visibility
+	return t.GroupID
 }
-func (t *GetGroupSetting_GroupSetting) GetJoinPolicy() *groupsetting.JoinPolicy {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetUserID() string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
-	return &t.JoinPolicy
+	return t.UserID
 }
-func (t *GetGroupSetting_GroupSetting) GetTags() []string {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetMessage() *string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
-	return t.Tags
+	return t.Message
 }
-func (t *GetGroupSetting_GroupSetting) GetSyncToSlack() bool {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetStatus() *groupjoinrequest.Status {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
-	return t.SyncToSlack
+	return &t.Status
 }
-func (t *GetGroupSetting_GroupSetting) GetSyncToGithub() bool {
+func (t *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest) GetReviewerID() *string {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest{}
 	}
-	return t.SyncToGithub
+	return t.ReviewerID
 }
-func (t *GetGroupSetting_GroupSetting) GetGroup() *GetGroupSetting_GroupSetting_Group {
+
+type RequestToJoinGroup_RequestToJoinGroup struct {
+	GroupJoinRequest RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest "json:\"groupJoinRequest\" graphql:\"groupJoinRequest\""
+}
+
+func (t *RequestToJoinGroup_RequestToJoinGroup) GetGroupJoinRequest() *RequestToJoinGroup_RequestToJoinGroup_GroupJoinRequest {
 	if t == nil {
-		t = &GetGroupSetting_GroupSetting{}
+		t = &RequestToJoinGroup_RequestToJoinGroup{}
 	}
-	return t.Group
+	return &t.GroupJoinRequest
 }
 
-type GetOrganizationByID_Organization_Parent struct {
-	ID   string "json:\"id\" graphql:\"id\""
-	Name string "json:\"name\" graphql:\"name\""
+type ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest struct {
+	ID         string                  "json:\"id\" graphql:\"id\""
+	GroupID    string                  "json:\"groupID\" graphql:\"groupID\""
+	UserID     string                  "json:\"userID\" graphql:\"userID\""
+	Status     groupjoinrequest.Status "json:\"status\" graphql:\"status\""
+	ReviewerID *string                 "json:\"reviewerID,omitempty\" graphql:\"reviewerID\""
 }
 
-func (t *GetOrganizationByID_Organization_Parent) GetID() string {
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest) GetID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Parent{}
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest{}
 	}
 	return t.ID
 }
-func (t *GetOrganizationByID_Organization_Parent) GetName() string {
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Parent{}
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest{}
 	}
-	return t.Name
+	return t.GroupID
+}
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest) GetUserID() string {
+	if t == nil {
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest{}
+	}
+	return t.UserID
+}
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest) GetStatus() *groupjoinrequest.Status {
+	if t == nil {
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest{}
+	}
+	return &t.Status
+}
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest) GetReviewerID() *string {
+	if t == nil {
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest{}
+	}
+	return t.ReviewerID
 }
 
-type GetOrganizationByID_Organization_Children_Edges_Node struct {
-	ID          string  "json:\"id\" graphql:\"id\""
-	Name        string  "json:\"name\" graphql:\"name\""
-	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
-	Description *string "json:\"description,omitempty\" graphql:\"description\""
+type ApproveGroupJoinRequest_ApproveGroupJoinRequest struct {
+	GroupJoinRequest ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest "json:\"groupJoinRequest\" graphql:\"groupJoinRequest\""
 }
 
-func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetID() string {
+func (t *ApproveGroupJoinRequest_ApproveGroupJoinRequest) GetGroupJoinRequest() *ApproveGroupJoinRequest_ApproveGroupJoinRequest_GroupJoinRequest {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
+		t = &ApproveGroupJoinRequest_ApproveGroupJoinRequest{}
+	}
+	return &t.GroupJoinRequest
+}
+
+type DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest struct {
+	ID         string                  "json:\"id\" graphql:\"id\""
+	GroupID    string                  "json:\"groupID\" graphql:\"groupID\""
+	UserID     string                  "json:\"userID\" graphql:\"userID\""
+	Status     groupjoinrequest.Status "json:\"status\" graphql:\"status\""
+	ReviewerID *string                 "json:\"reviewerID,omitempty\" graphql:\"reviewerID\""
+}
+
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest) GetID() string {
+	if t == nil {
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest{}
 	}
 	return t.ID
 }
-func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetName() string {
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest{}
 	}
-	return t.Name
+	return t.GroupID
 }
-func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetDisplayName() string {
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest) GetUserID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest{}
 	}
-	return t.DisplayName
+	return t.UserID
 }
-func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetDescription() *string {
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest) GetStatus() *groupjoinrequest.Status {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest{}
 	}
-	return t.Description
+	return &t.Status
+}
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest) GetReviewerID() *string {
+	if t == nil {
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest{}
+	}
+	return t.ReviewerID
 }
 
-type GetOrganizationByID_Organization_Children_Edges struct {
-	Node *GetOrganizationByID_Organization_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+type DeclineGroupJoinRequest_DeclineGroupJoinRequest struct {
+	GroupJoinRequest DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest "json:\"groupJoinRequest\" graphql:\"groupJoinRequest\""
 }
 
-func (t *GetOrganizationByID_Organization_Children_Edges) GetNode() *GetOrganizationByID_Organization_Children_Edges_Node {
+func (t *DeclineGroupJoinRequest_DeclineGroupJoinRequest) GetGroupJoinRequest() *DeclineGroupJoinRequest_DeclineGroupJoinRequest_GroupJoinRequest {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children_Edges{}
+		t = &DeclineGroupJoinRequest_DeclineGroupJoinRequest{}
 	}
-	return t.Node
+	return &t.GroupJoinRequest
 }
 
-type GetOrganizationByID_Organization_Children struct {
-	Edges []*GetOrganizationByID_Organization_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+type DeleteGroupJoinRequest_DeleteGroupJoinRequest struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
 
-func (t *GetOrganizationByID_Organization_Children) GetEdges() []*GetOrganizationByID_Organization_Children_Edges {
+func (t *DeleteGroupJoinRequest_DeleteGroupJoinRequest) GetDeletedID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Children{}
+		t = &DeleteGroupJoinRequest_DeleteGroupJoinRequest{}
 	}
-	return t.Edges
+	return t.DeletedID
 }
 
-type GetOrganizationByID_Organization_Setting struct {
-	ID             string    "json:\"id\" graphql:\"id\""
-	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
-	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
-	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
-	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
-	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+type GetGroupJoinRequestByID_GroupJoinRequest struct {
+	ID         string                  "json:\"id\" graphql:\"id\""
+	CreatedAt  time.Time               "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt  time.Time               "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy  *string                 "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy  *string                 "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	GroupID    string                  "json:\"groupID\" graphql:\"groupID\""
+	UserID     string                  "json:\"userID\" graphql:\"userID\""
+	Message    *string                 "json:\"message,omitempty\" graphql:\"message\""
+	Status     groupjoinrequest.Status "json:\"status\" graphql:\"status\""
+	ReviewerID *string                 "json:\"reviewerID,omitempty\" graphql:\"reviewerID\""
 }
 
-func (t *GetOrganizationByID_Organization_Setting) GetID() string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
 	return t.ID
 }
-func (t *GetOrganizationByID_Organization_Setting) GetCreatedAt() *time.Time {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
 	return &t.CreatedAt
 }
-func (t *GetOrganizationByID_Organization_Setting) GetUpdatedAt() *time.Time {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
 	return &t.UpdatedAt
 }
-func (t *GetOrganizationByID_Organization_Setting) GetCreatedBy() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
 	return t.CreatedBy
 }
-func (t *GetOrganizationByID_Organization_Setting) GetUpdatedBy() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
 	return t.UpdatedBy
 }
-func (t *GetOrganizationByID_Organization_Setting) GetDomains() []string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
-	return t.Domains
+	return t.GroupID
 }
-func (t *GetOrganizationByID_Organization_Setting) GetSsoCert() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetUserID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
-	return t.SsoCert
+	return t.UserID
 }
-func (t *GetOrganizationByID_Organization_Setting) GetSsoEntrypoint() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetMessage() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
-	return t.SsoEntrypoint
+	return t.Message
 }
-func (t *GetOrganizationByID_Organization_Setting) GetSsoIssuer() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetStatus() *groupjoinrequest.Status {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
-	return t.SsoIssuer
+	return &t.Status
 }
-func (t *GetOrganizationByID_Organization_Setting) GetBillingContact() *string {
+func (t *GetGroupJoinRequestByID_GroupJoinRequest) GetReviewerID() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &GetGroupJoinRequestByID_GroupJoinRequest{}
 	}
-	return t.BillingContact
+	return t.ReviewerID
 }
-func (t *GetOrganizationByID_Organization_Setting) GetBillingEmail() *string {
+
+type AddUserToGroup_AddUserToGroup_GroupMembership struct {
+	ID        string               "json:\"id\" graphql:\"id\""
+	CreatedAt time.Time            "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt time.Time            "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy *string              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy *string              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Role      groupmembership.Role "json:\"role\" graphql:\"role\""
+	GroupID   string               "json:\"groupID\" graphql:\"groupID\""
+	UserID    string               "json:\"userID\" graphql:\"userID\""
+}
+
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.BillingEmail
+	return t.ID
 }
-func (t *GetOrganizationByID_Organization_Setting) GetBillingPhone() *string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.BillingPhone
+	return &t.CreatedAt
 }
-func (t *GetOrganizationByID_Organization_Setting) GetBillingAddress() *string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.BillingAddress
+	return &t.UpdatedAt
 }
-func (t *GetOrganizationByID_Organization_Setting) GetTaxIdentifier() *string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.TaxIdentifier
+	return t.CreatedBy
 }
-func (t *GetOrganizationByID_Organization_Setting) GetTags() []string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization_Setting{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.Tags
+	return t.UpdatedBy
 }
-
-type GetOrganizationByID_Organization struct {
-	ID          string                                    "json:\"id\" graphql:\"id\""
-	Name        string                                    "json:\"name\" graphql:\"name\""
-	DisplayName string                                    "json:\"displayName\" graphql:\"displayName\""
-	Description *string                                   "json:\"description,omitempty\" graphql:\"description\""
-	PersonalOrg bool                                      "json:\"personalOrg\" graphql:\"personalOrg\""
-	Parent      *GetOrganizationByID_Organization_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
-	Children    GetOrganizationByID_Organization_Children "json:\"children\" graphql:\"children\""
-	Setting     *GetOrganizationByID_Organization_Setting "json:\"setting,omitempty\" graphql:\"setting\""
-	CreatedAt   time.Time                                 "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy   *string                                   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt   time.Time                                 "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy   *string                                   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetRole() *groupmembership.Role {
+	if t == nil {
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
+	}
+	return &t.Role
 }
-
-func (t *GetOrganizationByID_Organization) GetID() string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.ID
+	return t.GroupID
 }
-func (t *GetOrganizationByID_Organization) GetName() string {
+func (t *AddUserToGroup_AddUserToGroup_GroupMembership) GetUserID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &AddUserToGroup_AddUserToGroup_GroupMembership{}
 	}
-	return t.Name
+	return t.UserID
 }
-func (t *GetOrganizationByID_Organization) GetDisplayName() string {
+
+type AddUserToGroup_AddUserToGroup struct {
+	GroupMembership AddUserToGroup_AddUserToGroup_GroupMembership "json:\"groupMembership\" graphql:\"groupMembership\""
+}
+
+func (t *AddUserToGroup_AddUserToGroup) GetGroupMembership() *AddUserToGroup_AddUserToGroup_GroupMembership {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &AddUserToGroup_AddUserToGroup{}
 	}
-	return t.DisplayName
+	return &t.GroupMembership
 }
-func (t *GetOrganizationByID_Organization) GetDescription() *string {
+
+type JoinGroup_JoinGroup_GroupMembership struct {
+	ID      string               "json:\"id\" graphql:\"id\""
+	Role    groupmembership.Role "json:\"role\" graphql:\"role\""
+	GroupID string               "json:\"groupID\" graphql:\"groupID\""
+	UserID  string               "json:\"userID\" graphql:\"userID\""
+}
+
+func (t *JoinGroup_JoinGroup_GroupMembership) GetID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &JoinGroup_JoinGroup_GroupMembership{}
 	}
-	return t.Description
+	return t.ID
 }
-func (t *GetOrganizationByID_Organization) GetPersonalOrg() bool {
+func (t *JoinGroup_JoinGroup_GroupMembership) GetRole() *groupmembership.Role {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &JoinGroup_JoinGroup_GroupMembership{}
 	}
-	return t.PersonalOrg
+	return &t.Role
 }
-func (t *GetOrganizationByID_Organization) GetParent() *GetOrganizationByID_Organization_Parent {
+func (t *JoinGroup_JoinGroup_GroupMembership) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &JoinGroup_JoinGroup_GroupMembership{}
 	}
-	return t.Parent
+	return t.GroupID
 }
-func (t *GetOrganizationByID_Organization) GetChildren() *GetOrganizationByID_Organization_Children {
+func (t *JoinGroup_JoinGroup_GroupMembership) GetUserID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &JoinGroup_JoinGroup_GroupMembership{}
 	}
-	return &t.Children
+	return t.UserID
 }
-func (t *GetOrganizationByID_Organization) GetSetting() *GetOrganizationByID_Organization_Setting {
+
+type JoinGroup_JoinGroup struct {
+	GroupMembership JoinGroup_JoinGroup_GroupMembership "json:\"groupMembership\" graphql:\"groupMembership\""
+}
+
+func (t *JoinGroup_JoinGroup) GetGroupMembership() *JoinGroup_JoinGroup_GroupMembership {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &JoinGroup_JoinGroup{}
 	}
-	return t.Setting
+	return &t.GroupMembership
 }
-func (t *GetOrganizationByID_Organization) GetCreatedAt() *time.Time {
+
+type UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership struct {
+	ID      string               "json:\"id\" graphql:\"id\""
+	Role    groupmembership.Role "json:\"role\" graphql:\"role\""
+	GroupID string               "json:\"groupID\" graphql:\"groupID\""
+	UserID  string               "json:\"userID\" graphql:\"userID\""
+}
+
+func (t *UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership) GetID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership{}
 	}
-	return &t.CreatedAt
+	return t.ID
 }
-func (t *GetOrganizationByID_Organization) GetCreatedBy() *string {
+func (t *UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership) GetRole() *groupmembership.Role {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership{}
 	}
-	return t.CreatedBy
+	return &t.Role
 }
-func (t *GetOrganizationByID_Organization) GetUpdatedAt() *time.Time {
+func (t *UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership) GetGroupID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership{}
 	}
-	return &t.UpdatedAt
+	return t.GroupID
 }
-func (t *GetOrganizationByID_Organization) GetUpdatedBy() *string {
+func (t *UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership) GetUserID() string {
 	if t == nil {
-		t = &GetOrganizationByID_Organization{}
+		t = &UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership{}
 	}
-	return t.UpdatedBy
+	return t.UserID
 }
 
-type GetAllOrganizations_Organizations_Edges_Node_Parent struct {
-	ID   string "json:\"id\" graphql:\"id\""
-	Name string "json:\"name\" graphql:\"name\""
+type UpdateGroupMemberRole_UpdateGroupMemberRole struct {
+	GroupMembership UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership "json:\"groupMembership\" graphql:\"groupMembership\""
 }
 
-func (t *GetAllOrganizations_Organizations_Edges_Node_Parent) GetID() string {
+func (t *UpdateGroupMemberRole_UpdateGroupMemberRole) GetGroupMembership() *UpdateGroupMemberRole_UpdateGroupMemberRole_GroupMembership {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Parent{}
+		t = &UpdateGroupMemberRole_UpdateGroupMemberRole{}
 	}
-	return t.ID
+	return &t.GroupMembership
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Parent) GetName() string {
+
+type RemoveUserFromGroup_RemoveUserFromGroup struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *RemoveUserFromGroup_RemoveUserFromGroup) GetDeletedID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Parent{}
+		t = &RemoveUserFromGroup_RemoveUserFromGroup{}
 	}
-	return t.Name
+	return t.DeletedID
 }
 
-type GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node struct {
-	ID          string  "json:\"id\" graphql:\"id\""
-	Name        string  "json:\"name\" graphql:\"name\""
-	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
-	Description *string "json:\"description,omitempty\" graphql:\"description\""
+type GetGroupMembershipByID_GroupMembership struct {
+	ID        string               "json:\"id\" graphql:\"id\""
+	CreatedAt time.Time            "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt time.Time            "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy *string              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy *string              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Role      groupmembership.Role "json:\"role\" graphql:\"role\""
+	GroupID   string               "json:\"groupID\" graphql:\"groupID\""
+	UserID    string               "json:\"userID\" graphql:\"userID\""
 }
 
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetID() string {
+func (t *GetGroupMembershipByID_GroupMembership) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
 	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetName() string {
+func (t *GetGroupMembershipByID_GroupMembership) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
-	return t.Name
+	return &t.CreatedAt
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetDisplayName() string {
+func (t *GetGroupMembershipByID_GroupMembership) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
-	return t.DisplayName
+	return &t.UpdatedAt
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetDescription() *string {
+func (t *GetGroupMembershipByID_GroupMembership) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
-	return t.Description
-}
-
-type GetAllOrganizations_Organizations_Edges_Node_Children_Edges struct {
-	Node *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+	return t.CreatedBy
 }
-
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges) GetNode() *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node {
+func (t *GetGroupMembershipByID_GroupMembership) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
-	return t.Node
+	return t.UpdatedBy
 }
-
-type GetAllOrganizations_Organizations_Edges_Node_Children struct {
-	Edges []*GetAllOrganizations_Organizations_Edges_Node_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+func (t *GetGroupMembershipByID_GroupMembership) GetRole() *groupmembership.Role {
+	if t == nil {
+		t = &GetGroupMembershipByID_GroupMembership{}
+	}
+	return &t.Role
 }
-
-func (t *GetAllOrganizations_Organizations_Edges_Node_Children) GetEdges() []*GetAllOrganizations_Organizations_Edges_Node_Children_Edges {
+func (t *GetGroupMembershipByID_GroupMembership) GetGroupID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Children{}
+		t = &GetGroupMembershipByID_GroupMembership{}
 	}
-	return t.Edges
+	return t.GroupID
+}
+func (t *GetGroupMembershipByID_GroupMembership) GetUserID() string {
+	if t == nil {
+		t = &GetGroupMembershipByID_GroupMembership{}
+	}
+	return t.UserID
 }
 
-type GetAllOrganizations_Organizations_Edges_Node_Setting struct {
-	ID             string    "json:\"id\" graphql:\"id\""
-	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
-	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
-	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
-	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
-	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+type GetGroupMembers_Group_Members_User struct {
+	ID        string "json:\"id\" graphql:\"id\""
+	Email     string "json:\"email\" graphql:\"email\""
+	FirstName string "json:\"firstName\" graphql:\"firstName\""
+	LastName  string "json:\"lastName\" graphql:\"lastName\""
 }
 
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetID() string {
+func (t *GetGroupMembers_Group_Members_User) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members_User{}
 	}
 	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetCreatedAt() *time.Time {
+func (t *GetGroupMembers_Group_Members_User) GetEmail() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members_User{}
 	}
-	return &t.CreatedAt
+	return t.Email
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetUpdatedAt() *time.Time {
+func (t *GetGroupMembers_Group_Members_User) GetFirstName() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members_User{}
 	}
-	return &t.UpdatedAt
+	return t.FirstName
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetCreatedBy() *string {
+func (t *GetGroupMembers_Group_Members_User) GetLastName() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members_User{}
 	}
-	return t.CreatedBy
+	return t.LastName
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetUpdatedBy() *string {
+
+type GetGroupMembers_Group_Members struct {
+	ID   string                             "json:\"id\" graphql:\"id\""
+	Role groupmembership.Role               "json:\"role\" graphql:\"role\""
+	User GetGroupMembers_Group_Members_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetGroupMembers_Group_Members) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members{}
 	}
-	return t.UpdatedBy
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetDomains() []string {
+func (t *GetGroupMembers_Group_Members) GetRole() *groupmembership.Role {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members{}
 	}
-	return t.Domains
+	return &t.Role
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoCert() *string {
+func (t *GetGroupMembers_Group_Members) GetUser() *GetGroupMembers_Group_Members_User {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group_Members{}
 	}
-	return t.SsoCert
+	return &t.User
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoEntrypoint() *string {
+
+type GetGroupMembers_Group struct {
+	ID      string                           "json:\"id\" graphql:\"id\""
+	Members []*GetGroupMembers_Group_Members "json:\"members,omitempty\" graphql:\"members\""
+}
+
+func (t *GetGroupMembers_Group) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group{}
 	}
-	return t.SsoEntrypoint
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoIssuer() *string {
+func (t *GetGroupMembers_Group) GetMembers() []*GetGroupMembers_Group_Members {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupMembers_Group{}
 	}
-	return t.SsoIssuer
+	return t.Members
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingContact() *string {
+
+type GetGroupSetting_GroupSetting_Group struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetGroupSetting_GroupSetting_Group) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting_Group{}
 	}
-	return t.BillingContact
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingEmail() *string {
+
+type GetGroupSetting_GroupSetting struct {
+	ID           string                              "json:\"id\" graphql:\"id\""
+	CreatedAt    time.Time                           "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt    time.Time                           "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy    *string                             "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy    *string                             "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Visibility   groupsetting.Visibility             "json:\"visibility\" graphql:\"visibility\""
+	JoinPolicy   groupsetting.JoinPolicy             "json:\"joinPolicy\" graphql:\"joinPolicy\""
+	Tags         []string                            "json:\"tags\" graphql:\"tags\""
+	SyncToSlack  bool                                "json:\"syncToSlack\" graphql:\"syncToSlack\""
+	SyncToGithub bool                                "json:\"syncToGithub\" graphql:\"syncToGithub\""
+	Group        *GetGroupSetting_GroupSetting_Group "json:\"group,omitempty\" graphql:\"group\""
+}
+
+func (t *GetGroupSetting_GroupSetting) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.BillingEmail
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingPhone() *string {
+func (t *GetGroupSetting_GroupSetting) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.BillingPhone
+	return &t.CreatedAt
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingAddress() *string {
+func (t *GetGroupSetting_GroupSetting) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.BillingAddress
+	return &t.UpdatedAt
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetTaxIdentifier() *string {
+func (t *GetGroupSetting_GroupSetting) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.TaxIdentifier
+	return t.CreatedBy
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetTags() []string {
+func (t *GetGroupSetting_GroupSetting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.Tags
+	return t.UpdatedBy
 }
-
-type GetAllOrganizations_Organizations_Edges_Node struct {
-	ID          string                                                "json:\"id\" graphql:\"id\""
-	Name        string                                                "json:\"name\" graphql:\"name\""
-	DisplayName string                                                "json:\"displayName\" graphql:\"displayName\""
-	Description *string                                               "json:\"description,omitempty\" graphql:\"description\""
-	PersonalOrg bool                                                  "json:\"personalOrg\" graphql:\"personalOrg\""
-	Parent      *GetAllOrganizations_Organizations_Edges_Node_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
-	Children    GetAllOrganizations_Organizations_Edges_Node_Children "json:\"children\" graphql:\"children\""
-	Setting     *GetAllOrganizations_Organizations_Edges_Node_Setting "json:\"setting,omitempty\" graphql:\"setting\""
-	CreatedAt   time.Time                                             "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   time.Time                                             "json:\"updatedAt\" graphql:\"updatedAt\""
+func (t *GetGroupSetting_GroupSetting) GetVisibility() *groupsetting.Visibility {
+	if t == nil {
+		t = &GetGroupSetting_GroupSetting{}
+	}
+	return &t.Visibility
 }
-
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetID() string {
+func (t *GetGroupSetting_GroupSetting) GetJoinPolicy() *groupsetting.JoinPolicy {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.ID
+	return &t.JoinPolicy
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetName() string {
+func (t *GetGroupSetting_GroupSetting) GetTags() []string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.Name
+	return t.Tags
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetDisplayName() string {
+func (t *GetGroupSetting_GroupSetting) GetSyncToSlack() bool {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.DisplayName
+	return t.SyncToSlack
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetDescription() *string {
+func (t *GetGroupSetting_GroupSetting) GetSyncToGithub() bool {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.Description
+	return t.SyncToGithub
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetPersonalOrg() bool {
+func (t *GetGroupSetting_GroupSetting) GetGroup() *GetGroupSetting_GroupSetting_Group {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &GetGroupSetting_GroupSetting{}
 	}
-	return t.PersonalOrg
+	return t.Group
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetParent() *GetAllOrganizations_Organizations_Edges_Node_Parent {
+
+type CreateInvite_CreateInvite_Invite_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *CreateInvite_CreateInvite_Invite_Owner) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite_Owner{}
 	}
-	return t.Parent
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetChildren() *GetAllOrganizations_Organizations_Edges_Node_Children {
+func (t *CreateInvite_CreateInvite_Invite_Owner) GetName() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite_Owner{}
 	}
-	return &t.Children
+	return t.Name
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetSetting() *GetAllOrganizations_Organizations_Edges_Node_Setting {
+
+type CreateInvite_CreateInvite_Invite struct {
+	ID          string                                 "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                              "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                              "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Expires     time.Time                              "json:\"expires\" graphql:\"expires\""
+	Recipient   string                                 "json:\"recipient\" graphql:\"recipient\""
+	Status      invite.Status                          "json:\"status\" graphql:\"status\""
+	Role        invite.Role                            "json:\"role\" graphql:\"role\""
+	RequestorID *string                                "json:\"requestorID,omitempty\" graphql:\"requestorID\""
+	Owner       CreateInvite_CreateInvite_Invite_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *CreateInvite_CreateInvite_Invite) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Setting
+	return t.ID
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetCreatedAt() *time.Time {
+func (t *CreateInvite_CreateInvite_Invite) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
 	return &t.CreatedAt
 }
-func (t *GetAllOrganizations_Organizations_Edges_Node) GetUpdatedAt() *time.Time {
+func (t *CreateInvite_CreateInvite_Invite) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
 	return &t.UpdatedAt
 }
-
-type GetAllOrganizations_Organizations_Edges struct {
-	Node *GetAllOrganizations_Organizations_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
-}
-
-func (t *GetAllOrganizations_Organizations_Edges) GetNode() *GetAllOrganizations_Organizations_Edges_Node {
+func (t *CreateInvite_CreateInvite_Invite) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations_Edges{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Node
-}
-
-type GetAllOrganizations_Organizations struct {
-	Edges []*GetAllOrganizations_Organizations_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+	return t.CreatedBy
 }
-
-func (t *GetAllOrganizations_Organizations) GetEdges() []*GetAllOrganizations_Organizations_Edges {
+func (t *CreateInvite_CreateInvite_Invite) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetAllOrganizations_Organizations{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Edges
-}
-
-type OrganizationsWhere_Organizations_Edges_Node_Parent struct {
-	ID   string "json:\"id\" graphql:\"id\""
-	Name string "json:\"name\" graphql:\"name\""
+	return t.UpdatedBy
 }
-
-func (t *OrganizationsWhere_Organizations_Edges_Node_Parent) GetID() string {
+func (t *CreateInvite_CreateInvite_Invite) GetExpires() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Parent{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.ID
+	return &t.Expires
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Parent) GetName() string {
+func (t *CreateInvite_CreateInvite_Invite) GetRecipient() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Parent{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Name
-}
-
-type OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node struct {
-	ID          string  "json:\"id\" graphql:\"id\""
-	Name        string  "json:\"name\" graphql:\"name\""
-	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
-	Description *string "json:\"description,omitempty\" graphql:\"description\""
+	return t.Recipient
 }
-
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetID() string {
+func (t *CreateInvite_CreateInvite_Invite) GetStatus() *invite.Status {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.ID
+	return &t.Status
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetName() string {
+func (t *CreateInvite_CreateInvite_Invite) GetRole() *invite.Role {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Name
+	return &t.Role
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetDisplayName() string {
+func (t *CreateInvite_CreateInvite_Invite) GetRequestorID() *string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.DisplayName
+	return t.RequestorID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetDescription() *string {
+func (t *CreateInvite_CreateInvite_Invite) GetOwner() *CreateInvite_CreateInvite_Invite_Owner {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
+		t = &CreateInvite_CreateInvite_Invite{}
 	}
-	return t.Description
+	return &t.Owner
 }
 
-type OrganizationsWhere_Organizations_Edges_Node_Children_Edges struct {
-	Node *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+type CreateInvite_CreateInvite struct {
+	Invite CreateInvite_CreateInvite_Invite "json:\"invite\" graphql:\"invite\""
 }
 
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges) GetNode() *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node {
+func (t *CreateInvite_CreateInvite) GetInvite() *CreateInvite_CreateInvite_Invite {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges{}
+		t = &CreateInvite_CreateInvite{}
 	}
-	return t.Node
+	return &t.Invite
 }
 
-type OrganizationsWhere_Organizations_Edges_Node_Children struct {
-	Edges []*OrganizationsWhere_Organizations_Edges_Node_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+type RevokeInvite_RevokeInvite_Invite struct {
+	ID        string        "json:\"id\" graphql:\"id\""
+	Recipient string        "json:\"recipient\" graphql:\"recipient\""
+	Status    invite.Status "json:\"status\" graphql:\"status\""
 }
 
-func (t *OrganizationsWhere_Organizations_Edges_Node_Children) GetEdges() []*OrganizationsWhere_Organizations_Edges_Node_Children_Edges {
+func (t *RevokeInvite_RevokeInvite_Invite) GetID() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Children{}
+		t = &RevokeInvite_RevokeInvite_Invite{}
 	}
-	return t.Edges
+	return t.ID
 }
-
-type OrganizationsWhere_Organizations_Edges_Node_Setting struct {
-	ID             string    "json:\"id\" graphql:\"id\""
-	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
-	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
-	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
-	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
-	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+func (t *RevokeInvite_RevokeInvite_Invite) GetRecipient() string {
+	if t == nil {
+		t = &RevokeInvite_RevokeInvite_Invite{}
+	}
+	return t.Recipient
 }
-
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetID() string {
+func (t *RevokeInvite_RevokeInvite_Invite) GetStatus() *invite.Status {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &RevokeInvite_RevokeInvite_Invite{}
 	}
-	return t.ID
+	return &t.Status
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetCreatedAt() *time.Time {
+
+type RevokeInvite_RevokeInvite struct {
+	Invite RevokeInvite_RevokeInvite_Invite "json:\"invite\" graphql:\"invite\""
+}
+
+func (t *RevokeInvite_RevokeInvite) GetInvite() *RevokeInvite_RevokeInvite_Invite {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &RevokeInvite_RevokeInvite{}
 	}
-	return &t.CreatedAt
+	return &t.Invite
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetUpdatedAt() *time.Time {
+
+type GetInviteByID_Invite_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetInviteByID_Invite_Owner) GetID() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite_Owner{}
 	}
-	return &t.UpdatedAt
+	return t.ID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetCreatedBy() *string {
+func (t *GetInviteByID_Invite_Owner) GetName() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite_Owner{}
 	}
-	return t.CreatedBy
+	return t.Name
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetUpdatedBy() *string {
+
+type GetInviteByID_Invite struct {
+	ID          string                     "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                  "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                  "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                    "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                    "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Expires     time.Time                  "json:\"expires\" graphql:\"expires\""
+	Recipient   string                     "json:\"recipient\" graphql:\"recipient\""
+	Status      invite.Status              "json:\"status\" graphql:\"status\""
+	Role        invite.Role                "json:\"role\" graphql:\"role\""
+	RequestorID *string                    "json:\"requestorID,omitempty\" graphql:\"requestorID\""
+	Owner       GetInviteByID_Invite_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetInviteByID_Invite) GetID() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.UpdatedBy
+	return t.ID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetDomains() []string {
+func (t *GetInviteByID_Invite) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.Domains
+	return &t.CreatedAt
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoCert() *string {
+func (t *GetInviteByID_Invite) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.SsoCert
+	return &t.UpdatedAt
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoEntrypoint() *string {
+func (t *GetInviteByID_Invite) GetCreatedBy() *string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.SsoEntrypoint
+	return t.CreatedBy
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoIssuer() *string {
+func (t *GetInviteByID_Invite) GetUpdatedBy() *string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.SsoIssuer
+	return t.UpdatedBy
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingContact() *string {
+func (t *GetInviteByID_Invite) GetExpires() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.BillingContact
+	return &t.Expires
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingEmail() *string {
+func (t *GetInviteByID_Invite) GetRecipient() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.BillingEmail
+	return t.Recipient
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingPhone() *string {
+func (t *GetInviteByID_Invite) GetStatus() *invite.Status {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.BillingPhone
+	return &t.Status
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingAddress() *string {
+func (t *GetInviteByID_Invite) GetRole() *invite.Role {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.BillingAddress
+	return &t.Role
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetTaxIdentifier() *string {
+func (t *GetInviteByID_Invite) GetRequestorID() *string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.TaxIdentifier
+	return t.RequestorID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetTags() []string {
+func (t *GetInviteByID_Invite) GetOwner() *GetInviteByID_Invite_Owner {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+		t = &GetInviteByID_Invite{}
 	}
-	return t.Tags
+	return &t.Owner
 }
 
-type OrganizationsWhere_Organizations_Edges_Node struct {
-	ID          string                                               "json:\"id\" graphql:\"id\""
-	Name        string                                               "json:\"name\" graphql:\"name\""
-	DisplayName string                                               "json:\"displayName\" graphql:\"displayName\""
-	Description *string                                              "json:\"description,omitempty\" graphql:\"description\""
-	PersonalOrg bool                                                 "json:\"personalOrg\" graphql:\"personalOrg\""
-	Parent      *OrganizationsWhere_Organizations_Edges_Node_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
-	Children    OrganizationsWhere_Organizations_Edges_Node_Children "json:\"children\" graphql:\"children\""
-	Setting     *OrganizationsWhere_Organizations_Edges_Node_Setting "json:\"setting,omitempty\" graphql:\"setting\""
-	CreatedAt   time.Time                                            "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   time.Time                                            "json:\"updatedAt\" graphql:\"updatedAt\""
+type GetInvites_Invites_Edges_Node_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetID() string {
+func (t *GetInvites_Invites_Edges_Node_Owner) GetID() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node_Owner{}
 	}
 	return t.ID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetName() string {
+func (t *GetInvites_Invites_Edges_Node_Owner) GetName() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node_Owner{}
 	}
 	return t.Name
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetDisplayName() string {
-	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
-	}
-	return t.DisplayName
+
+type GetInvites_Invites_Edges_Node struct {
+	ID          string                              "json:\"id\" graphql:\"id\""
+	Expires     time.Time                           "json:\"expires\" graphql:\"expires\""
+	Recipient   string                              "json:\"recipient\" graphql:\"recipient\""
+	Status      invite.Status                       "json:\"status\" graphql:\"status\""
+	Role        invite.Role                         "json:\"role\" graphql:\"role\""
+	RequestorID *string                             "json:\"requestorID,omitempty\" graphql:\"requestorID\""
+	Owner       GetInvites_Invites_Edges_Node_Owner "json:\"owner\" graphql:\"owner\""
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetDescription() *string {
+
+func (t *GetInvites_Invites_Edges_Node) GetID() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return t.Description
+	return t.ID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetPersonalOrg() bool {
+func (t *GetInvites_Invites_Edges_Node) GetExpires() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return t.PersonalOrg
+	return &t.Expires
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetParent() *OrganizationsWhere_Organizations_Edges_Node_Parent {
+func (t *GetInvites_Invites_Edges_Node) GetRecipient() string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return t.Parent
+	return t.Recipient
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetChildren() *OrganizationsWhere_Organizations_Edges_Node_Children {
+func (t *GetInvites_Invites_Edges_Node) GetStatus() *invite.Status {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return &t.Children
+	return &t.Status
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetSetting() *OrganizationsWhere_Organizations_Edges_Node_Setting {
+func (t *GetInvites_Invites_Edges_Node) GetRole() *invite.Role {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return t.Setting
+	return &t.Role
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetCreatedAt() *time.Time {
+func (t *GetInvites_Invites_Edges_Node) GetRequestorID() *string {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return &t.CreatedAt
+	return t.RequestorID
 }
-func (t *OrganizationsWhere_Organizations_Edges_Node) GetUpdatedAt() *time.Time {
+func (t *GetInvites_Invites_Edges_Node) GetOwner() *GetInvites_Invites_Edges_Node_Owner {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges_Node{}
+		t = &GetInvites_Invites_Edges_Node{}
 	}
-	return &t.UpdatedAt
+	return &t.Owner
 }
 
-type OrganizationsWhere_Organizations_Edges struct {
-	Node *OrganizationsWhere_Organizations_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+type GetInvites_Invites_Edges struct {
+	Node *GetInvites_Invites_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
 }
 
-func (t *OrganizationsWhere_Organizations_Edges) GetNode() *OrganizationsWhere_Organizations_Edges_Node {
+func (t *GetInvites_Invites_Edges) GetNode() *GetInvites_Invites_Edges_Node {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations_Edges{}
+		t = &GetInvites_Invites_Edges{}
 	}
 	return t.Node
 }
 
-type OrganizationsWhere_Organizations struct {
-	Edges []*OrganizationsWhere_Organizations_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+type GetInvites_Invites struct {
+	Edges []*GetInvites_Invites_Edges "json:\"edges,omitempty\" graphql:\"edges\""
 }
 
-func (t *OrganizationsWhere_Organizations) GetEdges() []*OrganizationsWhere_Organizations_Edges {
+func (t *GetInvites_Invites) GetEdges() []*GetInvites_Invites_Edges {
 	if t == nil {
-		t = &OrganizationsWhere_Organizations{}
+		t = &GetInvites_Invites{}
 	}
 	return t.Edges
 }
 
-type CreateOrganization_CreateOrganization_Organization_Setting struct {
-	ID             string    "json:\"id\" graphql:\"id\""
-	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
-	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
-	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
-	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
-	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+type CreateOauthClient_CreateOauthClient_OauthClient_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetID() string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient_Owner) GetID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient_Owner{}
 	}
 	return t.ID
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetCreatedAt() *time.Time {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient_Owner) GetName() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient_Owner{}
+	}
+	return t.Name
+}
+
+type CreateOauthClient_CreateOauthClient_OauthClient struct {
+	ID           string                                                "json:\"id\" graphql:\"id\""
+	CreatedAt    time.Time                                             "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt    time.Time                                             "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy    *string                                               "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy    *string                                               "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name         string                                                "json:\"name\" graphql:\"name\""
+	ClientID     string                                                "json:\"clientID\" graphql:\"clientID\""
+	RedirectUris []string                                              "json:\"redirectUris\" graphql:\"redirectUris\""
+	Scopes       []string                                              "json:\"scopes\" graphql:\"scopes\""
+	Public       bool                                                  "json:\"public\" graphql:\"public\""
+	Description  *string                                               "json:\"description,omitempty\" graphql:\"description\""
+	Owner        CreateOauthClient_CreateOauthClient_OauthClient_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetID() string {
+	if t == nil {
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
+	}
+	return t.ID
+}
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
 	return &t.CreatedAt
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetUpdatedAt() *time.Time {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
 	return &t.UpdatedAt
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetCreatedBy() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetCreatedBy() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
 	return t.CreatedBy
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetUpdatedBy() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetUpdatedBy() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
 	return t.UpdatedBy
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetDomains() []string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetName() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.Domains
+	return t.Name
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoCert() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetClientID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.SsoCert
+	return t.ClientID
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoEntrypoint() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetRedirectUris() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.SsoEntrypoint
+	return t.RedirectUris
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoIssuer() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetScopes() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.SsoIssuer
+	return t.Scopes
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingContact() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetPublic() bool {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.BillingContact
+	return t.Public
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingEmail() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetDescription() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.BillingEmail
+	return t.Description
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingPhone() *string {
+func (t *CreateOauthClient_CreateOauthClient_OauthClient) GetOwner() *CreateOauthClient_CreateOauthClient_OauthClient_Owner {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient_OauthClient{}
 	}
-	return t.BillingPhone
+	return &t.Owner
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingAddress() *string {
-	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
-	}
-	return t.BillingAddress
+
+type CreateOauthClient_CreateOauthClient struct {
+	OauthClient  CreateOauthClient_CreateOauthClient_OauthClient "json:\"oauthClient\" graphql:\"oauthClient\""
+	ClientSecret *string                                         "json:\"clientSecret,omitempty\" graphql:\"clientSecret\""
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetTaxIdentifier() *string {
+
+func (t *CreateOauthClient_CreateOauthClient) GetOauthClient() *CreateOauthClient_CreateOauthClient_OauthClient {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient{}
 	}
-	return t.TaxIdentifier
+	return &t.OauthClient
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetTags() []string {
+func (t *CreateOauthClient_CreateOauthClient) GetClientSecret() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+		t = &CreateOauthClient_CreateOauthClient{}
 	}
-	return t.Tags
+	return t.ClientSecret
 }
 
-type CreateOrganization_CreateOrganization_Organization_Parent struct {
-	ID   string "json:\"id\" graphql:\"id\""
-	Name string "json:\"name\" graphql:\"name\""
+type UpdateOauthClient_UpdateOauthClient_OauthClient struct {
+	ID           string   "json:\"id\" graphql:\"id\""
+	Name         string   "json:\"name\" graphql:\"name\""
+	ClientID     string   "json:\"clientID\" graphql:\"clientID\""
+	RedirectUris []string "json:\"redirectUris\" graphql:\"redirectUris\""
+	Scopes       []string "json:\"scopes\" graphql:\"scopes\""
+	Public       bool     "json:\"public\" graphql:\"public\""
+	Description  *string  "json:\"description,omitempty\" graphql:\"description\""
 }
 
-func (t *CreateOrganization_CreateOrganization_Organization_Parent) GetID() string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Parent{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
 	return t.ID
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Parent) GetName() string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetName() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Parent{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
 	return t.Name
 }
-
-type CreateOrganization_CreateOrganization_Organization_Children_Edges_Node struct {
-	ID          string  "json:\"id\" graphql:\"id\""
-	Name        string  "json:\"name\" graphql:\"name\""
-	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
-	Description *string "json:\"description,omitempty\" graphql:\"description\""
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetClientID() string {
+	if t == nil {
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
+	}
+	return t.ClientID
 }
-
-func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetID() string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetRedirectUris() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
-	return t.ID
+	return t.RedirectUris
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetName() string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetScopes() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
-	return t.Name
+	return t.Scopes
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetDisplayName() string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetPublic() bool {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
-	return t.DisplayName
+	return t.Public
 }
-func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetDescription() *string {
+func (t *UpdateOauthClient_UpdateOauthClient_OauthClient) GetDescription() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
+		t = &UpdateOauthClient_UpdateOauthClient_OauthClient{}
 	}
 	return t.Description
 }
 
-type CreateOrganization_CreateOrganization_Organization_Children_Edges struct {
-	Node *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+type UpdateOauthClient_UpdateOauthClient struct {
+	OauthClient UpdateOauthClient_UpdateOauthClient_OauthClient "json:\"oauthClient\" graphql:\"oauthClient\""
 }
 
-func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges) GetNode() *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node {
+func (t *UpdateOauthClient_UpdateOauthClient) GetOauthClient() *UpdateOauthClient_UpdateOauthClient_OauthClient {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges{}
+		t = &UpdateOauthClient_UpdateOauthClient{}
 	}
-	return t.Node
+	return &t.OauthClient
 }
 
-type CreateOrganization_CreateOrganization_Organization_Children struct {
-	Edges []*CreateOrganization_CreateOrganization_Organization_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+type GetOauthClientByID_OauthClient_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *CreateOrganization_CreateOrganization_Organization_Children) GetEdges() []*CreateOrganization_CreateOrganization_Organization_Children_Edges {
+func (t *GetOauthClientByID_OauthClient_Owner) GetID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization_Children{}
+		t = &GetOauthClientByID_OauthClient_Owner{}
 	}
-	return t.Edges
+	return t.ID
+}
+func (t *GetOauthClientByID_OauthClient_Owner) GetName() string {
+	if t == nil {
+		t = &GetOauthClientByID_OauthClient_Owner{}
+	}
+	return t.Name
 }
 
-type CreateOrganization_CreateOrganization_Organization struct {
-	ID          string                                                      "json:\"id\" graphql:\"id\""
-	Name        string                                                      "json:\"name\" graphql:\"name\""
-	DisplayName string                                                      "json:\"displayName\" graphql:\"displayName\""
-	Description *string                                                     "json:\"description,omitempty\" graphql:\"description\""
-	PersonalOrg bool                                                        "json:\"personalOrg\" graphql:\"personalOrg\""
-	CreatedAt   time.Time                                                   "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   time.Time                                                   "json:\"updatedAt\" graphql:\"updatedAt\""
-	Setting     *CreateOrganization_CreateOrganization_Organization_Setting "json:\"setting,omitempty\" graphql:\"setting\""
-	Parent      *CreateOrganization_CreateOrganization_Organization_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
-	Children    CreateOrganization_CreateOrganization_Organization_Children "json:\"children\" graphql:\"children\""
+type GetOauthClientByID_OauthClient struct {
+	ID           string                               "json:\"id\" graphql:\"id\""
+	CreatedAt    time.Time                            "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt    time.Time                            "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy    *string                              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy    *string                              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name         string                               "json:\"name\" graphql:\"name\""
+	ClientID     string                               "json:\"clientID\" graphql:\"clientID\""
+	RedirectUris []string                             "json:\"redirectUris\" graphql:\"redirectUris\""
+	Scopes       []string                             "json:\"scopes\" graphql:\"scopes\""
+	Public       bool                                 "json:\"public\" graphql:\"public\""
+	Description  *string                              "json:\"description,omitempty\" graphql:\"description\""
+	Owner        GetOauthClientByID_OauthClient_Owner "json:\"owner\" graphql:\"owner\""
 }
 
-func (t *CreateOrganization_CreateOrganization_Organization) GetID() string {
+func (t *GetOauthClientByID_OauthClient) GetID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
 	return t.ID
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetName() string {
+func (t *GetOauthClientByID_OauthClient) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.Name
+	return &t.CreatedAt
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetDisplayName() string {
+func (t *GetOauthClientByID_OauthClient) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.DisplayName
+	return &t.UpdatedAt
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetDescription() *string {
+func (t *GetOauthClientByID_OauthClient) GetCreatedBy() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.Description
+	return t.CreatedBy
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetPersonalOrg() bool {
+func (t *GetOauthClientByID_OauthClient) GetUpdatedBy() *string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.PersonalOrg
+	return t.UpdatedBy
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetCreatedAt() *time.Time {
+func (t *GetOauthClientByID_OauthClient) GetName() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return &t.CreatedAt
+	return t.Name
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetUpdatedAt() *time.Time {
+func (t *GetOauthClientByID_OauthClient) GetClientID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return &t.UpdatedAt
+	return t.ClientID
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetSetting() *CreateOrganization_CreateOrganization_Organization_Setting {
+func (t *GetOauthClientByID_OauthClient) GetRedirectUris() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.Setting
+	return t.RedirectUris
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetParent() *CreateOrganization_CreateOrganization_Organization_Parent {
+func (t *GetOauthClientByID_OauthClient) GetScopes() []string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return t.Parent
+	return t.Scopes
 }
-func (t *CreateOrganization_CreateOrganization_Organization) GetChildren() *CreateOrganization_CreateOrganization_Organization_Children {
+func (t *GetOauthClientByID_OauthClient) GetPublic() bool {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization_Organization{}
+		t = &GetOauthClientByID_OauthClient{}
 	}
-	return &t.Children
+	return t.Public
+}
+func (t *GetOauthClientByID_OauthClient) GetDescription() *string {
+	if t == nil {
+		t = &GetOauthClientByID_OauthClient{}
+	}
+	return t.Description
+}
+func (t *GetOauthClientByID_OauthClient) GetOwner() *GetOauthClientByID_OauthClient_Owner {
+	if t == nil {
+		t = &GetOauthClientByID_OauthClient{}
+	}
+	return &t.Owner
 }
 
-type CreateOrganization_CreateOrganization struct {
-	Organization CreateOrganization_CreateOrganization_Organization "json:\"organization\" graphql:\"organization\""
+type GetOauthClients_OauthClients_Edges_Node_Owner struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *CreateOrganization_CreateOrganization) GetOrganization() *CreateOrganization_CreateOrganization_Organization {
+func (t *GetOauthClients_OauthClients_Edges_Node_Owner) GetID() string {
 	if t == nil {
-		t = &CreateOrganization_CreateOrganization{}
+		t = &GetOauthClients_OauthClients_Edges_Node_Owner{}
 	}
-	return &t.Organization
+	return t.ID
+}
+func (t *GetOauthClients_OauthClients_Edges_Node_Owner) GetName() string {
+	if t == nil {
+		t = &GetOauthClients_OauthClients_Edges_Node_Owner{}
+	}
+	return t.Name
 }
 
-type UpdateOrganization_UpdateOrganization_Organization struct {
-	ID          string  "json:\"id\" graphql:\"id\""
-	Name        string  "json:\"name\" graphql:\"name\""
-	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
-	Description *string "json:\"description,omitempty\" graphql:\"description\""
+type GetOauthClients_OauthClients_Edges_Node struct {
+	ID           string                                        "json:\"id\" graphql:\"id\""
+	Name         string                                        "json:\"name\" graphql:\"name\""
+	ClientID     string                                        "json:\"clientID\" graphql:\"clientID\""
+	RedirectUris []string                                      "json:\"redirectUris\" graphql:\"redirectUris\""
+	Scopes       []string                                      "json:\"scopes\" graphql:\"scopes\""
+	Public       bool                                          "json:\"public\" graphql:\"public\""
+	Description  *string                                       "json:\"description,omitempty\" graphql:\"description\""
+	Owner        GetOauthClients_OauthClients_Edges_Node_Owner "json:\"owner\" graphql:\"owner\""
 }
 
-func (t *UpdateOrganization_UpdateOrganization_Organization) GetID() string {
+func (t *GetOauthClients_OauthClients_Edges_Node) GetID() string {
 	if t == nil {
-		t = &UpdateOrganization_UpdateOrganization_Organization{}
+		t = &GetOauthClients_OauthClients_Edges_Node{}
 	}
 	return t.ID
 }
-func (t *UpdateOrganization_UpdateOrganization_Organization) GetName() string {
+func (t *GetOauthClients_OauthClients_Edges_Node) GetName() string {
 	if t == nil {
-		t = &UpdateOrganization_UpdateOrganization_Organization{}
+		t = &GetOauthClients_OauthClients_Edges_Node{}
 	}
 	return t.Name
 }
-func (t *UpdateOrganization_UpdateOrganization_Organization) GetDisplayName() string {
+func (t *GetOauthClients_OauthClients_Edges_Node) GetClientID() string {
 	if t == nil {
-		t = &UpdateOrganization_UpdateOrganization_Organization{}
+		t = &GetOauthClients_OauthClients_Edges_Node{}
 	}
-	return t.DisplayName
+	return t.ClientID
 }
-func (t *UpdateOrganization_UpdateOrganization_Organization) GetDescription() *string {
+func (t *GetOauthClients_OauthClients_Edges_Node) GetRedirectUris() []string {
 	if t == nil {
-		t = &UpdateOrganization_UpdateOrganization_Organization{}
+		t = &GetOauthClients_OauthClients_Edges_Node{}
+	}
+	return t.RedirectUris
+}
+func (t *GetOauthClients_OauthClients_Edges_Node) GetScopes() []string {
+	if t == nil {
+		t = &GetOauthClients_OauthClients_Edges_Node{}
+	}
+	return t.Scopes
+}
+func (t *GetOauthClients_OauthClients_Edges_Node) GetPublic() bool {
+	if t == nil {
+		t = &GetOauthClients_OauthClients_Edges_Node{}
+	}
+	return t.Public
+}
+func (t *GetOauthClients_OauthClients_Edges_Node) GetDescription() *string {
+	if t == nil {
+		t = &GetOauthClients_OauthClients_Edges_Node{}
 	}
 	return t.Description
 }
+func (t *GetOauthClients_OauthClients_Edges_Node) GetOwner() *GetOauthClients_OauthClients_Edges_Node_Owner {
+	if t == nil {
+		t = &GetOauthClients_OauthClients_Edges_Node{}
+	}
+	return &t.Owner
+}
 
-type UpdateOrganization_UpdateOrganization struct {
-	Organization UpdateOrganization_UpdateOrganization_Organization "json:\"organization\" graphql:\"organization\""
+type GetOauthClients_OauthClients_Edges struct {
+	Node *GetOauthClients_OauthClients_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
 }
 
-func (t *UpdateOrganization_UpdateOrganization) GetOrganization() *UpdateOrganization_UpdateOrganization_Organization {
+func (t *GetOauthClients_OauthClients_Edges) GetNode() *GetOauthClients_OauthClients_Edges_Node {
 	if t == nil {
-		t = &UpdateOrganization_UpdateOrganization{}
+		t = &GetOauthClients_OauthClients_Edges{}
 	}
-	return &t.Organization
+	return t.Node
 }
 
-type DeleteOrganization_DeleteOrganization struct {
-	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+type GetOauthClients_OauthClients struct {
+	Edges []*GetOauthClients_OauthClients_Edges "json:\"edges,omitempty\" graphql:\"edges\""
 }
 
-func (t *DeleteOrganization_DeleteOrganization) GetDeletedID() string {
+func (t *GetOauthClients_OauthClients) GetEdges() []*GetOauthClients_OauthClients_Edges {
 	if t == nil {
-		t = &DeleteOrganization_DeleteOrganization{}
+		t = &GetOauthClients_OauthClients{}
 	}
-	return t.DeletedID
+	return t.Edges
 }
 
-type GetOrganizationSetting_OrganizationSetting_Organization struct {
-	ID string "json:\"id\" graphql:\"id\""
+type DeleteOauthClient_DeleteOauthClient struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
 
-func (t *GetOrganizationSetting_OrganizationSetting_Organization) GetID() string {
+func (t *DeleteOauthClient_DeleteOauthClient) GetDeletedID() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting_Organization{}
+		t = &DeleteOauthClient_DeleteOauthClient{}
 	}
-	return t.ID
+	return t.DeletedID
 }
 
-type GetOrganizationSetting_OrganizationSetting struct {
-	ID             string                                                   "json:\"id\" graphql:\"id\""
-	SsoCert        *string                                                  "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
-	TaxIdentifier  *string                                                  "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	Tags           []string                                                 "json:\"tags,omitempty\" graphql:\"tags\""
-	SsoIssuer      *string                                                  "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
-	SsoEntrypoint  *string                                                  "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
-	BillingAddress *string                                                  "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string                                                  "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string                                                  "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string                                                  "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      time.Time                                                "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string                                                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string                                                 "json:\"domains,omitempty\" graphql:\"domains\""
-	UpdatedAt      time.Time                                                "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string                                                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Organization   *GetOrganizationSetting_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+type GetOrganizationByID_Organization_Parent struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *GetOrganizationSetting_OrganizationSetting) GetID() string {
+func (t *GetOrganizationByID_Organization_Parent) GetID() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Parent{}
 	}
 	return t.ID
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetSsoCert() *string {
+func (t *GetOrganizationByID_Organization_Parent) GetName() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Parent{}
 	}
-	return t.SsoCert
+	return t.Name
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetTaxIdentifier() *string {
+
+type GetOrganizationByID_Organization_Children_Edges_Node struct {
+	ID          string  "json:\"id\" graphql:\"id\""
+	Name        string  "json:\"name\" graphql:\"name\""
+	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
+	Description *string "json:\"description,omitempty\" graphql:\"description\""
+}
+
+func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetID() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
 	}
-	return t.TaxIdentifier
+	return t.ID
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetTags() []string {
+func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetName() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
 	}
-	return t.Tags
+	return t.Name
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetSsoIssuer() *string {
+func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
 	}
-	return t.SsoIssuer
+	return t.DisplayName
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetSsoEntrypoint() *string {
+func (t *GetOrganizationByID_Organization_Children_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children_Edges_Node{}
 	}
-	return t.SsoEntrypoint
+	return t.Description
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetBillingAddress() *string {
+
+type GetOrganizationByID_Organization_Children_Edges struct {
+	Node *GetOrganizationByID_Organization_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetOrganizationByID_Organization_Children_Edges) GetNode() *GetOrganizationByID_Organization_Children_Edges_Node {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children_Edges{}
 	}
-	return t.BillingAddress
+	return t.Node
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetBillingContact() *string {
+
+type GetOrganizationByID_Organization_Children struct {
+	Edges []*GetOrganizationByID_Organization_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetOrganizationByID_Organization_Children) GetEdges() []*GetOrganizationByID_Organization_Children_Edges {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Children{}
 	}
-	return t.BillingContact
+	return t.Edges
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetBillingEmail() *string {
+
+type GetOrganizationByID_Organization_Setting struct {
+	ID             string    "json:\"id\" graphql:\"id\""
+	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
+	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
+	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
+	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
+	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+}
+
+func (t *GetOrganizationByID_Organization_Setting) GetID() string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.BillingEmail
+	return t.ID
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetBillingPhone() *string {
+func (t *GetOrganizationByID_Organization_Setting) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.BillingPhone
+	return &t.CreatedAt
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetCreatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization_Setting) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return &t.CreatedAt
+	return &t.UpdatedAt
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetCreatedBy() *string {
+func (t *GetOrganizationByID_Organization_Setting) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
 	return t.CreatedBy
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetDomains() []string {
+func (t *GetOrganizationByID_Organization_Setting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.Domains
+	return t.UpdatedBy
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetUpdatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization_Setting) GetDomains() []string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return &t.UpdatedAt
+	return t.Domains
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetUpdatedBy() *string {
+func (t *GetOrganizationByID_Organization_Setting) GetSsoCert() *string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.UpdatedBy
+	return t.SsoCert
 }
-func (t *GetOrganizationSetting_OrganizationSetting) GetOrganization() *GetOrganizationSetting_OrganizationSetting_Organization {
+func (t *GetOrganizationByID_Organization_Setting) GetSsoEntrypoint() *string {
 	if t == nil {
-		t = &GetOrganizationSetting_OrganizationSetting{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.Organization
-}
-
-type CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner struct {
-	ID          string "json:\"id\" graphql:\"id\""
-	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+	return t.SsoEntrypoint
 }
-
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner) GetID() string {
+func (t *GetOrganizationByID_Organization_Setting) GetSsoIssuer() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.ID
+	return t.SsoIssuer
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner) GetDisplayName() string {
+func (t *GetOrganizationByID_Organization_Setting) GetBillingContact() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.DisplayName
-}
-
-type CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken struct {
-	ID          string                                                                        "json:\"id\" graphql:\"id\""
-	CreatedAt   time.Time                                                                     "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   time.Time                                                                     "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy   *string                                                                       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy   *string                                                                       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Name        string                                                                        "json:\"name\" graphql:\"name\""
-	Abilities   []string                                                                      "json:\"abilities,omitempty\" graphql:\"abilities\""
-	ExpiresAt   time.Time                                                                     "json:\"expiresAt\" graphql:\"expiresAt\""
-	Description *string                                                                       "json:\"description,omitempty\" graphql:\"description\""
-	LastUsedAt  *time.Time                                                                    "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
-	Owner       CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner "json:\"owner\" graphql:\"owner\""
+	return t.BillingContact
 }
-
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetID() string {
+func (t *GetOrganizationByID_Organization_Setting) GetBillingEmail() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.ID
+	return t.BillingEmail
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetCreatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization_Setting) GetBillingPhone() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return &t.CreatedAt
+	return t.BillingPhone
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetUpdatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization_Setting) GetBillingAddress() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return &t.UpdatedAt
+	return t.BillingAddress
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetCreatedBy() *string {
+func (t *GetOrganizationByID_Organization_Setting) GetTaxIdentifier() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.CreatedBy
+	return t.TaxIdentifier
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetUpdatedBy() *string {
+func (t *GetOrganizationByID_Organization_Setting) GetTags() []string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization_Setting{}
 	}
-	return t.UpdatedBy
+	return t.Tags
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetName() string {
-	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
-	}
-	return t.Name
+
+type GetOrganizationByID_Organization struct {
+	ID          string                                    "json:\"id\" graphql:\"id\""
+	Name        string                                    "json:\"name\" graphql:\"name\""
+	DisplayName string                                    "json:\"displayName\" graphql:\"displayName\""
+	Description *string                                   "json:\"description,omitempty\" graphql:\"description\""
+	PersonalOrg bool                                      "json:\"personalOrg\" graphql:\"personalOrg\""
+	Parent      *GetOrganizationByID_Organization_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
+	Children    GetOrganizationByID_Organization_Children "json:\"children\" graphql:\"children\""
+	Setting     *GetOrganizationByID_Organization_Setting "json:\"setting,omitempty\" graphql:\"setting\""
+	CreatedAt   time.Time                                 "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy   *string                                   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt   time.Time                                 "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy   *string                                   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetAbilities() []string {
+
+func (t *GetOrganizationByID_Organization) GetID() string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.Abilities
+	return t.ID
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetExpiresAt() *time.Time {
+func (t *GetOrganizationByID_Organization) GetName() string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return &t.ExpiresAt
+	return t.Name
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetDescription() *string {
+func (t *GetOrganizationByID_Organization) GetDisplayName() string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.Description
+	return t.DisplayName
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetLastUsedAt() *time.Time {
+func (t *GetOrganizationByID_Organization) GetDescription() *string {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.LastUsedAt
+	return t.Description
 }
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetOwner() *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner {
+func (t *GetOrganizationByID_Organization) GetPersonalOrg() bool {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return &t.Owner
-}
-
-type CreatePersonalAccessToken_CreatePersonalAccessToken struct {
-	PersonalAccessToken CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+	return t.PersonalOrg
 }
-
-func (t *CreatePersonalAccessToken_CreatePersonalAccessToken) GetPersonalAccessToken() *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken {
+func (t *GetOrganizationByID_Organization) GetParent() *GetOrganizationByID_Organization_Parent {
 	if t == nil {
-		t = &CreatePersonalAccessToken_CreatePersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return &t.PersonalAccessToken
-}
-
-type GetPersonalAccessTokenByID_PersonalAccessToken_Owner struct {
-	ID          string "json:\"id\" graphql:\"id\""
-	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+	return t.Parent
 }
-
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken_Owner) GetID() string {
+func (t *GetOrganizationByID_Organization) GetChildren() *GetOrganizationByID_Organization_Children {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken_Owner{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.ID
+	return &t.Children
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken_Owner) GetDisplayName() string {
+func (t *GetOrganizationByID_Organization) GetSetting() *GetOrganizationByID_Organization_Setting {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken_Owner{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.DisplayName
-}
-
-type GetPersonalAccessTokenByID_PersonalAccessToken struct {
-	ID          string                                               "json:\"id\" graphql:\"id\""
-	CreatedAt   time.Time                                            "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   time.Time                                            "json:\"updatedAt\" graphql:\"updatedAt\""
-	CreatedBy   *string                                              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy   *string                                              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Name        string                                               "json:\"name\" graphql:\"name\""
-	Abilities   []string                                             "json:\"abilities,omitempty\" graphql:\"abilities\""
-	ExpiresAt   time.Time                                            "json:\"expiresAt\" graphql:\"expiresAt\""
-	Description *string                                              "json:\"description,omitempty\" graphql:\"description\""
-	LastUsedAt  *time.Time                                           "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
-	Owner       GetPersonalAccessTokenByID_PersonalAccessToken_Owner "json:\"owner\" graphql:\"owner\""
+	return t.Setting
 }
-
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetID() string {
+func (t *GetOrganizationByID_Organization) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.ID
+	return &t.CreatedAt
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetCreatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return &t.CreatedAt
+	return t.CreatedBy
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetUpdatedAt() *time.Time {
+func (t *GetOrganizationByID_Organization) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
 	return &t.UpdatedAt
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetCreatedBy() *string {
+func (t *GetOrganizationByID_Organization) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetOrganizationByID_Organization{}
 	}
-	return t.CreatedBy
+	return t.UpdatedBy
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetUpdatedBy() *string {
+
+type GetAllOrganizations_Organizations_Edges_Node_Parent struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetAllOrganizations_Organizations_Edges_Node_Parent) GetID() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Parent{}
 	}
-	return t.UpdatedBy
+	return t.ID
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetName() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Parent) GetName() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Parent{}
 	}
 	return t.Name
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetAbilities() []string {
-	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
-	}
-	return t.Abilities
+
+type GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node struct {
+	ID          string  "json:\"id\" graphql:\"id\""
+	Name        string  "json:\"name\" graphql:\"name\""
+	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
+	Description *string "json:\"description,omitempty\" graphql:\"description\""
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetExpiresAt() *time.Time {
+
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetID() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return &t.ExpiresAt
+	return t.ID
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetDescription() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetName() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return t.Description
+	return t.Name
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetLastUsedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return t.LastUsedAt
+	return t.DisplayName
 }
-func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetOwner() *GetPersonalAccessTokenByID_PersonalAccessToken_Owner {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return &t.Owner
+	return t.Description
 }
 
-type DeletePersonalAccessToken_DeletePersonalAccessToken struct {
-	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+type GetAllOrganizations_Organizations_Edges_Node_Children_Edges struct {
+	Node *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
 }
 
-func (t *DeletePersonalAccessToken_DeletePersonalAccessToken) GetDeletedID() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children_Edges) GetNode() *GetAllOrganizations_Organizations_Edges_Node_Children_Edges_Node {
 	if t == nil {
-		t = &DeletePersonalAccessToken_DeletePersonalAccessToken{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children_Edges{}
 	}
-	return t.DeletedID
+	return t.Node
 }
 
-type GetUserByID_User_Setting struct {
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+type GetAllOrganizations_Organizations_Edges_Node_Children struct {
+	Edges []*GetAllOrganizations_Organizations_Edges_Node_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
 }
 
-func (t *GetUserByID_User_Setting) GetEmailConfirmed() bool {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Children) GetEdges() []*GetAllOrganizations_Organizations_Edges_Node_Children_Edges {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Children{}
 	}
-	return t.EmailConfirmed
+	return t.Edges
 }
-func (t *GetUserByID_User_Setting) GetLocked() bool {
-	if t == nil {
-		t = &GetUserByID_User_Setting{}
-	}
-	return t.Locked
+
+type GetAllOrganizations_Organizations_Edges_Node_Setting struct {
+	ID             string    "json:\"id\" graphql:\"id\""
+	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
+	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
+	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
+	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
+	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
 }
-func (t *GetUserByID_User_Setting) GetStatus() *usersetting.Status {
+
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetID() string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return &t.Status
+	return t.ID
 }
-func (t *GetUserByID_User_Setting) GetRole() *usersetting.Role {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return &t.Role
+	return &t.CreatedAt
 }
-func (t *GetUserByID_User_Setting) GetPermissions() []string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.Permissions
+	return &t.UpdatedAt
 }
-func (t *GetUserByID_User_Setting) GetTags() []string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.Tags
+	return t.CreatedBy
 }
-func (t *GetUserByID_User_Setting) GetSuspendedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.SuspendedAt
+	return t.UpdatedBy
 }
-func (t *GetUserByID_User_Setting) GetCreatedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetDomains() []string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return &t.CreatedAt
+	return t.Domains
 }
-func (t *GetUserByID_User_Setting) GetCreatedBy() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoCert() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.CreatedBy
+	return t.SsoCert
 }
-func (t *GetUserByID_User_Setting) GetUpdatedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoEntrypoint() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return &t.UpdatedAt
+	return t.SsoEntrypoint
 }
-func (t *GetUserByID_User_Setting) GetUpdatedBy() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoIssuer() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.UpdatedBy
+	return t.SsoIssuer
 }
-func (t *GetUserByID_User_Setting) GetSilencedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingContact() *string {
 	if t == nil {
-		t = &GetUserByID_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.SilencedAt
-}
-
-type GetUserByID_User struct {
-	ID              string                   "json:\"id\" graphql:\"id\""
-	FirstName       string                   "json:\"firstName\" graphql:\"firstName\""
-	LastName        string                   "json:\"lastName\" graphql:\"lastName\""
-	DisplayName     string                   "json:\"displayName\" graphql:\"displayName\""
-	Email           string                   "json:\"email\" graphql:\"email\""
-	LastSeen        *time.Time               "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
-	Sub             *string                  "json:\"sub,omitempty\" graphql:\"sub\""
-	AvatarRemoteURL *string                  "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
-	AvatarLocalFile *string                  "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
-	Oauth           bool                     "json:\"oauth\" graphql:\"oauth\""
-	Setting         GetUserByID_User_Setting "json:\"setting\" graphql:\"setting\""
-	UpdatedAt       time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy       *string                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	CreatedAt       time.Time                "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy       *string                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	return t.BillingContact
 }
-
-func (t *GetUserByID_User) GetID() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingEmail() *string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.ID
+	return t.BillingEmail
 }
-func (t *GetUserByID_User) GetFirstName() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingPhone() *string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.FirstName
+	return t.BillingPhone
 }
-func (t *GetUserByID_User) GetLastName() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetBillingAddress() *string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.LastName
+	return t.BillingAddress
 }
-func (t *GetUserByID_User) GetDisplayName() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetTaxIdentifier() *string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.DisplayName
+	return t.TaxIdentifier
 }
-func (t *GetUserByID_User) GetEmail() string {
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetTags() []string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
 	}
-	return t.Email
+	return t.Tags
 }
-func (t *GetUserByID_User) GetLastSeen() *time.Time {
+
+type GetAllOrganizations_Organizations_Edges_Node struct {
+	ID          string                                                "json:\"id\" graphql:\"id\""
+	Name        string                                                "json:\"name\" graphql:\"name\""
+	DisplayName string                                                "json:\"displayName\" graphql:\"displayName\""
+	Description *string                                               "json:\"description,omitempty\" graphql:\"description\""
+	PersonalOrg bool                                                  "json:\"personalOrg\" graphql:\"personalOrg\""
+	Parent      *GetAllOrganizations_Organizations_Edges_Node_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
+	Children    GetAllOrganizations_Organizations_Edges_Node_Children "json:\"children\" graphql:\"children\""
+	Setting     *GetAllOrganizations_Organizations_Edges_Node_Setting "json:\"setting,omitempty\" graphql:\"setting\""
+	CreatedAt   time.Time                                             "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                             "json:\"updatedAt\" graphql:\"updatedAt\""
+}
+
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetID() string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.LastSeen
+	return t.ID
 }
-func (t *GetUserByID_User) GetSub() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetName() string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.Sub
+	return t.Name
 }
-func (t *GetUserByID_User) GetAvatarRemoteURL() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.AvatarRemoteURL
+	return t.DisplayName
 }
-func (t *GetUserByID_User) GetAvatarLocalFile() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.AvatarLocalFile
+	return t.Description
 }
-func (t *GetUserByID_User) GetOauth() bool {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetPersonalOrg() bool {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.Oauth
+	return t.PersonalOrg
 }
-func (t *GetUserByID_User) GetSetting() *GetUserByID_User_Setting {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetParent() *GetAllOrganizations_Organizations_Edges_Node_Parent {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return &t.Setting
+	return t.Parent
 }
-func (t *GetUserByID_User) GetUpdatedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetChildren() *GetAllOrganizations_Organizations_Edges_Node_Children {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return &t.UpdatedAt
+	return &t.Children
 }
-func (t *GetUserByID_User) GetUpdatedBy() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetSetting() *GetAllOrganizations_Organizations_Edges_Node_Setting {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.UpdatedBy
+	return t.Setting
 }
-func (t *GetUserByID_User) GetCreatedAt() *time.Time {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
 	return &t.CreatedAt
 }
-func (t *GetUserByID_User) GetCreatedBy() *string {
+func (t *GetAllOrganizations_Organizations_Edges_Node) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByID_User{}
+		t = &GetAllOrganizations_Organizations_Edges_Node{}
 	}
-	return t.CreatedBy
+	return &t.UpdatedAt
 }
 
-type GetUserByIDWithOrgs_User_Setting struct {
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+type GetAllOrganizations_Organizations_Edges struct {
+	Node *GetAllOrganizations_Organizations_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
 }
 
-func (t *GetUserByIDWithOrgs_User_Setting) GetEmailConfirmed() bool {
+func (t *GetAllOrganizations_Organizations_Edges) GetNode() *GetAllOrganizations_Organizations_Edges_Node {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &GetAllOrganizations_Organizations_Edges{}
 	}
-	return t.EmailConfirmed
+	return t.Node
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetLocked() bool {
+
+type GetAllOrganizations_Organizations struct {
+	Edges []*GetAllOrganizations_Organizations_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetAllOrganizations_Organizations) GetEdges() []*GetAllOrganizations_Organizations_Edges {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &GetAllOrganizations_Organizations{}
 	}
-	return t.Locked
+	return t.Edges
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetStatus() *usersetting.Status {
-	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
-	}
-	return &t.Status
+
+type OrganizationsWhere_Organizations_Edges_Node_Parent struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetRole() *usersetting.Role {
+
+func (t *OrganizationsWhere_Organizations_Edges_Node_Parent) GetID() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Parent{}
 	}
-	return &t.Role
+	return t.ID
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetPermissions() []string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Parent) GetName() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Parent{}
 	}
-	return t.Permissions
+	return t.Name
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetTags() []string {
-	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
-	}
-	return t.Tags
+
+type OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node struct {
+	ID          string  "json:\"id\" graphql:\"id\""
+	Name        string  "json:\"name\" graphql:\"name\""
+	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
+	Description *string "json:\"description,omitempty\" graphql:\"description\""
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetSuspendedAt() *time.Time {
+
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetID() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return t.SuspendedAt
+	return t.ID
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetCreatedAt() *time.Time {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetName() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return &t.CreatedAt
+	return t.Name
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetCreatedBy() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return t.CreatedBy
+	return t.DisplayName
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetUpdatedAt() *time.Time {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node{}
 	}
-	return &t.UpdatedAt
+	return t.Description
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetUpdatedBy() *string {
+
+type OrganizationsWhere_Organizations_Edges_Node_Children_Edges struct {
+	Node *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children_Edges) GetNode() *OrganizationsWhere_Organizations_Edges_Node_Children_Edges_Node {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children_Edges{}
 	}
-	return t.UpdatedBy
+	return t.Node
 }
-func (t *GetUserByIDWithOrgs_User_Setting) GetSilencedAt() *time.Time {
+
+type OrganizationsWhere_Organizations_Edges_Node_Children struct {
+	Edges []*OrganizationsWhere_Organizations_Edges_Node_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *OrganizationsWhere_Organizations_Edges_Node_Children) GetEdges() []*OrganizationsWhere_Organizations_Edges_Node_Children_Edges {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Children{}
 	}
-	return t.SilencedAt
+	return t.Edges
 }
 
-type GetUserByIDWithOrgs_User_Organizations struct {
-	ID          string "json:\"id\" graphql:\"id\""
-	Name        string "json:\"name\" graphql:\"name\""
-	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+type OrganizationsWhere_Organizations_Edges_Node_Setting struct {
+	ID             string    "json:\"id\" graphql:\"id\""
+	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
+	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
+	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
+	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
+	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
-func (t *GetUserByIDWithOrgs_User_Organizations) GetID() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetID() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Organizations{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
 	return t.ID
 }
-func (t *GetUserByIDWithOrgs_User_Organizations) GetName() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Organizations{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.Name
+	return &t.CreatedAt
 }
-func (t *GetUserByIDWithOrgs_User_Organizations) GetDisplayName() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User_Organizations{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.DisplayName
+	return &t.UpdatedAt
 }
-
-type GetUserByIDWithOrgs_User struct {
-	ID              string                                    "json:\"id\" graphql:\"id\""
-	FirstName       string                                    "json:\"firstName\" graphql:\"firstName\""
-	LastName        string                                    "json:\"lastName\" graphql:\"lastName\""
-	DisplayName     string                                    "json:\"displayName\" graphql:\"displayName\""
-	Email           string                                    "json:\"email\" graphql:\"email\""
-	LastSeen        *time.Time                                "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
-	Sub             *string                                   "json:\"sub,omitempty\" graphql:\"sub\""
-	AvatarRemoteURL *string                                   "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
-	AvatarLocalFile *string                                   "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
-	Oauth           bool                                      "json:\"oauth\" graphql:\"oauth\""
-	Setting         GetUserByIDWithOrgs_User_Setting          "json:\"setting\" graphql:\"setting\""
-	UpdatedAt       time.Time                                 "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy       *string                                   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	CreatedAt       time.Time                                 "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy       *string                                   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Organizations   []*GetUserByIDWithOrgs_User_Organizations "json:\"organizations,omitempty\" graphql:\"organizations\""
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
+	}
+	return t.CreatedBy
 }
-
-func (t *GetUserByIDWithOrgs_User) GetID() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.ID
+	return t.UpdatedBy
 }
-func (t *GetUserByIDWithOrgs_User) GetFirstName() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetDomains() []string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.FirstName
+	return t.Domains
 }
-func (t *GetUserByIDWithOrgs_User) GetLastName() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoCert() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.LastName
+	return t.SsoCert
 }
-func (t *GetUserByIDWithOrgs_User) GetDisplayName() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoEntrypoint() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.DisplayName
+	return t.SsoEntrypoint
 }
-func (t *GetUserByIDWithOrgs_User) GetEmail() string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetSsoIssuer() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.Email
+	return t.SsoIssuer
 }
-func (t *GetUserByIDWithOrgs_User) GetLastSeen() *time.Time {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingContact() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.LastSeen
+	return t.BillingContact
 }
-func (t *GetUserByIDWithOrgs_User) GetSub() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingEmail() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.Sub
+	return t.BillingEmail
 }
-func (t *GetUserByIDWithOrgs_User) GetAvatarRemoteURL() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingPhone() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.AvatarRemoteURL
+	return t.BillingPhone
 }
-func (t *GetUserByIDWithOrgs_User) GetAvatarLocalFile() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetBillingAddress() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.AvatarLocalFile
+	return t.BillingAddress
 }
-func (t *GetUserByIDWithOrgs_User) GetOauth() bool {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetTaxIdentifier() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return t.Oauth
+	return t.TaxIdentifier
 }
-func (t *GetUserByIDWithOrgs_User) GetSetting() *GetUserByIDWithOrgs_User_Setting {
+func (t *OrganizationsWhere_Organizations_Edges_Node_Setting) GetTags() []string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node_Setting{}
 	}
-	return &t.Setting
+	return t.Tags
 }
-func (t *GetUserByIDWithOrgs_User) GetUpdatedAt() *time.Time {
+
+type OrganizationsWhere_Organizations_Edges_Node struct {
+	ID          string                                               "json:\"id\" graphql:\"id\""
+	Name        string                                               "json:\"name\" graphql:\"name\""
+	DisplayName string                                               "json:\"displayName\" graphql:\"displayName\""
+	Description *string                                              "json:\"description,omitempty\" graphql:\"description\""
+	PersonalOrg bool                                                 "json:\"personalOrg\" graphql:\"personalOrg\""
+	Parent      *OrganizationsWhere_Organizations_Edges_Node_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
+	Children    OrganizationsWhere_Organizations_Edges_Node_Children "json:\"children\" graphql:\"children\""
+	Setting     *OrganizationsWhere_Organizations_Edges_Node_Setting "json:\"setting,omitempty\" graphql:\"setting\""
+	CreatedAt   time.Time                                            "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                            "json:\"updatedAt\" graphql:\"updatedAt\""
+}
+
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetID() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return &t.UpdatedAt
+	return t.ID
 }
-func (t *GetUserByIDWithOrgs_User) GetUpdatedBy() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetName() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.UpdatedBy
+	return t.Name
 }
-func (t *GetUserByIDWithOrgs_User) GetCreatedAt() *time.Time {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return &t.CreatedAt
+	return t.DisplayName
 }
-func (t *GetUserByIDWithOrgs_User) GetCreatedBy() *string {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.CreatedBy
+	return t.Description
 }
-func (t *GetUserByIDWithOrgs_User) GetOrganizations() []*GetUserByIDWithOrgs_User_Organizations {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetPersonalOrg() bool {
 	if t == nil {
-		t = &GetUserByIDWithOrgs_User{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.Organizations
+	return t.PersonalOrg
 }
-
-type GetAllUsers_Users_Edges_Node_Setting struct {
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
-}
-
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetEmailConfirmed() bool {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetParent() *OrganizationsWhere_Organizations_Edges_Node_Parent {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.EmailConfirmed
+	return t.Parent
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetLocked() bool {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetChildren() *OrganizationsWhere_Organizations_Edges_Node_Children {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.Locked
+	return &t.Children
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetStatus() *usersetting.Status {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetSetting() *OrganizationsWhere_Organizations_Edges_Node_Setting {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return &t.Status
+	return t.Setting
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetRole() *usersetting.Role {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return &t.Role
+	return &t.CreatedAt
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetPermissions() []string {
+func (t *OrganizationsWhere_Organizations_Edges_Node) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges_Node{}
 	}
-	return t.Permissions
+	return &t.UpdatedAt
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetTags() []string {
+
+type OrganizationsWhere_Organizations_Edges struct {
+	Node *OrganizationsWhere_Organizations_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *OrganizationsWhere_Organizations_Edges) GetNode() *OrganizationsWhere_Organizations_Edges_Node {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations_Edges{}
 	}
-	return t.Tags
+	return t.Node
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetSuspendedAt() *time.Time {
+
+type OrganizationsWhere_Organizations struct {
+	Edges []*OrganizationsWhere_Organizations_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *OrganizationsWhere_Organizations) GetEdges() []*OrganizationsWhere_Organizations_Edges {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &OrganizationsWhere_Organizations{}
 	}
-	return t.SuspendedAt
+	return t.Edges
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetCreatedAt() *time.Time {
+
+type GetOrganizationAncestors_OrganizationAncestors_Parent struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetOrganizationAncestors_OrganizationAncestors_Parent) GetID() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &GetOrganizationAncestors_OrganizationAncestors_Parent{}
 	}
-	return &t.CreatedAt
+	return t.ID
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetCreatedBy() *string {
+
+type GetOrganizationAncestors_OrganizationAncestors struct {
+	ID          string                                                 "json:\"id\" graphql:\"id\""
+	Name        string                                                 "json:\"name\" graphql:\"name\""
+	DisplayName string                                                 "json:\"displayName\" graphql:\"displayName\""
+	Parent      *GetOrganizationAncestors_OrganizationAncestors_Parent "json:\"parent,omitempty\" graphql:\"parent\""
+}
+
+func (t *GetOrganizationAncestors_OrganizationAncestors) GetID() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &GetOrganizationAncestors_OrganizationAncestors{}
 	}
-	return t.CreatedBy
+	return t.ID
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetUpdatedAt() *time.Time {
+func (t *GetOrganizationAncestors_OrganizationAncestors) GetName() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &GetOrganizationAncestors_OrganizationAncestors{}
 	}
-	return &t.UpdatedAt
+	return t.Name
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetUpdatedBy() *string {
+func (t *GetOrganizationAncestors_OrganizationAncestors) GetDisplayName() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &GetOrganizationAncestors_OrganizationAncestors{}
 	}
-	return t.UpdatedBy
+	return t.DisplayName
 }
-func (t *GetAllUsers_Users_Edges_Node_Setting) GetSilencedAt() *time.Time {
+func (t *GetOrganizationAncestors_OrganizationAncestors) GetParent() *GetOrganizationAncestors_OrganizationAncestors_Parent {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node_Setting{}
+		t = &GetOrganizationAncestors_OrganizationAncestors{}
 	}
-	return t.SilencedAt
+	return t.Parent
 }
 
-type GetAllUsers_Users_Edges_Node struct {
-	ID              string                               "json:\"id\" graphql:\"id\""
-	FirstName       string                               "json:\"firstName\" graphql:\"firstName\""
-	LastName        string                               "json:\"lastName\" graphql:\"lastName\""
-	DisplayName     string                               "json:\"displayName\" graphql:\"displayName\""
-	Email           string                               "json:\"email\" graphql:\"email\""
-	LastSeen        *time.Time                           "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
-	Sub             *string                              "json:\"sub,omitempty\" graphql:\"sub\""
-	AvatarRemoteURL *string                              "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
-	AvatarLocalFile *string                              "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
-	Oauth           bool                                 "json:\"oauth\" graphql:\"oauth\""
-	Setting         GetAllUsers_Users_Edges_Node_Setting "json:\"setting\" graphql:\"setting\""
-	UpdatedAt       time.Time                            "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy       *string                              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	CreatedAt       time.Time                            "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy       *string                              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+type GetOrganizationDescendants_OrganizationDescendants_Parent struct {
+	ID string "json:\"id\" graphql:\"id\""
 }
 
-func (t *GetAllUsers_Users_Edges_Node) GetID() string {
+func (t *GetOrganizationDescendants_OrganizationDescendants_Parent) GetID() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &GetOrganizationDescendants_OrganizationDescendants_Parent{}
 	}
 	return t.ID
 }
-func (t *GetAllUsers_Users_Edges_Node) GetFirstName() string {
+
+type GetOrganizationDescendants_OrganizationDescendants struct {
+	ID          string                                                     "json:\"id\" graphql:\"id\""
+	Name        string                                                     "json:\"name\" graphql:\"name\""
+	DisplayName string                                                     "json:\"displayName\" graphql:\"displayName\""
+	Parent      *GetOrganizationDescendants_OrganizationDescendants_Parent "json:\"parent,omitempty\" graphql:\"parent\""
+}
+
+func (t *GetOrganizationDescendants_OrganizationDescendants) GetID() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &GetOrganizationDescendants_OrganizationDescendants{}
 	}
-	return t.FirstName
+	return t.ID
 }
-func (t *GetAllUsers_Users_Edges_Node) GetLastName() string {
+func (t *GetOrganizationDescendants_OrganizationDescendants) GetName() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &GetOrganizationDescendants_OrganizationDescendants{}
 	}
-	return t.LastName
+	return t.Name
 }
-func (t *GetAllUsers_Users_Edges_Node) GetDisplayName() string {
+func (t *GetOrganizationDescendants_OrganizationDescendants) GetDisplayName() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &GetOrganizationDescendants_OrganizationDescendants{}
 	}
 	return t.DisplayName
 }
-func (t *GetAllUsers_Users_Edges_Node) GetEmail() string {
+func (t *GetOrganizationDescendants_OrganizationDescendants) GetParent() *GetOrganizationDescendants_OrganizationDescendants_Parent {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &GetOrganizationDescendants_OrganizationDescendants{}
 	}
-	return t.Email
+	return t.Parent
 }
-func (t *GetAllUsers_Users_Edges_Node) GetLastSeen() *time.Time {
+
+type CreateOrganization_CreateOrganization_Organization_Setting struct {
+	ID             string    "json:\"id\" graphql:\"id\""
+	CreatedAt      time.Time "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt      time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy      *string   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains        []string  "json:\"domains,omitempty\" graphql:\"domains\""
+	SsoCert        *string   "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
+	SsoEntrypoint  *string   "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
+	SsoIssuer      *string   "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
+	BillingContact *string   "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail   *string   "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone   *string   "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress *string   "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier  *string   "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	Tags           []string  "json:\"tags,omitempty\" graphql:\"tags\""
+}
+
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetID() string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.LastSeen
+	return t.ID
 }
-func (t *GetAllUsers_Users_Edges_Node) GetSub() *string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.Sub
+	return &t.CreatedAt
 }
-func (t *GetAllUsers_Users_Edges_Node) GetAvatarRemoteURL() *string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.AvatarRemoteURL
+	return &t.UpdatedAt
 }
-func (t *GetAllUsers_Users_Edges_Node) GetAvatarLocalFile() *string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.AvatarLocalFile
+	return t.CreatedBy
 }
-func (t *GetAllUsers_Users_Edges_Node) GetOauth() bool {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.Oauth
+	return t.UpdatedBy
 }
-func (t *GetAllUsers_Users_Edges_Node) GetSetting() *GetAllUsers_Users_Edges_Node_Setting {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetDomains() []string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return &t.Setting
+	return t.Domains
 }
-func (t *GetAllUsers_Users_Edges_Node) GetUpdatedAt() *time.Time {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoCert() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return &t.UpdatedAt
+	return t.SsoCert
 }
-func (t *GetAllUsers_Users_Edges_Node) GetUpdatedBy() *string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoEntrypoint() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.UpdatedBy
+	return t.SsoEntrypoint
 }
-func (t *GetAllUsers_Users_Edges_Node) GetCreatedAt() *time.Time {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoIssuer() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return &t.CreatedAt
+	return t.SsoIssuer
 }
-func (t *GetAllUsers_Users_Edges_Node) GetCreatedBy() *string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingContact() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges_Node{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.CreatedBy
-}
-
-type GetAllUsers_Users_Edges struct {
-	Node *GetAllUsers_Users_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+	return t.BillingContact
 }
-
-func (t *GetAllUsers_Users_Edges) GetNode() *GetAllUsers_Users_Edges_Node {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingEmail() *string {
 	if t == nil {
-		t = &GetAllUsers_Users_Edges{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.Node
+	return t.BillingEmail
 }
-
-type GetAllUsers_Users struct {
-	Edges []*GetAllUsers_Users_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingPhone() *string {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.BillingPhone
 }
-
-func (t *GetAllUsers_Users) GetEdges() []*GetAllUsers_Users_Edges {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetBillingAddress() *string {
 	if t == nil {
-		t = &GetAllUsers_Users{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.Edges
+	return t.BillingAddress
 }
-
-type CreateUser_CreateUser_User_Organizations struct {
-	ID string "json:\"id\" graphql:\"id\""
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetTaxIdentifier() *string {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.TaxIdentifier
 }
-
-func (t *CreateUser_CreateUser_User_Organizations) GetID() string {
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetTags() []string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Organizations{}
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
 	}
-	return t.ID
+	return t.Tags
 }
 
-type CreateUser_CreateUser_User_Groups struct {
-	ID string "json:\"id\" graphql:\"id\""
+type CreateOrganization_CreateOrganization_Organization_Parent struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
 }
 
-func (t *CreateUser_CreateUser_User_Groups) GetID() string {
+func (t *CreateOrganization_CreateOrganization_Organization_Parent) GetID() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Groups{}
+		t = &CreateOrganization_CreateOrganization_Organization_Parent{}
 	}
 	return t.ID
 }
+func (t *CreateOrganization_CreateOrganization_Organization_Parent) GetName() string {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Parent{}
+	}
+	return t.Name
+}
 
-type CreateUser_CreateUser_User_Setting struct {
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+type CreateOrganization_CreateOrganization_Organization_Children_Edges_Node struct {
+	ID          string  "json:\"id\" graphql:\"id\""
+	Name        string  "json:\"name\" graphql:\"name\""
+	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
+	Description *string "json:\"description,omitempty\" graphql:\"description\""
 }
 
-func (t *CreateUser_CreateUser_User_Setting) GetEmailConfirmed() bool {
+func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetID() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
 	}
-	return t.EmailConfirmed
+	return t.ID
 }
-func (t *CreateUser_CreateUser_User_Setting) GetLocked() bool {
+func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
 	}
-	return t.Locked
+	return t.Name
 }
-func (t *CreateUser_CreateUser_User_Setting) GetStatus() *usersetting.Status {
+func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetDisplayName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
 	}
-	return &t.Status
+	return t.DisplayName
 }
-func (t *CreateUser_CreateUser_User_Setting) GetRole() *usersetting.Role {
+func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node) GetDescription() *string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges_Node{}
 	}
-	return &t.Role
+	return t.Description
 }
-func (t *CreateUser_CreateUser_User_Setting) GetPermissions() []string {
-	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
-	}
-	return t.Permissions
+
+type CreateOrganization_CreateOrganization_Organization_Children_Edges struct {
+	Node *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
 }
-func (t *CreateUser_CreateUser_User_Setting) GetTags() []string {
+
+func (t *CreateOrganization_CreateOrganization_Organization_Children_Edges) GetNode() *CreateOrganization_CreateOrganization_Organization_Children_Edges_Node {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children_Edges{}
 	}
-	return t.Tags
+	return t.Node
 }
-func (t *CreateUser_CreateUser_User_Setting) GetSuspendedAt() *time.Time {
-	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
-	}
-	return t.SuspendedAt
+
+type CreateOrganization_CreateOrganization_Organization_Children struct {
+	Edges []*CreateOrganization_CreateOrganization_Organization_Children_Edges "json:\"edges,omitempty\" graphql:\"edges\""
 }
-func (t *CreateUser_CreateUser_User_Setting) GetCreatedAt() *time.Time {
+
+func (t *CreateOrganization_CreateOrganization_Organization_Children) GetEdges() []*CreateOrganization_CreateOrganization_Organization_Children_Edges {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization_Children{}
 	}
-	return &t.CreatedAt
+	return t.Edges
 }
-func (t *CreateUser_CreateUser_User_Setting) GetCreatedBy() *string {
-	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
-	}
-	return t.CreatedBy
+
+type CreateOrganization_CreateOrganization_Organization struct {
+	ID          string                                                      "json:\"id\" graphql:\"id\""
+	Name        string                                                      "json:\"name\" graphql:\"name\""
+	DisplayName string                                                      "json:\"displayName\" graphql:\"displayName\""
+	Description *string                                                     "json:\"description,omitempty\" graphql:\"description\""
+	PersonalOrg bool                                                        "json:\"personalOrg\" graphql:\"personalOrg\""
+	CreatedAt   time.Time                                                   "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                                   "json:\"updatedAt\" graphql:\"updatedAt\""
+	Setting     *CreateOrganization_CreateOrganization_Organization_Setting "json:\"setting,omitempty\" graphql:\"setting\""
+	Parent      *CreateOrganization_CreateOrganization_Organization_Parent  "json:\"parent,omitempty\" graphql:\"parent\""
+	Children    CreateOrganization_CreateOrganization_Organization_Children "json:\"children\" graphql:\"children\""
 }
-func (t *CreateUser_CreateUser_User_Setting) GetUpdatedAt() *time.Time {
+
+func (t *CreateOrganization_CreateOrganization_Organization) GetID() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return &t.UpdatedAt
+	return t.ID
 }
-func (t *CreateUser_CreateUser_User_Setting) GetUpdatedBy() *string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.UpdatedBy
+	return t.Name
 }
-func (t *CreateUser_CreateUser_User_Setting) GetSilencedAt() *time.Time {
+func (t *CreateOrganization_CreateOrganization_Organization) GetDisplayName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User_Setting{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.SilencedAt
-}
-
-type CreateUser_CreateUser_User struct {
-	ID              string                                      "json:\"id\" graphql:\"id\""
-	Email           string                                      "json:\"email\" graphql:\"email\""
-	FirstName       string                                      "json:\"firstName\" graphql:\"firstName\""
-	LastName        string                                      "json:\"lastName\" graphql:\"lastName\""
-	DisplayName     string                                      "json:\"displayName\" graphql:\"displayName\""
-	AvatarRemoteURL *string                                     "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
-	AvatarLocalFile *string                                     "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
-	Sub             *string                                     "json:\"sub,omitempty\" graphql:\"sub\""
-	Oauth           bool                                        "json:\"oauth\" graphql:\"oauth\""
-	Organizations   []*CreateUser_CreateUser_User_Organizations "json:\"organizations,omitempty\" graphql:\"organizations\""
-	Groups          []*CreateUser_CreateUser_User_Groups        "json:\"groups,omitempty\" graphql:\"groups\""
-	Setting         CreateUser_CreateUser_User_Setting          "json:\"setting\" graphql:\"setting\""
+	return t.DisplayName
 }
-
-func (t *CreateUser_CreateUser_User) GetID() string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetDescription() *string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.ID
+	return t.Description
 }
-func (t *CreateUser_CreateUser_User) GetEmail() string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetPersonalOrg() bool {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.Email
+	return t.PersonalOrg
 }
-func (t *CreateUser_CreateUser_User) GetFirstName() string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.FirstName
+	return &t.CreatedAt
 }
-func (t *CreateUser_CreateUser_User) GetLastName() string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.LastName
+	return &t.UpdatedAt
 }
-func (t *CreateUser_CreateUser_User) GetDisplayName() string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetSetting() *CreateOrganization_CreateOrganization_Organization_Setting {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.DisplayName
+	return t.Setting
 }
-func (t *CreateUser_CreateUser_User) GetAvatarRemoteURL() *string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetParent() *CreateOrganization_CreateOrganization_Organization_Parent {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.AvatarRemoteURL
+	return t.Parent
 }
-func (t *CreateUser_CreateUser_User) GetAvatarLocalFile() *string {
+func (t *CreateOrganization_CreateOrganization_Organization) GetChildren() *CreateOrganization_CreateOrganization_Organization_Children {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization_Organization{}
 	}
-	return t.AvatarLocalFile
+	return &t.Children
 }
-func (t *CreateUser_CreateUser_User) GetSub() *string {
+
+type CreateOrganization_CreateOrganization struct {
+	Organization CreateOrganization_CreateOrganization_Organization "json:\"organization\" graphql:\"organization\""
+}
+
+func (t *CreateOrganization_CreateOrganization) GetOrganization() *CreateOrganization_CreateOrganization_Organization {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &CreateOrganization_CreateOrganization{}
 	}
-	return t.Sub
+	return &t.Organization
 }
-func (t *CreateUser_CreateUser_User) GetOauth() bool {
+
+type UpdateOrganization_UpdateOrganization_Organization struct {
+	ID          string  "json:\"id\" graphql:\"id\""
+	Name        string  "json:\"name\" graphql:\"name\""
+	DisplayName string  "json:\"displayName\" graphql:\"displayName\""
+	Description *string "json:\"description,omitempty\" graphql:\"description\""
+}
+
+func (t *UpdateOrganization_UpdateOrganization_Organization) GetID() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &UpdateOrganization_UpdateOrganization_Organization{}
 	}
-	return t.Oauth
+	return t.ID
 }
-func (t *CreateUser_CreateUser_User) GetOrganizations() []*CreateUser_CreateUser_User_Organizations {
+func (t *UpdateOrganization_UpdateOrganization_Organization) GetName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &UpdateOrganization_UpdateOrganization_Organization{}
 	}
-	return t.Organizations
+	return t.Name
 }
-func (t *CreateUser_CreateUser_User) GetGroups() []*CreateUser_CreateUser_User_Groups {
+func (t *UpdateOrganization_UpdateOrganization_Organization) GetDisplayName() string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &UpdateOrganization_UpdateOrganization_Organization{}
 	}
-	return t.Groups
+	return t.DisplayName
 }
-func (t *CreateUser_CreateUser_User) GetSetting() *CreateUser_CreateUser_User_Setting {
+func (t *UpdateOrganization_UpdateOrganization_Organization) GetDescription() *string {
 	if t == nil {
-		t = &CreateUser_CreateUser_User{}
+		t = &UpdateOrganization_UpdateOrganization_Organization{}
 	}
-	return &t.Setting
+	return t.Description
 }
 
-type CreateUser_CreateUser struct {
-	User CreateUser_CreateUser_User "json:\"user\" graphql:\"user\""
+type UpdateOrganization_UpdateOrganization struct {
+	Organization UpdateOrganization_UpdateOrganization_Organization "json:\"organization\" graphql:\"organization\""
 }
 
-func (t *CreateUser_CreateUser) GetUser() *CreateUser_CreateUser_User {
+func (t *UpdateOrganization_UpdateOrganization) GetOrganization() *UpdateOrganization_UpdateOrganization_Organization {
 	if t == nil {
-		t = &CreateUser_CreateUser{}
+		t = &UpdateOrganization_UpdateOrganization{}
 	}
-	return &t.User
+	return &t.Organization
 }
 
-type UpdateUser_UpdateUser_User_Groups struct {
+type MoveOrganization_MoveOrganization_Organization_Parent struct {
 	ID string "json:\"id\" graphql:\"id\""
 }
 
-func (t *UpdateUser_UpdateUser_User_Groups) GetID() string {
+func (t *MoveOrganization_MoveOrganization_Organization_Parent) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Groups{}
+		t = &MoveOrganization_MoveOrganization_Organization_Parent{}
 	}
 	return t.ID
 }
 
-type UpdateUser_UpdateUser_User_Organizations struct {
-	ID string "json:\"id\" graphql:\"id\""
+type MoveOrganization_MoveOrganization_Organization struct {
+	ID          string                                                 "json:\"id\" graphql:\"id\""
+	Name        string                                                 "json:\"name\" graphql:\"name\""
+	DisplayName string                                                 "json:\"displayName\" graphql:\"displayName\""
+	Parent      *MoveOrganization_MoveOrganization_Organization_Parent "json:\"parent,omitempty\" graphql:\"parent\""
 }
 
-func (t *UpdateUser_UpdateUser_User_Organizations) GetID() string {
+func (t *MoveOrganization_MoveOrganization_Organization) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Organizations{}
+		t = &MoveOrganization_MoveOrganization_Organization{}
 	}
 	return t.ID
 }
+func (t *MoveOrganization_MoveOrganization_Organization) GetName() string {
+	if t == nil {
+		t = &MoveOrganization_MoveOrganization_Organization{}
+	}
+	return t.Name
+}
+func (t *MoveOrganization_MoveOrganization_Organization) GetDisplayName() string {
+	if t == nil {
+		t = &MoveOrganization_MoveOrganization_Organization{}
+	}
+	return t.DisplayName
+}
+func (t *MoveOrganization_MoveOrganization_Organization) GetParent() *MoveOrganization_MoveOrganization_Organization_Parent {
+	if t == nil {
+		t = &MoveOrganization_MoveOrganization_Organization{}
+	}
+	return t.Parent
+}
 
-type UpdateUser_UpdateUser_User_PersonalAccessTokens struct {
-	ID string "json:\"id\" graphql:\"id\""
+type MoveOrganization_MoveOrganization struct {
+	Organization MoveOrganization_MoveOrganization_Organization "json:\"organization\" graphql:\"organization\""
 }
 
-func (t *UpdateUser_UpdateUser_User_PersonalAccessTokens) GetID() string {
+func (t *MoveOrganization_MoveOrganization) GetOrganization() *MoveOrganization_MoveOrganization_Organization {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_PersonalAccessTokens{}
+		t = &MoveOrganization_MoveOrganization{}
 	}
-	return t.ID
+	return &t.Organization
 }
 
-type UpdateUser_UpdateUser_User_Setting struct {
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
-	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+type DeleteOrganization_DeleteOrganization struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
 
-func (t *UpdateUser_UpdateUser_User_Setting) GetEmailConfirmed() bool {
+func (t *DeleteOrganization_DeleteOrganization) GetDeletedID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &DeleteOrganization_DeleteOrganization{}
 	}
-	return t.EmailConfirmed
+	return t.DeletedID
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetLocked() bool {
+
+type GetOrganizationSetting_OrganizationSetting_Organization struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetOrganizationSetting_OrganizationSetting_Organization) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting_Organization{}
 	}
-	return t.Locked
+	return t.ID
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetStatus() *usersetting.Status {
+
+type GetOrganizationSetting_OrganizationSetting struct {
+	ID             string                                                   "json:\"id\" graphql:\"id\""
+	SsoCert        *string                                                  "json:\"ssoCert,omitempty\" graphql:\"ssoCert\""
+	TaxIdentifier  *string                                                  "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	Tags           []string                                                 "json:\"tags,omitempty\" graphql:\"tags\""
+	SsoIssuer      *string                                                  "json:\"ssoIssuer,omitempty\" graphql:\"ssoIssuer\""
+	SsoEntrypoint  *string                                                  "json:\"ssoEntrypoint,omitempty\" graphql:\"ssoEntrypoint\""
+	BillingAddress *string                                                  "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact *string                                                  "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail   *string                                                  "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone   *string                                                  "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt      time.Time                                                "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string                                                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains        []string                                                 "json:\"domains,omitempty\" graphql:\"domains\""
+	UpdatedAt      time.Time                                                "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string                                                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Organization   *GetOrganizationSetting_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+}
+
+func (t *GetOrganizationSetting_OrganizationSetting) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
-	return &t.Status
+	return t.ID
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetRole() *usersetting.Role {
+func (t *GetOrganizationSetting_OrganizationSetting) GetSsoCert() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
-	return &t.Role
+	return t.SsoCert
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetPermissions() []string {
+func (t *GetOrganizationSetting_OrganizationSetting) GetTaxIdentifier() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
-	return t.Permissions
+	return t.TaxIdentifier
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetTags() []string {
+func (t *GetOrganizationSetting_OrganizationSetting) GetTags() []string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
 	return t.Tags
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetSuspendedAt() *time.Time {
+func (t *GetOrganizationSetting_OrganizationSetting) GetSsoIssuer() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
-	return t.SuspendedAt
+	return t.SsoIssuer
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetCreatedAt() *time.Time {
+func (t *GetOrganizationSetting_OrganizationSetting) GetSsoEntrypoint() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.SsoEntrypoint
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetBillingAddress() *string {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.BillingAddress
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetBillingContact() *string {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.BillingContact
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetBillingEmail() *string {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.BillingEmail
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetBillingPhone() *string {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.BillingPhone
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
 	return &t.CreatedAt
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetCreatedBy() *string {
+func (t *GetOrganizationSetting_OrganizationSetting) GetCreatedBy() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
 	return t.CreatedBy
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetUpdatedAt() *time.Time {
+func (t *GetOrganizationSetting_OrganizationSetting) GetDomains() []string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
+	}
+	return t.Domains
+}
+func (t *GetOrganizationSetting_OrganizationSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
 	return &t.UpdatedAt
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetUpdatedBy() *string {
+func (t *GetOrganizationSetting_OrganizationSetting) GetUpdatedBy() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
 	return t.UpdatedBy
 }
-func (t *UpdateUser_UpdateUser_User_Setting) GetSilencedAt() *time.Time {
+func (t *GetOrganizationSetting_OrganizationSetting) GetOrganization() *GetOrganizationSetting_OrganizationSetting_Organization {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User_Setting{}
+		t = &GetOrganizationSetting_OrganizationSetting{}
 	}
-	return t.SilencedAt
+	return t.Organization
 }
 
-type UpdateUser_UpdateUser_User struct {
-	ID                   string                                             "json:\"id\" graphql:\"id\""
-	Email                string                                             "json:\"email\" graphql:\"email\""
-	FirstName            string                                             "json:\"firstName\" graphql:\"firstName\""
-	LastName             string                                             "json:\"lastName\" graphql:\"lastName\""
-	DisplayName          string                                             "json:\"displayName\" graphql:\"displayName\""
-	AvatarRemoteURL      *string                                            "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
-	AvatarLocalFile      *string                                            "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
-	Sub                  *string                                            "json:\"sub,omitempty\" graphql:\"sub\""
-	Oauth                bool                                               "json:\"oauth\" graphql:\"oauth\""
-	Groups               []*UpdateUser_UpdateUser_User_Groups               "json:\"groups,omitempty\" graphql:\"groups\""
-	Organizations        []*UpdateUser_UpdateUser_User_Organizations        "json:\"organizations,omitempty\" graphql:\"organizations\""
-	PersonalAccessTokens []*UpdateUser_UpdateUser_User_PersonalAccessTokens "json:\"personalAccessTokens,omitempty\" graphql:\"personalAccessTokens\""
-	Setting              UpdateUser_UpdateUser_User_Setting                 "json:\"setting\" graphql:\"setting\""
+type AddUserToOrganization_AddUserToOrganization_OrgMembership struct {
+	ID             string             "json:\"id\" graphql:\"id\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Role           orgmembership.Role "json:\"role\" graphql:\"role\""
+	OrganizationID string             "json:\"organizationID\" graphql:\"organizationID\""
+	UserID         string             "json:\"userID\" graphql:\"userID\""
 }
 
-func (t *UpdateUser_UpdateUser_User) GetID() string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
 	return t.ID
 }
-func (t *UpdateUser_UpdateUser_User) GetEmail() string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.Email
+	return &t.CreatedAt
 }
-func (t *UpdateUser_UpdateUser_User) GetFirstName() string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.FirstName
+	return &t.UpdatedAt
 }
-func (t *UpdateUser_UpdateUser_User) GetLastName() string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetCreatedBy() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.LastName
+	return t.CreatedBy
 }
-func (t *UpdateUser_UpdateUser_User) GetDisplayName() string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetUpdatedBy() *string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.DisplayName
+	return t.UpdatedBy
 }
-func (t *UpdateUser_UpdateUser_User) GetAvatarRemoteURL() *string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetRole() *orgmembership.Role {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.AvatarRemoteURL
+	return &t.Role
 }
-func (t *UpdateUser_UpdateUser_User) GetAvatarLocalFile() *string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetOrganizationID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.AvatarLocalFile
+	return t.OrganizationID
 }
-func (t *UpdateUser_UpdateUser_User) GetSub() *string {
+func (t *AddUserToOrganization_AddUserToOrganization_OrgMembership) GetUserID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization_OrgMembership{}
 	}
-	return t.Sub
+	return t.UserID
 }
-func (t *UpdateUser_UpdateUser_User) GetOauth() bool {
+
+type AddUserToOrganization_AddUserToOrganization struct {
+	OrgMembership AddUserToOrganization_AddUserToOrganization_OrgMembership "json:\"orgMembership\" graphql:\"orgMembership\""
+}
+
+func (t *AddUserToOrganization_AddUserToOrganization) GetOrgMembership() *AddUserToOrganization_AddUserToOrganization_OrgMembership {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &AddUserToOrganization_AddUserToOrganization{}
 	}
-	return t.Oauth
+	return &t.OrgMembership
 }
-func (t *UpdateUser_UpdateUser_User) GetGroups() []*UpdateUser_UpdateUser_User_Groups {
+
+type UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership struct {
+	ID             string             "json:\"id\" graphql:\"id\""
+	Role           orgmembership.Role "json:\"role\" graphql:\"role\""
+	OrganizationID string             "json:\"organizationID\" graphql:\"organizationID\""
+	UserID         string             "json:\"userID\" graphql:\"userID\""
+}
+
+func (t *UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership) GetID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership{}
 	}
-	return t.Groups
+	return t.ID
 }
-func (t *UpdateUser_UpdateUser_User) GetOrganizations() []*UpdateUser_UpdateUser_User_Organizations {
+func (t *UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership) GetRole() *orgmembership.Role {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership{}
 	}
-	return t.Organizations
+	return &t.Role
 }
-func (t *UpdateUser_UpdateUser_User) GetPersonalAccessTokens() []*UpdateUser_UpdateUser_User_PersonalAccessTokens {
+func (t *UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership) GetOrganizationID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership{}
 	}
-	return t.PersonalAccessTokens
+	return t.OrganizationID
 }
-func (t *UpdateUser_UpdateUser_User) GetSetting() *UpdateUser_UpdateUser_User_Setting {
+func (t *UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership) GetUserID() string {
 	if t == nil {
-		t = &UpdateUser_UpdateUser_User{}
+		t = &UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership{}
 	}
-	return &t.Setting
+	return t.UserID
 }
 
-type UpdateUser_UpdateUser struct {
-	User UpdateUser_UpdateUser_User "json:\"user\" graphql:\"user\""
+type UpdateOrgMemberRole_UpdateOrgMemberRole struct {
+	OrgMembership UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership "json:\"orgMembership\" graphql:\"orgMembership\""
 }
 
-func (t *UpdateUser_UpdateUser) GetUser() *UpdateUser_UpdateUser_User {
+func (t *UpdateOrgMemberRole_UpdateOrgMemberRole) GetOrgMembership() *UpdateOrgMemberRole_UpdateOrgMemberRole_OrgMembership {
 	if t == nil {
-		t = &UpdateUser_UpdateUser{}
+		t = &UpdateOrgMemberRole_UpdateOrgMemberRole{}
 	}
-	return &t.User
+	return &t.OrgMembership
 }
 
-type DeleteUser_DeleteUser struct {
+type RemoveUserFromOrganization_RemoveUserFromOrganization struct {
 	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
 
-func (t *DeleteUser_DeleteUser) GetDeletedID() string {
+func (t *RemoveUserFromOrganization_RemoveUserFromOrganization) GetDeletedID() string {
 	if t == nil {
-		t = &DeleteUser_DeleteUser{}
+		t = &RemoveUserFromOrganization_RemoveUserFromOrganization{}
 	}
 	return t.DeletedID
 }
 
-type GetUserSettingByID_UserSetting struct {
+type GetOrgMembershipByID_OrgMembership struct {
 	ID             string             "json:\"id\" graphql:\"id\""
-	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
-	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
-	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
-	Status         usersetting.Status "json:\"status\" graphql:\"status\""
-	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
-	Tags           []string           "json:\"tags\" graphql:\"tags\""
-	Locked         bool               "json:\"locked\" graphql:\"locked\""
-	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
 	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
-	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	DeletedAt      *time.Time         "json:\"deletedAt,omitempty\" graphql:\"deletedAt\""
-	DeletedBy      *string            "json:\"deletedBy,omitempty\" graphql:\"deletedBy\""
 	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
 	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Role           orgmembership.Role "json:\"role\" graphql:\"role\""
+	OrganizationID string             "json:\"organizationID\" graphql:\"organizationID\""
+	UserID         string             "json:\"userID\" graphql:\"userID\""
 }
 
-func (t *GetUserSettingByID_UserSetting) GetID() string {
+func (t *GetOrgMembershipByID_OrgMembership) GetID() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
 	return t.ID
 }
-func (t *GetUserSettingByID_UserSetting) GetPermissions() []string {
+func (t *GetOrgMembershipByID_OrgMembership) GetCreatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return t.Permissions
+	return &t.CreatedAt
 }
-func (t *GetUserSettingByID_UserSetting) GetRole() *usersetting.Role {
+func (t *GetOrgMembershipByID_OrgMembership) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return &t.Role
+	return &t.UpdatedAt
 }
-func (t *GetUserSettingByID_UserSetting) GetSilencedAt() *time.Time {
+func (t *GetOrgMembershipByID_OrgMembership) GetCreatedBy() *string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return t.SilencedAt
+	return t.CreatedBy
 }
-func (t *GetUserSettingByID_UserSetting) GetStatus() *usersetting.Status {
+func (t *GetOrgMembershipByID_OrgMembership) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return &t.Status
+	return t.UpdatedBy
 }
-func (t *GetUserSettingByID_UserSetting) GetSuspendedAt() *time.Time {
+func (t *GetOrgMembershipByID_OrgMembership) GetRole() *orgmembership.Role {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return t.SuspendedAt
+	return &t.Role
 }
-func (t *GetUserSettingByID_UserSetting) GetTags() []string {
+func (t *GetOrgMembershipByID_OrgMembership) GetOrganizationID() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return t.Tags
+	return t.OrganizationID
 }
-func (t *GetUserSettingByID_UserSetting) GetLocked() bool {
+func (t *GetOrgMembershipByID_OrgMembership) GetUserID() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembershipByID_OrgMembership{}
 	}
-	return t.Locked
+	return t.UserID
 }
-func (t *GetUserSettingByID_UserSetting) GetEmailConfirmed() bool {
+
+type GetOrgMembers_Organization_Members_User struct {
+	ID        string "json:\"id\" graphql:\"id\""
+	Email     string "json:\"email\" graphql:\"email\""
+	FirstName string "json:\"firstName\" graphql:\"firstName\""
+	LastName  string "json:\"lastName\" graphql:\"lastName\""
+}
+
+func (t *GetOrgMembers_Organization_Members_User) GetID() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members_User{}
 	}
-	return t.EmailConfirmed
+	return t.ID
 }
-func (t *GetUserSettingByID_UserSetting) GetCreatedAt() *time.Time {
+func (t *GetOrgMembers_Organization_Members_User) GetEmail() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members_User{}
 	}
-	return &t.CreatedAt
+	return t.Email
 }
-func (t *GetUserSettingByID_UserSetting) GetCreatedBy() *string {
+func (t *GetOrgMembers_Organization_Members_User) GetFirstName() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members_User{}
 	}
-	return t.CreatedBy
+	return t.FirstName
 }
-func (t *GetUserSettingByID_UserSetting) GetDeletedAt() *time.Time {
+func (t *GetOrgMembers_Organization_Members_User) GetLastName() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members_User{}
 	}
-	return t.DeletedAt
+	return t.LastName
 }
-func (t *GetUserSettingByID_UserSetting) GetDeletedBy() *string {
+
+type GetOrgMembers_Organization_Members struct {
+	ID   string                                  "json:\"id\" graphql:\"id\""
+	Role orgmembership.Role                      "json:\"role\" graphql:\"role\""
+	User GetOrgMembers_Organization_Members_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetOrgMembers_Organization_Members) GetID() string {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members{}
 	}
-	return t.DeletedBy
+	return t.ID
 }
-func (t *GetUserSettingByID_UserSetting) GetUpdatedAt() *time.Time {
+func (t *GetOrgMembers_Organization_Members) GetRole() *orgmembership.Role {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members{}
 	}
-	return &t.UpdatedAt
+	return &t.Role
 }
-func (t *GetUserSettingByID_UserSetting) GetUpdatedBy() *string {
+func (t *GetOrgMembers_Organization_Members) GetUser() *GetOrgMembers_Organization_Members_User {
 	if t == nil {
-		t = &GetUserSettingByID_UserSetting{}
+		t = &GetOrgMembers_Organization_Members{}
 	}
-	return t.UpdatedBy
+	return &t.User
 }
 
-type GetGroupByID struct {
-	Group GetGroupByID_Group "json:\"group\" graphql:\"group\""
+type GetOrgMembers_Organization struct {
+	ID      string                                "json:\"id\" graphql:\"id\""
+	Members []*GetOrgMembers_Organization_Members "json:\"members,omitempty\" graphql:\"members\""
 }
 
-func (t *GetGroupByID) GetGroup() *GetGroupByID_Group {
+func (t *GetOrgMembers_Organization) GetID() string {
 	if t == nil {
-		t = &GetGroupByID{}
+		t = &GetOrgMembers_Organization{}
 	}
-	return &t.Group
+	return t.ID
+}
+func (t *GetOrgMembers_Organization) GetMembers() []*GetOrgMembers_Organization_Members {
+	if t == nil {
+		t = &GetOrgMembers_Organization{}
+	}
+	return t.Members
 }
 
-type GroupsWhere struct {
-	Groups GroupsWhere_Groups "json:\"groups\" graphql:\"groups\""
+type TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer struct {
+	ID          string                   "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Expires     time.Time                "json:\"expires\" graphql:\"expires\""
+	Status      ownershiptransfer.Status "json:\"status\" graphql:\"status\""
+	OwnerID     string                   "json:\"ownerID\" graphql:\"ownerID\""
+	GroupID     *string                  "json:\"groupID,omitempty\" graphql:\"groupID\""
+	RequestorID string                   "json:\"requestorID\" graphql:\"requestorID\""
+	RecipientID string                   "json:\"recipientID\" graphql:\"recipientID\""
 }
 
-func (t *GroupsWhere) GetGroups() *GroupsWhere_Groups {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetID() string {
 	if t == nil {
-		t = &GroupsWhere{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.Groups
+	return t.ID
 }
-
-type GetAllGroups struct {
-	Groups GetAllGroups_Groups "json:\"groups\" graphql:\"groups\""
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
+	}
+	return &t.CreatedAt
 }
-
-func (t *GetAllGroups) GetGroups() *GetAllGroups_Groups {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetAllGroups{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.Groups
+	return &t.UpdatedAt
 }
-
-type CreateGroup struct {
-	CreateGroup CreateGroup_CreateGroup "json:\"createGroup\" graphql:\"createGroup\""
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetCreatedBy() *string {
+	if t == nil {
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
+	}
+	return t.CreatedBy
 }
-
-func (t *CreateGroup) GetCreateGroup() *CreateGroup_CreateGroup {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetUpdatedBy() *string {
 	if t == nil {
-		t = &CreateGroup{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.CreateGroup
+	return t.UpdatedBy
 }
-
-type UpdateGroup struct {
-	UpdateGroup UpdateGroup_UpdateGroup "json:\"updateGroup\" graphql:\"updateGroup\""
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetExpires() *time.Time {
+	if t == nil {
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
+	}
+	return &t.Expires
 }
-
-func (t *UpdateGroup) GetUpdateGroup() *UpdateGroup_UpdateGroup {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetStatus() *ownershiptransfer.Status {
 	if t == nil {
-		t = &UpdateGroup{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.UpdateGroup
+	return &t.Status
 }
-
-type DeleteGroup struct {
-	DeleteGroup DeleteGroup_DeleteGroup "json:\"deleteGroup\" graphql:\"deleteGroup\""
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetOwnerID() string {
+	if t == nil {
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
+	}
+	return t.OwnerID
 }
-
-func (t *DeleteGroup) GetDeleteGroup() *DeleteGroup_DeleteGroup {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetGroupID() *string {
 	if t == nil {
-		t = &DeleteGroup{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.DeleteGroup
+	return t.GroupID
 }
-
-type GetGroupSetting struct {
-	GroupSetting GetGroupSetting_GroupSetting "json:\"groupSetting\" graphql:\"groupSetting\""
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetRequestorID() string {
+	if t == nil {
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
+	}
+	return t.RequestorID
 }
-
-func (t *GetGroupSetting) GetGroupSetting() *GetGroupSetting_GroupSetting {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer) GetRecipientID() string {
 	if t == nil {
-		t = &GetGroupSetting{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer{}
 	}
-	return &t.GroupSetting
+	return t.RecipientID
 }
 
-type GetOrganizationByID struct {
-	Organization GetOrganizationByID_Organization "json:\"organization\" graphql:\"organization\""
+type TransferOrganizationOwnership_TransferOrganizationOwnership struct {
+	OwnershipTransfer TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer "json:\"ownershipTransfer\" graphql:\"ownershipTransfer\""
 }
 
-func (t *GetOrganizationByID) GetOrganization() *GetOrganizationByID_Organization {
+func (t *TransferOrganizationOwnership_TransferOrganizationOwnership) GetOwnershipTransfer() *TransferOrganizationOwnership_TransferOrganizationOwnership_OwnershipTransfer {
 	if t == nil {
-		t = &GetOrganizationByID{}
+		t = &TransferOrganizationOwnership_TransferOrganizationOwnership{}
 	}
-	return &t.Organization
+	return &t.OwnershipTransfer
 }
 
-type GetAllOrganizations struct {
-	Organizations GetAllOrganizations_Organizations "json:\"organizations\" graphql:\"organizations\""
+type TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer struct {
+	ID          string                   "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Expires     time.Time                "json:\"expires\" graphql:\"expires\""
+	Status      ownershiptransfer.Status "json:\"status\" graphql:\"status\""
+	OwnerID     string                   "json:\"ownerID\" graphql:\"ownerID\""
+	GroupID     *string                  "json:\"groupID,omitempty\" graphql:\"groupID\""
+	RequestorID string                   "json:\"requestorID\" graphql:\"requestorID\""
+	RecipientID string                   "json:\"recipientID\" graphql:\"recipientID\""
 }
 
-func (t *GetAllOrganizations) GetOrganizations() *GetAllOrganizations_Organizations {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetID() string {
 	if t == nil {
-		t = &GetAllOrganizations{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.Organizations
+	return t.ID
 }
-
-type OrganizationsWhere struct {
-	Organizations OrganizationsWhere_Organizations "json:\"organizations\" graphql:\"organizations\""
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
+	}
+	return &t.CreatedAt
 }
-
-func (t *OrganizationsWhere) GetOrganizations() *OrganizationsWhere_Organizations {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &OrganizationsWhere{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.Organizations
+	return &t.UpdatedAt
 }
-
-type CreateOrganization struct {
-	CreateOrganization CreateOrganization_CreateOrganization "json:\"createOrganization\" graphql:\"createOrganization\""
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetCreatedBy() *string {
+	if t == nil {
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
+	}
+	return t.CreatedBy
 }
-
-func (t *CreateOrganization) GetCreateOrganization() *CreateOrganization_CreateOrganization {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetUpdatedBy() *string {
 	if t == nil {
-		t = &CreateOrganization{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.CreateOrganization
+	return t.UpdatedBy
 }
-
-type UpdateOrganization struct {
-	UpdateOrganization UpdateOrganization_UpdateOrganization "json:\"updateOrganization\" graphql:\"updateOrganization\""
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetExpires() *time.Time {
+	if t == nil {
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
+	}
+	return &t.Expires
 }
-
-func (t *UpdateOrganization) GetUpdateOrganization() *UpdateOrganization_UpdateOrganization {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetStatus() *ownershiptransfer.Status {
 	if t == nil {
-		t = &UpdateOrganization{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.UpdateOrganization
+	return &t.Status
 }
-
-type DeleteOrganization struct {
-	DeleteOrganization DeleteOrganization_DeleteOrganization "json:\"deleteOrganization\" graphql:\"deleteOrganization\""
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetOwnerID() string {
+	if t == nil {
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
+	}
+	return t.OwnerID
 }
-
-func (t *DeleteOrganization) GetDeleteOrganization() *DeleteOrganization_DeleteOrganization {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetGroupID() *string {
 	if t == nil {
-		t = &DeleteOrganization{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.DeleteOrganization
+	return t.GroupID
 }
-
-type GetOrganizationSetting struct {
-	OrganizationSetting GetOrganizationSetting_OrganizationSetting "json:\"organizationSetting\" graphql:\"organizationSetting\""
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetRequestorID() string {
+	if t == nil {
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
+	}
+	return t.RequestorID
 }
-
-func (t *GetOrganizationSetting) GetOrganizationSetting() *GetOrganizationSetting_OrganizationSetting {
+func (t *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer) GetRecipientID() string {
 	if t == nil {
-		t = &GetOrganizationSetting{}
+		t = &TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer{}
 	}
-	return &t.OrganizationSetting
+	return t.RecipientID
 }
 
-type CreatePersonalAccessToken struct {
-	CreatePersonalAccessToken CreatePersonalAccessToken_CreatePersonalAccessToken "json:\"createPersonalAccessToken\" graphql:\"createPersonalAccessToken\""
+type TransferGroupOwnership_TransferGroupOwnership struct {
+	OwnershipTransfer TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer "json:\"ownershipTransfer\" graphql:\"ownershipTransfer\""
 }
 
-func (t *CreatePersonalAccessToken) GetCreatePersonalAccessToken() *CreatePersonalAccessToken_CreatePersonalAccessToken {
+func (t *TransferGroupOwnership_TransferGroupOwnership) GetOwnershipTransfer() *TransferGroupOwnership_TransferGroupOwnership_OwnershipTransfer {
 	if t == nil {
-		t = &CreatePersonalAccessToken{}
+		t = &TransferGroupOwnership_TransferGroupOwnership{}
 	}
-	return &t.CreatePersonalAccessToken
+	return &t.OwnershipTransfer
 }
 
-type GetPersonalAccessTokenByID struct {
-	PersonalAccessToken GetPersonalAccessTokenByID_PersonalAccessToken "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+type CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer struct {
+	ID     string                   "json:\"id\" graphql:\"id\""
+	Status ownershiptransfer.Status "json:\"status\" graphql:\"status\""
 }
 
-func (t *GetPersonalAccessTokenByID) GetPersonalAccessToken() *GetPersonalAccessTokenByID_PersonalAccessToken {
+func (t *CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer) GetID() string {
 	if t == nil {
-		t = &GetPersonalAccessTokenByID{}
+		t = &CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer{}
 	}
-	return &t.PersonalAccessToken
+	return t.ID
+}
+func (t *CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer) GetStatus() *ownershiptransfer.Status {
+	if t == nil {
+		t = &CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer{}
+	}
+	return &t.Status
 }
 
-type DeletePersonalAccessToken struct {
-	DeletePersonalAccessToken DeletePersonalAccessToken_DeletePersonalAccessToken "json:\"deletePersonalAccessToken\" graphql:\"deletePersonalAccessToken\""
+type CancelOwnershipTransfer_CancelOwnershipTransfer struct {
+	OwnershipTransfer CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer "json:\"ownershipTransfer\" graphql:\"ownershipTransfer\""
 }
 
-func (t *DeletePersonalAccessToken) GetDeletePersonalAccessToken() *DeletePersonalAccessToken_DeletePersonalAccessToken {
+func (t *CancelOwnershipTransfer_CancelOwnershipTransfer) GetOwnershipTransfer() *CancelOwnershipTransfer_CancelOwnershipTransfer_OwnershipTransfer {
 	if t == nil {
-		t = &DeletePersonalAccessToken{}
+		t = &CancelOwnershipTransfer_CancelOwnershipTransfer{}
 	}
-	return &t.DeletePersonalAccessToken
+	return &t.OwnershipTransfer
 }
 
-type GetUserByID struct {
-	User GetUserByID_User "json:\"user\" graphql:\"user\""
+type GetOwnershipTransferByID_OwnershipTransfer struct {
+	ID          string                   "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Expires     time.Time                "json:\"expires\" graphql:\"expires\""
+	Status      ownershiptransfer.Status "json:\"status\" graphql:\"status\""
+	OwnerID     string                   "json:\"ownerID\" graphql:\"ownerID\""
+	GroupID     *string                  "json:\"groupID,omitempty\" graphql:\"groupID\""
+	RequestorID string                   "json:\"requestorID\" graphql:\"requestorID\""
+	RecipientID string                   "json:\"recipientID\" graphql:\"recipientID\""
 }
 
-func (t *GetUserByID) GetUser() *GetUserByID_User {
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetID() string {
 	if t == nil {
-		t = &GetUserByID{}
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
 	}
-	return &t.User
+	return t.ID
 }
-
-type GetUserByIDWithOrgs struct {
-	User GetUserByIDWithOrgs_User "json:\"user\" graphql:\"user\""
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return &t.CreatedAt
 }
-
-func (t *GetUserByIDWithOrgs) GetUser() *GetUserByIDWithOrgs_User {
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetUpdatedAt() *time.Time {
 	if t == nil {
-		t = &GetUserByIDWithOrgs{}
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
 	}
-	return &t.User
+	return &t.UpdatedAt
 }
-
-type GetAllUsers struct {
-	Users GetAllUsers_Users "json:\"users\" graphql:\"users\""
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return t.CreatedBy
 }
-
-func (t *GetAllUsers) GetUsers() *GetAllUsers_Users {
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetUpdatedBy() *string {
 	if t == nil {
-		t = &GetAllUsers{}
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
 	}
-	return &t.Users
+	return t.UpdatedBy
 }
-
-type CreateUser struct {
-	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetExpires() *time.Time {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return &t.Expires
 }
-
-func (t *CreateUser) GetCreateUser() *CreateUser_CreateUser {
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetStatus() *ownershiptransfer.Status {
 	if t == nil {
-		t = &CreateUser{}
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
 	}
-	return &t.CreateUser
+	return &t.Status
 }
-
-type UpdateUser struct {
-	UpdateUser UpdateUser_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetOwnerID() string {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return t.OwnerID
 }
-
-func (t *UpdateUser) GetUpdateUser() *UpdateUser_UpdateUser {
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetGroupID() *string {
 	if t == nil {
-		t = &UpdateUser{}
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
 	}
-	return &t.UpdateUser
+	return t.GroupID
+}
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetRequestorID() string {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return t.RequestorID
+}
+func (t *GetOwnershipTransferByID_OwnershipTransfer) GetRecipientID() string {
+	if t == nil {
+		t = &GetOwnershipTransferByID_OwnershipTransfer{}
+	}
+	return t.RecipientID
 }
 
-type DeleteUser struct {
-	DeleteUser DeleteUser_DeleteUser "json:\"deleteUser\" graphql:\"deleteUser\""
+type GetOwnershipTransfers_OwnershipTransfers_Edges_Node struct {
+	ID          string                   "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+	Expires     time.Time                "json:\"expires\" graphql:\"expires\""
+	Status      ownershiptransfer.Status "json:\"status\" graphql:\"status\""
+	OwnerID     string                   "json:\"ownerID\" graphql:\"ownerID\""
+	GroupID     *string                  "json:\"groupID,omitempty\" graphql:\"groupID\""
+	RequestorID string                   "json:\"requestorID\" graphql:\"requestorID\""
+	RecipientID string                   "json:\"recipientID\" graphql:\"recipientID\""
 }
 
-func (t *DeleteUser) GetDeleteUser() *DeleteUser_DeleteUser {
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetID() string {
 	if t == nil {
-		t = &DeleteUser{}
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetExpires() *time.Time {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return &t.Expires
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetStatus() *ownershiptransfer.Status {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return &t.Status
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetOwnerID() string {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return t.OwnerID
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetGroupID() *string {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return t.GroupID
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetRequestorID() string {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return t.RequestorID
+}
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges_Node) GetRecipientID() string {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges_Node{}
+	}
+	return t.RecipientID
+}
+
+type GetOwnershipTransfers_OwnershipTransfers_Edges struct {
+	Node *GetOwnershipTransfers_OwnershipTransfers_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetOwnershipTransfers_OwnershipTransfers_Edges) GetNode() *GetOwnershipTransfers_OwnershipTransfers_Edges_Node {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers_Edges{}
+	}
+	return t.Node
+}
+
+type GetOwnershipTransfers_OwnershipTransfers struct {
+	Edges []*GetOwnershipTransfers_OwnershipTransfers_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetOwnershipTransfers_OwnershipTransfers) GetEdges() []*GetOwnershipTransfers_OwnershipTransfers_Edges {
+	if t == nil {
+		t = &GetOwnershipTransfers_OwnershipTransfers{}
+	}
+	return t.Edges
+}
+
+type CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner) GetID() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner{}
+	}
+	return t.ID
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner{}
+	}
+	return t.DisplayName
+}
+
+type CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken struct {
+	ID          string                                                                        "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                                                                     "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                                                     "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                                                                       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                                                                       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name        string                                                                        "json:\"name\" graphql:\"name\""
+	TokenPrefix string                                                                        "json:\"tokenPrefix\" graphql:\"tokenPrefix\""
+	Abilities   []string                                                                      "json:\"abilities,omitempty\" graphql:\"abilities\""
+	ExpiresAt   time.Time                                                                     "json:\"expiresAt\" graphql:\"expiresAt\""
+	Description *string                                                                       "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                                                                    "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetID() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.ID
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.CreatedAt
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.UpdatedAt
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetCreatedBy() *string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.CreatedBy
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetUpdatedBy() *string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.UpdatedBy
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetName() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Name
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetTokenPrefix() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.TokenPrefix
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetAbilities() []string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Abilities
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.ExpiresAt
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetDescription() *string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Description
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.LastUsedAt
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken) GetOwner() *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken_Owner {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.Owner
+}
+
+type CreatePersonalAccessToken_CreatePersonalAccessToken struct {
+	PersonalAccessToken CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+	Token               string                                                                  "json:\"token\" graphql:\"token\""
+}
+
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken) GetPersonalAccessToken() *CreatePersonalAccessToken_CreatePersonalAccessToken_PersonalAccessToken {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken{}
+	}
+	return &t.PersonalAccessToken
+}
+func (t *CreatePersonalAccessToken_CreatePersonalAccessToken) GetToken() string {
+	if t == nil {
+		t = &CreatePersonalAccessToken_CreatePersonalAccessToken{}
+	}
+	return t.Token
+}
+
+type GetPersonalAccessTokenByID_PersonalAccessToken_Owner struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken_Owner) GetID() string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken_Owner{}
+	}
+	return t.ID
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken_Owner{}
+	}
+	return t.DisplayName
+}
+
+type GetPersonalAccessTokenByID_PersonalAccessToken struct {
+	ID          string                                               "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                                            "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                            "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                                              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                                              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name        string                                               "json:\"name\" graphql:\"name\""
+	TokenPrefix string                                               "json:\"tokenPrefix\" graphql:\"tokenPrefix\""
+	Abilities   []string                                             "json:\"abilities,omitempty\" graphql:\"abilities\""
+	ExpiresAt   time.Time                                            "json:\"expiresAt\" graphql:\"expiresAt\""
+	Description *string                                              "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                                           "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       GetPersonalAccessTokenByID_PersonalAccessToken_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetID() string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.ID
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.CreatedBy
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetName() string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.Name
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetTokenPrefix() string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.TokenPrefix
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetAbilities() []string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.Abilities
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return &t.ExpiresAt
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetDescription() *string {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.Description
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return t.LastUsedAt
+}
+func (t *GetPersonalAccessTokenByID_PersonalAccessToken) GetOwner() *GetPersonalAccessTokenByID_PersonalAccessToken_Owner {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID_PersonalAccessToken{}
+	}
+	return &t.Owner
+}
+
+type DeletePersonalAccessToken_DeletePersonalAccessToken struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *DeletePersonalAccessToken_DeletePersonalAccessToken) GetDeletedID() string {
+	if t == nil {
+		t = &DeletePersonalAccessToken_DeletePersonalAccessToken{}
+	}
+	return t.DeletedID
+}
+
+type UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner) GetID() string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner{}
+	}
+	return t.ID
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner{}
+	}
+	return t.DisplayName
+}
+
+type UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken struct {
+	ID          string                                                                        "json:\"id\" graphql:\"id\""
+	CreatedAt   time.Time                                                                     "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt   time.Time                                                                     "json:\"updatedAt\" graphql:\"updatedAt\""
+	CreatedBy   *string                                                                       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy   *string                                                                       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Name        string                                                                        "json:\"name\" graphql:\"name\""
+	TokenPrefix string                                                                        "json:\"tokenPrefix\" graphql:\"tokenPrefix\""
+	Abilities   []string                                                                      "json:\"abilities,omitempty\" graphql:\"abilities\""
+	ExpiresAt   time.Time                                                                     "json:\"expiresAt\" graphql:\"expiresAt\""
+	Description *string                                                                       "json:\"description,omitempty\" graphql:\"description\""
+	LastUsedAt  *time.Time                                                                    "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner       UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetID() string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.ID
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.CreatedAt
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.UpdatedAt
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.CreatedBy
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.UpdatedBy
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetName() string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Name
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetTokenPrefix() string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.TokenPrefix
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetAbilities() []string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Abilities
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.ExpiresAt
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetDescription() *string {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.Description
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return t.LastUsedAt
+}
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken) GetOwner() *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken_Owner {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken{}
+	}
+	return &t.Owner
+}
+
+type UpdatePersonalAccessToken_UpdatePersonalAccessToken struct {
+	PersonalAccessToken UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+}
+
+func (t *UpdatePersonalAccessToken_UpdatePersonalAccessToken) GetPersonalAccessToken() *UpdatePersonalAccessToken_UpdatePersonalAccessToken_PersonalAccessToken {
+	if t == nil {
+		t = &UpdatePersonalAccessToken_UpdatePersonalAccessToken{}
+	}
+	return &t.PersonalAccessToken
+}
+
+type EnrollTFA_EnrollTfa struct {
+	Secret string "json:\"secret\" graphql:\"secret\""
+	QRURI  string "json:\"qrURI\" graphql:\"qrURI\""
+}
+
+func (t *EnrollTFA_EnrollTfa) GetSecret() string {
+	if t == nil {
+		t = &EnrollTFA_EnrollTfa{}
+	}
+	return t.Secret
+}
+func (t *EnrollTFA_EnrollTfa) GetQRURI() string {
+	if t == nil {
+		t = &EnrollTFA_EnrollTfa{}
+	}
+	return t.QRURI
+}
+
+type ConfirmTFA_ConfirmTfa_UserSetting struct {
+	ID           string "json:\"id\" graphql:\"id\""
+	IsTfaEnabled bool   "json:\"isTfaEnabled\" graphql:\"isTfaEnabled\""
+}
+
+func (t *ConfirmTFA_ConfirmTfa_UserSetting) GetID() string {
+	if t == nil {
+		t = &ConfirmTFA_ConfirmTfa_UserSetting{}
+	}
+	return t.ID
+}
+func (t *ConfirmTFA_ConfirmTfa_UserSetting) GetIsTfaEnabled() bool {
+	if t == nil {
+		t = &ConfirmTFA_ConfirmTfa_UserSetting{}
+	}
+	return t.IsTfaEnabled
+}
+
+type ConfirmTFA_ConfirmTfa struct {
+	UserSetting   ConfirmTFA_ConfirmTfa_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+	RecoveryCodes []string                          "json:\"recoveryCodes\" graphql:\"recoveryCodes\""
+}
+
+func (t *ConfirmTFA_ConfirmTfa) GetUserSetting() *ConfirmTFA_ConfirmTfa_UserSetting {
+	if t == nil {
+		t = &ConfirmTFA_ConfirmTfa{}
+	}
+	return &t.UserSetting
+}
+func (t *ConfirmTFA_ConfirmTfa) GetRecoveryCodes() []string {
+	if t == nil {
+		t = &ConfirmTFA_ConfirmTfa{}
+	}
+	return t.RecoveryCodes
+}
+
+type DisableTFA_DisableTfa_UserSetting struct {
+	ID           string "json:\"id\" graphql:\"id\""
+	IsTfaEnabled bool   "json:\"isTfaEnabled\" graphql:\"isTfaEnabled\""
+}
+
+func (t *DisableTFA_DisableTfa_UserSetting) GetID() string {
+	if t == nil {
+		t = &DisableTFA_DisableTfa_UserSetting{}
+	}
+	return t.ID
+}
+func (t *DisableTFA_DisableTfa_UserSetting) GetIsTfaEnabled() bool {
+	if t == nil {
+		t = &DisableTFA_DisableTfa_UserSetting{}
+	}
+	return t.IsTfaEnabled
+}
+
+type DisableTFA_DisableTfa struct {
+	UserSetting DisableTFA_DisableTfa_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *DisableTFA_DisableTfa) GetUserSetting() *DisableTFA_DisableTfa_UserSetting {
+	if t == nil {
+		t = &DisableTFA_DisableTfa{}
+	}
+	return &t.UserSetting
+}
+
+type GetUserByID_User_Setting struct {
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+}
+
+func (t *GetUserByID_User_Setting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *GetUserByID_User_Setting) GetLocked() bool {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.Locked
+}
+func (t *GetUserByID_User_Setting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return &t.Status
+}
+func (t *GetUserByID_User_Setting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return &t.Role
+}
+func (t *GetUserByID_User_Setting) GetPermissions() []string {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.Permissions
+}
+func (t *GetUserByID_User_Setting) GetTags() []string {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.Tags
+}
+func (t *GetUserByID_User_Setting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.SuspendedAt
+}
+func (t *GetUserByID_User_Setting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetUserByID_User_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.CreatedBy
+}
+func (t *GetUserByID_User_Setting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetUserByID_User_Setting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetUserByID_User_Setting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User_Setting{}
+	}
+	return t.SilencedAt
+}
+
+type GetUserByID_User struct {
+	ID              string                   "json:\"id\" graphql:\"id\""
+	FirstName       string                   "json:\"firstName\" graphql:\"firstName\""
+	LastName        string                   "json:\"lastName\" graphql:\"lastName\""
+	DisplayName     string                   "json:\"displayName\" graphql:\"displayName\""
+	Email           string                   "json:\"email\" graphql:\"email\""
+	LastSeen        *time.Time               "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
+	Sub             *string                  "json:\"sub,omitempty\" graphql:\"sub\""
+	AvatarRemoteURL *string                  "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
+	AvatarLocalFile *string                  "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
+	Oauth           bool                     "json:\"oauth\" graphql:\"oauth\""
+	Setting         GetUserByID_User_Setting "json:\"setting\" graphql:\"setting\""
+	UpdatedAt       time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy       *string                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	CreatedAt       time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy       *string                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+}
+
+func (t *GetUserByID_User) GetID() string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.ID
+}
+func (t *GetUserByID_User) GetFirstName() string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.FirstName
+}
+func (t *GetUserByID_User) GetLastName() string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.LastName
+}
+func (t *GetUserByID_User) GetDisplayName() string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.DisplayName
+}
+func (t *GetUserByID_User) GetEmail() string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.Email
+}
+func (t *GetUserByID_User) GetLastSeen() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.LastSeen
+}
+func (t *GetUserByID_User) GetSub() *string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.Sub
+}
+func (t *GetUserByID_User) GetAvatarRemoteURL() *string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.AvatarRemoteURL
+}
+func (t *GetUserByID_User) GetAvatarLocalFile() *string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.AvatarLocalFile
+}
+func (t *GetUserByID_User) GetOauth() bool {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.Oauth
+}
+func (t *GetUserByID_User) GetSetting() *GetUserByID_User_Setting {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return &t.Setting
+}
+func (t *GetUserByID_User) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetUserByID_User) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetUserByID_User) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetUserByID_User) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetUserByID_User{}
+	}
+	return t.CreatedBy
+}
+
+type GetUserByIDWithOrgs_User_Setting struct {
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+}
+
+func (t *GetUserByIDWithOrgs_User_Setting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetLocked() bool {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.Locked
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return &t.Status
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return &t.Role
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetPermissions() []string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.Permissions
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetTags() []string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.Tags
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.SuspendedAt
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.CreatedBy
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetUserByIDWithOrgs_User_Setting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Setting{}
+	}
+	return t.SilencedAt
+}
+
+type GetUserByIDWithOrgs_User_Organizations struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	Name        string "json:\"name\" graphql:\"name\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetUserByIDWithOrgs_User_Organizations) GetID() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Organizations{}
+	}
+	return t.ID
+}
+func (t *GetUserByIDWithOrgs_User_Organizations) GetName() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Organizations{}
+	}
+	return t.Name
+}
+func (t *GetUserByIDWithOrgs_User_Organizations) GetDisplayName() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User_Organizations{}
+	}
+	return t.DisplayName
+}
+
+type GetUserByIDWithOrgs_User struct {
+	ID              string                                    "json:\"id\" graphql:\"id\""
+	FirstName       string                                    "json:\"firstName\" graphql:\"firstName\""
+	LastName        string                                    "json:\"lastName\" graphql:\"lastName\""
+	DisplayName     string                                    "json:\"displayName\" graphql:\"displayName\""
+	Email           string                                    "json:\"email\" graphql:\"email\""
+	LastSeen        *time.Time                                "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
+	Sub             *string                                   "json:\"sub,omitempty\" graphql:\"sub\""
+	AvatarRemoteURL *string                                   "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
+	AvatarLocalFile *string                                   "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
+	Oauth           bool                                      "json:\"oauth\" graphql:\"oauth\""
+	Setting         GetUserByIDWithOrgs_User_Setting          "json:\"setting\" graphql:\"setting\""
+	UpdatedAt       time.Time                                 "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy       *string                                   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	CreatedAt       time.Time                                 "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy       *string                                   "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Organizations   []*GetUserByIDWithOrgs_User_Organizations "json:\"organizations,omitempty\" graphql:\"organizations\""
+}
+
+func (t *GetUserByIDWithOrgs_User) GetID() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.ID
+}
+func (t *GetUserByIDWithOrgs_User) GetFirstName() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.FirstName
+}
+func (t *GetUserByIDWithOrgs_User) GetLastName() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.LastName
+}
+func (t *GetUserByIDWithOrgs_User) GetDisplayName() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.DisplayName
+}
+func (t *GetUserByIDWithOrgs_User) GetEmail() string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.Email
+}
+func (t *GetUserByIDWithOrgs_User) GetLastSeen() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.LastSeen
+}
+func (t *GetUserByIDWithOrgs_User) GetSub() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.Sub
+}
+func (t *GetUserByIDWithOrgs_User) GetAvatarRemoteURL() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.AvatarRemoteURL
+}
+func (t *GetUserByIDWithOrgs_User) GetAvatarLocalFile() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.AvatarLocalFile
+}
+func (t *GetUserByIDWithOrgs_User) GetOauth() bool {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.Oauth
+}
+func (t *GetUserByIDWithOrgs_User) GetSetting() *GetUserByIDWithOrgs_User_Setting {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return &t.Setting
+}
+func (t *GetUserByIDWithOrgs_User) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetUserByIDWithOrgs_User) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetUserByIDWithOrgs_User) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetUserByIDWithOrgs_User) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.CreatedBy
+}
+func (t *GetUserByIDWithOrgs_User) GetOrganizations() []*GetUserByIDWithOrgs_User_Organizations {
+	if t == nil {
+		t = &GetUserByIDWithOrgs_User{}
+	}
+	return t.Organizations
+}
+
+type GetAllUsers_Users_Edges_Node_Setting struct {
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+}
+
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetLocked() bool {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.Locked
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return &t.Status
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return &t.Role
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetPermissions() []string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.Permissions
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetTags() []string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.Tags
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.SuspendedAt
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.CreatedBy
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetAllUsers_Users_Edges_Node_Setting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node_Setting{}
+	}
+	return t.SilencedAt
+}
+
+type GetAllUsers_Users_Edges_Node struct {
+	ID              string                               "json:\"id\" graphql:\"id\""
+	FirstName       string                               "json:\"firstName\" graphql:\"firstName\""
+	LastName        string                               "json:\"lastName\" graphql:\"lastName\""
+	DisplayName     string                               "json:\"displayName\" graphql:\"displayName\""
+	Email           string                               "json:\"email\" graphql:\"email\""
+	LastSeen        *time.Time                           "json:\"lastSeen,omitempty\" graphql:\"lastSeen\""
+	Sub             *string                              "json:\"sub,omitempty\" graphql:\"sub\""
+	AvatarRemoteURL *string                              "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
+	AvatarLocalFile *string                              "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
+	Oauth           bool                                 "json:\"oauth\" graphql:\"oauth\""
+	Setting         GetAllUsers_Users_Edges_Node_Setting "json:\"setting\" graphql:\"setting\""
+	UpdatedAt       time.Time                            "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy       *string                              "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	CreatedAt       time.Time                            "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy       *string                              "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+}
+
+func (t *GetAllUsers_Users_Edges_Node) GetID() string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetAllUsers_Users_Edges_Node) GetFirstName() string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.FirstName
+}
+func (t *GetAllUsers_Users_Edges_Node) GetLastName() string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.LastName
+}
+func (t *GetAllUsers_Users_Edges_Node) GetDisplayName() string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.DisplayName
+}
+func (t *GetAllUsers_Users_Edges_Node) GetEmail() string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.Email
+}
+func (t *GetAllUsers_Users_Edges_Node) GetLastSeen() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.LastSeen
+}
+func (t *GetAllUsers_Users_Edges_Node) GetSub() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.Sub
+}
+func (t *GetAllUsers_Users_Edges_Node) GetAvatarRemoteURL() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.AvatarRemoteURL
+}
+func (t *GetAllUsers_Users_Edges_Node) GetAvatarLocalFile() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.AvatarLocalFile
+}
+func (t *GetAllUsers_Users_Edges_Node) GetOauth() bool {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.Oauth
+}
+func (t *GetAllUsers_Users_Edges_Node) GetSetting() *GetAllUsers_Users_Edges_Node_Setting {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return &t.Setting
+}
+func (t *GetAllUsers_Users_Edges_Node) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetAllUsers_Users_Edges_Node) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.UpdatedBy
+}
+func (t *GetAllUsers_Users_Edges_Node) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetAllUsers_Users_Edges_Node) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges_Node{}
+	}
+	return t.CreatedBy
+}
+
+type GetAllUsers_Users_Edges struct {
+	Node *GetAllUsers_Users_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetAllUsers_Users_Edges) GetNode() *GetAllUsers_Users_Edges_Node {
+	if t == nil {
+		t = &GetAllUsers_Users_Edges{}
+	}
+	return t.Node
+}
+
+type GetAllUsers_Users struct {
+	Edges []*GetAllUsers_Users_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetAllUsers_Users) GetEdges() []*GetAllUsers_Users_Edges {
+	if t == nil {
+		t = &GetAllUsers_Users{}
+	}
+	return t.Edges
+}
+
+type CreateUser_CreateUser_User_Organizations struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreateUser_CreateUser_User_Organizations) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Organizations{}
+	}
+	return t.ID
+}
+
+type CreateUser_CreateUser_User_Groups struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreateUser_CreateUser_User_Groups) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Groups{}
+	}
+	return t.ID
+}
+
+type CreateUser_CreateUser_User_Setting struct {
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+}
+
+func (t *CreateUser_CreateUser_User_Setting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *CreateUser_CreateUser_User_Setting) GetLocked() bool {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.Locked
+}
+func (t *CreateUser_CreateUser_User_Setting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return &t.Status
+}
+func (t *CreateUser_CreateUser_User_Setting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return &t.Role
+}
+func (t *CreateUser_CreateUser_User_Setting) GetPermissions() []string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.Permissions
+}
+func (t *CreateUser_CreateUser_User_Setting) GetTags() []string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.Tags
+}
+func (t *CreateUser_CreateUser_User_Setting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.SuspendedAt
+}
+func (t *CreateUser_CreateUser_User_Setting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return &t.CreatedAt
+}
+func (t *CreateUser_CreateUser_User_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.CreatedBy
+}
+func (t *CreateUser_CreateUser_User_Setting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *CreateUser_CreateUser_User_Setting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.UpdatedBy
+}
+func (t *CreateUser_CreateUser_User_Setting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &CreateUser_CreateUser_User_Setting{}
+	}
+	return t.SilencedAt
+}
+
+type CreateUser_CreateUser_User struct {
+	ID              string                                      "json:\"id\" graphql:\"id\""
+	Email           string                                      "json:\"email\" graphql:\"email\""
+	FirstName       string                                      "json:\"firstName\" graphql:\"firstName\""
+	LastName        string                                      "json:\"lastName\" graphql:\"lastName\""
+	DisplayName     string                                      "json:\"displayName\" graphql:\"displayName\""
+	AvatarRemoteURL *string                                     "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
+	AvatarLocalFile *string                                     "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
+	Sub             *string                                     "json:\"sub,omitempty\" graphql:\"sub\""
+	Oauth           bool                                        "json:\"oauth\" graphql:\"oauth\""
+	Organizations   []*CreateUser_CreateUser_User_Organizations "json:\"organizations,omitempty\" graphql:\"organizations\""
+	Groups          []*CreateUser_CreateUser_User_Groups        "json:\"groups,omitempty\" graphql:\"groups\""
+	Setting         CreateUser_CreateUser_User_Setting          "json:\"setting\" graphql:\"setting\""
+}
+
+func (t *CreateUser_CreateUser_User) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.ID
+}
+func (t *CreateUser_CreateUser_User) GetEmail() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.Email
+}
+func (t *CreateUser_CreateUser_User) GetFirstName() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.FirstName
+}
+func (t *CreateUser_CreateUser_User) GetLastName() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.LastName
+}
+func (t *CreateUser_CreateUser_User) GetDisplayName() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.DisplayName
+}
+func (t *CreateUser_CreateUser_User) GetAvatarRemoteURL() *string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.AvatarRemoteURL
+}
+func (t *CreateUser_CreateUser_User) GetAvatarLocalFile() *string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.AvatarLocalFile
+}
+func (t *CreateUser_CreateUser_User) GetSub() *string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.Sub
+}
+func (t *CreateUser_CreateUser_User) GetOauth() bool {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.Oauth
+}
+func (t *CreateUser_CreateUser_User) GetOrganizations() []*CreateUser_CreateUser_User_Organizations {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.Organizations
+}
+func (t *CreateUser_CreateUser_User) GetGroups() []*CreateUser_CreateUser_User_Groups {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.Groups
+}
+func (t *CreateUser_CreateUser_User) GetSetting() *CreateUser_CreateUser_User_Setting {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return &t.Setting
+}
+
+type CreateUser_CreateUser struct {
+	User CreateUser_CreateUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *CreateUser_CreateUser) GetUser() *CreateUser_CreateUser_User {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return &t.User
+}
+
+type UpdateUser_UpdateUser_User_Groups struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *UpdateUser_UpdateUser_User_Groups) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Groups{}
+	}
+	return t.ID
+}
+
+type UpdateUser_UpdateUser_User_Organizations struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *UpdateUser_UpdateUser_User_Organizations) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Organizations{}
+	}
+	return t.ID
+}
+
+type UpdateUser_UpdateUser_User_PersonalAccessTokens struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *UpdateUser_UpdateUser_User_PersonalAccessTokens) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_PersonalAccessTokens{}
+	}
+	return t.ID
+}
+
+type UpdateUser_UpdateUser_User_Setting struct {
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+}
+
+func (t *UpdateUser_UpdateUser_User_Setting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetLocked() bool {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.Locked
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return &t.Status
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return &t.Role
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetPermissions() []string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.Permissions
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetTags() []string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.Tags
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.SuspendedAt
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return &t.CreatedAt
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.CreatedBy
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.UpdatedBy
+}
+func (t *UpdateUser_UpdateUser_User_Setting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User_Setting{}
+	}
+	return t.SilencedAt
+}
+
+type UpdateUser_UpdateUser_User struct {
+	ID                   string                                             "json:\"id\" graphql:\"id\""
+	Email                string                                             "json:\"email\" graphql:\"email\""
+	FirstName            string                                             "json:\"firstName\" graphql:\"firstName\""
+	LastName             string                                             "json:\"lastName\" graphql:\"lastName\""
+	DisplayName          string                                             "json:\"displayName\" graphql:\"displayName\""
+	AvatarRemoteURL      *string                                            "json:\"avatarRemoteURL,omitempty\" graphql:\"avatarRemoteURL\""
+	AvatarLocalFile      *string                                            "json:\"avatarLocalFile,omitempty\" graphql:\"avatarLocalFile\""
+	Sub                  *string                                            "json:\"sub,omitempty\" graphql:\"sub\""
+	Oauth                bool                                               "json:\"oauth\" graphql:\"oauth\""
+	Groups               []*UpdateUser_UpdateUser_User_Groups               "json:\"groups,omitempty\" graphql:\"groups\""
+	Organizations        []*UpdateUser_UpdateUser_User_Organizations        "json:\"organizations,omitempty\" graphql:\"organizations\""
+	PersonalAccessTokens []*UpdateUser_UpdateUser_User_PersonalAccessTokens "json:\"personalAccessTokens,omitempty\" graphql:\"personalAccessTokens\""
+	Setting              UpdateUser_UpdateUser_User_Setting                 "json:\"setting\" graphql:\"setting\""
+}
+
+func (t *UpdateUser_UpdateUser_User) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.ID
+}
+func (t *UpdateUser_UpdateUser_User) GetEmail() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.Email
+}
+func (t *UpdateUser_UpdateUser_User) GetFirstName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.FirstName
+}
+func (t *UpdateUser_UpdateUser_User) GetLastName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.LastName
+}
+func (t *UpdateUser_UpdateUser_User) GetDisplayName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.DisplayName
+}
+func (t *UpdateUser_UpdateUser_User) GetAvatarRemoteURL() *string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.AvatarRemoteURL
+}
+func (t *UpdateUser_UpdateUser_User) GetAvatarLocalFile() *string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.AvatarLocalFile
+}
+func (t *UpdateUser_UpdateUser_User) GetSub() *string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.Sub
+}
+func (t *UpdateUser_UpdateUser_User) GetOauth() bool {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.Oauth
+}
+func (t *UpdateUser_UpdateUser_User) GetGroups() []*UpdateUser_UpdateUser_User_Groups {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.Groups
+}
+func (t *UpdateUser_UpdateUser_User) GetOrganizations() []*UpdateUser_UpdateUser_User_Organizations {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.Organizations
+}
+func (t *UpdateUser_UpdateUser_User) GetPersonalAccessTokens() []*UpdateUser_UpdateUser_User_PersonalAccessTokens {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return t.PersonalAccessTokens
+}
+func (t *UpdateUser_UpdateUser_User) GetSetting() *UpdateUser_UpdateUser_User_Setting {
+	if t == nil {
+		t = &UpdateUser_UpdateUser_User{}
+	}
+	return &t.Setting
+}
+
+type UpdateUser_UpdateUser struct {
+	User UpdateUser_UpdateUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *UpdateUser_UpdateUser) GetUser() *UpdateUser_UpdateUser_User {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return &t.User
+}
+
+type DeleteUser_DeleteUser struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *DeleteUser_DeleteUser) GetDeletedID() string {
+	if t == nil {
+		t = &DeleteUser_DeleteUser{}
+	}
+	return t.DeletedID
+}
+
+type UnlockUser_UnlockUser_UserSetting struct {
+	ID                  string             "json:\"id\" graphql:\"id\""
+	Locked              bool               "json:\"locked\" graphql:\"locked\""
+	Status              usersetting.Status "json:\"status\" graphql:\"status\""
+	FailedLoginAttempts int64              "json:\"failedLoginAttempts\" graphql:\"failedLoginAttempts\""
+}
+
+func (t *UnlockUser_UnlockUser_UserSetting) GetID() string {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.ID
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetFailedLoginAttempts() int64 {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.FailedLoginAttempts
+}
+
+type UnlockUser_UnlockUser struct {
+	UserSetting UnlockUser_UnlockUser_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *UnlockUser_UnlockUser) GetUserSetting() *UnlockUser_UnlockUser_UserSetting {
+	if t == nil {
+		t = &UnlockUser_UnlockUser{}
+	}
+	return &t.UserSetting
+}
+
+type MySessions_MySessions struct {
+	ID             string     "json:\"id\" graphql:\"id\""
+	IssuedAt       time.Time  "json:\"issuedAt\" graphql:\"issuedAt\""
+	ExpiresAt      time.Time  "json:\"expiresAt\" graphql:\"expiresAt\""
+	LastSeenAt     *time.Time "json:\"lastSeenAt,omitempty\" graphql:\"lastSeenAt\""
+	UserAgent      *string    "json:\"userAgent,omitempty\" graphql:\"userAgent\""
+	IPAddress      *string    "json:\"ipAddress,omitempty\" graphql:\"ipAddress\""
+	OrganizationID *string    "json:\"organizationID,omitempty\" graphql:\"organizationID\""
+}
+
+func (t *MySessions_MySessions) GetID() string {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.ID
+}
+func (t *MySessions_MySessions) GetIssuedAt() *time.Time {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return &t.IssuedAt
+}
+func (t *MySessions_MySessions) GetExpiresAt() *time.Time {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return &t.ExpiresAt
+}
+func (t *MySessions_MySessions) GetLastSeenAt() *time.Time {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.LastSeenAt
+}
+func (t *MySessions_MySessions) GetUserAgent() *string {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.UserAgent
+}
+func (t *MySessions_MySessions) GetIPAddress() *string {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.IPAddress
+}
+func (t *MySessions_MySessions) GetOrganizationID() *string {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.OrganizationID
+}
+
+type RevokeSession_RevokeSession_Session struct {
+	ID        string     "json:\"id\" graphql:\"id\""
+	RevokedAt *time.Time "json:\"revokedAt,omitempty\" graphql:\"revokedAt\""
+}
+
+func (t *RevokeSession_RevokeSession_Session) GetID() string {
+	if t == nil {
+		t = &RevokeSession_RevokeSession_Session{}
+	}
+	return t.ID
+}
+func (t *RevokeSession_RevokeSession_Session) GetRevokedAt() *time.Time {
+	if t == nil {
+		t = &RevokeSession_RevokeSession_Session{}
+	}
+	return t.RevokedAt
+}
+
+type RevokeSession_RevokeSession struct {
+	Session RevokeSession_RevokeSession_Session "json:\"session\" graphql:\"session\""
+}
+
+func (t *RevokeSession_RevokeSession) GetSession() *RevokeSession_RevokeSession_Session {
+	if t == nil {
+		t = &RevokeSession_RevokeSession{}
+	}
+	return &t.Session
+}
+
+type GetUserSettingByID_UserSetting struct {
+	ID             string             "json:\"id\" graphql:\"id\""
+	Permissions    []string           "json:\"permissions\" graphql:\"permissions\""
+	Role           usersetting.Role   "json:\"role\" graphql:\"role\""
+	SilencedAt     *time.Time         "json:\"silencedAt,omitempty\" graphql:\"silencedAt\""
+	Status         usersetting.Status "json:\"status\" graphql:\"status\""
+	SuspendedAt    *time.Time         "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	Tags           []string           "json:\"tags\" graphql:\"tags\""
+	Locked         bool               "json:\"locked\" graphql:\"locked\""
+	EmailConfirmed bool               "json:\"emailConfirmed\" graphql:\"emailConfirmed\""
+	IsTfaEnabled   bool               "json:\"isTfaEnabled\" graphql:\"isTfaEnabled\""
+	CreatedAt      time.Time          "json:\"createdAt\" graphql:\"createdAt\""
+	CreatedBy      *string            "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	DeletedAt      *time.Time         "json:\"deletedAt,omitempty\" graphql:\"deletedAt\""
+	DeletedBy      *string            "json:\"deletedBy,omitempty\" graphql:\"deletedBy\""
+	UpdatedAt      time.Time          "json:\"updatedAt\" graphql:\"updatedAt\""
+	UpdatedBy      *string            "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *GetUserSettingByID_UserSetting) GetID() string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.ID
+}
+func (t *GetUserSettingByID_UserSetting) GetPermissions() []string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.Permissions
+}
+func (t *GetUserSettingByID_UserSetting) GetRole() *usersetting.Role {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return &t.Role
+}
+func (t *GetUserSettingByID_UserSetting) GetSilencedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.SilencedAt
+}
+func (t *GetUserSettingByID_UserSetting) GetStatus() *usersetting.Status {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *GetUserSettingByID_UserSetting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.SuspendedAt
+}
+func (t *GetUserSettingByID_UserSetting) GetTags() []string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.Tags
+}
+func (t *GetUserSettingByID_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *GetUserSettingByID_UserSetting) GetEmailConfirmed() bool {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.EmailConfirmed
+}
+func (t *GetUserSettingByID_UserSetting) GetIsTfaEnabled() bool {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.IsTfaEnabled
+}
+func (t *GetUserSettingByID_UserSetting) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetUserSettingByID_UserSetting) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.CreatedBy
+}
+func (t *GetUserSettingByID_UserSetting) GetDeletedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.DeletedAt
+}
+func (t *GetUserSettingByID_UserSetting) GetDeletedBy() *string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.DeletedBy
+}
+func (t *GetUserSettingByID_UserSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetUserSettingByID_UserSetting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &GetUserSettingByID_UserSetting{}
+	}
+	return t.UpdatedBy
+}
+
+type GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner) GetID() string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner{}
+	}
+	return t.ID
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner{}
+	}
+	return t.DisplayName
+}
+
+type GetWebauthnCredentials_WebauthnCredentials_Edges_Node struct {
+	ID              string                                                      "json:\"id\" graphql:\"id\""
+	CreatedAt       time.Time                                                   "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt       time.Time                                                   "json:\"updatedAt\" graphql:\"updatedAt\""
+	Name            *string                                                     "json:\"name,omitempty\" graphql:\"name\""
+	AttestationType *string                                                     "json:\"attestationType,omitempty\" graphql:\"attestationType\""
+	Aaguid          *string                                                     "json:\"aaguid,omitempty\" graphql:\"aaguid\""
+	SignCount       int64                                                       "json:\"signCount\" graphql:\"signCount\""
+	Transports      []string                                                    "json:\"transports,omitempty\" graphql:\"transports\""
+	BackupEligible  bool                                                        "json:\"backupEligible\" graphql:\"backupEligible\""
+	BackupState     bool                                                        "json:\"backupState\" graphql:\"backupState\""
+	LastUsedAt      *time.Time                                                  "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner           GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetID() string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetName() *string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.Name
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetAttestationType() *string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.AttestationType
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetAaguid() *string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.Aaguid
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetSignCount() int64 {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.SignCount
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetTransports() []string {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.Transports
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetBackupEligible() bool {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.BackupEligible
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetBackupState() bool {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.BackupState
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return t.LastUsedAt
+}
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges_Node) GetOwner() *GetWebauthnCredentials_WebauthnCredentials_Edges_Node_Owner {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges_Node{}
+	}
+	return &t.Owner
+}
+
+type GetWebauthnCredentials_WebauthnCredentials_Edges struct {
+	Node *GetWebauthnCredentials_WebauthnCredentials_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetWebauthnCredentials_WebauthnCredentials_Edges) GetNode() *GetWebauthnCredentials_WebauthnCredentials_Edges_Node {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials_Edges{}
+	}
+	return t.Node
+}
+
+type GetWebauthnCredentials_WebauthnCredentials struct {
+	Edges []*GetWebauthnCredentials_WebauthnCredentials_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetWebauthnCredentials_WebauthnCredentials) GetEdges() []*GetWebauthnCredentials_WebauthnCredentials_Edges {
+	if t == nil {
+		t = &GetWebauthnCredentials_WebauthnCredentials{}
+	}
+	return t.Edges
+}
+
+type GetWebauthnCredentialByID_WebauthnCredential_Owner struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetWebauthnCredentialByID_WebauthnCredential_Owner) GetID() string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential_Owner{}
+	}
+	return t.ID
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential_Owner{}
+	}
+	return t.DisplayName
+}
+
+type GetWebauthnCredentialByID_WebauthnCredential struct {
+	ID              string                                             "json:\"id\" graphql:\"id\""
+	CreatedAt       time.Time                                          "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt       time.Time                                          "json:\"updatedAt\" graphql:\"updatedAt\""
+	Name            *string                                            "json:\"name,omitempty\" graphql:\"name\""
+	AttestationType *string                                            "json:\"attestationType,omitempty\" graphql:\"attestationType\""
+	Aaguid          *string                                            "json:\"aaguid,omitempty\" graphql:\"aaguid\""
+	SignCount       int64                                              "json:\"signCount\" graphql:\"signCount\""
+	Transports      []string                                           "json:\"transports,omitempty\" graphql:\"transports\""
+	BackupEligible  bool                                               "json:\"backupEligible\" graphql:\"backupEligible\""
+	BackupState     bool                                               "json:\"backupState\" graphql:\"backupState\""
+	LastUsedAt      *time.Time                                         "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Owner           GetWebauthnCredentialByID_WebauthnCredential_Owner "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetID() string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.ID
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return &t.CreatedAt
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return &t.UpdatedAt
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetName() *string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.Name
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetAttestationType() *string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.AttestationType
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetAaguid() *string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.Aaguid
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetSignCount() int64 {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.SignCount
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetTransports() []string {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.Transports
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetBackupEligible() bool {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.BackupEligible
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetBackupState() bool {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.BackupState
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return t.LastUsedAt
+}
+func (t *GetWebauthnCredentialByID_WebauthnCredential) GetOwner() *GetWebauthnCredentialByID_WebauthnCredential_Owner {
+	if t == nil {
+		t = &GetWebauthnCredentialByID_WebauthnCredential{}
+	}
+	return &t.Owner
+}
+
+type DeleteWebauthnCredential_DeleteWebauthnCredential struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *DeleteWebauthnCredential_DeleteWebauthnCredential) GetDeletedID() string {
+	if t == nil {
+		t = &DeleteWebauthnCredential_DeleteWebauthnCredential{}
+	}
+	return t.DeletedID
+}
+
+type CreateAPIKey struct {
+	CreateAPIKey CreateAPIKey_CreateAPIKey "json:\"createAPIKey\" graphql:\"createAPIKey\""
+}
+
+func (t *CreateAPIKey) GetCreateAPIKey() *CreateAPIKey_CreateAPIKey {
+	if t == nil {
+		t = &CreateAPIKey{}
+	}
+	return &t.CreateAPIKey
+}
+
+type GetAPIKeyByID struct {
+	APIKey GetAPIKeyByID_APIKey "json:\"apiKey\" graphql:\"apiKey\""
+}
+
+func (t *GetAPIKeyByID) GetAPIKey() *GetAPIKeyByID_APIKey {
+	if t == nil {
+		t = &GetAPIKeyByID{}
+	}
+	return &t.APIKey
+}
+
+type GetAPIKeys struct {
+	APIKeys GetAPIKeys_APIKeys "json:\"apiKeys\" graphql:\"apiKeys\""
+}
+
+func (t *GetAPIKeys) GetAPIKeys() *GetAPIKeys_APIKeys {
+	if t == nil {
+		t = &GetAPIKeys{}
+	}
+	return &t.APIKeys
+}
+
+type DeleteAPIKey struct {
+	DeleteAPIKey DeleteAPIKey_DeleteAPIKey "json:\"deleteAPIKey\" graphql:\"deleteAPIKey\""
+}
+
+func (t *DeleteAPIKey) GetDeleteAPIKey() *DeleteAPIKey_DeleteAPIKey {
+	if t == nil {
+		t = &DeleteAPIKey{}
+	}
+	return &t.DeleteAPIKey
+}
+
+type GetGroupByID struct {
+	Group GetGroupByID_Group "json:\"group\" graphql:\"group\""
+}
+
+func (t *GetGroupByID) GetGroup() *GetGroupByID_Group {
+	if t == nil {
+		t = &GetGroupByID{}
+	}
+	return &t.Group
+}
+
+type GroupsWhere struct {
+	Groups GroupsWhere_Groups "json:\"groups\" graphql:\"groups\""
+}
+
+func (t *GroupsWhere) GetGroups() *GroupsWhere_Groups {
+	if t == nil {
+		t = &GroupsWhere{}
+	}
+	return &t.Groups
+}
+
+type GetAllGroups struct {
+	Groups GetAllGroups_Groups "json:\"groups\" graphql:\"groups\""
+}
+
+func (t *GetAllGroups) GetGroups() *GetAllGroups_Groups {
+	if t == nil {
+		t = &GetAllGroups{}
+	}
+	return &t.Groups
+}
+
+type CreateGroup struct {
+	CreateGroup CreateGroup_CreateGroup "json:\"createGroup\" graphql:\"createGroup\""
+}
+
+func (t *CreateGroup) GetCreateGroup() *CreateGroup_CreateGroup {
+	if t == nil {
+		t = &CreateGroup{}
+	}
+	return &t.CreateGroup
+}
+
+type UpdateGroup struct {
+	UpdateGroup UpdateGroup_UpdateGroup "json:\"updateGroup\" graphql:\"updateGroup\""
+}
+
+func (t *UpdateGroup) GetUpdateGroup() *UpdateGroup_UpdateGroup {
+	if t == nil {
+		t = &UpdateGroup{}
+	}
+	return &t.UpdateGroup
+}
+
+type DeleteGroup struct {
+	DeleteGroup DeleteGroup_DeleteGroup "json:\"deleteGroup\" graphql:\"deleteGroup\""
+}
+
+func (t *DeleteGroup) GetDeleteGroup() *DeleteGroup_DeleteGroup {
+	if t == nil {
+		t = &DeleteGroup{}
+	}
+	return &t.DeleteGroup
+}
+
+type RequestToJoinGroup struct {
+	RequestToJoinGroup RequestToJoinGroup_RequestToJoinGroup "json:\"requestToJoinGroup\" graphql:\"requestToJoinGroup\""
+}
+
+func (t *RequestToJoinGroup) GetRequestToJoinGroup() *RequestToJoinGroup_RequestToJoinGroup {
+	if t == nil {
+		t = &RequestToJoinGroup{}
+	}
+	return &t.RequestToJoinGroup
+}
+
+type ApproveGroupJoinRequest struct {
+	ApproveGroupJoinRequest ApproveGroupJoinRequest_ApproveGroupJoinRequest "json:\"approveGroupJoinRequest\" graphql:\"approveGroupJoinRequest\""
+}
+
+func (t *ApproveGroupJoinRequest) GetApproveGroupJoinRequest() *ApproveGroupJoinRequest_ApproveGroupJoinRequest {
+	if t == nil {
+		t = &ApproveGroupJoinRequest{}
+	}
+	return &t.ApproveGroupJoinRequest
+}
+
+type DeclineGroupJoinRequest struct {
+	DeclineGroupJoinRequest DeclineGroupJoinRequest_DeclineGroupJoinRequest "json:\"declineGroupJoinRequest\" graphql:\"declineGroupJoinRequest\""
+}
+
+func (t *DeclineGroupJoinRequest) GetDeclineGroupJoinRequest() *DeclineGroupJoinRequest_DeclineGroupJoinRequest {
+	if t == nil {
+		t = &DeclineGroupJoinRequest{}
+	}
+	return &t.DeclineGroupJoinRequest
+}
+
+type DeleteGroupJoinRequest struct {
+	DeleteGroupJoinRequest DeleteGroupJoinRequest_DeleteGroupJoinRequest "json:\"deleteGroupJoinRequest\" graphql:\"deleteGroupJoinRequest\""
+}
+
+func (t *DeleteGroupJoinRequest) GetDeleteGroupJoinRequest() *DeleteGroupJoinRequest_DeleteGroupJoinRequest {
+	if t == nil {
+		t = &DeleteGroupJoinRequest{}
+	}
+	return &t.DeleteGroupJoinRequest
+}
+
+type GetGroupJoinRequestByID struct {
+	GroupJoinRequest GetGroupJoinRequestByID_GroupJoinRequest "json:\"groupJoinRequest\" graphql:\"groupJoinRequest\""
+}
+
+func (t *GetGroupJoinRequestByID) GetGroupJoinRequest() *GetGroupJoinRequestByID_GroupJoinRequest {
+	if t == nil {
+		t = &GetGroupJoinRequestByID{}
+	}
+	return &t.GroupJoinRequest
+}
+
+type AddUserToGroup struct {
+	AddUserToGroup AddUserToGroup_AddUserToGroup "json:\"addUserToGroup\" graphql:\"addUserToGroup\""
+}
+
+func (t *AddUserToGroup) GetAddUserToGroup() *AddUserToGroup_AddUserToGroup {
+	if t == nil {
+		t = &AddUserToGroup{}
+	}
+	return &t.AddUserToGroup
+}
+
+type JoinGroup struct {
+	JoinGroup JoinGroup_JoinGroup "json:\"joinGroup\" graphql:\"joinGroup\""
+}
+
+func (t *JoinGroup) GetJoinGroup() *JoinGroup_JoinGroup {
+	if t == nil {
+		t = &JoinGroup{}
+	}
+	return &t.JoinGroup
+}
+
+type UpdateGroupMemberRole struct {
+	UpdateGroupMemberRole UpdateGroupMemberRole_UpdateGroupMemberRole "json:\"updateGroupMemberRole\" graphql:\"updateGroupMemberRole\""
+}
+
+func (t *UpdateGroupMemberRole) GetUpdateGroupMemberRole() *UpdateGroupMemberRole_UpdateGroupMemberRole {
+	if t == nil {
+		t = &UpdateGroupMemberRole{}
+	}
+	return &t.UpdateGroupMemberRole
+}
+
+type RemoveUserFromGroup struct {
+	RemoveUserFromGroup RemoveUserFromGroup_RemoveUserFromGroup "json:\"removeUserFromGroup\" graphql:\"removeUserFromGroup\""
+}
+
+func (t *RemoveUserFromGroup) GetRemoveUserFromGroup() *RemoveUserFromGroup_RemoveUserFromGroup {
+	if t == nil {
+		t = &RemoveUserFromGroup{}
+	}
+	return &t.RemoveUserFromGroup
+}
+
+type GetGroupMembershipByID struct {
+	GroupMembership GetGroupMembershipByID_GroupMembership "json:\"groupMembership\" graphql:\"groupMembership\""
+}
+
+func (t *GetGroupMembershipByID) GetGroupMembership() *GetGroupMembershipByID_GroupMembership {
+	if t == nil {
+		t = &GetGroupMembershipByID{}
+	}
+	return &t.GroupMembership
+}
+
+type GetGroupMembers struct {
+	Group GetGroupMembers_Group "json:\"group\" graphql:\"group\""
+}
+
+func (t *GetGroupMembers) GetGroup() *GetGroupMembers_Group {
+	if t == nil {
+		t = &GetGroupMembers{}
+	}
+	return &t.Group
+}
+
+type GetGroupSetting struct {
+	GroupSetting GetGroupSetting_GroupSetting "json:\"groupSetting\" graphql:\"groupSetting\""
+}
+
+func (t *GetGroupSetting) GetGroupSetting() *GetGroupSetting_GroupSetting {
+	if t == nil {
+		t = &GetGroupSetting{}
+	}
+	return &t.GroupSetting
+}
+
+type CreateInvite struct {
+	CreateInvite CreateInvite_CreateInvite "json:\"createInvite\" graphql:\"createInvite\""
+}
+
+func (t *CreateInvite) GetCreateInvite() *CreateInvite_CreateInvite {
+	if t == nil {
+		t = &CreateInvite{}
+	}
+	return &t.CreateInvite
+}
+
+type RevokeInvite struct {
+	RevokeInvite RevokeInvite_RevokeInvite "json:\"revokeInvite\" graphql:\"revokeInvite\""
+}
+
+func (t *RevokeInvite) GetRevokeInvite() *RevokeInvite_RevokeInvite {
+	if t == nil {
+		t = &RevokeInvite{}
+	}
+	return &t.RevokeInvite
+}
+
+type GetInviteByID struct {
+	Invite GetInviteByID_Invite "json:\"invite\" graphql:\"invite\""
+}
+
+func (t *GetInviteByID) GetInvite() *GetInviteByID_Invite {
+	if t == nil {
+		t = &GetInviteByID{}
+	}
+	return &t.Invite
+}
+
+type GetInvites struct {
+	Invites GetInvites_Invites "json:\"invites\" graphql:\"invites\""
+}
+
+func (t *GetInvites) GetInvites() *GetInvites_Invites {
+	if t == nil {
+		t = &GetInvites{}
+	}
+	return &t.Invites
+}
+
+type CreateOauthClient struct {
+	CreateOauthClient CreateOauthClient_CreateOauthClient "json:\"createOauthClient\" graphql:\"createOauthClient\""
+}
+
+func (t *CreateOauthClient) GetCreateOauthClient() *CreateOauthClient_CreateOauthClient {
+	if t == nil {
+		t = &CreateOauthClient{}
+	}
+	return &t.CreateOauthClient
+}
+
+type UpdateOauthClient struct {
+	UpdateOauthClient UpdateOauthClient_UpdateOauthClient "json:\"updateOauthClient\" graphql:\"updateOauthClient\""
+}
+
+func (t *UpdateOauthClient) GetUpdateOauthClient() *UpdateOauthClient_UpdateOauthClient {
+	if t == nil {
+		t = &UpdateOauthClient{}
+	}
+	return &t.UpdateOauthClient
+}
+
+type GetOauthClientByID struct {
+	OauthClient GetOauthClientByID_OauthClient "json:\"oauthClient\" graphql:\"oauthClient\""
+}
+
+func (t *GetOauthClientByID) GetOauthClient() *GetOauthClientByID_OauthClient {
+	if t == nil {
+		t = &GetOauthClientByID{}
+	}
+	return &t.OauthClient
+}
+
+type GetOauthClients struct {
+	OauthClients GetOauthClients_OauthClients "json:\"oauthClients\" graphql:\"oauthClients\""
+}
+
+func (t *GetOauthClients) GetOauthClients() *GetOauthClients_OauthClients {
+	if t == nil {
+		t = &GetOauthClients{}
+	}
+	return &t.OauthClients
+}
+
+type DeleteOauthClient struct {
+	DeleteOauthClient DeleteOauthClient_DeleteOauthClient "json:\"deleteOauthClient\" graphql:\"deleteOauthClient\""
+}
+
+func (t *DeleteOauthClient) GetDeleteOauthClient() *DeleteOauthClient_DeleteOauthClient {
+	if t == nil {
+		t = &DeleteOauthClient{}
+	}
+	return &t.DeleteOauthClient
+}
+
+type GetOrganizationByID struct {
+	Organization GetOrganizationByID_Organization "json:\"organization\" graphql:\"organization\""
+}
+
+func (t *GetOrganizationByID) GetOrganization() *GetOrganizationByID_Organization {
+	if t == nil {
+		t = &GetOrganizationByID{}
+	}
+	return &t.Organization
+}
+
+type GetAllOrganizations struct {
+	Organizations GetAllOrganizations_Organizations "json:\"organizations\" graphql:\"organizations\""
+}
+
+func (t *GetAllOrganizations) GetOrganizations() *GetAllOrganizations_Organizations {
+	if t == nil {
+		t = &GetAllOrganizations{}
+	}
+	return &t.Organizations
+}
+
+type OrganizationsWhere struct {
+	Organizations OrganizationsWhere_Organizations "json:\"organizations\" graphql:\"organizations\""
+}
+
+func (t *OrganizationsWhere) GetOrganizations() *OrganizationsWhere_Organizations {
+	if t == nil {
+		t = &OrganizationsWhere{}
+	}
+	return &t.Organizations
+}
+
+type GetOrganizationAncestors struct {
+	OrganizationAncestors []*GetOrganizationAncestors_OrganizationAncestors "json:\"organizationAncestors\" graphql:\"organizationAncestors\""
+}
+
+func (t *GetOrganizationAncestors) GetOrganizationAncestors() []*GetOrganizationAncestors_OrganizationAncestors {
+	if t == nil {
+		t = &GetOrganizationAncestors{}
+	}
+	return t.OrganizationAncestors
+}
+
+type GetOrganizationDescendants struct {
+	OrganizationDescendants []*GetOrganizationDescendants_OrganizationDescendants "json:\"organizationDescendants\" graphql:\"organizationDescendants\""
+}
+
+func (t *GetOrganizationDescendants) GetOrganizationDescendants() []*GetOrganizationDescendants_OrganizationDescendants {
+	if t == nil {
+		t = &GetOrganizationDescendants{}
+	}
+	return t.OrganizationDescendants
+}
+
+type CreateOrganization struct {
+	CreateOrganization CreateOrganization_CreateOrganization "json:\"createOrganization\" graphql:\"createOrganization\""
+}
+
+func (t *CreateOrganization) GetCreateOrganization() *CreateOrganization_CreateOrganization {
+	if t == nil {
+		t = &CreateOrganization{}
+	}
+	return &t.CreateOrganization
+}
+
+type UpdateOrganization struct {
+	UpdateOrganization UpdateOrganization_UpdateOrganization "json:\"updateOrganization\" graphql:\"updateOrganization\""
+}
+
+func (t *UpdateOrganization) GetUpdateOrganization() *UpdateOrganization_UpdateOrganization {
+	if t == nil {
+		t = &UpdateOrganization{}
+	}
+	return &t.UpdateOrganization
+}
+
+type MoveOrganization struct {
+	MoveOrganization MoveOrganization_MoveOrganization "json:\"moveOrganization\" graphql:\"moveOrganization\""
+}
+
+func (t *MoveOrganization) GetMoveOrganization() *MoveOrganization_MoveOrganization {
+	if t == nil {
+		t = &MoveOrganization{}
+	}
+	return &t.MoveOrganization
+}
+
+type DeleteOrganization struct {
+	DeleteOrganization DeleteOrganization_DeleteOrganization "json:\"deleteOrganization\" graphql:\"deleteOrganization\""
+}
+
+func (t *DeleteOrganization) GetDeleteOrganization() *DeleteOrganization_DeleteOrganization {
+	if t == nil {
+		t = &DeleteOrganization{}
+	}
+	return &t.DeleteOrganization
+}
+
+type GetOrganizationSetting struct {
+	OrganizationSetting GetOrganizationSetting_OrganizationSetting "json:\"organizationSetting\" graphql:\"organizationSetting\""
+}
+
+func (t *GetOrganizationSetting) GetOrganizationSetting() *GetOrganizationSetting_OrganizationSetting {
+	if t == nil {
+		t = &GetOrganizationSetting{}
+	}
+	return &t.OrganizationSetting
+}
+
+type AddUserToOrganization struct {
+	AddUserToOrganization AddUserToOrganization_AddUserToOrganization "json:\"addUserToOrganization\" graphql:\"addUserToOrganization\""
+}
+
+func (t *AddUserToOrganization) GetAddUserToOrganization() *AddUserToOrganization_AddUserToOrganization {
+	if t == nil {
+		t = &AddUserToOrganization{}
+	}
+	return &t.AddUserToOrganization
+}
+
+type UpdateOrgMemberRole struct {
+	UpdateOrgMemberRole UpdateOrgMemberRole_UpdateOrgMemberRole "json:\"updateOrgMemberRole\" graphql:\"updateOrgMemberRole\""
+}
+
+func (t *UpdateOrgMemberRole) GetUpdateOrgMemberRole() *UpdateOrgMemberRole_UpdateOrgMemberRole {
+	if t == nil {
+		t = &UpdateOrgMemberRole{}
+	}
+	return &t.UpdateOrgMemberRole
+}
+
+type RemoveUserFromOrganization struct {
+	RemoveUserFromOrganization RemoveUserFromOrganization_RemoveUserFromOrganization "json:\"removeUserFromOrganization\" graphql:\"removeUserFromOrganization\""
+}
+
+func (t *RemoveUserFromOrganization) GetRemoveUserFromOrganization() *RemoveUserFromOrganization_RemoveUserFromOrganization {
+	if t == nil {
+		t = &RemoveUserFromOrganization{}
+	}
+	return &t.RemoveUserFromOrganization
+}
+
+type GetOrgMembershipByID struct {
+	OrgMembership GetOrgMembershipByID_OrgMembership "json:\"orgMembership\" graphql:\"orgMembership\""
+}
+
+func (t *GetOrgMembershipByID) GetOrgMembership() *GetOrgMembershipByID_OrgMembership {
+	if t == nil {
+		t = &GetOrgMembershipByID{}
+	}
+	return &t.OrgMembership
+}
+
+type GetOrgMembers struct {
+	Organization GetOrgMembers_Organization "json:\"organization\" graphql:\"organization\""
+}
+
+func (t *GetOrgMembers) GetOrganization() *GetOrgMembers_Organization {
+	if t == nil {
+		t = &GetOrgMembers{}
+	}
+	return &t.Organization
+}
+
+type TransferOrganizationOwnership struct {
+	TransferOrganizationOwnership TransferOrganizationOwnership_TransferOrganizationOwnership "json:\"transferOrganizationOwnership\" graphql:\"transferOrganizationOwnership\""
+}
+
+func (t *TransferOrganizationOwnership) GetTransferOrganizationOwnership() *TransferOrganizationOwnership_TransferOrganizationOwnership {
+	if t == nil {
+		t = &TransferOrganizationOwnership{}
+	}
+	return &t.TransferOrganizationOwnership
+}
+
+type TransferGroupOwnership struct {
+	TransferGroupOwnership TransferGroupOwnership_TransferGroupOwnership "json:\"transferGroupOwnership\" graphql:\"transferGroupOwnership\""
+}
+
+func (t *TransferGroupOwnership) GetTransferGroupOwnership() *TransferGroupOwnership_TransferGroupOwnership {
+	if t == nil {
+		t = &TransferGroupOwnership{}
+	}
+	return &t.TransferGroupOwnership
+}
+
+type CancelOwnershipTransfer struct {
+	CancelOwnershipTransfer CancelOwnershipTransfer_CancelOwnershipTransfer "json:\"cancelOwnershipTransfer\" graphql:\"cancelOwnershipTransfer\""
+}
+
+func (t *CancelOwnershipTransfer) GetCancelOwnershipTransfer() *CancelOwnershipTransfer_CancelOwnershipTransfer {
+	if t == nil {
+		t = &CancelOwnershipTransfer{}
+	}
+	return &t.CancelOwnershipTransfer
+}
+
+type GetOwnershipTransferByID struct {
+	OwnershipTransfer GetOwnershipTransferByID_OwnershipTransfer "json:\"ownershipTransfer\" graphql:\"ownershipTransfer\""
+}
+
+func (t *GetOwnershipTransferByID) GetOwnershipTransfer() *GetOwnershipTransferByID_OwnershipTransfer {
+	if t == nil {
+		t = &GetOwnershipTransferByID{}
+	}
+	return &t.OwnershipTransfer
+}
+
+type GetOwnershipTransfers struct {
+	OwnershipTransfers GetOwnershipTransfers_OwnershipTransfers "json:\"ownershipTransfers\" graphql:\"ownershipTransfers\""
+}
+
+func (t *GetOwnershipTransfers) GetOwnershipTransfers() *GetOwnershipTransfers_OwnershipTransfers {
+	if t == nil {
+		t = &GetOwnershipTransfers{}
+	}
+	return &t.OwnershipTransfers
+}
+
+type CreatePersonalAccessToken struct {
+	CreatePersonalAccessToken CreatePersonalAccessToken_CreatePersonalAccessToken "json:\"createPersonalAccessToken\" graphql:\"createPersonalAccessToken\""
+}
+
+func (t *CreatePersonalAccessToken) GetCreatePersonalAccessToken() *CreatePersonalAccessToken_CreatePersonalAccessToken {
+	if t == nil {
+		t = &CreatePersonalAccessToken{}
+	}
+	return &t.CreatePersonalAccessToken
+}
+
+type GetPersonalAccessTokenByID struct {
+	PersonalAccessToken GetPersonalAccessTokenByID_PersonalAccessToken "json:\"personalAccessToken\" graphql:\"personalAccessToken\""
+}
+
+func (t *GetPersonalAccessTokenByID) GetPersonalAccessToken() *GetPersonalAccessTokenByID_PersonalAccessToken {
+	if t == nil {
+		t = &GetPersonalAccessTokenByID{}
+	}
+	return &t.PersonalAccessToken
+}
+
+type DeletePersonalAccessToken struct {
+	DeletePersonalAccessToken DeletePersonalAccessToken_DeletePersonalAccessToken "json:\"deletePersonalAccessToken\" graphql:\"deletePersonalAccessToken\""
+}
+
+func (t *DeletePersonalAccessToken) GetDeletePersonalAccessToken() *DeletePersonalAccessToken_DeletePersonalAccessToken {
+	if t == nil {
+		t = &DeletePersonalAccessToken{}
+	}
+	return &t.DeletePersonalAccessToken
+}
+
+type UpdatePersonalAccessToken struct {
+	UpdatePersonalAccessToken UpdatePersonalAccessToken_UpdatePersonalAccessToken "json:\"updatePersonalAccessToken\" graphql:\"updatePersonalAccessToken\""
+}
+
+func (t *UpdatePersonalAccessToken) GetUpdatePersonalAccessToken() *UpdatePersonalAccessToken_UpdatePersonalAccessToken {
+	if t == nil {
+		t = &UpdatePersonalAccessToken{}
+	}
+	return &t.UpdatePersonalAccessToken
+}
+
+type EnrollTfa struct {
+	EnrollTfa EnrollTFA_EnrollTfa "json:\"enrollTFA\" graphql:\"enrollTFA\""
+}
+
+func (t *EnrollTfa) GetEnrollTfa() *EnrollTFA_EnrollTfa {
+	if t == nil {
+		t = &EnrollTfa{}
+	}
+	return &t.EnrollTfa
+}
+
+type ConfirmTfa struct {
+	ConfirmTfa ConfirmTFA_ConfirmTfa "json:\"confirmTFA\" graphql:\"confirmTFA\""
+}
+
+func (t *ConfirmTfa) GetConfirmTfa() *ConfirmTFA_ConfirmTfa {
+	if t == nil {
+		t = &ConfirmTfa{}
+	}
+	return &t.ConfirmTfa
+}
+
+type DisableTfa struct {
+	DisableTfa DisableTFA_DisableTfa "json:\"disableTFA\" graphql:\"disableTFA\""
+}
+
+func (t *DisableTfa) GetDisableTfa() *DisableTFA_DisableTfa {
+	if t == nil {
+		t = &DisableTfa{}
+	}
+	return &t.DisableTfa
+}
+
+type GetUserByID struct {
+	User GetUserByID_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetUserByID) GetUser() *GetUserByID_User {
+	if t == nil {
+		t = &GetUserByID{}
+	}
+	return &t.User
+}
+
+type GetUserByIDWithOrgs struct {
+	User GetUserByIDWithOrgs_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetUserByIDWithOrgs) GetUser() *GetUserByIDWithOrgs_User {
+	if t == nil {
+		t = &GetUserByIDWithOrgs{}
+	}
+	return &t.User
+}
+
+type GetAllUsers struct {
+	Users GetAllUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *GetAllUsers) GetUsers() *GetAllUsers_Users {
+	if t == nil {
+		t = &GetAllUsers{}
+	}
+	return &t.Users
+}
+
+type CreateUser struct {
+	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
+}
+
+func (t *CreateUser) GetCreateUser() *CreateUser_CreateUser {
+	if t == nil {
+		t = &CreateUser{}
+	}
+	return &t.CreateUser
+}
+
+type UpdateUser struct {
+	UpdateUser UpdateUser_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUser) GetUpdateUser() *UpdateUser_UpdateUser {
+	if t == nil {
+		t = &UpdateUser{}
+	}
+	return &t.UpdateUser
+}
+
+type DeleteUser struct {
+	DeleteUser DeleteUser_DeleteUser "json:\"deleteUser\" graphql:\"deleteUser\""
+}
+
+func (t *DeleteUser) GetDeleteUser() *DeleteUser_DeleteUser {
+	if t == nil {
+		t = &DeleteUser{}
+	}
+	return &t.DeleteUser
+}
+
+type UnlockUser struct {
+	UnlockUser UnlockUser_UnlockUser "json:\"unlockUser\" graphql:\"unlockUser\""
+}
+
+func (t *UnlockUser) GetUnlockUser() *UnlockUser_UnlockUser {
+	if t == nil {
+		t = &UnlockUser{}
+	}
+	return &t.UnlockUser
+}
+
+type MySessions struct {
+	MySessions []*MySessions_MySessions "json:\"mySessions\" graphql:\"mySessions\""
+}
+
+func (t *MySessions) GetMySessions() []*MySessions_MySessions {
+	if t == nil {
+		t = &MySessions{}
+	}
+	return t.MySessions
+}
+
+type RevokeSession struct {
+	RevokeSession RevokeSession_RevokeSession "json:\"revokeSession\" graphql:\"revokeSession\""
+}
+
+func (t *RevokeSession) GetRevokeSession() *RevokeSession_RevokeSession {
+	if t == nil {
+		t = &RevokeSession{}
+	}
+	return &t.RevokeSession
+}
+
+type GetUserSettingByID struct {
+	UserSetting GetUserSettingByID_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *GetUserSettingByID) GetUserSetting() *GetUserSettingByID_UserSetting {
+	if t == nil {
+		t = &GetUserSettingByID{}
+	}
+	return &t.UserSetting
+}
+
+type GetWebauthnCredentials struct {
+	WebauthnCredentials GetWebauthnCredentials_WebauthnCredentials "json:\"webauthnCredentials\" graphql:\"webauthnCredentials\""
+}
+
+func (t *GetWebauthnCredentials) GetWebauthnCredentials() *GetWebauthnCredentials_WebauthnCredentials {
+	if t == nil {
+		t = &GetWebauthnCredentials{}
+	}
+	return &t.WebauthnCredentials
+}
+
+type GetWebauthnCredentialByID struct {
+	WebauthnCredential GetWebauthnCredentialByID_WebauthnCredential "json:\"webauthnCredential\" graphql:\"webauthnCredential\""
+}
+
+func (t *GetWebauthnCredentialByID) GetWebauthnCredential() *GetWebauthnCredentialByID_WebauthnCredential {
+	if t == nil {
+		t = &GetWebauthnCredentialByID{}
+	}
+	return &t.WebauthnCredential
+}
+
+type DeleteWebauthnCredential struct {
+	DeleteWebauthnCredential DeleteWebauthnCredential_DeleteWebauthnCredential "json:\"deleteWebauthnCredential\" graphql:\"deleteWebauthnCredential\""
+}
+
+func (t *DeleteWebauthnCredential) GetDeleteWebauthnCredential() *DeleteWebauthnCredential_DeleteWebauthnCredential {
+	if t == nil {
+		t = &DeleteWebauthnCredential{}
+	}
+	return &t.DeleteWebauthnCredential
+}
+
+const CreateAPIKeyDocument = `mutation CreateAPIKey ($input: CreateAPIKeyInput!) {
+	createAPIKey(input: $input) {
+		apiKey {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			name
+			keyPrefix
+			abilities
+			expiresAt
+			description
+			lastUsedAt
+			owner {
+				id
+				name
+			}
+		}
+		key
+	}
+}
+`
+
+func (c *Client) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput, interceptors ...clientv2.RequestInterceptor) (*CreateAPIKey, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res CreateAPIKey
+	if err := c.Client.Post(ctx, "CreateAPIKey", CreateAPIKeyDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAPIKeyByIDDocument = `query GetAPIKeyByID ($apiKeyId: ID!) {
+	apiKey(id: $apiKeyId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		name
+		keyPrefix
+		abilities
+		expiresAt
+		description
+		lastUsedAt
+		owner {
+			id
+			name
+		}
+	}
+}
+`
+
+func (c *Client) GetAPIKeyByID(ctx context.Context, apiKeyID string, interceptors ...clientv2.RequestInterceptor) (*GetAPIKeyByID, error) {
+	vars := map[string]interface{}{
+		"apiKeyId": apiKeyID,
+	}
+
+	var res GetAPIKeyByID
+	if err := c.Client.Post(ctx, "GetAPIKeyByID", GetAPIKeyByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAPIKeysDocument = `query GetAPIKeys {
+	apiKeys {
+		edges {
+			node {
+				id
+				name
+				keyPrefix
+				abilities
+				expiresAt
+				description
+				lastUsedAt
+				owner {
+					id
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetAPIKeys(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAPIKeys, error) {
+	vars := map[string]interface{}{}
+
+	var res GetAPIKeys
+	if err := c.Client.Post(ctx, "GetAPIKeys", GetAPIKeysDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteAPIKeyDocument = `mutation DeleteAPIKey ($deleteAPIKeyId: ID!) {
+	deleteAPIKey(id: $deleteAPIKeyId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteAPIKey(ctx context.Context, deleteAPIKeyID string, interceptors ...clientv2.RequestInterceptor) (*DeleteAPIKey, error) {
+	vars := map[string]interface{}{
+		"deleteAPIKeyId": deleteAPIKeyID,
+	}
+
+	var res DeleteAPIKey
+	if err := c.Client.Post(ctx, "DeleteAPIKey", DeleteAPIKeyDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetGroupByIDDocument = `query GetGroupByID ($groupId: ID!) {
+	group(id: $groupId) {
+		id
+		name
+		description
+		displayName
+		owner {
+			id
+		}
+		logoURL
+		setting {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			visibility
+			joinPolicy
+			syncToSlack
+			syncToGithub
+			tags
+		}
+		createdAt
+		createdBy
+		updatedAt
+		updatedBy
+	}
+}
+`
+
+func (c *Client) GetGroupByID(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupByID, error) {
+	vars := map[string]interface{}{
+		"groupId": groupID,
+	}
+
+	var res GetGroupByID
+	if err := c.Client.Post(ctx, "GetGroupByID", GetGroupByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GroupsWhereDocument = `query GroupsWhere ($where: GroupWhereInput) {
+	groups(where: $where) {
+		edges {
+			node {
+				id
+				name
+				description
+				displayName
+				owner {
+					id
+				}
+				logoURL
+				setting {
+					id
+					createdAt
+					updatedAt
+					createdBy
+					updatedBy
+					visibility
+					joinPolicy
+					syncToSlack
+					syncToGithub
+					tags
+				}
+				createdAt
+				createdBy
+				updatedAt
+				updatedBy
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GroupsWhere(ctx context.Context, where *GroupWhereInput, interceptors ...clientv2.RequestInterceptor) (*GroupsWhere, error) {
+	vars := map[string]interface{}{
+		"where": where,
+	}
+
+	var res GroupsWhere
+	if err := c.Client.Post(ctx, "GroupsWhere", GroupsWhereDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAllGroupsDocument = `query GetAllGroups {
+	groups {
+		edges {
+			node {
+				id
+				name
+				description
+				displayName
+				owner {
+					id
+				}
+				logoURL
+				setting {
+					id
+					createdAt
+					updatedAt
+					createdBy
+					updatedBy
+					visibility
+					joinPolicy
+					syncToSlack
+					syncToGithub
+					tags
+				}
+				createdAt
+				createdBy
+				updatedAt
+				updatedBy
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetAllGroups(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllGroups, error) {
+	vars := map[string]interface{}{}
+
+	var res GetAllGroups
+	if err := c.Client.Post(ctx, "GetAllGroups", GetAllGroupsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateGroupDocument = `mutation CreateGroup ($input: CreateGroupInput!) {
+	createGroup(input: $input) {
+		group {
+			id
+			name
+			description
+			displayName
+			owner {
+				id
+			}
+			logoURL
+			setting {
+				id
+				createdAt
+				updatedAt
+				createdBy
+				updatedBy
+				visibility
+				joinPolicy
+				syncToSlack
+				syncToGithub
+				tags
+			}
+			createdAt
+			createdBy
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) CreateGroup(ctx context.Context, input CreateGroupInput, interceptors ...clientv2.RequestInterceptor) (*CreateGroup, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res CreateGroup
+	if err := c.Client.Post(ctx, "CreateGroup", CreateGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateGroupDocument = `mutation UpdateGroup ($updateGroupId: ID!, $input: UpdateGroupInput!) {
+	updateGroup(id: $updateGroupId, input: $input) {
+		group {
+			id
+			name
+			displayName
+			description
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) UpdateGroup(ctx context.Context, updateGroupID string, input UpdateGroupInput, interceptors ...clientv2.RequestInterceptor) (*UpdateGroup, error) {
+	vars := map[string]interface{}{
+		"updateGroupId": updateGroupID,
+		"input":         input,
+	}
+
+	var res UpdateGroup
+	if err := c.Client.Post(ctx, "UpdateGroup", UpdateGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteGroupDocument = `mutation DeleteGroup ($deleteGroupId: ID!) {
+	deleteGroup(id: $deleteGroupId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteGroup(ctx context.Context, deleteGroupID string, interceptors ...clientv2.RequestInterceptor) (*DeleteGroup, error) {
+	vars := map[string]interface{}{
+		"deleteGroupId": deleteGroupID,
+	}
+
+	var res DeleteGroup
+	if err := c.Client.Post(ctx, "DeleteGroup", DeleteGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RequestToJoinGroupDocument = `mutation RequestToJoinGroup ($input: CreateGroupJoinRequestInput!) {
+	requestToJoinGroup(input: $input) {
+		groupJoinRequest {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			groupID
+			userID
+			message
+			status
+			reviewerID
+		}
+	}
+}
+`
+
+func (c *Client) RequestToJoinGroup(ctx context.Context, input CreateGroupJoinRequestInput, interceptors ...clientv2.RequestInterceptor) (*RequestToJoinGroup, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res RequestToJoinGroup
+	if err := c.Client.Post(ctx, "RequestToJoinGroup", RequestToJoinGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ApproveGroupJoinRequestDocument = `mutation ApproveGroupJoinRequest ($approveGroupJoinRequestId: ID!) {
+	approveGroupJoinRequest(id: $approveGroupJoinRequestId) {
+		groupJoinRequest {
+			id
+			groupID
+			userID
+			status
+			reviewerID
+		}
+	}
+}
+`
+
+func (c *Client) ApproveGroupJoinRequest(ctx context.Context, approveGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*ApproveGroupJoinRequest, error) {
+	vars := map[string]interface{}{
+		"approveGroupJoinRequestId": approveGroupJoinRequestID,
+	}
+
+	var res ApproveGroupJoinRequest
+	if err := c.Client.Post(ctx, "ApproveGroupJoinRequest", ApproveGroupJoinRequestDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeclineGroupJoinRequestDocument = `mutation DeclineGroupJoinRequest ($declineGroupJoinRequestId: ID!) {
+	declineGroupJoinRequest(id: $declineGroupJoinRequestId) {
+		groupJoinRequest {
+			id
+			groupID
+			userID
+			status
+			reviewerID
+		}
+	}
+}
+`
+
+func (c *Client) DeclineGroupJoinRequest(ctx context.Context, declineGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*DeclineGroupJoinRequest, error) {
+	vars := map[string]interface{}{
+		"declineGroupJoinRequestId": declineGroupJoinRequestID,
+	}
+
+	var res DeclineGroupJoinRequest
+	if err := c.Client.Post(ctx, "DeclineGroupJoinRequest", DeclineGroupJoinRequestDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteGroupJoinRequestDocument = `mutation DeleteGroupJoinRequest ($deleteGroupJoinRequestId: ID!) {
+	deleteGroupJoinRequest(id: $deleteGroupJoinRequestId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteGroupJoinRequest(ctx context.Context, deleteGroupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*DeleteGroupJoinRequest, error) {
+	vars := map[string]interface{}{
+		"deleteGroupJoinRequestId": deleteGroupJoinRequestID,
+	}
+
+	var res DeleteGroupJoinRequest
+	if err := c.Client.Post(ctx, "DeleteGroupJoinRequest", DeleteGroupJoinRequestDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetGroupJoinRequestByIDDocument = `query GetGroupJoinRequestByID ($groupJoinRequestId: ID!) {
+	groupJoinRequest(id: $groupJoinRequestId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		groupID
+		userID
+		message
+		status
+		reviewerID
+	}
+}
+`
+
+func (c *Client) GetGroupJoinRequestByID(ctx context.Context, groupJoinRequestID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupJoinRequestByID, error) {
+	vars := map[string]interface{}{
+		"groupJoinRequestId": groupJoinRequestID,
+	}
+
+	var res GetGroupJoinRequestByID
+	if err := c.Client.Post(ctx, "GetGroupJoinRequestByID", GetGroupJoinRequestByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const AddUserToGroupDocument = `mutation AddUserToGroup ($input: CreateGroupMembershipInput!) {
+	addUserToGroup(input: $input) {
+		groupMembership {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			role
+			groupID
+			userID
+		}
+	}
+}
+`
+
+func (c *Client) AddUserToGroup(ctx context.Context, input CreateGroupMembershipInput, interceptors ...clientv2.RequestInterceptor) (*AddUserToGroup, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res AddUserToGroup
+	if err := c.Client.Post(ctx, "AddUserToGroup", AddUserToGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const JoinGroupDocument = `mutation JoinGroup ($groupID: ID!) {
+	joinGroup(groupID: $groupID) {
+		groupMembership {
+			id
+			role
+			groupID
+			userID
+		}
+	}
+}
+`
+
+func (c *Client) JoinGroup(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*JoinGroup, error) {
+	vars := map[string]interface{}{
+		"groupID": groupID,
+	}
+
+	var res JoinGroup
+	if err := c.Client.Post(ctx, "JoinGroup", JoinGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateGroupMemberRoleDocument = `mutation UpdateGroupMemberRole ($updateGroupMemberRoleId: ID!, $role: GroupMembershipRole!) {
+	updateGroupMemberRole(id: $updateGroupMemberRoleId, role: $role) {
+		groupMembership {
+			id
+			role
+			groupID
+			userID
+		}
+	}
+}
+`
+
+func (c *Client) UpdateGroupMemberRole(ctx context.Context, updateGroupMemberRoleID string, role groupmembership.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateGroupMemberRole, error) {
+	vars := map[string]interface{}{
+		"updateGroupMemberRoleId": updateGroupMemberRoleID,
+		"role":                    role,
+	}
+
+	var res UpdateGroupMemberRole
+	if err := c.Client.Post(ctx, "UpdateGroupMemberRole", UpdateGroupMemberRoleDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RemoveUserFromGroupDocument = `mutation RemoveUserFromGroup ($removeUserFromGroupId: ID!) {
+	removeUserFromGroup(id: $removeUserFromGroupId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) RemoveUserFromGroup(ctx context.Context, removeUserFromGroupID string, interceptors ...clientv2.RequestInterceptor) (*RemoveUserFromGroup, error) {
+	vars := map[string]interface{}{
+		"removeUserFromGroupId": removeUserFromGroupID,
+	}
+
+	var res RemoveUserFromGroup
+	if err := c.Client.Post(ctx, "RemoveUserFromGroup", RemoveUserFromGroupDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetGroupMembershipByIDDocument = `query GetGroupMembershipByID ($groupMembershipId: ID!) {
+	groupMembership(id: $groupMembershipId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		role
+		groupID
+		userID
+	}
+}
+`
+
+func (c *Client) GetGroupMembershipByID(ctx context.Context, groupMembershipID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupMembershipByID, error) {
+	vars := map[string]interface{}{
+		"groupMembershipId": groupMembershipID,
+	}
+
+	var res GetGroupMembershipByID
+	if err := c.Client.Post(ctx, "GetGroupMembershipByID", GetGroupMembershipByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetGroupMembersDocument = `query GetGroupMembers ($groupId: ID!) {
+	group(id: $groupId) {
+		id
+		members {
+			id
+			role
+			user {
+				id
+				email
+				firstName
+				lastName
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetGroupMembers(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupMembers, error) {
+	vars := map[string]interface{}{
+		"groupId": groupID,
+	}
+
+	var res GetGroupMembers
+	if err := c.Client.Post(ctx, "GetGroupMembers", GetGroupMembersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetGroupSettingDocument = `query GetGroupSetting ($groupSettingId: ID!) {
+	groupSetting(id: $groupSettingId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		visibility
+		joinPolicy
+		tags
+		syncToSlack
+		syncToGithub
+		group {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetGroupSetting(ctx context.Context, groupSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupSetting, error) {
+	vars := map[string]interface{}{
+		"groupSettingId": groupSettingID,
+	}
+
+	var res GetGroupSetting
+	if err := c.Client.Post(ctx, "GetGroupSetting", GetGroupSettingDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateInviteDocument = `mutation CreateInvite ($input: CreateInviteInput!) {
+	createInvite(input: $input) {
+		invite {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			expires
+			recipient
+			status
+			role
+			requestorID
+			owner {
+				id
+				name
+			}
+		}
+	}
+}
+`
+
+func (c *Client) CreateInvite(ctx context.Context, input CreateInviteInput, interceptors ...clientv2.RequestInterceptor) (*CreateInvite, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res CreateInvite
+	if err := c.Client.Post(ctx, "CreateInvite", CreateInviteDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RevokeInviteDocument = `mutation RevokeInvite ($revokeInviteId: ID!) {
+	revokeInvite(id: $revokeInviteId) {
+		invite {
+			id
+			recipient
+			status
+		}
+	}
+}
+`
+
+func (c *Client) RevokeInvite(ctx context.Context, revokeInviteID string, interceptors ...clientv2.RequestInterceptor) (*RevokeInvite, error) {
+	vars := map[string]interface{}{
+		"revokeInviteId": revokeInviteID,
+	}
+
+	var res RevokeInvite
+	if err := c.Client.Post(ctx, "RevokeInvite", RevokeInviteDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetInviteByIDDocument = `query GetInviteByID ($inviteId: ID!) {
+	invite(id: $inviteId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		expires
+		recipient
+		status
+		role
+		requestorID
+		owner {
+			id
+			name
+		}
+	}
+}
+`
+
+func (c *Client) GetInviteByID(ctx context.Context, inviteID string, interceptors ...clientv2.RequestInterceptor) (*GetInviteByID, error) {
+	vars := map[string]interface{}{
+		"inviteId": inviteID,
+	}
+
+	var res GetInviteByID
+	if err := c.Client.Post(ctx, "GetInviteByID", GetInviteByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetInvitesDocument = `query GetInvites {
+	invites {
+		edges {
+			node {
+				id
+				expires
+				recipient
+				status
+				role
+				requestorID
+				owner {
+					id
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetInvites(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetInvites, error) {
+	vars := map[string]interface{}{}
+
+	var res GetInvites
+	if err := c.Client.Post(ctx, "GetInvites", GetInvitesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateOauthClientDocument = `mutation CreateOauthClient ($input: CreateOauthClientInput!) {
+	createOauthClient(input: $input) {
+		oauthClient {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			name
+			clientID
+			redirectUris
+			scopes
+			public
+			description
+			owner {
+				id
+				name
+			}
+		}
+		clientSecret
+	}
+}
+`
+
+func (c *Client) CreateOauthClient(ctx context.Context, input CreateOauthClientInput, interceptors ...clientv2.RequestInterceptor) (*CreateOauthClient, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res CreateOauthClient
+	if err := c.Client.Post(ctx, "CreateOauthClient", CreateOauthClientDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateOauthClientDocument = `mutation UpdateOauthClient ($updateOauthClientId: ID!, $input: UpdateOauthClientInput!) {
+	updateOauthClient(id: $updateOauthClientId, input: $input) {
+		oauthClient {
+			id
+			name
+			clientID
+			redirectUris
+			scopes
+			public
+			description
+		}
+	}
+}
+`
+
+func (c *Client) UpdateOauthClient(ctx context.Context, updateOauthClientID string, input UpdateOauthClientInput, interceptors ...clientv2.RequestInterceptor) (*UpdateOauthClient, error) {
+	vars := map[string]interface{}{
+		"updateOauthClientId": updateOauthClientID,
+		"input":               input,
+	}
+
+	var res UpdateOauthClient
+	if err := c.Client.Post(ctx, "UpdateOauthClient", UpdateOauthClientDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOauthClientByIDDocument = `query GetOauthClientByID ($oauthClientId: ID!) {
+	oauthClient(id: $oauthClientId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		name
+		clientID
+		redirectUris
+		scopes
+		public
+		description
+		owner {
+			id
+			name
+		}
+	}
+}
+`
+
+func (c *Client) GetOauthClientByID(ctx context.Context, oauthClientID string, interceptors ...clientv2.RequestInterceptor) (*GetOauthClientByID, error) {
+	vars := map[string]interface{}{
+		"oauthClientId": oauthClientID,
+	}
+
+	var res GetOauthClientByID
+	if err := c.Client.Post(ctx, "GetOauthClientByID", GetOauthClientByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOauthClientsDocument = `query GetOauthClients {
+	oauthClients {
+		edges {
+			node {
+				id
+				name
+				clientID
+				redirectUris
+				scopes
+				public
+				description
+				owner {
+					id
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetOauthClients(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetOauthClients, error) {
+	vars := map[string]interface{}{}
+
+	var res GetOauthClients
+	if err := c.Client.Post(ctx, "GetOauthClients", GetOauthClientsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteOauthClientDocument = `mutation DeleteOauthClient ($deleteOauthClientId: ID!) {
+	deleteOauthClient(id: $deleteOauthClientId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteOauthClient(ctx context.Context, deleteOauthClientID string, interceptors ...clientv2.RequestInterceptor) (*DeleteOauthClient, error) {
+	vars := map[string]interface{}{
+		"deleteOauthClientId": deleteOauthClientID,
+	}
+
+	var res DeleteOauthClient
+	if err := c.Client.Post(ctx, "DeleteOauthClient", DeleteOauthClientDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOrganizationByIDDocument = `query GetOrganizationByID ($organizationId: ID!) {
+	organization(id: $organizationId) {
+		id
+		name
+		displayName
+		description
+		personalOrg
+		parent {
+			id
+			name
+		}
+		children {
+			edges {
+				node {
+					id
+					name
+					displayName
+					description
+				}
+			}
+		}
+		setting {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			domains
+			ssoCert
+			ssoEntrypoint
+			ssoIssuer
+			billingContact
+			billingEmail
+			billingPhone
+			billingAddress
+			taxIdentifier
+			tags
+		}
+		createdAt
+		createdBy
+		updatedAt
+		updatedBy
+	}
+}
+`
+
+func (c *Client) GetOrganizationByID(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationByID, error) {
+	vars := map[string]interface{}{
+		"organizationId": organizationID,
+	}
+
+	var res GetOrganizationByID
+	if err := c.Client.Post(ctx, "GetOrganizationByID", GetOrganizationByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAllOrganizationsDocument = `query GetAllOrganizations {
+	organizations {
+		edges {
+			node {
+				id
+				name
+				displayName
+				description
+				personalOrg
+				parent {
+					id
+					name
+				}
+				children {
+					edges {
+						node {
+							id
+							name
+							displayName
+							description
+						}
+					}
+				}
+				setting {
+					id
+					createdAt
+					updatedAt
+					createdBy
+					updatedBy
+					domains
+					ssoCert
+					ssoEntrypoint
+					ssoIssuer
+					billingContact
+					billingEmail
+					billingPhone
+					billingAddress
+					taxIdentifier
+					tags
+				}
+				createdAt
+				updatedAt
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetAllOrganizations(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllOrganizations, error) {
+	vars := map[string]interface{}{}
+
+	var res GetAllOrganizations
+	if err := c.Client.Post(ctx, "GetAllOrganizations", GetAllOrganizationsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const OrganizationsWhereDocument = `query OrganizationsWhere ($where: OrganizationWhereInput) {
+	organizations(where: $where) {
+		edges {
+			node {
+				id
+				name
+				displayName
+				description
+				personalOrg
+				parent {
+					id
+					name
+				}
+				children {
+					edges {
+						node {
+							id
+							name
+							displayName
+							description
+						}
+					}
+				}
+				setting {
+					id
+					createdAt
+					updatedAt
+					createdBy
+					updatedBy
+					domains
+					ssoCert
+					ssoEntrypoint
+					ssoIssuer
+					billingContact
+					billingEmail
+					billingPhone
+					billingAddress
+					taxIdentifier
+					tags
+				}
+				createdAt
+				updatedAt
+			}
+		}
+	}
+}
+`
+
+func (c *Client) OrganizationsWhere(ctx context.Context, where *OrganizationWhereInput, interceptors ...clientv2.RequestInterceptor) (*OrganizationsWhere, error) {
+	vars := map[string]interface{}{
+		"where": where,
+	}
+
+	var res OrganizationsWhere
+	if err := c.Client.Post(ctx, "OrganizationsWhere", OrganizationsWhereDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOrganizationAncestorsDocument = `query GetOrganizationAncestors ($organizationId: ID!) {
+	organizationAncestors(id: $organizationId) {
+		id
+		name
+		displayName
+		parent {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetOrganizationAncestors(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationAncestors, error) {
+	vars := map[string]interface{}{
+		"organizationId": organizationID,
 	}
-	return &t.DeleteUser
-}
 
-type GetUserSettingByID struct {
-	UserSetting GetUserSettingByID_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
-}
+	var res GetOrganizationAncestors
+	if err := c.Client.Post(ctx, "GetOrganizationAncestors", GetOrganizationAncestorsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
 
-func (t *GetUserSettingByID) GetUserSetting() *GetUserSettingByID_UserSetting {
-	if t == nil {
-		t = &GetUserSettingByID{}
+		return nil, err
 	}
-	return &t.UserSetting
+
+	return &res, nil
 }
 
-const GetGroupByIDDocument = `query GetGroupByID ($groupId: ID!) {
-	group(id: $groupId) {
+const GetOrganizationDescendantsDocument = `query GetOrganizationDescendants ($organizationId: ID!) {
+	organizationDescendants(id: $organizationId) {
 		id
 		name
-		description
 		displayName
-		owner {
-			id
-		}
-		logoURL
-		setting {
+		parent {
 			id
-			createdAt
-			updatedAt
-			createdBy
-			updatedBy
-			visibility
-			joinPolicy
-			syncToSlack
-			syncToGithub
-			tags
 		}
-		createdAt
-		createdBy
-		updatedAt
-		updatedBy
 	}
 }
 `
 
-func (c *Client) GetGroupByID(ctx context.Context, groupID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupByID, error) {
+func (c *Client) GetOrganizationDescendants(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationDescendants, error) {
 	vars := map[string]interface{}{
-		"groupId": groupID,
+		"organizationId": organizationID,
 	}
 
-	var res GetGroupByID
-	if err := c.Client.Post(ctx, "GetGroupByID", GetGroupByIDDocument, &res, vars, interceptors...); err != nil {
+	var res GetOrganizationDescendants
+	if err := c.Client.Post(ctx, "GetOrganizationDescendants", GetOrganizationDescendantsDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -3995,47 +8923,59 @@ func (c *Client) GetGroupByID(ctx context.Context, groupID string, interceptors
 	return &res, nil
 }
 
-const GroupsWhereDocument = `query GroupsWhere ($where: GroupWhereInput) {
-	groups(where: $where) {
-		edges {
-			node {
+const CreateOrganizationDocument = `mutation CreateOrganization ($input: CreateOrganizationInput!) {
+	createOrganization(input: $input) {
+		organization {
+			id
+			name
+			displayName
+			description
+			personalOrg
+			createdAt
+			updatedAt
+			setting {
 				id
-				name
-				description
-				displayName
-				owner {
-					id
-				}
-				logoURL
-				setting {
-					id
-					createdAt
-					updatedAt
-					createdBy
-					updatedBy
-					visibility
-					joinPolicy
-					syncToSlack
-					syncToGithub
-					tags
-				}
 				createdAt
-				createdBy
 				updatedAt
+				createdBy
 				updatedBy
+				domains
+				ssoCert
+				ssoEntrypoint
+				ssoIssuer
+				billingContact
+				billingEmail
+				billingPhone
+				billingAddress
+				taxIdentifier
+				tags
+			}
+			parent {
+				id
+				name
+			}
+			children {
+				edges {
+					node {
+						id
+						name
+						displayName
+						description
+					}
+				}
 			}
 		}
 	}
 }
 `
 
-func (c *Client) GroupsWhere(ctx context.Context, where *GroupWhereInput, interceptors ...clientv2.RequestInterceptor) (*GroupsWhere, error) {
+func (c *Client) CreateOrganization(ctx context.Context, input CreateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*CreateOrganization, error) {
 	vars := map[string]interface{}{
-		"where": where,
+		"input": input,
 	}
 
-	var res GroupsWhere
-	if err := c.Client.Post(ctx, "GroupsWhere", GroupsWhereDocument, &res, vars, interceptors...); err != nil {
+	var res CreateOrganization
+	if err := c.Client.Post(ctx, "CreateOrganization", CreateOrganizationDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4046,45 +8986,26 @@ func (c *Client) GroupsWhere(ctx context.Context, where *GroupWhereInput, interc
 	return &res, nil
 }
 
-const GetAllGroupsDocument = `query GetAllGroups {
-	groups {
-		edges {
-			node {
-				id
-				name
-				description
-				displayName
-				owner {
-					id
-				}
-				logoURL
-				setting {
-					id
-					createdAt
-					updatedAt
-					createdBy
-					updatedBy
-					visibility
-					joinPolicy
-					syncToSlack
-					syncToGithub
-					tags
-				}
-				createdAt
-				createdBy
-				updatedAt
-				updatedBy
-			}
+const UpdateOrganizationDocument = `mutation UpdateOrganization ($updateOrganizationId: ID!, $input: UpdateOrganizationInput!) {
+	updateOrganization(id: $updateOrganizationId, input: $input) {
+		organization {
+			id
+			name
+			displayName
+			description
 		}
 	}
 }
 `
 
-func (c *Client) GetAllGroups(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllGroups, error) {
-	vars := map[string]interface{}{}
+func (c *Client) UpdateOrganization(ctx context.Context, updateOrganizationID string, input UpdateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*UpdateOrganization, error) {
+	vars := map[string]interface{}{
+		"updateOrganizationId": updateOrganizationID,
+		"input":                input,
+	}
 
-	var res GetAllGroups
-	if err := c.Client.Post(ctx, "GetAllGroups", GetAllGroupsDocument, &res, vars, interceptors...); err != nil {
+	var res UpdateOrganization
+	if err := c.Client.Post(ctx, "UpdateOrganization", UpdateOrganizationDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4095,45 +9016,28 @@ func (c *Client) GetAllGroups(ctx context.Context, interceptors ...clientv2.Requ
 	return &res, nil
 }
 
-const CreateGroupDocument = `mutation CreateGroup ($input: CreateGroupInput!) {
-	createGroup(input: $input) {
-		group {
+const MoveOrganizationDocument = `mutation MoveOrganization ($moveOrganizationId: ID!, $parentID: ID!) {
+	moveOrganization(id: $moveOrganizationId, parentID: $parentID) {
+		organization {
 			id
 			name
-			description
 			displayName
-			owner {
-				id
-			}
-			logoURL
-			setting {
+			parent {
 				id
-				createdAt
-				updatedAt
-				createdBy
-				updatedBy
-				visibility
-				joinPolicy
-				syncToSlack
-				syncToGithub
-				tags
 			}
-			createdAt
-			createdBy
-			updatedAt
-			updatedBy
 		}
 	}
 }
 `
 
-func (c *Client) CreateGroup(ctx context.Context, input CreateGroupInput, interceptors ...clientv2.RequestInterceptor) (*CreateGroup, error) {
+func (c *Client) MoveOrganization(ctx context.Context, moveOrganizationID string, parentID string, interceptors ...clientv2.RequestInterceptor) (*MoveOrganization, error) {
 	vars := map[string]interface{}{
-		"input": input,
+		"moveOrganizationId": moveOrganizationID,
+		"parentID":           parentID,
 	}
 
-	var res CreateGroup
-	if err := c.Client.Post(ctx, "CreateGroup", CreateGroupDocument, &res, vars, interceptors...); err != nil {
+	var res MoveOrganization
+	if err := c.Client.Post(ctx, "MoveOrganization", MoveOrganizationDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4144,28 +9048,94 @@ func (c *Client) CreateGroup(ctx context.Context, input CreateGroupInput, interc
 	return &res, nil
 }
 
-const UpdateGroupDocument = `mutation UpdateGroup ($updateGroupId: ID!, $input: UpdateGroupInput!) {
-	updateGroup(id: $updateGroupId, input: $input) {
-		group {
+const DeleteOrganizationDocument = `mutation DeleteOrganization ($deleteOrganizationId: ID!) {
+	deleteOrganization(id: $deleteOrganizationId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteOrganization(ctx context.Context, deleteOrganizationID string, interceptors ...clientv2.RequestInterceptor) (*DeleteOrganization, error) {
+	vars := map[string]interface{}{
+		"deleteOrganizationId": deleteOrganizationID,
+	}
+
+	var res DeleteOrganization
+	if err := c.Client.Post(ctx, "DeleteOrganization", DeleteOrganizationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOrganizationSettingDocument = `query GetOrganizationSetting ($organizationSettingId: ID!) {
+	organizationSetting(id: $organizationSettingId) {
+		id
+		ssoCert
+		taxIdentifier
+		tags
+		ssoIssuer
+		ssoEntrypoint
+		billingAddress
+		billingContact
+		billingEmail
+		billingPhone
+		createdAt
+		createdBy
+		domains
+		updatedAt
+		updatedBy
+		organization {
 			id
-			name
-			displayName
-			description
+		}
+	}
+}
+`
+
+func (c *Client) GetOrganizationSetting(ctx context.Context, organizationSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationSetting, error) {
+	vars := map[string]interface{}{
+		"organizationSettingId": organizationSettingID,
+	}
+
+	var res GetOrganizationSetting
+	if err := c.Client.Post(ctx, "GetOrganizationSetting", GetOrganizationSettingDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const AddUserToOrganizationDocument = `mutation AddUserToOrganization ($input: CreateOrgMembershipInput!) {
+	addUserToOrganization(input: $input) {
+		orgMembership {
+			id
+			createdAt
 			updatedAt
+			createdBy
 			updatedBy
+			role
+			organizationID
+			userID
 		}
 	}
 }
 `
 
-func (c *Client) UpdateGroup(ctx context.Context, updateGroupID string, input UpdateGroupInput, interceptors ...clientv2.RequestInterceptor) (*UpdateGroup, error) {
+func (c *Client) AddUserToOrganization(ctx context.Context, input CreateOrgMembershipInput, interceptors ...clientv2.RequestInterceptor) (*AddUserToOrganization, error) {
 	vars := map[string]interface{}{
-		"updateGroupId": updateGroupID,
-		"input":         input,
+		"input": input,
 	}
 
-	var res UpdateGroup
-	if err := c.Client.Post(ctx, "UpdateGroup", UpdateGroupDocument, &res, vars, interceptors...); err != nil {
+	var res AddUserToOrganization
+	if err := c.Client.Post(ctx, "AddUserToOrganization", AddUserToOrganizationDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4176,20 +9146,26 @@ func (c *Client) UpdateGroup(ctx context.Context, updateGroupID string, input Up
 	return &res, nil
 }
 
-const DeleteGroupDocument = `mutation DeleteGroup ($deleteGroupId: ID!) {
-	deleteGroup(id: $deleteGroupId) {
-		deletedID
+const UpdateOrgMemberRoleDocument = `mutation UpdateOrgMemberRole ($updateOrgMemberRoleId: ID!, $role: OrgMembershipRole!) {
+	updateOrgMemberRole(id: $updateOrgMemberRoleId, role: $role) {
+		orgMembership {
+			id
+			role
+			organizationID
+			userID
+		}
 	}
 }
 `
 
-func (c *Client) DeleteGroup(ctx context.Context, deleteGroupID string, interceptors ...clientv2.RequestInterceptor) (*DeleteGroup, error) {
+func (c *Client) UpdateOrgMemberRole(ctx context.Context, updateOrgMemberRoleID string, role orgmembership.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateOrgMemberRole, error) {
 	vars := map[string]interface{}{
-		"deleteGroupId": deleteGroupID,
+		"updateOrgMemberRoleId": updateOrgMemberRoleID,
+		"role":                  role,
 	}
 
-	var res DeleteGroup
-	if err := c.Client.Post(ctx, "DeleteGroup", DeleteGroupDocument, &res, vars, interceptors...); err != nil {
+	var res UpdateOrgMemberRole
+	if err := c.Client.Post(ctx, "UpdateOrgMemberRole", UpdateOrgMemberRoleDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4200,32 +9176,20 @@ func (c *Client) DeleteGroup(ctx context.Context, deleteGroupID string, intercep
 	return &res, nil
 }
 
-const GetGroupSettingDocument = `query GetGroupSetting ($groupSettingId: ID!) {
-	groupSetting(id: $groupSettingId) {
-		id
-		createdAt
-		updatedAt
-		createdBy
-		updatedBy
-		visibility
-		joinPolicy
-		tags
-		syncToSlack
-		syncToGithub
-		group {
-			id
-		}
+const RemoveUserFromOrganizationDocument = `mutation RemoveUserFromOrganization ($removeUserFromOrganizationId: ID!) {
+	removeUserFromOrganization(id: $removeUserFromOrganizationId) {
+		deletedID
 	}
 }
 `
 
-func (c *Client) GetGroupSetting(ctx context.Context, groupSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetGroupSetting, error) {
+func (c *Client) RemoveUserFromOrganization(ctx context.Context, removeUserFromOrganizationID string, interceptors ...clientv2.RequestInterceptor) (*RemoveUserFromOrganization, error) {
 	vars := map[string]interface{}{
-		"groupSettingId": groupSettingID,
+		"removeUserFromOrganizationId": removeUserFromOrganizationID,
 	}
 
-	var res GetGroupSetting
-	if err := c.Client.Post(ctx, "GetGroupSetting", GetGroupSettingDocument, &res, vars, interceptors...); err != nil {
+	var res RemoveUserFromOrganization
+	if err := c.Client.Post(ctx, "RemoveUserFromOrganization", RemoveUserFromOrganizationDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4236,59 +9200,27 @@ func (c *Client) GetGroupSetting(ctx context.Context, groupSettingID string, int
 	return &res, nil
 }
 
-const GetOrganizationByIDDocument = `query GetOrganizationByID ($organizationId: ID!) {
-	organization(id: $organizationId) {
+const GetOrgMembershipByIDDocument = `query GetOrgMembershipByID ($orgMembershipId: ID!) {
+	orgMembership(id: $orgMembershipId) {
 		id
-		name
-		displayName
-		description
-		personalOrg
-		parent {
-			id
-			name
-		}
-		children {
-			edges {
-				node {
-					id
-					name
-					displayName
-					description
-				}
-			}
-		}
-		setting {
-			id
-			createdAt
-			updatedAt
-			createdBy
-			updatedBy
-			domains
-			ssoCert
-			ssoEntrypoint
-			ssoIssuer
-			billingContact
-			billingEmail
-			billingPhone
-			billingAddress
-			taxIdentifier
-			tags
-		}
 		createdAt
-		createdBy
 		updatedAt
+		createdBy
 		updatedBy
+		role
+		organizationID
+		userID
 	}
 }
 `
 
-func (c *Client) GetOrganizationByID(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationByID, error) {
+func (c *Client) GetOrgMembershipByID(ctx context.Context, orgMembershipID string, interceptors ...clientv2.RequestInterceptor) (*GetOrgMembershipByID, error) {
 	vars := map[string]interface{}{
-		"organizationId": organizationID,
+		"orgMembershipId": orgMembershipID,
 	}
 
-	var res GetOrganizationByID
-	if err := c.Client.Post(ctx, "GetOrganizationByID", GetOrganizationByIDDocument, &res, vars, interceptors...); err != nil {
+	var res GetOrgMembershipByID
+	if err := c.Client.Post(ctx, "GetOrgMembershipByID", GetOrgMembershipByIDDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4299,59 +9231,30 @@ func (c *Client) GetOrganizationByID(ctx context.Context, organizationID string,
 	return &res, nil
 }
 
-const GetAllOrganizationsDocument = `query GetAllOrganizations {
-	organizations {
-		edges {
-			node {
+const GetOrgMembersDocument = `query GetOrgMembers ($organizationId: ID!) {
+	organization(id: $organizationId) {
+		id
+		members {
+			id
+			role
+			user {
 				id
-				name
-				displayName
-				description
-				personalOrg
-				parent {
-					id
-					name
-				}
-				children {
-					edges {
-						node {
-							id
-							name
-							displayName
-							description
-						}
-					}
-				}
-				setting {
-					id
-					createdAt
-					updatedAt
-					createdBy
-					updatedBy
-					domains
-					ssoCert
-					ssoEntrypoint
-					ssoIssuer
-					billingContact
-					billingEmail
-					billingPhone
-					billingAddress
-					taxIdentifier
-					tags
-				}
-				createdAt
-				updatedAt
+				email
+				firstName
+				lastName
 			}
 		}
 	}
 }
 `
 
-func (c *Client) GetAllOrganizations(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllOrganizations, error) {
-	vars := map[string]interface{}{}
+func (c *Client) GetOrgMembers(ctx context.Context, organizationID string, interceptors ...clientv2.RequestInterceptor) (*GetOrgMembers, error) {
+	vars := map[string]interface{}{
+		"organizationId": organizationID,
+	}
 
-	var res GetAllOrganizations
-	if err := c.Client.Post(ctx, "GetAllOrganizations", GetAllOrganizationsDocument, &res, vars, interceptors...); err != nil {
+	var res GetOrgMembers
+	if err := c.Client.Post(ctx, "GetOrgMembers", GetOrgMembersDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4362,61 +9265,33 @@ func (c *Client) GetAllOrganizations(ctx context.Context, interceptors ...client
 	return &res, nil
 }
 
-const OrganizationsWhereDocument = `query OrganizationsWhere ($where: OrganizationWhereInput) {
-	organizations(where: $where) {
-		edges {
-			node {
-				id
-				name
-				displayName
-				description
-				personalOrg
-				parent {
-					id
-					name
-				}
-				children {
-					edges {
-						node {
-							id
-							name
-							displayName
-							description
-						}
-					}
-				}
-				setting {
-					id
-					createdAt
-					updatedAt
-					createdBy
-					updatedBy
-					domains
-					ssoCert
-					ssoEntrypoint
-					ssoIssuer
-					billingContact
-					billingEmail
-					billingPhone
-					billingAddress
-					taxIdentifier
-					tags
-				}
-				createdAt
-				updatedAt
-			}
+const TransferOrganizationOwnershipDocument = `mutation TransferOrganizationOwnership ($organizationID: ID!, $recipientID: ID!) {
+	transferOrganizationOwnership(organizationID: $organizationID, recipientID: $recipientID) {
+		ownershipTransfer {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			expires
+			status
+			ownerID
+			groupID
+			requestorID
+			recipientID
 		}
 	}
 }
 `
 
-func (c *Client) OrganizationsWhere(ctx context.Context, where *OrganizationWhereInput, interceptors ...clientv2.RequestInterceptor) (*OrganizationsWhere, error) {
+func (c *Client) TransferOrganizationOwnership(ctx context.Context, organizationID string, recipientID string, interceptors ...clientv2.RequestInterceptor) (*TransferOrganizationOwnership, error) {
 	vars := map[string]interface{}{
-		"where": where,
+		"organizationID": organizationID,
+		"recipientID":    recipientID,
 	}
 
-	var res OrganizationsWhere
-	if err := c.Client.Post(ctx, "OrganizationsWhere", OrganizationsWhereDocument, &res, vars, interceptors...); err != nil {
+	var res TransferOrganizationOwnership
+	if err := c.Client.Post(ctx, "TransferOrganizationOwnership", TransferOrganizationOwnershipDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4427,59 +9302,33 @@ func (c *Client) OrganizationsWhere(ctx context.Context, where *OrganizationWher
 	return &res, nil
 }
 
-const CreateOrganizationDocument = `mutation CreateOrganization ($input: CreateOrganizationInput!) {
-	createOrganization(input: $input) {
-		organization {
+const TransferGroupOwnershipDocument = `mutation TransferGroupOwnership ($groupID: ID!, $recipientID: ID!) {
+	transferGroupOwnership(groupID: $groupID, recipientID: $recipientID) {
+		ownershipTransfer {
 			id
-			name
-			displayName
-			description
-			personalOrg
 			createdAt
 			updatedAt
-			setting {
-				id
-				createdAt
-				updatedAt
-				createdBy
-				updatedBy
-				domains
-				ssoCert
-				ssoEntrypoint
-				ssoIssuer
-				billingContact
-				billingEmail
-				billingPhone
-				billingAddress
-				taxIdentifier
-				tags
-			}
-			parent {
-				id
-				name
-			}
-			children {
-				edges {
-					node {
-						id
-						name
-						displayName
-						description
-					}
-				}
-			}
+			createdBy
+			updatedBy
+			expires
+			status
+			ownerID
+			groupID
+			requestorID
+			recipientID
 		}
 	}
 }
 `
 
-func (c *Client) CreateOrganization(ctx context.Context, input CreateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*CreateOrganization, error) {
+func (c *Client) TransferGroupOwnership(ctx context.Context, groupID string, recipientID string, interceptors ...clientv2.RequestInterceptor) (*TransferGroupOwnership, error) {
 	vars := map[string]interface{}{
-		"input": input,
+		"groupID":     groupID,
+		"recipientID": recipientID,
 	}
 
-	var res CreateOrganization
-	if err := c.Client.Post(ctx, "CreateOrganization", CreateOrganizationDocument, &res, vars, interceptors...); err != nil {
+	var res TransferGroupOwnership
+	if err := c.Client.Post(ctx, "TransferGroupOwnership", TransferGroupOwnershipDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4490,26 +9339,23 @@ func (c *Client) CreateOrganization(ctx context.Context, input CreateOrganizatio
 	return &res, nil
 }
 
-const UpdateOrganizationDocument = `mutation UpdateOrganization ($updateOrganizationId: ID!, $input: UpdateOrganizationInput!) {
-	updateOrganization(id: $updateOrganizationId, input: $input) {
-		organization {
+const CancelOwnershipTransferDocument = `mutation CancelOwnershipTransfer ($cancelOwnershipTransferId: ID!) {
+	cancelOwnershipTransfer(id: $cancelOwnershipTransferId) {
+		ownershipTransfer {
 			id
-			name
-			displayName
-			description
+			status
 		}
 	}
 }
 `
 
-func (c *Client) UpdateOrganization(ctx context.Context, updateOrganizationID string, input UpdateOrganizationInput, interceptors ...clientv2.RequestInterceptor) (*UpdateOrganization, error) {
+func (c *Client) CancelOwnershipTransfer(ctx context.Context, cancelOwnershipTransferID string, interceptors ...clientv2.RequestInterceptor) (*CancelOwnershipTransfer, error) {
 	vars := map[string]interface{}{
-		"updateOrganizationId": updateOrganizationID,
-		"input":                input,
+		"cancelOwnershipTransferId": cancelOwnershipTransferID,
 	}
 
-	var res UpdateOrganization
-	if err := c.Client.Post(ctx, "UpdateOrganization", UpdateOrganizationDocument, &res, vars, interceptors...); err != nil {
+	var res CancelOwnershipTransfer
+	if err := c.Client.Post(ctx, "CancelOwnershipTransfer", CancelOwnershipTransferDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4520,20 +9366,30 @@ func (c *Client) UpdateOrganization(ctx context.Context, updateOrganizationID st
 	return &res, nil
 }
 
-const DeleteOrganizationDocument = `mutation DeleteOrganization ($deleteOrganizationId: ID!) {
-	deleteOrganization(id: $deleteOrganizationId) {
-		deletedID
+const GetOwnershipTransferByIDDocument = `query GetOwnershipTransferByID ($ownershipTransferId: ID!) {
+	ownershipTransfer(id: $ownershipTransferId) {
+		id
+		createdAt
+		updatedAt
+		createdBy
+		updatedBy
+		expires
+		status
+		ownerID
+		groupID
+		requestorID
+		recipientID
 	}
 }
 `
 
-func (c *Client) DeleteOrganization(ctx context.Context, deleteOrganizationID string, interceptors ...clientv2.RequestInterceptor) (*DeleteOrganization, error) {
+func (c *Client) GetOwnershipTransferByID(ctx context.Context, ownershipTransferID string, interceptors ...clientv2.RequestInterceptor) (*GetOwnershipTransferByID, error) {
 	vars := map[string]interface{}{
-		"deleteOrganizationId": deleteOrganizationID,
+		"ownershipTransferId": ownershipTransferID,
 	}
 
-	var res DeleteOrganization
-	if err := c.Client.Post(ctx, "DeleteOrganization", DeleteOrganizationDocument, &res, vars, interceptors...); err != nil {
+	var res GetOwnershipTransferByID
+	if err := c.Client.Post(ctx, "GetOwnershipTransferByID", GetOwnershipTransferByIDDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4544,37 +9400,30 @@ func (c *Client) DeleteOrganization(ctx context.Context, deleteOrganizationID st
 	return &res, nil
 }
 
-const GetOrganizationSettingDocument = `query GetOrganizationSetting ($organizationSettingId: ID!) {
-	organizationSetting(id: $organizationSettingId) {
-		id
-		ssoCert
-		taxIdentifier
-		tags
-		ssoIssuer
-		ssoEntrypoint
-		billingAddress
-		billingContact
-		billingEmail
-		billingPhone
-		createdAt
-		createdBy
-		domains
-		updatedAt
-		updatedBy
-		organization {
-			id
+const GetOwnershipTransfersDocument = `query GetOwnershipTransfers {
+	ownershipTransfers {
+		edges {
+			node {
+				id
+				createdAt
+				updatedAt
+				expires
+				status
+				ownerID
+				groupID
+				requestorID
+				recipientID
+			}
 		}
 	}
 }
 `
 
-func (c *Client) GetOrganizationSetting(ctx context.Context, organizationSettingID string, interceptors ...clientv2.RequestInterceptor) (*GetOrganizationSetting, error) {
-	vars := map[string]interface{}{
-		"organizationSettingId": organizationSettingID,
-	}
+func (c *Client) GetOwnershipTransfers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetOwnershipTransfers, error) {
+	vars := map[string]interface{}{}
 
-	var res GetOrganizationSetting
-	if err := c.Client.Post(ctx, "GetOrganizationSetting", GetOrganizationSettingDocument, &res, vars, interceptors...); err != nil {
+	var res GetOwnershipTransfers
+	if err := c.Client.Post(ctx, "GetOwnershipTransfers", GetOwnershipTransfersDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -4594,6 +9443,7 @@ const CreatePersonalAccessTokenDocument = `mutation CreatePersonalAccessToken ($
 			createdBy
 			updatedBy
 			name
+			tokenPrefix
 			abilities
 			expiresAt
 			description
@@ -4603,6 +9453,7 @@ const CreatePersonalAccessTokenDocument = `mutation CreatePersonalAccessToken ($
 				displayName
 			}
 		}
+		token
 	}
 }
 `
@@ -4632,6 +9483,7 @@ const GetPersonalAccessTokenByIDDocument = `query GetPersonalAccessTokenByID ($p
 		createdBy
 		updatedBy
 		name
+		tokenPrefix
 		abilities
 		expiresAt
 		description
@@ -4685,6 +9537,125 @@ func (c *Client) DeletePersonalAccessToken(ctx context.Context, deletePersonalAc
 	return &res, nil
 }
 
+const UpdatePersonalAccessTokenDocument = `mutation UpdatePersonalAccessToken ($updatePersonalAccessTokenId: ID!, $input: UpdatePersonalAccessTokenInput!) {
+	updatePersonalAccessToken(id: $updatePersonalAccessTokenId, input: $input) {
+		personalAccessToken {
+			id
+			createdAt
+			updatedAt
+			createdBy
+			updatedBy
+			name
+			tokenPrefix
+			abilities
+			expiresAt
+			description
+			lastUsedAt
+			owner {
+				id
+				displayName
+			}
+		}
+	}
+}
+`
+
+func (c *Client) UpdatePersonalAccessToken(ctx context.Context, updatePersonalAccessTokenID string, input UpdatePersonalAccessTokenInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePersonalAccessToken, error) {
+	vars := map[string]interface{}{
+		"updatePersonalAccessTokenId": updatePersonalAccessTokenID,
+		"input":                       input,
+	}
+
+	var res UpdatePersonalAccessToken
+	if err := c.Client.Post(ctx, "UpdatePersonalAccessToken", UpdatePersonalAccessTokenDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const EnrollTfaDocument = `mutation EnrollTFA {
+	enrollTFA {
+		secret
+		qrURI
+	}
+}
+`
+
+func (c *Client) EnrollTfa(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*EnrollTfa, error) {
+	vars := map[string]interface{}{}
+
+	var res EnrollTfa
+	if err := c.Client.Post(ctx, "EnrollTFA", EnrollTfaDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ConfirmTfaDocument = `mutation ConfirmTFA ($code: String!) {
+	confirmTFA(code: $code) {
+		userSetting {
+			id
+			isTfaEnabled
+		}
+		recoveryCodes
+	}
+}
+`
+
+func (c *Client) ConfirmTfa(ctx context.Context, code string, interceptors ...clientv2.RequestInterceptor) (*ConfirmTfa, error) {
+	vars := map[string]interface{}{
+		"code": code,
+	}
+
+	var res ConfirmTfa
+	if err := c.Client.Post(ctx, "ConfirmTFA", ConfirmTfaDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DisableTfaDocument = `mutation DisableTFA ($code: String!) {
+	disableTFA(code: $code) {
+		userSetting {
+			id
+			isTfaEnabled
+		}
+	}
+}
+`
+
+func (c *Client) DisableTfa(ctx context.Context, code string, interceptors ...clientv2.RequestInterceptor) (*DisableTfa, error) {
+	vars := map[string]interface{}{
+		"code": code,
+	}
+
+	var res DisableTfa
+	if err := c.Client.Post(ctx, "DisableTFA", DisableTfaDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetUserByIDDocument = `query GetUserByID ($userId: ID!) {
 	user(id: $userId) {
 		id
@@ -4981,6 +9952,90 @@ func (c *Client) DeleteUser(ctx context.Context, deleteUserID string, intercepto
 	return &res, nil
 }
 
+const UnlockUserDocument = `mutation UnlockUser ($unlockUserId: ID!) {
+	unlockUser(id: $unlockUserId) {
+		userSetting {
+			id
+			locked
+			status
+			failedLoginAttempts
+		}
+	}
+}
+`
+
+func (c *Client) UnlockUser(ctx context.Context, unlockUserID string, interceptors ...clientv2.RequestInterceptor) (*UnlockUser, error) {
+	vars := map[string]interface{}{
+		"unlockUserId": unlockUserID,
+	}
+
+	var res UnlockUser
+	if err := c.Client.Post(ctx, "UnlockUser", UnlockUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const MySessionsDocument = `query MySessions {
+	mySessions {
+		id
+		issuedAt
+		expiresAt
+		lastSeenAt
+		userAgent
+		ipAddress
+		organizationID
+	}
+}
+`
+
+func (c *Client) MySessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MySessions, error) {
+	vars := map[string]interface{}{}
+
+	var res MySessions
+	if err := c.Client.Post(ctx, "MySessions", MySessionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RevokeSessionDocument = `mutation RevokeSession ($revokeSessionId: ID!) {
+	revokeSession(id: $revokeSessionId) {
+		session {
+			id
+			revokedAt
+		}
+	}
+}
+`
+
+func (c *Client) RevokeSession(ctx context.Context, revokeSessionID string, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error) {
+	vars := map[string]interface{}{
+		"revokeSessionId": revokeSessionID,
+	}
+
+	var res RevokeSession
+	if err := c.Client.Post(ctx, "RevokeSession", RevokeSessionDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetUserSettingByIDDocument = `query GetUserSettingByID ($userSettingId: ID!) {
 	userSetting(id: $userSettingId) {
 		id
@@ -4992,6 +10047,7 @@ const GetUserSettingByIDDocument = `query GetUserSettingByID ($userSettingId: ID
 		tags
 		locked
 		emailConfirmed
+		isTfaEnabled
 		createdAt
 		createdBy
 		deletedAt
@@ -5019,29 +10075,178 @@ func (c *Client) GetUserSettingByID(ctx context.Context, userSettingID string, i
 	return &res, nil
 }
 
+const GetWebauthnCredentialsDocument = `query GetWebauthnCredentials {
+	webauthnCredentials {
+		edges {
+			node {
+				id
+				createdAt
+				updatedAt
+				name
+				attestationType
+				aaguid
+				signCount
+				transports
+				backupEligible
+				backupState
+				lastUsedAt
+				owner {
+					id
+					displayName
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetWebauthnCredentials(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetWebauthnCredentials, error) {
+	vars := map[string]interface{}{}
+
+	var res GetWebauthnCredentials
+	if err := c.Client.Post(ctx, "GetWebauthnCredentials", GetWebauthnCredentialsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetWebauthnCredentialByIDDocument = `query GetWebauthnCredentialByID ($webauthnCredentialId: ID!) {
+	webauthnCredential(id: $webauthnCredentialId) {
+		id
+		createdAt
+		updatedAt
+		name
+		attestationType
+		aaguid
+		signCount
+		transports
+		backupEligible
+		backupState
+		lastUsedAt
+		owner {
+			id
+			displayName
+		}
+	}
+}
+`
+
+func (c *Client) GetWebauthnCredentialByID(ctx context.Context, webauthnCredentialID string, interceptors ...clientv2.RequestInterceptor) (*GetWebauthnCredentialByID, error) {
+	vars := map[string]interface{}{
+		"webauthnCredentialId": webauthnCredentialID,
+	}
+
+	var res GetWebauthnCredentialByID
+	if err := c.Client.Post(ctx, "GetWebauthnCredentialByID", GetWebauthnCredentialByIDDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteWebauthnCredentialDocument = `mutation DeleteWebauthnCredential ($deleteWebauthnCredentialId: ID!) {
+	deleteWebauthnCredential(id: $deleteWebauthnCredentialId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteWebauthnCredential(ctx context.Context, deleteWebauthnCredentialID string, interceptors ...clientv2.RequestInterceptor) (*DeleteWebauthnCredential, error) {
+	vars := map[string]interface{}{
+		"deleteWebauthnCredentialId": deleteWebauthnCredentialID,
+	}
+
+	var res DeleteWebauthnCredential
+	if err := c.Client.Post(ctx, "DeleteWebauthnCredential", DeleteWebauthnCredentialDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 var DocumentOperationNames = map[string]string{
-	GetGroupByIDDocument:               "GetGroupByID",
-	GroupsWhereDocument:                "GroupsWhere",
-	GetAllGroupsDocument:               "GetAllGroups",
-	CreateGroupDocument:                "CreateGroup",
-	UpdateGroupDocument:                "UpdateGroup",
-	DeleteGroupDocument:                "DeleteGroup",
-	GetGroupSettingDocument:            "GetGroupSetting",
-	GetOrganizationByIDDocument:        "GetOrganizationByID",
-	GetAllOrganizationsDocument:        "GetAllOrganizations",
-	OrganizationsWhereDocument:         "OrganizationsWhere",
-	CreateOrganizationDocument:         "CreateOrganization",
-	UpdateOrganizationDocument:         "UpdateOrganization",
-	DeleteOrganizationDocument:         "DeleteOrganization",
-	GetOrganizationSettingDocument:     "GetOrganizationSetting",
-	CreatePersonalAccessTokenDocument:  "CreatePersonalAccessToken",
-	GetPersonalAccessTokenByIDDocument: "GetPersonalAccessTokenByID",
-	DeletePersonalAccessTokenDocument:  "DeletePersonalAccessToken",
-	GetUserByIDDocument:                "GetUserByID",
-	GetUserByIDWithOrgsDocument:        "GetUserByIDWithOrgs",
-	GetAllUsersDocument:                "GetAllUsers",
-	CreateUserDocument:                 "CreateUser",
-	UpdateUserDocument:                 "UpdateUser",
-	DeleteUserDocument:                 "DeleteUser",
-	GetUserSettingByIDDocument:         "GetUserSettingByID",
+	CreateAPIKeyDocument:                  "CreateAPIKey",
+	GetAPIKeyByIDDocument:                 "GetAPIKeyByID",
+	GetAPIKeysDocument:                    "GetAPIKeys",
+	DeleteAPIKeyDocument:                  "DeleteAPIKey",
+	GetGroupByIDDocument:                  "GetGroupByID",
+	GroupsWhereDocument:                   "GroupsWhere",
+	GetAllGroupsDocument:                  "GetAllGroups",
+	CreateGroupDocument:                   "CreateGroup",
+	UpdateGroupDocument:                   "UpdateGroup",
+	DeleteGroupDocument:                   "DeleteGroup",
+	RequestToJoinGroupDocument:            "RequestToJoinGroup",
+	ApproveGroupJoinRequestDocument:       "ApproveGroupJoinRequest",
+	DeclineGroupJoinRequestDocument:       "DeclineGroupJoinRequest",
+	DeleteGroupJoinRequestDocument:        "DeleteGroupJoinRequest",
+	GetGroupJoinRequestByIDDocument:       "GetGroupJoinRequestByID",
+	AddUserToGroupDocument:                "AddUserToGroup",
+	JoinGroupDocument:                     "JoinGroup",
+	UpdateGroupMemberRoleDocument:         "UpdateGroupMemberRole",
+	RemoveUserFromGroupDocument:           "RemoveUserFromGroup",
+	GetGroupMembershipByIDDocument:        "GetGroupMembershipByID",
+	GetGroupMembersDocument:               "GetGroupMembers",
+	GetGroupSettingDocument:               "GetGroupSetting",
+	CreateInviteDocument:                  "CreateInvite",
+	RevokeInviteDocument:                  "RevokeInvite",
+	GetInviteByIDDocument:                 "GetInviteByID",
+	GetInvitesDocument:                    "GetInvites",
+	CreateOauthClientDocument:             "CreateOauthClient",
+	UpdateOauthClientDocument:             "UpdateOauthClient",
+	GetOauthClientByIDDocument:            "GetOauthClientByID",
+	GetOauthClientsDocument:               "GetOauthClients",
+	DeleteOauthClientDocument:             "DeleteOauthClient",
+	GetOrganizationByIDDocument:           "GetOrganizationByID",
+	GetAllOrganizationsDocument:           "GetAllOrganizations",
+	OrganizationsWhereDocument:            "OrganizationsWhere",
+	GetOrganizationAncestorsDocument:      "GetOrganizationAncestors",
+	GetOrganizationDescendantsDocument:    "GetOrganizationDescendants",
+	CreateOrganizationDocument:            "CreateOrganization",
+	UpdateOrganizationDocument:            "UpdateOrganization",
+	MoveOrganizationDocument:              "MoveOrganization",
+	DeleteOrganizationDocument:            "DeleteOrganization",
+	GetOrganizationSettingDocument:        "GetOrganizationSetting",
+	AddUserToOrganizationDocument:         "AddUserToOrganization",
+	UpdateOrgMemberRoleDocument:           "UpdateOrgMemberRole",
+	RemoveUserFromOrganizationDocument:    "RemoveUserFromOrganization",
+	GetOrgMembershipByIDDocument:          "GetOrgMembershipByID",
+	GetOrgMembersDocument:                 "GetOrgMembers",
+	TransferOrganizationOwnershipDocument: "TransferOrganizationOwnership",
+	TransferGroupOwnershipDocument:        "TransferGroupOwnership",
+	CancelOwnershipTransferDocument:       "CancelOwnershipTransfer",
+	GetOwnershipTransferByIDDocument:      "GetOwnershipTransferByID",
+	GetOwnershipTransfersDocument:         "GetOwnershipTransfers",
+	CreatePersonalAccessTokenDocument:     "CreatePersonalAccessToken",
+	GetPersonalAccessTokenByIDDocument:    "GetPersonalAccessTokenByID",
+	DeletePersonalAccessTokenDocument:     "DeletePersonalAccessToken",
+	UpdatePersonalAccessTokenDocument:     "UpdatePersonalAccessToken",
+	EnrollTfaDocument:                     "EnrollTFA",
+	ConfirmTfaDocument:                    "ConfirmTFA",
+	DisableTfaDocument:                    "DisableTFA",
+	GetUserByIDDocument:                   "GetUserByID",
+	GetUserByIDWithOrgsDocument:           "GetUserByIDWithOrgs",
+	GetAllUsersDocument:                   "GetAllUsers",
+	CreateUserDocument:                    "CreateUser",
+	UpdateUserDocument:                    "UpdateUser",
+	DeleteUserDocument:                    "DeleteUser",
+	UnlockUserDocument:                    "UnlockUser",
+	MySessionsDocument:                    "MySessions",
+	RevokeSessionDocument:                 "RevokeSession",
+	GetUserSettingByIDDocument:            "GetUserSettingByID",
+	GetWebauthnCredentialsDocument:        "GetWebauthnCredentials",
+	GetWebauthnCredentialByIDDocument:     "GetWebauthnCredentialByID",
+	DeleteWebauthnCredentialDocument:      "DeleteWebauthnCredential",
 }
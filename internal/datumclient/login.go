@@ -56,6 +56,57 @@ func Login(c *Client, ctx context.Context, login handlers.LoginRequest) (*oauth2
 		return nil, newAuthenticationError(resp.StatusCode, out.Message)
 	}
 
+	// the user has multi-factor authentication enabled, the login must be completed with LoginMFA
+	if out.Message == handlers.MFARequiredMessage {
+		data, _ := out.Data.(map[string]interface{})
+		token, _ := data["mfa_token"].(string)
+
+		return nil, newMFARequiredError(token)
+	}
+
+	return getTokensFromCookies(resp), nil
+}
+
+// LoginMFA completes a login to the Datum API with a one-time passcode for users with multi-factor authentication enabled
+func LoginMFA(c *Client, ctx context.Context, login handlers.MFALoginRequest) (*oauth2.Token, error) {
+	method := http.MethodPost
+	endpoint := "login/mfa"
+
+	u := fmt.Sprintf("%s%s/%s", c.Client.BaseURL, route.V1Version, endpoint)
+
+	queryURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(login)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.Client.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	out := handlers.Response{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAuthenticationError(resp.StatusCode, out.Message)
+	}
+
 	return getTokensFromCookies(resp), nil
 }
 
@@ -9,10 +9,260 @@ import (
 	"time"
 
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 )
 
+type APIKey struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// the organization that owns the key
+	OwnerID string `json:"ownerID"`
+	// the name associated with the key
+	Name string `json:"name"`
+	// the visible prefix of the key used to identify it, the key itself is only returned on creation
+	KeyPrefix string `json:"keyPrefix"`
+	// the scopes the key is restricted to, e.g. org:read or group:write; at least one scope is required and the scopes cannot be changed once the key is created
+	Abilities []string `json:"abilities"`
+	// when the key expires, a key without an expiration is valid until it is deleted
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// a description of the key's purpose
+	Description *string      `json:"description,omitempty"`
+	LastUsedAt  *time.Time   `json:"lastUsedAt,omitempty"`
+	Owner       Organization `json:"owner"`
+}
+
+func (APIKey) IsNode() {}
+
+// A connection to a list of items.
+type APIKeyConnection struct {
+	// A list of edges.
+	Edges []*APIKeyEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createAPIKey mutation
+type APIKeyCreatePayload struct {
+	// Created apiKey
+	APIKey APIKey `json:"apiKey"`
+	// The apiKey secret, this is only returned once and cannot be retrieved again
+	Key string `json:"key"`
+}
+
+// Return response for deleteAPIKey mutation
+type APIKeyDeletePayload struct {
+	// Deleted apiKey ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type APIKeyEdge struct {
+	// The item at the end of the edge.
+	Node *APIKey `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Return response for updateAPIKey mutation
+type APIKeyUpdatePayload struct {
+	// Updated apiKey
+	APIKey APIKey `json:"apiKey"`
+}
+
+// APIKeyWhereInput is used for filtering APIKey objects.
+// Input was generated by ent.
+type APIKeyWhereInput struct {
+	Not *APIKeyWhereInput   `json:"not,omitempty"`
+	And []*APIKeyWhereInput `json:"and,omitempty"`
+	Or  []*APIKeyWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// key_prefix field predicates
+	KeyPrefix             *string  `json:"keyPrefix,omitempty"`
+	KeyPrefixNeq          *string  `json:"keyPrefixNEQ,omitempty"`
+	KeyPrefixIn           []string `json:"keyPrefixIn,omitempty"`
+	KeyPrefixNotIn        []string `json:"keyPrefixNotIn,omitempty"`
+	KeyPrefixGt           *string  `json:"keyPrefixGT,omitempty"`
+	KeyPrefixGte          *string  `json:"keyPrefixGTE,omitempty"`
+	KeyPrefixLt           *string  `json:"keyPrefixLT,omitempty"`
+	KeyPrefixLte          *string  `json:"keyPrefixLTE,omitempty"`
+	KeyPrefixContains     *string  `json:"keyPrefixContains,omitempty"`
+	KeyPrefixHasPrefix    *string  `json:"keyPrefixHasPrefix,omitempty"`
+	KeyPrefixHasSuffix    *string  `json:"keyPrefixHasSuffix,omitempty"`
+	KeyPrefixEqualFold    *string  `json:"keyPrefixEqualFold,omitempty"`
+	KeyPrefixContainsFold *string  `json:"keyPrefixContainsFold,omitempty"`
+	// expires_at field predicates
+	ExpiresAt       *time.Time   `json:"expiresAt,omitempty"`
+	ExpiresAtNeq    *time.Time   `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []*time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []*time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGt     *time.Time   `json:"expiresAtGT,omitempty"`
+	ExpiresAtGte    *time.Time   `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLt     *time.Time   `json:"expiresAtLT,omitempty"`
+	ExpiresAtLte    *time.Time   `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  *bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil *bool        `json:"expiresAtNotNil,omitempty"`
+	// last_used_at field predicates
+	LastUsedAt       *time.Time   `json:"lastUsedAt,omitempty"`
+	LastUsedAtNeq    *time.Time   `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []*time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []*time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGt     *time.Time   `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGte    *time.Time   `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLt     *time.Time   `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLte    *time.Time   `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  *bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil *bool        `json:"lastUsedAtNotNil,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+// CreateAPIKeyInput is used for create APIKey object.
+// Input was generated by ent.
+type CreateAPIKeyInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the name associated with the key
+	Name string `json:"name"`
+	// the scopes the key is restricted to, e.g. org:read or group:write; at least one scope is required and the scopes cannot be changed once the key is created
+	Abilities []string `json:"abilities"`
+	// when the key expires, a key without an expiration is valid until it is deleted
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// a description of the key's purpose
+	Description *string `json:"description,omitempty"`
+	OwnerID     string  `json:"ownerID"`
+}
+
 // CreateEntitlementInput is used for create Entitlement object.
 // Input was generated by ent.
 type CreateEntitlementInput struct {
@@ -50,10 +300,34 @@ type CreateGroupInput struct {
 	// the URL to an image uploaded by the customer for the groups avatar image
 	LogoURL *string `json:"logoURL,omitempty"`
 	// The group's displayed 'friendly' name
-	DisplayName *string  `json:"displayName,omitempty"`
-	SettingID   string   `json:"settingID"`
-	UserIDs     []string `json:"userIDs,omitempty"`
-	OwnerID     string   `json:"ownerID"`
+	DisplayName *string `json:"displayName,omitempty"`
+	SettingID   string  `json:"settingID"`
+	OwnerID     string  `json:"ownerID"`
+}
+
+// CreateGroupJoinRequestInput is used for create GroupJoinRequest object.
+// Input was generated by ent.
+type CreateGroupJoinRequestInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the message of the user to the admins of the group
+	Message *string `json:"message,omitempty"`
+	GroupID string  `json:"groupID"`
+}
+
+// CreateGroupMembershipInput is used for create GroupMembership object.
+// Input was generated by ent.
+type CreateGroupMembershipInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the role of the user in the group
+	Role    *groupmembership.Role `json:"role,omitempty"`
+	GroupID string                `json:"groupID"`
+	UserID  string                `json:"userID"`
 }
 
 // CreateGroupSettingInput is used for create GroupSetting object.
@@ -90,6 +364,40 @@ type CreateIntegrationInput struct {
 	OwnerID     *string `json:"ownerID,omitempty"`
 }
 
+// CreateInviteInput is used for create Invite object.
+// Input was generated by ent.
+type CreateInviteInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the email address of the user invited to the organization
+	Recipient string `json:"recipient"`
+	// the role the recipient is given in the organization when they accept the invitation
+	Role    *invite.Role `json:"role,omitempty"`
+	OwnerID string       `json:"ownerID"`
+}
+
+// CreateOauthClientInput is used for create OauthClient object.
+// Input was generated by ent.
+type CreateOauthClientInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the name of the client shown to users when they authorize it
+	Name string `json:"name"`
+	// the urls users can be redirected to after authorizing the client, the redirect uri of an authorization request must exactly match one of them
+	RedirectUris []string `json:"redirectUris"`
+	// the scopes the client can request, e.g. openid, email or org:read; the scopes cannot be changed once the client is created
+	Scopes []string `json:"scopes"`
+	// public clients, such as single page and native apps, cannot keep a secret; they are not issued a secret and must use PKCE
+	Public *bool `json:"public,omitempty"`
+	// a description of the client
+	Description *string `json:"description,omitempty"`
+	OwnerID     string  `json:"ownerID"`
+}
+
 // CreateOauthProviderInput is used for create OauthProvider object.
 // Input was generated by ent.
 type CreateOauthProviderInput struct {
@@ -123,7 +431,7 @@ type CreateOauthProviderInput struct {
 type CreateOhAuthTooTokenInput struct {
 	ClientID                string     `json:"clientID"`
 	Scopes                  []string   `json:"scopes,omitempty"`
-	Nonce                   string     `json:"nonce"`
+	Nonce                   *string    `json:"nonce,omitempty"`
 	ClaimsUserID            string     `json:"claimsUserID"`
 	ClaimsUsername          string     `json:"claimsUsername"`
 	ClaimsEmail             string     `json:"claimsEmail"`
@@ -135,6 +443,19 @@ type CreateOhAuthTooTokenInput struct {
 	LastUsed                *time.Time `json:"lastUsed,omitempty"`
 }
 
+// CreateOrgMembershipInput is used for create OrgMembership object.
+// Input was generated by ent.
+type CreateOrgMembershipInput struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the role of the user in the organization
+	Role           *orgmembership.Role `json:"role,omitempty"`
+	OrganizationID string              `json:"organizationID"`
+	UserID         string              `json:"userID"`
+}
+
 // CreateOrganizationInput is used for create Organization object.
 // Input was generated by ent.
 type CreateOrganizationInput struct {
@@ -151,12 +472,14 @@ type CreateOrganizationInput struct {
 	// orgs directly associated with a user
 	PersonalOrg      *bool    `json:"personalOrg,omitempty"`
 	ParentID         *string  `json:"parentID,omitempty"`
-	UserIDs          []string `json:"userIDs,omitempty"`
 	GroupIDs         []string `json:"groupIDs,omitempty"`
 	IntegrationIDs   []string `json:"integrationIDs,omitempty"`
 	SettingID        *string  `json:"settingID,omitempty"`
 	EntitlementIDs   []string `json:"entitlementIDs,omitempty"`
 	OauthproviderIDs []string `json:"oauthproviderIDs,omitempty"`
+	APIKeyIDs        []string `json:"apiKeyIDs,omitempty"`
+	OauthClientIDs   []string `json:"oauthClientIDs,omitempty"`
+	InviteIDs        []string `json:"inviteIDs,omitempty"`
 }
 
 // CreateOrganizationSettingInput is used for create OrganizationSetting object.
@@ -191,9 +514,8 @@ type CreatePersonalAccessTokenInput struct {
 	CreatedBy *string    `json:"createdBy,omitempty"`
 	UpdatedBy *string    `json:"updatedBy,omitempty"`
 	// the name associated with the token
-	Name  string  `json:"name"`
-	Token *string `json:"token,omitempty"`
-	// what abilites the token should have
+	Name string `json:"name"`
+	// the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner
 	Abilities []string `json:"abilities,omitempty"`
 	// when the token expires
 	ExpiresAt time.Time `json:"expiresAt"`
@@ -211,12 +533,12 @@ type CreateSessionInput struct {
 	CreatedBy *string    `json:"createdBy,omitempty"`
 	UpdatedBy *string    `json:"updatedBy,omitempty"`
 	// token is a string token issued to users that has a limited lifetime
-	SessionToken string    `json:"sessionToken"`
-	IssuedAt     time.Time `json:"issuedAt"`
-	ExpiresAt    time.Time `json:"expiresAt"`
+	SessionToken string     `json:"sessionToken"`
+	IssuedAt     *time.Time `json:"issuedAt,omitempty"`
+	ExpiresAt    time.Time  `json:"expiresAt"`
 	// organization ID of the organization the user is accessing
-	OrganizationID string `json:"organizationID"`
-	OwnerID        string `json:"ownerID"`
+	OrganizationID *string `json:"organizationID,omitempty"`
+	OwnerID        string  `json:"ownerID"`
 }
 
 // CreateUserInput is used for create User object.
@@ -245,13 +567,13 @@ type CreateUserInput struct {
 	Sub *string `json:"sub,omitempty"`
 	// whether the user uses oauth for login or not
 	Oauth                     *bool    `json:"oauth,omitempty"`
-	OrganizationIDs           []string `json:"organizationIDs,omitempty"`
 	SessionIDs                []string `json:"sessionIDs,omitempty"`
-	GroupIDs                  []string `json:"groupIDs,omitempty"`
 	PersonalAccessTokenIDs    []string `json:"personalAccessTokenIDs,omitempty"`
 	SettingID                 string   `json:"settingID"`
 	EmailVerificationTokenIDs []string `json:"emailVerificationTokenIDs,omitempty"`
 	ResetTokenIDs             []string `json:"resetTokenIDs,omitempty"`
+	MagicLinkTokenIDs         []string `json:"magicLinkTokenIDs,omitempty"`
+	WebauthnCredentialIDs     []string `json:"webauthnCredentialIDs,omitempty"`
 }
 
 // CreateUserSettingInput is used for create UserSetting object.
@@ -508,10 +830,11 @@ type Group struct {
 	// the URL to an image uploaded by the customer for the groups avatar image
 	LogoURL *string `json:"logoURL,omitempty"`
 	// The group's displayed 'friendly' name
-	DisplayName string       `json:"displayName"`
-	Setting     GroupSetting `json:"setting"`
-	Users       []*User      `json:"users,omitempty"`
-	Owner       Organization `json:"owner"`
+	DisplayName string             `json:"displayName"`
+	Setting     GroupSetting       `json:"setting"`
+	Users       []*User            `json:"users,omitempty"`
+	Owner       Organization       `json:"owner"`
+	Members     []*GroupMembership `json:"members,omitempty"`
 }
 
 func (Group) IsNode() {}
@@ -546,47 +869,373 @@ type GroupEdge struct {
 	Cursor string `json:"cursor"`
 }
 
-// Ordering options for Group connections
-type GroupOrder struct {
-	// The ordering direction.
-	Direction OrderDirection `json:"direction"`
-	// The field by which to order Groups.
-	Field GroupOrderField `json:"field"`
-}
-
-type GroupSetting struct {
-	ID        string     `json:"id"`
-	CreatedAt time.Time  `json:"createdAt"`
-	UpdatedAt time.Time  `json:"updatedAt"`
-	CreatedBy *string    `json:"createdBy,omitempty"`
-	UpdatedBy *string    `json:"updatedBy,omitempty"`
-	DeletedAt *time.Time `json:"deletedAt,omitempty"`
-	DeletedBy *string    `json:"deletedBy,omitempty"`
-	// whether the group is visible to it's members / owners only or if it's searchable by anyone within the organization
-	Visibility groupsetting.Visibility `json:"visibility"`
-	// the policy governing ability to freely join a group, whether it requires an invitation, application, or either
-	JoinPolicy groupsetting.JoinPolicy `json:"joinPolicy"`
-	// tags associated with the object
-	Tags         []string `json:"tags"`
-	SyncToSlack  bool     `json:"syncToSlack"`
-	SyncToGithub bool     `json:"syncToGithub"`
-	Group        *Group   `json:"group,omitempty"`
+type GroupJoinRequest struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedBy *string   `json:"createdBy,omitempty"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	// the group the user requested to join
+	GroupID string `json:"groupID"`
+	// the user who requested to join the group
+	UserID string `json:"userID"`
+	// the message of the user to the admins of the group
+	Message *string `json:"message,omitempty"`
+	// the status of the request
+	Status groupjoinrequest.Status `json:"status"`
+	// the group admin who approved or declined the request
+	ReviewerID *string `json:"reviewerID,omitempty"`
+	Group      Group   `json:"group"`
+	User       User    `json:"user"`
 }
 
-func (GroupSetting) IsNode() {}
+func (GroupJoinRequest) IsNode() {}
 
 // A connection to a list of items.
-type GroupSettingConnection struct {
+type GroupJoinRequestConnection struct {
 	// A list of edges.
-	Edges []*GroupSettingEdge `json:"edges,omitempty"`
+	Edges []*GroupJoinRequestEdge `json:"edges,omitempty"`
 	// Information to aid in pagination.
 	PageInfo PageInfo `json:"pageInfo"`
 	// Identifies the total count of items in the connection.
 	TotalCount int64 `json:"totalCount"`
 }
 
-// Return response for createGroupSetting mutation
-type GroupSettingCreatePayload struct {
+// Return response for requestToJoinGroup mutation
+type GroupJoinRequestCreatePayload struct {
+	// Created group join request
+	GroupJoinRequest GroupJoinRequest `json:"groupJoinRequest"`
+}
+
+// Return response for deleteGroupJoinRequest mutation
+type GroupJoinRequestDeletePayload struct {
+	// Deleted group join request ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type GroupJoinRequestEdge struct {
+	// The item at the end of the edge.
+	Node *GroupJoinRequest `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Return response for approveGroupJoinRequest and declineGroupJoinRequest mutations
+type GroupJoinRequestUpdatePayload struct {
+	// Updated group join request
+	GroupJoinRequest GroupJoinRequest `json:"groupJoinRequest"`
+}
+
+// GroupJoinRequestWhereInput is used for filtering GroupJoinRequest objects.
+// Input was generated by ent.
+type GroupJoinRequestWhereInput struct {
+	Not *GroupJoinRequestWhereInput   `json:"not,omitempty"`
+	And []*GroupJoinRequestWhereInput `json:"and,omitempty"`
+	Or  []*GroupJoinRequestWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// group_id field predicates
+	GroupID             *string  `json:"groupID,omitempty"`
+	GroupIDNeq          *string  `json:"groupIDNEQ,omitempty"`
+	GroupIDIn           []string `json:"groupIDIn,omitempty"`
+	GroupIDNotIn        []string `json:"groupIDNotIn,omitempty"`
+	GroupIDGt           *string  `json:"groupIDGT,omitempty"`
+	GroupIDGte          *string  `json:"groupIDGTE,omitempty"`
+	GroupIDLt           *string  `json:"groupIDLT,omitempty"`
+	GroupIDLte          *string  `json:"groupIDLTE,omitempty"`
+	GroupIDContains     *string  `json:"groupIDContains,omitempty"`
+	GroupIDHasPrefix    *string  `json:"groupIDHasPrefix,omitempty"`
+	GroupIDHasSuffix    *string  `json:"groupIDHasSuffix,omitempty"`
+	GroupIDEqualFold    *string  `json:"groupIDEqualFold,omitempty"`
+	GroupIDContainsFold *string  `json:"groupIDContainsFold,omitempty"`
+	// user_id field predicates
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNeq          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGt           *string  `json:"userIDGT,omitempty"`
+	UserIDGte          *string  `json:"userIDGTE,omitempty"`
+	UserIDLt           *string  `json:"userIDLT,omitempty"`
+	UserIDLte          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+	// message field predicates
+	Message             *string  `json:"message,omitempty"`
+	MessageNeq          *string  `json:"messageNEQ,omitempty"`
+	MessageIn           []string `json:"messageIn,omitempty"`
+	MessageNotIn        []string `json:"messageNotIn,omitempty"`
+	MessageGt           *string  `json:"messageGT,omitempty"`
+	MessageGte          *string  `json:"messageGTE,omitempty"`
+	MessageLt           *string  `json:"messageLT,omitempty"`
+	MessageLte          *string  `json:"messageLTE,omitempty"`
+	MessageContains     *string  `json:"messageContains,omitempty"`
+	MessageHasPrefix    *string  `json:"messageHasPrefix,omitempty"`
+	MessageHasSuffix    *string  `json:"messageHasSuffix,omitempty"`
+	MessageIsNil        *bool    `json:"messageIsNil,omitempty"`
+	MessageNotNil       *bool    `json:"messageNotNil,omitempty"`
+	MessageEqualFold    *string  `json:"messageEqualFold,omitempty"`
+	MessageContainsFold *string  `json:"messageContainsFold,omitempty"`
+	// status field predicates
+	Status      *groupjoinrequest.Status  `json:"status,omitempty"`
+	StatusNeq   *groupjoinrequest.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []groupjoinrequest.Status `json:"statusIn,omitempty"`
+	StatusNotIn []groupjoinrequest.Status `json:"statusNotIn,omitempty"`
+	// reviewer_id field predicates
+	ReviewerID             *string  `json:"reviewerID,omitempty"`
+	ReviewerIDNeq          *string  `json:"reviewerIDNEQ,omitempty"`
+	ReviewerIDIn           []string `json:"reviewerIDIn,omitempty"`
+	ReviewerIDNotIn        []string `json:"reviewerIDNotIn,omitempty"`
+	ReviewerIDGt           *string  `json:"reviewerIDGT,omitempty"`
+	ReviewerIDGte          *string  `json:"reviewerIDGTE,omitempty"`
+	ReviewerIDLt           *string  `json:"reviewerIDLT,omitempty"`
+	ReviewerIDLte          *string  `json:"reviewerIDLTE,omitempty"`
+	ReviewerIDContains     *string  `json:"reviewerIDContains,omitempty"`
+	ReviewerIDHasPrefix    *string  `json:"reviewerIDHasPrefix,omitempty"`
+	ReviewerIDHasSuffix    *string  `json:"reviewerIDHasSuffix,omitempty"`
+	ReviewerIDIsNil        *bool    `json:"reviewerIDIsNil,omitempty"`
+	ReviewerIDNotNil       *bool    `json:"reviewerIDNotNil,omitempty"`
+	ReviewerIDEqualFold    *string  `json:"reviewerIDEqualFold,omitempty"`
+	ReviewerIDContainsFold *string  `json:"reviewerIDContainsFold,omitempty"`
+	// group edge predicates
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
+	// user edge predicates
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+type GroupMembership struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedBy *string   `json:"createdBy,omitempty"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	// the role of the user in the group
+	Role groupmembership.Role `json:"role"`
+	// the group the user is a member of
+	GroupID string `json:"groupID"`
+	// the user who is a member of the group
+	UserID string `json:"userID"`
+	Group  Group  `json:"group"`
+	User   User   `json:"user"`
+}
+
+func (GroupMembership) IsNode() {}
+
+// A connection to a list of items.
+type GroupMembershipConnection struct {
+	// A list of edges.
+	Edges []*GroupMembershipEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for addUserToGroup and joinGroup mutations
+type GroupMembershipCreatePayload struct {
+	// Created group membership
+	GroupMembership GroupMembership `json:"groupMembership"`
+}
+
+// Return response for removeUserFromGroup mutation
+type GroupMembershipDeletePayload struct {
+	// Deleted group membership ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type GroupMembershipEdge struct {
+	// The item at the end of the edge.
+	Node *GroupMembership `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Return response for updateGroupMemberRole mutation
+type GroupMembershipUpdatePayload struct {
+	// Updated group membership
+	GroupMembership GroupMembership `json:"groupMembership"`
+}
+
+// GroupMembershipWhereInput is used for filtering GroupMembership objects.
+// Input was generated by ent.
+type GroupMembershipWhereInput struct {
+	Not *GroupMembershipWhereInput   `json:"not,omitempty"`
+	And []*GroupMembershipWhereInput `json:"and,omitempty"`
+	Or  []*GroupMembershipWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// role field predicates
+	Role      *groupmembership.Role  `json:"role,omitempty"`
+	RoleNeq   *groupmembership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []groupmembership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []groupmembership.Role `json:"roleNotIn,omitempty"`
+}
+
+// Ordering options for Group connections
+type GroupOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Groups.
+	Field GroupOrderField `json:"field"`
+}
+
+type GroupSetting struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// whether the group is visible to it's members / owners only or if it's searchable by anyone within the organization
+	Visibility groupsetting.Visibility `json:"visibility"`
+	// the policy governing ability to freely join a group, whether it requires an invitation, application, or either
+	JoinPolicy groupsetting.JoinPolicy `json:"joinPolicy"`
+	// tags associated with the object
+	Tags         []string `json:"tags"`
+	SyncToSlack  bool     `json:"syncToSlack"`
+	SyncToGithub bool     `json:"syncToGithub"`
+	Group        *Group   `json:"group,omitempty"`
+}
+
+func (GroupSetting) IsNode() {}
+
+// A connection to a list of items.
+type GroupSettingConnection struct {
+	// A list of edges.
+	Edges []*GroupSettingEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createGroupSetting mutation
+type GroupSettingCreatePayload struct {
 	// Created groupSetting
 	GroupSetting GroupSetting `json:"groupSetting"`
 }
@@ -726,18 +1375,415 @@ type GroupSettingWhereInput struct {
 	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
 }
 
-// Return response for updateGroup mutation
-type GroupUpdatePayload struct {
-	// Updated group
-	Group Group `json:"group"`
+// Return response for updateGroup mutation
+type GroupUpdatePayload struct {
+	// Updated group
+	Group Group `json:"group"`
+}
+
+// GroupWhereInput is used for filtering Group objects.
+// Input was generated by ent.
+type GroupWhereInput struct {
+	Not *GroupWhereInput   `json:"not,omitempty"`
+	And []*GroupWhereInput `json:"and,omitempty"`
+	Or  []*GroupWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// display_name field predicates
+	DisplayName             *string  `json:"displayName,omitempty"`
+	DisplayNameNeq          *string  `json:"displayNameNEQ,omitempty"`
+	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
+	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
+	DisplayNameGt           *string  `json:"displayNameGT,omitempty"`
+	DisplayNameGte          *string  `json:"displayNameGTE,omitempty"`
+	DisplayNameLt           *string  `json:"displayNameLT,omitempty"`
+	DisplayNameLte          *string  `json:"displayNameLTE,omitempty"`
+	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
+	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
+	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
+	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
+	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
+	// setting edge predicates
+	HasSetting     *bool                     `json:"hasSetting,omitempty"`
+	HasSettingWith []*GroupSettingWhereInput `json:"hasSettingWith,omitempty"`
+	// users edge predicates
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// members edge predicates
+	HasMembers     *bool                        `json:"hasMembers,omitempty"`
+	HasMembersWith []*GroupMembershipWhereInput `json:"hasMembersWith,omitempty"`
+}
+
+type Integration struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// the name of the integration - must be unique within the organization
+	Name string `json:"name"`
+	// a description of the integration
+	Description *string       `json:"description,omitempty"`
+	Kind        *string       `json:"kind,omitempty"`
+	SecretName  string        `json:"secretName"`
+	Owner       *Organization `json:"owner,omitempty"`
+}
+
+func (Integration) IsNode() {}
+
+// A connection to a list of items.
+type IntegrationConnection struct {
+	// A list of edges.
+	Edges []*IntegrationEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createIntegration mutation
+type IntegrationCreatePayload struct {
+	// Created integration
+	Integration Integration `json:"integration"`
+}
+
+// Return response for deleteIntegration mutation
+type IntegrationDeletePayload struct {
+	// Deleted integration ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type IntegrationEdge struct {
+	// The item at the end of the edge.
+	Node *Integration `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for Integration connections
+type IntegrationOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Integrations.
+	Field IntegrationOrderField `json:"field"`
+}
+
+// Return response for updateIntegration mutation
+type IntegrationUpdatePayload struct {
+	// Updated integration
+	Integration Integration `json:"integration"`
+}
+
+// IntegrationWhereInput is used for filtering Integration objects.
+// Input was generated by ent.
+type IntegrationWhereInput struct {
+	Not *IntegrationWhereInput   `json:"not,omitempty"`
+	And []*IntegrationWhereInput `json:"and,omitempty"`
+	Or  []*IntegrationWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// kind field predicates
+	Kind             *string  `json:"kind,omitempty"`
+	KindNeq          *string  `json:"kindNEQ,omitempty"`
+	KindIn           []string `json:"kindIn,omitempty"`
+	KindNotIn        []string `json:"kindNotIn,omitempty"`
+	KindGt           *string  `json:"kindGT,omitempty"`
+	KindGte          *string  `json:"kindGTE,omitempty"`
+	KindLt           *string  `json:"kindLT,omitempty"`
+	KindLte          *string  `json:"kindLTE,omitempty"`
+	KindContains     *string  `json:"kindContains,omitempty"`
+	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
+	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
+	KindIsNil        *bool    `json:"kindIsNil,omitempty"`
+	KindNotNil       *bool    `json:"kindNotNil,omitempty"`
+	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
+	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
+	// secret_name field predicates
+	SecretName             *string  `json:"secretName,omitempty"`
+	SecretNameNeq          *string  `json:"secretNameNEQ,omitempty"`
+	SecretNameIn           []string `json:"secretNameIn,omitempty"`
+	SecretNameNotIn        []string `json:"secretNameNotIn,omitempty"`
+	SecretNameGt           *string  `json:"secretNameGT,omitempty"`
+	SecretNameGte          *string  `json:"secretNameGTE,omitempty"`
+	SecretNameLt           *string  `json:"secretNameLT,omitempty"`
+	SecretNameLte          *string  `json:"secretNameLTE,omitempty"`
+	SecretNameContains     *string  `json:"secretNameContains,omitempty"`
+	SecretNameHasPrefix    *string  `json:"secretNameHasPrefix,omitempty"`
+	SecretNameHasSuffix    *string  `json:"secretNameHasSuffix,omitempty"`
+	SecretNameEqualFold    *string  `json:"secretNameEqualFold,omitempty"`
+	SecretNameContainsFold *string  `json:"secretNameContainsFold,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+type Invite struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// the organization the recipient is invited to
+	OwnerID string `json:"ownerID"`
+	// the expiration of the invitation, invitations expire 7 days after they are sent
+	Expires time.Time `json:"expires"`
+	// the email address of the user invited to the organization
+	Recipient string `json:"recipient"`
+	// the status of the invitation
+	Status invite.Status `json:"status"`
+	// the role the recipient is given in the organization when they accept the invitation
+	Role invite.Role `json:"role"`
+	// the user who sent the invitation
+	RequestorID *string      `json:"requestorID,omitempty"`
+	Owner       Organization `json:"owner"`
+}
+
+func (Invite) IsNode() {}
+
+// A connection to a list of items.
+type InviteConnection struct {
+	// A list of edges.
+	Edges []*InviteEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
 }
 
-// GroupWhereInput is used for filtering Group objects.
+// Return response for createInvite mutation
+type InviteCreatePayload struct {
+	// Created invite
+	Invite Invite `json:"invite"`
+}
+
+// An edge in a connection.
+type InviteEdge struct {
+	// The item at the end of the edge.
+	Node *Invite `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Return response for revokeInvite mutation
+type InviteRevokePayload struct {
+	// Revoked invite
+	Invite Invite `json:"invite"`
+}
+
+// InviteWhereInput is used for filtering Invite objects.
 // Input was generated by ent.
-type GroupWhereInput struct {
-	Not *GroupWhereInput   `json:"not,omitempty"`
-	And []*GroupWhereInput `json:"and,omitempty"`
-	Or  []*GroupWhereInput `json:"or,omitempty"`
+type InviteWhereInput struct {
+	Not *InviteWhereInput   `json:"not,omitempty"`
+	And []*InviteWhereInput `json:"and,omitempty"`
+	Or  []*InviteWhereInput `json:"or,omitempty"`
 	// id field predicates
 	ID             *string  `json:"id,omitempty"`
 	IDNeq          *string  `json:"idNEQ,omitempty"`
@@ -826,46 +1872,75 @@ type GroupWhereInput struct {
 	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
-	// name field predicates
-	Name             *string  `json:"name,omitempty"`
-	NameNeq          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGt           *string  `json:"nameGT,omitempty"`
-	NameGte          *string  `json:"nameGTE,omitempty"`
-	NameLt           *string  `json:"nameLT,omitempty"`
-	NameLte          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-	// display_name field predicates
-	DisplayName             *string  `json:"displayName,omitempty"`
-	DisplayNameNeq          *string  `json:"displayNameNEQ,omitempty"`
-	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
-	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
-	DisplayNameGt           *string  `json:"displayNameGT,omitempty"`
-	DisplayNameGte          *string  `json:"displayNameGTE,omitempty"`
-	DisplayNameLt           *string  `json:"displayNameLT,omitempty"`
-	DisplayNameLte          *string  `json:"displayNameLTE,omitempty"`
-	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
-	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
-	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
-	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
-	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
-	// setting edge predicates
-	HasSetting     *bool                     `json:"hasSetting,omitempty"`
-	HasSettingWith []*GroupSettingWhereInput `json:"hasSettingWith,omitempty"`
-	// users edge predicates
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// expires field predicates
+	Expires      *time.Time   `json:"expires,omitempty"`
+	ExpiresNeq   *time.Time   `json:"expiresNEQ,omitempty"`
+	ExpiresIn    []*time.Time `json:"expiresIn,omitempty"`
+	ExpiresNotIn []*time.Time `json:"expiresNotIn,omitempty"`
+	ExpiresGt    *time.Time   `json:"expiresGT,omitempty"`
+	ExpiresGte   *time.Time   `json:"expiresGTE,omitempty"`
+	ExpiresLt    *time.Time   `json:"expiresLT,omitempty"`
+	ExpiresLte   *time.Time   `json:"expiresLTE,omitempty"`
+	// recipient field predicates
+	Recipient             *string  `json:"recipient,omitempty"`
+	RecipientNeq          *string  `json:"recipientNEQ,omitempty"`
+	RecipientIn           []string `json:"recipientIn,omitempty"`
+	RecipientNotIn        []string `json:"recipientNotIn,omitempty"`
+	RecipientGt           *string  `json:"recipientGT,omitempty"`
+	RecipientGte          *string  `json:"recipientGTE,omitempty"`
+	RecipientLt           *string  `json:"recipientLT,omitempty"`
+	RecipientLte          *string  `json:"recipientLTE,omitempty"`
+	RecipientContains     *string  `json:"recipientContains,omitempty"`
+	RecipientHasPrefix    *string  `json:"recipientHasPrefix,omitempty"`
+	RecipientHasSuffix    *string  `json:"recipientHasSuffix,omitempty"`
+	RecipientEqualFold    *string  `json:"recipientEqualFold,omitempty"`
+	RecipientContainsFold *string  `json:"recipientContainsFold,omitempty"`
+	// status field predicates
+	Status      *invite.Status  `json:"status,omitempty"`
+	StatusNeq   *invite.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []invite.Status `json:"statusIn,omitempty"`
+	StatusNotIn []invite.Status `json:"statusNotIn,omitempty"`
+	// role field predicates
+	Role      *invite.Role  `json:"role,omitempty"`
+	RoleNeq   *invite.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []invite.Role `json:"roleIn,omitempty"`
+	RoleNotIn []invite.Role `json:"roleNotIn,omitempty"`
+	// requestor_id field predicates
+	RequestorID             *string  `json:"requestorID,omitempty"`
+	RequestorIDNeq          *string  `json:"requestorIDNEQ,omitempty"`
+	RequestorIDIn           []string `json:"requestorIDIn,omitempty"`
+	RequestorIDNotIn        []string `json:"requestorIDNotIn,omitempty"`
+	RequestorIDGt           *string  `json:"requestorIDGT,omitempty"`
+	RequestorIDGte          *string  `json:"requestorIDGTE,omitempty"`
+	RequestorIDLt           *string  `json:"requestorIDLT,omitempty"`
+	RequestorIDLte          *string  `json:"requestorIDLTE,omitempty"`
+	RequestorIDContains     *string  `json:"requestorIDContains,omitempty"`
+	RequestorIDHasPrefix    *string  `json:"requestorIDHasPrefix,omitempty"`
+	RequestorIDHasSuffix    *string  `json:"requestorIDHasSuffix,omitempty"`
+	RequestorIDIsNil        *bool    `json:"requestorIDIsNil,omitempty"`
+	RequestorIDNotNil       *bool    `json:"requestorIDNotNil,omitempty"`
+	RequestorIDEqualFold    *string  `json:"requestorIDEqualFold,omitempty"`
+	RequestorIDContainsFold *string  `json:"requestorIDContainsFold,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
-type Integration struct {
+type OauthClient struct {
 	ID        string     `json:"id"`
 	CreatedAt time.Time  `json:"createdAt"`
 	UpdatedAt time.Time  `json:"updatedAt"`
@@ -873,67 +1948,69 @@ type Integration struct {
 	UpdatedBy *string    `json:"updatedBy,omitempty"`
 	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	DeletedBy *string    `json:"deletedBy,omitempty"`
-	// the name of the integration - must be unique within the organization
+	// the organization that registered the client
+	OwnerID string `json:"ownerID"`
+	// the name of the client shown to users when they authorize it
 	Name string `json:"name"`
-	// a description of the integration
-	Description *string       `json:"description,omitempty"`
-	Kind        *string       `json:"kind,omitempty"`
-	SecretName  string        `json:"secretName"`
-	Owner       *Organization `json:"owner,omitempty"`
+	// the public identifier of the client used in authorization requests
+	ClientID string `json:"clientID"`
+	// the urls users can be redirected to after authorizing the client, the redirect uri of an authorization request must exactly match one of them
+	RedirectUris []string `json:"redirectUris"`
+	// the scopes the client can request, e.g. openid, email or org:read; the scopes cannot be changed once the client is created
+	Scopes []string `json:"scopes"`
+	// public clients, such as single page and native apps, cannot keep a secret; they are not issued a secret and must use PKCE
+	Public bool `json:"public"`
+	// a description of the client
+	Description *string      `json:"description,omitempty"`
+	Owner       Organization `json:"owner"`
 }
 
-func (Integration) IsNode() {}
+func (OauthClient) IsNode() {}
 
 // A connection to a list of items.
-type IntegrationConnection struct {
+type OauthClientConnection struct {
 	// A list of edges.
-	Edges []*IntegrationEdge `json:"edges,omitempty"`
+	Edges []*OauthClientEdge `json:"edges,omitempty"`
 	// Information to aid in pagination.
 	PageInfo PageInfo `json:"pageInfo"`
 	// Identifies the total count of items in the connection.
 	TotalCount int64 `json:"totalCount"`
 }
 
-// Return response for createIntegration mutation
-type IntegrationCreatePayload struct {
-	// Created integration
-	Integration Integration `json:"integration"`
+// Return response for createOauthClient mutation
+type OauthClientCreatePayload struct {
+	// Created oauthClient
+	OauthClient OauthClient `json:"oauthClient"`
+	// The oauthClient secret, this is only returned once and cannot be retrieved again; public clients do not have a secret
+	ClientSecret *string `json:"clientSecret,omitempty"`
 }
 
-// Return response for deleteIntegration mutation
-type IntegrationDeletePayload struct {
-	// Deleted integration ID
+// Return response for deleteOauthClient mutation
+type OauthClientDeletePayload struct {
+	// Deleted oauthClient ID
 	DeletedID string `json:"deletedID"`
 }
 
 // An edge in a connection.
-type IntegrationEdge struct {
+type OauthClientEdge struct {
 	// The item at the end of the edge.
-	Node *Integration `json:"node,omitempty"`
+	Node *OauthClient `json:"node,omitempty"`
 	// A cursor for use in pagination.
 	Cursor string `json:"cursor"`
 }
 
-// Ordering options for Integration connections
-type IntegrationOrder struct {
-	// The ordering direction.
-	Direction OrderDirection `json:"direction"`
-	// The field by which to order Integrations.
-	Field IntegrationOrderField `json:"field"`
-}
-
-// Return response for updateIntegration mutation
-type IntegrationUpdatePayload struct {
-	// Updated integration
-	Integration Integration `json:"integration"`
+// Return response for updateOauthClient mutation
+type OauthClientUpdatePayload struct {
+	// Updated oauthClient
+	OauthClient OauthClient `json:"oauthClient"`
 }
 
-// IntegrationWhereInput is used for filtering Integration objects.
+// OauthClientWhereInput is used for filtering OauthClient objects.
 // Input was generated by ent.
-type IntegrationWhereInput struct {
-	Not *IntegrationWhereInput   `json:"not,omitempty"`
-	And []*IntegrationWhereInput `json:"and,omitempty"`
-	Or  []*IntegrationWhereInput `json:"or,omitempty"`
+type OauthClientWhereInput struct {
+	Not *OauthClientWhereInput   `json:"not,omitempty"`
+	And []*OauthClientWhereInput `json:"and,omitempty"`
+	Or  []*OauthClientWhereInput `json:"or,omitempty"`
 	// id field predicates
 	ID             *string  `json:"id,omitempty"`
 	IDNeq          *string  `json:"idNEQ,omitempty"`
@@ -1022,6 +2099,20 @@ type IntegrationWhereInput struct {
 	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
 	// name field predicates
 	Name             *string  `json:"name,omitempty"`
 	NameNeq          *string  `json:"nameNEQ,omitempty"`
@@ -1036,36 +2127,23 @@ type IntegrationWhereInput struct {
 	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-	// kind field predicates
-	Kind             *string  `json:"kind,omitempty"`
-	KindNeq          *string  `json:"kindNEQ,omitempty"`
-	KindIn           []string `json:"kindIn,omitempty"`
-	KindNotIn        []string `json:"kindNotIn,omitempty"`
-	KindGt           *string  `json:"kindGT,omitempty"`
-	KindGte          *string  `json:"kindGTE,omitempty"`
-	KindLt           *string  `json:"kindLT,omitempty"`
-	KindLte          *string  `json:"kindLTE,omitempty"`
-	KindContains     *string  `json:"kindContains,omitempty"`
-	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
-	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
-	KindIsNil        *bool    `json:"kindIsNil,omitempty"`
-	KindNotNil       *bool    `json:"kindNotNil,omitempty"`
-	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
-	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
-	// secret_name field predicates
-	SecretName             *string  `json:"secretName,omitempty"`
-	SecretNameNeq          *string  `json:"secretNameNEQ,omitempty"`
-	SecretNameIn           []string `json:"secretNameIn,omitempty"`
-	SecretNameNotIn        []string `json:"secretNameNotIn,omitempty"`
-	SecretNameGt           *string  `json:"secretNameGT,omitempty"`
-	SecretNameGte          *string  `json:"secretNameGTE,omitempty"`
-	SecretNameLt           *string  `json:"secretNameLT,omitempty"`
-	SecretNameLte          *string  `json:"secretNameLTE,omitempty"`
-	SecretNameContains     *string  `json:"secretNameContains,omitempty"`
-	SecretNameHasPrefix    *string  `json:"secretNameHasPrefix,omitempty"`
-	SecretNameHasSuffix    *string  `json:"secretNameHasSuffix,omitempty"`
-	SecretNameEqualFold    *string  `json:"secretNameEqualFold,omitempty"`
-	SecretNameContainsFold *string  `json:"secretNameContainsFold,omitempty"`
+	// client_id field predicates
+	ClientID             *string  `json:"clientID,omitempty"`
+	ClientIDNeq          *string  `json:"clientIDNEQ,omitempty"`
+	ClientIDIn           []string `json:"clientIDIn,omitempty"`
+	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
+	ClientIDGt           *string  `json:"clientIDGT,omitempty"`
+	ClientIDGte          *string  `json:"clientIDGTE,omitempty"`
+	ClientIDLt           *string  `json:"clientIDLT,omitempty"`
+	ClientIDLte          *string  `json:"clientIDLTE,omitempty"`
+	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
+	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
+	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
+	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
+	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
+	// public field predicates
+	Public    *bool `json:"public,omitempty"`
+	PublicNeq *bool `json:"publicNEQ,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -1362,7 +2440,7 @@ type OhAuthTooToken struct {
 	ID                      string    `json:"id"`
 	ClientID                string    `json:"clientID"`
 	Scopes                  []string  `json:"scopes,omitempty"`
-	Nonce                   string    `json:"nonce"`
+	Nonce                   *string   `json:"nonce,omitempty"`
 	ClaimsUserID            string    `json:"claimsUserID"`
 	ClaimsUsername          string    `json:"claimsUsername"`
 	ClaimsEmail             string    `json:"claimsEmail"`
@@ -1455,6 +2533,8 @@ type OhAuthTooTokenWhereInput struct {
 	NonceContains     *string  `json:"nonceContains,omitempty"`
 	NonceHasPrefix    *string  `json:"nonceHasPrefix,omitempty"`
 	NonceHasSuffix    *string  `json:"nonceHasSuffix,omitempty"`
+	NonceIsNil        *bool    `json:"nonceIsNil,omitempty"`
+	NonceNotNil       *bool    `json:"nonceNotNil,omitempty"`
 	NonceEqualFold    *string  `json:"nonceEqualFold,omitempty"`
 	NonceContainsFold *string  `json:"nonceContainsFold,omitempty"`
 	// claims_user_id field predicates
@@ -1541,6 +2621,134 @@ type OhAuthTooTokenWhereInput struct {
 	LastUsedLte   *time.Time   `json:"lastUsedLTE,omitempty"`
 }
 
+type OrgMembership struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedBy *string   `json:"createdBy,omitempty"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	// the role of the user in the organization
+	Role orgmembership.Role `json:"role"`
+	// the organization the user is a member of
+	OrganizationID string `json:"organizationID"`
+	// the user who is a member of the organization
+	UserID       string       `json:"userID"`
+	Organization Organization `json:"organization"`
+	User         User         `json:"user"`
+}
+
+func (OrgMembership) IsNode() {}
+
+// A connection to a list of items.
+type OrgMembershipConnection struct {
+	// A list of edges.
+	Edges []*OrgMembershipEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for addUserToOrganization mutation
+type OrgMembershipCreatePayload struct {
+	// Created org membership
+	OrgMembership OrgMembership `json:"orgMembership"`
+}
+
+// Return response for removeUserFromOrganization mutation
+type OrgMembershipDeletePayload struct {
+	// Deleted org membership ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type OrgMembershipEdge struct {
+	// The item at the end of the edge.
+	Node *OrgMembership `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Return response for updateOrgMemberRole mutation
+type OrgMembershipUpdatePayload struct {
+	// Updated org membership
+	OrgMembership OrgMembership `json:"orgMembership"`
+}
+
+// OrgMembershipWhereInput is used for filtering OrgMembership objects.
+// Input was generated by ent.
+type OrgMembershipWhereInput struct {
+	Not *OrgMembershipWhereInput   `json:"not,omitempty"`
+	And []*OrgMembershipWhereInput `json:"and,omitempty"`
+	Or  []*OrgMembershipWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// role field predicates
+	Role      *orgmembership.Role  `json:"role,omitempty"`
+	RoleNeq   *orgmembership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []orgmembership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []orgmembership.Role `json:"roleNotIn,omitempty"`
+}
+
 type Organization struct {
 	ID        string     `json:"id"`
 	CreatedAt time.Time  `json:"createdAt"`
@@ -1565,6 +2773,10 @@ type Organization struct {
 	Setting       *OrganizationSetting   `json:"setting,omitempty"`
 	Entitlements  []*Entitlement         `json:"entitlements,omitempty"`
 	Oauthprovider []*OauthProvider       `json:"oauthprovider,omitempty"`
+	APIKeys       []*APIKey              `json:"apiKeys,omitempty"`
+	OauthClients  []*OauthClient         `json:"oauthClients,omitempty"`
+	Invites       []*Invite              `json:"invites,omitempty"`
+	Members       []*OrgMembership       `json:"members,omitempty"`
 }
 
 func (Organization) IsNode() {}
@@ -2054,6 +3266,227 @@ type OrganizationWhereInput struct {
 	// oauthprovider edge predicates
 	HasOauthprovider     *bool                      `json:"hasOauthprovider,omitempty"`
 	HasOauthproviderWith []*OauthProviderWhereInput `json:"hasOauthproviderWith,omitempty"`
+	// api_keys edge predicates
+	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
+	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
+	// oauth_clients edge predicates
+	HasOauthClients     *bool                    `json:"hasOauthClients,omitempty"`
+	HasOauthClientsWith []*OauthClientWhereInput `json:"hasOauthClientsWith,omitempty"`
+	// invites edge predicates
+	HasInvites     *bool               `json:"hasInvites,omitempty"`
+	HasInvitesWith []*InviteWhereInput `json:"hasInvitesWith,omitempty"`
+	// members edge predicates
+	HasMembers     *bool                      `json:"hasMembers,omitempty"`
+	HasMembersWith []*OrgMembershipWhereInput `json:"hasMembersWith,omitempty"`
+}
+
+type OwnershipTransfer struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedBy *string   `json:"createdBy,omitempty"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	// the organization that is transferred, or the organization of the group that is transferred
+	OwnerID string `json:"ownerID"`
+	// the group that is transferred, not set when the organization is transferred
+	GroupID *string `json:"groupID,omitempty"`
+	// the owner of the organization, or admin of the group, who transfers it
+	RequestorID string `json:"requestorID"`
+	// the user the organization or group is transferred to
+	RecipientID string `json:"recipientID"`
+	// the status of the transfer
+	Status ownershiptransfer.Status `json:"status"`
+	// the expiration of the transfer, transfers expire 7 days after they are requested
+	Expires   time.Time    `json:"expires"`
+	Owner     Organization `json:"owner"`
+	Group     *Group       `json:"group,omitempty"`
+	Requestor User         `json:"requestor"`
+	Recipient User         `json:"recipient"`
+}
+
+func (OwnershipTransfer) IsNode() {}
+
+// Return response for cancelOwnershipTransfer mutation
+type OwnershipTransferCancelPayload struct {
+	// Canceled ownership transfer
+	OwnershipTransfer OwnershipTransfer `json:"ownershipTransfer"`
+}
+
+// A connection to a list of items.
+type OwnershipTransferConnection struct {
+	// A list of edges.
+	Edges []*OwnershipTransferEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for transferOrganizationOwnership and transferGroupOwnership mutations
+type OwnershipTransferCreatePayload struct {
+	// Created ownership transfer
+	OwnershipTransfer OwnershipTransfer `json:"ownershipTransfer"`
+}
+
+// An edge in a connection.
+type OwnershipTransferEdge struct {
+	// The item at the end of the edge.
+	Node *OwnershipTransfer `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// OwnershipTransferWhereInput is used for filtering OwnershipTransfer objects.
+// Input was generated by ent.
+type OwnershipTransferWhereInput struct {
+	Not *OwnershipTransferWhereInput   `json:"not,omitempty"`
+	And []*OwnershipTransferWhereInput `json:"and,omitempty"`
+	Or  []*OwnershipTransferWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// group_id field predicates
+	GroupID             *string  `json:"groupID,omitempty"`
+	GroupIDNeq          *string  `json:"groupIDNEQ,omitempty"`
+	GroupIDIn           []string `json:"groupIDIn,omitempty"`
+	GroupIDNotIn        []string `json:"groupIDNotIn,omitempty"`
+	GroupIDGt           *string  `json:"groupIDGT,omitempty"`
+	GroupIDGte          *string  `json:"groupIDGTE,omitempty"`
+	GroupIDLt           *string  `json:"groupIDLT,omitempty"`
+	GroupIDLte          *string  `json:"groupIDLTE,omitempty"`
+	GroupIDContains     *string  `json:"groupIDContains,omitempty"`
+	GroupIDHasPrefix    *string  `json:"groupIDHasPrefix,omitempty"`
+	GroupIDHasSuffix    *string  `json:"groupIDHasSuffix,omitempty"`
+	GroupIDIsNil        *bool    `json:"groupIDIsNil,omitempty"`
+	GroupIDNotNil       *bool    `json:"groupIDNotNil,omitempty"`
+	GroupIDEqualFold    *string  `json:"groupIDEqualFold,omitempty"`
+	GroupIDContainsFold *string  `json:"groupIDContainsFold,omitempty"`
+	// requestor_id field predicates
+	RequestorID             *string  `json:"requestorID,omitempty"`
+	RequestorIDNeq          *string  `json:"requestorIDNEQ,omitempty"`
+	RequestorIDIn           []string `json:"requestorIDIn,omitempty"`
+	RequestorIDNotIn        []string `json:"requestorIDNotIn,omitempty"`
+	RequestorIDGt           *string  `json:"requestorIDGT,omitempty"`
+	RequestorIDGte          *string  `json:"requestorIDGTE,omitempty"`
+	RequestorIDLt           *string  `json:"requestorIDLT,omitempty"`
+	RequestorIDLte          *string  `json:"requestorIDLTE,omitempty"`
+	RequestorIDContains     *string  `json:"requestorIDContains,omitempty"`
+	RequestorIDHasPrefix    *string  `json:"requestorIDHasPrefix,omitempty"`
+	RequestorIDHasSuffix    *string  `json:"requestorIDHasSuffix,omitempty"`
+	RequestorIDEqualFold    *string  `json:"requestorIDEqualFold,omitempty"`
+	RequestorIDContainsFold *string  `json:"requestorIDContainsFold,omitempty"`
+	// recipient_id field predicates
+	RecipientID             *string  `json:"recipientID,omitempty"`
+	RecipientIDNeq          *string  `json:"recipientIDNEQ,omitempty"`
+	RecipientIDIn           []string `json:"recipientIDIn,omitempty"`
+	RecipientIDNotIn        []string `json:"recipientIDNotIn,omitempty"`
+	RecipientIDGt           *string  `json:"recipientIDGT,omitempty"`
+	RecipientIDGte          *string  `json:"recipientIDGTE,omitempty"`
+	RecipientIDLt           *string  `json:"recipientIDLT,omitempty"`
+	RecipientIDLte          *string  `json:"recipientIDLTE,omitempty"`
+	RecipientIDContains     *string  `json:"recipientIDContains,omitempty"`
+	RecipientIDHasPrefix    *string  `json:"recipientIDHasPrefix,omitempty"`
+	RecipientIDHasSuffix    *string  `json:"recipientIDHasSuffix,omitempty"`
+	RecipientIDEqualFold    *string  `json:"recipientIDEqualFold,omitempty"`
+	RecipientIDContainsFold *string  `json:"recipientIDContainsFold,omitempty"`
+	// status field predicates
+	Status      *ownershiptransfer.Status  `json:"status,omitempty"`
+	StatusNeq   *ownershiptransfer.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []ownershiptransfer.Status `json:"statusIn,omitempty"`
+	StatusNotIn []ownershiptransfer.Status `json:"statusNotIn,omitempty"`
+	// expires field predicates
+	Expires      *time.Time   `json:"expires,omitempty"`
+	ExpiresNeq   *time.Time   `json:"expiresNEQ,omitempty"`
+	ExpiresIn    []*time.Time `json:"expiresIn,omitempty"`
+	ExpiresNotIn []*time.Time `json:"expiresNotIn,omitempty"`
+	ExpiresGt    *time.Time   `json:"expiresGT,omitempty"`
+	ExpiresGte   *time.Time   `json:"expiresGTE,omitempty"`
+	ExpiresLt    *time.Time   `json:"expiresLT,omitempty"`
+	ExpiresLte   *time.Time   `json:"expiresLTE,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// group edge predicates
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
+	// requestor edge predicates
+	HasRequestor     *bool             `json:"hasRequestor,omitempty"`
+	HasRequestorWith []*UserWhereInput `json:"hasRequestorWith,omitempty"`
+	// recipient edge predicates
+	HasRecipient     *bool             `json:"hasRecipient,omitempty"`
+	HasRecipientWith []*UserWhereInput `json:"hasRecipientWith,omitempty"`
 }
 
 // Information about pagination in a connection.
@@ -2079,7 +3512,9 @@ type PersonalAccessToken struct {
 	DeletedBy *string    `json:"deletedBy,omitempty"`
 	// the name associated with the token
 	Name string `json:"name"`
-	// what abilites the token should have
+	// the visible prefix of the token used to identify it, the token itself is only returned on creation
+	TokenPrefix string `json:"tokenPrefix"`
+	// the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner
 	Abilities []string `json:"abilities,omitempty"`
 	// when the token expires
 	ExpiresAt time.Time `json:"expiresAt"`
@@ -2105,6 +3540,8 @@ type PersonalAccessTokenConnection struct {
 type PersonalAccessTokenCreatePayload struct {
 	// Created personalAccessToken
 	PersonalAccessToken PersonalAccessToken `json:"personalAccessToken"`
+	// The personalAccessToken secret, this is only returned once and cannot be retrieved again
+	Token string `json:"token"`
 }
 
 // Return response for deletePersonalAccessToken mutation
@@ -2235,6 +3672,20 @@ type PersonalAccessTokenWhereInput struct {
 	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// token_prefix field predicates
+	TokenPrefix             *string  `json:"tokenPrefix,omitempty"`
+	TokenPrefixNeq          *string  `json:"tokenPrefixNEQ,omitempty"`
+	TokenPrefixIn           []string `json:"tokenPrefixIn,omitempty"`
+	TokenPrefixNotIn        []string `json:"tokenPrefixNotIn,omitempty"`
+	TokenPrefixGt           *string  `json:"tokenPrefixGT,omitempty"`
+	TokenPrefixGte          *string  `json:"tokenPrefixGTE,omitempty"`
+	TokenPrefixLt           *string  `json:"tokenPrefixLT,omitempty"`
+	TokenPrefixLte          *string  `json:"tokenPrefixLTE,omitempty"`
+	TokenPrefixContains     *string  `json:"tokenPrefixContains,omitempty"`
+	TokenPrefixHasPrefix    *string  `json:"tokenPrefixHasPrefix,omitempty"`
+	TokenPrefixHasSuffix    *string  `json:"tokenPrefixHasSuffix,omitempty"`
+	TokenPrefixEqualFold    *string  `json:"tokenPrefixEqualFold,omitempty"`
+	TokenPrefixContainsFold *string  `json:"tokenPrefixContainsFold,omitempty"`
 	// expires_at field predicates
 	ExpiresAt      *time.Time   `json:"expiresAt,omitempty"`
 	ExpiresAtNeq   *time.Time   `json:"expiresAtNEQ,omitempty"`
@@ -2271,9 +3722,17 @@ type Session struct {
 	IssuedAt     time.Time `json:"issuedAt"`
 	ExpiresAt    time.Time `json:"expiresAt"`
 	// organization ID of the organization the user is accessing
-	OrganizationID string `json:"organizationID"`
+	OrganizationID *string `json:"organizationID,omitempty"`
 	// the user the session is associated with
 	UserID string `json:"userID"`
+	// the user agent of the client the session was started from
+	UserAgent *string `json:"userAgent,omitempty"`
+	// the ip address of the client the session was started from
+	IPAddress *string `json:"ipAddress,omitempty"`
+	// the last time the tokens of the session were refreshed
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+	// the time the session was revoked, the tokens issued for a revoked session are rejected
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
 	// Sessions belong to users
 	Owner User `json:"owner"`
 }
@@ -2427,6 +3886,8 @@ type SessionWhereInput struct {
 	OrganizationIDContains     *string  `json:"organizationIDContains,omitempty"`
 	OrganizationIDHasPrefix    *string  `json:"organizationIDHasPrefix,omitempty"`
 	OrganizationIDHasSuffix    *string  `json:"organizationIDHasSuffix,omitempty"`
+	OrganizationIDIsNil        *bool    `json:"organizationIDIsNil,omitempty"`
+	OrganizationIDNotNil       *bool    `json:"organizationIDNotNil,omitempty"`
 	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
 	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
 	// user_id field predicates
@@ -2443,11 +3904,97 @@ type SessionWhereInput struct {
 	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
 	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
 	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+	// user_agent field predicates
+	UserAgent             *string  `json:"userAgent,omitempty"`
+	UserAgentNeq          *string  `json:"userAgentNEQ,omitempty"`
+	UserAgentIn           []string `json:"userAgentIn,omitempty"`
+	UserAgentNotIn        []string `json:"userAgentNotIn,omitempty"`
+	UserAgentGt           *string  `json:"userAgentGT,omitempty"`
+	UserAgentGte          *string  `json:"userAgentGTE,omitempty"`
+	UserAgentLt           *string  `json:"userAgentLT,omitempty"`
+	UserAgentLte          *string  `json:"userAgentLTE,omitempty"`
+	UserAgentContains     *string  `json:"userAgentContains,omitempty"`
+	UserAgentHasPrefix    *string  `json:"userAgentHasPrefix,omitempty"`
+	UserAgentHasSuffix    *string  `json:"userAgentHasSuffix,omitempty"`
+	UserAgentIsNil        *bool    `json:"userAgentIsNil,omitempty"`
+	UserAgentNotNil       *bool    `json:"userAgentNotNil,omitempty"`
+	UserAgentEqualFold    *string  `json:"userAgentEqualFold,omitempty"`
+	UserAgentContainsFold *string  `json:"userAgentContainsFold,omitempty"`
+	// ip_address field predicates
+	IPAddress             *string  `json:"ipAddress,omitempty"`
+	IPAddressNeq          *string  `json:"ipAddressNEQ,omitempty"`
+	IPAddressIn           []string `json:"ipAddressIn,omitempty"`
+	IPAddressNotIn        []string `json:"ipAddressNotIn,omitempty"`
+	IPAddressGt           *string  `json:"ipAddressGT,omitempty"`
+	IPAddressGte          *string  `json:"ipAddressGTE,omitempty"`
+	IPAddressLt           *string  `json:"ipAddressLT,omitempty"`
+	IPAddressLte          *string  `json:"ipAddressLTE,omitempty"`
+	IPAddressContains     *string  `json:"ipAddressContains,omitempty"`
+	IPAddressHasPrefix    *string  `json:"ipAddressHasPrefix,omitempty"`
+	IPAddressHasSuffix    *string  `json:"ipAddressHasSuffix,omitempty"`
+	IPAddressIsNil        *bool    `json:"ipAddressIsNil,omitempty"`
+	IPAddressNotNil       *bool    `json:"ipAddressNotNil,omitempty"`
+	IPAddressEqualFold    *string  `json:"ipAddressEqualFold,omitempty"`
+	IPAddressContainsFold *string  `json:"ipAddressContainsFold,omitempty"`
+	// last_seen_at field predicates
+	LastSeenAt       *time.Time   `json:"lastSeenAt,omitempty"`
+	LastSeenAtNeq    *time.Time   `json:"lastSeenAtNEQ,omitempty"`
+	LastSeenAtIn     []*time.Time `json:"lastSeenAtIn,omitempty"`
+	LastSeenAtNotIn  []*time.Time `json:"lastSeenAtNotIn,omitempty"`
+	LastSeenAtGt     *time.Time   `json:"lastSeenAtGT,omitempty"`
+	LastSeenAtGte    *time.Time   `json:"lastSeenAtGTE,omitempty"`
+	LastSeenAtLt     *time.Time   `json:"lastSeenAtLT,omitempty"`
+	LastSeenAtLte    *time.Time   `json:"lastSeenAtLTE,omitempty"`
+	LastSeenAtIsNil  *bool        `json:"lastSeenAtIsNil,omitempty"`
+	LastSeenAtNotNil *bool        `json:"lastSeenAtNotNil,omitempty"`
+	// revoked_at field predicates
+	RevokedAt       *time.Time   `json:"revokedAt,omitempty"`
+	RevokedAtNeq    *time.Time   `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []*time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []*time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGt     *time.Time   `json:"revokedAtGT,omitempty"`
+	RevokedAtGte    *time.Time   `json:"revokedAtGTE,omitempty"`
+	RevokedAtLt     *time.Time   `json:"revokedAtLT,omitempty"`
+	RevokedAtLte    *time.Time   `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  *bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil *bool        `json:"revokedAtNotNil,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool             `json:"hasOwner,omitempty"`
 	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
+// Return response for confirmTFA mutation
+type TFAConfirmPayload struct {
+	// Updated userSetting
+	UserSetting UserSetting `json:"userSetting"`
+	// one-time recovery codes, these are only returned once and should be stored securely
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// Return response for enrollTFA mutation
+type TFAEnrollPayload struct {
+	// base32 encoded totp secret
+	Secret string `json:"secret"`
+	// otpauth uri of the secret that can be rendered as a QR code
+	QRURI string `json:"qrURI"`
+}
+
+// UpdateAPIKeyInput is used for update APIKey object.
+// Input was generated by ent.
+type UpdateAPIKeyInput struct {
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy      *string    `json:"updatedBy,omitempty"`
+	ClearUpdatedBy *bool      `json:"clearUpdatedBy,omitempty"`
+	// the name associated with the key
+	Name *string `json:"name,omitempty"`
+	// when the key expires, a key without an expiration is valid until it is deleted
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	ClearExpiresAt *bool      `json:"clearExpiresAt,omitempty"`
+	// a description of the key's purpose
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
+}
+
 // UpdateEntitlementInput is used for update Entitlement object.
 // Input was generated by ent.
 type UpdateEntitlementInput struct {
@@ -2490,12 +4037,9 @@ type UpdateGroupInput struct {
 	LogoURL      *string `json:"logoURL,omitempty"`
 	ClearLogoURL *bool   `json:"clearLogoURL,omitempty"`
 	// The group's displayed 'friendly' name
-	DisplayName   *string  `json:"displayName,omitempty"`
-	SettingID     *string  `json:"settingID,omitempty"`
-	AddUserIDs    []string `json:"addUserIDs,omitempty"`
-	RemoveUserIDs []string `json:"removeUserIDs,omitempty"`
-	ClearUsers    *bool    `json:"clearUsers,omitempty"`
-	OwnerID       *string  `json:"ownerID,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	SettingID   *string `json:"settingID,omitempty"`
+	OwnerID     *string `json:"ownerID,omitempty"`
 }
 
 // UpdateGroupSettingInput is used for update GroupSetting object.
@@ -2534,6 +4078,22 @@ type UpdateIntegrationInput struct {
 	ClearOwner       *bool   `json:"clearOwner,omitempty"`
 }
 
+// UpdateOauthClientInput is used for update OauthClient object.
+// Input was generated by ent.
+type UpdateOauthClientInput struct {
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy      *string    `json:"updatedBy,omitempty"`
+	ClearUpdatedBy *bool      `json:"clearUpdatedBy,omitempty"`
+	// the name of the client shown to users when they authorize it
+	Name *string `json:"name,omitempty"`
+	// the urls users can be redirected to after authorizing the client, the redirect uri of an authorization request must exactly match one of them
+	RedirectUris       []string `json:"redirectUris,omitempty"`
+	AppendRedirectUris []string `json:"appendRedirectUris,omitempty"`
+	// a description of the client
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
+}
+
 // UpdateOauthProviderInput is used for update OauthProvider object.
 // Input was generated by ent.
 type UpdateOauthProviderInput struct {
@@ -2570,6 +4130,7 @@ type UpdateOhAuthTooTokenInput struct {
 	AppendScopes            []string   `json:"appendScopes,omitempty"`
 	ClearScopes             *bool      `json:"clearScopes,omitempty"`
 	Nonce                   *string    `json:"nonce,omitempty"`
+	ClearNonce              *bool      `json:"clearNonce,omitempty"`
 	ClaimsUserID            *string    `json:"claimsUserID,omitempty"`
 	ClaimsUsername          *string    `json:"claimsUsername,omitempty"`
 	ClaimsEmail             *string    `json:"claimsEmail,omitempty"`
@@ -2598,9 +4159,6 @@ type UpdateOrganizationInput struct {
 	// An optional description of the organization
 	Description            *string  `json:"description,omitempty"`
 	ClearDescription       *bool    `json:"clearDescription,omitempty"`
-	AddUserIDs             []string `json:"addUserIDs,omitempty"`
-	RemoveUserIDs          []string `json:"removeUserIDs,omitempty"`
-	ClearUsers             *bool    `json:"clearUsers,omitempty"`
 	AddGroupIDs            []string `json:"addGroupIDs,omitempty"`
 	RemoveGroupIDs         []string `json:"removeGroupIDs,omitempty"`
 	ClearGroups            *bool    `json:"clearGroups,omitempty"`
@@ -2615,6 +4173,15 @@ type UpdateOrganizationInput struct {
 	AddOauthproviderIDs    []string `json:"addOauthproviderIDs,omitempty"`
 	RemoveOauthproviderIDs []string `json:"removeOauthproviderIDs,omitempty"`
 	ClearOauthprovider     *bool    `json:"clearOauthprovider,omitempty"`
+	AddAPIKeyIDs           []string `json:"addAPIKeyIDs,omitempty"`
+	RemoveAPIKeyIDs        []string `json:"removeAPIKeyIDs,omitempty"`
+	ClearAPIKeys           *bool    `json:"clearAPIKeys,omitempty"`
+	AddOauthClientIDs      []string `json:"addOauthClientIDs,omitempty"`
+	RemoveOauthClientIDs   []string `json:"removeOauthClientIDs,omitempty"`
+	ClearOauthClients      *bool    `json:"clearOauthClients,omitempty"`
+	AddInviteIDs           []string `json:"addInviteIDs,omitempty"`
+	RemoveInviteIDs        []string `json:"removeInviteIDs,omitempty"`
+	ClearInvites           *bool    `json:"clearInvites,omitempty"`
 }
 
 // UpdateOrganizationSettingInput is used for update OrganizationSetting object.
@@ -2661,7 +4228,7 @@ type UpdatePersonalAccessTokenInput struct {
 	ClearUpdatedBy *bool      `json:"clearUpdatedBy,omitempty"`
 	// the name associated with the token
 	Name *string `json:"name,omitempty"`
-	// what abilites the token should have
+	// the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner
 	Abilities       []string `json:"abilities,omitempty"`
 	AppendAbilities []string `json:"appendAbilities,omitempty"`
 	ClearAbilities  *bool    `json:"clearAbilities,omitempty"`
@@ -2681,11 +4248,11 @@ type UpdateSessionInput struct {
 	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
 	UpdatedBy      *string    `json:"updatedBy,omitempty"`
 	ClearUpdatedBy *bool      `json:"clearUpdatedBy,omitempty"`
-	IssuedAt       *time.Time `json:"issuedAt,omitempty"`
 	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
 	// organization ID of the organization the user is accessing
-	OrganizationID *string `json:"organizationID,omitempty"`
-	OwnerID        *string `json:"ownerID,omitempty"`
+	OrganizationID      *string `json:"organizationID,omitempty"`
+	ClearOrganizationID *bool   `json:"clearOrganizationID,omitempty"`
+	OwnerID             *string `json:"ownerID,omitempty"`
 }
 
 // UpdateUserInput is used for update User object.
@@ -2719,15 +4286,9 @@ type UpdateUserInput struct {
 	ClearSub *bool   `json:"clearSub,omitempty"`
 	// whether the user uses oauth for login or not
 	Oauth                           *bool    `json:"oauth,omitempty"`
-	AddOrganizationIDs              []string `json:"addOrganizationIDs,omitempty"`
-	RemoveOrganizationIDs           []string `json:"removeOrganizationIDs,omitempty"`
-	ClearOrganizations              *bool    `json:"clearOrganizations,omitempty"`
 	AddSessionIDs                   []string `json:"addSessionIDs,omitempty"`
 	RemoveSessionIDs                []string `json:"removeSessionIDs,omitempty"`
 	ClearSessions                   *bool    `json:"clearSessions,omitempty"`
-	AddGroupIDs                     []string `json:"addGroupIDs,omitempty"`
-	RemoveGroupIDs                  []string `json:"removeGroupIDs,omitempty"`
-	ClearGroups                     *bool    `json:"clearGroups,omitempty"`
 	AddPersonalAccessTokenIDs       []string `json:"addPersonalAccessTokenIDs,omitempty"`
 	RemovePersonalAccessTokenIDs    []string `json:"removePersonalAccessTokenIDs,omitempty"`
 	ClearPersonalAccessTokens       *bool    `json:"clearPersonalAccessTokens,omitempty"`
@@ -2738,6 +4299,12 @@ type UpdateUserInput struct {
 	AddResetTokenIDs                []string `json:"addResetTokenIDs,omitempty"`
 	RemoveResetTokenIDs             []string `json:"removeResetTokenIDs,omitempty"`
 	ClearResetTokens                *bool    `json:"clearResetTokens,omitempty"`
+	AddMagicLinkTokenIDs            []string `json:"addMagicLinkTokenIDs,omitempty"`
+	RemoveMagicLinkTokenIDs         []string `json:"removeMagicLinkTokenIDs,omitempty"`
+	ClearMagicLinkTokens            *bool    `json:"clearMagicLinkTokens,omitempty"`
+	AddWebauthnCredentialIDs        []string `json:"addWebauthnCredentialIDs,omitempty"`
+	RemoveWebauthnCredentialIDs     []string `json:"removeWebauthnCredentialIDs,omitempty"`
+	ClearWebauthnCredentials        *bool    `json:"clearWebauthnCredentials,omitempty"`
 }
 
 // UpdateUserSettingInput is used for update UserSetting object.
@@ -2790,6 +4357,8 @@ type User struct {
 	AvatarUpdatedAt *time.Time `json:"avatarUpdatedAt,omitempty"`
 	// the time the user was last seen
 	LastSeen *time.Time `json:"lastSeen,omitempty"`
+	// the time the password was last set, passwords older than the max age of the password policy have to be reset
+	PasswordChangedAt *time.Time `json:"passwordChangedAt,omitempty"`
 	// the Subject of the user JWT
 	Sub *string `json:"sub,omitempty"`
 	// whether the user uses oauth for login or not
@@ -2799,6 +4368,9 @@ type User struct {
 	Groups               []*Group               `json:"groups,omitempty"`
 	PersonalAccessTokens []*PersonalAccessToken `json:"personalAccessTokens,omitempty"`
 	Setting              UserSetting            `json:"setting"`
+	WebauthnCredentials  []*WebauthnCredential  `json:"webauthnCredentials,omitempty"`
+	OrgMemberships       []*OrgMembership       `json:"orgMemberships,omitempty"`
+	GroupMemberships     []*GroupMembership     `json:"groupMemberships,omitempty"`
 }
 
 func (User) IsNode() {}
@@ -2861,7 +4433,13 @@ type UserSetting struct {
 	EmailConfirmed bool               `json:"emailConfirmed"`
 	// tags associated with the object
 	Tags []string `json:"tags"`
-	User *User    `json:"user,omitempty"`
+	// whether the user has confirmed enrollment in totp multi-factor authentication
+	IsTfaEnabled bool `json:"isTfaEnabled"`
+	// the number of consecutive failed logins, reset on a successful login
+	FailedLoginAttempts int64 `json:"failedLoginAttempts"`
+	// logins are rejected until this time after repeated failed logins
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	User        *User      `json:"user,omitempty"`
 }
 
 func (UserSetting) IsNode() {}
@@ -3034,6 +4612,29 @@ type UserSettingWhereInput struct {
 	// email_confirmed field predicates
 	EmailConfirmed    *bool `json:"emailConfirmed,omitempty"`
 	EmailConfirmedNeq *bool `json:"emailConfirmedNEQ,omitempty"`
+	// is_tfa_enabled field predicates
+	IsTfaEnabled    *bool `json:"isTfaEnabled,omitempty"`
+	IsTfaEnabledNeq *bool `json:"isTfaEnabledNEQ,omitempty"`
+	// failed_login_attempts field predicates
+	FailedLoginAttempts      *int64  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNeq   *int64  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int64 `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int64 `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGt    *int64  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGte   *int64  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLt    *int64  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLte   *int64  `json:"failedLoginAttemptsLTE,omitempty"`
+	// locked_until field predicates
+	LockedUntil       *time.Time   `json:"lockedUntil,omitempty"`
+	LockedUntilNeq    *time.Time   `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []*time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []*time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGt     *time.Time   `json:"lockedUntilGT,omitempty"`
+	LockedUntilGte    *time.Time   `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLt     *time.Time   `json:"lockedUntilLT,omitempty"`
+	LockedUntilLte    *time.Time   `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  *bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil *bool        `json:"lockedUntilNotNil,omitempty"`
 	// user edge predicates
 	HasUser     *bool             `json:"hasUser,omitempty"`
 	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
@@ -3249,6 +4850,17 @@ type UserWhereInput struct {
 	LastSeenLte    *time.Time   `json:"lastSeenLTE,omitempty"`
 	LastSeenIsNil  *bool        `json:"lastSeenIsNil,omitempty"`
 	LastSeenNotNil *bool        `json:"lastSeenNotNil,omitempty"`
+	// password_changed_at field predicates
+	PasswordChangedAt       *time.Time   `json:"passwordChangedAt,omitempty"`
+	PasswordChangedAtNeq    *time.Time   `json:"passwordChangedAtNEQ,omitempty"`
+	PasswordChangedAtIn     []*time.Time `json:"passwordChangedAtIn,omitempty"`
+	PasswordChangedAtNotIn  []*time.Time `json:"passwordChangedAtNotIn,omitempty"`
+	PasswordChangedAtGt     *time.Time   `json:"passwordChangedAtGT,omitempty"`
+	PasswordChangedAtGte    *time.Time   `json:"passwordChangedAtGTE,omitempty"`
+	PasswordChangedAtLt     *time.Time   `json:"passwordChangedAtLT,omitempty"`
+	PasswordChangedAtLte    *time.Time   `json:"passwordChangedAtLTE,omitempty"`
+	PasswordChangedAtIsNil  *bool        `json:"passwordChangedAtIsNil,omitempty"`
+	PasswordChangedAtNotNil *bool        `json:"passwordChangedAtNotNil,omitempty"`
 	// sub field predicates
 	Sub             *string  `json:"sub,omitempty"`
 	SubNeq          *string  `json:"subNEQ,omitempty"`
@@ -3283,6 +4895,241 @@ type UserWhereInput struct {
 	// setting edge predicates
 	HasSetting     *bool                    `json:"hasSetting,omitempty"`
 	HasSettingWith []*UserSettingWhereInput `json:"hasSettingWith,omitempty"`
+	// webauthn_credentials edge predicates
+	HasWebauthnCredentials     *bool                           `json:"hasWebauthnCredentials,omitempty"`
+	HasWebauthnCredentialsWith []*WebauthnCredentialWhereInput `json:"hasWebauthnCredentialsWith,omitempty"`
+	// org_memberships edge predicates
+	HasOrgMemberships     *bool                      `json:"hasOrgMemberships,omitempty"`
+	HasOrgMembershipsWith []*OrgMembershipWhereInput `json:"hasOrgMembershipsWith,omitempty"`
+	// group_memberships edge predicates
+	HasGroupMemberships     *bool                        `json:"hasGroupMemberships,omitempty"`
+	HasGroupMembershipsWith []*GroupMembershipWhereInput `json:"hasGroupMembershipsWith,omitempty"`
+}
+
+type WebauthnCredential struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// the user provided name of the passkey
+	Name *string `json:"name,omitempty"`
+	// the attestation format used by the authenticator when creating the credential
+	AttestationType *string `json:"attestationType,omitempty"`
+	// the AAGUID of the authenticator model
+	Aaguid *string `json:"aaguid,omitempty"`
+	// the signature counter of the authenticator, used to detect cloned authenticators
+	SignCount int64 `json:"signCount"`
+	// the transports the authenticator supports
+	Transports []string `json:"transports,omitempty"`
+	// whether the credential can be backed up or synced between devices
+	BackupEligible bool `json:"backupEligible"`
+	// whether the credential is currently backed up or synced
+	BackupState bool `json:"backupState"`
+	// the last time the credential was used to authenticate
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Owner      User       `json:"owner"`
+}
+
+func (WebauthnCredential) IsNode() {}
+
+// A connection to a list of items.
+type WebauthnCredentialConnection struct {
+	// A list of edges.
+	Edges []*WebauthnCredentialEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for deleteWebauthnCredential mutation
+type WebauthnCredentialDeletePayload struct {
+	// Deleted webauthnCredential ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type WebauthnCredentialEdge struct {
+	// The item at the end of the edge.
+	Node *WebauthnCredential `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// WebauthnCredentialWhereInput is used for filtering WebauthnCredential objects.
+// Input was generated by ent.
+type WebauthnCredentialWhereInput struct {
+	Not *WebauthnCredentialWhereInput   `json:"not,omitempty"`
+	And []*WebauthnCredentialWhereInput `json:"and,omitempty"`
+	Or  []*WebauthnCredentialWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil        *bool    `json:"nameIsNil,omitempty"`
+	NameNotNil       *bool    `json:"nameNotNil,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// attestation_type field predicates
+	AttestationType             *string  `json:"attestationType,omitempty"`
+	AttestationTypeNeq          *string  `json:"attestationTypeNEQ,omitempty"`
+	AttestationTypeIn           []string `json:"attestationTypeIn,omitempty"`
+	AttestationTypeNotIn        []string `json:"attestationTypeNotIn,omitempty"`
+	AttestationTypeGt           *string  `json:"attestationTypeGT,omitempty"`
+	AttestationTypeGte          *string  `json:"attestationTypeGTE,omitempty"`
+	AttestationTypeLt           *string  `json:"attestationTypeLT,omitempty"`
+	AttestationTypeLte          *string  `json:"attestationTypeLTE,omitempty"`
+	AttestationTypeContains     *string  `json:"attestationTypeContains,omitempty"`
+	AttestationTypeHasPrefix    *string  `json:"attestationTypeHasPrefix,omitempty"`
+	AttestationTypeHasSuffix    *string  `json:"attestationTypeHasSuffix,omitempty"`
+	AttestationTypeIsNil        *bool    `json:"attestationTypeIsNil,omitempty"`
+	AttestationTypeNotNil       *bool    `json:"attestationTypeNotNil,omitempty"`
+	AttestationTypeEqualFold    *string  `json:"attestationTypeEqualFold,omitempty"`
+	AttestationTypeContainsFold *string  `json:"attestationTypeContainsFold,omitempty"`
+	// aaguid field predicates
+	Aaguid             *string  `json:"aaguid,omitempty"`
+	AaguidNeq          *string  `json:"aaguidNEQ,omitempty"`
+	AaguidIn           []string `json:"aaguidIn,omitempty"`
+	AaguidNotIn        []string `json:"aaguidNotIn,omitempty"`
+	AaguidGt           *string  `json:"aaguidGT,omitempty"`
+	AaguidGte          *string  `json:"aaguidGTE,omitempty"`
+	AaguidLt           *string  `json:"aaguidLT,omitempty"`
+	AaguidLte          *string  `json:"aaguidLTE,omitempty"`
+	AaguidContains     *string  `json:"aaguidContains,omitempty"`
+	AaguidHasPrefix    *string  `json:"aaguidHasPrefix,omitempty"`
+	AaguidHasSuffix    *string  `json:"aaguidHasSuffix,omitempty"`
+	AaguidIsNil        *bool    `json:"aaguidIsNil,omitempty"`
+	AaguidNotNil       *bool    `json:"aaguidNotNil,omitempty"`
+	AaguidEqualFold    *string  `json:"aaguidEqualFold,omitempty"`
+	AaguidContainsFold *string  `json:"aaguidContainsFold,omitempty"`
+	// sign_count field predicates
+	SignCount      *int64  `json:"signCount,omitempty"`
+	SignCountNeq   *int64  `json:"signCountNEQ,omitempty"`
+	SignCountIn    []int64 `json:"signCountIn,omitempty"`
+	SignCountNotIn []int64 `json:"signCountNotIn,omitempty"`
+	SignCountGt    *int64  `json:"signCountGT,omitempty"`
+	SignCountGte   *int64  `json:"signCountGTE,omitempty"`
+	SignCountLt    *int64  `json:"signCountLT,omitempty"`
+	SignCountLte   *int64  `json:"signCountLTE,omitempty"`
+	// backup_eligible field predicates
+	BackupEligible    *bool `json:"backupEligible,omitempty"`
+	BackupEligibleNeq *bool `json:"backupEligibleNEQ,omitempty"`
+	// backup_state field predicates
+	BackupState    *bool `json:"backupState,omitempty"`
+	BackupStateNeq *bool `json:"backupStateNEQ,omitempty"`
+	// last_used_at field predicates
+	LastUsedAt       *time.Time   `json:"lastUsedAt,omitempty"`
+	LastUsedAtNeq    *time.Time   `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []*time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []*time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGt     *time.Time   `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGte    *time.Time   `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLt     *time.Time   `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLte    *time.Time   `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  *bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil *bool        `json:"lastUsedAtNotNil,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // Properties by which Group connections can be ordered.
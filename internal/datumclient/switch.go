@@ -0,0 +1,61 @@
+package datumclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/route"
+)
+
+// Switch the organization the access + refresh token pair of the authenticated user is scoped to
+func Switch(c *Client, ctx context.Context, r handlers.SwitchOrganizationRequest, accessToken string) (*oauth2.Token, error) {
+	method := http.MethodPost
+	endpoint := "switch"
+
+	u := fmt.Sprintf("%s%s/%s", c.Client.BaseURL, route.V1Version, endpoint)
+
+	queryURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+	req.Header.Set(auth.Authorization, fmt.Sprintf("Bearer %s", accessToken))
+
+	resp, err := c.Client.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	out := handlers.Response{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newRequestError(resp.StatusCode, out.Message)
+	}
+
+	return getTokensFromCookies(resp), nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/datumforge/datum/internal/entx"
 	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/passwd"
 )
 
 var (
@@ -123,6 +124,10 @@ func main() {
 		entc.Dependency(
 			entc.DependencyType(&http.Client{}),
 		),
+		entc.Dependency(
+			entc.DependencyName("PasswordPolicy"),
+			entc.DependencyType(&passwd.Policy{}),
+		),
 		entc.TemplateDir("./internal/ent/templates"),
 		entc.Extensions(
 			gqlExt,
@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (aku *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aku *APIKeyUpdate) SetUpdatedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetUpdatedAt(t)
+	return aku
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aku *APIKeyUpdate) SetUpdatedBy(s string) *APIKeyUpdate {
+	aku.mutation.SetUpdatedBy(s)
+	return aku
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableUpdatedBy(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetUpdatedBy(*s)
+	}
+	return aku
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (aku *APIKeyUpdate) ClearUpdatedBy() *APIKeyUpdate {
+	aku.mutation.ClearUpdatedBy()
+	return aku
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aku *APIKeyUpdate) SetDeletedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetDeletedAt(t)
+	return aku
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableDeletedAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetDeletedAt(*t)
+	}
+	return aku
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aku *APIKeyUpdate) ClearDeletedAt() *APIKeyUpdate {
+	aku.mutation.ClearDeletedAt()
+	return aku
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (aku *APIKeyUpdate) SetDeletedBy(s string) *APIKeyUpdate {
+	aku.mutation.SetDeletedBy(s)
+	return aku
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableDeletedBy(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetDeletedBy(*s)
+	}
+	return aku
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (aku *APIKeyUpdate) ClearDeletedBy() *APIKeyUpdate {
+	aku.mutation.ClearDeletedBy()
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableName(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aku *APIKeyUpdate) SetExpiresAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetExpiresAt(t)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableExpiresAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aku *APIKeyUpdate) ClearExpiresAt() *APIKeyUpdate {
+	aku.mutation.ClearExpiresAt()
+	return aku
+}
+
+// SetDescription sets the "description" field.
+func (aku *APIKeyUpdate) SetDescription(s string) *APIKeyUpdate {
+	aku.mutation.SetDescription(s)
+	return aku
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableDescription(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetDescription(*s)
+	}
+	return aku
+}
+
+// ClearDescription clears the value of the "description" field.
+func (aku *APIKeyUpdate) ClearDescription() *APIKeyUpdate {
+	aku.mutation.ClearDescription()
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *APIKeyUpdate) SetLastUsedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *APIKeyUpdate) ClearLastUsedAt() *APIKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (aku *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := aku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, aku.sqlSave, aku.mutation, aku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aku *APIKeyUpdate) defaults() error {
+	if _, ok := aku.mutation.UpdatedAt(); !ok {
+		if apikey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized apikey.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := apikey.UpdateDefaultUpdatedAt()
+		aku.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aku.mutation.LastUsedAt(); !ok && !aku.mutation.LastUsedAtCleared() {
+		if apikey.UpdateDefaultLastUsedAt == nil {
+			return fmt.Errorf("generated: uninitialized apikey.UpdateDefaultLastUsedAt (forgotten import generated/runtime?)")
+		}
+		v := apikey.UpdateDefaultLastUsedAt()
+		aku.mutation.SetLastUsedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aku *APIKeyUpdate) check() error {
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if _, ok := aku.mutation.OwnerID(); aku.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "APIKey.owner"`)
+	}
+	return nil
+}
+
+func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString))
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aku.mutation.CreatedByCleared() {
+		_spec.ClearField(apikey.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := aku.mutation.UpdatedBy(); ok {
+		_spec.SetField(apikey.FieldUpdatedBy, field.TypeString, value)
+	}
+	if aku.mutation.UpdatedByCleared() {
+		_spec.ClearField(apikey.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := aku.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aku.mutation.DeletedAtCleared() {
+		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.DeletedBy(); ok {
+		_spec.SetField(apikey.FieldDeletedBy, field.TypeString, value)
+	}
+	if aku.mutation.DeletedByCleared() {
+		_spec.ClearField(apikey.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if aku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.Description(); ok {
+		_spec.SetField(apikey.FieldDescription, field.TypeString, value)
+	}
+	if aku.mutation.DescriptionCleared() {
+		_spec.ClearField(apikey.FieldDescription, field.TypeString)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = aku.schemaConfig.APIKey
+	ctx = internal.NewSchemaConfigContext(ctx, aku.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aku.mutation.done = true
+	return n, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (akuo *APIKeyUpdateOne) SetUpdatedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetUpdatedAt(t)
+	return akuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (akuo *APIKeyUpdateOne) SetUpdatedBy(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetUpdatedBy(s)
+	return akuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableUpdatedBy(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetUpdatedBy(*s)
+	}
+	return akuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (akuo *APIKeyUpdateOne) ClearUpdatedBy() *APIKeyUpdateOne {
+	akuo.mutation.ClearUpdatedBy()
+	return akuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (akuo *APIKeyUpdateOne) SetDeletedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetDeletedAt(t)
+	return akuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableDeletedAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetDeletedAt(*t)
+	}
+	return akuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (akuo *APIKeyUpdateOne) ClearDeletedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearDeletedAt()
+	return akuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (akuo *APIKeyUpdateOne) SetDeletedBy(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetDeletedBy(s)
+	return akuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableDeletedBy(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetDeletedBy(*s)
+	}
+	return akuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (akuo *APIKeyUpdateOne) ClearDeletedBy() *APIKeyUpdateOne {
+	akuo.mutation.ClearDeletedBy()
+	return akuo
+}
+
+// SetName sets the "name" field.
+func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableName(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *APIKeyUpdateOne) SetExpiresAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetExpiresAt(t)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (akuo *APIKeyUpdateOne) ClearExpiresAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearExpiresAt()
+	return akuo
+}
+
+// SetDescription sets the "description" field.
+func (akuo *APIKeyUpdateOne) SetDescription(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetDescription(s)
+	return akuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableDescription(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetDescription(*s)
+	}
+	return akuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (akuo *APIKeyUpdateOne) ClearDescription() *APIKeyUpdateOne {
+	akuo.mutation.ClearDescription()
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) SetLastUsedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) ClearLastUsedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (akuo *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return akuo.mutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (akuo *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
+	akuo.mutation.Where(ps...)
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (akuo *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	if err := akuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, akuo.sqlSave, akuo.mutation, akuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akuo *APIKeyUpdateOne) defaults() error {
+	if _, ok := akuo.mutation.UpdatedAt(); !ok {
+		if apikey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized apikey.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := apikey.UpdateDefaultUpdatedAt()
+		akuo.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := akuo.mutation.LastUsedAt(); !ok && !akuo.mutation.LastUsedAtCleared() {
+		if apikey.UpdateDefaultLastUsedAt == nil {
+			return fmt.Errorf("generated: uninitialized apikey.UpdateDefaultLastUsedAt (forgotten import generated/runtime?)")
+		}
+		v := apikey.UpdateDefaultLastUsedAt()
+		akuo.mutation.SetLastUsedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akuo *APIKeyUpdateOne) check() error {
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if _, ok := akuo.mutation.OwnerID(); akuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "APIKey.owner"`)
+	}
+	return nil
+}
+
+func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	if err := akuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString))
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.CreatedByCleared() {
+		_spec.ClearField(apikey.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := akuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(apikey.FieldUpdatedBy, field.TypeString, value)
+	}
+	if akuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(apikey.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := akuo.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.DeletedBy(); ok {
+		_spec.SetField(apikey.FieldDeletedBy, field.TypeString, value)
+	}
+	if akuo.mutation.DeletedByCleared() {
+		_spec.ClearField(apikey.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if akuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.Description(); ok {
+		_spec.SetField(apikey.FieldDescription, field.TypeString, value)
+	}
+	if akuo.mutation.DescriptionCleared() {
+		_spec.ClearField(apikey.FieldDescription, field.TypeString)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = akuo.schemaConfig.APIKey
+	ctx = internal.NewSchemaConfigContext(ctx, akuo.schemaConfig)
+	_node = &APIKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	akuo.mutation.done = true
+	return _node, nil
+}
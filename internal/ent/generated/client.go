@@ -17,21 +17,35 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/passwd"
 	"go.uber.org/zap"
 	"gocloud.dev/secrets"
 
@@ -43,34 +57,60 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// EmailVerificationToken is the client for interacting with the EmailVerificationToken builders.
 	EmailVerificationToken *EmailVerificationTokenClient
 	// Entitlement is the client for interacting with the Entitlement builders.
 	Entitlement *EntitlementClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// GroupJoinRequest is the client for interacting with the GroupJoinRequest builders.
+	GroupJoinRequest *GroupJoinRequestClient
+	// GroupMembership is the client for interacting with the GroupMembership builders.
+	GroupMembership *GroupMembershipClient
 	// GroupSetting is the client for interacting with the GroupSetting builders.
 	GroupSetting *GroupSettingClient
 	// Integration is the client for interacting with the Integration builders.
 	Integration *IntegrationClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// MagicLinkToken is the client for interacting with the MagicLinkToken builders.
+	MagicLinkToken *MagicLinkTokenClient
+	// OauthAuthorizationCode is the client for interacting with the OauthAuthorizationCode builders.
+	OauthAuthorizationCode *OauthAuthorizationCodeClient
+	// OauthClient is the client for interacting with the OauthClient builders.
+	OauthClient *OauthClientClient
 	// OauthProvider is the client for interacting with the OauthProvider builders.
 	OauthProvider *OauthProviderClient
 	// OhAuthTooToken is the client for interacting with the OhAuthTooToken builders.
 	OhAuthTooToken *OhAuthTooTokenClient
+	// OrgMembership is the client for interacting with the OrgMembership builders.
+	OrgMembership *OrgMembershipClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// OrganizationSetting is the client for interacting with the OrganizationSetting builders.
 	OrganizationSetting *OrganizationSettingClient
+	// OwnershipTransfer is the client for interacting with the OwnershipTransfer builders.
+	OwnershipTransfer *OwnershipTransferClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
 	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
 	PersonalAccessToken *PersonalAccessTokenClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// RevokedToken is the client for interacting with the RevokedToken builders.
+	RevokedToken *RevokedTokenClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// SessionData is the client for interacting with the SessionData builders.
+	SessionData *SessionDataClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserSetting is the client for interacting with the UserSetting builders.
 	UserSetting *UserSettingClient
+	// WebauthnCredential is the client for interacting with the WebauthnCredential builders.
+	WebauthnCredential *WebauthnCredentialClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -82,20 +122,33 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.EmailVerificationToken = NewEmailVerificationTokenClient(c.config)
 	c.Entitlement = NewEntitlementClient(c.config)
 	c.Group = NewGroupClient(c.config)
+	c.GroupJoinRequest = NewGroupJoinRequestClient(c.config)
+	c.GroupMembership = NewGroupMembershipClient(c.config)
 	c.GroupSetting = NewGroupSettingClient(c.config)
 	c.Integration = NewIntegrationClient(c.config)
+	c.Invite = NewInviteClient(c.config)
+	c.MagicLinkToken = NewMagicLinkTokenClient(c.config)
+	c.OauthAuthorizationCode = NewOauthAuthorizationCodeClient(c.config)
+	c.OauthClient = NewOauthClientClient(c.config)
 	c.OauthProvider = NewOauthProviderClient(c.config)
 	c.OhAuthTooToken = NewOhAuthTooTokenClient(c.config)
+	c.OrgMembership = NewOrgMembershipClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
 	c.OrganizationSetting = NewOrganizationSettingClient(c.config)
+	c.OwnershipTransfer = NewOwnershipTransferClient(c.config)
 	c.PasswordResetToken = NewPasswordResetTokenClient(c.config)
 	c.PersonalAccessToken = NewPersonalAccessTokenClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.RevokedToken = NewRevokedTokenClient(c.config)
 	c.Session = NewSessionClient(c.config)
+	c.SessionData = NewSessionDataClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserSetting = NewUserSettingClient(c.config)
+	c.WebauthnCredential = NewWebauthnCredentialClient(c.config)
 }
 
 type (
@@ -110,11 +163,12 @@ type (
 		// hooks to execute on mutations.
 		hooks *hooks
 		// interceptors to execute on queries.
-		inters        *inters
-		SecretsKeeper *secrets.Keeper
-		Authz         fga.Client
-		Logger        zap.SugaredLogger
-		HTTPClient    *http.Client
+		inters         *inters
+		SecretsKeeper  *secrets.Keeper
+		Authz          fga.Client
+		Logger         zap.SugaredLogger
+		HTTPClient     *http.Client
+		PasswordPolicy *passwd.Policy
 		// schemaConfig contains alternative names for all tables.
 		schemaConfig SchemaConfig
 	}
@@ -188,6 +242,13 @@ func HTTPClient(v *http.Client) Option {
 	}
 }
 
+// PasswordPolicy configures the PasswordPolicy.
+func PasswordPolicy(v *passwd.Policy) Option {
+	return func(c *config) {
+		c.PasswordPolicy = v
+	}
+}
+
 // Open opens a database/sql.DB specified by the driver name and
 // the data source name, and returns a new client attached to it.
 // Optional parameters can be added for configuring the client.
@@ -222,20 +283,33 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
 		EmailVerificationToken: NewEmailVerificationTokenClient(cfg),
 		Entitlement:            NewEntitlementClient(cfg),
 		Group:                  NewGroupClient(cfg),
+		GroupJoinRequest:       NewGroupJoinRequestClient(cfg),
+		GroupMembership:        NewGroupMembershipClient(cfg),
 		GroupSetting:           NewGroupSettingClient(cfg),
 		Integration:            NewIntegrationClient(cfg),
+		Invite:                 NewInviteClient(cfg),
+		MagicLinkToken:         NewMagicLinkTokenClient(cfg),
+		OauthAuthorizationCode: NewOauthAuthorizationCodeClient(cfg),
+		OauthClient:            NewOauthClientClient(cfg),
 		OauthProvider:          NewOauthProviderClient(cfg),
 		OhAuthTooToken:         NewOhAuthTooTokenClient(cfg),
+		OrgMembership:          NewOrgMembershipClient(cfg),
 		Organization:           NewOrganizationClient(cfg),
 		OrganizationSetting:    NewOrganizationSettingClient(cfg),
+		OwnershipTransfer:      NewOwnershipTransferClient(cfg),
 		PasswordResetToken:     NewPasswordResetTokenClient(cfg),
 		PersonalAccessToken:    NewPersonalAccessTokenClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		RevokedToken:           NewRevokedTokenClient(cfg),
 		Session:                NewSessionClient(cfg),
+		SessionData:            NewSessionDataClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserSetting:            NewUserSettingClient(cfg),
+		WebauthnCredential:     NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -255,27 +329,40 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
 		EmailVerificationToken: NewEmailVerificationTokenClient(cfg),
 		Entitlement:            NewEntitlementClient(cfg),
 		Group:                  NewGroupClient(cfg),
+		GroupJoinRequest:       NewGroupJoinRequestClient(cfg),
+		GroupMembership:        NewGroupMembershipClient(cfg),
 		GroupSetting:           NewGroupSettingClient(cfg),
 		Integration:            NewIntegrationClient(cfg),
+		Invite:                 NewInviteClient(cfg),
+		MagicLinkToken:         NewMagicLinkTokenClient(cfg),
+		OauthAuthorizationCode: NewOauthAuthorizationCodeClient(cfg),
+		OauthClient:            NewOauthClientClient(cfg),
 		OauthProvider:          NewOauthProviderClient(cfg),
 		OhAuthTooToken:         NewOhAuthTooTokenClient(cfg),
+		OrgMembership:          NewOrgMembershipClient(cfg),
 		Organization:           NewOrganizationClient(cfg),
 		OrganizationSetting:    NewOrganizationSettingClient(cfg),
+		OwnershipTransfer:      NewOwnershipTransferClient(cfg),
 		PasswordResetToken:     NewPasswordResetTokenClient(cfg),
 		PersonalAccessToken:    NewPersonalAccessTokenClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		RevokedToken:           NewRevokedTokenClient(cfg),
 		Session:                NewSessionClient(cfg),
+		SessionData:            NewSessionDataClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserSetting:            NewUserSettingClient(cfg),
+		WebauthnCredential:     NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		EmailVerificationToken.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -298,9 +385,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.EmailVerificationToken, c.Entitlement, c.Group, c.GroupSetting, c.Integration,
-		c.OauthProvider, c.OhAuthTooToken, c.Organization, c.OrganizationSetting,
-		c.PasswordResetToken, c.PersonalAccessToken, c.Session, c.User, c.UserSetting,
+		c.APIKey, c.EmailVerificationToken, c.Entitlement, c.Group, c.GroupJoinRequest,
+		c.GroupMembership, c.GroupSetting, c.Integration, c.Invite, c.MagicLinkToken,
+		c.OauthAuthorizationCode, c.OauthClient, c.OauthProvider, c.OhAuthTooToken,
+		c.OrgMembership, c.Organization, c.OrganizationSetting, c.OwnershipTransfer,
+		c.PasswordResetToken, c.PersonalAccessToken, c.RefreshToken, c.RevokedToken,
+		c.Session, c.SessionData, c.User, c.UserSetting, c.WebauthnCredential,
 	} {
 		n.Use(hooks...)
 	}
@@ -310,9 +400,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.EmailVerificationToken, c.Entitlement, c.Group, c.GroupSetting, c.Integration,
-		c.OauthProvider, c.OhAuthTooToken, c.Organization, c.OrganizationSetting,
-		c.PasswordResetToken, c.PersonalAccessToken, c.Session, c.User, c.UserSetting,
+		c.APIKey, c.EmailVerificationToken, c.Entitlement, c.Group, c.GroupJoinRequest,
+		c.GroupMembership, c.GroupSetting, c.Integration, c.Invite, c.MagicLinkToken,
+		c.OauthAuthorizationCode, c.OauthClient, c.OauthProvider, c.OhAuthTooToken,
+		c.OrgMembership, c.Organization, c.OrganizationSetting, c.OwnershipTransfer,
+		c.PasswordResetToken, c.PersonalAccessToken, c.RefreshToken, c.RevokedToken,
+		c.Session, c.SessionData, c.User, c.UserSetting, c.WebauthnCredential,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -321,39 +414,219 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *EmailVerificationTokenMutation:
 		return c.EmailVerificationToken.mutate(ctx, m)
 	case *EntitlementMutation:
 		return c.Entitlement.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
+	case *GroupJoinRequestMutation:
+		return c.GroupJoinRequest.mutate(ctx, m)
+	case *GroupMembershipMutation:
+		return c.GroupMembership.mutate(ctx, m)
 	case *GroupSettingMutation:
 		return c.GroupSetting.mutate(ctx, m)
 	case *IntegrationMutation:
 		return c.Integration.mutate(ctx, m)
+	case *InviteMutation:
+		return c.Invite.mutate(ctx, m)
+	case *MagicLinkTokenMutation:
+		return c.MagicLinkToken.mutate(ctx, m)
+	case *OauthAuthorizationCodeMutation:
+		return c.OauthAuthorizationCode.mutate(ctx, m)
+	case *OauthClientMutation:
+		return c.OauthClient.mutate(ctx, m)
 	case *OauthProviderMutation:
 		return c.OauthProvider.mutate(ctx, m)
 	case *OhAuthTooTokenMutation:
 		return c.OhAuthTooToken.mutate(ctx, m)
+	case *OrgMembershipMutation:
+		return c.OrgMembership.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
 	case *OrganizationSettingMutation:
 		return c.OrganizationSetting.mutate(ctx, m)
+	case *OwnershipTransferMutation:
+		return c.OwnershipTransfer.mutate(ctx, m)
 	case *PasswordResetTokenMutation:
 		return c.PasswordResetToken.mutate(ctx, m)
 	case *PersonalAccessTokenMutation:
 		return c.PersonalAccessToken.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *RevokedTokenMutation:
+		return c.RevokedToken.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
+	case *SessionDataMutation:
+		return c.SessionData.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserSettingMutation:
 		return c.UserSetting.mutate(ctx, m)
+	case *WebauthnCredentialMutation:
+		return c.WebauthnCredential.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("generated: unknown mutation type %T", m)
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id string) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id string) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id string) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id string) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a APIKey.
+func (c *APIKeyClient) QueryOwner(ak *APIKey) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.OwnerTable, apikey.OwnerColumn),
+		)
+		schemaConfig := ak.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.APIKey
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	hooks := c.hooks.APIKey
+	return append(hooks[:len(hooks):len(hooks)], apikey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	inters := c.inters.APIKey
+	return append(inters[:len(inters):len(inters)], apikey.Interceptors[:]...)
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // EmailVerificationTokenClient is a client for the EmailVerificationToken schema.
 type EmailVerificationTokenClient struct {
 	config
@@ -801,7 +1074,7 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 		)
 		schemaConfig := gr.schemaConfig
 		step.To.Schema = schemaConfig.User
-		step.Edge.Schema = schemaConfig.GroupUsers
+		step.Edge.Schema = schemaConfig.GroupMembership
 		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
@@ -827,6 +1100,25 @@ func (c *GroupClient) QueryOwner(gr *Group) *OrganizationQuery {
 	return query
 }
 
+// QueryMembers queries the members edge of a Group.
+func (c *GroupClient) QueryMembers(gr *Group) *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, group.MembersTable, group.MembersColumn),
+		)
+		schemaConfig := gr.schemaConfig
+		step.To.Schema = schemaConfig.GroupMembership
+		step.Edge.Schema = schemaConfig.GroupMembership
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
 	hooks := c.hooks.Group
@@ -854,107 +1146,107 @@ func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, erro
 	}
 }
 
-// GroupSettingClient is a client for the GroupSetting schema.
-type GroupSettingClient struct {
+// GroupJoinRequestClient is a client for the GroupJoinRequest schema.
+type GroupJoinRequestClient struct {
 	config
 }
 
-// NewGroupSettingClient returns a client for the GroupSetting from the given config.
-func NewGroupSettingClient(c config) *GroupSettingClient {
-	return &GroupSettingClient{config: c}
+// NewGroupJoinRequestClient returns a client for the GroupJoinRequest from the given config.
+func NewGroupJoinRequestClient(c config) *GroupJoinRequestClient {
+	return &GroupJoinRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupsetting.Hooks(f(g(h())))`.
-func (c *GroupSettingClient) Use(hooks ...Hook) {
-	c.hooks.GroupSetting = append(c.hooks.GroupSetting, hooks...)
+// A call to `Use(f, g, h)` equals to `groupjoinrequest.Hooks(f(g(h())))`.
+func (c *GroupJoinRequestClient) Use(hooks ...Hook) {
+	c.hooks.GroupJoinRequest = append(c.hooks.GroupJoinRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `groupsetting.Intercept(f(g(h())))`.
-func (c *GroupSettingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GroupSetting = append(c.inters.GroupSetting, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `groupjoinrequest.Intercept(f(g(h())))`.
+func (c *GroupJoinRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupJoinRequest = append(c.inters.GroupJoinRequest, interceptors...)
 }
 
-// Create returns a builder for creating a GroupSetting entity.
-func (c *GroupSettingClient) Create() *GroupSettingCreate {
-	mutation := newGroupSettingMutation(c.config, OpCreate)
-	return &GroupSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupJoinRequest entity.
+func (c *GroupJoinRequestClient) Create() *GroupJoinRequestCreate {
+	mutation := newGroupJoinRequestMutation(c.config, OpCreate)
+	return &GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GroupSetting entities.
-func (c *GroupSettingClient) CreateBulk(builders ...*GroupSettingCreate) *GroupSettingCreateBulk {
-	return &GroupSettingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupJoinRequest entities.
+func (c *GroupJoinRequestClient) CreateBulk(builders ...*GroupJoinRequestCreate) *GroupJoinRequestCreateBulk {
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupSettingClient) MapCreateBulk(slice any, setFunc func(*GroupSettingCreate, int)) *GroupSettingCreateBulk {
+func (c *GroupJoinRequestClient) MapCreateBulk(slice any, setFunc func(*GroupJoinRequestCreate, int)) *GroupJoinRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupSettingCreateBulk{err: fmt.Errorf("calling to GroupSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupJoinRequestCreateBulk{err: fmt.Errorf("calling to GroupJoinRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupSettingCreate, rv.Len())
+	builders := make([]*GroupJoinRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupSettingCreateBulk{config: c.config, builders: builders}
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GroupSetting.
-func (c *GroupSettingClient) Update() *GroupSettingUpdate {
-	mutation := newGroupSettingMutation(c.config, OpUpdate)
-	return &GroupSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Update() *GroupJoinRequestUpdate {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdate)
+	return &GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupSettingClient) UpdateOne(gs *GroupSetting) *GroupSettingUpdateOne {
-	mutation := newGroupSettingMutation(c.config, OpUpdateOne, withGroupSetting(gs))
-	return &GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupJoinRequestClient) UpdateOne(gjr *GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequest(gjr))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupSettingClient) UpdateOneID(id string) *GroupSettingUpdateOne {
-	mutation := newGroupSettingMutation(c.config, OpUpdateOne, withGroupSettingID(id))
-	return &GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupJoinRequestClient) UpdateOneID(id string) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequestID(id))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GroupSetting.
-func (c *GroupSettingClient) Delete() *GroupSettingDelete {
-	mutation := newGroupSettingMutation(c.config, OpDelete)
-	return &GroupSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Delete() *GroupJoinRequestDelete {
+	mutation := newGroupJoinRequestMutation(c.config, OpDelete)
+	return &GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupSettingClient) DeleteOne(gs *GroupSetting) *GroupSettingDeleteOne {
-	return c.DeleteOneID(gs.ID)
+func (c *GroupJoinRequestClient) DeleteOne(gjr *GroupJoinRequest) *GroupJoinRequestDeleteOne {
+	return c.DeleteOneID(gjr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupSettingClient) DeleteOneID(id string) *GroupSettingDeleteOne {
-	builder := c.Delete().Where(groupsetting.ID(id))
+func (c *GroupJoinRequestClient) DeleteOneID(id string) *GroupJoinRequestDeleteOne {
+	builder := c.Delete().Where(groupjoinrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupSettingDeleteOne{builder}
+	return &GroupJoinRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for GroupSetting.
-func (c *GroupSettingClient) Query() *GroupSettingQuery {
-	return &GroupSettingQuery{
+// Query returns a query builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Query() *GroupJoinRequestQuery {
+	return &GroupJoinRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroupSetting},
+		ctx:    &QueryContext{Type: TypeGroupJoinRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GroupSetting entity by its id.
-func (c *GroupSettingClient) Get(ctx context.Context, id string) (*GroupSetting, error) {
-	return c.Query().Where(groupsetting.ID(id)).Only(ctx)
+// Get returns a GroupJoinRequest entity by its id.
+func (c *GroupJoinRequestClient) Get(ctx context.Context, id string) (*GroupJoinRequest, error) {
+	return c.Query().Where(groupjoinrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupSettingClient) GetX(ctx context.Context, id string) *GroupSetting {
+func (c *GroupJoinRequestClient) GetX(ctx context.Context, id string) *GroupJoinRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -962,153 +1254,172 @@ func (c *GroupSettingClient) GetX(ctx context.Context, id string) *GroupSetting
 	return obj
 }
 
-// QueryGroup queries the group edge of a GroupSetting.
-func (c *GroupSettingClient) QueryGroup(gs *GroupSetting) *GroupQuery {
+// QueryGroup queries the group edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryGroup(gjr *GroupJoinRequest) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gs.ID
+		id := gjr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(groupsetting.Table, groupsetting.FieldID, id),
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, groupsetting.GroupTable, groupsetting.GroupColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupjoinrequest.GroupTable, groupjoinrequest.GroupColumn),
 		)
-		schemaConfig := gs.schemaConfig
+		schemaConfig := gjr.schemaConfig
 		step.To.Schema = schemaConfig.Group
-		step.Edge.Schema = schemaConfig.GroupSetting
-		fromV = sqlgraph.Neighbors(gs.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.GroupJoinRequest
+		fromV = sqlgraph.Neighbors(gjr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryUser(gjr *GroupJoinRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gjr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupjoinrequest.UserTable, groupjoinrequest.UserColumn),
+		)
+		schemaConfig := gjr.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.GroupJoinRequest
+		fromV = sqlgraph.Neighbors(gjr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *GroupSettingClient) Hooks() []Hook {
-	hooks := c.hooks.GroupSetting
-	return append(hooks[:len(hooks):len(hooks)], groupsetting.Hooks[:]...)
+func (c *GroupJoinRequestClient) Hooks() []Hook {
+	hooks := c.hooks.GroupJoinRequest
+	return append(hooks[:len(hooks):len(hooks)], groupjoinrequest.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupSettingClient) Interceptors() []Interceptor {
-	inters := c.inters.GroupSetting
-	return append(inters[:len(inters):len(inters)], groupsetting.Interceptors[:]...)
+func (c *GroupJoinRequestClient) Interceptors() []Interceptor {
+	inters := c.inters.GroupJoinRequest
+	return append(inters[:len(inters):len(inters)], groupjoinrequest.Interceptors[:]...)
 }
 
-func (c *GroupSettingClient) mutate(ctx context.Context, m *GroupSettingMutation) (Value, error) {
+func (c *GroupJoinRequestClient) mutate(ctx context.Context, m *GroupJoinRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown GroupSetting mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown GroupJoinRequest mutation op: %q", m.Op())
 	}
 }
 
-// IntegrationClient is a client for the Integration schema.
-type IntegrationClient struct {
+// GroupMembershipClient is a client for the GroupMembership schema.
+type GroupMembershipClient struct {
 	config
 }
 
-// NewIntegrationClient returns a client for the Integration from the given config.
-func NewIntegrationClient(c config) *IntegrationClient {
-	return &IntegrationClient{config: c}
+// NewGroupMembershipClient returns a client for the GroupMembership from the given config.
+func NewGroupMembershipClient(c config) *GroupMembershipClient {
+	return &GroupMembershipClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `integration.Hooks(f(g(h())))`.
-func (c *IntegrationClient) Use(hooks ...Hook) {
-	c.hooks.Integration = append(c.hooks.Integration, hooks...)
+// A call to `Use(f, g, h)` equals to `groupmembership.Hooks(f(g(h())))`.
+func (c *GroupMembershipClient) Use(hooks ...Hook) {
+	c.hooks.GroupMembership = append(c.hooks.GroupMembership, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `integration.Intercept(f(g(h())))`.
-func (c *IntegrationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Integration = append(c.inters.Integration, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `groupmembership.Intercept(f(g(h())))`.
+func (c *GroupMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupMembership = append(c.inters.GroupMembership, interceptors...)
 }
 
-// Create returns a builder for creating a Integration entity.
-func (c *IntegrationClient) Create() *IntegrationCreate {
-	mutation := newIntegrationMutation(c.config, OpCreate)
-	return &IntegrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupMembership entity.
+func (c *GroupMembershipClient) Create() *GroupMembershipCreate {
+	mutation := newGroupMembershipMutation(c.config, OpCreate)
+	return &GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Integration entities.
-func (c *IntegrationClient) CreateBulk(builders ...*IntegrationCreate) *IntegrationCreateBulk {
-	return &IntegrationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupMembership entities.
+func (c *GroupMembershipClient) CreateBulk(builders ...*GroupMembershipCreate) *GroupMembershipCreateBulk {
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IntegrationClient) MapCreateBulk(slice any, setFunc func(*IntegrationCreate, int)) *IntegrationCreateBulk {
+func (c *GroupMembershipClient) MapCreateBulk(slice any, setFunc func(*GroupMembershipCreate, int)) *GroupMembershipCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IntegrationCreateBulk{err: fmt.Errorf("calling to IntegrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupMembershipCreateBulk{err: fmt.Errorf("calling to GroupMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IntegrationCreate, rv.Len())
+	builders := make([]*GroupMembershipCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IntegrationCreateBulk{config: c.config, builders: builders}
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Integration.
-func (c *IntegrationClient) Update() *IntegrationUpdate {
-	mutation := newIntegrationMutation(c.config, OpUpdate)
-	return &IntegrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupMembership.
+func (c *GroupMembershipClient) Update() *GroupMembershipUpdate {
+	mutation := newGroupMembershipMutation(c.config, OpUpdate)
+	return &GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IntegrationClient) UpdateOne(i *Integration) *IntegrationUpdateOne {
-	mutation := newIntegrationMutation(c.config, OpUpdateOne, withIntegration(i))
-	return &IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupMembershipClient) UpdateOne(gm *GroupMembership) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembership(gm))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IntegrationClient) UpdateOneID(id string) *IntegrationUpdateOne {
-	mutation := newIntegrationMutation(c.config, OpUpdateOne, withIntegrationID(id))
-	return &IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupMembershipClient) UpdateOneID(id string) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembershipID(id))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Integration.
-func (c *IntegrationClient) Delete() *IntegrationDelete {
-	mutation := newIntegrationMutation(c.config, OpDelete)
-	return &IntegrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupMembership.
+func (c *GroupMembershipClient) Delete() *GroupMembershipDelete {
+	mutation := newGroupMembershipMutation(c.config, OpDelete)
+	return &GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IntegrationClient) DeleteOne(i *Integration) *IntegrationDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *GroupMembershipClient) DeleteOne(gm *GroupMembership) *GroupMembershipDeleteOne {
+	return c.DeleteOneID(gm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IntegrationClient) DeleteOneID(id string) *IntegrationDeleteOne {
-	builder := c.Delete().Where(integration.ID(id))
+func (c *GroupMembershipClient) DeleteOneID(id string) *GroupMembershipDeleteOne {
+	builder := c.Delete().Where(groupmembership.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IntegrationDeleteOne{builder}
+	return &GroupMembershipDeleteOne{builder}
 }
 
-// Query returns a query builder for Integration.
-func (c *IntegrationClient) Query() *IntegrationQuery {
-	return &IntegrationQuery{
+// Query returns a query builder for GroupMembership.
+func (c *GroupMembershipClient) Query() *GroupMembershipQuery {
+	return &GroupMembershipQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIntegration},
+		ctx:    &QueryContext{Type: TypeGroupMembership},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Integration entity by its id.
-func (c *IntegrationClient) Get(ctx context.Context, id string) (*Integration, error) {
-	return c.Query().Where(integration.ID(id)).Only(ctx)
+// Get returns a GroupMembership entity by its id.
+func (c *GroupMembershipClient) Get(ctx context.Context, id string) (*GroupMembership, error) {
+	return c.Query().Where(groupmembership.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IntegrationClient) GetX(ctx context.Context, id string) *Integration {
+func (c *GroupMembershipClient) GetX(ctx context.Context, id string) *GroupMembership {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1116,49 +1427,972 @@ func (c *IntegrationClient) GetX(ctx context.Context, id string) *Integration {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Integration.
-func (c *IntegrationClient) QueryOwner(i *Integration) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryGroup queries the group edge of a GroupMembership.
+func (c *GroupMembershipClient) QueryGroup(gm *GroupMembership) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := gm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(integration.Table, integration.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, integration.OwnerTable, integration.OwnerColumn),
+			sqlgraph.From(groupmembership.Table, groupmembership.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembership.GroupTable, groupmembership.GroupColumn),
 		)
-		schemaConfig := i.schemaConfig
-		step.To.Schema = schemaConfig.Organization
-		step.Edge.Schema = schemaConfig.Integration
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		schemaConfig := gm.schemaConfig
+		step.To.Schema = schemaConfig.Group
+		step.Edge.Schema = schemaConfig.GroupMembership
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a GroupMembership.
+func (c *GroupMembershipClient) QueryUser(gm *GroupMembership) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembership.Table, groupmembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembership.UserTable, groupmembership.UserColumn),
+		)
+		schemaConfig := gm.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.GroupMembership
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *IntegrationClient) Hooks() []Hook {
+func (c *GroupMembershipClient) Hooks() []Hook {
+	hooks := c.hooks.GroupMembership
+	return append(hooks[:len(hooks):len(hooks)], groupmembership.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupMembershipClient) Interceptors() []Interceptor {
+	inters := c.inters.GroupMembership
+	return append(inters[:len(inters):len(inters)], groupmembership.Interceptors[:]...)
+}
+
+func (c *GroupMembershipClient) mutate(ctx context.Context, m *GroupMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown GroupMembership mutation op: %q", m.Op())
+	}
+}
+
+// GroupSettingClient is a client for the GroupSetting schema.
+type GroupSettingClient struct {
+	config
+}
+
+// NewGroupSettingClient returns a client for the GroupSetting from the given config.
+func NewGroupSettingClient(c config) *GroupSettingClient {
+	return &GroupSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupsetting.Hooks(f(g(h())))`.
+func (c *GroupSettingClient) Use(hooks ...Hook) {
+	c.hooks.GroupSetting = append(c.hooks.GroupSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupsetting.Intercept(f(g(h())))`.
+func (c *GroupSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupSetting = append(c.inters.GroupSetting, interceptors...)
+}
+
+// Create returns a builder for creating a GroupSetting entity.
+func (c *GroupSettingClient) Create() *GroupSettingCreate {
+	mutation := newGroupSettingMutation(c.config, OpCreate)
+	return &GroupSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupSetting entities.
+func (c *GroupSettingClient) CreateBulk(builders ...*GroupSettingCreate) *GroupSettingCreateBulk {
+	return &GroupSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupSettingClient) MapCreateBulk(slice any, setFunc func(*GroupSettingCreate, int)) *GroupSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupSettingCreateBulk{err: fmt.Errorf("calling to GroupSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupSetting.
+func (c *GroupSettingClient) Update() *GroupSettingUpdate {
+	mutation := newGroupSettingMutation(c.config, OpUpdate)
+	return &GroupSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupSettingClient) UpdateOne(gs *GroupSetting) *GroupSettingUpdateOne {
+	mutation := newGroupSettingMutation(c.config, OpUpdateOne, withGroupSetting(gs))
+	return &GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupSettingClient) UpdateOneID(id string) *GroupSettingUpdateOne {
+	mutation := newGroupSettingMutation(c.config, OpUpdateOne, withGroupSettingID(id))
+	return &GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupSetting.
+func (c *GroupSettingClient) Delete() *GroupSettingDelete {
+	mutation := newGroupSettingMutation(c.config, OpDelete)
+	return &GroupSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupSettingClient) DeleteOne(gs *GroupSetting) *GroupSettingDeleteOne {
+	return c.DeleteOneID(gs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupSettingClient) DeleteOneID(id string) *GroupSettingDeleteOne {
+	builder := c.Delete().Where(groupsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupSetting.
+func (c *GroupSettingClient) Query() *GroupSettingQuery {
+	return &GroupSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupSetting entity by its id.
+func (c *GroupSettingClient) Get(ctx context.Context, id string) (*GroupSetting, error) {
+	return c.Query().Where(groupsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupSettingClient) GetX(ctx context.Context, id string) *GroupSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a GroupSetting.
+func (c *GroupSettingClient) QueryGroup(gs *GroupSetting) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupsetting.Table, groupsetting.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, groupsetting.GroupTable, groupsetting.GroupColumn),
+		)
+		schemaConfig := gs.schemaConfig
+		step.To.Schema = schemaConfig.Group
+		step.Edge.Schema = schemaConfig.GroupSetting
+		fromV = sqlgraph.Neighbors(gs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupSettingClient) Hooks() []Hook {
+	hooks := c.hooks.GroupSetting
+	return append(hooks[:len(hooks):len(hooks)], groupsetting.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupSettingClient) Interceptors() []Interceptor {
+	inters := c.inters.GroupSetting
+	return append(inters[:len(inters):len(inters)], groupsetting.Interceptors[:]...)
+}
+
+func (c *GroupSettingClient) mutate(ctx context.Context, m *GroupSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown GroupSetting mutation op: %q", m.Op())
+	}
+}
+
+// IntegrationClient is a client for the Integration schema.
+type IntegrationClient struct {
+	config
+}
+
+// NewIntegrationClient returns a client for the Integration from the given config.
+func NewIntegrationClient(c config) *IntegrationClient {
+	return &IntegrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `integration.Hooks(f(g(h())))`.
+func (c *IntegrationClient) Use(hooks ...Hook) {
+	c.hooks.Integration = append(c.hooks.Integration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `integration.Intercept(f(g(h())))`.
+func (c *IntegrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Integration = append(c.inters.Integration, interceptors...)
+}
+
+// Create returns a builder for creating a Integration entity.
+func (c *IntegrationClient) Create() *IntegrationCreate {
+	mutation := newIntegrationMutation(c.config, OpCreate)
+	return &IntegrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Integration entities.
+func (c *IntegrationClient) CreateBulk(builders ...*IntegrationCreate) *IntegrationCreateBulk {
+	return &IntegrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IntegrationClient) MapCreateBulk(slice any, setFunc func(*IntegrationCreate, int)) *IntegrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IntegrationCreateBulk{err: fmt.Errorf("calling to IntegrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IntegrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IntegrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Integration.
+func (c *IntegrationClient) Update() *IntegrationUpdate {
+	mutation := newIntegrationMutation(c.config, OpUpdate)
+	return &IntegrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IntegrationClient) UpdateOne(i *Integration) *IntegrationUpdateOne {
+	mutation := newIntegrationMutation(c.config, OpUpdateOne, withIntegration(i))
+	return &IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IntegrationClient) UpdateOneID(id string) *IntegrationUpdateOne {
+	mutation := newIntegrationMutation(c.config, OpUpdateOne, withIntegrationID(id))
+	return &IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Integration.
+func (c *IntegrationClient) Delete() *IntegrationDelete {
+	mutation := newIntegrationMutation(c.config, OpDelete)
+	return &IntegrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IntegrationClient) DeleteOne(i *Integration) *IntegrationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IntegrationClient) DeleteOneID(id string) *IntegrationDeleteOne {
+	builder := c.Delete().Where(integration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IntegrationDeleteOne{builder}
+}
+
+// Query returns a query builder for Integration.
+func (c *IntegrationClient) Query() *IntegrationQuery {
+	return &IntegrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIntegration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Integration entity by its id.
+func (c *IntegrationClient) Get(ctx context.Context, id string) (*Integration, error) {
+	return c.Query().Where(integration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IntegrationClient) GetX(ctx context.Context, id string) *Integration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Integration.
+func (c *IntegrationClient) QueryOwner(i *Integration) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(integration.Table, integration.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, integration.OwnerTable, integration.OwnerColumn),
+		)
+		schemaConfig := i.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.Integration
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IntegrationClient) Hooks() []Hook {
 	hooks := c.hooks.Integration
 	return append(hooks[:len(hooks):len(hooks)], integration.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *IntegrationClient) Interceptors() []Interceptor {
-	inters := c.inters.Integration
-	return append(inters[:len(inters):len(inters)], integration.Interceptors[:]...)
+func (c *IntegrationClient) Interceptors() []Interceptor {
+	inters := c.inters.Integration
+	return append(inters[:len(inters):len(inters)], integration.Interceptors[:]...)
+}
+
+func (c *IntegrationClient) mutate(ctx context.Context, m *IntegrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IntegrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IntegrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IntegrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Integration mutation op: %q", m.Op())
+	}
+}
+
+// InviteClient is a client for the Invite schema.
+type InviteClient struct {
+	config
+}
+
+// NewInviteClient returns a client for the Invite from the given config.
+func NewInviteClient(c config) *InviteClient {
+	return &InviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invite.Hooks(f(g(h())))`.
+func (c *InviteClient) Use(hooks ...Hook) {
+	c.hooks.Invite = append(c.hooks.Invite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invite.Intercept(f(g(h())))`.
+func (c *InviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invite = append(c.inters.Invite, interceptors...)
+}
+
+// Create returns a builder for creating a Invite entity.
+func (c *InviteClient) Create() *InviteCreate {
+	mutation := newInviteMutation(c.config, OpCreate)
+	return &InviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invite entities.
+func (c *InviteClient) CreateBulk(builders ...*InviteCreate) *InviteCreateBulk {
+	return &InviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InviteClient) MapCreateBulk(slice any, setFunc func(*InviteCreate, int)) *InviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InviteCreateBulk{err: fmt.Errorf("calling to InviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invite.
+func (c *InviteClient) Update() *InviteUpdate {
+	mutation := newInviteMutation(c.config, OpUpdate)
+	return &InviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InviteClient) UpdateOne(i *Invite) *InviteUpdateOne {
+	mutation := newInviteMutation(c.config, OpUpdateOne, withInvite(i))
+	return &InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InviteClient) UpdateOneID(id string) *InviteUpdateOne {
+	mutation := newInviteMutation(c.config, OpUpdateOne, withInviteID(id))
+	return &InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invite.
+func (c *InviteClient) Delete() *InviteDelete {
+	mutation := newInviteMutation(c.config, OpDelete)
+	return &InviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InviteClient) DeleteOne(i *Invite) *InviteDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InviteClient) DeleteOneID(id string) *InviteDeleteOne {
+	builder := c.Delete().Where(invite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InviteDeleteOne{builder}
+}
+
+// Query returns a query builder for Invite.
+func (c *InviteClient) Query() *InviteQuery {
+	return &InviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invite entity by its id.
+func (c *InviteClient) Get(ctx context.Context, id string) (*Invite, error) {
+	return c.Query().Where(invite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InviteClient) GetX(ctx context.Context, id string) *Invite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Invite.
+func (c *InviteClient) QueryOwner(i *Invite) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invite.Table, invite.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invite.OwnerTable, invite.OwnerColumn),
+		)
+		schemaConfig := i.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.Invite
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InviteClient) Hooks() []Hook {
+	hooks := c.hooks.Invite
+	return append(hooks[:len(hooks):len(hooks)], invite.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InviteClient) Interceptors() []Interceptor {
+	inters := c.inters.Invite
+	return append(inters[:len(inters):len(inters)], invite.Interceptors[:]...)
+}
+
+func (c *InviteClient) mutate(ctx context.Context, m *InviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Invite mutation op: %q", m.Op())
+	}
+}
+
+// MagicLinkTokenClient is a client for the MagicLinkToken schema.
+type MagicLinkTokenClient struct {
+	config
+}
+
+// NewMagicLinkTokenClient returns a client for the MagicLinkToken from the given config.
+func NewMagicLinkTokenClient(c config) *MagicLinkTokenClient {
+	return &MagicLinkTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `magiclinktoken.Hooks(f(g(h())))`.
+func (c *MagicLinkTokenClient) Use(hooks ...Hook) {
+	c.hooks.MagicLinkToken = append(c.hooks.MagicLinkToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `magiclinktoken.Intercept(f(g(h())))`.
+func (c *MagicLinkTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MagicLinkToken = append(c.inters.MagicLinkToken, interceptors...)
+}
+
+// Create returns a builder for creating a MagicLinkToken entity.
+func (c *MagicLinkTokenClient) Create() *MagicLinkTokenCreate {
+	mutation := newMagicLinkTokenMutation(c.config, OpCreate)
+	return &MagicLinkTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MagicLinkToken entities.
+func (c *MagicLinkTokenClient) CreateBulk(builders ...*MagicLinkTokenCreate) *MagicLinkTokenCreateBulk {
+	return &MagicLinkTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MagicLinkTokenClient) MapCreateBulk(slice any, setFunc func(*MagicLinkTokenCreate, int)) *MagicLinkTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MagicLinkTokenCreateBulk{err: fmt.Errorf("calling to MagicLinkTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MagicLinkTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MagicLinkTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Update() *MagicLinkTokenUpdate {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdate)
+	return &MagicLinkTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MagicLinkTokenClient) UpdateOne(mlt *MagicLinkToken) *MagicLinkTokenUpdateOne {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdateOne, withMagicLinkToken(mlt))
+	return &MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MagicLinkTokenClient) UpdateOneID(id string) *MagicLinkTokenUpdateOne {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdateOne, withMagicLinkTokenID(id))
+	return &MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Delete() *MagicLinkTokenDelete {
+	mutation := newMagicLinkTokenMutation(c.config, OpDelete)
+	return &MagicLinkTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MagicLinkTokenClient) DeleteOne(mlt *MagicLinkToken) *MagicLinkTokenDeleteOne {
+	return c.DeleteOneID(mlt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MagicLinkTokenClient) DeleteOneID(id string) *MagicLinkTokenDeleteOne {
+	builder := c.Delete().Where(magiclinktoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MagicLinkTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Query() *MagicLinkTokenQuery {
+	return &MagicLinkTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMagicLinkToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MagicLinkToken entity by its id.
+func (c *MagicLinkTokenClient) Get(ctx context.Context, id string) (*MagicLinkToken, error) {
+	return c.Query().Where(magiclinktoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MagicLinkTokenClient) GetX(ctx context.Context, id string) *MagicLinkToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a MagicLinkToken.
+func (c *MagicLinkTokenClient) QueryOwner(mlt *MagicLinkToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mlt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(magiclinktoken.Table, magiclinktoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, magiclinktoken.OwnerTable, magiclinktoken.OwnerColumn),
+		)
+		schemaConfig := mlt.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.MagicLinkToken
+		fromV = sqlgraph.Neighbors(mlt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MagicLinkTokenClient) Hooks() []Hook {
+	hooks := c.hooks.MagicLinkToken
+	return append(hooks[:len(hooks):len(hooks)], magiclinktoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MagicLinkTokenClient) Interceptors() []Interceptor {
+	inters := c.inters.MagicLinkToken
+	return append(inters[:len(inters):len(inters)], magiclinktoken.Interceptors[:]...)
+}
+
+func (c *MagicLinkTokenClient) mutate(ctx context.Context, m *MagicLinkTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MagicLinkTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MagicLinkTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MagicLinkTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown MagicLinkToken mutation op: %q", m.Op())
+	}
+}
+
+// OauthAuthorizationCodeClient is a client for the OauthAuthorizationCode schema.
+type OauthAuthorizationCodeClient struct {
+	config
+}
+
+// NewOauthAuthorizationCodeClient returns a client for the OauthAuthorizationCode from the given config.
+func NewOauthAuthorizationCodeClient(c config) *OauthAuthorizationCodeClient {
+	return &OauthAuthorizationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthauthorizationcode.Hooks(f(g(h())))`.
+func (c *OauthAuthorizationCodeClient) Use(hooks ...Hook) {
+	c.hooks.OauthAuthorizationCode = append(c.hooks.OauthAuthorizationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthauthorizationcode.Intercept(f(g(h())))`.
+func (c *OauthAuthorizationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthAuthorizationCode = append(c.inters.OauthAuthorizationCode, interceptors...)
+}
+
+// Create returns a builder for creating a OauthAuthorizationCode entity.
+func (c *OauthAuthorizationCodeClient) Create() *OauthAuthorizationCodeCreate {
+	mutation := newOauthAuthorizationCodeMutation(c.config, OpCreate)
+	return &OauthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthAuthorizationCode entities.
+func (c *OauthAuthorizationCodeClient) CreateBulk(builders ...*OauthAuthorizationCodeCreate) *OauthAuthorizationCodeCreateBulk {
+	return &OauthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthAuthorizationCodeClient) MapCreateBulk(slice any, setFunc func(*OauthAuthorizationCodeCreate, int)) *OauthAuthorizationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthAuthorizationCodeCreateBulk{err: fmt.Errorf("calling to OauthAuthorizationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthAuthorizationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthAuthorizationCode.
+func (c *OauthAuthorizationCodeClient) Update() *OauthAuthorizationCodeUpdate {
+	mutation := newOauthAuthorizationCodeMutation(c.config, OpUpdate)
+	return &OauthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthAuthorizationCodeClient) UpdateOne(oac *OauthAuthorizationCode) *OauthAuthorizationCodeUpdateOne {
+	mutation := newOauthAuthorizationCodeMutation(c.config, OpUpdateOne, withOauthAuthorizationCode(oac))
+	return &OauthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthAuthorizationCodeClient) UpdateOneID(id string) *OauthAuthorizationCodeUpdateOne {
+	mutation := newOauthAuthorizationCodeMutation(c.config, OpUpdateOne, withOauthAuthorizationCodeID(id))
+	return &OauthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthAuthorizationCode.
+func (c *OauthAuthorizationCodeClient) Delete() *OauthAuthorizationCodeDelete {
+	mutation := newOauthAuthorizationCodeMutation(c.config, OpDelete)
+	return &OauthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthAuthorizationCodeClient) DeleteOne(oac *OauthAuthorizationCode) *OauthAuthorizationCodeDeleteOne {
+	return c.DeleteOneID(oac.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthAuthorizationCodeClient) DeleteOneID(id string) *OauthAuthorizationCodeDeleteOne {
+	builder := c.Delete().Where(oauthauthorizationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthAuthorizationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthAuthorizationCode.
+func (c *OauthAuthorizationCodeClient) Query() *OauthAuthorizationCodeQuery {
+	return &OauthAuthorizationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthAuthorizationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthAuthorizationCode entity by its id.
+func (c *OauthAuthorizationCodeClient) Get(ctx context.Context, id string) (*OauthAuthorizationCode, error) {
+	return c.Query().Where(oauthauthorizationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthAuthorizationCodeClient) GetX(ctx context.Context, id string) *OauthAuthorizationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OauthAuthorizationCodeClient) Hooks() []Hook {
+	hooks := c.hooks.OauthAuthorizationCode
+	return append(hooks[:len(hooks):len(hooks)], oauthauthorizationcode.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthAuthorizationCodeClient) Interceptors() []Interceptor {
+	return c.inters.OauthAuthorizationCode
 }
 
-func (c *IntegrationClient) mutate(ctx context.Context, m *IntegrationMutation) (Value, error) {
+func (c *OauthAuthorizationCodeClient) mutate(ctx context.Context, m *OauthAuthorizationCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IntegrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OauthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IntegrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OauthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IntegrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OauthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IntegrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OauthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Integration mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown OauthAuthorizationCode mutation op: %q", m.Op())
+	}
+}
+
+// OauthClientClient is a client for the OauthClient schema.
+type OauthClientClient struct {
+	config
+}
+
+// NewOauthClientClient returns a client for the OauthClient from the given config.
+func NewOauthClientClient(c config) *OauthClientClient {
+	return &OauthClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthclient.Hooks(f(g(h())))`.
+func (c *OauthClientClient) Use(hooks ...Hook) {
+	c.hooks.OauthClient = append(c.hooks.OauthClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthclient.Intercept(f(g(h())))`.
+func (c *OauthClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthClient = append(c.inters.OauthClient, interceptors...)
+}
+
+// Create returns a builder for creating a OauthClient entity.
+func (c *OauthClientClient) Create() *OauthClientCreate {
+	mutation := newOauthClientMutation(c.config, OpCreate)
+	return &OauthClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthClient entities.
+func (c *OauthClientClient) CreateBulk(builders ...*OauthClientCreate) *OauthClientCreateBulk {
+	return &OauthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthClientClient) MapCreateBulk(slice any, setFunc func(*OauthClientCreate, int)) *OauthClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthClientCreateBulk{err: fmt.Errorf("calling to OauthClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthClient.
+func (c *OauthClientClient) Update() *OauthClientUpdate {
+	mutation := newOauthClientMutation(c.config, OpUpdate)
+	return &OauthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthClientClient) UpdateOne(oc *OauthClient) *OauthClientUpdateOne {
+	mutation := newOauthClientMutation(c.config, OpUpdateOne, withOauthClient(oc))
+	return &OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthClientClient) UpdateOneID(id string) *OauthClientUpdateOne {
+	mutation := newOauthClientMutation(c.config, OpUpdateOne, withOauthClientID(id))
+	return &OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthClient.
+func (c *OauthClientClient) Delete() *OauthClientDelete {
+	mutation := newOauthClientMutation(c.config, OpDelete)
+	return &OauthClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthClientClient) DeleteOne(oc *OauthClient) *OauthClientDeleteOne {
+	return c.DeleteOneID(oc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthClientClient) DeleteOneID(id string) *OauthClientDeleteOne {
+	builder := c.Delete().Where(oauthclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthClientDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthClient.
+func (c *OauthClientClient) Query() *OauthClientQuery {
+	return &OauthClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthClient entity by its id.
+func (c *OauthClientClient) Get(ctx context.Context, id string) (*OauthClient, error) {
+	return c.Query().Where(oauthclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthClientClient) GetX(ctx context.Context, id string) *OauthClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a OauthClient.
+func (c *OauthClientClient) QueryOwner(oc *OauthClient) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthclient.Table, oauthclient.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthclient.OwnerTable, oauthclient.OwnerColumn),
+		)
+		schemaConfig := oc.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.OauthClient
+		fromV = sqlgraph.Neighbors(oc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OauthClientClient) Hooks() []Hook {
+	hooks := c.hooks.OauthClient
+	return append(hooks[:len(hooks):len(hooks)], oauthclient.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthClientClient) Interceptors() []Interceptor {
+	inters := c.inters.OauthClient
+	return append(inters[:len(inters):len(inters)], oauthclient.Interceptors[:]...)
+}
+
+func (c *OauthClientClient) mutate(ctx context.Context, m *OauthClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OauthClient mutation op: %q", m.Op())
 	}
 }
 
@@ -1301,122 +2535,256 @@ func (c *OauthProviderClient) Interceptors() []Interceptor {
 	return append(inters[:len(inters):len(inters)], oauthprovider.Interceptors[:]...)
 }
 
-func (c *OauthProviderClient) mutate(ctx context.Context, m *OauthProviderMutation) (Value, error) {
+func (c *OauthProviderClient) mutate(ctx context.Context, m *OauthProviderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OauthProvider mutation op: %q", m.Op())
+	}
+}
+
+// OhAuthTooTokenClient is a client for the OhAuthTooToken schema.
+type OhAuthTooTokenClient struct {
+	config
+}
+
+// NewOhAuthTooTokenClient returns a client for the OhAuthTooToken from the given config.
+func NewOhAuthTooTokenClient(c config) *OhAuthTooTokenClient {
+	return &OhAuthTooTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ohauthtootoken.Hooks(f(g(h())))`.
+func (c *OhAuthTooTokenClient) Use(hooks ...Hook) {
+	c.hooks.OhAuthTooToken = append(c.hooks.OhAuthTooToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ohauthtootoken.Intercept(f(g(h())))`.
+func (c *OhAuthTooTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OhAuthTooToken = append(c.inters.OhAuthTooToken, interceptors...)
+}
+
+// Create returns a builder for creating a OhAuthTooToken entity.
+func (c *OhAuthTooTokenClient) Create() *OhAuthTooTokenCreate {
+	mutation := newOhAuthTooTokenMutation(c.config, OpCreate)
+	return &OhAuthTooTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OhAuthTooToken entities.
+func (c *OhAuthTooTokenClient) CreateBulk(builders ...*OhAuthTooTokenCreate) *OhAuthTooTokenCreateBulk {
+	return &OhAuthTooTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OhAuthTooTokenClient) MapCreateBulk(slice any, setFunc func(*OhAuthTooTokenCreate, int)) *OhAuthTooTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OhAuthTooTokenCreateBulk{err: fmt.Errorf("calling to OhAuthTooTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OhAuthTooTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OhAuthTooTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OhAuthTooToken.
+func (c *OhAuthTooTokenClient) Update() *OhAuthTooTokenUpdate {
+	mutation := newOhAuthTooTokenMutation(c.config, OpUpdate)
+	return &OhAuthTooTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OhAuthTooTokenClient) UpdateOne(oatt *OhAuthTooToken) *OhAuthTooTokenUpdateOne {
+	mutation := newOhAuthTooTokenMutation(c.config, OpUpdateOne, withOhAuthTooToken(oatt))
+	return &OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OhAuthTooTokenClient) UpdateOneID(id string) *OhAuthTooTokenUpdateOne {
+	mutation := newOhAuthTooTokenMutation(c.config, OpUpdateOne, withOhAuthTooTokenID(id))
+	return &OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OhAuthTooToken.
+func (c *OhAuthTooTokenClient) Delete() *OhAuthTooTokenDelete {
+	mutation := newOhAuthTooTokenMutation(c.config, OpDelete)
+	return &OhAuthTooTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OhAuthTooTokenClient) DeleteOne(oatt *OhAuthTooToken) *OhAuthTooTokenDeleteOne {
+	return c.DeleteOneID(oatt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OhAuthTooTokenClient) DeleteOneID(id string) *OhAuthTooTokenDeleteOne {
+	builder := c.Delete().Where(ohauthtootoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OhAuthTooTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for OhAuthTooToken.
+func (c *OhAuthTooTokenClient) Query() *OhAuthTooTokenQuery {
+	return &OhAuthTooTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOhAuthTooToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OhAuthTooToken entity by its id.
+func (c *OhAuthTooTokenClient) Get(ctx context.Context, id string) (*OhAuthTooToken, error) {
+	return c.Query().Where(ohauthtootoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OhAuthTooTokenClient) GetX(ctx context.Context, id string) *OhAuthTooToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OhAuthTooTokenClient) Hooks() []Hook {
+	hooks := c.hooks.OhAuthTooToken
+	return append(hooks[:len(hooks):len(hooks)], ohauthtootoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OhAuthTooTokenClient) Interceptors() []Interceptor {
+	return c.inters.OhAuthTooToken
+}
+
+func (c *OhAuthTooTokenClient) mutate(ctx context.Context, m *OhAuthTooTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OhAuthTooTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OhAuthTooTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OhAuthTooTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown OauthProvider mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown OhAuthTooToken mutation op: %q", m.Op())
 	}
 }
 
-// OhAuthTooTokenClient is a client for the OhAuthTooToken schema.
-type OhAuthTooTokenClient struct {
+// OrgMembershipClient is a client for the OrgMembership schema.
+type OrgMembershipClient struct {
 	config
 }
 
-// NewOhAuthTooTokenClient returns a client for the OhAuthTooToken from the given config.
-func NewOhAuthTooTokenClient(c config) *OhAuthTooTokenClient {
-	return &OhAuthTooTokenClient{config: c}
+// NewOrgMembershipClient returns a client for the OrgMembership from the given config.
+func NewOrgMembershipClient(c config) *OrgMembershipClient {
+	return &OrgMembershipClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ohauthtootoken.Hooks(f(g(h())))`.
-func (c *OhAuthTooTokenClient) Use(hooks ...Hook) {
-	c.hooks.OhAuthTooToken = append(c.hooks.OhAuthTooToken, hooks...)
+// A call to `Use(f, g, h)` equals to `orgmembership.Hooks(f(g(h())))`.
+func (c *OrgMembershipClient) Use(hooks ...Hook) {
+	c.hooks.OrgMembership = append(c.hooks.OrgMembership, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ohauthtootoken.Intercept(f(g(h())))`.
-func (c *OhAuthTooTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OhAuthTooToken = append(c.inters.OhAuthTooToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `orgmembership.Intercept(f(g(h())))`.
+func (c *OrgMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrgMembership = append(c.inters.OrgMembership, interceptors...)
 }
 
-// Create returns a builder for creating a OhAuthTooToken entity.
-func (c *OhAuthTooTokenClient) Create() *OhAuthTooTokenCreate {
-	mutation := newOhAuthTooTokenMutation(c.config, OpCreate)
-	return &OhAuthTooTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OrgMembership entity.
+func (c *OrgMembershipClient) Create() *OrgMembershipCreate {
+	mutation := newOrgMembershipMutation(c.config, OpCreate)
+	return &OrgMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OhAuthTooToken entities.
-func (c *OhAuthTooTokenClient) CreateBulk(builders ...*OhAuthTooTokenCreate) *OhAuthTooTokenCreateBulk {
-	return &OhAuthTooTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OrgMembership entities.
+func (c *OrgMembershipClient) CreateBulk(builders ...*OrgMembershipCreate) *OrgMembershipCreateBulk {
+	return &OrgMembershipCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OhAuthTooTokenClient) MapCreateBulk(slice any, setFunc func(*OhAuthTooTokenCreate, int)) *OhAuthTooTokenCreateBulk {
+func (c *OrgMembershipClient) MapCreateBulk(slice any, setFunc func(*OrgMembershipCreate, int)) *OrgMembershipCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OhAuthTooTokenCreateBulk{err: fmt.Errorf("calling to OhAuthTooTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrgMembershipCreateBulk{err: fmt.Errorf("calling to OrgMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OhAuthTooTokenCreate, rv.Len())
+	builders := make([]*OrgMembershipCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OhAuthTooTokenCreateBulk{config: c.config, builders: builders}
+	return &OrgMembershipCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OhAuthTooToken.
-func (c *OhAuthTooTokenClient) Update() *OhAuthTooTokenUpdate {
-	mutation := newOhAuthTooTokenMutation(c.config, OpUpdate)
-	return &OhAuthTooTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OrgMembership.
+func (c *OrgMembershipClient) Update() *OrgMembershipUpdate {
+	mutation := newOrgMembershipMutation(c.config, OpUpdate)
+	return &OrgMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OhAuthTooTokenClient) UpdateOne(oatt *OhAuthTooToken) *OhAuthTooTokenUpdateOne {
-	mutation := newOhAuthTooTokenMutation(c.config, OpUpdateOne, withOhAuthTooToken(oatt))
-	return &OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrgMembershipClient) UpdateOne(om *OrgMembership) *OrgMembershipUpdateOne {
+	mutation := newOrgMembershipMutation(c.config, OpUpdateOne, withOrgMembership(om))
+	return &OrgMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OhAuthTooTokenClient) UpdateOneID(id string) *OhAuthTooTokenUpdateOne {
-	mutation := newOhAuthTooTokenMutation(c.config, OpUpdateOne, withOhAuthTooTokenID(id))
-	return &OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrgMembershipClient) UpdateOneID(id string) *OrgMembershipUpdateOne {
+	mutation := newOrgMembershipMutation(c.config, OpUpdateOne, withOrgMembershipID(id))
+	return &OrgMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OhAuthTooToken.
-func (c *OhAuthTooTokenClient) Delete() *OhAuthTooTokenDelete {
-	mutation := newOhAuthTooTokenMutation(c.config, OpDelete)
-	return &OhAuthTooTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OrgMembership.
+func (c *OrgMembershipClient) Delete() *OrgMembershipDelete {
+	mutation := newOrgMembershipMutation(c.config, OpDelete)
+	return &OrgMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OhAuthTooTokenClient) DeleteOne(oatt *OhAuthTooToken) *OhAuthTooTokenDeleteOne {
-	return c.DeleteOneID(oatt.ID)
+func (c *OrgMembershipClient) DeleteOne(om *OrgMembership) *OrgMembershipDeleteOne {
+	return c.DeleteOneID(om.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OhAuthTooTokenClient) DeleteOneID(id string) *OhAuthTooTokenDeleteOne {
-	builder := c.Delete().Where(ohauthtootoken.ID(id))
+func (c *OrgMembershipClient) DeleteOneID(id string) *OrgMembershipDeleteOne {
+	builder := c.Delete().Where(orgmembership.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OhAuthTooTokenDeleteOne{builder}
+	return &OrgMembershipDeleteOne{builder}
 }
 
-// Query returns a query builder for OhAuthTooToken.
-func (c *OhAuthTooTokenClient) Query() *OhAuthTooTokenQuery {
-	return &OhAuthTooTokenQuery{
+// Query returns a query builder for OrgMembership.
+func (c *OrgMembershipClient) Query() *OrgMembershipQuery {
+	return &OrgMembershipQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOhAuthTooToken},
+		ctx:    &QueryContext{Type: TypeOrgMembership},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OhAuthTooToken entity by its id.
-func (c *OhAuthTooTokenClient) Get(ctx context.Context, id string) (*OhAuthTooToken, error) {
-	return c.Query().Where(ohauthtootoken.ID(id)).Only(ctx)
+// Get returns a OrgMembership entity by its id.
+func (c *OrgMembershipClient) Get(ctx context.Context, id string) (*OrgMembership, error) {
+	return c.Query().Where(orgmembership.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OhAuthTooTokenClient) GetX(ctx context.Context, id string) *OhAuthTooToken {
+func (c *OrgMembershipClient) GetX(ctx context.Context, id string) *OrgMembership {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1424,28 +2792,68 @@ func (c *OhAuthTooTokenClient) GetX(ctx context.Context, id string) *OhAuthTooTo
 	return obj
 }
 
+// QueryOrganization queries the organization edge of a OrgMembership.
+func (c *OrgMembershipClient) QueryOrganization(om *OrgMembership) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembership.Table, orgmembership.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembership.OrganizationTable, orgmembership.OrganizationColumn),
+		)
+		schemaConfig := om.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.OrgMembership
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrgMembership.
+func (c *OrgMembershipClient) QueryUser(om *OrgMembership) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembership.Table, orgmembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembership.UserTable, orgmembership.UserColumn),
+		)
+		schemaConfig := om.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OrgMembership
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *OhAuthTooTokenClient) Hooks() []Hook {
-	return c.hooks.OhAuthTooToken
+func (c *OrgMembershipClient) Hooks() []Hook {
+	hooks := c.hooks.OrgMembership
+	return append(hooks[:len(hooks):len(hooks)], orgmembership.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *OhAuthTooTokenClient) Interceptors() []Interceptor {
-	return c.inters.OhAuthTooToken
+func (c *OrgMembershipClient) Interceptors() []Interceptor {
+	inters := c.inters.OrgMembership
+	return append(inters[:len(inters):len(inters)], orgmembership.Interceptors[:]...)
 }
 
-func (c *OhAuthTooTokenClient) mutate(ctx context.Context, m *OhAuthTooTokenMutation) (Value, error) {
+func (c *OrgMembershipClient) mutate(ctx context.Context, m *OrgMembershipMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OhAuthTooTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrgMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OhAuthTooTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrgMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OhAuthTooTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrgMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OhAuthTooTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrgMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown OhAuthTooToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown OrgMembership mutation op: %q", m.Op())
 	}
 }
 
@@ -1607,7 +3015,7 @@ func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
 		)
 		schemaConfig := o.schemaConfig
 		step.To.Schema = schemaConfig.User
-		step.Edge.Schema = schemaConfig.UserOrganizations
+		step.Edge.Schema = schemaConfig.OrgMembership
 		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
@@ -1709,6 +3117,82 @@ func (c *OrganizationClient) QueryOauthprovider(o *Organization) *OauthProviderQ
 	return query
 }
 
+// QueryAPIKeys queries the api_keys edge of a Organization.
+func (c *OrganizationClient) QueryAPIKeys(o *Organization) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.APIKeysTable, organization.APIKeysColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.APIKey
+		step.Edge.Schema = schemaConfig.APIKey
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthClients queries the oauth_clients edge of a Organization.
+func (c *OrganizationClient) QueryOauthClients(o *Organization) *OauthClientQuery {
+	query := (&OauthClientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(oauthclient.Table, oauthclient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.OauthClientsTable, organization.OauthClientsColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.OauthClient
+		step.Edge.Schema = schemaConfig.OauthClient
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a Organization.
+func (c *OrganizationClient) QueryInvites(o *Organization) *InviteQuery {
+	query := (&InviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvitesTable, organization.InvitesColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.Invite
+		step.Edge.Schema = schemaConfig.Invite
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Organization.
+func (c *OrganizationClient) QueryMembers(o *Organization) *OrgMembershipQuery {
+	query := (&OrgMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(orgmembership.Table, orgmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, organization.MembersTable, organization.MembersColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.OrgMembership
+		step.Edge.Schema = schemaConfig.OrgMembership
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *OrganizationClient) Hooks() []Hook {
 	hooks := c.hooks.Organization
@@ -1821,22 +3305,176 @@ func (c *OrganizationSettingClient) DeleteOneID(id string) *OrganizationSettingD
 	return &OrganizationSettingDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationSetting.
-func (c *OrganizationSettingClient) Query() *OrganizationSettingQuery {
-	return &OrganizationSettingQuery{
+// Query returns a query builder for OrganizationSetting.
+func (c *OrganizationSettingClient) Query() *OrganizationSettingQuery {
+	return &OrganizationSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationSetting entity by its id.
+func (c *OrganizationSettingClient) Get(ctx context.Context, id string) (*OrganizationSetting, error) {
+	return c.Query().Where(organizationsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationSettingClient) GetX(ctx context.Context, id string) *OrganizationSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a OrganizationSetting.
+func (c *OrganizationSettingClient) QueryOrganization(os *OrganizationSetting) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := os.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationsetting.Table, organizationsetting.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, organizationsetting.OrganizationTable, organizationsetting.OrganizationColumn),
+		)
+		schemaConfig := os.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.OrganizationSetting
+		fromV = sqlgraph.Neighbors(os.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationSettingClient) Hooks() []Hook {
+	hooks := c.hooks.OrganizationSetting
+	return append(hooks[:len(hooks):len(hooks)], organizationsetting.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationSettingClient) Interceptors() []Interceptor {
+	inters := c.inters.OrganizationSetting
+	return append(inters[:len(inters):len(inters)], organizationsetting.Interceptors[:]...)
+}
+
+func (c *OrganizationSettingClient) mutate(ctx context.Context, m *OrganizationSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OrganizationSetting mutation op: %q", m.Op())
+	}
+}
+
+// OwnershipTransferClient is a client for the OwnershipTransfer schema.
+type OwnershipTransferClient struct {
+	config
+}
+
+// NewOwnershipTransferClient returns a client for the OwnershipTransfer from the given config.
+func NewOwnershipTransferClient(c config) *OwnershipTransferClient {
+	return &OwnershipTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ownershiptransfer.Hooks(f(g(h())))`.
+func (c *OwnershipTransferClient) Use(hooks ...Hook) {
+	c.hooks.OwnershipTransfer = append(c.hooks.OwnershipTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ownershiptransfer.Intercept(f(g(h())))`.
+func (c *OwnershipTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OwnershipTransfer = append(c.inters.OwnershipTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a OwnershipTransfer entity.
+func (c *OwnershipTransferClient) Create() *OwnershipTransferCreate {
+	mutation := newOwnershipTransferMutation(c.config, OpCreate)
+	return &OwnershipTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnershipTransfer entities.
+func (c *OwnershipTransferClient) CreateBulk(builders ...*OwnershipTransferCreate) *OwnershipTransferCreateBulk {
+	return &OwnershipTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OwnershipTransferClient) MapCreateBulk(slice any, setFunc func(*OwnershipTransferCreate, int)) *OwnershipTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OwnershipTransferCreateBulk{err: fmt.Errorf("calling to OwnershipTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OwnershipTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OwnershipTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Update() *OwnershipTransferUpdate {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdate)
+	return &OwnershipTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnershipTransferClient) UpdateOne(ot *OwnershipTransfer) *OwnershipTransferUpdateOne {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdateOne, withOwnershipTransfer(ot))
+	return &OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnershipTransferClient) UpdateOneID(id string) *OwnershipTransferUpdateOne {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdateOne, withOwnershipTransferID(id))
+	return &OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Delete() *OwnershipTransferDelete {
+	mutation := newOwnershipTransferMutation(c.config, OpDelete)
+	return &OwnershipTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OwnershipTransferClient) DeleteOne(ot *OwnershipTransfer) *OwnershipTransferDeleteOne {
+	return c.DeleteOneID(ot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OwnershipTransferClient) DeleteOneID(id string) *OwnershipTransferDeleteOne {
+	builder := c.Delete().Where(ownershiptransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnershipTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Query() *OwnershipTransferQuery {
+	return &OwnershipTransferQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationSetting},
+		ctx:    &QueryContext{Type: TypeOwnershipTransfer},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationSetting entity by its id.
-func (c *OrganizationSettingClient) Get(ctx context.Context, id string) (*OrganizationSetting, error) {
-	return c.Query().Where(organizationsetting.ID(id)).Only(ctx)
+// Get returns a OwnershipTransfer entity by its id.
+func (c *OwnershipTransferClient) Get(ctx context.Context, id string) (*OwnershipTransfer, error) {
+	return c.Query().Where(ownershiptransfer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationSettingClient) GetX(ctx context.Context, id string) *OrganizationSetting {
+func (c *OwnershipTransferClient) GetX(ctx context.Context, id string) *OwnershipTransfer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1844,49 +3482,106 @@ func (c *OrganizationSettingClient) GetX(ctx context.Context, id string) *Organi
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a OrganizationSetting.
-func (c *OrganizationSettingClient) QueryOrganization(os *OrganizationSetting) *OrganizationQuery {
+// QueryOwner queries the owner edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryOwner(ot *OwnershipTransfer) *OrganizationQuery {
 	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := os.ID
+		id := ot.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationsetting.Table, organizationsetting.FieldID, id),
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
 			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, organizationsetting.OrganizationTable, organizationsetting.OrganizationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ownershiptransfer.OwnerTable, ownershiptransfer.OwnerColumn),
 		)
-		schemaConfig := os.schemaConfig
+		schemaConfig := ot.schemaConfig
 		step.To.Schema = schemaConfig.Organization
-		step.Edge.Schema = schemaConfig.OrganizationSetting
-		fromV = sqlgraph.Neighbors(os.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryGroup(ot *OwnershipTransfer) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ownershiptransfer.GroupTable, ownershiptransfer.GroupColumn),
+		)
+		schemaConfig := ot.schemaConfig
+		step.To.Schema = schemaConfig.Group
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequestor queries the requestor edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryRequestor(ot *OwnershipTransfer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ownershiptransfer.RequestorTable, ownershiptransfer.RequestorColumn),
+		)
+		schemaConfig := ot.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryRecipient(ot *OwnershipTransfer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ownershiptransfer.RecipientTable, ownershiptransfer.RecipientColumn),
+		)
+		schemaConfig := ot.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationSettingClient) Hooks() []Hook {
-	hooks := c.hooks.OrganizationSetting
-	return append(hooks[:len(hooks):len(hooks)], organizationsetting.Hooks[:]...)
+func (c *OwnershipTransferClient) Hooks() []Hook {
+	hooks := c.hooks.OwnershipTransfer
+	return append(hooks[:len(hooks):len(hooks)], ownershiptransfer.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationSettingClient) Interceptors() []Interceptor {
-	inters := c.inters.OrganizationSetting
-	return append(inters[:len(inters):len(inters)], organizationsetting.Interceptors[:]...)
+func (c *OwnershipTransferClient) Interceptors() []Interceptor {
+	inters := c.inters.OwnershipTransfer
+	return append(inters[:len(inters):len(inters)], ownershiptransfer.Interceptors[:]...)
 }
 
-func (c *OrganizationSettingClient) mutate(ctx context.Context, m *OrganizationSettingMutation) (Value, error) {
+func (c *OwnershipTransferClient) mutate(ctx context.Context, m *OwnershipTransferMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OwnershipTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OwnershipTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OwnershipTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown OrganizationSetting mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown OwnershipTransfer mutation op: %q", m.Op())
 	}
 }
 
@@ -2110,41 +3805,329 @@ func (c *PersonalAccessTokenClient) UpdateOneID(id string) *PersonalAccessTokenU
 	return &PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PersonalAccessToken.
-func (c *PersonalAccessTokenClient) Delete() *PersonalAccessTokenDelete {
-	mutation := newPersonalAccessTokenMutation(c.config, OpDelete)
-	return &PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Delete() *PersonalAccessTokenDelete {
+	mutation := newPersonalAccessTokenMutation(c.config, OpDelete)
+	return &PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PersonalAccessTokenClient) DeleteOne(pat *PersonalAccessToken) *PersonalAccessTokenDeleteOne {
+	return c.DeleteOneID(pat.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PersonalAccessTokenClient) DeleteOneID(id string) *PersonalAccessTokenDeleteOne {
+	builder := c.Delete().Where(personalaccesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PersonalAccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Query() *PersonalAccessTokenQuery {
+	return &PersonalAccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePersonalAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PersonalAccessToken entity by its id.
+func (c *PersonalAccessTokenClient) Get(ctx context.Context, id string) (*PersonalAccessToken, error) {
+	return c.Query().Where(personalaccesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PersonalAccessTokenClient) GetX(ctx context.Context, id string) *PersonalAccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a PersonalAccessToken.
+func (c *PersonalAccessTokenClient) QueryOwner(pat *PersonalAccessToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pat.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(personalaccesstoken.Table, personalaccesstoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, personalaccesstoken.OwnerTable, personalaccesstoken.OwnerColumn),
+		)
+		schemaConfig := pat.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.PersonalAccessToken
+		fromV = sqlgraph.Neighbors(pat.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PersonalAccessTokenClient) Hooks() []Hook {
+	hooks := c.hooks.PersonalAccessToken
+	return append(hooks[:len(hooks):len(hooks)], personalaccesstoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PersonalAccessTokenClient) Interceptors() []Interceptor {
+	inters := c.inters.PersonalAccessToken
+	return append(inters[:len(inters):len(inters)], personalaccesstoken.Interceptors[:]...)
+}
+
+func (c *PersonalAccessTokenClient) mutate(ctx context.Context, m *PersonalAccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PersonalAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PersonalAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PersonalAccessToken mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(rt *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(rt))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id string) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(rt *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id string) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id string) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id string) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	hooks := c.hooks.RefreshToken
+	return append(hooks[:len(hooks):len(hooks)], refreshtoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// RevokedTokenClient is a client for the RevokedToken schema.
+type RevokedTokenClient struct {
+	config
+}
+
+// NewRevokedTokenClient returns a client for the RevokedToken from the given config.
+func NewRevokedTokenClient(c config) *RevokedTokenClient {
+	return &RevokedTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revokedtoken.Hooks(f(g(h())))`.
+func (c *RevokedTokenClient) Use(hooks ...Hook) {
+	c.hooks.RevokedToken = append(c.hooks.RevokedToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revokedtoken.Intercept(f(g(h())))`.
+func (c *RevokedTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevokedToken = append(c.inters.RevokedToken, interceptors...)
+}
+
+// Create returns a builder for creating a RevokedToken entity.
+func (c *RevokedTokenClient) Create() *RevokedTokenCreate {
+	mutation := newRevokedTokenMutation(c.config, OpCreate)
+	return &RevokedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevokedToken entities.
+func (c *RevokedTokenClient) CreateBulk(builders ...*RevokedTokenCreate) *RevokedTokenCreateBulk {
+	return &RevokedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevokedTokenClient) MapCreateBulk(slice any, setFunc func(*RevokedTokenCreate, int)) *RevokedTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevokedTokenCreateBulk{err: fmt.Errorf("calling to RevokedTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevokedTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevokedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevokedToken.
+func (c *RevokedTokenClient) Update() *RevokedTokenUpdate {
+	mutation := newRevokedTokenMutation(c.config, OpUpdate)
+	return &RevokedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevokedTokenClient) UpdateOne(rt *RevokedToken) *RevokedTokenUpdateOne {
+	mutation := newRevokedTokenMutation(c.config, OpUpdateOne, withRevokedToken(rt))
+	return &RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevokedTokenClient) UpdateOneID(id string) *RevokedTokenUpdateOne {
+	mutation := newRevokedTokenMutation(c.config, OpUpdateOne, withRevokedTokenID(id))
+	return &RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevokedToken.
+func (c *RevokedTokenClient) Delete() *RevokedTokenDelete {
+	mutation := newRevokedTokenMutation(c.config, OpDelete)
+	return &RevokedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PersonalAccessTokenClient) DeleteOne(pat *PersonalAccessToken) *PersonalAccessTokenDeleteOne {
-	return c.DeleteOneID(pat.ID)
+func (c *RevokedTokenClient) DeleteOne(rt *RevokedToken) *RevokedTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PersonalAccessTokenClient) DeleteOneID(id string) *PersonalAccessTokenDeleteOne {
-	builder := c.Delete().Where(personalaccesstoken.ID(id))
+func (c *RevokedTokenClient) DeleteOneID(id string) *RevokedTokenDeleteOne {
+	builder := c.Delete().Where(revokedtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PersonalAccessTokenDeleteOne{builder}
+	return &RevokedTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for PersonalAccessToken.
-func (c *PersonalAccessTokenClient) Query() *PersonalAccessTokenQuery {
-	return &PersonalAccessTokenQuery{
+// Query returns a query builder for RevokedToken.
+func (c *RevokedTokenClient) Query() *RevokedTokenQuery {
+	return &RevokedTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePersonalAccessToken},
+		ctx:    &QueryContext{Type: TypeRevokedToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PersonalAccessToken entity by its id.
-func (c *PersonalAccessTokenClient) Get(ctx context.Context, id string) (*PersonalAccessToken, error) {
-	return c.Query().Where(personalaccesstoken.ID(id)).Only(ctx)
+// Get returns a RevokedToken entity by its id.
+func (c *RevokedTokenClient) Get(ctx context.Context, id string) (*RevokedToken, error) {
+	return c.Query().Where(revokedtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PersonalAccessTokenClient) GetX(ctx context.Context, id string) *PersonalAccessToken {
+func (c *RevokedTokenClient) GetX(ctx context.Context, id string) *RevokedToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2152,49 +4135,29 @@ func (c *PersonalAccessTokenClient) GetX(ctx context.Context, id string) *Person
 	return obj
 }
 
-// QueryOwner queries the owner edge of a PersonalAccessToken.
-func (c *PersonalAccessTokenClient) QueryOwner(pat *PersonalAccessToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pat.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(personalaccesstoken.Table, personalaccesstoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, personalaccesstoken.OwnerTable, personalaccesstoken.OwnerColumn),
-		)
-		schemaConfig := pat.schemaConfig
-		step.To.Schema = schemaConfig.User
-		step.Edge.Schema = schemaConfig.PersonalAccessToken
-		fromV = sqlgraph.Neighbors(pat.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PersonalAccessTokenClient) Hooks() []Hook {
-	hooks := c.hooks.PersonalAccessToken
-	return append(hooks[:len(hooks):len(hooks)], personalaccesstoken.Hooks[:]...)
+func (c *RevokedTokenClient) Hooks() []Hook {
+	hooks := c.hooks.RevokedToken
+	return append(hooks[:len(hooks):len(hooks)], revokedtoken.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PersonalAccessTokenClient) Interceptors() []Interceptor {
-	inters := c.inters.PersonalAccessToken
-	return append(inters[:len(inters):len(inters)], personalaccesstoken.Interceptors[:]...)
+func (c *RevokedTokenClient) Interceptors() []Interceptor {
+	return c.inters.RevokedToken
 }
 
-func (c *PersonalAccessTokenClient) mutate(ctx context.Context, m *PersonalAccessTokenMutation) (Value, error) {
+func (c *RevokedTokenClient) mutate(ctx context.Context, m *RevokedTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PersonalAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RevokedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PersonalAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RevokedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RevokedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown PersonalAccessToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown RevokedToken mutation op: %q", m.Op())
 	}
 }
 
@@ -2351,6 +4314,140 @@ func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value,
 	}
 }
 
+// SessionDataClient is a client for the SessionData schema.
+type SessionDataClient struct {
+	config
+}
+
+// NewSessionDataClient returns a client for the SessionData from the given config.
+func NewSessionDataClient(c config) *SessionDataClient {
+	return &SessionDataClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sessiondata.Hooks(f(g(h())))`.
+func (c *SessionDataClient) Use(hooks ...Hook) {
+	c.hooks.SessionData = append(c.hooks.SessionData, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sessiondata.Intercept(f(g(h())))`.
+func (c *SessionDataClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionData = append(c.inters.SessionData, interceptors...)
+}
+
+// Create returns a builder for creating a SessionData entity.
+func (c *SessionDataClient) Create() *SessionDataCreate {
+	mutation := newSessionDataMutation(c.config, OpCreate)
+	return &SessionDataCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SessionData entities.
+func (c *SessionDataClient) CreateBulk(builders ...*SessionDataCreate) *SessionDataCreateBulk {
+	return &SessionDataCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionDataClient) MapCreateBulk(slice any, setFunc func(*SessionDataCreate, int)) *SessionDataCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionDataCreateBulk{err: fmt.Errorf("calling to SessionDataClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionDataCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionDataCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SessionData.
+func (c *SessionDataClient) Update() *SessionDataUpdate {
+	mutation := newSessionDataMutation(c.config, OpUpdate)
+	return &SessionDataUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionDataClient) UpdateOne(sd *SessionData) *SessionDataUpdateOne {
+	mutation := newSessionDataMutation(c.config, OpUpdateOne, withSessionData(sd))
+	return &SessionDataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionDataClient) UpdateOneID(id string) *SessionDataUpdateOne {
+	mutation := newSessionDataMutation(c.config, OpUpdateOne, withSessionDataID(id))
+	return &SessionDataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SessionData.
+func (c *SessionDataClient) Delete() *SessionDataDelete {
+	mutation := newSessionDataMutation(c.config, OpDelete)
+	return &SessionDataDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionDataClient) DeleteOne(sd *SessionData) *SessionDataDeleteOne {
+	return c.DeleteOneID(sd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionDataClient) DeleteOneID(id string) *SessionDataDeleteOne {
+	builder := c.Delete().Where(sessiondata.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDataDeleteOne{builder}
+}
+
+// Query returns a query builder for SessionData.
+func (c *SessionDataClient) Query() *SessionDataQuery {
+	return &SessionDataQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSessionData},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SessionData entity by its id.
+func (c *SessionDataClient) Get(ctx context.Context, id string) (*SessionData, error) {
+	return c.Query().Where(sessiondata.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionDataClient) GetX(ctx context.Context, id string) *SessionData {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SessionDataClient) Hooks() []Hook {
+	hooks := c.hooks.SessionData
+	return append(hooks[:len(hooks):len(hooks)], sessiondata.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionDataClient) Interceptors() []Interceptor {
+	return c.inters.SessionData
+}
+
+func (c *SessionDataClient) mutate(ctx context.Context, m *SessionDataMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionDataCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionDataUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionDataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDataDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown SessionData mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2471,7 +4568,7 @@ func (c *UserClient) QueryOrganizations(u *User) *OrganizationQuery {
 		)
 		schemaConfig := u.schemaConfig
 		step.To.Schema = schemaConfig.Organization
-		step.Edge.Schema = schemaConfig.UserOrganizations
+		step.Edge.Schema = schemaConfig.OrgMembership
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
 	}
@@ -2509,7 +4606,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 		)
 		schemaConfig := u.schemaConfig
 		step.To.Schema = schemaConfig.Group
-		step.Edge.Schema = schemaConfig.GroupUsers
+		step.Edge.Schema = schemaConfig.GroupMembership
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
 	}
@@ -2592,6 +4689,82 @@ func (c *UserClient) QueryResetTokens(u *User) *PasswordResetTokenQuery {
 	return query
 }
 
+// QueryMagicLinkTokens queries the magic_link_tokens edge of a User.
+func (c *UserClient) QueryMagicLinkTokens(u *User) *MagicLinkTokenQuery {
+	query := (&MagicLinkTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(magiclinktoken.Table, magiclinktoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MagicLinkTokensTable, user.MagicLinkTokensColumn),
+		)
+		schemaConfig := u.schemaConfig
+		step.To.Schema = schemaConfig.MagicLinkToken
+		step.Edge.Schema = schemaConfig.MagicLinkToken
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebauthnCredentials queries the webauthn_credentials edge of a User.
+func (c *UserClient) QueryWebauthnCredentials(u *User) *WebauthnCredentialQuery {
+	query := (&WebauthnCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(webauthncredential.Table, webauthncredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebauthnCredentialsTable, user.WebauthnCredentialsColumn),
+		)
+		schemaConfig := u.schemaConfig
+		step.To.Schema = schemaConfig.WebauthnCredential
+		step.Edge.Schema = schemaConfig.WebauthnCredential
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrgMemberships queries the org_memberships edge of a User.
+func (c *UserClient) QueryOrgMemberships(u *User) *OrgMembershipQuery {
+	query := (&OrgMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(orgmembership.Table, orgmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.OrgMembershipsTable, user.OrgMembershipsColumn),
+		)
+		schemaConfig := u.schemaConfig
+		step.To.Schema = schemaConfig.OrgMembership
+		step.Edge.Schema = schemaConfig.OrgMembership
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupMemberships queries the group_memberships edge of a User.
+func (c *UserClient) QueryGroupMemberships(u *User) *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.GroupMembershipsTable, user.GroupMembershipsColumn),
+		)
+		schemaConfig := u.schemaConfig
+		step.To.Schema = schemaConfig.GroupMembership
+		step.Edge.Schema = schemaConfig.GroupMembership
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -2773,18 +4946,177 @@ func (c *UserSettingClient) mutate(ctx context.Context, m *UserSettingMutation)
 	}
 }
 
+// WebauthnCredentialClient is a client for the WebauthnCredential schema.
+type WebauthnCredentialClient struct {
+	config
+}
+
+// NewWebauthnCredentialClient returns a client for the WebauthnCredential from the given config.
+func NewWebauthnCredentialClient(c config) *WebauthnCredentialClient {
+	return &WebauthnCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebauthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebauthnCredential = append(c.hooks.WebauthnCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webauthncredential.Intercept(f(g(h())))`.
+func (c *WebauthnCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebauthnCredential = append(c.inters.WebauthnCredential, interceptors...)
+}
+
+// Create returns a builder for creating a WebauthnCredential entity.
+func (c *WebauthnCredentialClient) Create() *WebauthnCredentialCreate {
+	mutation := newWebauthnCredentialMutation(c.config, OpCreate)
+	return &WebauthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebauthnCredential entities.
+func (c *WebauthnCredentialClient) CreateBulk(builders ...*WebauthnCredentialCreate) *WebauthnCredentialCreateBulk {
+	return &WebauthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebauthnCredentialClient) MapCreateBulk(slice any, setFunc func(*WebauthnCredentialCreate, int)) *WebauthnCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebauthnCredentialCreateBulk{err: fmt.Errorf("calling to WebauthnCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebauthnCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebauthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Update() *WebauthnCredentialUpdate {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdate)
+	return &WebauthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebauthnCredentialClient) UpdateOne(wc *WebauthnCredential) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredential(wc))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebauthnCredentialClient) UpdateOneID(id string) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredentialID(id))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Delete() *WebauthnCredentialDelete {
+	mutation := newWebauthnCredentialMutation(c.config, OpDelete)
+	return &WebauthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebauthnCredentialClient) DeleteOne(wc *WebauthnCredential) *WebauthnCredentialDeleteOne {
+	return c.DeleteOneID(wc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebauthnCredentialClient) DeleteOneID(id string) *WebauthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebauthnCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Query() *WebauthnCredentialQuery {
+	return &WebauthnCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebauthnCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebauthnCredential entity by its id.
+func (c *WebauthnCredentialClient) Get(ctx context.Context, id string) (*WebauthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebauthnCredentialClient) GetX(ctx context.Context, id string) *WebauthnCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a WebauthnCredential.
+func (c *WebauthnCredentialClient) QueryOwner(wc *WebauthnCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webauthncredential.Table, webauthncredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webauthncredential.OwnerTable, webauthncredential.OwnerColumn),
+		)
+		schemaConfig := wc.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.WebauthnCredential
+		fromV = sqlgraph.Neighbors(wc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebauthnCredentialClient) Hooks() []Hook {
+	hooks := c.hooks.WebauthnCredential
+	return append(hooks[:len(hooks):len(hooks)], webauthncredential.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebauthnCredentialClient) Interceptors() []Interceptor {
+	inters := c.inters.WebauthnCredential
+	return append(inters[:len(inters):len(inters)], webauthncredential.Interceptors[:]...)
+}
+
+func (c *WebauthnCredentialClient) mutate(ctx context.Context, m *WebauthnCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebauthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebauthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebauthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown WebauthnCredential mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		EmailVerificationToken, Entitlement, Group, GroupSetting, Integration,
-		OauthProvider, OhAuthTooToken, Organization, OrganizationSetting,
-		PasswordResetToken, PersonalAccessToken, Session, User, UserSetting []ent.Hook
+		APIKey, EmailVerificationToken, Entitlement, Group, GroupJoinRequest,
+		GroupMembership, GroupSetting, Integration, Invite, MagicLinkToken,
+		OauthAuthorizationCode, OauthClient, OauthProvider, OhAuthTooToken,
+		OrgMembership, Organization, OrganizationSetting, OwnershipTransfer,
+		PasswordResetToken, PersonalAccessToken, RefreshToken, RevokedToken, Session,
+		SessionData, User, UserSetting, WebauthnCredential []ent.Hook
 	}
 	inters struct {
-		EmailVerificationToken, Entitlement, Group, GroupSetting, Integration,
-		OauthProvider, OhAuthTooToken, Organization, OrganizationSetting,
-		PasswordResetToken, PersonalAccessToken, Session, User,
-		UserSetting []ent.Interceptor
+		APIKey, EmailVerificationToken, Entitlement, Group, GroupJoinRequest,
+		GroupMembership, GroupSetting, Integration, Invite, MagicLinkToken,
+		OauthAuthorizationCode, OauthClient, OauthProvider, OhAuthTooToken,
+		OrgMembership, Organization, OrganizationSetting, OwnershipTransfer,
+		PasswordResetToken, PersonalAccessToken, RefreshToken, RevokedToken, Session,
+		SessionData, User, UserSetting, WebauthnCredential []ent.Interceptor
 	}
 )
 
@@ -5,9 +5,13 @@ package generated
 import (
 	"context"
 
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/group"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
@@ -15,8 +19,14 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 )
 
+func APIKeyEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func EmailVerificationTokenEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
@@ -32,6 +42,16 @@ func GroupEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func GroupJoinRequestEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
+func GroupMembershipEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func GroupSettingEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
@@ -42,6 +62,26 @@ func IntegrationEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func InviteEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
+func MagicLinkTokenEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
+func OauthAuthorizationCodeEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
+func OauthClientEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func OauthProviderEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
@@ -52,6 +92,11 @@ func OhAuthTooTokenEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func OrgMembershipEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func OrganizationEdgeCleanup(ctx context.Context, id string) error {
 
 	if exists, err := FromContext(ctx).Group.Query().Where((group.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
@@ -75,6 +120,27 @@ func OrganizationEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).APIKey.Query().Where((apikey.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if apikeyCount, err := FromContext(ctx).APIKey.Delete().Where(apikey.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting apikey", "count", apikeyCount, "err", err)
+			return err
+		}
+	}
+
+	if exists, err := FromContext(ctx).OauthClient.Query().Where((oauthclient.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if oauthclientCount, err := FromContext(ctx).OauthClient.Delete().Where(oauthclient.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting oauthclient", "count", oauthclientCount, "err", err)
+			return err
+		}
+	}
+
+	if exists, err := FromContext(ctx).Invite.Query().Where((invite.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if inviteCount, err := FromContext(ctx).Invite.Delete().Where(invite.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting invite", "count", inviteCount, "err", err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -83,6 +149,11 @@ func OrganizationSettingEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func OwnershipTransferEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func PasswordResetTokenEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
@@ -93,11 +164,26 @@ func PersonalAccessTokenEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func RefreshTokenEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
+func RevokedTokenEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func SessionEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
 }
 
+func SessionDataEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
+
 func UserEdgeCleanup(ctx context.Context, id string) error {
 
 	if exists, err := FromContext(ctx).Session.Query().Where((session.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
@@ -135,6 +221,20 @@ func UserEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).MagicLinkToken.Query().Where((magiclinktoken.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
+		if magiclinktokenCount, err := FromContext(ctx).MagicLinkToken.Delete().Where(magiclinktoken.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting magiclinktoken", "count", magiclinktokenCount, "err", err)
+			return err
+		}
+	}
+
+	if exists, err := FromContext(ctx).WebauthnCredential.Query().Where((webauthncredential.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
+		if webauthncredentialCount, err := FromContext(ctx).WebauthnCredential.Delete().Where(webauthncredential.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting webauthncredential", "count", webauthncredentialCount, "err", err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -142,3 +242,8 @@ func UserSettingEdgeCleanup(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func WebauthnCredentialEdgeCleanup(ctx context.Context, id string) error {
+
+	return nil
+}
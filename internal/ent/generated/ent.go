@@ -12,20 +12,33 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -86,20 +99,33 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikey.Table:                 apikey.ValidColumn,
 			emailverificationtoken.Table: emailverificationtoken.ValidColumn,
 			entitlement.Table:            entitlement.ValidColumn,
 			group.Table:                  group.ValidColumn,
+			groupjoinrequest.Table:       groupjoinrequest.ValidColumn,
+			groupmembership.Table:        groupmembership.ValidColumn,
 			groupsetting.Table:           groupsetting.ValidColumn,
 			integration.Table:            integration.ValidColumn,
+			invite.Table:                 invite.ValidColumn,
+			magiclinktoken.Table:         magiclinktoken.ValidColumn,
+			oauthauthorizationcode.Table: oauthauthorizationcode.ValidColumn,
+			oauthclient.Table:            oauthclient.ValidColumn,
 			oauthprovider.Table:          oauthprovider.ValidColumn,
 			ohauthtootoken.Table:         ohauthtootoken.ValidColumn,
+			orgmembership.Table:          orgmembership.ValidColumn,
 			organization.Table:           organization.ValidColumn,
 			organizationsetting.Table:    organizationsetting.ValidColumn,
+			ownershiptransfer.Table:      ownershiptransfer.ValidColumn,
 			passwordresettoken.Table:     passwordresettoken.ValidColumn,
 			personalaccesstoken.Table:    personalaccesstoken.ValidColumn,
+			refreshtoken.Table:           refreshtoken.ValidColumn,
+			revokedtoken.Table:           revokedtoken.ValidColumn,
 			session.Table:                session.ValidColumn,
+			sessiondata.Table:            sessiondata.ValidColumn,
 			user.Table:                   user.ValidColumn,
 			usersetting.Table:            usersetting.ValidColumn,
+			webauthncredential.Table:     webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (eq *EntitlementQuery) prepareQuery(ctx context.Context) error {
 		}
 		eq.sql = prev
 	}
+	if entitlement.Policy == nil {
+		return errors.New("generated: uninitialized entitlement.Policy (forgotten import generated/runtime?)")
+	}
+	if err := entitlement.Policy.EvalQuery(ctx, eq); err != nil {
+		return err
+	}
 	return nil
 }
 
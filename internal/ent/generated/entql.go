@@ -3,21 +3,34 @@
 package generated
 
 import (
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,8 +40,35 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 14)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 27)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   apikey.Table,
+			Columns: apikey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: apikey.FieldID,
+			},
+		},
+		Type: "APIKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			apikey.FieldCreatedAt:   {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
+			apikey.FieldUpdatedAt:   {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
+			apikey.FieldCreatedBy:   {Type: field.TypeString, Column: apikey.FieldCreatedBy},
+			apikey.FieldUpdatedBy:   {Type: field.TypeString, Column: apikey.FieldUpdatedBy},
+			apikey.FieldDeletedAt:   {Type: field.TypeTime, Column: apikey.FieldDeletedAt},
+			apikey.FieldDeletedBy:   {Type: field.TypeString, Column: apikey.FieldDeletedBy},
+			apikey.FieldOwnerID:     {Type: field.TypeString, Column: apikey.FieldOwnerID},
+			apikey.FieldName:        {Type: field.TypeString, Column: apikey.FieldName},
+			apikey.FieldKeyPrefix:   {Type: field.TypeString, Column: apikey.FieldKeyPrefix},
+			apikey.FieldKeyHash:     {Type: field.TypeString, Column: apikey.FieldKeyHash},
+			apikey.FieldAbilities:   {Type: field.TypeJSON, Column: apikey.FieldAbilities},
+			apikey.FieldExpiresAt:   {Type: field.TypeTime, Column: apikey.FieldExpiresAt},
+			apikey.FieldDescription: {Type: field.TypeString, Column: apikey.FieldDescription},
+			apikey.FieldLastUsedAt:  {Type: field.TypeTime, Column: apikey.FieldLastUsedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   emailverificationtoken.Table,
 			Columns: emailverificationtoken.Columns,
@@ -52,7 +92,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			emailverificationtoken.FieldSecret:    {Type: field.TypeBytes, Column: emailverificationtoken.FieldSecret},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[2] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   entitlement.Table,
 			Columns: entitlement.Columns,
@@ -77,7 +117,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			entitlement.FieldCancelled:              {Type: field.TypeBool, Column: entitlement.FieldCancelled},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   group.Table,
 			Columns: group.Columns,
@@ -101,7 +141,49 @@ var schemaGraph = func() *sqlgraph.Schema {
 			group.FieldDisplayName:     {Type: field.TypeString, Column: group.FieldDisplayName},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   groupjoinrequest.Table,
+			Columns: groupjoinrequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: groupjoinrequest.FieldID,
+			},
+		},
+		Type: "GroupJoinRequest",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			groupjoinrequest.FieldCreatedAt:  {Type: field.TypeTime, Column: groupjoinrequest.FieldCreatedAt},
+			groupjoinrequest.FieldUpdatedAt:  {Type: field.TypeTime, Column: groupjoinrequest.FieldUpdatedAt},
+			groupjoinrequest.FieldCreatedBy:  {Type: field.TypeString, Column: groupjoinrequest.FieldCreatedBy},
+			groupjoinrequest.FieldUpdatedBy:  {Type: field.TypeString, Column: groupjoinrequest.FieldUpdatedBy},
+			groupjoinrequest.FieldGroupID:    {Type: field.TypeString, Column: groupjoinrequest.FieldGroupID},
+			groupjoinrequest.FieldUserID:     {Type: field.TypeString, Column: groupjoinrequest.FieldUserID},
+			groupjoinrequest.FieldMessage:    {Type: field.TypeString, Column: groupjoinrequest.FieldMessage},
+			groupjoinrequest.FieldStatus:     {Type: field.TypeEnum, Column: groupjoinrequest.FieldStatus},
+			groupjoinrequest.FieldReviewerID: {Type: field.TypeString, Column: groupjoinrequest.FieldReviewerID},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   groupmembership.Table,
+			Columns: groupmembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: groupmembership.FieldID,
+			},
+		},
+		Type: "GroupMembership",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			groupmembership.FieldCreatedAt: {Type: field.TypeTime, Column: groupmembership.FieldCreatedAt},
+			groupmembership.FieldUpdatedAt: {Type: field.TypeTime, Column: groupmembership.FieldUpdatedAt},
+			groupmembership.FieldCreatedBy: {Type: field.TypeString, Column: groupmembership.FieldCreatedBy},
+			groupmembership.FieldUpdatedBy: {Type: field.TypeString, Column: groupmembership.FieldUpdatedBy},
+			groupmembership.FieldRole:      {Type: field.TypeEnum, Column: groupmembership.FieldRole},
+			groupmembership.FieldGroupID:   {Type: field.TypeString, Column: groupmembership.FieldGroupID},
+			groupmembership.FieldUserID:    {Type: field.TypeString, Column: groupmembership.FieldUserID},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   groupsetting.Table,
 			Columns: groupsetting.Columns,
@@ -125,7 +207,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			groupsetting.FieldSyncToGithub: {Type: field.TypeBool, Column: groupsetting.FieldSyncToGithub},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   integration.Table,
 			Columns: integration.Columns,
@@ -148,7 +230,111 @@ var schemaGraph = func() *sqlgraph.Schema {
 			integration.FieldSecretName:  {Type: field.TypeString, Column: integration.FieldSecretName},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   invite.Table,
+			Columns: invite.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: invite.FieldID,
+			},
+		},
+		Type: "Invite",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			invite.FieldCreatedAt:   {Type: field.TypeTime, Column: invite.FieldCreatedAt},
+			invite.FieldUpdatedAt:   {Type: field.TypeTime, Column: invite.FieldUpdatedAt},
+			invite.FieldCreatedBy:   {Type: field.TypeString, Column: invite.FieldCreatedBy},
+			invite.FieldUpdatedBy:   {Type: field.TypeString, Column: invite.FieldUpdatedBy},
+			invite.FieldDeletedAt:   {Type: field.TypeTime, Column: invite.FieldDeletedAt},
+			invite.FieldDeletedBy:   {Type: field.TypeString, Column: invite.FieldDeletedBy},
+			invite.FieldOwnerID:     {Type: field.TypeString, Column: invite.FieldOwnerID},
+			invite.FieldToken:       {Type: field.TypeString, Column: invite.FieldToken},
+			invite.FieldExpires:     {Type: field.TypeTime, Column: invite.FieldExpires},
+			invite.FieldRecipient:   {Type: field.TypeString, Column: invite.FieldRecipient},
+			invite.FieldStatus:      {Type: field.TypeEnum, Column: invite.FieldStatus},
+			invite.FieldRole:        {Type: field.TypeEnum, Column: invite.FieldRole},
+			invite.FieldRequestorID: {Type: field.TypeString, Column: invite.FieldRequestorID},
+			invite.FieldSecret:      {Type: field.TypeBytes, Column: invite.FieldSecret},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   magiclinktoken.Table,
+			Columns: magiclinktoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: magiclinktoken.FieldID,
+			},
+		},
+		Type: "MagicLinkToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			magiclinktoken.FieldCreatedAt: {Type: field.TypeTime, Column: magiclinktoken.FieldCreatedAt},
+			magiclinktoken.FieldUpdatedAt: {Type: field.TypeTime, Column: magiclinktoken.FieldUpdatedAt},
+			magiclinktoken.FieldCreatedBy: {Type: field.TypeString, Column: magiclinktoken.FieldCreatedBy},
+			magiclinktoken.FieldUpdatedBy: {Type: field.TypeString, Column: magiclinktoken.FieldUpdatedBy},
+			magiclinktoken.FieldDeletedAt: {Type: field.TypeTime, Column: magiclinktoken.FieldDeletedAt},
+			magiclinktoken.FieldDeletedBy: {Type: field.TypeString, Column: magiclinktoken.FieldDeletedBy},
+			magiclinktoken.FieldToken:     {Type: field.TypeString, Column: magiclinktoken.FieldToken},
+			magiclinktoken.FieldTTL:       {Type: field.TypeTime, Column: magiclinktoken.FieldTTL},
+			magiclinktoken.FieldEmail:     {Type: field.TypeString, Column: magiclinktoken.FieldEmail},
+			magiclinktoken.FieldSecret:    {Type: field.TypeBytes, Column: magiclinktoken.FieldSecret},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   oauthauthorizationcode.Table,
+			Columns: oauthauthorizationcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: oauthauthorizationcode.FieldID,
+			},
+		},
+		Type: "OauthAuthorizationCode",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			oauthauthorizationcode.FieldCreatedAt:           {Type: field.TypeTime, Column: oauthauthorizationcode.FieldCreatedAt},
+			oauthauthorizationcode.FieldUpdatedAt:           {Type: field.TypeTime, Column: oauthauthorizationcode.FieldUpdatedAt},
+			oauthauthorizationcode.FieldCreatedBy:           {Type: field.TypeString, Column: oauthauthorizationcode.FieldCreatedBy},
+			oauthauthorizationcode.FieldUpdatedBy:           {Type: field.TypeString, Column: oauthauthorizationcode.FieldUpdatedBy},
+			oauthauthorizationcode.FieldCodeHash:            {Type: field.TypeString, Column: oauthauthorizationcode.FieldCodeHash},
+			oauthauthorizationcode.FieldClientID:            {Type: field.TypeString, Column: oauthauthorizationcode.FieldClientID},
+			oauthauthorizationcode.FieldUserID:              {Type: field.TypeString, Column: oauthauthorizationcode.FieldUserID},
+			oauthauthorizationcode.FieldRedirectURI:         {Type: field.TypeString, Column: oauthauthorizationcode.FieldRedirectURI},
+			oauthauthorizationcode.FieldScopes:              {Type: field.TypeJSON, Column: oauthauthorizationcode.FieldScopes},
+			oauthauthorizationcode.FieldNonce:               {Type: field.TypeString, Column: oauthauthorizationcode.FieldNonce},
+			oauthauthorizationcode.FieldCodeChallenge:       {Type: field.TypeString, Column: oauthauthorizationcode.FieldCodeChallenge},
+			oauthauthorizationcode.FieldCodeChallengeMethod: {Type: field.TypeString, Column: oauthauthorizationcode.FieldCodeChallengeMethod},
+			oauthauthorizationcode.FieldExpiresAt:           {Type: field.TypeTime, Column: oauthauthorizationcode.FieldExpiresAt},
+			oauthauthorizationcode.FieldUsedAt:              {Type: field.TypeTime, Column: oauthauthorizationcode.FieldUsedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   oauthclient.Table,
+			Columns: oauthclient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: oauthclient.FieldID,
+			},
+		},
+		Type: "OauthClient",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			oauthclient.FieldCreatedAt:        {Type: field.TypeTime, Column: oauthclient.FieldCreatedAt},
+			oauthclient.FieldUpdatedAt:        {Type: field.TypeTime, Column: oauthclient.FieldUpdatedAt},
+			oauthclient.FieldCreatedBy:        {Type: field.TypeString, Column: oauthclient.FieldCreatedBy},
+			oauthclient.FieldUpdatedBy:        {Type: field.TypeString, Column: oauthclient.FieldUpdatedBy},
+			oauthclient.FieldDeletedAt:        {Type: field.TypeTime, Column: oauthclient.FieldDeletedAt},
+			oauthclient.FieldDeletedBy:        {Type: field.TypeString, Column: oauthclient.FieldDeletedBy},
+			oauthclient.FieldOwnerID:          {Type: field.TypeString, Column: oauthclient.FieldOwnerID},
+			oauthclient.FieldName:             {Type: field.TypeString, Column: oauthclient.FieldName},
+			oauthclient.FieldClientID:         {Type: field.TypeString, Column: oauthclient.FieldClientID},
+			oauthclient.FieldClientSecretHash: {Type: field.TypeString, Column: oauthclient.FieldClientSecretHash},
+			oauthclient.FieldRedirectUris:     {Type: field.TypeJSON, Column: oauthclient.FieldRedirectUris},
+			oauthclient.FieldScopes:           {Type: field.TypeJSON, Column: oauthclient.FieldScopes},
+			oauthclient.FieldPublic:           {Type: field.TypeBool, Column: oauthclient.FieldPublic},
+			oauthclient.FieldDescription:      {Type: field.TypeString, Column: oauthclient.FieldDescription},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   oauthprovider.Table,
 			Columns: oauthprovider.Columns,
@@ -176,7 +362,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			oauthprovider.FieldInfoURL:      {Type: field.TypeString, Column: oauthprovider.FieldInfoURL},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   ohauthtootoken.Table,
 			Columns: ohauthtootoken.Columns,
@@ -201,7 +387,27 @@ var schemaGraph = func() *sqlgraph.Schema {
 			ohauthtootoken.FieldLastUsed:                {Type: field.TypeTime, Column: ohauthtootoken.FieldLastUsed},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   orgmembership.Table,
+			Columns: orgmembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: orgmembership.FieldID,
+			},
+		},
+		Type: "OrgMembership",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			orgmembership.FieldCreatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldCreatedAt},
+			orgmembership.FieldUpdatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldUpdatedAt},
+			orgmembership.FieldCreatedBy:      {Type: field.TypeString, Column: orgmembership.FieldCreatedBy},
+			orgmembership.FieldUpdatedBy:      {Type: field.TypeString, Column: orgmembership.FieldUpdatedBy},
+			orgmembership.FieldRole:           {Type: field.TypeEnum, Column: orgmembership.FieldRole},
+			orgmembership.FieldOrganizationID: {Type: field.TypeString, Column: orgmembership.FieldOrganizationID},
+			orgmembership.FieldUserID:         {Type: field.TypeString, Column: orgmembership.FieldUserID},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   organization.Table,
 			Columns: organization.Columns,
@@ -225,7 +431,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			organization.FieldPersonalOrg:          {Type: field.TypeBool, Column: organization.FieldPersonalOrg},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   organizationsetting.Table,
 			Columns: organizationsetting.Columns,
@@ -254,7 +460,32 @@ var schemaGraph = func() *sqlgraph.Schema {
 			organizationsetting.FieldTags:           {Type: field.TypeJSON, Column: organizationsetting.FieldTags},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   ownershiptransfer.Table,
+			Columns: ownershiptransfer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: ownershiptransfer.FieldID,
+			},
+		},
+		Type: "OwnershipTransfer",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			ownershiptransfer.FieldCreatedAt:   {Type: field.TypeTime, Column: ownershiptransfer.FieldCreatedAt},
+			ownershiptransfer.FieldUpdatedAt:   {Type: field.TypeTime, Column: ownershiptransfer.FieldUpdatedAt},
+			ownershiptransfer.FieldCreatedBy:   {Type: field.TypeString, Column: ownershiptransfer.FieldCreatedBy},
+			ownershiptransfer.FieldUpdatedBy:   {Type: field.TypeString, Column: ownershiptransfer.FieldUpdatedBy},
+			ownershiptransfer.FieldOwnerID:     {Type: field.TypeString, Column: ownershiptransfer.FieldOwnerID},
+			ownershiptransfer.FieldGroupID:     {Type: field.TypeString, Column: ownershiptransfer.FieldGroupID},
+			ownershiptransfer.FieldRequestorID: {Type: field.TypeString, Column: ownershiptransfer.FieldRequestorID},
+			ownershiptransfer.FieldRecipientID: {Type: field.TypeString, Column: ownershiptransfer.FieldRecipientID},
+			ownershiptransfer.FieldStatus:      {Type: field.TypeEnum, Column: ownershiptransfer.FieldStatus},
+			ownershiptransfer.FieldToken:       {Type: field.TypeString, Column: ownershiptransfer.FieldToken},
+			ownershiptransfer.FieldExpires:     {Type: field.TypeTime, Column: ownershiptransfer.FieldExpires},
+			ownershiptransfer.FieldSecret:      {Type: field.TypeBytes, Column: ownershiptransfer.FieldSecret},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   passwordresettoken.Table,
 			Columns: passwordresettoken.Columns,
@@ -277,7 +508,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			passwordresettoken.FieldSecret:    {Type: field.TypeBytes, Column: passwordresettoken.FieldSecret},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   personalaccesstoken.Table,
 			Columns: personalaccesstoken.Columns,
@@ -295,6 +526,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 			personalaccesstoken.FieldDeletedAt:   {Type: field.TypeTime, Column: personalaccesstoken.FieldDeletedAt},
 			personalaccesstoken.FieldDeletedBy:   {Type: field.TypeString, Column: personalaccesstoken.FieldDeletedBy},
 			personalaccesstoken.FieldName:        {Type: field.TypeString, Column: personalaccesstoken.FieldName},
+			personalaccesstoken.FieldTokenPrefix: {Type: field.TypeString, Column: personalaccesstoken.FieldTokenPrefix},
+			personalaccesstoken.FieldTokenHash:   {Type: field.TypeString, Column: personalaccesstoken.FieldTokenHash},
 			personalaccesstoken.FieldToken:       {Type: field.TypeString, Column: personalaccesstoken.FieldToken},
 			personalaccesstoken.FieldAbilities:   {Type: field.TypeJSON, Column: personalaccesstoken.FieldAbilities},
 			personalaccesstoken.FieldExpiresAt:   {Type: field.TypeTime, Column: personalaccesstoken.FieldExpiresAt},
@@ -302,7 +535,51 @@ var schemaGraph = func() *sqlgraph.Schema {
 			personalaccesstoken.FieldLastUsedAt:  {Type: field.TypeTime, Column: personalaccesstoken.FieldLastUsedAt},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: refreshtoken.FieldID,
+			},
+		},
+		Type: "RefreshToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			refreshtoken.FieldCreatedAt: {Type: field.TypeTime, Column: refreshtoken.FieldCreatedAt},
+			refreshtoken.FieldUpdatedAt: {Type: field.TypeTime, Column: refreshtoken.FieldUpdatedAt},
+			refreshtoken.FieldCreatedBy: {Type: field.TypeString, Column: refreshtoken.FieldCreatedBy},
+			refreshtoken.FieldUpdatedBy: {Type: field.TypeString, Column: refreshtoken.FieldUpdatedBy},
+			refreshtoken.FieldJti:       {Type: field.TypeString, Column: refreshtoken.FieldJti},
+			refreshtoken.FieldFamilyID:  {Type: field.TypeString, Column: refreshtoken.FieldFamilyID},
+			refreshtoken.FieldParentJti: {Type: field.TypeString, Column: refreshtoken.FieldParentJti},
+			refreshtoken.FieldUserID:    {Type: field.TypeString, Column: refreshtoken.FieldUserID},
+			refreshtoken.FieldUsedAt:    {Type: field.TypeTime, Column: refreshtoken.FieldUsedAt},
+			refreshtoken.FieldExpiresAt: {Type: field.TypeTime, Column: refreshtoken.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   revokedtoken.Table,
+			Columns: revokedtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: revokedtoken.FieldID,
+			},
+		},
+		Type: "RevokedToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			revokedtoken.FieldCreatedAt: {Type: field.TypeTime, Column: revokedtoken.FieldCreatedAt},
+			revokedtoken.FieldUpdatedAt: {Type: field.TypeTime, Column: revokedtoken.FieldUpdatedAt},
+			revokedtoken.FieldCreatedBy: {Type: field.TypeString, Column: revokedtoken.FieldCreatedBy},
+			revokedtoken.FieldUpdatedBy: {Type: field.TypeString, Column: revokedtoken.FieldUpdatedBy},
+			revokedtoken.FieldJti:       {Type: field.TypeString, Column: revokedtoken.FieldJti},
+			revokedtoken.FieldUserID:    {Type: field.TypeString, Column: revokedtoken.FieldUserID},
+			revokedtoken.FieldReason:    {Type: field.TypeString, Column: revokedtoken.FieldReason},
+			revokedtoken.FieldExpiresAt: {Type: field.TypeTime, Column: revokedtoken.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[22] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   session.Table,
 			Columns: session.Columns,
@@ -322,9 +599,33 @@ var schemaGraph = func() *sqlgraph.Schema {
 			session.FieldExpiresAt:      {Type: field.TypeTime, Column: session.FieldExpiresAt},
 			session.FieldOrganizationID: {Type: field.TypeString, Column: session.FieldOrganizationID},
 			session.FieldUserID:         {Type: field.TypeString, Column: session.FieldUserID},
+			session.FieldUserAgent:      {Type: field.TypeString, Column: session.FieldUserAgent},
+			session.FieldIPAddress:      {Type: field.TypeString, Column: session.FieldIPAddress},
+			session.FieldLastSeenAt:     {Type: field.TypeTime, Column: session.FieldLastSeenAt},
+			session.FieldRevokedAt:      {Type: field.TypeTime, Column: session.FieldRevokedAt},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[23] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   sessiondata.Table,
+			Columns: sessiondata.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: sessiondata.FieldID,
+			},
+		},
+		Type: "SessionData",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			sessiondata.FieldCreatedAt: {Type: field.TypeTime, Column: sessiondata.FieldCreatedAt},
+			sessiondata.FieldUpdatedAt: {Type: field.TypeTime, Column: sessiondata.FieldUpdatedAt},
+			sessiondata.FieldCreatedBy: {Type: field.TypeString, Column: sessiondata.FieldCreatedBy},
+			sessiondata.FieldUpdatedBy: {Type: field.TypeString, Column: sessiondata.FieldUpdatedBy},
+			sessiondata.FieldToken:     {Type: field.TypeString, Column: sessiondata.FieldToken},
+			sessiondata.FieldData:      {Type: field.TypeBytes, Column: sessiondata.FieldData},
+			sessiondata.FieldExpiry:    {Type: field.TypeTime, Column: sessiondata.FieldExpiry},
+		},
+	}
+	graph.Nodes[24] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -335,26 +636,28 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "User",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			user.FieldCreatedAt:       {Type: field.TypeTime, Column: user.FieldCreatedAt},
-			user.FieldUpdatedAt:       {Type: field.TypeTime, Column: user.FieldUpdatedAt},
-			user.FieldCreatedBy:       {Type: field.TypeString, Column: user.FieldCreatedBy},
-			user.FieldUpdatedBy:       {Type: field.TypeString, Column: user.FieldUpdatedBy},
-			user.FieldDeletedAt:       {Type: field.TypeTime, Column: user.FieldDeletedAt},
-			user.FieldDeletedBy:       {Type: field.TypeString, Column: user.FieldDeletedBy},
-			user.FieldEmail:           {Type: field.TypeString, Column: user.FieldEmail},
-			user.FieldFirstName:       {Type: field.TypeString, Column: user.FieldFirstName},
-			user.FieldLastName:        {Type: field.TypeString, Column: user.FieldLastName},
-			user.FieldDisplayName:     {Type: field.TypeString, Column: user.FieldDisplayName},
-			user.FieldAvatarRemoteURL: {Type: field.TypeString, Column: user.FieldAvatarRemoteURL},
-			user.FieldAvatarLocalFile: {Type: field.TypeString, Column: user.FieldAvatarLocalFile},
-			user.FieldAvatarUpdatedAt: {Type: field.TypeTime, Column: user.FieldAvatarUpdatedAt},
-			user.FieldLastSeen:        {Type: field.TypeTime, Column: user.FieldLastSeen},
-			user.FieldPassword:        {Type: field.TypeString, Column: user.FieldPassword},
-			user.FieldSub:             {Type: field.TypeString, Column: user.FieldSub},
-			user.FieldOauth:           {Type: field.TypeBool, Column: user.FieldOauth},
+			user.FieldCreatedAt:         {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:         {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+			user.FieldCreatedBy:         {Type: field.TypeString, Column: user.FieldCreatedBy},
+			user.FieldUpdatedBy:         {Type: field.TypeString, Column: user.FieldUpdatedBy},
+			user.FieldDeletedAt:         {Type: field.TypeTime, Column: user.FieldDeletedAt},
+			user.FieldDeletedBy:         {Type: field.TypeString, Column: user.FieldDeletedBy},
+			user.FieldEmail:             {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldFirstName:         {Type: field.TypeString, Column: user.FieldFirstName},
+			user.FieldLastName:          {Type: field.TypeString, Column: user.FieldLastName},
+			user.FieldDisplayName:       {Type: field.TypeString, Column: user.FieldDisplayName},
+			user.FieldAvatarRemoteURL:   {Type: field.TypeString, Column: user.FieldAvatarRemoteURL},
+			user.FieldAvatarLocalFile:   {Type: field.TypeString, Column: user.FieldAvatarLocalFile},
+			user.FieldAvatarUpdatedAt:   {Type: field.TypeTime, Column: user.FieldAvatarUpdatedAt},
+			user.FieldLastSeen:          {Type: field.TypeTime, Column: user.FieldLastSeen},
+			user.FieldPassword:          {Type: field.TypeString, Column: user.FieldPassword},
+			user.FieldPasswordHistory:   {Type: field.TypeJSON, Column: user.FieldPasswordHistory},
+			user.FieldPasswordChangedAt: {Type: field.TypeTime, Column: user.FieldPasswordChangedAt},
+			user.FieldSub:               {Type: field.TypeString, Column: user.FieldSub},
+			user.FieldOauth:             {Type: field.TypeBool, Column: user.FieldOauth},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[25] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usersetting.Table,
 			Columns: usersetting.Columns,
@@ -365,23 +668,74 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "UserSetting",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			usersetting.FieldCreatedAt:      {Type: field.TypeTime, Column: usersetting.FieldCreatedAt},
-			usersetting.FieldUpdatedAt:      {Type: field.TypeTime, Column: usersetting.FieldUpdatedAt},
-			usersetting.FieldCreatedBy:      {Type: field.TypeString, Column: usersetting.FieldCreatedBy},
-			usersetting.FieldUpdatedBy:      {Type: field.TypeString, Column: usersetting.FieldUpdatedBy},
-			usersetting.FieldDeletedAt:      {Type: field.TypeTime, Column: usersetting.FieldDeletedAt},
-			usersetting.FieldDeletedBy:      {Type: field.TypeString, Column: usersetting.FieldDeletedBy},
-			usersetting.FieldLocked:         {Type: field.TypeBool, Column: usersetting.FieldLocked},
-			usersetting.FieldSilencedAt:     {Type: field.TypeTime, Column: usersetting.FieldSilencedAt},
-			usersetting.FieldSuspendedAt:    {Type: field.TypeTime, Column: usersetting.FieldSuspendedAt},
-			usersetting.FieldRecoveryCode:   {Type: field.TypeString, Column: usersetting.FieldRecoveryCode},
-			usersetting.FieldStatus:         {Type: field.TypeEnum, Column: usersetting.FieldStatus},
-			usersetting.FieldRole:           {Type: field.TypeEnum, Column: usersetting.FieldRole},
-			usersetting.FieldPermissions:    {Type: field.TypeJSON, Column: usersetting.FieldPermissions},
-			usersetting.FieldEmailConfirmed: {Type: field.TypeBool, Column: usersetting.FieldEmailConfirmed},
-			usersetting.FieldTags:           {Type: field.TypeJSON, Column: usersetting.FieldTags},
+			usersetting.FieldCreatedAt:            {Type: field.TypeTime, Column: usersetting.FieldCreatedAt},
+			usersetting.FieldUpdatedAt:            {Type: field.TypeTime, Column: usersetting.FieldUpdatedAt},
+			usersetting.FieldCreatedBy:            {Type: field.TypeString, Column: usersetting.FieldCreatedBy},
+			usersetting.FieldUpdatedBy:            {Type: field.TypeString, Column: usersetting.FieldUpdatedBy},
+			usersetting.FieldDeletedAt:            {Type: field.TypeTime, Column: usersetting.FieldDeletedAt},
+			usersetting.FieldDeletedBy:            {Type: field.TypeString, Column: usersetting.FieldDeletedBy},
+			usersetting.FieldLocked:               {Type: field.TypeBool, Column: usersetting.FieldLocked},
+			usersetting.FieldSilencedAt:           {Type: field.TypeTime, Column: usersetting.FieldSilencedAt},
+			usersetting.FieldSuspendedAt:          {Type: field.TypeTime, Column: usersetting.FieldSuspendedAt},
+			usersetting.FieldRecoveryCode:         {Type: field.TypeString, Column: usersetting.FieldRecoveryCode},
+			usersetting.FieldStatus:               {Type: field.TypeEnum, Column: usersetting.FieldStatus},
+			usersetting.FieldRole:                 {Type: field.TypeEnum, Column: usersetting.FieldRole},
+			usersetting.FieldPermissions:          {Type: field.TypeJSON, Column: usersetting.FieldPermissions},
+			usersetting.FieldEmailConfirmed:       {Type: field.TypeBool, Column: usersetting.FieldEmailConfirmed},
+			usersetting.FieldTags:                 {Type: field.TypeJSON, Column: usersetting.FieldTags},
+			usersetting.FieldIsTfaEnabled:         {Type: field.TypeBool, Column: usersetting.FieldIsTfaEnabled},
+			usersetting.FieldTfaSecret:            {Type: field.TypeString, Column: usersetting.FieldTfaSecret},
+			usersetting.FieldRecoveryCodes:        {Type: field.TypeJSON, Column: usersetting.FieldRecoveryCodes},
+			usersetting.FieldTfaLastStep:          {Type: field.TypeInt64, Column: usersetting.FieldTfaLastStep},
+			usersetting.FieldFailedLoginAttempts:  {Type: field.TypeInt, Column: usersetting.FieldFailedLoginAttempts},
+			usersetting.FieldLockedUntil:          {Type: field.TypeTime, Column: usersetting.FieldLockedUntil},
+			usersetting.FieldUnlockToken:          {Type: field.TypeString, Column: usersetting.FieldUnlockToken},
+			usersetting.FieldUnlockTokenSecret:    {Type: field.TypeBytes, Column: usersetting.FieldUnlockTokenSecret},
+			usersetting.FieldUnlockTokenExpiresAt: {Type: field.TypeTime, Column: usersetting.FieldUnlockTokenExpiresAt},
+		},
+	}
+	graph.Nodes[26] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		},
+		Type: "WebauthnCredential",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webauthncredential.FieldCreatedAt:       {Type: field.TypeTime, Column: webauthncredential.FieldCreatedAt},
+			webauthncredential.FieldUpdatedAt:       {Type: field.TypeTime, Column: webauthncredential.FieldUpdatedAt},
+			webauthncredential.FieldCreatedBy:       {Type: field.TypeString, Column: webauthncredential.FieldCreatedBy},
+			webauthncredential.FieldUpdatedBy:       {Type: field.TypeString, Column: webauthncredential.FieldUpdatedBy},
+			webauthncredential.FieldDeletedAt:       {Type: field.TypeTime, Column: webauthncredential.FieldDeletedAt},
+			webauthncredential.FieldDeletedBy:       {Type: field.TypeString, Column: webauthncredential.FieldDeletedBy},
+			webauthncredential.FieldOwnerID:         {Type: field.TypeString, Column: webauthncredential.FieldOwnerID},
+			webauthncredential.FieldName:            {Type: field.TypeString, Column: webauthncredential.FieldName},
+			webauthncredential.FieldCredentialID:    {Type: field.TypeBytes, Column: webauthncredential.FieldCredentialID},
+			webauthncredential.FieldPublicKey:       {Type: field.TypeBytes, Column: webauthncredential.FieldPublicKey},
+			webauthncredential.FieldAttestationType: {Type: field.TypeString, Column: webauthncredential.FieldAttestationType},
+			webauthncredential.FieldAaguid:          {Type: field.TypeString, Column: webauthncredential.FieldAaguid},
+			webauthncredential.FieldSignCount:       {Type: field.TypeInt64, Column: webauthncredential.FieldSignCount},
+			webauthncredential.FieldTransports:      {Type: field.TypeJSON, Column: webauthncredential.FieldTransports},
+			webauthncredential.FieldBackupEligible:  {Type: field.TypeBool, Column: webauthncredential.FieldBackupEligible},
+			webauthncredential.FieldBackupState:     {Type: field.TypeBool, Column: webauthncredential.FieldBackupState},
+			webauthncredential.FieldLastUsedAt:      {Type: field.TypeTime, Column: webauthncredential.FieldLastUsedAt},
 		},
 	}
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apikey.OwnerTable,
+			Columns: []string{apikey.OwnerColumn},
+			Bidi:    false,
+		},
+		"APIKey",
+		"Organization",
+	)
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -442,6 +796,66 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Group",
 		"Organization",
 	)
+	graph.MustAddE(
+		"members",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: []string{group.MembersColumn},
+			Bidi:    false,
+		},
+		"Group",
+		"GroupMembership",
+	)
+	graph.MustAddE(
+		"group",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+		},
+		"GroupJoinRequest",
+		"Group",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+		},
+		"GroupJoinRequest",
+		"User",
+	)
+	graph.MustAddE(
+		"group",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+		},
+		"GroupMembership",
+		"Group",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+		},
+		"GroupMembership",
+		"User",
+	)
 	graph.MustAddE(
 		"group",
 		&sqlgraph.EdgeSpec{
@@ -466,6 +880,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Integration",
 		"Organization",
 	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.OwnerTable,
+			Columns: []string{invite.OwnerColumn},
+			Bidi:    false,
+		},
+		"Invite",
+		"Organization",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+		},
+		"MagicLinkToken",
+		"User",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.OwnerTable,
+			Columns: []string{oauthclient.OwnerColumn},
+			Bidi:    false,
+		},
+		"OauthClient",
+		"Organization",
+	)
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -478,6 +928,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"OauthProvider",
 		"Organization",
 	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembership.OrganizationTable,
+			Columns: []string{orgmembership.OrganizationColumn},
+			Bidi:    false,
+		},
+		"OrgMembership",
+		"Organization",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembership.UserTable,
+			Columns: []string{orgmembership.UserColumn},
+			Bidi:    false,
+		},
+		"OrgMembership",
+		"User",
+	)
 	graph.MustAddE(
 		"parent",
 		&sqlgraph.EdgeSpec{
@@ -575,40 +1049,136 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"OauthProvider",
 	)
 	graph.MustAddE(
-		"organization",
+		"api_keys",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
-			Inverse: true,
-			Table:   organizationsetting.OrganizationTable,
-			Columns: []string{organizationsetting.OrganizationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
 			Bidi:    false,
 		},
-		"OrganizationSetting",
 		"Organization",
+		"APIKey",
 	)
 	graph.MustAddE(
-		"owner",
+		"oauth_clients",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   passwordresettoken.OwnerTable,
-			Columns: []string{passwordresettoken.OwnerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
 			Bidi:    false,
 		},
-		"PasswordResetToken",
-		"User",
+		"Organization",
+		"OauthClient",
 	)
 	graph.MustAddE(
-		"owner",
+		"invites",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   personalaccesstoken.OwnerTable,
-			Columns: []string{personalaccesstoken.OwnerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
 			Bidi:    false,
 		},
-		"PersonalAccessToken",
-		"User",
+		"Organization",
+		"Invite",
+	)
+	graph.MustAddE(
+		"members",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"OrgMembership",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   organizationsetting.OrganizationTable,
+			Columns: []string{organizationsetting.OrganizationColumn},
+			Bidi:    false,
+		},
+		"OrganizationSetting",
+		"Organization",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.OwnerTable,
+			Columns: []string{ownershiptransfer.OwnerColumn},
+			Bidi:    false,
+		},
+		"OwnershipTransfer",
+		"Organization",
+	)
+	graph.MustAddE(
+		"group",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.GroupTable,
+			Columns: []string{ownershiptransfer.GroupColumn},
+			Bidi:    false,
+		},
+		"OwnershipTransfer",
+		"Group",
+	)
+	graph.MustAddE(
+		"requestor",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.RequestorTable,
+			Columns: []string{ownershiptransfer.RequestorColumn},
+			Bidi:    false,
+		},
+		"OwnershipTransfer",
+		"User",
+	)
+	graph.MustAddE(
+		"recipient",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.RecipientTable,
+			Columns: []string{ownershiptransfer.RecipientColumn},
+			Bidi:    false,
+		},
+		"OwnershipTransfer",
+		"User",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+		},
+		"PasswordResetToken",
+		"User",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.OwnerTable,
+			Columns: []string{personalaccesstoken.OwnerColumn},
+			Bidi:    false,
+		},
+		"PersonalAccessToken",
+		"User",
 	)
 	graph.MustAddE(
 		"owner",
@@ -706,6 +1276,54 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"PasswordResetToken",
 	)
+	graph.MustAddE(
+		"magic_link_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MagicLinkTokensTable,
+			Columns: []string{user.MagicLinkTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"MagicLinkToken",
+	)
+	graph.MustAddE(
+		"webauthn_credentials",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"WebauthnCredential",
+	)
+	graph.MustAddE(
+		"org_memberships",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OrgMembershipsTable,
+			Columns: []string{user.OrgMembershipsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"OrgMembership",
+	)
+	graph.MustAddE(
+		"group_memberships",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"GroupMembership",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -718,6 +1336,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"UserSetting",
 		"User",
 	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+		},
+		"WebauthnCredential",
+		"User",
+	)
 	return graph
 }()
 
@@ -727,6 +1357,130 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (akq *APIKeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	akq.predicates = append(akq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the APIKeyQuery builder.
+func (akq *APIKeyQuery) Filter() *APIKeyFilter {
+	return &APIKeyFilter{config: akq.config, predicateAdder: akq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *APIKeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the APIKeyMutation builder.
+func (m *APIKeyMutation) Filter() *APIKeyFilter {
+	return &APIKeyFilter{config: m.config, predicateAdder: m}
+}
+
+// APIKeyFilter provides a generic filtering capability at runtime for APIKeyQuery.
+type APIKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *APIKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *APIKeyFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *APIKeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *APIKeyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *APIKeyFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *APIKeyFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *APIKeyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *APIKeyFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *APIKeyFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldOwnerID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *APIKeyFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldName))
+}
+
+// WhereKeyPrefix applies the entql string predicate on the key_prefix field.
+func (f *APIKeyFilter) WhereKeyPrefix(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldKeyPrefix))
+}
+
+// WhereKeyHash applies the entql string predicate on the key_hash field.
+func (f *APIKeyFilter) WhereKeyHash(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldKeyHash))
+}
+
+// WhereAbilities applies the entql json.RawMessage predicate on the abilities field.
+func (f *APIKeyFilter) WhereAbilities(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldAbilities))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *APIKeyFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldExpiresAt))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *APIKeyFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldDescription))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *APIKeyFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldLastUsedAt))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *APIKeyFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *APIKeyFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (evtq *EmailVerificationTokenQuery) addPredicate(pred func(s *sql.Selector)) {
 	evtq.predicates = append(evtq.predicates, pred)
@@ -756,7 +1510,7 @@ type EmailVerificationTokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *EmailVerificationTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -865,7 +1619,7 @@ type EntitlementFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *EntitlementFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -979,7 +1733,7 @@ type GroupFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *GroupFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1087,95 +1841,325 @@ func (f *GroupFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
 	})))
 }
 
+// WhereHasMembers applies a predicate to check if query has an edge members.
+func (f *GroupFilter) WhereHasMembers() {
+	f.Where(entql.HasEdge("members"))
+}
+
+// WhereHasMembersWith applies a predicate to check if query has an edge members with a given conditions (other predicates).
+func (f *GroupFilter) WhereHasMembersWith(preds ...predicate.GroupMembership) {
+	f.Where(entql.HasEdgeWith("members", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
-func (gsq *GroupSettingQuery) addPredicate(pred func(s *sql.Selector)) {
-	gsq.predicates = append(gsq.predicates, pred)
+func (gjrq *GroupJoinRequestQuery) addPredicate(pred func(s *sql.Selector)) {
+	gjrq.predicates = append(gjrq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the GroupSettingQuery builder.
-func (gsq *GroupSettingQuery) Filter() *GroupSettingFilter {
-	return &GroupSettingFilter{config: gsq.config, predicateAdder: gsq}
+// Filter returns a Filter implementation to apply filters on the GroupJoinRequestQuery builder.
+func (gjrq *GroupJoinRequestQuery) Filter() *GroupJoinRequestFilter {
+	return &GroupJoinRequestFilter{config: gjrq.config, predicateAdder: gjrq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *GroupSettingMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *GroupJoinRequestMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the GroupSettingMutation builder.
-func (m *GroupSettingMutation) Filter() *GroupSettingFilter {
-	return &GroupSettingFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the GroupJoinRequestMutation builder.
+func (m *GroupJoinRequestMutation) Filter() *GroupJoinRequestFilter {
+	return &GroupJoinRequestFilter{config: m.config, predicateAdder: m}
 }
 
-// GroupSettingFilter provides a generic filtering capability at runtime for GroupSettingQuery.
-type GroupSettingFilter struct {
+// GroupJoinRequestFilter provides a generic filtering capability at runtime for GroupJoinRequestQuery.
+type GroupJoinRequestFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *GroupSettingFilter) Where(p entql.P) {
+func (f *GroupJoinRequestFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql string predicate on the id field.
-func (f *GroupSettingFilter) WhereID(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldID))
+func (f *GroupJoinRequestFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *GroupSettingFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(groupsetting.FieldCreatedAt))
+func (f *GroupJoinRequestFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupjoinrequest.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *GroupSettingFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(groupsetting.FieldUpdatedAt))
+func (f *GroupJoinRequestFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupjoinrequest.FieldUpdatedAt))
 }
 
 // WhereCreatedBy applies the entql string predicate on the created_by field.
-func (f *GroupSettingFilter) WhereCreatedBy(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldCreatedBy))
+func (f *GroupJoinRequestFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql string predicate on the updated_by field.
-func (f *GroupSettingFilter) WhereUpdatedBy(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldUpdatedBy))
+func (f *GroupJoinRequestFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldUpdatedBy))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *GroupSettingFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(groupsetting.FieldDeletedAt))
+// WhereGroupID applies the entql string predicate on the group_id field.
+func (f *GroupJoinRequestFilter) WhereGroupID(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldGroupID))
 }
 
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *GroupSettingFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldDeletedBy))
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *GroupJoinRequestFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldUserID))
 }
 
-// WhereVisibility applies the entql string predicate on the visibility field.
-func (f *GroupSettingFilter) WhereVisibility(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldVisibility))
+// WhereMessage applies the entql string predicate on the message field.
+func (f *GroupJoinRequestFilter) WhereMessage(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldMessage))
 }
 
-// WhereJoinPolicy applies the entql string predicate on the join_policy field.
-func (f *GroupSettingFilter) WhereJoinPolicy(p entql.StringP) {
-	f.Where(p.Field(groupsetting.FieldJoinPolicy))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *GroupJoinRequestFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldStatus))
 }
 
-// WhereTags applies the entql json.RawMessage predicate on the tags field.
-func (f *GroupSettingFilter) WhereTags(p entql.BytesP) {
-	f.Where(p.Field(groupsetting.FieldTags))
+// WhereReviewerID applies the entql string predicate on the reviewer_id field.
+func (f *GroupJoinRequestFilter) WhereReviewerID(p entql.StringP) {
+	f.Where(p.Field(groupjoinrequest.FieldReviewerID))
 }
 
-// WhereSyncToSlack applies the entql bool predicate on the sync_to_slack field.
-func (f *GroupSettingFilter) WhereSyncToSlack(p entql.BoolP) {
-	f.Where(p.Field(groupsetting.FieldSyncToSlack))
-}
+// WhereHasGroup applies a predicate to check if query has an edge group.
+func (f *GroupJoinRequestFilter) WhereHasGroup() {
+	f.Where(entql.HasEdge("group"))
+}
+
+// WhereHasGroupWith applies a predicate to check if query has an edge group with a given conditions (other predicates).
+func (f *GroupJoinRequestFilter) WhereHasGroupWith(preds ...predicate.Group) {
+	f.Where(entql.HasEdgeWith("group", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *GroupJoinRequestFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *GroupJoinRequestFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (gmq *GroupMembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	gmq.predicates = append(gmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the GroupMembershipQuery builder.
+func (gmq *GroupMembershipQuery) Filter() *GroupMembershipFilter {
+	return &GroupMembershipFilter{config: gmq.config, predicateAdder: gmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *GroupMembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the GroupMembershipMutation builder.
+func (m *GroupMembershipMutation) Filter() *GroupMembershipFilter {
+	return &GroupMembershipFilter{config: m.config, predicateAdder: m}
+}
+
+// GroupMembershipFilter provides a generic filtering capability at runtime for GroupMembershipQuery.
+type GroupMembershipFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *GroupMembershipFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *GroupMembershipFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *GroupMembershipFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupmembership.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *GroupMembershipFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupmembership.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *GroupMembershipFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *GroupMembershipFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldUpdatedBy))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *GroupMembershipFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldRole))
+}
+
+// WhereGroupID applies the entql string predicate on the group_id field.
+func (f *GroupMembershipFilter) WhereGroupID(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldGroupID))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *GroupMembershipFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(groupmembership.FieldUserID))
+}
+
+// WhereHasGroup applies a predicate to check if query has an edge group.
+func (f *GroupMembershipFilter) WhereHasGroup() {
+	f.Where(entql.HasEdge("group"))
+}
+
+// WhereHasGroupWith applies a predicate to check if query has an edge group with a given conditions (other predicates).
+func (f *GroupMembershipFilter) WhereHasGroupWith(preds ...predicate.Group) {
+	f.Where(entql.HasEdgeWith("group", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *GroupMembershipFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *GroupMembershipFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (gsq *GroupSettingQuery) addPredicate(pred func(s *sql.Selector)) {
+	gsq.predicates = append(gsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the GroupSettingQuery builder.
+func (gsq *GroupSettingQuery) Filter() *GroupSettingFilter {
+	return &GroupSettingFilter{config: gsq.config, predicateAdder: gsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *GroupSettingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the GroupSettingMutation builder.
+func (m *GroupSettingMutation) Filter() *GroupSettingFilter {
+	return &GroupSettingFilter{config: m.config, predicateAdder: m}
+}
+
+// GroupSettingFilter provides a generic filtering capability at runtime for GroupSettingQuery.
+type GroupSettingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *GroupSettingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *GroupSettingFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *GroupSettingFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupsetting.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *GroupSettingFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(groupsetting.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *GroupSettingFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *GroupSettingFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *GroupSettingFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(groupsetting.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *GroupSettingFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldDeletedBy))
+}
+
+// WhereVisibility applies the entql string predicate on the visibility field.
+func (f *GroupSettingFilter) WhereVisibility(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldVisibility))
+}
+
+// WhereJoinPolicy applies the entql string predicate on the join_policy field.
+func (f *GroupSettingFilter) WhereJoinPolicy(p entql.StringP) {
+	f.Where(p.Field(groupsetting.FieldJoinPolicy))
+}
+
+// WhereTags applies the entql json.RawMessage predicate on the tags field.
+func (f *GroupSettingFilter) WhereTags(p entql.BytesP) {
+	f.Where(p.Field(groupsetting.FieldTags))
+}
+
+// WhereSyncToSlack applies the entql bool predicate on the sync_to_slack field.
+func (f *GroupSettingFilter) WhereSyncToSlack(p entql.BoolP) {
+	f.Where(p.Field(groupsetting.FieldSyncToSlack))
+}
 
 // WhereSyncToGithub applies the entql bool predicate on the sync_to_github field.
 func (f *GroupSettingFilter) WhereSyncToGithub(p entql.BoolP) {
@@ -1225,7 +2209,7 @@ type IntegrationFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *IntegrationFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1301,127 +2285,122 @@ func (f *IntegrationFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (opq *OauthProviderQuery) addPredicate(pred func(s *sql.Selector)) {
-	opq.predicates = append(opq.predicates, pred)
+func (iq *InviteQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OauthProviderQuery builder.
-func (opq *OauthProviderQuery) Filter() *OauthProviderFilter {
-	return &OauthProviderFilter{config: opq.config, predicateAdder: opq}
+// Filter returns a Filter implementation to apply filters on the InviteQuery builder.
+func (iq *InviteQuery) Filter() *InviteFilter {
+	return &InviteFilter{config: iq.config, predicateAdder: iq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OauthProviderMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *InviteMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OauthProviderMutation builder.
-func (m *OauthProviderMutation) Filter() *OauthProviderFilter {
-	return &OauthProviderFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the InviteMutation builder.
+func (m *InviteMutation) Filter() *InviteFilter {
+	return &InviteFilter{config: m.config, predicateAdder: m}
 }
 
-// OauthProviderFilter provides a generic filtering capability at runtime for OauthProviderQuery.
-type OauthProviderFilter struct {
+// InviteFilter provides a generic filtering capability at runtime for InviteQuery.
+type InviteFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OauthProviderFilter) Where(p entql.P) {
+func (f *InviteFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql string predicate on the id field.
-func (f *OauthProviderFilter) WhereID(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldID))
+func (f *InviteFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(invite.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *OauthProviderFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(oauthprovider.FieldCreatedAt))
+func (f *InviteFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *OauthProviderFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(oauthprovider.FieldUpdatedAt))
+func (f *InviteFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldUpdatedAt))
 }
 
 // WhereCreatedBy applies the entql string predicate on the created_by field.
-func (f *OauthProviderFilter) WhereCreatedBy(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldCreatedBy))
+func (f *InviteFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(invite.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql string predicate on the updated_by field.
-func (f *OauthProviderFilter) WhereUpdatedBy(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldUpdatedBy))
+func (f *InviteFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(invite.FieldUpdatedBy))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *OauthProviderFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(oauthprovider.FieldDeletedAt))
+func (f *InviteFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldDeletedAt))
 }
 
 // WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *OauthProviderFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldDeletedBy))
-}
-
-// WhereName applies the entql string predicate on the name field.
-func (f *OauthProviderFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldName))
+func (f *InviteFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(invite.FieldDeletedBy))
 }
 
-// WhereClientID applies the entql string predicate on the client_id field.
-func (f *OauthProviderFilter) WhereClientID(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldClientID))
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *InviteFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(invite.FieldOwnerID))
 }
 
-// WhereClientSecret applies the entql string predicate on the client_secret field.
-func (f *OauthProviderFilter) WhereClientSecret(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldClientSecret))
+// WhereToken applies the entql string predicate on the token field.
+func (f *InviteFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(invite.FieldToken))
 }
 
-// WhereRedirectURL applies the entql string predicate on the redirect_url field.
-func (f *OauthProviderFilter) WhereRedirectURL(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldRedirectURL))
+// WhereExpires applies the entql time.Time predicate on the expires field.
+func (f *InviteFilter) WhereExpires(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldExpires))
 }
 
-// WhereScopes applies the entql string predicate on the scopes field.
-func (f *OauthProviderFilter) WhereScopes(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldScopes))
+// WhereRecipient applies the entql string predicate on the recipient field.
+func (f *InviteFilter) WhereRecipient(p entql.StringP) {
+	f.Where(p.Field(invite.FieldRecipient))
 }
 
-// WhereAuthURL applies the entql string predicate on the auth_url field.
-func (f *OauthProviderFilter) WhereAuthURL(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldAuthURL))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *InviteFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(invite.FieldStatus))
 }
 
-// WhereTokenURL applies the entql string predicate on the token_url field.
-func (f *OauthProviderFilter) WhereTokenURL(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldTokenURL))
+// WhereRole applies the entql string predicate on the role field.
+func (f *InviteFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(invite.FieldRole))
 }
 
-// WhereAuthStyle applies the entql uint8 predicate on the auth_style field.
-func (f *OauthProviderFilter) WhereAuthStyle(p entql.Uint8P) {
-	f.Where(p.Field(oauthprovider.FieldAuthStyle))
+// WhereRequestorID applies the entql string predicate on the requestor_id field.
+func (f *InviteFilter) WhereRequestorID(p entql.StringP) {
+	f.Where(p.Field(invite.FieldRequestorID))
 }
 
-// WhereInfoURL applies the entql string predicate on the info_url field.
-func (f *OauthProviderFilter) WhereInfoURL(p entql.StringP) {
-	f.Where(p.Field(oauthprovider.FieldInfoURL))
+// WhereSecret applies the entql []byte predicate on the secret field.
+func (f *InviteFilter) WhereSecret(p entql.BytesP) {
+	f.Where(p.Field(invite.FieldSecret))
 }
 
 // WhereHasOwner applies a predicate to check if query has an edge owner.
-func (f *OauthProviderFilter) WhereHasOwner() {
+func (f *InviteFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
 }
 
 // WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
-func (f *OauthProviderFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+func (f *InviteFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
 	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
@@ -1430,17 +2409,484 @@ func (f *OauthProviderFilter) WhereHasOwnerWith(preds ...predicate.Organization)
 }
 
 // addPredicate implements the predicateAdder interface.
-func (oattq *OhAuthTooTokenQuery) addPredicate(pred func(s *sql.Selector)) {
-	oattq.predicates = append(oattq.predicates, pred)
+func (mltq *MagicLinkTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	mltq.predicates = append(mltq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OhAuthTooTokenQuery builder.
-func (oattq *OhAuthTooTokenQuery) Filter() *OhAuthTooTokenFilter {
-	return &OhAuthTooTokenFilter{config: oattq.config, predicateAdder: oattq}
+// Filter returns a Filter implementation to apply filters on the MagicLinkTokenQuery builder.
+func (mltq *MagicLinkTokenQuery) Filter() *MagicLinkTokenFilter {
+	return &MagicLinkTokenFilter{config: mltq.config, predicateAdder: mltq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OhAuthTooTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *MagicLinkTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MagicLinkTokenMutation builder.
+func (m *MagicLinkTokenMutation) Filter() *MagicLinkTokenFilter {
+	return &MagicLinkTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// MagicLinkTokenFilter provides a generic filtering capability at runtime for MagicLinkTokenQuery.
+type MagicLinkTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MagicLinkTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *MagicLinkTokenFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MagicLinkTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MagicLinkTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *MagicLinkTokenFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *MagicLinkTokenFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MagicLinkTokenFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *MagicLinkTokenFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldDeletedBy))
+}
+
+// WhereToken applies the entql string predicate on the token field.
+func (f *MagicLinkTokenFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldToken))
+}
+
+// WhereTTL applies the entql time.Time predicate on the ttl field.
+func (f *MagicLinkTokenFilter) WhereTTL(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldTTL))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *MagicLinkTokenFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldEmail))
+}
+
+// WhereSecret applies the entql []byte predicate on the secret field.
+func (f *MagicLinkTokenFilter) WhereSecret(p entql.BytesP) {
+	f.Where(p.Field(magiclinktoken.FieldSecret))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *MagicLinkTokenFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *MagicLinkTokenFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (oacq *OauthAuthorizationCodeQuery) addPredicate(pred func(s *sql.Selector)) {
+	oacq.predicates = append(oacq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OauthAuthorizationCodeQuery builder.
+func (oacq *OauthAuthorizationCodeQuery) Filter() *OauthAuthorizationCodeFilter {
+	return &OauthAuthorizationCodeFilter{config: oacq.config, predicateAdder: oacq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OauthAuthorizationCodeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OauthAuthorizationCodeMutation builder.
+func (m *OauthAuthorizationCodeMutation) Filter() *OauthAuthorizationCodeFilter {
+	return &OauthAuthorizationCodeFilter{config: m.config, predicateAdder: m}
+}
+
+// OauthAuthorizationCodeFilter provides a generic filtering capability at runtime for OauthAuthorizationCodeQuery.
+type OauthAuthorizationCodeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OauthAuthorizationCodeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OauthAuthorizationCodeFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OauthAuthorizationCodeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OauthAuthorizationCodeFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OauthAuthorizationCodeFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OauthAuthorizationCodeFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldUpdatedBy))
+}
+
+// WhereCodeHash applies the entql string predicate on the code_hash field.
+func (f *OauthAuthorizationCodeFilter) WhereCodeHash(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldCodeHash))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *OauthAuthorizationCodeFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldClientID))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *OauthAuthorizationCodeFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldUserID))
+}
+
+// WhereRedirectURI applies the entql string predicate on the redirect_uri field.
+func (f *OauthAuthorizationCodeFilter) WhereRedirectURI(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldRedirectURI))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *OauthAuthorizationCodeFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldScopes))
+}
+
+// WhereNonce applies the entql string predicate on the nonce field.
+func (f *OauthAuthorizationCodeFilter) WhereNonce(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldNonce))
+}
+
+// WhereCodeChallenge applies the entql string predicate on the code_challenge field.
+func (f *OauthAuthorizationCodeFilter) WhereCodeChallenge(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldCodeChallenge))
+}
+
+// WhereCodeChallengeMethod applies the entql string predicate on the code_challenge_method field.
+func (f *OauthAuthorizationCodeFilter) WhereCodeChallengeMethod(p entql.StringP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldCodeChallengeMethod))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *OauthAuthorizationCodeFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *OauthAuthorizationCodeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthauthorizationcode.FieldUsedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ocq *OauthClientQuery) addPredicate(pred func(s *sql.Selector)) {
+	ocq.predicates = append(ocq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OauthClientQuery builder.
+func (ocq *OauthClientQuery) Filter() *OauthClientFilter {
+	return &OauthClientFilter{config: ocq.config, predicateAdder: ocq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OauthClientMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OauthClientMutation builder.
+func (m *OauthClientMutation) Filter() *OauthClientFilter {
+	return &OauthClientFilter{config: m.config, predicateAdder: m}
+}
+
+// OauthClientFilter provides a generic filtering capability at runtime for OauthClientQuery.
+type OauthClientFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OauthClientFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OauthClientFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OauthClientFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OauthClientFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OauthClientFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OauthClientFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OauthClientFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *OauthClientFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *OauthClientFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldOwnerID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OauthClientFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldName))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *OauthClientFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldClientID))
+}
+
+// WhereClientSecretHash applies the entql string predicate on the client_secret_hash field.
+func (f *OauthClientFilter) WhereClientSecretHash(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldClientSecretHash))
+}
+
+// WhereRedirectUris applies the entql json.RawMessage predicate on the redirect_uris field.
+func (f *OauthClientFilter) WhereRedirectUris(p entql.BytesP) {
+	f.Where(p.Field(oauthclient.FieldRedirectUris))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *OauthClientFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(oauthclient.FieldScopes))
+}
+
+// WherePublic applies the entql bool predicate on the public field.
+func (f *OauthClientFilter) WherePublic(p entql.BoolP) {
+	f.Where(p.Field(oauthclient.FieldPublic))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *OauthClientFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldDescription))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *OauthClientFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *OauthClientFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (opq *OauthProviderQuery) addPredicate(pred func(s *sql.Selector)) {
+	opq.predicates = append(opq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OauthProviderQuery builder.
+func (opq *OauthProviderQuery) Filter() *OauthProviderFilter {
+	return &OauthProviderFilter{config: opq.config, predicateAdder: opq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OauthProviderMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OauthProviderMutation builder.
+func (m *OauthProviderMutation) Filter() *OauthProviderFilter {
+	return &OauthProviderFilter{config: m.config, predicateAdder: m}
+}
+
+// OauthProviderFilter provides a generic filtering capability at runtime for OauthProviderQuery.
+type OauthProviderFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OauthProviderFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OauthProviderFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OauthProviderFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthprovider.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OauthProviderFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthprovider.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OauthProviderFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OauthProviderFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OauthProviderFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthprovider.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *OauthProviderFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldDeletedBy))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OauthProviderFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldName))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *OauthProviderFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldClientID))
+}
+
+// WhereClientSecret applies the entql string predicate on the client_secret field.
+func (f *OauthProviderFilter) WhereClientSecret(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldClientSecret))
+}
+
+// WhereRedirectURL applies the entql string predicate on the redirect_url field.
+func (f *OauthProviderFilter) WhereRedirectURL(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldRedirectURL))
+}
+
+// WhereScopes applies the entql string predicate on the scopes field.
+func (f *OauthProviderFilter) WhereScopes(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldScopes))
+}
+
+// WhereAuthURL applies the entql string predicate on the auth_url field.
+func (f *OauthProviderFilter) WhereAuthURL(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldAuthURL))
+}
+
+// WhereTokenURL applies the entql string predicate on the token_url field.
+func (f *OauthProviderFilter) WhereTokenURL(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldTokenURL))
+}
+
+// WhereAuthStyle applies the entql uint8 predicate on the auth_style field.
+func (f *OauthProviderFilter) WhereAuthStyle(p entql.Uint8P) {
+	f.Where(p.Field(oauthprovider.FieldAuthStyle))
+}
+
+// WhereInfoURL applies the entql string predicate on the info_url field.
+func (f *OauthProviderFilter) WhereInfoURL(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldInfoURL))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *OauthProviderFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *OauthProviderFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (oattq *OhAuthTooTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	oattq.predicates = append(oattq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OhAuthTooTokenQuery builder.
+func (oattq *OhAuthTooTokenQuery) Filter() *OhAuthTooTokenFilter {
+	return &OhAuthTooTokenFilter{config: oattq.config, predicateAdder: oattq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OhAuthTooTokenMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
@@ -1458,7 +2904,7 @@ type OhAuthTooTokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OhAuthTooTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1529,6 +2975,109 @@ func (f *OhAuthTooTokenFilter) WhereLastUsed(p entql.TimeP) {
 	f.Where(p.Field(ohauthtootoken.FieldLastUsed))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (omq *OrgMembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	omq.predicates = append(omq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OrgMembershipQuery builder.
+func (omq *OrgMembershipQuery) Filter() *OrgMembershipFilter {
+	return &OrgMembershipFilter{config: omq.config, predicateAdder: omq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OrgMembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrgMembershipMutation builder.
+func (m *OrgMembershipMutation) Filter() *OrgMembershipFilter {
+	return &OrgMembershipFilter{config: m.config, predicateAdder: m}
+}
+
+// OrgMembershipFilter provides a generic filtering capability at runtime for OrgMembershipQuery.
+type OrgMembershipFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrgMembershipFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OrgMembershipFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OrgMembershipFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgmembership.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OrgMembershipFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgmembership.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OrgMembershipFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OrgMembershipFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldUpdatedBy))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *OrgMembershipFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldRole))
+}
+
+// WhereOrganizationID applies the entql string predicate on the organization_id field.
+func (f *OrgMembershipFilter) WhereOrganizationID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldOrganizationID))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *OrgMembershipFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldUserID))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *OrgMembershipFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *OrgMembershipFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *OrgMembershipFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *OrgMembershipFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
 	oq.predicates = append(oq.predicates, pred)
@@ -1558,7 +3107,7 @@ type OrganizationFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OrganizationFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1736,6 +3285,62 @@ func (f *OrganizationFilter) WhereHasOauthproviderWith(preds ...predicate.OauthP
 	})))
 }
 
+// WhereHasAPIKeys applies a predicate to check if query has an edge api_keys.
+func (f *OrganizationFilter) WhereHasAPIKeys() {
+	f.Where(entql.HasEdge("api_keys"))
+}
+
+// WhereHasAPIKeysWith applies a predicate to check if query has an edge api_keys with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasAPIKeysWith(preds ...predicate.APIKey) {
+	f.Where(entql.HasEdgeWith("api_keys", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOauthClients applies a predicate to check if query has an edge oauth_clients.
+func (f *OrganizationFilter) WhereHasOauthClients() {
+	f.Where(entql.HasEdge("oauth_clients"))
+}
+
+// WhereHasOauthClientsWith applies a predicate to check if query has an edge oauth_clients with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasOauthClientsWith(preds ...predicate.OauthClient) {
+	f.Where(entql.HasEdgeWith("oauth_clients", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvites applies a predicate to check if query has an edge invites.
+func (f *OrganizationFilter) WhereHasInvites() {
+	f.Where(entql.HasEdge("invites"))
+}
+
+// WhereHasInvitesWith applies a predicate to check if query has an edge invites with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasInvitesWith(preds ...predicate.Invite) {
+	f.Where(entql.HasEdgeWith("invites", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMembers applies a predicate to check if query has an edge members.
+func (f *OrganizationFilter) WhereHasMembers() {
+	f.Where(entql.HasEdge("members"))
+}
+
+// WhereHasMembersWith applies a predicate to check if query has an edge members with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasMembersWith(preds ...predicate.OrgMembership) {
+	f.Where(entql.HasEdgeWith("members", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (osq *OrganizationSettingQuery) addPredicate(pred func(s *sql.Selector)) {
 	osq.predicates = append(osq.predicates, pred)
@@ -1747,123 +3352,279 @@ func (osq *OrganizationSettingQuery) Filter() *OrganizationSettingFilter {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OrganizationSettingMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *OrganizationSettingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrganizationSettingMutation builder.
+func (m *OrganizationSettingMutation) Filter() *OrganizationSettingFilter {
+	return &OrganizationSettingFilter{config: m.config, predicateAdder: m}
+}
+
+// OrganizationSettingFilter provides a generic filtering capability at runtime for OrganizationSettingQuery.
+type OrganizationSettingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrganizationSettingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OrganizationSettingFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OrganizationSettingFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(organizationsetting.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OrganizationSettingFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(organizationsetting.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OrganizationSettingFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OrganizationSettingFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OrganizationSettingFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(organizationsetting.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *OrganizationSettingFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldDeletedBy))
+}
+
+// WhereDomains applies the entql json.RawMessage predicate on the domains field.
+func (f *OrganizationSettingFilter) WhereDomains(p entql.BytesP) {
+	f.Where(p.Field(organizationsetting.FieldDomains))
+}
+
+// WhereSSOCert applies the entql string predicate on the sso_cert field.
+func (f *OrganizationSettingFilter) WhereSSOCert(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldSSOCert))
+}
+
+// WhereSSOEntrypoint applies the entql string predicate on the sso_entrypoint field.
+func (f *OrganizationSettingFilter) WhereSSOEntrypoint(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldSSOEntrypoint))
+}
+
+// WhereSSOIssuer applies the entql string predicate on the sso_issuer field.
+func (f *OrganizationSettingFilter) WhereSSOIssuer(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldSSOIssuer))
+}
+
+// WhereBillingContact applies the entql string predicate on the billing_contact field.
+func (f *OrganizationSettingFilter) WhereBillingContact(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldBillingContact))
+}
+
+// WhereBillingEmail applies the entql string predicate on the billing_email field.
+func (f *OrganizationSettingFilter) WhereBillingEmail(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldBillingEmail))
+}
+
+// WhereBillingPhone applies the entql string predicate on the billing_phone field.
+func (f *OrganizationSettingFilter) WhereBillingPhone(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldBillingPhone))
+}
+
+// WhereBillingAddress applies the entql string predicate on the billing_address field.
+func (f *OrganizationSettingFilter) WhereBillingAddress(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldBillingAddress))
+}
+
+// WhereTaxIdentifier applies the entql string predicate on the tax_identifier field.
+func (f *OrganizationSettingFilter) WhereTaxIdentifier(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldTaxIdentifier))
+}
+
+// WhereTags applies the entql json.RawMessage predicate on the tags field.
+func (f *OrganizationSettingFilter) WhereTags(p entql.BytesP) {
+	f.Where(p.Field(organizationsetting.FieldTags))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *OrganizationSettingFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *OrganizationSettingFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (otq *OwnershipTransferQuery) addPredicate(pred func(s *sql.Selector)) {
+	otq.predicates = append(otq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OwnershipTransferQuery builder.
+func (otq *OwnershipTransferQuery) Filter() *OwnershipTransferFilter {
+	return &OwnershipTransferFilter{config: otq.config, predicateAdder: otq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OwnershipTransferMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OrganizationSettingMutation builder.
-func (m *OrganizationSettingMutation) Filter() *OrganizationSettingFilter {
-	return &OrganizationSettingFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the OwnershipTransferMutation builder.
+func (m *OwnershipTransferMutation) Filter() *OwnershipTransferFilter {
+	return &OwnershipTransferFilter{config: m.config, predicateAdder: m}
 }
 
-// OrganizationSettingFilter provides a generic filtering capability at runtime for OrganizationSettingQuery.
-type OrganizationSettingFilter struct {
+// OwnershipTransferFilter provides a generic filtering capability at runtime for OwnershipTransferQuery.
+type OwnershipTransferFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OrganizationSettingFilter) Where(p entql.P) {
+func (f *OwnershipTransferFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql string predicate on the id field.
-func (f *OrganizationSettingFilter) WhereID(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldID))
+func (f *OwnershipTransferFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *OrganizationSettingFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(organizationsetting.FieldCreatedAt))
+func (f *OwnershipTransferFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(ownershiptransfer.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *OrganizationSettingFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(organizationsetting.FieldUpdatedAt))
+func (f *OwnershipTransferFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(ownershiptransfer.FieldUpdatedAt))
 }
 
 // WhereCreatedBy applies the entql string predicate on the created_by field.
-func (f *OrganizationSettingFilter) WhereCreatedBy(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldCreatedBy))
+func (f *OwnershipTransferFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql string predicate on the updated_by field.
-func (f *OrganizationSettingFilter) WhereUpdatedBy(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldUpdatedBy))
+func (f *OwnershipTransferFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldUpdatedBy))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *OrganizationSettingFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(organizationsetting.FieldDeletedAt))
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *OwnershipTransferFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldOwnerID))
 }
 
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *OrganizationSettingFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldDeletedBy))
+// WhereGroupID applies the entql string predicate on the group_id field.
+func (f *OwnershipTransferFilter) WhereGroupID(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldGroupID))
 }
 
-// WhereDomains applies the entql json.RawMessage predicate on the domains field.
-func (f *OrganizationSettingFilter) WhereDomains(p entql.BytesP) {
-	f.Where(p.Field(organizationsetting.FieldDomains))
+// WhereRequestorID applies the entql string predicate on the requestor_id field.
+func (f *OwnershipTransferFilter) WhereRequestorID(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldRequestorID))
 }
 
-// WhereSSOCert applies the entql string predicate on the sso_cert field.
-func (f *OrganizationSettingFilter) WhereSSOCert(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldSSOCert))
+// WhereRecipientID applies the entql string predicate on the recipient_id field.
+func (f *OwnershipTransferFilter) WhereRecipientID(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldRecipientID))
 }
 
-// WhereSSOEntrypoint applies the entql string predicate on the sso_entrypoint field.
-func (f *OrganizationSettingFilter) WhereSSOEntrypoint(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldSSOEntrypoint))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *OwnershipTransferFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldStatus))
 }
 
-// WhereSSOIssuer applies the entql string predicate on the sso_issuer field.
-func (f *OrganizationSettingFilter) WhereSSOIssuer(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldSSOIssuer))
+// WhereToken applies the entql string predicate on the token field.
+func (f *OwnershipTransferFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(ownershiptransfer.FieldToken))
 }
 
-// WhereBillingContact applies the entql string predicate on the billing_contact field.
-func (f *OrganizationSettingFilter) WhereBillingContact(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldBillingContact))
+// WhereExpires applies the entql time.Time predicate on the expires field.
+func (f *OwnershipTransferFilter) WhereExpires(p entql.TimeP) {
+	f.Where(p.Field(ownershiptransfer.FieldExpires))
 }
 
-// WhereBillingEmail applies the entql string predicate on the billing_email field.
-func (f *OrganizationSettingFilter) WhereBillingEmail(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldBillingEmail))
+// WhereSecret applies the entql []byte predicate on the secret field.
+func (f *OwnershipTransferFilter) WhereSecret(p entql.BytesP) {
+	f.Where(p.Field(ownershiptransfer.FieldSecret))
 }
 
-// WhereBillingPhone applies the entql string predicate on the billing_phone field.
-func (f *OrganizationSettingFilter) WhereBillingPhone(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldBillingPhone))
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *OwnershipTransferFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
 }
 
-// WhereBillingAddress applies the entql string predicate on the billing_address field.
-func (f *OrganizationSettingFilter) WhereBillingAddress(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldBillingAddress))
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *OwnershipTransferFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereTaxIdentifier applies the entql string predicate on the tax_identifier field.
-func (f *OrganizationSettingFilter) WhereTaxIdentifier(p entql.StringP) {
-	f.Where(p.Field(organizationsetting.FieldTaxIdentifier))
+// WhereHasGroup applies a predicate to check if query has an edge group.
+func (f *OwnershipTransferFilter) WhereHasGroup() {
+	f.Where(entql.HasEdge("group"))
 }
 
-// WhereTags applies the entql json.RawMessage predicate on the tags field.
-func (f *OrganizationSettingFilter) WhereTags(p entql.BytesP) {
-	f.Where(p.Field(organizationsetting.FieldTags))
+// WhereHasGroupWith applies a predicate to check if query has an edge group with a given conditions (other predicates).
+func (f *OwnershipTransferFilter) WhereHasGroupWith(preds ...predicate.Group) {
+	f.Where(entql.HasEdgeWith("group", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereHasOrganization applies a predicate to check if query has an edge organization.
-func (f *OrganizationSettingFilter) WhereHasOrganization() {
-	f.Where(entql.HasEdge("organization"))
+// WhereHasRequestor applies a predicate to check if query has an edge requestor.
+func (f *OwnershipTransferFilter) WhereHasRequestor() {
+	f.Where(entql.HasEdge("requestor"))
 }
 
-// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
-func (f *OrganizationSettingFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
-	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasRequestorWith applies a predicate to check if query has an edge requestor with a given conditions (other predicates).
+func (f *OwnershipTransferFilter) WhereHasRequestorWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("requestor", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecipient applies a predicate to check if query has an edge recipient.
+func (f *OwnershipTransferFilter) WhereHasRecipient() {
+	f.Where(entql.HasEdge("recipient"))
+}
+
+// WhereHasRecipientWith applies a predicate to check if query has an edge recipient with a given conditions (other predicates).
+func (f *OwnershipTransferFilter) WhereHasRecipientWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("recipient", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -1899,7 +3660,7 @@ type PasswordResetTokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PasswordResetTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1979,113 +3740,293 @@ func (patq *PersonalAccessTokenQuery) addPredicate(pred func(s *sql.Selector)) {
 	patq.predicates = append(patq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PersonalAccessTokenQuery builder.
-func (patq *PersonalAccessTokenQuery) Filter() *PersonalAccessTokenFilter {
-	return &PersonalAccessTokenFilter{config: patq.config, predicateAdder: patq}
+// Filter returns a Filter implementation to apply filters on the PersonalAccessTokenQuery builder.
+func (patq *PersonalAccessTokenQuery) Filter() *PersonalAccessTokenFilter {
+	return &PersonalAccessTokenFilter{config: patq.config, predicateAdder: patq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PersonalAccessTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PersonalAccessTokenMutation builder.
+func (m *PersonalAccessTokenMutation) Filter() *PersonalAccessTokenFilter {
+	return &PersonalAccessTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// PersonalAccessTokenFilter provides a generic filtering capability at runtime for PersonalAccessTokenQuery.
+type PersonalAccessTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PersonalAccessTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *PersonalAccessTokenFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PersonalAccessTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(personalaccesstoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PersonalAccessTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(personalaccesstoken.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *PersonalAccessTokenFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *PersonalAccessTokenFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PersonalAccessTokenFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(personalaccesstoken.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *PersonalAccessTokenFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldDeletedBy))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PersonalAccessTokenFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldName))
+}
+
+// WhereTokenPrefix applies the entql string predicate on the token_prefix field.
+func (f *PersonalAccessTokenFilter) WhereTokenPrefix(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldTokenPrefix))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *PersonalAccessTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldTokenHash))
+}
+
+// WhereToken applies the entql string predicate on the token field.
+func (f *PersonalAccessTokenFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldToken))
+}
+
+// WhereAbilities applies the entql json.RawMessage predicate on the abilities field.
+func (f *PersonalAccessTokenFilter) WhereAbilities(p entql.BytesP) {
+	f.Where(p.Field(personalaccesstoken.FieldAbilities))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *PersonalAccessTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(personalaccesstoken.FieldExpiresAt))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PersonalAccessTokenFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(personalaccesstoken.FieldDescription))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *PersonalAccessTokenFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(personalaccesstoken.FieldLastUsedAt))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *PersonalAccessTokenFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *PersonalAccessTokenFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rtq *RefreshTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	rtq.predicates = append(rtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RefreshTokenQuery builder.
+func (rtq *RefreshTokenQuery) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: rtq.config, predicateAdder: rtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RefreshTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// RefreshTokenFilter provides a generic filtering capability at runtime for RefreshTokenQuery.
+type RefreshTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RefreshTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *RefreshTokenFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RefreshTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RefreshTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *RefreshTokenFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *RefreshTokenFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldUpdatedBy))
+}
+
+// WhereJti applies the entql string predicate on the jti field.
+func (f *RefreshTokenFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldJti))
+}
+
+// WhereFamilyID applies the entql string predicate on the family_id field.
+func (f *RefreshTokenFilter) WhereFamilyID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldFamilyID))
+}
+
+// WhereParentJti applies the entql string predicate on the parent_jti field.
+func (f *RefreshTokenFilter) WhereParentJti(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldParentJti))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *RefreshTokenFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldUserID))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *RefreshTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldUsedAt))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RefreshTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldExpiresAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rtq *RevokedTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	rtq.predicates = append(rtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RevokedTokenQuery builder.
+func (rtq *RevokedTokenQuery) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: rtq.config, predicateAdder: rtq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PersonalAccessTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RevokedTokenMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PersonalAccessTokenMutation builder.
-func (m *PersonalAccessTokenMutation) Filter() *PersonalAccessTokenFilter {
-	return &PersonalAccessTokenFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: m.config, predicateAdder: m}
 }
 
-// PersonalAccessTokenFilter provides a generic filtering capability at runtime for PersonalAccessTokenQuery.
-type PersonalAccessTokenFilter struct {
+// RevokedTokenFilter provides a generic filtering capability at runtime for RevokedTokenQuery.
+type RevokedTokenFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PersonalAccessTokenFilter) Where(p entql.P) {
+func (f *RevokedTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql string predicate on the id field.
-func (f *PersonalAccessTokenFilter) WhereID(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldID))
+func (f *RevokedTokenFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PersonalAccessTokenFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(personalaccesstoken.FieldCreatedAt))
+func (f *RevokedTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PersonalAccessTokenFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(personalaccesstoken.FieldUpdatedAt))
+func (f *RevokedTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldUpdatedAt))
 }
 
 // WhereCreatedBy applies the entql string predicate on the created_by field.
-func (f *PersonalAccessTokenFilter) WhereCreatedBy(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldCreatedBy))
+func (f *RevokedTokenFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql string predicate on the updated_by field.
-func (f *PersonalAccessTokenFilter) WhereUpdatedBy(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldUpdatedBy))
-}
-
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PersonalAccessTokenFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(personalaccesstoken.FieldDeletedAt))
-}
-
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *PersonalAccessTokenFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldDeletedBy))
+func (f *RevokedTokenFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldUpdatedBy))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *PersonalAccessTokenFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldName))
+// WhereJti applies the entql string predicate on the jti field.
+func (f *RevokedTokenFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldJti))
 }
 
-// WhereToken applies the entql string predicate on the token field.
-func (f *PersonalAccessTokenFilter) WhereToken(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldToken))
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *RevokedTokenFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldUserID))
 }
 
-// WhereAbilities applies the entql json.RawMessage predicate on the abilities field.
-func (f *PersonalAccessTokenFilter) WhereAbilities(p entql.BytesP) {
-	f.Where(p.Field(personalaccesstoken.FieldAbilities))
+// WhereReason applies the entql string predicate on the reason field.
+func (f *RevokedTokenFilter) WhereReason(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldReason))
 }
 
 // WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
-func (f *PersonalAccessTokenFilter) WhereExpiresAt(p entql.TimeP) {
-	f.Where(p.Field(personalaccesstoken.FieldExpiresAt))
-}
-
-// WhereDescription applies the entql string predicate on the description field.
-func (f *PersonalAccessTokenFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(personalaccesstoken.FieldDescription))
-}
-
-// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
-func (f *PersonalAccessTokenFilter) WhereLastUsedAt(p entql.TimeP) {
-	f.Where(p.Field(personalaccesstoken.FieldLastUsedAt))
-}
-
-// WhereHasOwner applies a predicate to check if query has an edge owner.
-func (f *PersonalAccessTokenFilter) WhereHasOwner() {
-	f.Where(entql.HasEdge("owner"))
-}
-
-// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
-func (f *PersonalAccessTokenFilter) WhereHasOwnerWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+func (f *RevokedTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldExpiresAt))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -2117,7 +4058,7 @@ type SessionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SessionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2173,6 +4114,26 @@ func (f *SessionFilter) WhereUserID(p entql.StringP) {
 	f.Where(p.Field(session.FieldUserID))
 }
 
+// WhereUserAgent applies the entql string predicate on the user_agent field.
+func (f *SessionFilter) WhereUserAgent(p entql.StringP) {
+	f.Where(p.Field(session.FieldUserAgent))
+}
+
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *SessionFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(session.FieldIPAddress))
+}
+
+// WhereLastSeenAt applies the entql time.Time predicate on the last_seen_at field.
+func (f *SessionFilter) WhereLastSeenAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldLastSeenAt))
+}
+
+// WhereRevokedAt applies the entql time.Time predicate on the revoked_at field.
+func (f *SessionFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldRevokedAt))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *SessionFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -2187,6 +4148,81 @@ func (f *SessionFilter) WhereHasOwnerWith(preds ...predicate.User) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (sdq *SessionDataQuery) addPredicate(pred func(s *sql.Selector)) {
+	sdq.predicates = append(sdq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SessionDataQuery builder.
+func (sdq *SessionDataQuery) Filter() *SessionDataFilter {
+	return &SessionDataFilter{config: sdq.config, predicateAdder: sdq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SessionDataMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SessionDataMutation builder.
+func (m *SessionDataMutation) Filter() *SessionDataFilter {
+	return &SessionDataFilter{config: m.config, predicateAdder: m}
+}
+
+// SessionDataFilter provides a generic filtering capability at runtime for SessionDataQuery.
+type SessionDataFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SessionDataFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SessionDataFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(sessiondata.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SessionDataFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(sessiondata.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *SessionDataFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(sessiondata.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *SessionDataFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(sessiondata.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *SessionDataFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(sessiondata.FieldUpdatedBy))
+}
+
+// WhereToken applies the entql string predicate on the token field.
+func (f *SessionDataFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(sessiondata.FieldToken))
+}
+
+// WhereData applies the entql []byte predicate on the data field.
+func (f *SessionDataFilter) WhereData(p entql.BytesP) {
+	f.Where(p.Field(sessiondata.FieldData))
+}
+
+// WhereExpiry applies the entql time.Time predicate on the expiry field.
+func (f *SessionDataFilter) WhereExpiry(p entql.TimeP) {
+	f.Where(p.Field(sessiondata.FieldExpiry))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 	uq.predicates = append(uq.predicates, pred)
@@ -2216,7 +4252,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2302,6 +4338,16 @@ func (f *UserFilter) WherePassword(p entql.StringP) {
 	f.Where(p.Field(user.FieldPassword))
 }
 
+// WherePasswordHistory applies the entql json.RawMessage predicate on the password_history field.
+func (f *UserFilter) WherePasswordHistory(p entql.BytesP) {
+	f.Where(p.Field(user.FieldPasswordHistory))
+}
+
+// WherePasswordChangedAt applies the entql time.Time predicate on the password_changed_at field.
+func (f *UserFilter) WherePasswordChangedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldPasswordChangedAt))
+}
+
 // WhereSub applies the entql string predicate on the sub field.
 func (f *UserFilter) WhereSub(p entql.StringP) {
 	f.Where(p.Field(user.FieldSub))
@@ -2410,6 +4456,62 @@ func (f *UserFilter) WhereHasResetTokensWith(preds ...predicate.PasswordResetTok
 	})))
 }
 
+// WhereHasMagicLinkTokens applies a predicate to check if query has an edge magic_link_tokens.
+func (f *UserFilter) WhereHasMagicLinkTokens() {
+	f.Where(entql.HasEdge("magic_link_tokens"))
+}
+
+// WhereHasMagicLinkTokensWith applies a predicate to check if query has an edge magic_link_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMagicLinkTokensWith(preds ...predicate.MagicLinkToken) {
+	f.Where(entql.HasEdgeWith("magic_link_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebauthnCredentials applies a predicate to check if query has an edge webauthn_credentials.
+func (f *UserFilter) WhereHasWebauthnCredentials() {
+	f.Where(entql.HasEdge("webauthn_credentials"))
+}
+
+// WhereHasWebauthnCredentialsWith applies a predicate to check if query has an edge webauthn_credentials with a given conditions (other predicates).
+func (f *UserFilter) WhereHasWebauthnCredentialsWith(preds ...predicate.WebauthnCredential) {
+	f.Where(entql.HasEdgeWith("webauthn_credentials", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOrgMemberships applies a predicate to check if query has an edge org_memberships.
+func (f *UserFilter) WhereHasOrgMemberships() {
+	f.Where(entql.HasEdge("org_memberships"))
+}
+
+// WhereHasOrgMembershipsWith applies a predicate to check if query has an edge org_memberships with a given conditions (other predicates).
+func (f *UserFilter) WhereHasOrgMembershipsWith(preds ...predicate.OrgMembership) {
+	f.Where(entql.HasEdgeWith("org_memberships", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasGroupMemberships applies a predicate to check if query has an edge group_memberships.
+func (f *UserFilter) WhereHasGroupMemberships() {
+	f.Where(entql.HasEdge("group_memberships"))
+}
+
+// WhereHasGroupMembershipsWith applies a predicate to check if query has an edge group_memberships with a given conditions (other predicates).
+func (f *UserFilter) WhereHasGroupMembershipsWith(preds ...predicate.GroupMembership) {
+	f.Where(entql.HasEdgeWith("group_memberships", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (usq *UserSettingQuery) addPredicate(pred func(s *sql.Selector)) {
 	usq.predicates = append(usq.predicates, pred)
@@ -2439,7 +4541,7 @@ type UserSettingFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserSettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2525,6 +4627,51 @@ func (f *UserSettingFilter) WhereTags(p entql.BytesP) {
 	f.Where(p.Field(usersetting.FieldTags))
 }
 
+// WhereIsTfaEnabled applies the entql bool predicate on the is_tfa_enabled field.
+func (f *UserSettingFilter) WhereIsTfaEnabled(p entql.BoolP) {
+	f.Where(p.Field(usersetting.FieldIsTfaEnabled))
+}
+
+// WhereTfaSecret applies the entql string predicate on the tfa_secret field.
+func (f *UserSettingFilter) WhereTfaSecret(p entql.StringP) {
+	f.Where(p.Field(usersetting.FieldTfaSecret))
+}
+
+// WhereRecoveryCodes applies the entql json.RawMessage predicate on the recovery_codes field.
+func (f *UserSettingFilter) WhereRecoveryCodes(p entql.BytesP) {
+	f.Where(p.Field(usersetting.FieldRecoveryCodes))
+}
+
+// WhereTfaLastStep applies the entql int64 predicate on the tfa_last_step field.
+func (f *UserSettingFilter) WhereTfaLastStep(p entql.Int64P) {
+	f.Where(p.Field(usersetting.FieldTfaLastStep))
+}
+
+// WhereFailedLoginAttempts applies the entql int predicate on the failed_login_attempts field.
+func (f *UserSettingFilter) WhereFailedLoginAttempts(p entql.IntP) {
+	f.Where(p.Field(usersetting.FieldFailedLoginAttempts))
+}
+
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *UserSettingFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(usersetting.FieldLockedUntil))
+}
+
+// WhereUnlockToken applies the entql string predicate on the unlock_token field.
+func (f *UserSettingFilter) WhereUnlockToken(p entql.StringP) {
+	f.Where(p.Field(usersetting.FieldUnlockToken))
+}
+
+// WhereUnlockTokenSecret applies the entql []byte predicate on the unlock_token_secret field.
+func (f *UserSettingFilter) WhereUnlockTokenSecret(p entql.BytesP) {
+	f.Where(p.Field(usersetting.FieldUnlockTokenSecret))
+}
+
+// WhereUnlockTokenExpiresAt applies the entql time.Time predicate on the unlock_token_expires_at field.
+func (f *UserSettingFilter) WhereUnlockTokenExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(usersetting.FieldUnlockTokenExpiresAt))
+}
+
 // WhereHasUser applies a predicate to check if query has an edge user.
 func (f *UserSettingFilter) WhereHasUser() {
 	f.Where(entql.HasEdge("user"))
@@ -2538,3 +4685,142 @@ func (f *UserSettingFilter) WhereHasUserWith(preds ...predicate.User) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (wcq *WebauthnCredentialQuery) addPredicate(pred func(s *sql.Selector)) {
+	wcq.predicates = append(wcq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebauthnCredentialQuery builder.
+func (wcq *WebauthnCredentialQuery) Filter() *WebauthnCredentialFilter {
+	return &WebauthnCredentialFilter{config: wcq.config, predicateAdder: wcq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebauthnCredentialMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebauthnCredentialMutation builder.
+func (m *WebauthnCredentialMutation) Filter() *WebauthnCredentialFilter {
+	return &WebauthnCredentialFilter{config: m.config, predicateAdder: m}
+}
+
+// WebauthnCredentialFilter provides a generic filtering capability at runtime for WebauthnCredentialQuery.
+type WebauthnCredentialFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebauthnCredentialFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *WebauthnCredentialFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebauthnCredentialFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebauthnCredentialFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *WebauthnCredentialFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *WebauthnCredentialFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *WebauthnCredentialFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *WebauthnCredentialFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *WebauthnCredentialFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldOwnerID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *WebauthnCredentialFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldName))
+}
+
+// WhereCredentialID applies the entql []byte predicate on the credential_id field.
+func (f *WebauthnCredentialFilter) WhereCredentialID(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldCredentialID))
+}
+
+// WherePublicKey applies the entql []byte predicate on the public_key field.
+func (f *WebauthnCredentialFilter) WherePublicKey(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldPublicKey))
+}
+
+// WhereAttestationType applies the entql string predicate on the attestation_type field.
+func (f *WebauthnCredentialFilter) WhereAttestationType(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldAttestationType))
+}
+
+// WhereAaguid applies the entql string predicate on the aaguid field.
+func (f *WebauthnCredentialFilter) WhereAaguid(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldAaguid))
+}
+
+// WhereSignCount applies the entql int64 predicate on the sign_count field.
+func (f *WebauthnCredentialFilter) WhereSignCount(p entql.Int64P) {
+	f.Where(p.Field(webauthncredential.FieldSignCount))
+}
+
+// WhereTransports applies the entql json.RawMessage predicate on the transports field.
+func (f *WebauthnCredentialFilter) WhereTransports(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldTransports))
+}
+
+// WhereBackupEligible applies the entql bool predicate on the backup_eligible field.
+func (f *WebauthnCredentialFilter) WhereBackupEligible(p entql.BoolP) {
+	f.Where(p.Field(webauthncredential.FieldBackupEligible))
+}
+
+// WhereBackupState applies the entql bool predicate on the backup_state field.
+func (f *WebauthnCredentialFilter) WhereBackupState(p entql.BoolP) {
+	f.Where(p.Field(webauthncredential.FieldBackupState))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *WebauthnCredentialFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldLastUsedAt))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *WebauthnCredentialFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *WebauthnCredentialFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
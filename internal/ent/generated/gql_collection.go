@@ -10,20 +10,169 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ak *APIKeyQuery) CollectFields(ctx context.Context, satisfies ...string) (*APIKeyQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ak, nil
+	}
+	if err := ak.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ak, nil
+}
+
+func (ak *APIKeyQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(apikey.Columns))
+		selectedFields = []string{apikey.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: ak.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ak.withOwner = query
+			if _, ok := fieldSeen[apikey.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldOwnerID)
+				fieldSeen[apikey.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[apikey.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldCreatedAt)
+				fieldSeen[apikey.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[apikey.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldUpdatedAt)
+				fieldSeen[apikey.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[apikey.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldCreatedBy)
+				fieldSeen[apikey.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[apikey.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldUpdatedBy)
+				fieldSeen[apikey.FieldUpdatedBy] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[apikey.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldDeletedAt)
+				fieldSeen[apikey.FieldDeletedAt] = struct{}{}
+			}
+		case "deletedBy":
+			if _, ok := fieldSeen[apikey.FieldDeletedBy]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldDeletedBy)
+				fieldSeen[apikey.FieldDeletedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[apikey.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldOwnerID)
+				fieldSeen[apikey.FieldOwnerID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[apikey.FieldName]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldName)
+				fieldSeen[apikey.FieldName] = struct{}{}
+			}
+		case "keyPrefix":
+			if _, ok := fieldSeen[apikey.FieldKeyPrefix]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldKeyPrefix)
+				fieldSeen[apikey.FieldKeyPrefix] = struct{}{}
+			}
+		case "abilities":
+			if _, ok := fieldSeen[apikey.FieldAbilities]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldAbilities)
+				fieldSeen[apikey.FieldAbilities] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[apikey.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldExpiresAt)
+				fieldSeen[apikey.FieldExpiresAt] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[apikey.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldDescription)
+				fieldSeen[apikey.FieldDescription] = struct{}{}
+			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[apikey.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldLastUsedAt)
+				fieldSeen[apikey.FieldLastUsedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ak.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apikeyPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APIKeyPaginateOption
+}
+
+func newAPIKeyPaginateArgs(rv map[string]any) *apikeyPaginateArgs {
+	args := &apikeyPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APIKeyWhereInput); ok {
+		args.opts = append(args.opts, WithAPIKeyFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (e *EntitlementQuery) CollectFields(ctx context.Context, satisfies ...string) (*EntitlementQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -209,6 +358,18 @@ func (gr *GroupQuery) collectField(ctx context.Context, opCtx *graphql.Operation
 				return err
 			}
 			gr.withOwner = query
+		case "members":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupMembershipClient{config: gr.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			gr.WithNamedMembers(alias, func(wq *GroupMembershipQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[group.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, group.FieldCreatedAt)
@@ -327,6 +488,266 @@ func newGroupPaginateArgs(rv map[string]any) *groupPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (gjr *GroupJoinRequestQuery) CollectFields(ctx context.Context, satisfies ...string) (*GroupJoinRequestQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gjr, nil
+	}
+	if err := gjr.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return gjr, nil
+}
+
+func (gjr *GroupJoinRequestQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(groupjoinrequest.Columns))
+		selectedFields = []string{groupjoinrequest.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "group":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupClient{config: gjr.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			gjr.withGroup = query
+			if _, ok := fieldSeen[groupjoinrequest.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldGroupID)
+				fieldSeen[groupjoinrequest.FieldGroupID] = struct{}{}
+			}
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: gjr.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			gjr.withUser = query
+			if _, ok := fieldSeen[groupjoinrequest.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldUserID)
+				fieldSeen[groupjoinrequest.FieldUserID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[groupjoinrequest.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldCreatedAt)
+				fieldSeen[groupjoinrequest.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[groupjoinrequest.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldUpdatedAt)
+				fieldSeen[groupjoinrequest.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[groupjoinrequest.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldCreatedBy)
+				fieldSeen[groupjoinrequest.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[groupjoinrequest.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldUpdatedBy)
+				fieldSeen[groupjoinrequest.FieldUpdatedBy] = struct{}{}
+			}
+		case "groupID":
+			if _, ok := fieldSeen[groupjoinrequest.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldGroupID)
+				fieldSeen[groupjoinrequest.FieldGroupID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[groupjoinrequest.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldUserID)
+				fieldSeen[groupjoinrequest.FieldUserID] = struct{}{}
+			}
+		case "message":
+			if _, ok := fieldSeen[groupjoinrequest.FieldMessage]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldMessage)
+				fieldSeen[groupjoinrequest.FieldMessage] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[groupjoinrequest.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldStatus)
+				fieldSeen[groupjoinrequest.FieldStatus] = struct{}{}
+			}
+		case "reviewerID":
+			if _, ok := fieldSeen[groupjoinrequest.FieldReviewerID]; !ok {
+				selectedFields = append(selectedFields, groupjoinrequest.FieldReviewerID)
+				fieldSeen[groupjoinrequest.FieldReviewerID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		gjr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type groupjoinrequestPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GroupJoinRequestPaginateOption
+}
+
+func newGroupJoinRequestPaginateArgs(rv map[string]any) *groupjoinrequestPaginateArgs {
+	args := &groupjoinrequestPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*GroupJoinRequestWhereInput); ok {
+		args.opts = append(args.opts, WithGroupJoinRequestFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (gm *GroupMembershipQuery) CollectFields(ctx context.Context, satisfies ...string) (*GroupMembershipQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gm, nil
+	}
+	if err := gm.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return gm, nil
+}
+
+func (gm *GroupMembershipQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(groupmembership.Columns))
+		selectedFields = []string{groupmembership.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "group":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupClient{config: gm.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			gm.withGroup = query
+			if _, ok := fieldSeen[groupmembership.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldGroupID)
+				fieldSeen[groupmembership.FieldGroupID] = struct{}{}
+			}
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: gm.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			gm.withUser = query
+			if _, ok := fieldSeen[groupmembership.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldUserID)
+				fieldSeen[groupmembership.FieldUserID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[groupmembership.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldCreatedAt)
+				fieldSeen[groupmembership.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[groupmembership.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldUpdatedAt)
+				fieldSeen[groupmembership.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[groupmembership.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldCreatedBy)
+				fieldSeen[groupmembership.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[groupmembership.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldUpdatedBy)
+				fieldSeen[groupmembership.FieldUpdatedBy] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[groupmembership.FieldRole]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldRole)
+				fieldSeen[groupmembership.FieldRole] = struct{}{}
+			}
+		case "groupID":
+			if _, ok := fieldSeen[groupmembership.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldGroupID)
+				fieldSeen[groupmembership.FieldGroupID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[groupmembership.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, groupmembership.FieldUserID)
+				fieldSeen[groupmembership.FieldUserID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		gm.Select(selectedFields...)
+	}
+	return nil
+}
+
+type groupmembershipPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GroupMembershipPaginateOption
+}
+
+func newGroupMembershipPaginateArgs(rv map[string]any) *groupmembershipPaginateArgs {
+	args := &groupmembershipPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*GroupMembershipWhereInput); ok {
+		args.opts = append(args.opts, WithGroupMembershipFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (gs *GroupSettingQuery) CollectFields(ctx context.Context, satisfies ...string) (*GroupSettingQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -598,6 +1019,283 @@ func newIntegrationPaginateArgs(rv map[string]any) *integrationPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (i *InviteQuery) CollectFields(ctx context.Context, satisfies ...string) (*InviteQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return i, nil
+	}
+	if err := i.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *InviteQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(invite.Columns))
+		selectedFields = []string{invite.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: i.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			i.withOwner = query
+			if _, ok := fieldSeen[invite.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, invite.FieldOwnerID)
+				fieldSeen[invite.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[invite.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldCreatedAt)
+				fieldSeen[invite.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[invite.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldUpdatedAt)
+				fieldSeen[invite.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[invite.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, invite.FieldCreatedBy)
+				fieldSeen[invite.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[invite.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, invite.FieldUpdatedBy)
+				fieldSeen[invite.FieldUpdatedBy] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[invite.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldDeletedAt)
+				fieldSeen[invite.FieldDeletedAt] = struct{}{}
+			}
+		case "deletedBy":
+			if _, ok := fieldSeen[invite.FieldDeletedBy]; !ok {
+				selectedFields = append(selectedFields, invite.FieldDeletedBy)
+				fieldSeen[invite.FieldDeletedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[invite.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, invite.FieldOwnerID)
+				fieldSeen[invite.FieldOwnerID] = struct{}{}
+			}
+		case "expires":
+			if _, ok := fieldSeen[invite.FieldExpires]; !ok {
+				selectedFields = append(selectedFields, invite.FieldExpires)
+				fieldSeen[invite.FieldExpires] = struct{}{}
+			}
+		case "recipient":
+			if _, ok := fieldSeen[invite.FieldRecipient]; !ok {
+				selectedFields = append(selectedFields, invite.FieldRecipient)
+				fieldSeen[invite.FieldRecipient] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[invite.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, invite.FieldStatus)
+				fieldSeen[invite.FieldStatus] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[invite.FieldRole]; !ok {
+				selectedFields = append(selectedFields, invite.FieldRole)
+				fieldSeen[invite.FieldRole] = struct{}{}
+			}
+		case "requestorID":
+			if _, ok := fieldSeen[invite.FieldRequestorID]; !ok {
+				selectedFields = append(selectedFields, invite.FieldRequestorID)
+				fieldSeen[invite.FieldRequestorID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		i.Select(selectedFields...)
+	}
+	return nil
+}
+
+type invitePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []InvitePaginateOption
+}
+
+func newInvitePaginateArgs(rv map[string]any) *invitePaginateArgs {
+	args := &invitePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*InviteWhereInput); ok {
+		args.opts = append(args.opts, WithInviteFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (oc *OauthClientQuery) CollectFields(ctx context.Context, satisfies ...string) (*OauthClientQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return oc, nil
+	}
+	if err := oc.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return oc, nil
+}
+
+func (oc *OauthClientQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(oauthclient.Columns))
+		selectedFields = []string{oauthclient.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: oc.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			oc.withOwner = query
+			if _, ok := fieldSeen[oauthclient.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldOwnerID)
+				fieldSeen[oauthclient.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[oauthclient.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldCreatedAt)
+				fieldSeen[oauthclient.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[oauthclient.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldUpdatedAt)
+				fieldSeen[oauthclient.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[oauthclient.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldCreatedBy)
+				fieldSeen[oauthclient.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[oauthclient.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldUpdatedBy)
+				fieldSeen[oauthclient.FieldUpdatedBy] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[oauthclient.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldDeletedAt)
+				fieldSeen[oauthclient.FieldDeletedAt] = struct{}{}
+			}
+		case "deletedBy":
+			if _, ok := fieldSeen[oauthclient.FieldDeletedBy]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldDeletedBy)
+				fieldSeen[oauthclient.FieldDeletedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[oauthclient.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldOwnerID)
+				fieldSeen[oauthclient.FieldOwnerID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[oauthclient.FieldName]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldName)
+				fieldSeen[oauthclient.FieldName] = struct{}{}
+			}
+		case "clientID":
+			if _, ok := fieldSeen[oauthclient.FieldClientID]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldClientID)
+				fieldSeen[oauthclient.FieldClientID] = struct{}{}
+			}
+		case "redirectUris":
+			if _, ok := fieldSeen[oauthclient.FieldRedirectUris]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldRedirectUris)
+				fieldSeen[oauthclient.FieldRedirectUris] = struct{}{}
+			}
+		case "scopes":
+			if _, ok := fieldSeen[oauthclient.FieldScopes]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldScopes)
+				fieldSeen[oauthclient.FieldScopes] = struct{}{}
+			}
+		case "public":
+			if _, ok := fieldSeen[oauthclient.FieldPublic]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldPublic)
+				fieldSeen[oauthclient.FieldPublic] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[oauthclient.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, oauthclient.FieldDescription)
+				fieldSeen[oauthclient.FieldDescription] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		oc.Select(selectedFields...)
+	}
+	return nil
+}
+
+type oauthclientPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []OauthClientPaginateOption
+}
+
+func newOauthClientPaginateArgs(rv map[string]any) *oauthclientPaginateArgs {
+	args := &oauthclientPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*OauthClientWhereInput); ok {
+		args.opts = append(args.opts, WithOauthClientFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (op *OauthProviderQuery) CollectFields(ctx context.Context, satisfies ...string) (*OauthProviderQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -816,15 +1514,140 @@ func (oatt *OhAuthTooTokenQuery) collectField(ctx context.Context, opCtx *graphq
 				selectedFields = append(selectedFields, ohauthtootoken.FieldConnectorID)
 				fieldSeen[ohauthtootoken.FieldConnectorID] = struct{}{}
 			}
-		case "connectorData":
-			if _, ok := fieldSeen[ohauthtootoken.FieldConnectorData]; !ok {
-				selectedFields = append(selectedFields, ohauthtootoken.FieldConnectorData)
-				fieldSeen[ohauthtootoken.FieldConnectorData] = struct{}{}
+		case "connectorData":
+			if _, ok := fieldSeen[ohauthtootoken.FieldConnectorData]; !ok {
+				selectedFields = append(selectedFields, ohauthtootoken.FieldConnectorData)
+				fieldSeen[ohauthtootoken.FieldConnectorData] = struct{}{}
+			}
+		case "lastUsed":
+			if _, ok := fieldSeen[ohauthtootoken.FieldLastUsed]; !ok {
+				selectedFields = append(selectedFields, ohauthtootoken.FieldLastUsed)
+				fieldSeen[ohauthtootoken.FieldLastUsed] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		oatt.Select(selectedFields...)
+	}
+	return nil
+}
+
+type ohauthtootokenPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []OhAuthTooTokenPaginateOption
+}
+
+func newOhAuthTooTokenPaginateArgs(rv map[string]any) *ohauthtootokenPaginateArgs {
+	args := &ohauthtootokenPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*OhAuthTooTokenWhereInput); ok {
+		args.opts = append(args.opts, WithOhAuthTooTokenFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (om *OrgMembershipQuery) CollectFields(ctx context.Context, satisfies ...string) (*OrgMembershipQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return om, nil
+	}
+	if err := om.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return om, nil
+}
+
+func (om *OrgMembershipQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(orgmembership.Columns))
+		selectedFields = []string{orgmembership.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "organization":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: om.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			om.withOrganization = query
+			if _, ok := fieldSeen[orgmembership.FieldOrganizationID]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldOrganizationID)
+				fieldSeen[orgmembership.FieldOrganizationID] = struct{}{}
+			}
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: om.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			om.withUser = query
+			if _, ok := fieldSeen[orgmembership.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldUserID)
+				fieldSeen[orgmembership.FieldUserID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[orgmembership.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldCreatedAt)
+				fieldSeen[orgmembership.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[orgmembership.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldUpdatedAt)
+				fieldSeen[orgmembership.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[orgmembership.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldCreatedBy)
+				fieldSeen[orgmembership.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[orgmembership.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldUpdatedBy)
+				fieldSeen[orgmembership.FieldUpdatedBy] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[orgmembership.FieldRole]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldRole)
+				fieldSeen[orgmembership.FieldRole] = struct{}{}
+			}
+		case "organizationID":
+			if _, ok := fieldSeen[orgmembership.FieldOrganizationID]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldOrganizationID)
+				fieldSeen[orgmembership.FieldOrganizationID] = struct{}{}
 			}
-		case "lastUsed":
-			if _, ok := fieldSeen[ohauthtootoken.FieldLastUsed]; !ok {
-				selectedFields = append(selectedFields, ohauthtootoken.FieldLastUsed)
-				fieldSeen[ohauthtootoken.FieldLastUsed] = struct{}{}
+		case "userID":
+			if _, ok := fieldSeen[orgmembership.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, orgmembership.FieldUserID)
+				fieldSeen[orgmembership.FieldUserID] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -833,19 +1656,19 @@ func (oatt *OhAuthTooTokenQuery) collectField(ctx context.Context, opCtx *graphq
 		}
 	}
 	if !unknownSeen {
-		oatt.Select(selectedFields...)
+		om.Select(selectedFields...)
 	}
 	return nil
 }
 
-type ohauthtootokenPaginateArgs struct {
+type orgmembershipPaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []OhAuthTooTokenPaginateOption
+	opts          []OrgMembershipPaginateOption
 }
 
-func newOhAuthTooTokenPaginateArgs(rv map[string]any) *ohauthtootokenPaginateArgs {
-	args := &ohauthtootokenPaginateArgs{}
+func newOrgMembershipPaginateArgs(rv map[string]any) *orgmembershipPaginateArgs {
+	args := &orgmembershipPaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -861,8 +1684,8 @@ func newOhAuthTooTokenPaginateArgs(rv map[string]any) *ohauthtootokenPaginateArg
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[whereField].(*OhAuthTooTokenWhereInput); ok {
-		args.opts = append(args.opts, WithOhAuthTooTokenFilter(v.Filter))
+	if v, ok := rv[whereField].(*OrgMembershipWhereInput); ok {
+		args.opts = append(args.opts, WithOrgMembershipFilter(v.Filter))
 	}
 	return args
 }
@@ -1056,6 +1879,54 @@ func (o *OrganizationQuery) collectField(ctx context.Context, opCtx *graphql.Ope
 			o.WithNamedOauthprovider(alias, func(wq *OauthProviderQuery) {
 				*wq = *query
 			})
+		case "apiKeys":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIKeyClient{config: o.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			o.WithNamedAPIKeys(alias, func(wq *APIKeyQuery) {
+				*wq = *query
+			})
+		case "oauthClients":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OauthClientClient{config: o.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			o.WithNamedOauthClients(alias, func(wq *OauthClientQuery) {
+				*wq = *query
+			})
+		case "invites":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&InviteClient{config: o.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			o.WithNamedInvites(alias, func(wq *InviteQuery) {
+				*wq = *query
+			})
+		case "members":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrgMembershipClient{config: o.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			o.WithNamedMembers(alias, func(wq *OrgMembershipQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[organization.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, organization.FieldCreatedAt)
@@ -1321,6 +2192,174 @@ func newOrganizationSettingPaginateArgs(rv map[string]any) *organizationsettingP
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ot *OwnershipTransferQuery) CollectFields(ctx context.Context, satisfies ...string) (*OwnershipTransferQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ot, nil
+	}
+	if err := ot.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ot, nil
+}
+
+func (ot *OwnershipTransferQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(ownershiptransfer.Columns))
+		selectedFields = []string{ownershiptransfer.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: ot.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ot.withOwner = query
+			if _, ok := fieldSeen[ownershiptransfer.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldOwnerID)
+				fieldSeen[ownershiptransfer.FieldOwnerID] = struct{}{}
+			}
+		case "group":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupClient{config: ot.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ot.withGroup = query
+			if _, ok := fieldSeen[ownershiptransfer.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldGroupID)
+				fieldSeen[ownershiptransfer.FieldGroupID] = struct{}{}
+			}
+		case "requestor":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: ot.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ot.withRequestor = query
+			if _, ok := fieldSeen[ownershiptransfer.FieldRequestorID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldRequestorID)
+				fieldSeen[ownershiptransfer.FieldRequestorID] = struct{}{}
+			}
+		case "recipient":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: ot.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ot.withRecipient = query
+			if _, ok := fieldSeen[ownershiptransfer.FieldRecipientID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldRecipientID)
+				fieldSeen[ownershiptransfer.FieldRecipientID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[ownershiptransfer.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldCreatedAt)
+				fieldSeen[ownershiptransfer.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[ownershiptransfer.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldUpdatedAt)
+				fieldSeen[ownershiptransfer.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[ownershiptransfer.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldCreatedBy)
+				fieldSeen[ownershiptransfer.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[ownershiptransfer.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldUpdatedBy)
+				fieldSeen[ownershiptransfer.FieldUpdatedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[ownershiptransfer.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldOwnerID)
+				fieldSeen[ownershiptransfer.FieldOwnerID] = struct{}{}
+			}
+		case "groupID":
+			if _, ok := fieldSeen[ownershiptransfer.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldGroupID)
+				fieldSeen[ownershiptransfer.FieldGroupID] = struct{}{}
+			}
+		case "requestorID":
+			if _, ok := fieldSeen[ownershiptransfer.FieldRequestorID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldRequestorID)
+				fieldSeen[ownershiptransfer.FieldRequestorID] = struct{}{}
+			}
+		case "recipientID":
+			if _, ok := fieldSeen[ownershiptransfer.FieldRecipientID]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldRecipientID)
+				fieldSeen[ownershiptransfer.FieldRecipientID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[ownershiptransfer.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldStatus)
+				fieldSeen[ownershiptransfer.FieldStatus] = struct{}{}
+			}
+		case "expires":
+			if _, ok := fieldSeen[ownershiptransfer.FieldExpires]; !ok {
+				selectedFields = append(selectedFields, ownershiptransfer.FieldExpires)
+				fieldSeen[ownershiptransfer.FieldExpires] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ot.Select(selectedFields...)
+	}
+	return nil
+}
+
+type ownershiptransferPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []OwnershipTransferPaginateOption
+}
+
+func newOwnershipTransferPaginateArgs(rv map[string]any) *ownershiptransferPaginateArgs {
+	args := &ownershiptransferPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*OwnershipTransferWhereInput); ok {
+		args.opts = append(args.opts, WithOwnershipTransferFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (pat *PersonalAccessTokenQuery) CollectFields(ctx context.Context, satisfies ...string) (*PersonalAccessTokenQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1387,6 +2426,11 @@ func (pat *PersonalAccessTokenQuery) collectField(ctx context.Context, opCtx *gr
 				selectedFields = append(selectedFields, personalaccesstoken.FieldName)
 				fieldSeen[personalaccesstoken.FieldName] = struct{}{}
 			}
+		case "tokenPrefix":
+			if _, ok := fieldSeen[personalaccesstoken.FieldTokenPrefix]; !ok {
+				selectedFields = append(selectedFields, personalaccesstoken.FieldTokenPrefix)
+				fieldSeen[personalaccesstoken.FieldTokenPrefix] = struct{}{}
+			}
 		case "abilities":
 			if _, ok := fieldSeen[personalaccesstoken.FieldAbilities]; !ok {
 				selectedFields = append(selectedFields, personalaccesstoken.FieldAbilities)
@@ -1528,6 +2572,26 @@ func (s *SessionQuery) collectField(ctx context.Context, opCtx *graphql.Operatio
 				selectedFields = append(selectedFields, session.FieldUserID)
 				fieldSeen[session.FieldUserID] = struct{}{}
 			}
+		case "userAgent":
+			if _, ok := fieldSeen[session.FieldUserAgent]; !ok {
+				selectedFields = append(selectedFields, session.FieldUserAgent)
+				fieldSeen[session.FieldUserAgent] = struct{}{}
+			}
+		case "ipAddress":
+			if _, ok := fieldSeen[session.FieldIPAddress]; !ok {
+				selectedFields = append(selectedFields, session.FieldIPAddress)
+				fieldSeen[session.FieldIPAddress] = struct{}{}
+			}
+		case "lastSeenAt":
+			if _, ok := fieldSeen[session.FieldLastSeenAt]; !ok {
+				selectedFields = append(selectedFields, session.FieldLastSeenAt)
+				fieldSeen[session.FieldLastSeenAt] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[session.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, session.FieldRevokedAt)
+				fieldSeen[session.FieldRevokedAt] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1648,6 +2712,42 @@ func (u *UserQuery) collectField(ctx context.Context, opCtx *graphql.OperationCo
 				return err
 			}
 			u.withSetting = query
+		case "webauthnCredentials":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WebauthnCredentialClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			u.WithNamedWebauthnCredentials(alias, func(wq *WebauthnCredentialQuery) {
+				*wq = *query
+			})
+		case "orgMemberships":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrgMembershipClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			u.WithNamedOrgMemberships(alias, func(wq *OrgMembershipQuery) {
+				*wq = *query
+			})
+		case "groupMemberships":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupMembershipClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			u.WithNamedGroupMemberships(alias, func(wq *GroupMembershipQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[user.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, user.FieldCreatedAt)
@@ -1718,6 +2818,11 @@ func (u *UserQuery) collectField(ctx context.Context, opCtx *graphql.OperationCo
 				selectedFields = append(selectedFields, user.FieldLastSeen)
 				fieldSeen[user.FieldLastSeen] = struct{}{}
 			}
+		case "passwordChangedAt":
+			if _, ok := fieldSeen[user.FieldPasswordChangedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldPasswordChangedAt)
+				fieldSeen[user.FieldPasswordChangedAt] = struct{}{}
+			}
 		case "sub":
 			if _, ok := fieldSeen[user.FieldSub]; !ok {
 				selectedFields = append(selectedFields, user.FieldSub)
@@ -1892,6 +2997,21 @@ func (us *UserSettingQuery) collectField(ctx context.Context, opCtx *graphql.Ope
 				selectedFields = append(selectedFields, usersetting.FieldTags)
 				fieldSeen[usersetting.FieldTags] = struct{}{}
 			}
+		case "isTfaEnabled":
+			if _, ok := fieldSeen[usersetting.FieldIsTfaEnabled]; !ok {
+				selectedFields = append(selectedFields, usersetting.FieldIsTfaEnabled)
+				fieldSeen[usersetting.FieldIsTfaEnabled] = struct{}{}
+			}
+		case "failedLoginAttempts":
+			if _, ok := fieldSeen[usersetting.FieldFailedLoginAttempts]; !ok {
+				selectedFields = append(selectedFields, usersetting.FieldFailedLoginAttempts)
+				fieldSeen[usersetting.FieldFailedLoginAttempts] = struct{}{}
+			}
+		case "lockedUntil":
+			if _, ok := fieldSeen[usersetting.FieldLockedUntil]; !ok {
+				selectedFields = append(selectedFields, usersetting.FieldLockedUntil)
+				fieldSeen[usersetting.FieldLockedUntil] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1933,6 +3053,152 @@ func newUserSettingPaginateArgs(rv map[string]any) *usersettingPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (wc *WebauthnCredentialQuery) CollectFields(ctx context.Context, satisfies ...string) (*WebauthnCredentialQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return wc, nil
+	}
+	if err := wc.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return wc, nil
+}
+
+func (wc *WebauthnCredentialQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(webauthncredential.Columns))
+		selectedFields = []string{webauthncredential.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: wc.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			wc.withOwner = query
+			if _, ok := fieldSeen[webauthncredential.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldOwnerID)
+				fieldSeen[webauthncredential.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[webauthncredential.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldCreatedAt)
+				fieldSeen[webauthncredential.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[webauthncredential.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldUpdatedAt)
+				fieldSeen[webauthncredential.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[webauthncredential.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldCreatedBy)
+				fieldSeen[webauthncredential.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[webauthncredential.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldUpdatedBy)
+				fieldSeen[webauthncredential.FieldUpdatedBy] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[webauthncredential.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldDeletedAt)
+				fieldSeen[webauthncredential.FieldDeletedAt] = struct{}{}
+			}
+		case "deletedBy":
+			if _, ok := fieldSeen[webauthncredential.FieldDeletedBy]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldDeletedBy)
+				fieldSeen[webauthncredential.FieldDeletedBy] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[webauthncredential.FieldName]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldName)
+				fieldSeen[webauthncredential.FieldName] = struct{}{}
+			}
+		case "attestationType":
+			if _, ok := fieldSeen[webauthncredential.FieldAttestationType]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldAttestationType)
+				fieldSeen[webauthncredential.FieldAttestationType] = struct{}{}
+			}
+		case "aaguid":
+			if _, ok := fieldSeen[webauthncredential.FieldAaguid]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldAaguid)
+				fieldSeen[webauthncredential.FieldAaguid] = struct{}{}
+			}
+		case "signCount":
+			if _, ok := fieldSeen[webauthncredential.FieldSignCount]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldSignCount)
+				fieldSeen[webauthncredential.FieldSignCount] = struct{}{}
+			}
+		case "transports":
+			if _, ok := fieldSeen[webauthncredential.FieldTransports]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldTransports)
+				fieldSeen[webauthncredential.FieldTransports] = struct{}{}
+			}
+		case "backupEligible":
+			if _, ok := fieldSeen[webauthncredential.FieldBackupEligible]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldBackupEligible)
+				fieldSeen[webauthncredential.FieldBackupEligible] = struct{}{}
+			}
+		case "backupState":
+			if _, ok := fieldSeen[webauthncredential.FieldBackupState]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldBackupState)
+				fieldSeen[webauthncredential.FieldBackupState] = struct{}{}
+			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[webauthncredential.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, webauthncredential.FieldLastUsedAt)
+				fieldSeen[webauthncredential.FieldLastUsedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		wc.Select(selectedFields...)
+	}
+	return nil
+}
+
+type webauthncredentialPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WebauthnCredentialPaginateOption
+}
+
+func newWebauthnCredentialPaginateArgs(rv map[string]any) *webauthncredentialPaginateArgs {
+	args := &webauthncredentialPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*WebauthnCredentialWhereInput); ok {
+		args.opts = append(args.opts, WithWebauthnCredentialFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
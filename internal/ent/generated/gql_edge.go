@@ -8,6 +8,14 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+func (ak *APIKey) Owner(ctx context.Context) (*Organization, error) {
+	result, err := ak.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = ak.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
 func (e *Entitlement) Owner(ctx context.Context) (*Organization, error) {
 	result, err := e.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -44,6 +52,50 @@ func (gr *Group) Owner(ctx context.Context) (*Organization, error) {
 	return result, err
 }
 
+func (gr *Group) Members(ctx context.Context) (result []*GroupMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = gr.NamedMembers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = gr.Edges.MembersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = gr.QueryMembers().All(ctx)
+	}
+	return result, err
+}
+
+func (gjr *GroupJoinRequest) Group(ctx context.Context) (*Group, error) {
+	result, err := gjr.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = gjr.QueryGroup().Only(ctx)
+	}
+	return result, err
+}
+
+func (gjr *GroupJoinRequest) User(ctx context.Context) (*User, error) {
+	result, err := gjr.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = gjr.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (gm *GroupMembership) Group(ctx context.Context) (*Group, error) {
+	result, err := gm.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = gm.QueryGroup().Only(ctx)
+	}
+	return result, err
+}
+
+func (gm *GroupMembership) User(ctx context.Context) (*User, error) {
+	result, err := gm.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = gm.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
 func (gs *GroupSetting) Group(ctx context.Context) (*Group, error) {
 	result, err := gs.Edges.GroupOrErr()
 	if IsNotLoaded(err) {
@@ -60,6 +112,22 @@ func (i *Integration) Owner(ctx context.Context) (*Organization, error) {
 	return result, MaskNotFound(err)
 }
 
+func (i *Invite) Owner(ctx context.Context) (*Organization, error) {
+	result, err := i.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (oc *OauthClient) Owner(ctx context.Context) (*Organization, error) {
+	result, err := oc.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = oc.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
 func (op *OauthProvider) Owner(ctx context.Context) (*Organization, error) {
 	result, err := op.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -68,6 +136,22 @@ func (op *OauthProvider) Owner(ctx context.Context) (*Organization, error) {
 	return result, MaskNotFound(err)
 }
 
+func (om *OrgMembership) Organization(ctx context.Context) (*Organization, error) {
+	result, err := om.Edges.OrganizationOrErr()
+	if IsNotLoaded(err) {
+		result, err = om.QueryOrganization().Only(ctx)
+	}
+	return result, err
+}
+
+func (om *OrgMembership) User(ctx context.Context) (*User, error) {
+	result, err := om.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = om.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
 func (o *Organization) Parent(ctx context.Context) (*Organization, error) {
 	result, err := o.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
@@ -165,6 +249,54 @@ func (o *Organization) Oauthprovider(ctx context.Context) (result []*OauthProvid
 	return result, err
 }
 
+func (o *Organization) APIKeys(ctx context.Context) (result []*APIKey, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = o.NamedAPIKeys(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = o.Edges.APIKeysOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = o.QueryAPIKeys().All(ctx)
+	}
+	return result, err
+}
+
+func (o *Organization) OauthClients(ctx context.Context) (result []*OauthClient, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = o.NamedOauthClients(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = o.Edges.OauthClientsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = o.QueryOauthClients().All(ctx)
+	}
+	return result, err
+}
+
+func (o *Organization) Invites(ctx context.Context) (result []*Invite, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = o.NamedInvites(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = o.Edges.InvitesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = o.QueryInvites().All(ctx)
+	}
+	return result, err
+}
+
+func (o *Organization) Members(ctx context.Context) (result []*OrgMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = o.NamedMembers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = o.Edges.MembersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = o.QueryMembers().All(ctx)
+	}
+	return result, err
+}
+
 func (os *OrganizationSetting) Organization(ctx context.Context) (*Organization, error) {
 	result, err := os.Edges.OrganizationOrErr()
 	if IsNotLoaded(err) {
@@ -173,6 +305,38 @@ func (os *OrganizationSetting) Organization(ctx context.Context) (*Organization,
 	return result, MaskNotFound(err)
 }
 
+func (ot *OwnershipTransfer) Owner(ctx context.Context) (*Organization, error) {
+	result, err := ot.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = ot.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (ot *OwnershipTransfer) Group(ctx context.Context) (*Group, error) {
+	result, err := ot.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = ot.QueryGroup().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (ot *OwnershipTransfer) Requestor(ctx context.Context) (*User, error) {
+	result, err := ot.Edges.RequestorOrErr()
+	if IsNotLoaded(err) {
+		result, err = ot.QueryRequestor().Only(ctx)
+	}
+	return result, err
+}
+
+func (ot *OwnershipTransfer) Recipient(ctx context.Context) (*User, error) {
+	result, err := ot.Edges.RecipientOrErr()
+	if IsNotLoaded(err) {
+		result, err = ot.QueryRecipient().Only(ctx)
+	}
+	return result, err
+}
+
 func (pat *PersonalAccessToken) Owner(ctx context.Context) (*User, error) {
 	result, err := pat.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -245,6 +409,42 @@ func (u *User) Setting(ctx context.Context) (*UserSetting, error) {
 	return result, err
 }
 
+func (u *User) WebauthnCredentials(ctx context.Context) (result []*WebauthnCredential, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedWebauthnCredentials(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.WebauthnCredentialsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryWebauthnCredentials().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) OrgMemberships(ctx context.Context) (result []*OrgMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedOrgMemberships(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.OrgMembershipsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryOrgMemberships().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) GroupMemberships(ctx context.Context) (result []*GroupMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedGroupMemberships(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.GroupMembershipsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryGroupMemberships().All(ctx)
+	}
+	return result, err
+}
+
 func (us *UserSetting) User(ctx context.Context) (*User, error) {
 	result, err := us.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -252,3 +452,11 @@ func (us *UserSetting) User(ctx context.Context) (*User, error) {
 	}
 	return result, MaskNotFound(err)
 }
+
+func (wc *WebauthnCredential) Owner(ctx context.Context) (*User, error) {
+	result, err := wc.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = wc.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
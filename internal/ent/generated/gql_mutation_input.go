@@ -6,10 +6,111 @@ import (
 	"time"
 
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 )
 
+// CreateAPIKeyInput represents a mutation input for creating apikeys.
+type CreateAPIKeyInput struct {
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	CreatedBy   *string
+	UpdatedBy   *string
+	Name        string
+	Abilities   []string
+	ExpiresAt   *time.Time
+	Description *string
+	OwnerID     string
+}
+
+// Mutate applies the CreateAPIKeyInput on the APIKeyMutation builder.
+func (i *CreateAPIKeyInput) Mutate(m *APIKeyMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	m.SetName(i.Name)
+	if v := i.Abilities; v != nil {
+		m.SetAbilities(v)
+	}
+	if v := i.ExpiresAt; v != nil {
+		m.SetExpiresAt(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	m.SetOwnerID(i.OwnerID)
+}
+
+// SetInput applies the change-set in the CreateAPIKeyInput on the APIKeyCreate builder.
+func (c *APIKeyCreate) SetInput(i CreateAPIKeyInput) *APIKeyCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateAPIKeyInput represents a mutation input for updating apikeys.
+type UpdateAPIKeyInput struct {
+	UpdatedAt        *time.Time
+	ClearUpdatedBy   bool
+	UpdatedBy        *string
+	Name             *string
+	ClearExpiresAt   bool
+	ExpiresAt        *time.Time
+	ClearDescription bool
+	Description      *string
+}
+
+// Mutate applies the UpdateAPIKeyInput on the APIKeyMutation builder.
+func (i *UpdateAPIKeyInput) Mutate(m *APIKeyMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearUpdatedBy {
+		m.ClearUpdatedBy()
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearExpiresAt {
+		m.ClearExpiresAt()
+	}
+	if v := i.ExpiresAt; v != nil {
+		m.SetExpiresAt(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAPIKeyInput on the APIKeyUpdate builder.
+func (c *APIKeyUpdate) SetInput(i UpdateAPIKeyInput) *APIKeyUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateAPIKeyInput on the APIKeyUpdateOne builder.
+func (c *APIKeyUpdateOne) SetInput(i UpdateAPIKeyInput) *APIKeyUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateEntitlementInput represents a mutation input for creating entitlements.
 type CreateEntitlementInput struct {
 	CreatedAt              *time.Time
@@ -156,7 +257,6 @@ type CreateGroupInput struct {
 	LogoURL         *string
 	DisplayName     *string
 	SettingID       string
-	UserIDs         []string
 	OwnerID         string
 }
 
@@ -188,9 +288,6 @@ func (i *CreateGroupInput) Mutate(m *GroupMutation) {
 		m.SetDisplayName(*v)
 	}
 	m.SetSettingID(i.SettingID)
-	if v := i.UserIDs; len(v) > 0 {
-		m.AddUserIDs(v...)
-	}
 	m.SetOwnerID(i.OwnerID)
 }
 
@@ -214,9 +311,6 @@ type UpdateGroupInput struct {
 	LogoURL              *string
 	DisplayName          *string
 	SettingID            *string
-	ClearUsers           bool
-	AddUserIDs           []string
-	RemoveUserIDs        []string
 	OwnerID              *string
 }
 
@@ -258,15 +352,6 @@ func (i *UpdateGroupInput) Mutate(m *GroupMutation) {
 	if v := i.SettingID; v != nil {
 		m.SetSettingID(*v)
 	}
-	if i.ClearUsers {
-		m.ClearUsers()
-	}
-	if v := i.AddUserIDs; len(v) > 0 {
-		m.AddUserIDs(v...)
-	}
-	if v := i.RemoveUserIDs; len(v) > 0 {
-		m.RemoveUserIDs(v...)
-	}
 	if v := i.OwnerID; v != nil {
 		m.SetOwnerID(*v)
 	}
@@ -284,6 +369,80 @@ func (c *GroupUpdateOne) SetInput(i UpdateGroupInput) *GroupUpdateOne {
 	return c
 }
 
+// CreateGroupJoinRequestInput represents a mutation input for creating groupjoinrequests.
+type CreateGroupJoinRequestInput struct {
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	CreatedBy *string
+	UpdatedBy *string
+	Message   *string
+	GroupID   string
+}
+
+// Mutate applies the CreateGroupJoinRequestInput on the GroupJoinRequestMutation builder.
+func (i *CreateGroupJoinRequestInput) Mutate(m *GroupJoinRequestMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	if v := i.Message; v != nil {
+		m.SetMessage(*v)
+	}
+	m.SetGroupID(i.GroupID)
+}
+
+// SetInput applies the change-set in the CreateGroupJoinRequestInput on the GroupJoinRequestCreate builder.
+func (c *GroupJoinRequestCreate) SetInput(i CreateGroupJoinRequestInput) *GroupJoinRequestCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// CreateGroupMembershipInput represents a mutation input for creating groupmemberships.
+type CreateGroupMembershipInput struct {
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	CreatedBy *string
+	UpdatedBy *string
+	Role      *groupmembership.Role
+	GroupID   string
+	UserID    string
+}
+
+// Mutate applies the CreateGroupMembershipInput on the GroupMembershipMutation builder.
+func (i *CreateGroupMembershipInput) Mutate(m *GroupMembershipMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	if v := i.Role; v != nil {
+		m.SetRole(*v)
+	}
+	m.SetGroupID(i.GroupID)
+	m.SetUserID(i.UserID)
+}
+
+// SetInput applies the change-set in the CreateGroupMembershipInput on the GroupMembershipCreate builder.
+func (c *GroupMembershipCreate) SetInput(i CreateGroupMembershipInput) *GroupMembershipCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateGroupSettingInput represents a mutation input for creating groupsettings.
 type CreateGroupSettingInput struct {
 	CreatedAt    *time.Time
@@ -508,6 +667,146 @@ func (c *IntegrationUpdateOne) SetInput(i UpdateIntegrationInput) *IntegrationUp
 	return c
 }
 
+// CreateInviteInput represents a mutation input for creating invites.
+type CreateInviteInput struct {
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	CreatedBy *string
+	UpdatedBy *string
+	Recipient string
+	Role      *invite.Role
+	OwnerID   string
+}
+
+// Mutate applies the CreateInviteInput on the InviteMutation builder.
+func (i *CreateInviteInput) Mutate(m *InviteMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	m.SetRecipient(i.Recipient)
+	if v := i.Role; v != nil {
+		m.SetRole(*v)
+	}
+	m.SetOwnerID(i.OwnerID)
+}
+
+// SetInput applies the change-set in the CreateInviteInput on the InviteCreate builder.
+func (c *InviteCreate) SetInput(i CreateInviteInput) *InviteCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// CreateOauthClientInput represents a mutation input for creating oauthclients.
+type CreateOauthClientInput struct {
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	CreatedBy    *string
+	UpdatedBy    *string
+	Name         string
+	RedirectUris []string
+	Scopes       []string
+	Public       *bool
+	Description  *string
+	OwnerID      string
+}
+
+// Mutate applies the CreateOauthClientInput on the OauthClientMutation builder.
+func (i *CreateOauthClientInput) Mutate(m *OauthClientMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	m.SetName(i.Name)
+	if v := i.RedirectUris; v != nil {
+		m.SetRedirectUris(v)
+	}
+	if v := i.Scopes; v != nil {
+		m.SetScopes(v)
+	}
+	if v := i.Public; v != nil {
+		m.SetPublic(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	m.SetOwnerID(i.OwnerID)
+}
+
+// SetInput applies the change-set in the CreateOauthClientInput on the OauthClientCreate builder.
+func (c *OauthClientCreate) SetInput(i CreateOauthClientInput) *OauthClientCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateOauthClientInput represents a mutation input for updating oauthclients.
+type UpdateOauthClientInput struct {
+	UpdatedAt          *time.Time
+	ClearUpdatedBy     bool
+	UpdatedBy          *string
+	Name               *string
+	RedirectUris       []string
+	AppendRedirectUris []string
+	ClearDescription   bool
+	Description        *string
+}
+
+// Mutate applies the UpdateOauthClientInput on the OauthClientMutation builder.
+func (i *UpdateOauthClientInput) Mutate(m *OauthClientMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearUpdatedBy {
+		m.ClearUpdatedBy()
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.RedirectUris; v != nil {
+		m.SetRedirectUris(v)
+	}
+	if i.AppendRedirectUris != nil {
+		m.AppendRedirectUris(i.RedirectUris)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateOauthClientInput on the OauthClientUpdate builder.
+func (c *OauthClientUpdate) SetInput(i UpdateOauthClientInput) *OauthClientUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateOauthClientInput on the OauthClientUpdateOne builder.
+func (c *OauthClientUpdateOne) SetInput(i UpdateOauthClientInput) *OauthClientUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateOauthProviderInput represents a mutation input for creating oauthproviders.
 type CreateOauthProviderInput struct {
 	CreatedAt    *time.Time
@@ -640,7 +939,7 @@ func (c *OauthProviderUpdateOne) SetInput(i UpdateOauthProviderInput) *OauthProv
 type CreateOhAuthTooTokenInput struct {
 	ClientID                string
 	Scopes                  []string
-	Nonce                   string
+	Nonce                   *string
 	ClaimsUserID            string
 	ClaimsUsername          string
 	ClaimsEmail             string
@@ -658,7 +957,9 @@ func (i *CreateOhAuthTooTokenInput) Mutate(m *OhAuthTooTokenMutation) {
 	if v := i.Scopes; v != nil {
 		m.SetScopes(v)
 	}
-	m.SetNonce(i.Nonce)
+	if v := i.Nonce; v != nil {
+		m.SetNonce(*v)
+	}
 	m.SetClaimsUserID(i.ClaimsUserID)
 	m.SetClaimsUsername(i.ClaimsUsername)
 	m.SetClaimsEmail(i.ClaimsEmail)
@@ -688,6 +989,7 @@ type UpdateOhAuthTooTokenInput struct {
 	ClearScopes             bool
 	Scopes                  []string
 	AppendScopes            []string
+	ClearNonce              bool
 	Nonce                   *string
 	ClaimsUserID            *string
 	ClaimsUsername          *string
@@ -718,6 +1020,9 @@ func (i *UpdateOhAuthTooTokenInput) Mutate(m *OhAuthTooTokenMutation) {
 	if i.AppendScopes != nil {
 		m.AppendScopes(i.Scopes)
 	}
+	if i.ClearNonce {
+		m.ClearNonce()
+	}
 	if v := i.Nonce; v != nil {
 		m.SetNonce(*v)
 	}
@@ -774,6 +1079,44 @@ func (c *OhAuthTooTokenUpdateOne) SetInput(i UpdateOhAuthTooTokenInput) *OhAuthT
 	return c
 }
 
+// CreateOrgMembershipInput represents a mutation input for creating orgmemberships.
+type CreateOrgMembershipInput struct {
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	CreatedBy      *string
+	UpdatedBy      *string
+	Role           *orgmembership.Role
+	OrganizationID string
+	UserID         string
+}
+
+// Mutate applies the CreateOrgMembershipInput on the OrgMembershipMutation builder.
+func (i *CreateOrgMembershipInput) Mutate(m *OrgMembershipMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.CreatedBy; v != nil {
+		m.SetCreatedBy(*v)
+	}
+	if v := i.UpdatedBy; v != nil {
+		m.SetUpdatedBy(*v)
+	}
+	if v := i.Role; v != nil {
+		m.SetRole(*v)
+	}
+	m.SetOrganizationID(i.OrganizationID)
+	m.SetUserID(i.UserID)
+}
+
+// SetInput applies the change-set in the CreateOrgMembershipInput on the OrgMembershipCreate builder.
+func (c *OrgMembershipCreate) SetInput(i CreateOrgMembershipInput) *OrgMembershipCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateOrganizationInput represents a mutation input for creating organizations.
 type CreateOrganizationInput struct {
 	CreatedAt        *time.Time
@@ -785,12 +1128,14 @@ type CreateOrganizationInput struct {
 	Description      *string
 	PersonalOrg      *bool
 	ParentID         *string
-	UserIDs          []string
 	GroupIDs         []string
 	IntegrationIDs   []string
 	SettingID        *string
 	EntitlementIDs   []string
 	OauthproviderIDs []string
+	APIKeyIDs        []string
+	OauthClientIDs   []string
+	InviteIDs        []string
 }
 
 // Mutate applies the CreateOrganizationInput on the OrganizationMutation builder.
@@ -820,9 +1165,6 @@ func (i *CreateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.ParentID; v != nil {
 		m.SetParentID(*v)
 	}
-	if v := i.UserIDs; len(v) > 0 {
-		m.AddUserIDs(v...)
-	}
 	if v := i.GroupIDs; len(v) > 0 {
 		m.AddGroupIDs(v...)
 	}
@@ -838,6 +1180,15 @@ func (i *CreateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.OauthproviderIDs; len(v) > 0 {
 		m.AddOauthproviderIDs(v...)
 	}
+	if v := i.APIKeyIDs; len(v) > 0 {
+		m.AddAPIKeyIDs(v...)
+	}
+	if v := i.OauthClientIDs; len(v) > 0 {
+		m.AddOauthClientIDs(v...)
+	}
+	if v := i.InviteIDs; len(v) > 0 {
+		m.AddInviteIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the CreateOrganizationInput on the OrganizationCreate builder.
@@ -855,9 +1206,6 @@ type UpdateOrganizationInput struct {
 	DisplayName            *string
 	ClearDescription       bool
 	Description            *string
-	ClearUsers             bool
-	AddUserIDs             []string
-	RemoveUserIDs          []string
 	ClearGroups            bool
 	AddGroupIDs            []string
 	RemoveGroupIDs         []string
@@ -872,6 +1220,15 @@ type UpdateOrganizationInput struct {
 	ClearOauthprovider     bool
 	AddOauthproviderIDs    []string
 	RemoveOauthproviderIDs []string
+	ClearAPIKeys           bool
+	AddAPIKeyIDs           []string
+	RemoveAPIKeyIDs        []string
+	ClearOauthClients      bool
+	AddOauthClientIDs      []string
+	RemoveOauthClientIDs   []string
+	ClearInvites           bool
+	AddInviteIDs           []string
+	RemoveInviteIDs        []string
 }
 
 // Mutate applies the UpdateOrganizationInput on the OrganizationMutation builder.
@@ -897,15 +1254,6 @@ func (i *UpdateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.Description; v != nil {
 		m.SetDescription(*v)
 	}
-	if i.ClearUsers {
-		m.ClearUsers()
-	}
-	if v := i.AddUserIDs; len(v) > 0 {
-		m.AddUserIDs(v...)
-	}
-	if v := i.RemoveUserIDs; len(v) > 0 {
-		m.RemoveUserIDs(v...)
-	}
 	if i.ClearGroups {
 		m.ClearGroups()
 	}
@@ -948,6 +1296,33 @@ func (i *UpdateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.RemoveOauthproviderIDs; len(v) > 0 {
 		m.RemoveOauthproviderIDs(v...)
 	}
+	if i.ClearAPIKeys {
+		m.ClearAPIKeys()
+	}
+	if v := i.AddAPIKeyIDs; len(v) > 0 {
+		m.AddAPIKeyIDs(v...)
+	}
+	if v := i.RemoveAPIKeyIDs; len(v) > 0 {
+		m.RemoveAPIKeyIDs(v...)
+	}
+	if i.ClearOauthClients {
+		m.ClearOauthClients()
+	}
+	if v := i.AddOauthClientIDs; len(v) > 0 {
+		m.AddOauthClientIDs(v...)
+	}
+	if v := i.RemoveOauthClientIDs; len(v) > 0 {
+		m.RemoveOauthClientIDs(v...)
+	}
+	if i.ClearInvites {
+		m.ClearInvites()
+	}
+	if v := i.AddInviteIDs; len(v) > 0 {
+		m.AddInviteIDs(v...)
+	}
+	if v := i.RemoveInviteIDs; len(v) > 0 {
+		m.RemoveInviteIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateOrganizationInput on the OrganizationUpdate builder.
@@ -1171,7 +1546,6 @@ type CreatePersonalAccessTokenInput struct {
 	CreatedBy   *string
 	UpdatedBy   *string
 	Name        string
-	Token       *string
 	Abilities   []string
 	ExpiresAt   time.Time
 	Description *string
@@ -1194,9 +1568,6 @@ func (i *CreatePersonalAccessTokenInput) Mutate(m *PersonalAccessTokenMutation)
 		m.SetUpdatedBy(*v)
 	}
 	m.SetName(i.Name)
-	if v := i.Token; v != nil {
-		m.SetToken(*v)
-	}
 	if v := i.Abilities; v != nil {
 		m.SetAbilities(v)
 	}
@@ -1295,9 +1666,9 @@ type CreateSessionInput struct {
 	CreatedBy      *string
 	UpdatedBy      *string
 	SessionToken   string
-	IssuedAt       time.Time
+	IssuedAt       *time.Time
 	ExpiresAt      time.Time
-	OrganizationID string
+	OrganizationID *string
 	OwnerID        string
 }
 
@@ -1316,9 +1687,13 @@ func (i *CreateSessionInput) Mutate(m *SessionMutation) {
 		m.SetUpdatedBy(*v)
 	}
 	m.SetSessionToken(i.SessionToken)
-	m.SetIssuedAt(i.IssuedAt)
+	if v := i.IssuedAt; v != nil {
+		m.SetIssuedAt(*v)
+	}
 	m.SetExpiresAt(i.ExpiresAt)
-	m.SetOrganizationID(i.OrganizationID)
+	if v := i.OrganizationID; v != nil {
+		m.SetOrganizationID(*v)
+	}
 	m.SetOwnerID(i.OwnerID)
 }
 
@@ -1330,13 +1705,13 @@ func (c *SessionCreate) SetInput(i CreateSessionInput) *SessionCreate {
 
 // UpdateSessionInput represents a mutation input for updating sessions.
 type UpdateSessionInput struct {
-	UpdatedAt      *time.Time
-	ClearUpdatedBy bool
-	UpdatedBy      *string
-	IssuedAt       *time.Time
-	ExpiresAt      *time.Time
-	OrganizationID *string
-	OwnerID        *string
+	UpdatedAt           *time.Time
+	ClearUpdatedBy      bool
+	UpdatedBy           *string
+	ExpiresAt           *time.Time
+	ClearOrganizationID bool
+	OrganizationID      *string
+	OwnerID             *string
 }
 
 // Mutate applies the UpdateSessionInput on the SessionMutation builder.
@@ -1350,12 +1725,12 @@ func (i *UpdateSessionInput) Mutate(m *SessionMutation) {
 	if v := i.UpdatedBy; v != nil {
 		m.SetUpdatedBy(*v)
 	}
-	if v := i.IssuedAt; v != nil {
-		m.SetIssuedAt(*v)
-	}
 	if v := i.ExpiresAt; v != nil {
 		m.SetExpiresAt(*v)
 	}
+	if i.ClearOrganizationID {
+		m.ClearOrganizationID()
+	}
 	if v := i.OrganizationID; v != nil {
 		m.SetOrganizationID(*v)
 	}
@@ -1393,13 +1768,13 @@ type CreateUserInput struct {
 	Password                  *string
 	Sub                       *string
 	Oauth                     *bool
-	OrganizationIDs           []string
 	SessionIDs                []string
-	GroupIDs                  []string
 	PersonalAccessTokenIDs    []string
 	SettingID                 string
 	EmailVerificationTokenIDs []string
 	ResetTokenIDs             []string
+	MagicLinkTokenIDs         []string
+	WebauthnCredentialIDs     []string
 }
 
 // Mutate applies the CreateUserInput on the UserMutation builder.
@@ -1443,15 +1818,9 @@ func (i *CreateUserInput) Mutate(m *UserMutation) {
 	if v := i.Oauth; v != nil {
 		m.SetOauth(*v)
 	}
-	if v := i.OrganizationIDs; len(v) > 0 {
-		m.AddOrganizationIDs(v...)
-	}
 	if v := i.SessionIDs; len(v) > 0 {
 		m.AddSessionIDs(v...)
 	}
-	if v := i.GroupIDs; len(v) > 0 {
-		m.AddGroupIDs(v...)
-	}
 	if v := i.PersonalAccessTokenIDs; len(v) > 0 {
 		m.AddPersonalAccessTokenIDs(v...)
 	}
@@ -1462,6 +1831,12 @@ func (i *CreateUserInput) Mutate(m *UserMutation) {
 	if v := i.ResetTokenIDs; len(v) > 0 {
 		m.AddResetTokenIDs(v...)
 	}
+	if v := i.MagicLinkTokenIDs; len(v) > 0 {
+		m.AddMagicLinkTokenIDs(v...)
+	}
+	if v := i.WebauthnCredentialIDs; len(v) > 0 {
+		m.AddWebauthnCredentialIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the CreateUserInput on the UserCreate builder.
@@ -1492,15 +1867,9 @@ type UpdateUserInput struct {
 	ClearSub                        bool
 	Sub                             *string
 	Oauth                           *bool
-	ClearOrganizations              bool
-	AddOrganizationIDs              []string
-	RemoveOrganizationIDs           []string
 	ClearSessions                   bool
 	AddSessionIDs                   []string
 	RemoveSessionIDs                []string
-	ClearGroups                     bool
-	AddGroupIDs                     []string
-	RemoveGroupIDs                  []string
 	ClearPersonalAccessTokens       bool
 	AddPersonalAccessTokenIDs       []string
 	RemovePersonalAccessTokenIDs    []string
@@ -1511,6 +1880,12 @@ type UpdateUserInput struct {
 	ClearResetTokens                bool
 	AddResetTokenIDs                []string
 	RemoveResetTokenIDs             []string
+	ClearMagicLinkTokens            bool
+	AddMagicLinkTokenIDs            []string
+	RemoveMagicLinkTokenIDs         []string
+	ClearWebauthnCredentials        bool
+	AddWebauthnCredentialIDs        []string
+	RemoveWebauthnCredentialIDs     []string
 }
 
 // Mutate applies the UpdateUserInput on the UserMutation builder.
@@ -1575,15 +1950,6 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.Oauth; v != nil {
 		m.SetOauth(*v)
 	}
-	if i.ClearOrganizations {
-		m.ClearOrganizations()
-	}
-	if v := i.AddOrganizationIDs; len(v) > 0 {
-		m.AddOrganizationIDs(v...)
-	}
-	if v := i.RemoveOrganizationIDs; len(v) > 0 {
-		m.RemoveOrganizationIDs(v...)
-	}
 	if i.ClearSessions {
 		m.ClearSessions()
 	}
@@ -1593,15 +1959,6 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.RemoveSessionIDs; len(v) > 0 {
 		m.RemoveSessionIDs(v...)
 	}
-	if i.ClearGroups {
-		m.ClearGroups()
-	}
-	if v := i.AddGroupIDs; len(v) > 0 {
-		m.AddGroupIDs(v...)
-	}
-	if v := i.RemoveGroupIDs; len(v) > 0 {
-		m.RemoveGroupIDs(v...)
-	}
 	if i.ClearPersonalAccessTokens {
 		m.ClearPersonalAccessTokens()
 	}
@@ -1632,6 +1989,24 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.RemoveResetTokenIDs; len(v) > 0 {
 		m.RemoveResetTokenIDs(v...)
 	}
+	if i.ClearMagicLinkTokens {
+		m.ClearMagicLinkTokens()
+	}
+	if v := i.AddMagicLinkTokenIDs; len(v) > 0 {
+		m.AddMagicLinkTokenIDs(v...)
+	}
+	if v := i.RemoveMagicLinkTokenIDs; len(v) > 0 {
+		m.RemoveMagicLinkTokenIDs(v...)
+	}
+	if i.ClearWebauthnCredentials {
+		m.ClearWebauthnCredentials()
+	}
+	if v := i.AddWebauthnCredentialIDs; len(v) > 0 {
+		m.AddWebauthnCredentialIDs(v...)
+	}
+	if v := i.RemoveWebauthnCredentialIDs; len(v) > 0 {
+		m.RemoveWebauthnCredentialIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateUserInput on the UserUpdate builder.
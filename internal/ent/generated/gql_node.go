@@ -8,18 +8,26 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -28,30 +36,51 @@ type Noder interface {
 	IsNode()
 }
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *APIKey) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *Entitlement) IsNode() {}
 
 // IsNode implements the Node interface check for GQLGen.
 func (n *Group) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *GroupJoinRequest) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *GroupMembership) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *GroupSetting) IsNode() {}
 
 // IsNode implements the Node interface check for GQLGen.
 func (n *Integration) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *Invite) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *OauthClient) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *OauthProvider) IsNode() {}
 
 // IsNode implements the Node interface check for GQLGen.
 func (n *OhAuthTooToken) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *OrgMembership) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *Organization) IsNode() {}
 
 // IsNode implements the Node interface check for GQLGen.
 func (n *OrganizationSetting) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *OwnershipTransfer) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *PersonalAccessToken) IsNode() {}
 
@@ -64,6 +93,9 @@ func (n *User) IsNode() {}
 // IsNode implements the Node interface check for GQLGen.
 func (n *UserSetting) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *WebauthnCredential) IsNode() {}
+
 var errNodeInvalidID = &NotFoundError{"node"}
 
 // NodeOption allows configuring the Noder execution using functional options.
@@ -122,6 +154,18 @@ func (c *Client) Noder(ctx context.Context, id string, opts ...NodeOption) (_ No
 
 func (c *Client) noder(ctx context.Context, table string, id string) (Noder, error) {
 	switch table {
+	case apikey.Table:
+		query := c.APIKey.Query().
+			Where(apikey.ID(id))
+		query, err := query.CollectFields(ctx, "APIKey")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case entitlement.Table:
 		query := c.Entitlement.Query().
 			Where(entitlement.ID(id))
@@ -146,6 +190,30 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case groupjoinrequest.Table:
+		query := c.GroupJoinRequest.Query().
+			Where(groupjoinrequest.ID(id))
+		query, err := query.CollectFields(ctx, "GroupJoinRequest")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case groupmembership.Table:
+		query := c.GroupMembership.Query().
+			Where(groupmembership.ID(id))
+		query, err := query.CollectFields(ctx, "GroupMembership")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case groupsetting.Table:
 		query := c.GroupSetting.Query().
 			Where(groupsetting.ID(id))
@@ -170,6 +238,30 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case invite.Table:
+		query := c.Invite.Query().
+			Where(invite.ID(id))
+		query, err := query.CollectFields(ctx, "Invite")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case oauthclient.Table:
+		query := c.OauthClient.Query().
+			Where(oauthclient.ID(id))
+		query, err := query.CollectFields(ctx, "OauthClient")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case oauthprovider.Table:
 		query := c.OauthProvider.Query().
 			Where(oauthprovider.ID(id))
@@ -194,6 +286,18 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case orgmembership.Table:
+		query := c.OrgMembership.Query().
+			Where(orgmembership.ID(id))
+		query, err := query.CollectFields(ctx, "OrgMembership")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case organization.Table:
 		query := c.Organization.Query().
 			Where(organization.ID(id))
@@ -218,6 +322,18 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case ownershiptransfer.Table:
+		query := c.OwnershipTransfer.Query().
+			Where(ownershiptransfer.ID(id))
+		query, err := query.CollectFields(ctx, "OwnershipTransfer")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case personalaccesstoken.Table:
 		query := c.PersonalAccessToken.Query().
 			Where(personalaccesstoken.ID(id))
@@ -266,6 +382,18 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case webauthncredential.Table:
+		query := c.WebauthnCredential.Query().
+			Where(webauthncredential.ID(id))
+		query, err := query.CollectFields(ctx, "WebauthnCredential")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -339,6 +467,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case apikey.Table:
+		query := c.APIKey.Query().
+			Where(apikey.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "APIKey")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case entitlement.Table:
 		query := c.Entitlement.Query().
 			Where(entitlement.IDIn(ids...))
@@ -371,6 +515,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case groupjoinrequest.Table:
+		query := c.GroupJoinRequest.Query().
+			Where(groupjoinrequest.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "GroupJoinRequest")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case groupmembership.Table:
+		query := c.GroupMembership.Query().
+			Where(groupmembership.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "GroupMembership")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case groupsetting.Table:
 		query := c.GroupSetting.Query().
 			Where(groupsetting.IDIn(ids...))
@@ -403,6 +579,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case invite.Table:
+		query := c.Invite.Query().
+			Where(invite.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Invite")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case oauthclient.Table:
+		query := c.OauthClient.Query().
+			Where(oauthclient.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "OauthClient")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case oauthprovider.Table:
 		query := c.OauthProvider.Query().
 			Where(oauthprovider.IDIn(ids...))
@@ -435,6 +643,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case orgmembership.Table:
+		query := c.OrgMembership.Query().
+			Where(orgmembership.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "OrgMembership")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case organization.Table:
 		query := c.Organization.Query().
 			Where(organization.IDIn(ids...))
@@ -467,6 +691,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case ownershiptransfer.Table:
+		query := c.OwnershipTransfer.Query().
+			Where(ownershiptransfer.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "OwnershipTransfer")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case personalaccesstoken.Table:
 		query := c.PersonalAccessToken.Query().
 			Where(personalaccesstoken.IDIn(ids...))
@@ -531,6 +771,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case webauthncredential.Table:
+		query := c.WebauthnCredential.Query().
+			Where(webauthncredential.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "WebauthnCredential")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -14,18 +14,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -109,6 +117,252 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
+// APIKeyEdge is the edge representation of APIKey.
+type APIKeyEdge struct {
+	Node   *APIKey `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// APIKeyConnection is the connection containing edges to APIKey.
+type APIKeyConnection struct {
+	Edges      []*APIKeyEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *APIKeyConnection) build(nodes []*APIKey, pager *apikeyPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *APIKey
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *APIKey {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *APIKey {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*APIKeyEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &APIKeyEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// APIKeyPaginateOption enables pagination customization.
+type APIKeyPaginateOption func(*apikeyPager) error
+
+// WithAPIKeyOrder configures pagination ordering.
+func WithAPIKeyOrder(order *APIKeyOrder) APIKeyPaginateOption {
+	if order == nil {
+		order = DefaultAPIKeyOrder
+	}
+	o := *order
+	return func(pager *apikeyPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAPIKeyOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAPIKeyFilter configures pagination filter.
+func WithAPIKeyFilter(filter func(*APIKeyQuery) (*APIKeyQuery, error)) APIKeyPaginateOption {
+	return func(pager *apikeyPager) error {
+		if filter == nil {
+			return errors.New("APIKeyQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type apikeyPager struct {
+	reverse bool
+	order   *APIKeyOrder
+	filter  func(*APIKeyQuery) (*APIKeyQuery, error)
+}
+
+func newAPIKeyPager(opts []APIKeyPaginateOption, reverse bool) (*apikeyPager, error) {
+	pager := &apikeyPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAPIKeyOrder
+	}
+	return pager, nil
+}
+
+func (p *apikeyPager) applyFilter(query *APIKeyQuery) (*APIKeyQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *apikeyPager) toCursor(ak *APIKey) Cursor {
+	return p.order.Field.toCursor(ak)
+}
+
+func (p *apikeyPager) applyCursors(query *APIKeyQuery, after, before *Cursor) (*APIKeyQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPIKeyOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *apikeyPager) applyOrder(query *APIKeyQuery) *APIKeyQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAPIKeyOrder.Field {
+		query = query.Order(DefaultAPIKeyOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *apikeyPager) orderExpr(query *APIKeyQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAPIKeyOrder.Field {
+			b.Comma().Ident(DefaultAPIKeyOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to APIKey.
+func (ak *APIKeyQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...APIKeyPaginateOption,
+) (*APIKeyConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAPIKeyPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if ak, err = pager.applyFilter(ak); err != nil {
+		return nil, err
+	}
+	conn := &APIKeyConnection{Edges: []*APIKeyEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = ak.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if ak, err = pager.applyCursors(ak, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		ak.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ak.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	ak = pager.applyOrder(ak)
+	nodes, err := ak.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// APIKeyOrderField defines the ordering field of APIKey.
+type APIKeyOrderField struct {
+	// Value extracts the ordering value from the given APIKey.
+	Value    func(*APIKey) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) apikey.OrderOption
+	toCursor func(*APIKey) Cursor
+}
+
+// APIKeyOrder defines the ordering of APIKey.
+type APIKeyOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *APIKeyOrderField `json:"field"`
+}
+
+// DefaultAPIKeyOrder is the default ordering of APIKey.
+var DefaultAPIKeyOrder = &APIKeyOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &APIKeyOrderField{
+		Value: func(ak *APIKey) (ent.Value, error) {
+			return ak.ID, nil
+		},
+		column: apikey.FieldID,
+		toTerm: apikey.ByID,
+		toCursor: func(ak *APIKey) Cursor {
+			return Cursor{ID: ak.ID}
+		},
+	},
+}
+
+// ToEdge converts APIKey into APIKeyEdge.
+func (ak *APIKey) ToEdge(order *APIKeyOrder) *APIKeyEdge {
+	if order == nil {
+		order = DefaultAPIKeyOrder
+	}
+	return &APIKeyEdge{
+		Node:   ak,
+		Cursor: order.Field.toCursor(ak),
+	}
+}
+
 // EntitlementEdge is the edge representation of Entitlement.
 type EntitlementEdge struct {
 	Node   *Entitlement `json:"node"`
@@ -666,20 +920,20 @@ func (gr *Group) ToEdge(order *GroupOrder) *GroupEdge {
 	}
 }
 
-// GroupSettingEdge is the edge representation of GroupSetting.
-type GroupSettingEdge struct {
-	Node   *GroupSetting `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// GroupJoinRequestEdge is the edge representation of GroupJoinRequest.
+type GroupJoinRequestEdge struct {
+	Node   *GroupJoinRequest `json:"node"`
+	Cursor Cursor            `json:"cursor"`
 }
 
-// GroupSettingConnection is the connection containing edges to GroupSetting.
-type GroupSettingConnection struct {
-	Edges      []*GroupSettingEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// GroupJoinRequestConnection is the connection containing edges to GroupJoinRequest.
+type GroupJoinRequestConnection struct {
+	Edges      []*GroupJoinRequestEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
 }
 
-func (c *GroupSettingConnection) build(nodes []*GroupSetting, pager *groupsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *GroupJoinRequestConnection) build(nodes []*GroupJoinRequest, pager *groupjoinrequestPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -689,21 +943,21 @@ func (c *GroupSettingConnection) build(nodes []*GroupSetting, pager *groupsettin
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *GroupSetting
+	var nodeAt func(int) *GroupJoinRequest
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *GroupSetting {
+		nodeAt = func(i int) *GroupJoinRequest {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *GroupSetting {
+		nodeAt = func(i int) *GroupJoinRequest {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*GroupSettingEdge, len(nodes))
+	c.Edges = make([]*GroupJoinRequestEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &GroupSettingEdge{
+		c.Edges[i] = &GroupJoinRequestEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -717,87 +971,87 @@ func (c *GroupSettingConnection) build(nodes []*GroupSetting, pager *groupsettin
 	}
 }
 
-// GroupSettingPaginateOption enables pagination customization.
-type GroupSettingPaginateOption func(*groupsettingPager) error
+// GroupJoinRequestPaginateOption enables pagination customization.
+type GroupJoinRequestPaginateOption func(*groupjoinrequestPager) error
 
-// WithGroupSettingOrder configures pagination ordering.
-func WithGroupSettingOrder(order *GroupSettingOrder) GroupSettingPaginateOption {
+// WithGroupJoinRequestOrder configures pagination ordering.
+func WithGroupJoinRequestOrder(order *GroupJoinRequestOrder) GroupJoinRequestPaginateOption {
 	if order == nil {
-		order = DefaultGroupSettingOrder
+		order = DefaultGroupJoinRequestOrder
 	}
 	o := *order
-	return func(pager *groupsettingPager) error {
+	return func(pager *groupjoinrequestPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultGroupSettingOrder.Field
+			o.Field = DefaultGroupJoinRequestOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithGroupSettingFilter configures pagination filter.
-func WithGroupSettingFilter(filter func(*GroupSettingQuery) (*GroupSettingQuery, error)) GroupSettingPaginateOption {
-	return func(pager *groupsettingPager) error {
+// WithGroupJoinRequestFilter configures pagination filter.
+func WithGroupJoinRequestFilter(filter func(*GroupJoinRequestQuery) (*GroupJoinRequestQuery, error)) GroupJoinRequestPaginateOption {
+	return func(pager *groupjoinrequestPager) error {
 		if filter == nil {
-			return errors.New("GroupSettingQuery filter cannot be nil")
+			return errors.New("GroupJoinRequestQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type groupsettingPager struct {
+type groupjoinrequestPager struct {
 	reverse bool
-	order   *GroupSettingOrder
-	filter  func(*GroupSettingQuery) (*GroupSettingQuery, error)
+	order   *GroupJoinRequestOrder
+	filter  func(*GroupJoinRequestQuery) (*GroupJoinRequestQuery, error)
 }
 
-func newGroupSettingPager(opts []GroupSettingPaginateOption, reverse bool) (*groupsettingPager, error) {
-	pager := &groupsettingPager{reverse: reverse}
+func newGroupJoinRequestPager(opts []GroupJoinRequestPaginateOption, reverse bool) (*groupjoinrequestPager, error) {
+	pager := &groupjoinrequestPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultGroupSettingOrder
+		pager.order = DefaultGroupJoinRequestOrder
 	}
 	return pager, nil
 }
 
-func (p *groupsettingPager) applyFilter(query *GroupSettingQuery) (*GroupSettingQuery, error) {
+func (p *groupjoinrequestPager) applyFilter(query *GroupJoinRequestQuery) (*GroupJoinRequestQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *groupsettingPager) toCursor(gs *GroupSetting) Cursor {
-	return p.order.Field.toCursor(gs)
+func (p *groupjoinrequestPager) toCursor(gjr *GroupJoinRequest) Cursor {
+	return p.order.Field.toCursor(gjr)
 }
 
-func (p *groupsettingPager) applyCursors(query *GroupSettingQuery, after, before *Cursor) (*GroupSettingQuery, error) {
+func (p *groupjoinrequestPager) applyCursors(query *GroupJoinRequestQuery, after, before *Cursor) (*GroupJoinRequestQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGroupSettingOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGroupJoinRequestOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *groupsettingPager) applyOrder(query *GroupSettingQuery) *GroupSettingQuery {
+func (p *groupjoinrequestPager) applyOrder(query *GroupJoinRequestQuery) *GroupJoinRequestQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultGroupSettingOrder.Field {
-		query = query.Order(DefaultGroupSettingOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGroupJoinRequestOrder.Field {
+		query = query.Order(DefaultGroupJoinRequestOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -805,7 +1059,7 @@ func (p *groupsettingPager) applyOrder(query *GroupSettingQuery) *GroupSettingQu
 	return query
 }
 
-func (p *groupsettingPager) orderExpr(query *GroupSettingQuery) sql.Querier {
+func (p *groupjoinrequestPager) orderExpr(query *GroupJoinRequestQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -815,33 +1069,33 @@ func (p *groupsettingPager) orderExpr(query *GroupSettingQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultGroupSettingOrder.Field {
-			b.Comma().Ident(DefaultGroupSettingOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGroupJoinRequestOrder.Field {
+			b.Comma().Ident(DefaultGroupJoinRequestOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to GroupSetting.
-func (gs *GroupSettingQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to GroupJoinRequest.
+func (gjr *GroupJoinRequestQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...GroupSettingPaginateOption,
-) (*GroupSettingConnection, error) {
+	before *Cursor, last *int, opts ...GroupJoinRequestPaginateOption,
+) (*GroupJoinRequestConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newGroupSettingPager(opts, last != nil)
+	pager, err := newGroupJoinRequestPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if gs, err = pager.applyFilter(gs); err != nil {
+	if gjr, err = pager.applyFilter(gjr); err != nil {
 		return nil, err
 	}
-	conn := &GroupSettingConnection{Edges: []*GroupSettingEdge{}}
+	conn := &GroupJoinRequestConnection{Edges: []*GroupJoinRequestEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = gs.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = gjr.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -851,19 +1105,19 @@ func (gs *GroupSettingQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if gs, err = pager.applyCursors(gs, after, before); err != nil {
+	if gjr, err = pager.applyCursors(gjr, after, before); err != nil {
 		return nil, err
 	}
 	if limit := paginateLimit(first, last); limit != 0 {
-		gs.Limit(limit)
+		gjr.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := gs.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := gjr.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	gs = pager.applyOrder(gs)
-	nodes, err := gs.All(ctx)
+	gjr = pager.applyOrder(gjr)
+	nodes, err := gjr.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -871,61 +1125,553 @@ func (gs *GroupSettingQuery) Paginate(
 	return conn, nil
 }
 
-// GroupSettingOrderField defines the ordering field of GroupSetting.
-type GroupSettingOrderField struct {
-	// Value extracts the ordering value from the given GroupSetting.
-	Value    func(*GroupSetting) (ent.Value, error)
+// GroupJoinRequestOrderField defines the ordering field of GroupJoinRequest.
+type GroupJoinRequestOrderField struct {
+	// Value extracts the ordering value from the given GroupJoinRequest.
+	Value    func(*GroupJoinRequest) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) groupsetting.OrderOption
-	toCursor func(*GroupSetting) Cursor
+	toTerm   func(...sql.OrderTermOption) groupjoinrequest.OrderOption
+	toCursor func(*GroupJoinRequest) Cursor
 }
 
-// GroupSettingOrder defines the ordering of GroupSetting.
-type GroupSettingOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *GroupSettingOrderField `json:"field"`
+// GroupJoinRequestOrder defines the ordering of GroupJoinRequest.
+type GroupJoinRequestOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *GroupJoinRequestOrderField `json:"field"`
 }
 
-// DefaultGroupSettingOrder is the default ordering of GroupSetting.
-var DefaultGroupSettingOrder = &GroupSettingOrder{
+// DefaultGroupJoinRequestOrder is the default ordering of GroupJoinRequest.
+var DefaultGroupJoinRequestOrder = &GroupJoinRequestOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &GroupSettingOrderField{
-		Value: func(gs *GroupSetting) (ent.Value, error) {
-			return gs.ID, nil
+	Field: &GroupJoinRequestOrderField{
+		Value: func(gjr *GroupJoinRequest) (ent.Value, error) {
+			return gjr.ID, nil
 		},
-		column: groupsetting.FieldID,
-		toTerm: groupsetting.ByID,
-		toCursor: func(gs *GroupSetting) Cursor {
-			return Cursor{ID: gs.ID}
+		column: groupjoinrequest.FieldID,
+		toTerm: groupjoinrequest.ByID,
+		toCursor: func(gjr *GroupJoinRequest) Cursor {
+			return Cursor{ID: gjr.ID}
 		},
 	},
 }
 
-// ToEdge converts GroupSetting into GroupSettingEdge.
-func (gs *GroupSetting) ToEdge(order *GroupSettingOrder) *GroupSettingEdge {
+// ToEdge converts GroupJoinRequest into GroupJoinRequestEdge.
+func (gjr *GroupJoinRequest) ToEdge(order *GroupJoinRequestOrder) *GroupJoinRequestEdge {
 	if order == nil {
-		order = DefaultGroupSettingOrder
+		order = DefaultGroupJoinRequestOrder
 	}
-	return &GroupSettingEdge{
-		Node:   gs,
-		Cursor: order.Field.toCursor(gs),
+	return &GroupJoinRequestEdge{
+		Node:   gjr,
+		Cursor: order.Field.toCursor(gjr),
 	}
 }
 
-// IntegrationEdge is the edge representation of Integration.
-type IntegrationEdge struct {
-	Node   *Integration `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// GroupMembershipEdge is the edge representation of GroupMembership.
+type GroupMembershipEdge struct {
+	Node   *GroupMembership `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// IntegrationConnection is the connection containing edges to Integration.
-type IntegrationConnection struct {
-	Edges      []*IntegrationEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// GroupMembershipConnection is the connection containing edges to GroupMembership.
+type GroupMembershipConnection struct {
+	Edges      []*GroupMembershipEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *IntegrationConnection) build(nodes []*Integration, pager *integrationPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *GroupMembershipConnection) build(nodes []*GroupMembership, pager *groupmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *GroupMembership
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *GroupMembership {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *GroupMembership {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*GroupMembershipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &GroupMembershipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// GroupMembershipPaginateOption enables pagination customization.
+type GroupMembershipPaginateOption func(*groupmembershipPager) error
+
+// WithGroupMembershipOrder configures pagination ordering.
+func WithGroupMembershipOrder(order *GroupMembershipOrder) GroupMembershipPaginateOption {
+	if order == nil {
+		order = DefaultGroupMembershipOrder
+	}
+	o := *order
+	return func(pager *groupmembershipPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultGroupMembershipOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithGroupMembershipFilter configures pagination filter.
+func WithGroupMembershipFilter(filter func(*GroupMembershipQuery) (*GroupMembershipQuery, error)) GroupMembershipPaginateOption {
+	return func(pager *groupmembershipPager) error {
+		if filter == nil {
+			return errors.New("GroupMembershipQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type groupmembershipPager struct {
+	reverse bool
+	order   *GroupMembershipOrder
+	filter  func(*GroupMembershipQuery) (*GroupMembershipQuery, error)
+}
+
+func newGroupMembershipPager(opts []GroupMembershipPaginateOption, reverse bool) (*groupmembershipPager, error) {
+	pager := &groupmembershipPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultGroupMembershipOrder
+	}
+	return pager, nil
+}
+
+func (p *groupmembershipPager) applyFilter(query *GroupMembershipQuery) (*GroupMembershipQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *groupmembershipPager) toCursor(gm *GroupMembership) Cursor {
+	return p.order.Field.toCursor(gm)
+}
+
+func (p *groupmembershipPager) applyCursors(query *GroupMembershipQuery, after, before *Cursor) (*GroupMembershipQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGroupMembershipOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *groupmembershipPager) applyOrder(query *GroupMembershipQuery) *GroupMembershipQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGroupMembershipOrder.Field {
+		query = query.Order(DefaultGroupMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *groupmembershipPager) orderExpr(query *GroupMembershipQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGroupMembershipOrder.Field {
+			b.Comma().Ident(DefaultGroupMembershipOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to GroupMembership.
+func (gm *GroupMembershipQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...GroupMembershipPaginateOption,
+) (*GroupMembershipConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newGroupMembershipPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if gm, err = pager.applyFilter(gm); err != nil {
+		return nil, err
+	}
+	conn := &GroupMembershipConnection{Edges: []*GroupMembershipEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = gm.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if gm, err = pager.applyCursors(gm, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		gm.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := gm.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	gm = pager.applyOrder(gm)
+	nodes, err := gm.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// GroupMembershipOrderField defines the ordering field of GroupMembership.
+type GroupMembershipOrderField struct {
+	// Value extracts the ordering value from the given GroupMembership.
+	Value    func(*GroupMembership) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) groupmembership.OrderOption
+	toCursor func(*GroupMembership) Cursor
+}
+
+// GroupMembershipOrder defines the ordering of GroupMembership.
+type GroupMembershipOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *GroupMembershipOrderField `json:"field"`
+}
+
+// DefaultGroupMembershipOrder is the default ordering of GroupMembership.
+var DefaultGroupMembershipOrder = &GroupMembershipOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &GroupMembershipOrderField{
+		Value: func(gm *GroupMembership) (ent.Value, error) {
+			return gm.ID, nil
+		},
+		column: groupmembership.FieldID,
+		toTerm: groupmembership.ByID,
+		toCursor: func(gm *GroupMembership) Cursor {
+			return Cursor{ID: gm.ID}
+		},
+	},
+}
+
+// ToEdge converts GroupMembership into GroupMembershipEdge.
+func (gm *GroupMembership) ToEdge(order *GroupMembershipOrder) *GroupMembershipEdge {
+	if order == nil {
+		order = DefaultGroupMembershipOrder
+	}
+	return &GroupMembershipEdge{
+		Node:   gm,
+		Cursor: order.Field.toCursor(gm),
+	}
+}
+
+// GroupSettingEdge is the edge representation of GroupSetting.
+type GroupSettingEdge struct {
+	Node   *GroupSetting `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// GroupSettingConnection is the connection containing edges to GroupSetting.
+type GroupSettingConnection struct {
+	Edges      []*GroupSettingEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *GroupSettingConnection) build(nodes []*GroupSetting, pager *groupsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *GroupSetting
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *GroupSetting {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *GroupSetting {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*GroupSettingEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &GroupSettingEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// GroupSettingPaginateOption enables pagination customization.
+type GroupSettingPaginateOption func(*groupsettingPager) error
+
+// WithGroupSettingOrder configures pagination ordering.
+func WithGroupSettingOrder(order *GroupSettingOrder) GroupSettingPaginateOption {
+	if order == nil {
+		order = DefaultGroupSettingOrder
+	}
+	o := *order
+	return func(pager *groupsettingPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultGroupSettingOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithGroupSettingFilter configures pagination filter.
+func WithGroupSettingFilter(filter func(*GroupSettingQuery) (*GroupSettingQuery, error)) GroupSettingPaginateOption {
+	return func(pager *groupsettingPager) error {
+		if filter == nil {
+			return errors.New("GroupSettingQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type groupsettingPager struct {
+	reverse bool
+	order   *GroupSettingOrder
+	filter  func(*GroupSettingQuery) (*GroupSettingQuery, error)
+}
+
+func newGroupSettingPager(opts []GroupSettingPaginateOption, reverse bool) (*groupsettingPager, error) {
+	pager := &groupsettingPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultGroupSettingOrder
+	}
+	return pager, nil
+}
+
+func (p *groupsettingPager) applyFilter(query *GroupSettingQuery) (*GroupSettingQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *groupsettingPager) toCursor(gs *GroupSetting) Cursor {
+	return p.order.Field.toCursor(gs)
+}
+
+func (p *groupsettingPager) applyCursors(query *GroupSettingQuery, after, before *Cursor) (*GroupSettingQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGroupSettingOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *groupsettingPager) applyOrder(query *GroupSettingQuery) *GroupSettingQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGroupSettingOrder.Field {
+		query = query.Order(DefaultGroupSettingOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *groupsettingPager) orderExpr(query *GroupSettingQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGroupSettingOrder.Field {
+			b.Comma().Ident(DefaultGroupSettingOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to GroupSetting.
+func (gs *GroupSettingQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...GroupSettingPaginateOption,
+) (*GroupSettingConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newGroupSettingPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if gs, err = pager.applyFilter(gs); err != nil {
+		return nil, err
+	}
+	conn := &GroupSettingConnection{Edges: []*GroupSettingEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = gs.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if gs, err = pager.applyCursors(gs, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		gs.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := gs.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	gs = pager.applyOrder(gs)
+	nodes, err := gs.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// GroupSettingOrderField defines the ordering field of GroupSetting.
+type GroupSettingOrderField struct {
+	// Value extracts the ordering value from the given GroupSetting.
+	Value    func(*GroupSetting) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) groupsetting.OrderOption
+	toCursor func(*GroupSetting) Cursor
+}
+
+// GroupSettingOrder defines the ordering of GroupSetting.
+type GroupSettingOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *GroupSettingOrderField `json:"field"`
+}
+
+// DefaultGroupSettingOrder is the default ordering of GroupSetting.
+var DefaultGroupSettingOrder = &GroupSettingOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &GroupSettingOrderField{
+		Value: func(gs *GroupSetting) (ent.Value, error) {
+			return gs.ID, nil
+		},
+		column: groupsetting.FieldID,
+		toTerm: groupsetting.ByID,
+		toCursor: func(gs *GroupSetting) Cursor {
+			return Cursor{ID: gs.ID}
+		},
+	},
+}
+
+// ToEdge converts GroupSetting into GroupSettingEdge.
+func (gs *GroupSetting) ToEdge(order *GroupSettingOrder) *GroupSettingEdge {
+	if order == nil {
+		order = DefaultGroupSettingOrder
+	}
+	return &GroupSettingEdge{
+		Node:   gs,
+		Cursor: order.Field.toCursor(gs),
+	}
+}
+
+// IntegrationEdge is the edge representation of Integration.
+type IntegrationEdge struct {
+	Node   *Integration `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// IntegrationConnection is the connection containing edges to Integration.
+type IntegrationConnection struct {
+	Edges      []*IntegrationEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *IntegrationConnection) build(nodes []*Integration, pager *integrationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -938,18 +1684,329 @@ func (c *IntegrationConnection) build(nodes []*Integration, pager *integrationPa
 	var nodeAt func(int) *Integration
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Integration {
+		nodeAt = func(i int) *Integration {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Integration {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*IntegrationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &IntegrationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// IntegrationPaginateOption enables pagination customization.
+type IntegrationPaginateOption func(*integrationPager) error
+
+// WithIntegrationOrder configures pagination ordering.
+func WithIntegrationOrder(order *IntegrationOrder) IntegrationPaginateOption {
+	if order == nil {
+		order = DefaultIntegrationOrder
+	}
+	o := *order
+	return func(pager *integrationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultIntegrationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithIntegrationFilter configures pagination filter.
+func WithIntegrationFilter(filter func(*IntegrationQuery) (*IntegrationQuery, error)) IntegrationPaginateOption {
+	return func(pager *integrationPager) error {
+		if filter == nil {
+			return errors.New("IntegrationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type integrationPager struct {
+	reverse bool
+	order   *IntegrationOrder
+	filter  func(*IntegrationQuery) (*IntegrationQuery, error)
+}
+
+func newIntegrationPager(opts []IntegrationPaginateOption, reverse bool) (*integrationPager, error) {
+	pager := &integrationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultIntegrationOrder
+	}
+	return pager, nil
+}
+
+func (p *integrationPager) applyFilter(query *IntegrationQuery) (*IntegrationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *integrationPager) toCursor(i *Integration) Cursor {
+	return p.order.Field.toCursor(i)
+}
+
+func (p *integrationPager) applyCursors(query *IntegrationQuery, after, before *Cursor) (*IntegrationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultIntegrationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *integrationPager) applyOrder(query *IntegrationQuery) *IntegrationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultIntegrationOrder.Field {
+		query = query.Order(DefaultIntegrationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *integrationPager) orderExpr(query *IntegrationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultIntegrationOrder.Field {
+			b.Comma().Ident(DefaultIntegrationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Integration.
+func (i *IntegrationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...IntegrationPaginateOption,
+) (*IntegrationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newIntegrationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if i, err = pager.applyFilter(i); err != nil {
+		return nil, err
+	}
+	conn := &IntegrationConnection{Edges: []*IntegrationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = i.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if i, err = pager.applyCursors(i, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		i.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := i.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	i = pager.applyOrder(i)
+	nodes, err := i.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// IntegrationOrderFieldName orders Integration by name.
+	IntegrationOrderFieldName = &IntegrationOrderField{
+		Value: func(i *Integration) (ent.Value, error) {
+			return i.Name, nil
+		},
+		column: integration.FieldName,
+		toTerm: integration.ByName,
+		toCursor: func(i *Integration) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.Name,
+			}
+		},
+	}
+	// IntegrationOrderFieldKind orders Integration by kind.
+	IntegrationOrderFieldKind = &IntegrationOrderField{
+		Value: func(i *Integration) (ent.Value, error) {
+			return i.Kind, nil
+		},
+		column: integration.FieldKind,
+		toTerm: integration.ByKind,
+		toCursor: func(i *Integration) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.Kind,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f IntegrationOrderField) String() string {
+	var str string
+	switch f.column {
+	case IntegrationOrderFieldName.column:
+		str = "name"
+	case IntegrationOrderFieldKind.column:
+		str = "kind"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f IntegrationOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *IntegrationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("IntegrationOrderField %T must be a string", v)
+	}
+	switch str {
+	case "name":
+		*f = *IntegrationOrderFieldName
+	case "kind":
+		*f = *IntegrationOrderFieldKind
+	default:
+		return fmt.Errorf("%s is not a valid IntegrationOrderField", str)
+	}
+	return nil
+}
+
+// IntegrationOrderField defines the ordering field of Integration.
+type IntegrationOrderField struct {
+	// Value extracts the ordering value from the given Integration.
+	Value    func(*Integration) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) integration.OrderOption
+	toCursor func(*Integration) Cursor
+}
+
+// IntegrationOrder defines the ordering of Integration.
+type IntegrationOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *IntegrationOrderField `json:"field"`
+}
+
+// DefaultIntegrationOrder is the default ordering of Integration.
+var DefaultIntegrationOrder = &IntegrationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &IntegrationOrderField{
+		Value: func(i *Integration) (ent.Value, error) {
+			return i.ID, nil
+		},
+		column: integration.FieldID,
+		toTerm: integration.ByID,
+		toCursor: func(i *Integration) Cursor {
+			return Cursor{ID: i.ID}
+		},
+	},
+}
+
+// ToEdge converts Integration into IntegrationEdge.
+func (i *Integration) ToEdge(order *IntegrationOrder) *IntegrationEdge {
+	if order == nil {
+		order = DefaultIntegrationOrder
+	}
+	return &IntegrationEdge{
+		Node:   i,
+		Cursor: order.Field.toCursor(i),
+	}
+}
+
+// InviteEdge is the edge representation of Invite.
+type InviteEdge struct {
+	Node   *Invite `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// InviteConnection is the connection containing edges to Invite.
+type InviteConnection struct {
+	Edges      []*InviteEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *InviteConnection) build(nodes []*Invite, pager *invitePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Invite
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Invite {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Integration {
+		nodeAt = func(i int) *Invite {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*IntegrationEdge, len(nodes))
+	c.Edges = make([]*InviteEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &IntegrationEdge{
+		c.Edges[i] = &InviteEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -963,87 +2020,87 @@ func (c *IntegrationConnection) build(nodes []*Integration, pager *integrationPa
 	}
 }
 
-// IntegrationPaginateOption enables pagination customization.
-type IntegrationPaginateOption func(*integrationPager) error
+// InvitePaginateOption enables pagination customization.
+type InvitePaginateOption func(*invitePager) error
 
-// WithIntegrationOrder configures pagination ordering.
-func WithIntegrationOrder(order *IntegrationOrder) IntegrationPaginateOption {
+// WithInviteOrder configures pagination ordering.
+func WithInviteOrder(order *InviteOrder) InvitePaginateOption {
 	if order == nil {
-		order = DefaultIntegrationOrder
+		order = DefaultInviteOrder
 	}
 	o := *order
-	return func(pager *integrationPager) error {
+	return func(pager *invitePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultIntegrationOrder.Field
+			o.Field = DefaultInviteOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithIntegrationFilter configures pagination filter.
-func WithIntegrationFilter(filter func(*IntegrationQuery) (*IntegrationQuery, error)) IntegrationPaginateOption {
-	return func(pager *integrationPager) error {
+// WithInviteFilter configures pagination filter.
+func WithInviteFilter(filter func(*InviteQuery) (*InviteQuery, error)) InvitePaginateOption {
+	return func(pager *invitePager) error {
 		if filter == nil {
-			return errors.New("IntegrationQuery filter cannot be nil")
+			return errors.New("InviteQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type integrationPager struct {
+type invitePager struct {
 	reverse bool
-	order   *IntegrationOrder
-	filter  func(*IntegrationQuery) (*IntegrationQuery, error)
+	order   *InviteOrder
+	filter  func(*InviteQuery) (*InviteQuery, error)
 }
 
-func newIntegrationPager(opts []IntegrationPaginateOption, reverse bool) (*integrationPager, error) {
-	pager := &integrationPager{reverse: reverse}
+func newInvitePager(opts []InvitePaginateOption, reverse bool) (*invitePager, error) {
+	pager := &invitePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultIntegrationOrder
+		pager.order = DefaultInviteOrder
 	}
 	return pager, nil
 }
 
-func (p *integrationPager) applyFilter(query *IntegrationQuery) (*IntegrationQuery, error) {
+func (p *invitePager) applyFilter(query *InviteQuery) (*InviteQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *integrationPager) toCursor(i *Integration) Cursor {
+func (p *invitePager) toCursor(i *Invite) Cursor {
 	return p.order.Field.toCursor(i)
 }
 
-func (p *integrationPager) applyCursors(query *IntegrationQuery, after, before *Cursor) (*IntegrationQuery, error) {
+func (p *invitePager) applyCursors(query *InviteQuery, after, before *Cursor) (*InviteQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultIntegrationOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultInviteOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *integrationPager) applyOrder(query *IntegrationQuery) *IntegrationQuery {
+func (p *invitePager) applyOrder(query *InviteQuery) *InviteQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultIntegrationOrder.Field {
-		query = query.Order(DefaultIntegrationOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultInviteOrder.Field {
+		query = query.Order(DefaultInviteOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1051,7 +2108,7 @@ func (p *integrationPager) applyOrder(query *IntegrationQuery) *IntegrationQuery
 	return query
 }
 
-func (p *integrationPager) orderExpr(query *IntegrationQuery) sql.Querier {
+func (p *invitePager) orderExpr(query *InviteQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1061,28 +2118,28 @@ func (p *integrationPager) orderExpr(query *IntegrationQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultIntegrationOrder.Field {
-			b.Comma().Ident(DefaultIntegrationOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultInviteOrder.Field {
+			b.Comma().Ident(DefaultInviteOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Integration.
-func (i *IntegrationQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Invite.
+func (i *InviteQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...IntegrationPaginateOption,
-) (*IntegrationConnection, error) {
+	before *Cursor, last *int, opts ...InvitePaginateOption,
+) (*InviteConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newIntegrationPager(opts, last != nil)
+	pager, err := newInvitePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if i, err = pager.applyFilter(i); err != nil {
 		return nil, err
 	}
-	conn := &IntegrationConnection{Edges: []*IntegrationEdge{}}
+	conn := &InviteConnection{Edges: []*InviteEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1117,109 +2174,290 @@ func (i *IntegrationQuery) Paginate(
 	return conn, nil
 }
 
-var (
-	// IntegrationOrderFieldName orders Integration by name.
-	IntegrationOrderFieldName = &IntegrationOrderField{
-		Value: func(i *Integration) (ent.Value, error) {
-			return i.Name, nil
+// InviteOrderField defines the ordering field of Invite.
+type InviteOrderField struct {
+	// Value extracts the ordering value from the given Invite.
+	Value    func(*Invite) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) invite.OrderOption
+	toCursor func(*Invite) Cursor
+}
+
+// InviteOrder defines the ordering of Invite.
+type InviteOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *InviteOrderField `json:"field"`
+}
+
+// DefaultInviteOrder is the default ordering of Invite.
+var DefaultInviteOrder = &InviteOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &InviteOrderField{
+		Value: func(i *Invite) (ent.Value, error) {
+			return i.ID, nil
 		},
-		column: integration.FieldName,
-		toTerm: integration.ByName,
-		toCursor: func(i *Integration) Cursor {
-			return Cursor{
-				ID:    i.ID,
-				Value: i.Name,
-			}
+		column: invite.FieldID,
+		toTerm: invite.ByID,
+		toCursor: func(i *Invite) Cursor {
+			return Cursor{ID: i.ID}
 		},
+	},
+}
+
+// ToEdge converts Invite into InviteEdge.
+func (i *Invite) ToEdge(order *InviteOrder) *InviteEdge {
+	if order == nil {
+		order = DefaultInviteOrder
 	}
-	// IntegrationOrderFieldKind orders Integration by kind.
-	IntegrationOrderFieldKind = &IntegrationOrderField{
-		Value: func(i *Integration) (ent.Value, error) {
-			return i.Kind, nil
-		},
-		column: integration.FieldKind,
-		toTerm: integration.ByKind,
-		toCursor: func(i *Integration) Cursor {
-			return Cursor{
-				ID:    i.ID,
-				Value: i.Kind,
+	return &InviteEdge{
+		Node:   i,
+		Cursor: order.Field.toCursor(i),
+	}
+}
+
+// OauthClientEdge is the edge representation of OauthClient.
+type OauthClientEdge struct {
+	Node   *OauthClient `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// OauthClientConnection is the connection containing edges to OauthClient.
+type OauthClientConnection struct {
+	Edges      []*OauthClientEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *OauthClientConnection) build(nodes []*OauthClient, pager *oauthclientPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *OauthClient
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *OauthClient {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *OauthClient {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*OauthClientEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &OauthClientEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// OauthClientPaginateOption enables pagination customization.
+type OauthClientPaginateOption func(*oauthclientPager) error
+
+// WithOauthClientOrder configures pagination ordering.
+func WithOauthClientOrder(order *OauthClientOrder) OauthClientPaginateOption {
+	if order == nil {
+		order = DefaultOauthClientOrder
+	}
+	o := *order
+	return func(pager *oauthclientPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultOauthClientOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithOauthClientFilter configures pagination filter.
+func WithOauthClientFilter(filter func(*OauthClientQuery) (*OauthClientQuery, error)) OauthClientPaginateOption {
+	return func(pager *oauthclientPager) error {
+		if filter == nil {
+			return errors.New("OauthClientQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type oauthclientPager struct {
+	reverse bool
+	order   *OauthClientOrder
+	filter  func(*OauthClientQuery) (*OauthClientQuery, error)
+}
+
+func newOauthClientPager(opts []OauthClientPaginateOption, reverse bool) (*oauthclientPager, error) {
+	pager := &oauthclientPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultOauthClientOrder
+	}
+	return pager, nil
+}
+
+func (p *oauthclientPager) applyFilter(query *OauthClientQuery) (*OauthClientQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *oauthclientPager) toCursor(oc *OauthClient) Cursor {
+	return p.order.Field.toCursor(oc)
+}
+
+func (p *oauthclientPager) applyCursors(query *OauthClientQuery, after, before *Cursor) (*OauthClientQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOauthClientOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *oauthclientPager) applyOrder(query *OauthClientQuery) *OauthClientQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOauthClientOrder.Field {
+		query = query.Order(DefaultOauthClientOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *oauthclientPager) orderExpr(query *OauthClientQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOauthClientOrder.Field {
+			b.Comma().Ident(DefaultOauthClientOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to OauthClient.
+func (oc *OauthClientQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...OauthClientPaginateOption,
+) (*OauthClientConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newOauthClientPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if oc, err = pager.applyFilter(oc); err != nil {
+		return nil, err
+	}
+	conn := &OauthClientConnection{Edges: []*OauthClientEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = oc.Clone().Count(ctx); err != nil {
+				return nil, err
 			}
-		},
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
 	}
-)
-
-// String implement fmt.Stringer interface.
-func (f IntegrationOrderField) String() string {
-	var str string
-	switch f.column {
-	case IntegrationOrderFieldName.column:
-		str = "name"
-	case IntegrationOrderFieldKind.column:
-		str = "kind"
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
 	}
-	return str
-}
-
-// MarshalGQL implements graphql.Marshaler interface.
-func (f IntegrationOrderField) MarshalGQL(w io.Writer) {
-	io.WriteString(w, strconv.Quote(f.String()))
-}
-
-// UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *IntegrationOrderField) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("IntegrationOrderField %T must be a string", v)
+	if oc, err = pager.applyCursors(oc, after, before); err != nil {
+		return nil, err
 	}
-	switch str {
-	case "name":
-		*f = *IntegrationOrderFieldName
-	case "kind":
-		*f = *IntegrationOrderFieldKind
-	default:
-		return fmt.Errorf("%s is not a valid IntegrationOrderField", str)
+	if limit := paginateLimit(first, last); limit != 0 {
+		oc.Limit(limit)
 	}
-	return nil
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := oc.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	oc = pager.applyOrder(oc)
+	nodes, err := oc.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
 }
 
-// IntegrationOrderField defines the ordering field of Integration.
-type IntegrationOrderField struct {
-	// Value extracts the ordering value from the given Integration.
-	Value    func(*Integration) (ent.Value, error)
+// OauthClientOrderField defines the ordering field of OauthClient.
+type OauthClientOrderField struct {
+	// Value extracts the ordering value from the given OauthClient.
+	Value    func(*OauthClient) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) integration.OrderOption
-	toCursor func(*Integration) Cursor
+	toTerm   func(...sql.OrderTermOption) oauthclient.OrderOption
+	toCursor func(*OauthClient) Cursor
 }
 
-// IntegrationOrder defines the ordering of Integration.
-type IntegrationOrder struct {
+// OauthClientOrder defines the ordering of OauthClient.
+type OauthClientOrder struct {
 	Direction OrderDirection         `json:"direction"`
-	Field     *IntegrationOrderField `json:"field"`
+	Field     *OauthClientOrderField `json:"field"`
 }
 
-// DefaultIntegrationOrder is the default ordering of Integration.
-var DefaultIntegrationOrder = &IntegrationOrder{
+// DefaultOauthClientOrder is the default ordering of OauthClient.
+var DefaultOauthClientOrder = &OauthClientOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &IntegrationOrderField{
-		Value: func(i *Integration) (ent.Value, error) {
-			return i.ID, nil
+	Field: &OauthClientOrderField{
+		Value: func(oc *OauthClient) (ent.Value, error) {
+			return oc.ID, nil
 		},
-		column: integration.FieldID,
-		toTerm: integration.ByID,
-		toCursor: func(i *Integration) Cursor {
-			return Cursor{ID: i.ID}
+		column: oauthclient.FieldID,
+		toTerm: oauthclient.ByID,
+		toCursor: func(oc *OauthClient) Cursor {
+			return Cursor{ID: oc.ID}
 		},
 	},
 }
 
-// ToEdge converts Integration into IntegrationEdge.
-func (i *Integration) ToEdge(order *IntegrationOrder) *IntegrationEdge {
+// ToEdge converts OauthClient into OauthClientEdge.
+func (oc *OauthClient) ToEdge(order *OauthClientOrder) *OauthClientEdge {
 	if order == nil {
-		order = DefaultIntegrationOrder
+		order = DefaultOauthClientOrder
 	}
-	return &IntegrationEdge{
-		Node:   i,
-		Cursor: order.Field.toCursor(i),
+	return &OauthClientEdge{
+		Node:   oc,
+		Cursor: order.Field.toCursor(oc),
 	}
 }
 
@@ -1696,22 +2934,268 @@ var DefaultOhAuthTooTokenOrder = &OhAuthTooTokenOrder{
 		Value: func(oatt *OhAuthTooToken) (ent.Value, error) {
 			return oatt.ID, nil
 		},
-		column: ohauthtootoken.FieldID,
-		toTerm: ohauthtootoken.ByID,
-		toCursor: func(oatt *OhAuthTooToken) Cursor {
-			return Cursor{ID: oatt.ID}
+		column: ohauthtootoken.FieldID,
+		toTerm: ohauthtootoken.ByID,
+		toCursor: func(oatt *OhAuthTooToken) Cursor {
+			return Cursor{ID: oatt.ID}
+		},
+	},
+}
+
+// ToEdge converts OhAuthTooToken into OhAuthTooTokenEdge.
+func (oatt *OhAuthTooToken) ToEdge(order *OhAuthTooTokenOrder) *OhAuthTooTokenEdge {
+	if order == nil {
+		order = DefaultOhAuthTooTokenOrder
+	}
+	return &OhAuthTooTokenEdge{
+		Node:   oatt,
+		Cursor: order.Field.toCursor(oatt),
+	}
+}
+
+// OrgMembershipEdge is the edge representation of OrgMembership.
+type OrgMembershipEdge struct {
+	Node   *OrgMembership `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// OrgMembershipConnection is the connection containing edges to OrgMembership.
+type OrgMembershipConnection struct {
+	Edges      []*OrgMembershipEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *OrgMembershipConnection) build(nodes []*OrgMembership, pager *orgmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *OrgMembership
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *OrgMembership {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *OrgMembership {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*OrgMembershipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &OrgMembershipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// OrgMembershipPaginateOption enables pagination customization.
+type OrgMembershipPaginateOption func(*orgmembershipPager) error
+
+// WithOrgMembershipOrder configures pagination ordering.
+func WithOrgMembershipOrder(order *OrgMembershipOrder) OrgMembershipPaginateOption {
+	if order == nil {
+		order = DefaultOrgMembershipOrder
+	}
+	o := *order
+	return func(pager *orgmembershipPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultOrgMembershipOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithOrgMembershipFilter configures pagination filter.
+func WithOrgMembershipFilter(filter func(*OrgMembershipQuery) (*OrgMembershipQuery, error)) OrgMembershipPaginateOption {
+	return func(pager *orgmembershipPager) error {
+		if filter == nil {
+			return errors.New("OrgMembershipQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type orgmembershipPager struct {
+	reverse bool
+	order   *OrgMembershipOrder
+	filter  func(*OrgMembershipQuery) (*OrgMembershipQuery, error)
+}
+
+func newOrgMembershipPager(opts []OrgMembershipPaginateOption, reverse bool) (*orgmembershipPager, error) {
+	pager := &orgmembershipPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultOrgMembershipOrder
+	}
+	return pager, nil
+}
+
+func (p *orgmembershipPager) applyFilter(query *OrgMembershipQuery) (*OrgMembershipQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *orgmembershipPager) toCursor(om *OrgMembership) Cursor {
+	return p.order.Field.toCursor(om)
+}
+
+func (p *orgmembershipPager) applyCursors(query *OrgMembershipQuery, after, before *Cursor) (*OrgMembershipQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrgMembershipOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *orgmembershipPager) applyOrder(query *OrgMembershipQuery) *OrgMembershipQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOrgMembershipOrder.Field {
+		query = query.Order(DefaultOrgMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *orgmembershipPager) orderExpr(query *OrgMembershipQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOrgMembershipOrder.Field {
+			b.Comma().Ident(DefaultOrgMembershipOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to OrgMembership.
+func (om *OrgMembershipQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...OrgMembershipPaginateOption,
+) (*OrgMembershipConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newOrgMembershipPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if om, err = pager.applyFilter(om); err != nil {
+		return nil, err
+	}
+	conn := &OrgMembershipConnection{Edges: []*OrgMembershipEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = om.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if om, err = pager.applyCursors(om, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		om.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := om.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	om = pager.applyOrder(om)
+	nodes, err := om.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// OrgMembershipOrderField defines the ordering field of OrgMembership.
+type OrgMembershipOrderField struct {
+	// Value extracts the ordering value from the given OrgMembership.
+	Value    func(*OrgMembership) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) orgmembership.OrderOption
+	toCursor func(*OrgMembership) Cursor
+}
+
+// OrgMembershipOrder defines the ordering of OrgMembership.
+type OrgMembershipOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *OrgMembershipOrderField `json:"field"`
+}
+
+// DefaultOrgMembershipOrder is the default ordering of OrgMembership.
+var DefaultOrgMembershipOrder = &OrgMembershipOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &OrgMembershipOrderField{
+		Value: func(om *OrgMembership) (ent.Value, error) {
+			return om.ID, nil
+		},
+		column: orgmembership.FieldID,
+		toTerm: orgmembership.ByID,
+		toCursor: func(om *OrgMembership) Cursor {
+			return Cursor{ID: om.ID}
 		},
 	},
 }
 
-// ToEdge converts OhAuthTooToken into OhAuthTooTokenEdge.
-func (oatt *OhAuthTooToken) ToEdge(order *OhAuthTooTokenOrder) *OhAuthTooTokenEdge {
+// ToEdge converts OrgMembership into OrgMembershipEdge.
+func (om *OrgMembership) ToEdge(order *OrgMembershipOrder) *OrgMembershipEdge {
 	if order == nil {
-		order = DefaultOhAuthTooTokenOrder
+		order = DefaultOrgMembershipOrder
 	}
-	return &OhAuthTooTokenEdge{
-		Node:   oatt,
-		Cursor: order.Field.toCursor(oatt),
+	return &OrgMembershipEdge{
+		Node:   om,
+		Cursor: order.Field.toCursor(om),
 	}
 }
 
@@ -2018,28 +3502,274 @@ var DefaultOrganizationOrder = &OrganizationOrder{
 // ToEdge converts Organization into OrganizationEdge.
 func (o *Organization) ToEdge(order *OrganizationOrder) *OrganizationEdge {
 	if order == nil {
-		order = DefaultOrganizationOrder
+		order = DefaultOrganizationOrder
+	}
+	return &OrganizationEdge{
+		Node:   o,
+		Cursor: order.Field.toCursor(o),
+	}
+}
+
+// OrganizationSettingEdge is the edge representation of OrganizationSetting.
+type OrganizationSettingEdge struct {
+	Node   *OrganizationSetting `json:"node"`
+	Cursor Cursor               `json:"cursor"`
+}
+
+// OrganizationSettingConnection is the connection containing edges to OrganizationSetting.
+type OrganizationSettingConnection struct {
+	Edges      []*OrganizationSettingEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
+}
+
+func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, pager *organizationsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *OrganizationSetting
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *OrganizationSetting {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *OrganizationSetting {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*OrganizationSettingEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &OrganizationSettingEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// OrganizationSettingPaginateOption enables pagination customization.
+type OrganizationSettingPaginateOption func(*organizationsettingPager) error
+
+// WithOrganizationSettingOrder configures pagination ordering.
+func WithOrganizationSettingOrder(order *OrganizationSettingOrder) OrganizationSettingPaginateOption {
+	if order == nil {
+		order = DefaultOrganizationSettingOrder
+	}
+	o := *order
+	return func(pager *organizationsettingPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultOrganizationSettingOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithOrganizationSettingFilter configures pagination filter.
+func WithOrganizationSettingFilter(filter func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)) OrganizationSettingPaginateOption {
+	return func(pager *organizationsettingPager) error {
+		if filter == nil {
+			return errors.New("OrganizationSettingQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type organizationsettingPager struct {
+	reverse bool
+	order   *OrganizationSettingOrder
+	filter  func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)
+}
+
+func newOrganizationSettingPager(opts []OrganizationSettingPaginateOption, reverse bool) (*organizationsettingPager, error) {
+	pager := &organizationsettingPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultOrganizationSettingOrder
+	}
+	return pager, nil
+}
+
+func (p *organizationsettingPager) applyFilter(query *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *organizationsettingPager) toCursor(os *OrganizationSetting) Cursor {
+	return p.order.Field.toCursor(os)
+}
+
+func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery, after, before *Cursor) (*OrganizationSettingQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrganizationSettingOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *OrganizationSettingQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOrganizationSettingOrder.Field {
+		query = query.Order(DefaultOrganizationSettingOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOrganizationSettingOrder.Field {
+			b.Comma().Ident(DefaultOrganizationSettingOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to OrganizationSetting.
+func (os *OrganizationSettingQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...OrganizationSettingPaginateOption,
+) (*OrganizationSettingConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newOrganizationSettingPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if os, err = pager.applyFilter(os); err != nil {
+		return nil, err
+	}
+	conn := &OrganizationSettingConnection{Edges: []*OrganizationSettingEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = os.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if os, err = pager.applyCursors(os, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		os.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := os.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	os = pager.applyOrder(os)
+	nodes, err := os.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// OrganizationSettingOrderField defines the ordering field of OrganizationSetting.
+type OrganizationSettingOrderField struct {
+	// Value extracts the ordering value from the given OrganizationSetting.
+	Value    func(*OrganizationSetting) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) organizationsetting.OrderOption
+	toCursor func(*OrganizationSetting) Cursor
+}
+
+// OrganizationSettingOrder defines the ordering of OrganizationSetting.
+type OrganizationSettingOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *OrganizationSettingOrderField `json:"field"`
+}
+
+// DefaultOrganizationSettingOrder is the default ordering of OrganizationSetting.
+var DefaultOrganizationSettingOrder = &OrganizationSettingOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &OrganizationSettingOrderField{
+		Value: func(os *OrganizationSetting) (ent.Value, error) {
+			return os.ID, nil
+		},
+		column: organizationsetting.FieldID,
+		toTerm: organizationsetting.ByID,
+		toCursor: func(os *OrganizationSetting) Cursor {
+			return Cursor{ID: os.ID}
+		},
+	},
+}
+
+// ToEdge converts OrganizationSetting into OrganizationSettingEdge.
+func (os *OrganizationSetting) ToEdge(order *OrganizationSettingOrder) *OrganizationSettingEdge {
+	if order == nil {
+		order = DefaultOrganizationSettingOrder
 	}
-	return &OrganizationEdge{
-		Node:   o,
-		Cursor: order.Field.toCursor(o),
+	return &OrganizationSettingEdge{
+		Node:   os,
+		Cursor: order.Field.toCursor(os),
 	}
 }
 
-// OrganizationSettingEdge is the edge representation of OrganizationSetting.
-type OrganizationSettingEdge struct {
-	Node   *OrganizationSetting `json:"node"`
-	Cursor Cursor               `json:"cursor"`
+// OwnershipTransferEdge is the edge representation of OwnershipTransfer.
+type OwnershipTransferEdge struct {
+	Node   *OwnershipTransfer `json:"node"`
+	Cursor Cursor             `json:"cursor"`
 }
 
-// OrganizationSettingConnection is the connection containing edges to OrganizationSetting.
-type OrganizationSettingConnection struct {
-	Edges      []*OrganizationSettingEdge `json:"edges"`
-	PageInfo   PageInfo                   `json:"pageInfo"`
-	TotalCount int                        `json:"totalCount"`
+// OwnershipTransferConnection is the connection containing edges to OwnershipTransfer.
+type OwnershipTransferConnection struct {
+	Edges      []*OwnershipTransferEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
 }
 
-func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, pager *organizationsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *OwnershipTransferConnection) build(nodes []*OwnershipTransfer, pager *ownershiptransferPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2049,21 +3779,21 @@ func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *OrganizationSetting
+	var nodeAt func(int) *OwnershipTransfer
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *OrganizationSetting {
+		nodeAt = func(i int) *OwnershipTransfer {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *OrganizationSetting {
+		nodeAt = func(i int) *OwnershipTransfer {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OrganizationSettingEdge, len(nodes))
+	c.Edges = make([]*OwnershipTransferEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OrganizationSettingEdge{
+		c.Edges[i] = &OwnershipTransferEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2077,87 +3807,87 @@ func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, page
 	}
 }
 
-// OrganizationSettingPaginateOption enables pagination customization.
-type OrganizationSettingPaginateOption func(*organizationsettingPager) error
+// OwnershipTransferPaginateOption enables pagination customization.
+type OwnershipTransferPaginateOption func(*ownershiptransferPager) error
 
-// WithOrganizationSettingOrder configures pagination ordering.
-func WithOrganizationSettingOrder(order *OrganizationSettingOrder) OrganizationSettingPaginateOption {
+// WithOwnershipTransferOrder configures pagination ordering.
+func WithOwnershipTransferOrder(order *OwnershipTransferOrder) OwnershipTransferPaginateOption {
 	if order == nil {
-		order = DefaultOrganizationSettingOrder
+		order = DefaultOwnershipTransferOrder
 	}
 	o := *order
-	return func(pager *organizationsettingPager) error {
+	return func(pager *ownershiptransferPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultOrganizationSettingOrder.Field
+			o.Field = DefaultOwnershipTransferOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithOrganizationSettingFilter configures pagination filter.
-func WithOrganizationSettingFilter(filter func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)) OrganizationSettingPaginateOption {
-	return func(pager *organizationsettingPager) error {
+// WithOwnershipTransferFilter configures pagination filter.
+func WithOwnershipTransferFilter(filter func(*OwnershipTransferQuery) (*OwnershipTransferQuery, error)) OwnershipTransferPaginateOption {
+	return func(pager *ownershiptransferPager) error {
 		if filter == nil {
-			return errors.New("OrganizationSettingQuery filter cannot be nil")
+			return errors.New("OwnershipTransferQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type organizationsettingPager struct {
+type ownershiptransferPager struct {
 	reverse bool
-	order   *OrganizationSettingOrder
-	filter  func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)
+	order   *OwnershipTransferOrder
+	filter  func(*OwnershipTransferQuery) (*OwnershipTransferQuery, error)
 }
 
-func newOrganizationSettingPager(opts []OrganizationSettingPaginateOption, reverse bool) (*organizationsettingPager, error) {
-	pager := &organizationsettingPager{reverse: reverse}
+func newOwnershipTransferPager(opts []OwnershipTransferPaginateOption, reverse bool) (*ownershiptransferPager, error) {
+	pager := &ownershiptransferPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultOrganizationSettingOrder
+		pager.order = DefaultOwnershipTransferOrder
 	}
 	return pager, nil
 }
 
-func (p *organizationsettingPager) applyFilter(query *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
+func (p *ownershiptransferPager) applyFilter(query *OwnershipTransferQuery) (*OwnershipTransferQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *organizationsettingPager) toCursor(os *OrganizationSetting) Cursor {
-	return p.order.Field.toCursor(os)
+func (p *ownershiptransferPager) toCursor(ot *OwnershipTransfer) Cursor {
+	return p.order.Field.toCursor(ot)
 }
 
-func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery, after, before *Cursor) (*OrganizationSettingQuery, error) {
+func (p *ownershiptransferPager) applyCursors(query *OwnershipTransferQuery, after, before *Cursor) (*OwnershipTransferQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrganizationSettingOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOwnershipTransferOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *OrganizationSettingQuery {
+func (p *ownershiptransferPager) applyOrder(query *OwnershipTransferQuery) *OwnershipTransferQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultOrganizationSettingOrder.Field {
-		query = query.Order(DefaultOrganizationSettingOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOwnershipTransferOrder.Field {
+		query = query.Order(DefaultOwnershipTransferOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2165,7 +3895,7 @@ func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *
 	return query
 }
 
-func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sql.Querier {
+func (p *ownershiptransferPager) orderExpr(query *OwnershipTransferQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2175,33 +3905,33 @@ func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sq
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultOrganizationSettingOrder.Field {
-			b.Comma().Ident(DefaultOrganizationSettingOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOwnershipTransferOrder.Field {
+			b.Comma().Ident(DefaultOwnershipTransferOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to OrganizationSetting.
-func (os *OrganizationSettingQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to OwnershipTransfer.
+func (ot *OwnershipTransferQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OrganizationSettingPaginateOption,
-) (*OrganizationSettingConnection, error) {
+	before *Cursor, last *int, opts ...OwnershipTransferPaginateOption,
+) (*OwnershipTransferConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOrganizationSettingPager(opts, last != nil)
+	pager, err := newOwnershipTransferPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if os, err = pager.applyFilter(os); err != nil {
+	if ot, err = pager.applyFilter(ot); err != nil {
 		return nil, err
 	}
-	conn := &OrganizationSettingConnection{Edges: []*OrganizationSettingEdge{}}
+	conn := &OwnershipTransferConnection{Edges: []*OwnershipTransferEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = os.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = ot.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -2211,19 +3941,19 @@ func (os *OrganizationSettingQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if os, err = pager.applyCursors(os, after, before); err != nil {
+	if ot, err = pager.applyCursors(ot, after, before); err != nil {
 		return nil, err
 	}
 	if limit := paginateLimit(first, last); limit != 0 {
-		os.Limit(limit)
+		ot.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := os.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ot.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	os = pager.applyOrder(os)
-	nodes, err := os.All(ctx)
+	ot = pager.applyOrder(ot)
+	nodes, err := ot.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2231,44 +3961,44 @@ func (os *OrganizationSettingQuery) Paginate(
 	return conn, nil
 }
 
-// OrganizationSettingOrderField defines the ordering field of OrganizationSetting.
-type OrganizationSettingOrderField struct {
-	// Value extracts the ordering value from the given OrganizationSetting.
-	Value    func(*OrganizationSetting) (ent.Value, error)
+// OwnershipTransferOrderField defines the ordering field of OwnershipTransfer.
+type OwnershipTransferOrderField struct {
+	// Value extracts the ordering value from the given OwnershipTransfer.
+	Value    func(*OwnershipTransfer) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) organizationsetting.OrderOption
-	toCursor func(*OrganizationSetting) Cursor
+	toTerm   func(...sql.OrderTermOption) ownershiptransfer.OrderOption
+	toCursor func(*OwnershipTransfer) Cursor
 }
 
-// OrganizationSettingOrder defines the ordering of OrganizationSetting.
-type OrganizationSettingOrder struct {
-	Direction OrderDirection                 `json:"direction"`
-	Field     *OrganizationSettingOrderField `json:"field"`
+// OwnershipTransferOrder defines the ordering of OwnershipTransfer.
+type OwnershipTransferOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *OwnershipTransferOrderField `json:"field"`
 }
 
-// DefaultOrganizationSettingOrder is the default ordering of OrganizationSetting.
-var DefaultOrganizationSettingOrder = &OrganizationSettingOrder{
+// DefaultOwnershipTransferOrder is the default ordering of OwnershipTransfer.
+var DefaultOwnershipTransferOrder = &OwnershipTransferOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OrganizationSettingOrderField{
-		Value: func(os *OrganizationSetting) (ent.Value, error) {
-			return os.ID, nil
+	Field: &OwnershipTransferOrderField{
+		Value: func(ot *OwnershipTransfer) (ent.Value, error) {
+			return ot.ID, nil
 		},
-		column: organizationsetting.FieldID,
-		toTerm: organizationsetting.ByID,
-		toCursor: func(os *OrganizationSetting) Cursor {
-			return Cursor{ID: os.ID}
+		column: ownershiptransfer.FieldID,
+		toTerm: ownershiptransfer.ByID,
+		toCursor: func(ot *OwnershipTransfer) Cursor {
+			return Cursor{ID: ot.ID}
 		},
 	},
 }
 
-// ToEdge converts OrganizationSetting into OrganizationSettingEdge.
-func (os *OrganizationSetting) ToEdge(order *OrganizationSettingOrder) *OrganizationSettingEdge {
+// ToEdge converts OwnershipTransfer into OwnershipTransferEdge.
+func (ot *OwnershipTransfer) ToEdge(order *OwnershipTransferOrder) *OwnershipTransferEdge {
 	if order == nil {
-		order = DefaultOrganizationSettingOrder
+		order = DefaultOwnershipTransferOrder
 	}
-	return &OrganizationSettingEdge{
-		Node:   os,
-		Cursor: order.Field.toCursor(os),
+	return &OwnershipTransferEdge{
+		Node:   ot,
+		Cursor: order.Field.toCursor(ot),
 	}
 }
 
@@ -3338,3 +5068,249 @@ func (us *UserSetting) ToEdge(order *UserSettingOrder) *UserSettingEdge {
 		Cursor: order.Field.toCursor(us),
 	}
 }
+
+// WebauthnCredentialEdge is the edge representation of WebauthnCredential.
+type WebauthnCredentialEdge struct {
+	Node   *WebauthnCredential `json:"node"`
+	Cursor Cursor              `json:"cursor"`
+}
+
+// WebauthnCredentialConnection is the connection containing edges to WebauthnCredential.
+type WebauthnCredentialConnection struct {
+	Edges      []*WebauthnCredentialEdge `json:"edges"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	TotalCount int                       `json:"totalCount"`
+}
+
+func (c *WebauthnCredentialConnection) build(nodes []*WebauthnCredential, pager *webauthncredentialPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *WebauthnCredential
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *WebauthnCredential {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *WebauthnCredential {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*WebauthnCredentialEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &WebauthnCredentialEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// WebauthnCredentialPaginateOption enables pagination customization.
+type WebauthnCredentialPaginateOption func(*webauthncredentialPager) error
+
+// WithWebauthnCredentialOrder configures pagination ordering.
+func WithWebauthnCredentialOrder(order *WebauthnCredentialOrder) WebauthnCredentialPaginateOption {
+	if order == nil {
+		order = DefaultWebauthnCredentialOrder
+	}
+	o := *order
+	return func(pager *webauthncredentialPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultWebauthnCredentialOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithWebauthnCredentialFilter configures pagination filter.
+func WithWebauthnCredentialFilter(filter func(*WebauthnCredentialQuery) (*WebauthnCredentialQuery, error)) WebauthnCredentialPaginateOption {
+	return func(pager *webauthncredentialPager) error {
+		if filter == nil {
+			return errors.New("WebauthnCredentialQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type webauthncredentialPager struct {
+	reverse bool
+	order   *WebauthnCredentialOrder
+	filter  func(*WebauthnCredentialQuery) (*WebauthnCredentialQuery, error)
+}
+
+func newWebauthnCredentialPager(opts []WebauthnCredentialPaginateOption, reverse bool) (*webauthncredentialPager, error) {
+	pager := &webauthncredentialPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultWebauthnCredentialOrder
+	}
+	return pager, nil
+}
+
+func (p *webauthncredentialPager) applyFilter(query *WebauthnCredentialQuery) (*WebauthnCredentialQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *webauthncredentialPager) toCursor(wc *WebauthnCredential) Cursor {
+	return p.order.Field.toCursor(wc)
+}
+
+func (p *webauthncredentialPager) applyCursors(query *WebauthnCredentialQuery, after, before *Cursor) (*WebauthnCredentialQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultWebauthnCredentialOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *webauthncredentialPager) applyOrder(query *WebauthnCredentialQuery) *WebauthnCredentialQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultWebauthnCredentialOrder.Field {
+		query = query.Order(DefaultWebauthnCredentialOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *webauthncredentialPager) orderExpr(query *WebauthnCredentialQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWebauthnCredentialOrder.Field {
+			b.Comma().Ident(DefaultWebauthnCredentialOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to WebauthnCredential.
+func (wc *WebauthnCredentialQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...WebauthnCredentialPaginateOption,
+) (*WebauthnCredentialConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newWebauthnCredentialPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wc, err = pager.applyFilter(wc); err != nil {
+		return nil, err
+	}
+	conn := &WebauthnCredentialConnection{Edges: []*WebauthnCredentialEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = wc.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if wc, err = pager.applyCursors(wc, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		wc.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := wc.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	wc = pager.applyOrder(wc)
+	nodes, err := wc.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// WebauthnCredentialOrderField defines the ordering field of WebauthnCredential.
+type WebauthnCredentialOrderField struct {
+	// Value extracts the ordering value from the given WebauthnCredential.
+	Value    func(*WebauthnCredential) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) webauthncredential.OrderOption
+	toCursor func(*WebauthnCredential) Cursor
+}
+
+// WebauthnCredentialOrder defines the ordering of WebauthnCredential.
+type WebauthnCredentialOrder struct {
+	Direction OrderDirection                `json:"direction"`
+	Field     *WebauthnCredentialOrderField `json:"field"`
+}
+
+// DefaultWebauthnCredentialOrder is the default ordering of WebauthnCredential.
+var DefaultWebauthnCredentialOrder = &WebauthnCredentialOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &WebauthnCredentialOrderField{
+		Value: func(wc *WebauthnCredential) (ent.Value, error) {
+			return wc.ID, nil
+		},
+		column: webauthncredential.FieldID,
+		toTerm: webauthncredential.ByID,
+		toCursor: func(wc *WebauthnCredential) Cursor {
+			return Cursor{ID: wc.ID}
+		},
+	},
+}
+
+// ToEdge converts WebauthnCredential into WebauthnCredentialEdge.
+func (wc *WebauthnCredential) ToEdge(order *WebauthnCredentialOrder) *WebauthnCredentialEdge {
+	if order == nil {
+		order = DefaultWebauthnCredentialOrder
+	}
+	return &WebauthnCredentialEdge{
+		Node:   wc,
+		Cursor: order.Field.toCursor(wc),
+	}
+}
@@ -7,27 +7,35 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 )
 
-// EntitlementWhereInput represents a where input for filtering Entitlement queries.
-type EntitlementWhereInput struct {
-	Predicates []predicate.Entitlement  `json:"-"`
-	Not        *EntitlementWhereInput   `json:"not,omitempty"`
-	Or         []*EntitlementWhereInput `json:"or,omitempty"`
-	And        []*EntitlementWhereInput `json:"and,omitempty"`
+// APIKeyWhereInput represents a where input for filtering APIKey queries.
+type APIKeyWhereInput struct {
+	Predicates []predicate.APIKey  `json:"-"`
+	Not        *APIKeyWhereInput   `json:"not,omitempty"`
+	Or         []*APIKeyWhereInput `json:"or,omitempty"`
+	And        []*APIKeyWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -124,49 +132,50 @@ type EntitlementWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "tier" field predicates.
-	Tier      *entitlement.Tier  `json:"tier,omitempty"`
-	TierNEQ   *entitlement.Tier  `json:"tierNEQ,omitempty"`
-	TierIn    []entitlement.Tier `json:"tierIn,omitempty"`
-	TierNotIn []entitlement.Tier `json:"tierNotIn,omitempty"`
-
-	// "external_customer_id" field predicates.
-	ExternalCustomerID             *string  `json:"externalCustomerID,omitempty"`
-	ExternalCustomerIDNEQ          *string  `json:"externalCustomerIDNEQ,omitempty"`
-	ExternalCustomerIDIn           []string `json:"externalCustomerIDIn,omitempty"`
-	ExternalCustomerIDNotIn        []string `json:"externalCustomerIDNotIn,omitempty"`
-	ExternalCustomerIDGT           *string  `json:"externalCustomerIDGT,omitempty"`
-	ExternalCustomerIDGTE          *string  `json:"externalCustomerIDGTE,omitempty"`
-	ExternalCustomerIDLT           *string  `json:"externalCustomerIDLT,omitempty"`
-	ExternalCustomerIDLTE          *string  `json:"externalCustomerIDLTE,omitempty"`
-	ExternalCustomerIDContains     *string  `json:"externalCustomerIDContains,omitempty"`
-	ExternalCustomerIDHasPrefix    *string  `json:"externalCustomerIDHasPrefix,omitempty"`
-	ExternalCustomerIDHasSuffix    *string  `json:"externalCustomerIDHasSuffix,omitempty"`
-	ExternalCustomerIDIsNil        bool     `json:"externalCustomerIDIsNil,omitempty"`
-	ExternalCustomerIDNotNil       bool     `json:"externalCustomerIDNotNil,omitempty"`
-	ExternalCustomerIDEqualFold    *string  `json:"externalCustomerIDEqualFold,omitempty"`
-	ExternalCustomerIDContainsFold *string  `json:"externalCustomerIDContainsFold,omitempty"`
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
 
-	// "external_subscription_id" field predicates.
-	ExternalSubscriptionID             *string  `json:"externalSubscriptionID,omitempty"`
-	ExternalSubscriptionIDNEQ          *string  `json:"externalSubscriptionIDNEQ,omitempty"`
-	ExternalSubscriptionIDIn           []string `json:"externalSubscriptionIDIn,omitempty"`
-	ExternalSubscriptionIDNotIn        []string `json:"externalSubscriptionIDNotIn,omitempty"`
-	ExternalSubscriptionIDGT           *string  `json:"externalSubscriptionIDGT,omitempty"`
-	ExternalSubscriptionIDGTE          *string  `json:"externalSubscriptionIDGTE,omitempty"`
-	ExternalSubscriptionIDLT           *string  `json:"externalSubscriptionIDLT,omitempty"`
-	ExternalSubscriptionIDLTE          *string  `json:"externalSubscriptionIDLTE,omitempty"`
-	ExternalSubscriptionIDContains     *string  `json:"externalSubscriptionIDContains,omitempty"`
-	ExternalSubscriptionIDHasPrefix    *string  `json:"externalSubscriptionIDHasPrefix,omitempty"`
-	ExternalSubscriptionIDHasSuffix    *string  `json:"externalSubscriptionIDHasSuffix,omitempty"`
-	ExternalSubscriptionIDIsNil        bool     `json:"externalSubscriptionIDIsNil,omitempty"`
-	ExternalSubscriptionIDNotNil       bool     `json:"externalSubscriptionIDNotNil,omitempty"`
-	ExternalSubscriptionIDEqualFold    *string  `json:"externalSubscriptionIDEqualFold,omitempty"`
-	ExternalSubscriptionIDContainsFold *string  `json:"externalSubscriptionIDContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "expires" field predicates.
-	Expires    *bool `json:"expires,omitempty"`
-	ExpiresNEQ *bool `json:"expiresNEQ,omitempty"`
+	// "key_prefix" field predicates.
+	KeyPrefix             *string  `json:"keyPrefix,omitempty"`
+	KeyPrefixNEQ          *string  `json:"keyPrefixNEQ,omitempty"`
+	KeyPrefixIn           []string `json:"keyPrefixIn,omitempty"`
+	KeyPrefixNotIn        []string `json:"keyPrefixNotIn,omitempty"`
+	KeyPrefixGT           *string  `json:"keyPrefixGT,omitempty"`
+	KeyPrefixGTE          *string  `json:"keyPrefixGTE,omitempty"`
+	KeyPrefixLT           *string  `json:"keyPrefixLT,omitempty"`
+	KeyPrefixLTE          *string  `json:"keyPrefixLTE,omitempty"`
+	KeyPrefixContains     *string  `json:"keyPrefixContains,omitempty"`
+	KeyPrefixHasPrefix    *string  `json:"keyPrefixHasPrefix,omitempty"`
+	KeyPrefixHasSuffix    *string  `json:"keyPrefixHasSuffix,omitempty"`
+	KeyPrefixEqualFold    *string  `json:"keyPrefixEqualFold,omitempty"`
+	KeyPrefixContainsFold *string  `json:"keyPrefixContainsFold,omitempty"`
 
 	// "expires_at" field predicates.
 	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
@@ -180,9 +189,17 @@ type EntitlementWhereInput struct {
 	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
 	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
 
-	// "cancelled" field predicates.
-	Cancelled    *bool `json:"cancelled,omitempty"`
-	CancelledNEQ *bool `json:"cancelledNEQ,omitempty"`
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
 
 	// "owner" edge predicates.
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
@@ -190,18 +207,18 @@ type EntitlementWhereInput struct {
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *EntitlementWhereInput) AddPredicates(predicates ...predicate.Entitlement) {
+func (i *APIKeyWhereInput) AddPredicates(predicates ...predicate.APIKey) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the EntitlementWhereInput filter on the EntitlementQuery builder.
-func (i *EntitlementWhereInput) Filter(q *EntitlementQuery) (*EntitlementQuery, error) {
+// Filter applies the APIKeyWhereInput filter on the APIKeyQuery builder.
+func (i *APIKeyWhereInput) Filter(q *APIKeyQuery) (*APIKeyQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyEntitlementWhereInput {
+		if err == ErrEmptyAPIKeyWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -209,19 +226,19 @@ func (i *EntitlementWhereInput) Filter(q *EntitlementQuery) (*EntitlementQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyEntitlementWhereInput is returned in case the EntitlementWhereInput is empty.
-var ErrEmptyEntitlementWhereInput = errors.New("generated: empty predicate EntitlementWhereInput")
+// ErrEmptyAPIKeyWhereInput is returned in case the APIKeyWhereInput is empty.
+var ErrEmptyAPIKeyWhereInput = errors.New("generated: empty predicate APIKeyWhereInput")
 
-// P returns a predicate for filtering entitlements.
+// P returns a predicate for filtering apikeys.
 // An error is returned if the input is empty or invalid.
-func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
-	var predicates []predicate.Entitlement
+func (i *APIKeyWhereInput) P() (predicate.APIKey, error) {
+	var predicates []predicate.APIKey
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, entitlement.Not(p))
+		predicates = append(predicates, apikey.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -231,7 +248,7 @@ func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Entitlement, 0, n)
+		or := make([]predicate.APIKey, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -239,7 +256,7 @@ func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, entitlement.Or(or...))
+		predicates = append(predicates, apikey.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -249,7 +266,7 @@ func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Entitlement, 0, n)
+		and := make([]predicate.APIKey, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -257,401 +274,434 @@ func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, entitlement.And(and...))
+		predicates = append(predicates, apikey.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, entitlement.IDEQ(*i.ID))
+		predicates = append(predicates, apikey.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, entitlement.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, apikey.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, entitlement.IDIn(i.IDIn...))
+		predicates = append(predicates, apikey.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, entitlement.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, apikey.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, entitlement.IDGT(*i.IDGT))
+		predicates = append(predicates, apikey.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, entitlement.IDGTE(*i.IDGTE))
+		predicates = append(predicates, apikey.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, entitlement.IDLT(*i.IDLT))
+		predicates = append(predicates, apikey.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, entitlement.IDLTE(*i.IDLTE))
+		predicates = append(predicates, apikey.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, entitlement.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, apikey.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, entitlement.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, apikey.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, entitlement.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, apikey.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, entitlement.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, apikey.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, entitlement.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, apikey.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, entitlement.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, apikey.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, entitlement.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, apikey.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, entitlement.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, apikey.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, entitlement.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, apikey.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, entitlement.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, apikey.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, entitlement.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, apikey.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, entitlement.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, apikey.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, entitlement.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, apikey.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, entitlement.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, apikey.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, entitlement.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, apikey.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, entitlement.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, apikey.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, entitlement.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, apikey.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, entitlement.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, apikey.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, entitlement.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, apikey.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, entitlement.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, apikey.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, entitlement.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, apikey.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, entitlement.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, apikey.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, entitlement.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, apikey.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, entitlement.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, apikey.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, entitlement.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, apikey.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, entitlement.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, apikey.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, entitlement.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, apikey.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, entitlement.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, apikey.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, entitlement.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, apikey.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, entitlement.CreatedByIsNil())
+		predicates = append(predicates, apikey.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, entitlement.CreatedByNotNil())
+		predicates = append(predicates, apikey.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, entitlement.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, apikey.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, entitlement.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, apikey.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, entitlement.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, apikey.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, entitlement.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, apikey.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, entitlement.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, apikey.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, entitlement.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, apikey.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, entitlement.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, apikey.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, entitlement.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, apikey.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, entitlement.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, apikey.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, entitlement.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, apikey.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, entitlement.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, apikey.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, entitlement.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, apikey.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, entitlement.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, apikey.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, entitlement.UpdatedByIsNil())
+		predicates = append(predicates, apikey.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, entitlement.UpdatedByNotNil())
+		predicates = append(predicates, apikey.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, entitlement.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, apikey.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, entitlement.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, apikey.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, entitlement.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, apikey.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, entitlement.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, apikey.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, entitlement.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, apikey.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, entitlement.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, apikey.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, entitlement.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, apikey.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, entitlement.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, apikey.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, entitlement.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, apikey.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, entitlement.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, apikey.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, entitlement.DeletedAtIsNil())
+		predicates = append(predicates, apikey.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, entitlement.DeletedAtNotNil())
+		predicates = append(predicates, apikey.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, entitlement.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, apikey.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, entitlement.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, apikey.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, entitlement.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, apikey.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, entitlement.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, apikey.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, entitlement.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, apikey.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, entitlement.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, apikey.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, entitlement.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, apikey.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, entitlement.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, apikey.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, entitlement.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, apikey.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, entitlement.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, apikey.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, entitlement.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, apikey.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, entitlement.DeletedByIsNil())
+		predicates = append(predicates, apikey.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, entitlement.DeletedByNotNil())
+		predicates = append(predicates, apikey.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, entitlement.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, apikey.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, entitlement.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, apikey.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.Tier != nil {
-		predicates = append(predicates, entitlement.TierEQ(*i.Tier))
+	if i.OwnerID != nil {
+		predicates = append(predicates, apikey.OwnerIDEQ(*i.OwnerID))
 	}
-	if i.TierNEQ != nil {
-		predicates = append(predicates, entitlement.TierNEQ(*i.TierNEQ))
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, apikey.OwnerIDNEQ(*i.OwnerIDNEQ))
 	}
-	if len(i.TierIn) > 0 {
-		predicates = append(predicates, entitlement.TierIn(i.TierIn...))
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, apikey.OwnerIDIn(i.OwnerIDIn...))
 	}
-	if len(i.TierNotIn) > 0 {
-		predicates = append(predicates, entitlement.TierNotIn(i.TierNotIn...))
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, apikey.OwnerIDNotIn(i.OwnerIDNotIn...))
 	}
-	if i.ExternalCustomerID != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDEQ(*i.ExternalCustomerID))
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, apikey.OwnerIDGT(*i.OwnerIDGT))
 	}
-	if i.ExternalCustomerIDNEQ != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDNEQ(*i.ExternalCustomerIDNEQ))
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, apikey.OwnerIDGTE(*i.OwnerIDGTE))
 	}
-	if len(i.ExternalCustomerIDIn) > 0 {
-		predicates = append(predicates, entitlement.ExternalCustomerIDIn(i.ExternalCustomerIDIn...))
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, apikey.OwnerIDLT(*i.OwnerIDLT))
 	}
-	if len(i.ExternalCustomerIDNotIn) > 0 {
-		predicates = append(predicates, entitlement.ExternalCustomerIDNotIn(i.ExternalCustomerIDNotIn...))
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, apikey.OwnerIDLTE(*i.OwnerIDLTE))
 	}
-	if i.ExternalCustomerIDGT != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDGT(*i.ExternalCustomerIDGT))
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, apikey.OwnerIDContains(*i.OwnerIDContains))
 	}
-	if i.ExternalCustomerIDGTE != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDGTE(*i.ExternalCustomerIDGTE))
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, apikey.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
 	}
-	if i.ExternalCustomerIDLT != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDLT(*i.ExternalCustomerIDLT))
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, apikey.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
 	}
-	if i.ExternalCustomerIDLTE != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDLTE(*i.ExternalCustomerIDLTE))
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, apikey.OwnerIDEqualFold(*i.OwnerIDEqualFold))
 	}
-	if i.ExternalCustomerIDContains != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDContains(*i.ExternalCustomerIDContains))
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, apikey.OwnerIDContainsFold(*i.OwnerIDContainsFold))
 	}
-	if i.ExternalCustomerIDHasPrefix != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDHasPrefix(*i.ExternalCustomerIDHasPrefix))
+	if i.Name != nil {
+		predicates = append(predicates, apikey.NameEQ(*i.Name))
 	}
-	if i.ExternalCustomerIDHasSuffix != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDHasSuffix(*i.ExternalCustomerIDHasSuffix))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, apikey.NameNEQ(*i.NameNEQ))
 	}
-	if i.ExternalCustomerIDIsNil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDIsNil())
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, apikey.NameIn(i.NameIn...))
 	}
-	if i.ExternalCustomerIDNotNil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDNotNil())
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, apikey.NameNotIn(i.NameNotIn...))
 	}
-	if i.ExternalCustomerIDEqualFold != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDEqualFold(*i.ExternalCustomerIDEqualFold))
+	if i.NameGT != nil {
+		predicates = append(predicates, apikey.NameGT(*i.NameGT))
 	}
-	if i.ExternalCustomerIDContainsFold != nil {
-		predicates = append(predicates, entitlement.ExternalCustomerIDContainsFold(*i.ExternalCustomerIDContainsFold))
+	if i.NameGTE != nil {
+		predicates = append(predicates, apikey.NameGTE(*i.NameGTE))
 	}
-	if i.ExternalSubscriptionID != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDEQ(*i.ExternalSubscriptionID))
+	if i.NameLT != nil {
+		predicates = append(predicates, apikey.NameLT(*i.NameLT))
 	}
-	if i.ExternalSubscriptionIDNEQ != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDNEQ(*i.ExternalSubscriptionIDNEQ))
+	if i.NameLTE != nil {
+		predicates = append(predicates, apikey.NameLTE(*i.NameLTE))
 	}
-	if len(i.ExternalSubscriptionIDIn) > 0 {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDIn(i.ExternalSubscriptionIDIn...))
+	if i.NameContains != nil {
+		predicates = append(predicates, apikey.NameContains(*i.NameContains))
 	}
-	if len(i.ExternalSubscriptionIDNotIn) > 0 {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDNotIn(i.ExternalSubscriptionIDNotIn...))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, apikey.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.ExternalSubscriptionIDGT != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDGT(*i.ExternalSubscriptionIDGT))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, apikey.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.ExternalSubscriptionIDGTE != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDGTE(*i.ExternalSubscriptionIDGTE))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, apikey.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.ExternalSubscriptionIDLT != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDLT(*i.ExternalSubscriptionIDLT))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, apikey.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.ExternalSubscriptionIDLTE != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDLTE(*i.ExternalSubscriptionIDLTE))
+	if i.KeyPrefix != nil {
+		predicates = append(predicates, apikey.KeyPrefixEQ(*i.KeyPrefix))
 	}
-	if i.ExternalSubscriptionIDContains != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDContains(*i.ExternalSubscriptionIDContains))
+	if i.KeyPrefixNEQ != nil {
+		predicates = append(predicates, apikey.KeyPrefixNEQ(*i.KeyPrefixNEQ))
 	}
-	if i.ExternalSubscriptionIDHasPrefix != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDHasPrefix(*i.ExternalSubscriptionIDHasPrefix))
+	if len(i.KeyPrefixIn) > 0 {
+		predicates = append(predicates, apikey.KeyPrefixIn(i.KeyPrefixIn...))
 	}
-	if i.ExternalSubscriptionIDHasSuffix != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDHasSuffix(*i.ExternalSubscriptionIDHasSuffix))
+	if len(i.KeyPrefixNotIn) > 0 {
+		predicates = append(predicates, apikey.KeyPrefixNotIn(i.KeyPrefixNotIn...))
 	}
-	if i.ExternalSubscriptionIDIsNil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDIsNil())
+	if i.KeyPrefixGT != nil {
+		predicates = append(predicates, apikey.KeyPrefixGT(*i.KeyPrefixGT))
 	}
-	if i.ExternalSubscriptionIDNotNil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDNotNil())
+	if i.KeyPrefixGTE != nil {
+		predicates = append(predicates, apikey.KeyPrefixGTE(*i.KeyPrefixGTE))
 	}
-	if i.ExternalSubscriptionIDEqualFold != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDEqualFold(*i.ExternalSubscriptionIDEqualFold))
+	if i.KeyPrefixLT != nil {
+		predicates = append(predicates, apikey.KeyPrefixLT(*i.KeyPrefixLT))
 	}
-	if i.ExternalSubscriptionIDContainsFold != nil {
-		predicates = append(predicates, entitlement.ExternalSubscriptionIDContainsFold(*i.ExternalSubscriptionIDContainsFold))
+	if i.KeyPrefixLTE != nil {
+		predicates = append(predicates, apikey.KeyPrefixLTE(*i.KeyPrefixLTE))
 	}
-	if i.Expires != nil {
-		predicates = append(predicates, entitlement.ExpiresEQ(*i.Expires))
+	if i.KeyPrefixContains != nil {
+		predicates = append(predicates, apikey.KeyPrefixContains(*i.KeyPrefixContains))
 	}
-	if i.ExpiresNEQ != nil {
-		predicates = append(predicates, entitlement.ExpiresNEQ(*i.ExpiresNEQ))
+	if i.KeyPrefixHasPrefix != nil {
+		predicates = append(predicates, apikey.KeyPrefixHasPrefix(*i.KeyPrefixHasPrefix))
 	}
-	if i.ExpiresAt != nil {
-		predicates = append(predicates, entitlement.ExpiresAtEQ(*i.ExpiresAt))
+	if i.KeyPrefixHasSuffix != nil {
+		predicates = append(predicates, apikey.KeyPrefixHasSuffix(*i.KeyPrefixHasSuffix))
+	}
+	if i.KeyPrefixEqualFold != nil {
+		predicates = append(predicates, apikey.KeyPrefixEqualFold(*i.KeyPrefixEqualFold))
+	}
+	if i.KeyPrefixContainsFold != nil {
+		predicates = append(predicates, apikey.KeyPrefixContainsFold(*i.KeyPrefixContainsFold))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, apikey.ExpiresAtEQ(*i.ExpiresAt))
 	}
 	if i.ExpiresAtNEQ != nil {
-		predicates = append(predicates, entitlement.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+		predicates = append(predicates, apikey.ExpiresAtNEQ(*i.ExpiresAtNEQ))
 	}
 	if len(i.ExpiresAtIn) > 0 {
-		predicates = append(predicates, entitlement.ExpiresAtIn(i.ExpiresAtIn...))
+		predicates = append(predicates, apikey.ExpiresAtIn(i.ExpiresAtIn...))
 	}
 	if len(i.ExpiresAtNotIn) > 0 {
-		predicates = append(predicates, entitlement.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+		predicates = append(predicates, apikey.ExpiresAtNotIn(i.ExpiresAtNotIn...))
 	}
 	if i.ExpiresAtGT != nil {
-		predicates = append(predicates, entitlement.ExpiresAtGT(*i.ExpiresAtGT))
+		predicates = append(predicates, apikey.ExpiresAtGT(*i.ExpiresAtGT))
 	}
 	if i.ExpiresAtGTE != nil {
-		predicates = append(predicates, entitlement.ExpiresAtGTE(*i.ExpiresAtGTE))
+		predicates = append(predicates, apikey.ExpiresAtGTE(*i.ExpiresAtGTE))
 	}
 	if i.ExpiresAtLT != nil {
-		predicates = append(predicates, entitlement.ExpiresAtLT(*i.ExpiresAtLT))
+		predicates = append(predicates, apikey.ExpiresAtLT(*i.ExpiresAtLT))
 	}
 	if i.ExpiresAtLTE != nil {
-		predicates = append(predicates, entitlement.ExpiresAtLTE(*i.ExpiresAtLTE))
+		predicates = append(predicates, apikey.ExpiresAtLTE(*i.ExpiresAtLTE))
 	}
 	if i.ExpiresAtIsNil {
-		predicates = append(predicates, entitlement.ExpiresAtIsNil())
+		predicates = append(predicates, apikey.ExpiresAtIsNil())
 	}
 	if i.ExpiresAtNotNil {
-		predicates = append(predicates, entitlement.ExpiresAtNotNil())
+		predicates = append(predicates, apikey.ExpiresAtNotNil())
 	}
-	if i.Cancelled != nil {
-		predicates = append(predicates, entitlement.CancelledEQ(*i.Cancelled))
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, apikey.LastUsedAtEQ(*i.LastUsedAt))
 	}
-	if i.CancelledNEQ != nil {
-		predicates = append(predicates, entitlement.CancelledNEQ(*i.CancelledNEQ))
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, apikey.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, apikey.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, apikey.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, apikey.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, apikey.LastUsedAtNotNil())
 	}
 
 	if i.HasOwner != nil {
-		p := entitlement.HasOwner()
+		p := apikey.HasOwner()
 		if !*i.HasOwner {
-			p = entitlement.Not(p)
+			p = apikey.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
@@ -664,24 +714,24 @@ func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, entitlement.HasOwnerWith(with...))
+		predicates = append(predicates, apikey.HasOwnerWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyEntitlementWhereInput
+		return nil, ErrEmptyAPIKeyWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return entitlement.And(predicates...), nil
+		return apikey.And(predicates...), nil
 	}
 }
 
-// GroupWhereInput represents a where input for filtering Group queries.
-type GroupWhereInput struct {
-	Predicates []predicate.Group  `json:"-"`
-	Not        *GroupWhereInput   `json:"not,omitempty"`
-	Or         []*GroupWhereInput `json:"or,omitempty"`
-	And        []*GroupWhereInput `json:"and,omitempty"`
+// EntitlementWhereInput represents a where input for filtering Entitlement queries.
+type EntitlementWhereInput struct {
+	Predicates []predicate.Entitlement  `json:"-"`
+	Not        *EntitlementWhereInput   `json:"not,omitempty"`
+	Or         []*EntitlementWhereInput `json:"or,omitempty"`
+	And        []*EntitlementWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -778,43 +828,65 @@ type GroupWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "tier" field predicates.
+	Tier      *entitlement.Tier  `json:"tier,omitempty"`
+	TierNEQ   *entitlement.Tier  `json:"tierNEQ,omitempty"`
+	TierIn    []entitlement.Tier `json:"tierIn,omitempty"`
+	TierNotIn []entitlement.Tier `json:"tierNotIn,omitempty"`
 
-	// "display_name" field predicates.
-	DisplayName             *string  `json:"displayName,omitempty"`
-	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
-	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
-	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
-	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
-	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
-	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
-	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
-	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
-	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
-	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
-	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
-	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
+	// "external_customer_id" field predicates.
+	ExternalCustomerID             *string  `json:"externalCustomerID,omitempty"`
+	ExternalCustomerIDNEQ          *string  `json:"externalCustomerIDNEQ,omitempty"`
+	ExternalCustomerIDIn           []string `json:"externalCustomerIDIn,omitempty"`
+	ExternalCustomerIDNotIn        []string `json:"externalCustomerIDNotIn,omitempty"`
+	ExternalCustomerIDGT           *string  `json:"externalCustomerIDGT,omitempty"`
+	ExternalCustomerIDGTE          *string  `json:"externalCustomerIDGTE,omitempty"`
+	ExternalCustomerIDLT           *string  `json:"externalCustomerIDLT,omitempty"`
+	ExternalCustomerIDLTE          *string  `json:"externalCustomerIDLTE,omitempty"`
+	ExternalCustomerIDContains     *string  `json:"externalCustomerIDContains,omitempty"`
+	ExternalCustomerIDHasPrefix    *string  `json:"externalCustomerIDHasPrefix,omitempty"`
+	ExternalCustomerIDHasSuffix    *string  `json:"externalCustomerIDHasSuffix,omitempty"`
+	ExternalCustomerIDIsNil        bool     `json:"externalCustomerIDIsNil,omitempty"`
+	ExternalCustomerIDNotNil       bool     `json:"externalCustomerIDNotNil,omitempty"`
+	ExternalCustomerIDEqualFold    *string  `json:"externalCustomerIDEqualFold,omitempty"`
+	ExternalCustomerIDContainsFold *string  `json:"externalCustomerIDContainsFold,omitempty"`
 
-	// "setting" edge predicates.
-	HasSetting     *bool                     `json:"hasSetting,omitempty"`
-	HasSettingWith []*GroupSettingWhereInput `json:"hasSettingWith,omitempty"`
+	// "external_subscription_id" field predicates.
+	ExternalSubscriptionID             *string  `json:"externalSubscriptionID,omitempty"`
+	ExternalSubscriptionIDNEQ          *string  `json:"externalSubscriptionIDNEQ,omitempty"`
+	ExternalSubscriptionIDIn           []string `json:"externalSubscriptionIDIn,omitempty"`
+	ExternalSubscriptionIDNotIn        []string `json:"externalSubscriptionIDNotIn,omitempty"`
+	ExternalSubscriptionIDGT           *string  `json:"externalSubscriptionIDGT,omitempty"`
+	ExternalSubscriptionIDGTE          *string  `json:"externalSubscriptionIDGTE,omitempty"`
+	ExternalSubscriptionIDLT           *string  `json:"externalSubscriptionIDLT,omitempty"`
+	ExternalSubscriptionIDLTE          *string  `json:"externalSubscriptionIDLTE,omitempty"`
+	ExternalSubscriptionIDContains     *string  `json:"externalSubscriptionIDContains,omitempty"`
+	ExternalSubscriptionIDHasPrefix    *string  `json:"externalSubscriptionIDHasPrefix,omitempty"`
+	ExternalSubscriptionIDHasSuffix    *string  `json:"externalSubscriptionIDHasSuffix,omitempty"`
+	ExternalSubscriptionIDIsNil        bool     `json:"externalSubscriptionIDIsNil,omitempty"`
+	ExternalSubscriptionIDNotNil       bool     `json:"externalSubscriptionIDNotNil,omitempty"`
+	ExternalSubscriptionIDEqualFold    *string  `json:"externalSubscriptionIDEqualFold,omitempty"`
+	ExternalSubscriptionIDContainsFold *string  `json:"externalSubscriptionIDContainsFold,omitempty"`
 
-	// "users" edge predicates.
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+	// "expires" field predicates.
+	Expires    *bool `json:"expires,omitempty"`
+	ExpiresNEQ *bool `json:"expiresNEQ,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "cancelled" field predicates.
+	Cancelled    *bool `json:"cancelled,omitempty"`
+	CancelledNEQ *bool `json:"cancelledNEQ,omitempty"`
 
 	// "owner" edge predicates.
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
@@ -822,18 +894,18 @@ type GroupWhereInput struct {
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *GroupWhereInput) AddPredicates(predicates ...predicate.Group) {
+func (i *EntitlementWhereInput) AddPredicates(predicates ...predicate.Entitlement) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the GroupWhereInput filter on the GroupQuery builder.
-func (i *GroupWhereInput) Filter(q *GroupQuery) (*GroupQuery, error) {
+// Filter applies the EntitlementWhereInput filter on the EntitlementQuery builder.
+func (i *EntitlementWhereInput) Filter(q *EntitlementQuery) (*EntitlementQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyGroupWhereInput {
+		if err == ErrEmptyEntitlementWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -841,19 +913,19 @@ func (i *GroupWhereInput) Filter(q *GroupQuery) (*GroupQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyGroupWhereInput is returned in case the GroupWhereInput is empty.
-var ErrEmptyGroupWhereInput = errors.New("generated: empty predicate GroupWhereInput")
+// ErrEmptyEntitlementWhereInput is returned in case the EntitlementWhereInput is empty.
+var ErrEmptyEntitlementWhereInput = errors.New("generated: empty predicate EntitlementWhereInput")
 
-// P returns a predicate for filtering groups.
+// P returns a predicate for filtering entitlements.
 // An error is returned if the input is empty or invalid.
-func (i *GroupWhereInput) P() (predicate.Group, error) {
-	var predicates []predicate.Group
+func (i *EntitlementWhereInput) P() (predicate.Entitlement, error) {
+	var predicates []predicate.Entitlement
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, group.Not(p))
+		predicates = append(predicates, entitlement.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -863,7 +935,7 @@ func (i *GroupWhereInput) P() (predicate.Group, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Group, 0, n)
+		or := make([]predicate.Entitlement, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -871,7 +943,7 @@ func (i *GroupWhereInput) P() (predicate.Group, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, group.Or(or...))
+		predicates = append(predicates, entitlement.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -881,7 +953,7 @@ func (i *GroupWhereInput) P() (predicate.Group, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Group, 0, n)
+		and := make([]predicate.Entitlement, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -889,401 +961,431 @@ func (i *GroupWhereInput) P() (predicate.Group, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, group.And(and...))
+		predicates = append(predicates, entitlement.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, group.IDEQ(*i.ID))
+		predicates = append(predicates, entitlement.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, group.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, entitlement.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, group.IDIn(i.IDIn...))
+		predicates = append(predicates, entitlement.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, group.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, entitlement.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, group.IDGT(*i.IDGT))
+		predicates = append(predicates, entitlement.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, group.IDGTE(*i.IDGTE))
+		predicates = append(predicates, entitlement.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, group.IDLT(*i.IDLT))
+		predicates = append(predicates, entitlement.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, group.IDLTE(*i.IDLTE))
+		predicates = append(predicates, entitlement.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, group.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, entitlement.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, group.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, entitlement.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, group.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, entitlement.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, group.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, entitlement.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, group.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, entitlement.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, group.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, entitlement.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, group.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, entitlement.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, group.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, entitlement.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, group.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, entitlement.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, group.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, entitlement.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, group.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, entitlement.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, group.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, entitlement.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, group.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, entitlement.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, group.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, entitlement.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, group.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, entitlement.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, group.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, entitlement.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, group.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, entitlement.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, group.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, entitlement.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, group.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, entitlement.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, group.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, entitlement.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, group.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, entitlement.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, group.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, entitlement.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, group.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, entitlement.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, group.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, entitlement.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, group.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, entitlement.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, group.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, entitlement.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, group.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, entitlement.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, group.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, entitlement.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, group.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, entitlement.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, group.CreatedByIsNil())
+		predicates = append(predicates, entitlement.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, group.CreatedByNotNil())
+		predicates = append(predicates, entitlement.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, group.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, entitlement.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, group.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, entitlement.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, group.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, entitlement.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, group.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, entitlement.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, group.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, entitlement.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, group.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, entitlement.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, group.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, entitlement.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, group.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, entitlement.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, group.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, entitlement.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, group.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, entitlement.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, group.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, entitlement.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, group.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, entitlement.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, group.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, entitlement.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, group.UpdatedByIsNil())
+		predicates = append(predicates, entitlement.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, group.UpdatedByNotNil())
+		predicates = append(predicates, entitlement.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, group.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, entitlement.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, group.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, entitlement.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, group.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, entitlement.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, group.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, entitlement.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, group.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, entitlement.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, group.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, entitlement.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, group.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, entitlement.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, group.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, entitlement.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, group.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, entitlement.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, group.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, entitlement.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, group.DeletedAtIsNil())
+		predicates = append(predicates, entitlement.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, group.DeletedAtNotNil())
+		predicates = append(predicates, entitlement.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, group.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, entitlement.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, group.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, entitlement.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, group.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, entitlement.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, group.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, entitlement.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, group.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, entitlement.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, group.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, entitlement.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, group.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, entitlement.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, group.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, entitlement.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, group.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, entitlement.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, group.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, entitlement.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, group.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, entitlement.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, group.DeletedByIsNil())
+		predicates = append(predicates, entitlement.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, group.DeletedByNotNil())
+		predicates = append(predicates, entitlement.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, group.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, entitlement.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, group.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, entitlement.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, group.NameEQ(*i.Name))
+	if i.Tier != nil {
+		predicates = append(predicates, entitlement.TierEQ(*i.Tier))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, group.NameNEQ(*i.NameNEQ))
+	if i.TierNEQ != nil {
+		predicates = append(predicates, entitlement.TierNEQ(*i.TierNEQ))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, group.NameIn(i.NameIn...))
+	if len(i.TierIn) > 0 {
+		predicates = append(predicates, entitlement.TierIn(i.TierIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, group.NameNotIn(i.NameNotIn...))
+	if len(i.TierNotIn) > 0 {
+		predicates = append(predicates, entitlement.TierNotIn(i.TierNotIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, group.NameGT(*i.NameGT))
+	if i.ExternalCustomerID != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDEQ(*i.ExternalCustomerID))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, group.NameGTE(*i.NameGTE))
+	if i.ExternalCustomerIDNEQ != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDNEQ(*i.ExternalCustomerIDNEQ))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, group.NameLT(*i.NameLT))
+	if len(i.ExternalCustomerIDIn) > 0 {
+		predicates = append(predicates, entitlement.ExternalCustomerIDIn(i.ExternalCustomerIDIn...))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, group.NameLTE(*i.NameLTE))
+	if len(i.ExternalCustomerIDNotIn) > 0 {
+		predicates = append(predicates, entitlement.ExternalCustomerIDNotIn(i.ExternalCustomerIDNotIn...))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, group.NameContains(*i.NameContains))
+	if i.ExternalCustomerIDGT != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDGT(*i.ExternalCustomerIDGT))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, group.NameHasPrefix(*i.NameHasPrefix))
+	if i.ExternalCustomerIDGTE != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDGTE(*i.ExternalCustomerIDGTE))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, group.NameHasSuffix(*i.NameHasSuffix))
+	if i.ExternalCustomerIDLT != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDLT(*i.ExternalCustomerIDLT))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, group.NameEqualFold(*i.NameEqualFold))
+	if i.ExternalCustomerIDLTE != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDLTE(*i.ExternalCustomerIDLTE))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, group.NameContainsFold(*i.NameContainsFold))
+	if i.ExternalCustomerIDContains != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDContains(*i.ExternalCustomerIDContains))
 	}
-	if i.DisplayName != nil {
-		predicates = append(predicates, group.DisplayNameEQ(*i.DisplayName))
+	if i.ExternalCustomerIDHasPrefix != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDHasPrefix(*i.ExternalCustomerIDHasPrefix))
 	}
-	if i.DisplayNameNEQ != nil {
-		predicates = append(predicates, group.DisplayNameNEQ(*i.DisplayNameNEQ))
+	if i.ExternalCustomerIDHasSuffix != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDHasSuffix(*i.ExternalCustomerIDHasSuffix))
 	}
-	if len(i.DisplayNameIn) > 0 {
-		predicates = append(predicates, group.DisplayNameIn(i.DisplayNameIn...))
+	if i.ExternalCustomerIDIsNil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDIsNil())
 	}
-	if len(i.DisplayNameNotIn) > 0 {
-		predicates = append(predicates, group.DisplayNameNotIn(i.DisplayNameNotIn...))
+	if i.ExternalCustomerIDNotNil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDNotNil())
 	}
-	if i.DisplayNameGT != nil {
-		predicates = append(predicates, group.DisplayNameGT(*i.DisplayNameGT))
+	if i.ExternalCustomerIDEqualFold != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDEqualFold(*i.ExternalCustomerIDEqualFold))
 	}
-	if i.DisplayNameGTE != nil {
-		predicates = append(predicates, group.DisplayNameGTE(*i.DisplayNameGTE))
+	if i.ExternalCustomerIDContainsFold != nil {
+		predicates = append(predicates, entitlement.ExternalCustomerIDContainsFold(*i.ExternalCustomerIDContainsFold))
 	}
-	if i.DisplayNameLT != nil {
-		predicates = append(predicates, group.DisplayNameLT(*i.DisplayNameLT))
+	if i.ExternalSubscriptionID != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDEQ(*i.ExternalSubscriptionID))
 	}
-	if i.DisplayNameLTE != nil {
-		predicates = append(predicates, group.DisplayNameLTE(*i.DisplayNameLTE))
+	if i.ExternalSubscriptionIDNEQ != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDNEQ(*i.ExternalSubscriptionIDNEQ))
 	}
-	if i.DisplayNameContains != nil {
-		predicates = append(predicates, group.DisplayNameContains(*i.DisplayNameContains))
+	if len(i.ExternalSubscriptionIDIn) > 0 {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDIn(i.ExternalSubscriptionIDIn...))
 	}
-	if i.DisplayNameHasPrefix != nil {
-		predicates = append(predicates, group.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	if len(i.ExternalSubscriptionIDNotIn) > 0 {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDNotIn(i.ExternalSubscriptionIDNotIn...))
 	}
-	if i.DisplayNameHasSuffix != nil {
-		predicates = append(predicates, group.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	if i.ExternalSubscriptionIDGT != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDGT(*i.ExternalSubscriptionIDGT))
 	}
-	if i.DisplayNameEqualFold != nil {
-		predicates = append(predicates, group.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	if i.ExternalSubscriptionIDGTE != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDGTE(*i.ExternalSubscriptionIDGTE))
 	}
-	if i.DisplayNameContainsFold != nil {
-		predicates = append(predicates, group.DisplayNameContainsFold(*i.DisplayNameContainsFold))
+	if i.ExternalSubscriptionIDLT != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDLT(*i.ExternalSubscriptionIDLT))
 	}
-
-	if i.HasSetting != nil {
-		p := group.HasSetting()
-		if !*i.HasSetting {
-			p = group.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ExternalSubscriptionIDLTE != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDLTE(*i.ExternalSubscriptionIDLTE))
 	}
-	if len(i.HasSettingWith) > 0 {
-		with := make([]predicate.GroupSetting, 0, len(i.HasSettingWith))
-		for _, w := range i.HasSettingWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, group.HasSettingWith(with...))
+	if i.ExternalSubscriptionIDContains != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDContains(*i.ExternalSubscriptionIDContains))
 	}
-	if i.HasUsers != nil {
-		p := group.HasUsers()
-		if !*i.HasUsers {
-			p = group.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ExternalSubscriptionIDHasPrefix != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDHasPrefix(*i.ExternalSubscriptionIDHasPrefix))
 	}
-	if len(i.HasUsersWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUsersWith))
-		for _, w := range i.HasUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, group.HasUsersWith(with...))
+	if i.ExternalSubscriptionIDHasSuffix != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDHasSuffix(*i.ExternalSubscriptionIDHasSuffix))
 	}
-	if i.HasOwner != nil {
-		p := group.HasOwner()
-		if !*i.HasOwner {
-			p = group.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ExternalSubscriptionIDIsNil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDIsNil())
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, group.HasOwnerWith(with...))
+	if i.ExternalSubscriptionIDNotNil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDNotNil())
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyGroupWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return group.And(predicates...), nil
+	if i.ExternalSubscriptionIDEqualFold != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDEqualFold(*i.ExternalSubscriptionIDEqualFold))
 	}
-}
-
-// GroupSettingWhereInput represents a where input for filtering GroupSetting queries.
-type GroupSettingWhereInput struct {
-	Predicates []predicate.GroupSetting  `json:"-"`
-	Not        *GroupSettingWhereInput   `json:"not,omitempty"`
-	Or         []*GroupSettingWhereInput `json:"or,omitempty"`
-	And        []*GroupSettingWhereInput `json:"and,omitempty"`
+	if i.ExternalSubscriptionIDContainsFold != nil {
+		predicates = append(predicates, entitlement.ExternalSubscriptionIDContainsFold(*i.ExternalSubscriptionIDContainsFold))
+	}
+	if i.Expires != nil {
+		predicates = append(predicates, entitlement.ExpiresEQ(*i.Expires))
+	}
+	if i.ExpiresNEQ != nil {
+		predicates = append(predicates, entitlement.ExpiresNEQ(*i.ExpiresNEQ))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, entitlement.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, entitlement.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, entitlement.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, entitlement.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, entitlement.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, entitlement.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, entitlement.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, entitlement.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, entitlement.ExpiresAtIsNil())
+	}
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, entitlement.ExpiresAtNotNil())
+	}
+	if i.Cancelled != nil {
+		predicates = append(predicates, entitlement.CancelledEQ(*i.Cancelled))
+	}
+	if i.CancelledNEQ != nil {
+		predicates = append(predicates, entitlement.CancelledNEQ(*i.CancelledNEQ))
+	}
+
+	if i.HasOwner != nil {
+		p := entitlement.HasOwner()
+		if !*i.HasOwner {
+			p = entitlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, entitlement.HasOwnerWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyEntitlementWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return entitlement.And(predicates...), nil
+	}
+}
+
+// GroupWhereInput represents a where input for filtering Group queries.
+type GroupWhereInput struct {
+	Predicates []predicate.Group  `json:"-"`
+	Not        *GroupWhereInput   `json:"not,omitempty"`
+	Or         []*GroupWhereInput `json:"or,omitempty"`
+	And        []*GroupWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -1380,44 +1482,66 @@ type GroupSettingWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "visibility" field predicates.
-	Visibility      *groupsetting.Visibility  `json:"visibility,omitempty"`
-	VisibilityNEQ   *groupsetting.Visibility  `json:"visibilityNEQ,omitempty"`
-	VisibilityIn    []groupsetting.Visibility `json:"visibilityIn,omitempty"`
-	VisibilityNotIn []groupsetting.Visibility `json:"visibilityNotIn,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "join_policy" field predicates.
-	JoinPolicy      *groupsetting.JoinPolicy  `json:"joinPolicy,omitempty"`
-	JoinPolicyNEQ   *groupsetting.JoinPolicy  `json:"joinPolicyNEQ,omitempty"`
-	JoinPolicyIn    []groupsetting.JoinPolicy `json:"joinPolicyIn,omitempty"`
-	JoinPolicyNotIn []groupsetting.JoinPolicy `json:"joinPolicyNotIn,omitempty"`
+	// "display_name" field predicates.
+	DisplayName             *string  `json:"displayName,omitempty"`
+	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
+	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
+	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
+	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
+	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
+	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
+	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
+	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
+	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
+	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
+	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
+	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
 
-	// "sync_to_slack" field predicates.
-	SyncToSlack    *bool `json:"syncToSlack,omitempty"`
-	SyncToSlackNEQ *bool `json:"syncToSlackNEQ,omitempty"`
+	// "setting" edge predicates.
+	HasSetting     *bool                     `json:"hasSetting,omitempty"`
+	HasSettingWith []*GroupSettingWhereInput `json:"hasSettingWith,omitempty"`
 
-	// "sync_to_github" field predicates.
-	SyncToGithub    *bool `json:"syncToGithub,omitempty"`
-	SyncToGithubNEQ *bool `json:"syncToGithubNEQ,omitempty"`
+	// "users" edge predicates.
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
 
-	// "group" edge predicates.
-	HasGroup     *bool              `json:"hasGroup,omitempty"`
-	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "members" edge predicates.
+	HasMembers     *bool                        `json:"hasMembers,omitempty"`
+	HasMembersWith []*GroupMembershipWhereInput `json:"hasMembersWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *GroupSettingWhereInput) AddPredicates(predicates ...predicate.GroupSetting) {
+func (i *GroupWhereInput) AddPredicates(predicates ...predicate.Group) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the GroupSettingWhereInput filter on the GroupSettingQuery builder.
-func (i *GroupSettingWhereInput) Filter(q *GroupSettingQuery) (*GroupSettingQuery, error) {
+// Filter applies the GroupWhereInput filter on the GroupQuery builder.
+func (i *GroupWhereInput) Filter(q *GroupQuery) (*GroupQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyGroupSettingWhereInput {
+		if err == ErrEmptyGroupWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1425,19 +1549,19 @@ func (i *GroupSettingWhereInput) Filter(q *GroupSettingQuery) (*GroupSettingQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyGroupSettingWhereInput is returned in case the GroupSettingWhereInput is empty.
-var ErrEmptyGroupSettingWhereInput = errors.New("generated: empty predicate GroupSettingWhereInput")
+// ErrEmptyGroupWhereInput is returned in case the GroupWhereInput is empty.
+var ErrEmptyGroupWhereInput = errors.New("generated: empty predicate GroupWhereInput")
 
-// P returns a predicate for filtering groupsettings.
+// P returns a predicate for filtering groups.
 // An error is returned if the input is empty or invalid.
-func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
-	var predicates []predicate.GroupSetting
+func (i *GroupWhereInput) P() (predicate.Group, error) {
+	var predicates []predicate.Group
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, groupsetting.Not(p))
+		predicates = append(predicates, group.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1447,7 +1571,7 @@ func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.GroupSetting, 0, n)
+		or := make([]predicate.Group, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1455,7 +1579,7 @@ func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, groupsetting.Or(or...))
+		predicates = append(predicates, group.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1465,7 +1589,7 @@ func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.GroupSetting, 0, n)
+		and := make([]predicate.Group, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1473,323 +1597,419 @@ func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, groupsetting.And(and...))
+		predicates = append(predicates, group.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, groupsetting.IDEQ(*i.ID))
+		predicates = append(predicates, group.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, groupsetting.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, group.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, groupsetting.IDIn(i.IDIn...))
+		predicates = append(predicates, group.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, groupsetting.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, group.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, groupsetting.IDGT(*i.IDGT))
+		predicates = append(predicates, group.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, groupsetting.IDGTE(*i.IDGTE))
+		predicates = append(predicates, group.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, groupsetting.IDLT(*i.IDLT))
+		predicates = append(predicates, group.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, groupsetting.IDLTE(*i.IDLTE))
+		predicates = append(predicates, group.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, groupsetting.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, group.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, groupsetting.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, group.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, groupsetting.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, group.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, groupsetting.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, group.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, groupsetting.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, group.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, groupsetting.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, group.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, groupsetting.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, group.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, groupsetting.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, group.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, groupsetting.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, group.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, groupsetting.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, group.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, group.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, group.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, groupsetting.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, group.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, groupsetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, group.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, group.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, group.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, group.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, groupsetting.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, group.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, groupsetting.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, group.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, groupsetting.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, group.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, groupsetting.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, group.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, groupsetting.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, group.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, groupsetting.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, group.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, groupsetting.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, group.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, groupsetting.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, group.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, groupsetting.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, group.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, groupsetting.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, group.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, groupsetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, group.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, groupsetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, group.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, groupsetting.CreatedByIsNil())
+		predicates = append(predicates, group.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, groupsetting.CreatedByNotNil())
+		predicates = append(predicates, group.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, groupsetting.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, group.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, groupsetting.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, group.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, groupsetting.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, group.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, groupsetting.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, group.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, groupsetting.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, group.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, groupsetting.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, group.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, groupsetting.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, group.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, groupsetting.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, group.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, groupsetting.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, group.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, groupsetting.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, group.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, groupsetting.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, group.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, groupsetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, group.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, groupsetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, group.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, groupsetting.UpdatedByIsNil())
+		predicates = append(predicates, group.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, groupsetting.UpdatedByNotNil())
+		predicates = append(predicates, group.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, groupsetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, group.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, groupsetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, group.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, groupsetting.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, group.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, groupsetting.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, group.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, groupsetting.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, group.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, groupsetting.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, group.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, groupsetting.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, group.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, groupsetting.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, group.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, groupsetting.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, group.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, groupsetting.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, group.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, groupsetting.DeletedAtIsNil())
+		predicates = append(predicates, group.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, groupsetting.DeletedAtNotNil())
+		predicates = append(predicates, group.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, groupsetting.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, group.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, groupsetting.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, group.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, groupsetting.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, group.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, groupsetting.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, group.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, groupsetting.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, group.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, groupsetting.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, group.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, groupsetting.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, group.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, groupsetting.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, group.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, groupsetting.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, group.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, groupsetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, group.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, groupsetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, group.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, groupsetting.DeletedByIsNil())
+		predicates = append(predicates, group.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, groupsetting.DeletedByNotNil())
+		predicates = append(predicates, group.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, groupsetting.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, group.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, groupsetting.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, group.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.Visibility != nil {
-		predicates = append(predicates, groupsetting.VisibilityEQ(*i.Visibility))
+	if i.Name != nil {
+		predicates = append(predicates, group.NameEQ(*i.Name))
 	}
-	if i.VisibilityNEQ != nil {
-		predicates = append(predicates, groupsetting.VisibilityNEQ(*i.VisibilityNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, group.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.VisibilityIn) > 0 {
-		predicates = append(predicates, groupsetting.VisibilityIn(i.VisibilityIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, group.NameIn(i.NameIn...))
 	}
-	if len(i.VisibilityNotIn) > 0 {
-		predicates = append(predicates, groupsetting.VisibilityNotIn(i.VisibilityNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, group.NameNotIn(i.NameNotIn...))
 	}
-	if i.JoinPolicy != nil {
-		predicates = append(predicates, groupsetting.JoinPolicyEQ(*i.JoinPolicy))
+	if i.NameGT != nil {
+		predicates = append(predicates, group.NameGT(*i.NameGT))
 	}
-	if i.JoinPolicyNEQ != nil {
-		predicates = append(predicates, groupsetting.JoinPolicyNEQ(*i.JoinPolicyNEQ))
+	if i.NameGTE != nil {
+		predicates = append(predicates, group.NameGTE(*i.NameGTE))
 	}
-	if len(i.JoinPolicyIn) > 0 {
-		predicates = append(predicates, groupsetting.JoinPolicyIn(i.JoinPolicyIn...))
+	if i.NameLT != nil {
+		predicates = append(predicates, group.NameLT(*i.NameLT))
 	}
-	if len(i.JoinPolicyNotIn) > 0 {
-		predicates = append(predicates, groupsetting.JoinPolicyNotIn(i.JoinPolicyNotIn...))
+	if i.NameLTE != nil {
+		predicates = append(predicates, group.NameLTE(*i.NameLTE))
 	}
-	if i.SyncToSlack != nil {
-		predicates = append(predicates, groupsetting.SyncToSlackEQ(*i.SyncToSlack))
+	if i.NameContains != nil {
+		predicates = append(predicates, group.NameContains(*i.NameContains))
 	}
-	if i.SyncToSlackNEQ != nil {
-		predicates = append(predicates, groupsetting.SyncToSlackNEQ(*i.SyncToSlackNEQ))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, group.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.SyncToGithub != nil {
-		predicates = append(predicates, groupsetting.SyncToGithubEQ(*i.SyncToGithub))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, group.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.SyncToGithubNEQ != nil {
-		predicates = append(predicates, groupsetting.SyncToGithubNEQ(*i.SyncToGithubNEQ))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, group.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, group.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.DisplayName != nil {
+		predicates = append(predicates, group.DisplayNameEQ(*i.DisplayName))
+	}
+	if i.DisplayNameNEQ != nil {
+		predicates = append(predicates, group.DisplayNameNEQ(*i.DisplayNameNEQ))
+	}
+	if len(i.DisplayNameIn) > 0 {
+		predicates = append(predicates, group.DisplayNameIn(i.DisplayNameIn...))
+	}
+	if len(i.DisplayNameNotIn) > 0 {
+		predicates = append(predicates, group.DisplayNameNotIn(i.DisplayNameNotIn...))
+	}
+	if i.DisplayNameGT != nil {
+		predicates = append(predicates, group.DisplayNameGT(*i.DisplayNameGT))
+	}
+	if i.DisplayNameGTE != nil {
+		predicates = append(predicates, group.DisplayNameGTE(*i.DisplayNameGTE))
+	}
+	if i.DisplayNameLT != nil {
+		predicates = append(predicates, group.DisplayNameLT(*i.DisplayNameLT))
+	}
+	if i.DisplayNameLTE != nil {
+		predicates = append(predicates, group.DisplayNameLTE(*i.DisplayNameLTE))
+	}
+	if i.DisplayNameContains != nil {
+		predicates = append(predicates, group.DisplayNameContains(*i.DisplayNameContains))
+	}
+	if i.DisplayNameHasPrefix != nil {
+		predicates = append(predicates, group.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	}
+	if i.DisplayNameHasSuffix != nil {
+		predicates = append(predicates, group.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	}
+	if i.DisplayNameEqualFold != nil {
+		predicates = append(predicates, group.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	}
+	if i.DisplayNameContainsFold != nil {
+		predicates = append(predicates, group.DisplayNameContainsFold(*i.DisplayNameContainsFold))
 	}
 
-	if i.HasGroup != nil {
-		p := groupsetting.HasGroup()
-		if !*i.HasGroup {
-			p = groupsetting.Not(p)
+	if i.HasSetting != nil {
+		p := group.HasSetting()
+		if !*i.HasSetting {
+			p = group.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasGroupWith) > 0 {
-		with := make([]predicate.Group, 0, len(i.HasGroupWith))
-		for _, w := range i.HasGroupWith {
+	if len(i.HasSettingWith) > 0 {
+		with := make([]predicate.GroupSetting, 0, len(i.HasSettingWith))
+		for _, w := range i.HasSettingWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasGroupWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, groupsetting.HasGroupWith(with...))
+		predicates = append(predicates, group.HasSettingWith(with...))
+	}
+	if i.HasUsers != nil {
+		p := group.HasUsers()
+		if !*i.HasUsers {
+			p = group.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUsersWith))
+		for _, w := range i.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, group.HasUsersWith(with...))
+	}
+	if i.HasOwner != nil {
+		p := group.HasOwner()
+		if !*i.HasOwner {
+			p = group.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, group.HasOwnerWith(with...))
+	}
+	if i.HasMembers != nil {
+		p := group.HasMembers()
+		if !*i.HasMembers {
+			p = group.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMembersWith) > 0 {
+		with := make([]predicate.GroupMembership, 0, len(i.HasMembersWith))
+		for _, w := range i.HasMembersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMembersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, group.HasMembersWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyGroupSettingWhereInput
+		return nil, ErrEmptyGroupWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return groupsetting.And(predicates...), nil
+		return group.And(predicates...), nil
 	}
 }
 
-// IntegrationWhereInput represents a where input for filtering Integration queries.
-type IntegrationWhereInput struct {
-	Predicates []predicate.Integration  `json:"-"`
-	Not        *IntegrationWhereInput   `json:"not,omitempty"`
-	Or         []*IntegrationWhereInput `json:"or,omitempty"`
-	And        []*IntegrationWhereInput `json:"and,omitempty"`
+// GroupJoinRequestWhereInput represents a where input for filtering GroupJoinRequest queries.
+type GroupJoinRequestWhereInput struct {
+	Predicates []predicate.GroupJoinRequest  `json:"-"`
+	Not        *GroupJoinRequestWhereInput   `json:"not,omitempty"`
+	Or         []*GroupJoinRequestWhereInput `json:"or,omitempty"`
+	And        []*GroupJoinRequestWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -1857,100 +2077,98 @@ type IntegrationWhereInput struct {
 	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
 	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
 
-	// "deleted_at" field predicates.
-	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
-	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
-	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
-	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
-	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
-	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
-	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
-	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
-	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
-	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+	// "group_id" field predicates.
+	GroupID             *string  `json:"groupID,omitempty"`
+	GroupIDNEQ          *string  `json:"groupIDNEQ,omitempty"`
+	GroupIDIn           []string `json:"groupIDIn,omitempty"`
+	GroupIDNotIn        []string `json:"groupIDNotIn,omitempty"`
+	GroupIDGT           *string  `json:"groupIDGT,omitempty"`
+	GroupIDGTE          *string  `json:"groupIDGTE,omitempty"`
+	GroupIDLT           *string  `json:"groupIDLT,omitempty"`
+	GroupIDLTE          *string  `json:"groupIDLTE,omitempty"`
+	GroupIDContains     *string  `json:"groupIDContains,omitempty"`
+	GroupIDHasPrefix    *string  `json:"groupIDHasPrefix,omitempty"`
+	GroupIDHasSuffix    *string  `json:"groupIDHasSuffix,omitempty"`
+	GroupIDEqualFold    *string  `json:"groupIDEqualFold,omitempty"`
+	GroupIDContainsFold *string  `json:"groupIDContainsFold,omitempty"`
 
-	// "deleted_by" field predicates.
-	DeletedBy             *string  `json:"deletedBy,omitempty"`
-	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
-	DeletedByIn           []string `json:"deletedByIn,omitempty"`
-	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
-	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
-	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
-	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
-	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
-	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
-	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
-	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
-	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
-	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
-	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
-	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "message" field predicates.
+	Message             *string  `json:"message,omitempty"`
+	MessageNEQ          *string  `json:"messageNEQ,omitempty"`
+	MessageIn           []string `json:"messageIn,omitempty"`
+	MessageNotIn        []string `json:"messageNotIn,omitempty"`
+	MessageGT           *string  `json:"messageGT,omitempty"`
+	MessageGTE          *string  `json:"messageGTE,omitempty"`
+	MessageLT           *string  `json:"messageLT,omitempty"`
+	MessageLTE          *string  `json:"messageLTE,omitempty"`
+	MessageContains     *string  `json:"messageContains,omitempty"`
+	MessageHasPrefix    *string  `json:"messageHasPrefix,omitempty"`
+	MessageHasSuffix    *string  `json:"messageHasSuffix,omitempty"`
+	MessageIsNil        bool     `json:"messageIsNil,omitempty"`
+	MessageNotNil       bool     `json:"messageNotNil,omitempty"`
+	MessageEqualFold    *string  `json:"messageEqualFold,omitempty"`
+	MessageContainsFold *string  `json:"messageContainsFold,omitempty"`
 
-	// "kind" field predicates.
-	Kind             *string  `json:"kind,omitempty"`
-	KindNEQ          *string  `json:"kindNEQ,omitempty"`
-	KindIn           []string `json:"kindIn,omitempty"`
-	KindNotIn        []string `json:"kindNotIn,omitempty"`
-	KindGT           *string  `json:"kindGT,omitempty"`
-	KindGTE          *string  `json:"kindGTE,omitempty"`
-	KindLT           *string  `json:"kindLT,omitempty"`
-	KindLTE          *string  `json:"kindLTE,omitempty"`
-	KindContains     *string  `json:"kindContains,omitempty"`
-	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
-	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
-	KindIsNil        bool     `json:"kindIsNil,omitempty"`
-	KindNotNil       bool     `json:"kindNotNil,omitempty"`
-	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
-	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
+	// "status" field predicates.
+	Status      *groupjoinrequest.Status  `json:"status,omitempty"`
+	StatusNEQ   *groupjoinrequest.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []groupjoinrequest.Status `json:"statusIn,omitempty"`
+	StatusNotIn []groupjoinrequest.Status `json:"statusNotIn,omitempty"`
+
+	// "reviewer_id" field predicates.
+	ReviewerID             *string  `json:"reviewerID,omitempty"`
+	ReviewerIDNEQ          *string  `json:"reviewerIDNEQ,omitempty"`
+	ReviewerIDIn           []string `json:"reviewerIDIn,omitempty"`
+	ReviewerIDNotIn        []string `json:"reviewerIDNotIn,omitempty"`
+	ReviewerIDGT           *string  `json:"reviewerIDGT,omitempty"`
+	ReviewerIDGTE          *string  `json:"reviewerIDGTE,omitempty"`
+	ReviewerIDLT           *string  `json:"reviewerIDLT,omitempty"`
+	ReviewerIDLTE          *string  `json:"reviewerIDLTE,omitempty"`
+	ReviewerIDContains     *string  `json:"reviewerIDContains,omitempty"`
+	ReviewerIDHasPrefix    *string  `json:"reviewerIDHasPrefix,omitempty"`
+	ReviewerIDHasSuffix    *string  `json:"reviewerIDHasSuffix,omitempty"`
+	ReviewerIDIsNil        bool     `json:"reviewerIDIsNil,omitempty"`
+	ReviewerIDNotNil       bool     `json:"reviewerIDNotNil,omitempty"`
+	ReviewerIDEqualFold    *string  `json:"reviewerIDEqualFold,omitempty"`
+	ReviewerIDContainsFold *string  `json:"reviewerIDContainsFold,omitempty"`
 
-	// "secret_name" field predicates.
-	SecretName             *string  `json:"secretName,omitempty"`
-	SecretNameNEQ          *string  `json:"secretNameNEQ,omitempty"`
-	SecretNameIn           []string `json:"secretNameIn,omitempty"`
-	SecretNameNotIn        []string `json:"secretNameNotIn,omitempty"`
-	SecretNameGT           *string  `json:"secretNameGT,omitempty"`
-	SecretNameGTE          *string  `json:"secretNameGTE,omitempty"`
-	SecretNameLT           *string  `json:"secretNameLT,omitempty"`
-	SecretNameLTE          *string  `json:"secretNameLTE,omitempty"`
-	SecretNameContains     *string  `json:"secretNameContains,omitempty"`
-	SecretNameHasPrefix    *string  `json:"secretNameHasPrefix,omitempty"`
-	SecretNameHasSuffix    *string  `json:"secretNameHasSuffix,omitempty"`
-	SecretNameEqualFold    *string  `json:"secretNameEqualFold,omitempty"`
-	SecretNameContainsFold *string  `json:"secretNameContainsFold,omitempty"`
+	// "group" edge predicates.
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
 
-	// "owner" edge predicates.
-	HasOwner     *bool                     `json:"hasOwner,omitempty"`
-	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *IntegrationWhereInput) AddPredicates(predicates ...predicate.Integration) {
+func (i *GroupJoinRequestWhereInput) AddPredicates(predicates ...predicate.GroupJoinRequest) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the IntegrationWhereInput filter on the IntegrationQuery builder.
-func (i *IntegrationWhereInput) Filter(q *IntegrationQuery) (*IntegrationQuery, error) {
+// Filter applies the GroupJoinRequestWhereInput filter on the GroupJoinRequestQuery builder.
+func (i *GroupJoinRequestWhereInput) Filter(q *GroupJoinRequestQuery) (*GroupJoinRequestQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyIntegrationWhereInput {
+		if err == ErrEmptyGroupJoinRequestWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1958,19 +2176,19 @@ func (i *IntegrationWhereInput) Filter(q *IntegrationQuery) (*IntegrationQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyIntegrationWhereInput is returned in case the IntegrationWhereInput is empty.
-var ErrEmptyIntegrationWhereInput = errors.New("generated: empty predicate IntegrationWhereInput")
+// ErrEmptyGroupJoinRequestWhereInput is returned in case the GroupJoinRequestWhereInput is empty.
+var ErrEmptyGroupJoinRequestWhereInput = errors.New("generated: empty predicate GroupJoinRequestWhereInput")
 
-// P returns a predicate for filtering integrations.
+// P returns a predicate for filtering groupjoinrequests.
 // An error is returned if the input is empty or invalid.
-func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
-	var predicates []predicate.Integration
+func (i *GroupJoinRequestWhereInput) P() (predicate.GroupJoinRequest, error) {
+	var predicates []predicate.GroupJoinRequest
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, integration.Not(p))
+		predicates = append(predicates, groupjoinrequest.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1980,7 +2198,7 @@ func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Integration, 0, n)
+		or := make([]predicate.GroupJoinRequest, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1988,7 +2206,7 @@ func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, integration.Or(or...))
+		predicates = append(predicates, groupjoinrequest.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1998,7 +2216,7 @@ func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Integration, 0, n)
+		and := make([]predicate.GroupJoinRequest, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2006,410 +2224,410 @@ func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, integration.And(and...))
+		predicates = append(predicates, groupjoinrequest.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, integration.IDEQ(*i.ID))
+		predicates = append(predicates, groupjoinrequest.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, integration.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, groupjoinrequest.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, integration.IDIn(i.IDIn...))
+		predicates = append(predicates, groupjoinrequest.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, integration.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, groupjoinrequest.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, integration.IDGT(*i.IDGT))
+		predicates = append(predicates, groupjoinrequest.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, integration.IDGTE(*i.IDGTE))
+		predicates = append(predicates, groupjoinrequest.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, integration.IDLT(*i.IDLT))
+		predicates = append(predicates, groupjoinrequest.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, integration.IDLTE(*i.IDLTE))
+		predicates = append(predicates, groupjoinrequest.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, integration.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, groupjoinrequest.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, integration.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, groupjoinrequest.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, integration.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, groupjoinrequest.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, integration.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, groupjoinrequest.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, integration.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, groupjoinrequest.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, integration.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, groupjoinrequest.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, integration.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, groupjoinrequest.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, integration.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, groupjoinrequest.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, integration.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, groupjoinrequest.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, integration.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, groupjoinrequest.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, integration.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, integration.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, integration.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, integration.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, integration.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, integration.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, integration.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, integration.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, groupjoinrequest.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, integration.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, groupjoinrequest.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, integration.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, groupjoinrequest.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, integration.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, groupjoinrequest.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, integration.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, groupjoinrequest.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, integration.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, groupjoinrequest.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, integration.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, groupjoinrequest.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, integration.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, groupjoinrequest.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, integration.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, groupjoinrequest.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, integration.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, groupjoinrequest.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, integration.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, groupjoinrequest.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, integration.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, groupjoinrequest.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, integration.CreatedByIsNil())
+		predicates = append(predicates, groupjoinrequest.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, integration.CreatedByNotNil())
+		predicates = append(predicates, groupjoinrequest.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, integration.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, groupjoinrequest.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, integration.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, groupjoinrequest.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, integration.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, groupjoinrequest.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, integration.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, groupjoinrequest.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, integration.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, groupjoinrequest.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, integration.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, groupjoinrequest.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, integration.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, groupjoinrequest.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, integration.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, groupjoinrequest.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, integration.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, groupjoinrequest.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, integration.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, groupjoinrequest.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, integration.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, groupjoinrequest.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, integration.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, groupjoinrequest.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, integration.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, groupjoinrequest.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, integration.UpdatedByIsNil())
+		predicates = append(predicates, groupjoinrequest.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, integration.UpdatedByNotNil())
+		predicates = append(predicates, groupjoinrequest.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, integration.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, groupjoinrequest.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, integration.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, groupjoinrequest.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if i.DeletedAt != nil {
-		predicates = append(predicates, integration.DeletedAtEQ(*i.DeletedAt))
+	if i.GroupID != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDEQ(*i.GroupID))
 	}
-	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, integration.DeletedAtNEQ(*i.DeletedAtNEQ))
+	if i.GroupIDNEQ != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDNEQ(*i.GroupIDNEQ))
 	}
-	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, integration.DeletedAtIn(i.DeletedAtIn...))
+	if len(i.GroupIDIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.GroupIDIn(i.GroupIDIn...))
 	}
-	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, integration.DeletedAtNotIn(i.DeletedAtNotIn...))
+	if len(i.GroupIDNotIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.GroupIDNotIn(i.GroupIDNotIn...))
 	}
-	if i.DeletedAtGT != nil {
-		predicates = append(predicates, integration.DeletedAtGT(*i.DeletedAtGT))
+	if i.GroupIDGT != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDGT(*i.GroupIDGT))
 	}
-	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, integration.DeletedAtGTE(*i.DeletedAtGTE))
+	if i.GroupIDGTE != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDGTE(*i.GroupIDGTE))
 	}
-	if i.DeletedAtLT != nil {
-		predicates = append(predicates, integration.DeletedAtLT(*i.DeletedAtLT))
+	if i.GroupIDLT != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDLT(*i.GroupIDLT))
 	}
-	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, integration.DeletedAtLTE(*i.DeletedAtLTE))
+	if i.GroupIDLTE != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDLTE(*i.GroupIDLTE))
 	}
-	if i.DeletedAtIsNil {
-		predicates = append(predicates, integration.DeletedAtIsNil())
+	if i.GroupIDContains != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDContains(*i.GroupIDContains))
 	}
-	if i.DeletedAtNotNil {
-		predicates = append(predicates, integration.DeletedAtNotNil())
+	if i.GroupIDHasPrefix != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDHasPrefix(*i.GroupIDHasPrefix))
 	}
-	if i.DeletedBy != nil {
-		predicates = append(predicates, integration.DeletedByEQ(*i.DeletedBy))
+	if i.GroupIDHasSuffix != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDHasSuffix(*i.GroupIDHasSuffix))
 	}
-	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, integration.DeletedByNEQ(*i.DeletedByNEQ))
+	if i.GroupIDEqualFold != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDEqualFold(*i.GroupIDEqualFold))
 	}
-	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, integration.DeletedByIn(i.DeletedByIn...))
+	if i.GroupIDContainsFold != nil {
+		predicates = append(predicates, groupjoinrequest.GroupIDContainsFold(*i.GroupIDContainsFold))
 	}
-	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, integration.DeletedByNotIn(i.DeletedByNotIn...))
+	if i.UserID != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDEQ(*i.UserID))
 	}
-	if i.DeletedByGT != nil {
-		predicates = append(predicates, integration.DeletedByGT(*i.DeletedByGT))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.DeletedByGTE != nil {
-		predicates = append(predicates, integration.DeletedByGTE(*i.DeletedByGTE))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.UserIDIn(i.UserIDIn...))
 	}
-	if i.DeletedByLT != nil {
-		predicates = append(predicates, integration.DeletedByLT(*i.DeletedByLT))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.DeletedByLTE != nil {
-		predicates = append(predicates, integration.DeletedByLTE(*i.DeletedByLTE))
+	if i.UserIDGT != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDGT(*i.UserIDGT))
 	}
-	if i.DeletedByContains != nil {
-		predicates = append(predicates, integration.DeletedByContains(*i.DeletedByContains))
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDGTE(*i.UserIDGTE))
 	}
-	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, integration.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	if i.UserIDLT != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDLT(*i.UserIDLT))
 	}
-	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, integration.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDLTE(*i.UserIDLTE))
 	}
-	if i.DeletedByIsNil {
-		predicates = append(predicates, integration.DeletedByIsNil())
+	if i.UserIDContains != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDContains(*i.UserIDContains))
 	}
-	if i.DeletedByNotNil {
-		predicates = append(predicates, integration.DeletedByNotNil())
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDHasPrefix(*i.UserIDHasPrefix))
 	}
-	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, integration.DeletedByEqualFold(*i.DeletedByEqualFold))
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDHasSuffix(*i.UserIDHasSuffix))
 	}
-	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, integration.DeletedByContainsFold(*i.DeletedByContainsFold))
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDEqualFold(*i.UserIDEqualFold))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, integration.NameEQ(*i.Name))
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, groupjoinrequest.UserIDContainsFold(*i.UserIDContainsFold))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, integration.NameNEQ(*i.NameNEQ))
+	if i.Message != nil {
+		predicates = append(predicates, groupjoinrequest.MessageEQ(*i.Message))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, integration.NameIn(i.NameIn...))
+	if i.MessageNEQ != nil {
+		predicates = append(predicates, groupjoinrequest.MessageNEQ(*i.MessageNEQ))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, integration.NameNotIn(i.NameNotIn...))
+	if len(i.MessageIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.MessageIn(i.MessageIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, integration.NameGT(*i.NameGT))
+	if len(i.MessageNotIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.MessageNotIn(i.MessageNotIn...))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, integration.NameGTE(*i.NameGTE))
+	if i.MessageGT != nil {
+		predicates = append(predicates, groupjoinrequest.MessageGT(*i.MessageGT))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, integration.NameLT(*i.NameLT))
+	if i.MessageGTE != nil {
+		predicates = append(predicates, groupjoinrequest.MessageGTE(*i.MessageGTE))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, integration.NameLTE(*i.NameLTE))
+	if i.MessageLT != nil {
+		predicates = append(predicates, groupjoinrequest.MessageLT(*i.MessageLT))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, integration.NameContains(*i.NameContains))
+	if i.MessageLTE != nil {
+		predicates = append(predicates, groupjoinrequest.MessageLTE(*i.MessageLTE))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, integration.NameHasPrefix(*i.NameHasPrefix))
+	if i.MessageContains != nil {
+		predicates = append(predicates, groupjoinrequest.MessageContains(*i.MessageContains))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, integration.NameHasSuffix(*i.NameHasSuffix))
+	if i.MessageHasPrefix != nil {
+		predicates = append(predicates, groupjoinrequest.MessageHasPrefix(*i.MessageHasPrefix))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, integration.NameEqualFold(*i.NameEqualFold))
+	if i.MessageHasSuffix != nil {
+		predicates = append(predicates, groupjoinrequest.MessageHasSuffix(*i.MessageHasSuffix))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, integration.NameContainsFold(*i.NameContainsFold))
+	if i.MessageIsNil {
+		predicates = append(predicates, groupjoinrequest.MessageIsNil())
 	}
-	if i.Kind != nil {
-		predicates = append(predicates, integration.KindEQ(*i.Kind))
+	if i.MessageNotNil {
+		predicates = append(predicates, groupjoinrequest.MessageNotNil())
 	}
-	if i.KindNEQ != nil {
-		predicates = append(predicates, integration.KindNEQ(*i.KindNEQ))
+	if i.MessageEqualFold != nil {
+		predicates = append(predicates, groupjoinrequest.MessageEqualFold(*i.MessageEqualFold))
 	}
-	if len(i.KindIn) > 0 {
-		predicates = append(predicates, integration.KindIn(i.KindIn...))
+	if i.MessageContainsFold != nil {
+		predicates = append(predicates, groupjoinrequest.MessageContainsFold(*i.MessageContainsFold))
 	}
-	if len(i.KindNotIn) > 0 {
-		predicates = append(predicates, integration.KindNotIn(i.KindNotIn...))
+	if i.Status != nil {
+		predicates = append(predicates, groupjoinrequest.StatusEQ(*i.Status))
 	}
-	if i.KindGT != nil {
-		predicates = append(predicates, integration.KindGT(*i.KindGT))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, groupjoinrequest.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.KindGTE != nil {
-		predicates = append(predicates, integration.KindGTE(*i.KindGTE))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.StatusIn(i.StatusIn...))
 	}
-	if i.KindLT != nil {
-		predicates = append(predicates, integration.KindLT(*i.KindLT))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.KindLTE != nil {
-		predicates = append(predicates, integration.KindLTE(*i.KindLTE))
+	if i.ReviewerID != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDEQ(*i.ReviewerID))
 	}
-	if i.KindContains != nil {
-		predicates = append(predicates, integration.KindContains(*i.KindContains))
-	}
-	if i.KindHasPrefix != nil {
-		predicates = append(predicates, integration.KindHasPrefix(*i.KindHasPrefix))
-	}
-	if i.KindHasSuffix != nil {
-		predicates = append(predicates, integration.KindHasSuffix(*i.KindHasSuffix))
+	if i.ReviewerIDNEQ != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDNEQ(*i.ReviewerIDNEQ))
 	}
-	if i.KindIsNil {
-		predicates = append(predicates, integration.KindIsNil())
-	}
-	if i.KindNotNil {
-		predicates = append(predicates, integration.KindNotNil())
-	}
-	if i.KindEqualFold != nil {
-		predicates = append(predicates, integration.KindEqualFold(*i.KindEqualFold))
+	if len(i.ReviewerIDIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDIn(i.ReviewerIDIn...))
 	}
-	if i.KindContainsFold != nil {
-		predicates = append(predicates, integration.KindContainsFold(*i.KindContainsFold))
+	if len(i.ReviewerIDNotIn) > 0 {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDNotIn(i.ReviewerIDNotIn...))
 	}
-	if i.SecretName != nil {
-		predicates = append(predicates, integration.SecretNameEQ(*i.SecretName))
+	if i.ReviewerIDGT != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDGT(*i.ReviewerIDGT))
 	}
-	if i.SecretNameNEQ != nil {
-		predicates = append(predicates, integration.SecretNameNEQ(*i.SecretNameNEQ))
+	if i.ReviewerIDGTE != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDGTE(*i.ReviewerIDGTE))
 	}
-	if len(i.SecretNameIn) > 0 {
-		predicates = append(predicates, integration.SecretNameIn(i.SecretNameIn...))
+	if i.ReviewerIDLT != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDLT(*i.ReviewerIDLT))
 	}
-	if len(i.SecretNameNotIn) > 0 {
-		predicates = append(predicates, integration.SecretNameNotIn(i.SecretNameNotIn...))
+	if i.ReviewerIDLTE != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDLTE(*i.ReviewerIDLTE))
 	}
-	if i.SecretNameGT != nil {
-		predicates = append(predicates, integration.SecretNameGT(*i.SecretNameGT))
+	if i.ReviewerIDContains != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDContains(*i.ReviewerIDContains))
 	}
-	if i.SecretNameGTE != nil {
-		predicates = append(predicates, integration.SecretNameGTE(*i.SecretNameGTE))
+	if i.ReviewerIDHasPrefix != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDHasPrefix(*i.ReviewerIDHasPrefix))
 	}
-	if i.SecretNameLT != nil {
-		predicates = append(predicates, integration.SecretNameLT(*i.SecretNameLT))
+	if i.ReviewerIDHasSuffix != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDHasSuffix(*i.ReviewerIDHasSuffix))
 	}
-	if i.SecretNameLTE != nil {
-		predicates = append(predicates, integration.SecretNameLTE(*i.SecretNameLTE))
+	if i.ReviewerIDIsNil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDIsNil())
 	}
-	if i.SecretNameContains != nil {
-		predicates = append(predicates, integration.SecretNameContains(*i.SecretNameContains))
+	if i.ReviewerIDNotNil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDNotNil())
 	}
-	if i.SecretNameHasPrefix != nil {
-		predicates = append(predicates, integration.SecretNameHasPrefix(*i.SecretNameHasPrefix))
+	if i.ReviewerIDEqualFold != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDEqualFold(*i.ReviewerIDEqualFold))
 	}
-	if i.SecretNameHasSuffix != nil {
-		predicates = append(predicates, integration.SecretNameHasSuffix(*i.SecretNameHasSuffix))
+	if i.ReviewerIDContainsFold != nil {
+		predicates = append(predicates, groupjoinrequest.ReviewerIDContainsFold(*i.ReviewerIDContainsFold))
 	}
-	if i.SecretNameEqualFold != nil {
-		predicates = append(predicates, integration.SecretNameEqualFold(*i.SecretNameEqualFold))
+
+	if i.HasGroup != nil {
+		p := groupjoinrequest.HasGroup()
+		if !*i.HasGroup {
+			p = groupjoinrequest.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.SecretNameContainsFold != nil {
-		predicates = append(predicates, integration.SecretNameContainsFold(*i.SecretNameContainsFold))
+	if len(i.HasGroupWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupWith))
+		for _, w := range i.HasGroupWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, groupjoinrequest.HasGroupWith(with...))
 	}
-
-	if i.HasOwner != nil {
-		p := integration.HasOwner()
-		if !*i.HasOwner {
-			p = integration.Not(p)
+	if i.HasUser != nil {
+		p := groupjoinrequest.HasUser()
+		if !*i.HasUser {
+			p = groupjoinrequest.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, integration.HasOwnerWith(with...))
+		predicates = append(predicates, groupjoinrequest.HasUserWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyIntegrationWhereInput
+		return nil, ErrEmptyGroupJoinRequestWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return integration.And(predicates...), nil
+		return groupjoinrequest.And(predicates...), nil
 	}
 }
 
-// OauthProviderWhereInput represents a where input for filtering OauthProvider queries.
-type OauthProviderWhereInput struct {
-	Predicates []predicate.OauthProvider  `json:"-"`
-	Not        *OauthProviderWhereInput   `json:"not,omitempty"`
-	Or         []*OauthProviderWhereInput `json:"or,omitempty"`
-	And        []*OauthProviderWhereInput `json:"and,omitempty"`
+// GroupMembershipWhereInput represents a where input for filtering GroupMembership queries.
+type GroupMembershipWhereInput struct {
+	Predicates []predicate.GroupMembership  `json:"-"`
+	Not        *GroupMembershipWhereInput   `json:"not,omitempty"`
+	Or         []*GroupMembershipWhereInput `json:"or,omitempty"`
+	And        []*GroupMembershipWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -2477,203 +2695,84 @@ type OauthProviderWhereInput struct {
 	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
 	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
 
-	// "deleted_at" field predicates.
-	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
-	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
-	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
-	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
-	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
-	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
-	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
-	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
-	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
-	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
-
-	// "deleted_by" field predicates.
-	DeletedBy             *string  `json:"deletedBy,omitempty"`
-	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
-	DeletedByIn           []string `json:"deletedByIn,omitempty"`
-	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
-	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
-	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
-	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
-	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
-	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
-	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
-	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
-	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
-	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
-	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
-	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
-
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-
-	// "client_id" field predicates.
-	ClientID             *string  `json:"clientID,omitempty"`
-	ClientIDNEQ          *string  `json:"clientIDNEQ,omitempty"`
-	ClientIDIn           []string `json:"clientIDIn,omitempty"`
-	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
-	ClientIDGT           *string  `json:"clientIDGT,omitempty"`
-	ClientIDGTE          *string  `json:"clientIDGTE,omitempty"`
-	ClientIDLT           *string  `json:"clientIDLT,omitempty"`
-	ClientIDLTE          *string  `json:"clientIDLTE,omitempty"`
-	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
-	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
-	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
-	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
-	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
+	// "role" field predicates.
+	Role      *groupmembership.Role  `json:"role,omitempty"`
+	RoleNEQ   *groupmembership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []groupmembership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []groupmembership.Role `json:"roleNotIn,omitempty"`
+
+	// "group_id" field predicates.
+	GroupID             *string  `json:"groupID,omitempty"`
+	GroupIDNEQ          *string  `json:"groupIDNEQ,omitempty"`
+	GroupIDIn           []string `json:"groupIDIn,omitempty"`
+	GroupIDNotIn        []string `json:"groupIDNotIn,omitempty"`
+	GroupIDGT           *string  `json:"groupIDGT,omitempty"`
+	GroupIDGTE          *string  `json:"groupIDGTE,omitempty"`
+	GroupIDLT           *string  `json:"groupIDLT,omitempty"`
+	GroupIDLTE          *string  `json:"groupIDLTE,omitempty"`
+	GroupIDContains     *string  `json:"groupIDContains,omitempty"`
+	GroupIDHasPrefix    *string  `json:"groupIDHasPrefix,omitempty"`
+	GroupIDHasSuffix    *string  `json:"groupIDHasSuffix,omitempty"`
+	GroupIDEqualFold    *string  `json:"groupIDEqualFold,omitempty"`
+	GroupIDContainsFold *string  `json:"groupIDContainsFold,omitempty"`
 
-	// "client_secret" field predicates.
-	ClientSecret             *string  `json:"clientSecret,omitempty"`
-	ClientSecretNEQ          *string  `json:"clientSecretNEQ,omitempty"`
-	ClientSecretIn           []string `json:"clientSecretIn,omitempty"`
-	ClientSecretNotIn        []string `json:"clientSecretNotIn,omitempty"`
-	ClientSecretGT           *string  `json:"clientSecretGT,omitempty"`
-	ClientSecretGTE          *string  `json:"clientSecretGTE,omitempty"`
-	ClientSecretLT           *string  `json:"clientSecretLT,omitempty"`
-	ClientSecretLTE          *string  `json:"clientSecretLTE,omitempty"`
-	ClientSecretContains     *string  `json:"clientSecretContains,omitempty"`
-	ClientSecretHasPrefix    *string  `json:"clientSecretHasPrefix,omitempty"`
-	ClientSecretHasSuffix    *string  `json:"clientSecretHasSuffix,omitempty"`
-	ClientSecretEqualFold    *string  `json:"clientSecretEqualFold,omitempty"`
-	ClientSecretContainsFold *string  `json:"clientSecretContainsFold,omitempty"`
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
 
-	// "redirect_url" field predicates.
-	RedirectURL             *string  `json:"redirectURL,omitempty"`
-	RedirectURLNEQ          *string  `json:"redirectURLNEQ,omitempty"`
-	RedirectURLIn           []string `json:"redirectURLIn,omitempty"`
-	RedirectURLNotIn        []string `json:"redirectURLNotIn,omitempty"`
-	RedirectURLGT           *string  `json:"redirectURLGT,omitempty"`
-	RedirectURLGTE          *string  `json:"redirectURLGTE,omitempty"`
-	RedirectURLLT           *string  `json:"redirectURLLT,omitempty"`
-	RedirectURLLTE          *string  `json:"redirectURLLTE,omitempty"`
-	RedirectURLContains     *string  `json:"redirectURLContains,omitempty"`
-	RedirectURLHasPrefix    *string  `json:"redirectURLHasPrefix,omitempty"`
-	RedirectURLHasSuffix    *string  `json:"redirectURLHasSuffix,omitempty"`
-	RedirectURLEqualFold    *string  `json:"redirectURLEqualFold,omitempty"`
-	RedirectURLContainsFold *string  `json:"redirectURLContainsFold,omitempty"`
+	// "group" edge predicates.
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
 
-	// "scopes" field predicates.
-	Scopes             *string  `json:"scopes,omitempty"`
-	ScopesNEQ          *string  `json:"scopesNEQ,omitempty"`
-	ScopesIn           []string `json:"scopesIn,omitempty"`
-	ScopesNotIn        []string `json:"scopesNotIn,omitempty"`
-	ScopesGT           *string  `json:"scopesGT,omitempty"`
-	ScopesGTE          *string  `json:"scopesGTE,omitempty"`
-	ScopesLT           *string  `json:"scopesLT,omitempty"`
-	ScopesLTE          *string  `json:"scopesLTE,omitempty"`
-	ScopesContains     *string  `json:"scopesContains,omitempty"`
-	ScopesHasPrefix    *string  `json:"scopesHasPrefix,omitempty"`
-	ScopesHasSuffix    *string  `json:"scopesHasSuffix,omitempty"`
-	ScopesEqualFold    *string  `json:"scopesEqualFold,omitempty"`
-	ScopesContainsFold *string  `json:"scopesContainsFold,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
 
-	// "auth_url" field predicates.
-	AuthURL             *string  `json:"authURL,omitempty"`
-	AuthURLNEQ          *string  `json:"authURLNEQ,omitempty"`
-	AuthURLIn           []string `json:"authURLIn,omitempty"`
-	AuthURLNotIn        []string `json:"authURLNotIn,omitempty"`
-	AuthURLGT           *string  `json:"authURLGT,omitempty"`
-	AuthURLGTE          *string  `json:"authURLGTE,omitempty"`
-	AuthURLLT           *string  `json:"authURLLT,omitempty"`
-	AuthURLLTE          *string  `json:"authURLLTE,omitempty"`
-	AuthURLContains     *string  `json:"authURLContains,omitempty"`
-	AuthURLHasPrefix    *string  `json:"authURLHasPrefix,omitempty"`
-	AuthURLHasSuffix    *string  `json:"authURLHasSuffix,omitempty"`
-	AuthURLEqualFold    *string  `json:"authURLEqualFold,omitempty"`
-	AuthURLContainsFold *string  `json:"authURLContainsFold,omitempty"`
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *GroupMembershipWhereInput) AddPredicates(predicates ...predicate.GroupMembership) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
 
-	// "token_url" field predicates.
-	TokenURL             *string  `json:"tokenURL,omitempty"`
-	TokenURLNEQ          *string  `json:"tokenURLNEQ,omitempty"`
-	TokenURLIn           []string `json:"tokenURLIn,omitempty"`
-	TokenURLNotIn        []string `json:"tokenURLNotIn,omitempty"`
-	TokenURLGT           *string  `json:"tokenURLGT,omitempty"`
-	TokenURLGTE          *string  `json:"tokenURLGTE,omitempty"`
-	TokenURLLT           *string  `json:"tokenURLLT,omitempty"`
-	TokenURLLTE          *string  `json:"tokenURLLTE,omitempty"`
-	TokenURLContains     *string  `json:"tokenURLContains,omitempty"`
-	TokenURLHasPrefix    *string  `json:"tokenURLHasPrefix,omitempty"`
-	TokenURLHasSuffix    *string  `json:"tokenURLHasSuffix,omitempty"`
-	TokenURLEqualFold    *string  `json:"tokenURLEqualFold,omitempty"`
-	TokenURLContainsFold *string  `json:"tokenURLContainsFold,omitempty"`
+// Filter applies the GroupMembershipWhereInput filter on the GroupMembershipQuery builder.
+func (i *GroupMembershipWhereInput) Filter(q *GroupMembershipQuery) (*GroupMembershipQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyGroupMembershipWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
 
-	// "auth_style" field predicates.
-	AuthStyle      *uint8  `json:"authStyle,omitempty"`
-	AuthStyleNEQ   *uint8  `json:"authStyleNEQ,omitempty"`
-	AuthStyleIn    []uint8 `json:"authStyleIn,omitempty"`
-	AuthStyleNotIn []uint8 `json:"authStyleNotIn,omitempty"`
-	AuthStyleGT    *uint8  `json:"authStyleGT,omitempty"`
-	AuthStyleGTE   *uint8  `json:"authStyleGTE,omitempty"`
-	AuthStyleLT    *uint8  `json:"authStyleLT,omitempty"`
-	AuthStyleLTE   *uint8  `json:"authStyleLTE,omitempty"`
+// ErrEmptyGroupMembershipWhereInput is returned in case the GroupMembershipWhereInput is empty.
+var ErrEmptyGroupMembershipWhereInput = errors.New("generated: empty predicate GroupMembershipWhereInput")
 
-	// "info_url" field predicates.
-	InfoURL             *string  `json:"infoURL,omitempty"`
-	InfoURLNEQ          *string  `json:"infoURLNEQ,omitempty"`
-	InfoURLIn           []string `json:"infoURLIn,omitempty"`
-	InfoURLNotIn        []string `json:"infoURLNotIn,omitempty"`
-	InfoURLGT           *string  `json:"infoURLGT,omitempty"`
-	InfoURLGTE          *string  `json:"infoURLGTE,omitempty"`
-	InfoURLLT           *string  `json:"infoURLLT,omitempty"`
-	InfoURLLTE          *string  `json:"infoURLLTE,omitempty"`
-	InfoURLContains     *string  `json:"infoURLContains,omitempty"`
-	InfoURLHasPrefix    *string  `json:"infoURLHasPrefix,omitempty"`
-	InfoURLHasSuffix    *string  `json:"infoURLHasSuffix,omitempty"`
-	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
-	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
-
-	// "owner" edge predicates.
-	HasOwner     *bool                     `json:"hasOwner,omitempty"`
-	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
-}
-
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *OauthProviderWhereInput) AddPredicates(predicates ...predicate.OauthProvider) {
-	i.Predicates = append(i.Predicates, predicates...)
-}
-
-// Filter applies the OauthProviderWhereInput filter on the OauthProviderQuery builder.
-func (i *OauthProviderWhereInput) Filter(q *OauthProviderQuery) (*OauthProviderQuery, error) {
-	if i == nil {
-		return q, nil
-	}
-	p, err := i.P()
-	if err != nil {
-		if err == ErrEmptyOauthProviderWhereInput {
-			return q, nil
-		}
-		return nil, err
-	}
-	return q.Where(p), nil
-}
-
-// ErrEmptyOauthProviderWhereInput is returned in case the OauthProviderWhereInput is empty.
-var ErrEmptyOauthProviderWhereInput = errors.New("generated: empty predicate OauthProviderWhereInput")
-
-// P returns a predicate for filtering oauthproviders.
+// P returns a predicate for filtering groupmemberships.
 // An error is returned if the input is empty or invalid.
-func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
-	var predicates []predicate.OauthProvider
+func (i *GroupMembershipWhereInput) P() (predicate.GroupMembership, error) {
+	var predicates []predicate.GroupMembership
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, oauthprovider.Not(p))
+		predicates = append(predicates, groupmembership.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2683,7 +2782,7 @@ func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.OauthProvider, 0, n)
+		or := make([]predicate.GroupMembership, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2691,7 +2790,7 @@ func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, oauthprovider.Or(or...))
+		predicates = append(predicates, groupmembership.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2701,7 +2800,7 @@ func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.OauthProvider, 0, n)
+		and := make([]predicate.GroupMembership, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2709,623 +2808,826 @@ func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, oauthprovider.And(and...))
+		predicates = append(predicates, groupmembership.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, oauthprovider.IDEQ(*i.ID))
+		predicates = append(predicates, groupmembership.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, oauthprovider.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, groupmembership.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, oauthprovider.IDIn(i.IDIn...))
+		predicates = append(predicates, groupmembership.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, groupmembership.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, oauthprovider.IDGT(*i.IDGT))
+		predicates = append(predicates, groupmembership.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, oauthprovider.IDGTE(*i.IDGTE))
+		predicates = append(predicates, groupmembership.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, oauthprovider.IDLT(*i.IDLT))
+		predicates = append(predicates, groupmembership.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, oauthprovider.IDLTE(*i.IDLTE))
+		predicates = append(predicates, groupmembership.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, oauthprovider.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, groupmembership.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, oauthprovider.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, groupmembership.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, groupmembership.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, groupmembership.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, oauthprovider.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, groupmembership.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, groupmembership.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, groupmembership.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, groupmembership.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, groupmembership.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, oauthprovider.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, groupmembership.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, groupmembership.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, groupmembership.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, oauthprovider.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, groupmembership.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, groupmembership.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, groupmembership.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, groupmembership.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, groupmembership.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, oauthprovider.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, groupmembership.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, oauthprovider.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, groupmembership.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, oauthprovider.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, groupmembership.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, oauthprovider.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, groupmembership.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, groupmembership.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, oauthprovider.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, groupmembership.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, oauthprovider.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, groupmembership.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, oauthprovider.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, groupmembership.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, oauthprovider.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, groupmembership.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, oauthprovider.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, groupmembership.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, groupmembership.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, groupmembership.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, oauthprovider.CreatedByIsNil())
+		predicates = append(predicates, groupmembership.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, oauthprovider.CreatedByNotNil())
+		predicates = append(predicates, groupmembership.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, oauthprovider.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, groupmembership.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, oauthprovider.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, groupmembership.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, groupmembership.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, groupmembership.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, oauthprovider.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, groupmembership.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, groupmembership.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, groupmembership.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, groupmembership.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, groupmembership.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, groupmembership.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, groupmembership.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, groupmembership.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, groupmembership.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, oauthprovider.UpdatedByIsNil())
+		predicates = append(predicates, groupmembership.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, oauthprovider.UpdatedByNotNil())
+		predicates = append(predicates, groupmembership.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, groupmembership.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, oauthprovider.UpdatedByContainsFold(*i.UpdatedByContainsFold))
-	}
-	if i.DeletedAt != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtEQ(*i.DeletedAt))
-	}
-	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtNEQ(*i.DeletedAtNEQ))
-	}
-	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, oauthprovider.DeletedAtIn(i.DeletedAtIn...))
-	}
-	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.DeletedAtNotIn(i.DeletedAtNotIn...))
-	}
-	if i.DeletedAtGT != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtGT(*i.DeletedAtGT))
-	}
-	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtGTE(*i.DeletedAtGTE))
-	}
-	if i.DeletedAtLT != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtLT(*i.DeletedAtLT))
-	}
-	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, oauthprovider.DeletedAtLTE(*i.DeletedAtLTE))
-	}
-	if i.DeletedAtIsNil {
-		predicates = append(predicates, oauthprovider.DeletedAtIsNil())
-	}
-	if i.DeletedAtNotNil {
-		predicates = append(predicates, oauthprovider.DeletedAtNotNil())
+		predicates = append(predicates, groupmembership.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if i.DeletedBy != nil {
-		predicates = append(predicates, oauthprovider.DeletedByEQ(*i.DeletedBy))
+	if i.Role != nil {
+		predicates = append(predicates, groupmembership.RoleEQ(*i.Role))
 	}
-	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, oauthprovider.DeletedByNEQ(*i.DeletedByNEQ))
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, groupmembership.RoleNEQ(*i.RoleNEQ))
 	}
-	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, oauthprovider.DeletedByIn(i.DeletedByIn...))
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, groupmembership.RoleIn(i.RoleIn...))
 	}
-	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.DeletedByNotIn(i.DeletedByNotIn...))
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, groupmembership.RoleNotIn(i.RoleNotIn...))
 	}
-	if i.DeletedByGT != nil {
-		predicates = append(predicates, oauthprovider.DeletedByGT(*i.DeletedByGT))
+	if i.GroupID != nil {
+		predicates = append(predicates, groupmembership.GroupIDEQ(*i.GroupID))
 	}
-	if i.DeletedByGTE != nil {
-		predicates = append(predicates, oauthprovider.DeletedByGTE(*i.DeletedByGTE))
+	if i.GroupIDNEQ != nil {
+		predicates = append(predicates, groupmembership.GroupIDNEQ(*i.GroupIDNEQ))
 	}
-	if i.DeletedByLT != nil {
-		predicates = append(predicates, oauthprovider.DeletedByLT(*i.DeletedByLT))
+	if len(i.GroupIDIn) > 0 {
+		predicates = append(predicates, groupmembership.GroupIDIn(i.GroupIDIn...))
 	}
-	if i.DeletedByLTE != nil {
-		predicates = append(predicates, oauthprovider.DeletedByLTE(*i.DeletedByLTE))
+	if len(i.GroupIDNotIn) > 0 {
+		predicates = append(predicates, groupmembership.GroupIDNotIn(i.GroupIDNotIn...))
 	}
-	if i.DeletedByContains != nil {
-		predicates = append(predicates, oauthprovider.DeletedByContains(*i.DeletedByContains))
+	if i.GroupIDGT != nil {
+		predicates = append(predicates, groupmembership.GroupIDGT(*i.GroupIDGT))
 	}
-	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	if i.GroupIDGTE != nil {
+		predicates = append(predicates, groupmembership.GroupIDGTE(*i.GroupIDGTE))
 	}
-	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	if i.GroupIDLT != nil {
+		predicates = append(predicates, groupmembership.GroupIDLT(*i.GroupIDLT))
 	}
-	if i.DeletedByIsNil {
-		predicates = append(predicates, oauthprovider.DeletedByIsNil())
+	if i.GroupIDLTE != nil {
+		predicates = append(predicates, groupmembership.GroupIDLTE(*i.GroupIDLTE))
 	}
-	if i.DeletedByNotNil {
-		predicates = append(predicates, oauthprovider.DeletedByNotNil())
+	if i.GroupIDContains != nil {
+		predicates = append(predicates, groupmembership.GroupIDContains(*i.GroupIDContains))
 	}
-	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, oauthprovider.DeletedByEqualFold(*i.DeletedByEqualFold))
+	if i.GroupIDHasPrefix != nil {
+		predicates = append(predicates, groupmembership.GroupIDHasPrefix(*i.GroupIDHasPrefix))
 	}
-	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, oauthprovider.DeletedByContainsFold(*i.DeletedByContainsFold))
+	if i.GroupIDHasSuffix != nil {
+		predicates = append(predicates, groupmembership.GroupIDHasSuffix(*i.GroupIDHasSuffix))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, oauthprovider.NameEQ(*i.Name))
+	if i.GroupIDEqualFold != nil {
+		predicates = append(predicates, groupmembership.GroupIDEqualFold(*i.GroupIDEqualFold))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, oauthprovider.NameNEQ(*i.NameNEQ))
+	if i.GroupIDContainsFold != nil {
+		predicates = append(predicates, groupmembership.GroupIDContainsFold(*i.GroupIDContainsFold))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, oauthprovider.NameIn(i.NameIn...))
+	if i.UserID != nil {
+		predicates = append(predicates, groupmembership.UserIDEQ(*i.UserID))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.NameNotIn(i.NameNotIn...))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, groupmembership.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, oauthprovider.NameGT(*i.NameGT))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, groupmembership.UserIDIn(i.UserIDIn...))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, oauthprovider.NameGTE(*i.NameGTE))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, groupmembership.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, oauthprovider.NameLT(*i.NameLT))
+	if i.UserIDGT != nil {
+		predicates = append(predicates, groupmembership.UserIDGT(*i.UserIDGT))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, oauthprovider.NameLTE(*i.NameLTE))
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, groupmembership.UserIDGTE(*i.UserIDGTE))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, oauthprovider.NameContains(*i.NameContains))
+	if i.UserIDLT != nil {
+		predicates = append(predicates, groupmembership.UserIDLT(*i.UserIDLT))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.NameHasPrefix(*i.NameHasPrefix))
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, groupmembership.UserIDLTE(*i.UserIDLTE))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.NameHasSuffix(*i.NameHasSuffix))
+	if i.UserIDContains != nil {
+		predicates = append(predicates, groupmembership.UserIDContains(*i.UserIDContains))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, oauthprovider.NameEqualFold(*i.NameEqualFold))
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, groupmembership.UserIDHasPrefix(*i.UserIDHasPrefix))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, oauthprovider.NameContainsFold(*i.NameContainsFold))
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, groupmembership.UserIDHasSuffix(*i.UserIDHasSuffix))
 	}
-	if i.ClientID != nil {
-		predicates = append(predicates, oauthprovider.ClientIDEQ(*i.ClientID))
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, groupmembership.UserIDEqualFold(*i.UserIDEqualFold))
 	}
-	if i.ClientIDNEQ != nil {
-		predicates = append(predicates, oauthprovider.ClientIDNEQ(*i.ClientIDNEQ))
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, groupmembership.UserIDContainsFold(*i.UserIDContainsFold))
 	}
-	if len(i.ClientIDIn) > 0 {
-		predicates = append(predicates, oauthprovider.ClientIDIn(i.ClientIDIn...))
+
+	if i.HasGroup != nil {
+		p := groupmembership.HasGroup()
+		if !*i.HasGroup {
+			p = groupmembership.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.ClientIDNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.ClientIDNotIn(i.ClientIDNotIn...))
+	if len(i.HasGroupWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupWith))
+		for _, w := range i.HasGroupWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, groupmembership.HasGroupWith(with...))
 	}
-	if i.ClientIDGT != nil {
-		predicates = append(predicates, oauthprovider.ClientIDGT(*i.ClientIDGT))
+	if i.HasUser != nil {
+		p := groupmembership.HasUser()
+		if !*i.HasUser {
+			p = groupmembership.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ClientIDGTE != nil {
-		predicates = append(predicates, oauthprovider.ClientIDGTE(*i.ClientIDGTE))
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, groupmembership.HasUserWith(with...))
 	}
-	if i.ClientIDLT != nil {
-		predicates = append(predicates, oauthprovider.ClientIDLT(*i.ClientIDLT))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyGroupMembershipWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return groupmembership.And(predicates...), nil
 	}
-	if i.ClientIDLTE != nil {
-		predicates = append(predicates, oauthprovider.ClientIDLTE(*i.ClientIDLTE))
+}
+
+// GroupSettingWhereInput represents a where input for filtering GroupSetting queries.
+type GroupSettingWhereInput struct {
+	Predicates []predicate.GroupSetting  `json:"-"`
+	Not        *GroupSettingWhereInput   `json:"not,omitempty"`
+	Or         []*GroupSettingWhereInput `json:"or,omitempty"`
+	And        []*GroupSettingWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "visibility" field predicates.
+	Visibility      *groupsetting.Visibility  `json:"visibility,omitempty"`
+	VisibilityNEQ   *groupsetting.Visibility  `json:"visibilityNEQ,omitempty"`
+	VisibilityIn    []groupsetting.Visibility `json:"visibilityIn,omitempty"`
+	VisibilityNotIn []groupsetting.Visibility `json:"visibilityNotIn,omitempty"`
+
+	// "join_policy" field predicates.
+	JoinPolicy      *groupsetting.JoinPolicy  `json:"joinPolicy,omitempty"`
+	JoinPolicyNEQ   *groupsetting.JoinPolicy  `json:"joinPolicyNEQ,omitempty"`
+	JoinPolicyIn    []groupsetting.JoinPolicy `json:"joinPolicyIn,omitempty"`
+	JoinPolicyNotIn []groupsetting.JoinPolicy `json:"joinPolicyNotIn,omitempty"`
+
+	// "sync_to_slack" field predicates.
+	SyncToSlack    *bool `json:"syncToSlack,omitempty"`
+	SyncToSlackNEQ *bool `json:"syncToSlackNEQ,omitempty"`
+
+	// "sync_to_github" field predicates.
+	SyncToGithub    *bool `json:"syncToGithub,omitempty"`
+	SyncToGithubNEQ *bool `json:"syncToGithubNEQ,omitempty"`
+
+	// "group" edge predicates.
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *GroupSettingWhereInput) AddPredicates(predicates ...predicate.GroupSetting) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the GroupSettingWhereInput filter on the GroupSettingQuery builder.
+func (i *GroupSettingWhereInput) Filter(q *GroupSettingQuery) (*GroupSettingQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.ClientIDContains != nil {
-		predicates = append(predicates, oauthprovider.ClientIDContains(*i.ClientIDContains))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyGroupSettingWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.ClientIDHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.ClientIDHasPrefix(*i.ClientIDHasPrefix))
+	return q.Where(p), nil
+}
+
+// ErrEmptyGroupSettingWhereInput is returned in case the GroupSettingWhereInput is empty.
+var ErrEmptyGroupSettingWhereInput = errors.New("generated: empty predicate GroupSettingWhereInput")
+
+// P returns a predicate for filtering groupsettings.
+// An error is returned if the input is empty or invalid.
+func (i *GroupSettingWhereInput) P() (predicate.GroupSetting, error) {
+	var predicates []predicate.GroupSetting
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, groupsetting.Not(p))
 	}
-	if i.ClientIDHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.ClientIDHasSuffix(*i.ClientIDHasSuffix))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.GroupSetting, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, groupsetting.Or(or...))
 	}
-	if i.ClientIDEqualFold != nil {
-		predicates = append(predicates, oauthprovider.ClientIDEqualFold(*i.ClientIDEqualFold))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.GroupSetting, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, groupsetting.And(and...))
 	}
-	if i.ClientIDContainsFold != nil {
-		predicates = append(predicates, oauthprovider.ClientIDContainsFold(*i.ClientIDContainsFold))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, groupsetting.IDEQ(*i.ID))
 	}
-	if i.ClientSecret != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretEQ(*i.ClientSecret))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, groupsetting.IDNEQ(*i.IDNEQ))
 	}
-	if i.ClientSecretNEQ != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretNEQ(*i.ClientSecretNEQ))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, groupsetting.IDIn(i.IDIn...))
 	}
-	if len(i.ClientSecretIn) > 0 {
-		predicates = append(predicates, oauthprovider.ClientSecretIn(i.ClientSecretIn...))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, groupsetting.IDNotIn(i.IDNotIn...))
 	}
-	if len(i.ClientSecretNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.ClientSecretNotIn(i.ClientSecretNotIn...))
+	if i.IDGT != nil {
+		predicates = append(predicates, groupsetting.IDGT(*i.IDGT))
 	}
-	if i.ClientSecretGT != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretGT(*i.ClientSecretGT))
+	if i.IDGTE != nil {
+		predicates = append(predicates, groupsetting.IDGTE(*i.IDGTE))
 	}
-	if i.ClientSecretGTE != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretGTE(*i.ClientSecretGTE))
+	if i.IDLT != nil {
+		predicates = append(predicates, groupsetting.IDLT(*i.IDLT))
 	}
-	if i.ClientSecretLT != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretLT(*i.ClientSecretLT))
+	if i.IDLTE != nil {
+		predicates = append(predicates, groupsetting.IDLTE(*i.IDLTE))
 	}
-	if i.ClientSecretLTE != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretLTE(*i.ClientSecretLTE))
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, groupsetting.IDEqualFold(*i.IDEqualFold))
 	}
-	if i.ClientSecretContains != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretContains(*i.ClientSecretContains))
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, groupsetting.IDContainsFold(*i.IDContainsFold))
 	}
-	if i.ClientSecretHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretHasPrefix(*i.ClientSecretHasPrefix))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, groupsetting.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.ClientSecretHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretHasSuffix(*i.ClientSecretHasSuffix))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, groupsetting.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.ClientSecretEqualFold != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretEqualFold(*i.ClientSecretEqualFold))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, groupsetting.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.ClientSecretContainsFold != nil {
-		predicates = append(predicates, oauthprovider.ClientSecretContainsFold(*i.ClientSecretContainsFold))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, groupsetting.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.RedirectURL != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLEQ(*i.RedirectURL))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, groupsetting.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.RedirectURLNEQ != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLNEQ(*i.RedirectURLNEQ))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, groupsetting.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if len(i.RedirectURLIn) > 0 {
-		predicates = append(predicates, oauthprovider.RedirectURLIn(i.RedirectURLIn...))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, groupsetting.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if len(i.RedirectURLNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.RedirectURLNotIn(i.RedirectURLNotIn...))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, groupsetting.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.RedirectURLGT != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLGT(*i.RedirectURLGT))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.RedirectURLGTE != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLGTE(*i.RedirectURLGTE))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.RedirectURLLT != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLLT(*i.RedirectURLLT))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, groupsetting.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.RedirectURLLTE != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLLTE(*i.RedirectURLLTE))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, groupsetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.RedirectURLContains != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLContains(*i.RedirectURLContains))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.RedirectURLHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLHasPrefix(*i.RedirectURLHasPrefix))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.RedirectURLHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLHasSuffix(*i.RedirectURLHasSuffix))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.RedirectURLEqualFold != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLEqualFold(*i.RedirectURLEqualFold))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, groupsetting.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.RedirectURLContainsFold != nil {
-		predicates = append(predicates, oauthprovider.RedirectURLContainsFold(*i.RedirectURLContainsFold))
+	if i.CreatedBy != nil {
+		predicates = append(predicates, groupsetting.CreatedByEQ(*i.CreatedBy))
 	}
-	if i.Scopes != nil {
-		predicates = append(predicates, oauthprovider.ScopesEQ(*i.Scopes))
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, groupsetting.CreatedByNEQ(*i.CreatedByNEQ))
 	}
-	if i.ScopesNEQ != nil {
-		predicates = append(predicates, oauthprovider.ScopesNEQ(*i.ScopesNEQ))
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, groupsetting.CreatedByIn(i.CreatedByIn...))
 	}
-	if len(i.ScopesIn) > 0 {
-		predicates = append(predicates, oauthprovider.ScopesIn(i.ScopesIn...))
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, groupsetting.CreatedByNotIn(i.CreatedByNotIn...))
 	}
-	if len(i.ScopesNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.ScopesNotIn(i.ScopesNotIn...))
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, groupsetting.CreatedByGT(*i.CreatedByGT))
 	}
-	if i.ScopesGT != nil {
-		predicates = append(predicates, oauthprovider.ScopesGT(*i.ScopesGT))
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, groupsetting.CreatedByGTE(*i.CreatedByGTE))
 	}
-	if i.ScopesGTE != nil {
-		predicates = append(predicates, oauthprovider.ScopesGTE(*i.ScopesGTE))
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, groupsetting.CreatedByLT(*i.CreatedByLT))
 	}
-	if i.ScopesLT != nil {
-		predicates = append(predicates, oauthprovider.ScopesLT(*i.ScopesLT))
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, groupsetting.CreatedByLTE(*i.CreatedByLTE))
 	}
-	if i.ScopesLTE != nil {
-		predicates = append(predicates, oauthprovider.ScopesLTE(*i.ScopesLTE))
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, groupsetting.CreatedByContains(*i.CreatedByContains))
 	}
-	if i.ScopesContains != nil {
-		predicates = append(predicates, oauthprovider.ScopesContains(*i.ScopesContains))
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, groupsetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
-	if i.ScopesHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.ScopesHasPrefix(*i.ScopesHasPrefix))
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, groupsetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
-	if i.ScopesHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.ScopesHasSuffix(*i.ScopesHasSuffix))
+	if i.CreatedByIsNil {
+		predicates = append(predicates, groupsetting.CreatedByIsNil())
 	}
-	if i.ScopesEqualFold != nil {
-		predicates = append(predicates, oauthprovider.ScopesEqualFold(*i.ScopesEqualFold))
+	if i.CreatedByNotNil {
+		predicates = append(predicates, groupsetting.CreatedByNotNil())
 	}
-	if i.ScopesContainsFold != nil {
-		predicates = append(predicates, oauthprovider.ScopesContainsFold(*i.ScopesContainsFold))
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, groupsetting.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
-	if i.AuthURL != nil {
-		predicates = append(predicates, oauthprovider.AuthURLEQ(*i.AuthURL))
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, groupsetting.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
-	if i.AuthURLNEQ != nil {
-		predicates = append(predicates, oauthprovider.AuthURLNEQ(*i.AuthURLNEQ))
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, groupsetting.UpdatedByEQ(*i.UpdatedBy))
 	}
-	if len(i.AuthURLIn) > 0 {
-		predicates = append(predicates, oauthprovider.AuthURLIn(i.AuthURLIn...))
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, groupsetting.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
-	if len(i.AuthURLNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.AuthURLNotIn(i.AuthURLNotIn...))
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, groupsetting.UpdatedByIn(i.UpdatedByIn...))
 	}
-	if i.AuthURLGT != nil {
-		predicates = append(predicates, oauthprovider.AuthURLGT(*i.AuthURLGT))
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, groupsetting.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
-	if i.AuthURLGTE != nil {
-		predicates = append(predicates, oauthprovider.AuthURLGTE(*i.AuthURLGTE))
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, groupsetting.UpdatedByGT(*i.UpdatedByGT))
 	}
-	if i.AuthURLLT != nil {
-		predicates = append(predicates, oauthprovider.AuthURLLT(*i.AuthURLLT))
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, groupsetting.UpdatedByGTE(*i.UpdatedByGTE))
 	}
-	if i.AuthURLLTE != nil {
-		predicates = append(predicates, oauthprovider.AuthURLLTE(*i.AuthURLLTE))
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, groupsetting.UpdatedByLT(*i.UpdatedByLT))
 	}
-	if i.AuthURLContains != nil {
-		predicates = append(predicates, oauthprovider.AuthURLContains(*i.AuthURLContains))
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, groupsetting.UpdatedByLTE(*i.UpdatedByLTE))
 	}
-	if i.AuthURLHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.AuthURLHasPrefix(*i.AuthURLHasPrefix))
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, groupsetting.UpdatedByContains(*i.UpdatedByContains))
 	}
-	if i.AuthURLHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.AuthURLHasSuffix(*i.AuthURLHasSuffix))
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, groupsetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
-	if i.AuthURLEqualFold != nil {
-		predicates = append(predicates, oauthprovider.AuthURLEqualFold(*i.AuthURLEqualFold))
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, groupsetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
-	if i.AuthURLContainsFold != nil {
-		predicates = append(predicates, oauthprovider.AuthURLContainsFold(*i.AuthURLContainsFold))
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, groupsetting.UpdatedByIsNil())
 	}
-	if i.TokenURL != nil {
-		predicates = append(predicates, oauthprovider.TokenURLEQ(*i.TokenURL))
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, groupsetting.UpdatedByNotNil())
 	}
-	if i.TokenURLNEQ != nil {
-		predicates = append(predicates, oauthprovider.TokenURLNEQ(*i.TokenURLNEQ))
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, groupsetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
-	if len(i.TokenURLIn) > 0 {
-		predicates = append(predicates, oauthprovider.TokenURLIn(i.TokenURLIn...))
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, groupsetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if len(i.TokenURLNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.TokenURLNotIn(i.TokenURLNotIn...))
+	if i.DeletedAt != nil {
+		predicates = append(predicates, groupsetting.DeletedAtEQ(*i.DeletedAt))
 	}
-	if i.TokenURLGT != nil {
-		predicates = append(predicates, oauthprovider.TokenURLGT(*i.TokenURLGT))
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, groupsetting.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
-	if i.TokenURLGTE != nil {
-		predicates = append(predicates, oauthprovider.TokenURLGTE(*i.TokenURLGTE))
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, groupsetting.DeletedAtIn(i.DeletedAtIn...))
 	}
-	if i.TokenURLLT != nil {
-		predicates = append(predicates, oauthprovider.TokenURLLT(*i.TokenURLLT))
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, groupsetting.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
-	if i.TokenURLLTE != nil {
-		predicates = append(predicates, oauthprovider.TokenURLLTE(*i.TokenURLLTE))
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, groupsetting.DeletedAtGT(*i.DeletedAtGT))
 	}
-	if i.TokenURLContains != nil {
-		predicates = append(predicates, oauthprovider.TokenURLContains(*i.TokenURLContains))
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, groupsetting.DeletedAtGTE(*i.DeletedAtGTE))
 	}
-	if i.TokenURLHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.TokenURLHasPrefix(*i.TokenURLHasPrefix))
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, groupsetting.DeletedAtLT(*i.DeletedAtLT))
 	}
-	if i.TokenURLHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.TokenURLHasSuffix(*i.TokenURLHasSuffix))
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, groupsetting.DeletedAtLTE(*i.DeletedAtLTE))
 	}
-	if i.TokenURLEqualFold != nil {
-		predicates = append(predicates, oauthprovider.TokenURLEqualFold(*i.TokenURLEqualFold))
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, groupsetting.DeletedAtIsNil())
 	}
-	if i.TokenURLContainsFold != nil {
-		predicates = append(predicates, oauthprovider.TokenURLContainsFold(*i.TokenURLContainsFold))
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, groupsetting.DeletedAtNotNil())
 	}
-	if i.AuthStyle != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleEQ(*i.AuthStyle))
+	if i.DeletedBy != nil {
+		predicates = append(predicates, groupsetting.DeletedByEQ(*i.DeletedBy))
 	}
-	if i.AuthStyleNEQ != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleNEQ(*i.AuthStyleNEQ))
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, groupsetting.DeletedByNEQ(*i.DeletedByNEQ))
 	}
-	if len(i.AuthStyleIn) > 0 {
-		predicates = append(predicates, oauthprovider.AuthStyleIn(i.AuthStyleIn...))
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, groupsetting.DeletedByIn(i.DeletedByIn...))
 	}
-	if len(i.AuthStyleNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.AuthStyleNotIn(i.AuthStyleNotIn...))
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, groupsetting.DeletedByNotIn(i.DeletedByNotIn...))
 	}
-	if i.AuthStyleGT != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleGT(*i.AuthStyleGT))
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, groupsetting.DeletedByGT(*i.DeletedByGT))
 	}
-	if i.AuthStyleGTE != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleGTE(*i.AuthStyleGTE))
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, groupsetting.DeletedByGTE(*i.DeletedByGTE))
 	}
-	if i.AuthStyleLT != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleLT(*i.AuthStyleLT))
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, groupsetting.DeletedByLT(*i.DeletedByLT))
 	}
-	if i.AuthStyleLTE != nil {
-		predicates = append(predicates, oauthprovider.AuthStyleLTE(*i.AuthStyleLTE))
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, groupsetting.DeletedByLTE(*i.DeletedByLTE))
 	}
-	if i.InfoURL != nil {
-		predicates = append(predicates, oauthprovider.InfoURLEQ(*i.InfoURL))
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, groupsetting.DeletedByContains(*i.DeletedByContains))
 	}
-	if i.InfoURLNEQ != nil {
-		predicates = append(predicates, oauthprovider.InfoURLNEQ(*i.InfoURLNEQ))
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, groupsetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
-	if len(i.InfoURLIn) > 0 {
-		predicates = append(predicates, oauthprovider.InfoURLIn(i.InfoURLIn...))
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, groupsetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
-	if len(i.InfoURLNotIn) > 0 {
-		predicates = append(predicates, oauthprovider.InfoURLNotIn(i.InfoURLNotIn...))
+	if i.DeletedByIsNil {
+		predicates = append(predicates, groupsetting.DeletedByIsNil())
 	}
-	if i.InfoURLGT != nil {
-		predicates = append(predicates, oauthprovider.InfoURLGT(*i.InfoURLGT))
+	if i.DeletedByNotNil {
+		predicates = append(predicates, groupsetting.DeletedByNotNil())
 	}
-	if i.InfoURLGTE != nil {
-		predicates = append(predicates, oauthprovider.InfoURLGTE(*i.InfoURLGTE))
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, groupsetting.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
-	if i.InfoURLLT != nil {
-		predicates = append(predicates, oauthprovider.InfoURLLT(*i.InfoURLLT))
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, groupsetting.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.InfoURLLTE != nil {
-		predicates = append(predicates, oauthprovider.InfoURLLTE(*i.InfoURLLTE))
+	if i.Visibility != nil {
+		predicates = append(predicates, groupsetting.VisibilityEQ(*i.Visibility))
 	}
-	if i.InfoURLContains != nil {
-		predicates = append(predicates, oauthprovider.InfoURLContains(*i.InfoURLContains))
+	if i.VisibilityNEQ != nil {
+		predicates = append(predicates, groupsetting.VisibilityNEQ(*i.VisibilityNEQ))
 	}
-	if i.InfoURLHasPrefix != nil {
-		predicates = append(predicates, oauthprovider.InfoURLHasPrefix(*i.InfoURLHasPrefix))
+	if len(i.VisibilityIn) > 0 {
+		predicates = append(predicates, groupsetting.VisibilityIn(i.VisibilityIn...))
 	}
-	if i.InfoURLHasSuffix != nil {
-		predicates = append(predicates, oauthprovider.InfoURLHasSuffix(*i.InfoURLHasSuffix))
+	if len(i.VisibilityNotIn) > 0 {
+		predicates = append(predicates, groupsetting.VisibilityNotIn(i.VisibilityNotIn...))
 	}
-	if i.InfoURLEqualFold != nil {
-		predicates = append(predicates, oauthprovider.InfoURLEqualFold(*i.InfoURLEqualFold))
+	if i.JoinPolicy != nil {
+		predicates = append(predicates, groupsetting.JoinPolicyEQ(*i.JoinPolicy))
 	}
-	if i.InfoURLContainsFold != nil {
-		predicates = append(predicates, oauthprovider.InfoURLContainsFold(*i.InfoURLContainsFold))
+	if i.JoinPolicyNEQ != nil {
+		predicates = append(predicates, groupsetting.JoinPolicyNEQ(*i.JoinPolicyNEQ))
+	}
+	if len(i.JoinPolicyIn) > 0 {
+		predicates = append(predicates, groupsetting.JoinPolicyIn(i.JoinPolicyIn...))
+	}
+	if len(i.JoinPolicyNotIn) > 0 {
+		predicates = append(predicates, groupsetting.JoinPolicyNotIn(i.JoinPolicyNotIn...))
+	}
+	if i.SyncToSlack != nil {
+		predicates = append(predicates, groupsetting.SyncToSlackEQ(*i.SyncToSlack))
+	}
+	if i.SyncToSlackNEQ != nil {
+		predicates = append(predicates, groupsetting.SyncToSlackNEQ(*i.SyncToSlackNEQ))
+	}
+	if i.SyncToGithub != nil {
+		predicates = append(predicates, groupsetting.SyncToGithubEQ(*i.SyncToGithub))
+	}
+	if i.SyncToGithubNEQ != nil {
+		predicates = append(predicates, groupsetting.SyncToGithubNEQ(*i.SyncToGithubNEQ))
 	}
 
-	if i.HasOwner != nil {
-		p := oauthprovider.HasOwner()
-		if !*i.HasOwner {
-			p = oauthprovider.Not(p)
+	if i.HasGroup != nil {
+		p := groupsetting.HasGroup()
+		if !*i.HasGroup {
+			p = groupsetting.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
+	if len(i.HasGroupWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupWith))
+		for _, w := range i.HasGroupWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasGroupWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, oauthprovider.HasOwnerWith(with...))
+		predicates = append(predicates, groupsetting.HasGroupWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyOauthProviderWhereInput
+		return nil, ErrEmptyGroupSettingWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return oauthprovider.And(predicates...), nil
+		return groupsetting.And(predicates...), nil
 	}
 }
 
-// OhAuthTooTokenWhereInput represents a where input for filtering OhAuthTooToken queries.
-type OhAuthTooTokenWhereInput struct {
-	Predicates []predicate.OhAuthTooToken  `json:"-"`
-	Not        *OhAuthTooTokenWhereInput   `json:"not,omitempty"`
-	Or         []*OhAuthTooTokenWhereInput `json:"or,omitempty"`
-	And        []*OhAuthTooTokenWhereInput `json:"and,omitempty"`
+// IntegrationWhereInput represents a where input for filtering Integration queries.
+type IntegrationWhereInput struct {
+	Predicates []predicate.Integration  `json:"-"`
+	Not        *IntegrationWhereInput   `json:"not,omitempty"`
+	Or         []*IntegrationWhereInput `json:"or,omitempty"`
+	And        []*IntegrationWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -3339,139 +3641,154 @@ type OhAuthTooTokenWhereInput struct {
 	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
 	IDContainsFold *string  `json:"idContainsFold,omitempty"`
 
-	// "client_id" field predicates.
-	ClientID             *string  `json:"clientID,omitempty"`
-	ClientIDNEQ          *string  `json:"clientIDNEQ,omitempty"`
-	ClientIDIn           []string `json:"clientIDIn,omitempty"`
-	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
-	ClientIDGT           *string  `json:"clientIDGT,omitempty"`
-	ClientIDGTE          *string  `json:"clientIDGTE,omitempty"`
-	ClientIDLT           *string  `json:"clientIDLT,omitempty"`
-	ClientIDLTE          *string  `json:"clientIDLTE,omitempty"`
-	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
-	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
-	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
-	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
-	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "nonce" field predicates.
-	Nonce             *string  `json:"nonce,omitempty"`
-	NonceNEQ          *string  `json:"nonceNEQ,omitempty"`
-	NonceIn           []string `json:"nonceIn,omitempty"`
-	NonceNotIn        []string `json:"nonceNotIn,omitempty"`
-	NonceGT           *string  `json:"nonceGT,omitempty"`
-	NonceGTE          *string  `json:"nonceGTE,omitempty"`
-	NonceLT           *string  `json:"nonceLT,omitempty"`
-	NonceLTE          *string  `json:"nonceLTE,omitempty"`
-	NonceContains     *string  `json:"nonceContains,omitempty"`
-	NonceHasPrefix    *string  `json:"nonceHasPrefix,omitempty"`
-	NonceHasSuffix    *string  `json:"nonceHasSuffix,omitempty"`
-	NonceEqualFold    *string  `json:"nonceEqualFold,omitempty"`
-	NonceContainsFold *string  `json:"nonceContainsFold,omitempty"`
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "claims_user_id" field predicates.
-	ClaimsUserID             *string  `json:"claimsUserID,omitempty"`
-	ClaimsUserIDNEQ          *string  `json:"claimsUserIDNEQ,omitempty"`
-	ClaimsUserIDIn           []string `json:"claimsUserIDIn,omitempty"`
-	ClaimsUserIDNotIn        []string `json:"claimsUserIDNotIn,omitempty"`
-	ClaimsUserIDGT           *string  `json:"claimsUserIDGT,omitempty"`
-	ClaimsUserIDGTE          *string  `json:"claimsUserIDGTE,omitempty"`
-	ClaimsUserIDLT           *string  `json:"claimsUserIDLT,omitempty"`
-	ClaimsUserIDLTE          *string  `json:"claimsUserIDLTE,omitempty"`
-	ClaimsUserIDContains     *string  `json:"claimsUserIDContains,omitempty"`
-	ClaimsUserIDHasPrefix    *string  `json:"claimsUserIDHasPrefix,omitempty"`
-	ClaimsUserIDHasSuffix    *string  `json:"claimsUserIDHasSuffix,omitempty"`
-	ClaimsUserIDEqualFold    *string  `json:"claimsUserIDEqualFold,omitempty"`
-	ClaimsUserIDContainsFold *string  `json:"claimsUserIDContainsFold,omitempty"`
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
 
-	// "claims_username" field predicates.
-	ClaimsUsername             *string  `json:"claimsUsername,omitempty"`
-	ClaimsUsernameNEQ          *string  `json:"claimsUsernameNEQ,omitempty"`
-	ClaimsUsernameIn           []string `json:"claimsUsernameIn,omitempty"`
-	ClaimsUsernameNotIn        []string `json:"claimsUsernameNotIn,omitempty"`
-	ClaimsUsernameGT           *string  `json:"claimsUsernameGT,omitempty"`
-	ClaimsUsernameGTE          *string  `json:"claimsUsernameGTE,omitempty"`
-	ClaimsUsernameLT           *string  `json:"claimsUsernameLT,omitempty"`
-	ClaimsUsernameLTE          *string  `json:"claimsUsernameLTE,omitempty"`
-	ClaimsUsernameContains     *string  `json:"claimsUsernameContains,omitempty"`
-	ClaimsUsernameHasPrefix    *string  `json:"claimsUsernameHasPrefix,omitempty"`
-	ClaimsUsernameHasSuffix    *string  `json:"claimsUsernameHasSuffix,omitempty"`
-	ClaimsUsernameEqualFold    *string  `json:"claimsUsernameEqualFold,omitempty"`
-	ClaimsUsernameContainsFold *string  `json:"claimsUsernameContainsFold,omitempty"`
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
 
-	// "claims_email" field predicates.
-	ClaimsEmail             *string  `json:"claimsEmail,omitempty"`
-	ClaimsEmailNEQ          *string  `json:"claimsEmailNEQ,omitempty"`
-	ClaimsEmailIn           []string `json:"claimsEmailIn,omitempty"`
-	ClaimsEmailNotIn        []string `json:"claimsEmailNotIn,omitempty"`
-	ClaimsEmailGT           *string  `json:"claimsEmailGT,omitempty"`
-	ClaimsEmailGTE          *string  `json:"claimsEmailGTE,omitempty"`
-	ClaimsEmailLT           *string  `json:"claimsEmailLT,omitempty"`
-	ClaimsEmailLTE          *string  `json:"claimsEmailLTE,omitempty"`
-	ClaimsEmailContains     *string  `json:"claimsEmailContains,omitempty"`
-	ClaimsEmailHasPrefix    *string  `json:"claimsEmailHasPrefix,omitempty"`
-	ClaimsEmailHasSuffix    *string  `json:"claimsEmailHasSuffix,omitempty"`
-	ClaimsEmailEqualFold    *string  `json:"claimsEmailEqualFold,omitempty"`
-	ClaimsEmailContainsFold *string  `json:"claimsEmailContainsFold,omitempty"`
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
 
-	// "claims_email_verified" field predicates.
-	ClaimsEmailVerified    *bool `json:"claimsEmailVerified,omitempty"`
-	ClaimsEmailVerifiedNEQ *bool `json:"claimsEmailVerifiedNEQ,omitempty"`
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "claims_preferred_username" field predicates.
-	ClaimsPreferredUsername             *string  `json:"claimsPreferredUsername,omitempty"`
-	ClaimsPreferredUsernameNEQ          *string  `json:"claimsPreferredUsernameNEQ,omitempty"`
-	ClaimsPreferredUsernameIn           []string `json:"claimsPreferredUsernameIn,omitempty"`
-	ClaimsPreferredUsernameNotIn        []string `json:"claimsPreferredUsernameNotIn,omitempty"`
-	ClaimsPreferredUsernameGT           *string  `json:"claimsPreferredUsernameGT,omitempty"`
-	ClaimsPreferredUsernameGTE          *string  `json:"claimsPreferredUsernameGTE,omitempty"`
-	ClaimsPreferredUsernameLT           *string  `json:"claimsPreferredUsernameLT,omitempty"`
-	ClaimsPreferredUsernameLTE          *string  `json:"claimsPreferredUsernameLTE,omitempty"`
-	ClaimsPreferredUsernameContains     *string  `json:"claimsPreferredUsernameContains,omitempty"`
-	ClaimsPreferredUsernameHasPrefix    *string  `json:"claimsPreferredUsernameHasPrefix,omitempty"`
-	ClaimsPreferredUsernameHasSuffix    *string  `json:"claimsPreferredUsernameHasSuffix,omitempty"`
-	ClaimsPreferredUsernameEqualFold    *string  `json:"claimsPreferredUsernameEqualFold,omitempty"`
-	ClaimsPreferredUsernameContainsFold *string  `json:"claimsPreferredUsernameContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "connector_id" field predicates.
-	ConnectorID             *string  `json:"connectorID,omitempty"`
-	ConnectorIDNEQ          *string  `json:"connectorIDNEQ,omitempty"`
-	ConnectorIDIn           []string `json:"connectorIDIn,omitempty"`
-	ConnectorIDNotIn        []string `json:"connectorIDNotIn,omitempty"`
-	ConnectorIDGT           *string  `json:"connectorIDGT,omitempty"`
-	ConnectorIDGTE          *string  `json:"connectorIDGTE,omitempty"`
-	ConnectorIDLT           *string  `json:"connectorIDLT,omitempty"`
-	ConnectorIDLTE          *string  `json:"connectorIDLTE,omitempty"`
-	ConnectorIDContains     *string  `json:"connectorIDContains,omitempty"`
-	ConnectorIDHasPrefix    *string  `json:"connectorIDHasPrefix,omitempty"`
-	ConnectorIDHasSuffix    *string  `json:"connectorIDHasSuffix,omitempty"`
-	ConnectorIDEqualFold    *string  `json:"connectorIDEqualFold,omitempty"`
-	ConnectorIDContainsFold *string  `json:"connectorIDContainsFold,omitempty"`
+	// "kind" field predicates.
+	Kind             *string  `json:"kind,omitempty"`
+	KindNEQ          *string  `json:"kindNEQ,omitempty"`
+	KindIn           []string `json:"kindIn,omitempty"`
+	KindNotIn        []string `json:"kindNotIn,omitempty"`
+	KindGT           *string  `json:"kindGT,omitempty"`
+	KindGTE          *string  `json:"kindGTE,omitempty"`
+	KindLT           *string  `json:"kindLT,omitempty"`
+	KindLTE          *string  `json:"kindLTE,omitempty"`
+	KindContains     *string  `json:"kindContains,omitempty"`
+	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
+	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
+	KindIsNil        bool     `json:"kindIsNil,omitempty"`
+	KindNotNil       bool     `json:"kindNotNil,omitempty"`
+	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
+	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
 
-	// "last_used" field predicates.
-	LastUsed      *time.Time  `json:"lastUsed,omitempty"`
-	LastUsedNEQ   *time.Time  `json:"lastUsedNEQ,omitempty"`
-	LastUsedIn    []time.Time `json:"lastUsedIn,omitempty"`
-	LastUsedNotIn []time.Time `json:"lastUsedNotIn,omitempty"`
-	LastUsedGT    *time.Time  `json:"lastUsedGT,omitempty"`
-	LastUsedGTE   *time.Time  `json:"lastUsedGTE,omitempty"`
-	LastUsedLT    *time.Time  `json:"lastUsedLT,omitempty"`
-	LastUsedLTE   *time.Time  `json:"lastUsedLTE,omitempty"`
+	// "secret_name" field predicates.
+	SecretName             *string  `json:"secretName,omitempty"`
+	SecretNameNEQ          *string  `json:"secretNameNEQ,omitempty"`
+	SecretNameIn           []string `json:"secretNameIn,omitempty"`
+	SecretNameNotIn        []string `json:"secretNameNotIn,omitempty"`
+	SecretNameGT           *string  `json:"secretNameGT,omitempty"`
+	SecretNameGTE          *string  `json:"secretNameGTE,omitempty"`
+	SecretNameLT           *string  `json:"secretNameLT,omitempty"`
+	SecretNameLTE          *string  `json:"secretNameLTE,omitempty"`
+	SecretNameContains     *string  `json:"secretNameContains,omitempty"`
+	SecretNameHasPrefix    *string  `json:"secretNameHasPrefix,omitempty"`
+	SecretNameHasSuffix    *string  `json:"secretNameHasSuffix,omitempty"`
+	SecretNameEqualFold    *string  `json:"secretNameEqualFold,omitempty"`
+	SecretNameContainsFold *string  `json:"secretNameContainsFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *OhAuthTooTokenWhereInput) AddPredicates(predicates ...predicate.OhAuthTooToken) {
+func (i *IntegrationWhereInput) AddPredicates(predicates ...predicate.Integration) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the OhAuthTooTokenWhereInput filter on the OhAuthTooTokenQuery builder.
-func (i *OhAuthTooTokenWhereInput) Filter(q *OhAuthTooTokenQuery) (*OhAuthTooTokenQuery, error) {
+// Filter applies the IntegrationWhereInput filter on the IntegrationQuery builder.
+func (i *IntegrationWhereInput) Filter(q *IntegrationQuery) (*IntegrationQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyOhAuthTooTokenWhereInput {
+		if err == ErrEmptyIntegrationWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -3479,19 +3796,19 @@ func (i *OhAuthTooTokenWhereInput) Filter(q *OhAuthTooTokenQuery) (*OhAuthTooTok
 	return q.Where(p), nil
 }
 
-// ErrEmptyOhAuthTooTokenWhereInput is returned in case the OhAuthTooTokenWhereInput is empty.
-var ErrEmptyOhAuthTooTokenWhereInput = errors.New("generated: empty predicate OhAuthTooTokenWhereInput")
+// ErrEmptyIntegrationWhereInput is returned in case the IntegrationWhereInput is empty.
+var ErrEmptyIntegrationWhereInput = errors.New("generated: empty predicate IntegrationWhereInput")
 
-// P returns a predicate for filtering ohauthtootokens.
+// P returns a predicate for filtering integrations.
 // An error is returned if the input is empty or invalid.
-func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
-	var predicates []predicate.OhAuthTooToken
+func (i *IntegrationWhereInput) P() (predicate.Integration, error) {
+	var predicates []predicate.Integration
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, ohauthtootoken.Not(p))
+		predicates = append(predicates, integration.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -3501,7 +3818,7 @@ func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.OhAuthTooToken, 0, n)
+		or := make([]predicate.Integration, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -3509,7 +3826,7 @@ func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, ohauthtootoken.Or(or...))
+		predicates = append(predicates, integration.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -3519,7 +3836,7 @@ func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.OhAuthTooToken, 0, n)
+		and := make([]predicate.Integration, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -3527,359 +3844,410 @@ func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, ohauthtootoken.And(and...))
+		predicates = append(predicates, integration.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, ohauthtootoken.IDEQ(*i.ID))
+		predicates = append(predicates, integration.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, integration.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.IDIn(i.IDIn...))
+		predicates = append(predicates, integration.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, integration.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, ohauthtootoken.IDGT(*i.IDGT))
+		predicates = append(predicates, integration.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, ohauthtootoken.IDGTE(*i.IDGTE))
+		predicates = append(predicates, integration.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, ohauthtootoken.IDLT(*i.IDLT))
+		predicates = append(predicates, integration.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, ohauthtootoken.IDLTE(*i.IDLTE))
+		predicates = append(predicates, integration.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, integration.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, integration.IDContainsFold(*i.IDContainsFold))
 	}
-	if i.ClientID != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDEQ(*i.ClientID))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, integration.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.ClientIDNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDNEQ(*i.ClientIDNEQ))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, integration.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.ClientIDIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClientIDIn(i.ClientIDIn...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, integration.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if len(i.ClientIDNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClientIDNotIn(i.ClientIDNotIn...))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, integration.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.ClientIDGT != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDGT(*i.ClientIDGT))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, integration.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.ClientIDGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDGTE(*i.ClientIDGTE))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, integration.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.ClientIDLT != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDLT(*i.ClientIDLT))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, integration.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.ClientIDLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDLTE(*i.ClientIDLTE))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, integration.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.ClientIDContains != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDContains(*i.ClientIDContains))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, integration.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.ClientIDHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDHasPrefix(*i.ClientIDHasPrefix))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, integration.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.ClientIDHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDHasSuffix(*i.ClientIDHasSuffix))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, integration.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.ClientIDEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDEqualFold(*i.ClientIDEqualFold))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, integration.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.ClientIDContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClientIDContainsFold(*i.ClientIDContainsFold))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, integration.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.Nonce != nil {
-		predicates = append(predicates, ohauthtootoken.NonceEQ(*i.Nonce))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, integration.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.NonceNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.NonceNEQ(*i.NonceNEQ))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, integration.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if len(i.NonceIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.NonceIn(i.NonceIn...))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, integration.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if len(i.NonceNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.NonceNotIn(i.NonceNotIn...))
+	if i.CreatedBy != nil {
+		predicates = append(predicates, integration.CreatedByEQ(*i.CreatedBy))
 	}
-	if i.NonceGT != nil {
-		predicates = append(predicates, ohauthtootoken.NonceGT(*i.NonceGT))
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, integration.CreatedByNEQ(*i.CreatedByNEQ))
 	}
-	if i.NonceGTE != nil {
-		predicates = append(predicates, ohauthtootoken.NonceGTE(*i.NonceGTE))
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, integration.CreatedByIn(i.CreatedByIn...))
 	}
-	if i.NonceLT != nil {
-		predicates = append(predicates, ohauthtootoken.NonceLT(*i.NonceLT))
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, integration.CreatedByNotIn(i.CreatedByNotIn...))
 	}
-	if i.NonceLTE != nil {
-		predicates = append(predicates, ohauthtootoken.NonceLTE(*i.NonceLTE))
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, integration.CreatedByGT(*i.CreatedByGT))
 	}
-	if i.NonceContains != nil {
-		predicates = append(predicates, ohauthtootoken.NonceContains(*i.NonceContains))
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, integration.CreatedByGTE(*i.CreatedByGTE))
 	}
-	if i.NonceHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.NonceHasPrefix(*i.NonceHasPrefix))
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, integration.CreatedByLT(*i.CreatedByLT))
 	}
-	if i.NonceHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.NonceHasSuffix(*i.NonceHasSuffix))
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, integration.CreatedByLTE(*i.CreatedByLTE))
 	}
-	if i.NonceEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.NonceEqualFold(*i.NonceEqualFold))
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, integration.CreatedByContains(*i.CreatedByContains))
 	}
-	if i.NonceContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.NonceContainsFold(*i.NonceContainsFold))
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, integration.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
-	if i.ClaimsUserID != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDEQ(*i.ClaimsUserID))
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, integration.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
-	if i.ClaimsUserIDNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDNEQ(*i.ClaimsUserIDNEQ))
+	if i.CreatedByIsNil {
+		predicates = append(predicates, integration.CreatedByIsNil())
 	}
-	if len(i.ClaimsUserIDIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDIn(i.ClaimsUserIDIn...))
+	if i.CreatedByNotNil {
+		predicates = append(predicates, integration.CreatedByNotNil())
 	}
-	if len(i.ClaimsUserIDNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDNotIn(i.ClaimsUserIDNotIn...))
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, integration.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
-	if i.ClaimsUserIDGT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDGT(*i.ClaimsUserIDGT))
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, integration.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
-	if i.ClaimsUserIDGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDGTE(*i.ClaimsUserIDGTE))
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, integration.UpdatedByEQ(*i.UpdatedBy))
 	}
-	if i.ClaimsUserIDLT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDLT(*i.ClaimsUserIDLT))
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, integration.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
-	if i.ClaimsUserIDLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDLTE(*i.ClaimsUserIDLTE))
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, integration.UpdatedByIn(i.UpdatedByIn...))
 	}
-	if i.ClaimsUserIDContains != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDContains(*i.ClaimsUserIDContains))
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, integration.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
-	if i.ClaimsUserIDHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDHasPrefix(*i.ClaimsUserIDHasPrefix))
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, integration.UpdatedByGT(*i.UpdatedByGT))
 	}
-	if i.ClaimsUserIDHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDHasSuffix(*i.ClaimsUserIDHasSuffix))
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, integration.UpdatedByGTE(*i.UpdatedByGTE))
 	}
-	if i.ClaimsUserIDEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDEqualFold(*i.ClaimsUserIDEqualFold))
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, integration.UpdatedByLT(*i.UpdatedByLT))
 	}
-	if i.ClaimsUserIDContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUserIDContainsFold(*i.ClaimsUserIDContainsFold))
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, integration.UpdatedByLTE(*i.UpdatedByLTE))
 	}
-	if i.ClaimsUsername != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameEQ(*i.ClaimsUsername))
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, integration.UpdatedByContains(*i.UpdatedByContains))
 	}
-	if i.ClaimsUsernameNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameNEQ(*i.ClaimsUsernameNEQ))
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, integration.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
-	if len(i.ClaimsUsernameIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameIn(i.ClaimsUsernameIn...))
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, integration.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
-	if len(i.ClaimsUsernameNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameNotIn(i.ClaimsUsernameNotIn...))
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, integration.UpdatedByIsNil())
 	}
-	if i.ClaimsUsernameGT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameGT(*i.ClaimsUsernameGT))
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, integration.UpdatedByNotNil())
 	}
-	if i.ClaimsUsernameGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameGTE(*i.ClaimsUsernameGTE))
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, integration.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
-	if i.ClaimsUsernameLT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameLT(*i.ClaimsUsernameLT))
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, integration.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if i.ClaimsUsernameLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameLTE(*i.ClaimsUsernameLTE))
+	if i.DeletedAt != nil {
+		predicates = append(predicates, integration.DeletedAtEQ(*i.DeletedAt))
 	}
-	if i.ClaimsUsernameContains != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameContains(*i.ClaimsUsernameContains))
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, integration.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
-	if i.ClaimsUsernameHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameHasPrefix(*i.ClaimsUsernameHasPrefix))
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, integration.DeletedAtIn(i.DeletedAtIn...))
 	}
-	if i.ClaimsUsernameHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameHasSuffix(*i.ClaimsUsernameHasSuffix))
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, integration.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
-	if i.ClaimsUsernameEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameEqualFold(*i.ClaimsUsernameEqualFold))
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, integration.DeletedAtGT(*i.DeletedAtGT))
 	}
-	if i.ClaimsUsernameContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsUsernameContainsFold(*i.ClaimsUsernameContainsFold))
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, integration.DeletedAtGTE(*i.DeletedAtGTE))
 	}
-	if i.ClaimsEmail != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailEQ(*i.ClaimsEmail))
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, integration.DeletedAtLT(*i.DeletedAtLT))
 	}
-	if i.ClaimsEmailNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailNEQ(*i.ClaimsEmailNEQ))
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, integration.DeletedAtLTE(*i.DeletedAtLTE))
 	}
-	if len(i.ClaimsEmailIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailIn(i.ClaimsEmailIn...))
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, integration.DeletedAtIsNil())
 	}
-	if len(i.ClaimsEmailNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailNotIn(i.ClaimsEmailNotIn...))
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, integration.DeletedAtNotNil())
 	}
-	if i.ClaimsEmailGT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailGT(*i.ClaimsEmailGT))
+	if i.DeletedBy != nil {
+		predicates = append(predicates, integration.DeletedByEQ(*i.DeletedBy))
 	}
-	if i.ClaimsEmailGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailGTE(*i.ClaimsEmailGTE))
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, integration.DeletedByNEQ(*i.DeletedByNEQ))
 	}
-	if i.ClaimsEmailLT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailLT(*i.ClaimsEmailLT))
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, integration.DeletedByIn(i.DeletedByIn...))
 	}
-	if i.ClaimsEmailLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailLTE(*i.ClaimsEmailLTE))
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, integration.DeletedByNotIn(i.DeletedByNotIn...))
 	}
-	if i.ClaimsEmailContains != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailContains(*i.ClaimsEmailContains))
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, integration.DeletedByGT(*i.DeletedByGT))
 	}
-	if i.ClaimsEmailHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailHasPrefix(*i.ClaimsEmailHasPrefix))
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, integration.DeletedByGTE(*i.DeletedByGTE))
 	}
-	if i.ClaimsEmailHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailHasSuffix(*i.ClaimsEmailHasSuffix))
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, integration.DeletedByLT(*i.DeletedByLT))
 	}
-	if i.ClaimsEmailEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailEqualFold(*i.ClaimsEmailEqualFold))
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, integration.DeletedByLTE(*i.DeletedByLTE))
 	}
-	if i.ClaimsEmailContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailContainsFold(*i.ClaimsEmailContainsFold))
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, integration.DeletedByContains(*i.DeletedByContains))
 	}
-	if i.ClaimsEmailVerified != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailVerifiedEQ(*i.ClaimsEmailVerified))
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, integration.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
-	if i.ClaimsEmailVerifiedNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsEmailVerifiedNEQ(*i.ClaimsEmailVerifiedNEQ))
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, integration.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
-	if i.ClaimsPreferredUsername != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameEQ(*i.ClaimsPreferredUsername))
+	if i.DeletedByIsNil {
+		predicates = append(predicates, integration.DeletedByIsNil())
 	}
-	if i.ClaimsPreferredUsernameNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameNEQ(*i.ClaimsPreferredUsernameNEQ))
+	if i.DeletedByNotNil {
+		predicates = append(predicates, integration.DeletedByNotNil())
 	}
-	if len(i.ClaimsPreferredUsernameIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameIn(i.ClaimsPreferredUsernameIn...))
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, integration.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
-	if len(i.ClaimsPreferredUsernameNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameNotIn(i.ClaimsPreferredUsernameNotIn...))
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, integration.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.ClaimsPreferredUsernameGT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameGT(*i.ClaimsPreferredUsernameGT))
+	if i.Name != nil {
+		predicates = append(predicates, integration.NameEQ(*i.Name))
 	}
-	if i.ClaimsPreferredUsernameGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameGTE(*i.ClaimsPreferredUsernameGTE))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, integration.NameNEQ(*i.NameNEQ))
 	}
-	if i.ClaimsPreferredUsernameLT != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameLT(*i.ClaimsPreferredUsernameLT))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, integration.NameIn(i.NameIn...))
 	}
-	if i.ClaimsPreferredUsernameLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameLTE(*i.ClaimsPreferredUsernameLTE))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, integration.NameNotIn(i.NameNotIn...))
 	}
-	if i.ClaimsPreferredUsernameContains != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameContains(*i.ClaimsPreferredUsernameContains))
+	if i.NameGT != nil {
+		predicates = append(predicates, integration.NameGT(*i.NameGT))
 	}
-	if i.ClaimsPreferredUsernameHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameHasPrefix(*i.ClaimsPreferredUsernameHasPrefix))
+	if i.NameGTE != nil {
+		predicates = append(predicates, integration.NameGTE(*i.NameGTE))
 	}
-	if i.ClaimsPreferredUsernameHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameHasSuffix(*i.ClaimsPreferredUsernameHasSuffix))
+	if i.NameLT != nil {
+		predicates = append(predicates, integration.NameLT(*i.NameLT))
 	}
-	if i.ClaimsPreferredUsernameEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameEqualFold(*i.ClaimsPreferredUsernameEqualFold))
+	if i.NameLTE != nil {
+		predicates = append(predicates, integration.NameLTE(*i.NameLTE))
 	}
-	if i.ClaimsPreferredUsernameContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameContainsFold(*i.ClaimsPreferredUsernameContainsFold))
+	if i.NameContains != nil {
+		predicates = append(predicates, integration.NameContains(*i.NameContains))
 	}
-	if i.ConnectorID != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDEQ(*i.ConnectorID))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, integration.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.ConnectorIDNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDNEQ(*i.ConnectorIDNEQ))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, integration.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if len(i.ConnectorIDIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDIn(i.ConnectorIDIn...))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, integration.NameEqualFold(*i.NameEqualFold))
 	}
-	if len(i.ConnectorIDNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDNotIn(i.ConnectorIDNotIn...))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, integration.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.ConnectorIDGT != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDGT(*i.ConnectorIDGT))
+	if i.Kind != nil {
+		predicates = append(predicates, integration.KindEQ(*i.Kind))
 	}
-	if i.ConnectorIDGTE != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDGTE(*i.ConnectorIDGTE))
+	if i.KindNEQ != nil {
+		predicates = append(predicates, integration.KindNEQ(*i.KindNEQ))
 	}
-	if i.ConnectorIDLT != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDLT(*i.ConnectorIDLT))
+	if len(i.KindIn) > 0 {
+		predicates = append(predicates, integration.KindIn(i.KindIn...))
 	}
-	if i.ConnectorIDLTE != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDLTE(*i.ConnectorIDLTE))
+	if len(i.KindNotIn) > 0 {
+		predicates = append(predicates, integration.KindNotIn(i.KindNotIn...))
 	}
-	if i.ConnectorIDContains != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDContains(*i.ConnectorIDContains))
+	if i.KindGT != nil {
+		predicates = append(predicates, integration.KindGT(*i.KindGT))
 	}
-	if i.ConnectorIDHasPrefix != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDHasPrefix(*i.ConnectorIDHasPrefix))
+	if i.KindGTE != nil {
+		predicates = append(predicates, integration.KindGTE(*i.KindGTE))
 	}
-	if i.ConnectorIDHasSuffix != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDHasSuffix(*i.ConnectorIDHasSuffix))
+	if i.KindLT != nil {
+		predicates = append(predicates, integration.KindLT(*i.KindLT))
 	}
-	if i.ConnectorIDEqualFold != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDEqualFold(*i.ConnectorIDEqualFold))
+	if i.KindLTE != nil {
+		predicates = append(predicates, integration.KindLTE(*i.KindLTE))
 	}
-	if i.ConnectorIDContainsFold != nil {
-		predicates = append(predicates, ohauthtootoken.ConnectorIDContainsFold(*i.ConnectorIDContainsFold))
+	if i.KindContains != nil {
+		predicates = append(predicates, integration.KindContains(*i.KindContains))
 	}
-	if i.LastUsed != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedEQ(*i.LastUsed))
+	if i.KindHasPrefix != nil {
+		predicates = append(predicates, integration.KindHasPrefix(*i.KindHasPrefix))
 	}
-	if i.LastUsedNEQ != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedNEQ(*i.LastUsedNEQ))
+	if i.KindHasSuffix != nil {
+		predicates = append(predicates, integration.KindHasSuffix(*i.KindHasSuffix))
 	}
-	if len(i.LastUsedIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.LastUsedIn(i.LastUsedIn...))
+	if i.KindIsNil {
+		predicates = append(predicates, integration.KindIsNil())
 	}
-	if len(i.LastUsedNotIn) > 0 {
-		predicates = append(predicates, ohauthtootoken.LastUsedNotIn(i.LastUsedNotIn...))
+	if i.KindNotNil {
+		predicates = append(predicates, integration.KindNotNil())
 	}
-	if i.LastUsedGT != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedGT(*i.LastUsedGT))
+	if i.KindEqualFold != nil {
+		predicates = append(predicates, integration.KindEqualFold(*i.KindEqualFold))
 	}
-	if i.LastUsedGTE != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedGTE(*i.LastUsedGTE))
+	if i.KindContainsFold != nil {
+		predicates = append(predicates, integration.KindContainsFold(*i.KindContainsFold))
 	}
-	if i.LastUsedLT != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedLT(*i.LastUsedLT))
+	if i.SecretName != nil {
+		predicates = append(predicates, integration.SecretNameEQ(*i.SecretName))
 	}
-	if i.LastUsedLTE != nil {
-		predicates = append(predicates, ohauthtootoken.LastUsedLTE(*i.LastUsedLTE))
+	if i.SecretNameNEQ != nil {
+		predicates = append(predicates, integration.SecretNameNEQ(*i.SecretNameNEQ))
+	}
+	if len(i.SecretNameIn) > 0 {
+		predicates = append(predicates, integration.SecretNameIn(i.SecretNameIn...))
+	}
+	if len(i.SecretNameNotIn) > 0 {
+		predicates = append(predicates, integration.SecretNameNotIn(i.SecretNameNotIn...))
+	}
+	if i.SecretNameGT != nil {
+		predicates = append(predicates, integration.SecretNameGT(*i.SecretNameGT))
+	}
+	if i.SecretNameGTE != nil {
+		predicates = append(predicates, integration.SecretNameGTE(*i.SecretNameGTE))
+	}
+	if i.SecretNameLT != nil {
+		predicates = append(predicates, integration.SecretNameLT(*i.SecretNameLT))
+	}
+	if i.SecretNameLTE != nil {
+		predicates = append(predicates, integration.SecretNameLTE(*i.SecretNameLTE))
+	}
+	if i.SecretNameContains != nil {
+		predicates = append(predicates, integration.SecretNameContains(*i.SecretNameContains))
+	}
+	if i.SecretNameHasPrefix != nil {
+		predicates = append(predicates, integration.SecretNameHasPrefix(*i.SecretNameHasPrefix))
+	}
+	if i.SecretNameHasSuffix != nil {
+		predicates = append(predicates, integration.SecretNameHasSuffix(*i.SecretNameHasSuffix))
+	}
+	if i.SecretNameEqualFold != nil {
+		predicates = append(predicates, integration.SecretNameEqualFold(*i.SecretNameEqualFold))
+	}
+	if i.SecretNameContainsFold != nil {
+		predicates = append(predicates, integration.SecretNameContainsFold(*i.SecretNameContainsFold))
 	}
 
+	if i.HasOwner != nil {
+		p := integration.HasOwner()
+		if !*i.HasOwner {
+			p = integration.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, integration.HasOwnerWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyOhAuthTooTokenWhereInput
+		return nil, ErrEmptyIntegrationWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return ohauthtootoken.And(predicates...), nil
+		return integration.And(predicates...), nil
 	}
 }
 
-// OrganizationWhereInput represents a where input for filtering Organization queries.
-type OrganizationWhereInput struct {
-	Predicates []predicate.Organization  `json:"-"`
-	Not        *OrganizationWhereInput   `json:"not,omitempty"`
-	Or         []*OrganizationWhereInput `json:"or,omitempty"`
-	And        []*OrganizationWhereInput `json:"and,omitempty"`
+// InviteWhereInput represents a where input for filtering Invite queries.
+type InviteWhereInput struct {
+	Predicates []predicate.Invite  `json:"-"`
+	Not        *InviteWhereInput   `json:"not,omitempty"`
+	Or         []*InviteWhereInput `json:"or,omitempty"`
+	And        []*InviteWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -3976,88 +4344,93 @@ type OrganizationWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "display_name" field predicates.
-	DisplayName             *string  `json:"displayName,omitempty"`
-	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
-	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
-	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
-	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
-	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
-	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
-	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
-	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
-	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
-	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
-	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
-	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
-
-	// "parent_organization_id" field predicates.
-	ParentOrganizationID             *string  `json:"parentOrganizationID,omitempty"`
-	ParentOrganizationIDNEQ          *string  `json:"parentOrganizationIDNEQ,omitempty"`
-	ParentOrganizationIDIn           []string `json:"parentOrganizationIDIn,omitempty"`
-	ParentOrganizationIDNotIn        []string `json:"parentOrganizationIDNotIn,omitempty"`
-	ParentOrganizationIDGT           *string  `json:"parentOrganizationIDGT,omitempty"`
-	ParentOrganizationIDGTE          *string  `json:"parentOrganizationIDGTE,omitempty"`
-	ParentOrganizationIDLT           *string  `json:"parentOrganizationIDLT,omitempty"`
-	ParentOrganizationIDLTE          *string  `json:"parentOrganizationIDLTE,omitempty"`
-	ParentOrganizationIDContains     *string  `json:"parentOrganizationIDContains,omitempty"`
-	ParentOrganizationIDHasPrefix    *string  `json:"parentOrganizationIDHasPrefix,omitempty"`
-	ParentOrganizationIDHasSuffix    *string  `json:"parentOrganizationIDHasSuffix,omitempty"`
-	ParentOrganizationIDIsNil        bool     `json:"parentOrganizationIDIsNil,omitempty"`
-	ParentOrganizationIDNotNil       bool     `json:"parentOrganizationIDNotNil,omitempty"`
-	ParentOrganizationIDEqualFold    *string  `json:"parentOrganizationIDEqualFold,omitempty"`
-	ParentOrganizationIDContainsFold *string  `json:"parentOrganizationIDContainsFold,omitempty"`
-
-	// "personal_org" field predicates.
-	PersonalOrg    *bool `json:"personalOrg,omitempty"`
-	PersonalOrgNEQ *bool `json:"personalOrgNEQ,omitempty"`
-
-	// "parent" edge predicates.
-	HasParent     *bool                     `json:"hasParent,omitempty"`
-	HasParentWith []*OrganizationWhereInput `json:"hasParentWith,omitempty"`
-
-	// "children" edge predicates.
-	HasChildren     *bool                     `json:"hasChildren,omitempty"`
-	HasChildrenWith []*OrganizationWhereInput `json:"hasChildrenWith,omitempty"`
-
-	// "users" edge predicates.
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
-
-	// "groups" edge predicates.
-	HasGroups     *bool              `json:"hasGroups,omitempty"`
-	HasGroupsWith []*GroupWhereInput `json:"hasGroupsWith,omitempty"`
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
 
-	// "integrations" edge predicates.
-	HasIntegrations     *bool                    `json:"hasIntegrations,omitempty"`
-	HasIntegrationsWith []*IntegrationWhereInput `json:"hasIntegrationsWith,omitempty"`
+	// "expires" field predicates.
+	Expires      *time.Time  `json:"expires,omitempty"`
+	ExpiresNEQ   *time.Time  `json:"expiresNEQ,omitempty"`
+	ExpiresIn    []time.Time `json:"expiresIn,omitempty"`
+	ExpiresNotIn []time.Time `json:"expiresNotIn,omitempty"`
+	ExpiresGT    *time.Time  `json:"expiresGT,omitempty"`
+	ExpiresGTE   *time.Time  `json:"expiresGTE,omitempty"`
+	ExpiresLT    *time.Time  `json:"expiresLT,omitempty"`
+	ExpiresLTE   *time.Time  `json:"expiresLTE,omitempty"`
+
+	// "recipient" field predicates.
+	Recipient             *string  `json:"recipient,omitempty"`
+	RecipientNEQ          *string  `json:"recipientNEQ,omitempty"`
+	RecipientIn           []string `json:"recipientIn,omitempty"`
+	RecipientNotIn        []string `json:"recipientNotIn,omitempty"`
+	RecipientGT           *string  `json:"recipientGT,omitempty"`
+	RecipientGTE          *string  `json:"recipientGTE,omitempty"`
+	RecipientLT           *string  `json:"recipientLT,omitempty"`
+	RecipientLTE          *string  `json:"recipientLTE,omitempty"`
+	RecipientContains     *string  `json:"recipientContains,omitempty"`
+	RecipientHasPrefix    *string  `json:"recipientHasPrefix,omitempty"`
+	RecipientHasSuffix    *string  `json:"recipientHasSuffix,omitempty"`
+	RecipientEqualFold    *string  `json:"recipientEqualFold,omitempty"`
+	RecipientContainsFold *string  `json:"recipientContainsFold,omitempty"`
 
-	// "setting" edge predicates.
-	HasSetting     *bool                            `json:"hasSetting,omitempty"`
-	HasSettingWith []*OrganizationSettingWhereInput `json:"hasSettingWith,omitempty"`
+	// "status" field predicates.
+	Status      *invite.Status  `json:"status,omitempty"`
+	StatusNEQ   *invite.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []invite.Status `json:"statusIn,omitempty"`
+	StatusNotIn []invite.Status `json:"statusNotIn,omitempty"`
 
-	// "entitlements" edge predicates.
-	HasEntitlements     *bool                    `json:"hasEntitlements,omitempty"`
-	HasEntitlementsWith []*EntitlementWhereInput `json:"hasEntitlementsWith,omitempty"`
+	// "role" field predicates.
+	Role      *invite.Role  `json:"role,omitempty"`
+	RoleNEQ   *invite.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []invite.Role `json:"roleIn,omitempty"`
+	RoleNotIn []invite.Role `json:"roleNotIn,omitempty"`
+
+	// "requestor_id" field predicates.
+	RequestorID             *string  `json:"requestorID,omitempty"`
+	RequestorIDNEQ          *string  `json:"requestorIDNEQ,omitempty"`
+	RequestorIDIn           []string `json:"requestorIDIn,omitempty"`
+	RequestorIDNotIn        []string `json:"requestorIDNotIn,omitempty"`
+	RequestorIDGT           *string  `json:"requestorIDGT,omitempty"`
+	RequestorIDGTE          *string  `json:"requestorIDGTE,omitempty"`
+	RequestorIDLT           *string  `json:"requestorIDLT,omitempty"`
+	RequestorIDLTE          *string  `json:"requestorIDLTE,omitempty"`
+	RequestorIDContains     *string  `json:"requestorIDContains,omitempty"`
+	RequestorIDHasPrefix    *string  `json:"requestorIDHasPrefix,omitempty"`
+	RequestorIDHasSuffix    *string  `json:"requestorIDHasSuffix,omitempty"`
+	RequestorIDIsNil        bool     `json:"requestorIDIsNil,omitempty"`
+	RequestorIDNotNil       bool     `json:"requestorIDNotNil,omitempty"`
+	RequestorIDEqualFold    *string  `json:"requestorIDEqualFold,omitempty"`
+	RequestorIDContainsFold *string  `json:"requestorIDContainsFold,omitempty"`
 
-	// "oauthprovider" edge predicates.
-	HasOauthprovider     *bool                      `json:"hasOauthprovider,omitempty"`
-	HasOauthproviderWith []*OauthProviderWhereInput `json:"hasOauthproviderWith,omitempty"`
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *OrganizationWhereInput) AddPredicates(predicates ...predicate.Organization) {
+func (i *InviteWhereInput) AddPredicates(predicates ...predicate.Invite) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the OrganizationWhereInput filter on the OrganizationQuery builder.
-func (i *OrganizationWhereInput) Filter(q *OrganizationQuery) (*OrganizationQuery, error) {
+// Filter applies the InviteWhereInput filter on the InviteQuery builder.
+func (i *InviteWhereInput) Filter(q *InviteQuery) (*InviteQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyOrganizationWhereInput {
+		if err == ErrEmptyInviteWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -4065,19 +4438,19 @@ func (i *OrganizationWhereInput) Filter(q *OrganizationQuery) (*OrganizationQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyOrganizationWhereInput is returned in case the OrganizationWhereInput is empty.
-var ErrEmptyOrganizationWhereInput = errors.New("generated: empty predicate OrganizationWhereInput")
+// ErrEmptyInviteWhereInput is returned in case the InviteWhereInput is empty.
+var ErrEmptyInviteWhereInput = errors.New("generated: empty predicate InviteWhereInput")
 
-// P returns a predicate for filtering organizations.
+// P returns a predicate for filtering invites.
 // An error is returned if the input is empty or invalid.
-func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
-	var predicates []predicate.Organization
+func (i *InviteWhereInput) P() (predicate.Invite, error) {
+	var predicates []predicate.Invite
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, organization.Not(p))
+		predicates = append(predicates, invite.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -4087,7 +4460,7 @@ func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Organization, 0, n)
+		or := make([]predicate.Invite, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -4095,7 +4468,7 @@ func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, organization.Or(or...))
+		predicates = append(predicates, invite.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -4105,7 +4478,7 @@ func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Organization, 0, n)
+		and := make([]predicate.Invite, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -4113,503 +4486,458 @@ func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, organization.And(and...))
+		predicates = append(predicates, invite.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, organization.IDEQ(*i.ID))
+		predicates = append(predicates, invite.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, organization.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, invite.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, organization.IDIn(i.IDIn...))
+		predicates = append(predicates, invite.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, organization.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, invite.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, organization.IDGT(*i.IDGT))
+		predicates = append(predicates, invite.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, organization.IDGTE(*i.IDGTE))
+		predicates = append(predicates, invite.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, organization.IDLT(*i.IDLT))
+		predicates = append(predicates, invite.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, organization.IDLTE(*i.IDLTE))
+		predicates = append(predicates, invite.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, organization.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, invite.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, organization.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, invite.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, organization.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, invite.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, organization.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, invite.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, organization.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, invite.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, organization.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, invite.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, organization.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, invite.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, organization.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, invite.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, organization.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, invite.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, organization.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, invite.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, organization.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, invite.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, organization.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, invite.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, organization.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, invite.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, organization.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, invite.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, organization.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, invite.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, organization.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, invite.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, organization.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, invite.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, organization.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, invite.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, organization.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, invite.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, organization.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, invite.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, organization.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, invite.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, organization.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, invite.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, organization.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, invite.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, organization.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, invite.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, organization.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, invite.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, organization.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, invite.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, organization.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, invite.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, organization.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, invite.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, organization.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, invite.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, organization.CreatedByIsNil())
+		predicates = append(predicates, invite.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, organization.CreatedByNotNil())
+		predicates = append(predicates, invite.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, organization.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, invite.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, organization.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, invite.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, organization.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, invite.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, organization.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, invite.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, organization.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, invite.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, organization.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, invite.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, organization.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, invite.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, organization.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, invite.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, organization.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, invite.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, organization.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, invite.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, organization.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, invite.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, organization.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, invite.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, organization.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, invite.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, organization.UpdatedByIsNil())
+		predicates = append(predicates, invite.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, organization.UpdatedByNotNil())
+		predicates = append(predicates, invite.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, organization.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, invite.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, organization.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, invite.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, organization.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, invite.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, organization.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, invite.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, organization.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, invite.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, organization.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, invite.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, organization.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, invite.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, organization.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, invite.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, organization.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, invite.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, organization.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, invite.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, organization.DeletedAtIsNil())
+		predicates = append(predicates, invite.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, organization.DeletedAtNotNil())
+		predicates = append(predicates, invite.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, organization.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, invite.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, organization.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, invite.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, organization.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, invite.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, organization.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, invite.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, organization.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, invite.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, organization.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, invite.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, organization.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, invite.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, organization.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, invite.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, organization.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, invite.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, organization.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, invite.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, organization.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, invite.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, organization.DeletedByIsNil())
+		predicates = append(predicates, invite.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, organization.DeletedByNotNil())
+		predicates = append(predicates, invite.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, organization.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, invite.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, organization.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, invite.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.DisplayName != nil {
-		predicates = append(predicates, organization.DisplayNameEQ(*i.DisplayName))
+	if i.OwnerID != nil {
+		predicates = append(predicates, invite.OwnerIDEQ(*i.OwnerID))
 	}
-	if i.DisplayNameNEQ != nil {
-		predicates = append(predicates, organization.DisplayNameNEQ(*i.DisplayNameNEQ))
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, invite.OwnerIDNEQ(*i.OwnerIDNEQ))
 	}
-	if len(i.DisplayNameIn) > 0 {
-		predicates = append(predicates, organization.DisplayNameIn(i.DisplayNameIn...))
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, invite.OwnerIDIn(i.OwnerIDIn...))
 	}
-	if len(i.DisplayNameNotIn) > 0 {
-		predicates = append(predicates, organization.DisplayNameNotIn(i.DisplayNameNotIn...))
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, invite.OwnerIDNotIn(i.OwnerIDNotIn...))
 	}
-	if i.DisplayNameGT != nil {
-		predicates = append(predicates, organization.DisplayNameGT(*i.DisplayNameGT))
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, invite.OwnerIDGT(*i.OwnerIDGT))
 	}
-	if i.DisplayNameGTE != nil {
-		predicates = append(predicates, organization.DisplayNameGTE(*i.DisplayNameGTE))
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, invite.OwnerIDGTE(*i.OwnerIDGTE))
 	}
-	if i.DisplayNameLT != nil {
-		predicates = append(predicates, organization.DisplayNameLT(*i.DisplayNameLT))
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, invite.OwnerIDLT(*i.OwnerIDLT))
 	}
-	if i.DisplayNameLTE != nil {
-		predicates = append(predicates, organization.DisplayNameLTE(*i.DisplayNameLTE))
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, invite.OwnerIDLTE(*i.OwnerIDLTE))
 	}
-	if i.DisplayNameContains != nil {
-		predicates = append(predicates, organization.DisplayNameContains(*i.DisplayNameContains))
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, invite.OwnerIDContains(*i.OwnerIDContains))
 	}
-	if i.DisplayNameHasPrefix != nil {
-		predicates = append(predicates, organization.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, invite.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
 	}
-	if i.DisplayNameHasSuffix != nil {
-		predicates = append(predicates, organization.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, invite.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
 	}
-	if i.DisplayNameEqualFold != nil {
-		predicates = append(predicates, organization.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, invite.OwnerIDEqualFold(*i.OwnerIDEqualFold))
 	}
-	if i.DisplayNameContainsFold != nil {
-		predicates = append(predicates, organization.DisplayNameContainsFold(*i.DisplayNameContainsFold))
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, invite.OwnerIDContainsFold(*i.OwnerIDContainsFold))
 	}
-	if i.ParentOrganizationID != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDEQ(*i.ParentOrganizationID))
+	if i.Expires != nil {
+		predicates = append(predicates, invite.ExpiresEQ(*i.Expires))
 	}
-	if i.ParentOrganizationIDNEQ != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDNEQ(*i.ParentOrganizationIDNEQ))
+	if i.ExpiresNEQ != nil {
+		predicates = append(predicates, invite.ExpiresNEQ(*i.ExpiresNEQ))
 	}
-	if len(i.ParentOrganizationIDIn) > 0 {
-		predicates = append(predicates, organization.ParentOrganizationIDIn(i.ParentOrganizationIDIn...))
+	if len(i.ExpiresIn) > 0 {
+		predicates = append(predicates, invite.ExpiresIn(i.ExpiresIn...))
 	}
-	if len(i.ParentOrganizationIDNotIn) > 0 {
-		predicates = append(predicates, organization.ParentOrganizationIDNotIn(i.ParentOrganizationIDNotIn...))
+	if len(i.ExpiresNotIn) > 0 {
+		predicates = append(predicates, invite.ExpiresNotIn(i.ExpiresNotIn...))
 	}
-	if i.ParentOrganizationIDGT != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDGT(*i.ParentOrganizationIDGT))
+	if i.ExpiresGT != nil {
+		predicates = append(predicates, invite.ExpiresGT(*i.ExpiresGT))
 	}
-	if i.ParentOrganizationIDGTE != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDGTE(*i.ParentOrganizationIDGTE))
+	if i.ExpiresGTE != nil {
+		predicates = append(predicates, invite.ExpiresGTE(*i.ExpiresGTE))
 	}
-	if i.ParentOrganizationIDLT != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDLT(*i.ParentOrganizationIDLT))
+	if i.ExpiresLT != nil {
+		predicates = append(predicates, invite.ExpiresLT(*i.ExpiresLT))
 	}
-	if i.ParentOrganizationIDLTE != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDLTE(*i.ParentOrganizationIDLTE))
+	if i.ExpiresLTE != nil {
+		predicates = append(predicates, invite.ExpiresLTE(*i.ExpiresLTE))
 	}
-	if i.ParentOrganizationIDContains != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDContains(*i.ParentOrganizationIDContains))
+	if i.Recipient != nil {
+		predicates = append(predicates, invite.RecipientEQ(*i.Recipient))
 	}
-	if i.ParentOrganizationIDHasPrefix != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDHasPrefix(*i.ParentOrganizationIDHasPrefix))
+	if i.RecipientNEQ != nil {
+		predicates = append(predicates, invite.RecipientNEQ(*i.RecipientNEQ))
 	}
-	if i.ParentOrganizationIDHasSuffix != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDHasSuffix(*i.ParentOrganizationIDHasSuffix))
+	if len(i.RecipientIn) > 0 {
+		predicates = append(predicates, invite.RecipientIn(i.RecipientIn...))
 	}
-	if i.ParentOrganizationIDIsNil {
-		predicates = append(predicates, organization.ParentOrganizationIDIsNil())
+	if len(i.RecipientNotIn) > 0 {
+		predicates = append(predicates, invite.RecipientNotIn(i.RecipientNotIn...))
 	}
-	if i.ParentOrganizationIDNotNil {
-		predicates = append(predicates, organization.ParentOrganizationIDNotNil())
+	if i.RecipientGT != nil {
+		predicates = append(predicates, invite.RecipientGT(*i.RecipientGT))
 	}
-	if i.ParentOrganizationIDEqualFold != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDEqualFold(*i.ParentOrganizationIDEqualFold))
+	if i.RecipientGTE != nil {
+		predicates = append(predicates, invite.RecipientGTE(*i.RecipientGTE))
 	}
-	if i.ParentOrganizationIDContainsFold != nil {
-		predicates = append(predicates, organization.ParentOrganizationIDContainsFold(*i.ParentOrganizationIDContainsFold))
+	if i.RecipientLT != nil {
+		predicates = append(predicates, invite.RecipientLT(*i.RecipientLT))
 	}
-	if i.PersonalOrg != nil {
-		predicates = append(predicates, organization.PersonalOrgEQ(*i.PersonalOrg))
+	if i.RecipientLTE != nil {
+		predicates = append(predicates, invite.RecipientLTE(*i.RecipientLTE))
 	}
-	if i.PersonalOrgNEQ != nil {
-		predicates = append(predicates, organization.PersonalOrgNEQ(*i.PersonalOrgNEQ))
+	if i.RecipientContains != nil {
+		predicates = append(predicates, invite.RecipientContains(*i.RecipientContains))
 	}
-
-	if i.HasParent != nil {
-		p := organization.HasParent()
-		if !*i.HasParent {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RecipientHasPrefix != nil {
+		predicates = append(predicates, invite.RecipientHasPrefix(*i.RecipientHasPrefix))
 	}
-	if len(i.HasParentWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasParentWith))
-		for _, w := range i.HasParentWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasParentWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasParentWith(with...))
+	if i.RecipientHasSuffix != nil {
+		predicates = append(predicates, invite.RecipientHasSuffix(*i.RecipientHasSuffix))
 	}
-	if i.HasChildren != nil {
-		p := organization.HasChildren()
-		if !*i.HasChildren {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RecipientEqualFold != nil {
+		predicates = append(predicates, invite.RecipientEqualFold(*i.RecipientEqualFold))
 	}
-	if len(i.HasChildrenWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasChildrenWith))
-		for _, w := range i.HasChildrenWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChildrenWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasChildrenWith(with...))
+	if i.RecipientContainsFold != nil {
+		predicates = append(predicates, invite.RecipientContainsFold(*i.RecipientContainsFold))
 	}
-	if i.HasUsers != nil {
-		p := organization.HasUsers()
-		if !*i.HasUsers {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Status != nil {
+		predicates = append(predicates, invite.StatusEQ(*i.Status))
 	}
-	if len(i.HasUsersWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUsersWith))
-		for _, w := range i.HasUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasUsersWith(with...))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, invite.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.HasGroups != nil {
-		p := organization.HasGroups()
-		if !*i.HasGroups {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, invite.StatusIn(i.StatusIn...))
 	}
-	if len(i.HasGroupsWith) > 0 {
-		with := make([]predicate.Group, 0, len(i.HasGroupsWith))
-		for _, w := range i.HasGroupsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasGroupsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasGroupsWith(with...))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, invite.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.HasIntegrations != nil {
-		p := organization.HasIntegrations()
-		if !*i.HasIntegrations {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Role != nil {
+		predicates = append(predicates, invite.RoleEQ(*i.Role))
 	}
-	if len(i.HasIntegrationsWith) > 0 {
-		with := make([]predicate.Integration, 0, len(i.HasIntegrationsWith))
-		for _, w := range i.HasIntegrationsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasIntegrationsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasIntegrationsWith(with...))
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, invite.RoleNEQ(*i.RoleNEQ))
 	}
-	if i.HasSetting != nil {
-		p := organization.HasSetting()
-		if !*i.HasSetting {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, invite.RoleIn(i.RoleIn...))
 	}
-	if len(i.HasSettingWith) > 0 {
-		with := make([]predicate.OrganizationSetting, 0, len(i.HasSettingWith))
-		for _, w := range i.HasSettingWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasSettingWith(with...))
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, invite.RoleNotIn(i.RoleNotIn...))
 	}
-	if i.HasEntitlements != nil {
-		p := organization.HasEntitlements()
-		if !*i.HasEntitlements {
-			p = organization.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RequestorID != nil {
+		predicates = append(predicates, invite.RequestorIDEQ(*i.RequestorID))
 	}
-	if len(i.HasEntitlementsWith) > 0 {
-		with := make([]predicate.Entitlement, 0, len(i.HasEntitlementsWith))
-		for _, w := range i.HasEntitlementsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasEntitlementsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organization.HasEntitlementsWith(with...))
+	if i.RequestorIDNEQ != nil {
+		predicates = append(predicates, invite.RequestorIDNEQ(*i.RequestorIDNEQ))
 	}
-	if i.HasOauthprovider != nil {
-		p := organization.HasOauthprovider()
-		if !*i.HasOauthprovider {
-			p = organization.Not(p)
+	if len(i.RequestorIDIn) > 0 {
+		predicates = append(predicates, invite.RequestorIDIn(i.RequestorIDIn...))
+	}
+	if len(i.RequestorIDNotIn) > 0 {
+		predicates = append(predicates, invite.RequestorIDNotIn(i.RequestorIDNotIn...))
+	}
+	if i.RequestorIDGT != nil {
+		predicates = append(predicates, invite.RequestorIDGT(*i.RequestorIDGT))
+	}
+	if i.RequestorIDGTE != nil {
+		predicates = append(predicates, invite.RequestorIDGTE(*i.RequestorIDGTE))
+	}
+	if i.RequestorIDLT != nil {
+		predicates = append(predicates, invite.RequestorIDLT(*i.RequestorIDLT))
+	}
+	if i.RequestorIDLTE != nil {
+		predicates = append(predicates, invite.RequestorIDLTE(*i.RequestorIDLTE))
+	}
+	if i.RequestorIDContains != nil {
+		predicates = append(predicates, invite.RequestorIDContains(*i.RequestorIDContains))
+	}
+	if i.RequestorIDHasPrefix != nil {
+		predicates = append(predicates, invite.RequestorIDHasPrefix(*i.RequestorIDHasPrefix))
+	}
+	if i.RequestorIDHasSuffix != nil {
+		predicates = append(predicates, invite.RequestorIDHasSuffix(*i.RequestorIDHasSuffix))
+	}
+	if i.RequestorIDIsNil {
+		predicates = append(predicates, invite.RequestorIDIsNil())
+	}
+	if i.RequestorIDNotNil {
+		predicates = append(predicates, invite.RequestorIDNotNil())
+	}
+	if i.RequestorIDEqualFold != nil {
+		predicates = append(predicates, invite.RequestorIDEqualFold(*i.RequestorIDEqualFold))
+	}
+	if i.RequestorIDContainsFold != nil {
+		predicates = append(predicates, invite.RequestorIDContainsFold(*i.RequestorIDContainsFold))
+	}
+
+	if i.HasOwner != nil {
+		p := invite.HasOwner()
+		if !*i.HasOwner {
+			p = invite.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasOauthproviderWith) > 0 {
-		with := make([]predicate.OauthProvider, 0, len(i.HasOauthproviderWith))
-		for _, w := range i.HasOauthproviderWith {
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOauthproviderWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, organization.HasOauthproviderWith(with...))
+		predicates = append(predicates, invite.HasOwnerWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyOrganizationWhereInput
+		return nil, ErrEmptyInviteWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return organization.And(predicates...), nil
+		return invite.And(predicates...), nil
 	}
 }
 
-// OrganizationSettingWhereInput represents a where input for filtering OrganizationSetting queries.
-type OrganizationSettingWhereInput struct {
-	Predicates []predicate.OrganizationSetting  `json:"-"`
-	Not        *OrganizationSettingWhereInput   `json:"not,omitempty"`
-	Or         []*OrganizationSettingWhereInput `json:"or,omitempty"`
-	And        []*OrganizationSettingWhereInput `json:"and,omitempty"`
+// OauthClientWhereInput represents a where input for filtering OauthClient queries.
+type OauthClientWhereInput struct {
+	Predicates []predicate.OauthClient  `json:"-"`
+	Not        *OauthClientWhereInput   `json:"not,omitempty"`
+	Or         []*OauthClientWhereInput `json:"or,omitempty"`
+	And        []*OauthClientWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -4706,160 +5034,73 @@ type OrganizationSettingWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "sso_cert" field predicates.
-	SSOCert             *string  `json:"ssoCert,omitempty"`
-	SSOCertNEQ          *string  `json:"ssoCertNEQ,omitempty"`
-	SSOCertIn           []string `json:"ssoCertIn,omitempty"`
-	SSOCertNotIn        []string `json:"ssoCertNotIn,omitempty"`
-	SSOCertGT           *string  `json:"ssoCertGT,omitempty"`
-	SSOCertGTE          *string  `json:"ssoCertGTE,omitempty"`
-	SSOCertLT           *string  `json:"ssoCertLT,omitempty"`
-	SSOCertLTE          *string  `json:"ssoCertLTE,omitempty"`
-	SSOCertContains     *string  `json:"ssoCertContains,omitempty"`
-	SSOCertHasPrefix    *string  `json:"ssoCertHasPrefix,omitempty"`
-	SSOCertHasSuffix    *string  `json:"ssoCertHasSuffix,omitempty"`
-	SSOCertIsNil        bool     `json:"ssoCertIsNil,omitempty"`
-	SSOCertNotNil       bool     `json:"ssoCertNotNil,omitempty"`
-	SSOCertEqualFold    *string  `json:"ssoCertEqualFold,omitempty"`
-	SSOCertContainsFold *string  `json:"ssoCertContainsFold,omitempty"`
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
 
-	// "sso_entrypoint" field predicates.
-	SSOEntrypoint             *string  `json:"ssoEntrypoint,omitempty"`
-	SSOEntrypointNEQ          *string  `json:"ssoEntrypointNEQ,omitempty"`
-	SSOEntrypointIn           []string `json:"ssoEntrypointIn,omitempty"`
-	SSOEntrypointNotIn        []string `json:"ssoEntrypointNotIn,omitempty"`
-	SSOEntrypointGT           *string  `json:"ssoEntrypointGT,omitempty"`
-	SSOEntrypointGTE          *string  `json:"ssoEntrypointGTE,omitempty"`
-	SSOEntrypointLT           *string  `json:"ssoEntrypointLT,omitempty"`
-	SSOEntrypointLTE          *string  `json:"ssoEntrypointLTE,omitempty"`
-	SSOEntrypointContains     *string  `json:"ssoEntrypointContains,omitempty"`
-	SSOEntrypointHasPrefix    *string  `json:"ssoEntrypointHasPrefix,omitempty"`
-	SSOEntrypointHasSuffix    *string  `json:"ssoEntrypointHasSuffix,omitempty"`
-	SSOEntrypointIsNil        bool     `json:"ssoEntrypointIsNil,omitempty"`
-	SSOEntrypointNotNil       bool     `json:"ssoEntrypointNotNil,omitempty"`
-	SSOEntrypointEqualFold    *string  `json:"ssoEntrypointEqualFold,omitempty"`
-	SSOEntrypointContainsFold *string  `json:"ssoEntrypointContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "sso_issuer" field predicates.
-	SSOIssuer             *string  `json:"ssoIssuer,omitempty"`
-	SSOIssuerNEQ          *string  `json:"ssoIssuerNEQ,omitempty"`
-	SSOIssuerIn           []string `json:"ssoIssuerIn,omitempty"`
-	SSOIssuerNotIn        []string `json:"ssoIssuerNotIn,omitempty"`
-	SSOIssuerGT           *string  `json:"ssoIssuerGT,omitempty"`
-	SSOIssuerGTE          *string  `json:"ssoIssuerGTE,omitempty"`
-	SSOIssuerLT           *string  `json:"ssoIssuerLT,omitempty"`
-	SSOIssuerLTE          *string  `json:"ssoIssuerLTE,omitempty"`
-	SSOIssuerContains     *string  `json:"ssoIssuerContains,omitempty"`
-	SSOIssuerHasPrefix    *string  `json:"ssoIssuerHasPrefix,omitempty"`
-	SSOIssuerHasSuffix    *string  `json:"ssoIssuerHasSuffix,omitempty"`
-	SSOIssuerIsNil        bool     `json:"ssoIssuerIsNil,omitempty"`
-	SSOIssuerNotNil       bool     `json:"ssoIssuerNotNil,omitempty"`
-	SSOIssuerEqualFold    *string  `json:"ssoIssuerEqualFold,omitempty"`
-	SSOIssuerContainsFold *string  `json:"ssoIssuerContainsFold,omitempty"`
-
-	// "billing_contact" field predicates.
-	BillingContact             *string  `json:"billingContact,omitempty"`
-	BillingContactNEQ          *string  `json:"billingContactNEQ,omitempty"`
-	BillingContactIn           []string `json:"billingContactIn,omitempty"`
-	BillingContactNotIn        []string `json:"billingContactNotIn,omitempty"`
-	BillingContactGT           *string  `json:"billingContactGT,omitempty"`
-	BillingContactGTE          *string  `json:"billingContactGTE,omitempty"`
-	BillingContactLT           *string  `json:"billingContactLT,omitempty"`
-	BillingContactLTE          *string  `json:"billingContactLTE,omitempty"`
-	BillingContactContains     *string  `json:"billingContactContains,omitempty"`
-	BillingContactHasPrefix    *string  `json:"billingContactHasPrefix,omitempty"`
-	BillingContactHasSuffix    *string  `json:"billingContactHasSuffix,omitempty"`
-	BillingContactIsNil        bool     `json:"billingContactIsNil,omitempty"`
-	BillingContactNotNil       bool     `json:"billingContactNotNil,omitempty"`
-	BillingContactEqualFold    *string  `json:"billingContactEqualFold,omitempty"`
-	BillingContactContainsFold *string  `json:"billingContactContainsFold,omitempty"`
-
-	// "billing_email" field predicates.
-	BillingEmail             *string  `json:"billingEmail,omitempty"`
-	BillingEmailNEQ          *string  `json:"billingEmailNEQ,omitempty"`
-	BillingEmailIn           []string `json:"billingEmailIn,omitempty"`
-	BillingEmailNotIn        []string `json:"billingEmailNotIn,omitempty"`
-	BillingEmailGT           *string  `json:"billingEmailGT,omitempty"`
-	BillingEmailGTE          *string  `json:"billingEmailGTE,omitempty"`
-	BillingEmailLT           *string  `json:"billingEmailLT,omitempty"`
-	BillingEmailLTE          *string  `json:"billingEmailLTE,omitempty"`
-	BillingEmailContains     *string  `json:"billingEmailContains,omitempty"`
-	BillingEmailHasPrefix    *string  `json:"billingEmailHasPrefix,omitempty"`
-	BillingEmailHasSuffix    *string  `json:"billingEmailHasSuffix,omitempty"`
-	BillingEmailIsNil        bool     `json:"billingEmailIsNil,omitempty"`
-	BillingEmailNotNil       bool     `json:"billingEmailNotNil,omitempty"`
-	BillingEmailEqualFold    *string  `json:"billingEmailEqualFold,omitempty"`
-	BillingEmailContainsFold *string  `json:"billingEmailContainsFold,omitempty"`
-
-	// "billing_phone" field predicates.
-	BillingPhone             *string  `json:"billingPhone,omitempty"`
-	BillingPhoneNEQ          *string  `json:"billingPhoneNEQ,omitempty"`
-	BillingPhoneIn           []string `json:"billingPhoneIn,omitempty"`
-	BillingPhoneNotIn        []string `json:"billingPhoneNotIn,omitempty"`
-	BillingPhoneGT           *string  `json:"billingPhoneGT,omitempty"`
-	BillingPhoneGTE          *string  `json:"billingPhoneGTE,omitempty"`
-	BillingPhoneLT           *string  `json:"billingPhoneLT,omitempty"`
-	BillingPhoneLTE          *string  `json:"billingPhoneLTE,omitempty"`
-	BillingPhoneContains     *string  `json:"billingPhoneContains,omitempty"`
-	BillingPhoneHasPrefix    *string  `json:"billingPhoneHasPrefix,omitempty"`
-	BillingPhoneHasSuffix    *string  `json:"billingPhoneHasSuffix,omitempty"`
-	BillingPhoneIsNil        bool     `json:"billingPhoneIsNil,omitempty"`
-	BillingPhoneNotNil       bool     `json:"billingPhoneNotNil,omitempty"`
-	BillingPhoneEqualFold    *string  `json:"billingPhoneEqualFold,omitempty"`
-	BillingPhoneContainsFold *string  `json:"billingPhoneContainsFold,omitempty"`
-
-	// "billing_address" field predicates.
-	BillingAddress             *string  `json:"billingAddress,omitempty"`
-	BillingAddressNEQ          *string  `json:"billingAddressNEQ,omitempty"`
-	BillingAddressIn           []string `json:"billingAddressIn,omitempty"`
-	BillingAddressNotIn        []string `json:"billingAddressNotIn,omitempty"`
-	BillingAddressGT           *string  `json:"billingAddressGT,omitempty"`
-	BillingAddressGTE          *string  `json:"billingAddressGTE,omitempty"`
-	BillingAddressLT           *string  `json:"billingAddressLT,omitempty"`
-	BillingAddressLTE          *string  `json:"billingAddressLTE,omitempty"`
-	BillingAddressContains     *string  `json:"billingAddressContains,omitempty"`
-	BillingAddressHasPrefix    *string  `json:"billingAddressHasPrefix,omitempty"`
-	BillingAddressHasSuffix    *string  `json:"billingAddressHasSuffix,omitempty"`
-	BillingAddressIsNil        bool     `json:"billingAddressIsNil,omitempty"`
-	BillingAddressNotNil       bool     `json:"billingAddressNotNil,omitempty"`
-	BillingAddressEqualFold    *string  `json:"billingAddressEqualFold,omitempty"`
-	BillingAddressContainsFold *string  `json:"billingAddressContainsFold,omitempty"`
+	// "client_id" field predicates.
+	ClientID             *string  `json:"clientID,omitempty"`
+	ClientIDNEQ          *string  `json:"clientIDNEQ,omitempty"`
+	ClientIDIn           []string `json:"clientIDIn,omitempty"`
+	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
+	ClientIDGT           *string  `json:"clientIDGT,omitempty"`
+	ClientIDGTE          *string  `json:"clientIDGTE,omitempty"`
+	ClientIDLT           *string  `json:"clientIDLT,omitempty"`
+	ClientIDLTE          *string  `json:"clientIDLTE,omitempty"`
+	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
+	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
+	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
+	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
+	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
 
-	// "tax_identifier" field predicates.
-	TaxIdentifier             *string  `json:"taxIdentifier,omitempty"`
-	TaxIdentifierNEQ          *string  `json:"taxIdentifierNEQ,omitempty"`
-	TaxIdentifierIn           []string `json:"taxIdentifierIn,omitempty"`
-	TaxIdentifierNotIn        []string `json:"taxIdentifierNotIn,omitempty"`
-	TaxIdentifierGT           *string  `json:"taxIdentifierGT,omitempty"`
-	TaxIdentifierGTE          *string  `json:"taxIdentifierGTE,omitempty"`
-	TaxIdentifierLT           *string  `json:"taxIdentifierLT,omitempty"`
-	TaxIdentifierLTE          *string  `json:"taxIdentifierLTE,omitempty"`
-	TaxIdentifierContains     *string  `json:"taxIdentifierContains,omitempty"`
-	TaxIdentifierHasPrefix    *string  `json:"taxIdentifierHasPrefix,omitempty"`
-	TaxIdentifierHasSuffix    *string  `json:"taxIdentifierHasSuffix,omitempty"`
-	TaxIdentifierIsNil        bool     `json:"taxIdentifierIsNil,omitempty"`
-	TaxIdentifierNotNil       bool     `json:"taxIdentifierNotNil,omitempty"`
-	TaxIdentifierEqualFold    *string  `json:"taxIdentifierEqualFold,omitempty"`
-	TaxIdentifierContainsFold *string  `json:"taxIdentifierContainsFold,omitempty"`
+	// "public" field predicates.
+	Public    *bool `json:"public,omitempty"`
+	PublicNEQ *bool `json:"publicNEQ,omitempty"`
 
-	// "organization" edge predicates.
-	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
-	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *OrganizationSettingWhereInput) AddPredicates(predicates ...predicate.OrganizationSetting) {
+func (i *OauthClientWhereInput) AddPredicates(predicates ...predicate.OauthClient) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the OrganizationSettingWhereInput filter on the OrganizationSettingQuery builder.
-func (i *OrganizationSettingWhereInput) Filter(q *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
+// Filter applies the OauthClientWhereInput filter on the OauthClientQuery builder.
+func (i *OauthClientWhereInput) Filter(q *OauthClientQuery) (*OauthClientQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyOrganizationSettingWhereInput {
+		if err == ErrEmptyOauthClientWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -4867,19 +5108,19 @@ func (i *OrganizationSettingWhereInput) Filter(q *OrganizationSettingQuery) (*Or
 	return q.Where(p), nil
 }
 
-// ErrEmptyOrganizationSettingWhereInput is returned in case the OrganizationSettingWhereInput is empty.
-var ErrEmptyOrganizationSettingWhereInput = errors.New("generated: empty predicate OrganizationSettingWhereInput")
+// ErrEmptyOauthClientWhereInput is returned in case the OauthClientWhereInput is empty.
+var ErrEmptyOauthClientWhereInput = errors.New("generated: empty predicate OauthClientWhereInput")
 
-// P returns a predicate for filtering organizationsettings.
+// P returns a predicate for filtering oauthclients.
 // An error is returned if the input is empty or invalid.
-func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, error) {
-	var predicates []predicate.OrganizationSetting
+func (i *OauthClientWhereInput) P() (predicate.OauthClient, error) {
+	var predicates []predicate.OauthClient
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, organizationsetting.Not(p))
+		predicates = append(predicates, oauthclient.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -4889,7 +5130,7 @@ func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.OrganizationSetting, 0, n)
+		or := make([]predicate.OauthClient, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -4897,7 +5138,7 @@ func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, erro
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, organizationsetting.Or(or...))
+		predicates = append(predicates, oauthclient.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -4907,7 +5148,7 @@ func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.OrganizationSetting, 0, n)
+		and := make([]predicate.OauthClient, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -4915,647 +5156,410 @@ func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, erro
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, organizationsetting.And(and...))
+		predicates = append(predicates, oauthclient.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, organizationsetting.IDEQ(*i.ID))
+		predicates = append(predicates, oauthclient.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, organizationsetting.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, oauthclient.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, organizationsetting.IDIn(i.IDIn...))
+		predicates = append(predicates, oauthclient.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, oauthclient.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, organizationsetting.IDGT(*i.IDGT))
+		predicates = append(predicates, oauthclient.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, organizationsetting.IDGTE(*i.IDGTE))
+		predicates = append(predicates, oauthclient.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, organizationsetting.IDLT(*i.IDLT))
+		predicates = append(predicates, oauthclient.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, organizationsetting.IDLTE(*i.IDLTE))
+		predicates = append(predicates, oauthclient.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, organizationsetting.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, oauthclient.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, organizationsetting.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, oauthclient.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, oauthclient.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, oauthclient.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, organizationsetting.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, oauthclient.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, oauthclient.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, oauthclient.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, oauthclient.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, oauthclient.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, organizationsetting.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, oauthclient.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, oauthclient.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, oauthclient.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, organizationsetting.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, oauthclient.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, oauthclient.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, oauthclient.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, oauthclient.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, oauthclient.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, organizationsetting.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, oauthclient.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, organizationsetting.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, oauthclient.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, organizationsetting.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, oauthclient.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, organizationsetting.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, oauthclient.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, oauthclient.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, organizationsetting.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, oauthclient.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, organizationsetting.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, oauthclient.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, organizationsetting.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, oauthclient.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, organizationsetting.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, oauthclient.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, organizationsetting.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, oauthclient.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, oauthclient.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, oauthclient.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, organizationsetting.CreatedByIsNil())
+		predicates = append(predicates, oauthclient.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, organizationsetting.CreatedByNotNil())
+		predicates = append(predicates, oauthclient.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, organizationsetting.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, oauthclient.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, organizationsetting.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, oauthclient.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, oauthclient.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, oauthclient.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, organizationsetting.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, oauthclient.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, oauthclient.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, oauthclient.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, oauthclient.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, oauthclient.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, oauthclient.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, oauthclient.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, oauthclient.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, oauthclient.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, organizationsetting.UpdatedByIsNil())
+		predicates = append(predicates, oauthclient.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, organizationsetting.UpdatedByNotNil())
+		predicates = append(predicates, oauthclient.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, oauthclient.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, organizationsetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, oauthclient.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, oauthclient.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, oauthclient.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, organizationsetting.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, oauthclient.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, oauthclient.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, oauthclient.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, oauthclient.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, oauthclient.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, organizationsetting.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, oauthclient.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, organizationsetting.DeletedAtIsNil())
+		predicates = append(predicates, oauthclient.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, organizationsetting.DeletedAtNotNil())
+		predicates = append(predicates, oauthclient.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, organizationsetting.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, oauthclient.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, organizationsetting.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, oauthclient.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, organizationsetting.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, oauthclient.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, oauthclient.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, organizationsetting.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, oauthclient.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, organizationsetting.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, oauthclient.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, organizationsetting.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, oauthclient.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, organizationsetting.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, oauthclient.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, organizationsetting.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, oauthclient.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, oauthclient.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, oauthclient.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, organizationsetting.DeletedByIsNil())
+		predicates = append(predicates, oauthclient.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, organizationsetting.DeletedByNotNil())
+		predicates = append(predicates, oauthclient.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, organizationsetting.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, oauthclient.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, organizationsetting.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, oauthclient.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.SSOCert != nil {
-		predicates = append(predicates, organizationsetting.SSOCertEQ(*i.SSOCert))
+	if i.OwnerID != nil {
+		predicates = append(predicates, oauthclient.OwnerIDEQ(*i.OwnerID))
 	}
-	if i.SSOCertNEQ != nil {
-		predicates = append(predicates, organizationsetting.SSOCertNEQ(*i.SSOCertNEQ))
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, oauthclient.OwnerIDNEQ(*i.OwnerIDNEQ))
 	}
-	if len(i.SSOCertIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOCertIn(i.SSOCertIn...))
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, oauthclient.OwnerIDIn(i.OwnerIDIn...))
 	}
-	if len(i.SSOCertNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOCertNotIn(i.SSOCertNotIn...))
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, oauthclient.OwnerIDNotIn(i.OwnerIDNotIn...))
 	}
-	if i.SSOCertGT != nil {
-		predicates = append(predicates, organizationsetting.SSOCertGT(*i.SSOCertGT))
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, oauthclient.OwnerIDGT(*i.OwnerIDGT))
 	}
-	if i.SSOCertGTE != nil {
-		predicates = append(predicates, organizationsetting.SSOCertGTE(*i.SSOCertGTE))
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, oauthclient.OwnerIDGTE(*i.OwnerIDGTE))
 	}
-	if i.SSOCertLT != nil {
-		predicates = append(predicates, organizationsetting.SSOCertLT(*i.SSOCertLT))
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, oauthclient.OwnerIDLT(*i.OwnerIDLT))
 	}
-	if i.SSOCertLTE != nil {
-		predicates = append(predicates, organizationsetting.SSOCertLTE(*i.SSOCertLTE))
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, oauthclient.OwnerIDLTE(*i.OwnerIDLTE))
 	}
-	if i.SSOCertContains != nil {
-		predicates = append(predicates, organizationsetting.SSOCertContains(*i.SSOCertContains))
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, oauthclient.OwnerIDContains(*i.OwnerIDContains))
 	}
-	if i.SSOCertHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.SSOCertHasPrefix(*i.SSOCertHasPrefix))
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, oauthclient.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
 	}
-	if i.SSOCertHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.SSOCertHasSuffix(*i.SSOCertHasSuffix))
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, oauthclient.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
 	}
-	if i.SSOCertIsNil {
-		predicates = append(predicates, organizationsetting.SSOCertIsNil())
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, oauthclient.OwnerIDEqualFold(*i.OwnerIDEqualFold))
 	}
-	if i.SSOCertNotNil {
-		predicates = append(predicates, organizationsetting.SSOCertNotNil())
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, oauthclient.OwnerIDContainsFold(*i.OwnerIDContainsFold))
 	}
-	if i.SSOCertEqualFold != nil {
-		predicates = append(predicates, organizationsetting.SSOCertEqualFold(*i.SSOCertEqualFold))
+	if i.Name != nil {
+		predicates = append(predicates, oauthclient.NameEQ(*i.Name))
 	}
-	if i.SSOCertContainsFold != nil {
-		predicates = append(predicates, organizationsetting.SSOCertContainsFold(*i.SSOCertContainsFold))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, oauthclient.NameNEQ(*i.NameNEQ))
 	}
-	if i.SSOEntrypoint != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointEQ(*i.SSOEntrypoint))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, oauthclient.NameIn(i.NameIn...))
 	}
-	if i.SSOEntrypointNEQ != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointNEQ(*i.SSOEntrypointNEQ))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, oauthclient.NameNotIn(i.NameNotIn...))
 	}
-	if len(i.SSOEntrypointIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOEntrypointIn(i.SSOEntrypointIn...))
+	if i.NameGT != nil {
+		predicates = append(predicates, oauthclient.NameGT(*i.NameGT))
 	}
-	if len(i.SSOEntrypointNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOEntrypointNotIn(i.SSOEntrypointNotIn...))
+	if i.NameGTE != nil {
+		predicates = append(predicates, oauthclient.NameGTE(*i.NameGTE))
 	}
-	if i.SSOEntrypointGT != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointGT(*i.SSOEntrypointGT))
+	if i.NameLT != nil {
+		predicates = append(predicates, oauthclient.NameLT(*i.NameLT))
 	}
-	if i.SSOEntrypointGTE != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointGTE(*i.SSOEntrypointGTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, oauthclient.NameLTE(*i.NameLTE))
 	}
-	if i.SSOEntrypointLT != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointLT(*i.SSOEntrypointLT))
-	}
-	if i.SSOEntrypointLTE != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointLTE(*i.SSOEntrypointLTE))
-	}
-	if i.SSOEntrypointContains != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointContains(*i.SSOEntrypointContains))
-	}
-	if i.SSOEntrypointHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointHasPrefix(*i.SSOEntrypointHasPrefix))
-	}
-	if i.SSOEntrypointHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointHasSuffix(*i.SSOEntrypointHasSuffix))
-	}
-	if i.SSOEntrypointIsNil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointIsNil())
-	}
-	if i.SSOEntrypointNotNil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointNotNil())
-	}
-	if i.SSOEntrypointEqualFold != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointEqualFold(*i.SSOEntrypointEqualFold))
-	}
-	if i.SSOEntrypointContainsFold != nil {
-		predicates = append(predicates, organizationsetting.SSOEntrypointContainsFold(*i.SSOEntrypointContainsFold))
-	}
-	if i.SSOIssuer != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerEQ(*i.SSOIssuer))
-	}
-	if i.SSOIssuerNEQ != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerNEQ(*i.SSOIssuerNEQ))
-	}
-	if len(i.SSOIssuerIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOIssuerIn(i.SSOIssuerIn...))
-	}
-	if len(i.SSOIssuerNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.SSOIssuerNotIn(i.SSOIssuerNotIn...))
-	}
-	if i.SSOIssuerGT != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerGT(*i.SSOIssuerGT))
-	}
-	if i.SSOIssuerGTE != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerGTE(*i.SSOIssuerGTE))
-	}
-	if i.SSOIssuerLT != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerLT(*i.SSOIssuerLT))
-	}
-	if i.SSOIssuerLTE != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerLTE(*i.SSOIssuerLTE))
-	}
-	if i.SSOIssuerContains != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerContains(*i.SSOIssuerContains))
-	}
-	if i.SSOIssuerHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerHasPrefix(*i.SSOIssuerHasPrefix))
-	}
-	if i.SSOIssuerHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerHasSuffix(*i.SSOIssuerHasSuffix))
-	}
-	if i.SSOIssuerIsNil {
-		predicates = append(predicates, organizationsetting.SSOIssuerIsNil())
-	}
-	if i.SSOIssuerNotNil {
-		predicates = append(predicates, organizationsetting.SSOIssuerNotNil())
-	}
-	if i.SSOIssuerEqualFold != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerEqualFold(*i.SSOIssuerEqualFold))
-	}
-	if i.SSOIssuerContainsFold != nil {
-		predicates = append(predicates, organizationsetting.SSOIssuerContainsFold(*i.SSOIssuerContainsFold))
-	}
-	if i.BillingContact != nil {
-		predicates = append(predicates, organizationsetting.BillingContactEQ(*i.BillingContact))
-	}
-	if i.BillingContactNEQ != nil {
-		predicates = append(predicates, organizationsetting.BillingContactNEQ(*i.BillingContactNEQ))
-	}
-	if len(i.BillingContactIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingContactIn(i.BillingContactIn...))
-	}
-	if len(i.BillingContactNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingContactNotIn(i.BillingContactNotIn...))
-	}
-	if i.BillingContactGT != nil {
-		predicates = append(predicates, organizationsetting.BillingContactGT(*i.BillingContactGT))
-	}
-	if i.BillingContactGTE != nil {
-		predicates = append(predicates, organizationsetting.BillingContactGTE(*i.BillingContactGTE))
-	}
-	if i.BillingContactLT != nil {
-		predicates = append(predicates, organizationsetting.BillingContactLT(*i.BillingContactLT))
-	}
-	if i.BillingContactLTE != nil {
-		predicates = append(predicates, organizationsetting.BillingContactLTE(*i.BillingContactLTE))
-	}
-	if i.BillingContactContains != nil {
-		predicates = append(predicates, organizationsetting.BillingContactContains(*i.BillingContactContains))
-	}
-	if i.BillingContactHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.BillingContactHasPrefix(*i.BillingContactHasPrefix))
-	}
-	if i.BillingContactHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.BillingContactHasSuffix(*i.BillingContactHasSuffix))
-	}
-	if i.BillingContactIsNil {
-		predicates = append(predicates, organizationsetting.BillingContactIsNil())
-	}
-	if i.BillingContactNotNil {
-		predicates = append(predicates, organizationsetting.BillingContactNotNil())
-	}
-	if i.BillingContactEqualFold != nil {
-		predicates = append(predicates, organizationsetting.BillingContactEqualFold(*i.BillingContactEqualFold))
-	}
-	if i.BillingContactContainsFold != nil {
-		predicates = append(predicates, organizationsetting.BillingContactContainsFold(*i.BillingContactContainsFold))
-	}
-	if i.BillingEmail != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailEQ(*i.BillingEmail))
-	}
-	if i.BillingEmailNEQ != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailNEQ(*i.BillingEmailNEQ))
-	}
-	if len(i.BillingEmailIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingEmailIn(i.BillingEmailIn...))
-	}
-	if len(i.BillingEmailNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingEmailNotIn(i.BillingEmailNotIn...))
-	}
-	if i.BillingEmailGT != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailGT(*i.BillingEmailGT))
-	}
-	if i.BillingEmailGTE != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailGTE(*i.BillingEmailGTE))
-	}
-	if i.BillingEmailLT != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailLT(*i.BillingEmailLT))
-	}
-	if i.BillingEmailLTE != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailLTE(*i.BillingEmailLTE))
-	}
-	if i.BillingEmailContains != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailContains(*i.BillingEmailContains))
-	}
-	if i.BillingEmailHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailHasPrefix(*i.BillingEmailHasPrefix))
-	}
-	if i.BillingEmailHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailHasSuffix(*i.BillingEmailHasSuffix))
-	}
-	if i.BillingEmailIsNil {
-		predicates = append(predicates, organizationsetting.BillingEmailIsNil())
-	}
-	if i.BillingEmailNotNil {
-		predicates = append(predicates, organizationsetting.BillingEmailNotNil())
-	}
-	if i.BillingEmailEqualFold != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailEqualFold(*i.BillingEmailEqualFold))
-	}
-	if i.BillingEmailContainsFold != nil {
-		predicates = append(predicates, organizationsetting.BillingEmailContainsFold(*i.BillingEmailContainsFold))
-	}
-	if i.BillingPhone != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneEQ(*i.BillingPhone))
-	}
-	if i.BillingPhoneNEQ != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneNEQ(*i.BillingPhoneNEQ))
-	}
-	if len(i.BillingPhoneIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingPhoneIn(i.BillingPhoneIn...))
-	}
-	if len(i.BillingPhoneNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingPhoneNotIn(i.BillingPhoneNotIn...))
-	}
-	if i.BillingPhoneGT != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneGT(*i.BillingPhoneGT))
+	if i.NameContains != nil {
+		predicates = append(predicates, oauthclient.NameContains(*i.NameContains))
 	}
-	if i.BillingPhoneGTE != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneGTE(*i.BillingPhoneGTE))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, oauthclient.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.BillingPhoneLT != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneLT(*i.BillingPhoneLT))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, oauthclient.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.BillingPhoneLTE != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneLTE(*i.BillingPhoneLTE))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, oauthclient.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.BillingPhoneContains != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneContains(*i.BillingPhoneContains))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, oauthclient.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.BillingPhoneHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneHasPrefix(*i.BillingPhoneHasPrefix))
+	if i.ClientID != nil {
+		predicates = append(predicates, oauthclient.ClientIDEQ(*i.ClientID))
 	}
-	if i.BillingPhoneHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneHasSuffix(*i.BillingPhoneHasSuffix))
+	if i.ClientIDNEQ != nil {
+		predicates = append(predicates, oauthclient.ClientIDNEQ(*i.ClientIDNEQ))
 	}
-	if i.BillingPhoneIsNil {
-		predicates = append(predicates, organizationsetting.BillingPhoneIsNil())
+	if len(i.ClientIDIn) > 0 {
+		predicates = append(predicates, oauthclient.ClientIDIn(i.ClientIDIn...))
 	}
-	if i.BillingPhoneNotNil {
-		predicates = append(predicates, organizationsetting.BillingPhoneNotNil())
+	if len(i.ClientIDNotIn) > 0 {
+		predicates = append(predicates, oauthclient.ClientIDNotIn(i.ClientIDNotIn...))
 	}
-	if i.BillingPhoneEqualFold != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneEqualFold(*i.BillingPhoneEqualFold))
+	if i.ClientIDGT != nil {
+		predicates = append(predicates, oauthclient.ClientIDGT(*i.ClientIDGT))
 	}
-	if i.BillingPhoneContainsFold != nil {
-		predicates = append(predicates, organizationsetting.BillingPhoneContainsFold(*i.BillingPhoneContainsFold))
+	if i.ClientIDGTE != nil {
+		predicates = append(predicates, oauthclient.ClientIDGTE(*i.ClientIDGTE))
 	}
-	if i.BillingAddress != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressEQ(*i.BillingAddress))
+	if i.ClientIDLT != nil {
+		predicates = append(predicates, oauthclient.ClientIDLT(*i.ClientIDLT))
 	}
-	if i.BillingAddressNEQ != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressNEQ(*i.BillingAddressNEQ))
+	if i.ClientIDLTE != nil {
+		predicates = append(predicates, oauthclient.ClientIDLTE(*i.ClientIDLTE))
 	}
-	if len(i.BillingAddressIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingAddressIn(i.BillingAddressIn...))
+	if i.ClientIDContains != nil {
+		predicates = append(predicates, oauthclient.ClientIDContains(*i.ClientIDContains))
 	}
-	if len(i.BillingAddressNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.BillingAddressNotIn(i.BillingAddressNotIn...))
+	if i.ClientIDHasPrefix != nil {
+		predicates = append(predicates, oauthclient.ClientIDHasPrefix(*i.ClientIDHasPrefix))
 	}
-	if i.BillingAddressGT != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressGT(*i.BillingAddressGT))
+	if i.ClientIDHasSuffix != nil {
+		predicates = append(predicates, oauthclient.ClientIDHasSuffix(*i.ClientIDHasSuffix))
 	}
-	if i.BillingAddressGTE != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressGTE(*i.BillingAddressGTE))
+	if i.ClientIDEqualFold != nil {
+		predicates = append(predicates, oauthclient.ClientIDEqualFold(*i.ClientIDEqualFold))
 	}
-	if i.BillingAddressLT != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressLT(*i.BillingAddressLT))
+	if i.ClientIDContainsFold != nil {
+		predicates = append(predicates, oauthclient.ClientIDContainsFold(*i.ClientIDContainsFold))
 	}
-	if i.BillingAddressLTE != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressLTE(*i.BillingAddressLTE))
+	if i.Public != nil {
+		predicates = append(predicates, oauthclient.PublicEQ(*i.Public))
 	}
-	if i.BillingAddressContains != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressContains(*i.BillingAddressContains))
+	if i.PublicNEQ != nil {
+		predicates = append(predicates, oauthclient.PublicNEQ(*i.PublicNEQ))
 	}
-	if i.BillingAddressHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressHasPrefix(*i.BillingAddressHasPrefix))
+
+	if i.HasOwner != nil {
+		p := oauthclient.HasOwner()
+		if !*i.HasOwner {
+			p = oauthclient.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.BillingAddressHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressHasSuffix(*i.BillingAddressHasSuffix))
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, oauthclient.HasOwnerWith(with...))
 	}
-	if i.BillingAddressIsNil {
-		predicates = append(predicates, organizationsetting.BillingAddressIsNil())
-	}
-	if i.BillingAddressNotNil {
-		predicates = append(predicates, organizationsetting.BillingAddressNotNil())
-	}
-	if i.BillingAddressEqualFold != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressEqualFold(*i.BillingAddressEqualFold))
-	}
-	if i.BillingAddressContainsFold != nil {
-		predicates = append(predicates, organizationsetting.BillingAddressContainsFold(*i.BillingAddressContainsFold))
-	}
-	if i.TaxIdentifier != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierEQ(*i.TaxIdentifier))
-	}
-	if i.TaxIdentifierNEQ != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierNEQ(*i.TaxIdentifierNEQ))
-	}
-	if len(i.TaxIdentifierIn) > 0 {
-		predicates = append(predicates, organizationsetting.TaxIdentifierIn(i.TaxIdentifierIn...))
-	}
-	if len(i.TaxIdentifierNotIn) > 0 {
-		predicates = append(predicates, organizationsetting.TaxIdentifierNotIn(i.TaxIdentifierNotIn...))
-	}
-	if i.TaxIdentifierGT != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierGT(*i.TaxIdentifierGT))
-	}
-	if i.TaxIdentifierGTE != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierGTE(*i.TaxIdentifierGTE))
-	}
-	if i.TaxIdentifierLT != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierLT(*i.TaxIdentifierLT))
-	}
-	if i.TaxIdentifierLTE != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierLTE(*i.TaxIdentifierLTE))
-	}
-	if i.TaxIdentifierContains != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierContains(*i.TaxIdentifierContains))
-	}
-	if i.TaxIdentifierHasPrefix != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierHasPrefix(*i.TaxIdentifierHasPrefix))
-	}
-	if i.TaxIdentifierHasSuffix != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierHasSuffix(*i.TaxIdentifierHasSuffix))
-	}
-	if i.TaxIdentifierIsNil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierIsNil())
-	}
-	if i.TaxIdentifierNotNil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierNotNil())
-	}
-	if i.TaxIdentifierEqualFold != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierEqualFold(*i.TaxIdentifierEqualFold))
-	}
-	if i.TaxIdentifierContainsFold != nil {
-		predicates = append(predicates, organizationsetting.TaxIdentifierContainsFold(*i.TaxIdentifierContainsFold))
-	}
-
-	if i.HasOrganization != nil {
-		p := organizationsetting.HasOrganization()
-		if !*i.HasOrganization {
-			p = organizationsetting.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasOrganizationWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasOrganizationWith))
-		for _, w := range i.HasOrganizationWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOrganizationWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, organizationsetting.HasOrganizationWith(with...))
-	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyOrganizationSettingWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return organizationsetting.And(predicates...), nil
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOauthClientWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return oauthclient.And(predicates...), nil
 	}
 }
 
-// PersonalAccessTokenWhereInput represents a where input for filtering PersonalAccessToken queries.
-type PersonalAccessTokenWhereInput struct {
-	Predicates []predicate.PersonalAccessToken  `json:"-"`
-	Not        *PersonalAccessTokenWhereInput   `json:"not,omitempty"`
-	Or         []*PersonalAccessTokenWhereInput `json:"or,omitempty"`
-	And        []*PersonalAccessTokenWhereInput `json:"and,omitempty"`
+// OauthProviderWhereInput represents a where input for filtering OauthProvider queries.
+type OauthProviderWhereInput struct {
+	Predicates []predicate.OauthProvider  `json:"-"`
+	Not        *OauthProviderWhereInput   `json:"not,omitempty"`
+	Or         []*OauthProviderWhereInput `json:"or,omitempty"`
+	And        []*OauthProviderWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -5667,61 +5671,139 @@ type PersonalAccessTokenWhereInput struct {
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "token" field predicates.
-	Token             *string  `json:"token,omitempty"`
-	TokenNEQ          *string  `json:"tokenNEQ,omitempty"`
-	TokenIn           []string `json:"tokenIn,omitempty"`
-	TokenNotIn        []string `json:"tokenNotIn,omitempty"`
-	TokenGT           *string  `json:"tokenGT,omitempty"`
-	TokenGTE          *string  `json:"tokenGTE,omitempty"`
-	TokenLT           *string  `json:"tokenLT,omitempty"`
-	TokenLTE          *string  `json:"tokenLTE,omitempty"`
-	TokenContains     *string  `json:"tokenContains,omitempty"`
-	TokenHasPrefix    *string  `json:"tokenHasPrefix,omitempty"`
-	TokenHasSuffix    *string  `json:"tokenHasSuffix,omitempty"`
-	TokenEqualFold    *string  `json:"tokenEqualFold,omitempty"`
-	TokenContainsFold *string  `json:"tokenContainsFold,omitempty"`
+	// "client_id" field predicates.
+	ClientID             *string  `json:"clientID,omitempty"`
+	ClientIDNEQ          *string  `json:"clientIDNEQ,omitempty"`
+	ClientIDIn           []string `json:"clientIDIn,omitempty"`
+	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
+	ClientIDGT           *string  `json:"clientIDGT,omitempty"`
+	ClientIDGTE          *string  `json:"clientIDGTE,omitempty"`
+	ClientIDLT           *string  `json:"clientIDLT,omitempty"`
+	ClientIDLTE          *string  `json:"clientIDLTE,omitempty"`
+	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
+	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
+	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
+	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
+	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
 
-	// "expires_at" field predicates.
-	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
-	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
-	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
-	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
-	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
-	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
-	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
-	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+	// "client_secret" field predicates.
+	ClientSecret             *string  `json:"clientSecret,omitempty"`
+	ClientSecretNEQ          *string  `json:"clientSecretNEQ,omitempty"`
+	ClientSecretIn           []string `json:"clientSecretIn,omitempty"`
+	ClientSecretNotIn        []string `json:"clientSecretNotIn,omitempty"`
+	ClientSecretGT           *string  `json:"clientSecretGT,omitempty"`
+	ClientSecretGTE          *string  `json:"clientSecretGTE,omitempty"`
+	ClientSecretLT           *string  `json:"clientSecretLT,omitempty"`
+	ClientSecretLTE          *string  `json:"clientSecretLTE,omitempty"`
+	ClientSecretContains     *string  `json:"clientSecretContains,omitempty"`
+	ClientSecretHasPrefix    *string  `json:"clientSecretHasPrefix,omitempty"`
+	ClientSecretHasSuffix    *string  `json:"clientSecretHasSuffix,omitempty"`
+	ClientSecretEqualFold    *string  `json:"clientSecretEqualFold,omitempty"`
+	ClientSecretContainsFold *string  `json:"clientSecretContainsFold,omitempty"`
 
-	// "last_used_at" field predicates.
-	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
-	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
-	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
-	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
-	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
-	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
-	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
-	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
-	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
-	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
+	// "redirect_url" field predicates.
+	RedirectURL             *string  `json:"redirectURL,omitempty"`
+	RedirectURLNEQ          *string  `json:"redirectURLNEQ,omitempty"`
+	RedirectURLIn           []string `json:"redirectURLIn,omitempty"`
+	RedirectURLNotIn        []string `json:"redirectURLNotIn,omitempty"`
+	RedirectURLGT           *string  `json:"redirectURLGT,omitempty"`
+	RedirectURLGTE          *string  `json:"redirectURLGTE,omitempty"`
+	RedirectURLLT           *string  `json:"redirectURLLT,omitempty"`
+	RedirectURLLTE          *string  `json:"redirectURLLTE,omitempty"`
+	RedirectURLContains     *string  `json:"redirectURLContains,omitempty"`
+	RedirectURLHasPrefix    *string  `json:"redirectURLHasPrefix,omitempty"`
+	RedirectURLHasSuffix    *string  `json:"redirectURLHasSuffix,omitempty"`
+	RedirectURLEqualFold    *string  `json:"redirectURLEqualFold,omitempty"`
+	RedirectURLContainsFold *string  `json:"redirectURLContainsFold,omitempty"`
+
+	// "scopes" field predicates.
+	Scopes             *string  `json:"scopes,omitempty"`
+	ScopesNEQ          *string  `json:"scopesNEQ,omitempty"`
+	ScopesIn           []string `json:"scopesIn,omitempty"`
+	ScopesNotIn        []string `json:"scopesNotIn,omitempty"`
+	ScopesGT           *string  `json:"scopesGT,omitempty"`
+	ScopesGTE          *string  `json:"scopesGTE,omitempty"`
+	ScopesLT           *string  `json:"scopesLT,omitempty"`
+	ScopesLTE          *string  `json:"scopesLTE,omitempty"`
+	ScopesContains     *string  `json:"scopesContains,omitempty"`
+	ScopesHasPrefix    *string  `json:"scopesHasPrefix,omitempty"`
+	ScopesHasSuffix    *string  `json:"scopesHasSuffix,omitempty"`
+	ScopesEqualFold    *string  `json:"scopesEqualFold,omitempty"`
+	ScopesContainsFold *string  `json:"scopesContainsFold,omitempty"`
+
+	// "auth_url" field predicates.
+	AuthURL             *string  `json:"authURL,omitempty"`
+	AuthURLNEQ          *string  `json:"authURLNEQ,omitempty"`
+	AuthURLIn           []string `json:"authURLIn,omitempty"`
+	AuthURLNotIn        []string `json:"authURLNotIn,omitempty"`
+	AuthURLGT           *string  `json:"authURLGT,omitempty"`
+	AuthURLGTE          *string  `json:"authURLGTE,omitempty"`
+	AuthURLLT           *string  `json:"authURLLT,omitempty"`
+	AuthURLLTE          *string  `json:"authURLLTE,omitempty"`
+	AuthURLContains     *string  `json:"authURLContains,omitempty"`
+	AuthURLHasPrefix    *string  `json:"authURLHasPrefix,omitempty"`
+	AuthURLHasSuffix    *string  `json:"authURLHasSuffix,omitempty"`
+	AuthURLEqualFold    *string  `json:"authURLEqualFold,omitempty"`
+	AuthURLContainsFold *string  `json:"authURLContainsFold,omitempty"`
+
+	// "token_url" field predicates.
+	TokenURL             *string  `json:"tokenURL,omitempty"`
+	TokenURLNEQ          *string  `json:"tokenURLNEQ,omitempty"`
+	TokenURLIn           []string `json:"tokenURLIn,omitempty"`
+	TokenURLNotIn        []string `json:"tokenURLNotIn,omitempty"`
+	TokenURLGT           *string  `json:"tokenURLGT,omitempty"`
+	TokenURLGTE          *string  `json:"tokenURLGTE,omitempty"`
+	TokenURLLT           *string  `json:"tokenURLLT,omitempty"`
+	TokenURLLTE          *string  `json:"tokenURLLTE,omitempty"`
+	TokenURLContains     *string  `json:"tokenURLContains,omitempty"`
+	TokenURLHasPrefix    *string  `json:"tokenURLHasPrefix,omitempty"`
+	TokenURLHasSuffix    *string  `json:"tokenURLHasSuffix,omitempty"`
+	TokenURLEqualFold    *string  `json:"tokenURLEqualFold,omitempty"`
+	TokenURLContainsFold *string  `json:"tokenURLContainsFold,omitempty"`
+
+	// "auth_style" field predicates.
+	AuthStyle      *uint8  `json:"authStyle,omitempty"`
+	AuthStyleNEQ   *uint8  `json:"authStyleNEQ,omitempty"`
+	AuthStyleIn    []uint8 `json:"authStyleIn,omitempty"`
+	AuthStyleNotIn []uint8 `json:"authStyleNotIn,omitempty"`
+	AuthStyleGT    *uint8  `json:"authStyleGT,omitempty"`
+	AuthStyleGTE   *uint8  `json:"authStyleGTE,omitempty"`
+	AuthStyleLT    *uint8  `json:"authStyleLT,omitempty"`
+	AuthStyleLTE   *uint8  `json:"authStyleLTE,omitempty"`
+
+	// "info_url" field predicates.
+	InfoURL             *string  `json:"infoURL,omitempty"`
+	InfoURLNEQ          *string  `json:"infoURLNEQ,omitempty"`
+	InfoURLIn           []string `json:"infoURLIn,omitempty"`
+	InfoURLNotIn        []string `json:"infoURLNotIn,omitempty"`
+	InfoURLGT           *string  `json:"infoURLGT,omitempty"`
+	InfoURLGTE          *string  `json:"infoURLGTE,omitempty"`
+	InfoURLLT           *string  `json:"infoURLLT,omitempty"`
+	InfoURLLTE          *string  `json:"infoURLLTE,omitempty"`
+	InfoURLContains     *string  `json:"infoURLContains,omitempty"`
+	InfoURLHasPrefix    *string  `json:"infoURLHasPrefix,omitempty"`
+	InfoURLHasSuffix    *string  `json:"infoURLHasSuffix,omitempty"`
+	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
+	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
 
 	// "owner" edge predicates.
-	HasOwner     *bool             `json:"hasOwner,omitempty"`
-	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PersonalAccessTokenWhereInput) AddPredicates(predicates ...predicate.PersonalAccessToken) {
+func (i *OauthProviderWhereInput) AddPredicates(predicates ...predicate.OauthProvider) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PersonalAccessTokenWhereInput filter on the PersonalAccessTokenQuery builder.
-func (i *PersonalAccessTokenWhereInput) Filter(q *PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error) {
+// Filter applies the OauthProviderWhereInput filter on the OauthProviderQuery builder.
+func (i *OauthProviderWhereInput) Filter(q *OauthProviderQuery) (*OauthProviderQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPersonalAccessTokenWhereInput {
+		if err == ErrEmptyOauthProviderWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -5729,19 +5811,19 @@ func (i *PersonalAccessTokenWhereInput) Filter(q *PersonalAccessTokenQuery) (*Pe
 	return q.Where(p), nil
 }
 
-// ErrEmptyPersonalAccessTokenWhereInput is returned in case the PersonalAccessTokenWhereInput is empty.
-var ErrEmptyPersonalAccessTokenWhereInput = errors.New("generated: empty predicate PersonalAccessTokenWhereInput")
+// ErrEmptyOauthProviderWhereInput is returned in case the OauthProviderWhereInput is empty.
+var ErrEmptyOauthProviderWhereInput = errors.New("generated: empty predicate OauthProviderWhereInput")
 
-// P returns a predicate for filtering personalaccesstokens.
+// P returns a predicate for filtering oauthproviders.
 // An error is returned if the input is empty or invalid.
-func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, error) {
-	var predicates []predicate.PersonalAccessToken
+func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
+	var predicates []predicate.OauthProvider
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, personalaccesstoken.Not(p))
+		predicates = append(predicates, oauthprovider.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -5751,7 +5833,7 @@ func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.PersonalAccessToken, 0, n)
+		or := make([]predicate.OauthProvider, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -5759,7 +5841,7 @@ func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, erro
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, personalaccesstoken.Or(or...))
+		predicates = append(predicates, oauthprovider.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -5769,7 +5851,7 @@ func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.PersonalAccessToken, 0, n)
+		and := make([]predicate.OauthProvider, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -5777,394 +5859,598 @@ func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, erro
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, personalaccesstoken.And(and...))
+		predicates = append(predicates, oauthprovider.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, personalaccesstoken.IDEQ(*i.ID))
+		predicates = append(predicates, oauthprovider.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, oauthprovider.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.IDIn(i.IDIn...))
+		predicates = append(predicates, oauthprovider.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, oauthprovider.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, personalaccesstoken.IDGT(*i.IDGT))
+		predicates = append(predicates, oauthprovider.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, personalaccesstoken.IDGTE(*i.IDGTE))
+		predicates = append(predicates, oauthprovider.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, personalaccesstoken.IDLT(*i.IDLT))
+		predicates = append(predicates, oauthprovider.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, personalaccesstoken.IDLTE(*i.IDLTE))
+		predicates = append(predicates, oauthprovider.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, oauthprovider.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, oauthprovider.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, oauthprovider.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, oauthprovider.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, oauthprovider.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, oauthprovider.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, oauthprovider.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, oauthprovider.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, oauthprovider.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, oauthprovider.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, oauthprovider.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, oauthprovider.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, oauthprovider.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, oauthprovider.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, oauthprovider.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, oauthprovider.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, oauthprovider.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, oauthprovider.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, oauthprovider.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, oauthprovider.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, oauthprovider.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, oauthprovider.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, oauthprovider.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, oauthprovider.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, oauthprovider.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, oauthprovider.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, oauthprovider.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, oauthprovider.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, oauthprovider.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, personalaccesstoken.CreatedByIsNil())
+		predicates = append(predicates, oauthprovider.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, personalaccesstoken.CreatedByNotNil())
+		predicates = append(predicates, oauthprovider.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, oauthprovider.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, oauthprovider.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, oauthprovider.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, oauthprovider.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, oauthprovider.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, oauthprovider.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, oauthprovider.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, oauthprovider.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, oauthprovider.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, oauthprovider.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, oauthprovider.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, oauthprovider.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, oauthprovider.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByIsNil())
+		predicates = append(predicates, oauthprovider.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByNotNil())
+		predicates = append(predicates, oauthprovider.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, oauthprovider.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, oauthprovider.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, oauthprovider.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, oauthprovider.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, oauthprovider.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, oauthprovider.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, oauthprovider.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, oauthprovider.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, oauthprovider.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, oauthprovider.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtIsNil())
+		predicates = append(predicates, oauthprovider.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, personalaccesstoken.DeletedAtNotNil())
+		predicates = append(predicates, oauthprovider.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, oauthprovider.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, oauthprovider.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, oauthprovider.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, oauthprovider.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, oauthprovider.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, oauthprovider.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, oauthprovider.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, oauthprovider.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, oauthprovider.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, oauthprovider.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, oauthprovider.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, personalaccesstoken.DeletedByIsNil())
+		predicates = append(predicates, oauthprovider.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, personalaccesstoken.DeletedByNotNil())
+		predicates = append(predicates, oauthprovider.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, oauthprovider.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, oauthprovider.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
 	if i.Name != nil {
-		predicates = append(predicates, personalaccesstoken.NameEQ(*i.Name))
+		predicates = append(predicates, oauthprovider.NameEQ(*i.Name))
 	}
 	if i.NameNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.NameNEQ(*i.NameNEQ))
+		predicates = append(predicates, oauthprovider.NameNEQ(*i.NameNEQ))
 	}
 	if len(i.NameIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.NameIn(i.NameIn...))
+		predicates = append(predicates, oauthprovider.NameIn(i.NameIn...))
 	}
 	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.NameNotIn(i.NameNotIn...))
+		predicates = append(predicates, oauthprovider.NameNotIn(i.NameNotIn...))
 	}
 	if i.NameGT != nil {
-		predicates = append(predicates, personalaccesstoken.NameGT(*i.NameGT))
+		predicates = append(predicates, oauthprovider.NameGT(*i.NameGT))
 	}
 	if i.NameGTE != nil {
-		predicates = append(predicates, personalaccesstoken.NameGTE(*i.NameGTE))
+		predicates = append(predicates, oauthprovider.NameGTE(*i.NameGTE))
 	}
 	if i.NameLT != nil {
-		predicates = append(predicates, personalaccesstoken.NameLT(*i.NameLT))
+		predicates = append(predicates, oauthprovider.NameLT(*i.NameLT))
 	}
 	if i.NameLTE != nil {
-		predicates = append(predicates, personalaccesstoken.NameLTE(*i.NameLTE))
+		predicates = append(predicates, oauthprovider.NameLTE(*i.NameLTE))
 	}
 	if i.NameContains != nil {
-		predicates = append(predicates, personalaccesstoken.NameContains(*i.NameContains))
+		predicates = append(predicates, oauthprovider.NameContains(*i.NameContains))
 	}
 	if i.NameHasPrefix != nil {
-		predicates = append(predicates, personalaccesstoken.NameHasPrefix(*i.NameHasPrefix))
+		predicates = append(predicates, oauthprovider.NameHasPrefix(*i.NameHasPrefix))
 	}
 	if i.NameHasSuffix != nil {
-		predicates = append(predicates, personalaccesstoken.NameHasSuffix(*i.NameHasSuffix))
+		predicates = append(predicates, oauthprovider.NameHasSuffix(*i.NameHasSuffix))
 	}
 	if i.NameEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.NameEqualFold(*i.NameEqualFold))
+		predicates = append(predicates, oauthprovider.NameEqualFold(*i.NameEqualFold))
 	}
 	if i.NameContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.NameContainsFold(*i.NameContainsFold))
+		predicates = append(predicates, oauthprovider.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.Token != nil {
-		predicates = append(predicates, personalaccesstoken.TokenEQ(*i.Token))
+	if i.ClientID != nil {
+		predicates = append(predicates, oauthprovider.ClientIDEQ(*i.ClientID))
 	}
-	if i.TokenNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.TokenNEQ(*i.TokenNEQ))
+	if i.ClientIDNEQ != nil {
+		predicates = append(predicates, oauthprovider.ClientIDNEQ(*i.ClientIDNEQ))
 	}
-	if len(i.TokenIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.TokenIn(i.TokenIn...))
+	if len(i.ClientIDIn) > 0 {
+		predicates = append(predicates, oauthprovider.ClientIDIn(i.ClientIDIn...))
 	}
-	if len(i.TokenNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.TokenNotIn(i.TokenNotIn...))
+	if len(i.ClientIDNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.ClientIDNotIn(i.ClientIDNotIn...))
 	}
-	if i.TokenGT != nil {
-		predicates = append(predicates, personalaccesstoken.TokenGT(*i.TokenGT))
+	if i.ClientIDGT != nil {
+		predicates = append(predicates, oauthprovider.ClientIDGT(*i.ClientIDGT))
 	}
-	if i.TokenGTE != nil {
-		predicates = append(predicates, personalaccesstoken.TokenGTE(*i.TokenGTE))
+	if i.ClientIDGTE != nil {
+		predicates = append(predicates, oauthprovider.ClientIDGTE(*i.ClientIDGTE))
 	}
-	if i.TokenLT != nil {
-		predicates = append(predicates, personalaccesstoken.TokenLT(*i.TokenLT))
+	if i.ClientIDLT != nil {
+		predicates = append(predicates, oauthprovider.ClientIDLT(*i.ClientIDLT))
 	}
-	if i.TokenLTE != nil {
-		predicates = append(predicates, personalaccesstoken.TokenLTE(*i.TokenLTE))
+	if i.ClientIDLTE != nil {
+		predicates = append(predicates, oauthprovider.ClientIDLTE(*i.ClientIDLTE))
 	}
-	if i.TokenContains != nil {
-		predicates = append(predicates, personalaccesstoken.TokenContains(*i.TokenContains))
+	if i.ClientIDContains != nil {
+		predicates = append(predicates, oauthprovider.ClientIDContains(*i.ClientIDContains))
 	}
-	if i.TokenHasPrefix != nil {
-		predicates = append(predicates, personalaccesstoken.TokenHasPrefix(*i.TokenHasPrefix))
+	if i.ClientIDHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.ClientIDHasPrefix(*i.ClientIDHasPrefix))
 	}
-	if i.TokenHasSuffix != nil {
-		predicates = append(predicates, personalaccesstoken.TokenHasSuffix(*i.TokenHasSuffix))
+	if i.ClientIDHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.ClientIDHasSuffix(*i.ClientIDHasSuffix))
 	}
-	if i.TokenEqualFold != nil {
-		predicates = append(predicates, personalaccesstoken.TokenEqualFold(*i.TokenEqualFold))
+	if i.ClientIDEqualFold != nil {
+		predicates = append(predicates, oauthprovider.ClientIDEqualFold(*i.ClientIDEqualFold))
 	}
-	if i.TokenContainsFold != nil {
-		predicates = append(predicates, personalaccesstoken.TokenContainsFold(*i.TokenContainsFold))
+	if i.ClientIDContainsFold != nil {
+		predicates = append(predicates, oauthprovider.ClientIDContainsFold(*i.ClientIDContainsFold))
 	}
-	if i.ExpiresAt != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtEQ(*i.ExpiresAt))
+	if i.ClientSecret != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretEQ(*i.ClientSecret))
 	}
-	if i.ExpiresAtNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	if i.ClientSecretNEQ != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretNEQ(*i.ClientSecretNEQ))
 	}
-	if len(i.ExpiresAtIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtIn(i.ExpiresAtIn...))
+	if len(i.ClientSecretIn) > 0 {
+		predicates = append(predicates, oauthprovider.ClientSecretIn(i.ClientSecretIn...))
 	}
-	if len(i.ExpiresAtNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	if len(i.ClientSecretNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.ClientSecretNotIn(i.ClientSecretNotIn...))
 	}
-	if i.ExpiresAtGT != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtGT(*i.ExpiresAtGT))
+	if i.ClientSecretGT != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretGT(*i.ClientSecretGT))
 	}
-	if i.ExpiresAtGTE != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtGTE(*i.ExpiresAtGTE))
+	if i.ClientSecretGTE != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretGTE(*i.ClientSecretGTE))
 	}
-	if i.ExpiresAtLT != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtLT(*i.ExpiresAtLT))
+	if i.ClientSecretLT != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretLT(*i.ClientSecretLT))
 	}
-	if i.ExpiresAtLTE != nil {
-		predicates = append(predicates, personalaccesstoken.ExpiresAtLTE(*i.ExpiresAtLTE))
+	if i.ClientSecretLTE != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretLTE(*i.ClientSecretLTE))
 	}
-	if i.LastUsedAt != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtEQ(*i.LastUsedAt))
+	if i.ClientSecretContains != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretContains(*i.ClientSecretContains))
 	}
-	if i.LastUsedAtNEQ != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	if i.ClientSecretHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretHasPrefix(*i.ClientSecretHasPrefix))
 	}
-	if len(i.LastUsedAtIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtIn(i.LastUsedAtIn...))
+	if i.ClientSecretHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretHasSuffix(*i.ClientSecretHasSuffix))
 	}
-	if len(i.LastUsedAtNotIn) > 0 {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	if i.ClientSecretEqualFold != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretEqualFold(*i.ClientSecretEqualFold))
 	}
-	if i.LastUsedAtGT != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtGT(*i.LastUsedAtGT))
+	if i.ClientSecretContainsFold != nil {
+		predicates = append(predicates, oauthprovider.ClientSecretContainsFold(*i.ClientSecretContainsFold))
 	}
-	if i.LastUsedAtGTE != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtGTE(*i.LastUsedAtGTE))
+	if i.RedirectURL != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLEQ(*i.RedirectURL))
 	}
-	if i.LastUsedAtLT != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtLT(*i.LastUsedAtLT))
+	if i.RedirectURLNEQ != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLNEQ(*i.RedirectURLNEQ))
 	}
-	if i.LastUsedAtLTE != nil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtLTE(*i.LastUsedAtLTE))
+	if len(i.RedirectURLIn) > 0 {
+		predicates = append(predicates, oauthprovider.RedirectURLIn(i.RedirectURLIn...))
 	}
-	if i.LastUsedAtIsNil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtIsNil())
+	if len(i.RedirectURLNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.RedirectURLNotIn(i.RedirectURLNotIn...))
 	}
-	if i.LastUsedAtNotNil {
-		predicates = append(predicates, personalaccesstoken.LastUsedAtNotNil())
+	if i.RedirectURLGT != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLGT(*i.RedirectURLGT))
+	}
+	if i.RedirectURLGTE != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLGTE(*i.RedirectURLGTE))
+	}
+	if i.RedirectURLLT != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLLT(*i.RedirectURLLT))
+	}
+	if i.RedirectURLLTE != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLLTE(*i.RedirectURLLTE))
+	}
+	if i.RedirectURLContains != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLContains(*i.RedirectURLContains))
+	}
+	if i.RedirectURLHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLHasPrefix(*i.RedirectURLHasPrefix))
+	}
+	if i.RedirectURLHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLHasSuffix(*i.RedirectURLHasSuffix))
+	}
+	if i.RedirectURLEqualFold != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLEqualFold(*i.RedirectURLEqualFold))
+	}
+	if i.RedirectURLContainsFold != nil {
+		predicates = append(predicates, oauthprovider.RedirectURLContainsFold(*i.RedirectURLContainsFold))
+	}
+	if i.Scopes != nil {
+		predicates = append(predicates, oauthprovider.ScopesEQ(*i.Scopes))
+	}
+	if i.ScopesNEQ != nil {
+		predicates = append(predicates, oauthprovider.ScopesNEQ(*i.ScopesNEQ))
+	}
+	if len(i.ScopesIn) > 0 {
+		predicates = append(predicates, oauthprovider.ScopesIn(i.ScopesIn...))
+	}
+	if len(i.ScopesNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.ScopesNotIn(i.ScopesNotIn...))
+	}
+	if i.ScopesGT != nil {
+		predicates = append(predicates, oauthprovider.ScopesGT(*i.ScopesGT))
+	}
+	if i.ScopesGTE != nil {
+		predicates = append(predicates, oauthprovider.ScopesGTE(*i.ScopesGTE))
+	}
+	if i.ScopesLT != nil {
+		predicates = append(predicates, oauthprovider.ScopesLT(*i.ScopesLT))
+	}
+	if i.ScopesLTE != nil {
+		predicates = append(predicates, oauthprovider.ScopesLTE(*i.ScopesLTE))
+	}
+	if i.ScopesContains != nil {
+		predicates = append(predicates, oauthprovider.ScopesContains(*i.ScopesContains))
+	}
+	if i.ScopesHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.ScopesHasPrefix(*i.ScopesHasPrefix))
+	}
+	if i.ScopesHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.ScopesHasSuffix(*i.ScopesHasSuffix))
+	}
+	if i.ScopesEqualFold != nil {
+		predicates = append(predicates, oauthprovider.ScopesEqualFold(*i.ScopesEqualFold))
+	}
+	if i.ScopesContainsFold != nil {
+		predicates = append(predicates, oauthprovider.ScopesContainsFold(*i.ScopesContainsFold))
+	}
+	if i.AuthURL != nil {
+		predicates = append(predicates, oauthprovider.AuthURLEQ(*i.AuthURL))
+	}
+	if i.AuthURLNEQ != nil {
+		predicates = append(predicates, oauthprovider.AuthURLNEQ(*i.AuthURLNEQ))
+	}
+	if len(i.AuthURLIn) > 0 {
+		predicates = append(predicates, oauthprovider.AuthURLIn(i.AuthURLIn...))
+	}
+	if len(i.AuthURLNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.AuthURLNotIn(i.AuthURLNotIn...))
+	}
+	if i.AuthURLGT != nil {
+		predicates = append(predicates, oauthprovider.AuthURLGT(*i.AuthURLGT))
+	}
+	if i.AuthURLGTE != nil {
+		predicates = append(predicates, oauthprovider.AuthURLGTE(*i.AuthURLGTE))
+	}
+	if i.AuthURLLT != nil {
+		predicates = append(predicates, oauthprovider.AuthURLLT(*i.AuthURLLT))
+	}
+	if i.AuthURLLTE != nil {
+		predicates = append(predicates, oauthprovider.AuthURLLTE(*i.AuthURLLTE))
+	}
+	if i.AuthURLContains != nil {
+		predicates = append(predicates, oauthprovider.AuthURLContains(*i.AuthURLContains))
+	}
+	if i.AuthURLHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.AuthURLHasPrefix(*i.AuthURLHasPrefix))
+	}
+	if i.AuthURLHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.AuthURLHasSuffix(*i.AuthURLHasSuffix))
+	}
+	if i.AuthURLEqualFold != nil {
+		predicates = append(predicates, oauthprovider.AuthURLEqualFold(*i.AuthURLEqualFold))
+	}
+	if i.AuthURLContainsFold != nil {
+		predicates = append(predicates, oauthprovider.AuthURLContainsFold(*i.AuthURLContainsFold))
+	}
+	if i.TokenURL != nil {
+		predicates = append(predicates, oauthprovider.TokenURLEQ(*i.TokenURL))
+	}
+	if i.TokenURLNEQ != nil {
+		predicates = append(predicates, oauthprovider.TokenURLNEQ(*i.TokenURLNEQ))
+	}
+	if len(i.TokenURLIn) > 0 {
+		predicates = append(predicates, oauthprovider.TokenURLIn(i.TokenURLIn...))
+	}
+	if len(i.TokenURLNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.TokenURLNotIn(i.TokenURLNotIn...))
+	}
+	if i.TokenURLGT != nil {
+		predicates = append(predicates, oauthprovider.TokenURLGT(*i.TokenURLGT))
+	}
+	if i.TokenURLGTE != nil {
+		predicates = append(predicates, oauthprovider.TokenURLGTE(*i.TokenURLGTE))
+	}
+	if i.TokenURLLT != nil {
+		predicates = append(predicates, oauthprovider.TokenURLLT(*i.TokenURLLT))
+	}
+	if i.TokenURLLTE != nil {
+		predicates = append(predicates, oauthprovider.TokenURLLTE(*i.TokenURLLTE))
+	}
+	if i.TokenURLContains != nil {
+		predicates = append(predicates, oauthprovider.TokenURLContains(*i.TokenURLContains))
+	}
+	if i.TokenURLHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.TokenURLHasPrefix(*i.TokenURLHasPrefix))
+	}
+	if i.TokenURLHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.TokenURLHasSuffix(*i.TokenURLHasSuffix))
+	}
+	if i.TokenURLEqualFold != nil {
+		predicates = append(predicates, oauthprovider.TokenURLEqualFold(*i.TokenURLEqualFold))
+	}
+	if i.TokenURLContainsFold != nil {
+		predicates = append(predicates, oauthprovider.TokenURLContainsFold(*i.TokenURLContainsFold))
+	}
+	if i.AuthStyle != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleEQ(*i.AuthStyle))
+	}
+	if i.AuthStyleNEQ != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleNEQ(*i.AuthStyleNEQ))
+	}
+	if len(i.AuthStyleIn) > 0 {
+		predicates = append(predicates, oauthprovider.AuthStyleIn(i.AuthStyleIn...))
+	}
+	if len(i.AuthStyleNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.AuthStyleNotIn(i.AuthStyleNotIn...))
+	}
+	if i.AuthStyleGT != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleGT(*i.AuthStyleGT))
+	}
+	if i.AuthStyleGTE != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleGTE(*i.AuthStyleGTE))
+	}
+	if i.AuthStyleLT != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleLT(*i.AuthStyleLT))
+	}
+	if i.AuthStyleLTE != nil {
+		predicates = append(predicates, oauthprovider.AuthStyleLTE(*i.AuthStyleLTE))
+	}
+	if i.InfoURL != nil {
+		predicates = append(predicates, oauthprovider.InfoURLEQ(*i.InfoURL))
+	}
+	if i.InfoURLNEQ != nil {
+		predicates = append(predicates, oauthprovider.InfoURLNEQ(*i.InfoURLNEQ))
+	}
+	if len(i.InfoURLIn) > 0 {
+		predicates = append(predicates, oauthprovider.InfoURLIn(i.InfoURLIn...))
+	}
+	if len(i.InfoURLNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.InfoURLNotIn(i.InfoURLNotIn...))
+	}
+	if i.InfoURLGT != nil {
+		predicates = append(predicates, oauthprovider.InfoURLGT(*i.InfoURLGT))
+	}
+	if i.InfoURLGTE != nil {
+		predicates = append(predicates, oauthprovider.InfoURLGTE(*i.InfoURLGTE))
+	}
+	if i.InfoURLLT != nil {
+		predicates = append(predicates, oauthprovider.InfoURLLT(*i.InfoURLLT))
+	}
+	if i.InfoURLLTE != nil {
+		predicates = append(predicates, oauthprovider.InfoURLLTE(*i.InfoURLLTE))
+	}
+	if i.InfoURLContains != nil {
+		predicates = append(predicates, oauthprovider.InfoURLContains(*i.InfoURLContains))
+	}
+	if i.InfoURLHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.InfoURLHasPrefix(*i.InfoURLHasPrefix))
+	}
+	if i.InfoURLHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.InfoURLHasSuffix(*i.InfoURLHasSuffix))
+	}
+	if i.InfoURLEqualFold != nil {
+		predicates = append(predicates, oauthprovider.InfoURLEqualFold(*i.InfoURLEqualFold))
+	}
+	if i.InfoURLContainsFold != nil {
+		predicates = append(predicates, oauthprovider.InfoURLContainsFold(*i.InfoURLContainsFold))
 	}
 
 	if i.HasOwner != nil {
-		p := personalaccesstoken.HasOwner()
+		p := oauthprovider.HasOwner()
 		if !*i.HasOwner {
-			p = personalaccesstoken.Not(p)
+			p = oauthprovider.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
 	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
 		for _, w := range i.HasOwnerWith {
 			p, err := w.P()
 			if err != nil {
@@ -6172,27 +6458,27 @@ func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, erro
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, personalaccesstoken.HasOwnerWith(with...))
+		predicates = append(predicates, oauthprovider.HasOwnerWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPersonalAccessTokenWhereInput
+		return nil, ErrEmptyOauthProviderWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return personalaccesstoken.And(predicates...), nil
+		return oauthprovider.And(predicates...), nil
 	}
 }
 
-// SessionWhereInput represents a where input for filtering Session queries.
-type SessionWhereInput struct {
-	Predicates []predicate.Session  `json:"-"`
-	Not        *SessionWhereInput   `json:"not,omitempty"`
-	Or         []*SessionWhereInput `json:"or,omitempty"`
-	And        []*SessionWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID             *string  `json:"id,omitempty"`
+// OhAuthTooTokenWhereInput represents a where input for filtering OhAuthTooToken queries.
+type OhAuthTooTokenWhereInput struct {
+	Predicates []predicate.OhAuthTooToken  `json:"-"`
+	Not        *OhAuthTooTokenWhereInput   `json:"not,omitempty"`
+	Or         []*OhAuthTooTokenWhereInput `json:"or,omitempty"`
+	And        []*OhAuthTooTokenWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
 	IDNEQ          *string  `json:"idNEQ,omitempty"`
 	IDIn           []string `json:"idIn,omitempty"`
 	IDNotIn        []string `json:"idNotIn,omitempty"`
@@ -6203,143 +6489,141 @@ type SessionWhereInput struct {
 	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
 	IDContainsFold *string  `json:"idContainsFold,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+	// "client_id" field predicates.
+	ClientID             *string  `json:"clientID,omitempty"`
+	ClientIDNEQ          *string  `json:"clientIDNEQ,omitempty"`
+	ClientIDIn           []string `json:"clientIDIn,omitempty"`
+	ClientIDNotIn        []string `json:"clientIDNotIn,omitempty"`
+	ClientIDGT           *string  `json:"clientIDGT,omitempty"`
+	ClientIDGTE          *string  `json:"clientIDGTE,omitempty"`
+	ClientIDLT           *string  `json:"clientIDLT,omitempty"`
+	ClientIDLTE          *string  `json:"clientIDLTE,omitempty"`
+	ClientIDContains     *string  `json:"clientIDContains,omitempty"`
+	ClientIDHasPrefix    *string  `json:"clientIDHasPrefix,omitempty"`
+	ClientIDHasSuffix    *string  `json:"clientIDHasSuffix,omitempty"`
+	ClientIDEqualFold    *string  `json:"clientIDEqualFold,omitempty"`
+	ClientIDContainsFold *string  `json:"clientIDContainsFold,omitempty"`
 
-	// "created_by" field predicates.
-	CreatedBy             *string  `json:"createdBy,omitempty"`
-	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
-	CreatedByIn           []string `json:"createdByIn,omitempty"`
-	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
-	CreatedByGT           *string  `json:"createdByGT,omitempty"`
-	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
-	CreatedByLT           *string  `json:"createdByLT,omitempty"`
-	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
-	CreatedByContains     *string  `json:"createdByContains,omitempty"`
-	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
-	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
-	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
-	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
-	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
-	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// "nonce" field predicates.
+	Nonce             *string  `json:"nonce,omitempty"`
+	NonceNEQ          *string  `json:"nonceNEQ,omitempty"`
+	NonceIn           []string `json:"nonceIn,omitempty"`
+	NonceNotIn        []string `json:"nonceNotIn,omitempty"`
+	NonceGT           *string  `json:"nonceGT,omitempty"`
+	NonceGTE          *string  `json:"nonceGTE,omitempty"`
+	NonceLT           *string  `json:"nonceLT,omitempty"`
+	NonceLTE          *string  `json:"nonceLTE,omitempty"`
+	NonceContains     *string  `json:"nonceContains,omitempty"`
+	NonceHasPrefix    *string  `json:"nonceHasPrefix,omitempty"`
+	NonceHasSuffix    *string  `json:"nonceHasSuffix,omitempty"`
+	NonceIsNil        bool     `json:"nonceIsNil,omitempty"`
+	NonceNotNil       bool     `json:"nonceNotNil,omitempty"`
+	NonceEqualFold    *string  `json:"nonceEqualFold,omitempty"`
+	NonceContainsFold *string  `json:"nonceContainsFold,omitempty"`
 
-	// "updated_by" field predicates.
-	UpdatedBy             *string  `json:"updatedBy,omitempty"`
-	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
-	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
-	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
-	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
-	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
-	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
-	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
-	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
-	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
-	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
-	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
-	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
-	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
-	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// "claims_user_id" field predicates.
+	ClaimsUserID             *string  `json:"claimsUserID,omitempty"`
+	ClaimsUserIDNEQ          *string  `json:"claimsUserIDNEQ,omitempty"`
+	ClaimsUserIDIn           []string `json:"claimsUserIDIn,omitempty"`
+	ClaimsUserIDNotIn        []string `json:"claimsUserIDNotIn,omitempty"`
+	ClaimsUserIDGT           *string  `json:"claimsUserIDGT,omitempty"`
+	ClaimsUserIDGTE          *string  `json:"claimsUserIDGTE,omitempty"`
+	ClaimsUserIDLT           *string  `json:"claimsUserIDLT,omitempty"`
+	ClaimsUserIDLTE          *string  `json:"claimsUserIDLTE,omitempty"`
+	ClaimsUserIDContains     *string  `json:"claimsUserIDContains,omitempty"`
+	ClaimsUserIDHasPrefix    *string  `json:"claimsUserIDHasPrefix,omitempty"`
+	ClaimsUserIDHasSuffix    *string  `json:"claimsUserIDHasSuffix,omitempty"`
+	ClaimsUserIDEqualFold    *string  `json:"claimsUserIDEqualFold,omitempty"`
+	ClaimsUserIDContainsFold *string  `json:"claimsUserIDContainsFold,omitempty"`
 
-	// "session_token" field predicates.
-	SessionToken             *string  `json:"sessionToken,omitempty"`
-	SessionTokenNEQ          *string  `json:"sessionTokenNEQ,omitempty"`
-	SessionTokenIn           []string `json:"sessionTokenIn,omitempty"`
-	SessionTokenNotIn        []string `json:"sessionTokenNotIn,omitempty"`
-	SessionTokenGT           *string  `json:"sessionTokenGT,omitempty"`
-	SessionTokenGTE          *string  `json:"sessionTokenGTE,omitempty"`
-	SessionTokenLT           *string  `json:"sessionTokenLT,omitempty"`
-	SessionTokenLTE          *string  `json:"sessionTokenLTE,omitempty"`
-	SessionTokenContains     *string  `json:"sessionTokenContains,omitempty"`
-	SessionTokenHasPrefix    *string  `json:"sessionTokenHasPrefix,omitempty"`
-	SessionTokenHasSuffix    *string  `json:"sessionTokenHasSuffix,omitempty"`
-	SessionTokenEqualFold    *string  `json:"sessionTokenEqualFold,omitempty"`
-	SessionTokenContainsFold *string  `json:"sessionTokenContainsFold,omitempty"`
+	// "claims_username" field predicates.
+	ClaimsUsername             *string  `json:"claimsUsername,omitempty"`
+	ClaimsUsernameNEQ          *string  `json:"claimsUsernameNEQ,omitempty"`
+	ClaimsUsernameIn           []string `json:"claimsUsernameIn,omitempty"`
+	ClaimsUsernameNotIn        []string `json:"claimsUsernameNotIn,omitempty"`
+	ClaimsUsernameGT           *string  `json:"claimsUsernameGT,omitempty"`
+	ClaimsUsernameGTE          *string  `json:"claimsUsernameGTE,omitempty"`
+	ClaimsUsernameLT           *string  `json:"claimsUsernameLT,omitempty"`
+	ClaimsUsernameLTE          *string  `json:"claimsUsernameLTE,omitempty"`
+	ClaimsUsernameContains     *string  `json:"claimsUsernameContains,omitempty"`
+	ClaimsUsernameHasPrefix    *string  `json:"claimsUsernameHasPrefix,omitempty"`
+	ClaimsUsernameHasSuffix    *string  `json:"claimsUsernameHasSuffix,omitempty"`
+	ClaimsUsernameEqualFold    *string  `json:"claimsUsernameEqualFold,omitempty"`
+	ClaimsUsernameContainsFold *string  `json:"claimsUsernameContainsFold,omitempty"`
 
-	// "issued_at" field predicates.
-	IssuedAt      *time.Time  `json:"issuedAt,omitempty"`
-	IssuedAtNEQ   *time.Time  `json:"issuedAtNEQ,omitempty"`
-	IssuedAtIn    []time.Time `json:"issuedAtIn,omitempty"`
-	IssuedAtNotIn []time.Time `json:"issuedAtNotIn,omitempty"`
-	IssuedAtGT    *time.Time  `json:"issuedAtGT,omitempty"`
-	IssuedAtGTE   *time.Time  `json:"issuedAtGTE,omitempty"`
-	IssuedAtLT    *time.Time  `json:"issuedAtLT,omitempty"`
-	IssuedAtLTE   *time.Time  `json:"issuedAtLTE,omitempty"`
+	// "claims_email" field predicates.
+	ClaimsEmail             *string  `json:"claimsEmail,omitempty"`
+	ClaimsEmailNEQ          *string  `json:"claimsEmailNEQ,omitempty"`
+	ClaimsEmailIn           []string `json:"claimsEmailIn,omitempty"`
+	ClaimsEmailNotIn        []string `json:"claimsEmailNotIn,omitempty"`
+	ClaimsEmailGT           *string  `json:"claimsEmailGT,omitempty"`
+	ClaimsEmailGTE          *string  `json:"claimsEmailGTE,omitempty"`
+	ClaimsEmailLT           *string  `json:"claimsEmailLT,omitempty"`
+	ClaimsEmailLTE          *string  `json:"claimsEmailLTE,omitempty"`
+	ClaimsEmailContains     *string  `json:"claimsEmailContains,omitempty"`
+	ClaimsEmailHasPrefix    *string  `json:"claimsEmailHasPrefix,omitempty"`
+	ClaimsEmailHasSuffix    *string  `json:"claimsEmailHasSuffix,omitempty"`
+	ClaimsEmailEqualFold    *string  `json:"claimsEmailEqualFold,omitempty"`
+	ClaimsEmailContainsFold *string  `json:"claimsEmailContainsFold,omitempty"`
 
-	// "expires_at" field predicates.
-	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
-	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
-	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
-	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
-	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
-	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
-	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
-	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+	// "claims_email_verified" field predicates.
+	ClaimsEmailVerified    *bool `json:"claimsEmailVerified,omitempty"`
+	ClaimsEmailVerifiedNEQ *bool `json:"claimsEmailVerifiedNEQ,omitempty"`
 
-	// "organization_id" field predicates.
-	OrganizationID             *string  `json:"organizationID,omitempty"`
-	OrganizationIDNEQ          *string  `json:"organizationIDNEQ,omitempty"`
-	OrganizationIDIn           []string `json:"organizationIDIn,omitempty"`
-	OrganizationIDNotIn        []string `json:"organizationIDNotIn,omitempty"`
-	OrganizationIDGT           *string  `json:"organizationIDGT,omitempty"`
-	OrganizationIDGTE          *string  `json:"organizationIDGTE,omitempty"`
-	OrganizationIDLT           *string  `json:"organizationIDLT,omitempty"`
-	OrganizationIDLTE          *string  `json:"organizationIDLTE,omitempty"`
-	OrganizationIDContains     *string  `json:"organizationIDContains,omitempty"`
-	OrganizationIDHasPrefix    *string  `json:"organizationIDHasPrefix,omitempty"`
-	OrganizationIDHasSuffix    *string  `json:"organizationIDHasSuffix,omitempty"`
-	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
-	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+	// "claims_preferred_username" field predicates.
+	ClaimsPreferredUsername             *string  `json:"claimsPreferredUsername,omitempty"`
+	ClaimsPreferredUsernameNEQ          *string  `json:"claimsPreferredUsernameNEQ,omitempty"`
+	ClaimsPreferredUsernameIn           []string `json:"claimsPreferredUsernameIn,omitempty"`
+	ClaimsPreferredUsernameNotIn        []string `json:"claimsPreferredUsernameNotIn,omitempty"`
+	ClaimsPreferredUsernameGT           *string  `json:"claimsPreferredUsernameGT,omitempty"`
+	ClaimsPreferredUsernameGTE          *string  `json:"claimsPreferredUsernameGTE,omitempty"`
+	ClaimsPreferredUsernameLT           *string  `json:"claimsPreferredUsernameLT,omitempty"`
+	ClaimsPreferredUsernameLTE          *string  `json:"claimsPreferredUsernameLTE,omitempty"`
+	ClaimsPreferredUsernameContains     *string  `json:"claimsPreferredUsernameContains,omitempty"`
+	ClaimsPreferredUsernameHasPrefix    *string  `json:"claimsPreferredUsernameHasPrefix,omitempty"`
+	ClaimsPreferredUsernameHasSuffix    *string  `json:"claimsPreferredUsernameHasSuffix,omitempty"`
+	ClaimsPreferredUsernameEqualFold    *string  `json:"claimsPreferredUsernameEqualFold,omitempty"`
+	ClaimsPreferredUsernameContainsFold *string  `json:"claimsPreferredUsernameContainsFold,omitempty"`
 
-	// "user_id" field predicates.
-	UserID             *string  `json:"userID,omitempty"`
-	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
-	UserIDIn           []string `json:"userIDIn,omitempty"`
-	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
-	UserIDGT           *string  `json:"userIDGT,omitempty"`
-	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
-	UserIDLT           *string  `json:"userIDLT,omitempty"`
-	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
-	UserIDContains     *string  `json:"userIDContains,omitempty"`
-	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
-	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
-	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
-	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+	// "connector_id" field predicates.
+	ConnectorID             *string  `json:"connectorID,omitempty"`
+	ConnectorIDNEQ          *string  `json:"connectorIDNEQ,omitempty"`
+	ConnectorIDIn           []string `json:"connectorIDIn,omitempty"`
+	ConnectorIDNotIn        []string `json:"connectorIDNotIn,omitempty"`
+	ConnectorIDGT           *string  `json:"connectorIDGT,omitempty"`
+	ConnectorIDGTE          *string  `json:"connectorIDGTE,omitempty"`
+	ConnectorIDLT           *string  `json:"connectorIDLT,omitempty"`
+	ConnectorIDLTE          *string  `json:"connectorIDLTE,omitempty"`
+	ConnectorIDContains     *string  `json:"connectorIDContains,omitempty"`
+	ConnectorIDHasPrefix    *string  `json:"connectorIDHasPrefix,omitempty"`
+	ConnectorIDHasSuffix    *string  `json:"connectorIDHasSuffix,omitempty"`
+	ConnectorIDEqualFold    *string  `json:"connectorIDEqualFold,omitempty"`
+	ConnectorIDContainsFold *string  `json:"connectorIDContainsFold,omitempty"`
 
-	// "owner" edge predicates.
-	HasOwner     *bool             `json:"hasOwner,omitempty"`
-	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+	// "last_used" field predicates.
+	LastUsed      *time.Time  `json:"lastUsed,omitempty"`
+	LastUsedNEQ   *time.Time  `json:"lastUsedNEQ,omitempty"`
+	LastUsedIn    []time.Time `json:"lastUsedIn,omitempty"`
+	LastUsedNotIn []time.Time `json:"lastUsedNotIn,omitempty"`
+	LastUsedGT    *time.Time  `json:"lastUsedGT,omitempty"`
+	LastUsedGTE   *time.Time  `json:"lastUsedGTE,omitempty"`
+	LastUsedLT    *time.Time  `json:"lastUsedLT,omitempty"`
+	LastUsedLTE   *time.Time  `json:"lastUsedLTE,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SessionWhereInput) AddPredicates(predicates ...predicate.Session) {
+func (i *OhAuthTooTokenWhereInput) AddPredicates(predicates ...predicate.OhAuthTooToken) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SessionWhereInput filter on the SessionQuery builder.
-func (i *SessionWhereInput) Filter(q *SessionQuery) (*SessionQuery, error) {
+// Filter applies the OhAuthTooTokenWhereInput filter on the OhAuthTooTokenQuery builder.
+func (i *OhAuthTooTokenWhereInput) Filter(q *OhAuthTooTokenQuery) (*OhAuthTooTokenQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySessionWhereInput {
+		if err == ErrEmptyOhAuthTooTokenWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -6347,19 +6631,19 @@ func (i *SessionWhereInput) Filter(q *SessionQuery) (*SessionQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptySessionWhereInput is returned in case the SessionWhereInput is empty.
-var ErrEmptySessionWhereInput = errors.New("generated: empty predicate SessionWhereInput")
+// ErrEmptyOhAuthTooTokenWhereInput is returned in case the OhAuthTooTokenWhereInput is empty.
+var ErrEmptyOhAuthTooTokenWhereInput = errors.New("generated: empty predicate OhAuthTooTokenWhereInput")
 
-// P returns a predicate for filtering sessions.
+// P returns a predicate for filtering ohauthtootokens.
 // An error is returned if the input is empty or invalid.
-func (i *SessionWhereInput) P() (predicate.Session, error) {
-	var predicates []predicate.Session
+func (i *OhAuthTooTokenWhereInput) P() (predicate.OhAuthTooToken, error) {
+	var predicates []predicate.OhAuthTooToken
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, session.Not(p))
+		predicates = append(predicates, ohauthtootoken.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -6369,7 +6653,7 @@ func (i *SessionWhereInput) P() (predicate.Session, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Session, 0, n)
+		or := make([]predicate.OhAuthTooToken, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -6377,7 +6661,7 @@ func (i *SessionWhereInput) P() (predicate.Session, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, session.Or(or...))
+		predicates = append(predicates, ohauthtootoken.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -6387,7 +6671,7 @@ func (i *SessionWhereInput) P() (predicate.Session, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Session, 0, n)
+		and := make([]predicate.OhAuthTooToken, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -6395,377 +6679,365 @@ func (i *SessionWhereInput) P() (predicate.Session, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, session.And(and...))
+		predicates = append(predicates, ohauthtootoken.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, session.IDEQ(*i.ID))
+		predicates = append(predicates, ohauthtootoken.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, session.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, ohauthtootoken.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, session.IDIn(i.IDIn...))
+		predicates = append(predicates, ohauthtootoken.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, session.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, ohauthtootoken.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, session.IDGT(*i.IDGT))
+		predicates = append(predicates, ohauthtootoken.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, session.IDGTE(*i.IDGTE))
+		predicates = append(predicates, ohauthtootoken.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, session.IDLT(*i.IDLT))
+		predicates = append(predicates, ohauthtootoken.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, session.IDLTE(*i.IDLTE))
+		predicates = append(predicates, ohauthtootoken.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, session.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, ohauthtootoken.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, session.IDContainsFold(*i.IDContainsFold))
-	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, session.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, ohauthtootoken.IDContainsFold(*i.IDContainsFold))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, session.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.ClientID != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDEQ(*i.ClientID))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, session.CreatedAtIn(i.CreatedAtIn...))
+	if i.ClientIDNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDNEQ(*i.ClientIDNEQ))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, session.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if len(i.ClientIDIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClientIDIn(i.ClientIDIn...))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, session.CreatedAtGT(*i.CreatedAtGT))
+	if len(i.ClientIDNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClientIDNotIn(i.ClientIDNotIn...))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, session.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.ClientIDGT != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDGT(*i.ClientIDGT))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, session.CreatedAtLT(*i.CreatedAtLT))
+	if i.ClientIDGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDGTE(*i.ClientIDGTE))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, session.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.ClientIDLT != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDLT(*i.ClientIDLT))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, session.UpdatedAtEQ(*i.UpdatedAt))
+	if i.ClientIDLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDLTE(*i.ClientIDLTE))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, session.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.ClientIDContains != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDContains(*i.ClientIDContains))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, session.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.ClientIDHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDHasPrefix(*i.ClientIDHasPrefix))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, session.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.ClientIDHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDHasSuffix(*i.ClientIDHasSuffix))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, session.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.ClientIDEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDEqualFold(*i.ClientIDEqualFold))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, session.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.ClientIDContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClientIDContainsFold(*i.ClientIDContainsFold))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, session.UpdatedAtLT(*i.UpdatedAtLT))
+	if i.Nonce != nil {
+		predicates = append(predicates, ohauthtootoken.NonceEQ(*i.Nonce))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, session.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if i.NonceNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.NonceNEQ(*i.NonceNEQ))
 	}
-	if i.CreatedBy != nil {
-		predicates = append(predicates, session.CreatedByEQ(*i.CreatedBy))
+	if len(i.NonceIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.NonceIn(i.NonceIn...))
 	}
-	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, session.CreatedByNEQ(*i.CreatedByNEQ))
+	if len(i.NonceNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.NonceNotIn(i.NonceNotIn...))
 	}
-	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, session.CreatedByIn(i.CreatedByIn...))
+	if i.NonceGT != nil {
+		predicates = append(predicates, ohauthtootoken.NonceGT(*i.NonceGT))
 	}
-	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, session.CreatedByNotIn(i.CreatedByNotIn...))
+	if i.NonceGTE != nil {
+		predicates = append(predicates, ohauthtootoken.NonceGTE(*i.NonceGTE))
 	}
-	if i.CreatedByGT != nil {
-		predicates = append(predicates, session.CreatedByGT(*i.CreatedByGT))
+	if i.NonceLT != nil {
+		predicates = append(predicates, ohauthtootoken.NonceLT(*i.NonceLT))
 	}
-	if i.CreatedByGTE != nil {
-		predicates = append(predicates, session.CreatedByGTE(*i.CreatedByGTE))
+	if i.NonceLTE != nil {
+		predicates = append(predicates, ohauthtootoken.NonceLTE(*i.NonceLTE))
 	}
-	if i.CreatedByLT != nil {
-		predicates = append(predicates, session.CreatedByLT(*i.CreatedByLT))
+	if i.NonceContains != nil {
+		predicates = append(predicates, ohauthtootoken.NonceContains(*i.NonceContains))
 	}
-	if i.CreatedByLTE != nil {
-		predicates = append(predicates, session.CreatedByLTE(*i.CreatedByLTE))
+	if i.NonceHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.NonceHasPrefix(*i.NonceHasPrefix))
 	}
-	if i.CreatedByContains != nil {
-		predicates = append(predicates, session.CreatedByContains(*i.CreatedByContains))
+	if i.NonceHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.NonceHasSuffix(*i.NonceHasSuffix))
 	}
-	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, session.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	if i.NonceIsNil {
+		predicates = append(predicates, ohauthtootoken.NonceIsNil())
 	}
-	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, session.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	if i.NonceNotNil {
+		predicates = append(predicates, ohauthtootoken.NonceNotNil())
 	}
-	if i.CreatedByIsNil {
-		predicates = append(predicates, session.CreatedByIsNil())
+	if i.NonceEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.NonceEqualFold(*i.NonceEqualFold))
 	}
-	if i.CreatedByNotNil {
-		predicates = append(predicates, session.CreatedByNotNil())
+	if i.NonceContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.NonceContainsFold(*i.NonceContainsFold))
 	}
-	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, session.CreatedByEqualFold(*i.CreatedByEqualFold))
+	if i.ClaimsUserID != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDEQ(*i.ClaimsUserID))
 	}
-	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, session.CreatedByContainsFold(*i.CreatedByContainsFold))
+	if i.ClaimsUserIDNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDNEQ(*i.ClaimsUserIDNEQ))
 	}
-	if i.UpdatedBy != nil {
-		predicates = append(predicates, session.UpdatedByEQ(*i.UpdatedBy))
+	if len(i.ClaimsUserIDIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDIn(i.ClaimsUserIDIn...))
 	}
-	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, session.UpdatedByNEQ(*i.UpdatedByNEQ))
+	if len(i.ClaimsUserIDNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDNotIn(i.ClaimsUserIDNotIn...))
 	}
-	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, session.UpdatedByIn(i.UpdatedByIn...))
+	if i.ClaimsUserIDGT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDGT(*i.ClaimsUserIDGT))
 	}
-	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, session.UpdatedByNotIn(i.UpdatedByNotIn...))
+	if i.ClaimsUserIDGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDGTE(*i.ClaimsUserIDGTE))
 	}
-	if i.UpdatedByGT != nil {
-		predicates = append(predicates, session.UpdatedByGT(*i.UpdatedByGT))
+	if i.ClaimsUserIDLT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDLT(*i.ClaimsUserIDLT))
 	}
-	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, session.UpdatedByGTE(*i.UpdatedByGTE))
+	if i.ClaimsUserIDLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDLTE(*i.ClaimsUserIDLTE))
 	}
-	if i.UpdatedByLT != nil {
-		predicates = append(predicates, session.UpdatedByLT(*i.UpdatedByLT))
+	if i.ClaimsUserIDContains != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDContains(*i.ClaimsUserIDContains))
 	}
-	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, session.UpdatedByLTE(*i.UpdatedByLTE))
+	if i.ClaimsUserIDHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDHasPrefix(*i.ClaimsUserIDHasPrefix))
 	}
-	if i.UpdatedByContains != nil {
-		predicates = append(predicates, session.UpdatedByContains(*i.UpdatedByContains))
+	if i.ClaimsUserIDHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDHasSuffix(*i.ClaimsUserIDHasSuffix))
 	}
-	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, session.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	if i.ClaimsUserIDEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDEqualFold(*i.ClaimsUserIDEqualFold))
 	}
-	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, session.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	if i.ClaimsUserIDContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUserIDContainsFold(*i.ClaimsUserIDContainsFold))
 	}
-	if i.UpdatedByIsNil {
-		predicates = append(predicates, session.UpdatedByIsNil())
+	if i.ClaimsUsername != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameEQ(*i.ClaimsUsername))
 	}
-	if i.UpdatedByNotNil {
-		predicates = append(predicates, session.UpdatedByNotNil())
+	if i.ClaimsUsernameNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameNEQ(*i.ClaimsUsernameNEQ))
 	}
-	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, session.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	if len(i.ClaimsUsernameIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameIn(i.ClaimsUsernameIn...))
 	}
-	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, session.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	if len(i.ClaimsUsernameNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameNotIn(i.ClaimsUsernameNotIn...))
 	}
-	if i.SessionToken != nil {
-		predicates = append(predicates, session.SessionTokenEQ(*i.SessionToken))
+	if i.ClaimsUsernameGT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameGT(*i.ClaimsUsernameGT))
 	}
-	if i.SessionTokenNEQ != nil {
-		predicates = append(predicates, session.SessionTokenNEQ(*i.SessionTokenNEQ))
+	if i.ClaimsUsernameGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameGTE(*i.ClaimsUsernameGTE))
 	}
-	if len(i.SessionTokenIn) > 0 {
-		predicates = append(predicates, session.SessionTokenIn(i.SessionTokenIn...))
+	if i.ClaimsUsernameLT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameLT(*i.ClaimsUsernameLT))
 	}
-	if len(i.SessionTokenNotIn) > 0 {
-		predicates = append(predicates, session.SessionTokenNotIn(i.SessionTokenNotIn...))
+	if i.ClaimsUsernameLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameLTE(*i.ClaimsUsernameLTE))
 	}
-	if i.SessionTokenGT != nil {
-		predicates = append(predicates, session.SessionTokenGT(*i.SessionTokenGT))
+	if i.ClaimsUsernameContains != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameContains(*i.ClaimsUsernameContains))
 	}
-	if i.SessionTokenGTE != nil {
-		predicates = append(predicates, session.SessionTokenGTE(*i.SessionTokenGTE))
+	if i.ClaimsUsernameHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameHasPrefix(*i.ClaimsUsernameHasPrefix))
 	}
-	if i.SessionTokenLT != nil {
-		predicates = append(predicates, session.SessionTokenLT(*i.SessionTokenLT))
+	if i.ClaimsUsernameHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameHasSuffix(*i.ClaimsUsernameHasSuffix))
 	}
-	if i.SessionTokenLTE != nil {
-		predicates = append(predicates, session.SessionTokenLTE(*i.SessionTokenLTE))
+	if i.ClaimsUsernameEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameEqualFold(*i.ClaimsUsernameEqualFold))
 	}
-	if i.SessionTokenContains != nil {
-		predicates = append(predicates, session.SessionTokenContains(*i.SessionTokenContains))
+	if i.ClaimsUsernameContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsUsernameContainsFold(*i.ClaimsUsernameContainsFold))
 	}
-	if i.SessionTokenHasPrefix != nil {
-		predicates = append(predicates, session.SessionTokenHasPrefix(*i.SessionTokenHasPrefix))
+	if i.ClaimsEmail != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailEQ(*i.ClaimsEmail))
 	}
-	if i.SessionTokenHasSuffix != nil {
-		predicates = append(predicates, session.SessionTokenHasSuffix(*i.SessionTokenHasSuffix))
+	if i.ClaimsEmailNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailNEQ(*i.ClaimsEmailNEQ))
 	}
-	if i.SessionTokenEqualFold != nil {
-		predicates = append(predicates, session.SessionTokenEqualFold(*i.SessionTokenEqualFold))
+	if len(i.ClaimsEmailIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailIn(i.ClaimsEmailIn...))
 	}
-	if i.SessionTokenContainsFold != nil {
-		predicates = append(predicates, session.SessionTokenContainsFold(*i.SessionTokenContainsFold))
+	if len(i.ClaimsEmailNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailNotIn(i.ClaimsEmailNotIn...))
 	}
-	if i.IssuedAt != nil {
-		predicates = append(predicates, session.IssuedAtEQ(*i.IssuedAt))
+	if i.ClaimsEmailGT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailGT(*i.ClaimsEmailGT))
 	}
-	if i.IssuedAtNEQ != nil {
-		predicates = append(predicates, session.IssuedAtNEQ(*i.IssuedAtNEQ))
+	if i.ClaimsEmailGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailGTE(*i.ClaimsEmailGTE))
 	}
-	if len(i.IssuedAtIn) > 0 {
-		predicates = append(predicates, session.IssuedAtIn(i.IssuedAtIn...))
+	if i.ClaimsEmailLT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailLT(*i.ClaimsEmailLT))
 	}
-	if len(i.IssuedAtNotIn) > 0 {
-		predicates = append(predicates, session.IssuedAtNotIn(i.IssuedAtNotIn...))
+	if i.ClaimsEmailLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailLTE(*i.ClaimsEmailLTE))
 	}
-	if i.IssuedAtGT != nil {
-		predicates = append(predicates, session.IssuedAtGT(*i.IssuedAtGT))
+	if i.ClaimsEmailContains != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailContains(*i.ClaimsEmailContains))
 	}
-	if i.IssuedAtGTE != nil {
-		predicates = append(predicates, session.IssuedAtGTE(*i.IssuedAtGTE))
+	if i.ClaimsEmailHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailHasPrefix(*i.ClaimsEmailHasPrefix))
 	}
-	if i.IssuedAtLT != nil {
-		predicates = append(predicates, session.IssuedAtLT(*i.IssuedAtLT))
+	if i.ClaimsEmailHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailHasSuffix(*i.ClaimsEmailHasSuffix))
 	}
-	if i.IssuedAtLTE != nil {
-		predicates = append(predicates, session.IssuedAtLTE(*i.IssuedAtLTE))
+	if i.ClaimsEmailEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailEqualFold(*i.ClaimsEmailEqualFold))
 	}
-	if i.ExpiresAt != nil {
-		predicates = append(predicates, session.ExpiresAtEQ(*i.ExpiresAt))
+	if i.ClaimsEmailContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailContainsFold(*i.ClaimsEmailContainsFold))
 	}
-	if i.ExpiresAtNEQ != nil {
-		predicates = append(predicates, session.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	if i.ClaimsEmailVerified != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailVerifiedEQ(*i.ClaimsEmailVerified))
 	}
-	if len(i.ExpiresAtIn) > 0 {
-		predicates = append(predicates, session.ExpiresAtIn(i.ExpiresAtIn...))
+	if i.ClaimsEmailVerifiedNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsEmailVerifiedNEQ(*i.ClaimsEmailVerifiedNEQ))
 	}
-	if len(i.ExpiresAtNotIn) > 0 {
-		predicates = append(predicates, session.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	if i.ClaimsPreferredUsername != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameEQ(*i.ClaimsPreferredUsername))
 	}
-	if i.ExpiresAtGT != nil {
-		predicates = append(predicates, session.ExpiresAtGT(*i.ExpiresAtGT))
+	if i.ClaimsPreferredUsernameNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameNEQ(*i.ClaimsPreferredUsernameNEQ))
 	}
-	if i.ExpiresAtGTE != nil {
-		predicates = append(predicates, session.ExpiresAtGTE(*i.ExpiresAtGTE))
+	if len(i.ClaimsPreferredUsernameIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameIn(i.ClaimsPreferredUsernameIn...))
 	}
-	if i.ExpiresAtLT != nil {
-		predicates = append(predicates, session.ExpiresAtLT(*i.ExpiresAtLT))
+	if len(i.ClaimsPreferredUsernameNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameNotIn(i.ClaimsPreferredUsernameNotIn...))
 	}
-	if i.ExpiresAtLTE != nil {
-		predicates = append(predicates, session.ExpiresAtLTE(*i.ExpiresAtLTE))
+	if i.ClaimsPreferredUsernameGT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameGT(*i.ClaimsPreferredUsernameGT))
 	}
-	if i.OrganizationID != nil {
-		predicates = append(predicates, session.OrganizationIDEQ(*i.OrganizationID))
+	if i.ClaimsPreferredUsernameGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameGTE(*i.ClaimsPreferredUsernameGTE))
 	}
-	if i.OrganizationIDNEQ != nil {
-		predicates = append(predicates, session.OrganizationIDNEQ(*i.OrganizationIDNEQ))
+	if i.ClaimsPreferredUsernameLT != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameLT(*i.ClaimsPreferredUsernameLT))
 	}
-	if len(i.OrganizationIDIn) > 0 {
-		predicates = append(predicates, session.OrganizationIDIn(i.OrganizationIDIn...))
+	if i.ClaimsPreferredUsernameLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameLTE(*i.ClaimsPreferredUsernameLTE))
 	}
-	if len(i.OrganizationIDNotIn) > 0 {
-		predicates = append(predicates, session.OrganizationIDNotIn(i.OrganizationIDNotIn...))
+	if i.ClaimsPreferredUsernameContains != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameContains(*i.ClaimsPreferredUsernameContains))
 	}
-	if i.OrganizationIDGT != nil {
-		predicates = append(predicates, session.OrganizationIDGT(*i.OrganizationIDGT))
+	if i.ClaimsPreferredUsernameHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameHasPrefix(*i.ClaimsPreferredUsernameHasPrefix))
 	}
-	if i.OrganizationIDGTE != nil {
-		predicates = append(predicates, session.OrganizationIDGTE(*i.OrganizationIDGTE))
+	if i.ClaimsPreferredUsernameHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameHasSuffix(*i.ClaimsPreferredUsernameHasSuffix))
 	}
-	if i.OrganizationIDLT != nil {
-		predicates = append(predicates, session.OrganizationIDLT(*i.OrganizationIDLT))
+	if i.ClaimsPreferredUsernameEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameEqualFold(*i.ClaimsPreferredUsernameEqualFold))
 	}
-	if i.OrganizationIDLTE != nil {
-		predicates = append(predicates, session.OrganizationIDLTE(*i.OrganizationIDLTE))
+	if i.ClaimsPreferredUsernameContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ClaimsPreferredUsernameContainsFold(*i.ClaimsPreferredUsernameContainsFold))
 	}
-	if i.OrganizationIDContains != nil {
-		predicates = append(predicates, session.OrganizationIDContains(*i.OrganizationIDContains))
+	if i.ConnectorID != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDEQ(*i.ConnectorID))
 	}
-	if i.OrganizationIDHasPrefix != nil {
-		predicates = append(predicates, session.OrganizationIDHasPrefix(*i.OrganizationIDHasPrefix))
+	if i.ConnectorIDNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDNEQ(*i.ConnectorIDNEQ))
 	}
-	if i.OrganizationIDHasSuffix != nil {
-		predicates = append(predicates, session.OrganizationIDHasSuffix(*i.OrganizationIDHasSuffix))
+	if len(i.ConnectorIDIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDIn(i.ConnectorIDIn...))
 	}
-	if i.OrganizationIDEqualFold != nil {
-		predicates = append(predicates, session.OrganizationIDEqualFold(*i.OrganizationIDEqualFold))
+	if len(i.ConnectorIDNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDNotIn(i.ConnectorIDNotIn...))
 	}
-	if i.OrganizationIDContainsFold != nil {
-		predicates = append(predicates, session.OrganizationIDContainsFold(*i.OrganizationIDContainsFold))
+	if i.ConnectorIDGT != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDGT(*i.ConnectorIDGT))
 	}
-	if i.UserID != nil {
-		predicates = append(predicates, session.UserIDEQ(*i.UserID))
+	if i.ConnectorIDGTE != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDGTE(*i.ConnectorIDGTE))
 	}
-	if i.UserIDNEQ != nil {
-		predicates = append(predicates, session.UserIDNEQ(*i.UserIDNEQ))
+	if i.ConnectorIDLT != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDLT(*i.ConnectorIDLT))
 	}
-	if len(i.UserIDIn) > 0 {
-		predicates = append(predicates, session.UserIDIn(i.UserIDIn...))
+	if i.ConnectorIDLTE != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDLTE(*i.ConnectorIDLTE))
 	}
-	if len(i.UserIDNotIn) > 0 {
-		predicates = append(predicates, session.UserIDNotIn(i.UserIDNotIn...))
+	if i.ConnectorIDContains != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDContains(*i.ConnectorIDContains))
 	}
-	if i.UserIDGT != nil {
-		predicates = append(predicates, session.UserIDGT(*i.UserIDGT))
+	if i.ConnectorIDHasPrefix != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDHasPrefix(*i.ConnectorIDHasPrefix))
 	}
-	if i.UserIDGTE != nil {
-		predicates = append(predicates, session.UserIDGTE(*i.UserIDGTE))
+	if i.ConnectorIDHasSuffix != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDHasSuffix(*i.ConnectorIDHasSuffix))
 	}
-	if i.UserIDLT != nil {
-		predicates = append(predicates, session.UserIDLT(*i.UserIDLT))
+	if i.ConnectorIDEqualFold != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDEqualFold(*i.ConnectorIDEqualFold))
 	}
-	if i.UserIDLTE != nil {
-		predicates = append(predicates, session.UserIDLTE(*i.UserIDLTE))
+	if i.ConnectorIDContainsFold != nil {
+		predicates = append(predicates, ohauthtootoken.ConnectorIDContainsFold(*i.ConnectorIDContainsFold))
 	}
-	if i.UserIDContains != nil {
-		predicates = append(predicates, session.UserIDContains(*i.UserIDContains))
+	if i.LastUsed != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedEQ(*i.LastUsed))
 	}
-	if i.UserIDHasPrefix != nil {
-		predicates = append(predicates, session.UserIDHasPrefix(*i.UserIDHasPrefix))
+	if i.LastUsedNEQ != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedNEQ(*i.LastUsedNEQ))
 	}
-	if i.UserIDHasSuffix != nil {
-		predicates = append(predicates, session.UserIDHasSuffix(*i.UserIDHasSuffix))
+	if len(i.LastUsedIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.LastUsedIn(i.LastUsedIn...))
 	}
-	if i.UserIDEqualFold != nil {
-		predicates = append(predicates, session.UserIDEqualFold(*i.UserIDEqualFold))
+	if len(i.LastUsedNotIn) > 0 {
+		predicates = append(predicates, ohauthtootoken.LastUsedNotIn(i.LastUsedNotIn...))
 	}
-	if i.UserIDContainsFold != nil {
-		predicates = append(predicates, session.UserIDContainsFold(*i.UserIDContainsFold))
+	if i.LastUsedGT != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedGT(*i.LastUsedGT))
 	}
-
-	if i.HasOwner != nil {
-		p := session.HasOwner()
-		if !*i.HasOwner {
-			p = session.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LastUsedGTE != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedGTE(*i.LastUsedGTE))
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, session.HasOwnerWith(with...))
+	if i.LastUsedLT != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedLT(*i.LastUsedLT))
+	}
+	if i.LastUsedLTE != nil {
+		predicates = append(predicates, ohauthtootoken.LastUsedLTE(*i.LastUsedLTE))
 	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptySessionWhereInput
+		return nil, ErrEmptyOhAuthTooTokenWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return session.And(predicates...), nil
+		return ohauthtootoken.And(predicates...), nil
 	}
 }
 
-// UserWhereInput represents a where input for filtering User queries.
-type UserWhereInput struct {
-	Predicates []predicate.User  `json:"-"`
-	Not        *UserWhereInput   `json:"not,omitempty"`
-	Or         []*UserWhereInput `json:"or,omitempty"`
-	And        []*UserWhereInput `json:"and,omitempty"`
+// OrgMembershipWhereInput represents a where input for filtering OrgMembership queries.
+type OrgMembershipWhereInput struct {
+	Predicates []predicate.OrgMembership  `json:"-"`
+	Not        *OrgMembershipWhereInput   `json:"not,omitempty"`
+	Or         []*OrgMembershipWhereInput `json:"or,omitempty"`
+	And        []*OrgMembershipWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -6833,225 +7105,64 @@ type UserWhereInput struct {
 	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
 	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
 
-	// "deleted_at" field predicates.
-	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
-	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
-	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
-	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
-	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
-	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
-	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
-	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
-	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
-	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+	// "role" field predicates.
+	Role      *orgmembership.Role  `json:"role,omitempty"`
+	RoleNEQ   *orgmembership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []orgmembership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []orgmembership.Role `json:"roleNotIn,omitempty"`
 
-	// "deleted_by" field predicates.
-	DeletedBy             *string  `json:"deletedBy,omitempty"`
-	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
-	DeletedByIn           []string `json:"deletedByIn,omitempty"`
-	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
-	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
-	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
-	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
-	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
-	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
-	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
-	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
-	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
-	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
-	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
-	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// "organization_id" field predicates.
+	OrganizationID             *string  `json:"organizationID,omitempty"`
+	OrganizationIDNEQ          *string  `json:"organizationIDNEQ,omitempty"`
+	OrganizationIDIn           []string `json:"organizationIDIn,omitempty"`
+	OrganizationIDNotIn        []string `json:"organizationIDNotIn,omitempty"`
+	OrganizationIDGT           *string  `json:"organizationIDGT,omitempty"`
+	OrganizationIDGTE          *string  `json:"organizationIDGTE,omitempty"`
+	OrganizationIDLT           *string  `json:"organizationIDLT,omitempty"`
+	OrganizationIDLTE          *string  `json:"organizationIDLTE,omitempty"`
+	OrganizationIDContains     *string  `json:"organizationIDContains,omitempty"`
+	OrganizationIDHasPrefix    *string  `json:"organizationIDHasPrefix,omitempty"`
+	OrganizationIDHasSuffix    *string  `json:"organizationIDHasSuffix,omitempty"`
+	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
+	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
 
-	// "email" field predicates.
-	Email             *string  `json:"email,omitempty"`
-	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
-	EmailIn           []string `json:"emailIn,omitempty"`
-	EmailNotIn        []string `json:"emailNotIn,omitempty"`
-	EmailGT           *string  `json:"emailGT,omitempty"`
-	EmailGTE          *string  `json:"emailGTE,omitempty"`
-	EmailLT           *string  `json:"emailLT,omitempty"`
-	EmailLTE          *string  `json:"emailLTE,omitempty"`
-	EmailContains     *string  `json:"emailContains,omitempty"`
-	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
-	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
-	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
-	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
-
-	// "first_name" field predicates.
-	FirstName             *string  `json:"firstName,omitempty"`
-	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
-	FirstNameIn           []string `json:"firstNameIn,omitempty"`
-	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
-	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
-	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
-	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
-	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
-	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
-	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
-	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
-	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
-	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
-
-	// "last_name" field predicates.
-	LastName             *string  `json:"lastName,omitempty"`
-	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
-	LastNameIn           []string `json:"lastNameIn,omitempty"`
-	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
-	LastNameGT           *string  `json:"lastNameGT,omitempty"`
-	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
-	LastNameLT           *string  `json:"lastNameLT,omitempty"`
-	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
-	LastNameContains     *string  `json:"lastNameContains,omitempty"`
-	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
-	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
-	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
-	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
-
-	// "display_name" field predicates.
-	DisplayName             *string  `json:"displayName,omitempty"`
-	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
-	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
-	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
-	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
-	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
-	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
-	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
-	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
-	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
-	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
-	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
-	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
-
-	// "avatar_remote_url" field predicates.
-	AvatarRemoteURL             *string  `json:"avatarRemoteURL,omitempty"`
-	AvatarRemoteURLNEQ          *string  `json:"avatarRemoteURLNEQ,omitempty"`
-	AvatarRemoteURLIn           []string `json:"avatarRemoteURLIn,omitempty"`
-	AvatarRemoteURLNotIn        []string `json:"avatarRemoteURLNotIn,omitempty"`
-	AvatarRemoteURLGT           *string  `json:"avatarRemoteURLGT,omitempty"`
-	AvatarRemoteURLGTE          *string  `json:"avatarRemoteURLGTE,omitempty"`
-	AvatarRemoteURLLT           *string  `json:"avatarRemoteURLLT,omitempty"`
-	AvatarRemoteURLLTE          *string  `json:"avatarRemoteURLLTE,omitempty"`
-	AvatarRemoteURLContains     *string  `json:"avatarRemoteURLContains,omitempty"`
-	AvatarRemoteURLHasPrefix    *string  `json:"avatarRemoteURLHasPrefix,omitempty"`
-	AvatarRemoteURLHasSuffix    *string  `json:"avatarRemoteURLHasSuffix,omitempty"`
-	AvatarRemoteURLIsNil        bool     `json:"avatarRemoteURLIsNil,omitempty"`
-	AvatarRemoteURLNotNil       bool     `json:"avatarRemoteURLNotNil,omitempty"`
-	AvatarRemoteURLEqualFold    *string  `json:"avatarRemoteURLEqualFold,omitempty"`
-	AvatarRemoteURLContainsFold *string  `json:"avatarRemoteURLContainsFold,omitempty"`
-
-	// "avatar_local_file" field predicates.
-	AvatarLocalFile             *string  `json:"avatarLocalFile,omitempty"`
-	AvatarLocalFileNEQ          *string  `json:"avatarLocalFileNEQ,omitempty"`
-	AvatarLocalFileIn           []string `json:"avatarLocalFileIn,omitempty"`
-	AvatarLocalFileNotIn        []string `json:"avatarLocalFileNotIn,omitempty"`
-	AvatarLocalFileGT           *string  `json:"avatarLocalFileGT,omitempty"`
-	AvatarLocalFileGTE          *string  `json:"avatarLocalFileGTE,omitempty"`
-	AvatarLocalFileLT           *string  `json:"avatarLocalFileLT,omitempty"`
-	AvatarLocalFileLTE          *string  `json:"avatarLocalFileLTE,omitempty"`
-	AvatarLocalFileContains     *string  `json:"avatarLocalFileContains,omitempty"`
-	AvatarLocalFileHasPrefix    *string  `json:"avatarLocalFileHasPrefix,omitempty"`
-	AvatarLocalFileHasSuffix    *string  `json:"avatarLocalFileHasSuffix,omitempty"`
-	AvatarLocalFileIsNil        bool     `json:"avatarLocalFileIsNil,omitempty"`
-	AvatarLocalFileNotNil       bool     `json:"avatarLocalFileNotNil,omitempty"`
-	AvatarLocalFileEqualFold    *string  `json:"avatarLocalFileEqualFold,omitempty"`
-	AvatarLocalFileContainsFold *string  `json:"avatarLocalFileContainsFold,omitempty"`
-
-	// "avatar_updated_at" field predicates.
-	AvatarUpdatedAt       *time.Time  `json:"avatarUpdatedAt,omitempty"`
-	AvatarUpdatedAtNEQ    *time.Time  `json:"avatarUpdatedAtNEQ,omitempty"`
-	AvatarUpdatedAtIn     []time.Time `json:"avatarUpdatedAtIn,omitempty"`
-	AvatarUpdatedAtNotIn  []time.Time `json:"avatarUpdatedAtNotIn,omitempty"`
-	AvatarUpdatedAtGT     *time.Time  `json:"avatarUpdatedAtGT,omitempty"`
-	AvatarUpdatedAtGTE    *time.Time  `json:"avatarUpdatedAtGTE,omitempty"`
-	AvatarUpdatedAtLT     *time.Time  `json:"avatarUpdatedAtLT,omitempty"`
-	AvatarUpdatedAtLTE    *time.Time  `json:"avatarUpdatedAtLTE,omitempty"`
-	AvatarUpdatedAtIsNil  bool        `json:"avatarUpdatedAtIsNil,omitempty"`
-	AvatarUpdatedAtNotNil bool        `json:"avatarUpdatedAtNotNil,omitempty"`
-
-	// "last_seen" field predicates.
-	LastSeen       *time.Time  `json:"lastSeen,omitempty"`
-	LastSeenNEQ    *time.Time  `json:"lastSeenNEQ,omitempty"`
-	LastSeenIn     []time.Time `json:"lastSeenIn,omitempty"`
-	LastSeenNotIn  []time.Time `json:"lastSeenNotIn,omitempty"`
-	LastSeenGT     *time.Time  `json:"lastSeenGT,omitempty"`
-	LastSeenGTE    *time.Time  `json:"lastSeenGTE,omitempty"`
-	LastSeenLT     *time.Time  `json:"lastSeenLT,omitempty"`
-	LastSeenLTE    *time.Time  `json:"lastSeenLTE,omitempty"`
-	LastSeenIsNil  bool        `json:"lastSeenIsNil,omitempty"`
-	LastSeenNotNil bool        `json:"lastSeenNotNil,omitempty"`
-
-	// "password" field predicates.
-	Password             *string  `json:"password,omitempty"`
-	PasswordNEQ          *string  `json:"passwordNEQ,omitempty"`
-	PasswordIn           []string `json:"passwordIn,omitempty"`
-	PasswordNotIn        []string `json:"passwordNotIn,omitempty"`
-	PasswordGT           *string  `json:"passwordGT,omitempty"`
-	PasswordGTE          *string  `json:"passwordGTE,omitempty"`
-	PasswordLT           *string  `json:"passwordLT,omitempty"`
-	PasswordLTE          *string  `json:"passwordLTE,omitempty"`
-	PasswordContains     *string  `json:"passwordContains,omitempty"`
-	PasswordHasPrefix    *string  `json:"passwordHasPrefix,omitempty"`
-	PasswordHasSuffix    *string  `json:"passwordHasSuffix,omitempty"`
-	PasswordIsNil        bool     `json:"passwordIsNil,omitempty"`
-	PasswordNotNil       bool     `json:"passwordNotNil,omitempty"`
-	PasswordEqualFold    *string  `json:"passwordEqualFold,omitempty"`
-	PasswordContainsFold *string  `json:"passwordContainsFold,omitempty"`
-
-	// "sub" field predicates.
-	Sub             *string  `json:"sub,omitempty"`
-	SubNEQ          *string  `json:"subNEQ,omitempty"`
-	SubIn           []string `json:"subIn,omitempty"`
-	SubNotIn        []string `json:"subNotIn,omitempty"`
-	SubGT           *string  `json:"subGT,omitempty"`
-	SubGTE          *string  `json:"subGTE,omitempty"`
-	SubLT           *string  `json:"subLT,omitempty"`
-	SubLTE          *string  `json:"subLTE,omitempty"`
-	SubContains     *string  `json:"subContains,omitempty"`
-	SubHasPrefix    *string  `json:"subHasPrefix,omitempty"`
-	SubHasSuffix    *string  `json:"subHasSuffix,omitempty"`
-	SubIsNil        bool     `json:"subIsNil,omitempty"`
-	SubNotNil       bool     `json:"subNotNil,omitempty"`
-	SubEqualFold    *string  `json:"subEqualFold,omitempty"`
-	SubContainsFold *string  `json:"subContainsFold,omitempty"`
-
-	// "oauth" field predicates.
-	Oauth    *bool `json:"oauth,omitempty"`
-	OauthNEQ *bool `json:"oauthNEQ,omitempty"`
-
-	// "organizations" edge predicates.
-	HasOrganizations     *bool                     `json:"hasOrganizations,omitempty"`
-	HasOrganizationsWith []*OrganizationWhereInput `json:"hasOrganizationsWith,omitempty"`
-
-	// "sessions" edge predicates.
-	HasSessions     *bool                `json:"hasSessions,omitempty"`
-	HasSessionsWith []*SessionWhereInput `json:"hasSessionsWith,omitempty"`
-
-	// "groups" edge predicates.
-	HasGroups     *bool              `json:"hasGroups,omitempty"`
-	HasGroupsWith []*GroupWhereInput `json:"hasGroupsWith,omitempty"`
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
 
-	// "personal_access_tokens" edge predicates.
-	HasPersonalAccessTokens     *bool                            `json:"hasPersonalAccessTokens,omitempty"`
-	HasPersonalAccessTokensWith []*PersonalAccessTokenWhereInput `json:"hasPersonalAccessTokensWith,omitempty"`
+	// "organization" edge predicates.
+	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
+	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
 
-	// "setting" edge predicates.
-	HasSetting     *bool                    `json:"hasSetting,omitempty"`
-	HasSettingWith []*UserSettingWhereInput `json:"hasSettingWith,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UserWhereInput) AddPredicates(predicates ...predicate.User) {
+func (i *OrgMembershipWhereInput) AddPredicates(predicates ...predicate.OrgMembership) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UserWhereInput filter on the UserQuery builder.
-func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
+// Filter applies the OrgMembershipWhereInput filter on the OrgMembershipQuery builder.
+func (i *OrgMembershipWhereInput) Filter(q *OrgMembershipQuery) (*OrgMembershipQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUserWhereInput {
+		if err == ErrEmptyOrgMembershipWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -7059,19 +7170,19 @@ func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyUserWhereInput is returned in case the UserWhereInput is empty.
-var ErrEmptyUserWhereInput = errors.New("generated: empty predicate UserWhereInput")
+// ErrEmptyOrgMembershipWhereInput is returned in case the OrgMembershipWhereInput is empty.
+var ErrEmptyOrgMembershipWhereInput = errors.New("generated: empty predicate OrgMembershipWhereInput")
 
-// P returns a predicate for filtering users.
+// P returns a predicate for filtering orgmemberships.
 // An error is returned if the input is empty or invalid.
-func (i *UserWhereInput) P() (predicate.User, error) {
-	var predicates []predicate.User
+func (i *OrgMembershipWhereInput) P() (predicate.OrgMembership, error) {
+	var predicates []predicate.OrgMembership
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, user.Not(p))
+		predicates = append(predicates, orgmembership.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -7081,7 +7192,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.User, 0, n)
+		or := make([]predicate.OrgMembership, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -7089,7 +7200,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, user.Or(or...))
+		predicates = append(predicates, orgmembership.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -7099,7 +7210,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.User, 0, n)
+		and := make([]predicate.OrgMembership, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -7107,761 +7218,6140 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, user.And(and...))
+		predicates = append(predicates, orgmembership.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, user.IDEQ(*i.ID))
+		predicates = append(predicates, orgmembership.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, user.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, orgmembership.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, user.IDIn(i.IDIn...))
+		predicates = append(predicates, orgmembership.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, user.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, orgmembership.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, user.IDGT(*i.IDGT))
+		predicates = append(predicates, orgmembership.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, user.IDGTE(*i.IDGTE))
+		predicates = append(predicates, orgmembership.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, user.IDLT(*i.IDLT))
+		predicates = append(predicates, orgmembership.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, user.IDLTE(*i.IDLTE))
+		predicates = append(predicates, orgmembership.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, user.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, orgmembership.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, user.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, orgmembership.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, orgmembership.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, user.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, orgmembership.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, user.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, orgmembership.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, user.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, orgmembership.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, user.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, orgmembership.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, user.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, orgmembership.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, user.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, orgmembership.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, user.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, orgmembership.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, user.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, orgmembership.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, user.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, orgmembership.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, user.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, orgmembership.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, user.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, orgmembership.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, user.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, orgmembership.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, user.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, orgmembership.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, user.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, orgmembership.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, user.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, orgmembership.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, user.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, orgmembership.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, user.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, orgmembership.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, user.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, orgmembership.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, user.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, orgmembership.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, user.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, orgmembership.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, user.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, orgmembership.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, user.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, orgmembership.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, user.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, orgmembership.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, user.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, orgmembership.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, user.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, orgmembership.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, user.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, orgmembership.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, user.CreatedByIsNil())
+		predicates = append(predicates, orgmembership.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, user.CreatedByNotNil())
+		predicates = append(predicates, orgmembership.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, user.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, orgmembership.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, user.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, orgmembership.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, user.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, orgmembership.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, user.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, orgmembership.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, user.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, orgmembership.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, user.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, orgmembership.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, user.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, orgmembership.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, user.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, orgmembership.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, user.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, orgmembership.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, user.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, orgmembership.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, user.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, orgmembership.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, user.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, orgmembership.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, user.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, orgmembership.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, user.UpdatedByIsNil())
+		predicates = append(predicates, orgmembership.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, user.UpdatedByNotNil())
+		predicates = append(predicates, orgmembership.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, user.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, orgmembership.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, user.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, orgmembership.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if i.DeletedAt != nil {
-		predicates = append(predicates, user.DeletedAtEQ(*i.DeletedAt))
+	if i.Role != nil {
+		predicates = append(predicates, orgmembership.RoleEQ(*i.Role))
 	}
-	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, user.DeletedAtNEQ(*i.DeletedAtNEQ))
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, orgmembership.RoleNEQ(*i.RoleNEQ))
 	}
-	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, user.DeletedAtIn(i.DeletedAtIn...))
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, orgmembership.RoleIn(i.RoleIn...))
 	}
-	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, user.DeletedAtNotIn(i.DeletedAtNotIn...))
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, orgmembership.RoleNotIn(i.RoleNotIn...))
 	}
-	if i.DeletedAtGT != nil {
-		predicates = append(predicates, user.DeletedAtGT(*i.DeletedAtGT))
+	if i.OrganizationID != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDEQ(*i.OrganizationID))
 	}
-	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, user.DeletedAtGTE(*i.DeletedAtGTE))
+	if i.OrganizationIDNEQ != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDNEQ(*i.OrganizationIDNEQ))
 	}
-	if i.DeletedAtLT != nil {
-		predicates = append(predicates, user.DeletedAtLT(*i.DeletedAtLT))
+	if len(i.OrganizationIDIn) > 0 {
+		predicates = append(predicates, orgmembership.OrganizationIDIn(i.OrganizationIDIn...))
 	}
-	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, user.DeletedAtLTE(*i.DeletedAtLTE))
+	if len(i.OrganizationIDNotIn) > 0 {
+		predicates = append(predicates, orgmembership.OrganizationIDNotIn(i.OrganizationIDNotIn...))
 	}
-	if i.DeletedAtIsNil {
-		predicates = append(predicates, user.DeletedAtIsNil())
+	if i.OrganizationIDGT != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDGT(*i.OrganizationIDGT))
 	}
-	if i.DeletedAtNotNil {
-		predicates = append(predicates, user.DeletedAtNotNil())
+	if i.OrganizationIDGTE != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDGTE(*i.OrganizationIDGTE))
 	}
-	if i.DeletedBy != nil {
-		predicates = append(predicates, user.DeletedByEQ(*i.DeletedBy))
+	if i.OrganizationIDLT != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDLT(*i.OrganizationIDLT))
 	}
-	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, user.DeletedByNEQ(*i.DeletedByNEQ))
+	if i.OrganizationIDLTE != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDLTE(*i.OrganizationIDLTE))
 	}
-	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, user.DeletedByIn(i.DeletedByIn...))
+	if i.OrganizationIDContains != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDContains(*i.OrganizationIDContains))
 	}
-	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, user.DeletedByNotIn(i.DeletedByNotIn...))
+	if i.OrganizationIDHasPrefix != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDHasPrefix(*i.OrganizationIDHasPrefix))
 	}
-	if i.DeletedByGT != nil {
-		predicates = append(predicates, user.DeletedByGT(*i.DeletedByGT))
+	if i.OrganizationIDHasSuffix != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDHasSuffix(*i.OrganizationIDHasSuffix))
 	}
-	if i.DeletedByGTE != nil {
-		predicates = append(predicates, user.DeletedByGTE(*i.DeletedByGTE))
+	if i.OrganizationIDEqualFold != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDEqualFold(*i.OrganizationIDEqualFold))
 	}
-	if i.DeletedByLT != nil {
-		predicates = append(predicates, user.DeletedByLT(*i.DeletedByLT))
+	if i.OrganizationIDContainsFold != nil {
+		predicates = append(predicates, orgmembership.OrganizationIDContainsFold(*i.OrganizationIDContainsFold))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, orgmembership.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, orgmembership.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, orgmembership.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, orgmembership.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, orgmembership.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, orgmembership.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, orgmembership.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, orgmembership.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, orgmembership.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, orgmembership.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, orgmembership.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, orgmembership.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, orgmembership.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+
+	if i.HasOrganization != nil {
+		p := orgmembership.HasOrganization()
+		if !*i.HasOrganization {
+			p = orgmembership.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOrganizationWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOrganizationWith))
+		for _, w := range i.HasOrganizationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOrganizationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, orgmembership.HasOrganizationWith(with...))
+	}
+	if i.HasUser != nil {
+		p := orgmembership.HasUser()
+		if !*i.HasUser {
+			p = orgmembership.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, orgmembership.HasUserWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOrgMembershipWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return orgmembership.And(predicates...), nil
+	}
+}
+
+// OrganizationWhereInput represents a where input for filtering Organization queries.
+type OrganizationWhereInput struct {
+	Predicates []predicate.Organization  `json:"-"`
+	Not        *OrganizationWhereInput   `json:"not,omitempty"`
+	Or         []*OrganizationWhereInput `json:"or,omitempty"`
+	And        []*OrganizationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "display_name" field predicates.
+	DisplayName             *string  `json:"displayName,omitempty"`
+	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
+	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
+	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
+	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
+	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
+	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
+	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
+	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
+	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
+	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
+	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
+	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
+
+	// "parent_organization_id" field predicates.
+	ParentOrganizationID             *string  `json:"parentOrganizationID,omitempty"`
+	ParentOrganizationIDNEQ          *string  `json:"parentOrganizationIDNEQ,omitempty"`
+	ParentOrganizationIDIn           []string `json:"parentOrganizationIDIn,omitempty"`
+	ParentOrganizationIDNotIn        []string `json:"parentOrganizationIDNotIn,omitempty"`
+	ParentOrganizationIDGT           *string  `json:"parentOrganizationIDGT,omitempty"`
+	ParentOrganizationIDGTE          *string  `json:"parentOrganizationIDGTE,omitempty"`
+	ParentOrganizationIDLT           *string  `json:"parentOrganizationIDLT,omitempty"`
+	ParentOrganizationIDLTE          *string  `json:"parentOrganizationIDLTE,omitempty"`
+	ParentOrganizationIDContains     *string  `json:"parentOrganizationIDContains,omitempty"`
+	ParentOrganizationIDHasPrefix    *string  `json:"parentOrganizationIDHasPrefix,omitempty"`
+	ParentOrganizationIDHasSuffix    *string  `json:"parentOrganizationIDHasSuffix,omitempty"`
+	ParentOrganizationIDIsNil        bool     `json:"parentOrganizationIDIsNil,omitempty"`
+	ParentOrganizationIDNotNil       bool     `json:"parentOrganizationIDNotNil,omitempty"`
+	ParentOrganizationIDEqualFold    *string  `json:"parentOrganizationIDEqualFold,omitempty"`
+	ParentOrganizationIDContainsFold *string  `json:"parentOrganizationIDContainsFold,omitempty"`
+
+	// "personal_org" field predicates.
+	PersonalOrg    *bool `json:"personalOrg,omitempty"`
+	PersonalOrgNEQ *bool `json:"personalOrgNEQ,omitempty"`
+
+	// "parent" edge predicates.
+	HasParent     *bool                     `json:"hasParent,omitempty"`
+	HasParentWith []*OrganizationWhereInput `json:"hasParentWith,omitempty"`
+
+	// "children" edge predicates.
+	HasChildren     *bool                     `json:"hasChildren,omitempty"`
+	HasChildrenWith []*OrganizationWhereInput `json:"hasChildrenWith,omitempty"`
+
+	// "users" edge predicates.
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+
+	// "groups" edge predicates.
+	HasGroups     *bool              `json:"hasGroups,omitempty"`
+	HasGroupsWith []*GroupWhereInput `json:"hasGroupsWith,omitempty"`
+
+	// "integrations" edge predicates.
+	HasIntegrations     *bool                    `json:"hasIntegrations,omitempty"`
+	HasIntegrationsWith []*IntegrationWhereInput `json:"hasIntegrationsWith,omitempty"`
+
+	// "setting" edge predicates.
+	HasSetting     *bool                            `json:"hasSetting,omitempty"`
+	HasSettingWith []*OrganizationSettingWhereInput `json:"hasSettingWith,omitempty"`
+
+	// "entitlements" edge predicates.
+	HasEntitlements     *bool                    `json:"hasEntitlements,omitempty"`
+	HasEntitlementsWith []*EntitlementWhereInput `json:"hasEntitlementsWith,omitempty"`
+
+	// "oauthprovider" edge predicates.
+	HasOauthprovider     *bool                      `json:"hasOauthprovider,omitempty"`
+	HasOauthproviderWith []*OauthProviderWhereInput `json:"hasOauthproviderWith,omitempty"`
+
+	// "api_keys" edge predicates.
+	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
+	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
+
+	// "oauth_clients" edge predicates.
+	HasOauthClients     *bool                    `json:"hasOauthClients,omitempty"`
+	HasOauthClientsWith []*OauthClientWhereInput `json:"hasOauthClientsWith,omitempty"`
+
+	// "invites" edge predicates.
+	HasInvites     *bool               `json:"hasInvites,omitempty"`
+	HasInvitesWith []*InviteWhereInput `json:"hasInvitesWith,omitempty"`
+
+	// "members" edge predicates.
+	HasMembers     *bool                      `json:"hasMembers,omitempty"`
+	HasMembersWith []*OrgMembershipWhereInput `json:"hasMembersWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *OrganizationWhereInput) AddPredicates(predicates ...predicate.Organization) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the OrganizationWhereInput filter on the OrganizationQuery builder.
+func (i *OrganizationWhereInput) Filter(q *OrganizationQuery) (*OrganizationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyOrganizationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyOrganizationWhereInput is returned in case the OrganizationWhereInput is empty.
+var ErrEmptyOrganizationWhereInput = errors.New("generated: empty predicate OrganizationWhereInput")
+
+// P returns a predicate for filtering organizations.
+// An error is returned if the input is empty or invalid.
+func (i *OrganizationWhereInput) P() (predicate.Organization, error) {
+	var predicates []predicate.Organization
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, organization.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Organization, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, organization.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Organization, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, organization.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, organization.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, organization.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, organization.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, organization.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, organization.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, organization.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, organization.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, organization.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, organization.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, organization.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, organization.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, organization.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, organization.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, organization.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, organization.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, organization.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, organization.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, organization.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, organization.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, organization.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, organization.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, organization.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, organization.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, organization.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, organization.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, organization.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, organization.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, organization.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, organization.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, organization.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, organization.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, organization.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, organization.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, organization.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, organization.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, organization.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, organization.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, organization.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, organization.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, organization.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, organization.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, organization.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, organization.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, organization.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, organization.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, organization.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, organization.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, organization.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, organization.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, organization.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, organization.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, organization.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, organization.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, organization.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, organization.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, organization.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, organization.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, organization.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, organization.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, organization.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, organization.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, organization.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, organization.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, organization.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, organization.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, organization.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, organization.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, organization.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, organization.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, organization.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, organization.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, organization.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, organization.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, organization.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, organization.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, organization.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, organization.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, organization.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, organization.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, organization.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, organization.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.DisplayName != nil {
+		predicates = append(predicates, organization.DisplayNameEQ(*i.DisplayName))
+	}
+	if i.DisplayNameNEQ != nil {
+		predicates = append(predicates, organization.DisplayNameNEQ(*i.DisplayNameNEQ))
+	}
+	if len(i.DisplayNameIn) > 0 {
+		predicates = append(predicates, organization.DisplayNameIn(i.DisplayNameIn...))
+	}
+	if len(i.DisplayNameNotIn) > 0 {
+		predicates = append(predicates, organization.DisplayNameNotIn(i.DisplayNameNotIn...))
+	}
+	if i.DisplayNameGT != nil {
+		predicates = append(predicates, organization.DisplayNameGT(*i.DisplayNameGT))
+	}
+	if i.DisplayNameGTE != nil {
+		predicates = append(predicates, organization.DisplayNameGTE(*i.DisplayNameGTE))
+	}
+	if i.DisplayNameLT != nil {
+		predicates = append(predicates, organization.DisplayNameLT(*i.DisplayNameLT))
+	}
+	if i.DisplayNameLTE != nil {
+		predicates = append(predicates, organization.DisplayNameLTE(*i.DisplayNameLTE))
+	}
+	if i.DisplayNameContains != nil {
+		predicates = append(predicates, organization.DisplayNameContains(*i.DisplayNameContains))
+	}
+	if i.DisplayNameHasPrefix != nil {
+		predicates = append(predicates, organization.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	}
+	if i.DisplayNameHasSuffix != nil {
+		predicates = append(predicates, organization.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	}
+	if i.DisplayNameEqualFold != nil {
+		predicates = append(predicates, organization.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	}
+	if i.DisplayNameContainsFold != nil {
+		predicates = append(predicates, organization.DisplayNameContainsFold(*i.DisplayNameContainsFold))
+	}
+	if i.ParentOrganizationID != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDEQ(*i.ParentOrganizationID))
+	}
+	if i.ParentOrganizationIDNEQ != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDNEQ(*i.ParentOrganizationIDNEQ))
+	}
+	if len(i.ParentOrganizationIDIn) > 0 {
+		predicates = append(predicates, organization.ParentOrganizationIDIn(i.ParentOrganizationIDIn...))
+	}
+	if len(i.ParentOrganizationIDNotIn) > 0 {
+		predicates = append(predicates, organization.ParentOrganizationIDNotIn(i.ParentOrganizationIDNotIn...))
+	}
+	if i.ParentOrganizationIDGT != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDGT(*i.ParentOrganizationIDGT))
+	}
+	if i.ParentOrganizationIDGTE != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDGTE(*i.ParentOrganizationIDGTE))
+	}
+	if i.ParentOrganizationIDLT != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDLT(*i.ParentOrganizationIDLT))
+	}
+	if i.ParentOrganizationIDLTE != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDLTE(*i.ParentOrganizationIDLTE))
+	}
+	if i.ParentOrganizationIDContains != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDContains(*i.ParentOrganizationIDContains))
+	}
+	if i.ParentOrganizationIDHasPrefix != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDHasPrefix(*i.ParentOrganizationIDHasPrefix))
+	}
+	if i.ParentOrganizationIDHasSuffix != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDHasSuffix(*i.ParentOrganizationIDHasSuffix))
+	}
+	if i.ParentOrganizationIDIsNil {
+		predicates = append(predicates, organization.ParentOrganizationIDIsNil())
+	}
+	if i.ParentOrganizationIDNotNil {
+		predicates = append(predicates, organization.ParentOrganizationIDNotNil())
+	}
+	if i.ParentOrganizationIDEqualFold != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDEqualFold(*i.ParentOrganizationIDEqualFold))
+	}
+	if i.ParentOrganizationIDContainsFold != nil {
+		predicates = append(predicates, organization.ParentOrganizationIDContainsFold(*i.ParentOrganizationIDContainsFold))
+	}
+	if i.PersonalOrg != nil {
+		predicates = append(predicates, organization.PersonalOrgEQ(*i.PersonalOrg))
+	}
+	if i.PersonalOrgNEQ != nil {
+		predicates = append(predicates, organization.PersonalOrgNEQ(*i.PersonalOrgNEQ))
+	}
+
+	if i.HasParent != nil {
+		p := organization.HasParent()
+		if !*i.HasParent {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasParentWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasParentWith))
+		for _, w := range i.HasParentWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasParentWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasParentWith(with...))
+	}
+	if i.HasChildren != nil {
+		p := organization.HasChildren()
+		if !*i.HasChildren {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChildrenWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasChildrenWith))
+		for _, w := range i.HasChildrenWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChildrenWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasChildrenWith(with...))
+	}
+	if i.HasUsers != nil {
+		p := organization.HasUsers()
+		if !*i.HasUsers {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUsersWith))
+		for _, w := range i.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasUsersWith(with...))
+	}
+	if i.HasGroups != nil {
+		p := organization.HasGroups()
+		if !*i.HasGroups {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGroupsWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupsWith))
+		for _, w := range i.HasGroupsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasGroupsWith(with...))
+	}
+	if i.HasIntegrations != nil {
+		p := organization.HasIntegrations()
+		if !*i.HasIntegrations {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasIntegrationsWith) > 0 {
+		with := make([]predicate.Integration, 0, len(i.HasIntegrationsWith))
+		for _, w := range i.HasIntegrationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasIntegrationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasIntegrationsWith(with...))
+	}
+	if i.HasSetting != nil {
+		p := organization.HasSetting()
+		if !*i.HasSetting {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettingWith) > 0 {
+		with := make([]predicate.OrganizationSetting, 0, len(i.HasSettingWith))
+		for _, w := range i.HasSettingWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasSettingWith(with...))
+	}
+	if i.HasEntitlements != nil {
+		p := organization.HasEntitlements()
+		if !*i.HasEntitlements {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasEntitlementsWith) > 0 {
+		with := make([]predicate.Entitlement, 0, len(i.HasEntitlementsWith))
+		for _, w := range i.HasEntitlementsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasEntitlementsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasEntitlementsWith(with...))
+	}
+	if i.HasOauthprovider != nil {
+		p := organization.HasOauthprovider()
+		if !*i.HasOauthprovider {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOauthproviderWith) > 0 {
+		with := make([]predicate.OauthProvider, 0, len(i.HasOauthproviderWith))
+		for _, w := range i.HasOauthproviderWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOauthproviderWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasOauthproviderWith(with...))
+	}
+	if i.HasAPIKeys != nil {
+		p := organization.HasAPIKeys()
+		if !*i.HasAPIKeys {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAPIKeysWith) > 0 {
+		with := make([]predicate.APIKey, 0, len(i.HasAPIKeysWith))
+		for _, w := range i.HasAPIKeysWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAPIKeysWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasAPIKeysWith(with...))
+	}
+	if i.HasOauthClients != nil {
+		p := organization.HasOauthClients()
+		if !*i.HasOauthClients {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOauthClientsWith) > 0 {
+		with := make([]predicate.OauthClient, 0, len(i.HasOauthClientsWith))
+		for _, w := range i.HasOauthClientsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOauthClientsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasOauthClientsWith(with...))
+	}
+	if i.HasInvites != nil {
+		p := organization.HasInvites()
+		if !*i.HasInvites {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasInvitesWith) > 0 {
+		with := make([]predicate.Invite, 0, len(i.HasInvitesWith))
+		for _, w := range i.HasInvitesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasInvitesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasInvitesWith(with...))
+	}
+	if i.HasMembers != nil {
+		p := organization.HasMembers()
+		if !*i.HasMembers {
+			p = organization.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMembersWith) > 0 {
+		with := make([]predicate.OrgMembership, 0, len(i.HasMembersWith))
+		for _, w := range i.HasMembersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMembersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organization.HasMembersWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOrganizationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return organization.And(predicates...), nil
+	}
+}
+
+// OrganizationSettingWhereInput represents a where input for filtering OrganizationSetting queries.
+type OrganizationSettingWhereInput struct {
+	Predicates []predicate.OrganizationSetting  `json:"-"`
+	Not        *OrganizationSettingWhereInput   `json:"not,omitempty"`
+	Or         []*OrganizationSettingWhereInput `json:"or,omitempty"`
+	And        []*OrganizationSettingWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "sso_cert" field predicates.
+	SSOCert             *string  `json:"ssoCert,omitempty"`
+	SSOCertNEQ          *string  `json:"ssoCertNEQ,omitempty"`
+	SSOCertIn           []string `json:"ssoCertIn,omitempty"`
+	SSOCertNotIn        []string `json:"ssoCertNotIn,omitempty"`
+	SSOCertGT           *string  `json:"ssoCertGT,omitempty"`
+	SSOCertGTE          *string  `json:"ssoCertGTE,omitempty"`
+	SSOCertLT           *string  `json:"ssoCertLT,omitempty"`
+	SSOCertLTE          *string  `json:"ssoCertLTE,omitempty"`
+	SSOCertContains     *string  `json:"ssoCertContains,omitempty"`
+	SSOCertHasPrefix    *string  `json:"ssoCertHasPrefix,omitempty"`
+	SSOCertHasSuffix    *string  `json:"ssoCertHasSuffix,omitempty"`
+	SSOCertIsNil        bool     `json:"ssoCertIsNil,omitempty"`
+	SSOCertNotNil       bool     `json:"ssoCertNotNil,omitempty"`
+	SSOCertEqualFold    *string  `json:"ssoCertEqualFold,omitempty"`
+	SSOCertContainsFold *string  `json:"ssoCertContainsFold,omitempty"`
+
+	// "sso_entrypoint" field predicates.
+	SSOEntrypoint             *string  `json:"ssoEntrypoint,omitempty"`
+	SSOEntrypointNEQ          *string  `json:"ssoEntrypointNEQ,omitempty"`
+	SSOEntrypointIn           []string `json:"ssoEntrypointIn,omitempty"`
+	SSOEntrypointNotIn        []string `json:"ssoEntrypointNotIn,omitempty"`
+	SSOEntrypointGT           *string  `json:"ssoEntrypointGT,omitempty"`
+	SSOEntrypointGTE          *string  `json:"ssoEntrypointGTE,omitempty"`
+	SSOEntrypointLT           *string  `json:"ssoEntrypointLT,omitempty"`
+	SSOEntrypointLTE          *string  `json:"ssoEntrypointLTE,omitempty"`
+	SSOEntrypointContains     *string  `json:"ssoEntrypointContains,omitempty"`
+	SSOEntrypointHasPrefix    *string  `json:"ssoEntrypointHasPrefix,omitempty"`
+	SSOEntrypointHasSuffix    *string  `json:"ssoEntrypointHasSuffix,omitempty"`
+	SSOEntrypointIsNil        bool     `json:"ssoEntrypointIsNil,omitempty"`
+	SSOEntrypointNotNil       bool     `json:"ssoEntrypointNotNil,omitempty"`
+	SSOEntrypointEqualFold    *string  `json:"ssoEntrypointEqualFold,omitempty"`
+	SSOEntrypointContainsFold *string  `json:"ssoEntrypointContainsFold,omitempty"`
+
+	// "sso_issuer" field predicates.
+	SSOIssuer             *string  `json:"ssoIssuer,omitempty"`
+	SSOIssuerNEQ          *string  `json:"ssoIssuerNEQ,omitempty"`
+	SSOIssuerIn           []string `json:"ssoIssuerIn,omitempty"`
+	SSOIssuerNotIn        []string `json:"ssoIssuerNotIn,omitempty"`
+	SSOIssuerGT           *string  `json:"ssoIssuerGT,omitempty"`
+	SSOIssuerGTE          *string  `json:"ssoIssuerGTE,omitempty"`
+	SSOIssuerLT           *string  `json:"ssoIssuerLT,omitempty"`
+	SSOIssuerLTE          *string  `json:"ssoIssuerLTE,omitempty"`
+	SSOIssuerContains     *string  `json:"ssoIssuerContains,omitempty"`
+	SSOIssuerHasPrefix    *string  `json:"ssoIssuerHasPrefix,omitempty"`
+	SSOIssuerHasSuffix    *string  `json:"ssoIssuerHasSuffix,omitempty"`
+	SSOIssuerIsNil        bool     `json:"ssoIssuerIsNil,omitempty"`
+	SSOIssuerNotNil       bool     `json:"ssoIssuerNotNil,omitempty"`
+	SSOIssuerEqualFold    *string  `json:"ssoIssuerEqualFold,omitempty"`
+	SSOIssuerContainsFold *string  `json:"ssoIssuerContainsFold,omitempty"`
+
+	// "billing_contact" field predicates.
+	BillingContact             *string  `json:"billingContact,omitempty"`
+	BillingContactNEQ          *string  `json:"billingContactNEQ,omitempty"`
+	BillingContactIn           []string `json:"billingContactIn,omitempty"`
+	BillingContactNotIn        []string `json:"billingContactNotIn,omitempty"`
+	BillingContactGT           *string  `json:"billingContactGT,omitempty"`
+	BillingContactGTE          *string  `json:"billingContactGTE,omitempty"`
+	BillingContactLT           *string  `json:"billingContactLT,omitempty"`
+	BillingContactLTE          *string  `json:"billingContactLTE,omitempty"`
+	BillingContactContains     *string  `json:"billingContactContains,omitempty"`
+	BillingContactHasPrefix    *string  `json:"billingContactHasPrefix,omitempty"`
+	BillingContactHasSuffix    *string  `json:"billingContactHasSuffix,omitempty"`
+	BillingContactIsNil        bool     `json:"billingContactIsNil,omitempty"`
+	BillingContactNotNil       bool     `json:"billingContactNotNil,omitempty"`
+	BillingContactEqualFold    *string  `json:"billingContactEqualFold,omitempty"`
+	BillingContactContainsFold *string  `json:"billingContactContainsFold,omitempty"`
+
+	// "billing_email" field predicates.
+	BillingEmail             *string  `json:"billingEmail,omitempty"`
+	BillingEmailNEQ          *string  `json:"billingEmailNEQ,omitempty"`
+	BillingEmailIn           []string `json:"billingEmailIn,omitempty"`
+	BillingEmailNotIn        []string `json:"billingEmailNotIn,omitempty"`
+	BillingEmailGT           *string  `json:"billingEmailGT,omitempty"`
+	BillingEmailGTE          *string  `json:"billingEmailGTE,omitempty"`
+	BillingEmailLT           *string  `json:"billingEmailLT,omitempty"`
+	BillingEmailLTE          *string  `json:"billingEmailLTE,omitempty"`
+	BillingEmailContains     *string  `json:"billingEmailContains,omitempty"`
+	BillingEmailHasPrefix    *string  `json:"billingEmailHasPrefix,omitempty"`
+	BillingEmailHasSuffix    *string  `json:"billingEmailHasSuffix,omitempty"`
+	BillingEmailIsNil        bool     `json:"billingEmailIsNil,omitempty"`
+	BillingEmailNotNil       bool     `json:"billingEmailNotNil,omitempty"`
+	BillingEmailEqualFold    *string  `json:"billingEmailEqualFold,omitempty"`
+	BillingEmailContainsFold *string  `json:"billingEmailContainsFold,omitempty"`
+
+	// "billing_phone" field predicates.
+	BillingPhone             *string  `json:"billingPhone,omitempty"`
+	BillingPhoneNEQ          *string  `json:"billingPhoneNEQ,omitempty"`
+	BillingPhoneIn           []string `json:"billingPhoneIn,omitempty"`
+	BillingPhoneNotIn        []string `json:"billingPhoneNotIn,omitempty"`
+	BillingPhoneGT           *string  `json:"billingPhoneGT,omitempty"`
+	BillingPhoneGTE          *string  `json:"billingPhoneGTE,omitempty"`
+	BillingPhoneLT           *string  `json:"billingPhoneLT,omitempty"`
+	BillingPhoneLTE          *string  `json:"billingPhoneLTE,omitempty"`
+	BillingPhoneContains     *string  `json:"billingPhoneContains,omitempty"`
+	BillingPhoneHasPrefix    *string  `json:"billingPhoneHasPrefix,omitempty"`
+	BillingPhoneHasSuffix    *string  `json:"billingPhoneHasSuffix,omitempty"`
+	BillingPhoneIsNil        bool     `json:"billingPhoneIsNil,omitempty"`
+	BillingPhoneNotNil       bool     `json:"billingPhoneNotNil,omitempty"`
+	BillingPhoneEqualFold    *string  `json:"billingPhoneEqualFold,omitempty"`
+	BillingPhoneContainsFold *string  `json:"billingPhoneContainsFold,omitempty"`
+
+	// "billing_address" field predicates.
+	BillingAddress             *string  `json:"billingAddress,omitempty"`
+	BillingAddressNEQ          *string  `json:"billingAddressNEQ,omitempty"`
+	BillingAddressIn           []string `json:"billingAddressIn,omitempty"`
+	BillingAddressNotIn        []string `json:"billingAddressNotIn,omitempty"`
+	BillingAddressGT           *string  `json:"billingAddressGT,omitempty"`
+	BillingAddressGTE          *string  `json:"billingAddressGTE,omitempty"`
+	BillingAddressLT           *string  `json:"billingAddressLT,omitempty"`
+	BillingAddressLTE          *string  `json:"billingAddressLTE,omitempty"`
+	BillingAddressContains     *string  `json:"billingAddressContains,omitempty"`
+	BillingAddressHasPrefix    *string  `json:"billingAddressHasPrefix,omitempty"`
+	BillingAddressHasSuffix    *string  `json:"billingAddressHasSuffix,omitempty"`
+	BillingAddressIsNil        bool     `json:"billingAddressIsNil,omitempty"`
+	BillingAddressNotNil       bool     `json:"billingAddressNotNil,omitempty"`
+	BillingAddressEqualFold    *string  `json:"billingAddressEqualFold,omitempty"`
+	BillingAddressContainsFold *string  `json:"billingAddressContainsFold,omitempty"`
+
+	// "tax_identifier" field predicates.
+	TaxIdentifier             *string  `json:"taxIdentifier,omitempty"`
+	TaxIdentifierNEQ          *string  `json:"taxIdentifierNEQ,omitempty"`
+	TaxIdentifierIn           []string `json:"taxIdentifierIn,omitempty"`
+	TaxIdentifierNotIn        []string `json:"taxIdentifierNotIn,omitempty"`
+	TaxIdentifierGT           *string  `json:"taxIdentifierGT,omitempty"`
+	TaxIdentifierGTE          *string  `json:"taxIdentifierGTE,omitempty"`
+	TaxIdentifierLT           *string  `json:"taxIdentifierLT,omitempty"`
+	TaxIdentifierLTE          *string  `json:"taxIdentifierLTE,omitempty"`
+	TaxIdentifierContains     *string  `json:"taxIdentifierContains,omitempty"`
+	TaxIdentifierHasPrefix    *string  `json:"taxIdentifierHasPrefix,omitempty"`
+	TaxIdentifierHasSuffix    *string  `json:"taxIdentifierHasSuffix,omitempty"`
+	TaxIdentifierIsNil        bool     `json:"taxIdentifierIsNil,omitempty"`
+	TaxIdentifierNotNil       bool     `json:"taxIdentifierNotNil,omitempty"`
+	TaxIdentifierEqualFold    *string  `json:"taxIdentifierEqualFold,omitempty"`
+	TaxIdentifierContainsFold *string  `json:"taxIdentifierContainsFold,omitempty"`
+
+	// "organization" edge predicates.
+	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
+	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *OrganizationSettingWhereInput) AddPredicates(predicates ...predicate.OrganizationSetting) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the OrganizationSettingWhereInput filter on the OrganizationSettingQuery builder.
+func (i *OrganizationSettingWhereInput) Filter(q *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyOrganizationSettingWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyOrganizationSettingWhereInput is returned in case the OrganizationSettingWhereInput is empty.
+var ErrEmptyOrganizationSettingWhereInput = errors.New("generated: empty predicate OrganizationSettingWhereInput")
+
+// P returns a predicate for filtering organizationsettings.
+// An error is returned if the input is empty or invalid.
+func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, error) {
+	var predicates []predicate.OrganizationSetting
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, organizationsetting.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.OrganizationSetting, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, organizationsetting.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.OrganizationSetting, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, organizationsetting.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, organizationsetting.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, organizationsetting.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, organizationsetting.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, organizationsetting.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, organizationsetting.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, organizationsetting.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, organizationsetting.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, organizationsetting.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, organizationsetting.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, organizationsetting.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, organizationsetting.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, organizationsetting.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, organizationsetting.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, organizationsetting.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, organizationsetting.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, organizationsetting.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, organizationsetting.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, organizationsetting.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, organizationsetting.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, organizationsetting.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, organizationsetting.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, organizationsetting.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, organizationsetting.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, organizationsetting.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, organizationsetting.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, organizationsetting.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, organizationsetting.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, organizationsetting.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, organizationsetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, organizationsetting.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, organizationsetting.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, organizationsetting.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, organizationsetting.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, organizationsetting.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, organizationsetting.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, organizationsetting.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, organizationsetting.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, organizationsetting.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, organizationsetting.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, organizationsetting.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, organizationsetting.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, organizationsetting.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, organizationsetting.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, organizationsetting.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, organizationsetting.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.SSOCert != nil {
+		predicates = append(predicates, organizationsetting.SSOCertEQ(*i.SSOCert))
+	}
+	if i.SSOCertNEQ != nil {
+		predicates = append(predicates, organizationsetting.SSOCertNEQ(*i.SSOCertNEQ))
+	}
+	if len(i.SSOCertIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOCertIn(i.SSOCertIn...))
+	}
+	if len(i.SSOCertNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOCertNotIn(i.SSOCertNotIn...))
+	}
+	if i.SSOCertGT != nil {
+		predicates = append(predicates, organizationsetting.SSOCertGT(*i.SSOCertGT))
+	}
+	if i.SSOCertGTE != nil {
+		predicates = append(predicates, organizationsetting.SSOCertGTE(*i.SSOCertGTE))
+	}
+	if i.SSOCertLT != nil {
+		predicates = append(predicates, organizationsetting.SSOCertLT(*i.SSOCertLT))
+	}
+	if i.SSOCertLTE != nil {
+		predicates = append(predicates, organizationsetting.SSOCertLTE(*i.SSOCertLTE))
+	}
+	if i.SSOCertContains != nil {
+		predicates = append(predicates, organizationsetting.SSOCertContains(*i.SSOCertContains))
+	}
+	if i.SSOCertHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.SSOCertHasPrefix(*i.SSOCertHasPrefix))
+	}
+	if i.SSOCertHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.SSOCertHasSuffix(*i.SSOCertHasSuffix))
+	}
+	if i.SSOCertIsNil {
+		predicates = append(predicates, organizationsetting.SSOCertIsNil())
+	}
+	if i.SSOCertNotNil {
+		predicates = append(predicates, organizationsetting.SSOCertNotNil())
+	}
+	if i.SSOCertEqualFold != nil {
+		predicates = append(predicates, organizationsetting.SSOCertEqualFold(*i.SSOCertEqualFold))
+	}
+	if i.SSOCertContainsFold != nil {
+		predicates = append(predicates, organizationsetting.SSOCertContainsFold(*i.SSOCertContainsFold))
+	}
+	if i.SSOEntrypoint != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointEQ(*i.SSOEntrypoint))
+	}
+	if i.SSOEntrypointNEQ != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointNEQ(*i.SSOEntrypointNEQ))
+	}
+	if len(i.SSOEntrypointIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOEntrypointIn(i.SSOEntrypointIn...))
+	}
+	if len(i.SSOEntrypointNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOEntrypointNotIn(i.SSOEntrypointNotIn...))
+	}
+	if i.SSOEntrypointGT != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointGT(*i.SSOEntrypointGT))
+	}
+	if i.SSOEntrypointGTE != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointGTE(*i.SSOEntrypointGTE))
+	}
+	if i.SSOEntrypointLT != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointLT(*i.SSOEntrypointLT))
+	}
+	if i.SSOEntrypointLTE != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointLTE(*i.SSOEntrypointLTE))
+	}
+	if i.SSOEntrypointContains != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointContains(*i.SSOEntrypointContains))
+	}
+	if i.SSOEntrypointHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointHasPrefix(*i.SSOEntrypointHasPrefix))
+	}
+	if i.SSOEntrypointHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointHasSuffix(*i.SSOEntrypointHasSuffix))
+	}
+	if i.SSOEntrypointIsNil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointIsNil())
+	}
+	if i.SSOEntrypointNotNil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointNotNil())
+	}
+	if i.SSOEntrypointEqualFold != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointEqualFold(*i.SSOEntrypointEqualFold))
+	}
+	if i.SSOEntrypointContainsFold != nil {
+		predicates = append(predicates, organizationsetting.SSOEntrypointContainsFold(*i.SSOEntrypointContainsFold))
+	}
+	if i.SSOIssuer != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerEQ(*i.SSOIssuer))
+	}
+	if i.SSOIssuerNEQ != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerNEQ(*i.SSOIssuerNEQ))
+	}
+	if len(i.SSOIssuerIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOIssuerIn(i.SSOIssuerIn...))
+	}
+	if len(i.SSOIssuerNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.SSOIssuerNotIn(i.SSOIssuerNotIn...))
+	}
+	if i.SSOIssuerGT != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerGT(*i.SSOIssuerGT))
+	}
+	if i.SSOIssuerGTE != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerGTE(*i.SSOIssuerGTE))
+	}
+	if i.SSOIssuerLT != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerLT(*i.SSOIssuerLT))
+	}
+	if i.SSOIssuerLTE != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerLTE(*i.SSOIssuerLTE))
+	}
+	if i.SSOIssuerContains != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerContains(*i.SSOIssuerContains))
+	}
+	if i.SSOIssuerHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerHasPrefix(*i.SSOIssuerHasPrefix))
+	}
+	if i.SSOIssuerHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerHasSuffix(*i.SSOIssuerHasSuffix))
+	}
+	if i.SSOIssuerIsNil {
+		predicates = append(predicates, organizationsetting.SSOIssuerIsNil())
+	}
+	if i.SSOIssuerNotNil {
+		predicates = append(predicates, organizationsetting.SSOIssuerNotNil())
+	}
+	if i.SSOIssuerEqualFold != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerEqualFold(*i.SSOIssuerEqualFold))
+	}
+	if i.SSOIssuerContainsFold != nil {
+		predicates = append(predicates, organizationsetting.SSOIssuerContainsFold(*i.SSOIssuerContainsFold))
+	}
+	if i.BillingContact != nil {
+		predicates = append(predicates, organizationsetting.BillingContactEQ(*i.BillingContact))
+	}
+	if i.BillingContactNEQ != nil {
+		predicates = append(predicates, organizationsetting.BillingContactNEQ(*i.BillingContactNEQ))
+	}
+	if len(i.BillingContactIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingContactIn(i.BillingContactIn...))
+	}
+	if len(i.BillingContactNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingContactNotIn(i.BillingContactNotIn...))
+	}
+	if i.BillingContactGT != nil {
+		predicates = append(predicates, organizationsetting.BillingContactGT(*i.BillingContactGT))
+	}
+	if i.BillingContactGTE != nil {
+		predicates = append(predicates, organizationsetting.BillingContactGTE(*i.BillingContactGTE))
+	}
+	if i.BillingContactLT != nil {
+		predicates = append(predicates, organizationsetting.BillingContactLT(*i.BillingContactLT))
+	}
+	if i.BillingContactLTE != nil {
+		predicates = append(predicates, organizationsetting.BillingContactLTE(*i.BillingContactLTE))
+	}
+	if i.BillingContactContains != nil {
+		predicates = append(predicates, organizationsetting.BillingContactContains(*i.BillingContactContains))
+	}
+	if i.BillingContactHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.BillingContactHasPrefix(*i.BillingContactHasPrefix))
+	}
+	if i.BillingContactHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.BillingContactHasSuffix(*i.BillingContactHasSuffix))
+	}
+	if i.BillingContactIsNil {
+		predicates = append(predicates, organizationsetting.BillingContactIsNil())
+	}
+	if i.BillingContactNotNil {
+		predicates = append(predicates, organizationsetting.BillingContactNotNil())
+	}
+	if i.BillingContactEqualFold != nil {
+		predicates = append(predicates, organizationsetting.BillingContactEqualFold(*i.BillingContactEqualFold))
+	}
+	if i.BillingContactContainsFold != nil {
+		predicates = append(predicates, organizationsetting.BillingContactContainsFold(*i.BillingContactContainsFold))
+	}
+	if i.BillingEmail != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailEQ(*i.BillingEmail))
+	}
+	if i.BillingEmailNEQ != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailNEQ(*i.BillingEmailNEQ))
+	}
+	if len(i.BillingEmailIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingEmailIn(i.BillingEmailIn...))
+	}
+	if len(i.BillingEmailNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingEmailNotIn(i.BillingEmailNotIn...))
+	}
+	if i.BillingEmailGT != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailGT(*i.BillingEmailGT))
+	}
+	if i.BillingEmailGTE != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailGTE(*i.BillingEmailGTE))
+	}
+	if i.BillingEmailLT != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailLT(*i.BillingEmailLT))
+	}
+	if i.BillingEmailLTE != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailLTE(*i.BillingEmailLTE))
+	}
+	if i.BillingEmailContains != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailContains(*i.BillingEmailContains))
+	}
+	if i.BillingEmailHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailHasPrefix(*i.BillingEmailHasPrefix))
+	}
+	if i.BillingEmailHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailHasSuffix(*i.BillingEmailHasSuffix))
+	}
+	if i.BillingEmailIsNil {
+		predicates = append(predicates, organizationsetting.BillingEmailIsNil())
+	}
+	if i.BillingEmailNotNil {
+		predicates = append(predicates, organizationsetting.BillingEmailNotNil())
+	}
+	if i.BillingEmailEqualFold != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailEqualFold(*i.BillingEmailEqualFold))
+	}
+	if i.BillingEmailContainsFold != nil {
+		predicates = append(predicates, organizationsetting.BillingEmailContainsFold(*i.BillingEmailContainsFold))
+	}
+	if i.BillingPhone != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneEQ(*i.BillingPhone))
+	}
+	if i.BillingPhoneNEQ != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneNEQ(*i.BillingPhoneNEQ))
+	}
+	if len(i.BillingPhoneIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingPhoneIn(i.BillingPhoneIn...))
+	}
+	if len(i.BillingPhoneNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingPhoneNotIn(i.BillingPhoneNotIn...))
+	}
+	if i.BillingPhoneGT != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneGT(*i.BillingPhoneGT))
+	}
+	if i.BillingPhoneGTE != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneGTE(*i.BillingPhoneGTE))
+	}
+	if i.BillingPhoneLT != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneLT(*i.BillingPhoneLT))
+	}
+	if i.BillingPhoneLTE != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneLTE(*i.BillingPhoneLTE))
+	}
+	if i.BillingPhoneContains != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneContains(*i.BillingPhoneContains))
+	}
+	if i.BillingPhoneHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneHasPrefix(*i.BillingPhoneHasPrefix))
+	}
+	if i.BillingPhoneHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneHasSuffix(*i.BillingPhoneHasSuffix))
+	}
+	if i.BillingPhoneIsNil {
+		predicates = append(predicates, organizationsetting.BillingPhoneIsNil())
+	}
+	if i.BillingPhoneNotNil {
+		predicates = append(predicates, organizationsetting.BillingPhoneNotNil())
+	}
+	if i.BillingPhoneEqualFold != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneEqualFold(*i.BillingPhoneEqualFold))
+	}
+	if i.BillingPhoneContainsFold != nil {
+		predicates = append(predicates, organizationsetting.BillingPhoneContainsFold(*i.BillingPhoneContainsFold))
+	}
+	if i.BillingAddress != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressEQ(*i.BillingAddress))
+	}
+	if i.BillingAddressNEQ != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressNEQ(*i.BillingAddressNEQ))
+	}
+	if len(i.BillingAddressIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingAddressIn(i.BillingAddressIn...))
+	}
+	if len(i.BillingAddressNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.BillingAddressNotIn(i.BillingAddressNotIn...))
+	}
+	if i.BillingAddressGT != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressGT(*i.BillingAddressGT))
+	}
+	if i.BillingAddressGTE != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressGTE(*i.BillingAddressGTE))
+	}
+	if i.BillingAddressLT != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressLT(*i.BillingAddressLT))
+	}
+	if i.BillingAddressLTE != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressLTE(*i.BillingAddressLTE))
+	}
+	if i.BillingAddressContains != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressContains(*i.BillingAddressContains))
+	}
+	if i.BillingAddressHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressHasPrefix(*i.BillingAddressHasPrefix))
+	}
+	if i.BillingAddressHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressHasSuffix(*i.BillingAddressHasSuffix))
+	}
+	if i.BillingAddressIsNil {
+		predicates = append(predicates, organizationsetting.BillingAddressIsNil())
+	}
+	if i.BillingAddressNotNil {
+		predicates = append(predicates, organizationsetting.BillingAddressNotNil())
+	}
+	if i.BillingAddressEqualFold != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressEqualFold(*i.BillingAddressEqualFold))
+	}
+	if i.BillingAddressContainsFold != nil {
+		predicates = append(predicates, organizationsetting.BillingAddressContainsFold(*i.BillingAddressContainsFold))
+	}
+	if i.TaxIdentifier != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierEQ(*i.TaxIdentifier))
+	}
+	if i.TaxIdentifierNEQ != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierNEQ(*i.TaxIdentifierNEQ))
+	}
+	if len(i.TaxIdentifierIn) > 0 {
+		predicates = append(predicates, organizationsetting.TaxIdentifierIn(i.TaxIdentifierIn...))
+	}
+	if len(i.TaxIdentifierNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.TaxIdentifierNotIn(i.TaxIdentifierNotIn...))
+	}
+	if i.TaxIdentifierGT != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierGT(*i.TaxIdentifierGT))
+	}
+	if i.TaxIdentifierGTE != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierGTE(*i.TaxIdentifierGTE))
+	}
+	if i.TaxIdentifierLT != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierLT(*i.TaxIdentifierLT))
+	}
+	if i.TaxIdentifierLTE != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierLTE(*i.TaxIdentifierLTE))
+	}
+	if i.TaxIdentifierContains != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierContains(*i.TaxIdentifierContains))
+	}
+	if i.TaxIdentifierHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierHasPrefix(*i.TaxIdentifierHasPrefix))
+	}
+	if i.TaxIdentifierHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierHasSuffix(*i.TaxIdentifierHasSuffix))
+	}
+	if i.TaxIdentifierIsNil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierIsNil())
+	}
+	if i.TaxIdentifierNotNil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierNotNil())
+	}
+	if i.TaxIdentifierEqualFold != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierEqualFold(*i.TaxIdentifierEqualFold))
+	}
+	if i.TaxIdentifierContainsFold != nil {
+		predicates = append(predicates, organizationsetting.TaxIdentifierContainsFold(*i.TaxIdentifierContainsFold))
+	}
+
+	if i.HasOrganization != nil {
+		p := organizationsetting.HasOrganization()
+		if !*i.HasOrganization {
+			p = organizationsetting.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOrganizationWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOrganizationWith))
+		for _, w := range i.HasOrganizationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOrganizationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, organizationsetting.HasOrganizationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOrganizationSettingWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return organizationsetting.And(predicates...), nil
+	}
+}
+
+// OwnershipTransferWhereInput represents a where input for filtering OwnershipTransfer queries.
+type OwnershipTransferWhereInput struct {
+	Predicates []predicate.OwnershipTransfer  `json:"-"`
+	Not        *OwnershipTransferWhereInput   `json:"not,omitempty"`
+	Or         []*OwnershipTransferWhereInput `json:"or,omitempty"`
+	And        []*OwnershipTransferWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+
+	// "group_id" field predicates.
+	GroupID             *string  `json:"groupID,omitempty"`
+	GroupIDNEQ          *string  `json:"groupIDNEQ,omitempty"`
+	GroupIDIn           []string `json:"groupIDIn,omitempty"`
+	GroupIDNotIn        []string `json:"groupIDNotIn,omitempty"`
+	GroupIDGT           *string  `json:"groupIDGT,omitempty"`
+	GroupIDGTE          *string  `json:"groupIDGTE,omitempty"`
+	GroupIDLT           *string  `json:"groupIDLT,omitempty"`
+	GroupIDLTE          *string  `json:"groupIDLTE,omitempty"`
+	GroupIDContains     *string  `json:"groupIDContains,omitempty"`
+	GroupIDHasPrefix    *string  `json:"groupIDHasPrefix,omitempty"`
+	GroupIDHasSuffix    *string  `json:"groupIDHasSuffix,omitempty"`
+	GroupIDIsNil        bool     `json:"groupIDIsNil,omitempty"`
+	GroupIDNotNil       bool     `json:"groupIDNotNil,omitempty"`
+	GroupIDEqualFold    *string  `json:"groupIDEqualFold,omitempty"`
+	GroupIDContainsFold *string  `json:"groupIDContainsFold,omitempty"`
+
+	// "requestor_id" field predicates.
+	RequestorID             *string  `json:"requestorID,omitempty"`
+	RequestorIDNEQ          *string  `json:"requestorIDNEQ,omitempty"`
+	RequestorIDIn           []string `json:"requestorIDIn,omitempty"`
+	RequestorIDNotIn        []string `json:"requestorIDNotIn,omitempty"`
+	RequestorIDGT           *string  `json:"requestorIDGT,omitempty"`
+	RequestorIDGTE          *string  `json:"requestorIDGTE,omitempty"`
+	RequestorIDLT           *string  `json:"requestorIDLT,omitempty"`
+	RequestorIDLTE          *string  `json:"requestorIDLTE,omitempty"`
+	RequestorIDContains     *string  `json:"requestorIDContains,omitempty"`
+	RequestorIDHasPrefix    *string  `json:"requestorIDHasPrefix,omitempty"`
+	RequestorIDHasSuffix    *string  `json:"requestorIDHasSuffix,omitempty"`
+	RequestorIDEqualFold    *string  `json:"requestorIDEqualFold,omitempty"`
+	RequestorIDContainsFold *string  `json:"requestorIDContainsFold,omitempty"`
+
+	// "recipient_id" field predicates.
+	RecipientID             *string  `json:"recipientID,omitempty"`
+	RecipientIDNEQ          *string  `json:"recipientIDNEQ,omitempty"`
+	RecipientIDIn           []string `json:"recipientIDIn,omitempty"`
+	RecipientIDNotIn        []string `json:"recipientIDNotIn,omitempty"`
+	RecipientIDGT           *string  `json:"recipientIDGT,omitempty"`
+	RecipientIDGTE          *string  `json:"recipientIDGTE,omitempty"`
+	RecipientIDLT           *string  `json:"recipientIDLT,omitempty"`
+	RecipientIDLTE          *string  `json:"recipientIDLTE,omitempty"`
+	RecipientIDContains     *string  `json:"recipientIDContains,omitempty"`
+	RecipientIDHasPrefix    *string  `json:"recipientIDHasPrefix,omitempty"`
+	RecipientIDHasSuffix    *string  `json:"recipientIDHasSuffix,omitempty"`
+	RecipientIDEqualFold    *string  `json:"recipientIDEqualFold,omitempty"`
+	RecipientIDContainsFold *string  `json:"recipientIDContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *ownershiptransfer.Status  `json:"status,omitempty"`
+	StatusNEQ   *ownershiptransfer.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []ownershiptransfer.Status `json:"statusIn,omitempty"`
+	StatusNotIn []ownershiptransfer.Status `json:"statusNotIn,omitempty"`
+
+	// "expires" field predicates.
+	Expires      *time.Time  `json:"expires,omitempty"`
+	ExpiresNEQ   *time.Time  `json:"expiresNEQ,omitempty"`
+	ExpiresIn    []time.Time `json:"expiresIn,omitempty"`
+	ExpiresNotIn []time.Time `json:"expiresNotIn,omitempty"`
+	ExpiresGT    *time.Time  `json:"expiresGT,omitempty"`
+	ExpiresGTE   *time.Time  `json:"expiresGTE,omitempty"`
+	ExpiresLT    *time.Time  `json:"expiresLT,omitempty"`
+	ExpiresLTE   *time.Time  `json:"expiresLTE,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "group" edge predicates.
+	HasGroup     *bool              `json:"hasGroup,omitempty"`
+	HasGroupWith []*GroupWhereInput `json:"hasGroupWith,omitempty"`
+
+	// "requestor" edge predicates.
+	HasRequestor     *bool             `json:"hasRequestor,omitempty"`
+	HasRequestorWith []*UserWhereInput `json:"hasRequestorWith,omitempty"`
+
+	// "recipient" edge predicates.
+	HasRecipient     *bool             `json:"hasRecipient,omitempty"`
+	HasRecipientWith []*UserWhereInput `json:"hasRecipientWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *OwnershipTransferWhereInput) AddPredicates(predicates ...predicate.OwnershipTransfer) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the OwnershipTransferWhereInput filter on the OwnershipTransferQuery builder.
+func (i *OwnershipTransferWhereInput) Filter(q *OwnershipTransferQuery) (*OwnershipTransferQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyOwnershipTransferWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyOwnershipTransferWhereInput is returned in case the OwnershipTransferWhereInput is empty.
+var ErrEmptyOwnershipTransferWhereInput = errors.New("generated: empty predicate OwnershipTransferWhereInput")
+
+// P returns a predicate for filtering ownershiptransfers.
+// An error is returned if the input is empty or invalid.
+func (i *OwnershipTransferWhereInput) P() (predicate.OwnershipTransfer, error) {
+	var predicates []predicate.OwnershipTransfer
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, ownershiptransfer.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.OwnershipTransfer, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, ownershiptransfer.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.OwnershipTransfer, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, ownershiptransfer.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, ownershiptransfer.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, ownershiptransfer.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, ownershiptransfer.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, ownershiptransfer.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, ownershiptransfer.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, ownershiptransfer.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, ownershiptransfer.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.OwnerID != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDEQ(*i.OwnerID))
+	}
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDNEQ(*i.OwnerIDNEQ))
+	}
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.OwnerIDIn(i.OwnerIDIn...))
+	}
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.OwnerIDNotIn(i.OwnerIDNotIn...))
+	}
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDGT(*i.OwnerIDGT))
+	}
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDGTE(*i.OwnerIDGTE))
+	}
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDLT(*i.OwnerIDLT))
+	}
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDLTE(*i.OwnerIDLTE))
+	}
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDContains(*i.OwnerIDContains))
+	}
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
+	}
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
+	}
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDEqualFold(*i.OwnerIDEqualFold))
+	}
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.OwnerIDContainsFold(*i.OwnerIDContainsFold))
+	}
+	if i.GroupID != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDEQ(*i.GroupID))
+	}
+	if i.GroupIDNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDNEQ(*i.GroupIDNEQ))
+	}
+	if len(i.GroupIDIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.GroupIDIn(i.GroupIDIn...))
+	}
+	if len(i.GroupIDNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.GroupIDNotIn(i.GroupIDNotIn...))
+	}
+	if i.GroupIDGT != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDGT(*i.GroupIDGT))
+	}
+	if i.GroupIDGTE != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDGTE(*i.GroupIDGTE))
+	}
+	if i.GroupIDLT != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDLT(*i.GroupIDLT))
+	}
+	if i.GroupIDLTE != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDLTE(*i.GroupIDLTE))
+	}
+	if i.GroupIDContains != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDContains(*i.GroupIDContains))
+	}
+	if i.GroupIDHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDHasPrefix(*i.GroupIDHasPrefix))
+	}
+	if i.GroupIDHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDHasSuffix(*i.GroupIDHasSuffix))
+	}
+	if i.GroupIDIsNil {
+		predicates = append(predicates, ownershiptransfer.GroupIDIsNil())
+	}
+	if i.GroupIDNotNil {
+		predicates = append(predicates, ownershiptransfer.GroupIDNotNil())
+	}
+	if i.GroupIDEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDEqualFold(*i.GroupIDEqualFold))
+	}
+	if i.GroupIDContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.GroupIDContainsFold(*i.GroupIDContainsFold))
+	}
+	if i.RequestorID != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDEQ(*i.RequestorID))
+	}
+	if i.RequestorIDNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDNEQ(*i.RequestorIDNEQ))
+	}
+	if len(i.RequestorIDIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.RequestorIDIn(i.RequestorIDIn...))
+	}
+	if len(i.RequestorIDNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.RequestorIDNotIn(i.RequestorIDNotIn...))
+	}
+	if i.RequestorIDGT != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDGT(*i.RequestorIDGT))
+	}
+	if i.RequestorIDGTE != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDGTE(*i.RequestorIDGTE))
+	}
+	if i.RequestorIDLT != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDLT(*i.RequestorIDLT))
+	}
+	if i.RequestorIDLTE != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDLTE(*i.RequestorIDLTE))
+	}
+	if i.RequestorIDContains != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDContains(*i.RequestorIDContains))
+	}
+	if i.RequestorIDHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDHasPrefix(*i.RequestorIDHasPrefix))
+	}
+	if i.RequestorIDHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDHasSuffix(*i.RequestorIDHasSuffix))
+	}
+	if i.RequestorIDEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDEqualFold(*i.RequestorIDEqualFold))
+	}
+	if i.RequestorIDContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.RequestorIDContainsFold(*i.RequestorIDContainsFold))
+	}
+	if i.RecipientID != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDEQ(*i.RecipientID))
+	}
+	if i.RecipientIDNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDNEQ(*i.RecipientIDNEQ))
+	}
+	if len(i.RecipientIDIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.RecipientIDIn(i.RecipientIDIn...))
+	}
+	if len(i.RecipientIDNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.RecipientIDNotIn(i.RecipientIDNotIn...))
+	}
+	if i.RecipientIDGT != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDGT(*i.RecipientIDGT))
+	}
+	if i.RecipientIDGTE != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDGTE(*i.RecipientIDGTE))
+	}
+	if i.RecipientIDLT != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDLT(*i.RecipientIDLT))
+	}
+	if i.RecipientIDLTE != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDLTE(*i.RecipientIDLTE))
+	}
+	if i.RecipientIDContains != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDContains(*i.RecipientIDContains))
+	}
+	if i.RecipientIDHasPrefix != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDHasPrefix(*i.RecipientIDHasPrefix))
+	}
+	if i.RecipientIDHasSuffix != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDHasSuffix(*i.RecipientIDHasSuffix))
+	}
+	if i.RecipientIDEqualFold != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDEqualFold(*i.RecipientIDEqualFold))
+	}
+	if i.RecipientIDContainsFold != nil {
+		predicates = append(predicates, ownershiptransfer.RecipientIDContainsFold(*i.RecipientIDContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, ownershiptransfer.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Expires != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresEQ(*i.Expires))
+	}
+	if i.ExpiresNEQ != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresNEQ(*i.ExpiresNEQ))
+	}
+	if len(i.ExpiresIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.ExpiresIn(i.ExpiresIn...))
+	}
+	if len(i.ExpiresNotIn) > 0 {
+		predicates = append(predicates, ownershiptransfer.ExpiresNotIn(i.ExpiresNotIn...))
+	}
+	if i.ExpiresGT != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresGT(*i.ExpiresGT))
+	}
+	if i.ExpiresGTE != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresGTE(*i.ExpiresGTE))
+	}
+	if i.ExpiresLT != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresLT(*i.ExpiresLT))
+	}
+	if i.ExpiresLTE != nil {
+		predicates = append(predicates, ownershiptransfer.ExpiresLTE(*i.ExpiresLTE))
+	}
+
+	if i.HasOwner != nil {
+		p := ownershiptransfer.HasOwner()
+		if !*i.HasOwner {
+			p = ownershiptransfer.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, ownershiptransfer.HasOwnerWith(with...))
+	}
+	if i.HasGroup != nil {
+		p := ownershiptransfer.HasGroup()
+		if !*i.HasGroup {
+			p = ownershiptransfer.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGroupWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupWith))
+		for _, w := range i.HasGroupWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, ownershiptransfer.HasGroupWith(with...))
+	}
+	if i.HasRequestor != nil {
+		p := ownershiptransfer.HasRequestor()
+		if !*i.HasRequestor {
+			p = ownershiptransfer.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRequestorWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasRequestorWith))
+		for _, w := range i.HasRequestorWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRequestorWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, ownershiptransfer.HasRequestorWith(with...))
+	}
+	if i.HasRecipient != nil {
+		p := ownershiptransfer.HasRecipient()
+		if !*i.HasRecipient {
+			p = ownershiptransfer.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRecipientWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasRecipientWith))
+		for _, w := range i.HasRecipientWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRecipientWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, ownershiptransfer.HasRecipientWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOwnershipTransferWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return ownershiptransfer.And(predicates...), nil
+	}
+}
+
+// PersonalAccessTokenWhereInput represents a where input for filtering PersonalAccessToken queries.
+type PersonalAccessTokenWhereInput struct {
+	Predicates []predicate.PersonalAccessToken  `json:"-"`
+	Not        *PersonalAccessTokenWhereInput   `json:"not,omitempty"`
+	Or         []*PersonalAccessTokenWhereInput `json:"or,omitempty"`
+	And        []*PersonalAccessTokenWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "token_prefix" field predicates.
+	TokenPrefix             *string  `json:"tokenPrefix,omitempty"`
+	TokenPrefixNEQ          *string  `json:"tokenPrefixNEQ,omitempty"`
+	TokenPrefixIn           []string `json:"tokenPrefixIn,omitempty"`
+	TokenPrefixNotIn        []string `json:"tokenPrefixNotIn,omitempty"`
+	TokenPrefixGT           *string  `json:"tokenPrefixGT,omitempty"`
+	TokenPrefixGTE          *string  `json:"tokenPrefixGTE,omitempty"`
+	TokenPrefixLT           *string  `json:"tokenPrefixLT,omitempty"`
+	TokenPrefixLTE          *string  `json:"tokenPrefixLTE,omitempty"`
+	TokenPrefixContains     *string  `json:"tokenPrefixContains,omitempty"`
+	TokenPrefixHasPrefix    *string  `json:"tokenPrefixHasPrefix,omitempty"`
+	TokenPrefixHasSuffix    *string  `json:"tokenPrefixHasSuffix,omitempty"`
+	TokenPrefixEqualFold    *string  `json:"tokenPrefixEqualFold,omitempty"`
+	TokenPrefixContainsFold *string  `json:"tokenPrefixContainsFold,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PersonalAccessTokenWhereInput) AddPredicates(predicates ...predicate.PersonalAccessToken) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PersonalAccessTokenWhereInput filter on the PersonalAccessTokenQuery builder.
+func (i *PersonalAccessTokenWhereInput) Filter(q *PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPersonalAccessTokenWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPersonalAccessTokenWhereInput is returned in case the PersonalAccessTokenWhereInput is empty.
+var ErrEmptyPersonalAccessTokenWhereInput = errors.New("generated: empty predicate PersonalAccessTokenWhereInput")
+
+// P returns a predicate for filtering personalaccesstokens.
+// An error is returned if the input is empty or invalid.
+func (i *PersonalAccessTokenWhereInput) P() (predicate.PersonalAccessToken, error) {
+	var predicates []predicate.PersonalAccessToken
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, personalaccesstoken.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.PersonalAccessToken, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, personalaccesstoken.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.PersonalAccessToken, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, personalaccesstoken.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, personalaccesstoken.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, personalaccesstoken.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, personalaccesstoken.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, personalaccesstoken.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, personalaccesstoken.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, personalaccesstoken.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, personalaccesstoken.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, personalaccesstoken.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, personalaccesstoken.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, personalaccesstoken.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, personalaccesstoken.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, personalaccesstoken.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, personalaccesstoken.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, personalaccesstoken.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, personalaccesstoken.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, personalaccesstoken.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, personalaccesstoken.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.TokenPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixEQ(*i.TokenPrefix))
+	}
+	if i.TokenPrefixNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixNEQ(*i.TokenPrefixNEQ))
+	}
+	if len(i.TokenPrefixIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixIn(i.TokenPrefixIn...))
+	}
+	if len(i.TokenPrefixNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixNotIn(i.TokenPrefixNotIn...))
+	}
+	if i.TokenPrefixGT != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixGT(*i.TokenPrefixGT))
+	}
+	if i.TokenPrefixGTE != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixGTE(*i.TokenPrefixGTE))
+	}
+	if i.TokenPrefixLT != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixLT(*i.TokenPrefixLT))
+	}
+	if i.TokenPrefixLTE != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixLTE(*i.TokenPrefixLTE))
+	}
+	if i.TokenPrefixContains != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixContains(*i.TokenPrefixContains))
+	}
+	if i.TokenPrefixHasPrefix != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixHasPrefix(*i.TokenPrefixHasPrefix))
+	}
+	if i.TokenPrefixHasSuffix != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixHasSuffix(*i.TokenPrefixHasSuffix))
+	}
+	if i.TokenPrefixEqualFold != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixEqualFold(*i.TokenPrefixEqualFold))
+	}
+	if i.TokenPrefixContainsFold != nil {
+		predicates = append(predicates, personalaccesstoken.TokenPrefixContainsFold(*i.TokenPrefixContainsFold))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, personalaccesstoken.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtEQ(*i.LastUsedAt))
+	}
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, personalaccesstoken.LastUsedAtNotNil())
+	}
+
+	if i.HasOwner != nil {
+		p := personalaccesstoken.HasOwner()
+		if !*i.HasOwner {
+			p = personalaccesstoken.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, personalaccesstoken.HasOwnerWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPersonalAccessTokenWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return personalaccesstoken.And(predicates...), nil
+	}
+}
+
+// SessionWhereInput represents a where input for filtering Session queries.
+type SessionWhereInput struct {
+	Predicates []predicate.Session  `json:"-"`
+	Not        *SessionWhereInput   `json:"not,omitempty"`
+	Or         []*SessionWhereInput `json:"or,omitempty"`
+	And        []*SessionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "session_token" field predicates.
+	SessionToken             *string  `json:"sessionToken,omitempty"`
+	SessionTokenNEQ          *string  `json:"sessionTokenNEQ,omitempty"`
+	SessionTokenIn           []string `json:"sessionTokenIn,omitempty"`
+	SessionTokenNotIn        []string `json:"sessionTokenNotIn,omitempty"`
+	SessionTokenGT           *string  `json:"sessionTokenGT,omitempty"`
+	SessionTokenGTE          *string  `json:"sessionTokenGTE,omitempty"`
+	SessionTokenLT           *string  `json:"sessionTokenLT,omitempty"`
+	SessionTokenLTE          *string  `json:"sessionTokenLTE,omitempty"`
+	SessionTokenContains     *string  `json:"sessionTokenContains,omitempty"`
+	SessionTokenHasPrefix    *string  `json:"sessionTokenHasPrefix,omitempty"`
+	SessionTokenHasSuffix    *string  `json:"sessionTokenHasSuffix,omitempty"`
+	SessionTokenEqualFold    *string  `json:"sessionTokenEqualFold,omitempty"`
+	SessionTokenContainsFold *string  `json:"sessionTokenContainsFold,omitempty"`
+
+	// "issued_at" field predicates.
+	IssuedAt      *time.Time  `json:"issuedAt,omitempty"`
+	IssuedAtNEQ   *time.Time  `json:"issuedAtNEQ,omitempty"`
+	IssuedAtIn    []time.Time `json:"issuedAtIn,omitempty"`
+	IssuedAtNotIn []time.Time `json:"issuedAtNotIn,omitempty"`
+	IssuedAtGT    *time.Time  `json:"issuedAtGT,omitempty"`
+	IssuedAtGTE   *time.Time  `json:"issuedAtGTE,omitempty"`
+	IssuedAtLT    *time.Time  `json:"issuedAtLT,omitempty"`
+	IssuedAtLTE   *time.Time  `json:"issuedAtLTE,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "organization_id" field predicates.
+	OrganizationID             *string  `json:"organizationID,omitempty"`
+	OrganizationIDNEQ          *string  `json:"organizationIDNEQ,omitempty"`
+	OrganizationIDIn           []string `json:"organizationIDIn,omitempty"`
+	OrganizationIDNotIn        []string `json:"organizationIDNotIn,omitempty"`
+	OrganizationIDGT           *string  `json:"organizationIDGT,omitempty"`
+	OrganizationIDGTE          *string  `json:"organizationIDGTE,omitempty"`
+	OrganizationIDLT           *string  `json:"organizationIDLT,omitempty"`
+	OrganizationIDLTE          *string  `json:"organizationIDLTE,omitempty"`
+	OrganizationIDContains     *string  `json:"organizationIDContains,omitempty"`
+	OrganizationIDHasPrefix    *string  `json:"organizationIDHasPrefix,omitempty"`
+	OrganizationIDHasSuffix    *string  `json:"organizationIDHasSuffix,omitempty"`
+	OrganizationIDIsNil        bool     `json:"organizationIDIsNil,omitempty"`
+	OrganizationIDNotNil       bool     `json:"organizationIDNotNil,omitempty"`
+	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
+	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+
+	// "user_agent" field predicates.
+	UserAgent             *string  `json:"userAgent,omitempty"`
+	UserAgentNEQ          *string  `json:"userAgentNEQ,omitempty"`
+	UserAgentIn           []string `json:"userAgentIn,omitempty"`
+	UserAgentNotIn        []string `json:"userAgentNotIn,omitempty"`
+	UserAgentGT           *string  `json:"userAgentGT,omitempty"`
+	UserAgentGTE          *string  `json:"userAgentGTE,omitempty"`
+	UserAgentLT           *string  `json:"userAgentLT,omitempty"`
+	UserAgentLTE          *string  `json:"userAgentLTE,omitempty"`
+	UserAgentContains     *string  `json:"userAgentContains,omitempty"`
+	UserAgentHasPrefix    *string  `json:"userAgentHasPrefix,omitempty"`
+	UserAgentHasSuffix    *string  `json:"userAgentHasSuffix,omitempty"`
+	UserAgentIsNil        bool     `json:"userAgentIsNil,omitempty"`
+	UserAgentNotNil       bool     `json:"userAgentNotNil,omitempty"`
+	UserAgentEqualFold    *string  `json:"userAgentEqualFold,omitempty"`
+	UserAgentContainsFold *string  `json:"userAgentContainsFold,omitempty"`
+
+	// "ip_address" field predicates.
+	IPAddress             *string  `json:"ipAddress,omitempty"`
+	IPAddressNEQ          *string  `json:"ipAddressNEQ,omitempty"`
+	IPAddressIn           []string `json:"ipAddressIn,omitempty"`
+	IPAddressNotIn        []string `json:"ipAddressNotIn,omitempty"`
+	IPAddressGT           *string  `json:"ipAddressGT,omitempty"`
+	IPAddressGTE          *string  `json:"ipAddressGTE,omitempty"`
+	IPAddressLT           *string  `json:"ipAddressLT,omitempty"`
+	IPAddressLTE          *string  `json:"ipAddressLTE,omitempty"`
+	IPAddressContains     *string  `json:"ipAddressContains,omitempty"`
+	IPAddressHasPrefix    *string  `json:"ipAddressHasPrefix,omitempty"`
+	IPAddressHasSuffix    *string  `json:"ipAddressHasSuffix,omitempty"`
+	IPAddressIsNil        bool     `json:"ipAddressIsNil,omitempty"`
+	IPAddressNotNil       bool     `json:"ipAddressNotNil,omitempty"`
+	IPAddressEqualFold    *string  `json:"ipAddressEqualFold,omitempty"`
+	IPAddressContainsFold *string  `json:"ipAddressContainsFold,omitempty"`
+
+	// "last_seen_at" field predicates.
+	LastSeenAt       *time.Time  `json:"lastSeenAt,omitempty"`
+	LastSeenAtNEQ    *time.Time  `json:"lastSeenAtNEQ,omitempty"`
+	LastSeenAtIn     []time.Time `json:"lastSeenAtIn,omitempty"`
+	LastSeenAtNotIn  []time.Time `json:"lastSeenAtNotIn,omitempty"`
+	LastSeenAtGT     *time.Time  `json:"lastSeenAtGT,omitempty"`
+	LastSeenAtGTE    *time.Time  `json:"lastSeenAtGTE,omitempty"`
+	LastSeenAtLT     *time.Time  `json:"lastSeenAtLT,omitempty"`
+	LastSeenAtLTE    *time.Time  `json:"lastSeenAtLTE,omitempty"`
+	LastSeenAtIsNil  bool        `json:"lastSeenAtIsNil,omitempty"`
+	LastSeenAtNotNil bool        `json:"lastSeenAtNotNil,omitempty"`
+
+	// "revoked_at" field predicates.
+	RevokedAt       *time.Time  `json:"revokedAt,omitempty"`
+	RevokedAtNEQ    *time.Time  `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGT     *time.Time  `json:"revokedAtGT,omitempty"`
+	RevokedAtGTE    *time.Time  `json:"revokedAtGTE,omitempty"`
+	RevokedAtLT     *time.Time  `json:"revokedAtLT,omitempty"`
+	RevokedAtLTE    *time.Time  `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil bool        `json:"revokedAtNotNil,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SessionWhereInput) AddPredicates(predicates ...predicate.Session) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SessionWhereInput filter on the SessionQuery builder.
+func (i *SessionWhereInput) Filter(q *SessionQuery) (*SessionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySessionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySessionWhereInput is returned in case the SessionWhereInput is empty.
+var ErrEmptySessionWhereInput = errors.New("generated: empty predicate SessionWhereInput")
+
+// P returns a predicate for filtering sessions.
+// An error is returned if the input is empty or invalid.
+func (i *SessionWhereInput) P() (predicate.Session, error) {
+	var predicates []predicate.Session
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, session.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Session, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, session.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Session, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, session.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, session.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, session.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, session.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, session.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, session.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, session.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, session.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, session.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, session.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, session.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, session.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, session.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, session.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, session.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, session.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, session.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, session.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, session.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, session.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, session.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, session.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, session.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, session.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, session.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, session.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, session.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, session.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, session.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, session.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, session.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, session.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, session.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, session.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, session.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, session.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, session.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, session.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, session.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, session.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, session.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, session.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, session.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, session.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, session.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, session.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, session.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, session.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, session.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, session.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, session.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, session.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, session.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, session.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, session.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, session.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, session.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.SessionToken != nil {
+		predicates = append(predicates, session.SessionTokenEQ(*i.SessionToken))
+	}
+	if i.SessionTokenNEQ != nil {
+		predicates = append(predicates, session.SessionTokenNEQ(*i.SessionTokenNEQ))
+	}
+	if len(i.SessionTokenIn) > 0 {
+		predicates = append(predicates, session.SessionTokenIn(i.SessionTokenIn...))
+	}
+	if len(i.SessionTokenNotIn) > 0 {
+		predicates = append(predicates, session.SessionTokenNotIn(i.SessionTokenNotIn...))
+	}
+	if i.SessionTokenGT != nil {
+		predicates = append(predicates, session.SessionTokenGT(*i.SessionTokenGT))
+	}
+	if i.SessionTokenGTE != nil {
+		predicates = append(predicates, session.SessionTokenGTE(*i.SessionTokenGTE))
+	}
+	if i.SessionTokenLT != nil {
+		predicates = append(predicates, session.SessionTokenLT(*i.SessionTokenLT))
+	}
+	if i.SessionTokenLTE != nil {
+		predicates = append(predicates, session.SessionTokenLTE(*i.SessionTokenLTE))
+	}
+	if i.SessionTokenContains != nil {
+		predicates = append(predicates, session.SessionTokenContains(*i.SessionTokenContains))
+	}
+	if i.SessionTokenHasPrefix != nil {
+		predicates = append(predicates, session.SessionTokenHasPrefix(*i.SessionTokenHasPrefix))
+	}
+	if i.SessionTokenHasSuffix != nil {
+		predicates = append(predicates, session.SessionTokenHasSuffix(*i.SessionTokenHasSuffix))
+	}
+	if i.SessionTokenEqualFold != nil {
+		predicates = append(predicates, session.SessionTokenEqualFold(*i.SessionTokenEqualFold))
+	}
+	if i.SessionTokenContainsFold != nil {
+		predicates = append(predicates, session.SessionTokenContainsFold(*i.SessionTokenContainsFold))
+	}
+	if i.IssuedAt != nil {
+		predicates = append(predicates, session.IssuedAtEQ(*i.IssuedAt))
+	}
+	if i.IssuedAtNEQ != nil {
+		predicates = append(predicates, session.IssuedAtNEQ(*i.IssuedAtNEQ))
+	}
+	if len(i.IssuedAtIn) > 0 {
+		predicates = append(predicates, session.IssuedAtIn(i.IssuedAtIn...))
+	}
+	if len(i.IssuedAtNotIn) > 0 {
+		predicates = append(predicates, session.IssuedAtNotIn(i.IssuedAtNotIn...))
+	}
+	if i.IssuedAtGT != nil {
+		predicates = append(predicates, session.IssuedAtGT(*i.IssuedAtGT))
+	}
+	if i.IssuedAtGTE != nil {
+		predicates = append(predicates, session.IssuedAtGTE(*i.IssuedAtGTE))
+	}
+	if i.IssuedAtLT != nil {
+		predicates = append(predicates, session.IssuedAtLT(*i.IssuedAtLT))
+	}
+	if i.IssuedAtLTE != nil {
+		predicates = append(predicates, session.IssuedAtLTE(*i.IssuedAtLTE))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, session.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, session.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, session.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, session.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, session.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, session.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, session.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, session.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.OrganizationID != nil {
+		predicates = append(predicates, session.OrganizationIDEQ(*i.OrganizationID))
+	}
+	if i.OrganizationIDNEQ != nil {
+		predicates = append(predicates, session.OrganizationIDNEQ(*i.OrganizationIDNEQ))
+	}
+	if len(i.OrganizationIDIn) > 0 {
+		predicates = append(predicates, session.OrganizationIDIn(i.OrganizationIDIn...))
+	}
+	if len(i.OrganizationIDNotIn) > 0 {
+		predicates = append(predicates, session.OrganizationIDNotIn(i.OrganizationIDNotIn...))
+	}
+	if i.OrganizationIDGT != nil {
+		predicates = append(predicates, session.OrganizationIDGT(*i.OrganizationIDGT))
+	}
+	if i.OrganizationIDGTE != nil {
+		predicates = append(predicates, session.OrganizationIDGTE(*i.OrganizationIDGTE))
+	}
+	if i.OrganizationIDLT != nil {
+		predicates = append(predicates, session.OrganizationIDLT(*i.OrganizationIDLT))
+	}
+	if i.OrganizationIDLTE != nil {
+		predicates = append(predicates, session.OrganizationIDLTE(*i.OrganizationIDLTE))
+	}
+	if i.OrganizationIDContains != nil {
+		predicates = append(predicates, session.OrganizationIDContains(*i.OrganizationIDContains))
+	}
+	if i.OrganizationIDHasPrefix != nil {
+		predicates = append(predicates, session.OrganizationIDHasPrefix(*i.OrganizationIDHasPrefix))
+	}
+	if i.OrganizationIDHasSuffix != nil {
+		predicates = append(predicates, session.OrganizationIDHasSuffix(*i.OrganizationIDHasSuffix))
+	}
+	if i.OrganizationIDIsNil {
+		predicates = append(predicates, session.OrganizationIDIsNil())
+	}
+	if i.OrganizationIDNotNil {
+		predicates = append(predicates, session.OrganizationIDNotNil())
+	}
+	if i.OrganizationIDEqualFold != nil {
+		predicates = append(predicates, session.OrganizationIDEqualFold(*i.OrganizationIDEqualFold))
+	}
+	if i.OrganizationIDContainsFold != nil {
+		predicates = append(predicates, session.OrganizationIDContainsFold(*i.OrganizationIDContainsFold))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, session.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, session.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, session.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, session.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, session.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, session.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, session.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, session.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, session.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, session.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, session.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, session.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, session.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.UserAgent != nil {
+		predicates = append(predicates, session.UserAgentEQ(*i.UserAgent))
+	}
+	if i.UserAgentNEQ != nil {
+		predicates = append(predicates, session.UserAgentNEQ(*i.UserAgentNEQ))
+	}
+	if len(i.UserAgentIn) > 0 {
+		predicates = append(predicates, session.UserAgentIn(i.UserAgentIn...))
+	}
+	if len(i.UserAgentNotIn) > 0 {
+		predicates = append(predicates, session.UserAgentNotIn(i.UserAgentNotIn...))
+	}
+	if i.UserAgentGT != nil {
+		predicates = append(predicates, session.UserAgentGT(*i.UserAgentGT))
+	}
+	if i.UserAgentGTE != nil {
+		predicates = append(predicates, session.UserAgentGTE(*i.UserAgentGTE))
+	}
+	if i.UserAgentLT != nil {
+		predicates = append(predicates, session.UserAgentLT(*i.UserAgentLT))
+	}
+	if i.UserAgentLTE != nil {
+		predicates = append(predicates, session.UserAgentLTE(*i.UserAgentLTE))
+	}
+	if i.UserAgentContains != nil {
+		predicates = append(predicates, session.UserAgentContains(*i.UserAgentContains))
+	}
+	if i.UserAgentHasPrefix != nil {
+		predicates = append(predicates, session.UserAgentHasPrefix(*i.UserAgentHasPrefix))
+	}
+	if i.UserAgentHasSuffix != nil {
+		predicates = append(predicates, session.UserAgentHasSuffix(*i.UserAgentHasSuffix))
+	}
+	if i.UserAgentIsNil {
+		predicates = append(predicates, session.UserAgentIsNil())
+	}
+	if i.UserAgentNotNil {
+		predicates = append(predicates, session.UserAgentNotNil())
+	}
+	if i.UserAgentEqualFold != nil {
+		predicates = append(predicates, session.UserAgentEqualFold(*i.UserAgentEqualFold))
+	}
+	if i.UserAgentContainsFold != nil {
+		predicates = append(predicates, session.UserAgentContainsFold(*i.UserAgentContainsFold))
+	}
+	if i.IPAddress != nil {
+		predicates = append(predicates, session.IPAddressEQ(*i.IPAddress))
+	}
+	if i.IPAddressNEQ != nil {
+		predicates = append(predicates, session.IPAddressNEQ(*i.IPAddressNEQ))
+	}
+	if len(i.IPAddressIn) > 0 {
+		predicates = append(predicates, session.IPAddressIn(i.IPAddressIn...))
+	}
+	if len(i.IPAddressNotIn) > 0 {
+		predicates = append(predicates, session.IPAddressNotIn(i.IPAddressNotIn...))
+	}
+	if i.IPAddressGT != nil {
+		predicates = append(predicates, session.IPAddressGT(*i.IPAddressGT))
+	}
+	if i.IPAddressGTE != nil {
+		predicates = append(predicates, session.IPAddressGTE(*i.IPAddressGTE))
+	}
+	if i.IPAddressLT != nil {
+		predicates = append(predicates, session.IPAddressLT(*i.IPAddressLT))
+	}
+	if i.IPAddressLTE != nil {
+		predicates = append(predicates, session.IPAddressLTE(*i.IPAddressLTE))
+	}
+	if i.IPAddressContains != nil {
+		predicates = append(predicates, session.IPAddressContains(*i.IPAddressContains))
+	}
+	if i.IPAddressHasPrefix != nil {
+		predicates = append(predicates, session.IPAddressHasPrefix(*i.IPAddressHasPrefix))
+	}
+	if i.IPAddressHasSuffix != nil {
+		predicates = append(predicates, session.IPAddressHasSuffix(*i.IPAddressHasSuffix))
+	}
+	if i.IPAddressIsNil {
+		predicates = append(predicates, session.IPAddressIsNil())
+	}
+	if i.IPAddressNotNil {
+		predicates = append(predicates, session.IPAddressNotNil())
+	}
+	if i.IPAddressEqualFold != nil {
+		predicates = append(predicates, session.IPAddressEqualFold(*i.IPAddressEqualFold))
+	}
+	if i.IPAddressContainsFold != nil {
+		predicates = append(predicates, session.IPAddressContainsFold(*i.IPAddressContainsFold))
+	}
+	if i.LastSeenAt != nil {
+		predicates = append(predicates, session.LastSeenAtEQ(*i.LastSeenAt))
+	}
+	if i.LastSeenAtNEQ != nil {
+		predicates = append(predicates, session.LastSeenAtNEQ(*i.LastSeenAtNEQ))
+	}
+	if len(i.LastSeenAtIn) > 0 {
+		predicates = append(predicates, session.LastSeenAtIn(i.LastSeenAtIn...))
+	}
+	if len(i.LastSeenAtNotIn) > 0 {
+		predicates = append(predicates, session.LastSeenAtNotIn(i.LastSeenAtNotIn...))
+	}
+	if i.LastSeenAtGT != nil {
+		predicates = append(predicates, session.LastSeenAtGT(*i.LastSeenAtGT))
+	}
+	if i.LastSeenAtGTE != nil {
+		predicates = append(predicates, session.LastSeenAtGTE(*i.LastSeenAtGTE))
+	}
+	if i.LastSeenAtLT != nil {
+		predicates = append(predicates, session.LastSeenAtLT(*i.LastSeenAtLT))
+	}
+	if i.LastSeenAtLTE != nil {
+		predicates = append(predicates, session.LastSeenAtLTE(*i.LastSeenAtLTE))
+	}
+	if i.LastSeenAtIsNil {
+		predicates = append(predicates, session.LastSeenAtIsNil())
+	}
+	if i.LastSeenAtNotNil {
+		predicates = append(predicates, session.LastSeenAtNotNil())
+	}
+	if i.RevokedAt != nil {
+		predicates = append(predicates, session.RevokedAtEQ(*i.RevokedAt))
+	}
+	if i.RevokedAtNEQ != nil {
+		predicates = append(predicates, session.RevokedAtNEQ(*i.RevokedAtNEQ))
+	}
+	if len(i.RevokedAtIn) > 0 {
+		predicates = append(predicates, session.RevokedAtIn(i.RevokedAtIn...))
+	}
+	if len(i.RevokedAtNotIn) > 0 {
+		predicates = append(predicates, session.RevokedAtNotIn(i.RevokedAtNotIn...))
+	}
+	if i.RevokedAtGT != nil {
+		predicates = append(predicates, session.RevokedAtGT(*i.RevokedAtGT))
+	}
+	if i.RevokedAtGTE != nil {
+		predicates = append(predicates, session.RevokedAtGTE(*i.RevokedAtGTE))
+	}
+	if i.RevokedAtLT != nil {
+		predicates = append(predicates, session.RevokedAtLT(*i.RevokedAtLT))
+	}
+	if i.RevokedAtLTE != nil {
+		predicates = append(predicates, session.RevokedAtLTE(*i.RevokedAtLTE))
+	}
+	if i.RevokedAtIsNil {
+		predicates = append(predicates, session.RevokedAtIsNil())
+	}
+	if i.RevokedAtNotNil {
+		predicates = append(predicates, session.RevokedAtNotNil())
+	}
+
+	if i.HasOwner != nil {
+		p := session.HasOwner()
+		if !*i.HasOwner {
+			p = session.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, session.HasOwnerWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySessionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return session.And(predicates...), nil
+	}
+}
+
+// UserWhereInput represents a where input for filtering User queries.
+type UserWhereInput struct {
+	Predicates []predicate.User  `json:"-"`
+	Not        *UserWhereInput   `json:"not,omitempty"`
+	Or         []*UserWhereInput `json:"or,omitempty"`
+	And        []*UserWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "email" field predicates.
+	Email             *string  `json:"email,omitempty"`
+	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGT           *string  `json:"emailGT,omitempty"`
+	EmailGTE          *string  `json:"emailGTE,omitempty"`
+	EmailLT           *string  `json:"emailLT,omitempty"`
+	EmailLTE          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+
+	// "first_name" field predicates.
+	FirstName             *string  `json:"firstName,omitempty"`
+	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
+	FirstNameIn           []string `json:"firstNameIn,omitempty"`
+	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
+	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
+	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
+	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
+	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
+	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
+	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
+	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
+	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
+	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
+
+	// "last_name" field predicates.
+	LastName             *string  `json:"lastName,omitempty"`
+	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
+	LastNameIn           []string `json:"lastNameIn,omitempty"`
+	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
+	LastNameGT           *string  `json:"lastNameGT,omitempty"`
+	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
+	LastNameLT           *string  `json:"lastNameLT,omitempty"`
+	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
+	LastNameContains     *string  `json:"lastNameContains,omitempty"`
+	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
+	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
+	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
+	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
+
+	// "display_name" field predicates.
+	DisplayName             *string  `json:"displayName,omitempty"`
+	DisplayNameNEQ          *string  `json:"displayNameNEQ,omitempty"`
+	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
+	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
+	DisplayNameGT           *string  `json:"displayNameGT,omitempty"`
+	DisplayNameGTE          *string  `json:"displayNameGTE,omitempty"`
+	DisplayNameLT           *string  `json:"displayNameLT,omitempty"`
+	DisplayNameLTE          *string  `json:"displayNameLTE,omitempty"`
+	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
+	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
+	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
+	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
+	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
+
+	// "avatar_remote_url" field predicates.
+	AvatarRemoteURL             *string  `json:"avatarRemoteURL,omitempty"`
+	AvatarRemoteURLNEQ          *string  `json:"avatarRemoteURLNEQ,omitempty"`
+	AvatarRemoteURLIn           []string `json:"avatarRemoteURLIn,omitempty"`
+	AvatarRemoteURLNotIn        []string `json:"avatarRemoteURLNotIn,omitempty"`
+	AvatarRemoteURLGT           *string  `json:"avatarRemoteURLGT,omitempty"`
+	AvatarRemoteURLGTE          *string  `json:"avatarRemoteURLGTE,omitempty"`
+	AvatarRemoteURLLT           *string  `json:"avatarRemoteURLLT,omitempty"`
+	AvatarRemoteURLLTE          *string  `json:"avatarRemoteURLLTE,omitempty"`
+	AvatarRemoteURLContains     *string  `json:"avatarRemoteURLContains,omitempty"`
+	AvatarRemoteURLHasPrefix    *string  `json:"avatarRemoteURLHasPrefix,omitempty"`
+	AvatarRemoteURLHasSuffix    *string  `json:"avatarRemoteURLHasSuffix,omitempty"`
+	AvatarRemoteURLIsNil        bool     `json:"avatarRemoteURLIsNil,omitempty"`
+	AvatarRemoteURLNotNil       bool     `json:"avatarRemoteURLNotNil,omitempty"`
+	AvatarRemoteURLEqualFold    *string  `json:"avatarRemoteURLEqualFold,omitempty"`
+	AvatarRemoteURLContainsFold *string  `json:"avatarRemoteURLContainsFold,omitempty"`
+
+	// "avatar_local_file" field predicates.
+	AvatarLocalFile             *string  `json:"avatarLocalFile,omitempty"`
+	AvatarLocalFileNEQ          *string  `json:"avatarLocalFileNEQ,omitempty"`
+	AvatarLocalFileIn           []string `json:"avatarLocalFileIn,omitempty"`
+	AvatarLocalFileNotIn        []string `json:"avatarLocalFileNotIn,omitempty"`
+	AvatarLocalFileGT           *string  `json:"avatarLocalFileGT,omitempty"`
+	AvatarLocalFileGTE          *string  `json:"avatarLocalFileGTE,omitempty"`
+	AvatarLocalFileLT           *string  `json:"avatarLocalFileLT,omitempty"`
+	AvatarLocalFileLTE          *string  `json:"avatarLocalFileLTE,omitempty"`
+	AvatarLocalFileContains     *string  `json:"avatarLocalFileContains,omitempty"`
+	AvatarLocalFileHasPrefix    *string  `json:"avatarLocalFileHasPrefix,omitempty"`
+	AvatarLocalFileHasSuffix    *string  `json:"avatarLocalFileHasSuffix,omitempty"`
+	AvatarLocalFileIsNil        bool     `json:"avatarLocalFileIsNil,omitempty"`
+	AvatarLocalFileNotNil       bool     `json:"avatarLocalFileNotNil,omitempty"`
+	AvatarLocalFileEqualFold    *string  `json:"avatarLocalFileEqualFold,omitempty"`
+	AvatarLocalFileContainsFold *string  `json:"avatarLocalFileContainsFold,omitempty"`
+
+	// "avatar_updated_at" field predicates.
+	AvatarUpdatedAt       *time.Time  `json:"avatarUpdatedAt,omitempty"`
+	AvatarUpdatedAtNEQ    *time.Time  `json:"avatarUpdatedAtNEQ,omitempty"`
+	AvatarUpdatedAtIn     []time.Time `json:"avatarUpdatedAtIn,omitempty"`
+	AvatarUpdatedAtNotIn  []time.Time `json:"avatarUpdatedAtNotIn,omitempty"`
+	AvatarUpdatedAtGT     *time.Time  `json:"avatarUpdatedAtGT,omitempty"`
+	AvatarUpdatedAtGTE    *time.Time  `json:"avatarUpdatedAtGTE,omitempty"`
+	AvatarUpdatedAtLT     *time.Time  `json:"avatarUpdatedAtLT,omitempty"`
+	AvatarUpdatedAtLTE    *time.Time  `json:"avatarUpdatedAtLTE,omitempty"`
+	AvatarUpdatedAtIsNil  bool        `json:"avatarUpdatedAtIsNil,omitempty"`
+	AvatarUpdatedAtNotNil bool        `json:"avatarUpdatedAtNotNil,omitempty"`
+
+	// "last_seen" field predicates.
+	LastSeen       *time.Time  `json:"lastSeen,omitempty"`
+	LastSeenNEQ    *time.Time  `json:"lastSeenNEQ,omitempty"`
+	LastSeenIn     []time.Time `json:"lastSeenIn,omitempty"`
+	LastSeenNotIn  []time.Time `json:"lastSeenNotIn,omitempty"`
+	LastSeenGT     *time.Time  `json:"lastSeenGT,omitempty"`
+	LastSeenGTE    *time.Time  `json:"lastSeenGTE,omitempty"`
+	LastSeenLT     *time.Time  `json:"lastSeenLT,omitempty"`
+	LastSeenLTE    *time.Time  `json:"lastSeenLTE,omitempty"`
+	LastSeenIsNil  bool        `json:"lastSeenIsNil,omitempty"`
+	LastSeenNotNil bool        `json:"lastSeenNotNil,omitempty"`
+
+	// "password" field predicates.
+	Password             *string  `json:"password,omitempty"`
+	PasswordNEQ          *string  `json:"passwordNEQ,omitempty"`
+	PasswordIn           []string `json:"passwordIn,omitempty"`
+	PasswordNotIn        []string `json:"passwordNotIn,omitempty"`
+	PasswordGT           *string  `json:"passwordGT,omitempty"`
+	PasswordGTE          *string  `json:"passwordGTE,omitempty"`
+	PasswordLT           *string  `json:"passwordLT,omitempty"`
+	PasswordLTE          *string  `json:"passwordLTE,omitempty"`
+	PasswordContains     *string  `json:"passwordContains,omitempty"`
+	PasswordHasPrefix    *string  `json:"passwordHasPrefix,omitempty"`
+	PasswordHasSuffix    *string  `json:"passwordHasSuffix,omitempty"`
+	PasswordIsNil        bool     `json:"passwordIsNil,omitempty"`
+	PasswordNotNil       bool     `json:"passwordNotNil,omitempty"`
+	PasswordEqualFold    *string  `json:"passwordEqualFold,omitempty"`
+	PasswordContainsFold *string  `json:"passwordContainsFold,omitempty"`
+
+	// "password_changed_at" field predicates.
+	PasswordChangedAt       *time.Time  `json:"passwordChangedAt,omitempty"`
+	PasswordChangedAtNEQ    *time.Time  `json:"passwordChangedAtNEQ,omitempty"`
+	PasswordChangedAtIn     []time.Time `json:"passwordChangedAtIn,omitempty"`
+	PasswordChangedAtNotIn  []time.Time `json:"passwordChangedAtNotIn,omitempty"`
+	PasswordChangedAtGT     *time.Time  `json:"passwordChangedAtGT,omitempty"`
+	PasswordChangedAtGTE    *time.Time  `json:"passwordChangedAtGTE,omitempty"`
+	PasswordChangedAtLT     *time.Time  `json:"passwordChangedAtLT,omitempty"`
+	PasswordChangedAtLTE    *time.Time  `json:"passwordChangedAtLTE,omitempty"`
+	PasswordChangedAtIsNil  bool        `json:"passwordChangedAtIsNil,omitempty"`
+	PasswordChangedAtNotNil bool        `json:"passwordChangedAtNotNil,omitempty"`
+
+	// "sub" field predicates.
+	Sub             *string  `json:"sub,omitempty"`
+	SubNEQ          *string  `json:"subNEQ,omitempty"`
+	SubIn           []string `json:"subIn,omitempty"`
+	SubNotIn        []string `json:"subNotIn,omitempty"`
+	SubGT           *string  `json:"subGT,omitempty"`
+	SubGTE          *string  `json:"subGTE,omitempty"`
+	SubLT           *string  `json:"subLT,omitempty"`
+	SubLTE          *string  `json:"subLTE,omitempty"`
+	SubContains     *string  `json:"subContains,omitempty"`
+	SubHasPrefix    *string  `json:"subHasPrefix,omitempty"`
+	SubHasSuffix    *string  `json:"subHasSuffix,omitempty"`
+	SubIsNil        bool     `json:"subIsNil,omitempty"`
+	SubNotNil       bool     `json:"subNotNil,omitempty"`
+	SubEqualFold    *string  `json:"subEqualFold,omitempty"`
+	SubContainsFold *string  `json:"subContainsFold,omitempty"`
+
+	// "oauth" field predicates.
+	Oauth    *bool `json:"oauth,omitempty"`
+	OauthNEQ *bool `json:"oauthNEQ,omitempty"`
+
+	// "organizations" edge predicates.
+	HasOrganizations     *bool                     `json:"hasOrganizations,omitempty"`
+	HasOrganizationsWith []*OrganizationWhereInput `json:"hasOrganizationsWith,omitempty"`
+
+	// "sessions" edge predicates.
+	HasSessions     *bool                `json:"hasSessions,omitempty"`
+	HasSessionsWith []*SessionWhereInput `json:"hasSessionsWith,omitempty"`
+
+	// "groups" edge predicates.
+	HasGroups     *bool              `json:"hasGroups,omitempty"`
+	HasGroupsWith []*GroupWhereInput `json:"hasGroupsWith,omitempty"`
+
+	// "personal_access_tokens" edge predicates.
+	HasPersonalAccessTokens     *bool                            `json:"hasPersonalAccessTokens,omitempty"`
+	HasPersonalAccessTokensWith []*PersonalAccessTokenWhereInput `json:"hasPersonalAccessTokensWith,omitempty"`
+
+	// "setting" edge predicates.
+	HasSetting     *bool                    `json:"hasSetting,omitempty"`
+	HasSettingWith []*UserSettingWhereInput `json:"hasSettingWith,omitempty"`
+
+	// "webauthn_credentials" edge predicates.
+	HasWebauthnCredentials     *bool                           `json:"hasWebauthnCredentials,omitempty"`
+	HasWebauthnCredentialsWith []*WebauthnCredentialWhereInput `json:"hasWebauthnCredentialsWith,omitempty"`
+
+	// "org_memberships" edge predicates.
+	HasOrgMemberships     *bool                      `json:"hasOrgMemberships,omitempty"`
+	HasOrgMembershipsWith []*OrgMembershipWhereInput `json:"hasOrgMembershipsWith,omitempty"`
+
+	// "group_memberships" edge predicates.
+	HasGroupMemberships     *bool                        `json:"hasGroupMemberships,omitempty"`
+	HasGroupMembershipsWith []*GroupMembershipWhereInput `json:"hasGroupMembershipsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserWhereInput) AddPredicates(predicates ...predicate.User) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserWhereInput filter on the UserQuery builder.
+func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserWhereInput is returned in case the UserWhereInput is empty.
+var ErrEmptyUserWhereInput = errors.New("generated: empty predicate UserWhereInput")
+
+// P returns a predicate for filtering users.
+// An error is returned if the input is empty or invalid.
+func (i *UserWhereInput) P() (predicate.User, error) {
+	var predicates []predicate.User
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, user.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.User, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, user.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.User, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, user.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, user.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, user.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, user.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, user.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, user.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, user.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, user.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, user.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, user.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, user.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, user.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, user.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, user.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, user.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, user.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, user.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, user.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, user.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, user.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, user.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, user.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, user.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, user.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, user.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, user.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, user.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, user.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, user.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, user.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, user.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, user.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, user.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, user.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, user.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, user.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, user.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, user.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, user.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, user.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, user.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, user.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, user.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, user.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, user.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, user.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, user.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, user.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, user.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, user.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, user.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, user.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, user.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, user.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, user.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, user.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, user.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, user.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, user.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, user.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, user.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, user.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, user.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, user.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, user.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, user.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, user.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, user.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, user.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, user.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, user.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, user.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, user.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, user.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, user.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, user.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, user.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, user.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, user.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, user.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, user.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.Email != nil {
+		predicates = append(predicates, user.EmailEQ(*i.Email))
+	}
+	if i.EmailNEQ != nil {
+		predicates = append(predicates, user.EmailNEQ(*i.EmailNEQ))
+	}
+	if len(i.EmailIn) > 0 {
+		predicates = append(predicates, user.EmailIn(i.EmailIn...))
+	}
+	if len(i.EmailNotIn) > 0 {
+		predicates = append(predicates, user.EmailNotIn(i.EmailNotIn...))
+	}
+	if i.EmailGT != nil {
+		predicates = append(predicates, user.EmailGT(*i.EmailGT))
+	}
+	if i.EmailGTE != nil {
+		predicates = append(predicates, user.EmailGTE(*i.EmailGTE))
+	}
+	if i.EmailLT != nil {
+		predicates = append(predicates, user.EmailLT(*i.EmailLT))
+	}
+	if i.EmailLTE != nil {
+		predicates = append(predicates, user.EmailLTE(*i.EmailLTE))
+	}
+	if i.EmailContains != nil {
+		predicates = append(predicates, user.EmailContains(*i.EmailContains))
+	}
+	if i.EmailHasPrefix != nil {
+		predicates = append(predicates, user.EmailHasPrefix(*i.EmailHasPrefix))
+	}
+	if i.EmailHasSuffix != nil {
+		predicates = append(predicates, user.EmailHasSuffix(*i.EmailHasSuffix))
+	}
+	if i.EmailEqualFold != nil {
+		predicates = append(predicates, user.EmailEqualFold(*i.EmailEqualFold))
+	}
+	if i.EmailContainsFold != nil {
+		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
+	}
+	if i.FirstName != nil {
+		predicates = append(predicates, user.FirstNameEQ(*i.FirstName))
+	}
+	if i.FirstNameNEQ != nil {
+		predicates = append(predicates, user.FirstNameNEQ(*i.FirstNameNEQ))
+	}
+	if len(i.FirstNameIn) > 0 {
+		predicates = append(predicates, user.FirstNameIn(i.FirstNameIn...))
+	}
+	if len(i.FirstNameNotIn) > 0 {
+		predicates = append(predicates, user.FirstNameNotIn(i.FirstNameNotIn...))
+	}
+	if i.FirstNameGT != nil {
+		predicates = append(predicates, user.FirstNameGT(*i.FirstNameGT))
+	}
+	if i.FirstNameGTE != nil {
+		predicates = append(predicates, user.FirstNameGTE(*i.FirstNameGTE))
+	}
+	if i.FirstNameLT != nil {
+		predicates = append(predicates, user.FirstNameLT(*i.FirstNameLT))
+	}
+	if i.FirstNameLTE != nil {
+		predicates = append(predicates, user.FirstNameLTE(*i.FirstNameLTE))
+	}
+	if i.FirstNameContains != nil {
+		predicates = append(predicates, user.FirstNameContains(*i.FirstNameContains))
+	}
+	if i.FirstNameHasPrefix != nil {
+		predicates = append(predicates, user.FirstNameHasPrefix(*i.FirstNameHasPrefix))
+	}
+	if i.FirstNameHasSuffix != nil {
+		predicates = append(predicates, user.FirstNameHasSuffix(*i.FirstNameHasSuffix))
+	}
+	if i.FirstNameEqualFold != nil {
+		predicates = append(predicates, user.FirstNameEqualFold(*i.FirstNameEqualFold))
+	}
+	if i.FirstNameContainsFold != nil {
+		predicates = append(predicates, user.FirstNameContainsFold(*i.FirstNameContainsFold))
+	}
+	if i.LastName != nil {
+		predicates = append(predicates, user.LastNameEQ(*i.LastName))
+	}
+	if i.LastNameNEQ != nil {
+		predicates = append(predicates, user.LastNameNEQ(*i.LastNameNEQ))
+	}
+	if len(i.LastNameIn) > 0 {
+		predicates = append(predicates, user.LastNameIn(i.LastNameIn...))
+	}
+	if len(i.LastNameNotIn) > 0 {
+		predicates = append(predicates, user.LastNameNotIn(i.LastNameNotIn...))
+	}
+	if i.LastNameGT != nil {
+		predicates = append(predicates, user.LastNameGT(*i.LastNameGT))
+	}
+	if i.LastNameGTE != nil {
+		predicates = append(predicates, user.LastNameGTE(*i.LastNameGTE))
+	}
+	if i.LastNameLT != nil {
+		predicates = append(predicates, user.LastNameLT(*i.LastNameLT))
+	}
+	if i.LastNameLTE != nil {
+		predicates = append(predicates, user.LastNameLTE(*i.LastNameLTE))
+	}
+	if i.LastNameContains != nil {
+		predicates = append(predicates, user.LastNameContains(*i.LastNameContains))
+	}
+	if i.LastNameHasPrefix != nil {
+		predicates = append(predicates, user.LastNameHasPrefix(*i.LastNameHasPrefix))
+	}
+	if i.LastNameHasSuffix != nil {
+		predicates = append(predicates, user.LastNameHasSuffix(*i.LastNameHasSuffix))
+	}
+	if i.LastNameEqualFold != nil {
+		predicates = append(predicates, user.LastNameEqualFold(*i.LastNameEqualFold))
+	}
+	if i.LastNameContainsFold != nil {
+		predicates = append(predicates, user.LastNameContainsFold(*i.LastNameContainsFold))
+	}
+	if i.DisplayName != nil {
+		predicates = append(predicates, user.DisplayNameEQ(*i.DisplayName))
+	}
+	if i.DisplayNameNEQ != nil {
+		predicates = append(predicates, user.DisplayNameNEQ(*i.DisplayNameNEQ))
+	}
+	if len(i.DisplayNameIn) > 0 {
+		predicates = append(predicates, user.DisplayNameIn(i.DisplayNameIn...))
+	}
+	if len(i.DisplayNameNotIn) > 0 {
+		predicates = append(predicates, user.DisplayNameNotIn(i.DisplayNameNotIn...))
+	}
+	if i.DisplayNameGT != nil {
+		predicates = append(predicates, user.DisplayNameGT(*i.DisplayNameGT))
+	}
+	if i.DisplayNameGTE != nil {
+		predicates = append(predicates, user.DisplayNameGTE(*i.DisplayNameGTE))
+	}
+	if i.DisplayNameLT != nil {
+		predicates = append(predicates, user.DisplayNameLT(*i.DisplayNameLT))
+	}
+	if i.DisplayNameLTE != nil {
+		predicates = append(predicates, user.DisplayNameLTE(*i.DisplayNameLTE))
+	}
+	if i.DisplayNameContains != nil {
+		predicates = append(predicates, user.DisplayNameContains(*i.DisplayNameContains))
+	}
+	if i.DisplayNameHasPrefix != nil {
+		predicates = append(predicates, user.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	}
+	if i.DisplayNameHasSuffix != nil {
+		predicates = append(predicates, user.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	}
+	if i.DisplayNameEqualFold != nil {
+		predicates = append(predicates, user.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	}
+	if i.DisplayNameContainsFold != nil {
+		predicates = append(predicates, user.DisplayNameContainsFold(*i.DisplayNameContainsFold))
+	}
+	if i.AvatarRemoteURL != nil {
+		predicates = append(predicates, user.AvatarRemoteURLEQ(*i.AvatarRemoteURL))
+	}
+	if i.AvatarRemoteURLNEQ != nil {
+		predicates = append(predicates, user.AvatarRemoteURLNEQ(*i.AvatarRemoteURLNEQ))
+	}
+	if len(i.AvatarRemoteURLIn) > 0 {
+		predicates = append(predicates, user.AvatarRemoteURLIn(i.AvatarRemoteURLIn...))
+	}
+	if len(i.AvatarRemoteURLNotIn) > 0 {
+		predicates = append(predicates, user.AvatarRemoteURLNotIn(i.AvatarRemoteURLNotIn...))
+	}
+	if i.AvatarRemoteURLGT != nil {
+		predicates = append(predicates, user.AvatarRemoteURLGT(*i.AvatarRemoteURLGT))
+	}
+	if i.AvatarRemoteURLGTE != nil {
+		predicates = append(predicates, user.AvatarRemoteURLGTE(*i.AvatarRemoteURLGTE))
+	}
+	if i.AvatarRemoteURLLT != nil {
+		predicates = append(predicates, user.AvatarRemoteURLLT(*i.AvatarRemoteURLLT))
+	}
+	if i.AvatarRemoteURLLTE != nil {
+		predicates = append(predicates, user.AvatarRemoteURLLTE(*i.AvatarRemoteURLLTE))
+	}
+	if i.AvatarRemoteURLContains != nil {
+		predicates = append(predicates, user.AvatarRemoteURLContains(*i.AvatarRemoteURLContains))
+	}
+	if i.AvatarRemoteURLHasPrefix != nil {
+		predicates = append(predicates, user.AvatarRemoteURLHasPrefix(*i.AvatarRemoteURLHasPrefix))
+	}
+	if i.AvatarRemoteURLHasSuffix != nil {
+		predicates = append(predicates, user.AvatarRemoteURLHasSuffix(*i.AvatarRemoteURLHasSuffix))
+	}
+	if i.AvatarRemoteURLIsNil {
+		predicates = append(predicates, user.AvatarRemoteURLIsNil())
+	}
+	if i.AvatarRemoteURLNotNil {
+		predicates = append(predicates, user.AvatarRemoteURLNotNil())
+	}
+	if i.AvatarRemoteURLEqualFold != nil {
+		predicates = append(predicates, user.AvatarRemoteURLEqualFold(*i.AvatarRemoteURLEqualFold))
+	}
+	if i.AvatarRemoteURLContainsFold != nil {
+		predicates = append(predicates, user.AvatarRemoteURLContainsFold(*i.AvatarRemoteURLContainsFold))
+	}
+	if i.AvatarLocalFile != nil {
+		predicates = append(predicates, user.AvatarLocalFileEQ(*i.AvatarLocalFile))
+	}
+	if i.AvatarLocalFileNEQ != nil {
+		predicates = append(predicates, user.AvatarLocalFileNEQ(*i.AvatarLocalFileNEQ))
+	}
+	if len(i.AvatarLocalFileIn) > 0 {
+		predicates = append(predicates, user.AvatarLocalFileIn(i.AvatarLocalFileIn...))
+	}
+	if len(i.AvatarLocalFileNotIn) > 0 {
+		predicates = append(predicates, user.AvatarLocalFileNotIn(i.AvatarLocalFileNotIn...))
+	}
+	if i.AvatarLocalFileGT != nil {
+		predicates = append(predicates, user.AvatarLocalFileGT(*i.AvatarLocalFileGT))
+	}
+	if i.AvatarLocalFileGTE != nil {
+		predicates = append(predicates, user.AvatarLocalFileGTE(*i.AvatarLocalFileGTE))
+	}
+	if i.AvatarLocalFileLT != nil {
+		predicates = append(predicates, user.AvatarLocalFileLT(*i.AvatarLocalFileLT))
+	}
+	if i.AvatarLocalFileLTE != nil {
+		predicates = append(predicates, user.AvatarLocalFileLTE(*i.AvatarLocalFileLTE))
+	}
+	if i.AvatarLocalFileContains != nil {
+		predicates = append(predicates, user.AvatarLocalFileContains(*i.AvatarLocalFileContains))
+	}
+	if i.AvatarLocalFileHasPrefix != nil {
+		predicates = append(predicates, user.AvatarLocalFileHasPrefix(*i.AvatarLocalFileHasPrefix))
+	}
+	if i.AvatarLocalFileHasSuffix != nil {
+		predicates = append(predicates, user.AvatarLocalFileHasSuffix(*i.AvatarLocalFileHasSuffix))
+	}
+	if i.AvatarLocalFileIsNil {
+		predicates = append(predicates, user.AvatarLocalFileIsNil())
+	}
+	if i.AvatarLocalFileNotNil {
+		predicates = append(predicates, user.AvatarLocalFileNotNil())
+	}
+	if i.AvatarLocalFileEqualFold != nil {
+		predicates = append(predicates, user.AvatarLocalFileEqualFold(*i.AvatarLocalFileEqualFold))
+	}
+	if i.AvatarLocalFileContainsFold != nil {
+		predicates = append(predicates, user.AvatarLocalFileContainsFold(*i.AvatarLocalFileContainsFold))
+	}
+	if i.AvatarUpdatedAt != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtEQ(*i.AvatarUpdatedAt))
+	}
+	if i.AvatarUpdatedAtNEQ != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtNEQ(*i.AvatarUpdatedAtNEQ))
+	}
+	if len(i.AvatarUpdatedAtIn) > 0 {
+		predicates = append(predicates, user.AvatarUpdatedAtIn(i.AvatarUpdatedAtIn...))
+	}
+	if len(i.AvatarUpdatedAtNotIn) > 0 {
+		predicates = append(predicates, user.AvatarUpdatedAtNotIn(i.AvatarUpdatedAtNotIn...))
+	}
+	if i.AvatarUpdatedAtGT != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtGT(*i.AvatarUpdatedAtGT))
+	}
+	if i.AvatarUpdatedAtGTE != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtGTE(*i.AvatarUpdatedAtGTE))
+	}
+	if i.AvatarUpdatedAtLT != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtLT(*i.AvatarUpdatedAtLT))
+	}
+	if i.AvatarUpdatedAtLTE != nil {
+		predicates = append(predicates, user.AvatarUpdatedAtLTE(*i.AvatarUpdatedAtLTE))
+	}
+	if i.AvatarUpdatedAtIsNil {
+		predicates = append(predicates, user.AvatarUpdatedAtIsNil())
+	}
+	if i.AvatarUpdatedAtNotNil {
+		predicates = append(predicates, user.AvatarUpdatedAtNotNil())
+	}
+	if i.LastSeen != nil {
+		predicates = append(predicates, user.LastSeenEQ(*i.LastSeen))
+	}
+	if i.LastSeenNEQ != nil {
+		predicates = append(predicates, user.LastSeenNEQ(*i.LastSeenNEQ))
+	}
+	if len(i.LastSeenIn) > 0 {
+		predicates = append(predicates, user.LastSeenIn(i.LastSeenIn...))
+	}
+	if len(i.LastSeenNotIn) > 0 {
+		predicates = append(predicates, user.LastSeenNotIn(i.LastSeenNotIn...))
+	}
+	if i.LastSeenGT != nil {
+		predicates = append(predicates, user.LastSeenGT(*i.LastSeenGT))
+	}
+	if i.LastSeenGTE != nil {
+		predicates = append(predicates, user.LastSeenGTE(*i.LastSeenGTE))
+	}
+	if i.LastSeenLT != nil {
+		predicates = append(predicates, user.LastSeenLT(*i.LastSeenLT))
+	}
+	if i.LastSeenLTE != nil {
+		predicates = append(predicates, user.LastSeenLTE(*i.LastSeenLTE))
+	}
+	if i.LastSeenIsNil {
+		predicates = append(predicates, user.LastSeenIsNil())
+	}
+	if i.LastSeenNotNil {
+		predicates = append(predicates, user.LastSeenNotNil())
+	}
+	if i.Password != nil {
+		predicates = append(predicates, user.PasswordEQ(*i.Password))
+	}
+	if i.PasswordNEQ != nil {
+		predicates = append(predicates, user.PasswordNEQ(*i.PasswordNEQ))
+	}
+	if len(i.PasswordIn) > 0 {
+		predicates = append(predicates, user.PasswordIn(i.PasswordIn...))
+	}
+	if len(i.PasswordNotIn) > 0 {
+		predicates = append(predicates, user.PasswordNotIn(i.PasswordNotIn...))
+	}
+	if i.PasswordGT != nil {
+		predicates = append(predicates, user.PasswordGT(*i.PasswordGT))
+	}
+	if i.PasswordGTE != nil {
+		predicates = append(predicates, user.PasswordGTE(*i.PasswordGTE))
+	}
+	if i.PasswordLT != nil {
+		predicates = append(predicates, user.PasswordLT(*i.PasswordLT))
+	}
+	if i.PasswordLTE != nil {
+		predicates = append(predicates, user.PasswordLTE(*i.PasswordLTE))
+	}
+	if i.PasswordContains != nil {
+		predicates = append(predicates, user.PasswordContains(*i.PasswordContains))
+	}
+	if i.PasswordHasPrefix != nil {
+		predicates = append(predicates, user.PasswordHasPrefix(*i.PasswordHasPrefix))
+	}
+	if i.PasswordHasSuffix != nil {
+		predicates = append(predicates, user.PasswordHasSuffix(*i.PasswordHasSuffix))
+	}
+	if i.PasswordIsNil {
+		predicates = append(predicates, user.PasswordIsNil())
+	}
+	if i.PasswordNotNil {
+		predicates = append(predicates, user.PasswordNotNil())
+	}
+	if i.PasswordEqualFold != nil {
+		predicates = append(predicates, user.PasswordEqualFold(*i.PasswordEqualFold))
+	}
+	if i.PasswordContainsFold != nil {
+		predicates = append(predicates, user.PasswordContainsFold(*i.PasswordContainsFold))
+	}
+	if i.PasswordChangedAt != nil {
+		predicates = append(predicates, user.PasswordChangedAtEQ(*i.PasswordChangedAt))
+	}
+	if i.PasswordChangedAtNEQ != nil {
+		predicates = append(predicates, user.PasswordChangedAtNEQ(*i.PasswordChangedAtNEQ))
+	}
+	if len(i.PasswordChangedAtIn) > 0 {
+		predicates = append(predicates, user.PasswordChangedAtIn(i.PasswordChangedAtIn...))
+	}
+	if len(i.PasswordChangedAtNotIn) > 0 {
+		predicates = append(predicates, user.PasswordChangedAtNotIn(i.PasswordChangedAtNotIn...))
+	}
+	if i.PasswordChangedAtGT != nil {
+		predicates = append(predicates, user.PasswordChangedAtGT(*i.PasswordChangedAtGT))
+	}
+	if i.PasswordChangedAtGTE != nil {
+		predicates = append(predicates, user.PasswordChangedAtGTE(*i.PasswordChangedAtGTE))
+	}
+	if i.PasswordChangedAtLT != nil {
+		predicates = append(predicates, user.PasswordChangedAtLT(*i.PasswordChangedAtLT))
+	}
+	if i.PasswordChangedAtLTE != nil {
+		predicates = append(predicates, user.PasswordChangedAtLTE(*i.PasswordChangedAtLTE))
+	}
+	if i.PasswordChangedAtIsNil {
+		predicates = append(predicates, user.PasswordChangedAtIsNil())
+	}
+	if i.PasswordChangedAtNotNil {
+		predicates = append(predicates, user.PasswordChangedAtNotNil())
+	}
+	if i.Sub != nil {
+		predicates = append(predicates, user.SubEQ(*i.Sub))
+	}
+	if i.SubNEQ != nil {
+		predicates = append(predicates, user.SubNEQ(*i.SubNEQ))
+	}
+	if len(i.SubIn) > 0 {
+		predicates = append(predicates, user.SubIn(i.SubIn...))
+	}
+	if len(i.SubNotIn) > 0 {
+		predicates = append(predicates, user.SubNotIn(i.SubNotIn...))
+	}
+	if i.SubGT != nil {
+		predicates = append(predicates, user.SubGT(*i.SubGT))
+	}
+	if i.SubGTE != nil {
+		predicates = append(predicates, user.SubGTE(*i.SubGTE))
+	}
+	if i.SubLT != nil {
+		predicates = append(predicates, user.SubLT(*i.SubLT))
+	}
+	if i.SubLTE != nil {
+		predicates = append(predicates, user.SubLTE(*i.SubLTE))
+	}
+	if i.SubContains != nil {
+		predicates = append(predicates, user.SubContains(*i.SubContains))
+	}
+	if i.SubHasPrefix != nil {
+		predicates = append(predicates, user.SubHasPrefix(*i.SubHasPrefix))
+	}
+	if i.SubHasSuffix != nil {
+		predicates = append(predicates, user.SubHasSuffix(*i.SubHasSuffix))
+	}
+	if i.SubIsNil {
+		predicates = append(predicates, user.SubIsNil())
+	}
+	if i.SubNotNil {
+		predicates = append(predicates, user.SubNotNil())
+	}
+	if i.SubEqualFold != nil {
+		predicates = append(predicates, user.SubEqualFold(*i.SubEqualFold))
+	}
+	if i.SubContainsFold != nil {
+		predicates = append(predicates, user.SubContainsFold(*i.SubContainsFold))
+	}
+	if i.Oauth != nil {
+		predicates = append(predicates, user.OauthEQ(*i.Oauth))
+	}
+	if i.OauthNEQ != nil {
+		predicates = append(predicates, user.OauthNEQ(*i.OauthNEQ))
+	}
+
+	if i.HasOrganizations != nil {
+		p := user.HasOrganizations()
+		if !*i.HasOrganizations {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOrganizationsWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOrganizationsWith))
+		for _, w := range i.HasOrganizationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOrganizationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasOrganizationsWith(with...))
+	}
+	if i.HasSessions != nil {
+		p := user.HasSessions()
+		if !*i.HasSessions {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSessionsWith) > 0 {
+		with := make([]predicate.Session, 0, len(i.HasSessionsWith))
+		for _, w := range i.HasSessionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSessionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasSessionsWith(with...))
+	}
+	if i.HasGroups != nil {
+		p := user.HasGroups()
+		if !*i.HasGroups {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGroupsWith) > 0 {
+		with := make([]predicate.Group, 0, len(i.HasGroupsWith))
+		for _, w := range i.HasGroupsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasGroupsWith(with...))
+	}
+	if i.HasPersonalAccessTokens != nil {
+		p := user.HasPersonalAccessTokens()
+		if !*i.HasPersonalAccessTokens {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPersonalAccessTokensWith) > 0 {
+		with := make([]predicate.PersonalAccessToken, 0, len(i.HasPersonalAccessTokensWith))
+		for _, w := range i.HasPersonalAccessTokensWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPersonalAccessTokensWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasPersonalAccessTokensWith(with...))
+	}
+	if i.HasSetting != nil {
+		p := user.HasSetting()
+		if !*i.HasSetting {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettingWith) > 0 {
+		with := make([]predicate.UserSetting, 0, len(i.HasSettingWith))
+		for _, w := range i.HasSettingWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasSettingWith(with...))
+	}
+	if i.HasWebauthnCredentials != nil {
+		p := user.HasWebauthnCredentials()
+		if !*i.HasWebauthnCredentials {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWebauthnCredentialsWith) > 0 {
+		with := make([]predicate.WebauthnCredential, 0, len(i.HasWebauthnCredentialsWith))
+		for _, w := range i.HasWebauthnCredentialsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWebauthnCredentialsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasWebauthnCredentialsWith(with...))
+	}
+	if i.HasOrgMemberships != nil {
+		p := user.HasOrgMemberships()
+		if !*i.HasOrgMemberships {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOrgMembershipsWith) > 0 {
+		with := make([]predicate.OrgMembership, 0, len(i.HasOrgMembershipsWith))
+		for _, w := range i.HasOrgMembershipsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOrgMembershipsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasOrgMembershipsWith(with...))
+	}
+	if i.HasGroupMemberships != nil {
+		p := user.HasGroupMemberships()
+		if !*i.HasGroupMemberships {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGroupMembershipsWith) > 0 {
+		with := make([]predicate.GroupMembership, 0, len(i.HasGroupMembershipsWith))
+		for _, w := range i.HasGroupMembershipsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGroupMembershipsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasGroupMembershipsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyUserWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return user.And(predicates...), nil
+	}
+}
+
+// UserSettingWhereInput represents a where input for filtering UserSetting queries.
+type UserSettingWhereInput struct {
+	Predicates []predicate.UserSetting  `json:"-"`
+	Not        *UserSettingWhereInput   `json:"not,omitempty"`
+	Or         []*UserSettingWhereInput `json:"or,omitempty"`
+	And        []*UserSettingWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "locked" field predicates.
+	Locked    *bool `json:"locked,omitempty"`
+	LockedNEQ *bool `json:"lockedNEQ,omitempty"`
+
+	// "silenced_at" field predicates.
+	SilencedAt       *time.Time  `json:"silencedAt,omitempty"`
+	SilencedAtNEQ    *time.Time  `json:"silencedAtNEQ,omitempty"`
+	SilencedAtIn     []time.Time `json:"silencedAtIn,omitempty"`
+	SilencedAtNotIn  []time.Time `json:"silencedAtNotIn,omitempty"`
+	SilencedAtGT     *time.Time  `json:"silencedAtGT,omitempty"`
+	SilencedAtGTE    *time.Time  `json:"silencedAtGTE,omitempty"`
+	SilencedAtLT     *time.Time  `json:"silencedAtLT,omitempty"`
+	SilencedAtLTE    *time.Time  `json:"silencedAtLTE,omitempty"`
+	SilencedAtIsNil  bool        `json:"silencedAtIsNil,omitempty"`
+	SilencedAtNotNil bool        `json:"silencedAtNotNil,omitempty"`
+
+	// "suspended_at" field predicates.
+	SuspendedAt       *time.Time  `json:"suspendedAt,omitempty"`
+	SuspendedAtNEQ    *time.Time  `json:"suspendedAtNEQ,omitempty"`
+	SuspendedAtIn     []time.Time `json:"suspendedAtIn,omitempty"`
+	SuspendedAtNotIn  []time.Time `json:"suspendedAtNotIn,omitempty"`
+	SuspendedAtGT     *time.Time  `json:"suspendedAtGT,omitempty"`
+	SuspendedAtGTE    *time.Time  `json:"suspendedAtGTE,omitempty"`
+	SuspendedAtLT     *time.Time  `json:"suspendedAtLT,omitempty"`
+	SuspendedAtLTE    *time.Time  `json:"suspendedAtLTE,omitempty"`
+	SuspendedAtIsNil  bool        `json:"suspendedAtIsNil,omitempty"`
+	SuspendedAtNotNil bool        `json:"suspendedAtNotNil,omitempty"`
+
+	// "recovery_code" field predicates.
+	RecoveryCode             *string  `json:"recoveryCode,omitempty"`
+	RecoveryCodeNEQ          *string  `json:"recoveryCodeNEQ,omitempty"`
+	RecoveryCodeIn           []string `json:"recoveryCodeIn,omitempty"`
+	RecoveryCodeNotIn        []string `json:"recoveryCodeNotIn,omitempty"`
+	RecoveryCodeGT           *string  `json:"recoveryCodeGT,omitempty"`
+	RecoveryCodeGTE          *string  `json:"recoveryCodeGTE,omitempty"`
+	RecoveryCodeLT           *string  `json:"recoveryCodeLT,omitempty"`
+	RecoveryCodeLTE          *string  `json:"recoveryCodeLTE,omitempty"`
+	RecoveryCodeContains     *string  `json:"recoveryCodeContains,omitempty"`
+	RecoveryCodeHasPrefix    *string  `json:"recoveryCodeHasPrefix,omitempty"`
+	RecoveryCodeHasSuffix    *string  `json:"recoveryCodeHasSuffix,omitempty"`
+	RecoveryCodeIsNil        bool     `json:"recoveryCodeIsNil,omitempty"`
+	RecoveryCodeNotNil       bool     `json:"recoveryCodeNotNil,omitempty"`
+	RecoveryCodeEqualFold    *string  `json:"recoveryCodeEqualFold,omitempty"`
+	RecoveryCodeContainsFold *string  `json:"recoveryCodeContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *usersetting.Status  `json:"status,omitempty"`
+	StatusNEQ   *usersetting.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []usersetting.Status `json:"statusIn,omitempty"`
+	StatusNotIn []usersetting.Status `json:"statusNotIn,omitempty"`
+
+	// "role" field predicates.
+	Role      *usersetting.Role  `json:"role,omitempty"`
+	RoleNEQ   *usersetting.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []usersetting.Role `json:"roleIn,omitempty"`
+	RoleNotIn []usersetting.Role `json:"roleNotIn,omitempty"`
+
+	// "email_confirmed" field predicates.
+	EmailConfirmed    *bool `json:"emailConfirmed,omitempty"`
+	EmailConfirmedNEQ *bool `json:"emailConfirmedNEQ,omitempty"`
+
+	// "is_tfa_enabled" field predicates.
+	IsTfaEnabled    *bool `json:"isTfaEnabled,omitempty"`
+	IsTfaEnabledNEQ *bool `json:"isTfaEnabledNEQ,omitempty"`
+
+	// "failed_login_attempts" field predicates.
+	FailedLoginAttempts      *int  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNEQ   *int  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGT    *int  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGTE   *int  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLT    *int  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLTE   *int  `json:"failedLoginAttemptsLTE,omitempty"`
+
+	// "locked_until" field predicates.
+	LockedUntil       *time.Time  `json:"lockedUntil,omitempty"`
+	LockedUntilNEQ    *time.Time  `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGT     *time.Time  `json:"lockedUntilGT,omitempty"`
+	LockedUntilGTE    *time.Time  `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLT     *time.Time  `json:"lockedUntilLT,omitempty"`
+	LockedUntilLTE    *time.Time  `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil bool        `json:"lockedUntilNotNil,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserSettingWhereInput) AddPredicates(predicates ...predicate.UserSetting) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserSettingWhereInput filter on the UserSettingQuery builder.
+func (i *UserSettingWhereInput) Filter(q *UserSettingQuery) (*UserSettingQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserSettingWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.DeletedByLTE != nil {
-		predicates = append(predicates, user.DeletedByLTE(*i.DeletedByLTE))
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserSettingWhereInput is returned in case the UserSettingWhereInput is empty.
+var ErrEmptyUserSettingWhereInput = errors.New("generated: empty predicate UserSettingWhereInput")
+
+// P returns a predicate for filtering usersettings.
+// An error is returned if the input is empty or invalid.
+func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
+	var predicates []predicate.UserSetting
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, usersetting.Not(p))
 	}
-	if i.DeletedByContains != nil {
-		predicates = append(predicates, user.DeletedByContains(*i.DeletedByContains))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.UserSetting, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, usersetting.Or(or...))
 	}
-	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, user.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.UserSetting, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, usersetting.And(and...))
 	}
-	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, user.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, usersetting.IDEQ(*i.ID))
 	}
-	if i.DeletedByIsNil {
-		predicates = append(predicates, user.DeletedByIsNil())
+	if i.IDNEQ != nil {
+		predicates = append(predicates, usersetting.IDNEQ(*i.IDNEQ))
 	}
-	if i.DeletedByNotNil {
-		predicates = append(predicates, user.DeletedByNotNil())
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, usersetting.IDIn(i.IDIn...))
 	}
-	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, user.DeletedByEqualFold(*i.DeletedByEqualFold))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, usersetting.IDNotIn(i.IDNotIn...))
 	}
-	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, user.DeletedByContainsFold(*i.DeletedByContainsFold))
+	if i.IDGT != nil {
+		predicates = append(predicates, usersetting.IDGT(*i.IDGT))
 	}
-	if i.Email != nil {
-		predicates = append(predicates, user.EmailEQ(*i.Email))
+	if i.IDGTE != nil {
+		predicates = append(predicates, usersetting.IDGTE(*i.IDGTE))
 	}
-	if i.EmailNEQ != nil {
-		predicates = append(predicates, user.EmailNEQ(*i.EmailNEQ))
+	if i.IDLT != nil {
+		predicates = append(predicates, usersetting.IDLT(*i.IDLT))
 	}
-	if len(i.EmailIn) > 0 {
-		predicates = append(predicates, user.EmailIn(i.EmailIn...))
+	if i.IDLTE != nil {
+		predicates = append(predicates, usersetting.IDLTE(*i.IDLTE))
 	}
-	if len(i.EmailNotIn) > 0 {
-		predicates = append(predicates, user.EmailNotIn(i.EmailNotIn...))
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, usersetting.IDEqualFold(*i.IDEqualFold))
 	}
-	if i.EmailGT != nil {
-		predicates = append(predicates, user.EmailGT(*i.EmailGT))
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, usersetting.IDContainsFold(*i.IDContainsFold))
 	}
-	if i.EmailGTE != nil {
-		predicates = append(predicates, user.EmailGTE(*i.EmailGTE))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, usersetting.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.EmailLT != nil {
-		predicates = append(predicates, user.EmailLT(*i.EmailLT))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, usersetting.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.EmailLTE != nil {
-		predicates = append(predicates, user.EmailLTE(*i.EmailLTE))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, usersetting.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.EmailContains != nil {
-		predicates = append(predicates, user.EmailContains(*i.EmailContains))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, usersetting.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.EmailHasPrefix != nil {
-		predicates = append(predicates, user.EmailHasPrefix(*i.EmailHasPrefix))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, usersetting.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.EmailHasSuffix != nil {
-		predicates = append(predicates, user.EmailHasSuffix(*i.EmailHasSuffix))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, usersetting.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.EmailEqualFold != nil {
-		predicates = append(predicates, user.EmailEqualFold(*i.EmailEqualFold))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, usersetting.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.EmailContainsFold != nil {
-		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, usersetting.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.FirstName != nil {
-		predicates = append(predicates, user.FirstNameEQ(*i.FirstName))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, usersetting.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.FirstNameNEQ != nil {
-		predicates = append(predicates, user.FirstNameNEQ(*i.FirstNameNEQ))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, usersetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if len(i.FirstNameIn) > 0 {
-		predicates = append(predicates, user.FirstNameIn(i.FirstNameIn...))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, usersetting.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if len(i.FirstNameNotIn) > 0 {
-		predicates = append(predicates, user.FirstNameNotIn(i.FirstNameNotIn...))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, usersetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.FirstNameGT != nil {
-		predicates = append(predicates, user.FirstNameGT(*i.FirstNameGT))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, usersetting.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.FirstNameGTE != nil {
-		predicates = append(predicates, user.FirstNameGTE(*i.FirstNameGTE))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, usersetting.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.FirstNameLT != nil {
-		predicates = append(predicates, user.FirstNameLT(*i.FirstNameLT))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, usersetting.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.FirstNameLTE != nil {
-		predicates = append(predicates, user.FirstNameLTE(*i.FirstNameLTE))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, usersetting.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.FirstNameContains != nil {
-		predicates = append(predicates, user.FirstNameContains(*i.FirstNameContains))
+	if i.CreatedBy != nil {
+		predicates = append(predicates, usersetting.CreatedByEQ(*i.CreatedBy))
 	}
-	if i.FirstNameHasPrefix != nil {
-		predicates = append(predicates, user.FirstNameHasPrefix(*i.FirstNameHasPrefix))
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, usersetting.CreatedByNEQ(*i.CreatedByNEQ))
 	}
-	if i.FirstNameHasSuffix != nil {
-		predicates = append(predicates, user.FirstNameHasSuffix(*i.FirstNameHasSuffix))
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, usersetting.CreatedByIn(i.CreatedByIn...))
 	}
-	if i.FirstNameEqualFold != nil {
-		predicates = append(predicates, user.FirstNameEqualFold(*i.FirstNameEqualFold))
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, usersetting.CreatedByNotIn(i.CreatedByNotIn...))
 	}
-	if i.FirstNameContainsFold != nil {
-		predicates = append(predicates, user.FirstNameContainsFold(*i.FirstNameContainsFold))
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, usersetting.CreatedByGT(*i.CreatedByGT))
 	}
-	if i.LastName != nil {
-		predicates = append(predicates, user.LastNameEQ(*i.LastName))
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, usersetting.CreatedByGTE(*i.CreatedByGTE))
 	}
-	if i.LastNameNEQ != nil {
-		predicates = append(predicates, user.LastNameNEQ(*i.LastNameNEQ))
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, usersetting.CreatedByLT(*i.CreatedByLT))
 	}
-	if len(i.LastNameIn) > 0 {
-		predicates = append(predicates, user.LastNameIn(i.LastNameIn...))
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, usersetting.CreatedByLTE(*i.CreatedByLTE))
 	}
-	if len(i.LastNameNotIn) > 0 {
-		predicates = append(predicates, user.LastNameNotIn(i.LastNameNotIn...))
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, usersetting.CreatedByContains(*i.CreatedByContains))
 	}
-	if i.LastNameGT != nil {
-		predicates = append(predicates, user.LastNameGT(*i.LastNameGT))
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, usersetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
-	if i.LastNameGTE != nil {
-		predicates = append(predicates, user.LastNameGTE(*i.LastNameGTE))
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, usersetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
-	if i.LastNameLT != nil {
-		predicates = append(predicates, user.LastNameLT(*i.LastNameLT))
+	if i.CreatedByIsNil {
+		predicates = append(predicates, usersetting.CreatedByIsNil())
 	}
-	if i.LastNameLTE != nil {
-		predicates = append(predicates, user.LastNameLTE(*i.LastNameLTE))
+	if i.CreatedByNotNil {
+		predicates = append(predicates, usersetting.CreatedByNotNil())
 	}
-	if i.LastNameContains != nil {
-		predicates = append(predicates, user.LastNameContains(*i.LastNameContains))
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, usersetting.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
-	if i.LastNameHasPrefix != nil {
-		predicates = append(predicates, user.LastNameHasPrefix(*i.LastNameHasPrefix))
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, usersetting.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
-	if i.LastNameHasSuffix != nil {
-		predicates = append(predicates, user.LastNameHasSuffix(*i.LastNameHasSuffix))
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, usersetting.UpdatedByEQ(*i.UpdatedBy))
 	}
-	if i.LastNameEqualFold != nil {
-		predicates = append(predicates, user.LastNameEqualFold(*i.LastNameEqualFold))
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, usersetting.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
-	if i.LastNameContainsFold != nil {
-		predicates = append(predicates, user.LastNameContainsFold(*i.LastNameContainsFold))
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, usersetting.UpdatedByIn(i.UpdatedByIn...))
 	}
-	if i.DisplayName != nil {
-		predicates = append(predicates, user.DisplayNameEQ(*i.DisplayName))
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, usersetting.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
-	if i.DisplayNameNEQ != nil {
-		predicates = append(predicates, user.DisplayNameNEQ(*i.DisplayNameNEQ))
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, usersetting.UpdatedByGT(*i.UpdatedByGT))
 	}
-	if len(i.DisplayNameIn) > 0 {
-		predicates = append(predicates, user.DisplayNameIn(i.DisplayNameIn...))
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, usersetting.UpdatedByGTE(*i.UpdatedByGTE))
 	}
-	if len(i.DisplayNameNotIn) > 0 {
-		predicates = append(predicates, user.DisplayNameNotIn(i.DisplayNameNotIn...))
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, usersetting.UpdatedByLT(*i.UpdatedByLT))
 	}
-	if i.DisplayNameGT != nil {
-		predicates = append(predicates, user.DisplayNameGT(*i.DisplayNameGT))
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, usersetting.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, usersetting.UpdatedByContains(*i.UpdatedByContains))
 	}
-	if i.DisplayNameGTE != nil {
-		predicates = append(predicates, user.DisplayNameGTE(*i.DisplayNameGTE))
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, usersetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
-	if i.DisplayNameLT != nil {
-		predicates = append(predicates, user.DisplayNameLT(*i.DisplayNameLT))
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, usersetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
-	if i.DisplayNameLTE != nil {
-		predicates = append(predicates, user.DisplayNameLTE(*i.DisplayNameLTE))
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, usersetting.UpdatedByIsNil())
 	}
-	if i.DisplayNameContains != nil {
-		predicates = append(predicates, user.DisplayNameContains(*i.DisplayNameContains))
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, usersetting.UpdatedByNotNil())
 	}
-	if i.DisplayNameHasPrefix != nil {
-		predicates = append(predicates, user.DisplayNameHasPrefix(*i.DisplayNameHasPrefix))
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, usersetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
-	if i.DisplayNameHasSuffix != nil {
-		predicates = append(predicates, user.DisplayNameHasSuffix(*i.DisplayNameHasSuffix))
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, usersetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
-	if i.DisplayNameEqualFold != nil {
-		predicates = append(predicates, user.DisplayNameEqualFold(*i.DisplayNameEqualFold))
+	if i.DeletedAt != nil {
+		predicates = append(predicates, usersetting.DeletedAtEQ(*i.DeletedAt))
 	}
-	if i.DisplayNameContainsFold != nil {
-		predicates = append(predicates, user.DisplayNameContainsFold(*i.DisplayNameContainsFold))
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, usersetting.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
-	if i.AvatarRemoteURL != nil {
-		predicates = append(predicates, user.AvatarRemoteURLEQ(*i.AvatarRemoteURL))
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, usersetting.DeletedAtIn(i.DeletedAtIn...))
 	}
-	if i.AvatarRemoteURLNEQ != nil {
-		predicates = append(predicates, user.AvatarRemoteURLNEQ(*i.AvatarRemoteURLNEQ))
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, usersetting.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
-	if len(i.AvatarRemoteURLIn) > 0 {
-		predicates = append(predicates, user.AvatarRemoteURLIn(i.AvatarRemoteURLIn...))
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, usersetting.DeletedAtGT(*i.DeletedAtGT))
 	}
-	if len(i.AvatarRemoteURLNotIn) > 0 {
-		predicates = append(predicates, user.AvatarRemoteURLNotIn(i.AvatarRemoteURLNotIn...))
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, usersetting.DeletedAtGTE(*i.DeletedAtGTE))
 	}
-	if i.AvatarRemoteURLGT != nil {
-		predicates = append(predicates, user.AvatarRemoteURLGT(*i.AvatarRemoteURLGT))
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, usersetting.DeletedAtLT(*i.DeletedAtLT))
 	}
-	if i.AvatarRemoteURLGTE != nil {
-		predicates = append(predicates, user.AvatarRemoteURLGTE(*i.AvatarRemoteURLGTE))
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, usersetting.DeletedAtLTE(*i.DeletedAtLTE))
 	}
-	if i.AvatarRemoteURLLT != nil {
-		predicates = append(predicates, user.AvatarRemoteURLLT(*i.AvatarRemoteURLLT))
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, usersetting.DeletedAtIsNil())
 	}
-	if i.AvatarRemoteURLLTE != nil {
-		predicates = append(predicates, user.AvatarRemoteURLLTE(*i.AvatarRemoteURLLTE))
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, usersetting.DeletedAtNotNil())
 	}
-	if i.AvatarRemoteURLContains != nil {
-		predicates = append(predicates, user.AvatarRemoteURLContains(*i.AvatarRemoteURLContains))
+	if i.DeletedBy != nil {
+		predicates = append(predicates, usersetting.DeletedByEQ(*i.DeletedBy))
 	}
-	if i.AvatarRemoteURLHasPrefix != nil {
-		predicates = append(predicates, user.AvatarRemoteURLHasPrefix(*i.AvatarRemoteURLHasPrefix))
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, usersetting.DeletedByNEQ(*i.DeletedByNEQ))
 	}
-	if i.AvatarRemoteURLHasSuffix != nil {
-		predicates = append(predicates, user.AvatarRemoteURLHasSuffix(*i.AvatarRemoteURLHasSuffix))
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, usersetting.DeletedByIn(i.DeletedByIn...))
 	}
-	if i.AvatarRemoteURLIsNil {
-		predicates = append(predicates, user.AvatarRemoteURLIsNil())
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, usersetting.DeletedByNotIn(i.DeletedByNotIn...))
 	}
-	if i.AvatarRemoteURLNotNil {
-		predicates = append(predicates, user.AvatarRemoteURLNotNil())
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, usersetting.DeletedByGT(*i.DeletedByGT))
 	}
-	if i.AvatarRemoteURLEqualFold != nil {
-		predicates = append(predicates, user.AvatarRemoteURLEqualFold(*i.AvatarRemoteURLEqualFold))
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, usersetting.DeletedByGTE(*i.DeletedByGTE))
 	}
-	if i.AvatarRemoteURLContainsFold != nil {
-		predicates = append(predicates, user.AvatarRemoteURLContainsFold(*i.AvatarRemoteURLContainsFold))
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, usersetting.DeletedByLT(*i.DeletedByLT))
 	}
-	if i.AvatarLocalFile != nil {
-		predicates = append(predicates, user.AvatarLocalFileEQ(*i.AvatarLocalFile))
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, usersetting.DeletedByLTE(*i.DeletedByLTE))
 	}
-	if i.AvatarLocalFileNEQ != nil {
-		predicates = append(predicates, user.AvatarLocalFileNEQ(*i.AvatarLocalFileNEQ))
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, usersetting.DeletedByContains(*i.DeletedByContains))
 	}
-	if len(i.AvatarLocalFileIn) > 0 {
-		predicates = append(predicates, user.AvatarLocalFileIn(i.AvatarLocalFileIn...))
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, usersetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
-	if len(i.AvatarLocalFileNotIn) > 0 {
-		predicates = append(predicates, user.AvatarLocalFileNotIn(i.AvatarLocalFileNotIn...))
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, usersetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
-	if i.AvatarLocalFileGT != nil {
-		predicates = append(predicates, user.AvatarLocalFileGT(*i.AvatarLocalFileGT))
+	if i.DeletedByIsNil {
+		predicates = append(predicates, usersetting.DeletedByIsNil())
 	}
-	if i.AvatarLocalFileGTE != nil {
-		predicates = append(predicates, user.AvatarLocalFileGTE(*i.AvatarLocalFileGTE))
+	if i.DeletedByNotNil {
+		predicates = append(predicates, usersetting.DeletedByNotNil())
 	}
-	if i.AvatarLocalFileLT != nil {
-		predicates = append(predicates, user.AvatarLocalFileLT(*i.AvatarLocalFileLT))
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, usersetting.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
-	if i.AvatarLocalFileLTE != nil {
-		predicates = append(predicates, user.AvatarLocalFileLTE(*i.AvatarLocalFileLTE))
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, usersetting.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.AvatarLocalFileContains != nil {
-		predicates = append(predicates, user.AvatarLocalFileContains(*i.AvatarLocalFileContains))
+	if i.Locked != nil {
+		predicates = append(predicates, usersetting.LockedEQ(*i.Locked))
 	}
-	if i.AvatarLocalFileHasPrefix != nil {
-		predicates = append(predicates, user.AvatarLocalFileHasPrefix(*i.AvatarLocalFileHasPrefix))
+	if i.LockedNEQ != nil {
+		predicates = append(predicates, usersetting.LockedNEQ(*i.LockedNEQ))
 	}
-	if i.AvatarLocalFileHasSuffix != nil {
-		predicates = append(predicates, user.AvatarLocalFileHasSuffix(*i.AvatarLocalFileHasSuffix))
+	if i.SilencedAt != nil {
+		predicates = append(predicates, usersetting.SilencedAtEQ(*i.SilencedAt))
 	}
-	if i.AvatarLocalFileIsNil {
-		predicates = append(predicates, user.AvatarLocalFileIsNil())
+	if i.SilencedAtNEQ != nil {
+		predicates = append(predicates, usersetting.SilencedAtNEQ(*i.SilencedAtNEQ))
 	}
-	if i.AvatarLocalFileNotNil {
-		predicates = append(predicates, user.AvatarLocalFileNotNil())
+	if len(i.SilencedAtIn) > 0 {
+		predicates = append(predicates, usersetting.SilencedAtIn(i.SilencedAtIn...))
 	}
-	if i.AvatarLocalFileEqualFold != nil {
-		predicates = append(predicates, user.AvatarLocalFileEqualFold(*i.AvatarLocalFileEqualFold))
+	if len(i.SilencedAtNotIn) > 0 {
+		predicates = append(predicates, usersetting.SilencedAtNotIn(i.SilencedAtNotIn...))
 	}
-	if i.AvatarLocalFileContainsFold != nil {
-		predicates = append(predicates, user.AvatarLocalFileContainsFold(*i.AvatarLocalFileContainsFold))
+	if i.SilencedAtGT != nil {
+		predicates = append(predicates, usersetting.SilencedAtGT(*i.SilencedAtGT))
 	}
-	if i.AvatarUpdatedAt != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtEQ(*i.AvatarUpdatedAt))
+	if i.SilencedAtGTE != nil {
+		predicates = append(predicates, usersetting.SilencedAtGTE(*i.SilencedAtGTE))
 	}
-	if i.AvatarUpdatedAtNEQ != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtNEQ(*i.AvatarUpdatedAtNEQ))
+	if i.SilencedAtLT != nil {
+		predicates = append(predicates, usersetting.SilencedAtLT(*i.SilencedAtLT))
 	}
-	if len(i.AvatarUpdatedAtIn) > 0 {
-		predicates = append(predicates, user.AvatarUpdatedAtIn(i.AvatarUpdatedAtIn...))
+	if i.SilencedAtLTE != nil {
+		predicates = append(predicates, usersetting.SilencedAtLTE(*i.SilencedAtLTE))
 	}
-	if len(i.AvatarUpdatedAtNotIn) > 0 {
-		predicates = append(predicates, user.AvatarUpdatedAtNotIn(i.AvatarUpdatedAtNotIn...))
+	if i.SilencedAtIsNil {
+		predicates = append(predicates, usersetting.SilencedAtIsNil())
 	}
-	if i.AvatarUpdatedAtGT != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtGT(*i.AvatarUpdatedAtGT))
+	if i.SilencedAtNotNil {
+		predicates = append(predicates, usersetting.SilencedAtNotNil())
 	}
-	if i.AvatarUpdatedAtGTE != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtGTE(*i.AvatarUpdatedAtGTE))
+	if i.SuspendedAt != nil {
+		predicates = append(predicates, usersetting.SuspendedAtEQ(*i.SuspendedAt))
 	}
-	if i.AvatarUpdatedAtLT != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtLT(*i.AvatarUpdatedAtLT))
+	if i.SuspendedAtNEQ != nil {
+		predicates = append(predicates, usersetting.SuspendedAtNEQ(*i.SuspendedAtNEQ))
 	}
-	if i.AvatarUpdatedAtLTE != nil {
-		predicates = append(predicates, user.AvatarUpdatedAtLTE(*i.AvatarUpdatedAtLTE))
+	if len(i.SuspendedAtIn) > 0 {
+		predicates = append(predicates, usersetting.SuspendedAtIn(i.SuspendedAtIn...))
 	}
-	if i.AvatarUpdatedAtIsNil {
-		predicates = append(predicates, user.AvatarUpdatedAtIsNil())
+	if len(i.SuspendedAtNotIn) > 0 {
+		predicates = append(predicates, usersetting.SuspendedAtNotIn(i.SuspendedAtNotIn...))
 	}
-	if i.AvatarUpdatedAtNotNil {
-		predicates = append(predicates, user.AvatarUpdatedAtNotNil())
+	if i.SuspendedAtGT != nil {
+		predicates = append(predicates, usersetting.SuspendedAtGT(*i.SuspendedAtGT))
 	}
-	if i.LastSeen != nil {
-		predicates = append(predicates, user.LastSeenEQ(*i.LastSeen))
+	if i.SuspendedAtGTE != nil {
+		predicates = append(predicates, usersetting.SuspendedAtGTE(*i.SuspendedAtGTE))
 	}
-	if i.LastSeenNEQ != nil {
-		predicates = append(predicates, user.LastSeenNEQ(*i.LastSeenNEQ))
+	if i.SuspendedAtLT != nil {
+		predicates = append(predicates, usersetting.SuspendedAtLT(*i.SuspendedAtLT))
 	}
-	if len(i.LastSeenIn) > 0 {
-		predicates = append(predicates, user.LastSeenIn(i.LastSeenIn...))
+	if i.SuspendedAtLTE != nil {
+		predicates = append(predicates, usersetting.SuspendedAtLTE(*i.SuspendedAtLTE))
 	}
-	if len(i.LastSeenNotIn) > 0 {
-		predicates = append(predicates, user.LastSeenNotIn(i.LastSeenNotIn...))
+	if i.SuspendedAtIsNil {
+		predicates = append(predicates, usersetting.SuspendedAtIsNil())
 	}
-	if i.LastSeenGT != nil {
-		predicates = append(predicates, user.LastSeenGT(*i.LastSeenGT))
+	if i.SuspendedAtNotNil {
+		predicates = append(predicates, usersetting.SuspendedAtNotNil())
 	}
-	if i.LastSeenGTE != nil {
-		predicates = append(predicates, user.LastSeenGTE(*i.LastSeenGTE))
+	if i.RecoveryCode != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeEQ(*i.RecoveryCode))
 	}
-	if i.LastSeenLT != nil {
-		predicates = append(predicates, user.LastSeenLT(*i.LastSeenLT))
+	if i.RecoveryCodeNEQ != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeNEQ(*i.RecoveryCodeNEQ))
 	}
-	if i.LastSeenLTE != nil {
-		predicates = append(predicates, user.LastSeenLTE(*i.LastSeenLTE))
+	if len(i.RecoveryCodeIn) > 0 {
+		predicates = append(predicates, usersetting.RecoveryCodeIn(i.RecoveryCodeIn...))
 	}
-	if i.LastSeenIsNil {
-		predicates = append(predicates, user.LastSeenIsNil())
+	if len(i.RecoveryCodeNotIn) > 0 {
+		predicates = append(predicates, usersetting.RecoveryCodeNotIn(i.RecoveryCodeNotIn...))
 	}
-	if i.LastSeenNotNil {
-		predicates = append(predicates, user.LastSeenNotNil())
+	if i.RecoveryCodeGT != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeGT(*i.RecoveryCodeGT))
 	}
-	if i.Password != nil {
-		predicates = append(predicates, user.PasswordEQ(*i.Password))
+	if i.RecoveryCodeGTE != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeGTE(*i.RecoveryCodeGTE))
 	}
-	if i.PasswordNEQ != nil {
-		predicates = append(predicates, user.PasswordNEQ(*i.PasswordNEQ))
+	if i.RecoveryCodeLT != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeLT(*i.RecoveryCodeLT))
 	}
-	if len(i.PasswordIn) > 0 {
-		predicates = append(predicates, user.PasswordIn(i.PasswordIn...))
+	if i.RecoveryCodeLTE != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeLTE(*i.RecoveryCodeLTE))
 	}
-	if len(i.PasswordNotIn) > 0 {
-		predicates = append(predicates, user.PasswordNotIn(i.PasswordNotIn...))
+	if i.RecoveryCodeContains != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeContains(*i.RecoveryCodeContains))
 	}
-	if i.PasswordGT != nil {
-		predicates = append(predicates, user.PasswordGT(*i.PasswordGT))
+	if i.RecoveryCodeHasPrefix != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeHasPrefix(*i.RecoveryCodeHasPrefix))
 	}
-	if i.PasswordGTE != nil {
-		predicates = append(predicates, user.PasswordGTE(*i.PasswordGTE))
+	if i.RecoveryCodeHasSuffix != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeHasSuffix(*i.RecoveryCodeHasSuffix))
 	}
-	if i.PasswordLT != nil {
-		predicates = append(predicates, user.PasswordLT(*i.PasswordLT))
+	if i.RecoveryCodeIsNil {
+		predicates = append(predicates, usersetting.RecoveryCodeIsNil())
 	}
-	if i.PasswordLTE != nil {
-		predicates = append(predicates, user.PasswordLTE(*i.PasswordLTE))
+	if i.RecoveryCodeNotNil {
+		predicates = append(predicates, usersetting.RecoveryCodeNotNil())
 	}
-	if i.PasswordContains != nil {
-		predicates = append(predicates, user.PasswordContains(*i.PasswordContains))
+	if i.RecoveryCodeEqualFold != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeEqualFold(*i.RecoveryCodeEqualFold))
 	}
-	if i.PasswordHasPrefix != nil {
-		predicates = append(predicates, user.PasswordHasPrefix(*i.PasswordHasPrefix))
+	if i.RecoveryCodeContainsFold != nil {
+		predicates = append(predicates, usersetting.RecoveryCodeContainsFold(*i.RecoveryCodeContainsFold))
 	}
-	if i.PasswordHasSuffix != nil {
-		predicates = append(predicates, user.PasswordHasSuffix(*i.PasswordHasSuffix))
+	if i.Status != nil {
+		predicates = append(predicates, usersetting.StatusEQ(*i.Status))
 	}
-	if i.PasswordIsNil {
-		predicates = append(predicates, user.PasswordIsNil())
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, usersetting.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.PasswordNotNil {
-		predicates = append(predicates, user.PasswordNotNil())
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, usersetting.StatusIn(i.StatusIn...))
 	}
-	if i.PasswordEqualFold != nil {
-		predicates = append(predicates, user.PasswordEqualFold(*i.PasswordEqualFold))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, usersetting.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.PasswordContainsFold != nil {
-		predicates = append(predicates, user.PasswordContainsFold(*i.PasswordContainsFold))
+	if i.Role != nil {
+		predicates = append(predicates, usersetting.RoleEQ(*i.Role))
 	}
-	if i.Sub != nil {
-		predicates = append(predicates, user.SubEQ(*i.Sub))
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, usersetting.RoleNEQ(*i.RoleNEQ))
 	}
-	if i.SubNEQ != nil {
-		predicates = append(predicates, user.SubNEQ(*i.SubNEQ))
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, usersetting.RoleIn(i.RoleIn...))
 	}
-	if len(i.SubIn) > 0 {
-		predicates = append(predicates, user.SubIn(i.SubIn...))
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, usersetting.RoleNotIn(i.RoleNotIn...))
 	}
-	if len(i.SubNotIn) > 0 {
-		predicates = append(predicates, user.SubNotIn(i.SubNotIn...))
+	if i.EmailConfirmed != nil {
+		predicates = append(predicates, usersetting.EmailConfirmedEQ(*i.EmailConfirmed))
 	}
-	if i.SubGT != nil {
-		predicates = append(predicates, user.SubGT(*i.SubGT))
+	if i.EmailConfirmedNEQ != nil {
+		predicates = append(predicates, usersetting.EmailConfirmedNEQ(*i.EmailConfirmedNEQ))
 	}
-	if i.SubGTE != nil {
-		predicates = append(predicates, user.SubGTE(*i.SubGTE))
+	if i.IsTfaEnabled != nil {
+		predicates = append(predicates, usersetting.IsTfaEnabledEQ(*i.IsTfaEnabled))
 	}
-	if i.SubLT != nil {
-		predicates = append(predicates, user.SubLT(*i.SubLT))
+	if i.IsTfaEnabledNEQ != nil {
+		predicates = append(predicates, usersetting.IsTfaEnabledNEQ(*i.IsTfaEnabledNEQ))
 	}
-	if i.SubLTE != nil {
-		predicates = append(predicates, user.SubLTE(*i.SubLTE))
+	if i.FailedLoginAttempts != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsEQ(*i.FailedLoginAttempts))
 	}
-	if i.SubContains != nil {
-		predicates = append(predicates, user.SubContains(*i.SubContains))
+	if i.FailedLoginAttemptsNEQ != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsNEQ(*i.FailedLoginAttemptsNEQ))
 	}
-	if i.SubHasPrefix != nil {
-		predicates = append(predicates, user.SubHasPrefix(*i.SubHasPrefix))
+	if len(i.FailedLoginAttemptsIn) > 0 {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsIn(i.FailedLoginAttemptsIn...))
 	}
-	if i.SubHasSuffix != nil {
-		predicates = append(predicates, user.SubHasSuffix(*i.SubHasSuffix))
+	if len(i.FailedLoginAttemptsNotIn) > 0 {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsNotIn(i.FailedLoginAttemptsNotIn...))
 	}
-	if i.SubIsNil {
-		predicates = append(predicates, user.SubIsNil())
+	if i.FailedLoginAttemptsGT != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsGT(*i.FailedLoginAttemptsGT))
 	}
-	if i.SubNotNil {
-		predicates = append(predicates, user.SubNotNil())
+	if i.FailedLoginAttemptsGTE != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsGTE(*i.FailedLoginAttemptsGTE))
 	}
-	if i.SubEqualFold != nil {
-		predicates = append(predicates, user.SubEqualFold(*i.SubEqualFold))
+	if i.FailedLoginAttemptsLT != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsLT(*i.FailedLoginAttemptsLT))
 	}
-	if i.SubContainsFold != nil {
-		predicates = append(predicates, user.SubContainsFold(*i.SubContainsFold))
+	if i.FailedLoginAttemptsLTE != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsLTE(*i.FailedLoginAttemptsLTE))
 	}
-	if i.Oauth != nil {
-		predicates = append(predicates, user.OauthEQ(*i.Oauth))
+	if i.LockedUntil != nil {
+		predicates = append(predicates, usersetting.LockedUntilEQ(*i.LockedUntil))
 	}
-	if i.OauthNEQ != nil {
-		predicates = append(predicates, user.OauthNEQ(*i.OauthNEQ))
+	if i.LockedUntilNEQ != nil {
+		predicates = append(predicates, usersetting.LockedUntilNEQ(*i.LockedUntilNEQ))
 	}
-
-	if i.HasOrganizations != nil {
-		p := user.HasOrganizations()
-		if !*i.HasOrganizations {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.LockedUntilIn) > 0 {
+		predicates = append(predicates, usersetting.LockedUntilIn(i.LockedUntilIn...))
 	}
-	if len(i.HasOrganizationsWith) > 0 {
-		with := make([]predicate.Organization, 0, len(i.HasOrganizationsWith))
-		for _, w := range i.HasOrganizationsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOrganizationsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasOrganizationsWith(with...))
+	if len(i.LockedUntilNotIn) > 0 {
+		predicates = append(predicates, usersetting.LockedUntilNotIn(i.LockedUntilNotIn...))
 	}
-	if i.HasSessions != nil {
-		p := user.HasSessions()
-		if !*i.HasSessions {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LockedUntilGT != nil {
+		predicates = append(predicates, usersetting.LockedUntilGT(*i.LockedUntilGT))
 	}
-	if len(i.HasSessionsWith) > 0 {
-		with := make([]predicate.Session, 0, len(i.HasSessionsWith))
-		for _, w := range i.HasSessionsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSessionsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasSessionsWith(with...))
+	if i.LockedUntilGTE != nil {
+		predicates = append(predicates, usersetting.LockedUntilGTE(*i.LockedUntilGTE))
 	}
-	if i.HasGroups != nil {
-		p := user.HasGroups()
-		if !*i.HasGroups {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LockedUntilLT != nil {
+		predicates = append(predicates, usersetting.LockedUntilLT(*i.LockedUntilLT))
 	}
-	if len(i.HasGroupsWith) > 0 {
-		with := make([]predicate.Group, 0, len(i.HasGroupsWith))
-		for _, w := range i.HasGroupsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasGroupsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasGroupsWith(with...))
+	if i.LockedUntilLTE != nil {
+		predicates = append(predicates, usersetting.LockedUntilLTE(*i.LockedUntilLTE))
 	}
-	if i.HasPersonalAccessTokens != nil {
-		p := user.HasPersonalAccessTokens()
-		if !*i.HasPersonalAccessTokens {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LockedUntilIsNil {
+		predicates = append(predicates, usersetting.LockedUntilIsNil())
 	}
-	if len(i.HasPersonalAccessTokensWith) > 0 {
-		with := make([]predicate.PersonalAccessToken, 0, len(i.HasPersonalAccessTokensWith))
-		for _, w := range i.HasPersonalAccessTokensWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPersonalAccessTokensWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasPersonalAccessTokensWith(with...))
+	if i.LockedUntilNotNil {
+		predicates = append(predicates, usersetting.LockedUntilNotNil())
 	}
-	if i.HasSetting != nil {
-		p := user.HasSetting()
-		if !*i.HasSetting {
-			p = user.Not(p)
+
+	if i.HasUser != nil {
+		p := usersetting.HasUser()
+		if !*i.HasUser {
+			p = usersetting.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasSettingWith) > 0 {
-		with := make([]predicate.UserSetting, 0, len(i.HasSettingWith))
-		for _, w := range i.HasSettingWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSettingWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, user.HasSettingWith(with...))
+		predicates = append(predicates, usersetting.HasUserWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUserWhereInput
+		return nil, ErrEmptyUserSettingWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return user.And(predicates...), nil
+		return usersetting.And(predicates...), nil
 	}
 }
 
-// UserSettingWhereInput represents a where input for filtering UserSetting queries.
-type UserSettingWhereInput struct {
-	Predicates []predicate.UserSetting  `json:"-"`
-	Not        *UserSettingWhereInput   `json:"not,omitempty"`
-	Or         []*UserSettingWhereInput `json:"or,omitempty"`
-	And        []*UserSettingWhereInput `json:"and,omitempty"`
+// WebauthnCredentialWhereInput represents a where input for filtering WebauthnCredential queries.
+type WebauthnCredentialWhereInput struct {
+	Predicates []predicate.WebauthnCredential  `json:"-"`
+	Not        *WebauthnCredentialWhereInput   `json:"not,omitempty"`
+	Or         []*WebauthnCredentialWhereInput `json:"or,omitempty"`
+	And        []*WebauthnCredentialWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
@@ -7958,85 +13448,105 @@ type UserSettingWhereInput struct {
 	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
 	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
 
-	// "locked" field predicates.
-	Locked    *bool `json:"locked,omitempty"`
-	LockedNEQ *bool `json:"lockedNEQ,omitempty"`
-
-	// "silenced_at" field predicates.
-	SilencedAt       *time.Time  `json:"silencedAt,omitempty"`
-	SilencedAtNEQ    *time.Time  `json:"silencedAtNEQ,omitempty"`
-	SilencedAtIn     []time.Time `json:"silencedAtIn,omitempty"`
-	SilencedAtNotIn  []time.Time `json:"silencedAtNotIn,omitempty"`
-	SilencedAtGT     *time.Time  `json:"silencedAtGT,omitempty"`
-	SilencedAtGTE    *time.Time  `json:"silencedAtGTE,omitempty"`
-	SilencedAtLT     *time.Time  `json:"silencedAtLT,omitempty"`
-	SilencedAtLTE    *time.Time  `json:"silencedAtLTE,omitempty"`
-	SilencedAtIsNil  bool        `json:"silencedAtIsNil,omitempty"`
-	SilencedAtNotNil bool        `json:"silencedAtNotNil,omitempty"`
-
-	// "suspended_at" field predicates.
-	SuspendedAt       *time.Time  `json:"suspendedAt,omitempty"`
-	SuspendedAtNEQ    *time.Time  `json:"suspendedAtNEQ,omitempty"`
-	SuspendedAtIn     []time.Time `json:"suspendedAtIn,omitempty"`
-	SuspendedAtNotIn  []time.Time `json:"suspendedAtNotIn,omitempty"`
-	SuspendedAtGT     *time.Time  `json:"suspendedAtGT,omitempty"`
-	SuspendedAtGTE    *time.Time  `json:"suspendedAtGTE,omitempty"`
-	SuspendedAtLT     *time.Time  `json:"suspendedAtLT,omitempty"`
-	SuspendedAtLTE    *time.Time  `json:"suspendedAtLTE,omitempty"`
-	SuspendedAtIsNil  bool        `json:"suspendedAtIsNil,omitempty"`
-	SuspendedAtNotNil bool        `json:"suspendedAtNotNil,omitempty"`
-
-	// "recovery_code" field predicates.
-	RecoveryCode             *string  `json:"recoveryCode,omitempty"`
-	RecoveryCodeNEQ          *string  `json:"recoveryCodeNEQ,omitempty"`
-	RecoveryCodeIn           []string `json:"recoveryCodeIn,omitempty"`
-	RecoveryCodeNotIn        []string `json:"recoveryCodeNotIn,omitempty"`
-	RecoveryCodeGT           *string  `json:"recoveryCodeGT,omitempty"`
-	RecoveryCodeGTE          *string  `json:"recoveryCodeGTE,omitempty"`
-	RecoveryCodeLT           *string  `json:"recoveryCodeLT,omitempty"`
-	RecoveryCodeLTE          *string  `json:"recoveryCodeLTE,omitempty"`
-	RecoveryCodeContains     *string  `json:"recoveryCodeContains,omitempty"`
-	RecoveryCodeHasPrefix    *string  `json:"recoveryCodeHasPrefix,omitempty"`
-	RecoveryCodeHasSuffix    *string  `json:"recoveryCodeHasSuffix,omitempty"`
-	RecoveryCodeIsNil        bool     `json:"recoveryCodeIsNil,omitempty"`
-	RecoveryCodeNotNil       bool     `json:"recoveryCodeNotNil,omitempty"`
-	RecoveryCodeEqualFold    *string  `json:"recoveryCodeEqualFold,omitempty"`
-	RecoveryCodeContainsFold *string  `json:"recoveryCodeContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *usersetting.Status  `json:"status,omitempty"`
-	StatusNEQ   *usersetting.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []usersetting.Status `json:"statusIn,omitempty"`
-	StatusNotIn []usersetting.Status `json:"statusNotIn,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil        bool     `json:"nameIsNil,omitempty"`
+	NameNotNil       bool     `json:"nameNotNil,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "role" field predicates.
-	Role      *usersetting.Role  `json:"role,omitempty"`
-	RoleNEQ   *usersetting.Role  `json:"roleNEQ,omitempty"`
-	RoleIn    []usersetting.Role `json:"roleIn,omitempty"`
-	RoleNotIn []usersetting.Role `json:"roleNotIn,omitempty"`
+	// "attestation_type" field predicates.
+	AttestationType             *string  `json:"attestationType,omitempty"`
+	AttestationTypeNEQ          *string  `json:"attestationTypeNEQ,omitempty"`
+	AttestationTypeIn           []string `json:"attestationTypeIn,omitempty"`
+	AttestationTypeNotIn        []string `json:"attestationTypeNotIn,omitempty"`
+	AttestationTypeGT           *string  `json:"attestationTypeGT,omitempty"`
+	AttestationTypeGTE          *string  `json:"attestationTypeGTE,omitempty"`
+	AttestationTypeLT           *string  `json:"attestationTypeLT,omitempty"`
+	AttestationTypeLTE          *string  `json:"attestationTypeLTE,omitempty"`
+	AttestationTypeContains     *string  `json:"attestationTypeContains,omitempty"`
+	AttestationTypeHasPrefix    *string  `json:"attestationTypeHasPrefix,omitempty"`
+	AttestationTypeHasSuffix    *string  `json:"attestationTypeHasSuffix,omitempty"`
+	AttestationTypeIsNil        bool     `json:"attestationTypeIsNil,omitempty"`
+	AttestationTypeNotNil       bool     `json:"attestationTypeNotNil,omitempty"`
+	AttestationTypeEqualFold    *string  `json:"attestationTypeEqualFold,omitempty"`
+	AttestationTypeContainsFold *string  `json:"attestationTypeContainsFold,omitempty"`
+
+	// "aaguid" field predicates.
+	Aaguid             *string  `json:"aaguid,omitempty"`
+	AaguidNEQ          *string  `json:"aaguidNEQ,omitempty"`
+	AaguidIn           []string `json:"aaguidIn,omitempty"`
+	AaguidNotIn        []string `json:"aaguidNotIn,omitempty"`
+	AaguidGT           *string  `json:"aaguidGT,omitempty"`
+	AaguidGTE          *string  `json:"aaguidGTE,omitempty"`
+	AaguidLT           *string  `json:"aaguidLT,omitempty"`
+	AaguidLTE          *string  `json:"aaguidLTE,omitempty"`
+	AaguidContains     *string  `json:"aaguidContains,omitempty"`
+	AaguidHasPrefix    *string  `json:"aaguidHasPrefix,omitempty"`
+	AaguidHasSuffix    *string  `json:"aaguidHasSuffix,omitempty"`
+	AaguidIsNil        bool     `json:"aaguidIsNil,omitempty"`
+	AaguidNotNil       bool     `json:"aaguidNotNil,omitempty"`
+	AaguidEqualFold    *string  `json:"aaguidEqualFold,omitempty"`
+	AaguidContainsFold *string  `json:"aaguidContainsFold,omitempty"`
+
+	// "sign_count" field predicates.
+	SignCount      *int64  `json:"signCount,omitempty"`
+	SignCountNEQ   *int64  `json:"signCountNEQ,omitempty"`
+	SignCountIn    []int64 `json:"signCountIn,omitempty"`
+	SignCountNotIn []int64 `json:"signCountNotIn,omitempty"`
+	SignCountGT    *int64  `json:"signCountGT,omitempty"`
+	SignCountGTE   *int64  `json:"signCountGTE,omitempty"`
+	SignCountLT    *int64  `json:"signCountLT,omitempty"`
+	SignCountLTE   *int64  `json:"signCountLTE,omitempty"`
+
+	// "backup_eligible" field predicates.
+	BackupEligible    *bool `json:"backupEligible,omitempty"`
+	BackupEligibleNEQ *bool `json:"backupEligibleNEQ,omitempty"`
+
+	// "backup_state" field predicates.
+	BackupState    *bool `json:"backupState,omitempty"`
+	BackupStateNEQ *bool `json:"backupStateNEQ,omitempty"`
 
-	// "email_confirmed" field predicates.
-	EmailConfirmed    *bool `json:"emailConfirmed,omitempty"`
-	EmailConfirmedNEQ *bool `json:"emailConfirmedNEQ,omitempty"`
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UserSettingWhereInput) AddPredicates(predicates ...predicate.UserSetting) {
+func (i *WebauthnCredentialWhereInput) AddPredicates(predicates ...predicate.WebauthnCredential) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UserSettingWhereInput filter on the UserSettingQuery builder.
-func (i *UserSettingWhereInput) Filter(q *UserSettingQuery) (*UserSettingQuery, error) {
+// Filter applies the WebauthnCredentialWhereInput filter on the WebauthnCredentialQuery builder.
+func (i *WebauthnCredentialWhereInput) Filter(q *WebauthnCredentialQuery) (*WebauthnCredentialQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUserSettingWhereInput {
+		if err == ErrEmptyWebauthnCredentialWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -8044,19 +13554,19 @@ func (i *UserSettingWhereInput) Filter(q *UserSettingQuery) (*UserSettingQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyUserSettingWhereInput is returned in case the UserSettingWhereInput is empty.
-var ErrEmptyUserSettingWhereInput = errors.New("generated: empty predicate UserSettingWhereInput")
+// ErrEmptyWebauthnCredentialWhereInput is returned in case the WebauthnCredentialWhereInput is empty.
+var ErrEmptyWebauthnCredentialWhereInput = errors.New("generated: empty predicate WebauthnCredentialWhereInput")
 
-// P returns a predicate for filtering usersettings.
+// P returns a predicate for filtering webauthncredentials.
 // An error is returned if the input is empty or invalid.
-func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
-	var predicates []predicate.UserSetting
+func (i *WebauthnCredentialWhereInput) P() (predicate.WebauthnCredential, error) {
+	var predicates []predicate.WebauthnCredential
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, usersetting.Not(p))
+		predicates = append(predicates, webauthncredential.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -8066,7 +13576,7 @@ func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.UserSetting, 0, n)
+		or := make([]predicate.WebauthnCredential, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -8074,7 +13584,7 @@ func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, usersetting.Or(or...))
+		predicates = append(predicates, webauthncredential.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -8084,7 +13594,7 @@ func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.UserSetting, 0, n)
+		and := make([]predicate.WebauthnCredential, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -8092,418 +13602,478 @@ func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, usersetting.And(and...))
+		predicates = append(predicates, webauthncredential.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, usersetting.IDEQ(*i.ID))
+		predicates = append(predicates, webauthncredential.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, usersetting.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, webauthncredential.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, usersetting.IDIn(i.IDIn...))
+		predicates = append(predicates, webauthncredential.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, usersetting.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, webauthncredential.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, usersetting.IDGT(*i.IDGT))
+		predicates = append(predicates, webauthncredential.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, usersetting.IDGTE(*i.IDGTE))
+		predicates = append(predicates, webauthncredential.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, usersetting.IDLT(*i.IDLT))
+		predicates = append(predicates, webauthncredential.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, usersetting.IDLTE(*i.IDLTE))
+		predicates = append(predicates, webauthncredential.IDLTE(*i.IDLTE))
 	}
 	if i.IDEqualFold != nil {
-		predicates = append(predicates, usersetting.IDEqualFold(*i.IDEqualFold))
+		predicates = append(predicates, webauthncredential.IDEqualFold(*i.IDEqualFold))
 	}
 	if i.IDContainsFold != nil {
-		predicates = append(predicates, usersetting.IDContainsFold(*i.IDContainsFold))
+		predicates = append(predicates, webauthncredential.IDContainsFold(*i.IDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, usersetting.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, webauthncredential.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, usersetting.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, webauthncredential.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, usersetting.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, webauthncredential.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, usersetting.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, webauthncredential.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, usersetting.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, webauthncredential.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, usersetting.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, webauthncredential.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, usersetting.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, webauthncredential.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, usersetting.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, webauthncredential.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, usersetting.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, webauthncredential.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, usersetting.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, webauthncredential.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, usersetting.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, webauthncredential.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, usersetting.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, webauthncredential.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, usersetting.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, webauthncredential.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, usersetting.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, webauthncredential.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, usersetting.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, webauthncredential.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, usersetting.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, webauthncredential.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedBy != nil {
-		predicates = append(predicates, usersetting.CreatedByEQ(*i.CreatedBy))
+		predicates = append(predicates, webauthncredential.CreatedByEQ(*i.CreatedBy))
 	}
 	if i.CreatedByNEQ != nil {
-		predicates = append(predicates, usersetting.CreatedByNEQ(*i.CreatedByNEQ))
+		predicates = append(predicates, webauthncredential.CreatedByNEQ(*i.CreatedByNEQ))
 	}
 	if len(i.CreatedByIn) > 0 {
-		predicates = append(predicates, usersetting.CreatedByIn(i.CreatedByIn...))
+		predicates = append(predicates, webauthncredential.CreatedByIn(i.CreatedByIn...))
 	}
 	if len(i.CreatedByNotIn) > 0 {
-		predicates = append(predicates, usersetting.CreatedByNotIn(i.CreatedByNotIn...))
+		predicates = append(predicates, webauthncredential.CreatedByNotIn(i.CreatedByNotIn...))
 	}
 	if i.CreatedByGT != nil {
-		predicates = append(predicates, usersetting.CreatedByGT(*i.CreatedByGT))
+		predicates = append(predicates, webauthncredential.CreatedByGT(*i.CreatedByGT))
 	}
 	if i.CreatedByGTE != nil {
-		predicates = append(predicates, usersetting.CreatedByGTE(*i.CreatedByGTE))
+		predicates = append(predicates, webauthncredential.CreatedByGTE(*i.CreatedByGTE))
 	}
 	if i.CreatedByLT != nil {
-		predicates = append(predicates, usersetting.CreatedByLT(*i.CreatedByLT))
+		predicates = append(predicates, webauthncredential.CreatedByLT(*i.CreatedByLT))
 	}
 	if i.CreatedByLTE != nil {
-		predicates = append(predicates, usersetting.CreatedByLTE(*i.CreatedByLTE))
+		predicates = append(predicates, webauthncredential.CreatedByLTE(*i.CreatedByLTE))
 	}
 	if i.CreatedByContains != nil {
-		predicates = append(predicates, usersetting.CreatedByContains(*i.CreatedByContains))
+		predicates = append(predicates, webauthncredential.CreatedByContains(*i.CreatedByContains))
 	}
 	if i.CreatedByHasPrefix != nil {
-		predicates = append(predicates, usersetting.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+		predicates = append(predicates, webauthncredential.CreatedByHasPrefix(*i.CreatedByHasPrefix))
 	}
 	if i.CreatedByHasSuffix != nil {
-		predicates = append(predicates, usersetting.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+		predicates = append(predicates, webauthncredential.CreatedByHasSuffix(*i.CreatedByHasSuffix))
 	}
 	if i.CreatedByIsNil {
-		predicates = append(predicates, usersetting.CreatedByIsNil())
+		predicates = append(predicates, webauthncredential.CreatedByIsNil())
 	}
 	if i.CreatedByNotNil {
-		predicates = append(predicates, usersetting.CreatedByNotNil())
+		predicates = append(predicates, webauthncredential.CreatedByNotNil())
 	}
 	if i.CreatedByEqualFold != nil {
-		predicates = append(predicates, usersetting.CreatedByEqualFold(*i.CreatedByEqualFold))
+		predicates = append(predicates, webauthncredential.CreatedByEqualFold(*i.CreatedByEqualFold))
 	}
 	if i.CreatedByContainsFold != nil {
-		predicates = append(predicates, usersetting.CreatedByContainsFold(*i.CreatedByContainsFold))
+		predicates = append(predicates, webauthncredential.CreatedByContainsFold(*i.CreatedByContainsFold))
 	}
 	if i.UpdatedBy != nil {
-		predicates = append(predicates, usersetting.UpdatedByEQ(*i.UpdatedBy))
+		predicates = append(predicates, webauthncredential.UpdatedByEQ(*i.UpdatedBy))
 	}
 	if i.UpdatedByNEQ != nil {
-		predicates = append(predicates, usersetting.UpdatedByNEQ(*i.UpdatedByNEQ))
+		predicates = append(predicates, webauthncredential.UpdatedByNEQ(*i.UpdatedByNEQ))
 	}
 	if len(i.UpdatedByIn) > 0 {
-		predicates = append(predicates, usersetting.UpdatedByIn(i.UpdatedByIn...))
+		predicates = append(predicates, webauthncredential.UpdatedByIn(i.UpdatedByIn...))
 	}
 	if len(i.UpdatedByNotIn) > 0 {
-		predicates = append(predicates, usersetting.UpdatedByNotIn(i.UpdatedByNotIn...))
+		predicates = append(predicates, webauthncredential.UpdatedByNotIn(i.UpdatedByNotIn...))
 	}
 	if i.UpdatedByGT != nil {
-		predicates = append(predicates, usersetting.UpdatedByGT(*i.UpdatedByGT))
+		predicates = append(predicates, webauthncredential.UpdatedByGT(*i.UpdatedByGT))
 	}
 	if i.UpdatedByGTE != nil {
-		predicates = append(predicates, usersetting.UpdatedByGTE(*i.UpdatedByGTE))
+		predicates = append(predicates, webauthncredential.UpdatedByGTE(*i.UpdatedByGTE))
 	}
 	if i.UpdatedByLT != nil {
-		predicates = append(predicates, usersetting.UpdatedByLT(*i.UpdatedByLT))
+		predicates = append(predicates, webauthncredential.UpdatedByLT(*i.UpdatedByLT))
 	}
 	if i.UpdatedByLTE != nil {
-		predicates = append(predicates, usersetting.UpdatedByLTE(*i.UpdatedByLTE))
+		predicates = append(predicates, webauthncredential.UpdatedByLTE(*i.UpdatedByLTE))
 	}
 	if i.UpdatedByContains != nil {
-		predicates = append(predicates, usersetting.UpdatedByContains(*i.UpdatedByContains))
+		predicates = append(predicates, webauthncredential.UpdatedByContains(*i.UpdatedByContains))
 	}
 	if i.UpdatedByHasPrefix != nil {
-		predicates = append(predicates, usersetting.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+		predicates = append(predicates, webauthncredential.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
 	}
 	if i.UpdatedByHasSuffix != nil {
-		predicates = append(predicates, usersetting.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+		predicates = append(predicates, webauthncredential.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
 	}
 	if i.UpdatedByIsNil {
-		predicates = append(predicates, usersetting.UpdatedByIsNil())
+		predicates = append(predicates, webauthncredential.UpdatedByIsNil())
 	}
 	if i.UpdatedByNotNil {
-		predicates = append(predicates, usersetting.UpdatedByNotNil())
+		predicates = append(predicates, webauthncredential.UpdatedByNotNil())
 	}
 	if i.UpdatedByEqualFold != nil {
-		predicates = append(predicates, usersetting.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+		predicates = append(predicates, webauthncredential.UpdatedByEqualFold(*i.UpdatedByEqualFold))
 	}
 	if i.UpdatedByContainsFold != nil {
-		predicates = append(predicates, usersetting.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+		predicates = append(predicates, webauthncredential.UpdatedByContainsFold(*i.UpdatedByContainsFold))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, usersetting.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, webauthncredential.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, usersetting.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, webauthncredential.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, usersetting.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, webauthncredential.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, usersetting.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, webauthncredential.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, usersetting.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, webauthncredential.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, usersetting.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, webauthncredential.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, usersetting.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, webauthncredential.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, usersetting.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, webauthncredential.DeletedAtLTE(*i.DeletedAtLTE))
 	}
 	if i.DeletedAtIsNil {
-		predicates = append(predicates, usersetting.DeletedAtIsNil())
+		predicates = append(predicates, webauthncredential.DeletedAtIsNil())
 	}
 	if i.DeletedAtNotNil {
-		predicates = append(predicates, usersetting.DeletedAtNotNil())
+		predicates = append(predicates, webauthncredential.DeletedAtNotNil())
 	}
 	if i.DeletedBy != nil {
-		predicates = append(predicates, usersetting.DeletedByEQ(*i.DeletedBy))
+		predicates = append(predicates, webauthncredential.DeletedByEQ(*i.DeletedBy))
 	}
 	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, usersetting.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, webauthncredential.DeletedByNEQ(*i.DeletedByNEQ))
 	}
 	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, usersetting.DeletedByIn(i.DeletedByIn...))
+		predicates = append(predicates, webauthncredential.DeletedByIn(i.DeletedByIn...))
 	}
 	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, usersetting.DeletedByNotIn(i.DeletedByNotIn...))
+		predicates = append(predicates, webauthncredential.DeletedByNotIn(i.DeletedByNotIn...))
 	}
 	if i.DeletedByGT != nil {
-		predicates = append(predicates, usersetting.DeletedByGT(*i.DeletedByGT))
+		predicates = append(predicates, webauthncredential.DeletedByGT(*i.DeletedByGT))
 	}
 	if i.DeletedByGTE != nil {
-		predicates = append(predicates, usersetting.DeletedByGTE(*i.DeletedByGTE))
+		predicates = append(predicates, webauthncredential.DeletedByGTE(*i.DeletedByGTE))
 	}
 	if i.DeletedByLT != nil {
-		predicates = append(predicates, usersetting.DeletedByLT(*i.DeletedByLT))
+		predicates = append(predicates, webauthncredential.DeletedByLT(*i.DeletedByLT))
 	}
 	if i.DeletedByLTE != nil {
-		predicates = append(predicates, usersetting.DeletedByLTE(*i.DeletedByLTE))
+		predicates = append(predicates, webauthncredential.DeletedByLTE(*i.DeletedByLTE))
 	}
 	if i.DeletedByContains != nil {
-		predicates = append(predicates, usersetting.DeletedByContains(*i.DeletedByContains))
+		predicates = append(predicates, webauthncredential.DeletedByContains(*i.DeletedByContains))
 	}
 	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, usersetting.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+		predicates = append(predicates, webauthncredential.DeletedByHasPrefix(*i.DeletedByHasPrefix))
 	}
 	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, usersetting.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+		predicates = append(predicates, webauthncredential.DeletedByHasSuffix(*i.DeletedByHasSuffix))
 	}
 	if i.DeletedByIsNil {
-		predicates = append(predicates, usersetting.DeletedByIsNil())
+		predicates = append(predicates, webauthncredential.DeletedByIsNil())
 	}
 	if i.DeletedByNotNil {
-		predicates = append(predicates, usersetting.DeletedByNotNil())
+		predicates = append(predicates, webauthncredential.DeletedByNotNil())
 	}
 	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, usersetting.DeletedByEqualFold(*i.DeletedByEqualFold))
+		predicates = append(predicates, webauthncredential.DeletedByEqualFold(*i.DeletedByEqualFold))
 	}
 	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, usersetting.DeletedByContainsFold(*i.DeletedByContainsFold))
+		predicates = append(predicates, webauthncredential.DeletedByContainsFold(*i.DeletedByContainsFold))
 	}
-	if i.Locked != nil {
-		predicates = append(predicates, usersetting.LockedEQ(*i.Locked))
+	if i.Name != nil {
+		predicates = append(predicates, webauthncredential.NameEQ(*i.Name))
 	}
-	if i.LockedNEQ != nil {
-		predicates = append(predicates, usersetting.LockedNEQ(*i.LockedNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, webauthncredential.NameNEQ(*i.NameNEQ))
 	}
-	if i.SilencedAt != nil {
-		predicates = append(predicates, usersetting.SilencedAtEQ(*i.SilencedAt))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, webauthncredential.NameIn(i.NameIn...))
 	}
-	if i.SilencedAtNEQ != nil {
-		predicates = append(predicates, usersetting.SilencedAtNEQ(*i.SilencedAtNEQ))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, webauthncredential.NameNotIn(i.NameNotIn...))
 	}
-	if len(i.SilencedAtIn) > 0 {
-		predicates = append(predicates, usersetting.SilencedAtIn(i.SilencedAtIn...))
+	if i.NameGT != nil {
+		predicates = append(predicates, webauthncredential.NameGT(*i.NameGT))
 	}
-	if len(i.SilencedAtNotIn) > 0 {
-		predicates = append(predicates, usersetting.SilencedAtNotIn(i.SilencedAtNotIn...))
+	if i.NameGTE != nil {
+		predicates = append(predicates, webauthncredential.NameGTE(*i.NameGTE))
 	}
-	if i.SilencedAtGT != nil {
-		predicates = append(predicates, usersetting.SilencedAtGT(*i.SilencedAtGT))
+	if i.NameLT != nil {
+		predicates = append(predicates, webauthncredential.NameLT(*i.NameLT))
 	}
-	if i.SilencedAtGTE != nil {
-		predicates = append(predicates, usersetting.SilencedAtGTE(*i.SilencedAtGTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, webauthncredential.NameLTE(*i.NameLTE))
 	}
-	if i.SilencedAtLT != nil {
-		predicates = append(predicates, usersetting.SilencedAtLT(*i.SilencedAtLT))
+	if i.NameContains != nil {
+		predicates = append(predicates, webauthncredential.NameContains(*i.NameContains))
 	}
-	if i.SilencedAtLTE != nil {
-		predicates = append(predicates, usersetting.SilencedAtLTE(*i.SilencedAtLTE))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, webauthncredential.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.SilencedAtIsNil {
-		predicates = append(predicates, usersetting.SilencedAtIsNil())
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, webauthncredential.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.SilencedAtNotNil {
-		predicates = append(predicates, usersetting.SilencedAtNotNil())
+	if i.NameIsNil {
+		predicates = append(predicates, webauthncredential.NameIsNil())
 	}
-	if i.SuspendedAt != nil {
-		predicates = append(predicates, usersetting.SuspendedAtEQ(*i.SuspendedAt))
+	if i.NameNotNil {
+		predicates = append(predicates, webauthncredential.NameNotNil())
 	}
-	if i.SuspendedAtNEQ != nil {
-		predicates = append(predicates, usersetting.SuspendedAtNEQ(*i.SuspendedAtNEQ))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, webauthncredential.NameEqualFold(*i.NameEqualFold))
 	}
-	if len(i.SuspendedAtIn) > 0 {
-		predicates = append(predicates, usersetting.SuspendedAtIn(i.SuspendedAtIn...))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, webauthncredential.NameContainsFold(*i.NameContainsFold))
 	}
-	if len(i.SuspendedAtNotIn) > 0 {
-		predicates = append(predicates, usersetting.SuspendedAtNotIn(i.SuspendedAtNotIn...))
+	if i.AttestationType != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeEQ(*i.AttestationType))
 	}
-	if i.SuspendedAtGT != nil {
-		predicates = append(predicates, usersetting.SuspendedAtGT(*i.SuspendedAtGT))
+	if i.AttestationTypeNEQ != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeNEQ(*i.AttestationTypeNEQ))
 	}
-	if i.SuspendedAtGTE != nil {
-		predicates = append(predicates, usersetting.SuspendedAtGTE(*i.SuspendedAtGTE))
+	if len(i.AttestationTypeIn) > 0 {
+		predicates = append(predicates, webauthncredential.AttestationTypeIn(i.AttestationTypeIn...))
 	}
-	if i.SuspendedAtLT != nil {
-		predicates = append(predicates, usersetting.SuspendedAtLT(*i.SuspendedAtLT))
+	if len(i.AttestationTypeNotIn) > 0 {
+		predicates = append(predicates, webauthncredential.AttestationTypeNotIn(i.AttestationTypeNotIn...))
 	}
-	if i.SuspendedAtLTE != nil {
-		predicates = append(predicates, usersetting.SuspendedAtLTE(*i.SuspendedAtLTE))
+	if i.AttestationTypeGT != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeGT(*i.AttestationTypeGT))
 	}
-	if i.SuspendedAtIsNil {
-		predicates = append(predicates, usersetting.SuspendedAtIsNil())
+	if i.AttestationTypeGTE != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeGTE(*i.AttestationTypeGTE))
 	}
-	if i.SuspendedAtNotNil {
-		predicates = append(predicates, usersetting.SuspendedAtNotNil())
+	if i.AttestationTypeLT != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeLT(*i.AttestationTypeLT))
 	}
-	if i.RecoveryCode != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeEQ(*i.RecoveryCode))
+	if i.AttestationTypeLTE != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeLTE(*i.AttestationTypeLTE))
 	}
-	if i.RecoveryCodeNEQ != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeNEQ(*i.RecoveryCodeNEQ))
+	if i.AttestationTypeContains != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeContains(*i.AttestationTypeContains))
 	}
-	if len(i.RecoveryCodeIn) > 0 {
-		predicates = append(predicates, usersetting.RecoveryCodeIn(i.RecoveryCodeIn...))
+	if i.AttestationTypeHasPrefix != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeHasPrefix(*i.AttestationTypeHasPrefix))
 	}
-	if len(i.RecoveryCodeNotIn) > 0 {
-		predicates = append(predicates, usersetting.RecoveryCodeNotIn(i.RecoveryCodeNotIn...))
+	if i.AttestationTypeHasSuffix != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeHasSuffix(*i.AttestationTypeHasSuffix))
 	}
-	if i.RecoveryCodeGT != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeGT(*i.RecoveryCodeGT))
+	if i.AttestationTypeIsNil {
+		predicates = append(predicates, webauthncredential.AttestationTypeIsNil())
 	}
-	if i.RecoveryCodeGTE != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeGTE(*i.RecoveryCodeGTE))
+	if i.AttestationTypeNotNil {
+		predicates = append(predicates, webauthncredential.AttestationTypeNotNil())
 	}
-	if i.RecoveryCodeLT != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeLT(*i.RecoveryCodeLT))
+	if i.AttestationTypeEqualFold != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeEqualFold(*i.AttestationTypeEqualFold))
 	}
-	if i.RecoveryCodeLTE != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeLTE(*i.RecoveryCodeLTE))
+	if i.AttestationTypeContainsFold != nil {
+		predicates = append(predicates, webauthncredential.AttestationTypeContainsFold(*i.AttestationTypeContainsFold))
 	}
-	if i.RecoveryCodeContains != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeContains(*i.RecoveryCodeContains))
+	if i.Aaguid != nil {
+		predicates = append(predicates, webauthncredential.AaguidEQ(*i.Aaguid))
 	}
-	if i.RecoveryCodeHasPrefix != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeHasPrefix(*i.RecoveryCodeHasPrefix))
+	if i.AaguidNEQ != nil {
+		predicates = append(predicates, webauthncredential.AaguidNEQ(*i.AaguidNEQ))
 	}
-	if i.RecoveryCodeHasSuffix != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeHasSuffix(*i.RecoveryCodeHasSuffix))
+	if len(i.AaguidIn) > 0 {
+		predicates = append(predicates, webauthncredential.AaguidIn(i.AaguidIn...))
 	}
-	if i.RecoveryCodeIsNil {
-		predicates = append(predicates, usersetting.RecoveryCodeIsNil())
+	if len(i.AaguidNotIn) > 0 {
+		predicates = append(predicates, webauthncredential.AaguidNotIn(i.AaguidNotIn...))
 	}
-	if i.RecoveryCodeNotNil {
-		predicates = append(predicates, usersetting.RecoveryCodeNotNil())
+	if i.AaguidGT != nil {
+		predicates = append(predicates, webauthncredential.AaguidGT(*i.AaguidGT))
 	}
-	if i.RecoveryCodeEqualFold != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeEqualFold(*i.RecoveryCodeEqualFold))
+	if i.AaguidGTE != nil {
+		predicates = append(predicates, webauthncredential.AaguidGTE(*i.AaguidGTE))
 	}
-	if i.RecoveryCodeContainsFold != nil {
-		predicates = append(predicates, usersetting.RecoveryCodeContainsFold(*i.RecoveryCodeContainsFold))
+	if i.AaguidLT != nil {
+		predicates = append(predicates, webauthncredential.AaguidLT(*i.AaguidLT))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, usersetting.StatusEQ(*i.Status))
+	if i.AaguidLTE != nil {
+		predicates = append(predicates, webauthncredential.AaguidLTE(*i.AaguidLTE))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, usersetting.StatusNEQ(*i.StatusNEQ))
+	if i.AaguidContains != nil {
+		predicates = append(predicates, webauthncredential.AaguidContains(*i.AaguidContains))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, usersetting.StatusIn(i.StatusIn...))
+	if i.AaguidHasPrefix != nil {
+		predicates = append(predicates, webauthncredential.AaguidHasPrefix(*i.AaguidHasPrefix))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, usersetting.StatusNotIn(i.StatusNotIn...))
+	if i.AaguidHasSuffix != nil {
+		predicates = append(predicates, webauthncredential.AaguidHasSuffix(*i.AaguidHasSuffix))
 	}
-	if i.Role != nil {
-		predicates = append(predicates, usersetting.RoleEQ(*i.Role))
+	if i.AaguidIsNil {
+		predicates = append(predicates, webauthncredential.AaguidIsNil())
 	}
-	if i.RoleNEQ != nil {
-		predicates = append(predicates, usersetting.RoleNEQ(*i.RoleNEQ))
+	if i.AaguidNotNil {
+		predicates = append(predicates, webauthncredential.AaguidNotNil())
 	}
-	if len(i.RoleIn) > 0 {
-		predicates = append(predicates, usersetting.RoleIn(i.RoleIn...))
+	if i.AaguidEqualFold != nil {
+		predicates = append(predicates, webauthncredential.AaguidEqualFold(*i.AaguidEqualFold))
 	}
-	if len(i.RoleNotIn) > 0 {
-		predicates = append(predicates, usersetting.RoleNotIn(i.RoleNotIn...))
+	if i.AaguidContainsFold != nil {
+		predicates = append(predicates, webauthncredential.AaguidContainsFold(*i.AaguidContainsFold))
 	}
-	if i.EmailConfirmed != nil {
-		predicates = append(predicates, usersetting.EmailConfirmedEQ(*i.EmailConfirmed))
+	if i.SignCount != nil {
+		predicates = append(predicates, webauthncredential.SignCountEQ(*i.SignCount))
 	}
-	if i.EmailConfirmedNEQ != nil {
-		predicates = append(predicates, usersetting.EmailConfirmedNEQ(*i.EmailConfirmedNEQ))
+	if i.SignCountNEQ != nil {
+		predicates = append(predicates, webauthncredential.SignCountNEQ(*i.SignCountNEQ))
+	}
+	if len(i.SignCountIn) > 0 {
+		predicates = append(predicates, webauthncredential.SignCountIn(i.SignCountIn...))
+	}
+	if len(i.SignCountNotIn) > 0 {
+		predicates = append(predicates, webauthncredential.SignCountNotIn(i.SignCountNotIn...))
+	}
+	if i.SignCountGT != nil {
+		predicates = append(predicates, webauthncredential.SignCountGT(*i.SignCountGT))
+	}
+	if i.SignCountGTE != nil {
+		predicates = append(predicates, webauthncredential.SignCountGTE(*i.SignCountGTE))
+	}
+	if i.SignCountLT != nil {
+		predicates = append(predicates, webauthncredential.SignCountLT(*i.SignCountLT))
+	}
+	if i.SignCountLTE != nil {
+		predicates = append(predicates, webauthncredential.SignCountLTE(*i.SignCountLTE))
+	}
+	if i.BackupEligible != nil {
+		predicates = append(predicates, webauthncredential.BackupEligibleEQ(*i.BackupEligible))
+	}
+	if i.BackupEligibleNEQ != nil {
+		predicates = append(predicates, webauthncredential.BackupEligibleNEQ(*i.BackupEligibleNEQ))
+	}
+	if i.BackupState != nil {
+		predicates = append(predicates, webauthncredential.BackupStateEQ(*i.BackupState))
+	}
+	if i.BackupStateNEQ != nil {
+		predicates = append(predicates, webauthncredential.BackupStateNEQ(*i.BackupStateNEQ))
+	}
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtEQ(*i.LastUsedAt))
+	}
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, webauthncredential.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, webauthncredential.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, webauthncredential.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, webauthncredential.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, webauthncredential.LastUsedAtNotNil())
 	}
 
-	if i.HasUser != nil {
-		p := usersetting.HasUser()
-		if !*i.HasUser {
-			p = usersetting.Not(p)
+	if i.HasOwner != nil {
+		p := webauthncredential.HasOwner()
+		if !*i.HasOwner {
+			p = webauthncredential.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, usersetting.HasUserWith(with...))
+		predicates = append(predicates, webauthncredential.HasOwnerWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUserSettingWhereInput
+		return nil, ErrEmptyWebauthnCredentialWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return usersetting.And(predicates...), nil
+		return webauthncredential.And(predicates...), nil
 	}
 }
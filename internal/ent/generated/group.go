@@ -56,13 +56,16 @@ type GroupEdges struct {
 	Users []*User `json:"users,omitempty"`
 	// Owner holds the value of the owner edge.
 	Owner *Organization `json:"owner,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*GroupMembership `json:"members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
-	totalCount [3]map[string]int
+	totalCount [4]map[string]int
 
-	namedUsers map[string][]*User
+	namedUsers   map[string][]*User
+	namedMembers map[string][]*GroupMembership
 }
 
 // SettingOrErr returns the Setting value or an error if the edge
@@ -100,6 +103,15 @@ func (e GroupEdges) OwnerOrErr() (*Organization, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) MembersOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[3] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -233,6 +245,11 @@ func (gr *Group) QueryOwner() *OrganizationQuery {
 	return NewGroupClient(gr.config).QueryOwner(gr)
 }
 
+// QueryMembers queries the "members" edge of the Group entity.
+func (gr *Group) QueryMembers() *GroupMembershipQuery {
+	return NewGroupClient(gr.config).QueryMembers(gr)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -316,5 +333,29 @@ func (gr *Group) appendNamedUsers(name string, edges ...*User) {
 	}
 }
 
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (gr *Group) NamedMembers(name string) ([]*GroupMembership, error) {
+	if gr.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := gr.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (gr *Group) appendNamedMembers(name string, edges ...*GroupMembership) {
+	if gr.Edges.namedMembers == nil {
+		gr.Edges.namedMembers = make(map[string][]*GroupMembership)
+	}
+	if len(edges) == 0 {
+		gr.Edges.namedMembers[name] = []*GroupMembership{}
+	} else {
+		gr.Edges.namedMembers[name] = append(gr.Edges.namedMembers[name], edges...)
+	}
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
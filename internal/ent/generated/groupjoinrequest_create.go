@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+)
+
+// GroupJoinRequestCreate is the builder for creating a GroupJoinRequest entity.
+type GroupJoinRequestCreate struct {
+	config
+	mutation *GroupJoinRequestMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gjrc *GroupJoinRequestCreate) SetCreatedAt(t time.Time) *GroupJoinRequestCreate {
+	gjrc.mutation.SetCreatedAt(t)
+	return gjrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableCreatedAt(t *time.Time) *GroupJoinRequestCreate {
+	if t != nil {
+		gjrc.SetCreatedAt(*t)
+	}
+	return gjrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gjrc *GroupJoinRequestCreate) SetUpdatedAt(t time.Time) *GroupJoinRequestCreate {
+	gjrc.mutation.SetUpdatedAt(t)
+	return gjrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableUpdatedAt(t *time.Time) *GroupJoinRequestCreate {
+	if t != nil {
+		gjrc.SetUpdatedAt(*t)
+	}
+	return gjrc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (gjrc *GroupJoinRequestCreate) SetCreatedBy(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetCreatedBy(s)
+	return gjrc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableCreatedBy(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetCreatedBy(*s)
+	}
+	return gjrc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (gjrc *GroupJoinRequestCreate) SetUpdatedBy(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetUpdatedBy(s)
+	return gjrc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableUpdatedBy(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetUpdatedBy(*s)
+	}
+	return gjrc
+}
+
+// SetGroupID sets the "group_id" field.
+func (gjrc *GroupJoinRequestCreate) SetGroupID(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetGroupID(s)
+	return gjrc
+}
+
+// SetUserID sets the "user_id" field.
+func (gjrc *GroupJoinRequestCreate) SetUserID(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetUserID(s)
+	return gjrc
+}
+
+// SetMessage sets the "message" field.
+func (gjrc *GroupJoinRequestCreate) SetMessage(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetMessage(s)
+	return gjrc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableMessage(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetMessage(*s)
+	}
+	return gjrc
+}
+
+// SetStatus sets the "status" field.
+func (gjrc *GroupJoinRequestCreate) SetStatus(gr groupjoinrequest.Status) *GroupJoinRequestCreate {
+	gjrc.mutation.SetStatus(gr)
+	return gjrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableStatus(gr *groupjoinrequest.Status) *GroupJoinRequestCreate {
+	if gr != nil {
+		gjrc.SetStatus(*gr)
+	}
+	return gjrc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (gjrc *GroupJoinRequestCreate) SetReviewerID(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetReviewerID(s)
+	return gjrc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableReviewerID(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetReviewerID(*s)
+	}
+	return gjrc
+}
+
+// SetID sets the "id" field.
+func (gjrc *GroupJoinRequestCreate) SetID(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetID(s)
+	return gjrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableID(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetID(*s)
+	}
+	return gjrc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gjrc *GroupJoinRequestCreate) SetGroup(g *Group) *GroupJoinRequestCreate {
+	return gjrc.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gjrc *GroupJoinRequestCreate) SetUser(u *User) *GroupJoinRequestCreate {
+	return gjrc.SetUserID(u.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjrc *GroupJoinRequestCreate) Mutation() *GroupJoinRequestMutation {
+	return gjrc.mutation
+}
+
+// Save creates the GroupJoinRequest in the database.
+func (gjrc *GroupJoinRequestCreate) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	if err := gjrc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, gjrc.sqlSave, gjrc.mutation, gjrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gjrc *GroupJoinRequestCreate) SaveX(ctx context.Context) *GroupJoinRequest {
+	v, err := gjrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjrc *GroupJoinRequestCreate) Exec(ctx context.Context) error {
+	_, err := gjrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrc *GroupJoinRequestCreate) ExecX(ctx context.Context) {
+	if err := gjrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gjrc *GroupJoinRequestCreate) defaults() error {
+	if _, ok := gjrc.mutation.CreatedAt(); !ok {
+		if groupjoinrequest.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized groupjoinrequest.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := groupjoinrequest.DefaultCreatedAt()
+		gjrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gjrc.mutation.UpdatedAt(); !ok {
+		if groupjoinrequest.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized groupjoinrequest.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := groupjoinrequest.DefaultUpdatedAt()
+		gjrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := gjrc.mutation.Status(); !ok {
+		v := groupjoinrequest.DefaultStatus
+		gjrc.mutation.SetStatus(v)
+	}
+	if _, ok := gjrc.mutation.ID(); !ok {
+		if groupjoinrequest.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized groupjoinrequest.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := groupjoinrequest.DefaultID()
+		gjrc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjrc *GroupJoinRequestCreate) check() error {
+	if _, ok := gjrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "GroupJoinRequest.created_at"`)}
+	}
+	if _, ok := gjrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "GroupJoinRequest.updated_at"`)}
+	}
+	if _, ok := gjrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`generated: missing required field "GroupJoinRequest.group_id"`)}
+	}
+	if _, ok := gjrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "GroupJoinRequest.user_id"`)}
+	}
+	if _, ok := gjrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "GroupJoinRequest.status"`)}
+	}
+	if v, ok := gjrc.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := gjrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`generated: missing required edge "GroupJoinRequest.group"`)}
+	}
+	if _, ok := gjrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "GroupJoinRequest.user"`)}
+	}
+	return nil
+}
+
+func (gjrc *GroupJoinRequestCreate) sqlSave(ctx context.Context) (*GroupJoinRequest, error) {
+	if err := gjrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gjrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gjrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected GroupJoinRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	gjrc.mutation.id = &_node.ID
+	gjrc.mutation.done = true
+	return _node, nil
+}
+
+func (gjrc *GroupJoinRequestCreate) createSpec() (*GroupJoinRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupJoinRequest{config: gjrc.config}
+		_spec = sqlgraph.NewCreateSpec(groupjoinrequest.Table, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeString))
+	)
+	_spec.Schema = gjrc.schemaConfig.GroupJoinRequest
+	if id, ok := gjrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gjrc.mutation.CreatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gjrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := gjrc.mutation.CreatedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := gjrc.mutation.UpdatedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := gjrc.mutation.Message(); ok {
+		_spec.SetField(groupjoinrequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := gjrc.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := gjrc.mutation.ReviewerID(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewerID, field.TypeString, value)
+		_node.ReviewerID = value
+	}
+	if nodes := gjrc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = gjrc.schemaConfig.GroupJoinRequest
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gjrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = gjrc.schemaConfig.GroupJoinRequest
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupJoinRequestCreateBulk is the builder for creating many GroupJoinRequest entities in bulk.
+type GroupJoinRequestCreateBulk struct {
+	config
+	err      error
+	builders []*GroupJoinRequestCreate
+}
+
+// Save creates the GroupJoinRequest entities in the database.
+func (gjrcb *GroupJoinRequestCreateBulk) Save(ctx context.Context) ([]*GroupJoinRequest, error) {
+	if gjrcb.err != nil {
+		return nil, gjrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gjrcb.builders))
+	nodes := make([]*GroupJoinRequest, len(gjrcb.builders))
+	mutators := make([]Mutator, len(gjrcb.builders))
+	for i := range gjrcb.builders {
+		func(i int, root context.Context) {
+			builder := gjrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupJoinRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gjrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gjrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gjrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjrcb *GroupJoinRequestCreateBulk) SaveX(ctx context.Context) []*GroupJoinRequest {
+	v, err := gjrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjrcb *GroupJoinRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := gjrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrcb *GroupJoinRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := gjrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
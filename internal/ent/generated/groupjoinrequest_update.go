@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// GroupJoinRequestUpdate is the builder for updating GroupJoinRequest entities.
+type GroupJoinRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupJoinRequestMutation
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (gjru *GroupJoinRequestUpdate) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdate {
+	gjru.mutation.Where(ps...)
+	return gjru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gjru *GroupJoinRequestUpdate) SetUpdatedAt(t time.Time) *GroupJoinRequestUpdate {
+	gjru.mutation.SetUpdatedAt(t)
+	return gjru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (gjru *GroupJoinRequestUpdate) SetUpdatedBy(s string) *GroupJoinRequestUpdate {
+	gjru.mutation.SetUpdatedBy(s)
+	return gjru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (gjru *GroupJoinRequestUpdate) SetNillableUpdatedBy(s *string) *GroupJoinRequestUpdate {
+	if s != nil {
+		gjru.SetUpdatedBy(*s)
+	}
+	return gjru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (gjru *GroupJoinRequestUpdate) ClearUpdatedBy() *GroupJoinRequestUpdate {
+	gjru.mutation.ClearUpdatedBy()
+	return gjru
+}
+
+// SetStatus sets the "status" field.
+func (gjru *GroupJoinRequestUpdate) SetStatus(gr groupjoinrequest.Status) *GroupJoinRequestUpdate {
+	gjru.mutation.SetStatus(gr)
+	return gjru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gjru *GroupJoinRequestUpdate) SetNillableStatus(gr *groupjoinrequest.Status) *GroupJoinRequestUpdate {
+	if gr != nil {
+		gjru.SetStatus(*gr)
+	}
+	return gjru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (gjru *GroupJoinRequestUpdate) SetReviewerID(s string) *GroupJoinRequestUpdate {
+	gjru.mutation.SetReviewerID(s)
+	return gjru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (gjru *GroupJoinRequestUpdate) SetNillableReviewerID(s *string) *GroupJoinRequestUpdate {
+	if s != nil {
+		gjru.SetReviewerID(*s)
+	}
+	return gjru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (gjru *GroupJoinRequestUpdate) ClearReviewerID() *GroupJoinRequestUpdate {
+	gjru.mutation.ClearReviewerID()
+	return gjru
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjru *GroupJoinRequestUpdate) Mutation() *GroupJoinRequestMutation {
+	return gjru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gjru *GroupJoinRequestUpdate) Save(ctx context.Context) (int, error) {
+	if err := gjru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, gjru.sqlSave, gjru.mutation, gjru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjru *GroupJoinRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := gjru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gjru *GroupJoinRequestUpdate) Exec(ctx context.Context) error {
+	_, err := gjru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjru *GroupJoinRequestUpdate) ExecX(ctx context.Context) {
+	if err := gjru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gjru *GroupJoinRequestUpdate) defaults() error {
+	if _, ok := gjru.mutation.UpdatedAt(); !ok {
+		if groupjoinrequest.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized groupjoinrequest.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := groupjoinrequest.UpdateDefaultUpdatedAt()
+		gjru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjru *GroupJoinRequestUpdate) check() error {
+	if v, ok := gjru.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := gjru.mutation.GroupID(); gjru.mutation.GroupCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "GroupJoinRequest.group"`)
+	}
+	if _, ok := gjru.mutation.UserID(); gjru.mutation.UserCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	return nil
+}
+
+func (gjru *GroupJoinRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gjru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeString))
+	if ps := gjru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gjru.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gjru.mutation.CreatedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := gjru.mutation.UpdatedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedBy, field.TypeString, value)
+	}
+	if gjru.mutation.UpdatedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldUpdatedBy, field.TypeString)
+	}
+	if gjru.mutation.MessageCleared() {
+		_spec.ClearField(groupjoinrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := gjru.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gjru.mutation.ReviewerID(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewerID, field.TypeString, value)
+	}
+	if gjru.mutation.ReviewerIDCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewerID, field.TypeString)
+	}
+	_spec.Node.Schema = gjru.schemaConfig.GroupJoinRequest
+	ctx = internal.NewSchemaConfigContext(ctx, gjru.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, gjru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gjru.mutation.done = true
+	return n, nil
+}
+
+// GroupJoinRequestUpdateOne is the builder for updating a single GroupJoinRequest entity.
+type GroupJoinRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupJoinRequestMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gjruo *GroupJoinRequestUpdateOne) SetUpdatedAt(t time.Time) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetUpdatedAt(t)
+	return gjruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (gjruo *GroupJoinRequestUpdateOne) SetUpdatedBy(s string) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetUpdatedBy(s)
+	return gjruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (gjruo *GroupJoinRequestUpdateOne) SetNillableUpdatedBy(s *string) *GroupJoinRequestUpdateOne {
+	if s != nil {
+		gjruo.SetUpdatedBy(*s)
+	}
+	return gjruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (gjruo *GroupJoinRequestUpdateOne) ClearUpdatedBy() *GroupJoinRequestUpdateOne {
+	gjruo.mutation.ClearUpdatedBy()
+	return gjruo
+}
+
+// SetStatus sets the "status" field.
+func (gjruo *GroupJoinRequestUpdateOne) SetStatus(gr groupjoinrequest.Status) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetStatus(gr)
+	return gjruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gjruo *GroupJoinRequestUpdateOne) SetNillableStatus(gr *groupjoinrequest.Status) *GroupJoinRequestUpdateOne {
+	if gr != nil {
+		gjruo.SetStatus(*gr)
+	}
+	return gjruo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (gjruo *GroupJoinRequestUpdateOne) SetReviewerID(s string) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetReviewerID(s)
+	return gjruo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (gjruo *GroupJoinRequestUpdateOne) SetNillableReviewerID(s *string) *GroupJoinRequestUpdateOne {
+	if s != nil {
+		gjruo.SetReviewerID(*s)
+	}
+	return gjruo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (gjruo *GroupJoinRequestUpdateOne) ClearReviewerID() *GroupJoinRequestUpdateOne {
+	gjruo.mutation.ClearReviewerID()
+	return gjruo
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjruo *GroupJoinRequestUpdateOne) Mutation() *GroupJoinRequestMutation {
+	return gjruo.mutation
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (gjruo *GroupJoinRequestUpdateOne) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.Where(ps...)
+	return gjruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gjruo *GroupJoinRequestUpdateOne) Select(field string, fields ...string) *GroupJoinRequestUpdateOne {
+	gjruo.fields = append([]string{field}, fields...)
+	return gjruo
+}
+
+// Save executes the query and returns the updated GroupJoinRequest entity.
+func (gjruo *GroupJoinRequestUpdateOne) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	if err := gjruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, gjruo.sqlSave, gjruo.mutation, gjruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjruo *GroupJoinRequestUpdateOne) SaveX(ctx context.Context) *GroupJoinRequest {
+	node, err := gjruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gjruo *GroupJoinRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := gjruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjruo *GroupJoinRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := gjruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gjruo *GroupJoinRequestUpdateOne) defaults() error {
+	if _, ok := gjruo.mutation.UpdatedAt(); !ok {
+		if groupjoinrequest.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized groupjoinrequest.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := groupjoinrequest.UpdateDefaultUpdatedAt()
+		gjruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjruo *GroupJoinRequestUpdateOne) check() error {
+	if v, ok := gjruo.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := gjruo.mutation.GroupID(); gjruo.mutation.GroupCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "GroupJoinRequest.group"`)
+	}
+	if _, ok := gjruo.mutation.UserID(); gjruo.mutation.UserCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	return nil
+}
+
+func (gjruo *GroupJoinRequestUpdateOne) sqlSave(ctx context.Context) (_node *GroupJoinRequest, err error) {
+	if err := gjruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeString))
+	id, ok := gjruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "GroupJoinRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gjruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupjoinrequest.FieldID)
+		for _, f := range fields {
+			if !groupjoinrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != groupjoinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gjruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gjruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gjruo.mutation.CreatedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := gjruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedBy, field.TypeString, value)
+	}
+	if gjruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldUpdatedBy, field.TypeString)
+	}
+	if gjruo.mutation.MessageCleared() {
+		_spec.ClearField(groupjoinrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := gjruo.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gjruo.mutation.ReviewerID(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewerID, field.TypeString, value)
+	}
+	if gjruo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewerID, field.TypeString)
+	}
+	_spec.Node.Schema = gjruo.schemaConfig.GroupJoinRequest
+	ctx = internal.NewSchemaConfigContext(ctx, gjruo.schemaConfig)
+	_node = &GroupJoinRequest{config: gjruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gjruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gjruo.mutation.done = true
+	return _node, nil
+}
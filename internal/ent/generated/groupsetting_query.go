@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (gsq *GroupSettingQuery) prepareQuery(ctx context.Context) error {
 		}
 		gsq.sql = prev
 	}
+	if groupsetting.Policy == nil {
+		return errors.New("generated: uninitialized groupsetting.Policy (forgotten import generated/runtime?)")
+	}
+	if err := groupsetting.Policy.EvalQuery(ctx, gsq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (iq *IntegrationQuery) prepareQuery(ctx context.Context) error {
 		}
 		iq.sql = prev
 	}
+	if integration.Policy == nil {
+		return errors.New("generated: uninitialized integration.Policy (forgotten import generated/runtime?)")
+	}
+	if err := integration.Policy.EvalQuery(ctx, iq); err != nil {
+		return err
+	}
 	return nil
 }
 
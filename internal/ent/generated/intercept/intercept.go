@@ -8,21 +8,34 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -81,6 +94,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q generated.Query) error {
 	return f(ctx, query)
 }
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type APIKeyFunc func(context.Context, *generated.APIKeyQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f APIKeyFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.APIKeyQuery", q)
+}
+
+// The TraverseAPIKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAPIKey func(context.Context, *generated.APIKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAPIKey) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAPIKey) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.APIKeyQuery", q)
+}
+
 // The EmailVerificationTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
 type EmailVerificationTokenFunc func(context.Context, *generated.EmailVerificationTokenQuery) (generated.Value, error)
 
@@ -162,6 +202,60 @@ func (f TraverseGroup) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.GroupQuery", q)
 }
 
+// The GroupJoinRequestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GroupJoinRequestFunc func(context.Context, *generated.GroupJoinRequestQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f GroupJoinRequestFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.GroupJoinRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.GroupJoinRequestQuery", q)
+}
+
+// The TraverseGroupJoinRequest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGroupJoinRequest func(context.Context, *generated.GroupJoinRequestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGroupJoinRequest) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGroupJoinRequest) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.GroupJoinRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.GroupJoinRequestQuery", q)
+}
+
+// The GroupMembershipFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GroupMembershipFunc func(context.Context, *generated.GroupMembershipQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f GroupMembershipFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.GroupMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.GroupMembershipQuery", q)
+}
+
+// The TraverseGroupMembership type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGroupMembership func(context.Context, *generated.GroupMembershipQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGroupMembership) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGroupMembership) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.GroupMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.GroupMembershipQuery", q)
+}
+
 // The GroupSettingFunc type is an adapter to allow the use of ordinary function as a Querier.
 type GroupSettingFunc func(context.Context, *generated.GroupSettingQuery) (generated.Value, error)
 
@@ -216,6 +310,114 @@ func (f TraverseIntegration) Traverse(ctx context.Context, q generated.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *generated.IntegrationQuery", q)
 }
 
+// The InviteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type InviteFunc func(context.Context, *generated.InviteQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f InviteFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.InviteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.InviteQuery", q)
+}
+
+// The TraverseInvite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseInvite func(context.Context, *generated.InviteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseInvite) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseInvite) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.InviteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.InviteQuery", q)
+}
+
+// The MagicLinkTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MagicLinkTokenFunc func(context.Context, *generated.MagicLinkTokenQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f MagicLinkTokenFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.MagicLinkTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.MagicLinkTokenQuery", q)
+}
+
+// The TraverseMagicLinkToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMagicLinkToken func(context.Context, *generated.MagicLinkTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMagicLinkToken) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMagicLinkToken) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.MagicLinkTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.MagicLinkTokenQuery", q)
+}
+
+// The OauthAuthorizationCodeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthAuthorizationCodeFunc func(context.Context, *generated.OauthAuthorizationCodeQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthAuthorizationCodeFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OauthAuthorizationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OauthAuthorizationCodeQuery", q)
+}
+
+// The TraverseOauthAuthorizationCode type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthAuthorizationCode func(context.Context, *generated.OauthAuthorizationCodeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthAuthorizationCode) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthAuthorizationCode) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OauthAuthorizationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OauthAuthorizationCodeQuery", q)
+}
+
+// The OauthClientFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthClientFunc func(context.Context, *generated.OauthClientQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthClientFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OauthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OauthClientQuery", q)
+}
+
+// The TraverseOauthClient type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthClient func(context.Context, *generated.OauthClientQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthClient) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthClient) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OauthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OauthClientQuery", q)
+}
+
 // The OauthProviderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type OauthProviderFunc func(context.Context, *generated.OauthProviderQuery) (generated.Value, error)
 
@@ -270,6 +472,33 @@ func (f TraverseOhAuthTooToken) Traverse(ctx context.Context, q generated.Query)
 	return fmt.Errorf("unexpected query type %T. expect *generated.OhAuthTooTokenQuery", q)
 }
 
+// The OrgMembershipFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrgMembershipFunc func(context.Context, *generated.OrgMembershipQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrgMembershipFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OrgMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OrgMembershipQuery", q)
+}
+
+// The TraverseOrgMembership type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrgMembership func(context.Context, *generated.OrgMembershipQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrgMembership) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrgMembership) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OrgMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OrgMembershipQuery", q)
+}
+
 // The OrganizationFunc type is an adapter to allow the use of ordinary function as a Querier.
 type OrganizationFunc func(context.Context, *generated.OrganizationQuery) (generated.Value, error)
 
@@ -324,6 +553,33 @@ func (f TraverseOrganizationSetting) Traverse(ctx context.Context, q generated.Q
 	return fmt.Errorf("unexpected query type %T. expect *generated.OrganizationSettingQuery", q)
 }
 
+// The OwnershipTransferFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OwnershipTransferFunc func(context.Context, *generated.OwnershipTransferQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OwnershipTransferFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OwnershipTransferQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OwnershipTransferQuery", q)
+}
+
+// The TraverseOwnershipTransfer type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOwnershipTransfer func(context.Context, *generated.OwnershipTransferQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOwnershipTransfer) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOwnershipTransfer) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OwnershipTransferQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OwnershipTransferQuery", q)
+}
+
 // The PasswordResetTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PasswordResetTokenFunc func(context.Context, *generated.PasswordResetTokenQuery) (generated.Value, error)
 
@@ -378,6 +634,60 @@ func (f TraversePersonalAccessToken) Traverse(ctx context.Context, q generated.Q
 	return fmt.Errorf("unexpected query type %T. expect *generated.PersonalAccessTokenQuery", q)
 }
 
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RefreshTokenFunc func(context.Context, *generated.RefreshTokenQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RefreshTokenFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.RefreshTokenQuery", q)
+}
+
+// The TraverseRefreshToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRefreshToken func(context.Context, *generated.RefreshTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRefreshToken) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRefreshToken) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.RefreshTokenQuery", q)
+}
+
+// The RevokedTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RevokedTokenFunc func(context.Context, *generated.RevokedTokenQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RevokedTokenFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.RevokedTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.RevokedTokenQuery", q)
+}
+
+// The TraverseRevokedToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRevokedToken func(context.Context, *generated.RevokedTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRevokedToken) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRevokedToken) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RevokedTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.RevokedTokenQuery", q)
+}
+
 // The SessionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SessionFunc func(context.Context, *generated.SessionQuery) (generated.Value, error)
 
@@ -405,6 +715,33 @@ func (f TraverseSession) Traverse(ctx context.Context, q generated.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *generated.SessionQuery", q)
 }
 
+// The SessionDataFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SessionDataFunc func(context.Context, *generated.SessionDataQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f SessionDataFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.SessionDataQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.SessionDataQuery", q)
+}
+
+// The TraverseSessionData type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSessionData func(context.Context, *generated.SessionDataQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSessionData) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSessionData) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SessionDataQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.SessionDataQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *generated.UserQuery) (generated.Value, error)
 
@@ -459,37 +796,90 @@ func (f TraverseUserSetting) Traverse(ctx context.Context, q generated.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *generated.UserSettingQuery", q)
 }
 
+// The WebauthnCredentialFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebauthnCredentialFunc func(context.Context, *generated.WebauthnCredentialQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebauthnCredentialFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.WebauthnCredentialQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.WebauthnCredentialQuery", q)
+}
+
+// The TraverseWebauthnCredential type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebauthnCredential func(context.Context, *generated.WebauthnCredentialQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebauthnCredential) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebauthnCredential) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebauthnCredentialQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.WebauthnCredentialQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q generated.Query) (Query, error) {
 	switch q := q.(type) {
+	case *generated.APIKeyQuery:
+		return &query[*generated.APIKeyQuery, predicate.APIKey, apikey.OrderOption]{typ: generated.TypeAPIKey, tq: q}, nil
 	case *generated.EmailVerificationTokenQuery:
 		return &query[*generated.EmailVerificationTokenQuery, predicate.EmailVerificationToken, emailverificationtoken.OrderOption]{typ: generated.TypeEmailVerificationToken, tq: q}, nil
 	case *generated.EntitlementQuery:
 		return &query[*generated.EntitlementQuery, predicate.Entitlement, entitlement.OrderOption]{typ: generated.TypeEntitlement, tq: q}, nil
 	case *generated.GroupQuery:
 		return &query[*generated.GroupQuery, predicate.Group, group.OrderOption]{typ: generated.TypeGroup, tq: q}, nil
+	case *generated.GroupJoinRequestQuery:
+		return &query[*generated.GroupJoinRequestQuery, predicate.GroupJoinRequest, groupjoinrequest.OrderOption]{typ: generated.TypeGroupJoinRequest, tq: q}, nil
+	case *generated.GroupMembershipQuery:
+		return &query[*generated.GroupMembershipQuery, predicate.GroupMembership, groupmembership.OrderOption]{typ: generated.TypeGroupMembership, tq: q}, nil
 	case *generated.GroupSettingQuery:
 		return &query[*generated.GroupSettingQuery, predicate.GroupSetting, groupsetting.OrderOption]{typ: generated.TypeGroupSetting, tq: q}, nil
 	case *generated.IntegrationQuery:
 		return &query[*generated.IntegrationQuery, predicate.Integration, integration.OrderOption]{typ: generated.TypeIntegration, tq: q}, nil
+	case *generated.InviteQuery:
+		return &query[*generated.InviteQuery, predicate.Invite, invite.OrderOption]{typ: generated.TypeInvite, tq: q}, nil
+	case *generated.MagicLinkTokenQuery:
+		return &query[*generated.MagicLinkTokenQuery, predicate.MagicLinkToken, magiclinktoken.OrderOption]{typ: generated.TypeMagicLinkToken, tq: q}, nil
+	case *generated.OauthAuthorizationCodeQuery:
+		return &query[*generated.OauthAuthorizationCodeQuery, predicate.OauthAuthorizationCode, oauthauthorizationcode.OrderOption]{typ: generated.TypeOauthAuthorizationCode, tq: q}, nil
+	case *generated.OauthClientQuery:
+		return &query[*generated.OauthClientQuery, predicate.OauthClient, oauthclient.OrderOption]{typ: generated.TypeOauthClient, tq: q}, nil
 	case *generated.OauthProviderQuery:
 		return &query[*generated.OauthProviderQuery, predicate.OauthProvider, oauthprovider.OrderOption]{typ: generated.TypeOauthProvider, tq: q}, nil
 	case *generated.OhAuthTooTokenQuery:
 		return &query[*generated.OhAuthTooTokenQuery, predicate.OhAuthTooToken, ohauthtootoken.OrderOption]{typ: generated.TypeOhAuthTooToken, tq: q}, nil
+	case *generated.OrgMembershipQuery:
+		return &query[*generated.OrgMembershipQuery, predicate.OrgMembership, orgmembership.OrderOption]{typ: generated.TypeOrgMembership, tq: q}, nil
 	case *generated.OrganizationQuery:
 		return &query[*generated.OrganizationQuery, predicate.Organization, organization.OrderOption]{typ: generated.TypeOrganization, tq: q}, nil
 	case *generated.OrganizationSettingQuery:
 		return &query[*generated.OrganizationSettingQuery, predicate.OrganizationSetting, organizationsetting.OrderOption]{typ: generated.TypeOrganizationSetting, tq: q}, nil
+	case *generated.OwnershipTransferQuery:
+		return &query[*generated.OwnershipTransferQuery, predicate.OwnershipTransfer, ownershiptransfer.OrderOption]{typ: generated.TypeOwnershipTransfer, tq: q}, nil
 	case *generated.PasswordResetTokenQuery:
 		return &query[*generated.PasswordResetTokenQuery, predicate.PasswordResetToken, passwordresettoken.OrderOption]{typ: generated.TypePasswordResetToken, tq: q}, nil
 	case *generated.PersonalAccessTokenQuery:
 		return &query[*generated.PersonalAccessTokenQuery, predicate.PersonalAccessToken, personalaccesstoken.OrderOption]{typ: generated.TypePersonalAccessToken, tq: q}, nil
+	case *generated.RefreshTokenQuery:
+		return &query[*generated.RefreshTokenQuery, predicate.RefreshToken, refreshtoken.OrderOption]{typ: generated.TypeRefreshToken, tq: q}, nil
+	case *generated.RevokedTokenQuery:
+		return &query[*generated.RevokedTokenQuery, predicate.RevokedToken, revokedtoken.OrderOption]{typ: generated.TypeRevokedToken, tq: q}, nil
 	case *generated.SessionQuery:
 		return &query[*generated.SessionQuery, predicate.Session, session.OrderOption]{typ: generated.TypeSession, tq: q}, nil
+	case *generated.SessionDataQuery:
+		return &query[*generated.SessionDataQuery, predicate.SessionData, sessiondata.OrderOption]{typ: generated.TypeSessionData, tq: q}, nil
 	case *generated.UserQuery:
 		return &query[*generated.UserQuery, predicate.User, user.OrderOption]{typ: generated.TypeUser, tq: q}, nil
 	case *generated.UserSettingQuery:
 		return &query[*generated.UserSettingQuery, predicate.UserSetting, usersetting.OrderOption]{typ: generated.TypeUserSetting, tq: q}, nil
+	case *generated.WebauthnCredentialQuery:
+		return &query[*generated.WebauthnCredentialQuery, predicate.WebauthnCredential, webauthncredential.OrderOption]{typ: generated.TypeWebauthnCredential, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
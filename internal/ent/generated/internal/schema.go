@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = `{"Schema":"github.com/datumforge/datum/internal/ent/schema","Package":"github.com/datumforge/datum/internal/ent/generated","Schemas":[{"name":"EmailVerificationToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","field":"owner_id","ref_name":"email_verification_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":true,"MixinIndex":3},"annotations":{"EntGQL":{"Skip":63}}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the verification token sent to the user via email which should only be provided to the /verify endpoint + handler"},{"name":"ttl","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the ttl of the verification token which defaults to 7 days"},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the email used as input to generate the verification token; this is used to verify that the token when regenerated within the server matches the token emailed"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"Entitlement","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"entitlements","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"tier","type":{"Type":6,"Ident":"entitlement.Tier","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"free","V":"free"},{"N":"pro","V":"pro"},{"N":"enterprise","V":"enterprise"}],"default":true,"default_value":"free","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"external_customer_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"used to store references to external systems, e.g. Stripe"},{"name":"external_subscription_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"used to store references to external systems, e.g. Stripe"},{"name":"expires","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"whether or not the customers entitlement expires - expires_at will show the time"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the time at which a customer's entitlement will expire, e.g. they've cancelled but paid through the end of the month"},{"name":"cancelled","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"whether or not the customer has cancelled their entitlement - usually used in conjunction with expires and expires at"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Group","config":{"Table":""},"edges":[{"name":"setting","type":"GroupSetting","unique":true,"required":true},{"name":"users","type":"User"},{"name":"owner","type":"Organization","ref_name":"groups","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name"}},"comment":"the name of the group - must be unique within the organization"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the groups description"},{"name":"gravatar_logo_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the URL to an auto generated gravatar image for the group"},{"name":"logo_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the URL to an image uploaded by the customer for the groups avatar image"},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The group's displayed 'friendly' name"}],"indexes":[{"unique":true,"edges":["owner"],"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"GroupSetting","config":{"Table":""},"edges":[{"name":"group","type":"Group","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"visibility","type":{"Type":6,"Ident":"groupsetting.Visibility","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"public","V":"PUBLIC"},{"N":"private","V":"PRIVATE"}],"default":true,"default_value":"PUBLIC","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"whether the group is visible to it's members / owners only or if it's searchable by anyone within the organization"},{"name":"join_policy","type":{"Type":6,"Ident":"groupsetting.JoinPolicy","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"open","V":"OPEN"},{"N":"invite_only","V":"INVITE_ONLY"},{"N":"application_only","V":"APPLICATION_ONLY"},{"N":"invite_or_application","V":"INVITE_OR_APPLICATION"}],"default":true,"default_value":"INVITE_OR_APPLICATION","default_kind":24,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the policy governing ability to freely join a group, whether it requires an invitation, application, or either"},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"},{"name":"sync_to_slack","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"sync_to_github","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Integration","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"integrations","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name"}},"comment":"the name of the integration - must be unique within the organization"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the integration"},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"kind"}}},{"name":"secret_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OauthProvider","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"oauthprovider","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the oauth provider's name"},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the client id for the oauth provider"},{"name":"client_secret","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the client secret"},{"name":"redirect_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the redirect url"},{"name":"scopes","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the scopes"},{"name":"auth_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"the auth url of the provider"},{"name":"token_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"the token url of the provider"},{"name":"auth_style","type":{"Type":14,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"the auth style, 0: auto detect 1: third party log in 2: log in with username and password"},{"name":"info_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"the URL to request user information by token"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OhAuthTooToken","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"scopes","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"nonce","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"claims_user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"claims_username","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}},{"name":"claims_email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"claims_email_verified","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"claims_groups","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"claims_preferred_username","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"position":{"Index":8,"MixedIn":false,"MixinIndex":0}},{"name":"connector_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0}},{"name":"connector_data","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0}},{"name":"last_used","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"position":{"Index":11,"MixedIn":false,"MixinIndex":0}}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Organization","config":{"Table":""},"edges":[{"name":"parent","type":"Organization","field":"parent_organization_id","ref":{"name":"children","type":"Organization","annotations":{"EntGQL":{"RelayConnection":true,"Skip":48}}},"unique":true,"inverse":true,"immutable":true},{"name":"users","type":"User","ref_name":"organizations","inverse":true},{"name":"groups","type":"Group","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"integrations","type":"Integration","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"setting","type":"OrganizationSetting","unique":true,"annotations":{"DATUM_CASCADE":{"Field":"Organization"}}},{"name":"entitlements","type":"Entitlement"},{"name":"oauthprovider","type":"OauthProvider"}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":160,"validators":2,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name","Skip":8}},"comment":"the name of the organization"},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The organization's displayed 'friendly' name"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"An optional description of the organization"},{"name":"parent_organization_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":33,"Type":"ID"},"EntOAS":{"Create":{"Groups":null,"Policy":0},"Delete":{"Groups":null,"Policy":0},"Example":null,"Groups":null,"List":{"Groups":null,"Policy":0},"Read":{"Groups":null,"Policy":0},"ReadOnly":false,"Schema":{"type":"string"},"Skip":false,"Update":{"Groups":null,"Policy":0}}},"comment":"The ID of the parent organization for the organization."},{"name":"personal_org","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"orgs directly associated with a user"}],"indexes":[{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OrganizationSetting","config":{"Table":""},"edges":[{"name":"organization","type":"Organization","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"domains","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"domains associated with the organization"},{"name":"sso_cert","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"sso_entrypoint","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"sso_issuer","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"billing_contact","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Name of the person to contact for billing"},{"name":"billing_email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"billing_phone","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"billing_address","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"tax_identifier","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Usually government-issued tax ID or business ID such as ABN in Australia"},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":[],"default_kind":23,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"PasswordResetToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","ref_name":"reset_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the reset token sent to the user via email which should only be provided to the /forgot-password endpoint + handler"},{"name":"ttl","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the ttl of the reset token which defaults to 15 minutes"},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the email used as input to generate the reset token; this is used to verify that the token when regenerated within the server matches the token emailed"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"PersonalAccessToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","ref_name":"personal_access_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the name associated with the token"},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"sensitive":true},{"name":"abilities","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"what abilites the token should have"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"when the token expires"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"","default_kind":24,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the token's purpose"},{"name":"last_used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}}],"indexes":[{"fields":["token"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Session","config":{"Table":""},"edges":[{"name":"owner","type":"User","field":"user_id","ref_name":"sessions","unique":true,"inverse":true,"required":true,"comment":"Sessions belong to users"}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"session_token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"token is a string token issued to users that has a limited lifetime"},{"name":"issued_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"update_default":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"organization_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"organization ID of the organization the user is accessing"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the user the session is associated with"}],"indexes":[{"unique":true,"fields":["session_token"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"User","config":{"Table":""},"edges":[{"name":"organizations","type":"Organization"},{"name":"sessions","type":"Session","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"groups","type":"Group","ref_name":"users","inverse":true},{"name":"personal_access_tokens","type":"PersonalAccessToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"setting","type":"UserSetting","unique":true,"required":true,"annotations":{"DATUM_CASCADE":{"Field":"User"}}},{"name":"email_verification_tokens","type":"EmailVerificationToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"reset_tokens","type":"PasswordResetToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"first_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"validators":2,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"first_name"}}},{"name":"last_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"last_name"}}},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":3,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The user's displayed 'friendly' name"},{"name":"avatar_remote_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":255,"nillable":true,"optional":true,"validators":2,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"URL of the user's remote avatar"},{"name":"avatar_local_file","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":255,"nillable":true,"optional":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"The user's local avatar file"},{"name":"avatar_updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"The time the user's (local) avatar was last updated"},{"name":"last_seen","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"the time the user was last seen"},{"name":"password","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"user password hash"},{"name":"sub","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"the Subject of the user JWT"},{"name":"oauth","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"whether the user uses oauth for login or not"}],"indexes":[{"unique":true,"fields":["id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"UserSetting","config":{"Table":""},"edges":[{"name":"user","type":"User","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"locked","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"user account is locked if unconfirmed or explicitly locked"},{"name":"silenced_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The time notifications regarding the user were silenced"},{"name":"suspended_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The time the user was suspended"},{"name":"recovery_code","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"local user password recovery code generated during account creation - does not exist for oauth'd users"},{"name":"status","type":{"Type":6,"Ident":"usersetting.Status","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Active","V":"ACTIVE"},{"N":"Inactive","V":"INACTIVE"},{"N":"Deactivated","V":"DEACTIVATED"},{"N":"Suspended","V":"SUSPENDED"}],"default":true,"default_value":"ACTIVE","default_kind":24,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}},{"name":"role","type":{"Type":6,"Ident":"usersetting.Role","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"User","V":"USER"},{"N":"Admin","V":"ADMIN"},{"N":"Owner","V":"OWNER"}],"default":true,"default_value":"USER","default_kind":24,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"permissions","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"email_confirmed","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}}],"Features":["sql/versioned-migration","privacy","schema/snapshot","entql","namedges","sql/schemaconfig","intercept","namedges"]}`
+const Schema = `{"Schema":"github.com/datumforge/datum/internal/ent/schema","Package":"github.com/datumforge/datum/internal/ent/generated","Schemas":[{"name":"APIKey","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","field":"owner_id","ref_name":"api_keys","unique":true,"inverse":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the organization that owns the key"},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the name associated with the key"},{"name":"key_prefix","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the visible prefix of the key used to identify it, the key itself is only returned on creation"},{"name":"key_hash","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the salted hash of the key"},{"name":"abilities","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the scopes the key is restricted to, e.g. org:read or group:write; at least one scope is required and the scopes cannot be changed once the key is created"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"when the key expires, a key without an expiration is valid until it is deleted"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"","default_kind":24,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the key's purpose"},{"name":"last_used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}}}],"indexes":[{"unique":true,"fields":["key_prefix"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"EmailVerificationToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","field":"owner_id","ref_name":"email_verification_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":true,"MixinIndex":3},"annotations":{"EntGQL":{"Skip":63}}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the verification token sent to the user via email which should only be provided to the /verify endpoint + handler"},{"name":"ttl","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the ttl of the verification token which defaults to 7 days"},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the email used as input to generate the verification token; this is used to verify that the token when regenerated within the server matches the token emailed"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"Entitlement","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"entitlements","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"tier","type":{"Type":6,"Ident":"entitlement.Tier","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"free","V":"free"},{"N":"pro","V":"pro"},{"N":"enterprise","V":"enterprise"}],"default":true,"default_value":"free","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"external_customer_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"used to store references to external systems, e.g. Stripe"},{"name":"external_subscription_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"used to store references to external systems, e.g. Stripe"},{"name":"expires","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"whether or not the customers entitlement expires - expires_at will show the time"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the time at which a customer's entitlement will expire, e.g. they've cancelled but paid through the end of the month"},{"name":"cancelled","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"whether or not the customer has cancelled their entitlement - usually used in conjunction with expires and expires at"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Group","config":{"Table":""},"edges":[{"name":"setting","type":"GroupSetting","unique":true,"required":true},{"name":"users","type":"User","through":{"N":"members","T":"GroupMembership"},"annotations":{"EntGQL":{"Skip":48}}},{"name":"owner","type":"Organization","ref_name":"groups","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name"}},"comment":"the name of the group - must be unique within the organization"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the groups description"},{"name":"gravatar_logo_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the URL to an auto generated gravatar image for the group"},{"name":"logo_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"the URL to an image uploaded by the customer for the groups avatar image"},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The group's displayed 'friendly' name"}],"indexes":[{"unique":true,"edges":["owner"],"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"GroupJoinRequest","config":{"Table":""},"edges":[{"name":"group","type":"Group","field":"group_id","unique":true,"required":true,"immutable":true},{"name":"user","type":"User","field":"user_id","unique":true,"required":true,"immutable":true,"annotations":{"EntGQL":{"Skip":48}}}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"group_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the group the user requested to join"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the user who requested to join the group"},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the message of the user to the admins of the group"},{"name":"status","type":{"Type":6,"Ident":"groupjoinrequest.Status","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Pending","V":"PENDING"},{"N":"Approved","V":"APPROVED"},{"N":"Declined","V":"DECLINED"}],"default":true,"default_value":"PENDING","default_kind":24,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the status of the request"},{"name":"reviewer_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the group admin who approved or declined the request"}],"indexes":[{"fields":["group_id","status"]},{"fields":["user_id","group_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true}],"QueryField":{},"RelayConnection":true}}},{"name":"GroupMembership","config":{"Table":""},"edges":[{"name":"group","type":"Group","field":"group_id","unique":true,"required":true,"immutable":true},{"name":"user","type":"User","field":"user_id","unique":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"role","type":{"Type":6,"Ident":"groupmembership.Role","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Admin","V":"ADMIN"},{"N":"Member","V":"MEMBER"}],"default":true,"default_value":"MEMBER","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the role of the user in the group"},{"name":"group_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the group the user is a member of"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the user who is a member of the group"}],"indexes":[{"unique":true,"fields":["user_id","group_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true}],"QueryField":{},"RelayConnection":true}}},{"name":"GroupSetting","config":{"Table":""},"edges":[{"name":"group","type":"Group","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"visibility","type":{"Type":6,"Ident":"groupsetting.Visibility","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"public","V":"PUBLIC"},{"N":"private","V":"PRIVATE"}],"default":true,"default_value":"PUBLIC","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"whether the group is visible to it's members / owners only or if it's searchable by anyone within the organization"},{"name":"join_policy","type":{"Type":6,"Ident":"groupsetting.JoinPolicy","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"open","V":"OPEN"},{"N":"invite_only","V":"INVITE_ONLY"},{"N":"application_only","V":"APPLICATION_ONLY"},{"N":"invite_or_application","V":"INVITE_OR_APPLICATION"}],"default":true,"default_value":"INVITE_OR_APPLICATION","default_kind":24,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the policy governing ability to freely join a group, whether it requires an invitation, application, or either"},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"},{"name":"sync_to_slack","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"sync_to_github","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Integration","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"integrations","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name"}},"comment":"the name of the integration - must be unique within the organization"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the integration"},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"kind"}}},{"name":"secret_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"Invite","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","field":"owner_id","ref_name":"invites","unique":true,"inverse":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the organization the recipient is invited to"},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the invitation token sent to the recipient via email which should only be provided to the /invite endpoint + handler"},{"name":"expires","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the expiration of the invitation, invitations expire 7 days after they are sent"},{"name":"recipient","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":2,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the email address of the user invited to the organization"},{"name":"status","type":{"Type":6,"Ident":"invite.Status","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"InvitationSent","V":"INVITATION_SENT"},{"N":"InvitationAccepted","V":"INVITATION_ACCEPTED"},{"N":"InvitationDeclined","V":"INVITATION_DECLINED"},{"N":"InvitationRevoked","V":"INVITATION_REVOKED"},{"N":"InvitationExpired","V":"INVITATION_EXPIRED"}],"default":true,"default_value":"INVITATION_SENT","default_kind":24,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the status of the invitation"},{"name":"role","type":{"Type":6,"Ident":"invite.Role","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Admin","V":"ADMIN"},{"N":"Member","V":"MEMBER"}],"default":true,"default_value":"MEMBER","default_kind":24,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"the role the recipient is given in the organization when they accept the invitation"},{"name":"requestor_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the user who sent the invitation"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}},{"fields":["recipient","owner_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true}],"QueryField":{},"RelayConnection":true}}},{"name":"MagicLinkToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","ref_name":"magic_link_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the login token sent to the user via email which should only be provided to the /login/magic endpoint + handler"},{"name":"ttl","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the ttl of the login token which defaults to 15 minutes, the token is expired once it is used"},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the email used as input to generate the login token; this is used to verify that the token when regenerated within the server matches the token emailed"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"OauthAuthorizationCode","config":{"Table":""},"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"code_hash","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"the sha256 hash of the authorization code"},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the id of the oauth client the code was issued to"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the user that authorized the client"},{"name":"redirect_uri","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the redirect uri of the authorization request, the same uri must be sent when the code is exchanged"},{"name":"scopes","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the scopes granted to the client"},{"name":"nonce","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"the nonce of the authorization request, added to the id token"},{"name":"code_challenge","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"the PKCE code challenge of the authorization request"},{"name":"code_challenge_method","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"the PKCE code challenge method of the authorization request"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"when the code expires"},{"name":"used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"when the code was exchanged for a token pair"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"OauthClient","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","field":"owner_id","ref_name":"oauth_clients","unique":true,"inverse":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the organization that registered the client"},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the name of the client shown to users when they authorize it"},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the public identifier of the client used in authorization requests"},{"name":"client_secret_hash","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the salted hash of the client secret, public clients do not have a secret"},{"name":"redirect_uris","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the urls users can be redirected to after authorizing the client, the redirect uri of an authorization request must exactly match one of them"},{"name":"scopes","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"the scopes the client can request, e.g. openid, email or org:read; the scopes cannot be changed once the client is created"},{"name":"public","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"public clients, such as single page and native apps, cannot keep a secret; they are not issued a secret and must use PKCE"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"","default_kind":24,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the client"}],"indexes":[{"unique":true,"fields":["client_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OauthProvider","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","ref_name":"oauthprovider","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the oauth provider's name"},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the client id for the oauth provider"},{"name":"client_secret","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the client secret"},{"name":"redirect_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the redirect url"},{"name":"scopes","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the scopes"},{"name":"auth_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"the auth url of the provider"},{"name":"token_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"the token url of the provider"},{"name":"auth_style","type":{"Type":14,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"the auth style, 0: auto detect 1: third party log in 2: log in with username and password"},{"name":"info_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"the URL to request user information by token"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OhAuthTooToken","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"client_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"scopes","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"nonce","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"claims_user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"claims_username","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}},{"name":"claims_email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"claims_email_verified","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"claims_groups","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"claims_preferred_username","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"position":{"Index":8,"MixedIn":false,"MixinIndex":0}},{"name":"connector_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0}},{"name":"connector_data","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0}},{"name":"last_used","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"position":{"Index":11,"MixedIn":false,"MixinIndex":0}}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":1}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OrgMembership","config":{"Table":""},"edges":[{"name":"organization","type":"Organization","field":"organization_id","unique":true,"required":true,"immutable":true},{"name":"user","type":"User","field":"user_id","unique":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"role","type":{"Type":6,"Ident":"orgmembership.Role","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Owner","V":"OWNER"},{"N":"Admin","V":"ADMIN"},{"N":"Member","V":"MEMBER"}],"default":true,"default_value":"MEMBER","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the role of the user in the organization"},{"name":"organization_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the organization the user is a member of"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the user who is a member of the organization"}],"indexes":[{"unique":true,"fields":["user_id","organization_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true}],"QueryField":{},"RelayConnection":true}}},{"name":"Organization","config":{"Table":""},"edges":[{"name":"parent","type":"Organization","field":"parent_organization_id","ref":{"name":"children","type":"Organization","annotations":{"EntGQL":{"RelayConnection":true,"Skip":48}}},"unique":true,"inverse":true,"annotations":{"EntGQL":{"Skip":32}}},{"name":"users","type":"User","ref_name":"organizations","through":{"N":"members","T":"OrgMembership"},"inverse":true,"annotations":{"EntGQL":{"Skip":48}}},{"name":"groups","type":"Group","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"integrations","type":"Integration","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"setting","type":"OrganizationSetting","unique":true,"annotations":{"DATUM_CASCADE":{"Field":"Organization"}}},{"name":"entitlements","type":"Entitlement"},{"name":"oauthprovider","type":"OauthProvider"},{"name":"api_keys","type":"APIKey","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"oauth_clients","type":"OauthClient","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"invites","type":"Invite","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":160,"validators":2,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"name","Skip":8}},"comment":"the name of the organization"},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The organization's displayed 'friendly' name"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"An optional description of the organization"},{"name":"parent_organization_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":33,"Type":"ID"},"EntOAS":{"Create":{"Groups":null,"Policy":0},"Delete":{"Groups":null,"Policy":0},"Example":null,"Groups":null,"List":{"Groups":null,"Policy":0},"Read":{"Groups":null,"Policy":0},"ReadOnly":false,"Schema":{"type":"string"},"Skip":false,"Update":{"Groups":null,"Policy":0}}},"comment":"The ID of the parent organization for the organization."},{"name":"personal_org","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"orgs directly associated with a user"}],"indexes":[{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0},{"Index":2,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OrganizationSetting","config":{"Table":""},"edges":[{"name":"organization","type":"Organization","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"domains","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"domains associated with the organization"},{"name":"sso_cert","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"sso_entrypoint","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"sso_issuer","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"billing_contact","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Name of the person to contact for billing"},{"name":"billing_email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"billing_phone","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"billing_address","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"tax_identifier","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Usually government-issued tax ID or business ID such as ABN in Australia"},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":[],"default_kind":23,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"OwnershipTransfer","config":{"Table":""},"edges":[{"name":"owner","type":"Organization","field":"owner_id","unique":true,"required":true,"immutable":true},{"name":"group","type":"Group","field":"group_id","unique":true,"immutable":true},{"name":"requestor","type":"User","field":"requestor_id","unique":true,"required":true,"immutable":true},{"name":"recipient","type":"User","field":"recipient_id","unique":true,"required":true,"immutable":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the organization that is transferred, or the organization of the group that is transferred"},{"name":"group_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the group that is transferred, not set when the organization is transferred"},{"name":"requestor_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the owner of the organization, or admin of the group, who transfers it"},{"name":"recipient_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the user the organization or group is transferred to"},{"name":"status","type":{"Type":6,"Ident":"ownershiptransfer.Status","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Pending","V":"PENDING"},{"N":"Accepted","V":"ACCEPTED"},{"N":"Declined","V":"DECLINED"},{"N":"Canceled","V":"CANCELED"},{"N":"Expired","V":"EXPIRED"}],"default":true,"default_value":"PENDING","default_kind":24,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the status of the transfer"},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the transfer token sent to the recipient via email which should only be provided to the /transfer endpoint + handler"},{"name":"expires","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"the expiration of the transfer, transfers expire 7 days after they are requested"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"fields":["owner_id","status"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"QueryField":{},"RelayConnection":true}}},{"name":"PasswordResetToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","ref_name":"reset_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the reset token sent to the user via email which should only be provided to the /forgot-password endpoint + handler"},{"name":"ttl","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the ttl of the reset token which defaults to 15 minutes"},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the email used as input to generate the reset token; this is used to verify that the token when regenerated within the server matches the token emailed"},{"name":"secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the comparison secret to verify the token's signature"}],"indexes":[{"unique":true,"fields":["token"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"PersonalAccessToken","config":{"Table":""},"edges":[{"name":"owner","type":"User","ref_name":"personal_access_tokens","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the name associated with the token"},{"name":"token_prefix","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the visible prefix of the token used to identify it, the token itself is only returned on creation"},{"name":"token_hash","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the salted hash of the token, empty for tokens created before tokens were hashed"},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the plaintext token of tokens created before tokens were hashed, they can be used until they expire"},{"name":"abilities","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"when the token expires"},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"","default_kind":24,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":8}},"comment":"a description of the token's purpose"},{"name":"last_used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}}],"indexes":[{"unique":true,"fields":["token_prefix"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}},{"fields":["token"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"RefreshToken","config":{"Table":""},"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"jti","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the jwt id of the refresh token"},{"name":"family_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the jwt id of the first refresh token of the family, issued on login"},{"name":"parent_jti","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the jwt id of the refresh token that was exchanged for this refresh token"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the user the refresh token was issued to"},{"name":"used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"when the refresh token was exchanged for a new token pair"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"when the refresh token expires"}],"indexes":[{"fields":["family_id"]},{"fields":["user_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"RevokedToken","config":{"Table":""},"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"jti","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the jwt id of the revoked token pair"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the user the revoked token was issued to"},{"name":"reason","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"the reason the token was revoked"},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"when the revoked token pair expires, after which the revocation no longer needs to be kept"}],"indexes":[{"fields":["expires_at"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"Session","config":{"Table":""},"edges":[{"name":"owner","type":"User","field":"user_id","ref_name":"sessions","unique":true,"inverse":true,"required":true,"comment":"Sessions belong to users"}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"session_token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"token is a string token issued to users that has a limited lifetime"},{"name":"issued_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"organization_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"organization ID of the organization the user is accessing"},{"name":"user_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the user the session is associated with"},{"name":"user_agent","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the user agent of the client the session was started from"},{"name":"ip_address","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the ip address of the client the session was started from"},{"name":"last_seen_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the last time the tokens of the session were refreshed"},{"name":"revoked_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the time the session was revoked, the tokens issued for a revoked session are rejected"}],"indexes":[{"unique":true,"fields":["session_token"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0},{"Index":1,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":2},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"SessionData","config":{"Table":""},"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"the session token stored in the session cookie"},{"name":"data","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"the encoded data of the session"},{"name":"expiry","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"when the session expires, after which it is removed by the cleanup task"}],"indexes":[{"fields":["expiry"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"Skip":63}}},{"name":"User","config":{"Table":""},"edges":[{"name":"organizations","type":"Organization","through":{"N":"org_memberships","T":"OrgMembership"},"annotations":{"EntGQL":{"Skip":48}}},{"name":"sessions","type":"Session","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"groups","type":"Group","ref_name":"users","through":{"N":"group_memberships","T":"GroupMembership"},"inverse":true,"annotations":{"EntGQL":{"Skip":48}}},{"name":"personal_access_tokens","type":"PersonalAccessToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"setting","type":"UserSetting","unique":true,"required":true,"annotations":{"DATUM_CASCADE":{"Field":"User"}}},{"name":"email_verification_tokens","type":"EmailVerificationToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"reset_tokens","type":"PasswordResetToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"magic_link_tokens","type":"MagicLinkToken","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}},{"name":"webauthn_credentials","type":"WebauthnCredential","annotations":{"DATUM_CASCADE":{"Field":"Owner"}}}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntGQL":{"Skip":48}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2}},{"name":"email","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"first_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"validators":2,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"first_name"}}},{"name":"last_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"validators":2,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"last_name"}}},{"name":"display_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":64,"default":true,"default_value":"","default_kind":24,"validators":3,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"OrderField":"display_name"}},"comment":"The user's displayed 'friendly' name"},{"name":"avatar_remote_url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":255,"nillable":true,"optional":true,"validators":2,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"URL of the user's remote avatar"},{"name":"avatar_local_file","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":255,"nillable":true,"optional":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"The user's local avatar file"},{"name":"avatar_updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"The time the user's (local) avatar was last updated"},{"name":"last_seen","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"update_default":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"the time the user was last seen"},{"name":"password","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"user password hash"},{"name":"password_history","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"hashes of the most recent passwords, including the current password, that cannot be reused"},{"name":"password_changed_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the time the password was last set, passwords older than the max age of the password policy have to be reset"},{"name":"sub","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"optional":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"the Subject of the user JWT"},{"name":"oauth","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"whether the user uses oauth for login or not"}],"indexes":[{"unique":true,"fields":["id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":1},{"Index":0,"MixedIn":false,"MixinIndex":0}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"UserSetting","config":{"Table":""},"edges":[{"name":"user","type":"User","ref_name":"setting","unique":true,"inverse":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"locked","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"user account is locked if unconfirmed or explicitly locked"},{"name":"silenced_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The time notifications regarding the user were silenced"},{"name":"suspended_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The time the user was suspended"},{"name":"recovery_code","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"local user password recovery code generated during account creation - does not exist for oauth'd users"},{"name":"status","type":{"Type":6,"Ident":"usersetting.Status","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"Active","V":"ACTIVE"},{"N":"Inactive","V":"INACTIVE"},{"N":"Deactivated","V":"DEACTIVATED"},{"N":"Suspended","V":"SUSPENDED"}],"default":true,"default_value":"ACTIVE","default_kind":24,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}},{"name":"role","type":{"Type":6,"Ident":"usersetting.Role","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"enums":[{"N":"User","V":"USER"},{"N":"Admin","V":"ADMIN"},{"N":"Owner","V":"OWNER"}],"default":true,"default_value":"USER","default_kind":24,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"permissions","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":6,"MixedIn":false,"MixinIndex":0}},{"name":"email_confirmed","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0}},{"name":"tags","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"tags associated with the object"},{"name":"is_tfa_enabled","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"whether the user has confirmed enrollment in totp multi-factor authentication"},{"name":"tfa_secret","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the totp secret, set on enrollment and required to be confirmed before it is enabled"},{"name":"recovery_codes","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"hashes of the one-time multi-factor recovery codes, codes are removed once used"},{"name":"tfa_last_step","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":63}},"comment":"the totp time step of the last accepted passcode, passcodes for the same or an earlier step are rejected"},{"name":"failed_login_attempts","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the number of consecutive failed logins, reset on a successful login"},{"name":"locked_until","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":14,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"logins are rejected until this time after repeated failed logins"},{"name":"unlock_token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"nillable":true,"optional":true,"position":{"Index":15,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the token emailed to the user to unlock the account after it was locked by failed logins"},{"name":"unlock_token_secret","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"nillable":true,"optional":true,"position":{"Index":16,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the secret used to verify the unlock token"},{"name":"unlock_token_expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":17,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":63}},"comment":"the time the unlock token expires"}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":3}],"annotations":{"EntGQL":{"MutationInputs":[{"IsCreate":true},{}],"QueryField":{},"RelayConnection":true}}},{"name":"WebauthnCredential","config":{"Table":""},"edges":[{"name":"owner","type":"User","field":"owner_id","ref_name":"webauthn_credentials","unique":true,"inverse":true,"required":true}],"fields":[{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0}},{"name":"updated_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"updated_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":true,"MixinIndex":0}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1}},{"name":"deleted_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"deleted_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":2},"annotations":{"EntGQL":{"Skip":48}}},{"name":"owner_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":true,"MixinIndex":3},"annotations":{"EntGQL":{"Skip":63}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"the user provided name of the passkey"},{"name":"credential_id","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":63}},"comment":"the credential id generated by the authenticator"},{"name":"public_key","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntGQL":{"Skip":63}},"comment":"the public key portion of the credential used to verify assertions"},{"name":"attestation_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"the attestation format used by the authenticator when creating the credential"},{"name":"aaguid","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"the AAGUID of the authenticator model"},{"name":"sign_count","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":6,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntGQL":{"Skip":48}},"comment":"the signature counter of the authenticator, used to detect cloned authenticators"},{"name":"transports","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"the transports the authenticator supports"},{"name":"backup_eligible","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"whether the credential can be backed up or synced between devices"},{"name":"backup_state","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"whether the credential is currently backed up or synced"},{"name":"last_used_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"the last time the credential was used to authenticate"}],"indexes":[{"unique":true,"fields":["credential_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"deleted_at is NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":true,"MixinIndex":2}],"interceptors":[{"Index":0,"MixedIn":true,"MixinIndex":2}],"policy":[{"Index":0,"MixedIn":true,"MixinIndex":4},{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"DATUM_SCHEMAGEN":{"Skip":true},"EntGQL":{"QueryField":{},"RelayConnection":true}}}],"Features":["sql/versioned-migration","privacy","schema/snapshot","entql","namedges","sql/schemaconfig","intercept","namedges"]}`
@@ -7,22 +7,33 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
+	APIKey                 string // APIKey table.
 	EmailVerificationToken string // EmailVerificationToken table.
 	Entitlement            string // Entitlement table.
 	Group                  string // Group table.
-	GroupUsers             string // Group-users->User table.
+	GroupJoinRequest       string // GroupJoinRequest table.
+	GroupMembership        string // GroupMembership table.
 	GroupSetting           string // GroupSetting table.
 	Integration            string // Integration table.
+	Invite                 string // Invite table.
+	MagicLinkToken         string // MagicLinkToken table.
+	OauthAuthorizationCode string // OauthAuthorizationCode table.
+	OauthClient            string // OauthClient table.
 	OauthProvider          string // OauthProvider table.
 	OhAuthTooToken         string // OhAuthTooToken table.
+	OrgMembership          string // OrgMembership table.
 	Organization           string // Organization table.
 	OrganizationSetting    string // OrganizationSetting table.
+	OwnershipTransfer      string // OwnershipTransfer table.
 	PasswordResetToken     string // PasswordResetToken table.
 	PersonalAccessToken    string // PersonalAccessToken table.
+	RefreshToken           string // RefreshToken table.
+	RevokedToken           string // RevokedToken table.
 	Session                string // Session table.
+	SessionData            string // SessionData table.
 	User                   string // User table.
-	UserOrganizations      string // User-organizations->Organization table.
 	UserSetting            string // UserSetting table.
+	WebauthnCredential     string // WebauthnCredential table.
 }
 
 type schemaCtxKey struct{}
@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InviteCreate) SetCreatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InviteCreate) SetUpdatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUpdatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ic *InviteCreate) SetCreatedBy(s string) *InviteCreate {
+	ic.mutation.SetCreatedBy(s)
+	return ic
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedBy(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetCreatedBy(*s)
+	}
+	return ic
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ic *InviteCreate) SetUpdatedBy(s string) *InviteCreate {
+	ic.mutation.SetUpdatedBy(s)
+	return ic
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUpdatedBy(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetUpdatedBy(*s)
+	}
+	return ic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InviteCreate) SetDeletedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableDeletedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ic *InviteCreate) SetDeletedBy(s string) *InviteCreate {
+	ic.mutation.SetDeletedBy(s)
+	return ic
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableDeletedBy(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetDeletedBy(*s)
+	}
+	return ic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ic *InviteCreate) SetOwnerID(s string) *InviteCreate {
+	ic.mutation.SetOwnerID(s)
+	return ic
+}
+
+// SetToken sets the "token" field.
+func (ic *InviteCreate) SetToken(s string) *InviteCreate {
+	ic.mutation.SetToken(s)
+	return ic
+}
+
+// SetExpires sets the "expires" field.
+func (ic *InviteCreate) SetExpires(t time.Time) *InviteCreate {
+	ic.mutation.SetExpires(t)
+	return ic
+}
+
+// SetRecipient sets the "recipient" field.
+func (ic *InviteCreate) SetRecipient(s string) *InviteCreate {
+	ic.mutation.SetRecipient(s)
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InviteCreate) SetStatus(i invite.Status) *InviteCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableStatus(i *invite.Status) *InviteCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetRole sets the "role" field.
+func (ic *InviteCreate) SetRole(i invite.Role) *InviteCreate {
+	ic.mutation.SetRole(i)
+	return ic
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRole(i *invite.Role) *InviteCreate {
+	if i != nil {
+		ic.SetRole(*i)
+	}
+	return ic
+}
+
+// SetRequestorID sets the "requestor_id" field.
+func (ic *InviteCreate) SetRequestorID(s string) *InviteCreate {
+	ic.mutation.SetRequestorID(s)
+	return ic
+}
+
+// SetNillableRequestorID sets the "requestor_id" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRequestorID(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetRequestorID(*s)
+	}
+	return ic
+}
+
+// SetSecret sets the "secret" field.
+func (ic *InviteCreate) SetSecret(b []byte) *InviteCreate {
+	ic.mutation.SetSecret(b)
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *InviteCreate) SetID(s string) *InviteCreate {
+	ic.mutation.SetID(s)
+	return ic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableID(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetID(*s)
+	}
+	return ic
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (ic *InviteCreate) SetOwner(o *Organization) *InviteCreate {
+	return ic.SetOwnerID(o.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (ic *InviteCreate) Mutation() *InviteMutation {
+	return ic.mutation
+}
+
+// Save creates the Invite in the database.
+func (ic *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InviteCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InviteCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InviteCreate) defaults() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if invite.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized invite.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := invite.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if invite.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized invite.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := invite.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invite.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		v := invite.DefaultRole
+		ic.mutation.SetRole(v)
+	}
+	if _, ok := ic.mutation.ID(); !ok {
+		if invite.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized invite.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := invite.DefaultID()
+		ic.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InviteCreate) check() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Invite.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Invite.updated_at"`)}
+	}
+	if _, ok := ic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "Invite.owner_id"`)}
+	}
+	if _, ok := ic.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`generated: missing required field "Invite.token"`)}
+	}
+	if v, ok := ic.mutation.Token(); ok {
+		if err := invite.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "Invite.token": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`generated: missing required field "Invite.expires"`)}
+	}
+	if _, ok := ic.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`generated: missing required field "Invite.recipient"`)}
+	}
+	if v, ok := ic.mutation.Recipient(); ok {
+		if err := invite.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`generated: validator failed for field "Invite.recipient": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Invite.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Invite.status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`generated: missing required field "Invite.role"`)}
+	}
+	if v, ok := ic.mutation.Role(); ok {
+		if err := invite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "Invite.role": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`generated: missing required field "Invite.secret"`)}
+	}
+	if v, ok := ic.mutation.Secret(); ok {
+		if err := invite.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "Invite.secret": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "Invite.owner"`)}
+	}
+	return nil
+}
+
+func (ic *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Invite.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invite.Table, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString))
+	)
+	_spec.Schema = ic.schemaConfig.Invite
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.SetField(invite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ic.mutation.CreatedBy(); ok {
+		_spec.SetField(invite.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ic.mutation.UpdatedBy(); ok {
+		_spec.SetField(invite.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(invite.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := ic.mutation.DeletedBy(); ok {
+		_spec.SetField(invite.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := ic.mutation.Token(); ok {
+		_spec.SetField(invite.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := ic.mutation.Expires(); ok {
+		_spec.SetField(invite.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	if value, ok := ic.mutation.Recipient(); ok {
+		_spec.SetField(invite.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invite.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := ic.mutation.RequestorID(); ok {
+		_spec.SetField(invite.FieldRequestorID, field.TypeString, value)
+		_node.RequestorID = value
+	}
+	if value, ok := ic.mutation.Secret(); ok {
+		_spec.SetField(invite.FieldSecret, field.TypeBytes, value)
+		_node.Secret = &value
+	}
+	if nodes := ic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.OwnerTable,
+			Columns: []string{invite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ic.schemaConfig.Invite
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCreate
+}
+
+// Save creates the Invite entities in the database.
+func (icb *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invite, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iu *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InviteUpdate) SetUpdatedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iu *InviteUpdate) SetUpdatedBy(s string) *InviteUpdate {
+	iu.mutation.SetUpdatedBy(s)
+	return iu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableUpdatedBy(s *string) *InviteUpdate {
+	if s != nil {
+		iu.SetUpdatedBy(*s)
+	}
+	return iu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iu *InviteUpdate) ClearUpdatedBy() *InviteUpdate {
+	iu.mutation.ClearUpdatedBy()
+	return iu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InviteUpdate) SetDeletedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableDeletedAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InviteUpdate) ClearDeletedAt() *InviteUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (iu *InviteUpdate) SetDeletedBy(s string) *InviteUpdate {
+	iu.mutation.SetDeletedBy(s)
+	return iu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableDeletedBy(s *string) *InviteUpdate {
+	if s != nil {
+		iu.SetDeletedBy(*s)
+	}
+	return iu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (iu *InviteUpdate) ClearDeletedBy() *InviteUpdate {
+	iu.mutation.ClearDeletedBy()
+	return iu
+}
+
+// SetToken sets the "token" field.
+func (iu *InviteUpdate) SetToken(s string) *InviteUpdate {
+	iu.mutation.SetToken(s)
+	return iu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableToken(s *string) *InviteUpdate {
+	if s != nil {
+		iu.SetToken(*s)
+	}
+	return iu
+}
+
+// SetExpires sets the "expires" field.
+func (iu *InviteUpdate) SetExpires(t time.Time) *InviteUpdate {
+	iu.mutation.SetExpires(t)
+	return iu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableExpires(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetExpires(*t)
+	}
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InviteUpdate) SetStatus(i invite.Status) *InviteUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableStatus(i *invite.Status) *InviteUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetSecret sets the "secret" field.
+func (iu *InviteUpdate) SetSecret(b []byte) *InviteUpdate {
+	iu.mutation.SetSecret(b)
+	return iu
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iu *InviteUpdate) Mutation() *InviteMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InviteUpdate) Save(ctx context.Context) (int, error) {
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InviteUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InviteUpdate) defaults() error {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		if invite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized invite.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := invite.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InviteUpdate) check() error {
+	if v, ok := iu.mutation.Token(); ok {
+		if err := invite.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "Invite.token": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Invite.status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Secret(); ok {
+		if err := invite.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "Invite.secret": %w`, err)}
+		}
+	}
+	if _, ok := iu.mutation.OwnerID(); iu.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "Invite.owner"`)
+	}
+	return nil
+}
+
+func (iu *InviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iu.mutation.CreatedByCleared() {
+		_spec.ClearField(invite.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.UpdatedBy(); ok {
+		_spec.SetField(invite.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iu.mutation.UpdatedByCleared() {
+		_spec.ClearField(invite.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(invite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(invite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.DeletedBy(); ok {
+		_spec.SetField(invite.FieldDeletedBy, field.TypeString, value)
+	}
+	if iu.mutation.DeletedByCleared() {
+		_spec.ClearField(invite.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.Token(); ok {
+		_spec.SetField(invite.FieldToken, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Expires(); ok {
+		_spec.SetField(invite.FieldExpires, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invite.FieldStatus, field.TypeEnum, value)
+	}
+	if iu.mutation.RequestorIDCleared() {
+		_spec.ClearField(invite.FieldRequestorID, field.TypeString)
+	}
+	if value, ok := iu.mutation.Secret(); ok {
+		_spec.SetField(invite.FieldSecret, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = iu.schemaConfig.Invite
+	ctx = internal.NewSchemaConfigContext(ctx, iu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InviteUpdateOne) SetUpdatedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iuo *InviteUpdateOne) SetUpdatedBy(s string) *InviteUpdateOne {
+	iuo.mutation.SetUpdatedBy(s)
+	return iuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableUpdatedBy(s *string) *InviteUpdateOne {
+	if s != nil {
+		iuo.SetUpdatedBy(*s)
+	}
+	return iuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iuo *InviteUpdateOne) ClearUpdatedBy() *InviteUpdateOne {
+	iuo.mutation.ClearUpdatedBy()
+	return iuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InviteUpdateOne) SetDeletedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableDeletedAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InviteUpdateOne) ClearDeletedAt() *InviteUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (iuo *InviteUpdateOne) SetDeletedBy(s string) *InviteUpdateOne {
+	iuo.mutation.SetDeletedBy(s)
+	return iuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableDeletedBy(s *string) *InviteUpdateOne {
+	if s != nil {
+		iuo.SetDeletedBy(*s)
+	}
+	return iuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (iuo *InviteUpdateOne) ClearDeletedBy() *InviteUpdateOne {
+	iuo.mutation.ClearDeletedBy()
+	return iuo
+}
+
+// SetToken sets the "token" field.
+func (iuo *InviteUpdateOne) SetToken(s string) *InviteUpdateOne {
+	iuo.mutation.SetToken(s)
+	return iuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableToken(s *string) *InviteUpdateOne {
+	if s != nil {
+		iuo.SetToken(*s)
+	}
+	return iuo
+}
+
+// SetExpires sets the "expires" field.
+func (iuo *InviteUpdateOne) SetExpires(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetExpires(t)
+	return iuo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableExpires(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetExpires(*t)
+	}
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InviteUpdateOne) SetStatus(i invite.Status) *InviteUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableStatus(i *invite.Status) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetSecret sets the "secret" field.
+func (iuo *InviteUpdateOne) SetSecret(b []byte) *InviteUpdateOne {
+	iuo.mutation.SetSecret(b)
+	return iuo
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iuo *InviteUpdateOne) Mutation() *InviteMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iuo *InviteUpdateOne) Where(ps ...predicate.Invite) *InviteUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (iuo *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InviteUpdateOne) defaults() error {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		if invite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized invite.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := invite.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InviteUpdateOne) check() error {
+	if v, ok := iuo.mutation.Token(); ok {
+		if err := invite.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "Invite.token": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Invite.status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Secret(); ok {
+		if err := invite.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "Invite.secret": %w`, err)}
+		}
+	}
+	if _, ok := iuo.mutation.OwnerID(); iuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "Invite.owner"`)
+	}
+	return nil
+}
+
+func (iuo *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.CreatedByCleared() {
+		_spec.ClearField(invite.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(invite.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(invite.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(invite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(invite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.DeletedBy(); ok {
+		_spec.SetField(invite.FieldDeletedBy, field.TypeString, value)
+	}
+	if iuo.mutation.DeletedByCleared() {
+		_spec.ClearField(invite.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Token(); ok {
+		_spec.SetField(invite.FieldToken, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Expires(); ok {
+		_spec.SetField(invite.FieldExpires, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invite.FieldStatus, field.TypeEnum, value)
+	}
+	if iuo.mutation.RequestorIDCleared() {
+		_spec.ClearField(invite.FieldRequestorID, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Secret(); ok {
+		_spec.SetField(invite.FieldSecret, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = iuo.schemaConfig.Invite
+	ctx = internal.NewSchemaConfigContext(ctx, iuo.schemaConfig)
+	_node = &Invite{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
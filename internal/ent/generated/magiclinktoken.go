@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+)
+
+// MagicLinkToken is the model entity for the MagicLinkToken schema.
+type MagicLinkToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// the login token sent to the user via email which should only be provided to the /login/magic endpoint + handler
+	Token string `json:"token,omitempty"`
+	// the ttl of the login token which defaults to 15 minutes, the token is expired once it is used
+	TTL *time.Time `json:"ttl,omitempty"`
+	// the email used as input to generate the login token; this is used to verify that the token when regenerated within the server matches the token emailed
+	Email string `json:"email,omitempty"`
+	// the comparison secret to verify the token's signature
+	Secret *[]byte `json:"secret,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MagicLinkTokenQuery when eager-loading is set.
+	Edges                  MagicLinkTokenEdges `json:"edges"`
+	user_magic_link_tokens *string
+	selectValues           sql.SelectValues
+}
+
+// MagicLinkTokenEdges holds the relations/edges for other nodes in the graph.
+type MagicLinkTokenEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MagicLinkTokenEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MagicLinkToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case magiclinktoken.FieldSecret:
+			values[i] = new([]byte)
+		case magiclinktoken.FieldID, magiclinktoken.FieldCreatedBy, magiclinktoken.FieldUpdatedBy, magiclinktoken.FieldDeletedBy, magiclinktoken.FieldToken, magiclinktoken.FieldEmail:
+			values[i] = new(sql.NullString)
+		case magiclinktoken.FieldCreatedAt, magiclinktoken.FieldUpdatedAt, magiclinktoken.FieldDeletedAt, magiclinktoken.FieldTTL:
+			values[i] = new(sql.NullTime)
+		case magiclinktoken.ForeignKeys[0]: // user_magic_link_tokens
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MagicLinkToken fields.
+func (mlt *MagicLinkToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case magiclinktoken.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				mlt.ID = value.String
+			}
+		case magiclinktoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mlt.CreatedAt = value.Time
+			}
+		case magiclinktoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mlt.UpdatedAt = value.Time
+			}
+		case magiclinktoken.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				mlt.CreatedBy = value.String
+			}
+		case magiclinktoken.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				mlt.UpdatedBy = value.String
+			}
+		case magiclinktoken.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				mlt.DeletedAt = value.Time
+			}
+		case magiclinktoken.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				mlt.DeletedBy = value.String
+			}
+		case magiclinktoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				mlt.Token = value.String
+			}
+		case magiclinktoken.FieldTTL:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ttl", values[i])
+			} else if value.Valid {
+				mlt.TTL = new(time.Time)
+				*mlt.TTL = value.Time
+			}
+		case magiclinktoken.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				mlt.Email = value.String
+			}
+		case magiclinktoken.FieldSecret:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value != nil {
+				mlt.Secret = value
+			}
+		case magiclinktoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_magic_link_tokens", values[i])
+			} else if value.Valid {
+				mlt.user_magic_link_tokens = new(string)
+				*mlt.user_magic_link_tokens = value.String
+			}
+		default:
+			mlt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MagicLinkToken.
+// This includes values selected through modifiers, order, etc.
+func (mlt *MagicLinkToken) Value(name string) (ent.Value, error) {
+	return mlt.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the MagicLinkToken entity.
+func (mlt *MagicLinkToken) QueryOwner() *UserQuery {
+	return NewMagicLinkTokenClient(mlt.config).QueryOwner(mlt)
+}
+
+// Update returns a builder for updating this MagicLinkToken.
+// Note that you need to call MagicLinkToken.Unwrap() before calling this method if this MagicLinkToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mlt *MagicLinkToken) Update() *MagicLinkTokenUpdateOne {
+	return NewMagicLinkTokenClient(mlt.config).UpdateOne(mlt)
+}
+
+// Unwrap unwraps the MagicLinkToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mlt *MagicLinkToken) Unwrap() *MagicLinkToken {
+	_tx, ok := mlt.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: MagicLinkToken is not a transactional entity")
+	}
+	mlt.config.driver = _tx.drv
+	return mlt
+}
+
+// String implements the fmt.Stringer.
+func (mlt *MagicLinkToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("MagicLinkToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mlt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mlt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mlt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(mlt.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(mlt.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(mlt.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(mlt.DeletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(mlt.Token)
+	builder.WriteString(", ")
+	if v := mlt.TTL; v != nil {
+		builder.WriteString("ttl=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(mlt.Email)
+	builder.WriteString(", ")
+	if v := mlt.Secret; v != nil {
+		builder.WriteString("secret=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MagicLinkTokens is a parsable slice of MagicLinkToken.
+type MagicLinkTokens []*MagicLinkToken
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+)
+
+// MagicLinkTokenCreate is the builder for creating a MagicLinkToken entity.
+type MagicLinkTokenCreate struct {
+	config
+	mutation *MagicLinkTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mltc *MagicLinkTokenCreate) SetCreatedAt(t time.Time) *MagicLinkTokenCreate {
+	mltc.mutation.SetCreatedAt(t)
+	return mltc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableCreatedAt(t *time.Time) *MagicLinkTokenCreate {
+	if t != nil {
+		mltc.SetCreatedAt(*t)
+	}
+	return mltc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mltc *MagicLinkTokenCreate) SetUpdatedAt(t time.Time) *MagicLinkTokenCreate {
+	mltc.mutation.SetUpdatedAt(t)
+	return mltc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableUpdatedAt(t *time.Time) *MagicLinkTokenCreate {
+	if t != nil {
+		mltc.SetUpdatedAt(*t)
+	}
+	return mltc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mltc *MagicLinkTokenCreate) SetCreatedBy(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetCreatedBy(s)
+	return mltc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableCreatedBy(s *string) *MagicLinkTokenCreate {
+	if s != nil {
+		mltc.SetCreatedBy(*s)
+	}
+	return mltc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (mltc *MagicLinkTokenCreate) SetUpdatedBy(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetUpdatedBy(s)
+	return mltc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableUpdatedBy(s *string) *MagicLinkTokenCreate {
+	if s != nil {
+		mltc.SetUpdatedBy(*s)
+	}
+	return mltc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mltc *MagicLinkTokenCreate) SetDeletedAt(t time.Time) *MagicLinkTokenCreate {
+	mltc.mutation.SetDeletedAt(t)
+	return mltc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableDeletedAt(t *time.Time) *MagicLinkTokenCreate {
+	if t != nil {
+		mltc.SetDeletedAt(*t)
+	}
+	return mltc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (mltc *MagicLinkTokenCreate) SetDeletedBy(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetDeletedBy(s)
+	return mltc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableDeletedBy(s *string) *MagicLinkTokenCreate {
+	if s != nil {
+		mltc.SetDeletedBy(*s)
+	}
+	return mltc
+}
+
+// SetToken sets the "token" field.
+func (mltc *MagicLinkTokenCreate) SetToken(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetToken(s)
+	return mltc
+}
+
+// SetTTL sets the "ttl" field.
+func (mltc *MagicLinkTokenCreate) SetTTL(t time.Time) *MagicLinkTokenCreate {
+	mltc.mutation.SetTTL(t)
+	return mltc
+}
+
+// SetEmail sets the "email" field.
+func (mltc *MagicLinkTokenCreate) SetEmail(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetEmail(s)
+	return mltc
+}
+
+// SetSecret sets the "secret" field.
+func (mltc *MagicLinkTokenCreate) SetSecret(b []byte) *MagicLinkTokenCreate {
+	mltc.mutation.SetSecret(b)
+	return mltc
+}
+
+// SetID sets the "id" field.
+func (mltc *MagicLinkTokenCreate) SetID(s string) *MagicLinkTokenCreate {
+	mltc.mutation.SetID(s)
+	return mltc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mltc *MagicLinkTokenCreate) SetNillableID(s *string) *MagicLinkTokenCreate {
+	if s != nil {
+		mltc.SetID(*s)
+	}
+	return mltc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mltc *MagicLinkTokenCreate) SetOwnerID(id string) *MagicLinkTokenCreate {
+	mltc.mutation.SetOwnerID(id)
+	return mltc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mltc *MagicLinkTokenCreate) SetOwner(u *User) *MagicLinkTokenCreate {
+	return mltc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MagicLinkTokenMutation object of the builder.
+func (mltc *MagicLinkTokenCreate) Mutation() *MagicLinkTokenMutation {
+	return mltc.mutation
+}
+
+// Save creates the MagicLinkToken in the database.
+func (mltc *MagicLinkTokenCreate) Save(ctx context.Context) (*MagicLinkToken, error) {
+	if err := mltc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mltc.sqlSave, mltc.mutation, mltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mltc *MagicLinkTokenCreate) SaveX(ctx context.Context) *MagicLinkToken {
+	v, err := mltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mltc *MagicLinkTokenCreate) Exec(ctx context.Context) error {
+	_, err := mltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltc *MagicLinkTokenCreate) ExecX(ctx context.Context) {
+	if err := mltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mltc *MagicLinkTokenCreate) defaults() error {
+	if _, ok := mltc.mutation.CreatedAt(); !ok {
+		if magiclinktoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized magiclinktoken.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := magiclinktoken.DefaultCreatedAt()
+		mltc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mltc.mutation.UpdatedAt(); !ok {
+		if magiclinktoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized magiclinktoken.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := magiclinktoken.DefaultUpdatedAt()
+		mltc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mltc.mutation.ID(); !ok {
+		if magiclinktoken.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized magiclinktoken.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := magiclinktoken.DefaultID()
+		mltc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mltc *MagicLinkTokenCreate) check() error {
+	if _, ok := mltc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "MagicLinkToken.created_at"`)}
+	}
+	if _, ok := mltc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "MagicLinkToken.updated_at"`)}
+	}
+	if _, ok := mltc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`generated: missing required field "MagicLinkToken.token"`)}
+	}
+	if v, ok := mltc.mutation.Token(); ok {
+		if err := magiclinktoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.token": %w`, err)}
+		}
+	}
+	if _, ok := mltc.mutation.TTL(); !ok {
+		return &ValidationError{Name: "ttl", err: errors.New(`generated: missing required field "MagicLinkToken.ttl"`)}
+	}
+	if _, ok := mltc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`generated: missing required field "MagicLinkToken.email"`)}
+	}
+	if v, ok := mltc.mutation.Email(); ok {
+		if err := magiclinktoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.email": %w`, err)}
+		}
+	}
+	if _, ok := mltc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`generated: missing required field "MagicLinkToken.secret"`)}
+	}
+	if v, ok := mltc.mutation.Secret(); ok {
+		if err := magiclinktoken.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.secret": %w`, err)}
+		}
+	}
+	if _, ok := mltc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "MagicLinkToken.owner"`)}
+	}
+	return nil
+}
+
+func (mltc *MagicLinkTokenCreate) sqlSave(ctx context.Context) (*MagicLinkToken, error) {
+	if err := mltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MagicLinkToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mltc.mutation.id = &_node.ID
+	mltc.mutation.done = true
+	return _node, nil
+}
+
+func (mltc *MagicLinkTokenCreate) createSpec() (*MagicLinkToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MagicLinkToken{config: mltc.config}
+		_spec = sqlgraph.NewCreateSpec(magiclinktoken.Table, sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeString))
+	)
+	_spec.Schema = mltc.schemaConfig.MagicLinkToken
+	if id, ok := mltc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mltc.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mltc.mutation.UpdatedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mltc.mutation.CreatedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := mltc.mutation.UpdatedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := mltc.mutation.DeletedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := mltc.mutation.DeletedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := mltc.mutation.Token(); ok {
+		_spec.SetField(magiclinktoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := mltc.mutation.TTL(); ok {
+		_spec.SetField(magiclinktoken.FieldTTL, field.TypeTime, value)
+		_node.TTL = &value
+	}
+	if value, ok := mltc.mutation.Email(); ok {
+		_spec.SetField(magiclinktoken.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := mltc.mutation.Secret(); ok {
+		_spec.SetField(magiclinktoken.FieldSecret, field.TypeBytes, value)
+		_node.Secret = &value
+	}
+	if nodes := mltc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = mltc.schemaConfig.MagicLinkToken
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_magic_link_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MagicLinkTokenCreateBulk is the builder for creating many MagicLinkToken entities in bulk.
+type MagicLinkTokenCreateBulk struct {
+	config
+	err      error
+	builders []*MagicLinkTokenCreate
+}
+
+// Save creates the MagicLinkToken entities in the database.
+func (mltcb *MagicLinkTokenCreateBulk) Save(ctx context.Context) ([]*MagicLinkToken, error) {
+	if mltcb.err != nil {
+		return nil, mltcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mltcb.builders))
+	nodes := make([]*MagicLinkToken, len(mltcb.builders))
+	mutators := make([]Mutator, len(mltcb.builders))
+	for i := range mltcb.builders {
+		func(i int, root context.Context) {
+			builder := mltcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MagicLinkTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mltcb *MagicLinkTokenCreateBulk) SaveX(ctx context.Context) []*MagicLinkToken {
+	v, err := mltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mltcb *MagicLinkTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := mltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltcb *MagicLinkTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := mltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// MagicLinkTokenQuery is the builder for querying MagicLinkToken entities.
+type MagicLinkTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []magiclinktoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MagicLinkToken
+	withOwner  *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*MagicLinkToken) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MagicLinkTokenQuery builder.
+func (mltq *MagicLinkTokenQuery) Where(ps ...predicate.MagicLinkToken) *MagicLinkTokenQuery {
+	mltq.predicates = append(mltq.predicates, ps...)
+	return mltq
+}
+
+// Limit the number of records to be returned by this query.
+func (mltq *MagicLinkTokenQuery) Limit(limit int) *MagicLinkTokenQuery {
+	mltq.ctx.Limit = &limit
+	return mltq
+}
+
+// Offset to start from.
+func (mltq *MagicLinkTokenQuery) Offset(offset int) *MagicLinkTokenQuery {
+	mltq.ctx.Offset = &offset
+	return mltq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mltq *MagicLinkTokenQuery) Unique(unique bool) *MagicLinkTokenQuery {
+	mltq.ctx.Unique = &unique
+	return mltq
+}
+
+// Order specifies how the records should be ordered.
+func (mltq *MagicLinkTokenQuery) Order(o ...magiclinktoken.OrderOption) *MagicLinkTokenQuery {
+	mltq.order = append(mltq.order, o...)
+	return mltq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (mltq *MagicLinkTokenQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: mltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(magiclinktoken.Table, magiclinktoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, magiclinktoken.OwnerTable, magiclinktoken.OwnerColumn),
+		)
+		schemaConfig := mltq.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.MagicLinkToken
+		fromU = sqlgraph.SetNeighbors(mltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MagicLinkToken entity from the query.
+// Returns a *NotFoundError when no MagicLinkToken was found.
+func (mltq *MagicLinkTokenQuery) First(ctx context.Context) (*MagicLinkToken, error) {
+	nodes, err := mltq.Limit(1).All(setContextOp(ctx, mltq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{magiclinktoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) FirstX(ctx context.Context) *MagicLinkToken {
+	node, err := mltq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MagicLinkToken ID from the query.
+// Returns a *NotFoundError when no MagicLinkToken ID was found.
+func (mltq *MagicLinkTokenQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mltq.Limit(1).IDs(setContextOp(ctx, mltq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{magiclinktoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) FirstIDX(ctx context.Context) string {
+	id, err := mltq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MagicLinkToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MagicLinkToken entity is found.
+// Returns a *NotFoundError when no MagicLinkToken entities are found.
+func (mltq *MagicLinkTokenQuery) Only(ctx context.Context) (*MagicLinkToken, error) {
+	nodes, err := mltq.Limit(2).All(setContextOp(ctx, mltq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{magiclinktoken.Label}
+	default:
+		return nil, &NotSingularError{magiclinktoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) OnlyX(ctx context.Context) *MagicLinkToken {
+	node, err := mltq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MagicLinkToken ID in the query.
+// Returns a *NotSingularError when more than one MagicLinkToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mltq *MagicLinkTokenQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mltq.Limit(2).IDs(setContextOp(ctx, mltq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{magiclinktoken.Label}
+	default:
+		err = &NotSingularError{magiclinktoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mltq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MagicLinkTokens.
+func (mltq *MagicLinkTokenQuery) All(ctx context.Context) ([]*MagicLinkToken, error) {
+	ctx = setContextOp(ctx, mltq.ctx, "All")
+	if err := mltq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MagicLinkToken, *MagicLinkTokenQuery]()
+	return withInterceptors[[]*MagicLinkToken](ctx, mltq, qr, mltq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) AllX(ctx context.Context) []*MagicLinkToken {
+	nodes, err := mltq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MagicLinkToken IDs.
+func (mltq *MagicLinkTokenQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if mltq.ctx.Unique == nil && mltq.path != nil {
+		mltq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mltq.ctx, "IDs")
+	if err = mltq.Select(magiclinktoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) IDsX(ctx context.Context) []string {
+	ids, err := mltq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mltq *MagicLinkTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mltq.ctx, "Count")
+	if err := mltq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mltq, querierCount[*MagicLinkTokenQuery](), mltq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) CountX(ctx context.Context) int {
+	count, err := mltq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mltq *MagicLinkTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mltq.ctx, "Exist")
+	switch _, err := mltq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mltq *MagicLinkTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := mltq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MagicLinkTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mltq *MagicLinkTokenQuery) Clone() *MagicLinkTokenQuery {
+	if mltq == nil {
+		return nil
+	}
+	return &MagicLinkTokenQuery{
+		config:     mltq.config,
+		ctx:        mltq.ctx.Clone(),
+		order:      append([]magiclinktoken.OrderOption{}, mltq.order...),
+		inters:     append([]Interceptor{}, mltq.inters...),
+		predicates: append([]predicate.MagicLinkToken{}, mltq.predicates...),
+		withOwner:  mltq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  mltq.sql.Clone(),
+		path: mltq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (mltq *MagicLinkTokenQuery) WithOwner(opts ...func(*UserQuery)) *MagicLinkTokenQuery {
+	query := (&UserClient{config: mltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mltq.withOwner = query
+	return mltq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MagicLinkToken.Query().
+//		GroupBy(magiclinktoken.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (mltq *MagicLinkTokenQuery) GroupBy(field string, fields ...string) *MagicLinkTokenGroupBy {
+	mltq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MagicLinkTokenGroupBy{build: mltq}
+	grbuild.flds = &mltq.ctx.Fields
+	grbuild.label = magiclinktoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MagicLinkToken.Query().
+//		Select(magiclinktoken.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mltq *MagicLinkTokenQuery) Select(fields ...string) *MagicLinkTokenSelect {
+	mltq.ctx.Fields = append(mltq.ctx.Fields, fields...)
+	sbuild := &MagicLinkTokenSelect{MagicLinkTokenQuery: mltq}
+	sbuild.label = magiclinktoken.Label
+	sbuild.flds, sbuild.scan = &mltq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MagicLinkTokenSelect configured with the given aggregations.
+func (mltq *MagicLinkTokenQuery) Aggregate(fns ...AggregateFunc) *MagicLinkTokenSelect {
+	return mltq.Select().Aggregate(fns...)
+}
+
+func (mltq *MagicLinkTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mltq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mltq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mltq.ctx.Fields {
+		if !magiclinktoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if mltq.path != nil {
+		prev, err := mltq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mltq.sql = prev
+	}
+	return nil
+}
+
+func (mltq *MagicLinkTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MagicLinkToken, error) {
+	var (
+		nodes       = []*MagicLinkToken{}
+		withFKs     = mltq.withFKs
+		_spec       = mltq.querySpec()
+		loadedTypes = [1]bool{
+			mltq.withOwner != nil,
+		}
+	)
+	if mltq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclinktoken.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MagicLinkToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MagicLinkToken{config: mltq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = mltq.schemaConfig.MagicLinkToken
+	ctx = internal.NewSchemaConfigContext(ctx, mltq.schemaConfig)
+	if len(mltq.modifiers) > 0 {
+		_spec.Modifiers = mltq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mltq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mltq.withOwner; query != nil {
+		if err := mltq.loadOwner(ctx, query, nodes, nil,
+			func(n *MagicLinkToken, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range mltq.loadTotal {
+		if err := mltq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mltq *MagicLinkTokenQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*MagicLinkToken, init func(*MagicLinkToken), assign func(*MagicLinkToken, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MagicLinkToken)
+	for i := range nodes {
+		if nodes[i].user_magic_link_tokens == nil {
+			continue
+		}
+		fk := *nodes[i].user_magic_link_tokens
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_magic_link_tokens" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mltq *MagicLinkTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mltq.querySpec()
+	_spec.Node.Schema = mltq.schemaConfig.MagicLinkToken
+	ctx = internal.NewSchemaConfigContext(ctx, mltq.schemaConfig)
+	if len(mltq.modifiers) > 0 {
+		_spec.Modifiers = mltq.modifiers
+	}
+	_spec.Node.Columns = mltq.ctx.Fields
+	if len(mltq.ctx.Fields) > 0 {
+		_spec.Unique = mltq.ctx.Unique != nil && *mltq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mltq.driver, _spec)
+}
+
+func (mltq *MagicLinkTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(magiclinktoken.Table, magiclinktoken.Columns, sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeString))
+	_spec.From = mltq.sql
+	if unique := mltq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mltq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mltq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclinktoken.FieldID)
+		for i := range fields {
+			if fields[i] != magiclinktoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mltq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mltq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mltq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mltq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mltq *MagicLinkTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mltq.driver.Dialect())
+	t1 := builder.Table(magiclinktoken.Table)
+	columns := mltq.ctx.Fields
+	if len(columns) == 0 {
+		columns = magiclinktoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mltq.sql != nil {
+		selector = mltq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mltq.ctx.Unique != nil && *mltq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(mltq.schemaConfig.MagicLinkToken)
+	ctx = internal.NewSchemaConfigContext(ctx, mltq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range mltq.predicates {
+		p(selector)
+	}
+	for _, p := range mltq.order {
+		p(selector)
+	}
+	if offset := mltq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mltq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MagicLinkTokenGroupBy is the group-by builder for MagicLinkToken entities.
+type MagicLinkTokenGroupBy struct {
+	selector
+	build *MagicLinkTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mltgb *MagicLinkTokenGroupBy) Aggregate(fns ...AggregateFunc) *MagicLinkTokenGroupBy {
+	mltgb.fns = append(mltgb.fns, fns...)
+	return mltgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mltgb *MagicLinkTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mltgb.build.ctx, "GroupBy")
+	if err := mltgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MagicLinkTokenQuery, *MagicLinkTokenGroupBy](ctx, mltgb.build, mltgb, mltgb.build.inters, v)
+}
+
+func (mltgb *MagicLinkTokenGroupBy) sqlScan(ctx context.Context, root *MagicLinkTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mltgb.fns))
+	for _, fn := range mltgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mltgb.flds)+len(mltgb.fns))
+		for _, f := range *mltgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mltgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mltgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MagicLinkTokenSelect is the builder for selecting fields of MagicLinkToken entities.
+type MagicLinkTokenSelect struct {
+	*MagicLinkTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mlts *MagicLinkTokenSelect) Aggregate(fns ...AggregateFunc) *MagicLinkTokenSelect {
+	mlts.fns = append(mlts.fns, fns...)
+	return mlts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mlts *MagicLinkTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mlts.ctx, "Select")
+	if err := mlts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MagicLinkTokenQuery, *MagicLinkTokenSelect](ctx, mlts.MagicLinkTokenQuery, mlts, mlts.inters, v)
+}
+
+func (mlts *MagicLinkTokenSelect) sqlScan(ctx context.Context, root *MagicLinkTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mlts.fns))
+	for _, fn := range mlts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mlts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mlts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
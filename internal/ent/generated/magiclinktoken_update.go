@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// MagicLinkTokenUpdate is the builder for updating MagicLinkToken entities.
+type MagicLinkTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkTokenMutation
+}
+
+// Where appends a list predicates to the MagicLinkTokenUpdate builder.
+func (mltu *MagicLinkTokenUpdate) Where(ps ...predicate.MagicLinkToken) *MagicLinkTokenUpdate {
+	mltu.mutation.Where(ps...)
+	return mltu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mltu *MagicLinkTokenUpdate) SetUpdatedAt(t time.Time) *MagicLinkTokenUpdate {
+	mltu.mutation.SetUpdatedAt(t)
+	return mltu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (mltu *MagicLinkTokenUpdate) SetUpdatedBy(s string) *MagicLinkTokenUpdate {
+	mltu.mutation.SetUpdatedBy(s)
+	return mltu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableUpdatedBy(s *string) *MagicLinkTokenUpdate {
+	if s != nil {
+		mltu.SetUpdatedBy(*s)
+	}
+	return mltu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (mltu *MagicLinkTokenUpdate) ClearUpdatedBy() *MagicLinkTokenUpdate {
+	mltu.mutation.ClearUpdatedBy()
+	return mltu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mltu *MagicLinkTokenUpdate) SetDeletedAt(t time.Time) *MagicLinkTokenUpdate {
+	mltu.mutation.SetDeletedAt(t)
+	return mltu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableDeletedAt(t *time.Time) *MagicLinkTokenUpdate {
+	if t != nil {
+		mltu.SetDeletedAt(*t)
+	}
+	return mltu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mltu *MagicLinkTokenUpdate) ClearDeletedAt() *MagicLinkTokenUpdate {
+	mltu.mutation.ClearDeletedAt()
+	return mltu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (mltu *MagicLinkTokenUpdate) SetDeletedBy(s string) *MagicLinkTokenUpdate {
+	mltu.mutation.SetDeletedBy(s)
+	return mltu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableDeletedBy(s *string) *MagicLinkTokenUpdate {
+	if s != nil {
+		mltu.SetDeletedBy(*s)
+	}
+	return mltu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (mltu *MagicLinkTokenUpdate) ClearDeletedBy() *MagicLinkTokenUpdate {
+	mltu.mutation.ClearDeletedBy()
+	return mltu
+}
+
+// SetToken sets the "token" field.
+func (mltu *MagicLinkTokenUpdate) SetToken(s string) *MagicLinkTokenUpdate {
+	mltu.mutation.SetToken(s)
+	return mltu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableToken(s *string) *MagicLinkTokenUpdate {
+	if s != nil {
+		mltu.SetToken(*s)
+	}
+	return mltu
+}
+
+// SetTTL sets the "ttl" field.
+func (mltu *MagicLinkTokenUpdate) SetTTL(t time.Time) *MagicLinkTokenUpdate {
+	mltu.mutation.SetTTL(t)
+	return mltu
+}
+
+// SetNillableTTL sets the "ttl" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableTTL(t *time.Time) *MagicLinkTokenUpdate {
+	if t != nil {
+		mltu.SetTTL(*t)
+	}
+	return mltu
+}
+
+// SetEmail sets the "email" field.
+func (mltu *MagicLinkTokenUpdate) SetEmail(s string) *MagicLinkTokenUpdate {
+	mltu.mutation.SetEmail(s)
+	return mltu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mltu *MagicLinkTokenUpdate) SetNillableEmail(s *string) *MagicLinkTokenUpdate {
+	if s != nil {
+		mltu.SetEmail(*s)
+	}
+	return mltu
+}
+
+// SetSecret sets the "secret" field.
+func (mltu *MagicLinkTokenUpdate) SetSecret(b []byte) *MagicLinkTokenUpdate {
+	mltu.mutation.SetSecret(b)
+	return mltu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mltu *MagicLinkTokenUpdate) SetOwnerID(id string) *MagicLinkTokenUpdate {
+	mltu.mutation.SetOwnerID(id)
+	return mltu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mltu *MagicLinkTokenUpdate) SetOwner(u *User) *MagicLinkTokenUpdate {
+	return mltu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MagicLinkTokenMutation object of the builder.
+func (mltu *MagicLinkTokenUpdate) Mutation() *MagicLinkTokenMutation {
+	return mltu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (mltu *MagicLinkTokenUpdate) ClearOwner() *MagicLinkTokenUpdate {
+	mltu.mutation.ClearOwner()
+	return mltu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mltu *MagicLinkTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := mltu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, mltu.sqlSave, mltu.mutation, mltu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mltu *MagicLinkTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := mltu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mltu *MagicLinkTokenUpdate) Exec(ctx context.Context) error {
+	_, err := mltu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltu *MagicLinkTokenUpdate) ExecX(ctx context.Context) {
+	if err := mltu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mltu *MagicLinkTokenUpdate) defaults() error {
+	if _, ok := mltu.mutation.UpdatedAt(); !ok {
+		if magiclinktoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized magiclinktoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := magiclinktoken.UpdateDefaultUpdatedAt()
+		mltu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mltu *MagicLinkTokenUpdate) check() error {
+	if v, ok := mltu.mutation.Token(); ok {
+		if err := magiclinktoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.token": %w`, err)}
+		}
+	}
+	if v, ok := mltu.mutation.Email(); ok {
+		if err := magiclinktoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.email": %w`, err)}
+		}
+	}
+	if v, ok := mltu.mutation.Secret(); ok {
+		if err := magiclinktoken.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.secret": %w`, err)}
+		}
+	}
+	if _, ok := mltu.mutation.OwnerID(); mltu.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "MagicLinkToken.owner"`)
+	}
+	return nil
+}
+
+func (mltu *MagicLinkTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mltu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclinktoken.Table, magiclinktoken.Columns, sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeString))
+	if ps := mltu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mltu.mutation.UpdatedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mltu.mutation.CreatedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := mltu.mutation.UpdatedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedBy, field.TypeString, value)
+	}
+	if mltu.mutation.UpdatedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := mltu.mutation.DeletedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mltu.mutation.DeletedAtCleared() {
+		_spec.ClearField(magiclinktoken.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := mltu.mutation.DeletedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedBy, field.TypeString, value)
+	}
+	if mltu.mutation.DeletedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := mltu.mutation.Token(); ok {
+		_spec.SetField(magiclinktoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := mltu.mutation.TTL(); ok {
+		_spec.SetField(magiclinktoken.FieldTTL, field.TypeTime, value)
+	}
+	if value, ok := mltu.mutation.Email(); ok {
+		_spec.SetField(magiclinktoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := mltu.mutation.Secret(); ok {
+		_spec.SetField(magiclinktoken.FieldSecret, field.TypeBytes, value)
+	}
+	if mltu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = mltu.schemaConfig.MagicLinkToken
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mltu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = mltu.schemaConfig.MagicLinkToken
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = mltu.schemaConfig.MagicLinkToken
+	ctx = internal.NewSchemaConfigContext(ctx, mltu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, mltu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclinktoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mltu.mutation.done = true
+	return n, nil
+}
+
+// MagicLinkTokenUpdateOne is the builder for updating a single MagicLinkToken entity.
+type MagicLinkTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MagicLinkTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetUpdatedAt(t time.Time) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetUpdatedAt(t)
+	return mltuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetUpdatedBy(s string) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetUpdatedBy(s)
+	return mltuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableUpdatedBy(s *string) *MagicLinkTokenUpdateOne {
+	if s != nil {
+		mltuo.SetUpdatedBy(*s)
+	}
+	return mltuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (mltuo *MagicLinkTokenUpdateOne) ClearUpdatedBy() *MagicLinkTokenUpdateOne {
+	mltuo.mutation.ClearUpdatedBy()
+	return mltuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetDeletedAt(t time.Time) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetDeletedAt(t)
+	return mltuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableDeletedAt(t *time.Time) *MagicLinkTokenUpdateOne {
+	if t != nil {
+		mltuo.SetDeletedAt(*t)
+	}
+	return mltuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mltuo *MagicLinkTokenUpdateOne) ClearDeletedAt() *MagicLinkTokenUpdateOne {
+	mltuo.mutation.ClearDeletedAt()
+	return mltuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetDeletedBy(s string) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetDeletedBy(s)
+	return mltuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableDeletedBy(s *string) *MagicLinkTokenUpdateOne {
+	if s != nil {
+		mltuo.SetDeletedBy(*s)
+	}
+	return mltuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (mltuo *MagicLinkTokenUpdateOne) ClearDeletedBy() *MagicLinkTokenUpdateOne {
+	mltuo.mutation.ClearDeletedBy()
+	return mltuo
+}
+
+// SetToken sets the "token" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetToken(s string) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetToken(s)
+	return mltuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableToken(s *string) *MagicLinkTokenUpdateOne {
+	if s != nil {
+		mltuo.SetToken(*s)
+	}
+	return mltuo
+}
+
+// SetTTL sets the "ttl" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetTTL(t time.Time) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetTTL(t)
+	return mltuo
+}
+
+// SetNillableTTL sets the "ttl" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableTTL(t *time.Time) *MagicLinkTokenUpdateOne {
+	if t != nil {
+		mltuo.SetTTL(*t)
+	}
+	return mltuo
+}
+
+// SetEmail sets the "email" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetEmail(s string) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetEmail(s)
+	return mltuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mltuo *MagicLinkTokenUpdateOne) SetNillableEmail(s *string) *MagicLinkTokenUpdateOne {
+	if s != nil {
+		mltuo.SetEmail(*s)
+	}
+	return mltuo
+}
+
+// SetSecret sets the "secret" field.
+func (mltuo *MagicLinkTokenUpdateOne) SetSecret(b []byte) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetSecret(b)
+	return mltuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mltuo *MagicLinkTokenUpdateOne) SetOwnerID(id string) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.SetOwnerID(id)
+	return mltuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mltuo *MagicLinkTokenUpdateOne) SetOwner(u *User) *MagicLinkTokenUpdateOne {
+	return mltuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MagicLinkTokenMutation object of the builder.
+func (mltuo *MagicLinkTokenUpdateOne) Mutation() *MagicLinkTokenMutation {
+	return mltuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (mltuo *MagicLinkTokenUpdateOne) ClearOwner() *MagicLinkTokenUpdateOne {
+	mltuo.mutation.ClearOwner()
+	return mltuo
+}
+
+// Where appends a list predicates to the MagicLinkTokenUpdate builder.
+func (mltuo *MagicLinkTokenUpdateOne) Where(ps ...predicate.MagicLinkToken) *MagicLinkTokenUpdateOne {
+	mltuo.mutation.Where(ps...)
+	return mltuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mltuo *MagicLinkTokenUpdateOne) Select(field string, fields ...string) *MagicLinkTokenUpdateOne {
+	mltuo.fields = append([]string{field}, fields...)
+	return mltuo
+}
+
+// Save executes the query and returns the updated MagicLinkToken entity.
+func (mltuo *MagicLinkTokenUpdateOne) Save(ctx context.Context) (*MagicLinkToken, error) {
+	if err := mltuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mltuo.sqlSave, mltuo.mutation, mltuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mltuo *MagicLinkTokenUpdateOne) SaveX(ctx context.Context) *MagicLinkToken {
+	node, err := mltuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mltuo *MagicLinkTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := mltuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltuo *MagicLinkTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := mltuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mltuo *MagicLinkTokenUpdateOne) defaults() error {
+	if _, ok := mltuo.mutation.UpdatedAt(); !ok {
+		if magiclinktoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized magiclinktoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := magiclinktoken.UpdateDefaultUpdatedAt()
+		mltuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mltuo *MagicLinkTokenUpdateOne) check() error {
+	if v, ok := mltuo.mutation.Token(); ok {
+		if err := magiclinktoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.token": %w`, err)}
+		}
+	}
+	if v, ok := mltuo.mutation.Email(); ok {
+		if err := magiclinktoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.email": %w`, err)}
+		}
+	}
+	if v, ok := mltuo.mutation.Secret(); ok {
+		if err := magiclinktoken.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "MagicLinkToken.secret": %w`, err)}
+		}
+	}
+	if _, ok := mltuo.mutation.OwnerID(); mltuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "MagicLinkToken.owner"`)
+	}
+	return nil
+}
+
+func (mltuo *MagicLinkTokenUpdateOne) sqlSave(ctx context.Context) (_node *MagicLinkToken, err error) {
+	if err := mltuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclinktoken.Table, magiclinktoken.Columns, sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeString))
+	id, ok := mltuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "MagicLinkToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mltuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclinktoken.FieldID)
+		for _, f := range fields {
+			if !magiclinktoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != magiclinktoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mltuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mltuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mltuo.mutation.CreatedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := mltuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldUpdatedBy, field.TypeString, value)
+	}
+	if mltuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := mltuo.mutation.DeletedAt(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mltuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(magiclinktoken.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := mltuo.mutation.DeletedBy(); ok {
+		_spec.SetField(magiclinktoken.FieldDeletedBy, field.TypeString, value)
+	}
+	if mltuo.mutation.DeletedByCleared() {
+		_spec.ClearField(magiclinktoken.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := mltuo.mutation.Token(); ok {
+		_spec.SetField(magiclinktoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := mltuo.mutation.TTL(); ok {
+		_spec.SetField(magiclinktoken.FieldTTL, field.TypeTime, value)
+	}
+	if value, ok := mltuo.mutation.Email(); ok {
+		_spec.SetField(magiclinktoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := mltuo.mutation.Secret(); ok {
+		_spec.SetField(magiclinktoken.FieldSecret, field.TypeBytes, value)
+	}
+	if mltuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = mltuo.schemaConfig.MagicLinkToken
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mltuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.OwnerTable,
+			Columns: []string{magiclinktoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = mltuo.schemaConfig.MagicLinkToken
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = mltuo.schemaConfig.MagicLinkToken
+	ctx = internal.NewSchemaConfigContext(ctx, mltuo.schemaConfig)
+	_node = &MagicLinkToken{config: mltuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mltuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclinktoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mltuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+)
+
+// OauthAuthorizationCode is the model entity for the OauthAuthorizationCode schema.
+type OauthAuthorizationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// the sha256 hash of the authorization code
+	CodeHash string `json:"-"`
+	// the id of the oauth client the code was issued to
+	ClientID string `json:"client_id,omitempty"`
+	// the user that authorized the client
+	UserID string `json:"user_id,omitempty"`
+	// the redirect uri of the authorization request, the same uri must be sent when the code is exchanged
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// the scopes granted to the client
+	Scopes []string `json:"scopes,omitempty"`
+	// the nonce of the authorization request, added to the id token
+	Nonce string `json:"nonce,omitempty"`
+	// the PKCE code challenge of the authorization request
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// the PKCE code challenge method of the authorization request
+	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// when the code expires
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// when the code was exchanged for a token pair
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OauthAuthorizationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldScopes:
+			values[i] = new([]byte)
+		case oauthauthorizationcode.FieldID, oauthauthorizationcode.FieldCreatedBy, oauthauthorizationcode.FieldUpdatedBy, oauthauthorizationcode.FieldCodeHash, oauthauthorizationcode.FieldClientID, oauthauthorizationcode.FieldUserID, oauthauthorizationcode.FieldRedirectURI, oauthauthorizationcode.FieldNonce, oauthauthorizationcode.FieldCodeChallenge, oauthauthorizationcode.FieldCodeChallengeMethod:
+			values[i] = new(sql.NullString)
+		case oauthauthorizationcode.FieldCreatedAt, oauthauthorizationcode.FieldUpdatedAt, oauthauthorizationcode.FieldExpiresAt, oauthauthorizationcode.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OauthAuthorizationCode fields.
+func (oac *OauthAuthorizationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				oac.ID = value.String
+			}
+		case oauthauthorizationcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oac.CreatedAt = value.Time
+			}
+		case oauthauthorizationcode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oac.UpdatedAt = value.Time
+			}
+		case oauthauthorizationcode.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				oac.CreatedBy = value.String
+			}
+		case oauthauthorizationcode.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				oac.UpdatedBy = value.String
+			}
+		case oauthauthorizationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				oac.CodeHash = value.String
+			}
+		case oauthauthorizationcode.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oac.ClientID = value.String
+			}
+		case oauthauthorizationcode.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oac.UserID = value.String
+			}
+		case oauthauthorizationcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				oac.RedirectURI = value.String
+			}
+		case oauthauthorizationcode.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oac.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthauthorizationcode.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				oac.Nonce = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				oac.CodeChallenge = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallengeMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge_method", values[i])
+			} else if value.Valid {
+				oac.CodeChallengeMethod = value.String
+			}
+		case oauthauthorizationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				oac.ExpiresAt = value.Time
+			}
+		case oauthauthorizationcode.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				oac.UsedAt = new(time.Time)
+				*oac.UsedAt = value.Time
+			}
+		default:
+			oac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OauthAuthorizationCode.
+// This includes values selected through modifiers, order, etc.
+func (oac *OauthAuthorizationCode) Value(name string) (ent.Value, error) {
+	return oac.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OauthAuthorizationCode.
+// Note that you need to call OauthAuthorizationCode.Unwrap() before calling this method if this OauthAuthorizationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oac *OauthAuthorizationCode) Update() *OauthAuthorizationCodeUpdateOne {
+	return NewOauthAuthorizationCodeClient(oac.config).UpdateOne(oac)
+}
+
+// Unwrap unwraps the OauthAuthorizationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oac *OauthAuthorizationCode) Unwrap() *OauthAuthorizationCode {
+	_tx, ok := oac.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: OauthAuthorizationCode is not a transactional entity")
+	}
+	oac.config.driver = _tx.drv
+	return oac
+}
+
+// String implements the fmt.Stringer.
+func (oac *OauthAuthorizationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OauthAuthorizationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oac.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(oac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(oac.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(oac.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(oac.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(oac.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(oac.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uri=")
+	builder.WriteString(oac.RedirectURI)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oac.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(oac.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(oac.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge_method=")
+	builder.WriteString(oac.CodeChallengeMethod)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(oac.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := oac.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OauthAuthorizationCodes is a parsable slice of OauthAuthorizationCode.
+type OauthAuthorizationCodes []*OauthAuthorizationCode
@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+)
+
+// OauthAuthorizationCodeCreate is the builder for creating a OauthAuthorizationCode entity.
+type OauthAuthorizationCodeCreate struct {
+	config
+	mutation *OauthAuthorizationCodeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oacc *OauthAuthorizationCodeCreate) SetCreatedAt(t time.Time) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetCreatedAt(t)
+	return oacc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableCreatedAt(t *time.Time) *OauthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetCreatedAt(*t)
+	}
+	return oacc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oacc *OauthAuthorizationCodeCreate) SetUpdatedAt(t time.Time) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetUpdatedAt(t)
+	return oacc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableUpdatedAt(t *time.Time) *OauthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetUpdatedAt(*t)
+	}
+	return oacc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (oacc *OauthAuthorizationCodeCreate) SetCreatedBy(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetCreatedBy(s)
+	return oacc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableCreatedBy(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetCreatedBy(*s)
+	}
+	return oacc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oacc *OauthAuthorizationCodeCreate) SetUpdatedBy(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetUpdatedBy(s)
+	return oacc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableUpdatedBy(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetUpdatedBy(*s)
+	}
+	return oacc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (oacc *OauthAuthorizationCodeCreate) SetCodeHash(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeHash(s)
+	return oacc
+}
+
+// SetClientID sets the "client_id" field.
+func (oacc *OauthAuthorizationCodeCreate) SetClientID(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetClientID(s)
+	return oacc
+}
+
+// SetUserID sets the "user_id" field.
+func (oacc *OauthAuthorizationCodeCreate) SetUserID(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetUserID(s)
+	return oacc
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (oacc *OauthAuthorizationCodeCreate) SetRedirectURI(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetRedirectURI(s)
+	return oacc
+}
+
+// SetScopes sets the "scopes" field.
+func (oacc *OauthAuthorizationCodeCreate) SetScopes(s []string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetScopes(s)
+	return oacc
+}
+
+// SetNonce sets the "nonce" field.
+func (oacc *OauthAuthorizationCodeCreate) SetNonce(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetNonce(s)
+	return oacc
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableNonce(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetNonce(*s)
+	}
+	return oacc
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (oacc *OauthAuthorizationCodeCreate) SetCodeChallenge(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeChallenge(s)
+	return oacc
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableCodeChallenge(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetCodeChallenge(*s)
+	}
+	return oacc
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (oacc *OauthAuthorizationCodeCreate) SetCodeChallengeMethod(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeChallengeMethod(s)
+	return oacc
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableCodeChallengeMethod(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetCodeChallengeMethod(*s)
+	}
+	return oacc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oacc *OauthAuthorizationCodeCreate) SetExpiresAt(t time.Time) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetExpiresAt(t)
+	return oacc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacc *OauthAuthorizationCodeCreate) SetUsedAt(t time.Time) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetUsedAt(t)
+	return oacc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableUsedAt(t *time.Time) *OauthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetUsedAt(*t)
+	}
+	return oacc
+}
+
+// SetID sets the "id" field.
+func (oacc *OauthAuthorizationCodeCreate) SetID(s string) *OauthAuthorizationCodeCreate {
+	oacc.mutation.SetID(s)
+	return oacc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oacc *OauthAuthorizationCodeCreate) SetNillableID(s *string) *OauthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetID(*s)
+	}
+	return oacc
+}
+
+// Mutation returns the OauthAuthorizationCodeMutation object of the builder.
+func (oacc *OauthAuthorizationCodeCreate) Mutation() *OauthAuthorizationCodeMutation {
+	return oacc.mutation
+}
+
+// Save creates the OauthAuthorizationCode in the database.
+func (oacc *OauthAuthorizationCodeCreate) Save(ctx context.Context) (*OauthAuthorizationCode, error) {
+	if err := oacc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, oacc.sqlSave, oacc.mutation, oacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oacc *OauthAuthorizationCodeCreate) SaveX(ctx context.Context) *OauthAuthorizationCode {
+	v, err := oacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oacc *OauthAuthorizationCodeCreate) Exec(ctx context.Context) error {
+	_, err := oacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacc *OauthAuthorizationCodeCreate) ExecX(ctx context.Context) {
+	if err := oacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oacc *OauthAuthorizationCodeCreate) defaults() error {
+	if _, ok := oacc.mutation.CreatedAt(); !ok {
+		if oauthauthorizationcode.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthauthorizationcode.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthauthorizationcode.DefaultCreatedAt()
+		oacc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oacc.mutation.UpdatedAt(); !ok {
+		if oauthauthorizationcode.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthauthorizationcode.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthauthorizationcode.DefaultUpdatedAt()
+		oacc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oacc.mutation.ID(); !ok {
+		if oauthauthorizationcode.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized oauthauthorizationcode.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := oauthauthorizationcode.DefaultID()
+		oacc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacc *OauthAuthorizationCodeCreate) check() error {
+	if _, ok := oacc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "OauthAuthorizationCode.created_at"`)}
+	}
+	if _, ok := oacc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "OauthAuthorizationCode.updated_at"`)}
+	}
+	if _, ok := oacc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`generated: missing required field "OauthAuthorizationCode.code_hash"`)}
+	}
+	if v, ok := oacc.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`generated: validator failed for field "OauthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`generated: missing required field "OauthAuthorizationCode.client_id"`)}
+	}
+	if v, ok := oacc.mutation.ClientID(); ok {
+		if err := oauthauthorizationcode.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`generated: validator failed for field "OauthAuthorizationCode.client_id": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "OauthAuthorizationCode.user_id"`)}
+	}
+	if v, ok := oacc.mutation.UserID(); ok {
+		if err := oauthauthorizationcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "OauthAuthorizationCode.user_id": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`generated: missing required field "OauthAuthorizationCode.redirect_uri"`)}
+	}
+	if v, ok := oacc.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`generated: validator failed for field "OauthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`generated: missing required field "OauthAuthorizationCode.scopes"`)}
+	}
+	if _, ok := oacc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "OauthAuthorizationCode.expires_at"`)}
+	}
+	return nil
+}
+
+func (oacc *OauthAuthorizationCodeCreate) sqlSave(ctx context.Context) (*OauthAuthorizationCode, error) {
+	if err := oacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OauthAuthorizationCode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	oacc.mutation.id = &_node.ID
+	oacc.mutation.done = true
+	return _node, nil
+}
+
+func (oacc *OauthAuthorizationCodeCreate) createSpec() (*OauthAuthorizationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthAuthorizationCode{config: oacc.config}
+		_spec = sqlgraph.NewCreateSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeString))
+	)
+	_spec.Schema = oacc.schemaConfig.OauthAuthorizationCode
+	if id, ok := oacc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := oacc.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oacc.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := oacc.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := oacc.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := oacc.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := oacc.mutation.ClientID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := oacc.mutation.UserID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := oacc.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := oacc.mutation.Scopes(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := oacc.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := oacc.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := oacc.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := oacc.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := oacc.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	return _node, _spec
+}
+
+// OauthAuthorizationCodeCreateBulk is the builder for creating many OauthAuthorizationCode entities in bulk.
+type OauthAuthorizationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OauthAuthorizationCodeCreate
+}
+
+// Save creates the OauthAuthorizationCode entities in the database.
+func (oaccb *OauthAuthorizationCodeCreateBulk) Save(ctx context.Context) ([]*OauthAuthorizationCode, error) {
+	if oaccb.err != nil {
+		return nil, oaccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oaccb.builders))
+	nodes := make([]*OauthAuthorizationCode, len(oaccb.builders))
+	mutators := make([]Mutator, len(oaccb.builders))
+	for i := range oaccb.builders {
+		func(i int, root context.Context) {
+			builder := oaccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthAuthorizationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oaccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oaccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oaccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oaccb *OauthAuthorizationCodeCreateBulk) SaveX(ctx context.Context) []*OauthAuthorizationCode {
+	v, err := oaccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oaccb *OauthAuthorizationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := oaccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oaccb *OauthAuthorizationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := oaccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
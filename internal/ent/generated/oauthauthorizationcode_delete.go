@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+)
+
+// OauthAuthorizationCodeDelete is the builder for deleting a OauthAuthorizationCode entity.
+type OauthAuthorizationCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *OauthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OauthAuthorizationCodeDelete builder.
+func (oacd *OauthAuthorizationCodeDelete) Where(ps ...predicate.OauthAuthorizationCode) *OauthAuthorizationCodeDelete {
+	oacd.mutation.Where(ps...)
+	return oacd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oacd *OauthAuthorizationCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oacd.sqlExec, oacd.mutation, oacd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacd *OauthAuthorizationCodeDelete) ExecX(ctx context.Context) int {
+	n, err := oacd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oacd *OauthAuthorizationCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeString))
+	_spec.Node.Schema = oacd.schemaConfig.OauthAuthorizationCode
+	ctx = internal.NewSchemaConfigContext(ctx, oacd.schemaConfig)
+	if ps := oacd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oacd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oacd.mutation.done = true
+	return affected, err
+}
+
+// OauthAuthorizationCodeDeleteOne is the builder for deleting a single OauthAuthorizationCode entity.
+type OauthAuthorizationCodeDeleteOne struct {
+	oacd *OauthAuthorizationCodeDelete
+}
+
+// Where appends a list predicates to the OauthAuthorizationCodeDelete builder.
+func (oacdo *OauthAuthorizationCodeDeleteOne) Where(ps ...predicate.OauthAuthorizationCode) *OauthAuthorizationCodeDeleteOne {
+	oacdo.oacd.mutation.Where(ps...)
+	return oacdo
+}
+
+// Exec executes the deletion query.
+func (oacdo *OauthAuthorizationCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := oacdo.oacd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacdo *OauthAuthorizationCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := oacdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// OauthAuthorizationCodeQuery is the builder for querying OauthAuthorizationCode entities.
+type OauthAuthorizationCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oauthauthorizationcode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OauthAuthorizationCode
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*OauthAuthorizationCode) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OauthAuthorizationCodeQuery builder.
+func (oacq *OauthAuthorizationCodeQuery) Where(ps ...predicate.OauthAuthorizationCode) *OauthAuthorizationCodeQuery {
+	oacq.predicates = append(oacq.predicates, ps...)
+	return oacq
+}
+
+// Limit the number of records to be returned by this query.
+func (oacq *OauthAuthorizationCodeQuery) Limit(limit int) *OauthAuthorizationCodeQuery {
+	oacq.ctx.Limit = &limit
+	return oacq
+}
+
+// Offset to start from.
+func (oacq *OauthAuthorizationCodeQuery) Offset(offset int) *OauthAuthorizationCodeQuery {
+	oacq.ctx.Offset = &offset
+	return oacq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oacq *OauthAuthorizationCodeQuery) Unique(unique bool) *OauthAuthorizationCodeQuery {
+	oacq.ctx.Unique = &unique
+	return oacq
+}
+
+// Order specifies how the records should be ordered.
+func (oacq *OauthAuthorizationCodeQuery) Order(o ...oauthauthorizationcode.OrderOption) *OauthAuthorizationCodeQuery {
+	oacq.order = append(oacq.order, o...)
+	return oacq
+}
+
+// First returns the first OauthAuthorizationCode entity from the query.
+// Returns a *NotFoundError when no OauthAuthorizationCode was found.
+func (oacq *OauthAuthorizationCodeQuery) First(ctx context.Context) (*OauthAuthorizationCode, error) {
+	nodes, err := oacq.Limit(1).All(setContextOp(ctx, oacq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthauthorizationcode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) FirstX(ctx context.Context) *OauthAuthorizationCode {
+	node, err := oacq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OauthAuthorizationCode ID from the query.
+// Returns a *NotFoundError when no OauthAuthorizationCode ID was found.
+func (oacq *OauthAuthorizationCodeQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = oacq.Limit(1).IDs(setContextOp(ctx, oacq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthauthorizationcode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) FirstIDX(ctx context.Context) string {
+	id, err := oacq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OauthAuthorizationCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OauthAuthorizationCode entity is found.
+// Returns a *NotFoundError when no OauthAuthorizationCode entities are found.
+func (oacq *OauthAuthorizationCodeQuery) Only(ctx context.Context) (*OauthAuthorizationCode, error) {
+	nodes, err := oacq.Limit(2).All(setContextOp(ctx, oacq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		return nil, &NotSingularError{oauthauthorizationcode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) OnlyX(ctx context.Context) *OauthAuthorizationCode {
+	node, err := oacq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OauthAuthorizationCode ID in the query.
+// Returns a *NotSingularError when more than one OauthAuthorizationCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oacq *OauthAuthorizationCodeQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = oacq.Limit(2).IDs(setContextOp(ctx, oacq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		err = &NotSingularError{oauthauthorizationcode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) OnlyIDX(ctx context.Context) string {
+	id, err := oacq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OauthAuthorizationCodes.
+func (oacq *OauthAuthorizationCodeQuery) All(ctx context.Context) ([]*OauthAuthorizationCode, error) {
+	ctx = setContextOp(ctx, oacq.ctx, "All")
+	if err := oacq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OauthAuthorizationCode, *OauthAuthorizationCodeQuery]()
+	return withInterceptors[[]*OauthAuthorizationCode](ctx, oacq, qr, oacq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) AllX(ctx context.Context) []*OauthAuthorizationCode {
+	nodes, err := oacq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OauthAuthorizationCode IDs.
+func (oacq *OauthAuthorizationCodeQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if oacq.ctx.Unique == nil && oacq.path != nil {
+		oacq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oacq.ctx, "IDs")
+	if err = oacq.Select(oauthauthorizationcode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) IDsX(ctx context.Context) []string {
+	ids, err := oacq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oacq *OauthAuthorizationCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oacq.ctx, "Count")
+	if err := oacq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oacq, querierCount[*OauthAuthorizationCodeQuery](), oacq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) CountX(ctx context.Context) int {
+	count, err := oacq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oacq *OauthAuthorizationCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oacq.ctx, "Exist")
+	switch _, err := oacq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oacq *OauthAuthorizationCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := oacq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OauthAuthorizationCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oacq *OauthAuthorizationCodeQuery) Clone() *OauthAuthorizationCodeQuery {
+	if oacq == nil {
+		return nil
+	}
+	return &OauthAuthorizationCodeQuery{
+		config:     oacq.config,
+		ctx:        oacq.ctx.Clone(),
+		order:      append([]oauthauthorizationcode.OrderOption{}, oacq.order...),
+		inters:     append([]Interceptor{}, oacq.inters...),
+		predicates: append([]predicate.OauthAuthorizationCode{}, oacq.predicates...),
+		// clone intermediate query.
+		sql:  oacq.sql.Clone(),
+		path: oacq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OauthAuthorizationCode.Query().
+//		GroupBy(oauthauthorizationcode.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (oacq *OauthAuthorizationCodeQuery) GroupBy(field string, fields ...string) *OauthAuthorizationCodeGroupBy {
+	oacq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OauthAuthorizationCodeGroupBy{build: oacq}
+	grbuild.flds = &oacq.ctx.Fields
+	grbuild.label = oauthauthorizationcode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.OauthAuthorizationCode.Query().
+//		Select(oauthauthorizationcode.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (oacq *OauthAuthorizationCodeQuery) Select(fields ...string) *OauthAuthorizationCodeSelect {
+	oacq.ctx.Fields = append(oacq.ctx.Fields, fields...)
+	sbuild := &OauthAuthorizationCodeSelect{OauthAuthorizationCodeQuery: oacq}
+	sbuild.label = oauthauthorizationcode.Label
+	sbuild.flds, sbuild.scan = &oacq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OauthAuthorizationCodeSelect configured with the given aggregations.
+func (oacq *OauthAuthorizationCodeQuery) Aggregate(fns ...AggregateFunc) *OauthAuthorizationCodeSelect {
+	return oacq.Select().Aggregate(fns...)
+}
+
+func (oacq *OauthAuthorizationCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oacq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oacq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oacq.ctx.Fields {
+		if !oauthauthorizationcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if oacq.path != nil {
+		prev, err := oacq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oacq.sql = prev
+	}
+	return nil
+}
+
+func (oacq *OauthAuthorizationCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OauthAuthorizationCode, error) {
+	var (
+		nodes = []*OauthAuthorizationCode{}
+		_spec = oacq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OauthAuthorizationCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OauthAuthorizationCode{config: oacq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = oacq.schemaConfig.OauthAuthorizationCode
+	ctx = internal.NewSchemaConfigContext(ctx, oacq.schemaConfig)
+	if len(oacq.modifiers) > 0 {
+		_spec.Modifiers = oacq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oacq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range oacq.loadTotal {
+		if err := oacq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oacq *OauthAuthorizationCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oacq.querySpec()
+	_spec.Node.Schema = oacq.schemaConfig.OauthAuthorizationCode
+	ctx = internal.NewSchemaConfigContext(ctx, oacq.schemaConfig)
+	if len(oacq.modifiers) > 0 {
+		_spec.Modifiers = oacq.modifiers
+	}
+	_spec.Node.Columns = oacq.ctx.Fields
+	if len(oacq.ctx.Fields) > 0 {
+		_spec.Unique = oacq.ctx.Unique != nil && *oacq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oacq.driver, _spec)
+}
+
+func (oacq *OauthAuthorizationCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeString))
+	_spec.From = oacq.sql
+	if unique := oacq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oacq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oacq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for i := range fields {
+			if fields[i] != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := oacq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oacq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oacq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oacq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oacq *OauthAuthorizationCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oacq.driver.Dialect())
+	t1 := builder.Table(oauthauthorizationcode.Table)
+	columns := oacq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oauthauthorizationcode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oacq.sql != nil {
+		selector = oacq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oacq.ctx.Unique != nil && *oacq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(oacq.schemaConfig.OauthAuthorizationCode)
+	ctx = internal.NewSchemaConfigContext(ctx, oacq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range oacq.predicates {
+		p(selector)
+	}
+	for _, p := range oacq.order {
+		p(selector)
+	}
+	if offset := oacq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oacq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OauthAuthorizationCodeGroupBy is the group-by builder for OauthAuthorizationCode entities.
+type OauthAuthorizationCodeGroupBy struct {
+	selector
+	build *OauthAuthorizationCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oacgb *OauthAuthorizationCodeGroupBy) Aggregate(fns ...AggregateFunc) *OauthAuthorizationCodeGroupBy {
+	oacgb.fns = append(oacgb.fns, fns...)
+	return oacgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oacgb *OauthAuthorizationCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oacgb.build.ctx, "GroupBy")
+	if err := oacgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OauthAuthorizationCodeQuery, *OauthAuthorizationCodeGroupBy](ctx, oacgb.build, oacgb, oacgb.build.inters, v)
+}
+
+func (oacgb *OauthAuthorizationCodeGroupBy) sqlScan(ctx context.Context, root *OauthAuthorizationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oacgb.fns))
+	for _, fn := range oacgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oacgb.flds)+len(oacgb.fns))
+		for _, f := range *oacgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oacgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oacgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OauthAuthorizationCodeSelect is the builder for selecting fields of OauthAuthorizationCode entities.
+type OauthAuthorizationCodeSelect struct {
+	*OauthAuthorizationCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oacs *OauthAuthorizationCodeSelect) Aggregate(fns ...AggregateFunc) *OauthAuthorizationCodeSelect {
+	oacs.fns = append(oacs.fns, fns...)
+	return oacs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oacs *OauthAuthorizationCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oacs.ctx, "Select")
+	if err := oacs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OauthAuthorizationCodeQuery, *OauthAuthorizationCodeSelect](ctx, oacs.OauthAuthorizationCodeQuery, oacs, oacs.inters, v)
+}
+
+func (oacs *OauthAuthorizationCodeSelect) sqlScan(ctx context.Context, root *OauthAuthorizationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oacs.fns))
+	for _, fn := range oacs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oacs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oacs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
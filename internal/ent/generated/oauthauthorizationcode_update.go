@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// OauthAuthorizationCodeUpdate is the builder for updating OauthAuthorizationCode entities.
+type OauthAuthorizationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OauthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OauthAuthorizationCodeUpdate builder.
+func (oacu *OauthAuthorizationCodeUpdate) Where(ps ...predicate.OauthAuthorizationCode) *OauthAuthorizationCodeUpdate {
+	oacu.mutation.Where(ps...)
+	return oacu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oacu *OauthAuthorizationCodeUpdate) SetUpdatedAt(t time.Time) *OauthAuthorizationCodeUpdate {
+	oacu.mutation.SetUpdatedAt(t)
+	return oacu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oacu *OauthAuthorizationCodeUpdate) SetUpdatedBy(s string) *OauthAuthorizationCodeUpdate {
+	oacu.mutation.SetUpdatedBy(s)
+	return oacu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oacu *OauthAuthorizationCodeUpdate) SetNillableUpdatedBy(s *string) *OauthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetUpdatedBy(*s)
+	}
+	return oacu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (oacu *OauthAuthorizationCodeUpdate) ClearUpdatedBy() *OauthAuthorizationCodeUpdate {
+	oacu.mutation.ClearUpdatedBy()
+	return oacu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacu *OauthAuthorizationCodeUpdate) SetUsedAt(t time.Time) *OauthAuthorizationCodeUpdate {
+	oacu.mutation.SetUsedAt(t)
+	return oacu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacu *OauthAuthorizationCodeUpdate) SetNillableUsedAt(t *time.Time) *OauthAuthorizationCodeUpdate {
+	if t != nil {
+		oacu.SetUsedAt(*t)
+	}
+	return oacu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (oacu *OauthAuthorizationCodeUpdate) ClearUsedAt() *OauthAuthorizationCodeUpdate {
+	oacu.mutation.ClearUsedAt()
+	return oacu
+}
+
+// Mutation returns the OauthAuthorizationCodeMutation object of the builder.
+func (oacu *OauthAuthorizationCodeUpdate) Mutation() *OauthAuthorizationCodeMutation {
+	return oacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oacu *OauthAuthorizationCodeUpdate) Save(ctx context.Context) (int, error) {
+	if err := oacu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, oacu.sqlSave, oacu.mutation, oacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacu *OauthAuthorizationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := oacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oacu *OauthAuthorizationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := oacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacu *OauthAuthorizationCodeUpdate) ExecX(ctx context.Context) {
+	if err := oacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oacu *OauthAuthorizationCodeUpdate) defaults() error {
+	if _, ok := oacu.mutation.UpdatedAt(); !ok {
+		if oauthauthorizationcode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthauthorizationcode.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthauthorizationcode.UpdateDefaultUpdatedAt()
+		oacu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (oacu *OauthAuthorizationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeString))
+	if ps := oacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacu.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oacu.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := oacu.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if oacu.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUpdatedBy, field.TypeString)
+	}
+	if oacu.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if oacu.mutation.CodeChallengeCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString)
+	}
+	if oacu.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := oacu.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if oacu.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = oacu.schemaConfig.OauthAuthorizationCode
+	ctx = internal.NewSchemaConfigContext(ctx, oacu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, oacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oacu.mutation.done = true
+	return n, nil
+}
+
+// OauthAuthorizationCodeUpdateOne is the builder for updating a single OauthAuthorizationCode entity.
+type OauthAuthorizationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OauthAuthorizationCodeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SetUpdatedAt(t time.Time) *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUpdatedAt(t)
+	return oacuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SetUpdatedBy(s string) *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUpdatedBy(s)
+	return oacuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SetNillableUpdatedBy(s *string) *OauthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetUpdatedBy(*s)
+	}
+	return oacuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (oacuo *OauthAuthorizationCodeUpdateOne) ClearUpdatedBy() *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearUpdatedBy()
+	return oacuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SetUsedAt(t time.Time) *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUsedAt(t)
+	return oacuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SetNillableUsedAt(t *time.Time) *OauthAuthorizationCodeUpdateOne {
+	if t != nil {
+		oacuo.SetUsedAt(*t)
+	}
+	return oacuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (oacuo *OauthAuthorizationCodeUpdateOne) ClearUsedAt() *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearUsedAt()
+	return oacuo
+}
+
+// Mutation returns the OauthAuthorizationCodeMutation object of the builder.
+func (oacuo *OauthAuthorizationCodeUpdateOne) Mutation() *OauthAuthorizationCodeMutation {
+	return oacuo.mutation
+}
+
+// Where appends a list predicates to the OauthAuthorizationCodeUpdate builder.
+func (oacuo *OauthAuthorizationCodeUpdateOne) Where(ps ...predicate.OauthAuthorizationCode) *OauthAuthorizationCodeUpdateOne {
+	oacuo.mutation.Where(ps...)
+	return oacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oacuo *OauthAuthorizationCodeUpdateOne) Select(field string, fields ...string) *OauthAuthorizationCodeUpdateOne {
+	oacuo.fields = append([]string{field}, fields...)
+	return oacuo
+}
+
+// Save executes the query and returns the updated OauthAuthorizationCode entity.
+func (oacuo *OauthAuthorizationCodeUpdateOne) Save(ctx context.Context) (*OauthAuthorizationCode, error) {
+	if err := oacuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, oacuo.sqlSave, oacuo.mutation, oacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacuo *OauthAuthorizationCodeUpdateOne) SaveX(ctx context.Context) *OauthAuthorizationCode {
+	node, err := oacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oacuo *OauthAuthorizationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := oacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacuo *OauthAuthorizationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := oacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oacuo *OauthAuthorizationCodeUpdateOne) defaults() error {
+	if _, ok := oacuo.mutation.UpdatedAt(); !ok {
+		if oauthauthorizationcode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthauthorizationcode.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthauthorizationcode.UpdateDefaultUpdatedAt()
+		oacuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (oacuo *OauthAuthorizationCodeUpdateOne) sqlSave(ctx context.Context) (_node *OauthAuthorizationCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeString))
+	id, ok := oacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OauthAuthorizationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for _, f := range fields {
+			if !oauthauthorizationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oacuo.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := oacuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if oacuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUpdatedBy, field.TypeString)
+	}
+	if oacuo.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if oacuo.mutation.CodeChallengeCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString)
+	}
+	if oacuo.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := oacuo.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if oacuo.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = oacuo.schemaConfig.OauthAuthorizationCode
+	ctx = internal.NewSchemaConfigContext(ctx, oacuo.schemaConfig)
+	_node = &OauthAuthorizationCode{config: oacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oacuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+)
+
+// OauthClientCreate is the builder for creating a OauthClient entity.
+type OauthClientCreate struct {
+	config
+	mutation *OauthClientMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (occ *OauthClientCreate) SetCreatedAt(t time.Time) *OauthClientCreate {
+	occ.mutation.SetCreatedAt(t)
+	return occ
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableCreatedAt(t *time.Time) *OauthClientCreate {
+	if t != nil {
+		occ.SetCreatedAt(*t)
+	}
+	return occ
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (occ *OauthClientCreate) SetUpdatedAt(t time.Time) *OauthClientCreate {
+	occ.mutation.SetUpdatedAt(t)
+	return occ
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableUpdatedAt(t *time.Time) *OauthClientCreate {
+	if t != nil {
+		occ.SetUpdatedAt(*t)
+	}
+	return occ
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (occ *OauthClientCreate) SetCreatedBy(s string) *OauthClientCreate {
+	occ.mutation.SetCreatedBy(s)
+	return occ
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableCreatedBy(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetCreatedBy(*s)
+	}
+	return occ
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (occ *OauthClientCreate) SetUpdatedBy(s string) *OauthClientCreate {
+	occ.mutation.SetUpdatedBy(s)
+	return occ
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableUpdatedBy(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetUpdatedBy(*s)
+	}
+	return occ
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (occ *OauthClientCreate) SetDeletedAt(t time.Time) *OauthClientCreate {
+	occ.mutation.SetDeletedAt(t)
+	return occ
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableDeletedAt(t *time.Time) *OauthClientCreate {
+	if t != nil {
+		occ.SetDeletedAt(*t)
+	}
+	return occ
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (occ *OauthClientCreate) SetDeletedBy(s string) *OauthClientCreate {
+	occ.mutation.SetDeletedBy(s)
+	return occ
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableDeletedBy(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetDeletedBy(*s)
+	}
+	return occ
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (occ *OauthClientCreate) SetOwnerID(s string) *OauthClientCreate {
+	occ.mutation.SetOwnerID(s)
+	return occ
+}
+
+// SetName sets the "name" field.
+func (occ *OauthClientCreate) SetName(s string) *OauthClientCreate {
+	occ.mutation.SetName(s)
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OauthClientCreate) SetClientID(s string) *OauthClientCreate {
+	occ.mutation.SetClientID(s)
+	return occ
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (occ *OauthClientCreate) SetClientSecretHash(s string) *OauthClientCreate {
+	occ.mutation.SetClientSecretHash(s)
+	return occ
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableClientSecretHash(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetClientSecretHash(*s)
+	}
+	return occ
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (occ *OauthClientCreate) SetRedirectUris(s []string) *OauthClientCreate {
+	occ.mutation.SetRedirectUris(s)
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OauthClientCreate) SetScopes(s []string) *OauthClientCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetPublic sets the "public" field.
+func (occ *OauthClientCreate) SetPublic(b bool) *OauthClientCreate {
+	occ.mutation.SetPublic(b)
+	return occ
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillablePublic(b *bool) *OauthClientCreate {
+	if b != nil {
+		occ.SetPublic(*b)
+	}
+	return occ
+}
+
+// SetDescription sets the "description" field.
+func (occ *OauthClientCreate) SetDescription(s string) *OauthClientCreate {
+	occ.mutation.SetDescription(s)
+	return occ
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableDescription(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetDescription(*s)
+	}
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OauthClientCreate) SetID(s string) *OauthClientCreate {
+	occ.mutation.SetID(s)
+	return occ
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableID(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetID(*s)
+	}
+	return occ
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (occ *OauthClientCreate) SetOwner(o *Organization) *OauthClientCreate {
+	return occ.SetOwnerID(o.ID)
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (occ *OauthClientCreate) Mutation() *OauthClientMutation {
+	return occ.mutation
+}
+
+// Save creates the OauthClient in the database.
+func (occ *OauthClientCreate) Save(ctx context.Context) (*OauthClient, error) {
+	if err := occ.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OauthClientCreate) SaveX(ctx context.Context) *OauthClient {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OauthClientCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OauthClientCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OauthClientCreate) defaults() error {
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		if oauthclient.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthclient.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthclient.DefaultCreatedAt()
+		occ.mutation.SetCreatedAt(v)
+	}
+	if _, ok := occ.mutation.UpdatedAt(); !ok {
+		if oauthclient.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthclient.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthclient.DefaultUpdatedAt()
+		occ.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		v := oauthclient.DefaultPublic
+		occ.mutation.SetPublic(v)
+	}
+	if _, ok := occ.mutation.Description(); !ok {
+		v := oauthclient.DefaultDescription
+		occ.mutation.SetDescription(v)
+	}
+	if _, ok := occ.mutation.ID(); !ok {
+		if oauthclient.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized oauthclient.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := oauthclient.DefaultID()
+		occ.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OauthClientCreate) check() error {
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "OauthClient.created_at"`)}
+	}
+	if _, ok := occ.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "OauthClient.updated_at"`)}
+	}
+	if _, ok := occ.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "OauthClient.owner_id"`)}
+	}
+	if _, ok := occ.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "OauthClient.name"`)}
+	}
+	if v, ok := occ.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`generated: missing required field "OauthClient.client_id"`)}
+	}
+	if v, ok := occ.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`generated: validator failed for field "OauthClient.client_id": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.RedirectUris(); !ok {
+		return &ValidationError{Name: "redirect_uris", err: errors.New(`generated: missing required field "OauthClient.redirect_uris"`)}
+	}
+	if _, ok := occ.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`generated: missing required field "OauthClient.scopes"`)}
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`generated: missing required field "OauthClient.public"`)}
+	}
+	if _, ok := occ.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "OauthClient.owner"`)}
+	}
+	return nil
+}
+
+func (occ *OauthClientCreate) sqlSave(ctx context.Context) (*OauthClient, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OauthClient.ID type: %T", _spec.ID.Value)
+		}
+	}
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OauthClientCreate) createSpec() (*OauthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthClient{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString))
+	)
+	_spec.Schema = occ.schemaConfig.OauthClient
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := occ.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := occ.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := occ.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthclient.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := occ.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := occ.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := occ.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := occ.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := occ.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := occ.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := occ.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := occ.mutation.Public(); ok {
+		_spec.SetField(oauthclient.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := occ.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := occ.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.OwnerTable,
+			Columns: []string{oauthclient.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = occ.schemaConfig.OauthClient
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OauthClientCreateBulk is the builder for creating many OauthClient entities in bulk.
+type OauthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OauthClientCreate
+}
+
+// Save creates the OauthClient entities in the database.
+func (occb *OauthClientCreateBulk) Save(ctx context.Context) ([]*OauthClient, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OauthClient, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OauthClientCreateBulk) SaveX(ctx context.Context) []*OauthClient {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OauthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OauthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// OauthClientUpdate is the builder for updating OauthClient entities.
+type OauthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (ocu *OauthClientUpdate) Where(ps ...predicate.OauthClient) *OauthClientUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ocu *OauthClientUpdate) SetUpdatedAt(t time.Time) *OauthClientUpdate {
+	ocu.mutation.SetUpdatedAt(t)
+	return ocu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ocu *OauthClientUpdate) SetUpdatedBy(s string) *OauthClientUpdate {
+	ocu.mutation.SetUpdatedBy(s)
+	return ocu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableUpdatedBy(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetUpdatedBy(*s)
+	}
+	return ocu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ocu *OauthClientUpdate) ClearUpdatedBy() *OauthClientUpdate {
+	ocu.mutation.ClearUpdatedBy()
+	return ocu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ocu *OauthClientUpdate) SetDeletedAt(t time.Time) *OauthClientUpdate {
+	ocu.mutation.SetDeletedAt(t)
+	return ocu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableDeletedAt(t *time.Time) *OauthClientUpdate {
+	if t != nil {
+		ocu.SetDeletedAt(*t)
+	}
+	return ocu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ocu *OauthClientUpdate) ClearDeletedAt() *OauthClientUpdate {
+	ocu.mutation.ClearDeletedAt()
+	return ocu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ocu *OauthClientUpdate) SetDeletedBy(s string) *OauthClientUpdate {
+	ocu.mutation.SetDeletedBy(s)
+	return ocu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableDeletedBy(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetDeletedBy(*s)
+	}
+	return ocu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ocu *OauthClientUpdate) ClearDeletedBy() *OauthClientUpdate {
+	ocu.mutation.ClearDeletedBy()
+	return ocu
+}
+
+// SetName sets the "name" field.
+func (ocu *OauthClientUpdate) SetName(s string) *OauthClientUpdate {
+	ocu.mutation.SetName(s)
+	return ocu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableName(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetName(*s)
+	}
+	return ocu
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (ocu *OauthClientUpdate) SetClientSecretHash(s string) *OauthClientUpdate {
+	ocu.mutation.SetClientSecretHash(s)
+	return ocu
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableClientSecretHash(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetClientSecretHash(*s)
+	}
+	return ocu
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (ocu *OauthClientUpdate) ClearClientSecretHash() *OauthClientUpdate {
+	ocu.mutation.ClearClientSecretHash()
+	return ocu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocu *OauthClientUpdate) SetRedirectUris(s []string) *OauthClientUpdate {
+	ocu.mutation.SetRedirectUris(s)
+	return ocu
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocu *OauthClientUpdate) AppendRedirectUris(s []string) *OauthClientUpdate {
+	ocu.mutation.AppendRedirectUris(s)
+	return ocu
+}
+
+// SetDescription sets the "description" field.
+func (ocu *OauthClientUpdate) SetDescription(s string) *OauthClientUpdate {
+	ocu.mutation.SetDescription(s)
+	return ocu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableDescription(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetDescription(*s)
+	}
+	return ocu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ocu *OauthClientUpdate) ClearDescription() *OauthClientUpdate {
+	ocu.mutation.ClearDescription()
+	return ocu
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (ocu *OauthClientUpdate) Mutation() *OauthClientMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OauthClientUpdate) Save(ctx context.Context) (int, error) {
+	if err := ocu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OauthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OauthClientUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OauthClientUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OauthClientUpdate) defaults() error {
+	if _, ok := ocu.mutation.UpdatedAt(); !ok {
+		if oauthclient.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthclient.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		ocu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OauthClientUpdate) check() error {
+	if v, ok := ocu.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := ocu.mutation.OwnerID(); ocu.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OauthClient.owner"`)
+	}
+	return nil
+}
+
+func (ocu *OauthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ocu.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ocu.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ocu.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ocu.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ocu.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ocu.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeString, value)
+	}
+	if ocu.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ocu.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if ocu.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := ocu.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocu.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if ocu.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	_spec.Node.Schema = ocu.schemaConfig.OauthClient
+	ctx = internal.NewSchemaConfigContext(ctx, ocu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OauthClientUpdateOne is the builder for updating a single OauthClient entity.
+type OauthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ocuo *OauthClientUpdateOne) SetUpdatedAt(t time.Time) *OauthClientUpdateOne {
+	ocuo.mutation.SetUpdatedAt(t)
+	return ocuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ocuo *OauthClientUpdateOne) SetUpdatedBy(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetUpdatedBy(s)
+	return ocuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableUpdatedBy(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetUpdatedBy(*s)
+	}
+	return ocuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ocuo *OauthClientUpdateOne) ClearUpdatedBy() *OauthClientUpdateOne {
+	ocuo.mutation.ClearUpdatedBy()
+	return ocuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ocuo *OauthClientUpdateOne) SetDeletedAt(t time.Time) *OauthClientUpdateOne {
+	ocuo.mutation.SetDeletedAt(t)
+	return ocuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableDeletedAt(t *time.Time) *OauthClientUpdateOne {
+	if t != nil {
+		ocuo.SetDeletedAt(*t)
+	}
+	return ocuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ocuo *OauthClientUpdateOne) ClearDeletedAt() *OauthClientUpdateOne {
+	ocuo.mutation.ClearDeletedAt()
+	return ocuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ocuo *OauthClientUpdateOne) SetDeletedBy(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetDeletedBy(s)
+	return ocuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableDeletedBy(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetDeletedBy(*s)
+	}
+	return ocuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ocuo *OauthClientUpdateOne) ClearDeletedBy() *OauthClientUpdateOne {
+	ocuo.mutation.ClearDeletedBy()
+	return ocuo
+}
+
+// SetName sets the "name" field.
+func (ocuo *OauthClientUpdateOne) SetName(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetName(s)
+	return ocuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableName(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetName(*s)
+	}
+	return ocuo
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (ocuo *OauthClientUpdateOne) SetClientSecretHash(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetClientSecretHash(s)
+	return ocuo
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableClientSecretHash(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetClientSecretHash(*s)
+	}
+	return ocuo
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (ocuo *OauthClientUpdateOne) ClearClientSecretHash() *OauthClientUpdateOne {
+	ocuo.mutation.ClearClientSecretHash()
+	return ocuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocuo *OauthClientUpdateOne) SetRedirectUris(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.SetRedirectUris(s)
+	return ocuo
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocuo *OauthClientUpdateOne) AppendRedirectUris(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.AppendRedirectUris(s)
+	return ocuo
+}
+
+// SetDescription sets the "description" field.
+func (ocuo *OauthClientUpdateOne) SetDescription(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetDescription(s)
+	return ocuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableDescription(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetDescription(*s)
+	}
+	return ocuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ocuo *OauthClientUpdateOne) ClearDescription() *OauthClientUpdateOne {
+	ocuo.mutation.ClearDescription()
+	return ocuo
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (ocuo *OauthClientUpdateOne) Mutation() *OauthClientMutation {
+	return ocuo.mutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (ocuo *OauthClientUpdateOne) Where(ps ...predicate.OauthClient) *OauthClientUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OauthClientUpdateOne) Select(field string, fields ...string) *OauthClientUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OauthClient entity.
+func (ocuo *OauthClientUpdateOne) Save(ctx context.Context) (*OauthClient, error) {
+	if err := ocuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OauthClientUpdateOne) SaveX(ctx context.Context) *OauthClient {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OauthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OauthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OauthClientUpdateOne) defaults() error {
+	if _, ok := ocuo.mutation.UpdatedAt(); !ok {
+		if oauthclient.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized oauthclient.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		ocuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OauthClientUpdateOne) check() error {
+	if v, ok := ocuo.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := ocuo.mutation.OwnerID(); ocuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OauthClient.owner"`)
+	}
+	return nil
+}
+
+func (ocuo *OauthClientUpdateOne) sqlSave(ctx context.Context) (_node *OauthClient, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OauthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ocuo.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ocuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ocuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ocuo.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeString, value)
+	}
+	if ocuo.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if ocuo.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocuo.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if ocuo.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	_spec.Node.Schema = ocuo.schemaConfig.OauthClient
+	ctx = internal.NewSchemaConfigContext(ctx, ocuo.schemaConfig)
+	_node = &OauthClient{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (opq *OauthProviderQuery) prepareQuery(ctx context.Context) error {
 		}
 		opq.sql = prev
 	}
+	if oauthprovider.Policy == nil {
+		return errors.New("generated: uninitialized oauthprovider.Policy (forgotten import generated/runtime?)")
+	}
+	if err := oauthprovider.Policy.EvalQuery(ctx, opq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ package ohauthtootoken
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -68,11 +69,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/datumforge/datum/internal/ent/generated/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
 	ClientIDValidator func(string) error
-	// NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
-	NonceValidator func(string) error
 	// ClaimsUserIDValidator is a validator for the "claims_user_id" field. It is called by the builders before save.
 	ClaimsUserIDValidator func(string) error
 	// ClaimsUsernameValidator is a validator for the "claims_username" field. It is called by the builders before save.
@@ -239,6 +239,16 @@ func NonceHasSuffix(v string) predicate.OhAuthTooToken {
 	return predicate.OhAuthTooToken(sql.FieldHasSuffix(FieldNonce, v))
 }
 
+// NonceIsNil applies the IsNil predicate on the "nonce" field.
+func NonceIsNil() predicate.OhAuthTooToken {
+	return predicate.OhAuthTooToken(sql.FieldIsNull(FieldNonce))
+}
+
+// NonceNotNil applies the NotNil predicate on the "nonce" field.
+func NonceNotNil() predicate.OhAuthTooToken {
+	return predicate.OhAuthTooToken(sql.FieldNotNull(FieldNonce))
+}
+
 // NonceEqualFold applies the EqualFold predicate on the "nonce" field.
 func NonceEqualFold(v string) predicate.OhAuthTooToken {
 	return predicate.OhAuthTooToken(sql.FieldEqualFold(FieldNonce, v))
@@ -38,6 +38,14 @@ func (oattc *OhAuthTooTokenCreate) SetNonce(s string) *OhAuthTooTokenCreate {
 	return oattc
 }
 
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oattc *OhAuthTooTokenCreate) SetNillableNonce(s *string) *OhAuthTooTokenCreate {
+	if s != nil {
+		oattc.SetNonce(*s)
+	}
+	return oattc
+}
+
 // SetClaimsUserID sets the "claims_user_id" field.
 func (oattc *OhAuthTooTokenCreate) SetClaimsUserID(s string) *OhAuthTooTokenCreate {
 	oattc.mutation.SetClaimsUserID(s)
@@ -121,7 +129,9 @@ func (oattc *OhAuthTooTokenCreate) Mutation() *OhAuthTooTokenMutation {
 
 // Save creates the OhAuthTooToken in the database.
 func (oattc *OhAuthTooTokenCreate) Save(ctx context.Context) (*OhAuthTooToken, error) {
-	oattc.defaults()
+	if err := oattc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, oattc.sqlSave, oattc.mutation, oattc.hooks)
 }
 
@@ -148,15 +158,22 @@ func (oattc *OhAuthTooTokenCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (oattc *OhAuthTooTokenCreate) defaults() {
+func (oattc *OhAuthTooTokenCreate) defaults() error {
 	if _, ok := oattc.mutation.LastUsed(); !ok {
+		if ohauthtootoken.DefaultLastUsed == nil {
+			return fmt.Errorf("generated: uninitialized ohauthtootoken.DefaultLastUsed (forgotten import generated/runtime?)")
+		}
 		v := ohauthtootoken.DefaultLastUsed()
 		oattc.mutation.SetLastUsed(v)
 	}
 	if _, ok := oattc.mutation.ID(); !ok {
+		if ohauthtootoken.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized ohauthtootoken.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := ohauthtootoken.DefaultID()
 		oattc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -169,14 +186,6 @@ func (oattc *OhAuthTooTokenCreate) check() error {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.client_id": %w`, err)}
 		}
 	}
-	if _, ok := oattc.mutation.Nonce(); !ok {
-		return &ValidationError{Name: "nonce", err: errors.New(`generated: missing required field "OhAuthTooToken.nonce"`)}
-	}
-	if v, ok := oattc.mutation.Nonce(); ok {
-		if err := ohauthtootoken.NonceValidator(v); err != nil {
-			return &ValidationError{Name: "nonce", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.nonce": %w`, err)}
-		}
-	}
 	if _, ok := oattc.mutation.ClaimsUserID(); !ok {
 		return &ValidationError{Name: "claims_user_id", err: errors.New(`generated: missing required field "OhAuthTooToken.claims_user_id"`)}
 	}
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -330,6 +331,12 @@ func (oattq *OhAuthTooTokenQuery) prepareQuery(ctx context.Context) error {
 		}
 		oattq.sql = prev
 	}
+	if ohauthtootoken.Policy == nil {
+		return errors.New("generated: uninitialized ohauthtootoken.Policy (forgotten import generated/runtime?)")
+	}
+	if err := ohauthtootoken.Policy.EvalQuery(ctx, oattq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -77,6 +77,12 @@ func (oattu *OhAuthTooTokenUpdate) SetNillableNonce(s *string) *OhAuthTooTokenUp
 	return oattu
 }
 
+// ClearNonce clears the value of the "nonce" field.
+func (oattu *OhAuthTooTokenUpdate) ClearNonce() *OhAuthTooTokenUpdate {
+	oattu.mutation.ClearNonce()
+	return oattu
+}
+
 // SetClaimsUserID sets the "claims_user_id" field.
 func (oattu *OhAuthTooTokenUpdate) SetClaimsUserID(s string) *OhAuthTooTokenUpdate {
 	oattu.mutation.SetClaimsUserID(s)
@@ -250,11 +256,6 @@ func (oattu *OhAuthTooTokenUpdate) check() error {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.client_id": %w`, err)}
 		}
 	}
-	if v, ok := oattu.mutation.Nonce(); ok {
-		if err := ohauthtootoken.NonceValidator(v); err != nil {
-			return &ValidationError{Name: "nonce", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.nonce": %w`, err)}
-		}
-	}
 	if v, ok := oattu.mutation.ClaimsUserID(); ok {
 		if err := ohauthtootoken.ClaimsUserIDValidator(v); err != nil {
 			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.claims_user_id": %w`, err)}
@@ -307,6 +308,9 @@ func (oattu *OhAuthTooTokenUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := oattu.mutation.Nonce(); ok {
 		_spec.SetField(ohauthtootoken.FieldNonce, field.TypeString, value)
 	}
+	if oattu.mutation.NonceCleared() {
+		_spec.ClearField(ohauthtootoken.FieldNonce, field.TypeString)
+	}
 	if value, ok := oattu.mutation.ClaimsUserID(); ok {
 		_spec.SetField(ohauthtootoken.FieldClaimsUserID, field.TypeString, value)
 	}
@@ -418,6 +422,12 @@ func (oattuo *OhAuthTooTokenUpdateOne) SetNillableNonce(s *string) *OhAuthTooTok
 	return oattuo
 }
 
+// ClearNonce clears the value of the "nonce" field.
+func (oattuo *OhAuthTooTokenUpdateOne) ClearNonce() *OhAuthTooTokenUpdateOne {
+	oattuo.mutation.ClearNonce()
+	return oattuo
+}
+
 // SetClaimsUserID sets the "claims_user_id" field.
 func (oattuo *OhAuthTooTokenUpdateOne) SetClaimsUserID(s string) *OhAuthTooTokenUpdateOne {
 	oattuo.mutation.SetClaimsUserID(s)
@@ -604,11 +614,6 @@ func (oattuo *OhAuthTooTokenUpdateOne) check() error {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.client_id": %w`, err)}
 		}
 	}
-	if v, ok := oattuo.mutation.Nonce(); ok {
-		if err := ohauthtootoken.NonceValidator(v); err != nil {
-			return &ValidationError{Name: "nonce", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.nonce": %w`, err)}
-		}
-	}
 	if v, ok := oattuo.mutation.ClaimsUserID(); ok {
 		if err := ohauthtootoken.ClaimsUserIDValidator(v); err != nil {
 			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`generated: validator failed for field "OhAuthTooToken.claims_user_id": %w`, err)}
@@ -678,6 +683,9 @@ func (oattuo *OhAuthTooTokenUpdateOne) sqlSave(ctx context.Context) (_node *OhAu
 	if value, ok := oattuo.mutation.Nonce(); ok {
 		_spec.SetField(ohauthtootoken.FieldNonce, field.TypeString, value)
 	}
+	if oattuo.mutation.NonceCleared() {
+		_spec.ClearField(ohauthtootoken.FieldNonce, field.TypeString)
+	}
 	if value, ok := oattuo.mutation.ClaimsUserID(); ok {
 		_spec.SetField(ohauthtootoken.FieldClaimsUserID, field.TypeString, value)
 	}
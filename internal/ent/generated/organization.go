@@ -64,11 +64,19 @@ type OrganizationEdges struct {
 	Entitlements []*Entitlement `json:"entitlements,omitempty"`
 	// Oauthprovider holds the value of the oauthprovider edge.
 	Oauthprovider []*OauthProvider `json:"oauthprovider,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// OauthClients holds the value of the oauth_clients edge.
+	OauthClients []*OauthClient `json:"oauth_clients,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*OrgMembership `json:"members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [12]bool
 	// totalCount holds the count of the edges above.
-	totalCount [8]map[string]int
+	totalCount [12]map[string]int
 
 	namedChildren      map[string][]*Organization
 	namedUsers         map[string][]*User
@@ -76,6 +84,10 @@ type OrganizationEdges struct {
 	namedIntegrations  map[string][]*Integration
 	namedEntitlements  map[string][]*Entitlement
 	namedOauthprovider map[string][]*OauthProvider
+	namedAPIKeys       map[string][]*APIKey
+	namedOauthClients  map[string][]*OauthClient
+	namedInvites       map[string][]*Invite
+	namedMembers       map[string][]*OrgMembership
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -158,6 +170,42 @@ func (e OrganizationEdges) OauthproviderOrErr() ([]*OauthProvider, error) {
 	return nil, &NotLoadedError{edge: "oauthprovider"}
 }
 
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[8] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// OauthClientsOrErr returns the OauthClients value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) OauthClientsOrErr() ([]*OauthClient, error) {
+	if e.loadedTypes[9] {
+		return e.OauthClients, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_clients"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[10] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) MembersOrErr() ([]*OrgMembership, error) {
+	if e.loadedTypes[11] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -309,6 +357,26 @@ func (o *Organization) QueryOauthprovider() *OauthProviderQuery {
 	return NewOrganizationClient(o.config).QueryOauthprovider(o)
 }
 
+// QueryAPIKeys queries the "api_keys" edge of the Organization entity.
+func (o *Organization) QueryAPIKeys() *APIKeyQuery {
+	return NewOrganizationClient(o.config).QueryAPIKeys(o)
+}
+
+// QueryOauthClients queries the "oauth_clients" edge of the Organization entity.
+func (o *Organization) QueryOauthClients() *OauthClientQuery {
+	return NewOrganizationClient(o.config).QueryOauthClients(o)
+}
+
+// QueryInvites queries the "invites" edge of the Organization entity.
+func (o *Organization) QueryInvites() *InviteQuery {
+	return NewOrganizationClient(o.config).QueryInvites(o)
+}
+
+// QueryMembers queries the "members" edge of the Organization entity.
+func (o *Organization) QueryMembers() *OrgMembershipQuery {
+	return NewOrganizationClient(o.config).QueryMembers(o)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -512,5 +580,101 @@ func (o *Organization) appendNamedOauthprovider(name string, edges ...*OauthProv
 	}
 }
 
+// NamedAPIKeys returns the APIKeys named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedAPIKeys(name string) ([]*APIKey, error) {
+	if o.Edges.namedAPIKeys == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedAPIKeys[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedAPIKeys(name string, edges ...*APIKey) {
+	if o.Edges.namedAPIKeys == nil {
+		o.Edges.namedAPIKeys = make(map[string][]*APIKey)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedAPIKeys[name] = []*APIKey{}
+	} else {
+		o.Edges.namedAPIKeys[name] = append(o.Edges.namedAPIKeys[name], edges...)
+	}
+}
+
+// NamedOauthClients returns the OauthClients named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedOauthClients(name string) ([]*OauthClient, error) {
+	if o.Edges.namedOauthClients == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedOauthClients[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedOauthClients(name string, edges ...*OauthClient) {
+	if o.Edges.namedOauthClients == nil {
+		o.Edges.namedOauthClients = make(map[string][]*OauthClient)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedOauthClients[name] = []*OauthClient{}
+	} else {
+		o.Edges.namedOauthClients[name] = append(o.Edges.namedOauthClients[name], edges...)
+	}
+}
+
+// NamedInvites returns the Invites named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedInvites(name string) ([]*Invite, error) {
+	if o.Edges.namedInvites == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedInvites[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedInvites(name string, edges ...*Invite) {
+	if o.Edges.namedInvites == nil {
+		o.Edges.namedInvites = make(map[string][]*Invite)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedInvites[name] = []*Invite{}
+	} else {
+		o.Edges.namedInvites[name] = append(o.Edges.namedInvites[name], edges...)
+	}
+}
+
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedMembers(name string) ([]*OrgMembership, error) {
+	if o.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedMembers(name string, edges ...*OrgMembership) {
+	if o.Edges.namedMembers == nil {
+		o.Edges.namedMembers = make(map[string][]*OrgMembership)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedMembers[name] = []*OrgMembership{}
+	} else {
+		o.Edges.namedMembers[name] = append(o.Edges.namedMembers[name], edges...)
+	}
+}
+
 // Organizations is a parsable slice of Organization.
 type Organizations []*Organization
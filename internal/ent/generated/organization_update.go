@@ -11,12 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 
@@ -150,6 +154,45 @@ func (ou *OrganizationUpdate) ClearDescription() *OrganizationUpdate {
 	return ou
 }
 
+// SetParentOrganizationID sets the "parent_organization_id" field.
+func (ou *OrganizationUpdate) SetParentOrganizationID(s string) *OrganizationUpdate {
+	ou.mutation.SetParentOrganizationID(s)
+	return ou
+}
+
+// SetNillableParentOrganizationID sets the "parent_organization_id" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableParentOrganizationID(s *string) *OrganizationUpdate {
+	if s != nil {
+		ou.SetParentOrganizationID(*s)
+	}
+	return ou
+}
+
+// ClearParentOrganizationID clears the value of the "parent_organization_id" field.
+func (ou *OrganizationUpdate) ClearParentOrganizationID() *OrganizationUpdate {
+	ou.mutation.ClearParentOrganizationID()
+	return ou
+}
+
+// SetParentID sets the "parent" edge to the Organization entity by ID.
+func (ou *OrganizationUpdate) SetParentID(id string) *OrganizationUpdate {
+	ou.mutation.SetParentID(id)
+	return ou
+}
+
+// SetNillableParentID sets the "parent" edge to the Organization entity by ID if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableParentID(id *string) *OrganizationUpdate {
+	if id != nil {
+		ou = ou.SetParentID(*id)
+	}
+	return ou
+}
+
+// SetParent sets the "parent" edge to the Organization entity.
+func (ou *OrganizationUpdate) SetParent(o *Organization) *OrganizationUpdate {
+	return ou.SetParentID(o.ID)
+}
+
 // AddChildIDs adds the "children" edge to the Organization entity by IDs.
 func (ou *OrganizationUpdate) AddChildIDs(ids ...string) *OrganizationUpdate {
 	ou.mutation.AddChildIDs(ids...)
@@ -259,11 +302,77 @@ func (ou *OrganizationUpdate) AddOauthprovider(o ...*OauthProvider) *Organizatio
 	return ou.AddOauthproviderIDs(ids...)
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ou *OrganizationUpdate) AddAPIKeyIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddAPIKeyIDs(ids...)
+	return ou
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ou *OrganizationUpdate) AddAPIKeys(a ...*APIKey) *OrganizationUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.AddAPIKeyIDs(ids...)
+}
+
+// AddOauthClientIDs adds the "oauth_clients" edge to the OauthClient entity by IDs.
+func (ou *OrganizationUpdate) AddOauthClientIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddOauthClientIDs(ids...)
+	return ou
+}
+
+// AddOauthClients adds the "oauth_clients" edges to the OauthClient entity.
+func (ou *OrganizationUpdate) AddOauthClients(o ...*OauthClient) *OrganizationUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddOauthClientIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (ou *OrganizationUpdate) AddInviteIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddInviteIDs(ids...)
+	return ou
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (ou *OrganizationUpdate) AddInvites(i ...*Invite) *OrganizationUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.AddInviteIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembership entity by IDs.
+func (ou *OrganizationUpdate) AddMemberIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddMemberIDs(ids...)
+	return ou
+}
+
+// AddMembers adds the "members" edges to the OrgMembership entity.
+func (ou *OrganizationUpdate) AddMembers(o ...*OrgMembership) *OrganizationUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddMemberIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ou *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return ou.mutation
 }
 
+// ClearParent clears the "parent" edge to the Organization entity.
+func (ou *OrganizationUpdate) ClearParent() *OrganizationUpdate {
+	ou.mutation.ClearParent()
+	return ou
+}
+
 // ClearChildren clears all "children" edges to the Organization entity.
 func (ou *OrganizationUpdate) ClearChildren() *OrganizationUpdate {
 	ou.mutation.ClearChildren()
@@ -396,6 +505,90 @@ func (ou *OrganizationUpdate) RemoveOauthprovider(o ...*OauthProvider) *Organiza
 	return ou.RemoveOauthproviderIDs(ids...)
 }
 
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ou *OrganizationUpdate) ClearAPIKeys() *OrganizationUpdate {
+	ou.mutation.ClearAPIKeys()
+	return ou
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ou *OrganizationUpdate) RemoveAPIKeyIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveAPIKeyIDs(ids...)
+	return ou
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ou *OrganizationUpdate) RemoveAPIKeys(a ...*APIKey) *OrganizationUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearOauthClients clears all "oauth_clients" edges to the OauthClient entity.
+func (ou *OrganizationUpdate) ClearOauthClients() *OrganizationUpdate {
+	ou.mutation.ClearOauthClients()
+	return ou
+}
+
+// RemoveOauthClientIDs removes the "oauth_clients" edge to OauthClient entities by IDs.
+func (ou *OrganizationUpdate) RemoveOauthClientIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveOauthClientIDs(ids...)
+	return ou
+}
+
+// RemoveOauthClients removes "oauth_clients" edges to OauthClient entities.
+func (ou *OrganizationUpdate) RemoveOauthClients(o ...*OauthClient) *OrganizationUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveOauthClientIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (ou *OrganizationUpdate) ClearInvites() *OrganizationUpdate {
+	ou.mutation.ClearInvites()
+	return ou
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (ou *OrganizationUpdate) RemoveInviteIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveInviteIDs(ids...)
+	return ou
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (ou *OrganizationUpdate) RemoveInvites(i ...*Invite) *OrganizationUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.RemoveInviteIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the OrgMembership entity.
+func (ou *OrganizationUpdate) ClearMembers() *OrganizationUpdate {
+	ou.mutation.ClearMembers()
+	return ou
+}
+
+// RemoveMemberIDs removes the "members" edge to OrgMembership entities by IDs.
+func (ou *OrganizationUpdate) RemoveMemberIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveMemberIDs(ids...)
+	return ou
+}
+
+// RemoveMembers removes "members" edges to OrgMembership entities.
+func (ou *OrganizationUpdate) RemoveMembers(o ...*OrgMembership) *OrganizationUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveMemberIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	if err := ou.defaults(); err != nil {
@@ -501,6 +694,37 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ou.mutation.DescriptionCleared() {
 		_spec.ClearField(organization.FieldDescription, field.TypeString)
 	}
+	if ou.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organization.ParentTable,
+			Columns: []string{organization.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.Organization
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organization.ParentTable,
+			Columns: []string{organization.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.Organization
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ou.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -560,7 +784,14 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ou.schemaConfig.UserOrganizations
+		edge.Schema = ou.schemaConfig.OrgMembership
+		createE := &OrgMembershipCreate{config: ou.config, mutation: newOrgMembershipMutation(ou.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ou.mutation.RemovedUsersIDs(); len(nodes) > 0 && !ou.mutation.UsersCleared() {
@@ -574,10 +805,17 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ou.schemaConfig.UserOrganizations
+		edge.Schema = ou.schemaConfig.OrgMembership
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &OrgMembershipCreate{config: ou.config, mutation: newOrgMembershipMutation(ou.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ou.mutation.UsersIDs(); len(nodes) > 0 {
@@ -591,10 +829,17 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ou.schemaConfig.UserOrganizations
+		edge.Schema = ou.schemaConfig.OrgMembership
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &OrgMembershipCreate{config: ou.config, mutation: newOrgMembershipMutation(ou.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if ou.mutation.GroupsCleared() {
@@ -820,6 +1065,198 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.APIKey
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ou.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.APIKey
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.APIKey
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.OauthClientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OauthClient
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedOauthClientsIDs(); len(nodes) > 0 && !ou.mutation.OauthClientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OauthClient
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.OauthClientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OauthClient
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.Invite
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !ou.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.Invite
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.Invite
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OrgMembership
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedMembersIDs(); len(nodes) > 0 && !ou.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OrgMembership
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.OrgMembership
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ou.schemaConfig.Organization
 	ctx = internal.NewSchemaConfigContext(ctx, ou.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
@@ -928,32 +1365,71 @@ func (ouo *OrganizationUpdateOne) SetDisplayName(s string) *OrganizationUpdateOn
 	return ouo
 }
 
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (ouo *OrganizationUpdateOne) SetNillableDisplayName(s *string) *OrganizationUpdateOne {
-	if s != nil {
-		ouo.SetDisplayName(*s)
-	}
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableDisplayName(s *string) *OrganizationUpdateOne {
+	if s != nil {
+		ouo.SetDisplayName(*s)
+	}
+	return ouo
+}
+
+// SetDescription sets the "description" field.
+func (ouo *OrganizationUpdateOne) SetDescription(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetDescription(s)
+	return ouo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableDescription(s *string) *OrganizationUpdateOne {
+	if s != nil {
+		ouo.SetDescription(*s)
+	}
+	return ouo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ouo *OrganizationUpdateOne) ClearDescription() *OrganizationUpdateOne {
+	ouo.mutation.ClearDescription()
+	return ouo
+}
+
+// SetParentOrganizationID sets the "parent_organization_id" field.
+func (ouo *OrganizationUpdateOne) SetParentOrganizationID(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetParentOrganizationID(s)
+	return ouo
+}
+
+// SetNillableParentOrganizationID sets the "parent_organization_id" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableParentOrganizationID(s *string) *OrganizationUpdateOne {
+	if s != nil {
+		ouo.SetParentOrganizationID(*s)
+	}
+	return ouo
+}
+
+// ClearParentOrganizationID clears the value of the "parent_organization_id" field.
+func (ouo *OrganizationUpdateOne) ClearParentOrganizationID() *OrganizationUpdateOne {
+	ouo.mutation.ClearParentOrganizationID()
 	return ouo
 }
 
-// SetDescription sets the "description" field.
-func (ouo *OrganizationUpdateOne) SetDescription(s string) *OrganizationUpdateOne {
-	ouo.mutation.SetDescription(s)
+// SetParentID sets the "parent" edge to the Organization entity by ID.
+func (ouo *OrganizationUpdateOne) SetParentID(id string) *OrganizationUpdateOne {
+	ouo.mutation.SetParentID(id)
 	return ouo
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (ouo *OrganizationUpdateOne) SetNillableDescription(s *string) *OrganizationUpdateOne {
-	if s != nil {
-		ouo.SetDescription(*s)
+// SetNillableParentID sets the "parent" edge to the Organization entity by ID if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableParentID(id *string) *OrganizationUpdateOne {
+	if id != nil {
+		ouo = ouo.SetParentID(*id)
 	}
 	return ouo
 }
 
-// ClearDescription clears the value of the "description" field.
-func (ouo *OrganizationUpdateOne) ClearDescription() *OrganizationUpdateOne {
-	ouo.mutation.ClearDescription()
-	return ouo
+// SetParent sets the "parent" edge to the Organization entity.
+func (ouo *OrganizationUpdateOne) SetParent(o *Organization) *OrganizationUpdateOne {
+	return ouo.SetParentID(o.ID)
 }
 
 // AddChildIDs adds the "children" edge to the Organization entity by IDs.
@@ -1065,11 +1541,77 @@ func (ouo *OrganizationUpdateOne) AddOauthprovider(o ...*OauthProvider) *Organiz
 	return ouo.AddOauthproviderIDs(ids...)
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ouo *OrganizationUpdateOne) AddAPIKeyIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddAPIKeyIDs(ids...)
+	return ouo
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ouo *OrganizationUpdateOne) AddAPIKeys(a ...*APIKey) *OrganizationUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.AddAPIKeyIDs(ids...)
+}
+
+// AddOauthClientIDs adds the "oauth_clients" edge to the OauthClient entity by IDs.
+func (ouo *OrganizationUpdateOne) AddOauthClientIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddOauthClientIDs(ids...)
+	return ouo
+}
+
+// AddOauthClients adds the "oauth_clients" edges to the OauthClient entity.
+func (ouo *OrganizationUpdateOne) AddOauthClients(o ...*OauthClient) *OrganizationUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddOauthClientIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (ouo *OrganizationUpdateOne) AddInviteIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddInviteIDs(ids...)
+	return ouo
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (ouo *OrganizationUpdateOne) AddInvites(i ...*Invite) *OrganizationUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.AddInviteIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembership entity by IDs.
+func (ouo *OrganizationUpdateOne) AddMemberIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddMemberIDs(ids...)
+	return ouo
+}
+
+// AddMembers adds the "members" edges to the OrgMembership entity.
+func (ouo *OrganizationUpdateOne) AddMembers(o ...*OrgMembership) *OrganizationUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddMemberIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
 	return ouo.mutation
 }
 
+// ClearParent clears the "parent" edge to the Organization entity.
+func (ouo *OrganizationUpdateOne) ClearParent() *OrganizationUpdateOne {
+	ouo.mutation.ClearParent()
+	return ouo
+}
+
 // ClearChildren clears all "children" edges to the Organization entity.
 func (ouo *OrganizationUpdateOne) ClearChildren() *OrganizationUpdateOne {
 	ouo.mutation.ClearChildren()
@@ -1202,6 +1744,90 @@ func (ouo *OrganizationUpdateOne) RemoveOauthprovider(o ...*OauthProvider) *Orga
 	return ouo.RemoveOauthproviderIDs(ids...)
 }
 
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ouo *OrganizationUpdateOne) ClearAPIKeys() *OrganizationUpdateOne {
+	ouo.mutation.ClearAPIKeys()
+	return ouo
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveAPIKeyIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveAPIKeyIDs(ids...)
+	return ouo
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ouo *OrganizationUpdateOne) RemoveAPIKeys(a ...*APIKey) *OrganizationUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearOauthClients clears all "oauth_clients" edges to the OauthClient entity.
+func (ouo *OrganizationUpdateOne) ClearOauthClients() *OrganizationUpdateOne {
+	ouo.mutation.ClearOauthClients()
+	return ouo
+}
+
+// RemoveOauthClientIDs removes the "oauth_clients" edge to OauthClient entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveOauthClientIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveOauthClientIDs(ids...)
+	return ouo
+}
+
+// RemoveOauthClients removes "oauth_clients" edges to OauthClient entities.
+func (ouo *OrganizationUpdateOne) RemoveOauthClients(o ...*OauthClient) *OrganizationUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveOauthClientIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (ouo *OrganizationUpdateOne) ClearInvites() *OrganizationUpdateOne {
+	ouo.mutation.ClearInvites()
+	return ouo
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveInviteIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveInviteIDs(ids...)
+	return ouo
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (ouo *OrganizationUpdateOne) RemoveInvites(i ...*Invite) *OrganizationUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.RemoveInviteIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the OrgMembership entity.
+func (ouo *OrganizationUpdateOne) ClearMembers() *OrganizationUpdateOne {
+	ouo.mutation.ClearMembers()
+	return ouo
+}
+
+// RemoveMemberIDs removes the "members" edge to OrgMembership entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveMemberIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveMemberIDs(ids...)
+	return ouo
+}
+
+// RemoveMembers removes "members" edges to OrgMembership entities.
+func (ouo *OrganizationUpdateOne) RemoveMembers(o ...*OrgMembership) *OrganizationUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveMemberIDs(ids...)
+}
+
 // Where appends a list predicates to the OrganizationUpdate builder.
 func (ouo *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -1337,6 +1963,37 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 	if ouo.mutation.DescriptionCleared() {
 		_spec.ClearField(organization.FieldDescription, field.TypeString)
 	}
+	if ouo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organization.ParentTable,
+			Columns: []string{organization.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.Organization
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organization.ParentTable,
+			Columns: []string{organization.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.Organization
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ouo.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1396,7 +2053,14 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ouo.schemaConfig.UserOrganizations
+		edge.Schema = ouo.schemaConfig.OrgMembership
+		createE := &OrgMembershipCreate{config: ouo.config, mutation: newOrgMembershipMutation(ouo.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ouo.mutation.RemovedUsersIDs(); len(nodes) > 0 && !ouo.mutation.UsersCleared() {
@@ -1410,10 +2074,17 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ouo.schemaConfig.UserOrganizations
+		edge.Schema = ouo.schemaConfig.OrgMembership
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &OrgMembershipCreate{config: ouo.config, mutation: newOrgMembershipMutation(ouo.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ouo.mutation.UsersIDs(); len(nodes) > 0 {
@@ -1427,10 +2098,17 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = ouo.schemaConfig.UserOrganizations
+		edge.Schema = ouo.schemaConfig.OrgMembership
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &OrgMembershipCreate{config: ouo.config, mutation: newOrgMembershipMutation(ouo.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if ouo.mutation.GroupsCleared() {
@@ -1656,6 +2334,198 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.APIKey
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ouo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.APIKey
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.APIKey
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.OauthClientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OauthClient
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedOauthClientsIDs(); len(nodes) > 0 && !ouo.mutation.OauthClientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OauthClient
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.OauthClientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OauthClientsTable,
+			Columns: []string{organization.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OauthClient
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.Invite
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !ouo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.Invite
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitesTable,
+			Columns: []string{organization.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.Invite
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OrgMembership
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !ouo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OrgMembership
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembership.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.OrgMembership
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ouo.schemaConfig.Organization
 	ctx = internal.NewSchemaConfigContext(ctx, ouo.schemaConfig)
 	_node = &Organization{config: ouo.config}
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (osq *OrganizationSettingQuery) prepareQuery(ctx context.Context) error {
 		}
 		osq.sql = prev
 	}
+	if organizationsetting.Policy == nil {
+		return errors.New("generated: uninitialized organizationsetting.Policy (forgotten import generated/runtime?)")
+	}
+	if err := organizationsetting.Policy.EvalQuery(ctx, osq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,919 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ownershiptransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldGroupID, v))
+}
+
+// RequestorID applies equality check predicate on the "requestor_id" field. It's identical to RequestorIDEQ.
+func RequestorID(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRequestorID, v))
+}
+
+// RecipientID applies equality check predicate on the "recipient_id" field. It's identical to RecipientIDEQ.
+func RecipientID(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToken, v))
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldExpires, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldSecret, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// RequestorIDEQ applies the EQ predicate on the "requestor_id" field.
+func RequestorIDEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRequestorID, v))
+}
+
+// RequestorIDNEQ applies the NEQ predicate on the "requestor_id" field.
+func RequestorIDNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldRequestorID, v))
+}
+
+// RequestorIDIn applies the In predicate on the "requestor_id" field.
+func RequestorIDIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldRequestorID, vs...))
+}
+
+// RequestorIDNotIn applies the NotIn predicate on the "requestor_id" field.
+func RequestorIDNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldRequestorID, vs...))
+}
+
+// RequestorIDGT applies the GT predicate on the "requestor_id" field.
+func RequestorIDGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldRequestorID, v))
+}
+
+// RequestorIDGTE applies the GTE predicate on the "requestor_id" field.
+func RequestorIDGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldRequestorID, v))
+}
+
+// RequestorIDLT applies the LT predicate on the "requestor_id" field.
+func RequestorIDLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldRequestorID, v))
+}
+
+// RequestorIDLTE applies the LTE predicate on the "requestor_id" field.
+func RequestorIDLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldRequestorID, v))
+}
+
+// RequestorIDContains applies the Contains predicate on the "requestor_id" field.
+func RequestorIDContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldRequestorID, v))
+}
+
+// RequestorIDHasPrefix applies the HasPrefix predicate on the "requestor_id" field.
+func RequestorIDHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldRequestorID, v))
+}
+
+// RequestorIDHasSuffix applies the HasSuffix predicate on the "requestor_id" field.
+func RequestorIDHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldRequestorID, v))
+}
+
+// RequestorIDEqualFold applies the EqualFold predicate on the "requestor_id" field.
+func RequestorIDEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldRequestorID, v))
+}
+
+// RequestorIDContainsFold applies the ContainsFold predicate on the "requestor_id" field.
+func RequestorIDContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldRequestorID, v))
+}
+
+// RecipientIDEQ applies the EQ predicate on the "recipient_id" field.
+func RecipientIDEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientIDNEQ applies the NEQ predicate on the "recipient_id" field.
+func RecipientIDNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldRecipientID, v))
+}
+
+// RecipientIDIn applies the In predicate on the "recipient_id" field.
+func RecipientIDIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDNotIn applies the NotIn predicate on the "recipient_id" field.
+func RecipientIDNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDGT applies the GT predicate on the "recipient_id" field.
+func RecipientIDGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldRecipientID, v))
+}
+
+// RecipientIDGTE applies the GTE predicate on the "recipient_id" field.
+func RecipientIDGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldRecipientID, v))
+}
+
+// RecipientIDLT applies the LT predicate on the "recipient_id" field.
+func RecipientIDLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldRecipientID, v))
+}
+
+// RecipientIDLTE applies the LTE predicate on the "recipient_id" field.
+func RecipientIDLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldRecipientID, v))
+}
+
+// RecipientIDContains applies the Contains predicate on the "recipient_id" field.
+func RecipientIDContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldRecipientID, v))
+}
+
+// RecipientIDHasPrefix applies the HasPrefix predicate on the "recipient_id" field.
+func RecipientIDHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldRecipientID, v))
+}
+
+// RecipientIDHasSuffix applies the HasSuffix predicate on the "recipient_id" field.
+func RecipientIDHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldRecipientID, v))
+}
+
+// RecipientIDEqualFold applies the EqualFold predicate on the "recipient_id" field.
+func RecipientIDEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldRecipientID, v))
+}
+
+// RecipientIDContainsFold applies the ContainsFold predicate on the "recipient_id" field.
+func RecipientIDContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldRecipientID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldContainsFold(FieldToken, v))
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldExpires, v))
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldExpires, v))
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldExpires, vs...))
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldExpires, vs...))
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldExpires, v))
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldExpires, v))
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldExpires, v))
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldExpires, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...[]byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...[]byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v []byte) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldSecret, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OwnerTable, OwnerColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.Organization) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newOwnerStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GroupTable, GroupColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Group
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newGroupStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Group
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequestor applies the HasEdge predicate on the "requestor" edge.
+func HasRequestor() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RequestorTable, RequestorColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestorWith applies the HasEdge predicate on the "requestor" edge with a given conditions (other predicates).
+func HasRequestorWith(preds ...predicate.User) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newRequestorStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RecipientTable, RecipientColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.User) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newRecipientStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.OwnershipTransfer
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.NotPredicates(p))
+}
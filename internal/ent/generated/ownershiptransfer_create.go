@@ -0,0 +1,523 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+)
+
+// OwnershipTransferCreate is the builder for creating a OwnershipTransfer entity.
+type OwnershipTransferCreate struct {
+	config
+	mutation *OwnershipTransferMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (otc *OwnershipTransferCreate) SetCreatedAt(t time.Time) *OwnershipTransferCreate {
+	otc.mutation.SetCreatedAt(t)
+	return otc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableCreatedAt(t *time.Time) *OwnershipTransferCreate {
+	if t != nil {
+		otc.SetCreatedAt(*t)
+	}
+	return otc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otc *OwnershipTransferCreate) SetUpdatedAt(t time.Time) *OwnershipTransferCreate {
+	otc.mutation.SetUpdatedAt(t)
+	return otc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableUpdatedAt(t *time.Time) *OwnershipTransferCreate {
+	if t != nil {
+		otc.SetUpdatedAt(*t)
+	}
+	return otc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (otc *OwnershipTransferCreate) SetCreatedBy(s string) *OwnershipTransferCreate {
+	otc.mutation.SetCreatedBy(s)
+	return otc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableCreatedBy(s *string) *OwnershipTransferCreate {
+	if s != nil {
+		otc.SetCreatedBy(*s)
+	}
+	return otc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (otc *OwnershipTransferCreate) SetUpdatedBy(s string) *OwnershipTransferCreate {
+	otc.mutation.SetUpdatedBy(s)
+	return otc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableUpdatedBy(s *string) *OwnershipTransferCreate {
+	if s != nil {
+		otc.SetUpdatedBy(*s)
+	}
+	return otc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (otc *OwnershipTransferCreate) SetOwnerID(s string) *OwnershipTransferCreate {
+	otc.mutation.SetOwnerID(s)
+	return otc
+}
+
+// SetGroupID sets the "group_id" field.
+func (otc *OwnershipTransferCreate) SetGroupID(s string) *OwnershipTransferCreate {
+	otc.mutation.SetGroupID(s)
+	return otc
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableGroupID(s *string) *OwnershipTransferCreate {
+	if s != nil {
+		otc.SetGroupID(*s)
+	}
+	return otc
+}
+
+// SetRequestorID sets the "requestor_id" field.
+func (otc *OwnershipTransferCreate) SetRequestorID(s string) *OwnershipTransferCreate {
+	otc.mutation.SetRequestorID(s)
+	return otc
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (otc *OwnershipTransferCreate) SetRecipientID(s string) *OwnershipTransferCreate {
+	otc.mutation.SetRecipientID(s)
+	return otc
+}
+
+// SetStatus sets the "status" field.
+func (otc *OwnershipTransferCreate) SetStatus(o ownershiptransfer.Status) *OwnershipTransferCreate {
+	otc.mutation.SetStatus(o)
+	return otc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferCreate {
+	if o != nil {
+		otc.SetStatus(*o)
+	}
+	return otc
+}
+
+// SetToken sets the "token" field.
+func (otc *OwnershipTransferCreate) SetToken(s string) *OwnershipTransferCreate {
+	otc.mutation.SetToken(s)
+	return otc
+}
+
+// SetExpires sets the "expires" field.
+func (otc *OwnershipTransferCreate) SetExpires(t time.Time) *OwnershipTransferCreate {
+	otc.mutation.SetExpires(t)
+	return otc
+}
+
+// SetSecret sets the "secret" field.
+func (otc *OwnershipTransferCreate) SetSecret(b []byte) *OwnershipTransferCreate {
+	otc.mutation.SetSecret(b)
+	return otc
+}
+
+// SetID sets the "id" field.
+func (otc *OwnershipTransferCreate) SetID(s string) *OwnershipTransferCreate {
+	otc.mutation.SetID(s)
+	return otc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableID(s *string) *OwnershipTransferCreate {
+	if s != nil {
+		otc.SetID(*s)
+	}
+	return otc
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (otc *OwnershipTransferCreate) SetOwner(o *Organization) *OwnershipTransferCreate {
+	return otc.SetOwnerID(o.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (otc *OwnershipTransferCreate) SetGroup(g *Group) *OwnershipTransferCreate {
+	return otc.SetGroupID(g.ID)
+}
+
+// SetRequestor sets the "requestor" edge to the User entity.
+func (otc *OwnershipTransferCreate) SetRequestor(u *User) *OwnershipTransferCreate {
+	return otc.SetRequestorID(u.ID)
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (otc *OwnershipTransferCreate) SetRecipient(u *User) *OwnershipTransferCreate {
+	return otc.SetRecipientID(u.ID)
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otc *OwnershipTransferCreate) Mutation() *OwnershipTransferMutation {
+	return otc.mutation
+}
+
+// Save creates the OwnershipTransfer in the database.
+func (otc *OwnershipTransferCreate) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, otc.sqlSave, otc.mutation, otc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (otc *OwnershipTransferCreate) SaveX(ctx context.Context) *OwnershipTransfer {
+	v, err := otc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otc *OwnershipTransferCreate) Exec(ctx context.Context) error {
+	_, err := otc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otc *OwnershipTransferCreate) ExecX(ctx context.Context) {
+	if err := otc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otc *OwnershipTransferCreate) defaults() error {
+	if _, ok := otc.mutation.CreatedAt(); !ok {
+		if ownershiptransfer.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized ownershiptransfer.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := ownershiptransfer.DefaultCreatedAt()
+		otc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := otc.mutation.UpdatedAt(); !ok {
+		if ownershiptransfer.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized ownershiptransfer.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := ownershiptransfer.DefaultUpdatedAt()
+		otc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		v := ownershiptransfer.DefaultStatus
+		otc.mutation.SetStatus(v)
+	}
+	if _, ok := otc.mutation.ID(); !ok {
+		if ownershiptransfer.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized ownershiptransfer.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := ownershiptransfer.DefaultID()
+		otc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otc *OwnershipTransferCreate) check() error {
+	if _, ok := otc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "OwnershipTransfer.created_at"`)}
+	}
+	if _, ok := otc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "OwnershipTransfer.updated_at"`)}
+	}
+	if _, ok := otc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "OwnershipTransfer.owner_id"`)}
+	}
+	if _, ok := otc.mutation.RequestorID(); !ok {
+		return &ValidationError{Name: "requestor_id", err: errors.New(`generated: missing required field "OwnershipTransfer.requestor_id"`)}
+	}
+	if _, ok := otc.mutation.RecipientID(); !ok {
+		return &ValidationError{Name: "recipient_id", err: errors.New(`generated: missing required field "OwnershipTransfer.recipient_id"`)}
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "OwnershipTransfer.status"`)}
+	}
+	if v, ok := otc.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`generated: missing required field "OwnershipTransfer.token"`)}
+	}
+	if v, ok := otc.mutation.Token(); ok {
+		if err := ownershiptransfer.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.token": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`generated: missing required field "OwnershipTransfer.expires"`)}
+	}
+	if _, ok := otc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`generated: missing required field "OwnershipTransfer.secret"`)}
+	}
+	if v, ok := otc.mutation.Secret(); ok {
+		if err := ownershiptransfer.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.secret": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "OwnershipTransfer.owner"`)}
+	}
+	if _, ok := otc.mutation.RequestorID(); !ok {
+		return &ValidationError{Name: "requestor", err: errors.New(`generated: missing required edge "OwnershipTransfer.requestor"`)}
+	}
+	if _, ok := otc.mutation.RecipientID(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`generated: missing required edge "OwnershipTransfer.recipient"`)}
+	}
+	return nil
+}
+
+func (otc *OwnershipTransferCreate) sqlSave(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := otc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, otc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OwnershipTransfer.ID type: %T", _spec.ID.Value)
+		}
+	}
+	otc.mutation.id = &_node.ID
+	otc.mutation.done = true
+	return _node, nil
+}
+
+func (otc *OwnershipTransferCreate) createSpec() (*OwnershipTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnershipTransfer{config: otc.config}
+		_spec = sqlgraph.NewCreateSpec(ownershiptransfer.Table, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeString))
+	)
+	_spec.Schema = otc.schemaConfig.OwnershipTransfer
+	if id, ok := otc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := otc.mutation.CreatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := otc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := otc.mutation.CreatedBy(); ok {
+		_spec.SetField(ownershiptransfer.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := otc.mutation.UpdatedBy(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := otc.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := otc.mutation.Token(); ok {
+		_spec.SetField(ownershiptransfer.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := otc.mutation.Expires(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	if value, ok := otc.mutation.Secret(); ok {
+		_spec.SetField(ownershiptransfer.FieldSecret, field.TypeBytes, value)
+		_node.Secret = &value
+	}
+	if nodes := otc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.OwnerTable,
+			Columns: []string{ownershiptransfer.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = otc.schemaConfig.OwnershipTransfer
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := otc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.GroupTable,
+			Columns: []string{ownershiptransfer.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = otc.schemaConfig.OwnershipTransfer
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := otc.mutation.RequestorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.RequestorTable,
+			Columns: []string{ownershiptransfer.RequestorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = otc.schemaConfig.OwnershipTransfer
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RequestorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := otc.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ownershiptransfer.RecipientTable,
+			Columns: []string{ownershiptransfer.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = otc.schemaConfig.OwnershipTransfer
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecipientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OwnershipTransferCreateBulk is the builder for creating many OwnershipTransfer entities in bulk.
+type OwnershipTransferCreateBulk struct {
+	config
+	err      error
+	builders []*OwnershipTransferCreate
+}
+
+// Save creates the OwnershipTransfer entities in the database.
+func (otcb *OwnershipTransferCreateBulk) Save(ctx context.Context) ([]*OwnershipTransfer, error) {
+	if otcb.err != nil {
+		return nil, otcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(otcb.builders))
+	nodes := make([]*OwnershipTransfer, len(otcb.builders))
+	mutators := make([]Mutator, len(otcb.builders))
+	for i := range otcb.builders {
+		func(i int, root context.Context) {
+			builder := otcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnershipTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, otcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, otcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, otcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otcb *OwnershipTransferCreateBulk) SaveX(ctx context.Context) []*OwnershipTransfer {
+	v, err := otcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otcb *OwnershipTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := otcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otcb *OwnershipTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := otcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+)
+
+// OwnershipTransferDelete is the builder for deleting a OwnershipTransfer entity.
+type OwnershipTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// Where appends a list predicates to the OwnershipTransferDelete builder.
+func (otd *OwnershipTransferDelete) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferDelete {
+	otd.mutation.Where(ps...)
+	return otd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (otd *OwnershipTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, otd.sqlExec, otd.mutation, otd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otd *OwnershipTransferDelete) ExecX(ctx context.Context) int {
+	n, err := otd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (otd *OwnershipTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ownershiptransfer.Table, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeString))
+	_spec.Node.Schema = otd.schemaConfig.OwnershipTransfer
+	ctx = internal.NewSchemaConfigContext(ctx, otd.schemaConfig)
+	if ps := otd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, otd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	otd.mutation.done = true
+	return affected, err
+}
+
+// OwnershipTransferDeleteOne is the builder for deleting a single OwnershipTransfer entity.
+type OwnershipTransferDeleteOne struct {
+	otd *OwnershipTransferDelete
+}
+
+// Where appends a list predicates to the OwnershipTransferDelete builder.
+func (otdo *OwnershipTransferDeleteOne) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferDeleteOne {
+	otdo.otd.mutation.Where(ps...)
+	return otdo
+}
+
+// Exec executes the deletion query.
+func (otdo *OwnershipTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := otdo.otd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ownershiptransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otdo *OwnershipTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := otdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// OwnershipTransferUpdate is the builder for updating OwnershipTransfer entities.
+type OwnershipTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (otu *OwnershipTransferUpdate) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdate {
+	otu.mutation.Where(ps...)
+	return otu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otu *OwnershipTransferUpdate) SetUpdatedAt(t time.Time) *OwnershipTransferUpdate {
+	otu.mutation.SetUpdatedAt(t)
+	return otu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (otu *OwnershipTransferUpdate) SetUpdatedBy(s string) *OwnershipTransferUpdate {
+	otu.mutation.SetUpdatedBy(s)
+	return otu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (otu *OwnershipTransferUpdate) SetNillableUpdatedBy(s *string) *OwnershipTransferUpdate {
+	if s != nil {
+		otu.SetUpdatedBy(*s)
+	}
+	return otu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (otu *OwnershipTransferUpdate) ClearUpdatedBy() *OwnershipTransferUpdate {
+	otu.mutation.ClearUpdatedBy()
+	return otu
+}
+
+// SetStatus sets the "status" field.
+func (otu *OwnershipTransferUpdate) SetStatus(o ownershiptransfer.Status) *OwnershipTransferUpdate {
+	otu.mutation.SetStatus(o)
+	return otu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otu *OwnershipTransferUpdate) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferUpdate {
+	if o != nil {
+		otu.SetStatus(*o)
+	}
+	return otu
+}
+
+// SetToken sets the "token" field.
+func (otu *OwnershipTransferUpdate) SetToken(s string) *OwnershipTransferUpdate {
+	otu.mutation.SetToken(s)
+	return otu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (otu *OwnershipTransferUpdate) SetNillableToken(s *string) *OwnershipTransferUpdate {
+	if s != nil {
+		otu.SetToken(*s)
+	}
+	return otu
+}
+
+// SetExpires sets the "expires" field.
+func (otu *OwnershipTransferUpdate) SetExpires(t time.Time) *OwnershipTransferUpdate {
+	otu.mutation.SetExpires(t)
+	return otu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (otu *OwnershipTransferUpdate) SetNillableExpires(t *time.Time) *OwnershipTransferUpdate {
+	if t != nil {
+		otu.SetExpires(*t)
+	}
+	return otu
+}
+
+// SetSecret sets the "secret" field.
+func (otu *OwnershipTransferUpdate) SetSecret(b []byte) *OwnershipTransferUpdate {
+	otu.mutation.SetSecret(b)
+	return otu
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otu *OwnershipTransferUpdate) Mutation() *OwnershipTransferMutation {
+	return otu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (otu *OwnershipTransferUpdate) Save(ctx context.Context) (int, error) {
+	if err := otu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, otu.sqlSave, otu.mutation, otu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otu *OwnershipTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := otu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (otu *OwnershipTransferUpdate) Exec(ctx context.Context) error {
+	_, err := otu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otu *OwnershipTransferUpdate) ExecX(ctx context.Context) {
+	if err := otu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otu *OwnershipTransferUpdate) defaults() error {
+	if _, ok := otu.mutation.UpdatedAt(); !ok {
+		if ownershiptransfer.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized ownershiptransfer.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := ownershiptransfer.UpdateDefaultUpdatedAt()
+		otu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otu *OwnershipTransferUpdate) check() error {
+	if v, ok := otu.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if v, ok := otu.mutation.Token(); ok {
+		if err := ownershiptransfer.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.token": %w`, err)}
+		}
+	}
+	if v, ok := otu.mutation.Secret(); ok {
+		if err := ownershiptransfer.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.secret": %w`, err)}
+		}
+	}
+	if _, ok := otu.mutation.OwnerID(); otu.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.owner"`)
+	}
+	if _, ok := otu.mutation.RequestorID(); otu.mutation.RequestorCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.requestor"`)
+	}
+	if _, ok := otu.mutation.RecipientID(); otu.mutation.RecipientCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.recipient"`)
+	}
+	return nil
+}
+
+func (otu *OwnershipTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := otu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeString))
+	if ps := otu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if otu.mutation.CreatedByCleared() {
+		_spec.ClearField(ownershiptransfer.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := otu.mutation.UpdatedBy(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedBy, field.TypeString, value)
+	}
+	if otu.mutation.UpdatedByCleared() {
+		_spec.ClearField(ownershiptransfer.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := otu.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := otu.mutation.Token(); ok {
+		_spec.SetField(ownershiptransfer.FieldToken, field.TypeString, value)
+	}
+	if value, ok := otu.mutation.Expires(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpires, field.TypeTime, value)
+	}
+	if value, ok := otu.mutation.Secret(); ok {
+		_spec.SetField(ownershiptransfer.FieldSecret, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = otu.schemaConfig.OwnershipTransfer
+	ctx = internal.NewSchemaConfigContext(ctx, otu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, otu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	otu.mutation.done = true
+	return n, nil
+}
+
+// OwnershipTransferUpdateOne is the builder for updating a single OwnershipTransfer entity.
+type OwnershipTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otuo *OwnershipTransferUpdateOne) SetUpdatedAt(t time.Time) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetUpdatedAt(t)
+	return otuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (otuo *OwnershipTransferUpdateOne) SetUpdatedBy(s string) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetUpdatedBy(s)
+	return otuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (otuo *OwnershipTransferUpdateOne) SetNillableUpdatedBy(s *string) *OwnershipTransferUpdateOne {
+	if s != nil {
+		otuo.SetUpdatedBy(*s)
+	}
+	return otuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (otuo *OwnershipTransferUpdateOne) ClearUpdatedBy() *OwnershipTransferUpdateOne {
+	otuo.mutation.ClearUpdatedBy()
+	return otuo
+}
+
+// SetStatus sets the "status" field.
+func (otuo *OwnershipTransferUpdateOne) SetStatus(o ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetStatus(o)
+	return otuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otuo *OwnershipTransferUpdateOne) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	if o != nil {
+		otuo.SetStatus(*o)
+	}
+	return otuo
+}
+
+// SetToken sets the "token" field.
+func (otuo *OwnershipTransferUpdateOne) SetToken(s string) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetToken(s)
+	return otuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (otuo *OwnershipTransferUpdateOne) SetNillableToken(s *string) *OwnershipTransferUpdateOne {
+	if s != nil {
+		otuo.SetToken(*s)
+	}
+	return otuo
+}
+
+// SetExpires sets the "expires" field.
+func (otuo *OwnershipTransferUpdateOne) SetExpires(t time.Time) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetExpires(t)
+	return otuo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (otuo *OwnershipTransferUpdateOne) SetNillableExpires(t *time.Time) *OwnershipTransferUpdateOne {
+	if t != nil {
+		otuo.SetExpires(*t)
+	}
+	return otuo
+}
+
+// SetSecret sets the "secret" field.
+func (otuo *OwnershipTransferUpdateOne) SetSecret(b []byte) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetSecret(b)
+	return otuo
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otuo *OwnershipTransferUpdateOne) Mutation() *OwnershipTransferMutation {
+	return otuo.mutation
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (otuo *OwnershipTransferUpdateOne) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdateOne {
+	otuo.mutation.Where(ps...)
+	return otuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (otuo *OwnershipTransferUpdateOne) Select(field string, fields ...string) *OwnershipTransferUpdateOne {
+	otuo.fields = append([]string{field}, fields...)
+	return otuo
+}
+
+// Save executes the query and returns the updated OwnershipTransfer entity.
+func (otuo *OwnershipTransferUpdateOne) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, otuo.sqlSave, otuo.mutation, otuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otuo *OwnershipTransferUpdateOne) SaveX(ctx context.Context) *OwnershipTransfer {
+	node, err := otuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (otuo *OwnershipTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := otuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otuo *OwnershipTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := otuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otuo *OwnershipTransferUpdateOne) defaults() error {
+	if _, ok := otuo.mutation.UpdatedAt(); !ok {
+		if ownershiptransfer.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized ownershiptransfer.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := ownershiptransfer.UpdateDefaultUpdatedAt()
+		otuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otuo *OwnershipTransferUpdateOne) check() error {
+	if v, ok := otuo.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if v, ok := otuo.mutation.Token(); ok {
+		if err := ownershiptransfer.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.token": %w`, err)}
+		}
+	}
+	if v, ok := otuo.mutation.Secret(); ok {
+		if err := ownershiptransfer.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`generated: validator failed for field "OwnershipTransfer.secret": %w`, err)}
+		}
+	}
+	if _, ok := otuo.mutation.OwnerID(); otuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.owner"`)
+	}
+	if _, ok := otuo.mutation.RequestorID(); otuo.mutation.RequestorCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.requestor"`)
+	}
+	if _, ok := otuo.mutation.RecipientID(); otuo.mutation.RecipientCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "OwnershipTransfer.recipient"`)
+	}
+	return nil
+}
+
+func (otuo *OwnershipTransferUpdateOne) sqlSave(ctx context.Context) (_node *OwnershipTransfer, err error) {
+	if err := otuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeString))
+	id, ok := otuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OwnershipTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := otuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownershiptransfer.FieldID)
+		for _, f := range fields {
+			if !ownershiptransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != ownershiptransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := otuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if otuo.mutation.CreatedByCleared() {
+		_spec.ClearField(ownershiptransfer.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := otuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedBy, field.TypeString, value)
+	}
+	if otuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(ownershiptransfer.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := otuo.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := otuo.mutation.Token(); ok {
+		_spec.SetField(ownershiptransfer.FieldToken, field.TypeString, value)
+	}
+	if value, ok := otuo.mutation.Expires(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpires, field.TypeTime, value)
+	}
+	if value, ok := otuo.mutation.Secret(); ok {
+		_spec.SetField(ownershiptransfer.FieldSecret, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = otuo.schemaConfig.OwnershipTransfer
+	ctx = internal.NewSchemaConfigContext(ctx, otuo.schemaConfig)
+	_node = &OwnershipTransfer{config: otuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, otuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	otuo.mutation.done = true
+	return _node, nil
+}
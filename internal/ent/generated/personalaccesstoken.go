@@ -33,9 +33,13 @@ type PersonalAccessToken struct {
 	DeletedBy string `json:"deleted_by,omitempty"`
 	// the name associated with the token
 	Name string `json:"name,omitempty"`
-	// Token holds the value of the "token" field.
+	// the visible prefix of the token used to identify it, the token itself is only returned on creation
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// the salted hash of the token, empty for tokens created before tokens were hashed
+	TokenHash string `json:"-"`
+	// the plaintext token of tokens created before tokens were hashed, they can be used until they expire
 	Token string `json:"-"`
-	// what abilites the token should have
+	// the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner
 	Abilities []string `json:"abilities,omitempty"`
 	// when the token expires
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
@@ -81,7 +85,7 @@ func (*PersonalAccessToken) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case personalaccesstoken.FieldAbilities:
 			values[i] = new([]byte)
-		case personalaccesstoken.FieldID, personalaccesstoken.FieldCreatedBy, personalaccesstoken.FieldUpdatedBy, personalaccesstoken.FieldDeletedBy, personalaccesstoken.FieldName, personalaccesstoken.FieldToken, personalaccesstoken.FieldDescription:
+		case personalaccesstoken.FieldID, personalaccesstoken.FieldCreatedBy, personalaccesstoken.FieldUpdatedBy, personalaccesstoken.FieldDeletedBy, personalaccesstoken.FieldName, personalaccesstoken.FieldTokenPrefix, personalaccesstoken.FieldTokenHash, personalaccesstoken.FieldToken, personalaccesstoken.FieldDescription:
 			values[i] = new(sql.NullString)
 		case personalaccesstoken.FieldCreatedAt, personalaccesstoken.FieldUpdatedAt, personalaccesstoken.FieldDeletedAt, personalaccesstoken.FieldExpiresAt, personalaccesstoken.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
@@ -150,6 +154,18 @@ func (pat *PersonalAccessToken) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				pat.Name = value.String
 			}
+		case personalaccesstoken.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				pat.TokenPrefix = value.String
+			}
+		case personalaccesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				pat.TokenHash = value.String
+			}
 		case personalaccesstoken.FieldToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field token", values[i])
@@ -253,6 +269,11 @@ func (pat *PersonalAccessToken) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(pat.Name)
 	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(pat.TokenPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("token=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("abilities=")
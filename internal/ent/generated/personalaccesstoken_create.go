@@ -111,6 +111,26 @@ func (patc *PersonalAccessTokenCreate) SetName(s string) *PersonalAccessTokenCre
 	return patc
 }
 
+// SetTokenPrefix sets the "token_prefix" field.
+func (patc *PersonalAccessTokenCreate) SetTokenPrefix(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetTokenPrefix(s)
+	return patc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patc *PersonalAccessTokenCreate) SetTokenHash(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetTokenHash(s)
+	return patc
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableTokenHash(s *string) *PersonalAccessTokenCreate {
+	if s != nil {
+		patc.SetTokenHash(*s)
+	}
+	return patc
+}
+
 // SetToken sets the "token" field.
 func (patc *PersonalAccessTokenCreate) SetToken(s string) *PersonalAccessTokenCreate {
 	patc.mutation.SetToken(s)
@@ -241,13 +261,6 @@ func (patc *PersonalAccessTokenCreate) defaults() error {
 		v := personalaccesstoken.DefaultUpdatedAt()
 		patc.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := patc.mutation.Token(); !ok {
-		if personalaccesstoken.DefaultToken == nil {
-			return fmt.Errorf("generated: uninitialized personalaccesstoken.DefaultToken (forgotten import generated/runtime?)")
-		}
-		v := personalaccesstoken.DefaultToken()
-		patc.mutation.SetToken(v)
-	}
 	if _, ok := patc.mutation.Description(); !ok {
 		v := personalaccesstoken.DefaultDescription
 		patc.mutation.SetDescription(v)
@@ -273,8 +286,18 @@ func (patc *PersonalAccessTokenCreate) check() error {
 	if _, ok := patc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "PersonalAccessToken.name"`)}
 	}
-	if _, ok := patc.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New(`generated: missing required field "PersonalAccessToken.token"`)}
+	if _, ok := patc.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`generated: missing required field "PersonalAccessToken.token_prefix"`)}
+	}
+	if v, ok := patc.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`generated: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if v, ok := patc.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`generated: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
 	}
 	if _, ok := patc.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "PersonalAccessToken.expires_at"`)}
@@ -346,6 +369,14 @@ func (patc *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlg
 		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := patc.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := patc.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
 	if value, ok := patc.mutation.Token(); ok {
 		_spec.SetField(personalaccesstoken.FieldToken, field.TypeString, value)
 		_node.Token = value
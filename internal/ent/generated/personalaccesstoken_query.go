@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (patq *PersonalAccessTokenQuery) prepareQuery(ctx context.Context) error {
 		}
 		patq.sql = prev
 	}
+	if personalaccesstoken.Policy == nil {
+		return errors.New("generated: uninitialized personalaccesstoken.Policy (forgotten import generated/runtime?)")
+	}
+	if err := personalaccesstoken.Policy.EvalQuery(ctx, patq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -294,6 +294,12 @@ func (patu *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := patu.mutation.Name(); ok {
 		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
 	}
+	if patu.mutation.TokenHashCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenHash, field.TypeString)
+	}
+	if patu.mutation.TokenCleared() {
+		_spec.ClearField(personalaccesstoken.FieldToken, field.TypeString)
+	}
 	if value, ok := patu.mutation.Abilities(); ok {
 		_spec.SetField(personalaccesstoken.FieldAbilities, field.TypeJSON, value)
 	}
@@ -665,6 +671,12 @@ func (patuo *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := patuo.mutation.Name(); ok {
 		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
 	}
+	if patuo.mutation.TokenHashCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenHash, field.TypeString)
+	}
+	if patuo.mutation.TokenCleared() {
+		_spec.ClearField(personalaccesstoken.FieldToken, field.TypeString)
+	}
 	if value, ok := patuo.mutation.Abilities(); ok {
 		_spec.SetField(personalaccesstoken.FieldAbilities, field.TypeJSON, value)
 	}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // EmailVerificationToken is the predicate function for emailverificationtoken builders.
 type EmailVerificationToken func(*sql.Selector)
 
@@ -15,35 +18,71 @@ type Entitlement func(*sql.Selector)
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// GroupJoinRequest is the predicate function for groupjoinrequest builders.
+type GroupJoinRequest func(*sql.Selector)
+
+// GroupMembership is the predicate function for groupmembership builders.
+type GroupMembership func(*sql.Selector)
+
 // GroupSetting is the predicate function for groupsetting builders.
 type GroupSetting func(*sql.Selector)
 
 // Integration is the predicate function for integration builders.
 type Integration func(*sql.Selector)
 
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
+// MagicLinkToken is the predicate function for magiclinktoken builders.
+type MagicLinkToken func(*sql.Selector)
+
+// OauthAuthorizationCode is the predicate function for oauthauthorizationcode builders.
+type OauthAuthorizationCode func(*sql.Selector)
+
+// OauthClient is the predicate function for oauthclient builders.
+type OauthClient func(*sql.Selector)
+
 // OauthProvider is the predicate function for oauthprovider builders.
 type OauthProvider func(*sql.Selector)
 
 // OhAuthTooToken is the predicate function for ohauthtootoken builders.
 type OhAuthTooToken func(*sql.Selector)
 
+// OrgMembership is the predicate function for orgmembership builders.
+type OrgMembership func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
 // OrganizationSetting is the predicate function for organizationsetting builders.
 type OrganizationSetting func(*sql.Selector)
 
+// OwnershipTransfer is the predicate function for ownershiptransfer builders.
+type OwnershipTransfer func(*sql.Selector)
+
 // PasswordResetToken is the predicate function for passwordresettoken builders.
 type PasswordResetToken func(*sql.Selector)
 
 // PersonalAccessToken is the predicate function for personalaccesstoken builders.
 type PersonalAccessToken func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// RevokedToken is the predicate function for revokedtoken builders.
+type RevokedToken func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
+// SessionData is the predicate function for sessiondata builders.
+type SessionData func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserSetting is the predicate function for usersetting builders.
 type UserSetting func(*sql.Selector)
+
+// WebauthnCredential is the predicate function for webauthncredential builders.
+type WebauthnCredential func(*sql.Selector)
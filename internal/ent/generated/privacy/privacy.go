@@ -111,6 +111,30 @@ func DenyMutationOperationRule(op generated.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The APIKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type APIKeyQueryRuleFunc func(context.Context, *generated.APIKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f APIKeyQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.APIKeyQuery", q)
+}
+
+// The APIKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type APIKeyMutationRuleFunc func(context.Context, *generated.APIKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f APIKeyMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.APIKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.APIKeyMutation", m)
+}
+
 // The EmailVerificationTokenQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type EmailVerificationTokenQueryRuleFunc func(context.Context, *generated.EmailVerificationTokenQuery) error
@@ -183,6 +207,54 @@ func (f GroupMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mut
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.GroupMutation", m)
 }
 
+// The GroupJoinRequestQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type GroupJoinRequestQueryRuleFunc func(context.Context, *generated.GroupJoinRequestQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f GroupJoinRequestQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.GroupJoinRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.GroupJoinRequestQuery", q)
+}
+
+// The GroupJoinRequestMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type GroupJoinRequestMutationRuleFunc func(context.Context, *generated.GroupJoinRequestMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f GroupJoinRequestMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.GroupJoinRequestMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.GroupJoinRequestMutation", m)
+}
+
+// The GroupMembershipQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type GroupMembershipQueryRuleFunc func(context.Context, *generated.GroupMembershipQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f GroupMembershipQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.GroupMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.GroupMembershipQuery", q)
+}
+
+// The GroupMembershipMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type GroupMembershipMutationRuleFunc func(context.Context, *generated.GroupMembershipMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f GroupMembershipMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.GroupMembershipMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.GroupMembershipMutation", m)
+}
+
 // The GroupSettingQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type GroupSettingQueryRuleFunc func(context.Context, *generated.GroupSettingQuery) error
@@ -231,6 +303,102 @@ func (f IntegrationMutationRuleFunc) EvalMutation(ctx context.Context, m generat
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.IntegrationMutation", m)
 }
 
+// The InviteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InviteQueryRuleFunc func(context.Context, *generated.InviteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InviteQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.InviteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.InviteQuery", q)
+}
+
+// The InviteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InviteMutationRuleFunc func(context.Context, *generated.InviteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InviteMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.InviteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.InviteMutation", m)
+}
+
+// The MagicLinkTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MagicLinkTokenQueryRuleFunc func(context.Context, *generated.MagicLinkTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MagicLinkTokenQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.MagicLinkTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.MagicLinkTokenQuery", q)
+}
+
+// The MagicLinkTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MagicLinkTokenMutationRuleFunc func(context.Context, *generated.MagicLinkTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MagicLinkTokenMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.MagicLinkTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.MagicLinkTokenMutation", m)
+}
+
+// The OauthAuthorizationCodeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OauthAuthorizationCodeQueryRuleFunc func(context.Context, *generated.OauthAuthorizationCodeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OauthAuthorizationCodeQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OauthAuthorizationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.OauthAuthorizationCodeQuery", q)
+}
+
+// The OauthAuthorizationCodeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OauthAuthorizationCodeMutationRuleFunc func(context.Context, *generated.OauthAuthorizationCodeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OauthAuthorizationCodeMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.OauthAuthorizationCodeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OauthAuthorizationCodeMutation", m)
+}
+
+// The OauthClientQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OauthClientQueryRuleFunc func(context.Context, *generated.OauthClientQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OauthClientQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OauthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.OauthClientQuery", q)
+}
+
+// The OauthClientMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OauthClientMutationRuleFunc func(context.Context, *generated.OauthClientMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OauthClientMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.OauthClientMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OauthClientMutation", m)
+}
+
 // The OauthProviderQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type OauthProviderQueryRuleFunc func(context.Context, *generated.OauthProviderQuery) error
@@ -279,6 +447,30 @@ func (f OhAuthTooTokenMutationRuleFunc) EvalMutation(ctx context.Context, m gene
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OhAuthTooTokenMutation", m)
 }
 
+// The OrgMembershipQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OrgMembershipQueryRuleFunc func(context.Context, *generated.OrgMembershipQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OrgMembershipQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OrgMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.OrgMembershipQuery", q)
+}
+
+// The OrgMembershipMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OrgMembershipMutationRuleFunc func(context.Context, *generated.OrgMembershipMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OrgMembershipMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.OrgMembershipMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OrgMembershipMutation", m)
+}
+
 // The OrganizationQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type OrganizationQueryRuleFunc func(context.Context, *generated.OrganizationQuery) error
@@ -327,6 +519,30 @@ func (f OrganizationSettingMutationRuleFunc) EvalMutation(ctx context.Context, m
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OrganizationSettingMutation", m)
 }
 
+// The OwnershipTransferQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OwnershipTransferQueryRuleFunc func(context.Context, *generated.OwnershipTransferQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OwnershipTransferQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OwnershipTransferQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.OwnershipTransferQuery", q)
+}
+
+// The OwnershipTransferMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OwnershipTransferMutationRuleFunc func(context.Context, *generated.OwnershipTransferMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OwnershipTransferMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.OwnershipTransferMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OwnershipTransferMutation", m)
+}
+
 // The PasswordResetTokenQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PasswordResetTokenQueryRuleFunc func(context.Context, *generated.PasswordResetTokenQuery) error
@@ -375,6 +591,54 @@ func (f PersonalAccessTokenMutationRuleFunc) EvalMutation(ctx context.Context, m
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.PersonalAccessTokenMutation", m)
 }
 
+// The RefreshTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RefreshTokenQueryRuleFunc func(context.Context, *generated.RefreshTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RefreshTokenQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.RefreshTokenQuery", q)
+}
+
+// The RefreshTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RefreshTokenMutationRuleFunc func(context.Context, *generated.RefreshTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RefreshTokenMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.RefreshTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RefreshTokenMutation", m)
+}
+
+// The RevokedTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RevokedTokenQueryRuleFunc func(context.Context, *generated.RevokedTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RevokedTokenQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RevokedTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.RevokedTokenQuery", q)
+}
+
+// The RevokedTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RevokedTokenMutationRuleFunc func(context.Context, *generated.RevokedTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RevokedTokenMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.RevokedTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RevokedTokenMutation", m)
+}
+
 // The SessionQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SessionQueryRuleFunc func(context.Context, *generated.SessionQuery) error
@@ -399,6 +663,30 @@ func (f SessionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.M
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.SessionMutation", m)
 }
 
+// The SessionDataQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SessionDataQueryRuleFunc func(context.Context, *generated.SessionDataQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SessionDataQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SessionDataQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.SessionDataQuery", q)
+}
+
+// The SessionDataMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SessionDataMutationRuleFunc func(context.Context, *generated.SessionDataMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SessionDataMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.SessionDataMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.SessionDataMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *generated.UserQuery) error
@@ -447,6 +735,30 @@ func (f UserSettingMutationRuleFunc) EvalMutation(ctx context.Context, m generat
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.UserSettingMutation", m)
 }
 
+// The WebauthnCredentialQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebauthnCredentialQueryRuleFunc func(context.Context, *generated.WebauthnCredentialQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebauthnCredentialQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebauthnCredentialQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.WebauthnCredentialQuery", q)
+}
+
+// The WebauthnCredentialMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebauthnCredentialMutationRuleFunc func(context.Context, *generated.WebauthnCredentialMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebauthnCredentialMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.WebauthnCredentialMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.WebauthnCredentialMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -482,34 +794,60 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q generated.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *generated.APIKeyQuery:
+		return q.Filter(), nil
 	case *generated.EmailVerificationTokenQuery:
 		return q.Filter(), nil
 	case *generated.EntitlementQuery:
 		return q.Filter(), nil
 	case *generated.GroupQuery:
 		return q.Filter(), nil
+	case *generated.GroupJoinRequestQuery:
+		return q.Filter(), nil
+	case *generated.GroupMembershipQuery:
+		return q.Filter(), nil
 	case *generated.GroupSettingQuery:
 		return q.Filter(), nil
 	case *generated.IntegrationQuery:
 		return q.Filter(), nil
+	case *generated.InviteQuery:
+		return q.Filter(), nil
+	case *generated.MagicLinkTokenQuery:
+		return q.Filter(), nil
+	case *generated.OauthAuthorizationCodeQuery:
+		return q.Filter(), nil
+	case *generated.OauthClientQuery:
+		return q.Filter(), nil
 	case *generated.OauthProviderQuery:
 		return q.Filter(), nil
 	case *generated.OhAuthTooTokenQuery:
 		return q.Filter(), nil
+	case *generated.OrgMembershipQuery:
+		return q.Filter(), nil
 	case *generated.OrganizationQuery:
 		return q.Filter(), nil
 	case *generated.OrganizationSettingQuery:
 		return q.Filter(), nil
+	case *generated.OwnershipTransferQuery:
+		return q.Filter(), nil
 	case *generated.PasswordResetTokenQuery:
 		return q.Filter(), nil
 	case *generated.PersonalAccessTokenQuery:
 		return q.Filter(), nil
+	case *generated.RefreshTokenQuery:
+		return q.Filter(), nil
+	case *generated.RevokedTokenQuery:
+		return q.Filter(), nil
 	case *generated.SessionQuery:
 		return q.Filter(), nil
+	case *generated.SessionDataQuery:
+		return q.Filter(), nil
 	case *generated.UserQuery:
 		return q.Filter(), nil
 	case *generated.UserSettingQuery:
 		return q.Filter(), nil
+	case *generated.WebauthnCredentialQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("generated/privacy: unexpected query type %T for query filter", q)
 	}
@@ -517,34 +855,60 @@ func queryFilter(q generated.Query) (Filter, error) {
 
 func mutationFilter(m generated.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *generated.APIKeyMutation:
+		return m.Filter(), nil
 	case *generated.EmailVerificationTokenMutation:
 		return m.Filter(), nil
 	case *generated.EntitlementMutation:
 		return m.Filter(), nil
 	case *generated.GroupMutation:
 		return m.Filter(), nil
+	case *generated.GroupJoinRequestMutation:
+		return m.Filter(), nil
+	case *generated.GroupMembershipMutation:
+		return m.Filter(), nil
 	case *generated.GroupSettingMutation:
 		return m.Filter(), nil
 	case *generated.IntegrationMutation:
 		return m.Filter(), nil
+	case *generated.InviteMutation:
+		return m.Filter(), nil
+	case *generated.MagicLinkTokenMutation:
+		return m.Filter(), nil
+	case *generated.OauthAuthorizationCodeMutation:
+		return m.Filter(), nil
+	case *generated.OauthClientMutation:
+		return m.Filter(), nil
 	case *generated.OauthProviderMutation:
 		return m.Filter(), nil
 	case *generated.OhAuthTooTokenMutation:
 		return m.Filter(), nil
+	case *generated.OrgMembershipMutation:
+		return m.Filter(), nil
 	case *generated.OrganizationMutation:
 		return m.Filter(), nil
 	case *generated.OrganizationSettingMutation:
 		return m.Filter(), nil
+	case *generated.OwnershipTransferMutation:
+		return m.Filter(), nil
 	case *generated.PasswordResetTokenMutation:
 		return m.Filter(), nil
 	case *generated.PersonalAccessTokenMutation:
 		return m.Filter(), nil
+	case *generated.RefreshTokenMutation:
+		return m.Filter(), nil
+	case *generated.RevokedTokenMutation:
+		return m.Filter(), nil
 	case *generated.SessionMutation:
 		return m.Filter(), nil
+	case *generated.SessionDataMutation:
+		return m.Filter(), nil
 	case *generated.UserMutation:
 		return m.Filter(), nil
 	case *generated.UserSettingMutation:
 		return m.Filter(), nil
+	case *generated.WebauthnCredentialMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("generated/privacy: unexpected mutation type %T for mutation filter", m)
 	}
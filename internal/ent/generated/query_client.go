@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (akq *APIKeyQuery) Client() *Client {
+	client := &Client{config: akq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (evtq *EmailVerificationTokenQuery) Client() *Client {
+	client := &Client{config: evtq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (eq *EntitlementQuery) Client() *Client {
+	client := &Client{config: eq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (gq *GroupQuery) Client() *Client {
+	client := &Client{config: gq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (gjrq *GroupJoinRequestQuery) Client() *Client {
+	client := &Client{config: gjrq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (gmq *GroupMembershipQuery) Client() *Client {
+	client := &Client{config: gmq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (gsq *GroupSettingQuery) Client() *Client {
+	client := &Client{config: gsq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (iq *IntegrationQuery) Client() *Client {
+	client := &Client{config: iq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (iq *InviteQuery) Client() *Client {
+	client := &Client{config: iq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (mltq *MagicLinkTokenQuery) Client() *Client {
+	client := &Client{config: mltq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (oacq *OauthAuthorizationCodeQuery) Client() *Client {
+	client := &Client{config: oacq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (ocq *OauthClientQuery) Client() *Client {
+	client := &Client{config: ocq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (opq *OauthProviderQuery) Client() *Client {
+	client := &Client{config: opq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (oattq *OhAuthTooTokenQuery) Client() *Client {
+	client := &Client{config: oattq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (omq *OrgMembershipQuery) Client() *Client {
+	client := &Client{config: omq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (oq *OrganizationQuery) Client() *Client {
+	client := &Client{config: oq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (osq *OrganizationSettingQuery) Client() *Client {
+	client := &Client{config: osq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (otq *OwnershipTransferQuery) Client() *Client {
+	client := &Client{config: otq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (prtq *PasswordResetTokenQuery) Client() *Client {
+	client := &Client{config: prtq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (patq *PersonalAccessTokenQuery) Client() *Client {
+	client := &Client{config: patq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (rtq *RefreshTokenQuery) Client() *Client {
+	client := &Client{config: rtq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (rtq *RevokedTokenQuery) Client() *Client {
+	client := &Client{config: rtq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (sq *SessionQuery) Client() *Client {
+	client := &Client{config: sq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (sdq *SessionDataQuery) Client() *Client {
+	client := &Client{config: sdq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (uq *UserQuery) Client() *Client {
+	client := &Client{config: uq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (usq *UserSettingQuery) Client() *Client {
+	client := &Client{config: usq.config}
+	client.init()
+	return client
+}
+
+// Client returns a new `ent.Client` from the query's config, it can be used by interceptors to run other
+// queries in the same transaction as the query
+func (wcq *WebauthnCredentialQuery) Client() *Client {
+	client := &Client{config: wcq.config}
+	client.init()
+	return client
+}
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+)
+
+// RevokedTokenCreate is the builder for creating a RevokedToken entity.
+type RevokedTokenCreate struct {
+	config
+	mutation *RevokedTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RevokedTokenCreate) SetCreatedAt(t time.Time) *RevokedTokenCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableCreatedAt(t *time.Time) *RevokedTokenCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtc *RevokedTokenCreate) SetUpdatedAt(t time.Time) *RevokedTokenCreate {
+	rtc.mutation.SetUpdatedAt(t)
+	return rtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableUpdatedAt(t *time.Time) *RevokedTokenCreate {
+	if t != nil {
+		rtc.SetUpdatedAt(*t)
+	}
+	return rtc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rtc *RevokedTokenCreate) SetCreatedBy(s string) *RevokedTokenCreate {
+	rtc.mutation.SetCreatedBy(s)
+	return rtc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableCreatedBy(s *string) *RevokedTokenCreate {
+	if s != nil {
+		rtc.SetCreatedBy(*s)
+	}
+	return rtc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtc *RevokedTokenCreate) SetUpdatedBy(s string) *RevokedTokenCreate {
+	rtc.mutation.SetUpdatedBy(s)
+	return rtc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableUpdatedBy(s *string) *RevokedTokenCreate {
+	if s != nil {
+		rtc.SetUpdatedBy(*s)
+	}
+	return rtc
+}
+
+// SetJti sets the "jti" field.
+func (rtc *RevokedTokenCreate) SetJti(s string) *RevokedTokenCreate {
+	rtc.mutation.SetJti(s)
+	return rtc
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *RevokedTokenCreate) SetUserID(s string) *RevokedTokenCreate {
+	rtc.mutation.SetUserID(s)
+	return rtc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableUserID(s *string) *RevokedTokenCreate {
+	if s != nil {
+		rtc.SetUserID(*s)
+	}
+	return rtc
+}
+
+// SetReason sets the "reason" field.
+func (rtc *RevokedTokenCreate) SetReason(s string) *RevokedTokenCreate {
+	rtc.mutation.SetReason(s)
+	return rtc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableReason(s *string) *RevokedTokenCreate {
+	if s != nil {
+		rtc.SetReason(*s)
+	}
+	return rtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtc *RevokedTokenCreate) SetExpiresAt(t time.Time) *RevokedTokenCreate {
+	rtc.mutation.SetExpiresAt(t)
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RevokedTokenCreate) SetID(s string) *RevokedTokenCreate {
+	rtc.mutation.SetID(s)
+	return rtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rtc *RevokedTokenCreate) SetNillableID(s *string) *RevokedTokenCreate {
+	if s != nil {
+		rtc.SetID(*s)
+	}
+	return rtc
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (rtc *RevokedTokenCreate) Mutation() *RevokedTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RevokedToken in the database.
+func (rtc *RevokedTokenCreate) Save(ctx context.Context) (*RevokedToken, error) {
+	if err := rtc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RevokedTokenCreate) SaveX(ctx context.Context) *RevokedToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RevokedTokenCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RevokedTokenCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RevokedTokenCreate) defaults() error {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		if revokedtoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized revokedtoken.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := revokedtoken.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		if revokedtoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized revokedtoken.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := revokedtoken.DefaultUpdatedAt()
+		rtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rtc.mutation.ID(); !ok {
+		if revokedtoken.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized revokedtoken.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := revokedtoken.DefaultID()
+		rtc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RevokedTokenCreate) check() error {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "RevokedToken.created_at"`)}
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "RevokedToken.updated_at"`)}
+	}
+	if _, ok := rtc.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`generated: missing required field "RevokedToken.jti"`)}
+	}
+	if v, ok := rtc.mutation.Jti(); ok {
+		if err := revokedtoken.JtiValidator(v); err != nil {
+			return &ValidationError{Name: "jti", err: fmt.Errorf(`generated: validator failed for field "RevokedToken.jti": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "RevokedToken.expires_at"`)}
+	}
+	return nil
+}
+
+func (rtc *RevokedTokenCreate) sqlSave(ctx context.Context) (*RevokedToken, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RevokedToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RevokedTokenCreate) createSpec() (*RevokedToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RevokedToken{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(revokedtoken.Table, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString))
+	)
+	_spec.Schema = rtc.schemaConfig.RevokedToken
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(revokedtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rtc.mutation.CreatedBy(); ok {
+		_spec.SetField(revokedtoken.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rtc.mutation.UpdatedBy(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rtc.mutation.Jti(); ok {
+		_spec.SetField(revokedtoken.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := rtc.mutation.UserID(); ok {
+		_spec.SetField(revokedtoken.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rtc.mutation.Reason(); ok {
+		_spec.SetField(revokedtoken.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := rtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(revokedtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// RevokedTokenCreateBulk is the builder for creating many RevokedToken entities in bulk.
+type RevokedTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RevokedTokenCreate
+}
+
+// Save creates the RevokedToken entities in the database.
+func (rtcb *RevokedTokenCreateBulk) Save(ctx context.Context) ([]*RevokedToken, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RevokedToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevokedTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RevokedTokenCreateBulk) SaveX(ctx context.Context) []*RevokedToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RevokedTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RevokedTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
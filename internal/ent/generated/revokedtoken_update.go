@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// RevokedTokenUpdate is the builder for updating RevokedToken entities.
+type RevokedTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RevokedTokenMutation
+}
+
+// Where appends a list predicates to the RevokedTokenUpdate builder.
+func (rtu *RevokedTokenUpdate) Where(ps ...predicate.RevokedToken) *RevokedTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtu *RevokedTokenUpdate) SetUpdatedAt(t time.Time) *RevokedTokenUpdate {
+	rtu.mutation.SetUpdatedAt(t)
+	return rtu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtu *RevokedTokenUpdate) SetUpdatedBy(s string) *RevokedTokenUpdate {
+	rtu.mutation.SetUpdatedBy(s)
+	return rtu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtu *RevokedTokenUpdate) SetNillableUpdatedBy(s *string) *RevokedTokenUpdate {
+	if s != nil {
+		rtu.SetUpdatedBy(*s)
+	}
+	return rtu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (rtu *RevokedTokenUpdate) ClearUpdatedBy() *RevokedTokenUpdate {
+	rtu.mutation.ClearUpdatedBy()
+	return rtu
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (rtu *RevokedTokenUpdate) Mutation() *RevokedTokenMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RevokedTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := rtu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RevokedTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RevokedTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RevokedTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtu *RevokedTokenUpdate) defaults() error {
+	if _, ok := rtu.mutation.UpdatedAt(); !ok {
+		if revokedtoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized revokedtoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := revokedtoken.UpdateDefaultUpdatedAt()
+		rtu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (rtu *RevokedTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revokedtoken.Table, revokedtoken.Columns, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.CreatedByCleared() {
+		_spec.ClearField(revokedtoken.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := rtu.mutation.UpdatedBy(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedBy, field.TypeString, value)
+	}
+	if rtu.mutation.UpdatedByCleared() {
+		_spec.ClearField(revokedtoken.FieldUpdatedBy, field.TypeString)
+	}
+	if rtu.mutation.UserIDCleared() {
+		_spec.ClearField(revokedtoken.FieldUserID, field.TypeString)
+	}
+	if rtu.mutation.ReasonCleared() {
+		_spec.ClearField(revokedtoken.FieldReason, field.TypeString)
+	}
+	_spec.Node.Schema = rtu.schemaConfig.RevokedToken
+	ctx = internal.NewSchemaConfigContext(ctx, rtu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RevokedTokenUpdateOne is the builder for updating a single RevokedToken entity.
+type RevokedTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RevokedTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtuo *RevokedTokenUpdateOne) SetUpdatedAt(t time.Time) *RevokedTokenUpdateOne {
+	rtuo.mutation.SetUpdatedAt(t)
+	return rtuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtuo *RevokedTokenUpdateOne) SetUpdatedBy(s string) *RevokedTokenUpdateOne {
+	rtuo.mutation.SetUpdatedBy(s)
+	return rtuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtuo *RevokedTokenUpdateOne) SetNillableUpdatedBy(s *string) *RevokedTokenUpdateOne {
+	if s != nil {
+		rtuo.SetUpdatedBy(*s)
+	}
+	return rtuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (rtuo *RevokedTokenUpdateOne) ClearUpdatedBy() *RevokedTokenUpdateOne {
+	rtuo.mutation.ClearUpdatedBy()
+	return rtuo
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (rtuo *RevokedTokenUpdateOne) Mutation() *RevokedTokenMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RevokedTokenUpdate builder.
+func (rtuo *RevokedTokenUpdateOne) Where(ps ...predicate.RevokedToken) *RevokedTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RevokedTokenUpdateOne) Select(field string, fields ...string) *RevokedTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RevokedToken entity.
+func (rtuo *RevokedTokenUpdateOne) Save(ctx context.Context) (*RevokedToken, error) {
+	if err := rtuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RevokedTokenUpdateOne) SaveX(ctx context.Context) *RevokedToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RevokedTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RevokedTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtuo *RevokedTokenUpdateOne) defaults() error {
+	if _, ok := rtuo.mutation.UpdatedAt(); !ok {
+		if revokedtoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized revokedtoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := revokedtoken.UpdateDefaultUpdatedAt()
+		rtuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (rtuo *RevokedTokenUpdateOne) sqlSave(ctx context.Context) (_node *RevokedToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revokedtoken.Table, revokedtoken.Columns, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "RevokedToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revokedtoken.FieldID)
+		for _, f := range fields {
+			if !revokedtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != revokedtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.CreatedByCleared() {
+		_spec.ClearField(revokedtoken.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(revokedtoken.FieldUpdatedBy, field.TypeString, value)
+	}
+	if rtuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(revokedtoken.FieldUpdatedBy, field.TypeString)
+	}
+	if rtuo.mutation.UserIDCleared() {
+		_spec.ClearField(revokedtoken.FieldUserID, field.TypeString)
+	}
+	if rtuo.mutation.ReasonCleared() {
+		_spec.ClearField(revokedtoken.FieldReason, field.TypeString)
+	}
+	_spec.Node.Schema = rtuo.schemaConfig.RevokedToken
+	ctx = internal.NewSchemaConfigContext(ctx, rtuo.schemaConfig)
+	_node = &RevokedToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
@@ -6,20 +6,33 @@ import (
 	"context"
 	"time"
 
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/entitlement"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
 	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
 	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
 	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 	"github.com/datumforge/datum/internal/ent/schema"
 
 	"entgo.io/ent"
@@ -30,6 +43,71 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikey.Policy = privacy.NewPolicies(apikeyMixin[3], schema.APIKey{})
+	apikey.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := apikey.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	apikeyMixinHooks0 := apikeyMixin[0].Hooks()
+	apikeyMixinHooks1 := apikeyMixin[1].Hooks()
+	apikeyHooks := schema.APIKey{}.Hooks()
+
+	apikey.Hooks[1] = apikeyMixinHooks0[0]
+
+	apikey.Hooks[2] = apikeyMixinHooks1[0]
+
+	apikey.Hooks[3] = apikeyHooks[0]
+
+	apikey.Hooks[4] = apikeyHooks[1]
+	apikeyMixinInters1 := apikeyMixin[1].Interceptors()
+	apikeyInters := schema.APIKey{}.Interceptors()
+	apikey.Interceptors[0] = apikeyMixinInters1[0]
+	apikey.Interceptors[1] = apikeyInters[0]
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyMixinFields2 := apikeyMixin[2].Fields()
+	_ = apikeyMixinFields2
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescKeyPrefix is the schema descriptor for key_prefix field.
+	apikeyDescKeyPrefix := apikeyFields[2].Descriptor()
+	// apikey.KeyPrefixValidator is a validator for the "key_prefix" field. It is called by the builders before save.
+	apikey.KeyPrefixValidator = apikeyDescKeyPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[3].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescDescription is the schema descriptor for description field.
+	apikeyDescDescription := apikeyFields[6].Descriptor()
+	// apikey.DefaultDescription holds the default value on creation for the description field.
+	apikey.DefaultDescription = apikeyDescDescription.Default.(string)
+	// apikeyDescLastUsedAt is the schema descriptor for last_used_at field.
+	apikeyDescLastUsedAt := apikeyFields[7].Descriptor()
+	// apikey.UpdateDefaultLastUsedAt holds the default value on update for the last_used_at field.
+	apikey.UpdateDefaultLastUsedAt = apikeyDescLastUsedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyMixinFields2[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() string)
 	emailverificationtokenMixin := schema.EmailVerificationToken{}.Mixin()
 	emailverificationtoken.Policy = privacy.NewPolicies(schema.EmailVerificationToken{})
 	emailverificationtoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -98,10 +176,21 @@ func init() {
 	// emailverificationtoken.DefaultID holds the default value on creation for the id field.
 	emailverificationtoken.DefaultID = emailverificationtokenDescID.Default.(func() string)
 	entitlementMixin := schema.Entitlement{}.Mixin()
+	entitlement.Policy = privacy.NewPolicies(entitlementMixin[3], schema.Entitlement{})
+	entitlement.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := entitlement.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	entitlementMixinHooks0 := entitlementMixin[0].Hooks()
 	entitlementMixinHooks2 := entitlementMixin[2].Hooks()
-	entitlement.Hooks[0] = entitlementMixinHooks0[0]
-	entitlement.Hooks[1] = entitlementMixinHooks2[0]
+
+	entitlement.Hooks[1] = entitlementMixinHooks0[0]
+
+	entitlement.Hooks[2] = entitlementMixinHooks2[0]
 	entitlementMixinInters2 := entitlementMixin[2].Interceptors()
 	entitlement.Interceptors[0] = entitlementMixinInters2[0]
 	entitlementMixinFields0 := entitlementMixin[0].Fields()
@@ -133,7 +222,7 @@ func init() {
 	// entitlement.DefaultID holds the default value on creation for the id field.
 	entitlement.DefaultID = entitlementDescID.Default.(func() string)
 	groupMixin := schema.Group{}.Mixin()
-	group.Policy = privacy.NewPolicies(schema.Group{})
+	group.Policy = privacy.NewPolicies(groupMixin[3], schema.Group{})
 	group.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := group.Policy.EvalMutation(ctx, m); err != nil {
@@ -187,11 +276,100 @@ func init() {
 	groupDescID := groupMixinFields2[0].Descriptor()
 	// group.DefaultID holds the default value on creation for the id field.
 	group.DefaultID = groupDescID.Default.(func() string)
+	groupjoinrequestMixin := schema.GroupJoinRequest{}.Mixin()
+	groupjoinrequest.Policy = privacy.NewPolicies(groupjoinrequestMixin[2], schema.GroupJoinRequest{})
+	groupjoinrequest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := groupjoinrequest.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	groupjoinrequestMixinHooks0 := groupjoinrequestMixin[0].Hooks()
+	groupjoinrequestHooks := schema.GroupJoinRequest{}.Hooks()
+
+	groupjoinrequest.Hooks[1] = groupjoinrequestMixinHooks0[0]
+
+	groupjoinrequest.Hooks[2] = groupjoinrequestHooks[0]
+	groupjoinrequestInters := schema.GroupJoinRequest{}.Interceptors()
+	groupjoinrequest.Interceptors[0] = groupjoinrequestInters[0]
+	groupjoinrequestMixinFields0 := groupjoinrequestMixin[0].Fields()
+	_ = groupjoinrequestMixinFields0
+	groupjoinrequestMixinFields1 := groupjoinrequestMixin[1].Fields()
+	_ = groupjoinrequestMixinFields1
+	groupjoinrequestFields := schema.GroupJoinRequest{}.Fields()
+	_ = groupjoinrequestFields
+	// groupjoinrequestDescCreatedAt is the schema descriptor for created_at field.
+	groupjoinrequestDescCreatedAt := groupjoinrequestMixinFields0[0].Descriptor()
+	// groupjoinrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupjoinrequest.DefaultCreatedAt = groupjoinrequestDescCreatedAt.Default.(func() time.Time)
+	// groupjoinrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	groupjoinrequestDescUpdatedAt := groupjoinrequestMixinFields0[1].Descriptor()
+	// groupjoinrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupjoinrequest.DefaultUpdatedAt = groupjoinrequestDescUpdatedAt.Default.(func() time.Time)
+	// groupjoinrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupjoinrequest.UpdateDefaultUpdatedAt = groupjoinrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupjoinrequestDescID is the schema descriptor for id field.
+	groupjoinrequestDescID := groupjoinrequestMixinFields1[0].Descriptor()
+	// groupjoinrequest.DefaultID holds the default value on creation for the id field.
+	groupjoinrequest.DefaultID = groupjoinrequestDescID.Default.(func() string)
+	groupmembershipMixin := schema.GroupMembership{}.Mixin()
+	groupmembership.Policy = privacy.NewPolicies(groupmembershipMixin[2], schema.GroupMembership{})
+	groupmembership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := groupmembership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	groupmembershipMixinHooks0 := groupmembershipMixin[0].Hooks()
+	groupmembershipHooks := schema.GroupMembership{}.Hooks()
+
+	groupmembership.Hooks[1] = groupmembershipMixinHooks0[0]
+
+	groupmembership.Hooks[2] = groupmembershipHooks[0]
+
+	groupmembership.Hooks[3] = groupmembershipHooks[1]
+	groupmembershipInters := schema.GroupMembership{}.Interceptors()
+	groupmembership.Interceptors[0] = groupmembershipInters[0]
+	groupmembershipMixinFields0 := groupmembershipMixin[0].Fields()
+	_ = groupmembershipMixinFields0
+	groupmembershipMixinFields1 := groupmembershipMixin[1].Fields()
+	_ = groupmembershipMixinFields1
+	groupmembershipFields := schema.GroupMembership{}.Fields()
+	_ = groupmembershipFields
+	// groupmembershipDescCreatedAt is the schema descriptor for created_at field.
+	groupmembershipDescCreatedAt := groupmembershipMixinFields0[0].Descriptor()
+	// groupmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupmembership.DefaultCreatedAt = groupmembershipDescCreatedAt.Default.(func() time.Time)
+	// groupmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	groupmembershipDescUpdatedAt := groupmembershipMixinFields0[1].Descriptor()
+	// groupmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupmembership.DefaultUpdatedAt = groupmembershipDescUpdatedAt.Default.(func() time.Time)
+	// groupmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupmembership.UpdateDefaultUpdatedAt = groupmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupmembershipDescID is the schema descriptor for id field.
+	groupmembershipDescID := groupmembershipMixinFields1[0].Descriptor()
+	// groupmembership.DefaultID holds the default value on creation for the id field.
+	groupmembership.DefaultID = groupmembershipDescID.Default.(func() string)
 	groupsettingMixin := schema.GroupSetting{}.Mixin()
+	groupsetting.Policy = privacy.NewPolicies(groupsettingMixin[3], schema.GroupSetting{})
+	groupsetting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := groupsetting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	groupsettingMixinHooks0 := groupsettingMixin[0].Hooks()
 	groupsettingMixinHooks2 := groupsettingMixin[2].Hooks()
-	groupsetting.Hooks[0] = groupsettingMixinHooks0[0]
-	groupsetting.Hooks[1] = groupsettingMixinHooks2[0]
+
+	groupsetting.Hooks[1] = groupsettingMixinHooks0[0]
+
+	groupsetting.Hooks[2] = groupsettingMixinHooks2[0]
 	groupsettingMixinInters2 := groupsettingMixin[2].Interceptors()
 	groupsetting.Interceptors[0] = groupsettingMixinInters2[0]
 	groupsettingMixinFields0 := groupsettingMixin[0].Fields()
@@ -227,10 +405,21 @@ func init() {
 	// groupsetting.DefaultID holds the default value on creation for the id field.
 	groupsetting.DefaultID = groupsettingDescID.Default.(func() string)
 	integrationMixin := schema.Integration{}.Mixin()
+	integration.Policy = privacy.NewPolicies(integrationMixin[3], schema.Integration{})
+	integration.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := integration.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	integrationMixinHooks0 := integrationMixin[0].Hooks()
 	integrationMixinHooks2 := integrationMixin[2].Hooks()
-	integration.Hooks[0] = integrationMixinHooks0[0]
-	integration.Hooks[1] = integrationMixinHooks2[0]
+
+	integration.Hooks[1] = integrationMixinHooks0[0]
+
+	integration.Hooks[2] = integrationMixinHooks2[0]
 	integrationMixinInters2 := integrationMixin[2].Interceptors()
 	integration.Interceptors[0] = integrationMixinInters2[0]
 	integrationMixinFields0 := integrationMixin[0].Fields()
@@ -257,11 +446,246 @@ func init() {
 	integrationDescID := integrationMixinFields1[0].Descriptor()
 	// integration.DefaultID holds the default value on creation for the id field.
 	integration.DefaultID = integrationDescID.Default.(func() string)
+	inviteMixin := schema.Invite{}.Mixin()
+	invite.Policy = privacy.NewPolicies(inviteMixin[3], schema.Invite{})
+	invite.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invite.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	inviteMixinHooks0 := inviteMixin[0].Hooks()
+	inviteMixinHooks1 := inviteMixin[1].Hooks()
+	inviteHooks := schema.Invite{}.Hooks()
+
+	invite.Hooks[1] = inviteMixinHooks0[0]
+
+	invite.Hooks[2] = inviteMixinHooks1[0]
+
+	invite.Hooks[3] = inviteHooks[0]
+	inviteMixinInters1 := inviteMixin[1].Interceptors()
+	inviteInters := schema.Invite{}.Interceptors()
+	invite.Interceptors[0] = inviteMixinInters1[0]
+	invite.Interceptors[1] = inviteInters[0]
+	inviteMixinFields0 := inviteMixin[0].Fields()
+	_ = inviteMixinFields0
+	inviteMixinFields2 := inviteMixin[2].Fields()
+	_ = inviteMixinFields2
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteMixinFields0[0].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	// inviteDescUpdatedAt is the schema descriptor for updated_at field.
+	inviteDescUpdatedAt := inviteMixinFields0[1].Descriptor()
+	// invite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invite.DefaultUpdatedAt = inviteDescUpdatedAt.Default.(func() time.Time)
+	// invite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invite.UpdateDefaultUpdatedAt = inviteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// inviteDescToken is the schema descriptor for token field.
+	inviteDescToken := inviteFields[1].Descriptor()
+	// invite.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	invite.TokenValidator = inviteDescToken.Validators[0].(func(string) error)
+	// inviteDescRecipient is the schema descriptor for recipient field.
+	inviteDescRecipient := inviteFields[3].Descriptor()
+	// invite.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	invite.RecipientValidator = func() func(string) error {
+		validators := inviteDescRecipient.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(recipient string) error {
+			for _, fn := range fns {
+				if err := fn(recipient); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// inviteDescSecret is the schema descriptor for secret field.
+	inviteDescSecret := inviteFields[7].Descriptor()
+	// invite.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	invite.SecretValidator = inviteDescSecret.Validators[0].(func([]byte) error)
+	// inviteDescID is the schema descriptor for id field.
+	inviteDescID := inviteMixinFields2[0].Descriptor()
+	// invite.DefaultID holds the default value on creation for the id field.
+	invite.DefaultID = inviteDescID.Default.(func() string)
+	magiclinktokenMixin := schema.MagicLinkToken{}.Mixin()
+	magiclinktokenMixinHooks0 := magiclinktokenMixin[0].Hooks()
+	magiclinktokenMixinHooks2 := magiclinktokenMixin[2].Hooks()
+	magiclinktokenHooks := schema.MagicLinkToken{}.Hooks()
+	magiclinktoken.Hooks[0] = magiclinktokenMixinHooks0[0]
+	magiclinktoken.Hooks[1] = magiclinktokenMixinHooks2[0]
+	magiclinktoken.Hooks[2] = magiclinktokenHooks[0]
+	magiclinktokenMixinInters2 := magiclinktokenMixin[2].Interceptors()
+	magiclinktoken.Interceptors[0] = magiclinktokenMixinInters2[0]
+	magiclinktokenMixinFields0 := magiclinktokenMixin[0].Fields()
+	_ = magiclinktokenMixinFields0
+	magiclinktokenMixinFields1 := magiclinktokenMixin[1].Fields()
+	_ = magiclinktokenMixinFields1
+	magiclinktokenFields := schema.MagicLinkToken{}.Fields()
+	_ = magiclinktokenFields
+	// magiclinktokenDescCreatedAt is the schema descriptor for created_at field.
+	magiclinktokenDescCreatedAt := magiclinktokenMixinFields0[0].Descriptor()
+	// magiclinktoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclinktoken.DefaultCreatedAt = magiclinktokenDescCreatedAt.Default.(func() time.Time)
+	// magiclinktokenDescUpdatedAt is the schema descriptor for updated_at field.
+	magiclinktokenDescUpdatedAt := magiclinktokenMixinFields0[1].Descriptor()
+	// magiclinktoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	magiclinktoken.DefaultUpdatedAt = magiclinktokenDescUpdatedAt.Default.(func() time.Time)
+	// magiclinktoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	magiclinktoken.UpdateDefaultUpdatedAt = magiclinktokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// magiclinktokenDescToken is the schema descriptor for token field.
+	magiclinktokenDescToken := magiclinktokenFields[0].Descriptor()
+	// magiclinktoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	magiclinktoken.TokenValidator = magiclinktokenDescToken.Validators[0].(func(string) error)
+	// magiclinktokenDescEmail is the schema descriptor for email field.
+	magiclinktokenDescEmail := magiclinktokenFields[2].Descriptor()
+	// magiclinktoken.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	magiclinktoken.EmailValidator = func() func(string) error {
+		validators := magiclinktokenDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// magiclinktokenDescSecret is the schema descriptor for secret field.
+	magiclinktokenDescSecret := magiclinktokenFields[3].Descriptor()
+	// magiclinktoken.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	magiclinktoken.SecretValidator = magiclinktokenDescSecret.Validators[0].(func([]byte) error)
+	// magiclinktokenDescID is the schema descriptor for id field.
+	magiclinktokenDescID := magiclinktokenMixinFields1[0].Descriptor()
+	// magiclinktoken.DefaultID holds the default value on creation for the id field.
+	magiclinktoken.DefaultID = magiclinktokenDescID.Default.(func() string)
+	oauthauthorizationcodeMixin := schema.OauthAuthorizationCode{}.Mixin()
+	oauthauthorizationcodeMixinHooks0 := oauthauthorizationcodeMixin[0].Hooks()
+	oauthauthorizationcode.Hooks[0] = oauthauthorizationcodeMixinHooks0[0]
+	oauthauthorizationcodeMixinFields0 := oauthauthorizationcodeMixin[0].Fields()
+	_ = oauthauthorizationcodeMixinFields0
+	oauthauthorizationcodeMixinFields1 := oauthauthorizationcodeMixin[1].Fields()
+	_ = oauthauthorizationcodeMixinFields1
+	oauthauthorizationcodeFields := schema.OauthAuthorizationCode{}.Fields()
+	_ = oauthauthorizationcodeFields
+	// oauthauthorizationcodeDescCreatedAt is the schema descriptor for created_at field.
+	oauthauthorizationcodeDescCreatedAt := oauthauthorizationcodeMixinFields0[0].Descriptor()
+	// oauthauthorizationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthauthorizationcode.DefaultCreatedAt = oauthauthorizationcodeDescCreatedAt.Default.(func() time.Time)
+	// oauthauthorizationcodeDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthauthorizationcodeDescUpdatedAt := oauthauthorizationcodeMixinFields0[1].Descriptor()
+	// oauthauthorizationcode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthauthorizationcode.DefaultUpdatedAt = oauthauthorizationcodeDescUpdatedAt.Default.(func() time.Time)
+	// oauthauthorizationcode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthauthorizationcode.UpdateDefaultUpdatedAt = oauthauthorizationcodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthauthorizationcodeDescCodeHash is the schema descriptor for code_hash field.
+	oauthauthorizationcodeDescCodeHash := oauthauthorizationcodeFields[0].Descriptor()
+	// oauthauthorizationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeHashValidator = oauthauthorizationcodeDescCodeHash.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescClientID is the schema descriptor for client_id field.
+	oauthauthorizationcodeDescClientID := oauthauthorizationcodeFields[1].Descriptor()
+	// oauthauthorizationcode.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthauthorizationcode.ClientIDValidator = oauthauthorizationcodeDescClientID.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescUserID is the schema descriptor for user_id field.
+	oauthauthorizationcodeDescUserID := oauthauthorizationcodeFields[2].Descriptor()
+	// oauthauthorizationcode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthauthorizationcode.UserIDValidator = oauthauthorizationcodeDescUserID.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescRedirectURI is the schema descriptor for redirect_uri field.
+	oauthauthorizationcodeDescRedirectURI := oauthauthorizationcodeFields[3].Descriptor()
+	// oauthauthorizationcode.RedirectURIValidator is a validator for the "redirect_uri" field. It is called by the builders before save.
+	oauthauthorizationcode.RedirectURIValidator = oauthauthorizationcodeDescRedirectURI.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescID is the schema descriptor for id field.
+	oauthauthorizationcodeDescID := oauthauthorizationcodeMixinFields1[0].Descriptor()
+	// oauthauthorizationcode.DefaultID holds the default value on creation for the id field.
+	oauthauthorizationcode.DefaultID = oauthauthorizationcodeDescID.Default.(func() string)
+	oauthclientMixin := schema.OauthClient{}.Mixin()
+	oauthclient.Policy = privacy.NewPolicies(oauthclientMixin[3], schema.OauthClient{})
+	oauthclient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := oauthclient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	oauthclientMixinHooks0 := oauthclientMixin[0].Hooks()
+	oauthclientMixinHooks1 := oauthclientMixin[1].Hooks()
+	oauthclientHooks := schema.OauthClient{}.Hooks()
+
+	oauthclient.Hooks[1] = oauthclientMixinHooks0[0]
+
+	oauthclient.Hooks[2] = oauthclientMixinHooks1[0]
+
+	oauthclient.Hooks[3] = oauthclientHooks[0]
+
+	oauthclient.Hooks[4] = oauthclientHooks[1]
+	oauthclientMixinInters1 := oauthclientMixin[1].Interceptors()
+	oauthclientInters := schema.OauthClient{}.Interceptors()
+	oauthclient.Interceptors[0] = oauthclientMixinInters1[0]
+	oauthclient.Interceptors[1] = oauthclientInters[0]
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientMixinFields2 := oauthclientMixin[2].Fields()
+	_ = oauthclientMixinFields2
+	oauthclientFields := schema.OauthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientMixinFields0[1].Descriptor()
+	// oauthclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthclient.DefaultUpdatedAt = oauthclientDescUpdatedAt.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[1].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = oauthclientDescName.Validators[0].(func(string) error)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[2].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescPublic is the schema descriptor for public field.
+	oauthclientDescPublic := oauthclientFields[6].Descriptor()
+	// oauthclient.DefaultPublic holds the default value on creation for the public field.
+	oauthclient.DefaultPublic = oauthclientDescPublic.Default.(bool)
+	// oauthclientDescDescription is the schema descriptor for description field.
+	oauthclientDescDescription := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultDescription holds the default value on creation for the description field.
+	oauthclient.DefaultDescription = oauthclientDescDescription.Default.(string)
+	// oauthclientDescID is the schema descriptor for id field.
+	oauthclientDescID := oauthclientMixinFields2[0].Descriptor()
+	// oauthclient.DefaultID holds the default value on creation for the id field.
+	oauthclient.DefaultID = oauthclientDescID.Default.(func() string)
 	oauthproviderMixin := schema.OauthProvider{}.Mixin()
+	oauthprovider.Policy = privacy.NewPolicies(oauthproviderMixin[3], schema.OauthProvider{})
+	oauthprovider.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := oauthprovider.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	oauthproviderMixinHooks0 := oauthproviderMixin[0].Hooks()
 	oauthproviderMixinHooks2 := oauthproviderMixin[2].Hooks()
-	oauthprovider.Hooks[0] = oauthproviderMixinHooks0[0]
-	oauthprovider.Hooks[1] = oauthproviderMixinHooks2[0]
+
+	oauthprovider.Hooks[1] = oauthproviderMixinHooks0[0]
+
+	oauthprovider.Hooks[2] = oauthproviderMixinHooks2[0]
 	oauthproviderMixinInters2 := oauthproviderMixin[2].Interceptors()
 	oauthprovider.Interceptors[0] = oauthproviderMixinInters2[0]
 	oauthproviderMixinFields0 := oauthproviderMixin[0].Fields()
@@ -285,6 +709,15 @@ func init() {
 	// oauthprovider.DefaultID holds the default value on creation for the id field.
 	oauthprovider.DefaultID = oauthproviderDescID.Default.(func() string)
 	ohauthtootokenMixin := schema.OhAuthTooToken{}.Mixin()
+	ohauthtootoken.Policy = privacy.NewPolicies(ohauthtootokenMixin[1], schema.OhAuthTooToken{})
+	ohauthtootoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ohauthtootoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	ohauthtootokenMixinFields0 := ohauthtootokenMixin[0].Fields()
 	_ = ohauthtootokenMixinFields0
 	ohauthtootokenFields := schema.OhAuthTooToken{}.Fields()
@@ -293,10 +726,6 @@ func init() {
 	ohauthtootokenDescClientID := ohauthtootokenFields[0].Descriptor()
 	// ohauthtootoken.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
 	ohauthtootoken.ClientIDValidator = ohauthtootokenDescClientID.Validators[0].(func(string) error)
-	// ohauthtootokenDescNonce is the schema descriptor for nonce field.
-	ohauthtootokenDescNonce := ohauthtootokenFields[2].Descriptor()
-	// ohauthtootoken.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
-	ohauthtootoken.NonceValidator = ohauthtootokenDescNonce.Validators[0].(func(string) error)
 	// ohauthtootokenDescClaimsUserID is the schema descriptor for claims_user_id field.
 	ohauthtootokenDescClaimsUserID := ohauthtootokenFields[3].Descriptor()
 	// ohauthtootoken.ClaimsUserIDValidator is a validator for the "claims_user_id" field. It is called by the builders before save.
@@ -321,8 +750,48 @@ func init() {
 	ohauthtootokenDescID := ohauthtootokenMixinFields0[0].Descriptor()
 	// ohauthtootoken.DefaultID holds the default value on creation for the id field.
 	ohauthtootoken.DefaultID = ohauthtootokenDescID.Default.(func() string)
+	orgmembershipMixin := schema.OrgMembership{}.Mixin()
+	orgmembership.Policy = privacy.NewPolicies(orgmembershipMixin[2], schema.OrgMembership{})
+	orgmembership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := orgmembership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	orgmembershipMixinHooks0 := orgmembershipMixin[0].Hooks()
+	orgmembershipHooks := schema.OrgMembership{}.Hooks()
+
+	orgmembership.Hooks[1] = orgmembershipMixinHooks0[0]
+
+	orgmembership.Hooks[2] = orgmembershipHooks[0]
+
+	orgmembership.Hooks[3] = orgmembershipHooks[1]
+	orgmembershipInters := schema.OrgMembership{}.Interceptors()
+	orgmembership.Interceptors[0] = orgmembershipInters[0]
+	orgmembershipMixinFields0 := orgmembershipMixin[0].Fields()
+	_ = orgmembershipMixinFields0
+	orgmembershipMixinFields1 := orgmembershipMixin[1].Fields()
+	_ = orgmembershipMixinFields1
+	orgmembershipFields := schema.OrgMembership{}.Fields()
+	_ = orgmembershipFields
+	// orgmembershipDescCreatedAt is the schema descriptor for created_at field.
+	orgmembershipDescCreatedAt := orgmembershipMixinFields0[0].Descriptor()
+	// orgmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orgmembership.DefaultCreatedAt = orgmembershipDescCreatedAt.Default.(func() time.Time)
+	// orgmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	orgmembershipDescUpdatedAt := orgmembershipMixinFields0[1].Descriptor()
+	// orgmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	orgmembership.DefaultUpdatedAt = orgmembershipDescUpdatedAt.Default.(func() time.Time)
+	// orgmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	orgmembership.UpdateDefaultUpdatedAt = orgmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orgmembershipDescID is the schema descriptor for id field.
+	orgmembershipDescID := orgmembershipMixinFields1[0].Descriptor()
+	// orgmembership.DefaultID holds the default value on creation for the id field.
+	orgmembership.DefaultID = orgmembershipDescID.Default.(func() string)
 	organizationMixin := schema.Organization{}.Mixin()
-	organization.Policy = privacy.NewPolicies(schema.Organization{})
+	organization.Policy = privacy.NewPolicies(organizationMixin[3], schema.Organization{})
 	organization.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := organization.Policy.EvalMutation(ctx, m); err != nil {
@@ -342,6 +811,8 @@ func init() {
 	organization.Hooks[3] = organizationHooks[0]
 
 	organization.Hooks[4] = organizationHooks[1]
+
+	organization.Hooks[5] = organizationHooks[2]
 	organizationMixinInters2 := organizationMixin[2].Interceptors()
 	organizationInters := schema.Organization{}.Interceptors()
 	organization.Interceptors[0] = organizationMixinInters2[0]
@@ -395,10 +866,21 @@ func init() {
 	// organization.DefaultID holds the default value on creation for the id field.
 	organization.DefaultID = organizationDescID.Default.(func() string)
 	organizationsettingMixin := schema.OrganizationSetting{}.Mixin()
+	organizationsetting.Policy = privacy.NewPolicies(organizationsettingMixin[3], schema.OrganizationSetting{})
+	organizationsetting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := organizationsetting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	organizationsettingMixinHooks0 := organizationsettingMixin[0].Hooks()
 	organizationsettingMixinHooks2 := organizationsettingMixin[2].Hooks()
-	organizationsetting.Hooks[0] = organizationsettingMixinHooks0[0]
-	organizationsetting.Hooks[1] = organizationsettingMixinHooks2[0]
+
+	organizationsetting.Hooks[1] = organizationsettingMixinHooks0[0]
+
+	organizationsetting.Hooks[2] = organizationsettingMixinHooks2[0]
 	organizationsettingMixinInters2 := organizationsettingMixin[2].Interceptors()
 	organizationsetting.Interceptors[0] = organizationsettingMixinInters2[0]
 	organizationsettingMixinFields0 := organizationsettingMixin[0].Fields()
@@ -425,6 +907,52 @@ func init() {
 	organizationsettingDescID := organizationsettingMixinFields1[0].Descriptor()
 	// organizationsetting.DefaultID holds the default value on creation for the id field.
 	organizationsetting.DefaultID = organizationsettingDescID.Default.(func() string)
+	ownershiptransferMixin := schema.OwnershipTransfer{}.Mixin()
+	ownershiptransfer.Policy = privacy.NewPolicies(ownershiptransferMixin[2], schema.OwnershipTransfer{})
+	ownershiptransfer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ownershiptransfer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	ownershiptransferMixinHooks0 := ownershiptransferMixin[0].Hooks()
+	ownershiptransferHooks := schema.OwnershipTransfer{}.Hooks()
+
+	ownershiptransfer.Hooks[1] = ownershiptransferMixinHooks0[0]
+
+	ownershiptransfer.Hooks[2] = ownershiptransferHooks[0]
+	ownershiptransferInters := schema.OwnershipTransfer{}.Interceptors()
+	ownershiptransfer.Interceptors[0] = ownershiptransferInters[0]
+	ownershiptransferMixinFields0 := ownershiptransferMixin[0].Fields()
+	_ = ownershiptransferMixinFields0
+	ownershiptransferMixinFields1 := ownershiptransferMixin[1].Fields()
+	_ = ownershiptransferMixinFields1
+	ownershiptransferFields := schema.OwnershipTransfer{}.Fields()
+	_ = ownershiptransferFields
+	// ownershiptransferDescCreatedAt is the schema descriptor for created_at field.
+	ownershiptransferDescCreatedAt := ownershiptransferMixinFields0[0].Descriptor()
+	// ownershiptransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ownershiptransfer.DefaultCreatedAt = ownershiptransferDescCreatedAt.Default.(func() time.Time)
+	// ownershiptransferDescUpdatedAt is the schema descriptor for updated_at field.
+	ownershiptransferDescUpdatedAt := ownershiptransferMixinFields0[1].Descriptor()
+	// ownershiptransfer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ownershiptransfer.DefaultUpdatedAt = ownershiptransferDescUpdatedAt.Default.(func() time.Time)
+	// ownershiptransfer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ownershiptransfer.UpdateDefaultUpdatedAt = ownershiptransferDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ownershiptransferDescToken is the schema descriptor for token field.
+	ownershiptransferDescToken := ownershiptransferFields[5].Descriptor()
+	// ownershiptransfer.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	ownershiptransfer.TokenValidator = ownershiptransferDescToken.Validators[0].(func(string) error)
+	// ownershiptransferDescSecret is the schema descriptor for secret field.
+	ownershiptransferDescSecret := ownershiptransferFields[7].Descriptor()
+	// ownershiptransfer.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	ownershiptransfer.SecretValidator = ownershiptransferDescSecret.Validators[0].(func([]byte) error)
+	// ownershiptransferDescID is the schema descriptor for id field.
+	ownershiptransferDescID := ownershiptransferMixinFields1[0].Descriptor()
+	// ownershiptransfer.DefaultID holds the default value on creation for the id field.
+	ownershiptransfer.DefaultID = ownershiptransferDescID.Default.(func() string)
 	passwordresettokenMixin := schema.PasswordResetToken{}.Mixin()
 	passwordresettokenMixinHooks0 := passwordresettokenMixin[0].Hooks()
 	passwordresettokenMixinHooks2 := passwordresettokenMixin[2].Hooks()
@@ -481,12 +1009,26 @@ func init() {
 	// passwordresettoken.DefaultID holds the default value on creation for the id field.
 	passwordresettoken.DefaultID = passwordresettokenDescID.Default.(func() string)
 	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstoken.Policy = privacy.NewPolicies(personalaccesstokenMixin[3], schema.PersonalAccessToken{})
+	personalaccesstoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := personalaccesstoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	personalaccesstokenMixinHooks0 := personalaccesstokenMixin[0].Hooks()
 	personalaccesstokenMixinHooks1 := personalaccesstokenMixin[1].Hooks()
 	personalaccesstokenHooks := schema.PersonalAccessToken{}.Hooks()
-	personalaccesstoken.Hooks[0] = personalaccesstokenMixinHooks0[0]
-	personalaccesstoken.Hooks[1] = personalaccesstokenMixinHooks1[0]
-	personalaccesstoken.Hooks[2] = personalaccesstokenHooks[0]
+
+	personalaccesstoken.Hooks[1] = personalaccesstokenMixinHooks0[0]
+
+	personalaccesstoken.Hooks[2] = personalaccesstokenMixinHooks1[0]
+
+	personalaccesstoken.Hooks[3] = personalaccesstokenHooks[0]
+
+	personalaccesstoken.Hooks[4] = personalaccesstokenHooks[1]
 	personalaccesstokenMixinInters1 := personalaccesstokenMixin[1].Interceptors()
 	personalaccesstoken.Interceptors[0] = personalaccesstokenMixinInters1[0]
 	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
@@ -505,27 +1047,106 @@ func init() {
 	personalaccesstoken.DefaultUpdatedAt = personalaccesstokenDescUpdatedAt.Default.(func() time.Time)
 	// personalaccesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	personalaccesstoken.UpdateDefaultUpdatedAt = personalaccesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// personalaccesstokenDescToken is the schema descriptor for token field.
-	personalaccesstokenDescToken := personalaccesstokenFields[1].Descriptor()
-	// personalaccesstoken.DefaultToken holds the default value on creation for the token field.
-	personalaccesstoken.DefaultToken = personalaccesstokenDescToken.Default.(func() string)
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = personalaccesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = personalaccesstokenDescTokenHash.Validators[0].(func(string) error)
 	// personalaccesstokenDescDescription is the schema descriptor for description field.
-	personalaccesstokenDescDescription := personalaccesstokenFields[4].Descriptor()
+	personalaccesstokenDescDescription := personalaccesstokenFields[6].Descriptor()
 	// personalaccesstoken.DefaultDescription holds the default value on creation for the description field.
 	personalaccesstoken.DefaultDescription = personalaccesstokenDescDescription.Default.(string)
 	// personalaccesstokenDescLastUsedAt is the schema descriptor for last_used_at field.
-	personalaccesstokenDescLastUsedAt := personalaccesstokenFields[5].Descriptor()
+	personalaccesstokenDescLastUsedAt := personalaccesstokenFields[7].Descriptor()
 	// personalaccesstoken.UpdateDefaultLastUsedAt holds the default value on update for the last_used_at field.
 	personalaccesstoken.UpdateDefaultLastUsedAt = personalaccesstokenDescLastUsedAt.UpdateDefault.(func() time.Time)
 	// personalaccesstokenDescID is the schema descriptor for id field.
 	personalaccesstokenDescID := personalaccesstokenMixinFields2[0].Descriptor()
 	// personalaccesstoken.DefaultID holds the default value on creation for the id field.
 	personalaccesstoken.DefaultID = personalaccesstokenDescID.Default.(func() string)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinHooks0 := refreshtokenMixin[0].Hooks()
+	refreshtoken.Hooks[0] = refreshtokenMixinHooks0[0]
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenMixinFields1 := refreshtokenMixin[1].Fields()
+	_ = refreshtokenMixinFields1
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	refreshtokenDescUpdatedAt := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refreshtoken.DefaultUpdatedAt = refreshtokenDescUpdatedAt.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refreshtoken.UpdateDefaultUpdatedAt = refreshtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// refreshtokenDescJti is the schema descriptor for jti field.
+	refreshtokenDescJti := refreshtokenFields[0].Descriptor()
+	// refreshtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	refreshtoken.JtiValidator = refreshtokenDescJti.Validators[0].(func(string) error)
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	refreshtoken.FamilyIDValidator = refreshtokenDescFamilyID.Validators[0].(func(string) error)
+	// refreshtokenDescUserID is the schema descriptor for user_id field.
+	refreshtokenDescUserID := refreshtokenFields[3].Descriptor()
+	// refreshtoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	refreshtoken.UserIDValidator = refreshtokenDescUserID.Validators[0].(func(string) error)
+	// refreshtokenDescID is the schema descriptor for id field.
+	refreshtokenDescID := refreshtokenMixinFields1[0].Descriptor()
+	// refreshtoken.DefaultID holds the default value on creation for the id field.
+	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() string)
+	revokedtokenMixin := schema.RevokedToken{}.Mixin()
+	revokedtokenMixinHooks0 := revokedtokenMixin[0].Hooks()
+	revokedtoken.Hooks[0] = revokedtokenMixinHooks0[0]
+	revokedtokenMixinFields0 := revokedtokenMixin[0].Fields()
+	_ = revokedtokenMixinFields0
+	revokedtokenMixinFields1 := revokedtokenMixin[1].Fields()
+	_ = revokedtokenMixinFields1
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescCreatedAt is the schema descriptor for created_at field.
+	revokedtokenDescCreatedAt := revokedtokenMixinFields0[0].Descriptor()
+	// revokedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revokedtoken.DefaultCreatedAt = revokedtokenDescCreatedAt.Default.(func() time.Time)
+	// revokedtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	revokedtokenDescUpdatedAt := revokedtokenMixinFields0[1].Descriptor()
+	// revokedtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	revokedtoken.DefaultUpdatedAt = revokedtokenDescUpdatedAt.Default.(func() time.Time)
+	// revokedtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	revokedtoken.UpdateDefaultUpdatedAt = revokedtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// revokedtokenDescJti is the schema descriptor for jti field.
+	revokedtokenDescJti := revokedtokenFields[0].Descriptor()
+	// revokedtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	revokedtoken.JtiValidator = revokedtokenDescJti.Validators[0].(func(string) error)
+	// revokedtokenDescID is the schema descriptor for id field.
+	revokedtokenDescID := revokedtokenMixinFields1[0].Descriptor()
+	// revokedtoken.DefaultID holds the default value on creation for the id field.
+	revokedtoken.DefaultID = revokedtokenDescID.Default.(func() string)
 	sessionMixin := schema.Session{}.Mixin()
+	session.Policy = privacy.NewPolicies(sessionMixin[2], schema.Session{})
+	session.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := session.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	sessionMixinHooks0 := sessionMixin[0].Hooks()
 	sessionHooks := schema.Session{}.Hooks()
-	session.Hooks[0] = sessionMixinHooks0[0]
-	session.Hooks[1] = sessionHooks[0]
+
+	session.Hooks[1] = sessionMixinHooks0[0]
+
+	session.Hooks[2] = sessionHooks[0]
+
+	session.Hooks[3] = sessionHooks[1]
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
 	sessionMixinFields1 := sessionMixin[1].Fields()
@@ -544,14 +1165,41 @@ func init() {
 	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// sessionDescIssuedAt is the schema descriptor for issued_at field.
 	sessionDescIssuedAt := sessionFields[1].Descriptor()
-	// session.UpdateDefaultIssuedAt holds the default value on update for the issued_at field.
-	session.UpdateDefaultIssuedAt = sessionDescIssuedAt.UpdateDefault.(func() time.Time)
+	// session.DefaultIssuedAt holds the default value on creation for the issued_at field.
+	session.DefaultIssuedAt = sessionDescIssuedAt.Default.(func() time.Time)
 	// sessionDescID is the schema descriptor for id field.
 	sessionDescID := sessionMixinFields1[0].Descriptor()
 	// session.DefaultID holds the default value on creation for the id field.
 	session.DefaultID = sessionDescID.Default.(func() string)
+	sessiondataMixin := schema.SessionData{}.Mixin()
+	sessiondataMixinHooks0 := sessiondataMixin[0].Hooks()
+	sessiondata.Hooks[0] = sessiondataMixinHooks0[0]
+	sessiondataMixinFields0 := sessiondataMixin[0].Fields()
+	_ = sessiondataMixinFields0
+	sessiondataMixinFields1 := sessiondataMixin[1].Fields()
+	_ = sessiondataMixinFields1
+	sessiondataFields := schema.SessionData{}.Fields()
+	_ = sessiondataFields
+	// sessiondataDescCreatedAt is the schema descriptor for created_at field.
+	sessiondataDescCreatedAt := sessiondataMixinFields0[0].Descriptor()
+	// sessiondata.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessiondata.DefaultCreatedAt = sessiondataDescCreatedAt.Default.(func() time.Time)
+	// sessiondataDescUpdatedAt is the schema descriptor for updated_at field.
+	sessiondataDescUpdatedAt := sessiondataMixinFields0[1].Descriptor()
+	// sessiondata.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sessiondata.DefaultUpdatedAt = sessiondataDescUpdatedAt.Default.(func() time.Time)
+	// sessiondata.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sessiondata.UpdateDefaultUpdatedAt = sessiondataDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessiondataDescToken is the schema descriptor for token field.
+	sessiondataDescToken := sessiondataFields[0].Descriptor()
+	// sessiondata.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	sessiondata.TokenValidator = sessiondataDescToken.Validators[0].(func(string) error)
+	// sessiondataDescID is the schema descriptor for id field.
+	sessiondataDescID := sessiondataMixinFields1[0].Descriptor()
+	// sessiondata.DefaultID holds the default value on creation for the id field.
+	sessiondata.DefaultID = sessiondataDescID.Default.(func() string)
 	userMixin := schema.User{}.Mixin()
-	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Policy = privacy.NewPolicies(userMixin[3], schema.User{})
 	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := user.Policy.EvalMutation(ctx, m); err != nil {
@@ -681,7 +1329,7 @@ func init() {
 	// user.UpdateDefaultLastSeen holds the default value on update for the last_seen field.
 	user.UpdateDefaultLastSeen = userDescLastSeen.UpdateDefault.(func() time.Time)
 	// userDescOauth is the schema descriptor for oauth field.
-	userDescOauth := userFields[10].Descriptor()
+	userDescOauth := userFields[12].Descriptor()
 	// user.DefaultOauth holds the default value on creation for the oauth field.
 	user.DefaultOauth = userDescOauth.Default.(bool)
 	// userDescID is the schema descriptor for id field.
@@ -689,10 +1337,21 @@ func init() {
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() string)
 	usersettingMixin := schema.UserSetting{}.Mixin()
+	usersetting.Policy = privacy.NewPolicies(usersettingMixin[3], schema.UserSetting{})
+	usersetting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := usersetting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	usersettingMixinHooks0 := usersettingMixin[0].Hooks()
 	usersettingMixinHooks2 := usersettingMixin[2].Hooks()
-	usersetting.Hooks[0] = usersettingMixinHooks0[0]
-	usersetting.Hooks[1] = usersettingMixinHooks2[0]
+
+	usersetting.Hooks[1] = usersettingMixinHooks0[0]
+
+	usersetting.Hooks[2] = usersettingMixinHooks2[0]
 	usersettingMixinInters2 := usersettingMixin[2].Interceptors()
 	usersetting.Interceptors[0] = usersettingMixinInters2[0]
 	usersettingMixinFields0 := usersettingMixin[0].Fields()
@@ -727,10 +1386,82 @@ func init() {
 	usersettingDescTags := usersettingFields[8].Descriptor()
 	// usersetting.DefaultTags holds the default value on creation for the tags field.
 	usersetting.DefaultTags = usersettingDescTags.Default.([]string)
+	// usersettingDescIsTfaEnabled is the schema descriptor for is_tfa_enabled field.
+	usersettingDescIsTfaEnabled := usersettingFields[9].Descriptor()
+	// usersetting.DefaultIsTfaEnabled holds the default value on creation for the is_tfa_enabled field.
+	usersetting.DefaultIsTfaEnabled = usersettingDescIsTfaEnabled.Default.(bool)
+	// usersettingDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
+	usersettingDescFailedLoginAttempts := usersettingFields[13].Descriptor()
+	// usersetting.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
+	usersetting.DefaultFailedLoginAttempts = usersettingDescFailedLoginAttempts.Default.(int)
+	// usersetting.FailedLoginAttemptsValidator is a validator for the "failed_login_attempts" field. It is called by the builders before save.
+	usersetting.FailedLoginAttemptsValidator = usersettingDescFailedLoginAttempts.Validators[0].(func(int) error)
 	// usersettingDescID is the schema descriptor for id field.
 	usersettingDescID := usersettingMixinFields1[0].Descriptor()
 	// usersetting.DefaultID holds the default value on creation for the id field.
 	usersetting.DefaultID = usersettingDescID.Default.(func() string)
+	webauthncredentialMixin := schema.WebauthnCredential{}.Mixin()
+	webauthncredential.Policy = privacy.NewPolicies(webauthncredentialMixin[4], schema.WebauthnCredential{})
+	webauthncredential.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webauthncredential.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webauthncredentialMixinHooks0 := webauthncredentialMixin[0].Hooks()
+	webauthncredentialMixinHooks2 := webauthncredentialMixin[2].Hooks()
+
+	webauthncredential.Hooks[1] = webauthncredentialMixinHooks0[0]
+
+	webauthncredential.Hooks[2] = webauthncredentialMixinHooks2[0]
+	webauthncredentialMixinInters2 := webauthncredentialMixin[2].Interceptors()
+	webauthncredential.Interceptors[0] = webauthncredentialMixinInters2[0]
+	webauthncredentialMixinFields0 := webauthncredentialMixin[0].Fields()
+	_ = webauthncredentialMixinFields0
+	webauthncredentialMixinFields1 := webauthncredentialMixin[1].Fields()
+	_ = webauthncredentialMixinFields1
+	webauthncredentialFields := schema.WebauthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialMixinFields0[0].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	webauthncredentialDescUpdatedAt := webauthncredentialMixinFields0[1].Descriptor()
+	// webauthncredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webauthncredential.DefaultUpdatedAt = webauthncredentialDescUpdatedAt.Default.(func() time.Time)
+	// webauthncredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webauthncredential.UpdateDefaultUpdatedAt = webauthncredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredentialDescCredentialID is the schema descriptor for credential_id field.
+	webauthncredentialDescCredentialID := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	webauthncredential.CredentialIDValidator = webauthncredentialDescCredentialID.Validators[0].(func([]byte) error)
+	// webauthncredentialDescPublicKey is the schema descriptor for public_key field.
+	webauthncredentialDescPublicKey := webauthncredentialFields[2].Descriptor()
+	// webauthncredential.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	webauthncredential.PublicKeyValidator = webauthncredentialDescPublicKey.Validators[0].(func([]byte) error)
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[5].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(int64)
+	// webauthncredentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	webauthncredentialDescBackupEligible := webauthncredentialFields[7].Descriptor()
+	// webauthncredential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	webauthncredential.DefaultBackupEligible = webauthncredentialDescBackupEligible.Default.(bool)
+	// webauthncredentialDescBackupState is the schema descriptor for backup_state field.
+	webauthncredentialDescBackupState := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultBackupState holds the default value on creation for the backup_state field.
+	webauthncredential.DefaultBackupState = webauthncredentialDescBackupState.Default.(bool)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialMixinFields1[0].Descriptor()
+	// webauthncredential.DefaultID holds the default value on creation for the id field.
+	webauthncredential.DefaultID = webauthncredentialDescID.Default.(func() string)
 }
 
 const (
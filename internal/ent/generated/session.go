@@ -36,6 +36,14 @@ type Session struct {
 	OrganizationID string `json:"organization_id,omitempty"`
 	// the user the session is associated with
 	UserID string `json:"user_id,omitempty"`
+	// the user agent of the client the session was started from
+	UserAgent string `json:"user_agent,omitempty"`
+	// the ip address of the client the session was started from
+	IPAddress string `json:"ip_address,omitempty"`
+	// the last time the tokens of the session were refreshed
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// the time the session was revoked, the tokens issued for a revoked session are rejected
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SessionQuery when eager-loading is set.
 	Edges        SessionEdges `json:"edges"`
@@ -71,9 +79,9 @@ func (*Session) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case session.FieldID, session.FieldCreatedBy, session.FieldUpdatedBy, session.FieldSessionToken, session.FieldOrganizationID, session.FieldUserID:
+		case session.FieldID, session.FieldCreatedBy, session.FieldUpdatedBy, session.FieldSessionToken, session.FieldOrganizationID, session.FieldUserID, session.FieldUserAgent, session.FieldIPAddress:
 			values[i] = new(sql.NullString)
-		case session.FieldCreatedAt, session.FieldUpdatedAt, session.FieldIssuedAt, session.FieldExpiresAt:
+		case session.FieldCreatedAt, session.FieldUpdatedAt, session.FieldIssuedAt, session.FieldExpiresAt, session.FieldLastSeenAt, session.FieldRevokedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -150,6 +158,32 @@ func (s *Session) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.UserID = value.String
 			}
+		case session.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				s.UserAgent = value.String
+			}
+		case session.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				s.IPAddress = value.String
+			}
+		case session.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				s.LastSeenAt = new(time.Time)
+				*s.LastSeenAt = value.Time
+			}
+		case session.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				s.RevokedAt = new(time.Time)
+				*s.RevokedAt = value.Time
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -217,6 +251,22 @@ func (s *Session) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(s.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(s.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(s.IPAddress)
+	builder.WriteString(", ")
+	if v := s.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -89,6 +89,14 @@ func (sc *SessionCreate) SetIssuedAt(t time.Time) *SessionCreate {
 	return sc
 }
 
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableIssuedAt(t *time.Time) *SessionCreate {
+	if t != nil {
+		sc.SetIssuedAt(*t)
+	}
+	return sc
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (sc *SessionCreate) SetExpiresAt(t time.Time) *SessionCreate {
 	sc.mutation.SetExpiresAt(t)
@@ -101,12 +109,76 @@ func (sc *SessionCreate) SetOrganizationID(s string) *SessionCreate {
 	return sc
 }
 
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableOrganizationID(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetOrganizationID(*s)
+	}
+	return sc
+}
+
 // SetUserID sets the "user_id" field.
 func (sc *SessionCreate) SetUserID(s string) *SessionCreate {
 	sc.mutation.SetUserID(s)
 	return sc
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (sc *SessionCreate) SetUserAgent(s string) *SessionCreate {
+	sc.mutation.SetUserAgent(s)
+	return sc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableUserAgent(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetUserAgent(*s)
+	}
+	return sc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (sc *SessionCreate) SetIPAddress(s string) *SessionCreate {
+	sc.mutation.SetIPAddress(s)
+	return sc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableIPAddress(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetIPAddress(*s)
+	}
+	return sc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (sc *SessionCreate) SetLastSeenAt(t time.Time) *SessionCreate {
+	sc.mutation.SetLastSeenAt(t)
+	return sc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableLastSeenAt(t *time.Time) *SessionCreate {
+	if t != nil {
+		sc.SetLastSeenAt(*t)
+	}
+	return sc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sc *SessionCreate) SetRevokedAt(t time.Time) *SessionCreate {
+	sc.mutation.SetRevokedAt(t)
+	return sc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableRevokedAt(t *time.Time) *SessionCreate {
+	if t != nil {
+		sc.SetRevokedAt(*t)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SessionCreate) SetID(s string) *SessionCreate {
 	sc.mutation.SetID(s)
@@ -183,6 +255,13 @@ func (sc *SessionCreate) defaults() error {
 		v := session.DefaultUpdatedAt()
 		sc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := sc.mutation.IssuedAt(); !ok {
+		if session.DefaultIssuedAt == nil {
+			return fmt.Errorf("generated: uninitialized session.DefaultIssuedAt (forgotten import generated/runtime?)")
+		}
+		v := session.DefaultIssuedAt()
+		sc.mutation.SetIssuedAt(v)
+	}
 	if _, ok := sc.mutation.ID(); !ok {
 		if session.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized session.DefaultID (forgotten import generated/runtime?)")
@@ -210,9 +289,6 @@ func (sc *SessionCreate) check() error {
 	if _, ok := sc.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "Session.expires_at"`)}
 	}
-	if _, ok := sc.mutation.OrganizationID(); !ok {
-		return &ValidationError{Name: "organization_id", err: errors.New(`generated: missing required field "Session.organization_id"`)}
-	}
 	if _, ok := sc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Session.user_id"`)}
 	}
@@ -287,6 +363,22 @@ func (sc *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_spec.SetField(session.FieldOrganizationID, field.TypeString, value)
 		_node.OrganizationID = value
 	}
+	if value, ok := sc.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := sc.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := sc.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := sc.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
 	if nodes := sc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
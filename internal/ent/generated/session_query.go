@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -369,6 +370,12 @@ func (sq *SessionQuery) prepareQuery(ctx context.Context) error {
 		}
 		sq.sql = prev
 	}
+	if session.Policy == nil {
+		return errors.New("generated: uninitialized session.Policy (forgotten import generated/runtime?)")
+	}
+	if err := session.Policy.EvalQuery(ctx, sq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -57,12 +57,6 @@ func (su *SessionUpdate) ClearUpdatedBy() *SessionUpdate {
 	return su
 }
 
-// SetIssuedAt sets the "issued_at" field.
-func (su *SessionUpdate) SetIssuedAt(t time.Time) *SessionUpdate {
-	su.mutation.SetIssuedAt(t)
-	return su
-}
-
 // SetExpiresAt sets the "expires_at" field.
 func (su *SessionUpdate) SetExpiresAt(t time.Time) *SessionUpdate {
 	su.mutation.SetExpiresAt(t)
@@ -91,6 +85,12 @@ func (su *SessionUpdate) SetNillableOrganizationID(s *string) *SessionUpdate {
 	return su
 }
 
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (su *SessionUpdate) ClearOrganizationID() *SessionUpdate {
+	su.mutation.ClearOrganizationID()
+	return su
+}
+
 // SetUserID sets the "user_id" field.
 func (su *SessionUpdate) SetUserID(s string) *SessionUpdate {
 	su.mutation.SetUserID(s)
@@ -105,6 +105,86 @@ func (su *SessionUpdate) SetNillableUserID(s *string) *SessionUpdate {
 	return su
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (su *SessionUpdate) SetUserAgent(s string) *SessionUpdate {
+	su.mutation.SetUserAgent(s)
+	return su
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableUserAgent(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetUserAgent(*s)
+	}
+	return su
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (su *SessionUpdate) ClearUserAgent() *SessionUpdate {
+	su.mutation.ClearUserAgent()
+	return su
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (su *SessionUpdate) SetIPAddress(s string) *SessionUpdate {
+	su.mutation.SetIPAddress(s)
+	return su
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableIPAddress(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetIPAddress(*s)
+	}
+	return su
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (su *SessionUpdate) ClearIPAddress() *SessionUpdate {
+	su.mutation.ClearIPAddress()
+	return su
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (su *SessionUpdate) SetLastSeenAt(t time.Time) *SessionUpdate {
+	su.mutation.SetLastSeenAt(t)
+	return su
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableLastSeenAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetLastSeenAt(*t)
+	}
+	return su
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (su *SessionUpdate) ClearLastSeenAt() *SessionUpdate {
+	su.mutation.ClearLastSeenAt()
+	return su
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (su *SessionUpdate) SetRevokedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetRevokedAt(t)
+	return su
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableRevokedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetRevokedAt(*t)
+	}
+	return su
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (su *SessionUpdate) ClearRevokedAt() *SessionUpdate {
+	su.mutation.ClearRevokedAt()
+	return su
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (su *SessionUpdate) SetOwnerID(id string) *SessionUpdate {
 	su.mutation.SetOwnerID(id)
@@ -166,13 +246,6 @@ func (su *SessionUpdate) defaults() error {
 		v := session.UpdateDefaultUpdatedAt()
 		su.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := su.mutation.IssuedAt(); !ok {
-		if session.UpdateDefaultIssuedAt == nil {
-			return fmt.Errorf("generated: uninitialized session.UpdateDefaultIssuedAt (forgotten import generated/runtime?)")
-		}
-		v := session.UpdateDefaultIssuedAt()
-		su.mutation.SetIssuedAt(v)
-	}
 	return nil
 }
 
@@ -208,15 +281,39 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.UpdatedByCleared() {
 		_spec.ClearField(session.FieldUpdatedBy, field.TypeString)
 	}
-	if value, ok := su.mutation.IssuedAt(); ok {
-		_spec.SetField(session.FieldIssuedAt, field.TypeTime, value)
-	}
 	if value, ok := su.mutation.ExpiresAt(); ok {
 		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
 	}
 	if value, ok := su.mutation.OrganizationID(); ok {
 		_spec.SetField(session.FieldOrganizationID, field.TypeString, value)
 	}
+	if su.mutation.OrganizationIDCleared() {
+		_spec.ClearField(session.FieldOrganizationID, field.TypeString)
+	}
+	if value, ok := su.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if su.mutation.UserAgentCleared() {
+		_spec.ClearField(session.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := su.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if su.mutation.IPAddressCleared() {
+		_spec.ClearField(session.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := su.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if su.mutation.LastSeenAtCleared() {
+		_spec.ClearField(session.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if su.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
 	if su.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -296,12 +393,6 @@ func (suo *SessionUpdateOne) ClearUpdatedBy() *SessionUpdateOne {
 	return suo
 }
 
-// SetIssuedAt sets the "issued_at" field.
-func (suo *SessionUpdateOne) SetIssuedAt(t time.Time) *SessionUpdateOne {
-	suo.mutation.SetIssuedAt(t)
-	return suo
-}
-
 // SetExpiresAt sets the "expires_at" field.
 func (suo *SessionUpdateOne) SetExpiresAt(t time.Time) *SessionUpdateOne {
 	suo.mutation.SetExpiresAt(t)
@@ -330,6 +421,12 @@ func (suo *SessionUpdateOne) SetNillableOrganizationID(s *string) *SessionUpdate
 	return suo
 }
 
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (suo *SessionUpdateOne) ClearOrganizationID() *SessionUpdateOne {
+	suo.mutation.ClearOrganizationID()
+	return suo
+}
+
 // SetUserID sets the "user_id" field.
 func (suo *SessionUpdateOne) SetUserID(s string) *SessionUpdateOne {
 	suo.mutation.SetUserID(s)
@@ -344,6 +441,86 @@ func (suo *SessionUpdateOne) SetNillableUserID(s *string) *SessionUpdateOne {
 	return suo
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (suo *SessionUpdateOne) SetUserAgent(s string) *SessionUpdateOne {
+	suo.mutation.SetUserAgent(s)
+	return suo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableUserAgent(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetUserAgent(*s)
+	}
+	return suo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (suo *SessionUpdateOne) ClearUserAgent() *SessionUpdateOne {
+	suo.mutation.ClearUserAgent()
+	return suo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (suo *SessionUpdateOne) SetIPAddress(s string) *SessionUpdateOne {
+	suo.mutation.SetIPAddress(s)
+	return suo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableIPAddress(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetIPAddress(*s)
+	}
+	return suo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (suo *SessionUpdateOne) ClearIPAddress() *SessionUpdateOne {
+	suo.mutation.ClearIPAddress()
+	return suo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (suo *SessionUpdateOne) SetLastSeenAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetLastSeenAt(t)
+	return suo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableLastSeenAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetLastSeenAt(*t)
+	}
+	return suo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (suo *SessionUpdateOne) ClearLastSeenAt() *SessionUpdateOne {
+	suo.mutation.ClearLastSeenAt()
+	return suo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (suo *SessionUpdateOne) SetRevokedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetRevokedAt(t)
+	return suo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableRevokedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetRevokedAt(*t)
+	}
+	return suo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (suo *SessionUpdateOne) ClearRevokedAt() *SessionUpdateOne {
+	suo.mutation.ClearRevokedAt()
+	return suo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (suo *SessionUpdateOne) SetOwnerID(id string) *SessionUpdateOne {
 	suo.mutation.SetOwnerID(id)
@@ -418,13 +595,6 @@ func (suo *SessionUpdateOne) defaults() error {
 		v := session.UpdateDefaultUpdatedAt()
 		suo.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := suo.mutation.IssuedAt(); !ok {
-		if session.UpdateDefaultIssuedAt == nil {
-			return fmt.Errorf("generated: uninitialized session.UpdateDefaultIssuedAt (forgotten import generated/runtime?)")
-		}
-		v := session.UpdateDefaultIssuedAt()
-		suo.mutation.SetIssuedAt(v)
-	}
 	return nil
 }
 
@@ -477,15 +647,39 @@ func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err e
 	if suo.mutation.UpdatedByCleared() {
 		_spec.ClearField(session.FieldUpdatedBy, field.TypeString)
 	}
-	if value, ok := suo.mutation.IssuedAt(); ok {
-		_spec.SetField(session.FieldIssuedAt, field.TypeTime, value)
-	}
 	if value, ok := suo.mutation.ExpiresAt(); ok {
 		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
 	}
 	if value, ok := suo.mutation.OrganizationID(); ok {
 		_spec.SetField(session.FieldOrganizationID, field.TypeString, value)
 	}
+	if suo.mutation.OrganizationIDCleared() {
+		_spec.ClearField(session.FieldOrganizationID, field.TypeString)
+	}
+	if value, ok := suo.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if suo.mutation.UserAgentCleared() {
+		_spec.ClearField(session.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := suo.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if suo.mutation.IPAddressCleared() {
+		_spec.ClearField(session.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := suo.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(session.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if suo.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
 	if suo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+)
+
+// SessionData is the model entity for the SessionData schema.
+type SessionData struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// the session token stored in the session cookie
+	Token string `json:"-"`
+	// the encoded data of the session
+	Data []byte `json:"data,omitempty"`
+	// when the session expires, after which it is removed by the cleanup task
+	Expiry       time.Time `json:"expiry,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionData) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessiondata.FieldData:
+			values[i] = new([]byte)
+		case sessiondata.FieldID, sessiondata.FieldCreatedBy, sessiondata.FieldUpdatedBy, sessiondata.FieldToken:
+			values[i] = new(sql.NullString)
+		case sessiondata.FieldCreatedAt, sessiondata.FieldUpdatedAt, sessiondata.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionData fields.
+func (sd *SessionData) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessiondata.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sd.ID = value.String
+			}
+		case sessiondata.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sd.CreatedAt = value.Time
+			}
+		case sessiondata.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sd.UpdatedAt = value.Time
+			}
+		case sessiondata.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sd.CreatedBy = value.String
+			}
+		case sessiondata.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				sd.UpdatedBy = value.String
+			}
+		case sessiondata.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				sd.Token = value.String
+			}
+		case sessiondata.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				sd.Data = *value
+			}
+		case sessiondata.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				sd.Expiry = value.Time
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionData.
+// This includes values selected through modifiers, order, etc.
+func (sd *SessionData) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SessionData.
+// Note that you need to call SessionData.Unwrap() before calling this method if this SessionData
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *SessionData) Update() *SessionDataUpdateOne {
+	return NewSessionDataClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the SessionData entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *SessionData) Unwrap() *SessionData {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: SessionData is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *SessionData) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionData(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(sd.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(sd.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", sd.Data))
+	builder.WriteString(", ")
+	builder.WriteString("expiry=")
+	builder.WriteString(sd.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionDataSlice is a parsable slice of SessionData.
+type SessionDataSlice []*SessionData
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+)
+
+// SessionDataCreate is the builder for creating a SessionData entity.
+type SessionDataCreate struct {
+	config
+	mutation *SessionDataMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdc *SessionDataCreate) SetCreatedAt(t time.Time) *SessionDataCreate {
+	sdc.mutation.SetCreatedAt(t)
+	return sdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdc *SessionDataCreate) SetNillableCreatedAt(t *time.Time) *SessionDataCreate {
+	if t != nil {
+		sdc.SetCreatedAt(*t)
+	}
+	return sdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdc *SessionDataCreate) SetUpdatedAt(t time.Time) *SessionDataCreate {
+	sdc.mutation.SetUpdatedAt(t)
+	return sdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sdc *SessionDataCreate) SetNillableUpdatedAt(t *time.Time) *SessionDataCreate {
+	if t != nil {
+		sdc.SetUpdatedAt(*t)
+	}
+	return sdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sdc *SessionDataCreate) SetCreatedBy(s string) *SessionDataCreate {
+	sdc.mutation.SetCreatedBy(s)
+	return sdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sdc *SessionDataCreate) SetNillableCreatedBy(s *string) *SessionDataCreate {
+	if s != nil {
+		sdc.SetCreatedBy(*s)
+	}
+	return sdc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sdc *SessionDataCreate) SetUpdatedBy(s string) *SessionDataCreate {
+	sdc.mutation.SetUpdatedBy(s)
+	return sdc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sdc *SessionDataCreate) SetNillableUpdatedBy(s *string) *SessionDataCreate {
+	if s != nil {
+		sdc.SetUpdatedBy(*s)
+	}
+	return sdc
+}
+
+// SetToken sets the "token" field.
+func (sdc *SessionDataCreate) SetToken(s string) *SessionDataCreate {
+	sdc.mutation.SetToken(s)
+	return sdc
+}
+
+// SetData sets the "data" field.
+func (sdc *SessionDataCreate) SetData(b []byte) *SessionDataCreate {
+	sdc.mutation.SetData(b)
+	return sdc
+}
+
+// SetExpiry sets the "expiry" field.
+func (sdc *SessionDataCreate) SetExpiry(t time.Time) *SessionDataCreate {
+	sdc.mutation.SetExpiry(t)
+	return sdc
+}
+
+// SetID sets the "id" field.
+func (sdc *SessionDataCreate) SetID(s string) *SessionDataCreate {
+	sdc.mutation.SetID(s)
+	return sdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sdc *SessionDataCreate) SetNillableID(s *string) *SessionDataCreate {
+	if s != nil {
+		sdc.SetID(*s)
+	}
+	return sdc
+}
+
+// Mutation returns the SessionDataMutation object of the builder.
+func (sdc *SessionDataCreate) Mutation() *SessionDataMutation {
+	return sdc.mutation
+}
+
+// Save creates the SessionData in the database.
+func (sdc *SessionDataCreate) Save(ctx context.Context) (*SessionData, error) {
+	if err := sdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *SessionDataCreate) SaveX(ctx context.Context) *SessionData {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *SessionDataCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *SessionDataCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *SessionDataCreate) defaults() error {
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		if sessiondata.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized sessiondata.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := sessiondata.DefaultCreatedAt()
+		sdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sdc.mutation.UpdatedAt(); !ok {
+		if sessiondata.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized sessiondata.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := sessiondata.DefaultUpdatedAt()
+		sdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sdc.mutation.ID(); !ok {
+		if sessiondata.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized sessiondata.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := sessiondata.DefaultID()
+		sdc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *SessionDataCreate) check() error {
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "SessionData.created_at"`)}
+	}
+	if _, ok := sdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "SessionData.updated_at"`)}
+	}
+	if _, ok := sdc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`generated: missing required field "SessionData.token"`)}
+	}
+	if v, ok := sdc.mutation.Token(); ok {
+		if err := sessiondata.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`generated: validator failed for field "SessionData.token": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`generated: missing required field "SessionData.data"`)}
+	}
+	if _, ok := sdc.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`generated: missing required field "SessionData.expiry"`)}
+	}
+	return nil
+}
+
+func (sdc *SessionDataCreate) sqlSave(ctx context.Context) (*SessionData, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SessionData.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *SessionDataCreate) createSpec() (*SessionData, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionData{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(sessiondata.Table, sqlgraph.NewFieldSpec(sessiondata.FieldID, field.TypeString))
+	)
+	_spec.Schema = sdc.schemaConfig.SessionData
+	if id, ok := sdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sdc.mutation.CreatedAt(); ok {
+		_spec.SetField(sessiondata.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sdc.mutation.CreatedBy(); ok {
+		_spec.SetField(sessiondata.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sdc.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sdc.mutation.Token(); ok {
+		_spec.SetField(sessiondata.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := sdc.mutation.Data(); ok {
+		_spec.SetField(sessiondata.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := sdc.mutation.Expiry(); ok {
+		_spec.SetField(sessiondata.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// SessionDataCreateBulk is the builder for creating many SessionData entities in bulk.
+type SessionDataCreateBulk struct {
+	config
+	err      error
+	builders []*SessionDataCreate
+}
+
+// Save creates the SessionData entities in the database.
+func (sdcb *SessionDataCreateBulk) Save(ctx context.Context) ([]*SessionData, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*SessionData, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionDataMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *SessionDataCreateBulk) SaveX(ctx context.Context) []*SessionData {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *SessionDataCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *SessionDataCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+)
+
+// SessionDataDelete is the builder for deleting a SessionData entity.
+type SessionDataDelete struct {
+	config
+	hooks    []Hook
+	mutation *SessionDataMutation
+}
+
+// Where appends a list predicates to the SessionDataDelete builder.
+func (sdd *SessionDataDelete) Where(ps ...predicate.SessionData) *SessionDataDelete {
+	sdd.mutation.Where(ps...)
+	return sdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sdd *SessionDataDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdd.sqlExec, sdd.mutation, sdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdd *SessionDataDelete) ExecX(ctx context.Context) int {
+	n, err := sdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sdd *SessionDataDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sessiondata.Table, sqlgraph.NewFieldSpec(sessiondata.FieldID, field.TypeString))
+	_spec.Node.Schema = sdd.schemaConfig.SessionData
+	ctx = internal.NewSchemaConfigContext(ctx, sdd.schemaConfig)
+	if ps := sdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sdd.mutation.done = true
+	return affected, err
+}
+
+// SessionDataDeleteOne is the builder for deleting a single SessionData entity.
+type SessionDataDeleteOne struct {
+	sdd *SessionDataDelete
+}
+
+// Where appends a list predicates to the SessionDataDelete builder.
+func (sddo *SessionDataDeleteOne) Where(ps ...predicate.SessionData) *SessionDataDeleteOne {
+	sddo.sdd.mutation.Where(ps...)
+	return sddo
+}
+
+// Exec executes the deletion query.
+func (sddo *SessionDataDeleteOne) Exec(ctx context.Context) error {
+	n, err := sddo.sdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sessiondata.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sddo *SessionDataDeleteOne) ExecX(ctx context.Context) {
+	if err := sddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
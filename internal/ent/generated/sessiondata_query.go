@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// SessionDataQuery is the builder for querying SessionData entities.
+type SessionDataQuery struct {
+	config
+	ctx        *QueryContext
+	order      []sessiondata.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SessionData
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*SessionData) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SessionDataQuery builder.
+func (sdq *SessionDataQuery) Where(ps ...predicate.SessionData) *SessionDataQuery {
+	sdq.predicates = append(sdq.predicates, ps...)
+	return sdq
+}
+
+// Limit the number of records to be returned by this query.
+func (sdq *SessionDataQuery) Limit(limit int) *SessionDataQuery {
+	sdq.ctx.Limit = &limit
+	return sdq
+}
+
+// Offset to start from.
+func (sdq *SessionDataQuery) Offset(offset int) *SessionDataQuery {
+	sdq.ctx.Offset = &offset
+	return sdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sdq *SessionDataQuery) Unique(unique bool) *SessionDataQuery {
+	sdq.ctx.Unique = &unique
+	return sdq
+}
+
+// Order specifies how the records should be ordered.
+func (sdq *SessionDataQuery) Order(o ...sessiondata.OrderOption) *SessionDataQuery {
+	sdq.order = append(sdq.order, o...)
+	return sdq
+}
+
+// First returns the first SessionData entity from the query.
+// Returns a *NotFoundError when no SessionData was found.
+func (sdq *SessionDataQuery) First(ctx context.Context) (*SessionData, error) {
+	nodes, err := sdq.Limit(1).All(setContextOp(ctx, sdq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sessiondata.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sdq *SessionDataQuery) FirstX(ctx context.Context) *SessionData {
+	node, err := sdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SessionData ID from the query.
+// Returns a *NotFoundError when no SessionData ID was found.
+func (sdq *SessionDataQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sdq.Limit(1).IDs(setContextOp(ctx, sdq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sessiondata.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sdq *SessionDataQuery) FirstIDX(ctx context.Context) string {
+	id, err := sdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SessionData entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SessionData entity is found.
+// Returns a *NotFoundError when no SessionData entities are found.
+func (sdq *SessionDataQuery) Only(ctx context.Context) (*SessionData, error) {
+	nodes, err := sdq.Limit(2).All(setContextOp(ctx, sdq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sessiondata.Label}
+	default:
+		return nil, &NotSingularError{sessiondata.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sdq *SessionDataQuery) OnlyX(ctx context.Context) *SessionData {
+	node, err := sdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SessionData ID in the query.
+// Returns a *NotSingularError when more than one SessionData ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sdq *SessionDataQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sdq.Limit(2).IDs(setContextOp(ctx, sdq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sessiondata.Label}
+	default:
+		err = &NotSingularError{sessiondata.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sdq *SessionDataQuery) OnlyIDX(ctx context.Context) string {
+	id, err := sdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SessionDataSlice.
+func (sdq *SessionDataQuery) All(ctx context.Context) ([]*SessionData, error) {
+	ctx = setContextOp(ctx, sdq.ctx, "All")
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SessionData, *SessionDataQuery]()
+	return withInterceptors[[]*SessionData](ctx, sdq, qr, sdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sdq *SessionDataQuery) AllX(ctx context.Context) []*SessionData {
+	nodes, err := sdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SessionData IDs.
+func (sdq *SessionDataQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if sdq.ctx.Unique == nil && sdq.path != nil {
+		sdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sdq.ctx, "IDs")
+	if err = sdq.Select(sessiondata.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sdq *SessionDataQuery) IDsX(ctx context.Context) []string {
+	ids, err := sdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sdq *SessionDataQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sdq.ctx, "Count")
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sdq, querierCount[*SessionDataQuery](), sdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sdq *SessionDataQuery) CountX(ctx context.Context) int {
+	count, err := sdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sdq *SessionDataQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sdq.ctx, "Exist")
+	switch _, err := sdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sdq *SessionDataQuery) ExistX(ctx context.Context) bool {
+	exist, err := sdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SessionDataQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sdq *SessionDataQuery) Clone() *SessionDataQuery {
+	if sdq == nil {
+		return nil
+	}
+	return &SessionDataQuery{
+		config:     sdq.config,
+		ctx:        sdq.ctx.Clone(),
+		order:      append([]sessiondata.OrderOption{}, sdq.order...),
+		inters:     append([]Interceptor{}, sdq.inters...),
+		predicates: append([]predicate.SessionData{}, sdq.predicates...),
+		// clone intermediate query.
+		sql:  sdq.sql.Clone(),
+		path: sdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SessionData.Query().
+//		GroupBy(sessiondata.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (sdq *SessionDataQuery) GroupBy(field string, fields ...string) *SessionDataGroupBy {
+	sdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SessionDataGroupBy{build: sdq}
+	grbuild.flds = &sdq.ctx.Fields
+	grbuild.label = sessiondata.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SessionData.Query().
+//		Select(sessiondata.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (sdq *SessionDataQuery) Select(fields ...string) *SessionDataSelect {
+	sdq.ctx.Fields = append(sdq.ctx.Fields, fields...)
+	sbuild := &SessionDataSelect{SessionDataQuery: sdq}
+	sbuild.label = sessiondata.Label
+	sbuild.flds, sbuild.scan = &sdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SessionDataSelect configured with the given aggregations.
+func (sdq *SessionDataQuery) Aggregate(fns ...AggregateFunc) *SessionDataSelect {
+	return sdq.Select().Aggregate(fns...)
+}
+
+func (sdq *SessionDataQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sdq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sdq.ctx.Fields {
+		if !sessiondata.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if sdq.path != nil {
+		prev, err := sdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sdq.sql = prev
+	}
+	return nil
+}
+
+func (sdq *SessionDataQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SessionData, error) {
+	var (
+		nodes = []*SessionData{}
+		_spec = sdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SessionData).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SessionData{config: sdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = sdq.schemaConfig.SessionData
+	ctx = internal.NewSchemaConfigContext(ctx, sdq.schemaConfig)
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range sdq.loadTotal {
+		if err := sdq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sdq *SessionDataQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sdq.querySpec()
+	_spec.Node.Schema = sdq.schemaConfig.SessionData
+	ctx = internal.NewSchemaConfigContext(ctx, sdq.schemaConfig)
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	_spec.Node.Columns = sdq.ctx.Fields
+	if len(sdq.ctx.Fields) > 0 {
+		_spec.Unique = sdq.ctx.Unique != nil && *sdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sdq.driver, _spec)
+}
+
+func (sdq *SessionDataQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sessiondata.Table, sessiondata.Columns, sqlgraph.NewFieldSpec(sessiondata.FieldID, field.TypeString))
+	_spec.From = sdq.sql
+	if unique := sdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessiondata.FieldID)
+		for i := range fields {
+			if fields[i] != sessiondata.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sdq *SessionDataQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sdq.driver.Dialect())
+	t1 := builder.Table(sessiondata.Table)
+	columns := sdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sessiondata.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sdq.sql != nil {
+		selector = sdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sdq.ctx.Unique != nil && *sdq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(sdq.schemaConfig.SessionData)
+	ctx = internal.NewSchemaConfigContext(ctx, sdq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range sdq.predicates {
+		p(selector)
+	}
+	for _, p := range sdq.order {
+		p(selector)
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SessionDataGroupBy is the group-by builder for SessionData entities.
+type SessionDataGroupBy struct {
+	selector
+	build *SessionDataQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sdgb *SessionDataGroupBy) Aggregate(fns ...AggregateFunc) *SessionDataGroupBy {
+	sdgb.fns = append(sdgb.fns, fns...)
+	return sdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sdgb *SessionDataGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sdgb.build.ctx, "GroupBy")
+	if err := sdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SessionDataQuery, *SessionDataGroupBy](ctx, sdgb.build, sdgb, sdgb.build.inters, v)
+}
+
+func (sdgb *SessionDataGroupBy) sqlScan(ctx context.Context, root *SessionDataQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sdgb.fns))
+	for _, fn := range sdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sdgb.flds)+len(sdgb.fns))
+		for _, f := range *sdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SessionDataSelect is the builder for selecting fields of SessionData entities.
+type SessionDataSelect struct {
+	*SessionDataQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sds *SessionDataSelect) Aggregate(fns ...AggregateFunc) *SessionDataSelect {
+	sds.fns = append(sds.fns, fns...)
+	return sds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sds *SessionDataSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sds.ctx, "Select")
+	if err := sds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SessionDataQuery, *SessionDataSelect](ctx, sds.SessionDataQuery, sds, sds.inters, v)
+}
+
+func (sds *SessionDataSelect) sqlScan(ctx context.Context, root *SessionDataQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sds.fns))
+	for _, fn := range sds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// SessionDataUpdate is the builder for updating SessionData entities.
+type SessionDataUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionDataMutation
+}
+
+// Where appends a list predicates to the SessionDataUpdate builder.
+func (sdu *SessionDataUpdate) Where(ps ...predicate.SessionData) *SessionDataUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdu *SessionDataUpdate) SetUpdatedAt(t time.Time) *SessionDataUpdate {
+	sdu.mutation.SetUpdatedAt(t)
+	return sdu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sdu *SessionDataUpdate) SetUpdatedBy(s string) *SessionDataUpdate {
+	sdu.mutation.SetUpdatedBy(s)
+	return sdu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sdu *SessionDataUpdate) SetNillableUpdatedBy(s *string) *SessionDataUpdate {
+	if s != nil {
+		sdu.SetUpdatedBy(*s)
+	}
+	return sdu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sdu *SessionDataUpdate) ClearUpdatedBy() *SessionDataUpdate {
+	sdu.mutation.ClearUpdatedBy()
+	return sdu
+}
+
+// SetData sets the "data" field.
+func (sdu *SessionDataUpdate) SetData(b []byte) *SessionDataUpdate {
+	sdu.mutation.SetData(b)
+	return sdu
+}
+
+// SetExpiry sets the "expiry" field.
+func (sdu *SessionDataUpdate) SetExpiry(t time.Time) *SessionDataUpdate {
+	sdu.mutation.SetExpiry(t)
+	return sdu
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (sdu *SessionDataUpdate) SetNillableExpiry(t *time.Time) *SessionDataUpdate {
+	if t != nil {
+		sdu.SetExpiry(*t)
+	}
+	return sdu
+}
+
+// Mutation returns the SessionDataMutation object of the builder.
+func (sdu *SessionDataUpdate) Mutation() *SessionDataMutation {
+	return sdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *SessionDataUpdate) Save(ctx context.Context) (int, error) {
+	if err := sdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *SessionDataUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *SessionDataUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *SessionDataUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdu *SessionDataUpdate) defaults() error {
+	if _, ok := sdu.mutation.UpdatedAt(); !ok {
+		if sessiondata.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized sessiondata.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := sessiondata.UpdateDefaultUpdatedAt()
+		sdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (sdu *SessionDataUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessiondata.Table, sessiondata.Columns, sqlgraph.NewFieldSpec(sessiondata.FieldID, field.TypeString))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sdu.mutation.CreatedByCleared() {
+		_spec.ClearField(sessiondata.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sdu.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sdu.mutation.UpdatedByCleared() {
+		_spec.ClearField(sessiondata.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := sdu.mutation.Data(); ok {
+		_spec.SetField(sessiondata.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := sdu.mutation.Expiry(); ok {
+		_spec.SetField(sessiondata.FieldExpiry, field.TypeTime, value)
+	}
+	_spec.Node.Schema = sdu.schemaConfig.SessionData
+	ctx = internal.NewSchemaConfigContext(ctx, sdu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessiondata.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// SessionDataUpdateOne is the builder for updating a single SessionData entity.
+type SessionDataUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionDataMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sduo *SessionDataUpdateOne) SetUpdatedAt(t time.Time) *SessionDataUpdateOne {
+	sduo.mutation.SetUpdatedAt(t)
+	return sduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sduo *SessionDataUpdateOne) SetUpdatedBy(s string) *SessionDataUpdateOne {
+	sduo.mutation.SetUpdatedBy(s)
+	return sduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sduo *SessionDataUpdateOne) SetNillableUpdatedBy(s *string) *SessionDataUpdateOne {
+	if s != nil {
+		sduo.SetUpdatedBy(*s)
+	}
+	return sduo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sduo *SessionDataUpdateOne) ClearUpdatedBy() *SessionDataUpdateOne {
+	sduo.mutation.ClearUpdatedBy()
+	return sduo
+}
+
+// SetData sets the "data" field.
+func (sduo *SessionDataUpdateOne) SetData(b []byte) *SessionDataUpdateOne {
+	sduo.mutation.SetData(b)
+	return sduo
+}
+
+// SetExpiry sets the "expiry" field.
+func (sduo *SessionDataUpdateOne) SetExpiry(t time.Time) *SessionDataUpdateOne {
+	sduo.mutation.SetExpiry(t)
+	return sduo
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (sduo *SessionDataUpdateOne) SetNillableExpiry(t *time.Time) *SessionDataUpdateOne {
+	if t != nil {
+		sduo.SetExpiry(*t)
+	}
+	return sduo
+}
+
+// Mutation returns the SessionDataMutation object of the builder.
+func (sduo *SessionDataUpdateOne) Mutation() *SessionDataMutation {
+	return sduo.mutation
+}
+
+// Where appends a list predicates to the SessionDataUpdate builder.
+func (sduo *SessionDataUpdateOne) Where(ps ...predicate.SessionData) *SessionDataUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *SessionDataUpdateOne) Select(field string, fields ...string) *SessionDataUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated SessionData entity.
+func (sduo *SessionDataUpdateOne) Save(ctx context.Context) (*SessionData, error) {
+	if err := sduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *SessionDataUpdateOne) SaveX(ctx context.Context) *SessionData {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *SessionDataUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *SessionDataUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sduo *SessionDataUpdateOne) defaults() error {
+	if _, ok := sduo.mutation.UpdatedAt(); !ok {
+		if sessiondata.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized sessiondata.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := sessiondata.UpdateDefaultUpdatedAt()
+		sduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (sduo *SessionDataUpdateOne) sqlSave(ctx context.Context) (_node *SessionData, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessiondata.Table, sessiondata.Columns, sqlgraph.NewFieldSpec(sessiondata.FieldID, field.TypeString))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "SessionData.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessiondata.FieldID)
+		for _, f := range fields {
+			if !sessiondata.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != sessiondata.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sduo.mutation.CreatedByCleared() {
+		_spec.ClearField(sessiondata.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessiondata.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sduo.mutation.UpdatedByCleared() {
+		_spec.ClearField(sessiondata.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := sduo.mutation.Data(); ok {
+		_spec.SetField(sessiondata.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := sduo.mutation.Expiry(); ok {
+		_spec.SetField(sessiondata.FieldExpiry, field.TypeTime, value)
+	}
+	_spec.Node.Schema = sduo.schemaConfig.SessionData
+	ctx = internal.NewSchemaConfigContext(ctx, sduo.schemaConfig)
+	_node = &SessionData{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessiondata.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
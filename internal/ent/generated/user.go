@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -48,6 +49,10 @@ type User struct {
 	LastSeen *time.Time `json:"last_seen,omitempty"`
 	// user password hash
 	Password *string `json:"-"`
+	// hashes of the most recent passwords, including the current password, that cannot be reused
+	PasswordHistory []string `json:"-"`
+	// the time the password was last set, passwords older than the max age of the password policy have to be reset
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
 	// the Subject of the user JWT
 	Sub string `json:"sub,omitempty"`
 	// whether the user uses oauth for login or not
@@ -74,11 +79,19 @@ type UserEdges struct {
 	EmailVerificationTokens []*EmailVerificationToken `json:"email_verification_tokens,omitempty"`
 	// ResetTokens holds the value of the reset_tokens edge.
 	ResetTokens []*PasswordResetToken `json:"reset_tokens,omitempty"`
+	// MagicLinkTokens holds the value of the magic_link_tokens edge.
+	MagicLinkTokens []*MagicLinkToken `json:"magic_link_tokens,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*WebauthnCredential `json:"webauthn_credentials,omitempty"`
+	// OrgMemberships holds the value of the org_memberships edge.
+	OrgMemberships []*OrgMembership `json:"org_memberships,omitempty"`
+	// GroupMemberships holds the value of the group_memberships edge.
+	GroupMemberships []*GroupMembership `json:"group_memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [11]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
+	totalCount [8]map[string]int
 
 	namedOrganizations           map[string][]*Organization
 	namedSessions                map[string][]*Session
@@ -86,6 +99,10 @@ type UserEdges struct {
 	namedPersonalAccessTokens    map[string][]*PersonalAccessToken
 	namedEmailVerificationTokens map[string][]*EmailVerificationToken
 	namedResetTokens             map[string][]*PasswordResetToken
+	namedMagicLinkTokens         map[string][]*MagicLinkToken
+	namedWebauthnCredentials     map[string][]*WebauthnCredential
+	namedOrgMemberships          map[string][]*OrgMembership
+	namedGroupMemberships        map[string][]*GroupMembership
 }
 
 // OrganizationsOrErr returns the Organizations value or an error if the edge
@@ -155,16 +172,54 @@ func (e UserEdges) ResetTokensOrErr() ([]*PasswordResetToken, error) {
 	return nil, &NotLoadedError{edge: "reset_tokens"}
 }
 
+// MagicLinkTokensOrErr returns the MagicLinkTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MagicLinkTokensOrErr() ([]*MagicLinkToken, error) {
+	if e.loadedTypes[7] {
+		return e.MagicLinkTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "magic_link_tokens"}
+}
+
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*WebauthnCredential, error) {
+	if e.loadedTypes[8] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// OrgMembershipsOrErr returns the OrgMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrgMembershipsOrErr() ([]*OrgMembership, error) {
+	if e.loadedTypes[9] {
+		return e.OrgMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "org_memberships"}
+}
+
+// GroupMembershipsOrErr returns the GroupMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupMembershipsOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[10] {
+		return e.GroupMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "group_memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldPasswordHistory:
+			values[i] = new([]byte)
 		case user.FieldOauth:
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldCreatedBy, user.FieldUpdatedBy, user.FieldDeletedBy, user.FieldEmail, user.FieldFirstName, user.FieldLastName, user.FieldDisplayName, user.FieldAvatarRemoteURL, user.FieldAvatarLocalFile, user.FieldPassword, user.FieldSub:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldAvatarUpdatedAt, user.FieldLastSeen:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldAvatarUpdatedAt, user.FieldLastSeen, user.FieldPasswordChangedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -282,6 +337,21 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.Password = new(string)
 				*u.Password = value.String
 			}
+		case user.FieldPasswordHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.PasswordHistory); err != nil {
+					return fmt.Errorf("unmarshal field password_history: %w", err)
+				}
+			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				u.PasswordChangedAt = new(time.Time)
+				*u.PasswordChangedAt = value.Time
+			}
 		case user.FieldSub:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sub", values[i])
@@ -342,6 +412,26 @@ func (u *User) QueryResetTokens() *PasswordResetTokenQuery {
 	return NewUserClient(u.config).QueryResetTokens(u)
 }
 
+// QueryMagicLinkTokens queries the "magic_link_tokens" edge of the User entity.
+func (u *User) QueryMagicLinkTokens() *MagicLinkTokenQuery {
+	return NewUserClient(u.config).QueryMagicLinkTokens(u)
+}
+
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (u *User) QueryWebauthnCredentials() *WebauthnCredentialQuery {
+	return NewUserClient(u.config).QueryWebauthnCredentials(u)
+}
+
+// QueryOrgMemberships queries the "org_memberships" edge of the User entity.
+func (u *User) QueryOrgMemberships() *OrgMembershipQuery {
+	return NewUserClient(u.config).QueryOrgMemberships(u)
+}
+
+// QueryGroupMemberships queries the "group_memberships" edge of the User entity.
+func (u *User) QueryGroupMemberships() *GroupMembershipQuery {
+	return NewUserClient(u.config).QueryGroupMemberships(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -417,6 +507,13 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("password_history=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("sub=")
 	builder.WriteString(u.Sub)
 	builder.WriteString(", ")
@@ -570,5 +667,101 @@ func (u *User) appendNamedResetTokens(name string, edges ...*PasswordResetToken)
 	}
 }
 
+// NamedMagicLinkTokens returns the MagicLinkTokens named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedMagicLinkTokens(name string) ([]*MagicLinkToken, error) {
+	if u.Edges.namedMagicLinkTokens == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedMagicLinkTokens[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedMagicLinkTokens(name string, edges ...*MagicLinkToken) {
+	if u.Edges.namedMagicLinkTokens == nil {
+		u.Edges.namedMagicLinkTokens = make(map[string][]*MagicLinkToken)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedMagicLinkTokens[name] = []*MagicLinkToken{}
+	} else {
+		u.Edges.namedMagicLinkTokens[name] = append(u.Edges.namedMagicLinkTokens[name], edges...)
+	}
+}
+
+// NamedWebauthnCredentials returns the WebauthnCredentials named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedWebauthnCredentials(name string) ([]*WebauthnCredential, error) {
+	if u.Edges.namedWebauthnCredentials == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedWebauthnCredentials[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedWebauthnCredentials(name string, edges ...*WebauthnCredential) {
+	if u.Edges.namedWebauthnCredentials == nil {
+		u.Edges.namedWebauthnCredentials = make(map[string][]*WebauthnCredential)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedWebauthnCredentials[name] = []*WebauthnCredential{}
+	} else {
+		u.Edges.namedWebauthnCredentials[name] = append(u.Edges.namedWebauthnCredentials[name], edges...)
+	}
+}
+
+// NamedOrgMemberships returns the OrgMemberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedOrgMemberships(name string) ([]*OrgMembership, error) {
+	if u.Edges.namedOrgMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedOrgMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedOrgMemberships(name string, edges ...*OrgMembership) {
+	if u.Edges.namedOrgMemberships == nil {
+		u.Edges.namedOrgMemberships = make(map[string][]*OrgMembership)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedOrgMemberships[name] = []*OrgMembership{}
+	} else {
+		u.Edges.namedOrgMemberships[name] = append(u.Edges.namedOrgMemberships[name], edges...)
+	}
+}
+
+// NamedGroupMemberships returns the GroupMemberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedGroupMemberships(name string) ([]*GroupMembership, error) {
+	if u.Edges.namedGroupMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedGroupMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedGroupMemberships(name string, edges ...*GroupMembership) {
+	if u.Edges.namedGroupMemberships == nil {
+		u.Edges.namedGroupMemberships = make(map[string][]*GroupMembership)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedGroupMemberships[name] = []*GroupMembership{}
+	} else {
+		u.Edges.namedGroupMemberships[name] = append(u.Edges.namedGroupMemberships[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
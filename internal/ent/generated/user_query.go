@@ -14,13 +14,17 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
 	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -39,6 +43,10 @@ type UserQuery struct {
 	withSetting                      *UserSettingQuery
 	withEmailVerificationTokens      *EmailVerificationTokenQuery
 	withResetTokens                  *PasswordResetTokenQuery
+	withMagicLinkTokens              *MagicLinkTokenQuery
+	withWebauthnCredentials          *WebauthnCredentialQuery
+	withOrgMemberships               *OrgMembershipQuery
+	withGroupMemberships             *GroupMembershipQuery
 	modifiers                        []func(*sql.Selector)
 	loadTotal                        []func(context.Context, []*User) error
 	withNamedOrganizations           map[string]*OrganizationQuery
@@ -47,6 +55,10 @@ type UserQuery struct {
 	withNamedPersonalAccessTokens    map[string]*PersonalAccessTokenQuery
 	withNamedEmailVerificationTokens map[string]*EmailVerificationTokenQuery
 	withNamedResetTokens             map[string]*PasswordResetTokenQuery
+	withNamedMagicLinkTokens         map[string]*MagicLinkTokenQuery
+	withNamedWebauthnCredentials     map[string]*WebauthnCredentialQuery
+	withNamedOrgMemberships          map[string]*OrgMembershipQuery
+	withNamedGroupMemberships        map[string]*GroupMembershipQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -101,7 +113,7 @@ func (uq *UserQuery) QueryOrganizations() *OrganizationQuery {
 		)
 		schemaConfig := uq.schemaConfig
 		step.To.Schema = schemaConfig.Organization
-		step.Edge.Schema = schemaConfig.UserOrganizations
+		step.Edge.Schema = schemaConfig.OrgMembership
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
 	}
@@ -151,7 +163,7 @@ func (uq *UserQuery) QueryGroups() *GroupQuery {
 		)
 		schemaConfig := uq.schemaConfig
 		step.To.Schema = schemaConfig.Group
-		step.Edge.Schema = schemaConfig.GroupUsers
+		step.Edge.Schema = schemaConfig.GroupMembership
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
 	}
@@ -258,6 +270,106 @@ func (uq *UserQuery) QueryResetTokens() *PasswordResetTokenQuery {
 	return query
 }
 
+// QueryMagicLinkTokens chains the current query on the "magic_link_tokens" edge.
+func (uq *UserQuery) QueryMagicLinkTokens() *MagicLinkTokenQuery {
+	query := (&MagicLinkTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(magiclinktoken.Table, magiclinktoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MagicLinkTokensTable, user.MagicLinkTokensColumn),
+		)
+		schemaConfig := uq.schemaConfig
+		step.To.Schema = schemaConfig.MagicLinkToken
+		step.Edge.Schema = schemaConfig.MagicLinkToken
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebauthnCredentials chains the current query on the "webauthn_credentials" edge.
+func (uq *UserQuery) QueryWebauthnCredentials() *WebauthnCredentialQuery {
+	query := (&WebauthnCredentialClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(webauthncredential.Table, webauthncredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebauthnCredentialsTable, user.WebauthnCredentialsColumn),
+		)
+		schemaConfig := uq.schemaConfig
+		step.To.Schema = schemaConfig.WebauthnCredential
+		step.Edge.Schema = schemaConfig.WebauthnCredential
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrgMemberships chains the current query on the "org_memberships" edge.
+func (uq *UserQuery) QueryOrgMemberships() *OrgMembershipQuery {
+	query := (&OrgMembershipClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(orgmembership.Table, orgmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.OrgMembershipsTable, user.OrgMembershipsColumn),
+		)
+		schemaConfig := uq.schemaConfig
+		step.To.Schema = schemaConfig.OrgMembership
+		step.Edge.Schema = schemaConfig.OrgMembership
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupMemberships chains the current query on the "group_memberships" edge.
+func (uq *UserQuery) QueryGroupMemberships() *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.GroupMembershipsTable, user.GroupMembershipsColumn),
+		)
+		schemaConfig := uq.schemaConfig
+		step.To.Schema = schemaConfig.GroupMembership
+		step.Edge.Schema = schemaConfig.GroupMembership
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -457,6 +569,10 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withSetting:                 uq.withSetting.Clone(),
 		withEmailVerificationTokens: uq.withEmailVerificationTokens.Clone(),
 		withResetTokens:             uq.withResetTokens.Clone(),
+		withMagicLinkTokens:         uq.withMagicLinkTokens.Clone(),
+		withWebauthnCredentials:     uq.withWebauthnCredentials.Clone(),
+		withOrgMemberships:          uq.withOrgMemberships.Clone(),
+		withGroupMemberships:        uq.withGroupMemberships.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -540,6 +656,50 @@ func (uq *UserQuery) WithResetTokens(opts ...func(*PasswordResetTokenQuery)) *Us
 	return uq
 }
 
+// WithMagicLinkTokens tells the query-builder to eager-load the nodes that are connected to
+// the "magic_link_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMagicLinkTokens(opts ...func(*MagicLinkTokenQuery)) *UserQuery {
+	query := (&MagicLinkTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMagicLinkTokens = query
+	return uq
+}
+
+// WithWebauthnCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "webauthn_credentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithWebauthnCredentials(opts ...func(*WebauthnCredentialQuery)) *UserQuery {
+	query := (&WebauthnCredentialClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withWebauthnCredentials = query
+	return uq
+}
+
+// WithOrgMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "org_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithOrgMemberships(opts ...func(*OrgMembershipQuery)) *UserQuery {
+	query := (&OrgMembershipClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrgMemberships = query
+	return uq
+}
+
+// WithGroupMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "group_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGroupMemberships(opts ...func(*GroupMembershipQuery)) *UserQuery {
+	query := (&GroupMembershipClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupMemberships = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -624,7 +784,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [11]bool{
 			uq.withOrganizations != nil,
 			uq.withSessions != nil,
 			uq.withGroups != nil,
@@ -632,6 +792,10 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			uq.withSetting != nil,
 			uq.withEmailVerificationTokens != nil,
 			uq.withResetTokens != nil,
+			uq.withMagicLinkTokens != nil,
+			uq.withWebauthnCredentials != nil,
+			uq.withOrgMemberships != nil,
+			uq.withGroupMemberships != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -709,6 +873,36 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withMagicLinkTokens; query != nil {
+		if err := uq.loadMagicLinkTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.MagicLinkTokens = []*MagicLinkToken{} },
+			func(n *User, e *MagicLinkToken) { n.Edges.MagicLinkTokens = append(n.Edges.MagicLinkTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withWebauthnCredentials; query != nil {
+		if err := uq.loadWebauthnCredentials(ctx, query, nodes,
+			func(n *User) { n.Edges.WebauthnCredentials = []*WebauthnCredential{} },
+			func(n *User, e *WebauthnCredential) {
+				n.Edges.WebauthnCredentials = append(n.Edges.WebauthnCredentials, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withOrgMemberships; query != nil {
+		if err := uq.loadOrgMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.OrgMemberships = []*OrgMembership{} },
+			func(n *User, e *OrgMembership) { n.Edges.OrgMemberships = append(n.Edges.OrgMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroupMemberships; query != nil {
+		if err := uq.loadGroupMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupMemberships = []*GroupMembership{} },
+			func(n *User, e *GroupMembership) { n.Edges.GroupMemberships = append(n.Edges.GroupMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range uq.withNamedOrganizations {
 		if err := uq.loadOrganizations(ctx, query, nodes,
 			func(n *User) { n.appendNamedOrganizations(name) },
@@ -751,6 +945,34 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range uq.withNamedMagicLinkTokens {
+		if err := uq.loadMagicLinkTokens(ctx, query, nodes,
+			func(n *User) { n.appendNamedMagicLinkTokens(name) },
+			func(n *User, e *MagicLinkToken) { n.appendNamedMagicLinkTokens(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedWebauthnCredentials {
+		if err := uq.loadWebauthnCredentials(ctx, query, nodes,
+			func(n *User) { n.appendNamedWebauthnCredentials(name) },
+			func(n *User, e *WebauthnCredential) { n.appendNamedWebauthnCredentials(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedOrgMemberships {
+		if err := uq.loadOrgMemberships(ctx, query, nodes,
+			func(n *User) { n.appendNamedOrgMemberships(name) },
+			func(n *User, e *OrgMembership) { n.appendNamedOrgMemberships(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedGroupMemberships {
+		if err := uq.loadGroupMemberships(ctx, query, nodes,
+			func(n *User) { n.appendNamedGroupMemberships(name) },
+			func(n *User, e *GroupMembership) { n.appendNamedGroupMemberships(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range uq.loadTotal {
 		if err := uq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -772,7 +994,7 @@ func (uq *UserQuery) loadOrganizations(ctx context.Context, query *OrganizationQ
 	}
 	query.Where(func(s *sql.Selector) {
 		joinT := sql.Table(user.OrganizationsTable)
-		joinT.Schema(uq.schemaConfig.UserOrganizations)
+		joinT.Schema(uq.schemaConfig.OrgMembership)
 		s.Join(joinT).On(s.C(organization.FieldID), joinT.C(user.OrganizationsPrimaryKey[1]))
 		s.Where(sql.InValues(joinT.C(user.OrganizationsPrimaryKey[0]), edgeIDs...))
 		columns := s.SelectedColumns()
@@ -864,7 +1086,7 @@ func (uq *UserQuery) loadGroups(ctx context.Context, query *GroupQuery, nodes []
 	}
 	query.Where(func(s *sql.Selector) {
 		joinT := sql.Table(user.GroupsTable)
-		joinT.Schema(uq.schemaConfig.GroupUsers)
+		joinT.Schema(uq.schemaConfig.GroupMembership)
 		s.Join(joinT).On(s.C(group.FieldID), joinT.C(user.GroupsPrimaryKey[0]))
 		s.Where(sql.InValues(joinT.C(user.GroupsPrimaryKey[1]), edgeIDs...))
 		columns := s.SelectedColumns()
@@ -1033,6 +1255,127 @@ func (uq *UserQuery) loadResetTokens(ctx context.Context, query *PasswordResetTo
 	}
 	return nil
 }
+func (uq *UserQuery) loadMagicLinkTokens(ctx context.Context, query *MagicLinkTokenQuery, nodes []*User, init func(*User), assign func(*User, *MagicLinkToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MagicLinkToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MagicLinkTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_magic_link_tokens
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_magic_link_tokens" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_magic_link_tokens" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadWebauthnCredentials(ctx context.Context, query *WebauthnCredentialQuery, nodes []*User, init func(*User), assign func(*User, *WebauthnCredential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webauthncredential.FieldOwnerID)
+	}
+	query.Where(predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WebauthnCredentialsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadOrgMemberships(ctx context.Context, query *OrgMembershipQuery, nodes []*User, init func(*User), assign func(*User, *OrgMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orgmembership.FieldUserID)
+	}
+	query.Where(predicate.OrgMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OrgMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGroupMemberships(ctx context.Context, query *GroupMembershipQuery, nodes []*User, init func(*User), assign func(*User, *GroupMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupmembership.FieldUserID)
+	}
+	query.Where(predicate.GroupMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
@@ -1207,6 +1550,62 @@ func (uq *UserQuery) WithNamedResetTokens(name string, opts ...func(*PasswordRes
 	return uq
 }
 
+// WithNamedMagicLinkTokens tells the query-builder to eager-load the nodes that are connected to the "magic_link_tokens"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedMagicLinkTokens(name string, opts ...func(*MagicLinkTokenQuery)) *UserQuery {
+	query := (&MagicLinkTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedMagicLinkTokens == nil {
+		uq.withNamedMagicLinkTokens = make(map[string]*MagicLinkTokenQuery)
+	}
+	uq.withNamedMagicLinkTokens[name] = query
+	return uq
+}
+
+// WithNamedWebauthnCredentials tells the query-builder to eager-load the nodes that are connected to the "webauthn_credentials"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedWebauthnCredentials(name string, opts ...func(*WebauthnCredentialQuery)) *UserQuery {
+	query := (&WebauthnCredentialClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedWebauthnCredentials == nil {
+		uq.withNamedWebauthnCredentials = make(map[string]*WebauthnCredentialQuery)
+	}
+	uq.withNamedWebauthnCredentials[name] = query
+	return uq
+}
+
+// WithNamedOrgMemberships tells the query-builder to eager-load the nodes that are connected to the "org_memberships"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedOrgMemberships(name string, opts ...func(*OrgMembershipQuery)) *UserQuery {
+	query := (&OrgMembershipClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedOrgMemberships == nil {
+		uq.withNamedOrgMemberships = make(map[string]*OrgMembershipQuery)
+	}
+	uq.withNamedOrgMemberships[name] = query
+	return uq
+}
+
+// WithNamedGroupMemberships tells the query-builder to eager-load the nodes that are connected to the "group_memberships"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedGroupMemberships(name string, opts ...func(*GroupMembershipQuery)) *UserQuery {
+	query := (&GroupMembershipClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedGroupMemberships == nil {
+		uq.withNamedGroupMemberships = make(map[string]*GroupMembershipQuery)
+	}
+	uq.withNamedGroupMemberships[name] = query
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -49,6 +49,24 @@ type UserSetting struct {
 	EmailConfirmed bool `json:"email_confirmed,omitempty"`
 	// tags associated with the object
 	Tags []string `json:"tags,omitempty"`
+	// whether the user has confirmed enrollment in totp multi-factor authentication
+	IsTfaEnabled bool `json:"is_tfa_enabled,omitempty"`
+	// the totp secret, set on enrollment and required to be confirmed before it is enabled
+	TfaSecret *string `json:"-"`
+	// hashes of the one-time multi-factor recovery codes, codes are removed once used
+	RecoveryCodes []string `json:"-"`
+	// the totp time step of the last accepted passcode, passcodes for the same or an earlier step are rejected
+	TfaLastStep *int64 `json:"tfa_last_step,omitempty"`
+	// the number of consecutive failed logins, reset on a successful login
+	FailedLoginAttempts int `json:"failed_login_attempts,omitempty"`
+	// logins are rejected until this time after repeated failed logins
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// the token emailed to the user to unlock the account after it was locked by failed logins
+	UnlockToken *string `json:"-"`
+	// the secret used to verify the unlock token
+	UnlockTokenSecret *[]byte `json:"-"`
+	// the time the unlock token expires
+	UnlockTokenExpiresAt *time.Time `json:"unlock_token_expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserSettingQuery when eager-loading is set.
 	Edges        UserSettingEdges `json:"edges"`
@@ -85,13 +103,15 @@ func (*UserSetting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case usersetting.FieldPermissions, usersetting.FieldTags:
+		case usersetting.FieldPermissions, usersetting.FieldTags, usersetting.FieldRecoveryCodes, usersetting.FieldUnlockTokenSecret:
 			values[i] = new([]byte)
-		case usersetting.FieldLocked, usersetting.FieldEmailConfirmed:
+		case usersetting.FieldLocked, usersetting.FieldEmailConfirmed, usersetting.FieldIsTfaEnabled:
 			values[i] = new(sql.NullBool)
-		case usersetting.FieldID, usersetting.FieldCreatedBy, usersetting.FieldUpdatedBy, usersetting.FieldDeletedBy, usersetting.FieldRecoveryCode, usersetting.FieldStatus, usersetting.FieldRole:
+		case usersetting.FieldTfaLastStep, usersetting.FieldFailedLoginAttempts:
+			values[i] = new(sql.NullInt64)
+		case usersetting.FieldID, usersetting.FieldCreatedBy, usersetting.FieldUpdatedBy, usersetting.FieldDeletedBy, usersetting.FieldRecoveryCode, usersetting.FieldStatus, usersetting.FieldRole, usersetting.FieldTfaSecret, usersetting.FieldUnlockToken:
 			values[i] = new(sql.NullString)
-		case usersetting.FieldCreatedAt, usersetting.FieldUpdatedAt, usersetting.FieldDeletedAt, usersetting.FieldSilencedAt, usersetting.FieldSuspendedAt:
+		case usersetting.FieldCreatedAt, usersetting.FieldUpdatedAt, usersetting.FieldDeletedAt, usersetting.FieldSilencedAt, usersetting.FieldSuspendedAt, usersetting.FieldLockedUntil, usersetting.FieldUnlockTokenExpiresAt:
 			values[i] = new(sql.NullTime)
 		case usersetting.ForeignKeys[0]: // user_setting
 			values[i] = new(sql.NullString)
@@ -213,6 +233,67 @@ func (us *UserSetting) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case usersetting.FieldIsTfaEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_tfa_enabled", values[i])
+			} else if value.Valid {
+				us.IsTfaEnabled = value.Bool
+			}
+		case usersetting.FieldTfaSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tfa_secret", values[i])
+			} else if value.Valid {
+				us.TfaSecret = new(string)
+				*us.TfaSecret = value.String
+			}
+		case usersetting.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &us.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case usersetting.FieldTfaLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tfa_last_step", values[i])
+			} else if value.Valid {
+				us.TfaLastStep = new(int64)
+				*us.TfaLastStep = value.Int64
+			}
+		case usersetting.FieldFailedLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_attempts", values[i])
+			} else if value.Valid {
+				us.FailedLoginAttempts = int(value.Int64)
+			}
+		case usersetting.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				us.LockedUntil = new(time.Time)
+				*us.LockedUntil = value.Time
+			}
+		case usersetting.FieldUnlockToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unlock_token", values[i])
+			} else if value.Valid {
+				us.UnlockToken = new(string)
+				*us.UnlockToken = value.String
+			}
+		case usersetting.FieldUnlockTokenSecret:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unlock_token_secret", values[i])
+			} else if value != nil {
+				us.UnlockTokenSecret = value
+			}
+		case usersetting.FieldUnlockTokenExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unlock_token_expires_at", values[i])
+			} else if value.Valid {
+				us.UnlockTokenExpiresAt = new(time.Time)
+				*us.UnlockTokenExpiresAt = value.Time
+			}
 		case usersetting.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_setting", values[i])
@@ -308,6 +389,35 @@ func (us *UserSetting) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("tags=")
 	builder.WriteString(fmt.Sprintf("%v", us.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("is_tfa_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", us.IsTfaEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("tfa_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	if v := us.TfaLastStep; v != nil {
+		builder.WriteString("tfa_last_step=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", us.FailedLoginAttempts))
+	builder.WriteString(", ")
+	if v := us.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unlock_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("unlock_token_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := us.UnlockTokenExpiresAt; v != nil {
+		builder.WriteString("unlock_token_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
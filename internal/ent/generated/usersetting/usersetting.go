@@ -48,6 +48,24 @@ const (
 	FieldEmailConfirmed = "email_confirmed"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldIsTfaEnabled holds the string denoting the is_tfa_enabled field in the database.
+	FieldIsTfaEnabled = "is_tfa_enabled"
+	// FieldTfaSecret holds the string denoting the tfa_secret field in the database.
+	FieldTfaSecret = "tfa_secret"
+	// FieldRecoveryCodes holds the string denoting the recovery_codes field in the database.
+	FieldRecoveryCodes = "recovery_codes"
+	// FieldTfaLastStep holds the string denoting the tfa_last_step field in the database.
+	FieldTfaLastStep = "tfa_last_step"
+	// FieldFailedLoginAttempts holds the string denoting the failed_login_attempts field in the database.
+	FieldFailedLoginAttempts = "failed_login_attempts"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldUnlockToken holds the string denoting the unlock_token field in the database.
+	FieldUnlockToken = "unlock_token"
+	// FieldUnlockTokenSecret holds the string denoting the unlock_token_secret field in the database.
+	FieldUnlockTokenSecret = "unlock_token_secret"
+	// FieldUnlockTokenExpiresAt holds the string denoting the unlock_token_expires_at field in the database.
+	FieldUnlockTokenExpiresAt = "unlock_token_expires_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the usersetting in the database.
@@ -79,6 +97,15 @@ var Columns = []string{
 	FieldPermissions,
 	FieldEmailConfirmed,
 	FieldTags,
+	FieldIsTfaEnabled,
+	FieldTfaSecret,
+	FieldRecoveryCodes,
+	FieldTfaLastStep,
+	FieldFailedLoginAttempts,
+	FieldLockedUntil,
+	FieldUnlockToken,
+	FieldUnlockTokenSecret,
+	FieldUnlockTokenExpiresAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "user_settings"
@@ -108,8 +135,9 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/datumforge/datum/internal/ent/generated/runtime"
 var (
-	Hooks        [2]ent.Hook
+	Hooks        [3]ent.Hook
 	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -124,6 +152,12 @@ var (
 	DefaultEmailConfirmed bool
 	// DefaultTags holds the default value on creation for the "tags" field.
 	DefaultTags []string
+	// DefaultIsTfaEnabled holds the default value on creation for the "is_tfa_enabled" field.
+	DefaultIsTfaEnabled bool
+	// DefaultFailedLoginAttempts holds the default value on creation for the "failed_login_attempts" field.
+	DefaultFailedLoginAttempts int
+	// FailedLoginAttemptsValidator is a validator for the "failed_login_attempts" field. It is called by the builders before save.
+	FailedLoginAttemptsValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -256,6 +290,41 @@ func ByEmailConfirmed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmailConfirmed, opts...).ToFunc()
 }
 
+// ByIsTfaEnabled orders the results by the is_tfa_enabled field.
+func ByIsTfaEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsTfaEnabled, opts...).ToFunc()
+}
+
+// ByTfaSecret orders the results by the tfa_secret field.
+func ByTfaSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTfaSecret, opts...).ToFunc()
+}
+
+// ByTfaLastStep orders the results by the tfa_last_step field.
+func ByTfaLastStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTfaLastStep, opts...).ToFunc()
+}
+
+// ByFailedLoginAttempts orders the results by the failed_login_attempts field.
+func ByFailedLoginAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedLoginAttempts, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByUnlockToken orders the results by the unlock_token field.
+func ByUnlockToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnlockToken, opts...).ToFunc()
+}
+
+// ByUnlockTokenExpiresAt orders the results by the unlock_token_expires_at field.
+func ByUnlockTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnlockTokenExpiresAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
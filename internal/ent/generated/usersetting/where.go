@@ -122,6 +122,46 @@ func EmailConfirmed(v bool) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldEQ(FieldEmailConfirmed, v))
 }
 
+// IsTfaEnabled applies equality check predicate on the "is_tfa_enabled" field. It's identical to IsTfaEnabledEQ.
+func IsTfaEnabled(v bool) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldIsTfaEnabled, v))
+}
+
+// TfaSecret applies equality check predicate on the "tfa_secret" field. It's identical to TfaSecretEQ.
+func TfaSecret(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldTfaSecret, v))
+}
+
+// TfaLastStep applies equality check predicate on the "tfa_last_step" field. It's identical to TfaLastStepEQ.
+func TfaLastStep(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldTfaLastStep, v))
+}
+
+// FailedLoginAttempts applies equality check predicate on the "failed_login_attempts" field. It's identical to FailedLoginAttemptsEQ.
+func FailedLoginAttempts(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// UnlockToken applies equality check predicate on the "unlock_token" field. It's identical to UnlockTokenEQ.
+func UnlockToken(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockToken, v))
+}
+
+// UnlockTokenSecret applies equality check predicate on the "unlock_token_secret" field. It's identical to UnlockTokenSecretEQ.
+func UnlockTokenSecret(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenExpiresAt applies equality check predicate on the "unlock_token_expires_at" field. It's identical to UnlockTokenExpiresAtEQ.
+func UnlockTokenExpiresAt(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockTokenExpiresAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldEQ(FieldCreatedAt, v))
@@ -712,6 +752,416 @@ func EmailConfirmedNEQ(v bool) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldNEQ(FieldEmailConfirmed, v))
 }
 
+// IsTfaEnabledEQ applies the EQ predicate on the "is_tfa_enabled" field.
+func IsTfaEnabledEQ(v bool) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldIsTfaEnabled, v))
+}
+
+// IsTfaEnabledNEQ applies the NEQ predicate on the "is_tfa_enabled" field.
+func IsTfaEnabledNEQ(v bool) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldIsTfaEnabled, v))
+}
+
+// TfaSecretEQ applies the EQ predicate on the "tfa_secret" field.
+func TfaSecretEQ(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldTfaSecret, v))
+}
+
+// TfaSecretNEQ applies the NEQ predicate on the "tfa_secret" field.
+func TfaSecretNEQ(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldTfaSecret, v))
+}
+
+// TfaSecretIn applies the In predicate on the "tfa_secret" field.
+func TfaSecretIn(vs ...string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldTfaSecret, vs...))
+}
+
+// TfaSecretNotIn applies the NotIn predicate on the "tfa_secret" field.
+func TfaSecretNotIn(vs ...string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldTfaSecret, vs...))
+}
+
+// TfaSecretGT applies the GT predicate on the "tfa_secret" field.
+func TfaSecretGT(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldTfaSecret, v))
+}
+
+// TfaSecretGTE applies the GTE predicate on the "tfa_secret" field.
+func TfaSecretGTE(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldTfaSecret, v))
+}
+
+// TfaSecretLT applies the LT predicate on the "tfa_secret" field.
+func TfaSecretLT(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldTfaSecret, v))
+}
+
+// TfaSecretLTE applies the LTE predicate on the "tfa_secret" field.
+func TfaSecretLTE(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldTfaSecret, v))
+}
+
+// TfaSecretContains applies the Contains predicate on the "tfa_secret" field.
+func TfaSecretContains(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldContains(FieldTfaSecret, v))
+}
+
+// TfaSecretHasPrefix applies the HasPrefix predicate on the "tfa_secret" field.
+func TfaSecretHasPrefix(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldHasPrefix(FieldTfaSecret, v))
+}
+
+// TfaSecretHasSuffix applies the HasSuffix predicate on the "tfa_secret" field.
+func TfaSecretHasSuffix(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldHasSuffix(FieldTfaSecret, v))
+}
+
+// TfaSecretIsNil applies the IsNil predicate on the "tfa_secret" field.
+func TfaSecretIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldTfaSecret))
+}
+
+// TfaSecretNotNil applies the NotNil predicate on the "tfa_secret" field.
+func TfaSecretNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldTfaSecret))
+}
+
+// TfaSecretEqualFold applies the EqualFold predicate on the "tfa_secret" field.
+func TfaSecretEqualFold(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEqualFold(FieldTfaSecret, v))
+}
+
+// TfaSecretContainsFold applies the ContainsFold predicate on the "tfa_secret" field.
+func TfaSecretContainsFold(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldContainsFold(FieldTfaSecret, v))
+}
+
+// RecoveryCodesIsNil applies the IsNil predicate on the "recovery_codes" field.
+func RecoveryCodesIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldRecoveryCodes))
+}
+
+// RecoveryCodesNotNil applies the NotNil predicate on the "recovery_codes" field.
+func RecoveryCodesNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldRecoveryCodes))
+}
+
+// TfaLastStepEQ applies the EQ predicate on the "tfa_last_step" field.
+func TfaLastStepEQ(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldTfaLastStep, v))
+}
+
+// TfaLastStepNEQ applies the NEQ predicate on the "tfa_last_step" field.
+func TfaLastStepNEQ(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldTfaLastStep, v))
+}
+
+// TfaLastStepIn applies the In predicate on the "tfa_last_step" field.
+func TfaLastStepIn(vs ...int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldTfaLastStep, vs...))
+}
+
+// TfaLastStepNotIn applies the NotIn predicate on the "tfa_last_step" field.
+func TfaLastStepNotIn(vs ...int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldTfaLastStep, vs...))
+}
+
+// TfaLastStepGT applies the GT predicate on the "tfa_last_step" field.
+func TfaLastStepGT(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldTfaLastStep, v))
+}
+
+// TfaLastStepGTE applies the GTE predicate on the "tfa_last_step" field.
+func TfaLastStepGTE(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldTfaLastStep, v))
+}
+
+// TfaLastStepLT applies the LT predicate on the "tfa_last_step" field.
+func TfaLastStepLT(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldTfaLastStep, v))
+}
+
+// TfaLastStepLTE applies the LTE predicate on the "tfa_last_step" field.
+func TfaLastStepLTE(v int64) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldTfaLastStep, v))
+}
+
+// TfaLastStepIsNil applies the IsNil predicate on the "tfa_last_step" field.
+func TfaLastStepIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldTfaLastStep))
+}
+
+// TfaLastStepNotNil applies the NotNil predicate on the "tfa_last_step" field.
+func TfaLastStepNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldTfaLastStep))
+}
+
+// FailedLoginAttemptsEQ applies the EQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsEQ(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsNEQ applies the NEQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNEQ(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsIn applies the In predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsIn(vs ...int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsNotIn applies the NotIn predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNotIn(vs ...int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsGT applies the GT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGT(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsGTE applies the GTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGTE(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLT applies the LT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLT(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLTE applies the LTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLTE(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldFailedLoginAttempts, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// UnlockTokenEQ applies the EQ predicate on the "unlock_token" field.
+func UnlockTokenEQ(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockToken, v))
+}
+
+// UnlockTokenNEQ applies the NEQ predicate on the "unlock_token" field.
+func UnlockTokenNEQ(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldUnlockToken, v))
+}
+
+// UnlockTokenIn applies the In predicate on the "unlock_token" field.
+func UnlockTokenIn(vs ...string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldUnlockToken, vs...))
+}
+
+// UnlockTokenNotIn applies the NotIn predicate on the "unlock_token" field.
+func UnlockTokenNotIn(vs ...string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldUnlockToken, vs...))
+}
+
+// UnlockTokenGT applies the GT predicate on the "unlock_token" field.
+func UnlockTokenGT(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldUnlockToken, v))
+}
+
+// UnlockTokenGTE applies the GTE predicate on the "unlock_token" field.
+func UnlockTokenGTE(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldUnlockToken, v))
+}
+
+// UnlockTokenLT applies the LT predicate on the "unlock_token" field.
+func UnlockTokenLT(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldUnlockToken, v))
+}
+
+// UnlockTokenLTE applies the LTE predicate on the "unlock_token" field.
+func UnlockTokenLTE(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldUnlockToken, v))
+}
+
+// UnlockTokenContains applies the Contains predicate on the "unlock_token" field.
+func UnlockTokenContains(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldContains(FieldUnlockToken, v))
+}
+
+// UnlockTokenHasPrefix applies the HasPrefix predicate on the "unlock_token" field.
+func UnlockTokenHasPrefix(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldHasPrefix(FieldUnlockToken, v))
+}
+
+// UnlockTokenHasSuffix applies the HasSuffix predicate on the "unlock_token" field.
+func UnlockTokenHasSuffix(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldHasSuffix(FieldUnlockToken, v))
+}
+
+// UnlockTokenIsNil applies the IsNil predicate on the "unlock_token" field.
+func UnlockTokenIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldUnlockToken))
+}
+
+// UnlockTokenNotNil applies the NotNil predicate on the "unlock_token" field.
+func UnlockTokenNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldUnlockToken))
+}
+
+// UnlockTokenEqualFold applies the EqualFold predicate on the "unlock_token" field.
+func UnlockTokenEqualFold(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEqualFold(FieldUnlockToken, v))
+}
+
+// UnlockTokenContainsFold applies the ContainsFold predicate on the "unlock_token" field.
+func UnlockTokenContainsFold(v string) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldContainsFold(FieldUnlockToken, v))
+}
+
+// UnlockTokenSecretEQ applies the EQ predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretEQ(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretNEQ applies the NEQ predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretNEQ(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretIn applies the In predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretIn(vs ...[]byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldUnlockTokenSecret, vs...))
+}
+
+// UnlockTokenSecretNotIn applies the NotIn predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretNotIn(vs ...[]byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldUnlockTokenSecret, vs...))
+}
+
+// UnlockTokenSecretGT applies the GT predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretGT(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretGTE applies the GTE predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretGTE(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretLT applies the LT predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretLT(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretLTE applies the LTE predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretLTE(v []byte) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldUnlockTokenSecret, v))
+}
+
+// UnlockTokenSecretIsNil applies the IsNil predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldUnlockTokenSecret))
+}
+
+// UnlockTokenSecretNotNil applies the NotNil predicate on the "unlock_token_secret" field.
+func UnlockTokenSecretNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldUnlockTokenSecret))
+}
+
+// UnlockTokenExpiresAtEQ applies the EQ predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtNEQ applies the NEQ predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtNEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtIn applies the In predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldUnlockTokenExpiresAt, vs...))
+}
+
+// UnlockTokenExpiresAtNotIn applies the NotIn predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtNotIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldUnlockTokenExpiresAt, vs...))
+}
+
+// UnlockTokenExpiresAtGT applies the GT predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtGT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtGTE applies the GTE predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtGTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtLT applies the LT predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtLT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtLTE applies the LTE predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtLTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldUnlockTokenExpiresAt, v))
+}
+
+// UnlockTokenExpiresAtIsNil applies the IsNil predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldUnlockTokenExpiresAt))
+}
+
+// UnlockTokenExpiresAtNotNil applies the NotNil predicate on the "unlock_token_expires_at" field.
+func UnlockTokenExpiresAtNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldUnlockTokenExpiresAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserSetting {
 	return predicate.UserSetting(func(s *sql.Selector) {
@@ -215,6 +215,116 @@ func (usc *UserSettingCreate) SetTags(s []string) *UserSettingCreate {
 	return usc
 }
 
+// SetIsTfaEnabled sets the "is_tfa_enabled" field.
+func (usc *UserSettingCreate) SetIsTfaEnabled(b bool) *UserSettingCreate {
+	usc.mutation.SetIsTfaEnabled(b)
+	return usc
+}
+
+// SetNillableIsTfaEnabled sets the "is_tfa_enabled" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableIsTfaEnabled(b *bool) *UserSettingCreate {
+	if b != nil {
+		usc.SetIsTfaEnabled(*b)
+	}
+	return usc
+}
+
+// SetTfaSecret sets the "tfa_secret" field.
+func (usc *UserSettingCreate) SetTfaSecret(s string) *UserSettingCreate {
+	usc.mutation.SetTfaSecret(s)
+	return usc
+}
+
+// SetNillableTfaSecret sets the "tfa_secret" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableTfaSecret(s *string) *UserSettingCreate {
+	if s != nil {
+		usc.SetTfaSecret(*s)
+	}
+	return usc
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (usc *UserSettingCreate) SetRecoveryCodes(s []string) *UserSettingCreate {
+	usc.mutation.SetRecoveryCodes(s)
+	return usc
+}
+
+// SetTfaLastStep sets the "tfa_last_step" field.
+func (usc *UserSettingCreate) SetTfaLastStep(i int64) *UserSettingCreate {
+	usc.mutation.SetTfaLastStep(i)
+	return usc
+}
+
+// SetNillableTfaLastStep sets the "tfa_last_step" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableTfaLastStep(i *int64) *UserSettingCreate {
+	if i != nil {
+		usc.SetTfaLastStep(*i)
+	}
+	return usc
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usc *UserSettingCreate) SetFailedLoginAttempts(i int) *UserSettingCreate {
+	usc.mutation.SetFailedLoginAttempts(i)
+	return usc
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableFailedLoginAttempts(i *int) *UserSettingCreate {
+	if i != nil {
+		usc.SetFailedLoginAttempts(*i)
+	}
+	return usc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (usc *UserSettingCreate) SetLockedUntil(t time.Time) *UserSettingCreate {
+	usc.mutation.SetLockedUntil(t)
+	return usc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableLockedUntil(t *time.Time) *UserSettingCreate {
+	if t != nil {
+		usc.SetLockedUntil(*t)
+	}
+	return usc
+}
+
+// SetUnlockToken sets the "unlock_token" field.
+func (usc *UserSettingCreate) SetUnlockToken(s string) *UserSettingCreate {
+	usc.mutation.SetUnlockToken(s)
+	return usc
+}
+
+// SetNillableUnlockToken sets the "unlock_token" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableUnlockToken(s *string) *UserSettingCreate {
+	if s != nil {
+		usc.SetUnlockToken(*s)
+	}
+	return usc
+}
+
+// SetUnlockTokenSecret sets the "unlock_token_secret" field.
+func (usc *UserSettingCreate) SetUnlockTokenSecret(b []byte) *UserSettingCreate {
+	usc.mutation.SetUnlockTokenSecret(b)
+	return usc
+}
+
+// SetUnlockTokenExpiresAt sets the "unlock_token_expires_at" field.
+func (usc *UserSettingCreate) SetUnlockTokenExpiresAt(t time.Time) *UserSettingCreate {
+	usc.mutation.SetUnlockTokenExpiresAt(t)
+	return usc
+}
+
+// SetNillableUnlockTokenExpiresAt sets the "unlock_token_expires_at" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableUnlockTokenExpiresAt(t *time.Time) *UserSettingCreate {
+	if t != nil {
+		usc.SetUnlockTokenExpiresAt(*t)
+	}
+	return usc
+}
+
 // SetID sets the "id" field.
 func (usc *UserSettingCreate) SetID(s string) *UserSettingCreate {
 	usc.mutation.SetID(s)
@@ -323,6 +433,14 @@ func (usc *UserSettingCreate) defaults() error {
 		v := usersetting.DefaultTags
 		usc.mutation.SetTags(v)
 	}
+	if _, ok := usc.mutation.IsTfaEnabled(); !ok {
+		v := usersetting.DefaultIsTfaEnabled
+		usc.mutation.SetIsTfaEnabled(v)
+	}
+	if _, ok := usc.mutation.FailedLoginAttempts(); !ok {
+		v := usersetting.DefaultFailedLoginAttempts
+		usc.mutation.SetFailedLoginAttempts(v)
+	}
 	if _, ok := usc.mutation.ID(); !ok {
 		if usersetting.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized usersetting.DefaultID (forgotten import generated/runtime?)")
@@ -369,6 +487,17 @@ func (usc *UserSettingCreate) check() error {
 	if _, ok := usc.mutation.Tags(); !ok {
 		return &ValidationError{Name: "tags", err: errors.New(`generated: missing required field "UserSetting.tags"`)}
 	}
+	if _, ok := usc.mutation.IsTfaEnabled(); !ok {
+		return &ValidationError{Name: "is_tfa_enabled", err: errors.New(`generated: missing required field "UserSetting.is_tfa_enabled"`)}
+	}
+	if _, ok := usc.mutation.FailedLoginAttempts(); !ok {
+		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`generated: missing required field "UserSetting.failed_login_attempts"`)}
+	}
+	if v, ok := usc.mutation.FailedLoginAttempts(); ok {
+		if err := usersetting.FailedLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_attempts", err: fmt.Errorf(`generated: validator failed for field "UserSetting.failed_login_attempts": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -465,6 +594,42 @@ func (usc *UserSettingCreate) createSpec() (*UserSetting, *sqlgraph.CreateSpec)
 		_spec.SetField(usersetting.FieldTags, field.TypeJSON, value)
 		_node.Tags = value
 	}
+	if value, ok := usc.mutation.IsTfaEnabled(); ok {
+		_spec.SetField(usersetting.FieldIsTfaEnabled, field.TypeBool, value)
+		_node.IsTfaEnabled = value
+	}
+	if value, ok := usc.mutation.TfaSecret(); ok {
+		_spec.SetField(usersetting.FieldTfaSecret, field.TypeString, value)
+		_node.TfaSecret = &value
+	}
+	if value, ok := usc.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usersetting.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := usc.mutation.TfaLastStep(); ok {
+		_spec.SetField(usersetting.FieldTfaLastStep, field.TypeInt64, value)
+		_node.TfaLastStep = &value
+	}
+	if value, ok := usc.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+		_node.FailedLoginAttempts = value
+	}
+	if value, ok := usc.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := usc.mutation.UnlockToken(); ok {
+		_spec.SetField(usersetting.FieldUnlockToken, field.TypeString, value)
+		_node.UnlockToken = &value
+	}
+	if value, ok := usc.mutation.UnlockTokenSecret(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenSecret, field.TypeBytes, value)
+		_node.UnlockTokenSecret = &value
+	}
+	if value, ok := usc.mutation.UnlockTokenExpiresAt(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenExpiresAt, field.TypeTime, value)
+		_node.UnlockTokenExpiresAt = &value
+	}
 	if nodes := usc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
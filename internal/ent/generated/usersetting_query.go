@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -370,6 +371,12 @@ func (usq *UserSettingQuery) prepareQuery(ctx context.Context) error {
 		}
 		usq.sql = prev
 	}
+	if usersetting.Policy == nil {
+		return errors.New("generated: uninitialized usersetting.Policy (forgotten import generated/runtime?)")
+	}
+	if err := usersetting.Policy.EvalQuery(ctx, usq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -238,6 +238,178 @@ func (usu *UserSettingUpdate) AppendTags(s []string) *UserSettingUpdate {
 	return usu
 }
 
+// SetIsTfaEnabled sets the "is_tfa_enabled" field.
+func (usu *UserSettingUpdate) SetIsTfaEnabled(b bool) *UserSettingUpdate {
+	usu.mutation.SetIsTfaEnabled(b)
+	return usu
+}
+
+// SetNillableIsTfaEnabled sets the "is_tfa_enabled" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableIsTfaEnabled(b *bool) *UserSettingUpdate {
+	if b != nil {
+		usu.SetIsTfaEnabled(*b)
+	}
+	return usu
+}
+
+// SetTfaSecret sets the "tfa_secret" field.
+func (usu *UserSettingUpdate) SetTfaSecret(s string) *UserSettingUpdate {
+	usu.mutation.SetTfaSecret(s)
+	return usu
+}
+
+// SetNillableTfaSecret sets the "tfa_secret" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableTfaSecret(s *string) *UserSettingUpdate {
+	if s != nil {
+		usu.SetTfaSecret(*s)
+	}
+	return usu
+}
+
+// ClearTfaSecret clears the value of the "tfa_secret" field.
+func (usu *UserSettingUpdate) ClearTfaSecret() *UserSettingUpdate {
+	usu.mutation.ClearTfaSecret()
+	return usu
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (usu *UserSettingUpdate) SetRecoveryCodes(s []string) *UserSettingUpdate {
+	usu.mutation.SetRecoveryCodes(s)
+	return usu
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (usu *UserSettingUpdate) AppendRecoveryCodes(s []string) *UserSettingUpdate {
+	usu.mutation.AppendRecoveryCodes(s)
+	return usu
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (usu *UserSettingUpdate) ClearRecoveryCodes() *UserSettingUpdate {
+	usu.mutation.ClearRecoveryCodes()
+	return usu
+}
+
+// SetTfaLastStep sets the "tfa_last_step" field.
+func (usu *UserSettingUpdate) SetTfaLastStep(i int64) *UserSettingUpdate {
+	usu.mutation.ResetTfaLastStep()
+	usu.mutation.SetTfaLastStep(i)
+	return usu
+}
+
+// SetNillableTfaLastStep sets the "tfa_last_step" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableTfaLastStep(i *int64) *UserSettingUpdate {
+	if i != nil {
+		usu.SetTfaLastStep(*i)
+	}
+	return usu
+}
+
+// AddTfaLastStep adds i to the "tfa_last_step" field.
+func (usu *UserSettingUpdate) AddTfaLastStep(i int64) *UserSettingUpdate {
+	usu.mutation.AddTfaLastStep(i)
+	return usu
+}
+
+// ClearTfaLastStep clears the value of the "tfa_last_step" field.
+func (usu *UserSettingUpdate) ClearTfaLastStep() *UserSettingUpdate {
+	usu.mutation.ClearTfaLastStep()
+	return usu
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usu *UserSettingUpdate) SetFailedLoginAttempts(i int) *UserSettingUpdate {
+	usu.mutation.ResetFailedLoginAttempts()
+	usu.mutation.SetFailedLoginAttempts(i)
+	return usu
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableFailedLoginAttempts(i *int) *UserSettingUpdate {
+	if i != nil {
+		usu.SetFailedLoginAttempts(*i)
+	}
+	return usu
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (usu *UserSettingUpdate) AddFailedLoginAttempts(i int) *UserSettingUpdate {
+	usu.mutation.AddFailedLoginAttempts(i)
+	return usu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (usu *UserSettingUpdate) SetLockedUntil(t time.Time) *UserSettingUpdate {
+	usu.mutation.SetLockedUntil(t)
+	return usu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableLockedUntil(t *time.Time) *UserSettingUpdate {
+	if t != nil {
+		usu.SetLockedUntil(*t)
+	}
+	return usu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (usu *UserSettingUpdate) ClearLockedUntil() *UserSettingUpdate {
+	usu.mutation.ClearLockedUntil()
+	return usu
+}
+
+// SetUnlockToken sets the "unlock_token" field.
+func (usu *UserSettingUpdate) SetUnlockToken(s string) *UserSettingUpdate {
+	usu.mutation.SetUnlockToken(s)
+	return usu
+}
+
+// SetNillableUnlockToken sets the "unlock_token" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableUnlockToken(s *string) *UserSettingUpdate {
+	if s != nil {
+		usu.SetUnlockToken(*s)
+	}
+	return usu
+}
+
+// ClearUnlockToken clears the value of the "unlock_token" field.
+func (usu *UserSettingUpdate) ClearUnlockToken() *UserSettingUpdate {
+	usu.mutation.ClearUnlockToken()
+	return usu
+}
+
+// SetUnlockTokenSecret sets the "unlock_token_secret" field.
+func (usu *UserSettingUpdate) SetUnlockTokenSecret(b []byte) *UserSettingUpdate {
+	usu.mutation.SetUnlockTokenSecret(b)
+	return usu
+}
+
+// ClearUnlockTokenSecret clears the value of the "unlock_token_secret" field.
+func (usu *UserSettingUpdate) ClearUnlockTokenSecret() *UserSettingUpdate {
+	usu.mutation.ClearUnlockTokenSecret()
+	return usu
+}
+
+// SetUnlockTokenExpiresAt sets the "unlock_token_expires_at" field.
+func (usu *UserSettingUpdate) SetUnlockTokenExpiresAt(t time.Time) *UserSettingUpdate {
+	usu.mutation.SetUnlockTokenExpiresAt(t)
+	return usu
+}
+
+// SetNillableUnlockTokenExpiresAt sets the "unlock_token_expires_at" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableUnlockTokenExpiresAt(t *time.Time) *UserSettingUpdate {
+	if t != nil {
+		usu.SetUnlockTokenExpiresAt(*t)
+	}
+	return usu
+}
+
+// ClearUnlockTokenExpiresAt clears the value of the "unlock_token_expires_at" field.
+func (usu *UserSettingUpdate) ClearUnlockTokenExpiresAt() *UserSettingUpdate {
+	usu.mutation.ClearUnlockTokenExpiresAt()
+	return usu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (usu *UserSettingUpdate) SetUserID(id string) *UserSettingUpdate {
 	usu.mutation.SetUserID(id)
@@ -322,6 +494,11 @@ func (usu *UserSettingUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "UserSetting.role": %w`, err)}
 		}
 	}
+	if v, ok := usu.mutation.FailedLoginAttempts(); ok {
+		if err := usersetting.FailedLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_attempts", err: fmt.Errorf(`generated: validator failed for field "UserSetting.failed_login_attempts": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -407,6 +584,65 @@ func (usu *UserSettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, usersetting.FieldTags, value)
 		})
 	}
+	if value, ok := usu.mutation.IsTfaEnabled(); ok {
+		_spec.SetField(usersetting.FieldIsTfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := usu.mutation.TfaSecret(); ok {
+		_spec.SetField(usersetting.FieldTfaSecret, field.TypeString, value)
+	}
+	if usu.mutation.TfaSecretCleared() {
+		_spec.ClearField(usersetting.FieldTfaSecret, field.TypeString)
+	}
+	if value, ok := usu.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usersetting.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := usu.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersetting.FieldRecoveryCodes, value)
+		})
+	}
+	if usu.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usersetting.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := usu.mutation.TfaLastStep(); ok {
+		_spec.SetField(usersetting.FieldTfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := usu.mutation.AddedTfaLastStep(); ok {
+		_spec.AddField(usersetting.FieldTfaLastStep, field.TypeInt64, value)
+	}
+	if usu.mutation.TfaLastStepCleared() {
+		_spec.ClearField(usersetting.FieldTfaLastStep, field.TypeInt64)
+	}
+	if value, ok := usu.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usu.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usu.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+	}
+	if usu.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersetting.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := usu.mutation.UnlockToken(); ok {
+		_spec.SetField(usersetting.FieldUnlockToken, field.TypeString, value)
+	}
+	if usu.mutation.UnlockTokenCleared() {
+		_spec.ClearField(usersetting.FieldUnlockToken, field.TypeString)
+	}
+	if value, ok := usu.mutation.UnlockTokenSecret(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenSecret, field.TypeBytes, value)
+	}
+	if usu.mutation.UnlockTokenSecretCleared() {
+		_spec.ClearField(usersetting.FieldUnlockTokenSecret, field.TypeBytes)
+	}
+	if value, ok := usu.mutation.UnlockTokenExpiresAt(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenExpiresAt, field.TypeTime, value)
+	}
+	if usu.mutation.UnlockTokenExpiresAtCleared() {
+		_spec.ClearField(usersetting.FieldUnlockTokenExpiresAt, field.TypeTime)
+	}
 	if usu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -666,6 +902,178 @@ func (usuo *UserSettingUpdateOne) AppendTags(s []string) *UserSettingUpdateOne {
 	return usuo
 }
 
+// SetIsTfaEnabled sets the "is_tfa_enabled" field.
+func (usuo *UserSettingUpdateOne) SetIsTfaEnabled(b bool) *UserSettingUpdateOne {
+	usuo.mutation.SetIsTfaEnabled(b)
+	return usuo
+}
+
+// SetNillableIsTfaEnabled sets the "is_tfa_enabled" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableIsTfaEnabled(b *bool) *UserSettingUpdateOne {
+	if b != nil {
+		usuo.SetIsTfaEnabled(*b)
+	}
+	return usuo
+}
+
+// SetTfaSecret sets the "tfa_secret" field.
+func (usuo *UserSettingUpdateOne) SetTfaSecret(s string) *UserSettingUpdateOne {
+	usuo.mutation.SetTfaSecret(s)
+	return usuo
+}
+
+// SetNillableTfaSecret sets the "tfa_secret" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableTfaSecret(s *string) *UserSettingUpdateOne {
+	if s != nil {
+		usuo.SetTfaSecret(*s)
+	}
+	return usuo
+}
+
+// ClearTfaSecret clears the value of the "tfa_secret" field.
+func (usuo *UserSettingUpdateOne) ClearTfaSecret() *UserSettingUpdateOne {
+	usuo.mutation.ClearTfaSecret()
+	return usuo
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (usuo *UserSettingUpdateOne) SetRecoveryCodes(s []string) *UserSettingUpdateOne {
+	usuo.mutation.SetRecoveryCodes(s)
+	return usuo
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (usuo *UserSettingUpdateOne) AppendRecoveryCodes(s []string) *UserSettingUpdateOne {
+	usuo.mutation.AppendRecoveryCodes(s)
+	return usuo
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (usuo *UserSettingUpdateOne) ClearRecoveryCodes() *UserSettingUpdateOne {
+	usuo.mutation.ClearRecoveryCodes()
+	return usuo
+}
+
+// SetTfaLastStep sets the "tfa_last_step" field.
+func (usuo *UserSettingUpdateOne) SetTfaLastStep(i int64) *UserSettingUpdateOne {
+	usuo.mutation.ResetTfaLastStep()
+	usuo.mutation.SetTfaLastStep(i)
+	return usuo
+}
+
+// SetNillableTfaLastStep sets the "tfa_last_step" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableTfaLastStep(i *int64) *UserSettingUpdateOne {
+	if i != nil {
+		usuo.SetTfaLastStep(*i)
+	}
+	return usuo
+}
+
+// AddTfaLastStep adds i to the "tfa_last_step" field.
+func (usuo *UserSettingUpdateOne) AddTfaLastStep(i int64) *UserSettingUpdateOne {
+	usuo.mutation.AddTfaLastStep(i)
+	return usuo
+}
+
+// ClearTfaLastStep clears the value of the "tfa_last_step" field.
+func (usuo *UserSettingUpdateOne) ClearTfaLastStep() *UserSettingUpdateOne {
+	usuo.mutation.ClearTfaLastStep()
+	return usuo
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usuo *UserSettingUpdateOne) SetFailedLoginAttempts(i int) *UserSettingUpdateOne {
+	usuo.mutation.ResetFailedLoginAttempts()
+	usuo.mutation.SetFailedLoginAttempts(i)
+	return usuo
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableFailedLoginAttempts(i *int) *UserSettingUpdateOne {
+	if i != nil {
+		usuo.SetFailedLoginAttempts(*i)
+	}
+	return usuo
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (usuo *UserSettingUpdateOne) AddFailedLoginAttempts(i int) *UserSettingUpdateOne {
+	usuo.mutation.AddFailedLoginAttempts(i)
+	return usuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (usuo *UserSettingUpdateOne) SetLockedUntil(t time.Time) *UserSettingUpdateOne {
+	usuo.mutation.SetLockedUntil(t)
+	return usuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableLockedUntil(t *time.Time) *UserSettingUpdateOne {
+	if t != nil {
+		usuo.SetLockedUntil(*t)
+	}
+	return usuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (usuo *UserSettingUpdateOne) ClearLockedUntil() *UserSettingUpdateOne {
+	usuo.mutation.ClearLockedUntil()
+	return usuo
+}
+
+// SetUnlockToken sets the "unlock_token" field.
+func (usuo *UserSettingUpdateOne) SetUnlockToken(s string) *UserSettingUpdateOne {
+	usuo.mutation.SetUnlockToken(s)
+	return usuo
+}
+
+// SetNillableUnlockToken sets the "unlock_token" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableUnlockToken(s *string) *UserSettingUpdateOne {
+	if s != nil {
+		usuo.SetUnlockToken(*s)
+	}
+	return usuo
+}
+
+// ClearUnlockToken clears the value of the "unlock_token" field.
+func (usuo *UserSettingUpdateOne) ClearUnlockToken() *UserSettingUpdateOne {
+	usuo.mutation.ClearUnlockToken()
+	return usuo
+}
+
+// SetUnlockTokenSecret sets the "unlock_token_secret" field.
+func (usuo *UserSettingUpdateOne) SetUnlockTokenSecret(b []byte) *UserSettingUpdateOne {
+	usuo.mutation.SetUnlockTokenSecret(b)
+	return usuo
+}
+
+// ClearUnlockTokenSecret clears the value of the "unlock_token_secret" field.
+func (usuo *UserSettingUpdateOne) ClearUnlockTokenSecret() *UserSettingUpdateOne {
+	usuo.mutation.ClearUnlockTokenSecret()
+	return usuo
+}
+
+// SetUnlockTokenExpiresAt sets the "unlock_token_expires_at" field.
+func (usuo *UserSettingUpdateOne) SetUnlockTokenExpiresAt(t time.Time) *UserSettingUpdateOne {
+	usuo.mutation.SetUnlockTokenExpiresAt(t)
+	return usuo
+}
+
+// SetNillableUnlockTokenExpiresAt sets the "unlock_token_expires_at" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableUnlockTokenExpiresAt(t *time.Time) *UserSettingUpdateOne {
+	if t != nil {
+		usuo.SetUnlockTokenExpiresAt(*t)
+	}
+	return usuo
+}
+
+// ClearUnlockTokenExpiresAt clears the value of the "unlock_token_expires_at" field.
+func (usuo *UserSettingUpdateOne) ClearUnlockTokenExpiresAt() *UserSettingUpdateOne {
+	usuo.mutation.ClearUnlockTokenExpiresAt()
+	return usuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (usuo *UserSettingUpdateOne) SetUserID(id string) *UserSettingUpdateOne {
 	usuo.mutation.SetUserID(id)
@@ -763,6 +1171,11 @@ func (usuo *UserSettingUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "UserSetting.role": %w`, err)}
 		}
 	}
+	if v, ok := usuo.mutation.FailedLoginAttempts(); ok {
+		if err := usersetting.FailedLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_attempts", err: fmt.Errorf(`generated: validator failed for field "UserSetting.failed_login_attempts": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -865,6 +1278,65 @@ func (usuo *UserSettingUpdateOne) sqlSave(ctx context.Context) (_node *UserSetti
 			sqljson.Append(u, usersetting.FieldTags, value)
 		})
 	}
+	if value, ok := usuo.mutation.IsTfaEnabled(); ok {
+		_spec.SetField(usersetting.FieldIsTfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := usuo.mutation.TfaSecret(); ok {
+		_spec.SetField(usersetting.FieldTfaSecret, field.TypeString, value)
+	}
+	if usuo.mutation.TfaSecretCleared() {
+		_spec.ClearField(usersetting.FieldTfaSecret, field.TypeString)
+	}
+	if value, ok := usuo.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usersetting.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := usuo.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersetting.FieldRecoveryCodes, value)
+		})
+	}
+	if usuo.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usersetting.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := usuo.mutation.TfaLastStep(); ok {
+		_spec.SetField(usersetting.FieldTfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := usuo.mutation.AddedTfaLastStep(); ok {
+		_spec.AddField(usersetting.FieldTfaLastStep, field.TypeInt64, value)
+	}
+	if usuo.mutation.TfaLastStepCleared() {
+		_spec.ClearField(usersetting.FieldTfaLastStep, field.TypeInt64)
+	}
+	if value, ok := usuo.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usuo.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usuo.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+	}
+	if usuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersetting.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := usuo.mutation.UnlockToken(); ok {
+		_spec.SetField(usersetting.FieldUnlockToken, field.TypeString, value)
+	}
+	if usuo.mutation.UnlockTokenCleared() {
+		_spec.ClearField(usersetting.FieldUnlockToken, field.TypeString)
+	}
+	if value, ok := usuo.mutation.UnlockTokenSecret(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenSecret, field.TypeBytes, value)
+	}
+	if usuo.mutation.UnlockTokenSecretCleared() {
+		_spec.ClearField(usersetting.FieldUnlockTokenSecret, field.TypeBytes)
+	}
+	if value, ok := usuo.mutation.UnlockTokenExpiresAt(); ok {
+		_spec.SetField(usersetting.FieldUnlockTokenExpiresAt, field.TypeTime, value)
+	}
+	if usuo.mutation.UnlockTokenExpiresAtCleared() {
+		_spec.ClearField(usersetting.FieldUnlockTokenExpiresAt, field.TypeTime)
+	}
 	if usuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
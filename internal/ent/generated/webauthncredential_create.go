@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
+)
+
+// WebauthnCredentialCreate is the builder for creating a WebauthnCredential entity.
+type WebauthnCredentialCreate struct {
+	config
+	mutation *WebauthnCredentialMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wcc *WebauthnCredentialCreate) SetCreatedAt(t time.Time) *WebauthnCredentialCreate {
+	wcc.mutation.SetCreatedAt(t)
+	return wcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableCreatedAt(t *time.Time) *WebauthnCredentialCreate {
+	if t != nil {
+		wcc.SetCreatedAt(*t)
+	}
+	return wcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wcc *WebauthnCredentialCreate) SetUpdatedAt(t time.Time) *WebauthnCredentialCreate {
+	wcc.mutation.SetUpdatedAt(t)
+	return wcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableUpdatedAt(t *time.Time) *WebauthnCredentialCreate {
+	if t != nil {
+		wcc.SetUpdatedAt(*t)
+	}
+	return wcc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wcc *WebauthnCredentialCreate) SetCreatedBy(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetCreatedBy(s)
+	return wcc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableCreatedBy(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetCreatedBy(*s)
+	}
+	return wcc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wcc *WebauthnCredentialCreate) SetUpdatedBy(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetUpdatedBy(s)
+	return wcc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableUpdatedBy(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetUpdatedBy(*s)
+	}
+	return wcc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wcc *WebauthnCredentialCreate) SetDeletedAt(t time.Time) *WebauthnCredentialCreate {
+	wcc.mutation.SetDeletedAt(t)
+	return wcc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableDeletedAt(t *time.Time) *WebauthnCredentialCreate {
+	if t != nil {
+		wcc.SetDeletedAt(*t)
+	}
+	return wcc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (wcc *WebauthnCredentialCreate) SetDeletedBy(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetDeletedBy(s)
+	return wcc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableDeletedBy(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetDeletedBy(*s)
+	}
+	return wcc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wcc *WebauthnCredentialCreate) SetOwnerID(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetOwnerID(s)
+	return wcc
+}
+
+// SetName sets the "name" field.
+func (wcc *WebauthnCredentialCreate) SetName(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetName(s)
+	return wcc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableName(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetName(*s)
+	}
+	return wcc
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (wcc *WebauthnCredentialCreate) SetCredentialID(b []byte) *WebauthnCredentialCreate {
+	wcc.mutation.SetCredentialID(b)
+	return wcc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wcc *WebauthnCredentialCreate) SetPublicKey(b []byte) *WebauthnCredentialCreate {
+	wcc.mutation.SetPublicKey(b)
+	return wcc
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wcc *WebauthnCredentialCreate) SetAttestationType(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetAttestationType(s)
+	return wcc
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableAttestationType(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetAttestationType(*s)
+	}
+	return wcc
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wcc *WebauthnCredentialCreate) SetAaguid(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetAaguid(s)
+	return wcc
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableAaguid(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetAaguid(*s)
+	}
+	return wcc
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcc *WebauthnCredentialCreate) SetSignCount(i int64) *WebauthnCredentialCreate {
+	wcc.mutation.SetSignCount(i)
+	return wcc
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableSignCount(i *int64) *WebauthnCredentialCreate {
+	if i != nil {
+		wcc.SetSignCount(*i)
+	}
+	return wcc
+}
+
+// SetTransports sets the "transports" field.
+func (wcc *WebauthnCredentialCreate) SetTransports(s []string) *WebauthnCredentialCreate {
+	wcc.mutation.SetTransports(s)
+	return wcc
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcc *WebauthnCredentialCreate) SetBackupEligible(b bool) *WebauthnCredentialCreate {
+	wcc.mutation.SetBackupEligible(b)
+	return wcc
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableBackupEligible(b *bool) *WebauthnCredentialCreate {
+	if b != nil {
+		wcc.SetBackupEligible(*b)
+	}
+	return wcc
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcc *WebauthnCredentialCreate) SetBackupState(b bool) *WebauthnCredentialCreate {
+	wcc.mutation.SetBackupState(b)
+	return wcc
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableBackupState(b *bool) *WebauthnCredentialCreate {
+	if b != nil {
+		wcc.SetBackupState(*b)
+	}
+	return wcc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcc *WebauthnCredentialCreate) SetLastUsedAt(t time.Time) *WebauthnCredentialCreate {
+	wcc.mutation.SetLastUsedAt(t)
+	return wcc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialCreate {
+	if t != nil {
+		wcc.SetLastUsedAt(*t)
+	}
+	return wcc
+}
+
+// SetID sets the "id" field.
+func (wcc *WebauthnCredentialCreate) SetID(s string) *WebauthnCredentialCreate {
+	wcc.mutation.SetID(s)
+	return wcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wcc *WebauthnCredentialCreate) SetNillableID(s *string) *WebauthnCredentialCreate {
+	if s != nil {
+		wcc.SetID(*s)
+	}
+	return wcc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (wcc *WebauthnCredentialCreate) SetOwner(u *User) *WebauthnCredentialCreate {
+	return wcc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcc *WebauthnCredentialCreate) Mutation() *WebauthnCredentialMutation {
+	return wcc.mutation
+}
+
+// Save creates the WebauthnCredential in the database.
+func (wcc *WebauthnCredentialCreate) Save(ctx context.Context) (*WebauthnCredential, error) {
+	if err := wcc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wcc.sqlSave, wcc.mutation, wcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wcc *WebauthnCredentialCreate) SaveX(ctx context.Context) *WebauthnCredential {
+	v, err := wcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcc *WebauthnCredentialCreate) Exec(ctx context.Context) error {
+	_, err := wcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcc *WebauthnCredentialCreate) ExecX(ctx context.Context) {
+	if err := wcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcc *WebauthnCredentialCreate) defaults() error {
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		if webauthncredential.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webauthncredential.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := webauthncredential.DefaultCreatedAt()
+		wcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wcc.mutation.UpdatedAt(); !ok {
+		if webauthncredential.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webauthncredential.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webauthncredential.DefaultUpdatedAt()
+		wcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wcc.mutation.Name(); !ok {
+		v := webauthncredential.DefaultName
+		wcc.mutation.SetName(v)
+	}
+	if _, ok := wcc.mutation.SignCount(); !ok {
+		v := webauthncredential.DefaultSignCount
+		wcc.mutation.SetSignCount(v)
+	}
+	if _, ok := wcc.mutation.BackupEligible(); !ok {
+		v := webauthncredential.DefaultBackupEligible
+		wcc.mutation.SetBackupEligible(v)
+	}
+	if _, ok := wcc.mutation.BackupState(); !ok {
+		v := webauthncredential.DefaultBackupState
+		wcc.mutation.SetBackupState(v)
+	}
+	if _, ok := wcc.mutation.ID(); !ok {
+		if webauthncredential.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized webauthncredential.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := webauthncredential.DefaultID()
+		wcc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcc *WebauthnCredentialCreate) check() error {
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "WebauthnCredential.created_at"`)}
+	}
+	if _, ok := wcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "WebauthnCredential.updated_at"`)}
+	}
+	if _, ok := wcc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "WebauthnCredential.owner_id"`)}
+	}
+	if _, ok := wcc.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`generated: missing required field "WebauthnCredential.credential_id"`)}
+	}
+	if v, ok := wcc.mutation.CredentialID(); ok {
+		if err := webauthncredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`generated: validator failed for field "WebauthnCredential.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := wcc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`generated: missing required field "WebauthnCredential.public_key"`)}
+	}
+	if v, ok := wcc.mutation.PublicKey(); ok {
+		if err := webauthncredential.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`generated: validator failed for field "WebauthnCredential.public_key": %w`, err)}
+		}
+	}
+	if _, ok := wcc.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`generated: missing required field "WebauthnCredential.sign_count"`)}
+	}
+	if _, ok := wcc.mutation.BackupEligible(); !ok {
+		return &ValidationError{Name: "backup_eligible", err: errors.New(`generated: missing required field "WebauthnCredential.backup_eligible"`)}
+	}
+	if _, ok := wcc.mutation.BackupState(); !ok {
+		return &ValidationError{Name: "backup_state", err: errors.New(`generated: missing required field "WebauthnCredential.backup_state"`)}
+	}
+	if _, ok := wcc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "WebauthnCredential.owner"`)}
+	}
+	return nil
+}
+
+func (wcc *WebauthnCredentialCreate) sqlSave(ctx context.Context) (*WebauthnCredential, error) {
+	if err := wcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebauthnCredential.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wcc.mutation.id = &_node.ID
+	wcc.mutation.done = true
+	return _node, nil
+}
+
+func (wcc *WebauthnCredentialCreate) createSpec() (*WebauthnCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebauthnCredential{config: wcc.config}
+		_spec = sqlgraph.NewCreateSpec(webauthncredential.Table, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeString))
+	)
+	_spec.Schema = wcc.schemaConfig.WebauthnCredential
+	if id, ok := wcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wcc.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wcc.mutation.CreatedBy(); ok {
+		_spec.SetField(webauthncredential.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wcc.mutation.UpdatedBy(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wcc.mutation.DeletedAt(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := wcc.mutation.DeletedBy(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := wcc.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wcc.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeBytes, value)
+		_node.CredentialID = value
+	}
+	if value, ok := wcc.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := wcc.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := wcc.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeString, value)
+		_node.Aaguid = value
+	}
+	if value, ok := wcc.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeInt64, value)
+		_node.SignCount = value
+	}
+	if value, ok := wcc.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := wcc.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+		_node.BackupEligible = value
+	}
+	if value, ok := wcc.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+		_node.BackupState = value
+	}
+	if value, ok := wcc.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := wcc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wcc.schemaConfig.WebauthnCredential
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebauthnCredentialCreateBulk is the builder for creating many WebauthnCredential entities in bulk.
+type WebauthnCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*WebauthnCredentialCreate
+}
+
+// Save creates the WebauthnCredential entities in the database.
+func (wccb *WebauthnCredentialCreateBulk) Save(ctx context.Context) ([]*WebauthnCredential, error) {
+	if wccb.err != nil {
+		return nil, wccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wccb.builders))
+	nodes := make([]*WebauthnCredential, len(wccb.builders))
+	mutators := make([]Mutator, len(wccb.builders))
+	for i := range wccb.builders {
+		func(i int, root context.Context) {
+			builder := wccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebauthnCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wccb *WebauthnCredentialCreateBulk) SaveX(ctx context.Context) []*WebauthnCredential {
+	v, err := wccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wccb *WebauthnCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := wccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wccb *WebauthnCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := wccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,824 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// WebauthnCredentialUpdate is the builder for updating WebauthnCredential entities.
+type WebauthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (wcu *WebauthnCredentialUpdate) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdate {
+	wcu.mutation.Where(ps...)
+	return wcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wcu *WebauthnCredentialUpdate) SetUpdatedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetUpdatedAt(t)
+	return wcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wcu *WebauthnCredentialUpdate) SetUpdatedBy(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetUpdatedBy(s)
+	return wcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableUpdatedBy(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetUpdatedBy(*s)
+	}
+	return wcu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wcu *WebauthnCredentialUpdate) ClearUpdatedBy() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearUpdatedBy()
+	return wcu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wcu *WebauthnCredentialUpdate) SetDeletedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetDeletedAt(t)
+	return wcu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableDeletedAt(t *time.Time) *WebauthnCredentialUpdate {
+	if t != nil {
+		wcu.SetDeletedAt(*t)
+	}
+	return wcu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wcu *WebauthnCredentialUpdate) ClearDeletedAt() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearDeletedAt()
+	return wcu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (wcu *WebauthnCredentialUpdate) SetDeletedBy(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetDeletedBy(s)
+	return wcu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableDeletedBy(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetDeletedBy(*s)
+	}
+	return wcu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (wcu *WebauthnCredentialUpdate) ClearDeletedBy() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearDeletedBy()
+	return wcu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wcu *WebauthnCredentialUpdate) SetOwnerID(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetOwnerID(s)
+	return wcu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableOwnerID(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetOwnerID(*s)
+	}
+	return wcu
+}
+
+// SetName sets the "name" field.
+func (wcu *WebauthnCredentialUpdate) SetName(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetName(s)
+	return wcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableName(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetName(*s)
+	}
+	return wcu
+}
+
+// ClearName clears the value of the "name" field.
+func (wcu *WebauthnCredentialUpdate) ClearName() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearName()
+	return wcu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) SetSignCount(i int64) *WebauthnCredentialUpdate {
+	wcu.mutation.ResetSignCount()
+	wcu.mutation.SetSignCount(i)
+	return wcu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableSignCount(i *int64) *WebauthnCredentialUpdate {
+	if i != nil {
+		wcu.SetSignCount(*i)
+	}
+	return wcu
+}
+
+// AddSignCount adds i to the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) AddSignCount(i int64) *WebauthnCredentialUpdate {
+	wcu.mutation.AddSignCount(i)
+	return wcu
+}
+
+// SetTransports sets the "transports" field.
+func (wcu *WebauthnCredentialUpdate) SetTransports(s []string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetTransports(s)
+	return wcu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wcu *WebauthnCredentialUpdate) AppendTransports(s []string) *WebauthnCredentialUpdate {
+	wcu.mutation.AppendTransports(s)
+	return wcu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcu *WebauthnCredentialUpdate) ClearTransports() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearTransports()
+	return wcu
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupEligible(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupEligible(b)
+	return wcu
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupEligible(*b)
+	}
+	return wcu
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupState(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupState(b)
+	return wcu
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupState(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupState(*b)
+	}
+	return wcu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetLastUsedAt(t)
+	return wcu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdate {
+	if t != nil {
+		wcu.SetLastUsedAt(*t)
+	}
+	return wcu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) ClearLastUsedAt() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearLastUsedAt()
+	return wcu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) SetOwner(u *User) *WebauthnCredentialUpdate {
+	return wcu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcu *WebauthnCredentialUpdate) Mutation() *WebauthnCredentialMutation {
+	return wcu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) ClearOwner() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearOwner()
+	return wcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wcu *WebauthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	if err := wcu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wcu.sqlSave, wcu.mutation, wcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := wcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wcu *WebauthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := wcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := wcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcu *WebauthnCredentialUpdate) defaults() error {
+	if _, ok := wcu.mutation.UpdatedAt(); !ok {
+		if webauthncredential.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webauthncredential.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		wcu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcu *WebauthnCredentialUpdate) check() error {
+	if _, ok := wcu.mutation.OwnerID(); wcu.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "WebauthnCredential.owner"`)
+	}
+	return nil
+}
+
+func (wcu *WebauthnCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeString))
+	if ps := wcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wcu.mutation.CreatedByCleared() {
+		_spec.ClearField(webauthncredential.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wcu.mutation.UpdatedByCleared() {
+		_spec.ClearField(webauthncredential.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := wcu.mutation.DeletedAt(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wcu.mutation.DeletedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wcu.mutation.DeletedBy(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedBy, field.TypeString, value)
+	}
+	if wcu.mutation.DeletedByCleared() {
+		_spec.ClearField(webauthncredential.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := wcu.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if wcu.mutation.NameCleared() {
+		_spec.ClearField(webauthncredential.FieldName, field.TypeString)
+	}
+	if wcu.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if wcu.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeString)
+	}
+	if value, ok := wcu.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeInt64, value)
+	}
+	if value, ok := wcu.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeInt64, value)
+	}
+	if value, ok := wcu.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wcu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wcu.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wcu.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := wcu.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wcu.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wcu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if wcu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wcu.schemaConfig.WebauthnCredential
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wcu.schemaConfig.WebauthnCredential
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wcu.schemaConfig.WebauthnCredential
+	ctx = internal.NewSchemaConfigContext(ctx, wcu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, wcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wcu.mutation.done = true
+	return n, nil
+}
+
+// WebauthnCredentialUpdateOne is the builder for updating a single WebauthnCredential entity.
+type WebauthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetUpdatedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetUpdatedAt(t)
+	return wcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetUpdatedBy(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetUpdatedBy(s)
+	return wcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableUpdatedBy(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetUpdatedBy(*s)
+	}
+	return wcuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearUpdatedBy() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearUpdatedBy()
+	return wcuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetDeletedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetDeletedAt(t)
+	return wcuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableDeletedAt(t *time.Time) *WebauthnCredentialUpdateOne {
+	if t != nil {
+		wcuo.SetDeletedAt(*t)
+	}
+	return wcuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearDeletedAt() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearDeletedAt()
+	return wcuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetDeletedBy(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetDeletedBy(s)
+	return wcuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableDeletedBy(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetDeletedBy(*s)
+	}
+	return wcuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearDeletedBy() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearDeletedBy()
+	return wcuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetOwnerID(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetOwnerID(s)
+	return wcuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableOwnerID(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetOwnerID(*s)
+	}
+	return wcuo
+}
+
+// SetName sets the "name" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetName(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetName(s)
+	return wcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableName(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetName(*s)
+	}
+	return wcuo
+}
+
+// ClearName clears the value of the "name" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearName() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearName()
+	return wcuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetSignCount(i int64) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ResetSignCount()
+	wcuo.mutation.SetSignCount(i)
+	return wcuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableSignCount(i *int64) *WebauthnCredentialUpdateOne {
+	if i != nil {
+		wcuo.SetSignCount(*i)
+	}
+	return wcuo
+}
+
+// AddSignCount adds i to the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) AddSignCount(i int64) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.AddSignCount(i)
+	return wcuo
+}
+
+// SetTransports sets the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetTransports(s []string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetTransports(s)
+	return wcuo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) AppendTransports(s []string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.AppendTransports(s)
+	return wcuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearTransports() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearTransports()
+	return wcuo
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupEligible(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupEligible(b)
+	return wcuo
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupEligible(*b)
+	}
+	return wcuo
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupState(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupState(b)
+	return wcuo
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupState(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupState(*b)
+	}
+	return wcuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetLastUsedAt(t)
+	return wcuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdateOne {
+	if t != nil {
+		wcuo.SetLastUsedAt(*t)
+	}
+	return wcuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearLastUsedAt() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearLastUsedAt()
+	return wcuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) SetOwner(u *User) *WebauthnCredentialUpdateOne {
+	return wcuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcuo *WebauthnCredentialUpdateOne) Mutation() *WebauthnCredentialMutation {
+	return wcuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) ClearOwner() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearOwner()
+	return wcuo
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (wcuo *WebauthnCredentialUpdateOne) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.Where(ps...)
+	return wcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wcuo *WebauthnCredentialUpdateOne) Select(field string, fields ...string) *WebauthnCredentialUpdateOne {
+	wcuo.fields = append([]string{field}, fields...)
+	return wcuo
+}
+
+// Save executes the query and returns the updated WebauthnCredential entity.
+func (wcuo *WebauthnCredentialUpdateOne) Save(ctx context.Context) (*WebauthnCredential, error) {
+	if err := wcuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wcuo.sqlSave, wcuo.mutation, wcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) SaveX(ctx context.Context) *WebauthnCredential {
+	node, err := wcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wcuo *WebauthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := wcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := wcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcuo *WebauthnCredentialUpdateOne) defaults() error {
+	if _, ok := wcuo.mutation.UpdatedAt(); !ok {
+		if webauthncredential.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webauthncredential.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		wcuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcuo *WebauthnCredentialUpdateOne) check() error {
+	if _, ok := wcuo.mutation.OwnerID(); wcuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`generated: clearing a required unique edge "WebauthnCredential.owner"`)
+	}
+	return nil
+}
+
+func (wcuo *WebauthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebauthnCredential, err error) {
+	if err := wcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeString))
+	id, ok := wcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "WebauthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wcuo.mutation.CreatedByCleared() {
+		_spec.ClearField(webauthncredential.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wcuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(webauthncredential.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.DeletedAt(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wcuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wcuo.mutation.DeletedBy(); ok {
+		_spec.SetField(webauthncredential.FieldDeletedBy, field.TypeString, value)
+	}
+	if wcuo.mutation.DeletedByCleared() {
+		_spec.ClearField(webauthncredential.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if wcuo.mutation.NameCleared() {
+		_spec.ClearField(webauthncredential.FieldName, field.TypeString)
+	}
+	if wcuo.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if wcuo.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeInt64, value)
+	}
+	if value, ok := wcuo.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeInt64, value)
+	}
+	if value, ok := wcuo.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wcuo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wcuo.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wcuo.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := wcuo.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wcuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wcuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if wcuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wcuo.schemaConfig.WebauthnCredential
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wcuo.schemaConfig.WebauthnCredential
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wcuo.schemaConfig.WebauthnCredential
+	ctx = internal.NewSchemaConfigContext(ctx, wcuo.schemaConfig)
+	_node = &WebauthnCredential{config: wcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,153 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// HookAPIKeyAbilities runs on api key mutations and ensures the key is restricted to valid scopes; unlike
+// personal access tokens an api key must have at least one scope, and a token restricted to scopes can only
+// create keys with a subset of its own scopes so it cannot mint a key with more access than it has
+func HookAPIKeyAbilities() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.APIKeyFunc(func(ctx context.Context, mutation *generated.APIKeyMutation) (generated.Value, error) {
+			abilities, _ := mutation.Abilities()
+			if len(abilities) == 0 {
+				return nil, ErrAPIKeyMissingAbilities
+			}
+
+			if err := tokens.ValidateScopes(abilities); err != nil {
+				return nil, err
+			}
+
+			if err := checkGrantableScopes(ctx, abilities); err != nil {
+				return nil, err
+			}
+
+			return next.Mutate(ctx, mutation)
+		})
+	}, ent.OpCreate)
+}
+
+// HookAPIKeyAuthz runs on api key mutations to add the service account of the key to its organization,
+// and to remove it when the key is deleted
+func HookAPIKeyAuthz() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.APIKeyFunc(func(ctx context.Context, m *generated.APIKeyMutation) (ent.Value, error) {
+			if m.Authz.Ofga == nil {
+				return next.Mutate(ctx, m)
+			}
+
+			isDelete := m.Op().Is(ent.OpDelete|ent.OpDeleteOne) || mixin.CheckIsSoftDelete(ctx)
+
+			// the keys are looked up before they are deleted to know which tuples to remove
+			var deleted []*generated.APIKey
+
+			if isDelete {
+				allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+				ids, err := m.IDs(allowCtx)
+				if err != nil {
+					return nil, err
+				}
+
+				deleted, err = m.Client().APIKey.Query().
+					Where(apikey.IDIn(ids...)).
+					All(allowCtx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// do the mutation, and then create/delete the relationship
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				// if we error, do not attempt to create the relationships
+				return retValue, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				err = apiKeyCreateHook(ctx, m)
+			} else if isDelete {
+				err = apiKeyDeleteHook(ctx, m, deleted)
+			}
+
+			return retValue, err
+		})
+	}
+}
+
+func apiKeyCreateHook(ctx context.Context, m *generated.APIKeyMutation) error {
+	id, exists := m.ID()
+	org, orgExists := m.OwnerID()
+
+	if !exists || !orgExists {
+		return nil
+	}
+
+	abilities, _ := m.Abilities()
+	relation := APIKeyRelation(abilities)
+	object := fmt.Sprintf("organization:%s", org)
+
+	m.Logger.Infow("creating relationship tuples", "relation", relation, "object", object)
+
+	tuples := []ofgaclient.ClientTupleKey{{
+		User:     fmt.Sprintf("%s:%s", fga.ServiceSubjectType, id),
+		Relation: relation,
+		Object:   object,
+	}}
+
+	if _, err := m.Authz.CreateRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to create relationship tuple", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func apiKeyDeleteHook(ctx context.Context, m *generated.APIKeyMutation, keys []*generated.APIKey) error {
+	tuples := []openfga.TupleKeyWithoutCondition{}
+
+	for _, k := range keys {
+		tuples = append(tuples, openfga.TupleKeyWithoutCondition{
+			User:     fmt.Sprintf("%s:%s", fga.ServiceSubjectType, k.ID),
+			Relation: APIKeyRelation(k.Abilities),
+			Object:   fmt.Sprintf("organization:%s", k.OwnerID),
+		})
+	}
+
+	m.Logger.Infow("deleting relationship tuples", "count", len(tuples))
+
+	if _, err := m.Authz.DeleteRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to delete relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// APIKeyRelation returns the relation the service account of an api key has to its organization;
+// keys that can write to the organization are admins and all other keys are members
+func APIKeyRelation(abilities []string) string {
+	claims := tokens.Claims{Scopes: abilities}
+
+	if claims.HasScope(tokens.ScopeResourceOrg, tokens.ScopeActionWrite) {
+		return fga.AdminRelation
+	}
+
+	return fga.MemberRelation
+}
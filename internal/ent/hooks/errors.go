@@ -11,6 +11,78 @@ var (
 	// ErrPersonalOrgsNoChildren is returned when personal org attempts to add a child org
 	ErrPersonalOrgsNoChildren = errors.New("personal organizations are not allowed to have child organizations")
 
-	// ErrPersonalOrgsNoUser is returned when personal org has no user associated, so no permissions can be added
-	ErrPersonalOrgsNoUser = errors.New("personal organizations missing user association")
+	// ErrAPIKeyMissingAbilities is returned when an api key is created without any scopes
+	ErrAPIKeyMissingAbilities = errors.New("api keys must be restricted to at least one scope")
+
+	// ErrOauthClientMissingScopes is returned when an oauth client is created without any scopes
+	ErrOauthClientMissingScopes = errors.New("oauth clients must be allowed at least one scope")
+
+	// ErrOauthClientMissingRedirectURIs is returned when an oauth client does not have a redirect uri
+	ErrOauthClientMissingRedirectURIs = errors.New("oauth clients must have at least one redirect uri")
+
+	// ErrInvalidRedirectURI is returned when a redirect uri of an oauth client is not an absolute url without a fragment
+	ErrInvalidRedirectURI = errors.New("redirect uris must be absolute urls without a fragment")
+
+	// ErrPersonalOrgsNoInvites is returned when a user is invited to a personal organization
+	ErrPersonalOrgsNoInvites = errors.New("users cannot be invited to personal organizations")
+
+	// ErrUserAlreadyOrgMember is returned when a user is invited to an organization they are already a member of
+	ErrUserAlreadyOrgMember = errors.New("user is already a member of the organization")
+
+	// ErrUserAlreadyInvited is returned when a user is invited to an organization with an outstanding invitation
+	ErrUserAlreadyInvited = errors.New("user already has an outstanding invitation to the organization")
+
+	// ErrPersonalOrgsNoMembers is returned when a user is added to a personal organization
+	ErrPersonalOrgsNoMembers = errors.New("users cannot be added to personal organizations")
+
+	// ErrOrgOwnerRequired is returned when the last owner of an organization is removed or given another role
+	ErrOrgOwnerRequired = errors.New("organizations must have at least one owner")
+
+	// ErrGroupAdminRequired is returned when the last admin of a group is removed or given another role
+	ErrGroupAdminRequired = errors.New("groups must have at least one admin")
+
+	// ErrUserNotInGroupOrg is returned when a user is added to a group of an organization they are not a member of
+	ErrUserNotInGroupOrg = errors.New("user is not a member of the organization of the group")
+
+	// ErrUserAlreadyGroupMember is returned when a user requests to join a group they are already a member of
+	ErrUserAlreadyGroupMember = errors.New("user is already a member of the group")
+
+	// ErrGroupJoinRequestsNotAccepted is returned when a user requests to join a group that does not accept applications
+	ErrGroupJoinRequestsNotAccepted = errors.New("group does not accept requests to join")
+
+	// ErrGroupJoinRequestPending is returned when a user requests to join a group with a pending request
+	ErrGroupJoinRequestPending = errors.New("user already has a pending request to join the group")
+
+	// ErrGroupJoinRequestReviewed is returned when a request to join a group that was already approved or declined is reviewed
+	ErrGroupJoinRequestReviewed = errors.New("request to join the group was already reviewed")
+
+	// ErrPersonalOrgsNotTransferable is returned when the ownership of a personal organization is transferred
+	ErrPersonalOrgsNotTransferable = errors.New("personal organizations cannot be transferred")
+
+	// ErrTransferRequestorNotOwner is returned when a user who is not an owner of the organization, or admin of the group, transfers it
+	ErrTransferRequestorNotOwner = errors.New("only owners of the organization, or admins of the group, can transfer it")
+
+	// ErrTransferRecipientNotOrgMember is returned when an organization is transferred to a user who is not a member of it
+	ErrTransferRecipientNotOrgMember = errors.New("recipient of the transfer is not a member of the organization")
+
+	// ErrTransferRecipientIsOwner is returned when an organization or group is transferred to a user who already owns it
+	ErrTransferRecipientIsOwner = errors.New("recipient of the transfer already owns the organization or group")
+
+	// ErrOwnershipTransferPending is returned when an organization or group with a pending transfer is transferred
+	ErrOwnershipTransferPending = errors.New("organization or group already has a pending transfer")
+
+	// ErrOwnershipTransferNotPending is returned when a transfer that was already accepted, declined, canceled or expired is updated
+	ErrOwnershipTransferNotPending = errors.New("transfer is no longer pending")
+
+	// ErrPersonalOrgsNotMovable is returned when a personal organization is moved under another organization
+	ErrPersonalOrgsNotMovable = errors.New("personal organizations cannot be moved under another organization")
+
+	// ErrOrgHierarchyCycle is returned when an organization is moved under itself or one of its descendants
+	ErrOrgHierarchyCycle = errors.New("organizations cannot be moved under themselves or their child organizations")
+
+	// ErrOrgHierarchyTooDeep is returned when an organization is created or moved below the maximum depth of the hierarchy
+	ErrOrgHierarchyTooDeep = errors.New("organization hierarchy exceeds the maximum depth")
+
+	// ErrOrgHasChildren is returned when an organization with child organizations is deleted
+	ErrOrgHasChildren = errors.New("organizations with child organizations cannot be deleted, move or delete the child organizations first")
 )
@@ -2,6 +2,7 @@ package hooks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,9 +10,12 @@ import (
 	openfga "github.com/openfga/go-sdk"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 )
 
 // HookGroup runs on group mutations to set default values that are not provided
@@ -71,6 +75,13 @@ func HookGroupAuthz() ent.Hook {
 }
 
 func groupCreateHook(ctx context.Context, m *generated.GroupMutation) error {
+	// users are added as the admin of the groups they create when they are members of the organization of
+	// the group, the membership creates the relationship tuple of the admin
+	userID, err := groupCreatorUserID(ctx, m)
+	if err != nil {
+		return err
+	}
+
 	if m.Authz.Ofga != nil {
 		objID, exists := m.ID()
 		objType := strings.ToLower(m.Type())
@@ -78,17 +89,19 @@ func groupCreateHook(ctx context.Context, m *generated.GroupMutation) error {
 		org, orgexists := m.OwnerID()
 
 		if exists && orgexists {
-			m.Logger.Infow("creating admin relationship tuples", "relation", fga.AdminRelation, "object", object)
-
 			tupleGroup := []openfga.TupleKey{}
 
-			adminTuples, err := createTupleFromUserContext(ctx, &m.Authz, fga.AdminRelation, object)
+			if userID == "" {
+				m.Logger.Infow("creating admin relationship tuples", "relation", fga.AdminRelation, "object", object)
 
-			if err != nil {
-				return err
-			}
+				adminTuples, err := createTupleFromUserContext(ctx, &m.Authz, fga.AdminRelation, object)
 
-			tupleGroup = append(tupleGroup, adminTuples...)
+				if err != nil {
+					return err
+				}
+
+				tupleGroup = append(tupleGroup, adminTuples...)
+			}
 
 			m.Logger.Infow("creating parent relationship tuples", "relation", fga.ParentRelation, "org", org, "object", object)
 
@@ -108,6 +121,59 @@ func groupCreateHook(ctx context.Context, m *generated.GroupMutation) error {
 		}
 	}
 
+	if userID != "" {
+		return createAdminMembership(ctx, m, userID)
+	}
+
+	return nil
+}
+
+// groupCreatorUserID returns the user of the request creating the group, an empty string is returned when the
+// group is created by a service account or the subject of the request is not a member of the organization
+func groupCreatorUserID(ctx context.Context, m *generated.GroupMutation) (string, error) {
+	if auth.GetSubjectTypeFromContext(ctx) != fga.UserSubjectType {
+		return "", nil
+	}
+
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", nil
+	}
+
+	groupID, ok := m.ID()
+	if !ok {
+		return "", nil
+	}
+
+	if err := checkGroupOrgMember(ctx, m.Client(), groupID, userID); err != nil {
+		if errors.Is(err, ErrUserNotInGroupOrg) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// createAdminMembership adds the user as the admin of the group created by the mutation
+func createAdminMembership(ctx context.Context, m *generated.GroupMutation, userID string) error {
+	groupID, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	// the user is not yet a member of the group, so the creation bypasses the privacy policy
+	if err := m.Client().GroupMembership.Create().
+		SetGroupID(groupID).
+		SetUserID(userID).
+		SetRole(groupmembership.RoleAdmin).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		m.Logger.Errorw("failed to add admin to group", "error", err)
+
+		return err
+	}
+
 	return nil
 }
 
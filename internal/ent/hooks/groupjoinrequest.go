@@ -0,0 +1,163 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HookGroupJoinRequest runs on group join request mutations to ensure the group accepts applications from the
+// user, and to add the user to the group when the request is approved
+func HookGroupJoinRequest() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.GroupJoinRequestFunc(func(ctx context.Context, m *generated.GroupJoinRequestMutation) (generated.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				// users request to join groups themselves
+				if _, ok := m.UserID(); !ok {
+					userID, err := auth.GetUserIDFromContext(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					m.SetUserID(userID)
+				}
+
+				if err := checkGroupJoinRequest(ctx, m); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			status, ok := m.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			if err := checkGroupJoinRequestPending(ctx, m); err != nil {
+				return nil, err
+			}
+
+			// the request is reviewed by the group admin of the request
+			if auth.GetSubjectTypeFromContext(ctx) == fga.UserSubjectType {
+				if userID, err := auth.GetUserIDFromContext(ctx); err == nil {
+					m.SetReviewerID(userID)
+				}
+			}
+
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				return retValue, err
+			}
+
+			if status != groupjoinrequest.StatusApproved {
+				return retValue, nil
+			}
+
+			jr, ok := retValue.(*generated.GroupJoinRequest)
+			if !ok {
+				return nil, ErrInternalServerError
+			}
+
+			// the user is not yet a member of the group, and access to approve the request was checked by
+			// the privacy policy, so the membership bypasses the privacy policy
+			if err := m.Client().GroupMembership.Create().
+				SetGroupID(jr.GroupID).
+				SetUserID(jr.UserID).
+				SetRole(groupmembership.RoleMember).
+				Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+				m.Logger.Errorw("failed to add user to group", "error", err)
+
+				return nil, err
+			}
+
+			return retValue, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// checkGroupJoinRequest returns an error when the group does not accept applications, the user is not a member
+// of the organization of the group, or the user is already a member of the group or has a pending request to it
+func checkGroupJoinRequest(ctx context.Context, m *generated.GroupJoinRequestMutation) error {
+	// the user is not a member of the group, so the lookups bypass the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	groupID, _ := m.GroupID()
+	userID, _ := m.UserID()
+
+	setting, err := m.Client().GroupSetting.Query().
+		Where(groupsetting.HasGroupWith(group.ID(groupID))).
+		Only(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if setting.JoinPolicy != groupsetting.JoinPolicyApplicationOnly &&
+		setting.JoinPolicy != groupsetting.JoinPolicyInviteOrApplication {
+		return ErrGroupJoinRequestsNotAccepted
+	}
+
+	if err := checkGroupOrgMember(ctx, m.Client(), groupID, userID); err != nil {
+		return err
+	}
+
+	member, err := m.Client().GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(groupID),
+			groupmembership.UserID(userID),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if member {
+		return ErrUserAlreadyGroupMember
+	}
+
+	pending, err := m.Client().GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.GroupID(groupID),
+			groupjoinrequest.UserID(userID),
+			groupjoinrequest.StatusEQ(groupjoinrequest.StatusPending),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		return ErrGroupJoinRequestPending
+	}
+
+	return nil
+}
+
+// checkGroupJoinRequestPending returns an error when the request was already approved or declined
+func checkGroupJoinRequestPending(ctx context.Context, m *generated.GroupJoinRequestMutation) error {
+	id, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	jr, err := m.Client().GroupJoinRequest.Get(privacy.DecisionContext(ctx, privacy.Allow), id)
+	if err != nil {
+		return err
+	}
+
+	if jr.Status != groupjoinrequest.StatusPending {
+		return ErrGroupJoinRequestReviewed
+	}
+
+	return nil
+}
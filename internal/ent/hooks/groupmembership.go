@@ -0,0 +1,282 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// HookGroupMembership runs on group membership mutations to ensure users are only added to the groups of the
+// organizations they are a member of, and that groups with admins always keep at least one admin
+func HookGroupMembership() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.GroupMembershipFunc(func(ctx context.Context, m *generated.GroupMembershipMutation) (generated.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				groupID, _ := m.GroupID()
+				userID, _ := m.UserID()
+
+				if err := checkGroupOrgMember(ctx, m.Client(), groupID, userID); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			if err := checkGroupAdmins(ctx, m); err != nil {
+				return nil, err
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// HookGroupMembershipAuthz runs on group membership mutations to create, update or delete the relationship
+// tuple of the user in the group, the relation of the tuple is the role of the membership
+func HookGroupMembershipAuthz() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.GroupMembershipFunc(func(ctx context.Context, m *generated.GroupMembershipMutation) (ent.Value, error) {
+			// the tuples are written by the caller, e.g. when the ownership of an organization or group is
+			// transferred and the tuples of the owners are swapped in a single write
+			if membershipTuplesSkipped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			// the memberships are looked up before they are updated or deleted to replace their tuples
+			var (
+				existing []*generated.GroupMembership
+				err      error
+			)
+
+			if m.Authz.Ofga != nil && !m.Op().Is(ent.OpCreate) {
+				if existing, err = getGroupMemberships(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+
+			// do the mutation, and then create/update/delete the relationship
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				// if we error, do not attempt to create the relationships
+				return retValue, err
+			}
+
+			if m.Authz.Ofga == nil {
+				return retValue, nil
+			}
+
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				err = groupMembershipCreateHook(ctx, m, retValue)
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				err = groupMembershipDeleteHook(ctx, m, existing)
+			default:
+				err = groupMembershipUpdateHook(ctx, m, existing)
+			}
+
+			return retValue, err
+		})
+	}
+}
+
+func groupMembershipCreateHook(ctx context.Context, m *generated.GroupMembershipMutation, v ent.Value) error {
+	gm, ok := v.(*generated.GroupMembership)
+	if !ok {
+		return ErrInternalServerError
+	}
+
+	tuples := []ofgaclient.ClientTupleKey{groupMembershipTuple(gm.UserID, gm.GroupID, gm.Role)}
+
+	if _, err := m.Authz.CreateRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to create relationship tuple", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	m.Logger.Infow("created relationship tuples", "relation", tuples[0].Relation, "object", tuples[0].Object)
+
+	return nil
+}
+
+func groupMembershipUpdateHook(ctx context.Context, m *generated.GroupMembershipMutation, existing []*generated.GroupMembership) error {
+	role, ok := m.Role()
+	if !ok {
+		return nil
+	}
+
+	var (
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+	)
+
+	for _, gm := range existing {
+		if gm.Role == role {
+			continue
+		}
+
+		writes = append(writes, groupMembershipTuple(gm.UserID, gm.GroupID, role))
+
+		old := groupMembershipTuple(gm.UserID, gm.GroupID, gm.Role)
+		deletes = append(deletes, openfga.TupleKeyWithoutCondition{
+			User:     old.User,
+			Relation: old.Relation,
+			Object:   old.Object,
+		})
+	}
+
+	// the tuple of the new role is written with the removal of the tuple of the old role, so the user
+	// does not lose access to the group in between
+	if _, err := m.Authz.UpdateRelationshipTuple(ctx, writes, deletes); err != nil {
+		m.Logger.Errorw("failed to update relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func groupMembershipDeleteHook(ctx context.Context, m *generated.GroupMembershipMutation, existing []*generated.GroupMembership) error {
+	tuples := make([]openfga.TupleKeyWithoutCondition, 0, len(existing))
+
+	for _, gm := range existing {
+		t := groupMembershipTuple(gm.UserID, gm.GroupID, gm.Role)
+
+		tuples = append(tuples, openfga.TupleKeyWithoutCondition{
+			User:     t.User,
+			Relation: t.Relation,
+			Object:   t.Object,
+		})
+	}
+
+	if _, err := m.Authz.DeleteRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to delete relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// groupMembershipTuple returns the relationship tuple of the user in the group with the role
+func groupMembershipTuple(userID, groupID string, role groupmembership.Role) ofgaclient.ClientTupleKey {
+	return ofgaclient.ClientTupleKey{
+		User:     fmt.Sprintf("%s:%s", fga.UserSubjectType, userID),
+		Relation: strings.ToLower(role.String()),
+		Object:   fmt.Sprintf("group:%s", groupID),
+	}
+}
+
+// getGroupMemberships returns the memberships of an update or delete mutation
+func getGroupMemberships(ctx context.Context, m *generated.GroupMembershipMutation) ([]*generated.GroupMembership, error) {
+	// the memberships are looked up without filtering, access was checked by the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().GroupMembership.Query().
+		Where(groupmembership.IDIn(ids...)).
+		All(allowCtx)
+}
+
+// checkGroupAdmins returns an error when the mutation removes, or changes the role of, the last admins of a
+// group, so admins cannot leave a group without another admin to manage it
+func checkGroupAdmins(ctx context.Context, m *generated.GroupMembershipMutation) error {
+	// only changes to the role from admin to another role remove an admin on update
+	if role, ok := m.Role(); m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && (!ok || role == groupmembership.RoleAdmin) {
+		return nil
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	groupIDs, err := m.Client().GroupMembership.Query().
+		Where(
+			groupmembership.IDIn(ids...),
+			groupmembership.RoleEQ(groupmembership.RoleAdmin),
+		).
+		Unique(true).
+		Select(groupmembership.FieldGroupID).
+		Strings(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range groupIDs {
+		admins, err := m.Client().GroupMembership.Query().
+			Where(
+				groupmembership.GroupID(groupID),
+				groupmembership.RoleEQ(groupmembership.RoleAdmin),
+				groupmembership.IDNotIn(ids...),
+			).
+			Exist(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if !admins {
+			return ErrGroupAdminRequired
+		}
+	}
+
+	return nil
+}
+
+// checkGroupOrgMember returns an error when the user is not a member of the organization that owns the group,
+// the relations of the group are only granted to members of its organization, members of the parent
+// organizations are members of the organization as well
+func checkGroupOrgMember(ctx context.Context, client *generated.Client, groupID, userID string) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	org, err := client.Group.Query().
+		Where(group.ID(groupID)).
+		QueryOwner().
+		Only(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	orgIDs := []string{org.ID}
+
+	for org.ParentOrganizationID != "" {
+		if org, err = client.Organization.Get(allowCtx, org.ParentOrganizationID); err != nil {
+			return err
+		}
+
+		orgIDs = append(orgIDs, org.ID)
+	}
+
+	member, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationIDIn(orgIDs...),
+			orgmembership.UserID(userID),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if !member {
+		return ErrUserNotInGroupOrg
+	}
+
+	return nil
+}
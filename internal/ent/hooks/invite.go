@@ -0,0 +1,110 @@
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// HookInvite runs on invite mutations to ensure the recipient can be invited to the organization and to set
+// the signed token, and its expiration, that is emailed to the recipient
+func HookInvite() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.InviteFunc(func(ctx context.Context, mutation *generated.InviteMutation) (generated.Value, error) {
+			orgID, _ := mutation.OwnerID()
+			recipient, _ := mutation.Recipient()
+
+			if err := checkInviteRecipient(ctx, mutation, orgID, recipient); err != nil {
+				return nil, err
+			}
+
+			// the invitation is sent by the user of the request, service accounts are not recorded
+			if auth.GetSubjectTypeFromContext(ctx) == fga.UserSubjectType {
+				if userID, err := auth.GetUserIDFromContext(ctx); err == nil {
+					mutation.SetRequestorID(userID)
+				}
+			}
+
+			oid, err := ulids.Parse(orgID)
+			if err != nil {
+				return nil, err
+			}
+
+			t, err := tokens.NewOrgInvitationToken(recipient, oid)
+			if err != nil {
+				return nil, err
+			}
+
+			signature, secret, err := t.Sign()
+			if err != nil {
+				return nil, err
+			}
+
+			mutation.SetToken(signature)
+			mutation.SetSecret(secret)
+			mutation.SetExpires(t.ExpiresAt)
+
+			return next.Mutate(ctx, mutation)
+		})
+	}, ent.OpCreate)
+}
+
+// checkInviteRecipient returns an error when the organization is a personal organization, or the recipient is
+// already a member of the organization or has an invitation to it that has not expired
+func checkInviteRecipient(ctx context.Context, m *generated.InviteMutation, orgID, recipient string) error {
+	// the invitee is not a member of the organization, so the lookups bypass the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	org, err := m.Client().Organization.Get(allowCtx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if org.PersonalOrg {
+		return ErrPersonalOrgsNoInvites
+	}
+
+	member, err := m.Client().User.Query().
+		Where(
+			user.EmailEqualFold(recipient),
+			user.HasOrganizationsWith(organization.ID(orgID)),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if member {
+		return ErrUserAlreadyOrgMember
+	}
+
+	invited, err := m.Client().Invite.Query().
+		Where(
+			invite.RecipientEqualFold(recipient),
+			invite.OwnerID(orgID),
+			invite.StatusEQ(invite.StatusInvitationSent),
+			invite.ExpiresGT(time.Now()),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if invited {
+		return ErrUserAlreadyInvited
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+)
+
+// HookMagicLinkToken runs on magic link token mutations and sets expires
+func HookMagicLinkToken() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.MagicLinkTokenFunc(func(ctx context.Context, mutation *generated.MagicLinkTokenMutation) (generated.Value, error) {
+			expires, _ := mutation.TTL()
+			if expires.IsZero() {
+				mutation.SetTTL(time.Now().Add(time.Minute * 15)) // nolint: gomnd
+			}
+
+			return next.Mutate(ctx, mutation)
+		})
+	}, ent.OpCreate)
+}
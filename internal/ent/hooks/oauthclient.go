@@ -0,0 +1,166 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// HookOauthClient runs on oauth client mutations and ensures the client is allowed at least one valid scope
+// and can only redirect users to absolute urls
+func HookOauthClient() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OauthClientFunc(func(ctx context.Context, mutation *generated.OauthClientMutation) (generated.Value, error) {
+			if mutation.Op().Is(ent.OpCreate) {
+				scopes, _ := mutation.Scopes()
+				if len(scopes) == 0 {
+					return nil, ErrOauthClientMissingScopes
+				}
+
+				if err := tokens.ValidateOauthScopes(scopes); err != nil {
+					return nil, err
+				}
+			}
+
+			if uris, ok := mutation.RedirectUris(); ok || mutation.Op().Is(ent.OpCreate) {
+				if err := validateRedirectURIs(uris); err != nil {
+					return nil, err
+				}
+			}
+
+			return next.Mutate(ctx, mutation)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// validateRedirectURIs ensures there is at least one redirect uri and each is an absolute url without a
+// fragment; custom schemes are allowed for native apps
+func validateRedirectURIs(uris []string) error {
+	if len(uris) == 0 {
+		return ErrOauthClientMissingRedirectURIs
+	}
+
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return fmt.Errorf("%w: %s", ErrInvalidRedirectURI, uri)
+		}
+	}
+
+	return nil
+}
+
+// HookOauthClientAuthz runs on oauth client mutations to add the service account of a confidential client
+// to its organization, used by the client credentials grant, and to remove it when the client is deleted
+func HookOauthClientAuthz() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.OauthClientFunc(func(ctx context.Context, m *generated.OauthClientMutation) (ent.Value, error) {
+			if m.Authz.Ofga == nil {
+				return next.Mutate(ctx, m)
+			}
+
+			isDelete := m.Op().Is(ent.OpDelete|ent.OpDeleteOne) || mixin.CheckIsSoftDelete(ctx)
+
+			// the clients are looked up before they are deleted to know which tuples to remove
+			var deleted []*generated.OauthClient
+
+			if isDelete {
+				allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+				ids, err := m.IDs(allowCtx)
+				if err != nil {
+					return nil, err
+				}
+
+				deleted, err = m.Client().OauthClient.Query().
+					Where(
+						oauthclient.IDIn(ids...),
+						oauthclient.Public(false),
+					).
+					All(allowCtx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// do the mutation, and then create/delete the relationship
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				// if we error, do not attempt to create the relationships
+				return retValue, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				err = oauthClientCreateHook(ctx, m)
+			} else if isDelete && len(deleted) > 0 {
+				err = oauthClientDeleteHook(ctx, m, deleted)
+			}
+
+			return retValue, err
+		})
+	}
+}
+
+func oauthClientCreateHook(ctx context.Context, m *generated.OauthClientMutation) error {
+	id, exists := m.ID()
+	org, orgExists := m.OwnerID()
+
+	// public clients cannot use the client credentials grant so they are not added to the organization
+	if public, _ := m.Public(); !exists || !orgExists || public {
+		return nil
+	}
+
+	scopes, _ := m.Scopes()
+	relation := APIKeyRelation(scopes)
+	object := fmt.Sprintf("organization:%s", org)
+
+	m.Logger.Infow("creating relationship tuples", "relation", relation, "object", object)
+
+	tuples := []ofgaclient.ClientTupleKey{{
+		User:     fmt.Sprintf("%s:%s", fga.ServiceSubjectType, id),
+		Relation: relation,
+		Object:   object,
+	}}
+
+	if _, err := m.Authz.CreateRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to create relationship tuple", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func oauthClientDeleteHook(ctx context.Context, m *generated.OauthClientMutation, clients []*generated.OauthClient) error {
+	tuples := []openfga.TupleKeyWithoutCondition{}
+
+	for _, c := range clients {
+		tuples = append(tuples, openfga.TupleKeyWithoutCondition{
+			User:     fmt.Sprintf("%s:%s", fga.ServiceSubjectType, c.ID),
+			Relation: APIKeyRelation(c.Scopes),
+			Object:   fmt.Sprintf("organization:%s", c.OwnerID),
+		})
+	}
+
+	m.Logger.Infow("deleting relationship tuples", "count", len(tuples))
+
+	if _, err := m.Authz.DeleteRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to delete relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
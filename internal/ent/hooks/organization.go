@@ -6,14 +6,24 @@ import (
 	"strings"
 
 	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
 	ofgaclient "github.com/openfga/go-sdk/client"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
 	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 )
 
+// MaxOrganizationDepth is the maximum number of levels of child organizations below a root organization
+const MaxOrganizationDepth = 5
+
 // HookOrganization runs on org mutations to set default values that are not provided
 func HookOrganization() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
@@ -44,6 +54,20 @@ func HookOrganization() ent.Hook {
 					if parentOrg.PersonalOrg {
 						return nil, ErrPersonalOrgsNoChildren
 					}
+
+					// the new organization does not have child organizations yet
+					if err := checkOrgDepth(ctx, mutation.Client(), parentOrgID, 0); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			// check the organization can be moved under its new parent
+			if mutation.Op().Is(ent.OpUpdateOne) {
+				if parentOrgID, ok := mutation.ParentID(); ok {
+					if err := checkOrgMove(ctx, mutation, parentOrgID); err != nil {
+						return nil, err
+					}
 				}
 			}
 
@@ -59,10 +83,40 @@ func HookOrganization() ent.Hook {
 	}, ent.OpCreate|ent.OpUpdateOne)
 }
 
+// HookOrganizationDelete runs on organization delete mutations, organizations with child organizations are not
+// deleted so child organizations are never left without their parent, the child organizations must be moved
+// under another parent, or deleted, first
+func HookOrganizationDelete() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.OrganizationFunc(func(ctx context.Context, m *generated.OrganizationMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) || mixin.CheckIsSoftDelete(ctx) {
+				if err := checkOrgChildren(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
 // HookOrganizationAuthz runs on organization mutations to setup or remove relationship tuples
 func HookOrganizationAuthz() ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return hook.OrganizationFunc(func(ctx context.Context, m *generated.OrganizationMutation) (ent.Value, error) {
+			// the tuple of the previous parent is replaced when the organization is moved, so the parent is
+			// looked up before the mutation, access to the organization is checked by the privacy policy
+			var oldParentOrgID string
+
+			if orgParentChanged(m) && m.Authz.Ofga != nil {
+				var err error
+
+				oldParentOrgID, err = m.OldParentOrganizationID(privacy.DecisionContext(ctx, privacy.Allow))
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			// do the mutation, and then create/delete the relationship
 			retValue, err := next.Mutate(ctx, m)
 			if err != nil {
@@ -74,8 +128,17 @@ func HookOrganizationAuthz() ent.Hook {
 				// create the relationship tuple for the owner
 				err = organizationCreateHook(ctx, m)
 			} else if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) || mixin.CheckIsSoftDelete(ctx) {
+				// api keys of the organization can no longer be used once it is deleted, this also
+				// removes the service account tuples before the organization tuples are deleted
+				if err = deleteOrganizationAPIKeys(ctx, m); err != nil {
+					return retValue, err
+				}
+
 				// delete all relationship tuples on delete, or soft delete (Update Op)
 				err = organizationDeleteHook(ctx, m)
+			} else if orgParentChanged(m) {
+				// replace the parent tuple when the organization is moved under another parent
+				err = organizationMoveHook(ctx, m, oldParentOrgID)
 			}
 
 			return retValue, err
@@ -84,9 +147,19 @@ func HookOrganizationAuthz() ent.Hook {
 }
 
 func organizationCreateHook(ctx context.Context, m *generated.OrganizationMutation) error {
+	// the user of a personal org is added as its owner when the user is created
+	if personalOrg, _ := m.PersonalOrg(); personalOrg {
+		return nil
+	}
+
+	userID, err := creatorUserID(ctx, m)
+	if err != nil {
+		return err
+	}
+
 	// Add relationship tuples if authz is enabled
 	if m.Authz.Ofga != nil {
-		tuples, err := getOrganizationTuples(ctx, m)
+		tuples, err := getOrganizationTuples(ctx, m, userID == "")
 		if err != nil {
 			return err
 		}
@@ -98,10 +171,63 @@ func organizationCreateHook(ctx context.Context, m *generated.OrganizationMutati
 				return ErrInternalServerError
 			}
 
-			m.Logger.Infow("created relationship tuples", "relation", fga.OwnerRelation, "object", tuples[0].Object)
+			m.Logger.Infow("created relationship tuples", "object", tuples[0].Object)
 		}
 	}
 
+	// users are added as the owner of the organizations they create, the membership creates the
+	// relationship tuple of the owner
+	if userID != "" {
+		return createOwnerMembership(ctx, m, userID)
+	}
+
+	return nil
+}
+
+// creatorUserID returns the user of the request creating the organization, an empty string is returned when
+// the organization is created by a service account or the subject of the request is not a user in the database
+func creatorUserID(ctx context.Context, m *generated.OrganizationMutation) (string, error) {
+	if auth.GetSubjectTypeFromContext(ctx) != fga.UserSubjectType {
+		return "", nil
+	}
+
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", nil
+	}
+
+	exists, err := m.Client().User.Query().
+		Where(user.ID(userID)).
+		Exist(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return "", err
+	}
+
+	if !exists {
+		return "", nil
+	}
+
+	return userID, nil
+}
+
+// createOwnerMembership adds the user as the owner of the organization created by the mutation
+func createOwnerMembership(ctx context.Context, m *generated.OrganizationMutation, userID string) error {
+	orgID, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	// the user is not yet a member of the organization, so the creation bypasses the privacy policy
+	if err := m.Client().OrgMembership.Create().
+		SetOrganizationID(orgID).
+		SetUserID(userID).
+		SetRole(orgmembership.RoleOwner).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		m.Logger.Errorw("failed to add owner to organization", "error", err)
+
+		return err
+	}
+
 	return nil
 }
 
@@ -129,37 +255,121 @@ func organizationDeleteHook(ctx context.Context, m *generated.OrganizationMutati
 	return nil
 }
 
-func getOrganizationTuples(ctx context.Context, m *generated.OrganizationMutation) (tuples []ofgaclient.ClientTupleKey, err error) {
+// getOrganizationTuples returns the relationship tuples of a new organization; the tuple of its parent, so the
+// owners, admins and members of the parent inherit access to the organization, and the tuple of the user of the
+// request as its owner when the owner is not added as a member of the organization
+func getOrganizationTuples(ctx context.Context, m *generated.OrganizationMutation, withOwner bool) (tuples []ofgaclient.ClientTupleKey, err error) {
 	objID, exists := m.ID()
+	if !exists {
+		return
+	}
+
 	objType := strings.ToLower(m.Type())
 	object := fmt.Sprintf("%s:%s", objType, objID)
 
-	m.Logger.Infow("creating relationship tuples", "relation", fga.OwnerRelation, "object", object)
+	if withOwner {
+		m.Logger.Infow("creating relationship tuples", "relation", fga.OwnerRelation, "object", object)
 
-	if exists {
-		// personal orgs should be owned by the user, and won't yet have data in the claims
-		if personalOrg, _ := m.PersonalOrg(); personalOrg {
-			users := m.UsersIDs()
-			if len(users) == 0 {
-				return tuples, ErrPersonalOrgsNoUser
-			}
+		tuples, err = createTupleFromUserContext(ctx, &m.Authz, fga.OwnerRelation, object)
+		if err != nil {
+			return tuples, err
+		}
+	}
 
-			tuples = []ofgaclient.ClientTupleKey{{
-				User:     fmt.Sprintf("user:%s", users[0]),
-				Relation: fga.OwnerRelation,
-				Object:   object,
-			}}
-		} else {
-			tuples, err = createTupleFromUserContext(ctx, &m.Authz, fga.OwnerRelation, object)
-			if err != nil {
-				return tuples, err
-			}
+	if parentOrgID, ok := m.ParentID(); ok {
+		m.Logger.Infow("creating parent relationship tuples", "relation", fga.ParentRelation, "org", parentOrgID, "object", object)
+
+		parentTuples, err := createOrgTuple(ctx, &m.Authz, parentOrgID, fga.ParentRelation, object)
+		if err != nil {
+			return tuples, err
 		}
+
+		tuples = append(tuples, parentTuples...)
 	}
 
 	return
 }
 
+// orgParentChanged returns true when the mutation moves an existing organization under another parent, or
+// removes its parent
+func orgParentChanged(m *generated.OrganizationMutation) bool {
+	if !m.Op().Is(ent.OpUpdateOne) {
+		return false
+	}
+
+	_, ok := m.ParentID()
+
+	return ok || m.ParentCleared()
+}
+
+// organizationMoveHook replaces the parent relationship tuple of an organization moved under another parent in a
+// single write, so the organization never inherits access from both parents, or from neither
+func organizationMoveHook(ctx context.Context, m *generated.OrganizationMutation, oldParentOrgID string) error {
+	if m.Authz.Ofga == nil {
+		return nil
+	}
+
+	objID, _ := m.ID()
+	objType := strings.ToLower(m.Type())
+	object := fmt.Sprintf("%s:%s", objType, objID)
+
+	parentOrgID, _ := m.ParentID()
+	if parentOrgID == oldParentOrgID {
+		return nil
+	}
+
+	writes := []ofgaclient.ClientTupleKey{}
+	deletes := []openfga.TupleKeyWithoutCondition{}
+
+	if parentOrgID != "" {
+		tuples, err := createOrgTuple(ctx, &m.Authz, parentOrgID, fga.ParentRelation, object)
+		if err != nil {
+			return err
+		}
+
+		writes = append(writes, tuples...)
+	}
+
+	if oldParentOrgID != "" {
+		tuples, err := createOrgTuple(ctx, &m.Authz, oldParentOrgID, fga.ParentRelation, object)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range tuples {
+			deletes = append(deletes, tupleKeyWithoutCondition(t))
+		}
+	}
+
+	if _, err := m.Authz.UpdateRelationshipTuple(ctx, writes, deletes); err != nil {
+		m.Logger.Errorw("failed to update parent relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	m.Logger.Infow("moved organization", "object", object, "parent_organization_id", parentOrgID, "previous_parent_organization_id", oldParentOrgID)
+
+	return nil
+}
+
+// deleteOrganizationAPIKeys deletes the api keys owned by the organization
+func deleteOrganizationAPIKeys(ctx context.Context, m *generated.OrganizationMutation) error {
+	orgID, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	if _, err := m.Client().APIKey.Delete().
+		Where(apikey.OwnerID(orgID)).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		m.Logger.Errorw("failed to delete organization api keys", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
 // defaultOrganizationSettings creates the default organizations settings for a new org
 func defaultOrganizationSettings(ctx context.Context, mutation *generated.OrganizationMutation) (string, error) {
 	input := generated.CreateOrganizationSettingInput{}
@@ -171,3 +381,203 @@ func defaultOrganizationSettings(ctx context.Context, mutation *generated.Organi
 
 	return organizationSetting.ID, nil
 }
+
+// checkOrgChildren returns an error when one of the organizations deleted by the mutation has child organizations
+// that are not deleted along with it
+func checkOrgChildren(ctx context.Context, m *generated.OrganizationMutation) error {
+	// the child organizations are looked up without filtering, they may not be visible to the user
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids := []string{}
+
+	if id, ok := m.ID(); ok {
+		ids = append(ids, id)
+	} else {
+		var err error
+
+		ids, err = m.IDs(allowCtx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	hasChildren, err := m.Client().Organization.Query().
+		Where(
+			organization.ParentOrganizationIDIn(ids...),
+			organization.IDNotIn(ids...),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if hasChildren {
+		return ErrOrgHasChildren
+	}
+
+	return nil
+}
+
+// checkOrgMove returns an error when the organization cannot be moved under the parent organization; personal
+// organizations are not moved, organizations are not moved under personal organizations, under themselves or
+// their descendants, and the hierarchy does not exceed the maximum depth after the move
+func checkOrgMove(ctx context.Context, m *generated.OrganizationMutation, parentOrgID string) error {
+	orgID, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	// the lookups bypass the privacy policy, access to both organizations was checked by the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	org, err := m.Client().Organization.Get(allowCtx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if org.PersonalOrg {
+		return ErrPersonalOrgsNotMovable
+	}
+
+	if parentOrgID == orgID {
+		return ErrOrgHierarchyCycle
+	}
+
+	parentOrg, err := m.Client().Organization.Get(allowCtx, parentOrgID)
+	if err != nil {
+		return err
+	}
+
+	if parentOrg.PersonalOrg {
+		return ErrPersonalOrgsNoChildren
+	}
+
+	levels, err := orgDescendantLevels(ctx, m.Client(), orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		for _, id := range level {
+			if id == parentOrgID {
+				return ErrOrgHierarchyCycle
+			}
+		}
+	}
+
+	// the child organizations are moved along with the organization
+	return checkOrgDepth(ctx, m.Client(), parentOrgID, len(levels))
+}
+
+// checkOrgDepth returns an error when an organization, with levels of child organizations below it, placed under
+// the parent organization is deeper than MaxOrganizationDepth levels below the root organization of the hierarchy
+func checkOrgDepth(ctx context.Context, client *generated.Client, parentOrgID string, levels int) error {
+	ancestors, err := OrganizationAncestorIDs(ctx, client, parentOrgID)
+	if err != nil {
+		return err
+	}
+
+	// the parent is a level below each of its ancestors, and the organization a level below its parent
+	if len(ancestors)+1+levels > MaxOrganizationDepth {
+		return ErrOrgHierarchyTooDeep
+	}
+
+	return nil
+}
+
+// OrganizationAncestorIDs returns the IDs of the ancestors of the organization, ordered from its parent up to the
+// root organization of the hierarchy. The organizations are looked up without the privacy policy, callers must
+// check access to the organizations returned
+func OrganizationAncestorIDs(ctx context.Context, client *generated.Client, orgID string) ([]string, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids := []string{}
+	seen := map[string]bool{orgID: true}
+
+	for {
+		org, err := client.Organization.Query().
+			Where(organization.ID(orgID)).
+			Select(organization.FieldParentOrganizationID).
+			Only(allowCtx)
+		if err != nil {
+			// the parent of an organization deleted before its children could not be deleted ends the path
+			if generated.IsNotFound(err) && len(ids) > 0 {
+				return ids[:len(ids)-1], nil
+			}
+
+			return nil, err
+		}
+
+		if org.ParentOrganizationID == "" {
+			return ids, nil
+		}
+
+		if seen[org.ParentOrganizationID] {
+			return nil, ErrOrgHierarchyCycle
+		}
+
+		seen[org.ParentOrganizationID] = true
+		ids = append(ids, org.ParentOrganizationID)
+		orgID = org.ParentOrganizationID
+	}
+}
+
+// OrganizationDescendantIDs returns the IDs of the descendants of the organization; its child organizations, then
+// their child organizations, and so on. The organizations are looked up without the privacy policy, callers must
+// check access to the organizations returned
+func OrganizationDescendantIDs(ctx context.Context, client *generated.Client, orgID string) ([]string, error) {
+	levels, err := orgDescendantLevels(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, level := range levels {
+		ids = append(ids, level...)
+	}
+
+	return ids, nil
+}
+
+// orgDescendantLevels returns the IDs of the descendants of the organization by level below it, the IDs of each
+// level are sorted so the order is deterministic
+func orgDescendantLevels(ctx context.Context, client *generated.Client, orgID string) ([][]string, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	levels := [][]string{}
+	seen := map[string]bool{orgID: true}
+	parents := []string{orgID}
+
+	for len(parents) > 0 {
+		children, err := client.Organization.Query().
+			Where(organization.ParentOrganizationIDIn(parents...)).
+			Order(organization.ByID()).
+			IDs(allowCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		level := []string{}
+
+		for _, id := range children {
+			if seen[id] {
+				continue
+			}
+
+			seen[id] = true
+			level = append(level, id)
+		}
+
+		if len(level) > 0 {
+			levels = append(levels, level)
+		}
+
+		parents = level
+	}
+
+	return levels, nil
+}
@@ -0,0 +1,266 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// HookOrgMembership runs on org membership mutations to ensure users are not added to personal organizations,
+// which only have their owner as a member, and that organizations always keep at least one owner
+func HookOrgMembership() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OrgMembershipFunc(func(ctx context.Context, m *generated.OrgMembershipMutation) (generated.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				if err := checkPersonalOrgMembership(ctx, m); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			if err := checkOrgOwners(ctx, m); err != nil {
+				return nil, err
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// HookOrgMembershipAuthz runs on org membership mutations to create, update or delete the relationship tuple
+// of the user in the organization, the relation of the tuple is the role of the membership
+func HookOrgMembershipAuthz() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.OrgMembershipFunc(func(ctx context.Context, m *generated.OrgMembershipMutation) (ent.Value, error) {
+			// the tuples are written by the caller, e.g. when the ownership of an organization or group is
+			// transferred and the tuples of the owners are swapped in a single write
+			if membershipTuplesSkipped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			// the memberships are looked up before they are updated or deleted to replace their tuples
+			var (
+				existing []*generated.OrgMembership
+				err      error
+			)
+
+			if m.Authz.Ofga != nil && !m.Op().Is(ent.OpCreate) {
+				if existing, err = getMemberships(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+
+			// do the mutation, and then create/update/delete the relationship
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				// if we error, do not attempt to create the relationships
+				return retValue, err
+			}
+
+			if m.Authz.Ofga == nil {
+				return retValue, nil
+			}
+
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				err = orgMembershipCreateHook(ctx, m, retValue)
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				err = orgMembershipDeleteHook(ctx, m, existing)
+			default:
+				err = orgMembershipUpdateHook(ctx, m, existing)
+			}
+
+			return retValue, err
+		})
+	}
+}
+
+func orgMembershipCreateHook(ctx context.Context, m *generated.OrgMembershipMutation, v ent.Value) error {
+	om, ok := v.(*generated.OrgMembership)
+	if !ok {
+		return ErrInternalServerError
+	}
+
+	tuples := []ofgaclient.ClientTupleKey{orgMembershipTuple(om.UserID, om.OrganizationID, om.Role)}
+
+	if _, err := m.Authz.CreateRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to create relationship tuple", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	m.Logger.Infow("created relationship tuples", "relation", tuples[0].Relation, "object", tuples[0].Object)
+
+	return nil
+}
+
+func orgMembershipUpdateHook(ctx context.Context, m *generated.OrgMembershipMutation, existing []*generated.OrgMembership) error {
+	role, ok := m.Role()
+	if !ok {
+		return nil
+	}
+
+	var (
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+	)
+
+	for _, om := range existing {
+		if om.Role == role {
+			continue
+		}
+
+		writes = append(writes, orgMembershipTuple(om.UserID, om.OrganizationID, role))
+
+		old := orgMembershipTuple(om.UserID, om.OrganizationID, om.Role)
+		deletes = append(deletes, openfga.TupleKeyWithoutCondition{
+			User:     old.User,
+			Relation: old.Relation,
+			Object:   old.Object,
+		})
+	}
+
+	// the tuple of the new role is written with the removal of the tuple of the old role, so the user
+	// does not lose access to the organization in between
+	if _, err := m.Authz.UpdateRelationshipTuple(ctx, writes, deletes); err != nil {
+		m.Logger.Errorw("failed to update relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func orgMembershipDeleteHook(ctx context.Context, m *generated.OrgMembershipMutation, existing []*generated.OrgMembership) error {
+	tuples := make([]openfga.TupleKeyWithoutCondition, 0, len(existing))
+
+	for _, om := range existing {
+		t := orgMembershipTuple(om.UserID, om.OrganizationID, om.Role)
+
+		tuples = append(tuples, openfga.TupleKeyWithoutCondition{
+			User:     t.User,
+			Relation: t.Relation,
+			Object:   t.Object,
+		})
+	}
+
+	if _, err := m.Authz.DeleteRelationshipTuple(ctx, tuples); err != nil {
+		m.Logger.Errorw("failed to delete relationship tuples", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// orgMembershipTuple returns the relationship tuple of the user in the organization with the role
+func orgMembershipTuple(userID, orgID string, role orgmembership.Role) ofgaclient.ClientTupleKey {
+	return ofgaclient.ClientTupleKey{
+		User:     fmt.Sprintf("%s:%s", fga.UserSubjectType, userID),
+		Relation: strings.ToLower(role.String()),
+		Object:   fmt.Sprintf("organization:%s", orgID),
+	}
+}
+
+// getMemberships returns the memberships of an update or delete mutation
+func getMemberships(ctx context.Context, m *generated.OrgMembershipMutation) ([]*generated.OrgMembership, error) {
+	// the memberships are looked up without filtering, access was checked by the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().OrgMembership.Query().
+		Where(orgmembership.IDIn(ids...)).
+		All(allowCtx)
+}
+
+// checkPersonalOrgMembership returns an error when a user is added to a personal organization that already has
+// its owner as a member
+func checkPersonalOrgMembership(ctx context.Context, m *generated.OrgMembershipMutation) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	orgID, _ := m.OrganizationID()
+
+	org, err := m.Client().Organization.Get(allowCtx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if !org.PersonalOrg {
+		return nil
+	}
+
+	hasMembers, err := m.Client().OrgMembership.Query().
+		Where(orgmembership.OrganizationID(orgID)).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if hasMembers {
+		return ErrPersonalOrgsNoMembers
+	}
+
+	return nil
+}
+
+// checkOrgOwners returns an error when the mutation removes, or changes the role of, the last owners of an
+// organization
+func checkOrgOwners(ctx context.Context, m *generated.OrgMembershipMutation) error {
+	// only changes to the role from owner to another role remove an owner on update
+	if role, ok := m.Role(); m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && (!ok || role == orgmembership.RoleOwner) {
+		return nil
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	orgIDs, err := m.Client().OrgMembership.Query().
+		Where(
+			orgmembership.IDIn(ids...),
+			orgmembership.RoleEQ(orgmembership.RoleOwner),
+		).
+		Unique(true).
+		Select(orgmembership.FieldOrganizationID).
+		Strings(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, orgID := range orgIDs {
+		owners, err := m.Client().OrgMembership.Query().
+			Where(
+				orgmembership.OrganizationID(orgID),
+				orgmembership.RoleEQ(orgmembership.RoleOwner),
+				orgmembership.IDNotIn(ids...),
+			).
+			Exist(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if !owners {
+			return ErrOrgOwnerRequired
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,479 @@
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// membershipTuplesSkipKey is the context key to skip writing the relationship tuples of memberships
+type membershipTuplesSkipKey struct{}
+
+// skipMembershipTuples returns a new context that skips writing the relationship tuples of the memberships
+// that are mutated, the tuples are written by the caller instead
+func skipMembershipTuples(parent context.Context) context.Context {
+	return context.WithValue(parent, membershipTuplesSkipKey{}, true)
+}
+
+// membershipTuplesSkipped returns true when writing the relationship tuples of memberships is skipped
+func membershipTuplesSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(membershipTuplesSkipKey{}).(bool)
+
+	return skip
+}
+
+// HookOwnershipTransfer runs on ownership transfer mutations to ensure the organization or group can be
+// transferred from the user to the recipient, to set the signed token that is emailed to the recipient, and to
+// swap the owners when the recipient accepts the transfer
+func HookOwnershipTransfer() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OwnershipTransferFunc(func(ctx context.Context, m *generated.OwnershipTransferMutation) (generated.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				if err := setOwnershipTransfer(ctx, m); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			status, ok := m.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			if err := checkOwnershipTransferPending(ctx, m); err != nil {
+				return nil, err
+			}
+
+			retValue, err := next.Mutate(ctx, m)
+			if err != nil {
+				return retValue, err
+			}
+
+			if status != ownershiptransfer.StatusAccepted {
+				return retValue, nil
+			}
+
+			t, ok := retValue.(*generated.OwnershipTransfer)
+			if !ok {
+				return nil, ErrInternalServerError
+			}
+
+			if err := transferOwnership(ctx, m, t); err != nil {
+				return nil, err
+			}
+
+			return retValue, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// setOwnershipTransfer sets the requestor, the organization of a group, and the signed token of a new transfer,
+// after checking the organization or group can be transferred to the recipient
+func setOwnershipTransfer(ctx context.Context, m *generated.OwnershipTransferMutation) error {
+	// the organization or group is transferred by the user of the request
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.SetRequestorID(userID)
+
+	// the lookups bypass the privacy policy, access to transfer was checked by the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	resourceID, ok := m.GroupID()
+	if ok {
+		orgID, err := m.Client().Group.Query().
+			Where(group.ID(resourceID)).
+			QueryOwner().
+			OnlyID(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		m.SetOwnerID(orgID)
+	} else {
+		resourceID, _ = m.OwnerID()
+	}
+
+	if err := checkOwnershipTransfer(ctx, m); err != nil {
+		return err
+	}
+
+	recipientID, _ := m.RecipientID()
+
+	uid, err := ulids.Parse(recipientID)
+	if err != nil {
+		return err
+	}
+
+	rid, err := ulids.Parse(resourceID)
+	if err != nil {
+		return err
+	}
+
+	t, err := tokens.NewOwnershipTransferToken(uid, rid)
+	if err != nil {
+		return err
+	}
+
+	signature, secret, err := t.Sign()
+	if err != nil {
+		return err
+	}
+
+	m.SetToken(signature)
+	m.SetSecret(secret)
+	m.SetExpires(t.ExpiresAt)
+
+	return nil
+}
+
+// checkOwnershipTransfer returns an error when the organization is a personal organization, the requestor is not
+// an owner of the organization or admin of the group, the recipient is not a member of the organization or
+// already owns it, or the organization or group has a pending transfer
+func checkOwnershipTransfer(ctx context.Context, m *generated.OwnershipTransferMutation) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	orgID, _ := m.OwnerID()
+	requestorID, _ := m.RequestorID()
+	recipientID, _ := m.RecipientID()
+
+	org, err := m.Client().Organization.Get(allowCtx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if org.PersonalOrg {
+		return ErrPersonalOrgsNotTransferable
+	}
+
+	pending := m.Client().OwnershipTransfer.Query().
+		Where(
+			ownershiptransfer.OwnerID(orgID),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+			ownershiptransfer.ExpiresGT(time.Now()),
+		)
+
+	if groupID, ok := m.GroupID(); ok {
+		if err := checkGroupTransfer(ctx, m.Client(), groupID, requestorID, recipientID); err != nil {
+			return err
+		}
+
+		pending.Where(ownershiptransfer.GroupID(groupID))
+	} else {
+		if err := checkOrgTransfer(ctx, m.Client(), orgID, requestorID, recipientID); err != nil {
+			return err
+		}
+
+		pending.Where(ownershiptransfer.GroupIDIsNil())
+	}
+
+	exists, err := pending.Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return ErrOwnershipTransferPending
+	}
+
+	return nil
+}
+
+// checkOrgTransfer returns an error when the requestor is not an owner of the organization, or the recipient is
+// not a member of the organization or is already an owner of it
+func checkOrgTransfer(ctx context.Context, client *generated.Client, orgID, requestorID, recipientID string) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(requestorID),
+			orgmembership.RoleEQ(orgmembership.RoleOwner),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if !owner {
+		return ErrTransferRequestorNotOwner
+	}
+
+	recipient, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(recipientID),
+		).
+		Only(allowCtx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return ErrTransferRecipientNotOrgMember
+		}
+
+		return err
+	}
+
+	if recipient.Role == orgmembership.RoleOwner {
+		return ErrTransferRecipientIsOwner
+	}
+
+	return nil
+}
+
+// checkGroupTransfer returns an error when the requestor is not an admin of the group, or the recipient is not a
+// member of the organization of the group or is already an admin of the group
+func checkGroupTransfer(ctx context.Context, client *generated.Client, groupID, requestorID, recipientID string) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	admin, err := client.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(groupID),
+			groupmembership.UserID(requestorID),
+			groupmembership.RoleEQ(groupmembership.RoleAdmin),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if !admin {
+		return ErrTransferRequestorNotOwner
+	}
+
+	if err := checkGroupOrgMember(ctx, client, groupID, recipientID); err != nil {
+		return err
+	}
+
+	recipientAdmin, err := client.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(groupID),
+			groupmembership.UserID(recipientID),
+			groupmembership.RoleEQ(groupmembership.RoleAdmin),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if recipientAdmin {
+		return ErrTransferRecipientIsOwner
+	}
+
+	return nil
+}
+
+// checkOwnershipTransferPending returns an error when the transfer was already accepted, declined, canceled or expired
+func checkOwnershipTransferPending(ctx context.Context, m *generated.OwnershipTransferMutation) error {
+	id, ok := m.ID()
+	if !ok {
+		return nil
+	}
+
+	t, err := m.Client().OwnershipTransfer.Get(privacy.DecisionContext(ctx, privacy.Allow), id)
+	if err != nil {
+		return err
+	}
+
+	if t.Status != ownershiptransfer.StatusPending {
+		return ErrOwnershipTransferNotPending
+	}
+
+	return nil
+}
+
+// transferOwnership makes the recipient of the transfer an owner of the organization, or admin of the group, and
+// the requestor an admin of the organization, or member of the group. The relationship tuples of both users are
+// swapped in a single write so the organization or group is never left without an owner
+func transferOwnership(ctx context.Context, m *generated.OwnershipTransferMutation, t *generated.OwnershipTransfer) error {
+	// access to accept the transfer was checked by the privacy policy, and the tuples of the memberships
+	// are written below
+	allowCtx := skipMembershipTuples(privacy.DecisionContext(ctx, privacy.Allow))
+
+	var (
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+		err     error
+	)
+
+	if t.GroupID != "" {
+		writes, deletes, err = transferGroup(allowCtx, m.Client(), t)
+	} else {
+		writes, deletes, err = transferOrg(allowCtx, m.Client(), t)
+	}
+
+	if err != nil {
+		m.Logger.Errorw("failed to transfer ownership", "error", err)
+
+		return err
+	}
+
+	if m.Authz.Ofga != nil {
+		if _, err := m.Authz.UpdateRelationshipTuple(ctx, writes, deletes); err != nil {
+			m.Logger.Errorw("failed to update relationship tuples", "error", err)
+
+			return ErrInternalServerError
+		}
+	}
+
+	m.Logger.Infow("transferred ownership", "organization_id", t.OwnerID, "group_id", t.GroupID,
+		"requestor_id", t.RequestorID, "recipient_id", t.RecipientID)
+
+	return nil
+}
+
+// transferOrg updates the roles of the memberships of the recipient and requestor of the transfer of an
+// organization, and returns the relationship tuples to write and delete
+func transferOrg(ctx context.Context, client *generated.Client, t *generated.OwnershipTransfer) ([]ofgaclient.ClientTupleKey, []openfga.TupleKeyWithoutCondition, error) {
+	var (
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+	)
+
+	recipient, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(t.OwnerID),
+			orgmembership.UserID(t.RecipientID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil, ErrTransferRecipientNotOrgMember
+		}
+
+		return nil, nil, err
+	}
+
+	if recipient.Role != orgmembership.RoleOwner {
+		if err := client.OrgMembership.UpdateOne(recipient).
+			SetRole(orgmembership.RoleOwner).
+			Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		writes = append(writes, orgMembershipTuple(t.RecipientID, t.OwnerID, orgmembership.RoleOwner))
+		deletes = append(deletes, tupleKeyWithoutCondition(orgMembershipTuple(t.RecipientID, t.OwnerID, recipient.Role)))
+	}
+
+	// the requestor is no longer an owner when they were given another role, or left the organization,
+	// after they requested the transfer
+	requestor, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(t.OwnerID),
+			orgmembership.UserID(t.RequestorID),
+			orgmembership.RoleEQ(orgmembership.RoleOwner),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return writes, deletes, nil
+		}
+
+		return nil, nil, err
+	}
+
+	if err := client.OrgMembership.UpdateOne(requestor).
+		SetRole(orgmembership.RoleAdmin).
+		Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	writes = append(writes, orgMembershipTuple(t.RequestorID, t.OwnerID, orgmembership.RoleAdmin))
+	deletes = append(deletes, tupleKeyWithoutCondition(orgMembershipTuple(t.RequestorID, t.OwnerID, orgmembership.RoleOwner)))
+
+	return writes, deletes, nil
+}
+
+// transferGroup updates, or creates, the membership of the recipient and updates the membership of the
+// requestor of the transfer of a group, and returns the relationship tuples to write and delete
+func transferGroup(ctx context.Context, client *generated.Client, t *generated.OwnershipTransfer) ([]ofgaclient.ClientTupleKey, []openfga.TupleKeyWithoutCondition, error) {
+	var (
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+	)
+
+	recipient, err := client.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(t.GroupID),
+			groupmembership.UserID(t.RecipientID),
+		).
+		Only(ctx)
+
+	switch {
+	case generated.IsNotFound(err):
+		// recipients who are not a member of the group join it as an admin
+		if err := client.GroupMembership.Create().
+			SetGroupID(t.GroupID).
+			SetUserID(t.RecipientID).
+			SetRole(groupmembership.RoleAdmin).
+			Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		writes = append(writes, groupMembershipTuple(t.RecipientID, t.GroupID, groupmembership.RoleAdmin))
+	case err != nil:
+		return nil, nil, err
+	case recipient.Role != groupmembership.RoleAdmin:
+		if err := client.GroupMembership.UpdateOne(recipient).
+			SetRole(groupmembership.RoleAdmin).
+			Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		writes = append(writes, groupMembershipTuple(t.RecipientID, t.GroupID, groupmembership.RoleAdmin))
+		deletes = append(deletes, tupleKeyWithoutCondition(groupMembershipTuple(t.RecipientID, t.GroupID, recipient.Role)))
+	}
+
+	// the requestor is no longer an admin when they were given another role, or left the group, after they
+	// requested the transfer
+	requestor, err := client.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(t.GroupID),
+			groupmembership.UserID(t.RequestorID),
+			groupmembership.RoleEQ(groupmembership.RoleAdmin),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return writes, deletes, nil
+		}
+
+		return nil, nil, err
+	}
+
+	if err := client.GroupMembership.UpdateOne(requestor).
+		SetRole(groupmembership.RoleMember).
+		Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	writes = append(writes, groupMembershipTuple(t.RequestorID, t.GroupID, groupmembership.RoleMember))
+	deletes = append(deletes, tupleKeyWithoutCondition(groupMembershipTuple(t.RequestorID, t.GroupID, groupmembership.RoleAdmin)))
+
+	return writes, deletes, nil
+}
+
+// tupleKeyWithoutCondition returns the tuple key of the relationship tuple to delete it
+func tupleKeyWithoutCondition(t ofgaclient.ClientTupleKey) openfga.TupleKeyWithoutCondition {
+	return openfga.TupleKeyWithoutCondition{
+		User:     t.User,
+		Relation: t.Relation,
+		Object:   t.Object,
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // HookPersonalAccessToken runs on accesstoken mutations and sets expires
@@ -23,3 +25,56 @@ func HookPersonalAccessToken() ent.Hook {
 		})
 	}, ent.OpCreate)
 }
+
+// HookPersonalAccessTokenAbilities runs on accesstoken mutations and ensures the abilities are valid scopes,
+// a token restricted to scopes can only create or update tokens with a subset of its own scopes
+func HookPersonalAccessTokenAbilities() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.PersonalAccessTokenFunc(func(ctx context.Context, mutation *generated.PersonalAccessTokenMutation) (generated.Value, error) {
+			abilities, ok := mutation.Abilities()
+			if ok {
+				if err := tokens.ValidateScopes(abilities); err != nil {
+					return nil, err
+				}
+			}
+
+			appended, appendOk := mutation.AppendedAbilities()
+			if appendOk {
+				if err := tokens.ValidateScopes(appended); err != nil {
+					return nil, err
+				}
+			}
+
+			switch {
+			case ok, mutation.Op().Is(ent.OpCreate):
+				// tokens created without abilities have the full access of their owner
+				if err := checkGrantableScopes(ctx, abilities); err != nil {
+					return nil, err
+				}
+			case mutation.AbilitiesCleared():
+				if err := checkGrantableScopes(ctx, nil); err != nil {
+					return nil, err
+				}
+			}
+
+			if appendOk {
+				if err := checkGrantableScopes(ctx, appended); err != nil {
+					return nil, err
+				}
+			}
+
+			return next.Mutate(ctx, mutation)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// checkGrantableScopes returns an error when the token of the request cannot grant the scopes to the token or
+// api key it creates, requests that are not authenticated with a token, e.g. internal requests, are not checked
+func checkGrantableScopes(ctx context.Context, scopes []string) error {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return claims.CanGrant(scopes)
+}
@@ -8,8 +8,15 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+	"github.com/datumforge/datum/internal/ent/generated/session"
 )
 
+// sessionRevokedReason is the reason recorded for the tokens of a revoked session
+const sessionRevokedReason = "session revoked"
+
 // HookSession runs on refresh token creation and sets expires fields
 func HookSession() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
@@ -23,3 +30,76 @@ func HookSession() ent.Hook {
 		})
 	}, ent.OpCreate)
 }
+
+// HookSessionRevoke runs on session updates and revokes the token pairs issued for the session when it
+// is revoked, so the access and refresh tokens of the session are rejected
+func HookSessionRevoke() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.SessionFunc(func(ctx context.Context, mutation *generated.SessionMutation) (generated.Value, error) {
+			if _, ok := mutation.RevokedAt(); !ok {
+				return next.Mutate(ctx, mutation)
+			}
+
+			// the sessions are looked up before the update, access to them is checked by the mutation policy
+			ids, err := mutation.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, mutation)
+			if err != nil {
+				return v, err
+			}
+
+			allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+			sessions, err := mutation.Client().Session.Query().Where(session.IDIn(ids...)).All(allowCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, s := range sessions {
+				if err := revokeSessionTokens(allowCtx, mutation.Client(), s); err != nil {
+					return nil, err
+				}
+			}
+
+			return v, nil
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// revokeSessionTokens adds the unexpired refresh tokens of the session token family to the revocation list
+func revokeSessionTokens(ctx context.Context, client *generated.Client, s *generated.Session) error {
+	rts, err := client.RefreshToken.Query().
+		Where(
+			refreshtoken.FamilyID(s.SessionToken),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range rts {
+		exists, err := client.RevokedToken.Query().Where(revokedtoken.Jti(rt.Jti)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		if err := client.RevokedToken.Create().
+			SetJti(rt.Jti).
+			SetUserID(rt.UserID).
+			SetReason(sessionRevokedReason).
+			SetExpiresAt(rt.ExpiresAt).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
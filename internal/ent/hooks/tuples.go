@@ -18,8 +18,11 @@ func createTupleFromUserContext(ctx context.Context, c *fga.Client, relation, ob
 		return nil, err
 	}
 
+	// the actor may be a user or a service account using an organization api key
+	subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 	tuples := []ofgaclient.ClientTupleKey{{
-		User:     fmt.Sprintf("user:%s", actor),
+		User:     fmt.Sprintf("%s:%s", subjectType, actor),
 		Relation: relation,
 		Object:   object,
 	}}
@@ -3,6 +3,7 @@ package hooks
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent"
 	petname "github.com/dustinkirkland/golang-petname"
@@ -11,8 +12,8 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
-	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/utils/gravatar"
 )
@@ -26,17 +27,9 @@ func HookUser() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
 		return hook.UserFunc(func(ctx context.Context, mutation *generated.UserMutation) (generated.Value, error) {
 			if password, ok := mutation.Password(); ok {
-				// validate password before its encrypted
-				if passwd.Strength(password) < passwd.Moderate {
-					return nil, auth.ErrPasswordTooWeak
-				}
-
-				hash, err := passwd.CreateDerivedKey(password)
-				if err != nil {
+				if err := setPassword(ctx, mutation, password); err != nil {
 					return nil, err
 				}
-
-				mutation.SetPassword(hash)
 			}
 
 			if email, ok := mutation.Email(); ok {
@@ -101,6 +94,60 @@ func HookUser() ent.Hook {
 	}, ent.OpCreate|ent.OpUpdateOne)
 }
 
+// setPassword validates the password against the password policy before it is hashed, passwords in the
+// password history of the user cannot be reused. The hash is added to the password history and the time
+// the password changed is recorded
+func setPassword(ctx context.Context, mutation *generated.UserMutation, password string) error {
+	policy := mutation.PasswordPolicy
+	if policy == nil {
+		policy = passwd.DefaultPolicy()
+	}
+
+	if err := policy.Validate(password); err != nil {
+		return err
+	}
+
+	var history []string
+
+	if mutation.Op().Is(ent.OpUpdateOne) {
+		// the current password may be read by the user updating their own password only
+		allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+		var err error
+
+		if history, err = mutation.OldPasswordHistory(allowCtx); err != nil {
+			return err
+		}
+
+		// users that set their password before the history was recorded have their current password checked
+		if len(history) == 0 {
+			current, err := mutation.OldPassword(allowCtx)
+			if err != nil {
+				return err
+			}
+
+			if current != nil {
+				history = []string{*current}
+			}
+		}
+
+		if err := policy.CheckReuse(password, history); err != nil {
+			return err
+		}
+	}
+
+	hash, err := passwd.CreateDerivedKey(password)
+	if err != nil {
+		return err
+	}
+
+	mutation.SetPassword(hash)
+	mutation.SetPasswordHistory(policy.History(hash, history))
+	mutation.SetPasswordChangedAt(time.Now())
+
+	return nil
+}
+
 // getPersonalOrgInput generates the input for a new personal organization
 // personal orgs are assigned to all new users when registering with Datum
 func getPersonalOrgInput(user *generated.User) generated.CreateOrganizationInput {
@@ -113,26 +160,22 @@ func getPersonalOrgInput(user *generated.User) generated.CreateOrganizationInput
 	personalOrg := true
 	desc := fmt.Sprintf("%s - %s %s", personalOrgPrefix, caser.String(user.FirstName), caser.String(user.LastName))
 
-	// add user to the users of the personal organization
-	users := []string{user.ID}
-
 	return generated.CreateOrganizationInput{
 		Name:        name,
 		DisplayName: &displayName,
 		Description: &desc,
 		PersonalOrg: &personalOrg,
-		UserIDs:     users,
 	}
 }
 
-// createPersonalOrg creates an org for a user with a unique random name
+// createPersonalOrg creates an org for a user with a unique random name, the user is the owner of the org
 func createPersonalOrg(ctx context.Context, dbClient *generated.Client, user *generated.User) error {
 	// this prevents a privacy check that would be required for regular orgs, but not a personal org
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
 
 	orgInput := getPersonalOrgInput(user)
 
-	_, err := dbClient.Organization.Create().SetInput(orgInput).Save(ctx)
+	org, err := dbClient.Organization.Create().SetInput(orgInput).Save(ctx)
 	if err != nil {
 		// retry on unique constraint
 		if generated.IsConstraintError(err) {
@@ -140,9 +183,21 @@ func createPersonalOrg(ctx context.Context, dbClient *generated.Client, user *ge
 		}
 
 		user.Logger.Errorw("unable to create personal org", "error", err.Error())
+
+		return err
+	}
+
+	if err := dbClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(user.ID).
+		SetRole(orgmembership.RoleOwner).
+		Exec(ctx); err != nil {
+		user.Logger.Errorw("unable to add user to personal org", "error", err.Error())
+
+		return err
 	}
 
-	return err
+	return nil
 }
 
 // defaultUserSettings creates the default user settings for a new user
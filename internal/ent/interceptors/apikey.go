@@ -0,0 +1,26 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorAPIKey is middleware to change the APIKey query, api keys are looked up with an allow decision before
+// there is a subject on the request, e.g. when a key is validated as a bearer token, those lookups are not filtered
+func InterceptorAPIKey() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.APIKeyQuery]{
+		name: "api key",
+		where: func(q *generated.APIKeyQuery, orgIDs []string) {
+			q.Where(apikey.HasOwnerWith(organization.IDIn(orgIDs...)))
+		},
+		objectType: "organization",
+		relation:   fga.CanView,
+		access: func(q *generated.APIKeyQuery, objectIDs []string, userID string) {
+			q.Where(apikey.OwnerIDIn(objectIDs...))
+		},
+	})
+}
@@ -2,12 +2,18 @@ package interceptors
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"entgo.io/ent"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/intercept"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/fga"
 	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 )
@@ -16,6 +22,33 @@ import (
 func InterceptorGroup() ent.Interceptor {
 	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
 		return intercept.GroupFunc(func(ctx context.Context, q *generated.GroupQuery) (generated.Value, error) {
+			// groups are looked up with an allow decision by the hooks and privacy rules to check the
+			// organization and settings of a group, those lookups are not filtered
+			// an allow decision is returned as a nil error
+			if decision, ok := privacy.DecisionFromContext(ctx); ok && decision == nil {
+				return next.Query(ctx, q)
+			}
+
+			// filter to the groups of the organization of the token, and its descendant organizations, by default
+			orgIDs, err := tokenOrgIDs(ctx, q.Client())
+			if err != nil {
+				return nil, err
+			}
+
+			if orgIDs != nil {
+				q.Where(group.HasOwnerWith(organization.IDIn(orgIDs...)))
+			}
+
+			// private groups are only visible to their members
+			if auth.GetSubjectTypeFromContext(ctx) == fga.UserSubjectType {
+				if userID, err := auth.GetUserIDFromContext(ctx); err == nil {
+					q.Where(group.Or(
+						group.HasSettingWith(groupsetting.VisibilityEQ(groupsetting.VisibilityPublic)),
+						group.HasMembersWith(groupmembership.UserID(userID)),
+					))
+				}
+			}
+
 			// We only care these checks with authz is enabled, if this is empty skip interception checks
 			if q.Authz.Ofga != nil {
 				// run the query
@@ -32,7 +65,8 @@ func InterceptorGroup() ent.Interceptor {
 	})
 }
 
-// filterGroupsByAccess checks fga, using ListObjects, and ensure user has view access to a group before it is returned
+// filterGroupsByAccess checks fga, using ListObjects, and ensure user has view access to a group before it is returned,
+// public groups are visible to the members of the organization of the group
 func filterGroupsByAccess(ctx context.Context, q *generated.GroupQuery, v ent.Value) ([]*generated.Group, error) {
 	q.Logger.Debugw("intercepting list group query")
 
@@ -50,20 +84,35 @@ func filterGroupsByAccess(ctx context.Context, q *generated.GroupQuery, v ent.Va
 		return nil, err
 	}
 
+	subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 	// See all groups user has view access
-	groupList, err := q.Authz.ListObjectsRequest(ctx, userID, "group", fga.CanView)
+	groupList, err := q.Authz.ListObjectsRequest(ctx, userID, subjectType, "group", fga.CanView)
 	if err != nil {
 		return nil, err
 	}
 
 	userGroups := groupList.GetObjects()
 
+	var nonMemberGroups []string
+
+	for _, g := range groups {
+		if !fga.ListContains("group", userGroups, g.ID) {
+			nonMemberGroups = append(nonMemberGroups, g.ID)
+		}
+	}
+
+	publicGroups, err := publicGroupsInViewableOrgs(ctx, q, userID, subjectType, nonMemberGroups)
+	if err != nil {
+		return nil, err
+	}
+
 	var accessibleGroups []*generated.Group
 
 	for _, g := range groups {
 		entityType := strings.ToLower(g.Update().Mutation().Type())
 
-		if !fga.ListContains(entityType, userGroups, g.ID) {
+		if !fga.ListContains(entityType, userGroups, g.ID) && !slices.Contains(publicGroups, g.ID) {
 			q.Logger.Infow("access denied to group", "relation", fga.CanView, "group_id", g.ID, "type", entityType)
 
 			continue
@@ -76,3 +125,46 @@ func filterGroupsByAccess(ctx context.Context, q *generated.GroupQuery, v ent.Va
 	// return updated groups
 	return accessibleGroups, nil
 }
+
+// publicGroupsInViewableOrgs returns the groups, of the group ids, that are public and owned by an organization
+// the user has view access to
+func publicGroupsInViewableOrgs(ctx context.Context, q *generated.GroupQuery, userID, subjectType string, groupIDs []string) ([]string, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	orgList, err := q.Authz.ListObjectsRequest(ctx, userID, subjectType, "organization", fga.CanView)
+	if err != nil {
+		return nil, err
+	}
+
+	orgIDs := make([]string, 0, len(orgList.GetObjects()))
+	for _, o := range orgList.GetObjects() {
+		orgIDs = append(orgIDs, strings.TrimPrefix(o, "organization:"))
+	}
+
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+
+	// the groups were already returned by the query, the lookup of their settings and owners is not filtered,
+	// the groups are queried rather than their ids as the query may have fields selected already
+	public, err := q.Clone().
+		Where(
+			group.IDIn(groupIDs...),
+			group.HasSettingWith(groupsetting.VisibilityEQ(groupsetting.VisibilityPublic)),
+			group.HasOwnerWith(organization.IDIn(orgIDs...)),
+		).
+		Offset(0).
+		All(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(public))
+	for _, g := range public {
+		ids = append(ids, g.ID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,31 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorGroupJoinRequest is middleware to change the GroupJoinRequest query, requests are looked up with an
+// allow decision by the hooks and privacy rules to review them, those lookups are not filtered; a request is
+// returned to the user that made it or when the subject can edit its group
+func InterceptorGroupJoinRequest() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.GroupJoinRequestQuery]{
+		name: "group join request",
+		where: func(q *generated.GroupJoinRequestQuery, orgIDs []string) {
+			q.Where(groupjoinrequest.HasGroupWith(group.HasOwnerWith(organization.IDIn(orgIDs...))))
+		},
+		objectType: "group",
+		relation:   fga.CanEdit,
+		access: func(q *generated.GroupJoinRequestQuery, objectIDs []string, userID string) {
+			q.Where(groupjoinrequest.Or(
+				groupjoinrequest.UserID(userID),
+				groupjoinrequest.GroupIDIn(objectIDs...),
+			))
+		},
+	})
+}
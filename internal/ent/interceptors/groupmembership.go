@@ -0,0 +1,28 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorGroupMembership is middleware to change the GroupMembership query, memberships are looked up with an
+// allow decision by the hooks and privacy rules to check the members of a group, those lookups are not filtered; a
+// membership is returned when the subject can view its group
+func InterceptorGroupMembership() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.GroupMembershipQuery]{
+		name: "group membership",
+		where: func(q *generated.GroupMembershipQuery, orgIDs []string) {
+			q.Where(groupmembership.HasGroupWith(group.HasOwnerWith(organization.IDIn(orgIDs...))))
+		},
+		objectType: "group",
+		relation:   fga.CanView,
+		access: func(q *generated.GroupMembershipQuery, objectIDs []string, userID string) {
+			q.Where(groupmembership.GroupIDIn(objectIDs...))
+		},
+	})
+}
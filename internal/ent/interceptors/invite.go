@@ -0,0 +1,27 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorInvite is middleware to change the Invite query, invitations are looked up with an allow decision by
+// the invite handler when the invitee accepts them, before they are a member of the organization, those lookups are
+// not filtered
+func InterceptorInvite() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.InviteQuery]{
+		name: "invite",
+		where: func(q *generated.InviteQuery, orgIDs []string) {
+			q.Where(invite.HasOwnerWith(organization.IDIn(orgIDs...)))
+		},
+		objectType: "organization",
+		relation:   fga.CanView,
+		access: func(q *generated.InviteQuery, objectIDs []string, userID string) {
+			q.Where(invite.OwnerIDIn(objectIDs...))
+		},
+	})
+}
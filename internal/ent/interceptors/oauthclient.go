@@ -0,0 +1,27 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorOauthClient is middleware to change the OauthClient query, oauth clients are looked up with an allow
+// decision before there is a subject on the request, e.g. when a client authenticates to the token endpoint, those
+// lookups are not filtered
+func InterceptorOauthClient() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.OauthClientQuery]{
+		name: "oauth client",
+		where: func(q *generated.OauthClientQuery, orgIDs []string) {
+			q.Where(oauthclient.HasOwnerWith(organization.IDIn(orgIDs...)))
+		},
+		objectType: "organization",
+		relation:   fga.CanView,
+		access: func(q *generated.OauthClientQuery, objectIDs []string, userID string) {
+			q.Where(oauthclient.OwnerIDIn(objectIDs...))
+		},
+	})
+}
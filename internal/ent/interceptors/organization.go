@@ -8,6 +8,9 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/intercept"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/fga"
 	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 )
@@ -16,6 +19,23 @@ import (
 func InterceptorOrganization() ent.Interceptor {
 	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
 		return intercept.OrganizationFunc(func(ctx context.Context, q *generated.OrganizationQuery) (generated.Value, error) {
+			// organizations are looked up with an allow decision to check access to them, e.g. the parent of
+			// an organization, those lookups are not filtered
+			// an allow decision is returned as a nil error
+			if decision, ok := privacy.DecisionFromContext(ctx); ok && decision == nil {
+				return next.Query(ctx, q)
+			}
+
+			// filter to the organization of the token, and its descendant organizations, by default
+			orgIDs, err := tokenOrgIDs(ctx, q.Client())
+			if err != nil {
+				return nil, err
+			}
+
+			if orgIDs != nil {
+				q.Where(organization.IDIn(orgIDs...))
+			}
+
 			// We only care these checks with authz is enabled, if this is empty skip interception checks
 			if q.Authz.Ofga != nil {
 				// run the query
@@ -32,6 +52,28 @@ func InterceptorOrganization() ent.Interceptor {
 	})
 }
 
+// tokenOrgIDs returns the organizations queries are filtered to by default, the organization the token of the
+// request is scoped to and all of its descendant organizations; nil is returned when the queries are not filtered,
+// either because the token is not scoped to an organization or the query has an allow decision, such as internal lookups
+func tokenOrgIDs(ctx context.Context, client *generated.Client) ([]string, error) {
+	// an allow decision is returned as a nil error
+	if decision, ok := privacy.DecisionFromContext(ctx); ok && decision == nil {
+		return nil, nil
+	}
+
+	orgID := auth.GetOrganizationIDFromContext(ctx)
+	if orgID == "" {
+		return nil, nil
+	}
+
+	descendants, err := hooks.OrganizationDescendantIDs(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{orgID}, descendants...), nil
+}
+
 // filterOrgsByAccess checks fga, using ListObjects, and ensure user has view access to an org before it is returned
 // this checks both the org itself and any parent org in the request
 func filterOrgsByAccess(ctx context.Context, q *generated.OrganizationQuery, v ent.Value) ([]*generated.Organization, error) {
@@ -51,8 +93,10 @@ func filterOrgsByAccess(ctx context.Context, q *generated.OrganizationQuery, v e
 		return nil, err
 	}
 
+	subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 	// See all orgs user has view access
-	orgList, err := q.Authz.ListObjectsRequest(ctx, userID, "organization", fga.CanView)
+	orgList, err := q.Authz.ListObjectsRequest(ctx, userID, subjectType, "organization", fga.CanView)
 	if err != nil {
 		return nil, err
 	}
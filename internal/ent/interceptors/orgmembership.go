@@ -0,0 +1,26 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorOrgMembership is middleware to change the OrgMembership query, memberships are looked up with an allow
+// decision by the hooks and privacy rules to check the owners of an organization, those lookups are not filtered
+func InterceptorOrgMembership() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.OrgMembershipQuery]{
+		name: "org membership",
+		where: func(q *generated.OrgMembershipQuery, orgIDs []string) {
+			q.Where(orgmembership.HasOrganizationWith(organization.IDIn(orgIDs...)))
+		},
+		objectType: "organization",
+		relation:   fga.CanView,
+		access: func(q *generated.OrgMembershipQuery, objectIDs []string, userID string) {
+			q.Where(orgmembership.OrganizationIDIn(objectIDs...))
+		},
+	})
+}
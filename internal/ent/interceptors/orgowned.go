@@ -0,0 +1,115 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// orgOwnedQuery is a query of a schema owned by an organization, directly or through one of its groups
+type orgOwnedQuery interface {
+	generated.Query
+	Client() *generated.Client
+}
+
+// orgOwnedFilter describes how the queries of a schema owned by an organization are filtered
+type orgOwnedFilter[Q orgOwnedQuery] struct {
+	// name of the schema used in the logs, e.g. api key
+	name string
+	// where filters the query to the results owned by the organizations
+	where func(q Q, orgIDs []string)
+	// objectType and relation the subject must have in fga to the object of a result for it to be returned
+	objectType string
+	relation   string
+	// access filters the query to the results of the fga objects the subject has access to, or that belong to
+	// the subject
+	access func(q Q, objectIDs []string, userID string)
+}
+
+// interceptOrgOwned returns the interceptor of the queries of a schema owned by an organization; lookups with an
+// allow decision are not filtered, other queries are filtered to the organization of the token and its descendant
+// organizations and then, when authz is enabled, to the results the subject can access in fga. The filters are
+// added as predicates so every kind of query is filtered, e.g. counts
+func interceptOrgOwned[Q orgOwnedQuery](f orgOwnedFilter[Q]) ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, query generated.Query) (generated.Value, error) {
+			q, ok := query.(Q)
+			if !ok {
+				return nil, fmt.Errorf("unexpected query type %T", query)
+			}
+
+			// an allow decision is returned as a nil error
+			if decision, ok := privacy.DecisionFromContext(ctx); ok && decision == nil {
+				return next.Query(ctx, q)
+			}
+
+			client := q.Client()
+
+			// filter to the organization of the token, and its descendant organizations, by default
+			orgIDs, err := tokenOrgIDs(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+
+			if orgIDs != nil {
+				f.where(q, orgIDs)
+			}
+
+			// We only care these checks with authz is enabled, if this is empty skip interception checks
+			if client.Authz.Ofga != nil {
+				if err := filterOrgOwnedByAccess(ctx, client, f, q); err != nil {
+					return nil, err
+				}
+			}
+
+			return next.Query(ctx, q)
+		})
+	})
+}
+
+// filterOrgOwnedByAccess checks fga, using ListObjects, and filters the query to the results of the objects the
+// user has access to
+func filterOrgOwnedByAccess[Q orgOwnedQuery](ctx context.Context, client *generated.Client, f orgOwnedFilter[Q], q Q) error {
+	client.Logger.Debugw("intercepting " + f.name + " query")
+
+	// get userID for tuple checks
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		client.Logger.Errorw("unable to get user id from echo context")
+		return err
+	}
+
+	subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+	// See all objects user has access to
+	objectList, err := client.Authz.ListObjectsRequest(ctx, userID, subjectType, f.objectType, f.relation)
+	if err != nil {
+		return err
+	}
+
+	f.access(q, listObjectIDs(f.objectType, objectList.GetObjects()), userID)
+
+	return nil
+}
+
+// listObjectIDs returns the identifiers of the objects of the entity type in the ListObjects response
+func listObjectIDs(entityType string, objects []string) []string {
+	ids := []string{}
+
+	for _, o := range objects {
+		e, err := fga.ParseEntity(o)
+		if err != nil || e.Kind.String() != entityType {
+			continue
+		}
+
+		ids = append(ids, e.Identifier)
+	}
+
+	return ids
+}
@@ -0,0 +1,26 @@
+package interceptors
+
+import (
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/fga"
+)
+
+// InterceptorOwnershipTransfer is middleware to change the OwnershipTransfer query, transfers are looked up with an
+// allow decision by the transfer handler when the recipient accepts them, those lookups are not filtered
+func InterceptorOwnershipTransfer() ent.Interceptor {
+	return interceptOrgOwned(orgOwnedFilter[*generated.OwnershipTransferQuery]{
+		name: "ownership transfer",
+		where: func(q *generated.OwnershipTransferQuery, orgIDs []string) {
+			q.Where(ownershiptransfer.HasOwnerWith(organization.IDIn(orgIDs...)))
+		},
+		objectType: "organization",
+		relation:   fga.CanView,
+		access: func(q *generated.OwnershipTransferQuery, objectIDs []string, userID string) {
+			q.Where(ownershiptransfer.OwnerIDIn(objectIDs...))
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apikey"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasAPIKeyMutationAccess is a rule that returns allow decision if the user has edit access in the organizations
+// that own the api keys; service accounts cannot manage api keys so a key cannot be used to create more keys
+func HasAPIKeyMutationAccess() privacy.APIKeyMutationRuleFunc {
+	return privacy.APIKeyMutationRuleFunc(func(ctx context.Context, m *generated.APIKeyMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+		if subjectType == fga.ServiceSubjectType {
+			return privacy.Denyf("service accounts cannot manage api keys")
+		}
+
+		orgIDs, err := apiKeyOwnerIDs(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		if len(orgIDs) == 0 {
+			return privacy.Skipf("no owner set on request, cannot check access")
+		}
+
+		for _, oID := range orgIDs {
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+				return err
+			}
+
+			m.Logger.Infow("checking relationship tuples", "relation", fga.CanEdit, "organization_id", oID)
+
+			access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, fga.CanEdit)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to organization", "relation", fga.CanEdit, "organization_id", oID)
+
+				return privacy.Deny
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// apiKeyOwnerIDs returns the organizations that own the api keys in the mutation, from the input on create
+// and from the existing keys on update and delete
+func apiKeyOwnerIDs(ctx context.Context, m *generated.APIKeyMutation) ([]string, error) {
+	if m.Op().Is(ent.OpCreate) {
+		if oID, ok := m.OwnerID(); ok {
+			return []string{oID}, nil
+		}
+
+		return nil, nil
+	}
+
+	// the keys are looked up without filtering, access is checked on their organizations
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().APIKey.Query().
+		Where(apikey.IDIn(ids...)).
+		Unique(true).
+		Select(apikey.FieldOwnerID).
+		Strings(allowCtx)
+}
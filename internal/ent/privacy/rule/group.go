@@ -7,6 +7,8 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 	"github.com/datumforge/datum/internal/fga"
@@ -31,9 +33,11 @@ func HasGroupReadAccess() privacy.GroupQueryRuleFunc {
 				return err
 			}
 
+			subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 			q.Logger.Infow("checking relationship tuples", "relation", fga.CanView, "group_id", gID)
 
-			access, err := q.Authz.CheckGroupAccess(ctx, userID, gID, fga.CanView)
+			access, err := q.Authz.CheckGroupAccess(ctx, userID, subjectType, gID, fga.CanView)
 			if err != nil {
 				return privacy.Skipf("unable to check access, %s", err.Error())
 			}
@@ -43,6 +47,33 @@ func HasGroupReadAccess() privacy.GroupQueryRuleFunc {
 
 				return privacy.Allow
 			}
+
+			// public groups are visible to the members of the organization of the group
+			oID, err := q.Clone().
+				Where(
+					group.ID(gID),
+					group.HasSettingWith(groupsetting.VisibilityEQ(groupsetting.VisibilityPublic)),
+				).
+				QueryOwner().
+				OnlyID(privacy.DecisionContext(ctx, privacy.Allow))
+			if err != nil {
+				if generated.IsNotFound(err) {
+					return privacy.Skip
+				}
+
+				return err
+			}
+
+			access, err = q.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, fga.CanView)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if access {
+				q.Logger.Infow("access allowed to public group", "relation", fga.CanView, "organization_id", oID)
+
+				return privacy.Allow
+			}
 		}
 
 		// Skip to the next privacy rule (equivalent to return nil)
@@ -76,9 +107,26 @@ func HasGroupMutationAccess() privacy.GroupMutationRuleFunc {
 			return err
 		}
 
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		if auth.GetOrganizationIDFromContext(ctx) != "" {
+			// the group is looked up without filtering to check the organization that owns it
+			oID, err := m.Client().Group.Query().
+				Where(group.ID(gID)).
+				QueryOwner().
+				OnlyID(privacy.DecisionContext(ctx, privacy.Allow))
+			if err != nil {
+				return err
+			}
+
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+				return err
+			}
+		}
+
 		m.Logger.Infow("checking relationship tuples", "relation", relation, "group_id", gID)
 
-		access, err := m.Authz.CheckGroupAccess(ctx, userID, gID, relation)
+		access, err := m.Authz.CheckGroupAccess(ctx, userID, subjectType, gID, relation)
 		if err != nil {
 			return privacy.Skipf("unable to check access, %s", err.Error())
 		}
@@ -114,9 +162,15 @@ func CanCreateGroupsInOrg() privacy.GroupMutationRuleFunc {
 			return err
 		}
 
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+			return err
+		}
+
 		m.Logger.Infow("checking relationship tuples", "relation", relation, "organization_id", oID)
 
-		access, err := m.Authz.CheckOrgAccess(ctx, userID, oID, relation)
+		access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, relation)
 		if err != nil {
 			return privacy.Skipf("unable to check access, %s", err.Error())
 		}
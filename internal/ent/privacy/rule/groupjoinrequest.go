@@ -0,0 +1,92 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasGroupJoinRequestMutationAccess is a rule that returns allow decision if the user requests to join a group
+// themselves, or has edit access in the groups of the requests to approve or decline them. Users can withdraw
+// their own requests
+func HasGroupJoinRequestMutationAccess() privacy.GroupJoinRequestMutationRuleFunc {
+	return privacy.GroupJoinRequestMutationRuleFunc(func(ctx context.Context, m *generated.GroupJoinRequestMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		if m.Op().Is(ent.OpCreate) {
+			gID, ok := m.GroupID()
+			if !ok {
+				return privacy.Skipf("no group set on request, cannot check access")
+			}
+
+			// only users request to join groups, for themselves
+			if uID, ok := m.UserID(); subjectType != fga.UserSubjectType || (ok && uID != userID) {
+				return privacy.Deny
+			}
+
+			if err := denyIfGroupOutsideTokenOrg(ctx, m.Client(), gID); err != nil {
+				return err
+			}
+
+			return privacy.Allow
+		}
+
+		// the requests are looked up without filtering, access is checked on their groups
+		allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+		ids, err := m.IDs(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		requests, err := m.Client().GroupJoinRequest.Query().
+			Where(groupjoinrequest.IDIn(ids...)).
+			All(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if len(requests) == 0 {
+			return privacy.Skipf("no group set on request, cannot check access")
+		}
+
+		for _, jr := range requests {
+			if err := denyIfGroupOutsideTokenOrg(ctx, m.Client(), jr.GroupID); err != nil {
+				return err
+			}
+
+			// users withdraw their own requests
+			if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && subjectType == fga.UserSubjectType && jr.UserID == userID {
+				continue
+			}
+
+			m.Logger.Infow("checking relationship tuples", "relation", fga.CanEdit, "group_id", jr.GroupID)
+
+			access, err := m.Authz.CheckGroupAccess(ctx, userID, subjectType, jr.GroupID, fga.CanEdit)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to group", "relation", fga.CanEdit, "group_id", jr.GroupID)
+
+				return privacy.Deny
+			}
+		}
+
+		return privacy.Allow
+	})
+}
@@ -0,0 +1,160 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasGroupMembershipMutationAccess is a rule that returns allow decision if the user has edit access in the
+// groups of the memberships, so group admins can add, update and remove members. Users can join groups with
+// an open join policy and leave groups themselves, and groups that only accept members through requests to
+// join cannot have members added by their admins
+func HasGroupMembershipMutationAccess() privacy.GroupMembershipMutationRuleFunc {
+	return privacy.GroupMembershipMutationRuleFunc(func(ctx context.Context, m *generated.GroupMembershipMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		memberships, err := groupMemberships(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		if len(memberships) == 0 {
+			return privacy.Skipf("no group set on request, cannot check access")
+		}
+
+		ownMembership := subjectType == fga.UserSubjectType && ownGroupMemberships(memberships, userID)
+
+		// users leaving a group do not need edit access, the last admin of a group cannot leave it
+		if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && ownMembership {
+			return privacy.Allow
+		}
+
+		for _, gm := range memberships {
+			if err := denyIfGroupOutsideTokenOrg(ctx, m.Client(), gm.GroupID); err != nil {
+				return err
+			}
+
+			var joinPolicy groupsetting.JoinPolicy
+
+			if m.Op().Is(ent.OpCreate) {
+				if joinPolicy, err = groupJoinPolicy(ctx, m.Client(), gm.GroupID); err != nil {
+					return err
+				}
+
+				// users join open groups as members themselves
+				if ownMembership && gm.Role == groupmembership.RoleMember && joinPolicy == groupsetting.JoinPolicyOpen {
+					continue
+				}
+			}
+
+			m.Logger.Infow("checking relationship tuples", "relation", fga.CanEdit, "group_id", gm.GroupID)
+
+			access, err := m.Authz.CheckGroupAccess(ctx, userID, subjectType, gm.GroupID, fga.CanEdit)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to group", "relation", fga.CanEdit, "group_id", gm.GroupID)
+
+				return privacy.Deny
+			}
+
+			if joinPolicy == groupsetting.JoinPolicyApplicationOnly {
+				return privacy.Denyf("group %s only accepts members through requests to join", gm.GroupID)
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// groupMemberships returns the memberships of the mutation, from the input on create and the existing
+// memberships on update and delete
+func groupMemberships(ctx context.Context, m *generated.GroupMembershipMutation) ([]*generated.GroupMembership, error) {
+	if m.Op().Is(ent.OpCreate) {
+		gID, ok := m.GroupID()
+		if !ok {
+			return nil, nil
+		}
+
+		uID, _ := m.UserID()
+
+		role, ok := m.Role()
+		if !ok {
+			role = groupmembership.DefaultRole
+		}
+
+		return []*generated.GroupMembership{{GroupID: gID, UserID: uID, Role: role}}, nil
+	}
+
+	// the memberships are looked up without filtering, access is checked on their groups
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().GroupMembership.Query().
+		Where(groupmembership.IDIn(ids...)).
+		All(allowCtx)
+}
+
+// ownGroupMemberships returns true when all the memberships are memberships of the user
+func ownGroupMemberships(memberships []*generated.GroupMembership, userID string) bool {
+	for _, gm := range memberships {
+		if gm.UserID != userID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// groupJoinPolicy returns the join policy in the settings of the group
+func groupJoinPolicy(ctx context.Context, client *generated.Client, groupID string) (groupsetting.JoinPolicy, error) {
+	setting, err := client.GroupSetting.Query().
+		Where(groupsetting.HasGroupWith(group.ID(groupID))).
+		Only(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return "", err
+	}
+
+	return setting.JoinPolicy, nil
+}
+
+// denyIfGroupOutsideTokenOrg returns a deny decision when the request is made with a token of an organization
+// that does not own the group, or is not the parent of the organization that owns it
+func denyIfGroupOutsideTokenOrg(ctx context.Context, client *generated.Client, groupID string) error {
+	if auth.GetOrganizationIDFromContext(ctx) == "" {
+		return nil
+	}
+
+	// the group is looked up without filtering to check the organization that owns it
+	oID, err := client.Group.Query().
+		Where(group.ID(groupID)).
+		QueryOwner().
+		OnlyID(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return err
+	}
+
+	return denyIfOutsideTokenOrg(ctx, client, oID)
+}
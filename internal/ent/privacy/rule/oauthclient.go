@@ -0,0 +1,87 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasOauthClientMutationAccess is a rule that returns allow decision if the user has edit access in the organizations
+// that own the oauth clients; service accounts cannot manage oauth clients so a client cannot be used to register more clients
+func HasOauthClientMutationAccess() privacy.OauthClientMutationRuleFunc {
+	return privacy.OauthClientMutationRuleFunc(func(ctx context.Context, m *generated.OauthClientMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+		if subjectType == fga.ServiceSubjectType {
+			return privacy.Denyf("service accounts cannot manage oauth clients")
+		}
+
+		orgIDs, err := oauthClientOwnerIDs(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		if len(orgIDs) == 0 {
+			return privacy.Skipf("no owner set on request, cannot check access")
+		}
+
+		for _, oID := range orgIDs {
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+				return err
+			}
+
+			m.Logger.Infow("checking relationship tuples", "relation", fga.CanEdit, "organization_id", oID)
+
+			access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, fga.CanEdit)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to organization", "relation", fga.CanEdit, "organization_id", oID)
+
+				return privacy.Deny
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// oauthClientOwnerIDs returns the organizations that own the oauth clients in the mutation, from the input on create
+// and from the existing clients on update and delete
+func oauthClientOwnerIDs(ctx context.Context, m *generated.OauthClientMutation) ([]string, error) {
+	if m.Op().Is(ent.OpCreate) {
+		if oID, ok := m.OwnerID(); ok {
+			return []string{oID}, nil
+		}
+
+		return nil, nil
+	}
+
+	// the clients are looked up without filtering, access is checked on their organizations
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().OauthClient.Query().
+		Where(oauthclient.IDIn(ids...)).
+		Unique(true).
+		Select(oauthclient.FieldOwnerID).
+		Strings(allowCtx)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 	"github.com/datumforge/datum/internal/fga"
@@ -31,9 +32,11 @@ func HasOrgReadAccess() privacy.OrganizationQueryRuleFunc {
 				return err
 			}
 
+			subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 			q.Logger.Infow("checking relationship tuples", "relation", fga.CanView, "organization_id", oID)
 
-			access, err := q.Authz.CheckOrgAccess(ctx, userID, oID, fga.CanView)
+			access, err := q.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, fga.CanView)
 			if err != nil {
 				return privacy.Skipf("unable to check access, %s", err.Error())
 			}
@@ -65,12 +68,23 @@ func HasOrgMutationAccess() privacy.OrganizationMutationRuleFunc {
 			return err
 		}
 
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
 		// No permissions checks on creation of org except if this is not a root org
 		if m.Op().Is(ent.OpCreate) {
+			// organizations must be owned by a user, service accounts act within their own organization
+			if subjectType == fga.ServiceSubjectType {
+				return privacy.Denyf("service accounts cannot create organizations")
+			}
+
 			parentOrgID, ok := m.ParentID()
 
 			if ok {
-				access, err := m.Authz.CheckOrgAccess(ctx, userID, parentOrgID, relation)
+				if err := denyIfOutsideTokenOrg(ctx, m.Client(), parentOrgID); err != nil {
+					return err
+				}
+
+				access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, parentOrgID, relation)
 				if err != nil {
 					return privacy.Skipf("unable to check access, %s", err.Error())
 				}
@@ -99,9 +113,34 @@ func HasOrgMutationAccess() privacy.OrganizationMutationRuleFunc {
 			return privacy.Denyf("missing organization ID information in viewer")
 		}
 
+		if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+			return err
+		}
+
+		// moving an organization under another parent changes who inherits access to it, so it requires delete
+		// access in the organization, and edit access in its new parent
+		if parentOrgID, ok := m.ParentID(); ok {
+			relation = fga.CanDelete
+
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), parentOrgID); err != nil {
+				return err
+			}
+
+			access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, parentOrgID, fga.CanEdit)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to parent org", "relation", fga.CanEdit, "organization_id", parentOrgID)
+
+				return privacy.Deny
+			}
+		}
+
 		m.Logger.Infow("checking relationship tuples", "relation", relation, "organization_id", oID)
 
-		access, err := m.Authz.CheckOrgAccess(ctx, userID, oID, relation)
+		access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, relation)
 		if err != nil {
 			return privacy.Skipf("unable to check access, %s", err.Error())
 		}
@@ -116,3 +155,47 @@ func HasOrgMutationAccess() privacy.OrganizationMutationRuleFunc {
 		return privacy.Deny
 	})
 }
+
+// denyIfOutsideTokenOrg returns a deny decision when the request is authenticated with a token scoped to an
+// organization and the organization is neither that organization nor one of its descendant organizations; tokens
+// that are not scoped to an organization can access every organization the subject has access to
+func denyIfOutsideTokenOrg(ctx context.Context, client *generated.Client, orgID string) error {
+	tokenOrgID := auth.GetOrganizationIDFromContext(ctx)
+	if tokenOrgID == "" || tokenOrgID == orgID {
+		return nil
+	}
+
+	// the organization is a descendant of the organization of the token when the token organization is one of
+	// its ancestors, the ancestors are looked up without filtering by walking up the parent organizations
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	id := orgID
+	seen := map[string]bool{id: true}
+
+	for {
+		org, err := client.Organization.Query().
+			Where(organization.ID(id)).
+			Select(organization.FieldParentOrganizationID).
+			Only(allowCtx)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				break
+			}
+
+			return err
+		}
+
+		id = org.ParentOrganizationID
+		if id == tokenOrgID {
+			return nil
+		}
+
+		if id == "" || seen[id] {
+			break
+		}
+
+		seen[id] = true
+	}
+
+	return privacy.Denyf("organization %s is outside the organization of the token", orgID)
+}
@@ -0,0 +1,111 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasOrgMembershipMutationAccess is a rule that returns allow decision if the user has edit access in the
+// organizations of the memberships, so organization admins and owners can add, update and remove members.
+// Owners are the only members that can make a user an owner, or change the role of or remove an owner,
+// and users can always remove themselves from an organization
+func HasOrgMembershipMutationAccess() privacy.OrgMembershipMutationRuleFunc {
+	return privacy.OrgMembershipMutationRuleFunc(func(ctx context.Context, m *generated.OrgMembershipMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		memberships, err := orgMemberships(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		if len(memberships) == 0 {
+			return privacy.Skipf("no organization set on request, cannot check access")
+		}
+
+		// users leaving an organization do not need edit access
+		if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && subjectType == fga.UserSubjectType && ownMemberships(memberships, userID) {
+			return privacy.Allow
+		}
+
+		role, roleSet := m.Role()
+
+		for _, om := range memberships {
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), om.OrganizationID); err != nil {
+				return err
+			}
+
+			relation := fga.CanEdit
+			if om.Role == orgmembership.RoleOwner || (roleSet && role == orgmembership.RoleOwner) {
+				relation = fga.CanDelete
+			}
+
+			m.Logger.Infow("checking relationship tuples", "relation", relation, "organization_id", om.OrganizationID)
+
+			access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, om.OrganizationID, relation)
+			if err != nil {
+				return privacy.Skipf("unable to check access, %s", err.Error())
+			}
+
+			if !access {
+				m.Logger.Debugw("access denied to organization", "relation", relation, "organization_id", om.OrganizationID)
+
+				return privacy.Deny
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// orgMemberships returns the memberships of the mutation, from the input on create and the existing memberships
+// on update and delete
+func orgMemberships(ctx context.Context, m *generated.OrgMembershipMutation) ([]*generated.OrgMembership, error) {
+	if m.Op().Is(ent.OpCreate) {
+		oID, ok := m.OrganizationID()
+		if !ok {
+			return nil, nil
+		}
+
+		uID, _ := m.UserID()
+		role, _ := m.Role()
+
+		return []*generated.OrgMembership{{OrganizationID: oID, UserID: uID, Role: role}}, nil
+	}
+
+	// the memberships are looked up without filtering, access is checked on their organizations
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	ids, err := m.IDs(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().OrgMembership.Query().
+		Where(orgmembership.IDIn(ids...)).
+		All(allowCtx)
+}
+
+// ownMemberships returns true when all the memberships are memberships of the user
+func ownMemberships(memberships []*generated.OrgMembership, userID string) bool {
+	for _, om := range memberships {
+		if om.UserID != userID {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,121 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// HasOwnershipTransferMutationAccess is a rule that returns allow decision if the user has delete access in the
+// organization, or group, they transfer, so only owners of organizations and admins of groups can transfer them.
+// Only the user who requested a transfer can cancel it, and transfers are never deleted as they are kept as
+// the audit trail of the owners
+func HasOwnershipTransferMutationAccess() privacy.OwnershipTransferMutationRuleFunc {
+	return privacy.OwnershipTransferMutationRuleFunc(func(ctx context.Context, m *generated.OwnershipTransferMutation) error {
+		m.Logger.Debugw("checking mutation access")
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		subjectType := auth.GetSubjectTypeFromContext(ctx)
+
+		// ownership is transferred from one user to another, service accounts are never owners
+		if subjectType != fga.UserSubjectType || m.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+			return privacy.Deny
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			return ownershipTransferCreateAccess(ctx, m, userID, subjectType)
+		}
+
+		// the transfers are looked up without filtering, only their requestor can cancel them
+		allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+		ids, err := m.IDs(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		transfers, err := m.Client().OwnershipTransfer.Query().
+			Where(ownershiptransfer.IDIn(ids...)).
+			All(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if len(transfers) == 0 {
+			return privacy.Skipf("no transfer set on request, cannot check access")
+		}
+
+		for _, t := range transfers {
+			if err := denyIfOutsideTokenOrg(ctx, m.Client(), t.OwnerID); err != nil {
+				return err
+			}
+
+			if t.RequestorID != userID {
+				m.Logger.Debugw("access denied to transfer", "transfer_id", t.ID)
+
+				return privacy.Deny
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// ownershipTransferCreateAccess returns allow decision if the user is an owner of the organization, or an admin of
+// the group, of the new transfer
+func ownershipTransferCreateAccess(ctx context.Context, m *generated.OwnershipTransferMutation, userID, subjectType string) error {
+	if gID, ok := m.GroupID(); ok {
+		if err := denyIfGroupOutsideTokenOrg(ctx, m.Client(), gID); err != nil {
+			return err
+		}
+
+		m.Logger.Infow("checking relationship tuples", "relation", fga.CanDelete, "group_id", gID)
+
+		access, err := m.Authz.CheckGroupAccess(ctx, userID, subjectType, gID, fga.CanDelete)
+		if err != nil {
+			return privacy.Skipf("unable to check access, %s", err.Error())
+		}
+
+		if !access {
+			m.Logger.Debugw("access denied to group", "relation", fga.CanDelete, "group_id", gID)
+
+			return privacy.Deny
+		}
+
+		return privacy.Allow
+	}
+
+	oID, ok := m.OwnerID()
+	if !ok {
+		return privacy.Skipf("no owner set on request, cannot check access")
+	}
+
+	if err := denyIfOutsideTokenOrg(ctx, m.Client(), oID); err != nil {
+		return err
+	}
+
+	m.Logger.Infow("checking relationship tuples", "relation", fga.CanDelete, "organization_id", oID)
+
+	access, err := m.Authz.CheckOrgAccess(ctx, userID, subjectType, oID, fga.CanDelete)
+	if err != nil {
+		return privacy.Skipf("unable to check access, %s", err.Error())
+	}
+
+	if !access {
+		m.Logger.Debugw("access denied to organization", "relation", fga.CanDelete, "organization_id", oID)
+
+		return privacy.Deny
+	}
+
+	return privacy.Allow
+}
@@ -0,0 +1,52 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// DenyIfMissingScope is a rule that returns deny decision if the request was authenticated with a token
+// restricted to scopes that do not include the resource; queries require read, create and update
+// mutations require write and delete mutations require admin. Requests that are not restricted to
+// scopes, such as users that logged in, are skipped so the remaining rules decide
+func DenyIfMissingScope(resource string) privacy.QueryMutationRule {
+	return scopeRule{resource: resource}
+}
+
+type scopeRule struct {
+	resource string
+}
+
+// EvalQuery requires read access to the resource
+func (r scopeRule) EvalQuery(ctx context.Context, _ ent.Query) error {
+	return checkScope(ctx, r.resource, tokens.ScopeActionRead)
+}
+
+// EvalMutation requires write access to the resource, or admin access to delete it
+func (r scopeRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	action := tokens.ScopeActionWrite
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		action = tokens.ScopeActionAdmin
+	}
+
+	return checkScope(ctx, r.resource, action)
+}
+
+func checkScope(ctx context.Context, resource, action string) error {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		// the request is not authenticated with a token, e.g. internal requests
+		return privacy.Skip
+	}
+
+	if !claims.HasScope(resource, action) {
+		return privacy.Denyf("token is missing the %s scope", tokens.Scope(resource, action))
+	}
+
+	return privacy.Skip
+}
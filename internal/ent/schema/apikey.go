@@ -0,0 +1,148 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// APIKey holds the schema definition for the APIKey entity, an organization owned key used by
+// service accounts to call the API as the organization rather than as a user
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner_id").
+			Comment("the organization that owns the key").
+			Immutable(),
+		field.String("name").
+			Comment("the name associated with the key").
+			NotEmpty(),
+		field.String("key_prefix").
+			Comment("the visible prefix of the key used to identify it, the key itself is only returned on creation").
+			Immutable().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("key_hash").
+			Comment("the salted hash of the key").
+			Sensitive().
+			Immutable().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.JSON("abilities", []string{}).
+			Comment("the scopes the key is restricted to, e.g. org:read or group:write; at least one scope is required and the scopes cannot be changed once the key is created").
+			Immutable(),
+		field.Time("expires_at").
+			Comment("when the key expires, a key without an expiration is valid until it is deleted").
+			Optional().
+			Nillable(),
+		field.String("description").
+			Comment("a description of the key's purpose").
+			Optional().
+			Default("").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.Time("last_used_at").
+			UpdateDefault(time.Now).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Edges of the APIKey
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Organization.Type).
+			Ref("api_keys").
+			Field("owner_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the APIKey
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// key prefixes should be unique, but ignore deleted keys
+		index.Fields("key_prefix").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Mixin of the APIKey
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.SoftDeleteMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
+	}
+}
+
+// Annotations of the APIKey
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), (entgql.MutationUpdate())),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the APIKey
+func (APIKey) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasAPIKeyMutationAccess(), // Requires edit access in the owning organization
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters keys to organizations the user can view
+		},
+	}
+}
+
+// Interceptors of the APIKey
+func (APIKey) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorAPIKey(),
+	}
+}
+
+// Hooks of the APIKey
+func (APIKey) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookAPIKeyAuthz(),
+		hooks.HookAPIKeyAbilities(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // Entitlement holds the schema definition for the Entitlement entity.
@@ -62,5 +63,8 @@ func (Entitlement) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
 	}
 }
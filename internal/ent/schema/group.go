@@ -14,6 +14,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/interceptors"
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // Group holds the schema definition for the Group entity
@@ -27,6 +28,9 @@ func (Group) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.SoftDeleteMixin{},
 		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceGroup,
+		},
 	}
 }
 
@@ -73,7 +77,12 @@ func (Group) Edges() []ent.Edge {
 		edge.To("setting", GroupSetting.Type).
 			Required().
 			Unique(),
-		edge.To("users", User.Type),
+		// memberships are managed with the group membership mutations so their relationship tuples are kept in sync
+		edge.To("users", User.Type).
+			Through("members", GroupMembership.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		edge.From("owner", Organization.Type).
 			Ref("groups").
 			Unique().
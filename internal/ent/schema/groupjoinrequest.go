@@ -0,0 +1,138 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// GroupJoinRequest holds the schema definition for the GroupJoinRequest entity, an application of a user to
+// join a group that only accepts members through applications; the admins of the group approve or decline
+// the request, and the user is added to the group when it is approved
+type GroupJoinRequest struct {
+	ent.Schema
+}
+
+// Fields of the GroupJoinRequest
+func (GroupJoinRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("group_id").
+			Comment("the group the user requested to join").
+			Immutable(),
+		field.String("user_id").
+			Comment("the user who requested to join the group").
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Text("message").
+			Comment("the message of the user to the admins of the group").
+			Optional().
+			Immutable(),
+		field.Enum("status").
+			Comment("the status of the request").
+			NamedValues(
+				"Pending", "PENDING",
+				"Approved", "APPROVED",
+				"Declined", "DECLINED",
+			).
+			Default("PENDING").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("reviewer_id").
+			Comment("the group admin who approved or declined the request").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Edges of the GroupJoinRequest
+func (GroupJoinRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("group", Group.Type).
+			Field("group_id").
+			Immutable().
+			Required().
+			Unique(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Immutable().
+			Required().
+			Unique().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Indexes of the GroupJoinRequest
+func (GroupJoinRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "status"),
+		index.Fields("user_id", "group_id"),
+	}
+}
+
+// Mixin of the GroupJoinRequest
+func (GroupJoinRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceGroup,
+		},
+	}
+}
+
+// Annotations of the GroupJoinRequest
+func (GroupJoinRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate()),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the GroupJoinRequest
+func (GroupJoinRequest) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasGroupJoinRequestMutationAccess(), // Requires edit access in the group to review requests
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters requests to the user's own requests and groups the user can edit
+		},
+	}
+}
+
+// Interceptors of the GroupJoinRequest
+func (GroupJoinRequest) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorGroupJoinRequest(),
+	}
+}
+
+// Hooks of the GroupJoinRequest
+func (GroupJoinRequest) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookGroupJoinRequest(),
+	}
+}
@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// GroupMembership holds the schema definition for the GroupMembership entity, the membership of a user in a
+// group with the role of the user; memberships are kept in sync with the relationship tuples of the group
+type GroupMembership struct {
+	ent.Schema
+}
+
+// Fields of the GroupMembership
+func (GroupMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Comment("the role of the user in the group").
+			NamedValues(
+				"Admin", "ADMIN",
+				"Member", "MEMBER",
+			).
+			Default("MEMBER"),
+		field.String("group_id").
+			Comment("the group the user is a member of").
+			Immutable(),
+		field.String("user_id").
+			Comment("the user who is a member of the group").
+			Immutable(),
+	}
+}
+
+// Edges of the GroupMembership
+func (GroupMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("group", Group.Type).
+			Field("group_id").
+			Immutable().
+			Required().
+			Unique(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the GroupMembership
+func (GroupMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// a user is a member of a group once
+		index.Fields("user_id", "group_id").
+			Unique(),
+	}
+}
+
+// Mixin of the GroupMembership, memberships are not soft deleted as the users and groups edges join on the
+// membership table directly
+func (GroupMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceGroup,
+		},
+	}
+}
+
+// Annotations of the GroupMembership
+func (GroupMembership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate()),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the GroupMembership
+func (GroupMembership) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasGroupMembershipMutationAccess(), // Requires edit access in the group, unless users join open groups or leave groups
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters memberships to groups the user can view
+		},
+	}
+}
+
+// Interceptors of the GroupMembership
+func (GroupMembership) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorGroupMembership(),
+	}
+}
+
+// Hooks of the GroupMembership
+func (GroupMembership) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookGroupMembership(),
+		hooks.HookGroupMembershipAuthz(),
+	}
+}
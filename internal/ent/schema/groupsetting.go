@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // GroupSetting holds the schema definition for the GroupSetting entity.
@@ -60,5 +61,8 @@ func (GroupSetting) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceGroup,
+		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // Integration maps configured integrations (github, slack, etc.) to organizations
@@ -61,5 +62,8 @@ func (Integration) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
 	}
 }
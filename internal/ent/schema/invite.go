@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"net/mail"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// Invite holds the schema definition for the Invite entity, an invitation emailed to a user to join an
+// organization; the invitee accepts the invitation with the signed token in the email, registering first
+// if they do not yet have an account
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner_id").
+			Comment("the organization the recipient is invited to").
+			Immutable(),
+		field.String("token").
+			Comment("the invitation token sent to the recipient via email which should only be provided to the /invite endpoint + handler").
+			Unique().
+			Sensitive().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("expires").
+			Comment("the expiration of the invitation, invitations expire 7 days after they are sent").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("recipient").
+			Comment("the email address of the user invited to the organization").
+			Validate(func(email string) error {
+				_, err := mail.ParseAddress(email)
+				return err
+			}).
+			Immutable().
+			NotEmpty(),
+		field.Enum("status").
+			Comment("the status of the invitation").
+			NamedValues(
+				"InvitationSent", "INVITATION_SENT",
+				"InvitationAccepted", "INVITATION_ACCEPTED",
+				"InvitationDeclined", "INVITATION_DECLINED",
+				"InvitationRevoked", "INVITATION_REVOKED",
+				"InvitationExpired", "INVITATION_EXPIRED",
+			).
+			Default("INVITATION_SENT").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Enum("role").
+			Comment("the role the recipient is given in the organization when they accept the invitation").
+			NamedValues(
+				"Admin", "ADMIN",
+				"Member", "MEMBER",
+			).
+			Default("MEMBER").
+			Immutable(),
+		field.String("requestor_id").
+			Comment("the user who sent the invitation").
+			Optional().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Bytes("secret").
+			Comment("the comparison secret to verify the token's signature").
+			Sensitive().
+			NotEmpty().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
+}
+
+// Edges of the Invite
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Organization.Type).
+			Ref("invites").
+			Field("owner_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the Invite
+func (Invite) Indexes() []ent.Index {
+	return []ent.Index{
+		// invitation tokens should be unique, but ignore deleted invitations
+		index.Fields("token").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+		index.Fields("recipient", "owner_id"),
+	}
+}
+
+// Mixin of the Invite
+func (Invite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.SoftDeleteMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
+	}
+}
+
+// Annotations of the Invite
+func (Invite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate()),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the Invite
+func (Invite) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasInviteMutationAccess(), // Requires edit access in the organization of the invitation
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters invitations to organizations the user can view
+		},
+	}
+}
+
+// Interceptors of the Invite
+func (Invite) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorInvite(),
+	}
+}
+
+// Hooks of the Invite
+func (Invite) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookInvite(),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"net/mail"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/entx"
+)
+
+// MagicLinkToken holds the schema definition for the MagicLinkToken entity
+type MagicLinkToken struct {
+	ent.Schema
+}
+
+// Fields of the MagicLinkToken
+func (MagicLinkToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Comment("the login token sent to the user via email which should only be provided to the /login/magic endpoint + handler").
+			Unique().
+			NotEmpty(),
+		field.Time("ttl").
+			Comment("the ttl of the login token which defaults to 15 minutes, the token is expired once it is used").
+			Nillable(),
+		field.String("email").
+			Comment("the email used as input to generate the login token; this is used to verify that the token when regenerated within the server matches the token emailed").
+			Validate(func(email string) error {
+				_, err := mail.ParseAddress(email)
+				return err
+			}).
+			NotEmpty(),
+		field.Bytes("secret").
+			Comment("the comparison secret to verify the token's signature").
+			NotEmpty().
+			Nillable(),
+	}
+}
+
+// Edges of the MagicLinkToken
+func (MagicLinkToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("magic_link_tokens").
+			Required().
+			Unique(),
+	}
+}
+
+// Mixin of the MagicLinkToken
+func (MagicLinkToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		mixin.SoftDeleteMixin{},
+	}
+}
+
+// Indexes of the MagicLinkToken
+func (MagicLinkToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// MagicLinkTokens should be unique, but ignore deleted MagicLinkTokens
+		index.Fields("token").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the MagicLinkToken
+func (MagicLinkToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Hooks of the MagicLinkToken
+func (MagicLinkToken) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookMagicLinkToken(),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/entx"
+)
+
+// OauthAuthorizationCode holds the schema definition for the OauthAuthorizationCode entity. An authorization
+// code is issued to an oauth client when a user authorizes it and can be exchanged once for a token pair
+type OauthAuthorizationCode struct {
+	ent.Schema
+}
+
+// Fields of the OauthAuthorizationCode
+func (OauthAuthorizationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").
+			Comment("the sha256 hash of the authorization code").
+			Sensitive().
+			Unique().
+			Immutable().
+			NotEmpty(),
+		field.String("client_id").
+			Comment("the id of the oauth client the code was issued to").
+			Immutable().
+			NotEmpty(),
+		field.String("user_id").
+			Comment("the user that authorized the client").
+			Immutable().
+			NotEmpty(),
+		field.String("redirect_uri").
+			Comment("the redirect uri of the authorization request, the same uri must be sent when the code is exchanged").
+			Immutable().
+			NotEmpty(),
+		field.JSON("scopes", []string{}).
+			Comment("the scopes granted to the client").
+			Immutable(),
+		field.String("nonce").
+			Comment("the nonce of the authorization request, added to the id token").
+			Immutable().
+			Optional(),
+		field.String("code_challenge").
+			Comment("the PKCE code challenge of the authorization request").
+			Immutable().
+			Optional(),
+		field.String("code_challenge_method").
+			Comment("the PKCE code challenge method of the authorization request").
+			Immutable().
+			Optional(),
+		field.Time("expires_at").
+			Comment("when the code expires").
+			Immutable(),
+		field.Time("used_at").
+			Comment("when the code was exchanged for a token pair").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the OauthAuthorizationCode
+func (OauthAuthorizationCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+	}
+}
+
+// Annotations of the OauthAuthorizationCode
+func (OauthAuthorizationCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+	}
+}
@@ -0,0 +1,141 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// OauthClient holds the schema definition for the OauthClient entity, a third party application registered
+// by an organization that users can authorize to access datum on their behalf with the authorization code
+// flow; confidential clients can also access their organization with the client credentials grant
+type OauthClient struct {
+	ent.Schema
+}
+
+// Fields of the OauthClient
+func (OauthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner_id").
+			Comment("the organization that registered the client").
+			Immutable(),
+		field.String("name").
+			Comment("the name of the client shown to users when they authorize it").
+			NotEmpty(),
+		field.String("client_id").
+			Comment("the public identifier of the client used in authorization requests").
+			Immutable().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("client_secret_hash").
+			Comment("the salted hash of the client secret, public clients do not have a secret").
+			Sensitive().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.JSON("redirect_uris", []string{}).
+			Comment("the urls users can be redirected to after authorizing the client, the redirect uri of an authorization request must exactly match one of them"),
+		field.JSON("scopes", []string{}).
+			Comment("the scopes the client can request, e.g. openid, email or org:read; the scopes cannot be changed once the client is created").
+			Immutable(),
+		field.Bool("public").
+			Comment("public clients, such as single page and native apps, cannot keep a secret; they are not issued a secret and must use PKCE").
+			Immutable().
+			Default(false),
+		field.String("description").
+			Comment("a description of the client").
+			Optional().
+			Default("").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Edges of the OauthClient
+func (OauthClient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Organization.Type).
+			Ref("oauth_clients").
+			Field("owner_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OauthClient
+func (OauthClient) Indexes() []ent.Index {
+	return []ent.Index{
+		// client ids should be unique, but ignore deleted clients
+		index.Fields("client_id").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Mixin of the OauthClient
+func (OauthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.SoftDeleteMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
+	}
+}
+
+// Annotations of the OauthClient
+func (OauthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), (entgql.MutationUpdate())),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the OauthClient
+func (OauthClient) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasOauthClientMutationAccess(), // Requires edit access in the owning organization
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters clients to organizations the user can view
+		},
+	}
+}
+
+// Interceptors of the OauthClient
+func (OauthClient) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorOauthClient(),
+	}
+}
+
+// Hooks of the OauthClient
+func (OauthClient) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookOauthClientAuthz(),
+		hooks.HookOauthClient(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // OauthProvider holds the schema definition for the OauthProvider entity
@@ -61,5 +62,8 @@ func (OauthProvider) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // OhAuthTooToken holds the schema definition for the OhAuthTooToken entity
@@ -24,7 +25,7 @@ func (OhAuthTooToken) Fields() []ent.Field {
 		field.JSON("scopes", []string{}).
 			Optional(),
 		field.Text("nonce").
-			NotEmpty(),
+			Optional(),
 		field.Text("claims_user_id").
 			NotEmpty(),
 		field.Text("claims_username").
@@ -53,6 +54,9 @@ func (OhAuthTooToken) Edges() []ent.Edge {
 func (OhAuthTooToken) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
 	}
 }
 
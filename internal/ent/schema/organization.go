@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/internal/ent/privacy/rule"
 	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 const (
@@ -52,7 +53,7 @@ func (Organization) Fields() []ent.Field {
 			Annotations(
 				entgql.Skip(entgql.SkipWhereInput),
 			),
-		field.String("parent_organization_id").Optional().Immutable().
+		field.String("parent_organization_id").Optional().
 			Comment("The ID of the parent organization for the organization.").
 			Annotations(
 				entgql.Type("ID"),
@@ -76,11 +77,17 @@ func (Organization) Edges() []ent.Edge {
 			).
 			From("parent").
 			Field("parent_organization_id").
-			Immutable().
-			Unique(),
+			Unique().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationUpdateInput),
+			),
 		// an org can have and belong to many users
 		edge.From("users", User.Type).
-			Ref("organizations"),
+			Ref("organizations").
+			Through("members", OrgMembership.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		edge.To("groups", Group.Type).
 			Annotations(entx.CascadeAnnotationField("Owner")),
 		edge.To("integrations", Integration.Type).
@@ -90,6 +97,12 @@ func (Organization) Edges() []ent.Edge {
 			Annotations(entx.CascadeAnnotationField("Organization")),
 		edge.To("entitlements", Entitlement.Type),
 		edge.To("oauthprovider", OauthProvider.Type),
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entx.CascadeAnnotationField("Owner")),
+		edge.To("oauth_clients", OauthClient.Type).
+			Annotations(entx.CascadeAnnotationField("Owner")),
+		edge.To("invites", Invite.Type).
+			Annotations(entx.CascadeAnnotationField("Owner")),
 	}
 }
 
@@ -107,6 +120,7 @@ func (Organization) Annotations() []schema.Annotation {
 		entgql.QueryField(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), (entgql.MutationUpdate())),
+		entx.SchemaGenSkip(true),
 	}
 }
 
@@ -116,6 +130,9 @@ func (Organization) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
 	}
 }
 
@@ -144,6 +161,7 @@ func (Organization) Interceptors() []ent.Interceptor {
 // Hooks of the Organization
 func (Organization) Hooks() []ent.Hook {
 	return []ent.Hook{
+		hooks.HookOrganizationDelete(),
 		hooks.HookOrganizationAuthz(),
 		hooks.HookOrganization(),
 	}
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// OrgMembership holds the schema definition for the OrgMembership entity, the membership of a user in an
+// organization with the role of the user; memberships are kept in sync with the relationship tuples of the
+// organization
+type OrgMembership struct {
+	ent.Schema
+}
+
+// Fields of the OrgMembership
+func (OrgMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Comment("the role of the user in the organization").
+			NamedValues(
+				"Owner", "OWNER",
+				"Admin", "ADMIN",
+				"Member", "MEMBER",
+			).
+			Default("MEMBER"),
+		field.String("organization_id").
+			Comment("the organization the user is a member of").
+			Immutable(),
+		field.String("user_id").
+			Comment("the user who is a member of the organization").
+			Immutable(),
+	}
+}
+
+// Edges of the OrgMembership
+func (OrgMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).
+			Field("organization_id").
+			Immutable().
+			Required().
+			Unique(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OrgMembership
+func (OrgMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// a user is a member of an organization once
+		index.Fields("user_id", "organization_id").
+			Unique(),
+	}
+}
+
+// Mixin of the OrgMembership, memberships are not soft deleted as the users and organizations edges join
+// on the membership table directly
+func (OrgMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
+	}
+}
+
+// Annotations of the OrgMembership
+func (OrgMembership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate()),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the OrgMembership
+func (OrgMembership) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasOrgMembershipMutationAccess(), // Requires edit access in the organization, or owner access for owners
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters memberships to organizations the user can view
+		},
+	}
+}
+
+// Interceptors of the OrgMembership
+func (OrgMembership) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorOrgMembership(),
+	}
+}
+
+// Hooks of the OrgMembership
+func (OrgMembership) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookOrgMembership(),
+		hooks.HookOrgMembershipAuthz(),
+	}
+}
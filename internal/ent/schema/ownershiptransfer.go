@@ -0,0 +1,154 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/interceptors"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// OwnershipTransfer holds the schema definition for the OwnershipTransfer entity, the transfer of the ownership
+// of an organization, or of the administration of a group, from one user to another; the recipient confirms the
+// transfer with the signed token emailed to them. Transfers are not deleted so they are kept as the audit trail
+// of the owners of the organization or group
+type OwnershipTransfer struct {
+	ent.Schema
+}
+
+// Fields of the OwnershipTransfer
+func (OwnershipTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner_id").
+			Comment("the organization that is transferred, or the organization of the group that is transferred").
+			Immutable(),
+		field.String("group_id").
+			Comment("the group that is transferred, not set when the organization is transferred").
+			Optional().
+			Immutable(),
+		field.String("requestor_id").
+			Comment("the owner of the organization, or admin of the group, who transfers it").
+			Immutable(),
+		field.String("recipient_id").
+			Comment("the user the organization or group is transferred to").
+			Immutable(),
+		field.Enum("status").
+			Comment("the status of the transfer").
+			NamedValues(
+				"Pending", "PENDING",
+				"Accepted", "ACCEPTED",
+				"Declined", "DECLINED",
+				"Canceled", "CANCELED",
+				"Expired", "EXPIRED",
+			).
+			Default("PENDING"),
+		field.String("token").
+			Comment("the transfer token sent to the recipient via email which should only be provided to the /transfer endpoint + handler").
+			Unique().
+			Sensitive().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("expires").
+			Comment("the expiration of the transfer, transfers expire 7 days after they are requested"),
+		field.Bytes("secret").
+			Comment("the comparison secret to verify the token's signature").
+			Sensitive().
+			NotEmpty().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
+}
+
+// Edges of the OwnershipTransfer
+func (OwnershipTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", Organization.Type).
+			Field("owner_id").
+			Immutable().
+			Required().
+			Unique(),
+		edge.To("group", Group.Type).
+			Field("group_id").
+			Immutable().
+			Unique(),
+		edge.To("requestor", User.Type).
+			Field("requestor_id").
+			Immutable().
+			Required().
+			Unique(),
+		edge.To("recipient", User.Type).
+			Field("recipient_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OwnershipTransfer
+func (OwnershipTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "status"),
+	}
+}
+
+// Mixin of the OwnershipTransfer
+func (OwnershipTransfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceOrg,
+		},
+	}
+}
+
+// Annotations of the OwnershipTransfer
+func (OwnershipTransfer) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the OwnershipTransfer
+func (OwnershipTransfer) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoSubject(),
+			rule.HasOwnershipTransferMutationAccess(), // Requires delete access in the organization or group, transfers are never deleted
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoSubject(),
+			privacy.AlwaysAllowRule(), // the interceptor filters transfers to organizations the user can view
+		},
+	}
+}
+
+// Interceptors of the OwnershipTransfer
+func (OwnershipTransfer) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorOwnershipTransfer(),
+	}
+}
+
+// Hooks of the OwnershipTransfer
+func (OwnershipTransfer) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookOwnershipTransfer(),
+	}
+}
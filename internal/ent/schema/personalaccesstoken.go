@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -12,7 +13,8 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
-	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
@@ -25,15 +27,32 @@ func (PersonalAccessToken) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			Comment("the name associated with the token"),
-		field.String("token").Sensitive().
-			Unique().
+		field.String("token_prefix").
+			Comment("the visible prefix of the token used to identify it, the token itself is only returned on creation").
 			Immutable().
-			DefaultFunc(func() string {
-				token := keygen.Secret()
-				return token
-			}),
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("token_hash").
+			Comment("the salted hash of the token, empty for tokens created before tokens were hashed").
+			Sensitive().
+			Immutable().
+			NotEmpty().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("token").
+			Comment("the plaintext token of tokens created before tokens were hashed, they can be used until they expire").
+			Sensitive().
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 		field.JSON("abilities", []string{}).
-			Comment("what abilites the token should have").
+			Comment("the scopes the token is restricted to, e.g. org:read or group:write; a token without abilities has the full access of its owner").
 			Optional(),
 		field.Time("expires_at").
 			Comment("when the token expires").
@@ -65,7 +84,10 @@ func (PersonalAccessToken) Edges() []ent.Edge {
 // Indexes of the PersonalAccessToken
 func (PersonalAccessToken) Indexes() []ent.Index {
 	return []ent.Index{
-		// non-unique index.
+		// token prefixes should be unique, but ignore deleted tokens
+		index.Fields("token_prefix").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+		// non-unique index to look up the tokens created before tokens were hashed
 		index.Fields("token"),
 	}
 }
@@ -76,6 +98,9 @@ func (PersonalAccessToken) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.SoftDeleteMixin{},
 		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceUser,
+		},
 	}
 }
 
@@ -85,6 +110,7 @@ func (PersonalAccessToken) Annotations() []schema.Annotation {
 		entgql.QueryField(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), (entgql.MutationUpdate())),
+		entx.SchemaGenSkip(true),
 	}
 }
 
@@ -92,5 +118,6 @@ func (PersonalAccessToken) Annotations() []schema.Annotation {
 func (PersonalAccessToken) Hooks() []ent.Hook {
 	return []ent.Hook{
 		hooks.HookPersonalAccessToken(),
+		hooks.HookPersonalAccessTokenAbilities(),
 	}
 }
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/entx"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity. Every issued refresh token
+// is recorded with the token it was exchanged for so it can only be used once; refresh tokens that
+// descend from the same login belong to the same family
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Comment("the jwt id of the refresh token").
+			Unique().
+			Immutable().
+			NotEmpty(),
+		field.String("family_id").
+			Comment("the jwt id of the first refresh token of the family, issued on login").
+			Immutable().
+			NotEmpty(),
+		field.String("parent_jti").
+			Comment("the jwt id of the refresh token that was exchanged for this refresh token").
+			Immutable().
+			Optional(),
+		field.String("user_id").
+			Comment("the user the refresh token was issued to").
+			Immutable().
+			NotEmpty(),
+		field.Time("used_at").
+			Comment("when the refresh token was exchanged for a new token pair").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Comment("when the refresh token expires").
+			Immutable(),
+	}
+}
+
+// Indexes of the RefreshToken
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the RefreshToken
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+	}
+}
+
+// Annotations of the RefreshToken
+func (RefreshToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/entx"
+)
+
+// RevokedToken holds the schema definition for the RevokedToken entity. Access and refresh token pairs
+// share the same jti, so revoking the jti rejects both tokens until they expire
+type RevokedToken struct {
+	ent.Schema
+}
+
+// Fields of the RevokedToken
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Comment("the jwt id of the revoked token pair").
+			Unique().
+			Immutable().
+			NotEmpty(),
+		field.String("user_id").
+			Comment("the user the revoked token was issued to").
+			Immutable().
+			Optional(),
+		field.String("reason").
+			Comment("the reason the token was revoked").
+			Immutable().
+			Optional(),
+		field.Time("expires_at").
+			Comment("when the revoked token pair expires, after which the revocation no longer needs to be kept").
+			Immutable(),
+	}
+}
+
+// Indexes of the RevokedToken
+func (RevokedToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// non-unique index used to prune expired revocations
+		index.Fields("expires_at"),
+	}
+}
+
+// Mixin of the RevokedToken
+func (RevokedToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+	}
+}
+
+// Annotations of the RevokedToken
+func (RevokedToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+	}
+}
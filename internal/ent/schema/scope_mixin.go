@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+)
+
+// ScopeMixin restricts access to the schema for requests authenticated with a token that is restricted
+// to scopes, such as a personal access token; the policy of the mixin is evaluated before the policy of
+// the schema so a missing scope is denied even if the user could otherwise access the resource
+type ScopeMixin struct {
+	mixin.Schema
+	// Resource is the scope resource the schema belongs to, e.g. tokens.ScopeResourceOrg
+	Resource string
+}
+
+// Policy of the ScopeMixin
+func (s ScopeMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfMissingScope(s.Resource),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfMissingScope(s.Resource),
+		},
+	}
+}
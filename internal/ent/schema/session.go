@@ -10,8 +10,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // Session holds authentication sessions. They can either be first-party web auth sessions or OAuth sessions. Sessions should persist in the database for some time duration after expiration, but with the "disabled" boolean set to true.
@@ -27,12 +30,40 @@ func (Session) Fields() []ent.Field {
 			Unique().
 			Immutable(),
 		field.Time("issued_at").
-			UpdateDefault(time.Now),
+			Default(time.Now).
+			Immutable(),
 		field.Time("expires_at"),
 		field.String("organization_id").
-			Comment("organization ID of the organization the user is accessing"),
+			Comment("organization ID of the organization the user is accessing").
+			Optional(),
 		field.String("user_id").
 			Comment("the user the session is associated with"),
+		field.String("user_agent").
+			Comment("the user agent of the client the session was started from").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("ip_address").
+			Comment("the ip address of the client the session was started from").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("last_seen_at").
+			Comment("the last time the tokens of the session were refreshed").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("revoked_at").
+			Comment("the time the session was revoked, the tokens issued for a revoked session are rejected").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -70,6 +101,28 @@ func (Session) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceUser,
+		},
+	}
+}
+
+// Policy of the Session
+func (Session) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfOwnedByViewer(),
+			privacy.AlwaysDenyRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfAdmin(),
+			// sessions are started on login, users can only update and revoke their own sessions
+			privacy.OnMutationOperation(
+				rule.AllowMutationAfterApplyingOwnerFilter(),
+				ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+			),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
 
@@ -77,5 +130,6 @@ func (Session) Mixin() []ent.Mixin {
 func (Session) Hooks() []ent.Hook {
 	return []ent.Hook{
 		hooks.HookSession(),
+		hooks.HookSessionRevoke(),
 	}
 }
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/entx"
+)
+
+// SessionData holds the schema definition for the SessionData entity. It persists the data of the server
+// side sessions of the session manager, so sessions survive restarts and are shared across replicas
+type SessionData struct {
+	ent.Schema
+}
+
+// Fields of the SessionData
+func (SessionData) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Comment("the session token stored in the session cookie").
+			Unique().
+			Immutable().
+			NotEmpty().
+			Sensitive(),
+		field.Bytes("data").
+			Comment("the encoded data of the session"),
+		field.Time("expiry").
+			Comment("when the session expires, after which it is removed by the cleanup task"),
+	}
+}
+
+// Indexes of the SessionData
+func (SessionData) Indexes() []ent.Index {
+	return []ent.Index{
+		// non-unique index used to remove expired sessions
+		index.Fields("expiry"),
+	}
+}
+
+// Mixin of the SessionData
+func (SessionData) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+	}
+}
+
+// Annotations of the SessionData
+func (SessionData) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+	}
+}
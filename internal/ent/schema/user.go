@@ -24,6 +24,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/privacy/token"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 const (
@@ -42,6 +43,9 @@ func (User) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.SoftDeleteMixin{},
 		mixin.IDMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceUser,
+		},
 	}
 }
 
@@ -112,6 +116,20 @@ func (User) Fields() []ent.Field {
 			Nillable().
 			Sensitive().
 			Optional(),
+		field.JSON("password_history", []string{}).
+			Comment("hashes of the most recent passwords, including the current password, that cannot be reused").
+			Sensitive().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("password_changed_at").
+			Comment("the time the password was last set, passwords older than the max age of the password policy have to be reset").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.String("sub").
 			Comment("the Subject of the user JWT").
 			Unique().
@@ -133,11 +151,20 @@ func (User) Indexes() []ent.Index {
 // Edges of the User
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("organizations", Organization.Type),
+		// memberships are managed with the org membership mutations so their relationship tuples are kept in sync
+		edge.To("organizations", Organization.Type).
+			Through("org_memberships", OrgMembership.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		edge.To("sessions", Session.Type).
 			Annotations(entx.CascadeAnnotationField("Owner")),
 		edge.From("groups", Group.Type).
-			Ref("users"),
+			Ref("users").
+			Through("group_memberships", GroupMembership.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		edge.To("personal_access_tokens", PersonalAccessToken.Type).
 			Annotations(entx.CascadeAnnotationField("Owner")),
 		edge.To("setting", UserSetting.Type).
@@ -148,6 +175,10 @@ func (User) Edges() []ent.Edge {
 			Annotations(entx.CascadeAnnotationField("Owner")),
 		edge.To("reset_tokens", PasswordResetToken.Type).
 			Annotations(entx.CascadeAnnotationField("Owner")),
+		edge.To("magic_link_tokens", MagicLinkToken.Type).
+			Annotations(entx.CascadeAnnotationField("Owner")),
+		edge.To("webauthn_credentials", WebauthnCredential.Type).
+			Annotations(entx.CascadeAnnotationField("Owner")),
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // UserSetting holds the schema definition for the User entity.
@@ -21,6 +22,9 @@ func (UserSetting) Mixin() []ent.Mixin {
 		mixin.AuditMixin{},
 		mixin.IDMixin{},
 		mixin.SoftDeleteMixin{},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceUser,
+		},
 	}
 }
 
@@ -63,6 +67,72 @@ func (UserSetting) Fields() []ent.Field {
 		field.JSON("tags", []string{}).
 			Comment("tags associated with the object").
 			Default([]string{}),
+		field.Bool("is_tfa_enabled").
+			Comment("whether the user has confirmed enrollment in totp multi-factor authentication").
+			Default(false).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("tfa_secret").
+			Comment("the totp secret, set on enrollment and required to be confirmed before it is enabled").
+			Sensitive().
+			Nillable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.JSON("recovery_codes", []string{}).
+			Comment("hashes of the one-time multi-factor recovery codes, codes are removed once used").
+			Sensitive().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Int64("tfa_last_step").
+			Comment("the totp time step of the last accepted passcode, passcodes for the same or an earlier step are rejected").
+			Nillable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Int("failed_login_attempts").
+			Comment("the number of consecutive failed logins, reset on a successful login").
+			Default(0).
+			NonNegative().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("locked_until").
+			Comment("logins are rejected until this time after repeated failed logins").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("unlock_token").
+			Comment("the token emailed to the user to unlock the account after it was locked by failed logins").
+			Sensitive().
+			Unique().
+			Nillable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Bytes("unlock_token_secret").
+			Comment("the secret used to verify the unlock token").
+			Sensitive().
+			Nillable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("unlock_token_expires_at").
+			Comment("the time the unlock token expires").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 	}
 }
 
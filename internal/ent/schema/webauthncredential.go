@@ -0,0 +1,121 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/rule"
+	"github.com/datumforge/datum/internal/entx"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// WebauthnCredential holds the schema definition for the WebauthnCredential entity
+type WebauthnCredential struct {
+	ent.Schema
+}
+
+// Fields of the WebauthnCredential
+func (WebauthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("the user provided name of the passkey").
+			Optional().
+			Default(""),
+		field.Bytes("credential_id").
+			Comment("the credential id generated by the authenticator").
+			Immutable().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Bytes("public_key").
+			Comment("the public key portion of the credential used to verify assertions").
+			Sensitive().
+			Immutable().
+			NotEmpty().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("attestation_type").
+			Comment("the attestation format used by the authenticator when creating the credential").
+			Immutable().
+			Optional(),
+		field.String("aaguid").
+			Comment("the AAGUID of the authenticator model").
+			Immutable().
+			Optional(),
+		field.Int64("sign_count").
+			Comment("the signature counter of the authenticator, used to detect cloned authenticators").
+			Default(0).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.JSON("transports", []string{}).
+			Comment("the transports the authenticator supports").
+			Optional(),
+		field.Bool("backup_eligible").
+			Comment("whether the credential can be backed up or synced between devices").
+			Default(false),
+		field.Bool("backup_state").
+			Comment("whether the credential is currently backed up or synced").
+			Default(false),
+		field.Time("last_used_at").
+			Comment("the last time the credential was used to authenticate").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the WebauthnCredential
+func (WebauthnCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+		mixin.IDMixin{},
+		mixin.SoftDeleteMixin{},
+		UserOwnedMixin{
+			Ref: "webauthn_credentials",
+		},
+		ScopeMixin{
+			Resource: tokens.ScopeResourceUser,
+		},
+	}
+}
+
+// Indexes of the WebauthnCredential
+func (WebauthnCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		// credential ids should be unique, but ignore deleted credentials
+		index.Fields("credential_id").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the WebauthnCredential
+func (WebauthnCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+	}
+}
+
+// Policy of the WebauthnCredential
+func (WebauthnCredential) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfOwnedByViewer(),
+			privacy.AlwaysDenyRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowMutationAfterApplyingOwnerFilter(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
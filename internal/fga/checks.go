@@ -18,10 +18,11 @@ func (c *Client) CheckTuple(ctx context.Context, check ofgaclient.ClientCheckReq
 	return *data.Allowed, nil
 }
 
-func (c *Client) CheckOrgAccess(ctx context.Context, userID, orgID, relation string) (bool, error) {
+// CheckOrgAccess checks if the subject, a user or service, has the relation to the organization
+func (c *Client) CheckOrgAccess(ctx context.Context, subjectID, subjectType, orgID, relation string) (bool, error) {
 	sub := Entity{
-		Kind:       "user",
-		Identifier: userID,
+		Kind:       Kind(subjectType),
+		Identifier: subjectID,
 	}
 
 	obj := Entity{
@@ -40,10 +41,11 @@ func (c *Client) CheckOrgAccess(ctx context.Context, userID, orgID, relation str
 	return c.CheckTuple(ctx, checkReq)
 }
 
-func (c *Client) CheckGroupAccess(ctx context.Context, userID, groupID, relation string) (bool, error) {
+// CheckGroupAccess checks if the subject, a user or service, has the relation to the group
+func (c *Client) CheckGroupAccess(ctx context.Context, subjectID, subjectType, groupID, relation string) (bool, error) {
 	sub := Entity{
-		Kind:       "user",
-		Identifier: userID,
+		Kind:       Kind(subjectType),
+		Identifier: subjectID,
 	}
 
 	obj := Entity{
@@ -22,11 +22,11 @@ func (c *Client) listObjects(ctx context.Context, req ofgaclient.ClientListObjec
 	return list, nil
 }
 
-// ListObjectsRequest creates the ClientListObjectsRequest and queries the FGA store for all objects with the user+relation
-func (c *Client) ListObjectsRequest(ctx context.Context, userID, objectType, relation string) (*ofgaclient.ClientListObjectsResponse, error) {
+// ListObjectsRequest creates the ClientListObjectsRequest and queries the FGA store for all objects with the subject+relation
+func (c *Client) ListObjectsRequest(ctx context.Context, subjectID, subjectType, objectType, relation string) (*ofgaclient.ClientListObjectsResponse, error) {
 	sub := Entity{
-		Kind:       "user",
-		Identifier: userID,
+		Kind:       Kind(subjectType),
+		Identifier: subjectID,
 	}
 
 	listReq := ofgaclient.ClientListObjectsRequest{
@@ -113,6 +113,7 @@ func Test_ListObjectsRequest(t *testing.T) {
 			resp, err := fc.ListObjectsRequest(
 				context.Background(),
 				tc.userID,
+				UserSubjectType,
 				tc.objectType,
 				tc.relation,
 			)
@@ -20,6 +20,13 @@ const (
 	CanDelete      = "can_delete"
 )
 
+const (
+	// UserSubjectType is the subject type of users in relationship tuples
+	UserSubjectType = "user"
+	// ServiceSubjectType is the subject type of organization service accounts in relationship tuples
+	ServiceSubjectType = "service"
+)
+
 type TupleKey struct {
 	Subject  Entity
 	Object   Entity
@@ -117,8 +124,8 @@ func (c *Client) CreateRelationshipTuple(ctx context.Context, tuples []ofgaclien
 	return resp, nil
 }
 
-// deleteRelationshipTuple deletes a relationship tuple in the openFGA store
-func (c *Client) deleteRelationshipTuple(ctx context.Context, tuples []openfga.TupleKeyWithoutCondition) (*ofgaclient.ClientWriteResponse, error) {
+// DeleteRelationshipTuple deletes a relationship tuple in the openFGA store
+func (c *Client) DeleteRelationshipTuple(ctx context.Context, tuples []openfga.TupleKeyWithoutCondition) (*ofgaclient.ClientWriteResponse, error) {
 	if len(tuples) == 0 {
 		return nil, nil
 	}
@@ -143,6 +150,46 @@ func (c *Client) deleteRelationshipTuple(ctx context.Context, tuples []openfga.T
 	return resp, nil
 }
 
+// UpdateRelationshipTuple writes and deletes relationship tuples in the openFGA store in a single request, so
+// either all the tuples are written and deleted or none of them are, e.g. when the relation of a user changes
+func (c *Client) UpdateRelationshipTuple(ctx context.Context, writes []ofgaclient.ClientTupleKey, deletes []openfga.TupleKeyWithoutCondition) (*ofgaclient.ClientWriteResponse, error) {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return nil, nil
+	}
+
+	opts := ofgaclient.ClientWriteOptions{AuthorizationModelId: openfga.PtrString(c.Config.AuthorizationModelId)}
+
+	body := ofgaclient.ClientWriteRequest{
+		Writes:  writes,
+		Deletes: deletes,
+	}
+
+	resp, err := c.Ofga.Write(ctx).Body(body).Options(opts).Execute()
+	if err != nil {
+		c.Logger.Errorw("error updating relationship tuples", "error", err.Error())
+
+		return resp, err
+	}
+
+	for _, writes := range resp.Writes {
+		if writes.Error != nil {
+			c.Logger.Errorw("error writing relationship tuples", "user", writes.TupleKey.User, "relation", writes.TupleKey.Relation, "object", writes.TupleKey.Object)
+
+			return resp, newWritingTuplesError(writes.TupleKey.User, writes.TupleKey.Relation, writes.TupleKey.Object, "writing", err)
+		}
+	}
+
+	for _, del := range resp.Deletes {
+		if del.Error != nil {
+			c.Logger.Errorw("error deleting relationship tuples", "user", del.TupleKey.User, "relation", del.TupleKey.Relation, "object", del.TupleKey.Object)
+
+			return resp, newWritingTuplesError(del.TupleKey.User, del.TupleKey.Relation, del.TupleKey.Object, "deleting", err)
+		}
+	}
+
+	return resp, nil
+}
+
 func (c *Client) DeleteAllObjectRelations(ctx context.Context, object string) error {
 	// validate object is not empty
 	if object == "" {
@@ -179,7 +226,7 @@ func (c *Client) DeleteAllObjectRelations(ctx context.Context, object string) er
 	}
 
 	// Notes: Writes only allow 10 tuples per call, this will need to be fixed
-	_, err = c.deleteRelationshipTuple(ctx, tuplesToDelete)
+	_, err = c.DeleteRelationshipTuple(ctx, tuplesToDelete)
 
 	return err
 }
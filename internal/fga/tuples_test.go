@@ -254,7 +254,7 @@ func Test_DeleteRelationshipTuple(t *testing.T) {
 
 			mockDeleteTuples(mockCtrl, c, context.Background(), tuples, tc.errRes)
 
-			_, err = fc.deleteRelationshipTuple(context.Background(), tuples)
+			_, err = fc.DeleteRelationshipTuple(context.Background(), tuples)
 
 			if tc.errRes != "" {
 				assert.Error(t, err)
@@ -268,6 +268,84 @@ func Test_DeleteRelationshipTuple(t *testing.T) {
 	}
 }
 
+func Test_UpdateRelationshipTuple(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+	c := mock_client.NewMockSdkClient(mockCtrl)
+
+	fc, err := NewTestFGAClient(t, mockCtrl, c)
+	if err != nil {
+		t.Fatal()
+	}
+
+	testCases := []struct {
+		name    string
+		writes  []ofgaclient.ClientTupleKey
+		deletes []openfga.TupleKeyWithoutCondition
+		errRes  error
+	}{
+		{
+			name: "happy path, relation changed",
+			writes: []ofgaclient.ClientTupleKey{
+				{
+					User:     "user:ulid-of-member",
+					Relation: "admin",
+					Object:   "organization:datum",
+				},
+			},
+			deletes: []openfga.TupleKeyWithoutCondition{
+				{
+					User:     "user:ulid-of-member",
+					Relation: "member",
+					Object:   "organization:datum",
+				},
+			},
+		},
+		{
+			name: "error, missing relation",
+			writes: []ofgaclient.ClientTupleKey{
+				{
+					User:     "user:ulid-of-member",
+					Relation: "",
+					Object:   "organization:datum",
+				},
+			},
+			deletes: []openfga.TupleKeyWithoutCondition{
+				{
+					User:     "user:ulid-of-member",
+					Relation: "member",
+					Object:   "organization:datum",
+				},
+			},
+			errRes: ErrMissingRelation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWrite(mockCtrl, c, context.Background(), tc.writes, tc.deletes, tc.errRes)
+
+			resp, err := fc.UpdateRelationshipTuple(context.Background(), tc.writes, tc.deletes)
+
+			if tc.errRes != nil {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, tc.errRes)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, resp.Writes, len(tc.writes))
+			assert.Len(t, resp.Deletes, len(tc.deletes))
+		})
+	}
+
+	// nothing is sent to the store when there are no changes
+	resp, err := fc.UpdateRelationshipTuple(context.Background(), nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
 // mockWriteTuples creates mock responses based on the mock FGA client
 func mockWriteTuples(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx context.Context, tuples []ofgaclient.ClientTupleKey, errMsg error) {
 	mockExecute := mock_client.NewMockSdkClientWriteTuplesRequestInterface(mockCtrl)
@@ -355,3 +433,44 @@ func mockDeleteTuples(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient,
 
 	c.EXPECT().DeleteTuples(ctx).Return(mockBody)
 }
+
+// mockWrite creates mock responses based on the mock FGA client, expecting the tuples to be written and deleted
+// in a single request
+func mockWrite(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx context.Context, writes []ofgaclient.ClientTupleKey, deletes []openfga.TupleKeyWithoutCondition, errMsg error) {
+	mockExecute := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	status := ofgaclient.SUCCESS
+	if errMsg != nil {
+		status = ofgaclient.FAILURE
+	}
+
+	expectedResponse := ofgaclient.ClientWriteResponse{}
+
+	for _, w := range writes {
+		expectedResponse.Writes = append(expectedResponse.Writes, ofgaclient.ClientWriteRequestWriteResponse{
+			TupleKey: w,
+			Status:   status,
+		})
+	}
+
+	for _, d := range deletes {
+		expectedResponse.Deletes = append(expectedResponse.Deletes, ofgaclient.ClientWriteRequestDeleteResponse{
+			TupleKey: d,
+			Status:   status,
+		})
+	}
+
+	mockExecute.EXPECT().Execute().Return(&expectedResponse, errMsg)
+
+	mockRequest := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	options := ofgaclient.ClientWriteOptions{AuthorizationModelId: openfga.PtrString("test-model-id")}
+
+	mockRequest.EXPECT().Options(options).Return(mockExecute)
+
+	mockBody := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	mockBody.EXPECT().Body(ofgaclient.ClientWriteRequest{Writes: writes, Deletes: deletes}).Return(mockRequest)
+
+	c.EXPECT().Write(ctx).Return(mockBody)
+}
@@ -0,0 +1,139 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// CreateAPIKey is the resolver for the createAPIKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input generated.CreateAPIKeyInput) (*APIKeyCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	// the key is generated by the server and only the hash is stored, so it is only returned once
+	key, prefix := keygen.APIKey()
+
+	hash, err := keygen.HashAPIKey(key)
+	if err != nil {
+		r.logger.Errorw("failed to hash api key", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	apiKey, err := withTransactionalMutation(ctx).APIKey.Create().
+		SetInput(input).
+		SetKeyPrefix(prefix).
+		SetKeyHash(hash).
+		Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, tokens.ErrInvalidScope) || errors.Is(err, tokens.ErrScopeNotGranted) ||
+			errors.Is(err, hooks.ErrAPIKeyMissingAbilities) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "api key")
+		}
+
+		r.logger.Errorw("failed to create api key", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &APIKeyCreatePayload{APIKey: apiKey, Key: key}, nil
+}
+
+// UpdateAPIKey is the resolver for the updateAPIKey field.
+func (r *mutationResolver) UpdateAPIKey(ctx context.Context, id string, input generated.UpdateAPIKeyInput) (*APIKeyUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	apiKey, err := withTransactionalMutation(ctx).APIKey.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) || generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "api key")
+		}
+
+		r.logger.Errorw("failed to update api key", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &APIKeyUpdatePayload{APIKey: apiKey}, nil
+}
+
+// DeleteAPIKey is the resolver for the deleteAPIKey field.
+func (r *mutationResolver) DeleteAPIKey(ctx context.Context, id string) (*APIKeyDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).APIKey.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionDelete, "api key")
+		}
+
+		r.logger.Errorw("failed to delete api key", "error", err)
+		return nil, err
+	}
+
+	return &APIKeyDeletePayload{DeletedID: id}, nil
+}
+
+// APIKey is the resolver for the apiKey field.
+func (r *queryResolver) APIKey(ctx context.Context, id string) (*generated.APIKey, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	apiKey, err := r.client.APIKey.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get api key", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return apiKey, nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -0,0 +1,256 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	openfga "github.com/openfga/go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+func TestMutation_CreateAPIKey(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	userID := ulids.New().String()
+
+	ec, err := auth.NewTestContextWithValidUser(userID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	// service accounts authenticate with the claims of their api key
+	svc, err := auth.NewTestContextWithServiceAccount(ulids.New().String(), org.ID, "org:admin")
+	require.NoError(t, err)
+
+	svcCtx := context.WithValue(svc.Request().Context(), echocontext.EchoContextKey, svc)
+
+	svc.SetRequest(svc.Request().WithContext(svcCtx))
+
+	// the user authenticated with a token restricted to scopes
+	scoped, err := auth.NewTestContextWithScopes(userID, "org:write", "group:read")
+	require.NoError(t, err)
+
+	scopedCtx := context.WithValue(scoped.Request().Context(), echocontext.EchoContextKey, scoped)
+
+	scoped.SetRequest(scoped.Request().WithContext(scopedCtx))
+
+	testCases := []struct {
+		name      string
+		ctx       context.Context
+		abilities []string
+		allowed   bool
+		check     bool
+		errorMsg  string
+	}{
+		{
+			name:      "happy path",
+			ctx:       reqCtx,
+			abilities: []string{"org:write", "group:read"},
+			allowed:   true,
+			check:     true,
+		},
+		{
+			name:      "no access to organization",
+			ctx:       reqCtx,
+			abilities: []string{"org:read"},
+			allowed:   false,
+			check:     true,
+			errorMsg:  "not authorized",
+		},
+		{
+			name:      "scoped token, within the token scopes",
+			ctx:       scopedCtx,
+			abilities: []string{"org:read"},
+			allowed:   true,
+			check:     true,
+		},
+		{
+			name:      "scoped token, beyond the token scopes",
+			ctx:       scopedCtx,
+			abilities: []string{"org:admin"},
+			allowed:   true,
+			check:     true,
+			errorMsg:  "scope not granted",
+		},
+		{
+			name:      "service accounts cannot create api keys",
+			ctx:       svcCtx,
+			abilities: []string{"org:read"},
+			errorMsg:  "not authorized",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			if tc.check {
+				mockCheckAny(mockCtrl, mc, tc.ctx, tc.allowed)
+			}
+
+			// the owner of the created key is returned, which lists the organizations of the user
+			if tc.errorMsg == "" {
+				mockWriteTuplesAny(mockCtrl, mc, tc.ctx, nil)
+				mockListAny(mockCtrl, mc, tc.ctx, []string{fmt.Sprintf("organization:%s", org.ID)})
+			}
+
+			resp, err := client.CreateAPIKey(tc.ctx, datumclient.CreateAPIKeyInput{
+				Name:      gofakeit.AppName(),
+				OwnerID:   org.ID,
+				Abilities: tc.abilities,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			// the generated key is returned once and identified by its visible prefix
+			created := resp.CreateAPIKey
+			assert.True(t, keygen.IsAPIKey(created.Key))
+			assert.True(t, strings.HasPrefix(created.Key, created.APIKey.KeyPrefix))
+			assert.Equal(t, org.ID, created.APIKey.Owner.ID)
+			assert.Equal(t, tc.abilities, created.APIKey.Abilities)
+		})
+	}
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_CreateAPIKeyNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	testCases := []struct {
+		name      string
+		abilities []string
+		errorMsg  string
+	}{
+		{
+			name:      "happy path",
+			abilities: []string{"group:admin"},
+		},
+		{
+			name:      "missing abilities",
+			abilities: []string{},
+			errorMsg:  "at least one scope",
+		},
+		{
+			name:      "invalid abilities",
+			abilities: []string{"org:everything"},
+			errorMsg:  "invalid scope",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := client.CreateAPIKey(reqCtx, datumclient.CreateAPIKeyInput{
+				Name:      gofakeit.AppName(),
+				OwnerID:   org.ID,
+				Abilities: tc.abilities,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, keygen.IsAPIKey(resp.CreateAPIKey.Key))
+		})
+	}
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_DeleteAPIKey(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	ec, err := auth.NewTestContextWithValidUser(ulids.New().String())
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	// creating the key adds the service account to the organization
+	mockWriteTuplesAny(mockCtrl, mc, reqCtx, nil)
+
+	key, prefix := keygen.APIKey()
+
+	hash, err := keygen.HashAPIKey(key)
+	require.NoError(t, err)
+
+	apiKey := entClient.APIKey.Create().
+		SetName(gofakeit.AppName()).
+		SetOwnerID(org.ID).
+		SetKeyPrefix(prefix).
+		SetKeyHash(hash).
+		SetAbilities([]string{"org:read"}).
+		SaveX(privacy.DecisionContext(reqCtx, privacy.Allow))
+
+	// access is checked on the delete and again on the soft delete update; a read only key is a member of
+	// the organization, and the member tuple is removed on delete
+	mockCheckAny(mockCtrl, mc, reqCtx, true)
+	mockCheckAny(mockCtrl, mc, reqCtx, true)
+	mockDeleteTuples(mockCtrl, mc, reqCtx, []openfga.TupleKeyWithoutCondition{{
+		User:     fmt.Sprintf("service:%s", apiKey.ID),
+		Relation: "member",
+		Object:   fmt.Sprintf("organization:%s", org.ID),
+	}})
+
+	resp, err := client.DeleteAPIKey(reqCtx, apiKey.ID)
+	require.NoError(t, err)
+	assert.Equal(t, apiKey.ID, resp.DeleteAPIKey.DeletedID)
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+}
@@ -29,6 +29,16 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]generated.No
 	panic(fmt.Errorf("not implemented: Nodes - nodes"))
 }
 
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.APIKeyWhereInput) (*generated.APIKeyConnection, error) {
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	}
+
+	return r.client.APIKey.Query().Paginate(ctx, after, first, before, last, generated.WithAPIKeyFilter(where.Filter))
+}
+
 // Entitlements is the resolver for the entitlements field.
 func (r *queryResolver) Entitlements(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.EntitlementWhereInput) (*generated.EntitlementConnection, error) {
 	panic(fmt.Errorf("not implemented: Entitlements - entitlements"))
@@ -44,6 +54,16 @@ func (r *queryResolver) Groups(ctx context.Context, after *entgql.Cursor[string]
 	return r.client.Group.Query().Paginate(ctx, after, first, before, last, generated.WithGroupOrder(orderBy), generated.WithGroupFilter(where.Filter))
 }
 
+// GroupJoinRequests is the resolver for the groupJoinRequests field.
+func (r *queryResolver) GroupJoinRequests(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.GroupJoinRequestWhereInput) (*generated.GroupJoinRequestConnection, error) {
+	panic(fmt.Errorf("not implemented: GroupJoinRequests - groupJoinRequests"))
+}
+
+// GroupMemberships is the resolver for the groupMemberships field.
+func (r *queryResolver) GroupMemberships(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.GroupMembershipWhereInput) (*generated.GroupMembershipConnection, error) {
+	panic(fmt.Errorf("not implemented: GroupMemberships - groupMemberships"))
+}
+
 // GroupSettings is the resolver for the groupSettings field.
 func (r *queryResolver) GroupSettings(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.GroupSettingWhereInput) (*generated.GroupSettingConnection, error) {
 	// if auth is disabled, policy decisions will be skipped
@@ -59,6 +79,21 @@ func (r *queryResolver) Integrations(ctx context.Context, after *entgql.Cursor[s
 	return r.client.Integration.Query().Paginate(ctx, after, first, before, last, generated.WithIntegrationOrder(orderBy), generated.WithIntegrationFilter(where.Filter))
 }
 
+// Invites is the resolver for the invites field.
+func (r *queryResolver) Invites(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.InviteWhereInput) (*generated.InviteConnection, error) {
+	panic(fmt.Errorf("not implemented: Invites - invites"))
+}
+
+// OauthClients is the resolver for the oauthClients field.
+func (r *queryResolver) OauthClients(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.OauthClientWhereInput) (*generated.OauthClientConnection, error) {
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	}
+
+	return r.client.OauthClient.Query().Paginate(ctx, after, first, before, last, generated.WithOauthClientFilter(where.Filter))
+}
+
 // OauthProviders is the resolver for the oauthProviders field.
 func (r *queryResolver) OauthProviders(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.OauthProviderWhereInput) (*generated.OauthProviderConnection, error) {
 	panic(fmt.Errorf("not implemented: OauthProviders - oauthProviders"))
@@ -69,6 +104,11 @@ func (r *queryResolver) OhAuthTooTokens(ctx context.Context, after *entgql.Curso
 	panic(fmt.Errorf("not implemented: OhAuthTooTokens - ohAuthTooTokens"))
 }
 
+// OrgMemberships is the resolver for the orgMemberships field.
+func (r *queryResolver) OrgMemberships(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.OrgMembershipWhereInput) (*generated.OrgMembershipConnection, error) {
+	panic(fmt.Errorf("not implemented: OrgMemberships - orgMemberships"))
+}
+
 // Organizations is the resolver for the organizations field.
 func (r *queryResolver) Organizations(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.OrganizationOrder, where *generated.OrganizationWhereInput) (*generated.OrganizationConnection, error) {
 	// if auth is disabled, policy decisions will be skipped
@@ -89,6 +129,16 @@ func (r *queryResolver) OrganizationSettings(ctx context.Context, after *entgql.
 	return r.client.OrganizationSetting.Query().Paginate(ctx, after, first, before, last, generated.WithOrganizationSettingFilter(where.Filter))
 }
 
+// OwnershipTransfers is the resolver for the ownershipTransfers field.
+func (r *queryResolver) OwnershipTransfers(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.OwnershipTransferWhereInput) (*generated.OwnershipTransferConnection, error) {
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	}
+
+	return r.client.OwnershipTransfer.Query().Paginate(ctx, after, first, before, last, generated.WithOwnershipTransferFilter(where.Filter))
+}
+
 // PersonalAccessTokens is the resolver for the personalAccessTokens field.
 func (r *queryResolver) PersonalAccessTokens(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.PersonalAccessTokenWhereInput) (*generated.PersonalAccessTokenConnection, error) {
 	panic(fmt.Errorf("not implemented: PersonalAccessTokens - personalAccessTokens"))
@@ -96,6 +146,13 @@ func (r *queryResolver) PersonalAccessTokens(ctx context.Context, after *entgql.
 
 // Sessions is the resolver for the sessions field.
 func (r *queryResolver) Sessions(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.SessionWhereInput) (*generated.SessionConnection, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
 	return r.client.Session.Query().Paginate(ctx, after, first, before, last, generated.WithSessionFilter(where.Filter))
 }
 
@@ -116,6 +173,18 @@ func (r *queryResolver) UserSettings(ctx context.Context, after *entgql.Cursor[s
 	panic(fmt.Errorf("not implemented: UserSettings - userSettings"))
 }
 
+// WebauthnCredentials is the resolver for the webauthnCredentials field.
+func (r *queryResolver) WebauthnCredentials(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *generated.WebauthnCredentialWhereInput) (*generated.WebauthnCredentialConnection, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	return r.client.WebauthnCredential.Query().Paginate(ctx, after, first, before, last, generated.WithWebauthnCredentialFilter(where.Filter))
+}
+
 // AuthStyle is the resolver for the authStyle field.
 func (r *createOauthProviderInputResolver) AuthStyle(ctx context.Context, obj *generated.CreateOauthProviderInput, data int) error {
 	panic(fmt.Errorf("not implemented: AuthStyle - authStyle"))
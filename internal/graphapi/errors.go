@@ -14,6 +14,18 @@ var (
 
 	// ErrCascadeDelete is returned when an error occurs while performing cascade deletes on associated objects
 	ErrCascadeDelete = errors.New("error deleting associated objects")
+
+	// ErrReadOnlyToken is returned when a mutation is requested with a token that is restricted to read scopes
+	ErrReadOnlyToken = errors.New("token is restricted to read scopes and cannot perform mutations")
+
+	// ErrTFAAlreadyEnabled is returned when enrolling in multi-factor authentication when it is already enabled
+	ErrTFAAlreadyEnabled = errors.New("multi-factor authentication is already enabled")
+
+	// ErrTFANotEnrolled is returned when multi-factor authentication has not been enrolled or enabled
+	ErrTFANotEnrolled = errors.New("multi-factor authentication is not enrolled")
+
+	// ErrInvalidTFACode is returned when the provided one-time passcode or recovery code is invalid
+	ErrInvalidTFACode = errors.New("invalid multi-factor authentication code")
 )
 
 // PermissionDeniedError is returned when user is not authorized to perform the requested query or mutation
@@ -6,6 +6,26 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated"
 )
 
+// Return response for createAPIKey mutation
+type APIKeyCreatePayload struct {
+	// Created apiKey
+	APIKey *generated.APIKey `json:"apiKey"`
+	// The apiKey secret, this is only returned once and cannot be retrieved again
+	Key string `json:"key"`
+}
+
+// Return response for deleteAPIKey mutation
+type APIKeyDeletePayload struct {
+	// Deleted apiKey ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateAPIKey mutation
+type APIKeyUpdatePayload struct {
+	// Updated apiKey
+	APIKey *generated.APIKey `json:"apiKey"`
+}
+
 // Return response for createEntitlement mutation
 type EntitlementCreatePayload struct {
 	// Created entitlement
@@ -36,6 +56,42 @@ type GroupDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// Return response for requestToJoinGroup mutation
+type GroupJoinRequestCreatePayload struct {
+	// Created group join request
+	GroupJoinRequest *generated.GroupJoinRequest `json:"groupJoinRequest"`
+}
+
+// Return response for deleteGroupJoinRequest mutation
+type GroupJoinRequestDeletePayload struct {
+	// Deleted group join request ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for approveGroupJoinRequest and declineGroupJoinRequest mutations
+type GroupJoinRequestUpdatePayload struct {
+	// Updated group join request
+	GroupJoinRequest *generated.GroupJoinRequest `json:"groupJoinRequest"`
+}
+
+// Return response for addUserToGroup and joinGroup mutations
+type GroupMembershipCreatePayload struct {
+	// Created group membership
+	GroupMembership *generated.GroupMembership `json:"groupMembership"`
+}
+
+// Return response for removeUserFromGroup mutation
+type GroupMembershipDeletePayload struct {
+	// Deleted group membership ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateGroupMemberRole mutation
+type GroupMembershipUpdatePayload struct {
+	// Updated group membership
+	GroupMembership *generated.GroupMembership `json:"groupMembership"`
+}
+
 // Return response for createGroupSetting mutation
 type GroupSettingCreatePayload struct {
 	// Created groupSetting
@@ -78,6 +134,38 @@ type IntegrationUpdatePayload struct {
 	Integration *generated.Integration `json:"integration"`
 }
 
+// Return response for createInvite mutation
+type InviteCreatePayload struct {
+	// Created invite
+	Invite *generated.Invite `json:"invite"`
+}
+
+// Return response for revokeInvite mutation
+type InviteRevokePayload struct {
+	// Revoked invite
+	Invite *generated.Invite `json:"invite"`
+}
+
+// Return response for createOauthClient mutation
+type OauthClientCreatePayload struct {
+	// Created oauthClient
+	OauthClient *generated.OauthClient `json:"oauthClient"`
+	// The oauthClient secret, this is only returned once and cannot be retrieved again; public clients do not have a secret
+	ClientSecret *string `json:"clientSecret,omitempty"`
+}
+
+// Return response for deleteOauthClient mutation
+type OauthClientDeletePayload struct {
+	// Deleted oauthClient ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateOauthClient mutation
+type OauthClientUpdatePayload struct {
+	// Updated oauthClient
+	OauthClient *generated.OauthClient `json:"oauthClient"`
+}
+
 // Return response for createOauthProvider mutation
 type OauthProviderCreatePayload struct {
 	// Created oauthProvider
@@ -114,6 +202,24 @@ type OhAuthTooTokenUpdatePayload struct {
 	OhAuthTooToken *generated.OhAuthTooToken `json:"ohAuthTooToken"`
 }
 
+// Return response for addUserToOrganization mutation
+type OrgMembershipCreatePayload struct {
+	// Created org membership
+	OrgMembership *generated.OrgMembership `json:"orgMembership"`
+}
+
+// Return response for removeUserFromOrganization mutation
+type OrgMembershipDeletePayload struct {
+	// Deleted org membership ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateOrgMemberRole mutation
+type OrgMembershipUpdatePayload struct {
+	// Updated org membership
+	OrgMembership *generated.OrgMembership `json:"orgMembership"`
+}
+
 // Return response for createOrganization mutation
 type OrganizationCreatePayload struct {
 	// Created organization
@@ -150,10 +256,24 @@ type OrganizationUpdatePayload struct {
 	Organization *generated.Organization `json:"organization"`
 }
 
+// Return response for cancelOwnershipTransfer mutation
+type OwnershipTransferCancelPayload struct {
+	// Canceled ownership transfer
+	OwnershipTransfer *generated.OwnershipTransfer `json:"ownershipTransfer"`
+}
+
+// Return response for transferOrganizationOwnership and transferGroupOwnership mutations
+type OwnershipTransferCreatePayload struct {
+	// Created ownership transfer
+	OwnershipTransfer *generated.OwnershipTransfer `json:"ownershipTransfer"`
+}
+
 // Return response for createPersonalAccessToken mutation
 type PersonalAccessTokenCreatePayload struct {
 	// Created personalAccessToken
 	PersonalAccessToken *generated.PersonalAccessToken `json:"personalAccessToken"`
+	// The personalAccessToken secret, this is only returned once and cannot be retrieved again
+	Token string `json:"token"`
 }
 
 // Return response for deletePersonalAccessToken mutation
@@ -186,6 +306,22 @@ type SessionUpdatePayload struct {
 	Session *generated.Session `json:"session"`
 }
 
+// Return response for confirmTFA mutation
+type TFAConfirmPayload struct {
+	// Updated userSetting
+	UserSetting *generated.UserSetting `json:"userSetting"`
+	// one-time recovery codes, these are only returned once and should be stored securely
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// Return response for enrollTFA mutation
+type TFAEnrollPayload struct {
+	// base32 encoded totp secret
+	Secret string `json:"secret"`
+	// otpauth uri of the secret that can be rendered as a QR code
+	QRURI string `json:"qrURI"`
+}
+
 // Return response for createUser mutation
 type UserCreatePayload struct {
 	// Created user
@@ -221,3 +357,9 @@ type UserUpdatePayload struct {
 	// Updated user
 	User *generated.User `json:"user"`
 }
+
+// Return response for deleteWebauthnCredential mutation
+type WebauthnCredentialDeletePayload struct {
+	// Deleted webauthnCredential ID
+	DeletedID string `json:"deletedID"`
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/datumforge/datum/internal/datumclient"
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	mock_client "github.com/datumforge/datum/internal/fga/mocks"
 	auth "github.com/datumforge/datum/internal/httpserve/middleware/auth"
@@ -80,6 +81,9 @@ func TestQuery_Group(t *testing.T) {
 				// we need to check list objects even on a get to check the user
 				// has access to the owner (organization of the group)
 				mockListAny(mockCtrl, mc, reqCtx, listOrgs)
+			} else {
+				// public groups are visible to the members of the organization of the group
+				mockCheckAny(mockCtrl, mc, reqCtx, false)
 			}
 
 			resp, err := client.GetGroupByID(reqCtx, tc.queryID)
@@ -223,6 +227,8 @@ func TestQuery_GroupsByOwner(t *testing.T) {
 		// Try to get groups for org not authorized to access
 		mockListAny(mockCtrl, mc, reqCtx, listOrgs)
 		mockListAny(mockCtrl, mc, reqCtx, listGroups)
+		// groups the user is not a member of are checked for public groups of the organizations of the user
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs)
 
 		whereInput = &datumclient.GroupWhereInput{
 			HasOwnerWith: []*datumclient.OrganizationWhereInput{
@@ -340,6 +346,7 @@ func TestQuery_Groups(t *testing.T) {
 
 		mockListAny(mockCtrl, mc, reqCtx, listOrgs) // org check comes before group check
 		mockListAny(mockCtrl, mc, reqCtx, listGroups)
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs) // public groups check of groups the user is not a member of
 
 		resp, err := client.GetAllGroups(reqCtx)
 
@@ -378,6 +385,7 @@ func TestQuery_Groups(t *testing.T) {
 		// Check user with no relations, gets no groups back
 		mockListAny(mockCtrl, mc, reqCtx, []string{}) // list orgs
 		mockListAny(mockCtrl, mc, reqCtx, []string{}) // list group
+		mockListAny(mockCtrl, mc, reqCtx, []string{}) // list orgs for public groups
 
 		resp, err = client.GetAllGroups(reqCtx)
 
@@ -396,6 +404,84 @@ func TestQuery_Groups(t *testing.T) {
 	(&OrganizationCleanup{OrgID: org2.ID}).MustDelete(reqCtx)
 }
 
+func TestQuery_GroupsVisibility(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	// Setup Test Graph Client
+	client := graphTestClient(t, entClient)
+
+	user := (&UserBuilder{}).MustNew(context.Background())
+
+	ec, err := auth.NewTestContextWithValidUser(user.ID)
+	if err != nil {
+		t.Fatal()
+	}
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	// the groups are created without the user as their admin
+	org := (&OrganizationBuilder{}).MustNew(context.Background())
+	publicGroup := (&GroupBuilder{Owner: org.ID}).MustNew(context.Background())
+	privateGroup := (&GroupBuilder{Owner: org.ID, Visibility: groupsetting.VisibilityPrivate}).MustNew(context.Background())
+
+	listOrgs := []string{fmt.Sprintf("organization:%s", org.ID)}
+
+	groupIDs := func(resp *datumclient.GetAllGroups) []string {
+		ids := []string{}
+		for _, g := range resp.Groups.Edges {
+			ids = append(ids, g.Node.ID)
+		}
+
+		return ids
+	}
+
+	t.Run("public groups are visible to members of the organization", func(t *testing.T) {
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs)   // owner of the groups
+		mockListAny(mockCtrl, mc, reqCtx, []string{}) // list groups
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs)   // public groups check
+
+		resp, err := client.GetAllGroups(reqCtx)
+		require.NoError(t, err)
+
+		ids := groupIDs(resp)
+		assert.Contains(t, ids, publicGroup.ID)
+		assert.NotContains(t, ids, privateGroup.ID)
+	})
+
+	t.Run("private groups are visible to their members", func(t *testing.T) {
+		allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+		EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(user.ID).ExecX(allowCtx)
+		EntClient.GroupMembership.Create().SetGroupID(privateGroup.ID).SetUserID(user.ID).ExecX(allowCtx)
+
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs) // owner of the groups
+		mockListAny(mockCtrl, mc, reqCtx, []string{fmt.Sprintf("group:%s", privateGroup.ID)})
+		mockListAny(mockCtrl, mc, reqCtx, listOrgs) // public groups check
+
+		resp, err := client.GetAllGroups(reqCtx)
+		require.NoError(t, err)
+
+		ids := groupIDs(resp)
+		assert.Contains(t, ids, publicGroup.ID)
+		assert.Contains(t, ids, privateGroup.ID)
+	})
+
+	// delete created org and groups
+	(&GroupCleanup{GroupID: publicGroup.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: privateGroup.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: user.ID}).MustDelete(reqCtx)
+}
+
 func TestQuery_GroupNoAuth(t *testing.T) {
 	// Setup Test Graph Client Without Auth
 	client := graphTestClientNoAuth(t, EntClient)
@@ -736,6 +822,9 @@ func TestMutation_UpdateGroup(t *testing.T) {
 				mockCheckAny(mockCtrl, mc, reqCtx, tc.allowed)
 				// check access
 				mockListAny(mockCtrl, mc, reqCtx, listObjects)
+			} else {
+				// public groups are visible to the members of the organization of the group
+				mockCheckAny(mockCtrl, mc, reqCtx, false)
 			}
 
 			// update group
@@ -0,0 +1,157 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+)
+
+// RequestToJoinGroup is the resolver for the requestToJoinGroup field.
+func (r *mutationResolver) RequestToJoinGroup(ctx context.Context, input generated.CreateGroupJoinRequestInput) (*GroupJoinRequestCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	jr, err := withTransactionalMutation(ctx).GroupJoinRequest.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrGroupJoinRequestsNotAccepted) ||
+			errors.Is(err, hooks.ErrUserNotInGroupOrg) ||
+			errors.Is(err, hooks.ErrUserAlreadyGroupMember) ||
+			errors.Is(err, hooks.ErrGroupJoinRequestPending) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "group join request")
+		}
+
+		r.logger.Errorw("failed to create group join request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupJoinRequestCreatePayload{GroupJoinRequest: jr}, nil
+}
+
+// ApproveGroupJoinRequest is the resolver for the approveGroupJoinRequest field.
+func (r *mutationResolver) ApproveGroupJoinRequest(ctx context.Context, id string) (*GroupJoinRequestUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	jr, err := withTransactionalMutation(ctx).GroupJoinRequest.UpdateOneID(id).SetStatus(groupjoinrequest.StatusApproved).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrGroupJoinRequestReviewed) ||
+			errors.Is(err, hooks.ErrUserNotInGroupOrg) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "group join request")
+		}
+
+		r.logger.Errorw("failed to approve group join request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupJoinRequestUpdatePayload{GroupJoinRequest: jr}, nil
+}
+
+// DeclineGroupJoinRequest is the resolver for the declineGroupJoinRequest field.
+func (r *mutationResolver) DeclineGroupJoinRequest(ctx context.Context, id string) (*GroupJoinRequestUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	jr, err := withTransactionalMutation(ctx).GroupJoinRequest.UpdateOneID(id).SetStatus(groupjoinrequest.StatusDeclined).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrGroupJoinRequestReviewed) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "group join request")
+		}
+
+		r.logger.Errorw("failed to decline group join request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupJoinRequestUpdatePayload{GroupJoinRequest: jr}, nil
+}
+
+// DeleteGroupJoinRequest is the resolver for the deleteGroupJoinRequest field.
+func (r *mutationResolver) DeleteGroupJoinRequest(ctx context.Context, id string) (*GroupJoinRequestDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).GroupJoinRequest.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionDelete, "group join request")
+		}
+
+		r.logger.Errorw("failed to delete group join request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupJoinRequestDeletePayload{DeletedID: id}, nil
+}
+
+// GroupJoinRequest is the resolver for the groupJoinRequest field.
+func (r *queryResolver) GroupJoinRequest(ctx context.Context, id string) (*generated.GroupJoinRequest, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	jr, err := r.client.GroupJoinRequest.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get group join request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return jr, nil
+}
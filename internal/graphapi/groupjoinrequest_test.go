@@ -0,0 +1,281 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/groupjoinrequest"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_RequestToJoinGroupNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	member := (&UserBuilder{}).MustNew(context.Background())
+	outsider := (&UserBuilder{}).MustNew(context.Background())
+
+	ec, err := auth.NewTestContextWithValidUser(member.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	outsiderEc, err := auth.NewTestContextWithValidUser(outsider.ID)
+	require.NoError(t, err)
+
+	outsiderCtx := context.WithValue(outsiderEc.Request().Context(), echocontext.EchoContextKey, outsiderEc)
+
+	outsiderEc.SetRequest(outsiderEc.Request().WithContext(outsiderCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(context.Background())
+
+	EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		ExecX(privacy.DecisionContext(reqCtx, privacy.Allow))
+
+	applicationGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyApplicationOnly}).MustNew(context.Background())
+	inviteOrApplicationGroup := (&GroupBuilder{Owner: org.ID}).MustNew(context.Background())
+	inviteGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyInviteOnly}).MustNew(context.Background())
+	openGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyOpen}).MustNew(context.Background())
+
+	message := "please let me in"
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		groupID  string
+		errorMsg string
+	}{
+		{
+			name:    "happy path, application only",
+			ctx:     reqCtx,
+			groupID: applicationGroup.ID,
+		},
+		{
+			name:    "happy path, invite or application",
+			ctx:     reqCtx,
+			groupID: inviteOrApplicationGroup.ID,
+		},
+		{
+			name:     "pending request",
+			ctx:      reqCtx,
+			groupID:  applicationGroup.ID,
+			errorMsg: "user already has a pending request to join the group",
+		},
+		{
+			name:     "invite only",
+			ctx:      reqCtx,
+			groupID:  inviteGroup.ID,
+			errorMsg: "group does not accept requests to join",
+		},
+		{
+			name:     "open group is joined directly",
+			ctx:      reqCtx,
+			groupID:  openGroup.ID,
+			errorMsg: "group does not accept requests to join",
+		},
+		{
+			name:     "user not a member of the organization",
+			ctx:      outsiderCtx,
+			groupID:  applicationGroup.ID,
+			errorMsg: "user is not a member of the organization of the group",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Request "+tc.name, func(t *testing.T) {
+			resp, err := client.RequestToJoinGroup(tc.ctx, datumclient.CreateGroupJoinRequestInput{
+				GroupID: tc.groupID,
+				Message: &message,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			jr := resp.RequestToJoinGroup.GroupJoinRequest
+			assert.Equal(t, tc.groupID, jr.GroupID)
+			assert.Equal(t, member.ID, jr.UserID)
+			assert.Equal(t, message, *jr.Message)
+			assert.Equal(t, groupjoinrequest.StatusPending, jr.Status)
+		})
+	}
+
+	(&GroupCleanup{GroupID: applicationGroup.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: inviteOrApplicationGroup.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: inviteGroup.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: openGroup.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: outsider.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_ReviewGroupJoinRequestNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	member := (&UserBuilder{}).MustNew(context.Background())
+
+	ec, err := auth.NewTestContextWithValidUser(member.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(context.Background())
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	approvedGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyApplicationOnly}).MustNew(context.Background())
+	declinedGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyApplicationOnly}).MustNew(context.Background())
+
+	approveReq, err := client.RequestToJoinGroup(reqCtx, datumclient.CreateGroupJoinRequestInput{GroupID: approvedGroup.ID})
+	require.NoError(t, err)
+
+	declineReq, err := client.RequestToJoinGroup(reqCtx, datumclient.CreateGroupJoinRequestInput{GroupID: declinedGroup.ID})
+	require.NoError(t, err)
+
+	// the user is added to the group as a member when the request is approved
+	approved, err := client.ApproveGroupJoinRequest(reqCtx, approveReq.RequestToJoinGroup.GroupJoinRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, groupjoinrequest.StatusApproved, approved.ApproveGroupJoinRequest.GroupJoinRequest.Status)
+	assert.Equal(t, member.ID, *approved.ApproveGroupJoinRequest.GroupJoinRequest.ReviewerID)
+
+	gm := EntClient.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(approvedGroup.ID),
+			groupmembership.UserID(member.ID),
+		).
+		OnlyX(allowCtx)
+	assert.Equal(t, groupmembership.RoleMember, gm.Role)
+
+	// requests are reviewed once
+	_, err = client.DeclineGroupJoinRequest(reqCtx, approveReq.RequestToJoinGroup.GroupJoinRequest.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "request to join the group was already reviewed")
+
+	// members do not request to join the group again
+	_, err = client.RequestToJoinGroup(reqCtx, datumclient.CreateGroupJoinRequestInput{GroupID: approvedGroup.ID})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "user is already a member of the group")
+
+	// the user is not added to the group when the request is declined
+	declined, err := client.DeclineGroupJoinRequest(reqCtx, declineReq.RequestToJoinGroup.GroupJoinRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, groupjoinrequest.StatusDeclined, declined.DeclineGroupJoinRequest.GroupJoinRequest.Status)
+
+	assert.False(t, EntClient.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(declinedGroup.ID),
+			groupmembership.UserID(member.ID),
+		).
+		ExistX(allowCtx))
+
+	_, err = client.ApproveGroupJoinRequest(reqCtx, declineReq.RequestToJoinGroup.GroupJoinRequest.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "request to join the group was already reviewed")
+
+	// the declined request no longer blocks a new request
+	resp, err := client.RequestToJoinGroup(reqCtx, datumclient.CreateGroupJoinRequestInput{GroupID: declinedGroup.ID})
+	require.NoError(t, err)
+
+	deleted, err := client.DeleteGroupJoinRequest(reqCtx, resp.RequestToJoinGroup.GroupJoinRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, resp.RequestToJoinGroup.GroupJoinRequest.ID, deleted.DeleteGroupJoinRequest.DeletedID)
+
+	(&GroupCleanup{GroupID: approvedGroup.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: declinedGroup.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_GroupJoinRequestAuthz(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	admin := (&UserBuilder{}).MustNew(context.Background())
+	member := (&UserBuilder{}).MustNew(context.Background())
+
+	adminEc, err := auth.NewTestContextWithValidUser(admin.ID)
+	require.NoError(t, err)
+
+	adminCtx := context.WithValue(adminEc.Request().Context(), echocontext.EchoContextKey, adminEc)
+
+	adminEc.SetRequest(adminEc.Request().WithContext(adminCtx))
+
+	memberEc, err := auth.NewTestContextWithValidUser(member.ID)
+	require.NoError(t, err)
+
+	memberCtx := context.WithValue(memberEc.Request().Context(), echocontext.EchoContextKey, memberEc)
+
+	memberEc.SetRequest(memberEc.Request().WithContext(memberCtx))
+
+	allowCtx := privacy.DecisionContext(adminCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(adminCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	group := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyApplicationOnly}).MustNew(adminCtx)
+
+	// users request to join groups without edit access
+	resp, err := client.RequestToJoinGroup(memberCtx, datumclient.CreateGroupJoinRequestInput{GroupID: group.ID})
+	require.NoError(t, err)
+
+	id := resp.RequestToJoinGroup.GroupJoinRequest.ID
+
+	// users cannot approve their own requests
+	mockCheckAny(mockCtrl, mc, memberCtx, false)
+
+	_, err = client.ApproveGroupJoinRequest(memberCtx, id)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// group admins approve requests, and the member tuple is written for the user
+	mockCheckAny(mockCtrl, mc, adminCtx, true)
+	mockWriteTuplesAny(mockCtrl, mc, adminCtx, nil)
+
+	approved, err := client.ApproveGroupJoinRequest(adminCtx, id)
+	require.NoError(t, err)
+	assert.Equal(t, groupjoinrequest.StatusApproved, approved.ApproveGroupJoinRequest.GroupJoinRequest.Status)
+	assert.Equal(t, admin.ID, *approved.ApproveGroupJoinRequest.GroupJoinRequest.ReviewerID)
+
+	assert.True(t, EntClient.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(group.ID),
+			groupmembership.UserID(member.ID),
+		).
+		ExistX(allowCtx))
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(adminCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: admin.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(adminCtx)
+}
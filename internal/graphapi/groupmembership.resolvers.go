@@ -0,0 +1,167 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// AddUserToGroup is the resolver for the addUserToGroup field.
+func (r *mutationResolver) AddUserToGroup(ctx context.Context, input generated.CreateGroupMembershipInput) (*GroupMembershipCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	gm, err := withTransactionalMutation(ctx).GroupMembership.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrUserNotInGroupOrg) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "group membership")
+		}
+
+		r.logger.Errorw("failed to create group membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupMembershipCreatePayload{GroupMembership: gm}, nil
+}
+
+// JoinGroup is the resolver for the joinGroup field.
+func (r *mutationResolver) JoinGroup(ctx context.Context, groupID string) (*GroupMembershipCreatePayload, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	gm, err := withTransactionalMutation(ctx).GroupMembership.Create().
+		SetGroupID(groupID).
+		SetUserID(userID).
+		SetRole(groupmembership.RoleMember).
+		Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrUserNotInGroupOrg) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "group membership")
+		}
+
+		r.logger.Errorw("failed to create group membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupMembershipCreatePayload{GroupMembership: gm}, nil
+}
+
+// UpdateGroupMemberRole is the resolver for the updateGroupMemberRole field.
+func (r *mutationResolver) UpdateGroupMemberRole(ctx context.Context, id string, role groupmembership.Role) (*GroupMembershipUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	gm, err := withTransactionalMutation(ctx).GroupMembership.UpdateOneID(id).SetRole(role).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrGroupAdminRequired) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "group membership")
+		}
+
+		r.logger.Errorw("failed to update group membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupMembershipUpdatePayload{GroupMembership: gm}, nil
+}
+
+// RemoveUserFromGroup is the resolver for the removeUserFromGroup field.
+func (r *mutationResolver) RemoveUserFromGroup(ctx context.Context, id string) (*GroupMembershipDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).GroupMembership.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrGroupAdminRequired) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionDelete, "group membership")
+		}
+
+		r.logger.Errorw("failed to delete group membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &GroupMembershipDeletePayload{DeletedID: id}, nil
+}
+
+// GroupMembership is the resolver for the groupMembership field.
+func (r *queryResolver) GroupMembership(ctx context.Context, id string) (*generated.GroupMembership, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	gm, err := r.client.GroupMembership.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get group membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return gm, nil
+}
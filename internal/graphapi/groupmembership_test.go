@@ -0,0 +1,430 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_AddUserToGroupNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(reqCtx)
+
+	admin := (&UserBuilder{}).MustNew(reqCtx)
+	member := (&UserBuilder{}).MustNew(reqCtx)
+	outsider := (&UserBuilder{}).MustNew(reqCtx)
+
+	// only members of the organization of the group can be added to the group
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).ExecX(allowCtx)
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	adminRole := groupmembership.RoleAdmin
+
+	testCases := []struct {
+		name     string
+		userID   string
+		role     *groupmembership.Role
+		expected groupmembership.Role
+		errorMsg string
+	}{
+		{
+			name:     "happy path, admin",
+			userID:   admin.ID,
+			role:     &adminRole,
+			expected: groupmembership.RoleAdmin,
+		},
+		{
+			name:     "happy path, member by default",
+			userID:   member.ID,
+			expected: groupmembership.RoleMember,
+		},
+		{
+			name:     "user already a member",
+			userID:   member.ID,
+			errorMsg: "constraint failed",
+		},
+		{
+			name:     "user not a member of the organization",
+			userID:   outsider.ID,
+			errorMsg: "user is not a member of the organization of the group",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Add "+tc.name, func(t *testing.T) {
+			resp, err := client.AddUserToGroup(reqCtx, datumclient.CreateGroupMembershipInput{
+				GroupID: group.ID,
+				UserID:  tc.userID,
+				Role:    tc.role,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			gm := resp.AddUserToGroup.GroupMembership
+			assert.Equal(t, group.ID, gm.GroupID)
+			assert.Equal(t, tc.userID, gm.UserID)
+			assert.Equal(t, tc.expected, gm.Role)
+		})
+	}
+
+	// the users are members of the group
+	userIDs := EntClient.Group.QueryUsers(group).IDsX(allowCtx)
+
+	assert.ElementsMatch(t, []string{admin.ID, member.ID}, userIDs)
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: admin.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: outsider.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_JoinGroupNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	member := (&UserBuilder{}).MustNew(context.Background())
+
+	ec, err := auth.NewTestContextWithValidUser(member.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	// the organizations are created without the user as their owner
+	org := (&OrganizationBuilder{}).MustNew(context.Background())
+	group := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyOpen}).MustNew(context.Background())
+
+	otherOrg := (&OrganizationBuilder{}).MustNew(context.Background())
+	otherGroup := (&GroupBuilder{Owner: otherOrg.ID, JoinPolicy: groupsetting.JoinPolicyOpen}).MustNew(context.Background())
+
+	EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		ExecX(privacy.DecisionContext(reqCtx, privacy.Allow))
+
+	// the user joins the group as a member
+	resp, err := client.JoinGroup(reqCtx, group.ID)
+	require.NoError(t, err)
+
+	gm := resp.JoinGroup.GroupMembership
+	assert.Equal(t, group.ID, gm.GroupID)
+	assert.Equal(t, member.ID, gm.UserID)
+	assert.Equal(t, groupmembership.RoleMember, gm.Role)
+
+	// users only join groups of their organizations
+	_, err = client.JoinGroup(reqCtx, otherGroup.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "user is not a member of the organization of the group")
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(reqCtx)
+	(&GroupCleanup{GroupID: otherGroup.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: otherOrg.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_UpdateGroupMemberRoleNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(reqCtx)
+
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	gm := EntClient.GroupMembership.Create().
+		SetGroupID(group.ID).
+		SetUserID(member.ID).
+		SaveX(allowCtx)
+
+	resp, err := client.UpdateGroupMemberRole(reqCtx, gm.ID, groupmembership.RoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, groupmembership.RoleAdmin, resp.UpdateGroupMemberRole.GroupMembership.Role)
+
+	_, err = client.UpdateGroupMemberRole(reqCtx, gm.ID, "OWNER")
+	require.Error(t, err)
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_RemoveUserFromGroupNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(reqCtx)
+
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	gm := EntClient.GroupMembership.Create().
+		SetGroupID(group.ID).
+		SetUserID(member.ID).
+		SaveX(allowCtx)
+
+	resp, err := client.RemoveUserFromGroup(reqCtx, gm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, gm.ID, resp.RemoveUserFromGroup.DeletedID)
+
+	assert.Empty(t, EntClient.Group.QueryUsers(group).IDsX(allowCtx))
+
+	_, err = client.RemoveUserFromGroup(reqCtx, gm.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not found")
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_GroupMembershipAuthz(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	admin := (&UserBuilder{}).MustNew(context.Background())
+	member := (&UserBuilder{}).MustNew(context.Background())
+
+	adminEc, err := auth.NewTestContextWithValidUser(admin.ID)
+	require.NoError(t, err)
+
+	adminCtx := context.WithValue(adminEc.Request().Context(), echocontext.EchoContextKey, adminEc)
+
+	adminEc.SetRequest(adminEc.Request().WithContext(adminCtx))
+
+	memberEc, err := auth.NewTestContextWithValidUser(member.ID)
+	require.NoError(t, err)
+
+	memberCtx := context.WithValue(memberEc.Request().Context(), echocontext.EchoContextKey, memberEc)
+
+	memberEc.SetRequest(memberEc.Request().WithContext(memberCtx))
+
+	allowCtx := privacy.DecisionContext(adminCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(adminCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	// the user creating the group is its admin
+	openGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyOpen}).MustNew(adminCtx)
+	applicationGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyApplicationOnly}).MustNew(adminCtx)
+	inviteGroup := (&GroupBuilder{Owner: org.ID, JoinPolicy: groupsetting.JoinPolicyInviteOnly}).MustNew(adminCtx)
+
+	assert.True(t, EntClient.GroupMembership.Query().
+		Where(
+			groupmembership.GroupID(openGroup.ID),
+			groupmembership.UserID(admin.ID),
+			groupmembership.RoleEQ(groupmembership.RoleAdmin),
+		).
+		ExistX(allowCtx))
+
+	memberTuple := func(relation, groupID string) ofgaclient.ClientTupleKey {
+		return ofgaclient.ClientTupleKey{
+			User:     fmt.Sprintf("user:%s", member.ID),
+			Relation: relation,
+			Object:   fmt.Sprintf("group:%s", groupID),
+		}
+	}
+
+	// users join open groups without edit access, and the member tuple is written
+	mockWriteTuplesAny(mockCtrl, mc, memberCtx, nil)
+
+	joined, err := client.JoinGroup(memberCtx, openGroup.ID)
+	require.NoError(t, err)
+
+	// users leave groups without edit access, and the member tuple is deleted
+	mockDeleteTuples(mockCtrl, mc, memberCtx, []openfga.TupleKeyWithoutCondition{{
+		User:     memberTuple("member", openGroup.ID).User,
+		Relation: "member",
+		Object:   memberTuple("member", openGroup.ID).Object,
+	}})
+
+	_, err = client.RemoveUserFromGroup(memberCtx, joined.JoinGroup.GroupMembership.ID)
+	require.NoError(t, err)
+
+	// users without edit access cannot join groups that are not open
+	mockCheckAny(mockCtrl, mc, memberCtx, false)
+
+	_, err = client.JoinGroup(memberCtx, inviteGroup.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// admins cannot add members to groups that only accept requests to join
+	mockCheckAny(mockCtrl, mc, adminCtx, true)
+
+	_, err = client.AddUserToGroup(adminCtx, datumclient.CreateGroupMembershipInput{
+		GroupID: applicationGroup.ID,
+		UserID:  member.ID,
+	})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// admins add members to groups that accept invitations
+	mockCheckAny(mockCtrl, mc, adminCtx, true)
+	mockWriteTuplesAny(mockCtrl, mc, adminCtx, nil)
+
+	added, err := client.AddUserToGroup(adminCtx, datumclient.CreateGroupMembershipInput{
+		GroupID: inviteGroup.ID,
+		UserID:  member.ID,
+	})
+	require.NoError(t, err)
+
+	id := added.AddUserToGroup.GroupMembership.ID
+
+	// the admin tuple replaces the member tuple in a single write
+	mockCheckAny(mockCtrl, mc, adminCtx, true)
+	mockWrite(mockCtrl, mc, adminCtx, ofgaclient.ClientWriteRequest{
+		Writes: []ofgaclient.ClientTupleKey{memberTuple("admin", inviteGroup.ID)},
+		Deletes: []openfga.TupleKeyWithoutCondition{{
+			User:     memberTuple("member", inviteGroup.ID).User,
+			Relation: "member",
+			Object:   memberTuple("member", inviteGroup.ID).Object,
+		}},
+	})
+
+	updated, err := client.UpdateGroupMemberRole(adminCtx, id, groupmembership.RoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, groupmembership.RoleAdmin, updated.UpdateGroupMemberRole.GroupMembership.Role)
+
+	// the admin tuple is deleted when the user is removed
+	mockCheckAny(mockCtrl, mc, adminCtx, true)
+	mockDeleteTuples(mockCtrl, mc, adminCtx, []openfga.TupleKeyWithoutCondition{{
+		User:     memberTuple("admin", inviteGroup.ID).User,
+		Relation: "admin",
+		Object:   memberTuple("admin", inviteGroup.ID).Object,
+	}})
+
+	removed, err := client.RemoveUserFromGroup(adminCtx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, removed.RemoveUserFromGroup.DeletedID)
+
+	(&GroupCleanup{GroupID: openGroup.ID}).MustDelete(adminCtx)
+	(&GroupCleanup{GroupID: applicationGroup.ID}).MustDelete(adminCtx)
+	(&GroupCleanup{GroupID: inviteGroup.ID}).MustDelete(adminCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: admin.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(adminCtx)
+}
+
+func TestMutation_GroupAdminRequiredNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(reqCtx)
+
+	admin := (&UserBuilder{}).MustNew(reqCtx)
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).ExecX(allowCtx)
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).ExecX(allowCtx)
+
+	adminMembership := EntClient.GroupMembership.Create().
+		SetGroupID(group.ID).
+		SetUserID(admin.ID).
+		SetRole(groupmembership.RoleAdmin).
+		SaveX(allowCtx)
+
+	memberMembership := EntClient.GroupMembership.Create().
+		SetGroupID(group.ID).
+		SetUserID(member.ID).
+		SaveX(allowCtx)
+
+	// the last admin cannot be demoted or removed
+	_, err := client.UpdateGroupMemberRole(reqCtx, adminMembership.ID, groupmembership.RoleMember)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "at least one admin")
+
+	_, err = client.RemoveUserFromGroup(reqCtx, adminMembership.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "at least one admin")
+
+	// members can be removed
+	_, err = client.RemoveUserFromGroup(reqCtx, memberMembership.ID)
+	require.NoError(t, err)
+
+	// once another admin is added, the first admin can leave
+	memberMembership = EntClient.GroupMembership.Create().
+		SetGroupID(group.ID).
+		SetUserID(member.ID).
+		SetRole(groupmembership.RoleAdmin).
+		SaveX(allowCtx)
+
+	resp, err := client.UpdateGroupMemberRole(reqCtx, adminMembership.ID, groupmembership.RoleMember)
+	require.NoError(t, err)
+	assert.Equal(t, groupmembership.RoleMember, resp.UpdateGroupMemberRole.GroupMembership.Role)
+
+	_, err = client.RemoveUserFromGroup(reqCtx, memberMembership.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "at least one admin")
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: admin.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
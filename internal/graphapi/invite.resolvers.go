@@ -0,0 +1,106 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+)
+
+// CreateInvite is the resolver for the createInvite field.
+func (r *mutationResolver) CreateInvite(ctx context.Context, input generated.CreateInviteInput) (*InviteCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	i, err := withTransactionalMutation(ctx).Invite.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrPersonalOrgsNoInvites) || errors.Is(err, hooks.ErrUserAlreadyOrgMember) ||
+			errors.Is(err, hooks.ErrUserAlreadyInvited) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "invite")
+		}
+
+		r.logger.Errorw("failed to create invite", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if r.inviteSender != nil {
+		if err := r.inviteSender.SendOrgInvitationEmail(ctx, i); err != nil {
+			r.logger.Errorw("failed to send invite email", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	return &InviteCreatePayload{Invite: i}, nil
+}
+
+// RevokeInvite is the resolver for the revokeInvite field.
+func (r *mutationResolver) RevokeInvite(ctx context.Context, id string) (*InviteRevokePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	// only invitations that have not been accepted or declined can be revoked
+	i, err := withTransactionalMutation(ctx).Invite.UpdateOneID(id).
+		Where(invite.StatusEQ(invite.StatusInvitationSent)).
+		SetStatus(invite.StatusInvitationRevoked).
+		Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "invite")
+		}
+
+		r.logger.Errorw("failed to revoke invite", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &InviteRevokePayload{Invite: i}, nil
+}
+
+// Invite is the resolver for the invite field.
+func (r *queryResolver) Invite(ctx context.Context, id string) (*generated.Invite, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	i, err := r.client.Invite.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get invite", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return i, nil
+}
@@ -0,0 +1,167 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_CreateInviteNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	personalOrg := (&OrganizationBuilder{PersonalOrg: true}).MustNew(reqCtx)
+
+	member := (&UserBuilder{}).MustNew(reqCtx)
+	EntClient.Organization.UpdateOne(org).AddUserIDs(member.ID).ExecX(privacy.DecisionContext(reqCtx, privacy.Allow))
+
+	invited := gofakeit.Email()
+
+	admin := invite.RoleAdmin
+
+	testCases := []struct {
+		name      string
+		orgID     string
+		recipient string
+		role      *invite.Role
+		errorMsg  string
+	}{
+		{
+			name:      "happy path, member",
+			orgID:     org.ID,
+			recipient: invited,
+		},
+		{
+			name:      "happy path, admin",
+			orgID:     org.ID,
+			recipient: gofakeit.Email(),
+			role:      &admin,
+		},
+		{
+			name:      "recipient already invited",
+			orgID:     org.ID,
+			recipient: invited,
+			errorMsg:  "outstanding invitation",
+		},
+		{
+			name:      "recipient already a member",
+			orgID:     org.ID,
+			recipient: member.Email,
+			errorMsg:  "already a member",
+		},
+		{
+			name:      "invalid email",
+			orgID:     org.ID,
+			recipient: "not-an-email",
+			errorMsg:  "recipient",
+		},
+		{
+			name:      "personal organization",
+			orgID:     personalOrg.ID,
+			recipient: gofakeit.Email(),
+			errorMsg:  "personal organizations",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := client.CreateInvite(reqCtx, datumclient.CreateInviteInput{
+				Recipient: tc.recipient,
+				Role:      tc.role,
+				OwnerID:   tc.orgID,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			created := resp.CreateInvite.Invite
+			assert.Equal(t, tc.recipient, created.Recipient)
+			assert.Equal(t, org.ID, created.Owner.ID)
+			assert.Equal(t, invite.StatusInvitationSent, created.Status)
+			assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), created.Expires, time.Minute)
+
+			if tc.role != nil {
+				assert.Equal(t, *tc.role, created.Role)
+			} else {
+				assert.Equal(t, invite.RoleMember, created.Role)
+			}
+
+			// the signed token and its secret are stored to verify the token when the invitation is accepted
+			i := EntClient.Invite.GetX(privacy.DecisionContext(reqCtx, privacy.Allow), created.ID)
+			assert.NotEmpty(t, i.Token)
+			assert.NotNil(t, i.Secret)
+		})
+	}
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: personalOrg.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_RevokeInviteNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	recipient := gofakeit.Email()
+
+	resp, err := client.CreateInvite(reqCtx, datumclient.CreateInviteInput{
+		Recipient: recipient,
+		OwnerID:   org.ID,
+	})
+	require.NoError(t, err)
+
+	id := resp.CreateInvite.Invite.ID
+
+	revoked, err := client.RevokeInvite(reqCtx, id)
+	require.NoError(t, err)
+
+	assert.Equal(t, id, revoked.RevokeInvite.Invite.ID)
+	assert.Equal(t, invite.StatusInvitationRevoked, revoked.RevokeInvite.Invite.Status)
+
+	// revoked invitations can no longer be revoked
+	_, err = client.RevokeInvite(reqCtx, id)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not found")
+
+	// the recipient can be invited again once the invitation is revoked
+	_, err = client.CreateInvite(reqCtx, datumclient.CreateInviteInput{
+		Recipient: recipient,
+		OwnerID:   org.ID,
+	})
+	require.NoError(t, err)
+
+	got, err := client.GetInviteByID(reqCtx, id)
+	require.NoError(t, err)
+
+	assert.Equal(t, invite.StatusInvitationRevoked, got.Invite.Status)
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+}
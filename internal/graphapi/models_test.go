@@ -7,7 +7,9 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/keygen"
 )
 
 type OrganizationBuilder struct {
@@ -24,8 +26,10 @@ type OrganizationCleanup struct {
 }
 
 type GroupBuilder struct {
-	Name  string
-	Owner string
+	Name       string
+	Owner      string
+	Visibility groupsetting.Visibility
+	JoinPolicy groupsetting.JoinPolicy
 }
 
 type GroupCleanup struct {
@@ -140,7 +144,24 @@ func (g *GroupBuilder) MustNew(ctx context.Context) *generated.Group {
 		owner = org.ID
 	}
 
-	return EntClient.Group.Create().SetName(g.Name).SetOwnerID(owner).SaveX(ctx)
+	create := EntClient.Group.Create().SetName(g.Name).SetOwnerID(owner)
+
+	// the default settings are created by the group hook unless the visibility or join policy is provided
+	if g.Visibility != "" || g.JoinPolicy != "" {
+		setting := EntClient.GroupSetting.Create()
+
+		if g.Visibility != "" {
+			setting.SetVisibility(g.Visibility)
+		}
+
+		if g.JoinPolicy != "" {
+			setting.SetJoinPolicy(g.JoinPolicy)
+		}
+
+		create.SetSetting(setting.SaveX(ctx))
+	}
+
+	return create.SaveX(ctx)
 }
 
 // MustDelete is used to cleanup, without authz checks, groups in the database
@@ -166,10 +187,25 @@ func (t *PersonalAccessTokenBuilder) MustNew(ctx context.Context) *generated.Per
 		t.OwnerID = owner.ID
 	}
 
+	if t.Token == "" {
+		t.Token, _ = keygen.PersonalAccessToken()
+	}
+
+	prefix, err := keygen.PersonalAccessTokenPrefixOf(t.Token)
+	if err != nil {
+		panic(err)
+	}
+
+	hash, err := keygen.HashPersonalAccessToken(t.Token)
+	if err != nil {
+		panic(err)
+	}
+
 	return EntClient.PersonalAccessToken.Create().
 		SetName(t.Name).
 		SetOwnerID(t.OwnerID).
-		SetToken(t.Token).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hash).
 		SetDescription(t.Description).
 		SetExpiresAt(t.ExpiresAt).
 		SaveX(ctx)
@@ -0,0 +1,153 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// CreateOauthClient is the resolver for the createOauthClient field.
+func (r *mutationResolver) CreateOauthClient(ctx context.Context, input generated.CreateOauthClientInput) (*OauthClientCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	// the client id and secret are generated by the server and only the hash of the secret is stored,
+	// so the secret is only returned once
+	clientID, secret := keygen.OauthClient()
+
+	create := withTransactionalMutation(ctx).OauthClient.Create().
+		SetInput(input).
+		SetClientID(clientID)
+
+	// public clients cannot keep a secret so they are not issued one
+	public := input.Public != nil && *input.Public
+	if public {
+		secret = ""
+	} else {
+		hash, err := keygen.HashOauthClientSecret(secret)
+		if err != nil {
+			r.logger.Errorw("failed to hash oauth client secret", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		create.SetClientSecretHash(hash)
+	}
+
+	client, err := create.Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, tokens.ErrInvalidScope) || errors.Is(err, hooks.ErrOauthClientMissingScopes) ||
+			errors.Is(err, hooks.ErrOauthClientMissingRedirectURIs) || errors.Is(err, hooks.ErrInvalidRedirectURI) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "oauth client")
+		}
+
+		r.logger.Errorw("failed to create oauth client", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	payload := &OauthClientCreatePayload{OauthClient: client}
+
+	if !public {
+		payload.ClientSecret = &secret
+	}
+
+	return payload, nil
+}
+
+// UpdateOauthClient is the resolver for the updateOauthClient field.
+func (r *mutationResolver) UpdateOauthClient(ctx context.Context, id string, input generated.UpdateOauthClientInput) (*OauthClientUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	client, err := withTransactionalMutation(ctx).OauthClient.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) || generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrOauthClientMissingRedirectURIs) || errors.Is(err, hooks.ErrInvalidRedirectURI) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "oauth client")
+		}
+
+		r.logger.Errorw("failed to update oauth client", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OauthClientUpdatePayload{OauthClient: client}, nil
+}
+
+// DeleteOauthClient is the resolver for the deleteOauthClient field.
+func (r *mutationResolver) DeleteOauthClient(ctx context.Context, id string) (*OauthClientDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).OauthClient.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionDelete, "oauth client")
+		}
+
+		r.logger.Errorw("failed to delete oauth client", "error", err)
+		return nil, err
+	}
+
+	return &OauthClientDeletePayload{DeletedID: id}, nil
+}
+
+// OauthClient is the resolver for the oauthClient field.
+func (r *queryResolver) OauthClient(ctx context.Context, id string) (*generated.OauthClient, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	client, err := r.client.OauthClient.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get oauth client", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return client, nil
+}
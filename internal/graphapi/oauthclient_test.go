@@ -0,0 +1,109 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+func TestMutation_CreateOauthClientNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	public := true
+
+	testCases := []struct {
+		name         string
+		scopes       []string
+		redirectURIs []string
+		public       *bool
+		errorMsg     string
+	}{
+		{
+			name:         "happy path, confidential client",
+			scopes:       []string{"openid", "email", "org:read"},
+			redirectURIs: []string{"https://app.example.com/callback"},
+		},
+		{
+			name:         "happy path, public client",
+			scopes:       []string{"openid"},
+			redirectURIs: []string{"com.example.app:/callback"},
+			public:       &public,
+		},
+		{
+			name:         "missing scopes",
+			scopes:       []string{},
+			redirectURIs: []string{"https://app.example.com/callback"},
+			errorMsg:     "at least one scope",
+		},
+		{
+			name:         "invalid scopes",
+			scopes:       []string{"offline_access"},
+			redirectURIs: []string{"https://app.example.com/callback"},
+			errorMsg:     "invalid scope",
+		},
+		{
+			name:         "missing redirect uris",
+			scopes:       []string{"openid"},
+			redirectURIs: []string{},
+			errorMsg:     "redirect uri",
+		},
+		{
+			name:         "relative redirect uri",
+			scopes:       []string{"openid"},
+			redirectURIs: []string{"/callback"},
+			errorMsg:     "redirect uri",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := client.CreateOauthClient(reqCtx, datumclient.CreateOauthClientInput{
+				Name:         gofakeit.AppName(),
+				OwnerID:      org.ID,
+				RedirectUris: tc.redirectURIs,
+				Scopes:       tc.scopes,
+				Public:       tc.public,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			created := resp.CreateOauthClient
+			assert.Len(t, created.OauthClient.ClientID, keygen.OauthClientIDLength)
+			assert.Equal(t, tc.scopes, created.OauthClient.Scopes)
+			assert.Equal(t, org.ID, created.OauthClient.Owner.ID)
+
+			// only confidential clients are issued a secret
+			if tc.public != nil && *tc.public {
+				assert.True(t, created.OauthClient.Public)
+				assert.Nil(t, created.ClientSecret)
+			} else {
+				require.NotNil(t, created.ClientSecret)
+				assert.NotEmpty(t, *created.ClientSecret)
+			}
+		})
+	}
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+}
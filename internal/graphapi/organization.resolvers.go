@@ -11,6 +11,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 )
 
@@ -100,6 +101,43 @@ func (r *mutationResolver) UpdateOrganization(ctx context.Context, id string, in
 	return &OrganizationUpdatePayload{Organization: org}, nil
 }
 
+// MoveOrganization is the resolver for the moveOrganization field.
+func (r *mutationResolver) MoveOrganization(ctx context.Context, id string, parentID string) (*OrganizationUpdatePayload, error) {
+	// check permissions if authz is enabled
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		v := viewer.UserViewer{
+			OrgID: id,
+		}
+
+		ctx = viewer.NewContext(ctx, v)
+	}
+
+	org, err := withTransactionalMutation(ctx).Organization.UpdateOneID(id).SetParentID(parentID).Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) || generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrPersonalOrgsNotMovable) || errors.Is(err, hooks.ErrPersonalOrgsNoChildren) ||
+			errors.Is(err, hooks.ErrOrgHierarchyCycle) || errors.Is(err, hooks.ErrOrgHierarchyTooDeep) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "organization")
+		}
+
+		r.logger.Errorw("failed to move organization", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OrganizationUpdatePayload{Organization: org}, nil
+}
+
 // DeleteOrganization is the resolver for the deleteOrganization field.
 func (r *mutationResolver) DeleteOrganization(ctx context.Context, id string) (*OrganizationDeletePayload, error) {
 	// check permissions if authz is enabled
@@ -116,7 +154,7 @@ func (r *mutationResolver) DeleteOrganization(ctx context.Context, id string) (*
 	}
 
 	if err := withTransactionalMutation(ctx).Organization.DeleteOneID(id).Exec(ctx); err != nil {
-		if generated.IsNotFound(err) {
+		if generated.IsNotFound(err) || errors.Is(err, hooks.ErrOrgHasChildren) {
 			return nil, err
 		}
 
@@ -167,3 +205,93 @@ func (r *queryResolver) Organization(ctx context.Context, id string) (*generated
 
 	return org, nil
 }
+
+// OrganizationAncestors is the resolver for the organizationAncestors field.
+func (r *queryResolver) OrganizationAncestors(ctx context.Context, id string) ([]*generated.Organization, error) {
+	// check permissions if authz is enabled
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		v := viewer.UserViewer{
+			OrgID: id,
+		}
+
+		ctx = viewer.NewContext(ctx, v)
+	}
+
+	// the organization is looked up first to check access to it
+	if _, err := r.client.Organization.Get(ctx, id); err != nil {
+		r.logger.Errorw("failed to get organization", "error", err)
+
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionGet, "organization")
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	ids, err := hooks.OrganizationAncestorIDs(ctx, r.client, id)
+	if err != nil {
+		r.logger.Errorw("failed to get organization ancestors", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	orgs, err := organizationsByID(ctx, r.client, ids)
+	if err != nil {
+		r.logger.Errorw("failed to get organization ancestors", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orgs, nil
+}
+
+// OrganizationDescendants is the resolver for the organizationDescendants field.
+func (r *queryResolver) OrganizationDescendants(ctx context.Context, id string) ([]*generated.Organization, error) {
+	// check permissions if authz is enabled
+	// if auth is disabled, policy decisions will be skipped
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		v := viewer.UserViewer{
+			OrgID: id,
+		}
+
+		ctx = viewer.NewContext(ctx, v)
+	}
+
+	// the organization is looked up first to check access to it
+	if _, err := r.client.Organization.Get(ctx, id); err != nil {
+		r.logger.Errorw("failed to get organization", "error", err)
+
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionGet, "organization")
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	ids, err := hooks.OrganizationDescendantIDs(ctx, r.client, id)
+	if err != nil {
+		r.logger.Errorw("failed to get organization descendants", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	orgs, err := organizationsByID(ctx, r.client, ids)
+	if err != nil {
+		r.logger.Errorw("failed to get organization descendants", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orgs, nil
+}
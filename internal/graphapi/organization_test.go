@@ -7,13 +7,17 @@ import (
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/datumforge/datum/internal/datumclient"
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/fga"
 	mock_client "github.com/datumforge/datum/internal/fga/mocks"
 	auth "github.com/datumforge/datum/internal/httpserve/middleware/auth"
 	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
@@ -217,6 +221,121 @@ func TestQuery_OrganizationsAuth(t *testing.T) {
 	})
 }
 
+func TestQuery_OrganizationsScopedToken(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	// Setup Test Graph Client
+	client := graphTestClient(t, entClient)
+
+	sub := ulids.New().String()
+
+	ec, err := auth.NewTestContextWithValidUser(sub)
+	if err != nil {
+		t.Fatal()
+	}
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+	child := (&OrganizationBuilder{ParentOrgID: org.ID}).MustNew(reqCtx)
+	grandchild := (&OrganizationBuilder{ParentOrgID: child.ID}).MustNew(reqCtx)
+	otherOrg := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	// the token is scoped to the organization, e.g. after switching organizations
+	scoped, err := auth.NewTestContextWithOrgID(sub, org.ID)
+	if err != nil {
+		t.Fatal()
+	}
+
+	scopedCtx := context.WithValue(scoped.Request().Context(), echocontext.EchoContextKey, scoped)
+
+	scoped.SetRequest(scoped.Request().WithContext(scopedCtx))
+
+	// the user has access to every organization
+	listObjects := []string{
+		fmt.Sprintf("organization:%s", org.ID),
+		fmt.Sprintf("organization:%s", child.ID),
+		fmt.Sprintf("organization:%s", grandchild.ID),
+		fmt.Sprintf("organization:%s", otherOrg.ID),
+	}
+
+	t.Run("Get Organizations filtered to the token organization", func(t *testing.T) {
+		mockListAny(mockCtrl, mc, scopedCtx, listObjects)
+		mockListAny(mockCtrl, mc, scopedCtx, listObjects)
+		mockListAny(mockCtrl, mc, scopedCtx, listObjects)
+
+		resp, err := client.GetAllOrganizations(scopedCtx)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		// the organization and all of its descendant organizations are returned
+		var ids []string
+		for _, o := range resp.Organizations.Edges {
+			ids = append(ids, o.Node.ID)
+		}
+
+		assert.ElementsMatch(t, []string{org.ID, child.ID, grandchild.ID}, ids)
+	})
+
+	t.Run("Get Organization outside the token organization", func(t *testing.T) {
+		mockCheckAny(mockCtrl, mc, scopedCtx, true)
+		mockListAny(mockCtrl, mc, scopedCtx, listObjects)
+
+		resp, err := client.GetOrganizationByID(scopedCtx, otherOrg.ID)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "not found")
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Create Group in a descendant of the token organization", func(t *testing.T) {
+		input := datumclient.CreateGroupInput{
+			Name:    gofakeit.Name(),
+			OwnerID: grandchild.ID,
+		}
+
+		mockCheckAny(mockCtrl, mc, scopedCtx, true)
+		mockWriteTuplesAny(mockCtrl, mc, scopedCtx, nil)
+		mockListAny(mockCtrl, mc, scopedCtx, listObjects)
+
+		resp, err := client.CreateGroup(scopedCtx, input)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, grandchild.ID, resp.CreateGroup.Group.Owner.ID)
+	})
+
+	t.Run("Create Group outside the token organization", func(t *testing.T) {
+		input := datumclient.CreateGroupInput{
+			Name:    gofakeit.Name(),
+			OwnerID: otherOrg.ID,
+		}
+
+		// access is denied before the relationship tuples are checked
+		resp, err := client.CreateGroup(scopedCtx, input)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "not authorized")
+		assert.Nil(t, resp)
+	})
+
+	// delete created orgs
+	(&OrganizationCleanup{OrgID: grandchild.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: child.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: otherOrg.ID}).MustDelete(reqCtx)
+}
+
 func TestMutation_CreateOrganization(t *testing.T) {
 	// Add Authz Client Mock
 	// setup mock controller
@@ -665,6 +784,11 @@ func TestMutation_DeleteOrganization(t *testing.T) {
 				if tc.errorMsg == "" {
 					mockListAny(mockCtrl, mc, reqCtx, listObjects)
 					mockCheckAny(mockCtrl, mc, reqCtx, tc.accessAllowed)
+
+					// the edge cleanup looks up the api keys, oauth clients and invites of the org
+					mockListAny(mockCtrl, mc, reqCtx, listObjects)
+					mockListAny(mockCtrl, mc, reqCtx, listObjects)
+					mockListAny(mockCtrl, mc, reqCtx, listObjects)
 				}
 			}
 
@@ -820,3 +944,310 @@ func TestMutation_CreateOrganizationTransaction(t *testing.T) {
 		}
 	})
 }
+
+func TestQuery_OrganizationHierarchyNoAuth(t *testing.T) {
+	// Setup Test Graph Client Without Auth
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	root := (&OrganizationBuilder{}).MustNew(reqCtx)
+	child := (&OrganizationBuilder{ParentOrgID: root.ID}).MustNew(reqCtx)
+	sibling := (&OrganizationBuilder{ParentOrgID: root.ID}).MustNew(reqCtx)
+	grandchild := (&OrganizationBuilder{ParentOrgID: child.ID}).MustNew(reqCtx)
+
+	t.Run("Get ancestors, ordered from the parent up to the root", func(t *testing.T) {
+		resp, err := client.GetOrganizationAncestors(reqCtx, grandchild.ID)
+		require.NoError(t, err)
+
+		require.Len(t, resp.OrganizationAncestors, 2)
+		assert.Equal(t, child.ID, resp.OrganizationAncestors[0].ID)
+		assert.Equal(t, root.ID, resp.OrganizationAncestors[1].ID)
+		assert.Equal(t, root.ID, resp.OrganizationAncestors[0].Parent.ID)
+	})
+
+	t.Run("Get ancestors of a root organization", func(t *testing.T) {
+		resp, err := client.GetOrganizationAncestors(reqCtx, root.ID)
+		require.NoError(t, err)
+
+		assert.Empty(t, resp.OrganizationAncestors)
+	})
+
+	t.Run("Get descendants, level by level", func(t *testing.T) {
+		resp, err := client.GetOrganizationDescendants(reqCtx, root.ID)
+		require.NoError(t, err)
+
+		require.Len(t, resp.OrganizationDescendants, 3)
+
+		ids := []string{resp.OrganizationDescendants[0].ID, resp.OrganizationDescendants[1].ID}
+		assert.ElementsMatch(t, []string{child.ID, sibling.ID}, ids)
+		assert.Equal(t, grandchild.ID, resp.OrganizationDescendants[2].ID)
+		assert.Equal(t, child.ID, resp.OrganizationDescendants[2].Parent.ID)
+	})
+
+	t.Run("Get descendants of an organization without child organizations", func(t *testing.T) {
+		resp, err := client.GetOrganizationDescendants(reqCtx, grandchild.ID)
+		require.NoError(t, err)
+
+		assert.Empty(t, resp.OrganizationDescendants)
+	})
+
+	t.Run("Get ancestors of an unknown organization", func(t *testing.T) {
+		resp, err := client.GetOrganizationAncestors(reqCtx, ulids.New().String())
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "not found")
+		assert.Nil(t, resp)
+	})
+
+	(&OrganizationCleanup{OrgID: grandchild.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: sibling.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: child.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: root.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_MoveOrganizationNoAuth(t *testing.T) {
+	// Setup Test Graph Client Without Auth
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	root := (&OrganizationBuilder{}).MustNew(reqCtx)
+	child := (&OrganizationBuilder{ParentOrgID: root.ID}).MustNew(reqCtx)
+	grandchild := (&OrganizationBuilder{ParentOrgID: child.ID}).MustNew(reqCtx)
+	otherRoot := (&OrganizationBuilder{}).MustNew(reqCtx)
+	personalOrg := (&OrganizationBuilder{PersonalOrg: true}).MustNew(reqCtx)
+
+	// a chain of organizations as deep as the hierarchy allows
+	chain := []*ent.Organization{(&OrganizationBuilder{}).MustNew(reqCtx)}
+	for i := 0; i < hooks.MaxOrganizationDepth; i++ {
+		chain = append(chain, (&OrganizationBuilder{ParentOrgID: chain[i].ID}).MustNew(reqCtx))
+	}
+
+	deepest := chain[len(chain)-1]
+
+	testCases := []struct {
+		name     string
+		orgID    string
+		parentID string
+		errorMsg string
+	}{
+		{
+			name:     "under itself",
+			orgID:    child.ID,
+			parentID: child.ID,
+			errorMsg: hooks.ErrOrgHierarchyCycle.Error(),
+		},
+		{
+			name:     "under a descendant",
+			orgID:    root.ID,
+			parentID: grandchild.ID,
+			errorMsg: hooks.ErrOrgHierarchyCycle.Error(),
+		},
+		{
+			name:     "personal organization",
+			orgID:    personalOrg.ID,
+			parentID: root.ID,
+			errorMsg: hooks.ErrPersonalOrgsNotMovable.Error(),
+		},
+		{
+			name:     "under a personal organization",
+			orgID:    child.ID,
+			parentID: personalOrg.ID,
+			errorMsg: hooks.ErrPersonalOrgsNoChildren.Error(),
+		},
+		{
+			name:     "child organizations below the maximum depth",
+			orgID:    child.ID,
+			parentID: chain[len(chain)-2].ID,
+			errorMsg: hooks.ErrOrgHierarchyTooDeep.Error(),
+		},
+		{
+			name:     "unknown parent organization",
+			orgID:    child.ID,
+			parentID: ulids.New().String(),
+			errorMsg: "not found",
+		},
+		{
+			name:     "happy path, along with its child organizations",
+			orgID:    child.ID,
+			parentID: otherRoot.ID,
+		},
+		{
+			name:     "happy path, root organization under another root",
+			orgID:    root.ID,
+			parentID: chain[0].ID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Move "+tc.name, func(t *testing.T) {
+			resp, err := client.MoveOrganization(reqCtx, tc.orgID, tc.parentID)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.MoveOrganization.Organization.Parent)
+			assert.Equal(t, tc.parentID, resp.MoveOrganization.Organization.Parent.ID)
+		})
+	}
+
+	// the child organization was moved along with its parent
+	ancestors, err := client.GetOrganizationAncestors(reqCtx, grandchild.ID)
+	require.NoError(t, err)
+
+	require.Len(t, ancestors.OrganizationAncestors, 2)
+	assert.Equal(t, child.ID, ancestors.OrganizationAncestors[0].ID)
+	assert.Equal(t, otherRoot.ID, ancestors.OrganizationAncestors[1].ID)
+
+	t.Run("Create organization below the maximum depth", func(t *testing.T) {
+		resp, err := client.CreateOrganization(reqCtx, datumclient.CreateOrganizationInput{
+			Name:     gofakeit.Name(),
+			ParentID: &deepest.ID,
+		})
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, hooks.ErrOrgHierarchyTooDeep.Error())
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Delete organization with child organizations", func(t *testing.T) {
+		resp, err := client.DeleteOrganization(reqCtx, otherRoot.ID)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, hooks.ErrOrgHasChildren.Error())
+		assert.Nil(t, resp)
+
+		// the organization and its child organizations are untouched
+		descendants, err := client.GetOrganizationDescendants(reqCtx, otherRoot.ID)
+		require.NoError(t, err)
+
+		assert.Len(t, descendants.OrganizationDescendants, 2)
+	})
+
+	(&OrganizationCleanup{OrgID: grandchild.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: child.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: otherRoot.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: root.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: personalOrg.ID}).MustDelete(reqCtx)
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		(&OrganizationCleanup{OrgID: chain[i].ID}).MustDelete(reqCtx)
+	}
+}
+
+func TestMutation_MoveOrganization(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	// Setup Test Graph Client
+	client := graphTestClient(t, entClient)
+
+	// Setup echo context
+	sub := ulids.New().String()
+
+	ec, err := auth.NewTestContextWithValidUser(sub)
+	if err != nil {
+		t.Fatal()
+	}
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	oldParent := (&OrganizationBuilder{}).MustNew(reqCtx)
+	newParent := (&OrganizationBuilder{}).MustNew(reqCtx)
+	child := (&OrganizationBuilder{ParentOrgID: oldParent.ID}).MustNew(reqCtx)
+
+	listObjects := []string{
+		fmt.Sprintf("organization:%s", oldParent.ID),
+		fmt.Sprintf("organization:%s", newParent.ID),
+		fmt.Sprintf("organization:%s", child.ID),
+	}
+
+	t.Run("Move without edit access in the new parent", func(t *testing.T) {
+		mockCheckAny(mockCtrl, mc, reqCtx, false)
+
+		resp, err := client.MoveOrganization(reqCtx, child.ID, newParent.ID)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "you are not authorized to perform this action")
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Move without delete access in the organization", func(t *testing.T) {
+		mockCheckAny(mockCtrl, mc, reqCtx, true)
+		mockCheckAny(mockCtrl, mc, reqCtx, false)
+
+		resp, err := client.MoveOrganization(reqCtx, child.ID, newParent.ID)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "you are not authorized to perform this action")
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Move happy path", func(t *testing.T) {
+		mockCheckAny(mockCtrl, mc, reqCtx, true)
+		mockCheckAny(mockCtrl, mc, reqCtx, true)
+
+		// the parent tuple is replaced in a single write
+		object := fmt.Sprintf("organization:%s", child.ID)
+
+		mockWrite(mockCtrl, mc, reqCtx, ofgaclient.ClientWriteRequest{
+			Writes: []ofgaclient.ClientTupleKey{{
+				User:     fmt.Sprintf("organization:%s", newParent.ID),
+				Relation: fga.ParentRelation,
+				Object:   object,
+			}},
+			Deletes: []openfga.TupleKeyWithoutCondition{{
+				User:     fmt.Sprintf("organization:%s", oldParent.ID),
+				Relation: fga.ParentRelation,
+				Object:   object,
+			}},
+		})
+
+		mockListAny(mockCtrl, mc, reqCtx, listObjects)
+
+		resp, err := client.MoveOrganization(reqCtx, child.ID, newParent.ID)
+		require.NoError(t, err)
+
+		require.NotNil(t, resp.MoveOrganization.Organization.Parent)
+		assert.Equal(t, newParent.ID, resp.MoveOrganization.Organization.Parent.ID)
+	})
+
+	t.Run("Delete organization with child organizations", func(t *testing.T) {
+		// the access is checked again when the delete is changed to a soft delete
+		mockCheckAny(mockCtrl, mc, reqCtx, true)
+		mockCheckAny(mockCtrl, mc, reqCtx, true)
+
+		resp, err := client.DeleteOrganization(reqCtx, newParent.ID)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, hooks.ErrOrgHasChildren.Error())
+		assert.Nil(t, resp)
+	})
+
+	(&OrganizationCleanup{OrgID: child.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: oldParent.ID}).MustDelete(reqCtx)
+	(&OrganizationCleanup{OrgID: newParent.ID}).MustDelete(reqCtx)
+}
@@ -0,0 +1,127 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+)
+
+// AddUserToOrganization is the resolver for the addUserToOrganization field.
+func (r *mutationResolver) AddUserToOrganization(ctx context.Context, input generated.CreateOrgMembershipInput) (*OrgMembershipCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	om, err := withTransactionalMutation(ctx).OrgMembership.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrPersonalOrgsNoMembers) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "org membership")
+		}
+
+		r.logger.Errorw("failed to create org membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OrgMembershipCreatePayload{OrgMembership: om}, nil
+}
+
+// UpdateOrgMemberRole is the resolver for the updateOrgMemberRole field.
+func (r *mutationResolver) UpdateOrgMemberRole(ctx context.Context, id string, role orgmembership.Role) (*OrgMembershipUpdatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	om, err := withTransactionalMutation(ctx).OrgMembership.UpdateOneID(id).SetRole(role).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrOrgOwnerRequired) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "org membership")
+		}
+
+		r.logger.Errorw("failed to update org membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OrgMembershipUpdatePayload{OrgMembership: om}, nil
+}
+
+// RemoveUserFromOrganization is the resolver for the removeUserFromOrganization field.
+func (r *mutationResolver) RemoveUserFromOrganization(ctx context.Context, id string) (*OrgMembershipDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).OrgMembership.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrOrgOwnerRequired) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionDelete, "org membership")
+		}
+
+		r.logger.Errorw("failed to delete org membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OrgMembershipDeletePayload{DeletedID: id}, nil
+}
+
+// OrgMembership is the resolver for the orgMembership field.
+func (r *queryResolver) OrgMembership(ctx context.Context, id string) (*generated.OrgMembership, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	om, err := r.client.OrgMembership.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get org membership", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return om, nil
+}
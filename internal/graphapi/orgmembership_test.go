@@ -0,0 +1,345 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_AddUserToOrganizationNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	owner := (&UserBuilder{}).MustNew(reqCtx)
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	// users are the owner of their personal organization
+	personalOrg := EntClient.OrgMembership.Query().
+		Where(
+			orgmembership.UserID(owner.ID),
+			orgmembership.HasOrganizationWith(organization.PersonalOrg(true)),
+		).
+		OnlyX(privacy.DecisionContext(reqCtx, privacy.Allow))
+	assert.Equal(t, orgmembership.RoleOwner, personalOrg.Role)
+
+	ownerRole := orgmembership.RoleOwner
+
+	testCases := []struct {
+		name     string
+		orgID    string
+		userID   string
+		role     *orgmembership.Role
+		expected orgmembership.Role
+		errorMsg string
+	}{
+		{
+			name:     "happy path, owner",
+			orgID:    org.ID,
+			userID:   owner.ID,
+			role:     &ownerRole,
+			expected: orgmembership.RoleOwner,
+		},
+		{
+			name:     "happy path, member by default",
+			orgID:    org.ID,
+			userID:   member.ID,
+			expected: orgmembership.RoleMember,
+		},
+		{
+			name:     "user already a member",
+			orgID:    org.ID,
+			userID:   member.ID,
+			errorMsg: "constraint failed",
+		},
+		{
+			name:     "personal organization",
+			orgID:    personalOrg.OrganizationID,
+			userID:   member.ID,
+			errorMsg: "personal organizations",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Add "+tc.name, func(t *testing.T) {
+			resp, err := client.AddUserToOrganization(reqCtx, datumclient.CreateOrgMembershipInput{
+				OrganizationID: tc.orgID,
+				UserID:         tc.userID,
+				Role:           tc.role,
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			om := resp.AddUserToOrganization.OrgMembership
+			assert.Equal(t, tc.orgID, om.OrganizationID)
+			assert.Equal(t, tc.userID, om.UserID)
+			assert.Equal(t, tc.expected, om.Role)
+		})
+	}
+
+	// the users are members of the organization
+	userIDs := EntClient.Organization.QueryUsers(org).IDsX(privacy.DecisionContext(reqCtx, privacy.Allow))
+
+	assert.ElementsMatch(t, []string{owner.ID, member.ID}, userIDs)
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_UpdateOrgMemberRoleNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	owner := (&UserBuilder{}).MustNew(reqCtx)
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	ownerMembership := EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(owner.ID).
+		SetRole(orgmembership.RoleOwner).
+		SaveX(allowCtx)
+
+	memberMembership := EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		SaveX(allowCtx)
+
+	testCases := []struct {
+		name     string
+		id       string
+		role     orgmembership.Role
+		errorMsg string
+	}{
+		{
+			name: "happy path, member to admin",
+			id:   memberMembership.ID,
+			role: orgmembership.RoleAdmin,
+		},
+		{
+			name:     "last owner cannot be demoted",
+			id:       ownerMembership.ID,
+			role:     orgmembership.RoleAdmin,
+			errorMsg: "at least one owner",
+		},
+		{
+			name: "happy path, admin to owner",
+			id:   memberMembership.ID,
+			role: orgmembership.RoleOwner,
+		},
+		{
+			name: "happy path, owner demoted once there is another owner",
+			id:   ownerMembership.ID,
+			role: orgmembership.RoleMember,
+		},
+		{
+			name:     "membership not found",
+			id:       "notfound",
+			role:     orgmembership.RoleAdmin,
+			errorMsg: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Update "+tc.name, func(t *testing.T) {
+			resp, err := client.UpdateOrgMemberRole(reqCtx, tc.id, tc.role)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.id, resp.UpdateOrgMemberRole.OrgMembership.ID)
+			assert.Equal(t, tc.role, resp.UpdateOrgMemberRole.OrgMembership.Role)
+		})
+	}
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_RemoveUserFromOrganizationNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	owner := (&UserBuilder{}).MustNew(reqCtx)
+	member := (&UserBuilder{}).MustNew(reqCtx)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	ownerMembership := EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(owner.ID).
+		SetRole(orgmembership.RoleOwner).
+		SaveX(allowCtx)
+
+	memberMembership := EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		SaveX(allowCtx)
+
+	// the last owner cannot be removed
+	_, err := client.RemoveUserFromOrganization(reqCtx, ownerMembership.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "at least one owner")
+
+	resp, err := client.RemoveUserFromOrganization(reqCtx, memberMembership.ID)
+	require.NoError(t, err)
+	assert.Equal(t, memberMembership.ID, resp.RemoveUserFromOrganization.DeletedID)
+
+	// the user is no longer a member of the organization
+	assert.False(t, EntClient.Organization.Query().
+		Where(organization.ID(org.ID), organization.HasMembersWith(orgmembership.UserID(member.ID))).
+		ExistX(allowCtx))
+
+	_, err = client.GetOrgMembershipByID(reqCtx, memberMembership.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not found")
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_OrgMembershipAuthz(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	owner := (&UserBuilder{}).MustNew(context.Background())
+	member := (&UserBuilder{}).MustNew(context.Background())
+
+	ec, err := auth.NewTestContextWithValidUser(owner.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	// the user creating the organization is its owner
+	org := (&OrganizationBuilder{}).MustNew(reqCtx)
+
+	assert.True(t, EntClient.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(org.ID),
+			orgmembership.UserID(owner.ID),
+			orgmembership.RoleEQ(orgmembership.RoleOwner),
+		).
+		ExistX(privacy.DecisionContext(reqCtx, privacy.Allow)))
+
+	memberTuple := func(relation string) ofgaclient.ClientTupleKey {
+		return ofgaclient.ClientTupleKey{
+			User:     fmt.Sprintf("user:%s", member.ID),
+			Relation: relation,
+			Object:   fmt.Sprintf("organization:%s", org.ID),
+		}
+	}
+
+	// users without edit access cannot add members
+	mockCheckAny(mockCtrl, mc, reqCtx, false)
+
+	_, err = client.AddUserToOrganization(reqCtx, datumclient.CreateOrgMembershipInput{
+		OrganizationID: org.ID,
+		UserID:         member.ID,
+	})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// the member tuple is written when the user is added
+	mockCheckAny(mockCtrl, mc, reqCtx, true)
+	mockWriteTuplesAny(mockCtrl, mc, reqCtx, nil)
+
+	added, err := client.AddUserToOrganization(reqCtx, datumclient.CreateOrgMembershipInput{
+		OrganizationID: org.ID,
+		UserID:         member.ID,
+	})
+	require.NoError(t, err)
+
+	id := added.AddUserToOrganization.OrgMembership.ID
+
+	// the admin tuple replaces the member tuple in a single write
+	mockCheckAny(mockCtrl, mc, reqCtx, true)
+	mockWrite(mockCtrl, mc, reqCtx, ofgaclient.ClientWriteRequest{
+		Writes: []ofgaclient.ClientTupleKey{memberTuple("admin")},
+		Deletes: []openfga.TupleKeyWithoutCondition{{
+			User:     memberTuple("member").User,
+			Relation: "member",
+			Object:   memberTuple("member").Object,
+		}},
+	})
+
+	updated, err := client.UpdateOrgMemberRole(reqCtx, id, orgmembership.RoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, orgmembership.RoleAdmin, updated.UpdateOrgMemberRole.OrgMembership.Role)
+
+	// the admin tuple is deleted when the user is removed
+	mockCheckAny(mockCtrl, mc, reqCtx, true)
+	mockDeleteTuples(mockCtrl, mc, reqCtx, []openfga.TupleKeyWithoutCondition{{
+		User:     memberTuple("admin").User,
+		Relation: "admin",
+		Object:   memberTuple("admin").Object,
+	}})
+
+	removed, err := client.RemoveUserFromOrganization(reqCtx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, removed.RemoveUserFromOrganization.DeletedID)
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+	(&UserCleanup{UserID: member.ID}).MustDelete(reqCtx)
+}
@@ -0,0 +1,151 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+)
+
+// TransferOrganizationOwnership is the resolver for the transferOrganizationOwnership field.
+func (r *mutationResolver) TransferOrganizationOwnership(ctx context.Context, organizationID string, recipientID string) (*OwnershipTransferCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	t, err := withTransactionalMutation(ctx).OwnershipTransfer.Create().
+		SetOwnerID(organizationID).
+		SetRecipientID(recipientID).
+		Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrPersonalOrgsNotTransferable) || errors.Is(err, hooks.ErrTransferRequestorNotOwner) ||
+			errors.Is(err, hooks.ErrTransferRecipientNotOrgMember) || errors.Is(err, hooks.ErrTransferRecipientIsOwner) ||
+			errors.Is(err, hooks.ErrOwnershipTransferPending) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "ownership transfer")
+		}
+
+		r.logger.Errorw("failed to create ownership transfer", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if r.transferSender != nil {
+		if err := r.transferSender.SendOwnershipTransferEmail(ctx, t); err != nil {
+			r.logger.Errorw("failed to send ownership transfer email", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	return &OwnershipTransferCreatePayload{OwnershipTransfer: t}, nil
+}
+
+// TransferGroupOwnership is the resolver for the transferGroupOwnership field.
+func (r *mutationResolver) TransferGroupOwnership(ctx context.Context, groupID string, recipientID string) (*OwnershipTransferCreatePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	t, err := withTransactionalMutation(ctx).OwnershipTransfer.Create().
+		SetGroupID(groupID).
+		SetRecipientID(recipientID).
+		Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) || generated.IsConstraintError(err) || generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, hooks.ErrPersonalOrgsNotTransferable) || errors.Is(err, hooks.ErrTransferRequestorNotOwner) ||
+			errors.Is(err, hooks.ErrUserNotInGroupOrg) || errors.Is(err, hooks.ErrTransferRecipientIsOwner) ||
+			errors.Is(err, hooks.ErrOwnershipTransferPending) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionCreate, "ownership transfer")
+		}
+
+		r.logger.Errorw("failed to create ownership transfer", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if r.transferSender != nil {
+		if err := r.transferSender.SendOwnershipTransferEmail(ctx, t); err != nil {
+			r.logger.Errorw("failed to send ownership transfer email", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	return &OwnershipTransferCreatePayload{OwnershipTransfer: t}, nil
+}
+
+// CancelOwnershipTransfer is the resolver for the cancelOwnershipTransfer field.
+func (r *mutationResolver) CancelOwnershipTransfer(ctx context.Context, id string) (*OwnershipTransferCancelPayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	// only transfers that have not been accepted or declined can be canceled
+	t, err := withTransactionalMutation(ctx).OwnershipTransfer.UpdateOneID(id).
+		SetStatus(ownershiptransfer.StatusCanceled).
+		Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) || errors.Is(err, hooks.ErrOwnershipTransferNotPending) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, newPermissionDeniedError(ActionUpdate, "ownership transfer")
+		}
+
+		r.logger.Errorw("failed to cancel ownership transfer", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &OwnershipTransferCancelPayload{OwnershipTransfer: t}, nil
+}
+
+// OwnershipTransfer is the resolver for the ownershipTransfer field.
+func (r *queryResolver) OwnershipTransfer(ctx context.Context, id string) (*generated.OwnershipTransfer, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	t, err := r.client.OwnershipTransfer.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get ownership transfer", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return t, nil
+}
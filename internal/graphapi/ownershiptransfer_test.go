@@ -0,0 +1,325 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_TransferOrganizationOwnershipNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	owner := (&UserBuilder{}).MustNew(context.Background())
+	recipient := (&UserBuilder{}).MustNew(context.Background())
+	outsider := (&UserBuilder{}).MustNew(context.Background())
+
+	ownerEc, err := auth.NewTestContextWithValidUser(owner.ID)
+	require.NoError(t, err)
+
+	ownerCtx := context.WithValue(ownerEc.Request().Context(), echocontext.EchoContextKey, ownerEc)
+
+	ownerEc.SetRequest(ownerEc.Request().WithContext(ownerCtx))
+
+	recipientEc, err := auth.NewTestContextWithValidUser(recipient.ID)
+	require.NoError(t, err)
+
+	recipientCtx := context.WithValue(recipientEc.Request().Context(), echocontext.EchoContextKey, recipientEc)
+
+	recipientEc.SetRequest(recipientEc.Request().WithContext(recipientCtx))
+
+	// the user creating the organizations is their owner
+	org := (&OrganizationBuilder{}).MustNew(ownerCtx)
+	personalOrg := (&OrganizationBuilder{PersonalOrg: true}).MustNew(ownerCtx)
+
+	EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(recipient.ID).
+		ExecX(privacy.DecisionContext(ownerCtx, privacy.Allow))
+
+	testCases := []struct {
+		name        string
+		ctx         context.Context
+		orgID       string
+		recipientID string
+		errorMsg    string
+	}{
+		{
+			name:        "recipient not a member of the organization",
+			ctx:         ownerCtx,
+			orgID:       org.ID,
+			recipientID: outsider.ID,
+			errorMsg:    "recipient of the transfer is not a member of the organization",
+		},
+		{
+			name:        "requestor not an owner of the organization",
+			ctx:         recipientCtx,
+			orgID:       org.ID,
+			recipientID: owner.ID,
+			errorMsg:    "only owners of the organization, or admins of the group, can transfer it",
+		},
+		{
+			name:        "personal organizations are not transferable",
+			ctx:         ownerCtx,
+			orgID:       personalOrg.ID,
+			recipientID: recipient.ID,
+			errorMsg:    "personal organizations cannot be transferred",
+		},
+		{
+			name:        "happy path",
+			ctx:         ownerCtx,
+			orgID:       org.ID,
+			recipientID: recipient.ID,
+		},
+		{
+			name:        "organization with a pending transfer",
+			ctx:         ownerCtx,
+			orgID:       org.ID,
+			recipientID: recipient.ID,
+			errorMsg:    "organization or group already has a pending transfer",
+		},
+	}
+
+	var transferID string
+
+	for _, tc := range testCases {
+		t.Run("Transfer "+tc.name, func(t *testing.T) {
+			resp, err := client.TransferOrganizationOwnership(tc.ctx, tc.orgID, tc.recipientID)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			ot := resp.TransferOrganizationOwnership.OwnershipTransfer
+			assert.Equal(t, org.ID, ot.OwnerID)
+			assert.Empty(t, ot.GroupID)
+			assert.Equal(t, owner.ID, ot.RequestorID)
+			assert.Equal(t, recipient.ID, ot.RecipientID)
+			assert.Equal(t, ownershiptransfer.StatusPending, ot.Status)
+
+			transferID = ot.ID
+		})
+	}
+
+	resp, err := client.CancelOwnershipTransfer(ownerCtx, transferID)
+	require.NoError(t, err)
+	assert.Equal(t, ownershiptransfer.StatusCanceled, resp.CancelOwnershipTransfer.OwnershipTransfer.Status)
+
+	_, err = client.CancelOwnershipTransfer(ownerCtx, transferID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "transfer is no longer pending")
+
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(ownerCtx)
+	(&OrganizationCleanup{OrgID: personalOrg.ID}).MustDelete(ownerCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(ownerCtx)
+	(&UserCleanup{UserID: recipient.ID}).MustDelete(ownerCtx)
+	(&UserCleanup{UserID: outsider.ID}).MustDelete(ownerCtx)
+}
+
+func TestMutation_TransferGroupOwnershipNoAuth(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	admin := (&UserBuilder{}).MustNew(context.Background())
+	recipient := (&UserBuilder{}).MustNew(context.Background())
+	outsider := (&UserBuilder{}).MustNew(context.Background())
+
+	adminEc, err := auth.NewTestContextWithValidUser(admin.ID)
+	require.NoError(t, err)
+
+	adminCtx := context.WithValue(adminEc.Request().Context(), echocontext.EchoContextKey, adminEc)
+
+	adminEc.SetRequest(adminEc.Request().WithContext(adminCtx))
+
+	// the user creating the group is its admin
+	org := (&OrganizationBuilder{}).MustNew(adminCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(adminCtx)
+
+	EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(recipient.ID).
+		ExecX(privacy.DecisionContext(adminCtx, privacy.Allow))
+
+	// groups are only transferred to members of their organization
+	_, err = client.TransferGroupOwnership(adminCtx, group.ID, outsider.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "user is not a member of the organization of the group")
+
+	resp, err := client.TransferGroupOwnership(adminCtx, group.ID, recipient.ID)
+	require.NoError(t, err)
+
+	ot := resp.TransferGroupOwnership.OwnershipTransfer
+	assert.Equal(t, org.ID, ot.OwnerID)
+	require.NotNil(t, ot.GroupID)
+	assert.Equal(t, group.ID, *ot.GroupID)
+	assert.Equal(t, admin.ID, ot.RequestorID)
+	assert.Equal(t, recipient.ID, ot.RecipientID)
+	assert.Equal(t, ownershiptransfer.StatusPending, ot.Status)
+
+	// the pending transfer of the group does not block the transfer of the organization
+	orgResp, err := client.TransferOrganizationOwnership(adminCtx, org.ID, recipient.ID)
+	require.NoError(t, err)
+	assert.Empty(t, orgResp.TransferOrganizationOwnership.OwnershipTransfer.GroupID)
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(adminCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: admin.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: recipient.ID}).MustDelete(adminCtx)
+	(&UserCleanup{UserID: outsider.ID}).MustDelete(adminCtx)
+}
+
+func TestMutation_OwnershipTransferAuthz(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	owner := (&UserBuilder{}).MustNew(context.Background())
+	recipient := (&UserBuilder{}).MustNew(context.Background())
+
+	ownerEc, err := auth.NewTestContextWithValidUser(owner.ID)
+	require.NoError(t, err)
+
+	ownerCtx := context.WithValue(ownerEc.Request().Context(), echocontext.EchoContextKey, ownerEc)
+
+	ownerEc.SetRequest(ownerEc.Request().WithContext(ownerCtx))
+
+	recipientEc, err := auth.NewTestContextWithValidUser(recipient.ID)
+	require.NoError(t, err)
+
+	recipientCtx := context.WithValue(recipientEc.Request().Context(), echocontext.EchoContextKey, recipientEc)
+
+	recipientEc.SetRequest(recipientEc.Request().WithContext(recipientCtx))
+
+	allowCtx := privacy.DecisionContext(ownerCtx, privacy.Allow)
+
+	// the user creating the organization is its owner, and the user creating the group is its admin
+	org := (&OrganizationBuilder{}).MustNew(ownerCtx)
+	group := (&GroupBuilder{Owner: org.ID}).MustNew(ownerCtx)
+
+	EntClient.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(recipient.ID).ExecX(allowCtx)
+
+	tuple := func(userID, relation, object string) ofgaclient.ClientTupleKey {
+		return ofgaclient.ClientTupleKey{
+			User:     fmt.Sprintf("user:%s", userID),
+			Relation: relation,
+			Object:   object,
+		}
+	}
+
+	tupleKey := func(userID, relation, object string) openfga.TupleKeyWithoutCondition {
+		return openfga.TupleKeyWithoutCondition{
+			User:     fmt.Sprintf("user:%s", userID),
+			Relation: relation,
+			Object:   object,
+		}
+	}
+
+	// users without delete access cannot transfer the organization
+	mockCheckAny(mockCtrl, mc, recipientCtx, false)
+
+	_, err = client.TransferOrganizationOwnership(recipientCtx, org.ID, owner.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// owners transfer the organization
+	mockCheckAny(mockCtrl, mc, ownerCtx, true)
+
+	orgResp, err := client.TransferOrganizationOwnership(ownerCtx, org.ID, recipient.ID)
+	require.NoError(t, err)
+
+	// admins transfer the group
+	mockCheckAny(mockCtrl, mc, ownerCtx, true)
+
+	groupResp, err := client.TransferGroupOwnership(ownerCtx, group.ID, recipient.ID)
+	require.NoError(t, err)
+
+	// only the requestor cancels the transfer
+	_, err = client.CancelOwnershipTransfer(recipientCtx, orgResp.TransferOrganizationOwnership.OwnershipTransfer.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are not authorized to perform this action")
+
+	// the owner tuples are swapped in a single write when the recipient accepts the transfer
+	orgObject := fmt.Sprintf("organization:%s", org.ID)
+
+	mockWrite(mockCtrl, mc, recipientCtx, ofgaclient.ClientWriteRequest{
+		Writes: []ofgaclient.ClientTupleKey{
+			tuple(recipient.ID, "owner", orgObject),
+			tuple(owner.ID, "admin", orgObject),
+		},
+		Deletes: []openfga.TupleKeyWithoutCondition{
+			tupleKey(recipient.ID, "member", orgObject),
+			tupleKey(owner.ID, "owner", orgObject),
+		},
+	})
+
+	entClient.OwnershipTransfer.UpdateOneID(orgResp.TransferOrganizationOwnership.OwnershipTransfer.ID).
+		SetStatus(ownershiptransfer.StatusAccepted).
+		ExecX(privacy.DecisionContext(recipientCtx, privacy.Allow))
+
+	roles := map[string]orgmembership.Role{}
+	for _, om := range EntClient.OrgMembership.Query().Where(orgmembership.OrganizationID(org.ID)).AllX(allowCtx) {
+		roles[om.UserID] = om.Role
+	}
+
+	assert.Equal(t, orgmembership.RoleOwner, roles[recipient.ID])
+	assert.Equal(t, orgmembership.RoleAdmin, roles[owner.ID])
+
+	// the recipient joins the group as its admin, and the requestor is a member of the group
+	groupObject := fmt.Sprintf("group:%s", group.ID)
+
+	mockWrite(mockCtrl, mc, recipientCtx, ofgaclient.ClientWriteRequest{
+		Writes: []ofgaclient.ClientTupleKey{
+			tuple(recipient.ID, "admin", groupObject),
+			tuple(owner.ID, "member", groupObject),
+		},
+		Deletes: []openfga.TupleKeyWithoutCondition{
+			tupleKey(owner.ID, "admin", groupObject),
+		},
+	})
+
+	entClient.OwnershipTransfer.UpdateOneID(groupResp.TransferGroupOwnership.OwnershipTransfer.ID).
+		SetStatus(ownershiptransfer.StatusAccepted).
+		ExecX(privacy.DecisionContext(recipientCtx, privacy.Allow))
+
+	groupRoles := map[string]groupmembership.Role{}
+	for _, gm := range EntClient.GroupMembership.Query().Where(groupmembership.GroupID(group.ID)).AllX(allowCtx) {
+		groupRoles[gm.UserID] = gm.Role
+	}
+
+	assert.Equal(t, groupmembership.RoleAdmin, groupRoles[recipient.ID])
+	assert.Equal(t, groupmembership.RoleMember, groupRoles[owner.ID])
+
+	// accepted transfers are kept, and can no longer be canceled
+	_, err = client.CancelOwnershipTransfer(ownerCtx, orgResp.TransferOrganizationOwnership.OwnershipTransfer.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "transfer is no longer pending")
+
+	(&GroupCleanup{GroupID: group.ID}).MustDelete(ownerCtx)
+	(&OrganizationCleanup{OrgID: org.ID}).MustDelete(ownerCtx)
+	(&UserCleanup{UserID: owner.ID}).MustDelete(ownerCtx)
+	(&UserCleanup{UserID: recipient.ID}).MustDelete(ownerCtx)
+}
@@ -6,16 +6,34 @@ package graphapi
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 // CreatePersonalAccessToken is the resolver for the createPersonalAccessToken field.
 func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input generated.CreatePersonalAccessTokenInput) (*PersonalAccessTokenCreatePayload, error) {
-	pat, err := withTransactionalMutation(ctx).PersonalAccessToken.Create().SetInput(input).Save(ctx)
+	// the token is generated by the server and only the hash is stored, so it is only returned once
+	token, prefix := keygen.PersonalAccessToken()
+
+	hash, err := keygen.HashPersonalAccessToken(token)
+	if err != nil {
+		r.logger.Errorw("failed to hash personal access token", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	pat, err := withTransactionalMutation(ctx).PersonalAccessToken.Create().
+		SetInput(input).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hash).
+		Save(ctx)
 	if err != nil {
 		if generated.IsValidationError(err) {
 			return nil, err
@@ -25,16 +43,60 @@ func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input
 			return nil, err
 		}
 
+		if errors.Is(err, tokens.ErrInvalidScope) || errors.Is(err, tokens.ErrScopeNotGranted) {
+			return nil, err
+		}
+
 		r.logger.Errorw("failed to create personal access token", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	return &PersonalAccessTokenCreatePayload{PersonalAccessToken: pat}, err
+	return &PersonalAccessTokenCreatePayload{PersonalAccessToken: pat, Token: token}, err
 }
 
 // UpdatePersonalAccessToken is the resolver for the updatePersonalAccessToken field.
 func (r *mutationResolver) UpdatePersonalAccessToken(ctx context.Context, id string, input generated.UpdatePersonalAccessTokenInput) (*PersonalAccessTokenUpdatePayload, error) {
-	panic(fmt.Errorf("not implemented: CreateOrganizationSetting - createOrganizationSetting"))
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newPermissionDeniedError(ActionUpdate, "personal access token")
+	}
+
+	// tokens can only be updated by their owner and cannot be given to another user
+	if input.OwnerID != nil && *input.OwnerID != userID {
+		return nil, newPermissionDeniedError(ActionUpdate, "personal access token")
+	}
+
+	pat, err := withTransactionalMutation(ctx).PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.ID(id),
+			personalaccesstoken.HasOwnerWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get personal access token", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	// the abilities hook ensures a token restricted to scopes cannot widen the scopes of the token
+	pat, err = pat.Update().SetInput(input).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, tokens.ErrInvalidScope) || errors.Is(err, tokens.ErrScopeNotGranted) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to update personal access token", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &PersonalAccessTokenUpdatePayload{PersonalAccessToken: pat}, nil
 }
 
 // DeletePersonalAccessToken is the resolver for the deletePersonalAccessToken field.
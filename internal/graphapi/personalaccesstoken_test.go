@@ -0,0 +1,276 @@
+package graphapi_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/datumclient"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+func TestMutation_CreatePersonalAccessToken(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	owner := (&UserBuilder{}).MustNew(reqCtx)
+
+	resp, err := client.CreatePersonalAccessToken(reqCtx, datumclient.CreatePersonalAccessTokenInput{
+		Name:      "ci",
+		OwnerID:   owner.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	// the generated token is returned once and identified by its visible prefix
+	created := resp.CreatePersonalAccessToken
+	assert.True(t, strings.HasPrefix(created.Token, keygen.PersonalAccessTokenPrefix))
+	assert.True(t, strings.HasPrefix(created.Token, created.PersonalAccessToken.TokenPrefix))
+
+	// only the salted hash of the token is stored
+	pat := EntClient.PersonalAccessToken.GetX(entcache.Skip(privacy.DecisionContext(reqCtx, privacy.Allow)), created.PersonalAccessToken.ID)
+	assert.NotContains(t, pat.TokenHash, created.Token)
+	assert.True(t, keygen.VerifyPersonalAccessToken(created.Token, pat.TokenHash))
+
+	// the token cannot be retrieved again
+	got, err := client.GetPersonalAccessTokenByID(reqCtx, pat.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.PersonalAccessToken.TokenPrefix, got.PersonalAccessToken.TokenPrefix)
+
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+}
+
+func TestPersonalAccessTokenScopes(t *testing.T) {
+	client := graphTestClient(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(ctx))
+
+	user := (&UserBuilder{}).MustNew(ctx)
+
+	// scopedContext returns a request context authenticated as the user with a token restricted to the scopes
+	scopedContext := func(scopes ...string) context.Context {
+		userCtx, err := auth.NewTestContextWithScopes(user.ID, scopes...)
+		require.NoError(t, err)
+
+		reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+		userCtx.SetRequest(ec.Request().WithContext(reqCtx))
+
+		return reqCtx
+	}
+
+	firstName := gofakeit.FirstName()
+	update := datumclient.UpdateUserInput{FirstName: &firstName}
+
+	// a read only token can read but cannot perform any mutation
+	readOnly := scopedContext("user:read", "org:read")
+
+	got, err := client.GetUserByID(readOnly, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.User.ID)
+
+	_, err = client.UpdateUser(readOnly, user.ID, update)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "cannot perform mutations")
+
+	// a token without the user scope cannot read or update the user
+	groupOnly := scopedContext("group:write")
+
+	_, err = client.GetUserByID(groupOnly, user.ID)
+	require.Error(t, err)
+
+	_, err = client.UpdateUser(groupOnly, user.ID, update)
+	require.Error(t, err)
+
+	// write access allows updates, but deletes require admin access; the returned user includes its
+	// groups and organizations so those must be readable as well
+	userWrite := scopedContext("user:write", "org:read", "group:read")
+
+	updated, err := client.UpdateUser(userWrite, user.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, firstName, updated.UpdateUser.User.FirstName)
+
+	_, err = client.DeleteUser(userWrite, user.ID)
+	require.Error(t, err)
+
+	deleted, err := client.DeleteUser(scopedContext("user:admin", "org:admin", "group:read"), user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, deleted.DeleteUser.DeletedID)
+}
+
+func TestMutation_CreatePersonalAccessTokenInvalidAbilities(t *testing.T) {
+	client := graphTestClientNoAuth(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	reqCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(reqCtx))
+
+	owner := (&UserBuilder{}).MustNew(reqCtx)
+
+	_, err := client.CreatePersonalAccessToken(reqCtx, datumclient.CreatePersonalAccessTokenInput{
+		Name:      "ci",
+		OwnerID:   owner.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+		Abilities: []string{"org:read", "everything"},
+	})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "invalid scope")
+
+	(&UserCleanup{UserID: owner.ID}).MustDelete(reqCtx)
+}
+
+func TestMutation_PersonalAccessTokenScopedAbilities(t *testing.T) {
+	client := graphTestClient(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(ctx))
+
+	owner := (&UserBuilder{}).MustNew(ctx)
+	other := (&UserBuilder{}).MustNew(ctx)
+
+	// scopedContext returns a request context authenticated as the user with a token restricted to the scopes
+	scopedContext := func(userID string, scopes ...string) context.Context {
+		userCtx, err := auth.NewTestContextWithScopes(userID, scopes...)
+		require.NoError(t, err)
+
+		reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+		userCtx.SetRequest(ec.Request().WithContext(reqCtx))
+
+		return reqCtx
+	}
+
+	scoped := scopedContext(owner.ID, "user:write", "org:read")
+
+	testCases := []struct {
+		name      string
+		abilities []string
+		errMsg    string
+	}{
+		{
+			name:      "subset of the token scopes",
+			abilities: []string{"user:read", "org:read"},
+		},
+		{
+			name:      "without abilities",
+			abilities: nil,
+			errMsg:    "scope not granted",
+		},
+		{
+			name:      "scope beyond the token scopes",
+			abilities: []string{"user:read", "org:admin"},
+			errMsg:    "scope not granted",
+		},
+	}
+
+	var created string
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := client.CreatePersonalAccessToken(scoped, datumclient.CreatePersonalAccessTokenInput{
+				Name:      "ci",
+				OwnerID:   owner.ID,
+				ExpiresAt: time.Now().Add(time.Hour),
+				Abilities: tc.abilities,
+			})
+
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.abilities, resp.CreatePersonalAccessToken.PersonalAccessToken.Abilities)
+
+			created = resp.CreatePersonalAccessToken.PersonalAccessToken.ID
+		})
+	}
+
+	require.NotEmpty(t, created)
+
+	clear := true
+	name := "deploy"
+
+	updateCases := []struct {
+		name   string
+		ctx    context.Context
+		input  datumclient.UpdatePersonalAccessTokenInput
+		errMsg string
+	}{
+		{
+			name:  "happy path, within the token scopes",
+			ctx:   scoped,
+			input: datumclient.UpdatePersonalAccessTokenInput{Name: &name, Abilities: []string{"user:write"}},
+		},
+		{
+			name:   "scope beyond the token scopes",
+			ctx:    scoped,
+			input:  datumclient.UpdatePersonalAccessTokenInput{Abilities: []string{"user:admin"}},
+			errMsg: "scope not granted",
+		},
+		{
+			name:   "clear the abilities",
+			ctx:    scoped,
+			input:  datumclient.UpdatePersonalAccessTokenInput{ClearAbilities: &clear},
+			errMsg: "scope not granted",
+		},
+		{
+			name:   "token of another user",
+			ctx:    scopedContext(other.ID, "user:admin"),
+			input:  datumclient.UpdatePersonalAccessTokenInput{Name: &name},
+			errMsg: "not found",
+		},
+		{
+			name:   "give the token to another user",
+			ctx:    scoped,
+			input:  datumclient.UpdatePersonalAccessTokenInput{OwnerID: &other.ID},
+			errMsg: "not authorized",
+		},
+	}
+
+	for _, tc := range updateCases {
+		t.Run("Update "+tc.name, func(t *testing.T) {
+			resp, err := client.UpdatePersonalAccessToken(tc.ctx, created, tc.input)
+
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, name, resp.UpdatePersonalAccessToken.PersonalAccessToken.Name)
+			assert.Equal(t, []string{"user:write"}, resp.UpdatePersonalAccessToken.PersonalAccessToken.Abilities)
+		})
+	}
+
+	(&UserCleanup{UserID: owner.ID}).MustDelete(ctx)
+	(&UserCleanup{UserID: other.ID}).MustDelete(ctx)
+}
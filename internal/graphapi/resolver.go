@@ -1,6 +1,7 @@
 package graphapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -23,6 +24,9 @@ const (
 	ActionUpdate = "update"
 	ActionDelete = "delete"
 	ActionCreate = "create"
+
+	// tfaIssuer is the issuer displayed in authenticator apps
+	tfaIssuer = "Datum"
 )
 
 var (
@@ -34,9 +38,21 @@ var (
 
 // Resolver provides a graph response resolver
 type Resolver struct {
-	client       *ent.Client
-	logger       *zap.SugaredLogger
-	authDisabled bool
+	client         *ent.Client
+	logger         *zap.SugaredLogger
+	authDisabled   bool
+	inviteSender   InviteSender
+	transferSender TransferSender
+}
+
+// InviteSender sends the email of a new organization invitation to the recipient
+type InviteSender interface {
+	SendOrgInvitationEmail(ctx context.Context, i *ent.Invite) error
+}
+
+// TransferSender sends the email of a new ownership transfer to the recipient
+type TransferSender interface {
+	SendOwnershipTransferEmail(ctx context.Context, t *ent.OwnershipTransfer) error
 }
 
 // NewResolver returns a resolver configured with the given ent client
@@ -54,6 +70,22 @@ func (r Resolver) WithLogger(l *zap.SugaredLogger) *Resolver {
 	return &r
 }
 
+// WithInviteSender sets the sender of the invitation emails, invitations are created without being emailed
+// to the recipient when it is not set
+func (r Resolver) WithInviteSender(s InviteSender) *Resolver {
+	r.inviteSender = s
+
+	return &r
+}
+
+// WithTransferSender sets the sender of the ownership transfer emails, transfers are created without being
+// emailed to the recipient when it is not set
+func (r Resolver) WithTransferSender(s TransferSender) *Resolver {
+	r.transferSender = s
+
+	return &r
+}
+
 // Handler is an http handler wrapping a Resolver
 type Handler struct {
 	r              *Resolver
@@ -75,6 +107,9 @@ func (r *Resolver) Handler(withPlayground bool, middleware ...echo.MiddlewareFun
 	// add transactional db client
 	WithTransactions(srv, r.client)
 
+	// reject mutations from read only tokens
+	WithTokenScopes(srv)
+
 	srv.Use(extension.Introspection{})
 
 	h := &Handler{
@@ -101,6 +136,11 @@ func WithTransactions(h *handler.Server, c *ent.Client) {
 	h.Use(entgql.Transactioner{TxOpener: c})
 }
 
+// WithTokenScopes rejects mutations from requests authenticated with a token restricted to read scopes
+func WithTokenScopes(h *handler.Server) {
+	h.AroundOperations(requireMutationScope())
+}
+
 // Handler returns the http.HandlerFunc for the GraphAPI
 func (h *Handler) Handler() http.HandlerFunc {
 	return h.graphqlHandler.ServeHTTP
@@ -8,7 +8,9 @@ import (
 	"context"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 )
 
 // CreateSession is the resolver for the createSession field.
@@ -29,7 +31,12 @@ func (r *mutationResolver) CreateSession(ctx context.Context, input generated.Cr
 
 // UpdateSession is the resolver for the updateSession field.
 func (r *mutationResolver) UpdateSession(ctx context.Context, id string, input generated.UpdateSessionInput) (*SessionUpdatePayload, error) {
-	// TODO - add permissions checks
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
 
 	sess, err := withTransactionalMutation(ctx).Session.Get(ctx, id)
 	if err != nil {
@@ -56,7 +63,12 @@ func (r *mutationResolver) UpdateSession(ctx context.Context, id string, input g
 
 // DeleteSession is the resolver for the deleteSession field.
 func (r *mutationResolver) DeleteSession(ctx context.Context, id string) (*SessionDeletePayload, error) {
-	// TODO - add permissions checks
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
 
 	if err := withTransactionalMutation(ctx).Session.DeleteOneID(id).Exec(ctx); err != nil {
 		if generated.IsNotFound(err) {
@@ -72,7 +84,12 @@ func (r *mutationResolver) DeleteSession(ctx context.Context, id string) (*Sessi
 
 // Session is the resolver for the session field.
 func (r *queryResolver) Session(ctx context.Context, id string) (*generated.Session, error) {
-	// TODO - add permissions checks
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
 
 	sess, err := r.client.Session.Get(ctx, id)
 	if err != nil {
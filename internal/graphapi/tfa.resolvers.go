@@ -0,0 +1,138 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/otp"
+)
+
+// EnrollTfa is the resolver for the enrollTFA field.
+func (r *mutationResolver) EnrollTfa(ctx context.Context) (*TFAEnrollPayload, error) {
+	ctx, user, err := getAuthenticatedUser(ctx)
+	if err != nil {
+		if errors.Is(err, ErrPermissionDenied) || errors.Is(err, privacy.Deny) {
+			return nil, ErrPermissionDenied
+		}
+
+		r.logger.Errorw("failed to get user", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if user.Edges.Setting.IsTfaEnabled {
+		return nil, ErrTFAAlreadyEnabled
+	}
+
+	secret, err := otp.GenerateSecret()
+	if err != nil {
+		r.logger.Errorw("failed to generate tfa secret", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	// the secret is stored but not enabled until the enrollment is confirmed
+	if _, err := withTransactionalMutation(ctx).UserSetting.
+		UpdateOneID(user.Edges.Setting.ID).
+		SetTfaSecret(secret).
+		Save(ctx); err != nil {
+		r.logger.Errorw("failed to update user setting", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &TFAEnrollPayload{
+		Secret: secret,
+		QRURI:  otp.URI(secret, tfaIssuer, user.Email),
+	}, nil
+}
+
+// ConfirmTfa is the resolver for the confirmTFA field.
+func (r *mutationResolver) ConfirmTfa(ctx context.Context, code string) (*TFAConfirmPayload, error) {
+	ctx, user, err := getAuthenticatedUser(ctx)
+	if err != nil {
+		if errors.Is(err, ErrPermissionDenied) || errors.Is(err, privacy.Deny) {
+			return nil, ErrPermissionDenied
+		}
+
+		r.logger.Errorw("failed to get user", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	setting := user.Edges.Setting
+
+	if setting.IsTfaEnabled {
+		return nil, ErrTFAAlreadyEnabled
+	}
+
+	if setting.TfaSecret == nil {
+		return nil, ErrTFANotEnrolled
+	}
+
+	step, ok := otp.ValidateStep(code, *setting.TfaSecret, time.Now())
+	if !ok {
+		return nil, ErrInvalidTFACode
+	}
+
+	codes, hashes := otp.GenerateRecoveryCodes(otp.RecoveryCodeCount)
+
+	// the confirmation passcode cannot be replayed to complete a login
+	setting, err = withTransactionalMutation(ctx).UserSetting.
+		UpdateOneID(setting.ID).
+		SetIsTfaEnabled(true).
+		SetRecoveryCodes(hashes).
+		SetTfaLastStep(step).
+		Save(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to update user setting", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &TFAConfirmPayload{
+		UserSetting:   setting,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// DisableTfa is the resolver for the disableTFA field.
+func (r *mutationResolver) DisableTfa(ctx context.Context, code string) (*UserSettingUpdatePayload, error) {
+	ctx, user, err := getAuthenticatedUser(ctx)
+	if err != nil {
+		if errors.Is(err, ErrPermissionDenied) || errors.Is(err, privacy.Deny) {
+			return nil, ErrPermissionDenied
+		}
+
+		r.logger.Errorw("failed to get user", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	setting := user.Edges.Setting
+
+	if !setting.IsTfaEnabled || setting.TfaSecret == nil {
+		return nil, ErrTFANotEnrolled
+	}
+
+	// either a current passcode or an unused recovery code is required to disable
+	if !otp.Validate(code, *setting.TfaSecret, time.Now()) {
+		if _, ok := otp.ConsumeRecoveryCode(code, setting.RecoveryCodes); !ok {
+			return nil, ErrInvalidTFACode
+		}
+	}
+
+	setting, err = withTransactionalMutation(ctx).UserSetting.
+		UpdateOneID(setting.ID).
+		SetIsTfaEnabled(false).
+		ClearTfaSecret().
+		ClearRecoveryCodes().
+		ClearTfaLastStep().
+		Save(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to update user setting", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
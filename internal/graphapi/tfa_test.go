@@ -0,0 +1,90 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/graphapi"
+	auth "github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/otp"
+)
+
+func TestMutation_TFA(t *testing.T) {
+	// Setup Test Graph Client
+	client := graphTestClient(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(ctx))
+
+	user := (&UserBuilder{}).MustNew(ctx)
+
+	// setup valid user context
+	userCtx, err := auth.NewTestContextWithValidUser(user.ID)
+	if err != nil {
+		t.Fatal()
+	}
+
+	reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+	userCtx.SetRequest(ec.Request().WithContext(reqCtx))
+
+	// confirm before enrolling
+	_, err = client.ConfirmTfa(reqCtx, "123456")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrTFANotEnrolled.Error())
+
+	enroll, err := client.EnrollTfa(reqCtx)
+	require.NoError(t, err)
+	require.NotEmpty(t, enroll.EnrollTfa.Secret)
+	assert.Contains(t, enroll.EnrollTfa.QRURI, "otpauth://totp/Datum:"+user.Email)
+
+	secret := enroll.EnrollTfa.Secret
+
+	// invalid code
+	_, err = client.ConfirmTfa(reqCtx, "000000")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrInvalidTFACode.Error())
+
+	code, err := otp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	confirm, err := client.ConfirmTfa(reqCtx, code)
+	require.NoError(t, err)
+	assert.True(t, confirm.ConfirmTfa.UserSetting.IsTfaEnabled)
+	assert.Len(t, confirm.ConfirmTfa.RecoveryCodes, otp.RecoveryCodeCount)
+
+	// only hashes of the recovery codes are stored
+	setting := user.QuerySetting().OnlyX(privacy.DecisionContext(ctx, privacy.Allow))
+	assert.Equal(t, secret, *setting.TfaSecret)
+	assert.NotContains(t, setting.RecoveryCodes, confirm.ConfirmTfa.RecoveryCodes[0])
+
+	// cannot enroll again while enabled
+	_, err = client.EnrollTfa(reqCtx)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrTFAAlreadyEnabled.Error())
+
+	// disable requires a valid code
+	_, err = client.DisableTfa(reqCtx, "not-a-recovery-code")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrInvalidTFACode.Error())
+
+	// disable with a recovery code
+	disable, err := client.DisableTfa(reqCtx, confirm.ConfirmTfa.RecoveryCodes[0])
+	require.NoError(t, err)
+	assert.False(t, disable.DisableTfa.UserSetting.IsTfaEnabled)
+
+	setting = user.QuerySetting().OnlyX(privacy.DecisionContext(ctx, privacy.Allow))
+	assert.Nil(t, setting.TfaSecret)
+	assert.Empty(t, setting.RecoveryCodes)
+
+	(&UserCleanup{UserID: user.ID}).MustDelete(reqCtx)
+}
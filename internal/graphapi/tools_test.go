@@ -147,6 +147,7 @@ func graphTestClient(t *testing.T, c *ent.Client) datumclient.DatumClient {
 		))
 
 	graphapi.WithTransactions(srv, c)
+	graphapi.WithTokenScopes(srv)
 
 	g := &graphClient{
 		srvURL:     "query",
@@ -240,6 +241,24 @@ func mockWriteTuplesAny(mockCtrl *gomock.Controller, c *mock_client.MockSdkClien
 	c.EXPECT().WriteTuples(gomock.Any()).Return(mockBody)
 }
 
+// mockWrite creates mock responses based on the mock FGA client, expecting the tuples to be written and deleted
+// in a single request
+func mockWrite(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx context.Context, body ofgaclient.ClientWriteRequest) {
+	mockExecute := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	mockExecute.EXPECT().Execute().Return(&ofgaclient.ClientWriteResponse{}, nil)
+
+	mockRequest := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	mockRequest.EXPECT().Options(gomock.Any()).Return(mockExecute)
+
+	mockBody := mock_client.NewMockSdkClientWriteRequestInterface(mockCtrl)
+
+	mockBody.EXPECT().Body(body).Return(mockRequest)
+
+	c.EXPECT().Write(gomock.Any()).Return(mockBody)
+}
+
 func mockCheckAny(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx context.Context, allowed bool) {
 	mockExecute := mock_client.NewMockSdkClientCheckRequestInterface(mockCtrl)
 
@@ -286,3 +305,29 @@ func mockReadAny(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx
 
 	c.EXPECT().Read(gomock.Any()).Return(mockRequest)
 }
+
+// mockDeleteTuples creates mock responses based on the mock FGA client, expecting the tuples to be deleted
+func mockDeleteTuples(mockCtrl *gomock.Controller, c *mock_client.MockSdkClient, ctx context.Context, tuples []openfga.TupleKeyWithoutCondition) {
+	mockExecute := mock_client.NewMockSdkClientDeleteTuplesRequestInterface(mockCtrl)
+
+	expectedResponse := ofgaclient.ClientWriteResponse{
+		Deletes: []ofgaclient.ClientWriteRequestDeleteResponse{
+			{
+				TupleKey: tuples[0],
+				Status:   ofgaclient.SUCCESS,
+			},
+		},
+	}
+
+	mockExecute.EXPECT().Execute().Return(&expectedResponse, nil)
+
+	mockRequest := mock_client.NewMockSdkClientDeleteTuplesRequestInterface(mockCtrl)
+
+	mockRequest.EXPECT().Options(gomock.Any()).Return(mockExecute)
+
+	mockBody := mock_client.NewMockSdkClientDeleteTuplesRequestInterface(mockCtrl)
+
+	mockBody.EXPECT().Body(tuples).Return(mockRequest)
+
+	c.EXPECT().DeleteTuples(gomock.Any()).Return(mockBody)
+}
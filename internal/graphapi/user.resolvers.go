@@ -12,7 +12,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
-	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/passwd"
 )
 
 // CreateUser is the resolver for the createUser field.
@@ -33,7 +33,7 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input generated.Creat
 
 		// the password field is encrypted so we cannot use the
 		// built in validation function/validation error
-		if errors.Is(err, auth.ErrPasswordTooWeak) {
+		if passwd.IsPolicyViolation(err) {
 			return nil, err
 		}
 
@@ -81,7 +81,7 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input gene
 
 		// the password field is encrypted so we cannot use the
 		// built in validation function/validation error
-		if errors.Is(err, auth.ErrPasswordTooWeak) {
+		if passwd.IsPolicyViolation(err) {
 			return nil, err
 		}
 
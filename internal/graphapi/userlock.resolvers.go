@@ -0,0 +1,62 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+)
+
+// UnlockUser is the resolver for the unlockUser field.
+func (r *mutationResolver) UnlockUser(ctx context.Context, id string) (*UserSettingUpdatePayload, error) {
+	if !r.authDisabled {
+		if err := checkUnlockUserAccess(ctx, id); err != nil {
+			if errors.Is(err, ErrPermissionDenied) {
+				return nil, newPermissionDeniedError(ActionUpdate, "user")
+			}
+
+			r.logger.Errorw("failed to check unlock user access", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	// access to the user was checked above, the setting is updated without the privacy rules of the user
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	setting, err := withTransactionalMutation(ctx).User.Query().
+		Where(user.ID(id)).
+		QuerySetting().
+		Only(allowCtx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get user setting", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	setting, err = withTransactionalMutation(ctx).UserSetting.UpdateOneID(setting.ID).
+		SetLocked(false).
+		SetStatus(usersetting.StatusActive).
+		ClearSuspendedAt().
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		ClearUnlockToken().
+		ClearUnlockTokenSecret().
+		ClearUnlockTokenExpiresAt().
+		Save(allowCtx)
+	if err != nil {
+		r.logger.Errorw("failed to unlock user", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
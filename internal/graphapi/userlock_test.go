@@ -0,0 +1,152 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestMutation_UnlockUser(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	admin := (&UserBuilder{}).MustNew(ctx)
+
+	// setup valid user context
+	adminCtx, err := auth.NewTestContextWithValidUser(admin.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(adminCtx.Request().Context(), echocontext.EchoContextKey, adminCtx)
+
+	adminCtx.SetRequest(adminCtx.Request().WithContext(reqCtx))
+
+	orgID := "01HJ4NGWMYFH5V7YC2R9PS5J3A"
+
+	testCases := []struct {
+		name     string
+		self     bool
+		orgs     []string
+		list     bool
+		check    bool
+		allowed  bool
+		errorMsg string
+	}{
+		{
+			name:    "happy path, admin of an organization of the user",
+			orgs:    []string{fmt.Sprintf("organization:%s", orgID)},
+			list:    true,
+			check:   true,
+			allowed: true,
+		},
+		{
+			name:     "not an admin of the organization of the user",
+			orgs:     []string{fmt.Sprintf("organization:%s", orgID)},
+			list:     true,
+			check:    true,
+			allowed:  false,
+			errorMsg: "not authorized",
+		},
+		{
+			name:     "user is not a member of any organization",
+			orgs:     []string{},
+			list:     true,
+			errorMsg: "not authorized",
+		},
+		{
+			name:     "users cannot unlock themselves",
+			self:     true,
+			errorMsg: "not authorized",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Unlock "+tc.name, func(t *testing.T) {
+			target := (&UserBuilder{}).MustNew(ctx)
+			if tc.self {
+				target = admin
+			}
+
+			lockUser(ctx, target)
+
+			if tc.list {
+				mockListAny(mockCtrl, mc, reqCtx, tc.orgs)
+			}
+
+			if tc.check {
+				mockCheckAny(mockCtrl, mc, reqCtx, tc.allowed)
+			}
+
+			resp, err := client.UnlockUser(reqCtx, target.ID)
+
+			setting := target.QuerySetting().OnlyX(privacy.DecisionContext(ctx, privacy.Allow))
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				assert.True(t, setting.Locked)
+				assert.Equal(t, usersetting.StatusSuspended, setting.Status)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.False(t, resp.UnlockUser.UserSetting.Locked)
+			assert.Equal(t, usersetting.StatusActive, resp.UnlockUser.UserSetting.Status)
+			assert.Zero(t, resp.UnlockUser.UserSetting.FailedLoginAttempts)
+
+			assert.False(t, setting.Locked)
+			assert.Nil(t, setting.SuspendedAt)
+			assert.Nil(t, setting.UnlockToken)
+		})
+	}
+
+	// unlocking is not checked when auth is disabled
+	target := (&UserBuilder{}).MustNew(ctx)
+	lockUser(ctx, target)
+
+	resp, err := graphTestClientNoAuth(t, EntClient).UnlockUser(ctx, target.ID)
+	require.NoError(t, err)
+	assert.False(t, resp.UnlockUser.UserSetting.Locked)
+}
+
+// lockUser locks the account of the user as if it was locked after repeated failed logins
+func lockUser(ctx context.Context, u *generated.User) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	setting := u.QuerySetting().OnlyX(ctx)
+
+	EntClient.UserSetting.UpdateOneID(setting.ID).
+		SetLocked(true).
+		SetStatus(usersetting.StatusSuspended).
+		SetSuspendedAt(time.Now()).
+		SetFailedLoginAttempts(10). //nolint:gomnd
+		SetUnlockToken(fmt.Sprintf("token-%s", u.ID)).
+		ExecX(ctx)
+}
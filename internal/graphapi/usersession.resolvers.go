@@ -0,0 +1,77 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"time"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (*SessionUpdatePayload, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newPermissionDeniedError(ActionUpdate, "session")
+	}
+
+	// sessions can only be revoked by the user they belong to
+	ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromID(userID, true))
+
+	sess, err := withTransactionalMutation(ctx).Session.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get session", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if sess.RevokedAt != nil {
+		return &SessionUpdatePayload{Session: sess}, nil
+	}
+
+	sess, err = sess.Update().SetRevokedAt(time.Now()).Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to revoke session", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return &SessionUpdatePayload{Session: sess}, nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*generated.Session, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newPermissionDeniedError(ActionGet, "session")
+	}
+
+	ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromID(userID, true))
+
+	sessions, err := r.client.Session.Query().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(generated.Desc(session.FieldIssuedAt)).
+		All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to list sessions", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,171 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+	mock_client "github.com/datumforge/datum/internal/fga/mocks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// createSession creates a session of the user with a refresh token in the session token family
+func createSession(ctx context.Context, client *generated.Client, userID string, expiresAt time.Time) *generated.Session {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	familyID := ulids.New().String()
+
+	client.RefreshToken.Create().
+		SetJti(familyID).
+		SetFamilyID(familyID).
+		SetUserID(userID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		ExecX(allowCtx)
+
+	return client.Session.Create().
+		SetSessionToken(familyID).
+		SetUserID(userID).
+		SetUserAgent("datum-test").
+		SetIPAddress("192.0.2.1").
+		SetExpiresAt(expiresAt).
+		SaveX(allowCtx)
+}
+
+func TestQuery_MySessions(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	user := (&UserBuilder{}).MustNew(ctx)
+	other := (&UserBuilder{}).MustNew(ctx)
+
+	active := createSession(ctx, entClient, user.ID, time.Now().Add(time.Hour))
+	expired := createSession(ctx, entClient, user.ID, time.Now().Add(-time.Hour))
+	revoked := createSession(ctx, entClient, user.ID, time.Now().Add(time.Hour))
+	otherSession := createSession(ctx, entClient, other.ID, time.Now().Add(time.Hour))
+
+	entClient.Session.UpdateOneID(revoked.ID).
+		SetRevokedAt(time.Now()).
+		ExecX(privacy.DecisionContext(ctx, privacy.Allow))
+
+	// setup valid user context
+	userCtx, err := auth.NewTestContextWithValidUser(user.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+	userCtx.SetRequest(userCtx.Request().WithContext(reqCtx))
+
+	resp, err := client.MySessions(reqCtx)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	// only the active sessions of the user are listed
+	require.Len(t, resp.MySessions, 1)
+	assert.Equal(t, active.ID, resp.MySessions[0].ID)
+	assert.Equal(t, "datum-test", *resp.MySessions[0].UserAgent)
+	assert.Equal(t, "192.0.2.1", *resp.MySessions[0].IPAddress)
+
+	for _, s := range resp.MySessions {
+		assert.NotEqual(t, expired.ID, s.ID)
+		assert.NotEqual(t, revoked.ID, s.ID)
+		assert.NotEqual(t, otherSession.ID, s.ID)
+	}
+}
+
+func TestMutation_RevokeSession(t *testing.T) {
+	// setup mock controller
+	mockCtrl := gomock.NewController(t)
+
+	mc := mock_client.NewMockSdkClient(mockCtrl)
+
+	// setup entdb with authz
+	entClient := setupAuthEntDB(t, mockCtrl, mc)
+	defer entClient.Close()
+
+	client := graphTestClient(t, entClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	user := (&UserBuilder{}).MustNew(ctx)
+	other := (&UserBuilder{}).MustNew(ctx)
+
+	// setup valid user context
+	userCtx, err := auth.NewTestContextWithValidUser(user.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+	userCtx.SetRequest(userCtx.Request().WithContext(reqCtx))
+
+	testCases := []struct {
+		name     string
+		owner    string
+		errorMsg string
+	}{
+		{
+			name:  "happy path, own session",
+			owner: user.ID,
+		},
+		{
+			name:     "session of another user",
+			owner:    other.ID,
+			errorMsg: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Revoke "+tc.name, func(t *testing.T) {
+			sess := createSession(ctx, entClient, tc.owner, time.Now().Add(time.Hour))
+
+			resp, err := client.RevokeSession(reqCtx, sess.ID)
+
+			allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+			tokenRevoked := entClient.RevokedToken.Query().
+				Where(revokedtoken.Jti(sess.SessionToken)).
+				ExistX(allowCtx)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				assert.Nil(t, entClient.Session.GetX(allowCtx, sess.ID).RevokedAt)
+				assert.False(t, tokenRevoked)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, sess.ID, resp.RevokeSession.Session.ID)
+			assert.NotNil(t, resp.RevokeSession.Session.RevokedAt)
+
+			// the tokens issued for the session are revoked with it
+			assert.True(t, tokenRevoked)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+)
+
+// DeleteWebauthnCredential is the resolver for the deleteWebauthnCredential field.
+func (r *mutationResolver) DeleteWebauthnCredential(ctx context.Context, id string) (*WebauthnCredentialDeletePayload, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	if err := withTransactionalMutation(ctx).WebauthnCredential.DeleteOneID(id).Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, ErrPermissionDenied
+		}
+
+		r.logger.Errorw("failed to delete webauthn credential", "error", err)
+		return nil, err
+	}
+
+	return &WebauthnCredentialDeletePayload{DeletedID: id}, nil
+}
+
+// WebauthnCredential is the resolver for the webauthnCredential field.
+func (r *queryResolver) WebauthnCredential(ctx context.Context, id string) (*generated.WebauthnCredential, error) {
+	if r.authDisabled {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	} else {
+		// setup view context
+		ctx = viewer.NewContext(ctx, viewer.NewUserViewerFromSubject(ctx))
+	}
+
+	cred, err := r.client.WebauthnCredential.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if errors.Is(err, privacy.Deny) {
+			return nil, ErrPermissionDenied
+		}
+
+		r.logger.Errorw("failed to get webauthn credential", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return cred, nil
+}
@@ -0,0 +1,84 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	auth "github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestQueryAndDelete_WebauthnCredential(t *testing.T) {
+	// Setup Test Graph Client
+	client := graphTestClient(t, EntClient)
+
+	ec := echocontext.NewTestEchoContext()
+
+	ctx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	ec.SetRequest(ec.Request().WithContext(ctx))
+
+	owner := (&UserBuilder{}).MustNew(ctx)
+	other := (&UserBuilder{}).MustNew(ctx)
+
+	// passkeys are only created by the webauthn registration endpoints
+	cred := EntClient.WebauthnCredential.Create().
+		SetOwnerID(owner.ID).
+		SetName("yubikey").
+		SetCredentialID([]byte("credential-id")).
+		SetPublicKey([]byte("public-key")).
+		SetAttestationType("none").
+		SaveX(privacy.DecisionContext(ctx, privacy.Allow))
+
+	userContext := func(id string) context.Context {
+		userCtx, err := auth.NewTestContextWithValidUser(id)
+		require.NoError(t, err)
+
+		reqCtx := context.WithValue(userCtx.Request().Context(), echocontext.EchoContextKey, userCtx)
+
+		userCtx.SetRequest(ec.Request().WithContext(reqCtx))
+
+		return reqCtx
+	}
+
+	ownerCtx := userContext(owner.ID)
+	otherCtx := userContext(other.ID)
+
+	// other users cannot see the passkey
+	list, err := client.GetWebauthnCredentials(otherCtx)
+	require.NoError(t, err)
+	assert.Empty(t, list.WebauthnCredentials.Edges)
+
+	_, err = client.GetWebauthnCredentialByID(otherCtx, cred.ID)
+	require.Error(t, err)
+
+	// other users cannot revoke the passkey
+	_, err = client.DeleteWebauthnCredential(otherCtx, cred.ID)
+	require.Error(t, err)
+
+	list, err = client.GetWebauthnCredentials(ownerCtx)
+	require.NoError(t, err)
+	require.Len(t, list.WebauthnCredentials.Edges, 1)
+	assert.Equal(t, cred.ID, list.WebauthnCredentials.Edges[0].Node.ID)
+	assert.Equal(t, "yubikey", *list.WebauthnCredentials.Edges[0].Node.Name)
+	assert.Equal(t, owner.ID, list.WebauthnCredentials.Edges[0].Node.Owner.ID)
+
+	got, err := client.GetWebauthnCredentialByID(ownerCtx, cred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "none", *got.WebauthnCredential.AttestationType)
+
+	deleted, err := client.DeleteWebauthnCredential(ownerCtx, cred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, cred.ID, deleted.DeleteWebauthnCredential.DeletedID)
+
+	// the revoked passkey is no longer returned
+	_, err = client.GetWebauthnCredentialByID(ownerCtx, cred.ID)
+	require.Error(t, err)
+
+	(&UserCleanup{UserID: owner.ID}).MustDelete(ctx)
+	(&UserCleanup{UserID: other.ID}).MustDelete(ctx)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/datumforge/datum/internal/entdb"
 	"github.com/datumforge/datum/internal/fga"
 	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/lockout"
+	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/tokens"
 )
 
@@ -92,6 +94,40 @@ type (
 		Enabled bool `yaml:"enabled" split_words:"true" default:"true"`
 		// A list of auth providers. Currently enables only the first provider in the list.
 		Providers []AuthProvider `yaml:"providers"`
+		// Webauthn contains the relying party settings for passkey registration and login
+		Webauthn Webauthn `yaml:"webauthn"`
+		// SSO contains the service provider settings for SAML single sign-on per organization
+		SSO SSO `yaml:"sso"`
+		// Lockout contains the thresholds used to throttle failed logins and lock accounts
+		Lockout lockout.Config `yaml:"lockout"`
+		// Password contains the password policy enforced when passwords are set
+		Password passwd.PolicyConfig `yaml:"password"`
+		// RefreshTokenCutover is when the deployment started recording refresh tokens, refresh tokens issued before
+		// it can be exchanged once so users are not logged out by the upgrade; RFC 3339 formatted
+		RefreshTokenCutover time.Time `yaml:"refreshTokenCutover" split_words:"true"`
+	}
+
+	// SSO settings for SAML single sign-on, the identity provider of each organization is configured
+	// with the sso settings of the organization
+	SSO struct {
+		// Enabled turns on the SAML metadata, login and assertion consumer endpoints
+		Enabled bool `yaml:"enabled" split_words:"true" default:"true"`
+		// BaseURL is the externally reachable url of the server the identity provider redirects users to
+		BaseURL string `yaml:"baseUrl" split_words:"true" default:"http://localhost:17608"`
+	}
+
+	// Webauthn settings for passkey registration and login
+	Webauthn struct {
+		// Enabled turns on the webauthn registration and login endpoints
+		Enabled bool `yaml:"enabled" split_words:"true" default:"true"`
+		// RelyingPartyID is the domain the passkeys are scoped to, this should be the origin without a scheme and port
+		RelyingPartyID string `yaml:"relyingPartyId" split_words:"true" default:"localhost"`
+		// DisplayName of the relying party shown by the authenticator
+		DisplayName string `yaml:"displayName" split_words:"true" default:"Datum"`
+		// RequestOrigins are the fully qualified origins permitted to perform the webauthn ceremonies
+		RequestOrigins []string `yaml:"requestOrigins" split_words:"true" default:"http://localhost:17608"`
+		// Timeout is how long the user has to complete a registration or login ceremony
+		Timeout time.Duration `yaml:"timeout" split_words:"true" default:"60s"`
 	}
 
 	// CORS settings
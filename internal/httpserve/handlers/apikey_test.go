@@ -0,0 +1,140 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+func TestValidateAPIKey(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the org without
+	// authentication in the tests
+	ec = privacy.DecisionContext(ec, privacy.Allow)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ec)
+
+	// createKey stores the hash of a new api key and returns the key with the stored row
+	createKey := func(name string, expiresAt *time.Time) (string, *generated.APIKey) {
+		key, prefix := keygen.APIKey()
+
+		hash, err := keygen.HashAPIKey(key)
+		require.NoError(t, err)
+
+		k := EntClient.APIKey.Create().
+			SetName(name).
+			SetOwner(org).
+			SetKeyPrefix(prefix).
+			SetKeyHash(hash).
+			SetAbilities([]string{"org:read", "group:write"}).
+			SetNillableExpiresAt(expiresAt).
+			SaveX(ec)
+
+		return key, k
+	}
+
+	expired := time.Now().Add(-time.Hour)
+
+	key, apiKey := createKey("ci", nil)
+	expiredKey, _ := createKey("expired", &expired)
+
+	// a key with a known prefix but the wrong secret
+	forgedKey := apiKey.KeyPrefix + "_" + keygen.Secret()
+
+	// a personal access token cannot be used as an api key
+	pat, _ := keygen.PersonalAccessToken()
+
+	e := setupEcho(h.SM)
+	e.GET("whoami", func(c echo.Context) error {
+		claims, err := auth.GetClaims(c)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, claims)
+	}, auth.Authenticate(auth.WithValidator(h.TM), auth.WithPersonalAccessTokenValidator(h), auth.WithAPIKeyValidator(h)))
+
+	testCases := []struct {
+		name           string
+		key            string
+		expectedStatus int
+	}{
+		{
+			name:           "api key",
+			key:            key,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expired api key",
+			key:            expiredKey,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "forged api key",
+			key:            forgedKey,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "personal access token is not an api key",
+			key:            pat,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var claims tokens.Claims
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&claims))
+
+				// the request is authenticated as the service account of the organization
+				assert.Equal(t, apiKey.ID, claims.Subject)
+				assert.Equal(t, org.ID, claims.OrgID)
+				assert.True(t, claims.IsService())
+				assert.Equal(t, []string{"org:read", "group:write"}, claims.Scopes)
+			}
+		})
+	}
+
+	// the last used time is recorded when the api key is used
+	used := EntClient.APIKey.GetX(entcache.Skip(ec), apiKey.ID)
+	require.NotNil(t, used.LastUsedAt)
+	assert.WithinDuration(t, time.Now(), *used.LastUsedAt, time.Minute)
+
+	// the api keys of a deleted organization can no longer be used
+	EntClient.Organization.DeleteOneID(org.ID).ExecX(ec)
+
+	_, err := h.ValidateAPIKey(ec, key)
+	require.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+}
@@ -143,12 +143,130 @@ func (h *Handler) SendPasswordResetSuccessEmail(user *User) error {
 	return h.emailManager.Send(msg)
 }
 
+// SendAccountLockedEmail Send an email to a user to inform them their account was locked with a link to unlock it
+func (h *Handler) SendAccountLockedEmail(user *User) error {
+	data := emails.UnlockRequestData{
+		EmailData: emails.EmailData{
+			Sender: h.SendGridConfig.MustFromContact(),
+			Recipient: sendgrid.Contact{
+				Email:     user.Email,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+			},
+		},
+	}
+	data.Recipient.ParseName(user.Name)
+
+	var err error
+	if data.UnlockURL, err = h.EmailURL.UnlockURL(user.GetUnlockToken()); err != nil {
+		return err
+	}
+
+	msg, err := emails.AccountLockedEmail(data)
+	if err != nil {
+		return err
+	}
+
+	// Send the email
+	return h.emailManager.Send(msg)
+}
+
+// SendMagicLinkEmail Send an email to a user with a link to log in without a password
+func (h *Handler) SendMagicLinkEmail(user *User) error {
+	data := emails.MagicLinkData{
+		EmailData: emails.EmailData{
+			Sender: h.SendGridConfig.MustFromContact(),
+			Recipient: sendgrid.Contact{
+				Email:     user.Email,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+			},
+		},
+	}
+	data.Recipient.ParseName(user.Name)
+
+	var err error
+	if data.MagicLinkURL, err = h.EmailURL.MagicLinkURL(user.GetMagicLinkToken()); err != nil {
+		return err
+	}
+
+	msg, err := emails.MagicLinkEmail(data)
+	if err != nil {
+		return err
+	}
+
+	// Send the email
+	return h.emailManager.Send(msg)
+}
+
+// SendInviteEmail Send an email to the recipient of an invitation with a link to join the organization
+func (h *Handler) SendInviteEmail(i *Invite) error {
+	data := emails.InviteData{
+		EmailData: emails.EmailData{
+			Sender: h.SendGridConfig.MustFromContact(),
+			Recipient: sendgrid.Contact{
+				Email: i.Recipient,
+			},
+		},
+		Email:       i.Recipient,
+		InviterName: i.InviterName,
+		OrgName:     i.OrgName,
+		Role:        i.Role,
+	}
+
+	var err error
+	if data.InviteURL, err = h.EmailURL.InviteURL(i.Token); err != nil {
+		return err
+	}
+
+	msg, err := emails.InviteEmail(data)
+	if err != nil {
+		return err
+	}
+
+	// Send the email
+	return h.emailManager.Send(msg)
+}
+
+// SendTransferEmail Send an email to the recipient of an ownership transfer with a link to accept the transfer
+func (h *Handler) SendTransferEmail(t *Transfer) error {
+	data := emails.OwnershipTransferData{
+		EmailData: emails.EmailData{
+			Sender: h.SendGridConfig.MustFromContact(),
+			Recipient: sendgrid.Contact{
+				Email:     t.Recipient,
+				FirstName: t.RecipientFirstName,
+				LastName:  t.RecipientLastName,
+			},
+		},
+		RequestorName: t.RequestorName,
+		ResourceType:  t.ResourceType,
+		ResourceName:  t.ResourceName,
+	}
+
+	var err error
+	if data.TransferURL, err = h.EmailURL.TransferURL(t.Token); err != nil {
+		return err
+	}
+
+	msg, err := emails.OwnershipTransferEmail(data)
+	if err != nil {
+		return err
+	}
+
+	// Send the email
+	return h.emailManager.Send(msg)
+}
+
 // URLConfig for the datum registration
 type URLConfig struct {
-	Base   string `split_words:"true" default:"https://app.datum.net"`
-	Verify string `split_words:"true" default:"/v1/verify"`
-	Invite string `split_words:"true" default:"/v1/invite"`
-	Reset  string `split_words:"true" default:"/v1/reset-password"`
+	Base      string `split_words:"true" default:"https://app.datum.net"`
+	Verify    string `split_words:"true" default:"/v1/verify"`
+	Invite    string `split_words:"true" default:"/v1/invite"`
+	Reset     string `split_words:"true" default:"/v1/reset-password"`
+	Unlock    string `split_words:"true" default:"/v1/unlock"`
+	MagicLink string `split_words:"true" default:"/v1/login/magic"`
+	Transfer  string `split_words:"true" default:"/v1/transfer"`
 }
 
 func (c URLConfig) Validate() error {
@@ -168,6 +286,18 @@ func (c URLConfig) Validate() error {
 		return newInvalidEmailConfigError("reset path")
 	}
 
+	if c.Unlock == "" {
+		return newInvalidEmailConfigError("unlock path")
+	}
+
+	if c.MagicLink == "" {
+		return newInvalidEmailConfigError("magic link path")
+	}
+
+	if c.Transfer == "" {
+		return newInvalidEmailConfigError("transfer path")
+	}
+
 	return nil
 }
 
@@ -208,6 +338,45 @@ func (c URLConfig) ResetURL(token string) (string, error) {
 	return url.String(), nil
 }
 
+// UnlockURL constructs an unlock URL from the token.
+func (c URLConfig) UnlockURL(token string) (string, error) {
+	if token == "" {
+		return "", newMissingRequiredFieldError("token")
+	}
+
+	base, _ := url.Parse(c.Base)
+
+	url := base.ResolveReference(&url.URL{Path: c.Unlock, RawQuery: url.Values{"token": []string{token}}.Encode()})
+
+	return url.String(), nil
+}
+
+// MagicLinkURL constructs a magic link login URL from the token.
+func (c URLConfig) MagicLinkURL(token string) (string, error) {
+	if token == "" {
+		return "", newMissingRequiredFieldError("token")
+	}
+
+	base, _ := url.Parse(c.Base)
+
+	url := base.ResolveReference(&url.URL{Path: c.MagicLink, RawQuery: url.Values{"token": []string{token}}.Encode()})
+
+	return url.String(), nil
+}
+
+// TransferURL constructs an ownership transfer URL from the token.
+func (c URLConfig) TransferURL(token string) (string, error) {
+	if token == "" {
+		return "", newMissingRequiredFieldError("token")
+	}
+
+	base, _ := url.Parse(c.Base)
+
+	url := base.ResolveReference(&url.URL{Path: c.Transfer, RawQuery: url.Values{"token": []string{token}}.Encode()})
+
+	return url.String(), nil
+}
+
 // createSendGridContact creates a contact in sendgrid to be used later
 // TODO: this current returns a 403 error, come back and figure out why
 func (h *Handler) createSendGridContact(contact *sendgrid.Contact) error { //nolint:unused
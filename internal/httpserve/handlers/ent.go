@@ -2,14 +2,37 @@ package handlers
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"time"
 
+	"ariga.io/entcache"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/go-webauthn/webauthn/webauthn"
+
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/emailverificationtoken"
+	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/magiclinktoken"
+	"github.com/datumforge/datum/internal/ent/generated/oauthauthorizationcode"
+	"github.com/datumforge/datum/internal/ent/generated/oauthclient"
+	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+	"github.com/datumforge/datum/internal/ent/generated/session"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/httpserve/middleware/transaction"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
 func (h *Handler) updateUserLastSeen(ctx context.Context, id string) error {
@@ -85,6 +108,29 @@ func (h *Handler) createPasswordResetToken(ctx context.Context, user *User) (*en
 	return meowtoken, nil
 }
 
+func (h *Handler) createMagicLinkToken(ctx context.Context, user *User) (*ent.MagicLinkToken, error) {
+	ttl, err := time.Parse(time.RFC3339Nano, user.MagicLinkExpires.String)
+	if err != nil {
+		h.Logger.Errorw("unable to parse ttl", "error", err)
+		return nil, err
+	}
+
+	meowtoken, err := transaction.FromContext(ctx).MagicLinkToken.Create().
+		SetOwnerID(user.ID).
+		SetToken(user.MagicLinkToken.String).
+		SetTTL(ttl).
+		SetEmail(user.Email).
+		SetSecret(user.MagicLinkSecret).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error creating magic link token", "error", err)
+
+		return nil, err
+	}
+
+	return meowtoken, nil
+}
+
 // getUserByEVToken returns the ent user with the user settings and email verification token fields based on the
 // token in the request
 func (h *Handler) getUserByEVToken(ctx context.Context, token string) (*ent.User, error) {
@@ -119,11 +165,32 @@ func (h *Handler) getUserByResetToken(ctx context.Context, token string) (*ent.U
 	return user, nil
 }
 
-// getUserByEmail returns the ent user with the user settings based on the email in the request
+// getMagicLinkToken returns the magic link token with its owner and the owner's user settings based on the
+// token in the request, the query cache is skipped so a used token is always seen as expired
+func (h *Handler) getMagicLinkToken(ctx context.Context, token string) (*ent.MagicLinkToken, error) {
+	mt, err := transaction.FromContext(ctx).MagicLinkToken.Query().
+		Where(
+			magiclinktoken.Token(token),
+		).
+		WithOwner(func(q *ent.UserQuery) {
+			q.WithSetting()
+		}).
+		Only(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error obtaining magic link token", "error", err)
+
+		return nil, err
+	}
+
+	return mt, nil
+}
+
+// getUserByEmail returns the ent user with the user settings based on the email in the request, the query
+// cache is skipped so a locked account and the failed logins of the user are always seen
 func (h *Handler) getUserByEmail(ctx context.Context, email string) (*ent.User, error) {
 	user, err := transaction.FromContext(ctx).User.Query().WithSetting().
 		Where(user.Email(email)).
-		Only(ctx)
+		Only(entcache.Skip(ctx))
 	if err != nil {
 		h.Logger.Errorw("error obtaining user from email", "error", err)
 
@@ -133,6 +200,77 @@ func (h *Handler) getUserByEmail(ctx context.Context, email string) (*ent.User,
 	return user, nil
 }
 
+// getUserWithWebauthnCredentials returns the ent user with the user settings and registered passkeys based on the user id
+func (h *Handler) getUserWithWebauthnCredentials(ctx context.Context, id string) (*ent.User, error) {
+	user, err := transaction.FromContext(ctx).User.Query().WithSetting().WithWebauthnCredentials().Where(
+		user.ID(id),
+	).Only(ctx)
+	if err != nil {
+		h.Logger.Errorw("error retrieving user", "error", err)
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (h *Handler) createWebauthnCredential(ctx context.Context, userID, name string, cred *webauthn.Credential) (*ent.WebauthnCredential, error) {
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+
+	meowcred, err := transaction.FromContext(ctx).WebauthnCredential.Create().
+		SetOwnerID(userID).
+		SetName(name).
+		SetCredentialID(cred.ID).
+		SetPublicKey(cred.PublicKey).
+		SetAttestationType(cred.AttestationType).
+		SetAaguid(hex.EncodeToString(cred.Authenticator.AAGUID)).
+		SetSignCount(int64(cred.Authenticator.SignCount)).
+		SetTransports(transports).
+		SetBackupEligible(cred.Flags.BackupEligible).
+		SetBackupState(cred.Flags.BackupState).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error creating webauthn credential", "error", err)
+
+		return nil, err
+	}
+
+	return meowcred, nil
+}
+
+// updateWebauthnCredentialUse stores the updated sign count and backup state of the passkey after a login
+func (h *Handler) updateWebauthnCredentialUse(ctx context.Context, cred *webauthn.Credential) error {
+	if err := transaction.FromContext(ctx).WebauthnCredential.Update().
+		Where(webauthncredential.CredentialID(cred.ID)).
+		SetSignCount(int64(cred.Authenticator.SignCount)).
+		SetBackupState(cred.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error updating webauthn credential", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getUserByID returns the ent user with the user settings based on the user id
+func (h *Handler) getUserByID(ctx context.Context, id string) (*ent.User, error) {
+	user, err := transaction.FromContext(ctx).User.Query().WithSetting().Where(
+		user.ID(id),
+	).Only(ctx)
+	if err != nil {
+		h.Logger.Errorw("error retrieving user", "error", err)
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
 // getUserBySub returns the ent user with the user settings based on the subject in the claim
 func (h *Handler) getUserBySub(ctx context.Context, subject string) (*ent.User, error) {
 	// check user in the database, sub == claims subject and ensure only one record is returned
@@ -196,6 +334,51 @@ func (h *Handler) expireAllResetTokensUserByEmail(ctx context.Context, email str
 	return nil
 }
 
+// expireAllMagicLinkTokensUserByEmail expires all existing magic link tokens before issuing a new one
+func (h *Handler) expireAllMagicLinkTokensUserByEmail(ctx context.Context, email string) error {
+	mts, err := transaction.FromContext(ctx).MagicLinkToken.Query().WithOwner().Where(
+		magiclinktoken.And(
+			magiclinktoken.Email(email),
+			magiclinktoken.TTLGT(time.Now()),
+		)).All(ctx)
+	if err != nil {
+		h.Logger.Errorw("error obtaining magic link tokens", "error", err)
+
+		return err
+	}
+
+	for _, mt := range mts {
+		if err := mt.Update().SetTTL(time.Now()).Exec(ctx); err != nil {
+			h.Logger.Errorw("error expiring magic link token", "error", err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consumeMagicLinkToken expires the magic link token so it can only be used once, false is returned when
+// the token was already used or expired, e.g. by a concurrent request
+func (h *Handler) consumeMagicLinkToken(ctx context.Context, mt *ent.MagicLinkToken) (bool, error) {
+	now := time.Now()
+
+	n, err := transaction.FromContext(ctx).MagicLinkToken.Update().
+		Where(
+			magiclinktoken.ID(mt.ID),
+			magiclinktoken.TTLGT(now),
+		).
+		SetTTL(now).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error consuming magic link token", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
 // setEmailConfirmed sets the user setting field email_confirmed to true within a transaction
 func (h *Handler) setEmailConfirmed(ctx context.Context, user *ent.User) error {
 	if _, err := transaction.FromContext(ctx).UserSetting.Update().SetEmailConfirmed(true).
@@ -220,3 +403,793 @@ func (h *Handler) updateUserPassword(ctx context.Context, id string, password st
 
 	return nil
 }
+
+// revokeToken adds the jti of the token pair to the revocation list, revoking an already revoked
+// token is a no-op
+func (h *Handler) revokeToken(ctx context.Context, claims *tokens.Claims, reason string) error {
+	return h.revokeJTI(ctx, claims.ID, claims.Subject, reason, h.TM.PairExpiration(claims))
+}
+
+// revokeJTI adds the jti to the revocation list until the token pair expires
+func (h *Handler) revokeJTI(ctx context.Context, jti, userID, reason string, expiresAt time.Time) error {
+	db := transaction.FromContext(ctx)
+
+	exists, err := db.RevokedToken.Query().Where(revokedtoken.Jti(jti)).Exist(ctx)
+	if err != nil {
+		h.Logger.Errorw("error checking revoked token", "error", err)
+
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	if err := db.RevokedToken.Create().
+		SetJti(jti).
+		SetUserID(userID).
+		SetReason(reason).
+		SetExpiresAt(expiresAt).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error revoking token", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// consumeJTI adds the jti of a single use token to the revocation list, ErrTokenRevoked is returned when the
+// token was already used; a concurrent use fails on the unique jti
+func (h *Handler) consumeJTI(ctx context.Context, jti, userID, reason string, expiresAt time.Time) error {
+	db := transaction.FromContext(ctx)
+
+	used, err := db.RevokedToken.Query().Where(revokedtoken.Jti(jti)).Exist(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error checking revoked token", "error", err)
+
+		return err
+	}
+
+	if used {
+		return ErrTokenRevoked
+	}
+
+	if err := db.RevokedToken.Create().
+		SetJti(jti).
+		SetUserID(userID).
+		SetReason(reason).
+		SetExpiresAt(expiresAt).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error consuming token", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// IsRevoked checks the revocation list for the jti of the token pair, the query cache is skipped so
+// a revocation is enforced as soon as it is committed
+func (h *Handler) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	revoked, err := h.DBClient.RevokedToken.Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error checking revoked token", "error", err)
+
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// createRefreshToken records the refresh token issued for the claims, the refresh token joins the
+// family of its parent or starts a new family when it was issued on login
+func (h *Handler) createRefreshToken(ctx context.Context, claims *tokens.Claims, parent *ent.RefreshToken) error {
+	familyID := claims.ID
+	parentJTI := ""
+
+	if parent != nil {
+		familyID = parent.FamilyID
+		parentJTI = parent.Jti
+	}
+
+	if err := transaction.FromContext(ctx).RefreshToken.Create().
+		SetJti(claims.ID).
+		SetFamilyID(familyID).
+		SetParentJti(parentJTI).
+		SetUserID(claims.Subject).
+		SetExpiresAt(h.TM.PairExpiration(claims)).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error recording refresh token", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// createSession records the session started by the login that issued the first token pair of a
+// refresh token family, the session is identified by the family id
+func (h *Handler) createSession(ctx context.Context, claims *tokens.Claims, userAgent, ip string) error {
+	// sessions are started on login before the user is authenticated
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	if err := transaction.FromContext(ctx).Session.Create().
+		SetSessionToken(claims.ID).
+		SetUserID(claims.Subject).
+		SetOrganizationID(claims.OrgID).
+		SetUserAgent(userAgent).
+		SetIPAddress(ip).
+		SetExpiresAt(h.TM.PairExpiration(claims)).
+		Exec(allowCtx); err != nil {
+		h.Logger.Errorw("error creating session", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// touchSession records the activity of the session of the refresh token family and extends it to the
+// expiration of the new token pair
+func (h *Handler) touchSession(ctx context.Context, familyID string, expiresAt time.Time) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	if err := transaction.FromContext(ctx).Session.Update().
+		Where(
+			session.SessionToken(familyID),
+			session.RevokedAtIsNil(),
+		).
+		SetLastSeenAt(time.Now()).
+		SetExpiresAt(expiresAt).
+		Exec(allowCtx); err != nil {
+		h.Logger.Errorw("error updating session", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getRefreshToken returns the recorded refresh token by its jti, the query cache is skipped so a
+// refresh token already exchanged is always seen as used
+func (h *Handler) getRefreshToken(ctx context.Context, jti string) (*ent.RefreshToken, error) {
+	rt, err := transaction.FromContext(ctx).RefreshToken.Query().
+		Where(refreshtoken.Jti(jti)).
+		Only(entcache.Skip(ctx))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining refresh token", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// recordUntrackedRefreshToken records a refresh token issued before refresh tokens were recorded as exchanged, false
+// is returned when the refresh token was already recorded, e.g. by a concurrent request
+func (h *Handler) recordUntrackedRefreshToken(ctx context.Context, claims *tokens.Claims) (bool, error) {
+	if err := transaction.FromContext(ctx).RefreshToken.Create().
+		SetJti(claims.ID).
+		SetFamilyID(claims.ID).
+		SetUserID(claims.Subject).
+		SetUsedAt(time.Now()).
+		SetExpiresAt(h.TM.PairExpiration(claims)).
+		Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+
+		h.Logger.Errorw("error recording refresh token", "error", err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// markRefreshTokenUsed marks the refresh token as exchanged, false is returned when the refresh token
+// was already used, e.g. by a concurrent request
+func (h *Handler) markRefreshTokenUsed(ctx context.Context, rt *ent.RefreshToken) (bool, error) {
+	n, err := transaction.FromContext(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.ID(rt.ID),
+			refreshtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error marking refresh token used", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// revokeUserRefreshTokens revokes every unexpired refresh token of the user across all token families,
+// along with the access tokens sharing their jti, ending all of the user's sessions
+func (h *Handler) revokeUserRefreshTokens(ctx context.Context, userID, reason string) error {
+	rts, err := transaction.FromContext(ctx).RefreshToken.Query().
+		Where(
+			refreshtoken.UserID(userID),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		All(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error obtaining refresh tokens", "error", err)
+
+		return err
+	}
+
+	for _, rt := range rts {
+		if err := h.revokeJTI(ctx, rt.Jti, rt.UserID, reason, rt.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordTOTPStep stores the time step of an accepted one-time passcode, false is returned when a passcode for
+// the same or a later step was already accepted, e.g. the passcode is replayed
+func (h *Handler) recordTOTPStep(ctx context.Context, settingID string, step int64) (bool, error) {
+	n, err := transaction.FromContext(ctx).UserSetting.Update().
+		Where(
+			usersetting.ID(settingID),
+			usersetting.Or(
+				usersetting.TfaLastStepIsNil(),
+				usersetting.TfaLastStepLT(step),
+			),
+		).
+		SetTfaLastStep(step).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error recording totp step", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// consumeRecoveryCode replaces the recovery code hashes of the user setting with the remaining hashes when
+// the used hash is still stored, false is returned when the code was already used, e.g. by a concurrent request
+func (h *Handler) consumeRecoveryCode(ctx context.Context, settingID, hash string, remaining []string) (bool, error) {
+	n, err := transaction.FromContext(ctx).UserSetting.Update().
+		Where(
+			usersetting.ID(settingID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(usersetting.FieldRecoveryCodes), hash))
+			},
+		).
+		SetRecoveryCodes(remaining).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error consuming recovery code", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// addFailedLogin increments the consecutive failed logins of the user setting, the updated setting is
+// returned without the query cache so concurrent failures are all counted
+func (h *Handler) addFailedLogin(ctx context.Context, settingID string) (*ent.UserSetting, error) {
+	setting, err := transaction.FromContext(ctx).UserSetting.UpdateOneID(settingID).
+		AddFailedLoginAttempts(1).
+		Save(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error recording failed login", "error", err)
+
+		return nil, err
+	}
+
+	return setting, nil
+}
+
+// setLockedUntil rejects logins of the user until the time after repeated failed logins
+func (h *Handler) setLockedUntil(ctx context.Context, settingID string, until time.Time) error {
+	if err := transaction.FromContext(ctx).UserSetting.UpdateOneID(settingID).
+		SetLockedUntil(until).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error setting locked until", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// resetFailedLogins clears the consecutive failed logins of the user setting after a successful login
+func (h *Handler) resetFailedLogins(ctx context.Context, settingID string) error {
+	if err := transaction.FromContext(ctx).UserSetting.UpdateOneID(settingID).
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error resetting failed logins", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// lockUserSetting locks and suspends the account of the user and stores the unlock token sent to the user
+func (h *Handler) lockUserSetting(ctx context.Context, settingID string, user *User) error {
+	ttl, err := time.Parse(time.RFC3339Nano, user.UnlockExpires.String)
+	if err != nil {
+		h.Logger.Errorw("unable to parse ttl", "error", err)
+		return err
+	}
+
+	if err := transaction.FromContext(ctx).UserSetting.UpdateOneID(settingID).
+		SetLocked(true).
+		SetStatus(usersetting.StatusSuspended).
+		SetSuspendedAt(time.Now()).
+		ClearLockedUntil().
+		SetUnlockToken(user.UnlockToken.String).
+		SetUnlockTokenSecret(user.UnlockSecret).
+		SetUnlockTokenExpiresAt(ttl).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error locking user", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getUserByUnlockToken returns the ent user with the user settings based on the unlock token in the request
+func (h *Handler) getUserByUnlockToken(ctx context.Context, token string) (*ent.User, error) {
+	user, err := transaction.FromContext(ctx).UserSetting.Query().
+		Where(
+			usersetting.UnlockToken(token),
+		).
+		QueryUser().WithSetting().Only(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error obtaining user from unlock token", "error", err)
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// unlockUserSetting unlocks and reactivates the account of the user, clearing the failed logins and unlock token
+func (h *Handler) unlockUserSetting(ctx context.Context, settingID string) error {
+	if err := transaction.FromContext(ctx).UserSetting.UpdateOneID(settingID).
+		SetLocked(false).
+		SetStatus(usersetting.StatusActive).
+		ClearSuspendedAt().
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		ClearUnlockToken().
+		ClearUnlockTokenSecret().
+		ClearUnlockTokenExpiresAt().
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error unlocking user", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getOrgByID returns the organization by id, the organization is looked up without filtering so access
+// to the organization must be checked by the caller
+func (h *Handler) getOrgByID(ctx context.Context, id string) (*ent.Organization, error) {
+	org, err := transaction.FromContext(ctx).Organization.Get(privacy.DecisionContext(ctx, privacy.Allow), id)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining organization", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// isOrgMember returns true when the user is a member of the organization, used to check access to the
+// organization when authorization is not enabled
+func (h *Handler) isOrgMember(ctx context.Context, orgID, userID string) (bool, error) {
+	member, err := transaction.FromContext(ctx).Organization.Query().
+		Where(
+			organization.ID(orgID),
+			organization.HasUsersWith(user.ID(userID)),
+		).
+		Exist(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		h.Logger.Errorw("error checking organization membership", "error", err)
+
+		return false, err
+	}
+
+	return member, nil
+}
+
+// getOrgTier returns the highest tier of the active entitlements of the organization, entitlements that were
+// cancelled or have expired are ignored; organizations without an active entitlement are on the free tier
+func (h *Handler) getOrgTier(ctx context.Context, orgID string) (entitlement.Tier, error) {
+	ents, err := transaction.FromContext(ctx).Entitlement.Query().
+		Where(
+			entitlement.HasOwnerWith(organization.ID(orgID)),
+			entitlement.Cancelled(false),
+			entitlement.Or(
+				entitlement.Expires(false),
+				entitlement.ExpiresAtGT(time.Now()),
+			),
+		).
+		All(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		h.Logger.Errorw("error obtaining organization entitlements", "error", err)
+
+		return "", err
+	}
+
+	tier := entitlement.TierFree
+
+	for _, e := range ents {
+		if tierRank[e.Tier] > tierRank[tier] {
+			tier = e.Tier
+		}
+	}
+
+	return tier, nil
+}
+
+// tierRank orders the entitlement tiers from lowest to highest
+var tierRank = map[entitlement.Tier]int{
+	entitlement.TierFree:       0,
+	entitlement.TierPro:        1,
+	entitlement.TierEnterprise: 2, //nolint:gomnd
+}
+
+// revokeRefreshTokenFamily revokes every unexpired refresh token of the token family, along with the access
+// tokens sharing their jti, ending the session of the family
+func (h *Handler) revokeRefreshTokenFamily(ctx context.Context, familyID, reason string) error {
+	rts, err := transaction.FromContext(ctx).RefreshToken.Query().
+		Where(
+			refreshtoken.FamilyID(familyID),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		All(entcache.Skip(ctx))
+	if err != nil {
+		h.Logger.Errorw("error obtaining refresh tokens", "error", err)
+
+		return err
+	}
+
+	for _, rt := range rts {
+		if err := h.revokeJTI(ctx, rt.Jti, rt.UserID, reason, rt.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOauthClient returns the oauth client by its public client id, the client is looked up before the
+// request is authenticated so the lookup bypasses the privacy policy; deleted clients are not found
+func (h *Handler) getOauthClient(ctx context.Context, clientID string) (*ent.OauthClient, error) {
+	client, err := transaction.FromContext(ctx).OauthClient.Query().
+		Where(oauthclient.ClientID(clientID)).
+		Only(entcache.Skip(privacy.DecisionContext(ctx, privacy.Allow)))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining oauth client", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// createAuthorizationCode records the authorization code issued to the client, only the hash of the code is stored
+func (h *Handler) createAuthorizationCode(ctx context.Context, codeHash string, a *authorization, expiresAt time.Time) error {
+	if err := transaction.FromContext(ctx).OauthAuthorizationCode.Create().
+		SetCodeHash(codeHash).
+		SetClientID(a.client.ID).
+		SetUserID(a.user.ID).
+		SetRedirectURI(a.redirectURI).
+		SetScopes(a.scopes).
+		SetNonce(a.nonce).
+		SetCodeChallenge(a.codeChallenge).
+		SetCodeChallengeMethod(a.codeChallengeMethod).
+		SetExpiresAt(expiresAt).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error creating authorization code", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getAuthorizationCode returns the authorization code by the hash of the code, the query cache is skipped so
+// a code already exchanged is always seen as used
+func (h *Handler) getAuthorizationCode(ctx context.Context, codeHash string) (*ent.OauthAuthorizationCode, error) {
+	code, err := transaction.FromContext(ctx).OauthAuthorizationCode.Query().
+		Where(oauthauthorizationcode.CodeHash(codeHash)).
+		Only(entcache.Skip(ctx))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining authorization code", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return code, nil
+}
+
+// markAuthorizationCodeUsed marks the authorization code as exchanged, false is returned when the code was
+// already used, e.g. by a concurrent request
+func (h *Handler) markAuthorizationCodeUsed(ctx context.Context, code *ent.OauthAuthorizationCode) (bool, error) {
+	n, err := transaction.FromContext(ctx).OauthAuthorizationCode.Update().
+		Where(
+			oauthauthorizationcode.ID(code.ID),
+			oauthauthorizationcode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error marking authorization code used", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// getOauthConsent returns the consent the user gave the oauth client, the query cache is skipped so a new
+// consent is seen by the next authorization request
+func (h *Handler) getOauthConsent(ctx context.Context, clientID, userID string) (*ent.OhAuthTooToken, error) {
+	consent, err := transaction.FromContext(ctx).OhAuthTooToken.Query().
+		Where(
+			ohauthtootoken.ClientID(clientID),
+			ohauthtootoken.ClaimsUserID(userID),
+		).
+		First(entcache.Skip(privacy.DecisionContext(ctx, privacy.Allow)))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining oauth consent", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+// saveOauthConsent records the scopes the user granted to the oauth client along with the claims about the user
+// shared with the client, the scopes are added to any scopes the user granted before
+func (h *Handler) saveOauthConsent(ctx context.Context, a *authorization, existing *ent.OhAuthTooToken) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	db := transaction.FromContext(ctx)
+
+	username := a.user.DisplayName
+	if username == "" {
+		username = a.user.Email
+	}
+
+	var err error
+
+	if existing == nil {
+		err = db.OhAuthTooToken.Create().
+			SetClientID(a.client.ClientID).
+			SetScopes(a.scopes).
+			SetNonce(a.nonce).
+			SetClaimsUserID(a.user.ID).
+			SetClaimsUsername(username).
+			SetClaimsEmail(a.user.Email).
+			SetClaimsEmailVerified(a.user.Edges.Setting.EmailConfirmed).
+			SetClaimsPreferredUsername(username).
+			SetConnectorID(oauthConsentConnectorID).
+			SetLastUsed(time.Now()).
+			Exec(allowCtx)
+	} else {
+		err = db.OhAuthTooToken.UpdateOneID(existing.ID).
+			SetScopes(mergeScopes(existing.Scopes, a.scopes)).
+			SetNonce(a.nonce).
+			SetClaimsUsername(username).
+			SetClaimsEmail(a.user.Email).
+			SetClaimsEmailVerified(a.user.Edges.Setting.EmailConfirmed).
+			SetClaimsPreferredUsername(username).
+			SetLastUsed(time.Now()).
+			Exec(allowCtx)
+	}
+
+	if err != nil {
+		h.Logger.Errorw("error saving oauth consent", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// touchOauthConsent records when the consent was last used to authorize the client
+func (h *Handler) touchOauthConsent(ctx context.Context, consent *ent.OhAuthTooToken) error {
+	if err := transaction.FromContext(ctx).OhAuthTooToken.UpdateOneID(consent.ID).
+		SetLastUsed(time.Now()).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		h.Logger.Errorw("error updating oauth consent", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// revokeSessionByToken marks the session of the token family revoked, the tokens of the session are revoked
+// by the session hook
+func (h *Handler) revokeSessionByToken(ctx context.Context, familyID string) error {
+	if err := transaction.FromContext(ctx).Session.Update().
+		Where(
+			session.SessionToken(familyID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		h.Logger.Errorw("error revoking session", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// deletePersonalAccessToken deletes the personal access token so it can no longer be used
+func (h *Handler) deletePersonalAccessToken(ctx context.Context, id string) error {
+	if err := transaction.FromContext(ctx).PersonalAccessToken.DeleteOneID(id).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		h.Logger.Errorw("error deleting personal access token", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// deleteAPIKey deletes the api key so it can no longer be used
+func (h *Handler) deleteAPIKey(ctx context.Context, id string) error {
+	if err := transaction.FromContext(ctx).APIKey.DeleteOneID(id).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		h.Logger.Errorw("error deleting api key", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// addUserToOrganization adds the user to the organization with the role, if they are not already a member,
+// the membership creates the relationship tuple of the user
+func (h *Handler) addUserToOrganization(ctx context.Context, userID, orgID string, role orgmembership.Role) error {
+	member, err := h.isOrgMember(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	if member {
+		return nil
+	}
+
+	// the user is not yet a member, so the creation bypasses the privacy policy
+	if err := transaction.FromContext(ctx).OrgMembership.Create().
+		SetOrganizationID(orgID).
+		SetUserID(userID).
+		SetRole(role).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		h.Logger.Errorw("error adding user to organization", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getInviteByToken returns the invitation of the token, the invitation is looked up without filtering as the
+// invitee is not yet a member of the organization
+func (h *Handler) getInviteByToken(ctx context.Context, token string) (*ent.Invite, error) {
+	allowCtx := entcache.Skip(privacy.DecisionContext(ctx, privacy.Allow))
+
+	i, err := transaction.FromContext(ctx).Invite.Query().
+		Where(invite.Token(token)).
+		Only(allowCtx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining invite", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// setInviteStatus sets the status of the invitation if it is still pending, false is returned when the
+// invitation was already accepted, declined or revoked by a concurrent request
+func (h *Handler) setInviteStatus(ctx context.Context, i *ent.Invite, status invite.Status) (bool, error) {
+	n, err := transaction.FromContext(ctx).Invite.Update().
+		Where(
+			invite.ID(i.ID),
+			invite.StatusEQ(invite.StatusInvitationSent),
+		).
+		SetStatus(status).
+		Save(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		h.Logger.Errorw("error updating invite status", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// getTransferByToken returns the ownership transfer of the token, the transfer is looked up without filtering
+// as the request to decline it is not authenticated
+func (h *Handler) getTransferByToken(ctx context.Context, token string) (*ent.OwnershipTransfer, error) {
+	allowCtx := entcache.Skip(privacy.DecisionContext(ctx, privacy.Allow))
+
+	t, err := transaction.FromContext(ctx).OwnershipTransfer.Query().
+		Where(ownershiptransfer.Token(token)).
+		Only(allowCtx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining ownership transfer", "error", err)
+		}
+
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// setTransferStatus sets the status of the ownership transfer if it is still pending, false is returned when
+// the transfer was already accepted, declined or canceled by a concurrent request
+func (h *Handler) setTransferStatus(ctx context.Context, t *ent.OwnershipTransfer, status ownershiptransfer.Status) (bool, error) {
+	n, err := transaction.FromContext(ctx).OwnershipTransfer.Update().
+		Where(
+			ownershiptransfer.ID(t.ID),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		SetStatus(status).
+		Save(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		h.Logger.Errorw("error updating ownership transfer status", "error", err)
+
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// acceptTransfer marks the ownership transfer as accepted, the owners are swapped by the hook of the transfer
+// when it is accepted; the recipient cannot yet edit the organization or group so the update bypasses the
+// privacy policy
+func (h *Handler) acceptTransfer(ctx context.Context, t *ent.OwnershipTransfer) error {
+	err := transaction.FromContext(ctx).OwnershipTransfer.UpdateOneID(t.ID).
+		Where(ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending)).
+		SetStatus(ownershiptransfer.StatusAccepted).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		if ent.IsNotFound(err) || errors.Is(err, hooks.ErrOwnershipTransferNotPending) {
+			return ErrTransferNotPending
+		}
+
+		h.Logger.Errorw("error accepting ownership transfer", "error", err)
+
+		return err
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/datumforge/datum/internal/ent/generated"
 
@@ -55,6 +56,111 @@ var (
 	// ErrPassWordResetTokenInvalid is returned when the provided token and secret do not match the stored
 	ErrPassWordResetTokenInvalid = errors.New("password reset token invalid")
 
+	// ErrOauthProviderNotFound is returned when the requested oauth provider is not configured
+	ErrOauthProviderNotFound = errors.New("oauth provider not found")
+
+	// ErrOauthDiscovery is returned when the oauth provider configuration could not be discovered
+	ErrOauthDiscovery = errors.New("unable to discover oauth provider configuration")
+
+	// ErrInvalidOauthState is returned when the state on the oauth callback does not match the session
+	ErrInvalidOauthState = errors.New("invalid oauth state")
+
+	// ErrOauthIdentityConflict is returned when the email of an oauth login belongs to a user linked to another account of the same provider
+	ErrOauthIdentityConflict = errors.New("user is linked to another account with the oauth provider")
+
+	// ErrMissingIDToken is returned when the oauth provider does not return an id token
+	ErrMissingIDToken = errors.New("id token missing from oauth provider response")
+
+	// ErrInvalidMFACode is returned when the one-time passcode or recovery code is invalid
+	ErrInvalidMFACode = errors.New("invalid multi-factor authentication code")
+
+	// ErrTokenRevoked is returned when a revoked access or refresh token is used
+	ErrTokenRevoked = errors.New("token has been revoked")
+
+	// ErrRefreshTokenReused is returned when a refresh token that was already exchanged is used again
+	ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+	// ErrUnknownRefreshToken is returned when a refresh token was not issued by a tracked login
+	ErrUnknownRefreshToken = errors.New("refresh token not recognized")
+
+	// ErrWebauthnNotEnabled is returned when passkey registration or login is requested but not configured
+	ErrWebauthnNotEnabled = errors.New("webauthn is not enabled")
+
+	// ErrInvalidWebauthnSession is returned when the webauthn ceremony was not started or has expired
+	ErrInvalidWebauthnSession = errors.New("invalid or expired webauthn session")
+
+	// ErrSSONotEnabled is returned when SAML single sign-on is requested but not configured on the server
+	ErrSSONotEnabled = errors.New("sso is not enabled")
+
+	// ErrSSONotConfigured is returned when the organization does not have a valid sso certificate, entrypoint, issuer and domains
+	ErrSSONotConfigured = errors.New("sso is not configured for the organization")
+
+	// ErrInvalidSAMLRequest is returned when the SAML response is not for an authn request started by the user
+	ErrInvalidSAMLRequest = errors.New("invalid or expired sso request")
+
+	// ErrSSODomainNotAllowed is returned when the asserted email is not in one of the organization domains
+	ErrSSODomainNotAllowed = errors.New("email domain is not allowed for the organization")
+
+	// ErrSSOUserNotMember is returned when the asserted email belongs to an existing user that is not a member of the organization
+	ErrSSOUserNotMember = errors.New("user is not a member of the organization, ask an organization admin for an invitation")
+
+	// ErrTooManyLoginAttempts is returned when logins are throttled after repeated failed logins
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts, please try again later")
+
+	// ErrAccountLocked is returned when the account was locked after repeated failed logins or by an administrator
+	ErrAccountLocked = errors.New("account is locked, check your email to unlock your account")
+
+	// ErrUnlockTokenInvalid is returned when the provided unlock token and secret do not match the stored
+	ErrUnlockTokenInvalid = errors.New("unlock token invalid")
+
+	// ErrMagicLinkTokenInvalid is returned when the provided login link token and secret do not match the stored
+	ErrMagicLinkTokenInvalid = errors.New("login link token invalid")
+
+	// ErrSwitchNotAllowed is returned when an api key, personal access token or oauth client token is used to switch organizations
+	ErrSwitchNotAllowed = errors.New("organizations can only be switched after logging in")
+
+	// ErrOrganizationNotFound is returned when the organization does not exist or the user cannot access it
+	ErrOrganizationNotFound = errors.New("organization not found")
+
+	// ErrOauthRefreshNotAllowed is returned when a refresh token issued to an oauth client is used to refresh a login
+	ErrOauthRefreshNotAllowed = errors.New("refresh tokens issued to oauth clients must be exchanged at the token endpoint")
+
+	// ErrOauthAuthorizeNotAllowed is returned when an api key, personal access token or oauth client token is used to authorize an oauth client
+	ErrOauthAuthorizeNotAllowed = errors.New("oauth clients can only be authorized after logging in")
+
+	// ErrUnsupportedContentType is returned when the request body is not json
+	ErrUnsupportedContentType = errors.New("unsupported content type, expected application/json")
+
+	// ErrInviteTokenInvalid is returned when the provided invitation token and secret do not match the stored
+	ErrInviteTokenInvalid = errors.New("invitation token invalid")
+
+	// ErrInviteNotPending is returned when an invitation that was already accepted, declined or revoked is used
+	ErrInviteNotPending = errors.New("invitation is no longer pending")
+
+	// ErrInviteExpired is returned when an expired invitation is used, a new invitation has to be sent
+	ErrInviteExpired = errors.New("invitation has expired, please ask for a new invitation")
+
+	// ErrInviteRecipientMismatch is returned when the invitation was sent to another email address than the user's
+	ErrInviteRecipientMismatch = errors.New("invitation was sent to a different email address")
+
+	// ErrInviteAcceptNotAllowed is returned when an api key or oauth client token is used to accept an invitation
+	ErrInviteAcceptNotAllowed = errors.New("invitations can only be accepted by the invited user")
+
+	// ErrTransferTokenInvalid is returned when the provided transfer token and secret do not match the stored
+	ErrTransferTokenInvalid = errors.New("transfer token invalid")
+
+	// ErrTransferNotPending is returned when a transfer that was already accepted, declined or canceled is used
+	ErrTransferNotPending = errors.New("transfer is no longer pending")
+
+	// ErrTransferExpired is returned when an expired transfer is used, the transfer has to be requested again
+	ErrTransferExpired = errors.New("transfer has expired, please ask for a new transfer")
+
+	// ErrTransferRecipientMismatch is returned when the transfer is accepted by another user than its recipient
+	ErrTransferRecipientMismatch = errors.New("transfer was sent to a different user")
+
+	// ErrTransferAcceptNotAllowed is returned when an api key or oauth client token is used to accept a transfer
+	ErrTransferAcceptNotAllowed = errors.New("transfers can only be accepted by their recipient")
+
 	unsuccessful = echo.HTTPError{}
 )
 
@@ -76,6 +182,29 @@ func newInvalidEmailConfigError(field string) *InvalidEmailConfigError {
 	}
 }
 
+// TooManyLoginAttemptsError is returned when logins are throttled after repeated failed logins
+type TooManyLoginAttemptsError struct {
+	// RetryAfter is how long until another login is allowed
+	RetryAfter time.Duration
+}
+
+// Error returns the TooManyLoginAttemptsError in string format
+func (e *TooManyLoginAttemptsError) Error() string {
+	return ErrTooManyLoginAttempts.Error()
+}
+
+// Is allows the TooManyLoginAttemptsError to be matched with ErrTooManyLoginAttempts
+func (e *TooManyLoginAttemptsError) Is(target error) bool {
+	return target == ErrTooManyLoginAttempts
+}
+
+// newTooManyLoginAttemptsError returns an error with the time until another login is allowed
+func newTooManyLoginAttemptsError(retryAfter time.Duration) *TooManyLoginAttemptsError {
+	return &TooManyLoginAttemptsError{
+		RetryAfter: retryAfter,
+	}
+}
+
 // MissingRequiredFieldError is returned when a required field was not provided in a request
 type MissingRequiredFieldError struct {
 	// RequiredField that is missing
@@ -94,6 +223,47 @@ func newMissingRequiredFieldError(field string) *MissingRequiredFieldError {
 	}
 }
 
+// Error codes returned by the oauth authorization server, defined by RFC 6749 and OpenID Connect Core
+const (
+	oauthErrInvalidRequest          = "invalid_request"
+	oauthErrInvalidClient           = "invalid_client"
+	oauthErrInvalidGrant            = "invalid_grant"
+	oauthErrUnauthorizedClient      = "unauthorized_client"
+	oauthErrUnsupportedGrantType    = "unsupported_grant_type"
+	oauthErrUnsupportedResponseType = "unsupported_response_type"
+	oauthErrInvalidScope            = "invalid_scope"
+	oauthErrAccessDenied            = "access_denied"
+	oauthErrConsentRequired         = "consent_required"
+	oauthErrInsufficientScope       = "insufficient_scope"
+	oauthErrServerError             = "server_error"
+)
+
+// OauthError is returned by the oauth authorization server endpoints in the format defined by RFC 6749,
+// the error is returned to the client in the response body or in the query of the redirect uri
+type OauthError struct {
+	// Code is the error code, e.g. invalid_grant
+	Code string `json:"error"`
+	// Description is a human readable description of the error
+	Description string `json:"error_description,omitempty"`
+}
+
+// Error returns the OauthError in string format
+func (e *OauthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+// newOauthError returns an oauth error with the code and description
+func newOauthError(code, description string) *OauthError {
+	return &OauthError{
+		Code:        code,
+		Description: description,
+	}
+}
+
 // IsConstraintError returns true if the error resulted from a database constraint violation.
 func IsConstraintError(err error) bool {
 	var e *generated.ConstraintError
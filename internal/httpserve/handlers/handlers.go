@@ -1,11 +1,16 @@
 package handlers
 
 import (
+	"time"
+
 	"github.com/alexedwards/scs/v2"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"go.uber.org/zap"
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/lockout"
+	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/tokens"
 	"github.com/datumforge/datum/internal/utils/emails"
 	"github.com/datumforge/datum/internal/utils/marionette"
@@ -35,6 +40,29 @@ type Handler struct {
 	TaskMan *marionette.TaskManager
 	// SessionManager manages sessions for users
 	SM *scs.SessionManager
+	// OauthProviders contains the configured external OIDC providers keyed by name
+	OauthProviders map[string]*OauthProvider
+	// WebAuthn is the relying party used for passkey registration and login, nil when disabled
+	WebAuthn *webauthn.WebAuthn
+	// SSOBaseURL is the externally reachable url of the server used to build the SAML service provider
+	// urls of each organization, SAML SSO is disabled when empty
+	SSOBaseURL string
+	// Lockout throttles failed logins and locks accounts after repeated failures, nil when disabled
+	Lockout *lockout.Lockout
+	// PasswordPolicy contains the requirements passwords must meet, the default policy is used when nil
+	PasswordPolicy *passwd.Policy
+	// RefreshTokenCutover is when refresh tokens started being recorded, refresh tokens issued before it are not
+	// recorded and can be exchanged once; when zero they are rejected and the users must log in again
+	RefreshTokenCutover time.Time
+}
+
+// passwordPolicy returns the configured password policy or the default policy
+func (h *Handler) passwordPolicy() *passwd.Policy {
+	if h.PasswordPolicy == nil {
+		return passwd.DefaultPolicy()
+	}
+
+	return h.PasswordPolicy
 }
 
 type Response struct {
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+const (
+	tokenTypeAccess              = "access_token"
+	tokenTypeRefresh             = "refresh_token"
+	tokenTypePersonalAccessToken = "personal_access_token"
+	tokenTypeAPIKey              = "api_key"
+)
+
+// IntrospectionReply is the state of an introspected token as described in RFC 7662, only active is returned
+// for tokens that are unknown, no longer active or not visible to the client
+type IntrospectionReply struct {
+	Active      bool     `json:"active"`
+	Scope       string   `json:"scope,omitempty"`
+	ClientID    string   `json:"client_id,omitempty"`
+	Username    string   `json:"username,omitempty"`
+	TokenType   string   `json:"token_type,omitempty"`
+	Exp         int64    `json:"exp,omitempty"`
+	Iat         int64    `json:"iat,omitempty"`
+	Nbf         int64    `json:"nbf,omitempty"`
+	Sub         string   `json:"sub,omitempty"`
+	Aud         []string `json:"aud,omitempty"`
+	Iss         string   `json:"iss,omitempty"`
+	Jti         string   `json:"jti,omitempty"`
+	SubjectType string   `json:"sub_type,omitempty"`
+	OrgID       string   `json:"org_id,omitempty"`
+}
+
+// inspectedToken is a token presented to the introspection or revocation endpoint that was issued by datum
+type inspectedToken struct {
+	tokenType string
+	claims    *tokens.Claims
+	active    bool
+	// refresh is the refresh token record of the token pair, if any
+	refresh *ent.RefreshToken
+	// pat and apiKey are set when the token is a personal access token or api key
+	pat    *ent.PersonalAccessToken
+	apiKey *ent.APIKey
+}
+
+// IntrospectionHandler returns whether the presented token is active along with its subject, organization and
+// scopes so downstream services can learn about revoked tokens and personal access tokens. The endpoint is
+// only available to confidential oauth clients, which can introspect tokens issued to them or scoped to the
+// organization that owns the client; clients with the org:admin scope can also introspect the tokens of the
+// members of the organization
+func (h *Handler) IntrospectionHandler(ctx echo.Context) error {
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	ctx.Response().Header().Set("Pragma", "no-cache")
+
+	client, oerr := h.authenticateOauthClient(ctx)
+	if oerr != nil {
+		return h.oauthErrorResponse(ctx, oerr)
+	}
+
+	if client.Public {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrUnauthorizedClient, "public clients cannot introspect tokens"))
+	}
+
+	token := ctx.FormValue("token")
+	if token == "" {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrInvalidRequest, "token is required"))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	t, err := h.inspectToken(reqCtx, token)
+	if err != nil {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrServerError, ""))
+	}
+
+	if t == nil || !t.active {
+		return ctx.JSON(http.StatusOK, IntrospectionReply{Active: false})
+	}
+
+	visible, err := h.clientCanAccessToken(reqCtx, client, t.claims)
+	if err != nil {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrServerError, ""))
+	}
+
+	if !visible {
+		return ctx.JSON(http.StatusOK, IntrospectionReply{Active: false})
+	}
+
+	return ctx.JSON(http.StatusOK, introspectionReply(t))
+}
+
+// inspectToken returns the inspected token for the access token, refresh token, personal access token or api
+// key; nil is returned when the token was not issued by datum
+func (h *Handler) inspectToken(ctx context.Context, token string) (*inspectedToken, error) {
+	now := time.Now()
+
+	switch {
+	case keygen.IsAPIKey(token):
+		k, err := h.getAPIKey(ctx, token)
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidAPIKey) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return &inspectedToken{
+			tokenType: tokenTypeAPIKey,
+			claims:    apiKeyClaims(k),
+			active:    k.ExpiresAt == nil || k.ExpiresAt.After(now),
+			apiKey:    k,
+		}, nil
+	case !auth.IsJWT(token):
+		pat, err := h.getPersonalAccessToken(ctx, token)
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidPAT) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return &inspectedToken{
+			tokenType: tokenTypePersonalAccessToken,
+			claims:    personalAccessTokenClaims(pat),
+			active:    pat.ExpiresAt == nil || pat.ExpiresAt.After(now),
+			pat:       pat,
+		}, nil
+	default:
+		return h.inspectJWT(ctx, token)
+	}
+}
+
+// inspectJWT returns the inspected access or refresh token, the signature is verified but expired tokens,
+// used refresh tokens and revoked tokens are returned as inactive rather than an error
+func (h *Handler) inspectJWT(ctx context.Context, token string) (*inspectedToken, error) {
+	claims, err := h.TM.Parse(token)
+	if err != nil || !claims.VerifyIssuer(h.TM.Issuer(), true) {
+		return nil, nil
+	}
+
+	now := time.Now()
+
+	t := &inspectedToken{
+		claims: claims,
+		active: claims.ExpiresAt != nil && claims.ExpiresAt.After(now),
+	}
+
+	// refresh tokens have the access audience as well, so the refresh audience is checked first
+	switch {
+	case claims.VerifyAudience(h.TM.RefreshAudience(), true):
+		t.tokenType = tokenTypeRefresh
+
+		if t.refresh, err = h.getRefreshToken(ctx, claims.ID); err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		t.active = t.active && t.refresh.UsedAt == nil
+	case claims.VerifyAudience(h.TM.Audience(), true):
+		t.tokenType = tokenTypeAccess
+		t.active = t.active && (claims.NotBefore == nil || !claims.NotBefore.After(now))
+
+		// access tokens issued with a refresh token share the id of the refresh token
+		if t.refresh, err = h.getRefreshToken(ctx, claims.ID); err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+
+	if t.active {
+		revoked, err := h.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		t.active = !revoked
+	}
+
+	return t, nil
+}
+
+// clientCanAccessToken reports whether the client can access the token: tokens issued to the client and tokens
+// scoped to the organization that owns the client are accessible, tokens of users that are not scoped to an
+// organization, such as personal access tokens, are only accessible to confidential clients with the org:admin
+// scope when the user is a member of the organization that owns the client
+func (h *Handler) clientCanAccessToken(ctx context.Context, client *ent.OauthClient, claims *tokens.Claims) (bool, error) {
+	switch {
+	case claims.ClientID == client.ClientID:
+		return true, nil
+	case claims.OrgID != "":
+		return claims.OrgID == client.OwnerID, nil
+	case claims.IsService(), !clientIsOrgAdmin(client):
+		return false, nil
+	default:
+		return h.isOrgMember(ctx, client.OwnerID, claims.Subject)
+	}
+}
+
+// clientIsOrgAdmin reports whether the client is a confidential client with the org:admin scope
+func clientIsOrgAdmin(client *ent.OauthClient) bool {
+	return !client.Public && slices.Contains(client.Scopes, tokens.Scope(tokens.ScopeResourceOrg, tokens.ScopeActionAdmin))
+}
+
+// introspectionReply returns the introspection response of the active token
+func introspectionReply(t *inspectedToken) IntrospectionReply {
+	claims := t.claims
+
+	out := IntrospectionReply{
+		Active:      true,
+		Scope:       strings.Join(claims.Scopes, " "),
+		ClientID:    claims.ClientID,
+		Username:    claims.Email,
+		TokenType:   t.tokenType,
+		Sub:         claims.Subject,
+		Aud:         slices.Clone(claims.Audience),
+		Iss:         claims.Issuer,
+		Jti:         claims.ID,
+		SubjectType: claims.GetSubjectType(),
+		OrgID:       claims.OrgID,
+	}
+
+	if claims.ExpiresAt != nil {
+		out.Exp = claims.ExpiresAt.Unix()
+	}
+
+	if claims.IssuedAt != nil {
+		out.Iat = claims.IssuedAt.Unix()
+	}
+
+	if claims.NotBefore != nil {
+		out.Nbf = claims.NotBefore.Unix()
+	}
+
+	return out
+}
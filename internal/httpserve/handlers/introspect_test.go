@@ -0,0 +1,366 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+func TestIntrospectionHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	require.NoError(t, err)
+
+	h.TM = tm
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	user, access := createOauthUser(ctx, t, h)
+	outsider, outsiderAccess := createOauthUser(ctx, t, h)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	otherOrg := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	client, secret := createOauthClient(ctx, t, org.ID, false, "org:read")
+	admin, adminSecret := createOauthClient(ctx, t, org.ID, false, "org:admin")
+	otherClient, otherSecret := createOauthClient(ctx, t, otherOrg.ID, false, "org:read")
+	public, _ := createOauthClient(ctx, t, org.ID, true, "org:read")
+
+	// createPair creates a token pair of the user scoped to the organization with the refresh token recorded as
+	// a new token family
+	createPair := func() (string, string, *tokens.Claims) {
+		claims := &tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID: user.ID,
+			Email:  user.Email,
+			OrgID:  org.ID,
+		}
+
+		access, refresh, err := tm.CreateTokenPair(claims)
+		require.NoError(t, err)
+
+		EntClient.RefreshToken.Create().
+			SetJti(claims.ID).
+			SetFamilyID(claims.ID).
+			SetUserID(user.ID).
+			SetExpiresAt(tm.PairExpiration(claims)).
+			ExecX(ctx)
+
+		return access, refresh, claims
+	}
+
+	pairAccess, pairRefresh, pairClaims := createPair()
+	_, usedRefresh, usedClaims := createPair()
+	revokedAccess, _, revokedClaims := createPair()
+
+	EntClient.RefreshToken.Update().
+		Where(refreshtoken.Jti(usedClaims.ID)).
+		SetUsedAt(time.Now()).
+		ExecX(ctx)
+
+	EntClient.RevokedToken.Create().
+		SetJti(revokedClaims.ID).
+		SetUserID(user.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		ExecX(ctx)
+
+	// createPAT stores the hash of a new personal access token of the user
+	createPAT := func(expiresAt time.Time) string {
+		token, prefix := keygen.PersonalAccessToken()
+
+		hash, err := keygen.HashPersonalAccessToken(token)
+		require.NoError(t, err)
+
+		EntClient.PersonalAccessToken.Create().
+			SetName(gofakeit.AppName()).
+			SetOwner(user).
+			SetTokenPrefix(prefix).
+			SetTokenHash(hash).
+			SetAbilities([]string{"org:read"}).
+			SetExpiresAt(expiresAt).
+			ExecX(ctx)
+
+		return token
+	}
+
+	pat := createPAT(time.Now().Add(time.Hour))
+	expiredPAT := createPAT(time.Now().Add(-time.Hour))
+
+	e := setupEcho(h.SM)
+	e.POST("oauth/token", h.OauthTokenHandler)
+	e.POST("introspect", h.IntrospectionHandler)
+
+	// the client credentials token of the client is issued to the client itself
+	recorder := oauthTokenRequest(t, e, url.Values{"grant_type": {"client_credentials"}}, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var clientTokens handlers.OauthTokenReply
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&clientTokens))
+
+	testCases := []struct {
+		name              string
+		token             string
+		clientID          string
+		secret            string
+		expectedStatus    int
+		expectedErr       string
+		expectedActive    bool
+		expectedTokenType string
+		expectedSub       string
+	}{
+		{
+			name:           "access token of a member, client without the admin scope",
+			token:          access,
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:              "access token of a member",
+			token:             access,
+			clientID:          admin.ClientID,
+			secret:            adminSecret,
+			expectedStatus:    http.StatusOK,
+			expectedActive:    true,
+			expectedTokenType: "access_token",
+			expectedSub:       user.ID,
+		},
+		{
+			name:              "access token with a refresh token",
+			token:             pairAccess,
+			clientID:          client.ClientID,
+			secret:            secret,
+			expectedStatus:    http.StatusOK,
+			expectedActive:    true,
+			expectedTokenType: "access_token",
+			expectedSub:       user.ID,
+		},
+		{
+			name:              "refresh token",
+			token:             pairRefresh,
+			clientID:          client.ClientID,
+			secret:            secret,
+			expectedStatus:    http.StatusOK,
+			expectedActive:    true,
+			expectedTokenType: "refresh_token",
+			expectedSub:       user.ID,
+		},
+		{
+			name:           "personal access token of a member, client without the admin scope",
+			token:          pat,
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:              "personal access token of a member",
+			token:             pat,
+			clientID:          admin.ClientID,
+			secret:            adminSecret,
+			expectedStatus:    http.StatusOK,
+			expectedActive:    true,
+			expectedTokenType: "personal_access_token",
+			expectedSub:       user.ID,
+		},
+		{
+			name:              "client credentials token of the client",
+			token:             clientTokens.AccessToken,
+			clientID:          client.ClientID,
+			secret:            secret,
+			expectedStatus:    http.StatusOK,
+			expectedActive:    true,
+			expectedTokenType: "access_token",
+			expectedSub:       client.ID,
+		},
+		{
+			name:           "used refresh token",
+			token:          usedRefresh,
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "revoked access token",
+			token:          revokedAccess,
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expired personal access token",
+			token:          expiredPAT,
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown token",
+			token:          keygen.Secret(),
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "access token of a user outside the organization",
+			token:          outsiderAccess,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "client of another organization",
+			token:          pairAccess,
+			clientID:       otherClient.ClientID,
+			secret:         otherSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "client credentials token of another client",
+			token:          clientTokens.AccessToken,
+			clientID:       otherClient.ClientID,
+			secret:         otherSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "public client",
+			token:          access,
+			clientID:       public.ClientID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "missing token",
+			clientID:       client.ClientID,
+			secret:         secret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:           "wrong client secret",
+			token:          access,
+			clientID:       client.ClientID,
+			secret:         otherSecret,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    "invalid_client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			form := url.Values{}
+			if tc.token != "" {
+				form.Set("token", tc.token)
+			}
+
+			recorder := oauthClientRequest(t, e, "/introspect", form, tc.clientID, tc.secret)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, "no-store", res.Header.Get("Cache-Control"))
+
+			if tc.expectedErr != "" {
+				var out handlers.OauthError
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+				assert.Equal(t, tc.expectedErr, out.Code)
+
+				return
+			}
+
+			var out handlers.IntrospectionReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, tc.expectedActive, out.Active)
+
+			if !tc.expectedActive {
+				assert.Equal(t, handlers.IntrospectionReply{}, out)
+
+				return
+			}
+
+			assert.Equal(t, tc.expectedTokenType, out.TokenType)
+			assert.Equal(t, tc.expectedSub, out.Sub)
+			assert.NotZero(t, out.Exp)
+			assert.NotZero(t, out.Iat)
+		})
+	}
+
+	// the claims of the tokens are reported
+	recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {pairAccess}}, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var out handlers.IntrospectionReply
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Equal(t, pairClaims.ID, out.Jti)
+	assert.Equal(t, user.Email, out.Username)
+	assert.Equal(t, tokens.SubjectTypeUser, out.SubjectType)
+	assert.Equal(t, tm.Issuer(), out.Iss)
+
+	recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {clientTokens.AccessToken}}, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	out = handlers.IntrospectionReply{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Equal(t, client.ClientID, out.ClientID)
+	assert.Equal(t, org.ID, out.OrgID)
+	assert.Equal(t, tokens.SubjectTypeService, out.SubjectType)
+	assert.Equal(t, "org:read", out.Scope)
+
+	recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {pat}}, admin.ClientID, adminSecret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	out = handlers.IntrospectionReply{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Equal(t, "org:read", out.Scope)
+
+	// the outsider is reported once they are a member of the organization
+	EntClient.Organization.UpdateOne(org).AddUserIDs(outsider.ID).ExecX(ctx)
+
+	recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {outsiderAccess}}, admin.ClientID, adminSecret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	out = handlers.IntrospectionReply{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.True(t, out.Active)
+	assert.Equal(t, outsider.ID, out.Sub)
+
+	// clients without the admin scope still cannot see the tokens of members
+	recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {outsiderAccess}}, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	out = handlers.IntrospectionReply{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.False(t, out.Active)
+}
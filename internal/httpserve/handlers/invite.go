@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/marionette"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// Invite holds the data of an organization invitation that is emailed to the recipient
+type Invite struct {
+	ID          string
+	Token       string
+	Recipient   string
+	Role        string
+	OrgName     string
+	InviterName string
+}
+
+// InviteReply holds the fields that are sent on a response to the `/invite` endpoint
+type InviteReply struct {
+	ID          string `json:"user_id"`
+	Email       string `json:"email"`
+	Message     string `json:"message"`
+	JoinedOrgID string `json:"joined_org_id"`
+	Role        string `json:"role"`
+}
+
+// InviteHandler accepts the invitation of the token in the request for the authenticated user, who is added
+// to the organization with the role of the invitation. The invitation must have been sent to the email address
+// of the user; invitees who do not have an account yet accept the invitation when they register
+func (h *Handler) InviteHandler(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(err))
+	}
+
+	// invitations are sent to users, api keys and oauth clients cannot accept them
+	if claims.IsService() || claims.ClientID != "" {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrInviteAcceptNotAllowed))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	entUser, err := h.getUserByID(reqCtx, claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	i, err := h.verifyInvite(reqCtx, reqToken)
+	if err != nil {
+		return inviteErrorResponse(ctx, err)
+	}
+
+	if !strings.EqualFold(i.Recipient, entUser.Email) {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrInviteRecipientMismatch))
+	}
+
+	if err := h.acceptInvite(reqCtx, i, entUser.ID); err != nil {
+		return inviteErrorResponse(ctx, err)
+	}
+
+	out := &InviteReply{
+		ID:          entUser.ID,
+		Email:       entUser.Email,
+		Message:     "Welcome to your new organization!",
+		JoinedOrgID: i.OwnerID,
+		Role:        i.Role.String(),
+	}
+
+	return ctx.JSON(http.StatusOK, out)
+}
+
+// DeclineInviteHandler declines the invitation of the token in the request so it can no longer be accepted, the
+// token was emailed to the recipient so the request does not have to be authenticated
+func (h *Handler) DeclineInviteHandler(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	i, err := h.verifyInvite(reqCtx, reqToken)
+	if err != nil {
+		return inviteErrorResponse(ctx, err)
+	}
+
+	declined, err := h.setInviteStatus(reqCtx, i, invite.StatusInvitationDeclined)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if !declined {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrInviteNotPending))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// verifyInvite returns the pending invitation of the token after verifying the token with the stored secret,
+// expired invitations are marked as expired so they are no longer listed as pending
+func (h *Handler) verifyInvite(ctx context.Context, reqToken string) (*ent.Invite, error) {
+	i, err := h.getInviteByToken(ctx, reqToken)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInviteTokenInvalid
+		}
+
+		return nil, err
+	}
+
+	if i.Status != invite.StatusInvitationSent {
+		return nil, ErrInviteNotPending
+	}
+
+	// Construct the invite token from the database fields
+	t := &tokens.OrgInviteToken{
+		Email: i.Recipient,
+		SigningInfo: tokens.SigningInfo{
+			ExpiresAt: i.Expires,
+		},
+	}
+
+	if t.OrgID, err = ulids.Parse(i.OwnerID); err != nil {
+		h.Logger.Errorw("unable to parse organization id", "error", err)
+
+		return nil, err
+	}
+
+	// Verify the token with the stored secret
+	if err := t.Verify(reqToken, *i.Secret); err != nil {
+		if errors.Is(err, tokens.ErrTokenExpired) {
+			if _, err := h.setInviteStatus(ctx, i, invite.StatusInvitationExpired); err != nil {
+				return nil, err
+			}
+
+			return nil, ErrInviteExpired
+		}
+
+		return nil, ErrInviteTokenInvalid
+	}
+
+	return i, nil
+}
+
+// acceptInvite adds the user to the organization of the invitation, with the relation of the role of the
+// invitation, and marks the invitation as accepted
+func (h *Handler) acceptInvite(ctx context.Context, i *ent.Invite, userID string) error {
+	accepted, err := h.setInviteStatus(ctx, i, invite.StatusInvitationAccepted)
+	if err != nil {
+		return err
+	}
+
+	if !accepted {
+		return ErrInviteNotPending
+	}
+
+	return h.addUserToOrganization(ctx, userID, i.OwnerID, inviteMemberRole(i.Role))
+}
+
+// inviteMemberRole returns the role of the organization membership of the role of the invitation
+func inviteMemberRole(role invite.Role) orgmembership.Role {
+	if role == invite.RoleAdmin {
+		return orgmembership.RoleAdmin
+	}
+
+	return orgmembership.RoleMember
+}
+
+// inviteErrorResponse returns the response for the error of an invitation
+func inviteErrorResponse(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrInviteTokenInvalid), errors.Is(err, ErrInviteNotPending), errors.Is(err, ErrInviteExpired):
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+}
+
+// SendOrgInvitationEmail queues the email of the invitation to the recipient, with the link to accept the
+// invitation, it is called when an invitation is created
+func (h *Handler) SendOrgInvitationEmail(ctx context.Context, i *ent.Invite) error {
+	// the invitation is emailed before the recipient is a member of the organization, so the lookups
+	// bypass the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	org, err := i.QueryOwner().Only(allowCtx)
+	if err != nil {
+		h.Logger.Errorw("error obtaining organization of invite", "error", err)
+
+		return err
+	}
+
+	data := &Invite{
+		ID:          i.ID,
+		Token:       i.Token,
+		Recipient:   i.Recipient,
+		Role:        strings.ToLower(i.Role.String()),
+		OrgName:     org.DisplayName,
+		InviterName: org.DisplayName,
+	}
+
+	// the invitation is sent by a member of the organization, or by a service account of the organization
+	// in which case the organization is named as the inviter
+	if i.RequestorID != "" {
+		inviter, err := i.QueryOwner().QueryUsers().Where(user.ID(i.RequestorID)).Only(allowCtx)
+		if err != nil && !ent.IsNotFound(err) {
+			h.Logger.Errorw("error obtaining inviter", "error", err)
+
+			return err
+		}
+
+		if inviter != nil {
+			data.InviterName = strings.TrimSpace(inviter.FirstName + " " + inviter.LastName)
+		}
+	}
+
+	// send emails via TaskMan as to not create blocking operations in the server
+	return h.TaskMan.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+		return h.SendInviteEmail(data)
+	}), marionette.WithRetries(3), // nolint: gomnd
+		marionette.WithBackoff(backoff.NewExponentialBackOff()),
+		marionette.WithErrorf("could not send invite email for invite %s", i.ID),
+	)
+}
@@ -0,0 +1,407 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rShetty/asyncwait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/emails"
+	"github.com/datumforge/datum/internal/utils/emails/mock"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// createInvite creates an invitation of the recipient to the organization
+func createInvite(ctx context.Context, t *testing.T, orgID, recipient string, role invite.Role) *ent.Invite {
+	t.Helper()
+
+	return EntClient.Invite.Create().
+		SetOwnerID(orgID).
+		SetRecipient(recipient).
+		SetRole(role).
+		SaveX(ctx)
+}
+
+func TestInviteHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users, orgs and invites without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	invitee := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("shrek@datum.net").
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	adminOrg := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	accepted := createInvite(ctx, t, org.ID, "Shrek@datum.net", invite.RoleMember)
+	admin := createInvite(ctx, t, adminOrg.ID, invitee.Email, invite.RoleAdmin)
+	other := createInvite(ctx, t, org.ID, "donkey@datum.net", invite.RoleMember)
+
+	expiredOrg := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	expired := createInvite(ctx, t, expiredOrg.ID, invitee.Email, invite.RoleMember)
+	EntClient.Invite.UpdateOne(expired).SetExpires(time.Now().Add(-time.Hour)).ExecX(ctx)
+
+	revokedOrg := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	revoked := createInvite(ctx, t, revokedOrg.ID, invitee.Email, invite.RoleMember)
+	EntClient.Invite.UpdateOne(revoked).SetStatus(invite.StatusInvitationRevoked).ExecX(ctx)
+
+	access, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: invitee.ID,
+		},
+		UserID: invitee.ID,
+		Email:  invitee.Email,
+	})
+	require.NoError(t, err)
+
+	serviceAccess, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ulids.New().String(),
+		},
+		OrgID:       org.ID,
+		SubjectType: tokens.SubjectTypeService,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.GET("invite", h.InviteHandler, auth.Authenticate(auth.WithValidator(h.TM)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		access         string
+		orgID          string
+		role           string
+		expectedErr    string
+		expectedStatus int
+	}{
+		{
+			name:           "happy path",
+			token:          accepted.Token,
+			access:         access,
+			orgID:          org.ID,
+			role:           "MEMBER",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "happy path, admin",
+			token:          admin.Token,
+			access:         access,
+			orgID:          adminOrg.ID,
+			role:           "ADMIN",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "already accepted",
+			token:          accepted.Token,
+			access:         access,
+			expectedErr:    handlers.ErrInviteNotPending.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invitation of another user",
+			token:          other.Token,
+			access:         access,
+			expectedErr:    handlers.ErrInviteRecipientMismatch.Error(),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "expired invitation",
+			token:          expired.Token,
+			access:         access,
+			expectedErr:    handlers.ErrInviteExpired.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "revoked invitation",
+			token:          revoked.Token,
+			access:         access,
+			expectedErr:    handlers.ErrInviteNotPending.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown token",
+			token:          "bogus",
+			access:         access,
+			expectedErr:    handlers.ErrInviteTokenInvalid.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing token",
+			access:         access,
+			expectedErr:    "token is required",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "service account cannot accept",
+			token:          other.Token,
+			access:         serviceAccess,
+			expectedErr:    handlers.ErrInviteAcceptNotAllowed.Error(),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := "/invite"
+			if tc.token != "" {
+				target += "?token=" + tc.token
+			}
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.Header.Set("Authorization", "Bearer "+tc.access)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				var out *handlers.Response
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+				assert.Contains(t, out.Message, tc.expectedErr)
+
+				return
+			}
+
+			var out *handlers.InviteReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, invitee.ID, out.ID)
+			assert.Equal(t, tc.orgID, out.JoinedOrgID)
+			assert.Equal(t, tc.role, out.Role)
+
+			// the user is now a member of the organization
+			assert.True(t, EntClient.Organization.Query().
+				Where(organization.ID(tc.orgID), organization.HasUsersWith(user.ID(invitee.ID))).
+				ExistX(ctx))
+		})
+	}
+
+	assert.Equal(t, invite.StatusInvitationAccepted, EntClient.Invite.GetX(ctx, accepted.ID).Status)
+	assert.Equal(t, invite.StatusInvitationExpired, EntClient.Invite.GetX(ctx, expired.ID).Status)
+	assert.Equal(t, invite.StatusInvitationSent, EntClient.Invite.GetX(ctx, other.ID).Status)
+}
+
+func TestDeclineInviteHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	i := createInvite(ctx, t, org.ID, "fiona@datum.net", invite.RoleMember)
+
+	e := setupEcho(h.SM)
+	e.POST("invite/decline", h.DeclineInviteHandler)
+
+	decline := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/invite/decline?token="+token, nil)
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	recorder := decline(i.Token)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	assert.Equal(t, invite.StatusInvitationDeclined, EntClient.Invite.GetX(ctx, i.ID).Status)
+
+	// declined invitations can no longer be declined, or accepted
+	recorder = decline(i.Token)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var out *handlers.Response
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Contains(t, out.Message, handlers.ErrInviteNotPending.Error())
+}
+
+func TestRegisterHandlerWithInvite(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	i := createInvite(ctx, t, org.ID, "farquaad@datum.net", invite.RoleAdmin)
+
+	e := setupEcho(h.SM)
+	e.POST("register", h.RegisterHandler)
+
+	register := func(email string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(handlers.RegisterRequest{
+			FirstName: "Lord",
+			LastName:  "Farquaad",
+			Email:     email,
+			Password:  validPassword,
+			Token:     i.Token,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/register", strings.NewReader(string(body)))
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	mock.ResetEmailMock()
+
+	// the invitation can only be used to register with the email address it was sent to
+	recorder := register("duloc@datum.net")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var errOut *handlers.Response
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&errOut))
+
+	assert.Contains(t, errOut.Message, handlers.ErrInviteRecipientMismatch.Error())
+
+	recorder = register(i.Recipient)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+
+	var out *handlers.RegisterReply
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Equal(t, org.ID, out.JoinedOrgID)
+
+	// the user joined the organization and the email address is confirmed by the invitation
+	registered := EntClient.User.Query().
+		Where(user.ID(out.ID)).
+		WithSetting().
+		WithOrganizations().
+		OnlyX(ctx)
+
+	assert.True(t, registered.Edges.Setting.EmailConfirmed)
+
+	orgIDs := []string{}
+	for _, o := range registered.Edges.Organizations {
+		orgIDs = append(orgIDs, o.ID)
+	}
+
+	assert.Contains(t, orgIDs, org.ID)
+	assert.Equal(t, invite.StatusInvitationAccepted, EntClient.Invite.GetX(ctx, i.ID).Status)
+
+	// no verification email is sent
+	predicate := func() bool {
+		return h.TaskMan.GetQueueLength() == 0
+	}
+	successful := asyncwait.NewAsyncWait(maxWaitInMillis, pollIntervalInMillis).Check(predicate)
+
+	if successful != true {
+		t.Errorf("max wait of email send")
+	}
+
+	mock.CheckEmails(t, nil)
+}
+
+func TestSendOrgInvitationEmail(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	inviter := EntClient.User.Create().
+		SetFirstName("Dragon").
+		SetLastName("Keep").
+		SetEmail("dragon.keep@datum.net").
+		SetPassword(validPassword).
+		SaveX(ctx)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(inviter.ID).
+		SaveX(ctx)
+
+	// the inviter is recorded from the authenticated user of the request
+	userEc, err := auth.NewTestContextWithValidUser(inviter.ID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(userEc.Request().Context(), echocontext.EchoContextKey, userEc)
+
+	i := createInvite(privacy.DecisionContext(reqCtx, privacy.Allow), t, org.ID, "gingy@datum.net", invite.RoleMember)
+	assert.Equal(t, inviter.ID, i.RequestorID)
+
+	sent := time.Now()
+
+	mock.ResetEmailMock()
+
+	require.NoError(t, h.SendOrgInvitationEmail(ctx, i))
+
+	predicate := func() bool {
+		return h.TaskMan.GetQueueLength() == 0
+	}
+	successful := asyncwait.NewAsyncWait(maxWaitInMillis, pollIntervalInMillis).Check(predicate)
+
+	if successful != true {
+		t.Errorf("max wait of email send")
+	}
+
+	mock.CheckEmails(t, []*mock.EmailMetadata{
+		{
+			To:        i.Recipient,
+			From:      h.SendGridConfig.FromEmail,
+			Subject:   fmt.Sprintf(emails.InviteRE, "Dragon Keep"),
+			Timestamp: sent,
+		},
+	})
+}
@@ -1,17 +1,25 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	echo "github.com/datumforge/echox"
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/ent/privacy/viewer"
 	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/marionette"
 )
 
 // LoginRequest to authenticate with the Datum Sever
@@ -20,12 +28,30 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
+// MFARequiredMessage is returned in the login response when a one-time passcode is required to complete the login
+const MFARequiredMessage = "mfa_required"
+
+// MFARequiredReply is returned on login when the user must verify a one-time passcode before tokens are issued
+type MFARequiredReply struct {
+	MFAToken string `json:"mfa_token"`
+}
+
 // LoginHandler validates the user credentials and returns a valid cookie
 // this only supports username password login today (not oauth)
 func (h *Handler) LoginHandler(ctx echo.Context) error {
 	user, err := h.verifyUserPassword(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+		return loginErrorResponse(ctx, err)
+	}
+
+	return h.loginUser(ctx, user)
+}
+
+// loginUser issues the access and refresh tokens of the authenticated user and sets the auth cookies, when
+// multi-factor authentication is enabled a pending token is returned to complete the login instead
+func (h *Handler) loginUser(ctx echo.Context, user *generated.User) error {
+	if user.Edges.Setting.IsTfaEnabled {
+		return h.mfaRequired(ctx, user)
 	}
 
 	// set context for remaining request based on logged in user
@@ -33,7 +59,7 @@ func (h *Handler) LoginHandler(ctx echo.Context) error {
 
 	claims := createClaims(user)
 
-	access, refresh, err := h.TM.CreateTokenPair(claims)
+	access, refresh, err := h.createTokenPair(ctx, claims, nil)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
 	}
@@ -53,6 +79,17 @@ func (h *Handler) LoginHandler(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, Response{Message: "success"})
 }
 
+// mfaRequired returns the mfa pending token of a user with multi-factor authentication enabled, tokens are not
+// issued until the one-time passcode is verified with the pending token on the mfa login endpoint
+func (h *Handler) mfaRequired(ctx echo.Context, user *generated.User) error {
+	mfaToken, err := h.TM.CreateMFAPendingToken(user.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Message: MFARequiredMessage, Data: MFARequiredReply{MFAToken: mfaToken}})
+}
+
 func createClaims(u *generated.User) *tokens.Claims {
 	return &tokens.Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
@@ -63,7 +100,8 @@ func createClaims(u *generated.User) *tokens.Claims {
 	}
 }
 
-// verifyUserPassword verifies the username and password are valid
+// verifyUserPassword verifies the username and password are valid, failed logins are tracked per user and
+// ip address to throttle further logins and lock the account after repeated failures
 func (h *Handler) verifyUserPassword(ctx echo.Context) (*generated.User, error) {
 	var l LoginRequest
 
@@ -76,19 +114,54 @@ func (h *Handler) verifyUserPassword(ctx echo.Context) (*generated.User, error)
 		return nil, ErrMissingRequiredFields
 	}
 
+	reqCtx := ctx.Request().Context()
+	ip := ctx.RealIP()
+	now := time.Now()
+
+	if h.Lockout != nil {
+		if wait := h.Lockout.IPRetryAfter(ip, now); wait > 0 {
+			return nil, newTooManyLoginAttemptsError(wait)
+		}
+	}
+
 	// check user in the database, username == email and ensure only one record is returned
-	user, err := h.getUserByEmail(ctx.Request().Context(), l.Username)
+	user, err := h.getUserByEmail(reqCtx, l.Username)
 	if err != nil {
+		h.ipLoginFailed(ip, now)
+
 		return nil, ErrNoAuthUser
 	}
 
-	if user.Edges.Setting.Status != "ACTIVE" {
-		return nil, ErrNoAuthUser
+	setting := user.Edges.Setting
+
+	// the password is verified before the account is checked so the response to a wrong password does not
+	// reveal whether the account is locked or throttled
+	// users that signed up with an oauth provider, saml or a magic link have no password, their logins with a
+	// password fail like a wrong password
+	valid := false
+	if user.Password != nil {
+		valid, err = passwd.VerifyDerivedKey(*user.Password, l.Password)
 	}
 
-	// verify the password is correct
-	valid, err := passwd.VerifyDerivedKey(*user.Password, l.Password)
 	if err != nil || !valid {
+		h.ipLoginFailed(ip, now)
+
+		// failures while the account is locked or throttled are not counted, so the lock of a victim's
+		// account cannot be escalated faster than the delays allow
+		if checkAccountUsable(user) != nil || h.loginRetryAfter(setting, now) > 0 {
+			return nil, ErrInvalidCredentials
+		}
+
+		if err := h.userLoginFailed(reqCtx, user, now); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrInvalidCredentials
+	}
+
+	// the correct password is not accepted while the account is locked or logins of the user are throttled,
+	// the same response as a wrong password is returned so the password cannot be guessed in the meantime
+	if checkAccountUsable(user) != nil || h.loginRetryAfter(setting, now) > 0 {
 		return nil, ErrInvalidCredentials
 	}
 
@@ -97,5 +170,144 @@ func (h *Handler) verifyUserPassword(ctx echo.Context) (*generated.User, error)
 		return nil, ErrUnverifiedUser
 	}
 
+	if setting.FailedLoginAttempts > 0 || setting.LockedUntil != nil {
+		if err := h.resetFailedLogins(reqCtx, setting.ID); err != nil {
+			return nil, ErrProcessingRequest
+		}
+	}
+
+	// passwords older than the max age of the password policy have to be reset before logging in, passwords
+	// set before the change time was recorded are as old as the user
+	changedAt := user.CreatedAt
+	if user.PasswordChangedAt != nil {
+		changedAt = *user.PasswordChangedAt
+	}
+
+	if h.passwordPolicy().Expired(changedAt) {
+		return nil, passwd.ErrPasswordExpired
+	}
+
 	return user, nil
 }
+
+// checkAccountUsable returns an error when the account of the user cannot authenticate or be issued tokens,
+// it is checked on every path that logs the user in or issues tokens for the user
+func checkAccountUsable(user *generated.User) error {
+	setting := user.Edges.Setting
+	if setting == nil {
+		return ErrNoAuthUser
+	}
+
+	if setting.Locked {
+		return ErrAccountLocked
+	}
+
+	if setting.Status != usersetting.StatusActive {
+		return ErrNoAuthUser
+	}
+
+	return nil
+}
+
+// accountErrorStatus returns the response status of an error from checkAccountUsable, locked accounts are
+// forbidden and status is returned for accounts that are not active
+func accountErrorStatus(err error, status int) int {
+	if errors.Is(err, ErrAccountLocked) {
+		return http.StatusForbidden
+	}
+
+	return status
+}
+
+// loginRetryAfter returns how long until the user may attempt another login after repeated failed logins,
+// zero when a login is allowed now
+func (h *Handler) loginRetryAfter(setting *generated.UserSetting, now time.Time) time.Duration {
+	if h.Lockout == nil || setting.LockedUntil == nil || !now.Before(*setting.LockedUntil) {
+		return 0
+	}
+
+	return setting.LockedUntil.Sub(now)
+}
+
+// ipLoginFailed records a failed login from the ip address when failed logins are tracked
+func (h *Handler) ipLoginFailed(ip string, now time.Time) {
+	if h.Lockout != nil {
+		h.Lockout.IPFailed(ip, now)
+	}
+}
+
+// userLoginFailed records a failed login of the user and delays the next login once the threshold is reached,
+// the account is locked and the user is emailed a link to unlock it once the lock threshold is reached; the
+// caller responds as for any other failed login so the lock is only revealed to the user by the email
+func (h *Handler) userLoginFailed(ctx context.Context, user *generated.User, now time.Time) error {
+	if h.Lockout == nil {
+		return nil
+	}
+
+	setting, err := h.addFailedLogin(ctx, user.Edges.Setting.ID)
+	if err != nil {
+		return ErrProcessingRequest
+	}
+
+	if !h.Lockout.ShouldLock(setting.FailedLoginAttempts) {
+		if delay := h.Lockout.Delay(setting.FailedLoginAttempts); delay > 0 {
+			if err := h.setLockedUntil(ctx, setting.ID, now.Add(delay)); err != nil {
+				return ErrProcessingRequest
+			}
+		}
+
+		return nil
+	}
+
+	u := &User{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+	}
+
+	if err := h.lockAndSendUnlockToken(ctx, setting.ID, u); err != nil {
+		return ErrProcessingRequest
+	}
+
+	return nil
+}
+
+// lockAndSendUnlockToken locks the account of the user and emails the user a link to unlock it
+func (h *Handler) lockAndSendUnlockToken(ctx context.Context, settingID string, user *User) error {
+	if err := user.CreateUnlockToken(); err != nil {
+		h.Logger.Errorw("unable to create unlock token", "error", err)
+
+		return err
+	}
+
+	if err := h.lockUserSetting(ctx, settingID, user); err != nil {
+		return err
+	}
+
+	// send emails via TaskMan as to not create blocking operations in the server
+	return h.TaskMan.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+		return h.SendAccountLockedEmail(user)
+	}), marionette.WithRetries(3), //nolint: gomnd
+		marionette.WithBackoff(backoff.NewExponentialBackOff()),
+		marionette.WithErrorf("could not send account locked email to user %s", user.ID),
+	)
+}
+
+// loginErrorResponse returns the error response of a failed login, throttled logins are told when to retry
+func loginErrorResponse(ctx echo.Context, err error) error {
+	var throttled *TooManyLoginAttemptsError
+
+	switch {
+	case errors.As(err, &throttled):
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+
+		return ctx.JSON(http.StatusTooManyRequests, ErrorResponse(err))
+	case errors.Is(err, ErrAccountLocked), errors.Is(err, passwd.ErrPasswordExpired):
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(err))
+	case errors.Is(err, ErrProcessingRequest):
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	default:
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+}
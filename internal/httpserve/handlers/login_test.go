@@ -6,17 +6,24 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"ariga.io/entcache"
 	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
 	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/lockout"
+	"github.com/datumforge/datum/internal/passwd"
 )
 
 func TestLoginHandler(t *testing.T) {
@@ -156,3 +163,319 @@ func TestLoginHandler(t *testing.T) {
 	EntClient.User.DeleteOneID(userConfirmed.ID).ExecX(ctx)
 	EntClient.User.DeleteOneID(userUnconfirmed.ID).ExecX(ctx)
 }
+
+func TestLoginHandlerPasswordExpired(t *testing.T) {
+	h := handlerSetup(t)
+
+	policy, err := passwd.NewPolicy(passwd.PolicyConfig{
+		MinStrength: passwd.Moderate,
+		MinLength:   8,              //nolint:gomnd
+		MaxAge:      24 * time.Hour, //nolint:gomnd
+	})
+	require.NoError(t, err)
+
+	h.PasswordPolicy = policy
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	// createUser creates a confirmed user with the password changed at the time
+	createUser := func(changedAt time.Time) *ent.User {
+		userSetting := EntClient.UserSetting.Create().
+			SetEmailConfirmed(true).
+			SaveX(ctx)
+
+		user := EntClient.User.Create().
+			SetFirstName(gofakeit.FirstName()).
+			SetLastName(gofakeit.LastName()).
+			SetEmail(gofakeit.Email()).
+			SetPassword(validPassword).
+			SetSetting(userSetting).
+			SaveX(ctx)
+
+		return EntClient.User.UpdateOne(user).
+			SetPasswordChangedAt(changedAt).
+			SaveX(ctx)
+	}
+
+	current := createUser(time.Now().Add(-time.Hour))
+	expired := createUser(time.Now().Add(-48 * time.Hour)) //nolint:gomnd
+
+	// the password changed time is recorded when the password is set
+	require.NotNil(t, current.PasswordChangedAt)
+
+	testCases := []struct {
+		name           string
+		user           *ent.User
+		expectedStatus int
+		expectedErr    error
+	}{
+		{
+			name:           "password within max age",
+			user:           current,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "password older than max age",
+			user:           expired,
+			expectedStatus: http.StatusForbidden,
+			expectedErr:    passwd.ErrPasswordExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := setupEcho(h.SM)
+			e.POST("login", h.LoginHandler)
+
+			body, err := json.Marshal(handlers.LoginRequest{
+				Username: tc.user.Email,
+				Password: validPassword,
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+			recorder := httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *handlers.Response
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != nil {
+				assert.Contains(t, out.Message, tc.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestLoginHandlerLockout(t *testing.T) {
+	h := handlerSetup(t)
+
+	l, err := lockout.New(lockout.Config{
+		Enabled:       true,
+		Threshold:     2,
+		LockThreshold: 4,
+		IPThreshold:   100,
+		BaseDelay:     time.Hour,
+		MaxDelay:      2 * time.Hour,
+		IPResetAfter:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	h.Lockout = l
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	email := "bperson@datum.net"
+	validPassword := "sup3rs3cu7e!"
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.POST("login", h.LoginHandler)
+	e.GET("unlock", h.UnlockAccount)
+
+	ip := "198.51.100.10"
+
+	// getSetting skips the query cache to see the failed logins recorded by the handler
+	getSetting := func() *ent.UserSetting {
+		return EntClient.UserSetting.GetX(entcache.Skip(ctx), userSetting.ID)
+	}
+
+	// clearLockedUntil lets the next login through without waiting out the delay
+	clearLockedUntil := func() {
+		EntClient.UserSetting.UpdateOneID(userSetting.ID).ClearLockedUntil().ExecX(ctx)
+	}
+
+	// failures under the threshold are not delayed
+	recorder := login(t, e, email, "thisisnottherightone", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// the failure reaching the threshold delays the next login
+	recorder = login(t, e, email, "thisisnottherightone", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// while throttled the correct password gets the same response as a wrong one
+	recorder = login(t, e, email, validPassword, ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidCredentials.Error())
+
+	recorder = login(t, e, email, "thisisnottherightone", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidCredentials.Error())
+
+	// throttled logins are not counted as failures
+	setting := getSetting()
+	assert.Equal(t, 2, setting.FailedLoginAttempts)
+	require.NotNil(t, setting.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *setting.LockedUntil, time.Minute)
+
+	clearLockedUntil()
+
+	recorder = login(t, e, email, "thisisnottherightone", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	setting = getSetting()
+	require.NotNil(t, setting.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), *setting.LockedUntil, time.Minute)
+
+	clearLockedUntil()
+
+	// the failure reaching the lock threshold locks the account, the lock is only revealed by the email
+	recorder = login(t, e, email, "thisisnottherightone", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidCredentials.Error())
+
+	recorder = login(t, e, email, validPassword, ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidCredentials.Error())
+
+	setting = getSetting()
+	assert.True(t, setting.Locked)
+	assert.Equal(t, usersetting.StatusSuspended, setting.Status)
+	assert.NotNil(t, setting.SuspendedAt)
+	require.NotNil(t, setting.UnlockToken)
+
+	// unlock the account with the emailed token
+	req := httptest.NewRequest(http.MethodGet, "/unlock?token="+*setting.UnlockToken, nil)
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = login(t, e, email, validPassword, ip)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	setting = getSetting()
+	assert.False(t, setting.Locked)
+	assert.Equal(t, usersetting.StatusActive, setting.Status)
+	assert.Zero(t, setting.FailedLoginAttempts)
+	assert.Nil(t, setting.LockedUntil)
+	assert.Nil(t, setting.UnlockToken)
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
+
+func TestLoginHandlerIPThrottle(t *testing.T) {
+	h := handlerSetup(t)
+
+	l, err := lockout.New(lockout.Config{
+		Enabled:       true,
+		Threshold:     5,
+		LockThreshold: 10,
+		IPThreshold:   2,
+		BaseDelay:     time.Minute,
+		MaxDelay:      time.Hour,
+		IPResetAfter:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	h.Lockout = l
+
+	e := setupEcho(h.SM)
+	e.POST("login", h.LoginHandler)
+
+	ip := "198.51.100.20"
+
+	// guessing unknown users counts against the ip address
+	recorder := login(t, e, "unknown1@datum.net", "password", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = login(t, e, "unknown2@datum.net", "password", ip)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = login(t, e, "unknown3@datum.net", "password", ip)
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "60", recorder.Header().Get("Retry-After"))
+
+	// other ip addresses are not throttled
+	recorder = login(t, e, "unknown3@datum.net", "password", "198.51.100.21")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestLoginHandlerPasswordlessUser(t *testing.T) {
+	h := handlerSetup(t)
+
+	l, err := lockout.New(lockout.Config{
+		Enabled:       true,
+		Threshold:     2,
+		LockThreshold: 4,
+		IPThreshold:   100,
+		BaseDelay:     time.Hour,
+		MaxDelay:      2 * time.Hour,
+		IPResetAfter:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	h.Lockout = l
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	// users that signed up with an oauth provider, saml or a magic link have no password
+	email := "oauthperson@datum.net"
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.POST("login", h.LoginHandler)
+
+	recorder := login(t, e, email, "sup3rs3cu7e!", "198.51.100.30")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var out *handlers.Response
+
+	require.NoError(t, json.NewDecoder(recorder.Result().Body).Decode(&out))
+	assert.Contains(t, out.Message, auth.ErrInvalidCredentials.Error())
+
+	// the login is counted as a failed attempt
+	setting := EntClient.UserSetting.GetX(entcache.Skip(ctx), userSetting.ID)
+	assert.Equal(t, 1, setting.FailedLoginAttempts)
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
+
+// login posts the credentials to the login handler from the ip address
+func login(t *testing.T, e *echo.Echo, username, password, ip string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(handlers.LoginRequest{
+		Username: username,
+		Password: password,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderXRealIP, ip)
+
+	recorder := httptest.NewRecorder()
+
+	e.ServeHTTP(recorder, req)
+
+	return recorder
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/otp"
+)
+
+// mfaLoginReason is recorded when the jti of a used mfa token is added to the revocation list
+const mfaLoginReason = "mfa login"
+
+// MFALoginRequest completes a login for a user with multi-factor authentication enabled
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+// MFALoginHandler completes the second step of the login for users with multi-factor authentication
+// enabled, verifying the mfa pending token returned by the login endpoint along with either a one-time
+// passcode from the authenticator app or an unused recovery code. The mfa token can only be used to
+// complete one login and failed codes are counted by the lockout like failed passwords
+func (h *Handler) MFALoginHandler(ctx echo.Context) error {
+	var in MFALoginRequest
+
+	// parse request body
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+	}
+
+	if in.MFAToken == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("mfa_token")))
+	}
+
+	if in.Code == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("code")))
+	}
+
+	reqCtx := ctx.Request().Context()
+	ip := ctx.RealIP()
+	now := time.Now()
+
+	if h.Lockout != nil {
+		if wait := h.Lockout.IPRetryAfter(ip, now); wait > 0 {
+			return loginErrorResponse(ctx, newTooManyLoginAttemptsError(wait))
+		}
+	}
+
+	claims, err := h.TM.VerifyMFAPendingToken(in.MFAToken)
+	if err != nil {
+		h.Logger.Errorw("error verifying mfa token", "error", err)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	// the mfa token is single use, its jti is added to the revocation list once the login is completed
+	used, err := h.IsRevoked(reqCtx, claims.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if used {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	user, err := h.getUserByID(reqCtx, claims.Subject)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if err := checkAccountUsable(user); err != nil {
+		return ctx.JSON(accountErrorStatus(err, http.StatusUnauthorized), ErrorResponse(err))
+	}
+
+	setting := user.Edges.Setting
+
+	if !setting.IsTfaEnabled || setting.TfaSecret == nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+	}
+
+	// failed passcodes count towards the same lockout as failed passwords
+	if wait := h.loginRetryAfter(setting, now); wait > 0 {
+		return loginErrorResponse(ctx, newTooManyLoginAttemptsError(wait))
+	}
+
+	// set context for remaining request based on logged in user
+	userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(user.ID, true))
+
+	valid, err := h.verifyMFACode(userCtx, setting, in.Code, now)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if !valid {
+		h.ipLoginFailed(ip, now)
+
+		if err := h.userLoginFailed(userCtx, user, now); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+		}
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidMFACode))
+	}
+
+	if err := h.consumeJTI(userCtx, claims.ID, user.ID, mfaLoginReason, claims.ExpiresAt.Time); err != nil {
+		if errors.Is(err, ErrTokenRevoked) {
+			return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if setting.FailedLoginAttempts > 0 || setting.LockedUntil != nil {
+		if err := h.resetFailedLogins(userCtx, setting.ID); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+	}
+
+	access, refresh, err := h.createTokenPair(ctx, createClaims(user), nil)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	// set cookies on request with the access and refresh token
+	// when cookie domain is localhost, this is dropped but expected
+	if err := auth.SetAuthCookies(ctx, access, refresh, h.CookieDomain); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	if err := h.updateUserLastSeen(userCtx, user.ID); err != nil {
+		h.Logger.Errorw("unable to update last seen", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success"})
+}
+
+// verifyMFACode checks the code as a one-time passcode and then as a recovery code. An accepted passcode
+// records its time step so it cannot be replayed, and an accepted recovery code is removed; both are
+// conditional updates so concurrent requests with the same code cannot both succeed
+func (h *Handler) verifyMFACode(ctx context.Context, setting *ent.UserSetting, code string, now time.Time) (bool, error) {
+	if step, ok := otp.ValidateStep(code, *setting.TfaSecret, now); ok {
+		return h.recordTOTPStep(ctx, setting.ID, step)
+	}
+
+	remaining, ok := otp.ConsumeRecoveryCode(code, setting.RecoveryCodes)
+	if !ok {
+		return false, nil
+	}
+
+	return h.consumeRecoveryCode(ctx, setting.ID, otp.HashRecoveryCode(code), remaining)
+}
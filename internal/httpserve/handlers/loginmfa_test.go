@@ -0,0 +1,276 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/lockout"
+	"github.com/datumforge/datum/internal/otp"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+func TestMFALoginHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+
+	recoveryCodes, hashes := otp.GenerateRecoveryCodes(otp.RecoveryCodeCount)
+
+	// create user in the database with mfa enabled
+	validMFAUser := "summer@datum.net"
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SetIsTfaEnabled(true).
+		SetTfaSecret(secret).
+		SetRecoveryCodes(hashes).
+		SaveX(ec)
+
+	userMFA := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(validMFAUser).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.POST("login", h.LoginHandler)
+	e.POST("login/mfa", h.MFALoginHandler)
+
+	// mfaLogin completes the first login step, which returns an mfa pending token without setting the auth cookies
+	mfaLogin := func() string {
+		body, err := json.Marshal(handlers.LoginRequest{Username: validMFAUser, Password: validPassword})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+		recorder := httptest.NewRecorder()
+
+		e.ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Result().Cookies())
+
+		var loginOut struct {
+			Message string                    `json:"message"`
+			Data    handlers.MFARequiredReply `json:"data"`
+		}
+
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&loginOut))
+		assert.Equal(t, handlers.MFARequiredMessage, loginOut.Message)
+		require.NotEmpty(t, loginOut.Data.MFAToken)
+
+		return loginOut.Data.MFAToken
+	}
+
+	// each mfa token completes a single login
+	firstToken, secondToken, thirdToken := mfaLogin(), mfaLogin(), mfaLogin()
+
+	validCode, err := otp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	// an access token cannot be used as an mfa pending token
+	accessToken, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userMFA.ID},
+		UserID:           userMFA.ID,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		mfaToken       string
+		code           string
+		expectedErr    error
+		expectedStatus int
+	}{
+		{
+			name:           "happy path, valid passcode",
+			mfaToken:       firstToken,
+			code:           validCode,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "mfa token already used",
+			mfaToken:       firstToken,
+			code:           recoveryCodes[1],
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidCredentials,
+		},
+		{
+			name:           "passcode replayed",
+			mfaToken:       secondToken,
+			code:           validCode,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidMFACode,
+		},
+		{
+			name:           "happy path, recovery code",
+			mfaToken:       secondToken,
+			code:           recoveryCodes[0],
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "recovery code already used",
+			mfaToken:       thirdToken,
+			code:           recoveryCodes[0],
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidMFACode,
+		},
+		{
+			name:           "invalid passcode",
+			mfaToken:       thirdToken,
+			code:           "000000",
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidMFACode,
+		},
+		{
+			name:           "access token instead of mfa token",
+			mfaToken:       accessToken,
+			code:           validCode,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidCredentials,
+		},
+		{
+			name:           "missing code",
+			mfaToken:       thirdToken,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    &handlers.MissingRequiredFieldError{RequiredField: "code"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(handlers.MFALoginRequest{MFAToken: tc.mfaToken, Code: tc.code})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/login/mfa", strings.NewReader(string(body)))
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *handlers.Response
+
+			// parse request body
+			if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+				t.Error("error parsing response", err)
+			}
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, out.Message, "success")
+				assert.NotEmpty(t, res.Cookies())
+			} else {
+				assert.Contains(t, out.Message, tc.expectedErr.Error())
+			}
+		})
+	}
+
+	// cleanup after
+	EntClient.User.DeleteOneID(userMFA.ID).ExecX(ctx)
+}
+
+func TestMFALoginHandlerLockout(t *testing.T) {
+	h := handlerSetup(t)
+
+	l, err := lockout.New(lockout.Config{
+		Enabled:       true,
+		Threshold:     2,
+		LockThreshold: 4,
+		IPThreshold:   100,
+		BaseDelay:     time.Hour,
+		MaxDelay:      2 * time.Hour,
+		IPResetAfter:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	h.Lockout = l
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SetIsTfaEnabled(true).
+		SetTfaSecret(secret).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("morty@datum.net").
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	mfaToken, err := h.TM.CreateMFAPendingToken(user.ID)
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.POST("login/mfa", h.MFALoginHandler)
+
+	mfaLogin := func(code string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(handlers.MFALoginRequest{MFAToken: mfaToken, Code: code})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login/mfa", strings.NewReader(string(body)))
+		recorder := httptest.NewRecorder()
+
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	// failed passcodes are counted like failed passwords
+	recorder := mfaLogin("000000")
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = mfaLogin("000001")
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	setting := EntClient.UserSetting.GetX(entcache.Skip(ctx), userSetting.ID)
+	assert.Equal(t, 2, setting.FailedLoginAttempts)
+	require.NotNil(t, setting.LockedUntil)
+
+	// once throttled even a valid passcode is rejected until the delay has passed
+	validCode, err := otp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	recorder = mfaLogin(validCode)
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "3600", recorder.Header().Get("Retry-After"))
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+const logoutReason = "logout"
+
+// LogoutRequest optionally holds the refresh token to revoke when it is not sent as a cookie
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LogoutHandler revokes the access and refresh tokens presented on the request so they can no
+// longer be used to authenticate or refresh, clears the auth cookies and destroys the session
+func (h *Handler) LogoutHandler(ctx echo.Context) error {
+	var in LogoutRequest
+
+	// the refresh token may be sent as a cookie instead, an empty body is allowed
+	if ctx.Request().ContentLength > 0 {
+		if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+		}
+	}
+
+	if in.RefreshToken == "" {
+		in.RefreshToken, _ = auth.GetRefreshToken(ctx)
+	}
+
+	presented := []string{in.RefreshToken}
+
+	if access, err := auth.GetAccessToken(ctx); err == nil {
+		presented = append(presented, access)
+	}
+
+	// the access and refresh token of a pair share the same jti, so only revoke each jti once
+	revoke := map[string]*tokens.Claims{}
+
+	for _, tk := range presented {
+		if tk == "" {
+			continue
+		}
+
+		// expired tokens can still be revoked, only the signature is verified
+		claims, err := h.TM.Parse(tk)
+		if err != nil {
+			h.Logger.Errorw("error parsing token", "error", err)
+
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+		}
+
+		revoke[claims.ID] = claims
+	}
+
+	if len(revoke) == 0 {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("refresh_token")))
+	}
+
+	for _, claims := range revoke {
+		if err := h.revokeToken(ctx.Request().Context(), claims, logoutReason); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+	}
+
+	auth.ClearAuthCookies(ctx, h.CookieDomain)
+
+	if err := h.SM.Destroy(ctx.Request().Context()); err != nil {
+		h.Logger.Errorw("error destroying session", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success"})
+}
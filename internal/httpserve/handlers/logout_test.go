@@ -0,0 +1,146 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+func TestLogoutHandler(t *testing.T) {
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	if err != nil {
+		t.Error("error creating token manager")
+	}
+
+	h := handlers.Handler{
+		TM:           tm,
+		DBClient:     EntClient,
+		Logger:       zap.NewNop().Sugar(),
+		CookieDomain: "datum.net",
+		SM:           scs.New(),
+	}
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ec = privacy.DecisionContext(ec, privacy.Allow)
+
+	userID := ulids.New().String()
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(gofakeit.Password(true, true, true, true, false, 20)).
+		SetSetting(userSetting).
+		SetID(userID).
+		SetSub(userID).
+		SaveX(ec)
+
+	access, refresh, err := tm.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID,
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.POST("logout", h.LogoutHandler)
+	e.POST("refresh", h.RefreshHandler)
+	e.GET("protected", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, handlers.Response{Message: "success"})
+	}, auth.Authenticate(auth.WithValidator(tm), auth.WithRevocationChecker(&h)))
+
+	doRequest := func(method, path, token, body string) (int, string) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		var out *handlers.Response
+		if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+			t.Error("error parsing response", err)
+		}
+
+		return recorder.Code, out.Message
+	}
+
+	refreshBody := func(token string) string {
+		body, err := json.Marshal(handlers.RefreshRequest{RefreshToken: token})
+		require.NoError(t, err)
+
+		return string(body)
+	}
+
+	// the tokens are valid before logging out
+	status, _ := doRequest(http.MethodGet, "/protected", access, "")
+	assert.Equal(t, http.StatusOK, status)
+
+	t.Run("no tokens", func(t *testing.T) {
+		status, msg := doRequest(http.MethodPost, "/logout", "", "")
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, msg, "refresh_token is required")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		status, _ := doRequest(http.MethodPost, "/logout", "", refreshBody("notatoken"))
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		status, msg := doRequest(http.MethodPost, "/logout", access, refreshBody(refresh))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "success", msg)
+	})
+
+	t.Run("logging out again is a no-op", func(t *testing.T) {
+		status, _ := doRequest(http.MethodPost, "/logout", access, refreshBody(refresh))
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("revoked refresh token is rejected", func(t *testing.T) {
+		status, msg := doRequest(http.MethodPost, "/refresh", "", refreshBody(refresh))
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Contains(t, msg, "token has been revoked")
+	})
+
+	t.Run("revoked access token is rejected", func(t *testing.T) {
+		status, _ := doRequest(http.MethodGet, "/protected", access, "")
+		assert.NotEqual(t, http.StatusOK, status)
+	})
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ec)
+}
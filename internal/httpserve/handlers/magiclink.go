@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/cenkalti/backoff/v4"
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/marionette"
+)
+
+// MagicLinkRequest contains the email address of the user requesting a login link
+type MagicLinkRequest struct {
+	Email string `json:"email"`
+}
+
+// MagicLinkRequestHandler sends an email with a single-use login link if the provided email
+// belongs to an active user, a 204 is returned either way to avoid exposing which users exist
+func (h *Handler) MagicLinkRequestHandler(ctx echo.Context) error {
+	var in *MagicLinkRequest
+
+	// parse request body
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+		h.Logger.Errorw("error parsing request", "error", err)
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+	}
+
+	if in.Email == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("email")))
+	}
+
+	entUser, err := h.getUserByEmail(ctx.Request().Context(), in.Email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// return a 204 response even if user is not found to avoid
+			// exposing confidential information
+			return ctx.NoContent(http.StatusNoContent)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// locked and inactive users cannot log in, so no link is sent
+	if checkAccountUsable(entUser) != nil {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	user := &User{
+		FirstName: entUser.FirstName,
+		LastName:  entUser.LastName,
+		Email:     entUser.Email,
+		ID:        entUser.ID,
+	}
+
+	viewerCtx := viewer.NewContext(ctx.Request().Context(), viewer.NewUserViewerFromID(entUser.ID, true))
+
+	if _, err = h.storeAndSendMagicLinkToken(viewerCtx, user); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// MagicLinkLoginHandler exchanges the token of a login link for the access and refresh tokens of
+// the user, the token can only be used once. Following the link proves the user owns the email
+// address, so an unconfirmed email address is confirmed
+func (h *Handler) MagicLinkLoginHandler(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	mt, err := h.getMagicLinkToken(ctx.Request().Context(), reqToken)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrMagicLinkTokenInvalid))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	entUser := mt.Edges.Owner
+
+	// the link is only valid for the email address it was sent to
+	if entUser.Email != mt.Email {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrMagicLinkTokenInvalid))
+	}
+
+	// construct token from db fields
+	t := &tokens.VerificationToken{
+		Email: mt.Email,
+		SigningInfo: tokens.SigningInfo{
+			ExpiresAt: *mt.TTL,
+		},
+	}
+
+	// Verify the token with the stored secret
+	if err := t.Verify(reqToken, *mt.Secret); err != nil {
+		if errors.Is(err, tokens.ErrTokenExpired) {
+			out := &Response{
+				Message: "login link is expired or was already used, please request a new link",
+			}
+
+			return ctx.JSON(http.StatusBadRequest, out)
+		}
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrMagicLinkTokenInvalid))
+	}
+
+	consumed, err := h.consumeMagicLinkToken(ctx.Request().Context(), mt)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if !consumed {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrMagicLinkTokenInvalid))
+	}
+
+	if err := checkAccountUsable(entUser); err != nil {
+		return ctx.JSON(accountErrorStatus(err, http.StatusBadRequest), ErrorResponse(err))
+	}
+
+	setting := entUser.Edges.Setting
+
+	viewerCtx := viewer.NewContext(ctx.Request().Context(), viewer.NewUserViewerFromID(entUser.ID, true))
+
+	if !setting.EmailConfirmed {
+		if err := h.setEmailConfirmed(viewerCtx, entUser); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+	}
+
+	if setting.FailedLoginAttempts > 0 || setting.LockedUntil != nil {
+		if err := h.resetFailedLogins(viewerCtx, setting.ID); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+	}
+
+	return h.loginUser(ctx, entUser)
+}
+
+func (h *Handler) storeAndSendMagicLinkToken(ctx context.Context, user *User) (*ent.MagicLinkToken, error) {
+	if err := h.expireAllMagicLinkTokensUserByEmail(ctx, user.Email); err != nil {
+		h.Logger.Errorw("error expiring existing tokens", "error", err)
+
+		return nil, err
+	}
+
+	if err := user.CreateMagicLinkToken(); err != nil {
+		h.Logger.Errorw("unable to create magic link token", "error", err)
+		return nil, err
+	}
+
+	meowtoken, err := h.createMagicLinkToken(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// send emails via TaskMan as to not create blocking operations in the server
+	if err := h.TaskMan.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+		return h.SendMagicLinkEmail(user)
+	}), marionette.WithRetries(3), //nolint: gomnd
+		marionette.WithBackoff(backoff.NewExponentialBackOff()),
+		marionette.WithErrorf("could not send magic link email to user %s", user.ID),
+	); err != nil {
+		return nil, err
+	}
+
+	return meowtoken, nil
+}
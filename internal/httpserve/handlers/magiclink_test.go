@@ -0,0 +1,319 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/rShetty/asyncwait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ariga.io/entcache"
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/utils/emails"
+	"github.com/datumforge/datum/internal/utils/emails/mock"
+)
+
+func TestMagicLinkRequestHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// create users in the database
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	u := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("hoid@datum.net").
+		SetPassword(validPassword).
+		SetSetting(EntClient.UserSetting.Create().SetEmailConfirmed(true).SaveX(ctx)).
+		SaveX(ctx)
+
+	locked := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("wit@datum.net").
+		SetPassword(validPassword).
+		SetSetting(EntClient.UserSetting.Create().SetEmailConfirmed(true).SetLocked(true).SaveX(ctx)).
+		SaveX(ctx)
+
+	testCases := []struct {
+		name               string
+		email              string
+		emailExpected      bool
+		expectedErrMessage string
+		expectedStatus     int
+	}{
+		{
+			name:           "happy path",
+			email:          "hoid@datum.net",
+			emailExpected:  true,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "email does not exist, should still return 204",
+			email:          "hoid1@datum.net",
+			emailExpected:  false,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "locked user, should still return 204",
+			email:          "wit@datum.net",
+			emailExpected:  false,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:               "email not sent in request",
+			emailExpected:      false,
+			expectedErrMessage: "email",
+			expectedStatus:     http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sent := time.Now()
+			mock.ResetEmailMock()
+
+			e := setupEcho(h.SM)
+			e.POST("login/magic", h.MagicLinkRequestHandler)
+
+			body, err := json.Marshal(handlers.MagicLinkRequest{Email: tc.email})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/login/magic", strings.NewReader(string(body)))
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusNoContent {
+				var out *handlers.Response
+
+				// parse request body
+				if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+					t.Error("error parsing response", err)
+				}
+
+				assert.Contains(t, out.Message, tc.expectedErrMessage)
+			}
+
+			messages := []*mock.EmailMetadata{
+				{
+					To:        tc.email,
+					From:      h.SendGridConfig.FromEmail,
+					Subject:   emails.MagicLinkRE,
+					Timestamp: sent,
+				},
+			}
+
+			// wait for messages
+			predicate := func() bool {
+				return h.TaskMan.GetQueueLength() == 0
+			}
+			successful := asyncwait.NewAsyncWait(maxWaitInMillis, pollIntervalInMillis).Check(predicate)
+
+			if successful != true {
+				t.Errorf("max wait of email send")
+			}
+
+			if tc.emailExpected {
+				mock.CheckEmails(t, messages)
+			} else {
+				mock.CheckEmails(t, nil)
+			}
+		})
+	}
+
+	// cleanup after
+	EntClient.User.DeleteOneID(u.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(locked.ID).ExecX(ctx)
+}
+
+func TestMagicLinkLoginHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	testCases := []struct {
+		name           string
+		email          string
+		ttl            string
+		tokenProvided  string
+		locked         bool
+		useTwice       bool
+		expectedResp   string
+		expectedStatus int
+	}{
+		{
+			name:           "happy path",
+			email:          "khriss@datum.net",
+			expectedResp:   "success",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "token already used",
+			email:          "nazh@datum.net",
+			useTwice:       true,
+			expectedResp:   "login link is expired or was already used",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "expired token",
+			email:          "frost@datum.net",
+			ttl:            "1987-08-16T03:04:11.169086-07:00",
+			expectedResp:   "login link is expired",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "bad token",
+			email:          "taln@datum.net",
+			tokenProvided:  "thisisnotavalidtoken",
+			expectedResp:   "login link token invalid",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "locked user",
+			email:          "vasher@datum.net",
+			locked:         true,
+			expectedResp:   "account is locked",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// create echo context with middleware
+			e := setupEcho(h.SM)
+			e.GET("login/magic", h.MagicLinkLoginHandler)
+
+			mt, userID, err := createUserWithMagicLinkToken(ec, tc.email, tc.ttl, tc.locked)
+			require.NoError(t, err)
+
+			token := mt.Token
+			if tc.tokenProvided != "" {
+				token = tc.tokenProvided
+			}
+
+			target := fmt.Sprintf("/login/magic?token=%s", token)
+
+			var recorder *httptest.ResponseRecorder
+
+			attempts := 1
+			if tc.useTwice {
+				attempts = 2
+			}
+
+			for i := 0; i < attempts; i++ {
+				req := httptest.NewRequest(http.MethodGet, target, nil)
+
+				// Set writer for tests that write on the response
+				recorder = httptest.NewRecorder()
+
+				// Using the ServerHTTP on echo will trigger the router and middleware
+				e.ServeHTTP(recorder, req)
+			}
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var out *handlers.Response
+
+			// parse request body
+			if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+				t.Error("error parsing response", err)
+			}
+
+			assert.Contains(t, out.Message, tc.expectedResp)
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.NotEmpty(t, res.Cookies())
+
+				// the email address is confirmed by following the link
+				ctx := privacy.DecisionContext(ec, privacy.Allow)
+				user, err := EntClient.User.Get(entcache.Skip(ctx), userID)
+				require.NoError(t, err)
+
+				setting, err := user.Setting(entcache.Skip(ctx))
+				require.NoError(t, err)
+				assert.True(t, setting.EmailConfirmed)
+			}
+
+			// cleanup after
+			ctx := privacy.DecisionContext(ec, privacy.Allow)
+			EntClient.User.DeleteOneID(userID).ExecX(ctx)
+		})
+	}
+}
+
+// createUserWithMagicLinkToken creates a user with an unconfirmed email and a magic link token and returns the
+// token, user id, and error if one occurred
+func createUserWithMagicLinkToken(ec context.Context, email, ttl string, locked bool) (*ent.MagicLinkToken, string, error) {
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(false).
+		SetLocked(locked).
+		SaveX(ctx)
+
+	u := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	user := handlers.User{
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+		ID:        u.ID,
+	}
+
+	// create token
+	if err := user.CreateMagicLinkToken(); err != nil {
+		return nil, "", err
+	}
+
+	// set expiry if provided in test case
+	if ttl != "" {
+		user.MagicLinkExpires.String = ttl
+	}
+
+	exp, err := user.GetMagicLinkExpires()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// store token in db
+	return EntClient.MagicLinkToken.Create().
+		SetOwner(u).
+		SetToken(user.MagicLinkToken.String).
+		SetEmail(user.Email).
+		SetSecret(user.MagicLinkSecret).
+		SetTTL(exp).
+		SaveX(ctx), user.ID, nil
+}
@@ -0,0 +1,608 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	echo "github.com/datumforge/echox"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/oauth2"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/privacy/token"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/transaction"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/store"
+)
+
+const (
+	// oidcDiscoveryPath is appended to the provider url to fetch the provider configuration
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	// oauthFlowCookie holds the oauth flow state between the login and callback requests, the provider redirects
+	// the user back cross-site so this cannot be stored in the strict session cookie
+	oauthFlowCookie = "oauth_flow"
+	// oauthFlowMaxAge is the number of seconds the user has to authenticate with the provider
+	oauthFlowMaxAge = 300
+
+	oauthStateLength = 32
+
+	// oauthSubjectSeparator separates the provider name from the provider subject in the subject of oauth users
+	oauthSubjectSeparator = "|"
+
+	// oauthKeysRefreshInterval is the minimum time between fetches of the provider signing keys when an id token
+	// is signed with an unknown key
+	oauthKeysRefreshInterval = time.Minute
+)
+
+// OauthProvider contains the settings to authenticate users with an external OIDC provider
+type OauthProvider struct {
+	// Name of the provider, used in the login and callback paths
+	Name string
+	// ProviderURL is the base url used to discover the provider configuration
+	ProviderURL string
+	// IssuerURL is the expected issuer of the id token, defaults to the ProviderURL
+	IssuerURL string
+	// Config is the oauth2 client configuration, endpoints are set on discovery
+	Config oauth2.Config
+	// Options are added as query params when redirecting to the provider
+	Options map[string]interface{}
+	// HTTPClient used to reach the provider, defaults to http.DefaultClient
+	HTTPClient *http.Client
+
+	mu              sync.Mutex
+	discovery       *oidcDiscovery
+	keys            jwk.Set
+	keysRefreshedAt time.Time
+}
+
+// oidcDiscovery contains the fields used from the provider openid-configuration
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oauthFlow is the state of an authorization code flow stored between the login and callback requests
+type oauthFlow struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Provider string `json:"provider"`
+}
+
+// OauthUserInfo contains the user details returned in the provider id token
+type OauthUserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Picture       string `json:"picture"`
+}
+
+// NewOauthProvider returns a new OauthProvider, provider endpoints are discovered on first use
+func NewOauthProvider(name, providerURL, issuerURL, clientID, clientSecret, callbackURL string, scopes []string, options map[string]interface{}) *OauthProvider {
+	if issuerURL == "" {
+		issuerURL = providerURL
+	}
+
+	return &OauthProvider{
+		Name:        name,
+		ProviderURL: providerURL,
+		IssuerURL:   issuerURL,
+		Config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  callbackURL,
+			Scopes:       scopes,
+		},
+		Options: options,
+	}
+}
+
+// OauthLoginHandler redirects the user to the external provider to start the authorization code flow
+// the state, nonce and PKCE verifier are stored in a cookie to be validated on the callback
+func (h *Handler) OauthLoginHandler(ctx echo.Context) error {
+	p, err := h.getOauthProvider(ctx.PathParam("provider"))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(err))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	conf, err := p.oauthConfig(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to discover oauth provider", "provider", p.Name, "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	flow := oauthFlow{
+		State:    keygen.AlphaNumeric(oauthStateLength),
+		Nonce:    keygen.AlphaNumeric(oauthStateLength),
+		Verifier: oauth2.GenerateVerifier(),
+		Provider: p.Name,
+	}
+
+	body, err := json.Marshal(flow)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	store.SetCookieB64(ctx.Response().Writer, body, oauthFlowCookie, oauthFlowCookieConfig())
+
+	opts := []oauth2.AuthCodeOption{
+		oauth2.S256ChallengeOption(flow.Verifier),
+		oauth2.SetAuthURLParam("nonce", flow.Nonce),
+	}
+
+	for k, v := range p.Options {
+		opts = append(opts, oauth2.SetAuthURLParam(k, fmt.Sprintf("%v", v)))
+	}
+
+	return ctx.Redirect(http.StatusFound, conf.AuthCodeURL(flow.State, opts...))
+}
+
+// OauthCallbackHandler completes the authorization code flow with the external provider, the user
+// is linked by subject or email, or created if they do not exist, and the auth cookies are set; users
+// with multi-factor authentication enabled are returned a pending token to complete the login instead
+func (h *Handler) OauthCallbackHandler(ctx echo.Context) error {
+	p, err := h.getOauthProvider(ctx.PathParam("provider"))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(err))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	if e := ctx.QueryParam("error"); e != "" {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(fmt.Sprintf("%s: %s", e, ctx.QueryParam("error_description"))))
+	}
+
+	// the flow state can only be used once
+	flow := readOauthFlow(ctx.Request())
+	store.RemoveCookie(ctx.Response().Writer, oauthFlowCookie, oauthFlowCookieConfig())
+
+	if flow.State == "" || flow.State != ctx.QueryParam("state") || flow.Provider != p.Name {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrInvalidOauthState))
+	}
+
+	code := ctx.QueryParam("code")
+	if code == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("code")))
+	}
+
+	conf, err := p.oauthConfig(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to discover oauth provider", "provider", p.Name, "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	oauthToken, err := conf.Exchange(p.clientContext(reqCtx), code, oauth2.VerifierOption(flow.Verifier))
+	if err != nil {
+		h.Logger.Errorw("unable to exchange oauth code", "provider", p.Name, "error", err)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	info, err := p.verifyIDToken(reqCtx, oauthToken, flow.Nonce)
+	if err != nil {
+		h.Logger.Errorw("unable to verify id token", "provider", p.Name, "error", err)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	user, err := h.linkOrCreateOauthUser(reqCtx, p.Name, info)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOauthIdentityConflict):
+			return ctx.JSON(http.StatusConflict, ErrorResponse(err))
+		case errors.Is(err, ErrProcessingRequest):
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+		default:
+			return ctx.JSON(accountErrorStatus(err, http.StatusBadRequest), ErrorResponse(err))
+		}
+	}
+
+	if err := h.SM.RenewToken(reqCtx); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	if user.Edges.Setting.IsTfaEnabled {
+		return h.mfaRequired(ctx, user)
+	}
+
+	// set context for remaining request based on logged in user
+	userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(user.ID, true))
+
+	claims := createClaims(user)
+
+	access, refresh, err := h.createTokenPair(ctx, claims, nil)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	// set cookies on request with the access and refresh token
+	// when cookie domain is localhost, this is dropped but expected
+	if err := auth.SetAuthCookies(ctx, access, refresh, h.CookieDomain); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	if err := h.updateUserLastSeen(userCtx, user.ID); err != nil {
+		h.Logger.Errorw("unable to update last seen", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	h.SM.Put(reqCtx, "userID", user.ID)
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success"})
+}
+
+// getOauthProvider returns the configured provider by name
+func (h *Handler) getOauthProvider(name string) (*OauthProvider, error) {
+	p, ok := h.OauthProviders[name]
+	if !ok || p == nil {
+		return nil, ErrOauthProviderNotFound
+	}
+
+	return p, nil
+}
+
+// linkOrCreateOauthUser looks up the user by the provider subject and then by email, linking the
+// oauth login to the existing user; if no user exists a new one is created along with the personal org
+func (h *Handler) linkOrCreateOauthUser(ctx context.Context, provider string, info *OauthUserInfo) (*ent.User, error) {
+	if info.Email == "" {
+		return nil, newMissingRequiredFieldError("email")
+	}
+
+	if !info.EmailVerified {
+		return nil, ErrUnverifiedUser
+	}
+
+	// subjects are only unique per provider, and the subject of password users is their id
+	sub := oauthSubject(provider, info.Subject)
+
+	user, err := h.getUserBySub(ctx, sub)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, ErrProcessingRequest
+	}
+
+	linked := user != nil
+
+	if !linked {
+		user, err = h.getUserByEmail(ctx, info.Email)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, ErrProcessingRequest
+		}
+	}
+
+	if user == nil {
+		return h.createOauthUser(ctx, sub, info)
+	}
+
+	if err := checkAccountUsable(user); err != nil {
+		return nil, err
+	}
+
+	if linked {
+		return user, nil
+	}
+
+	// the provider can reassign the email to another account, which must not sign in to the linked user
+	if h.oauthSubjectProvider(user.Sub) == provider {
+		return nil, ErrOauthIdentityConflict
+	}
+
+	viewerCtx := viewer.NewContext(ctx, viewer.NewUserViewerFromID(user.ID, true))
+
+	// the subject is stored unless the user is already linked to another provider, which keeps its subject
+	// and is linked by email on logins with this provider
+	if h.oauthSubjectProvider(user.Sub) != "" {
+		sub = user.Sub
+	}
+
+	if !user.Oauth || user.Sub != sub {
+		if user, err = h.setUserOauth(viewerCtx, user, sub); err != nil {
+			return nil, ErrProcessingRequest
+		}
+	}
+
+	return user, nil
+}
+
+// oauthSubject returns the subject of an oauth user, the provider subject qualified by the provider name
+func oauthSubject(provider, subject string) string {
+	return provider + oauthSubjectSeparator + subject
+}
+
+// oauthSubjectProvider returns the name of the configured provider the subject of the user belongs to, or
+// an empty string when the subject is not the subject of an oauth user
+func (h *Handler) oauthSubjectProvider(sub string) string {
+	provider, _, ok := strings.Cut(sub, oauthSubjectSeparator)
+	if !ok {
+		return ""
+	}
+
+	if _, ok := h.OauthProviders[provider]; !ok {
+		return ""
+	}
+
+	return provider
+}
+
+// createOauthUser creates a new user from the provider user info, the email is already verified by the provider
+func (h *Handler) createOauthUser(ctx context.Context, sub string, info *OauthUserInfo) (*ent.User, error) {
+	firstName, lastName := info.GivenName, info.FamilyName
+	if firstName == "" && lastName == "" {
+		firstName, lastName, _ = strings.Cut(info.Name, " ")
+	}
+
+	oauth := true
+
+	input := ent.CreateUserInput{
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     info.Email,
+		Sub:       &sub,
+		Oauth:     &oauth,
+	}
+
+	if info.Picture != "" {
+		input.AvatarRemoteURL = &info.Picture
+	}
+
+	ctxWithToken := token.NewContextWithSignUpToken(ctx, info.Email)
+
+	meowuser, err := h.createUser(ctxWithToken, input)
+	if err != nil {
+		if IsUniqueConstraintError(err) {
+			return nil, ErrDuplicate
+		}
+
+		return nil, ErrProcessingRequest
+	}
+
+	viewerCtx := viewer.NewContext(ctxWithToken, viewer.NewUserViewerFromID(meowuser.ID, true))
+
+	// the user is queried again to load the settings edge
+	meowuser, err = h.getUserBySub(viewerCtx, sub)
+	if err != nil {
+		return nil, ErrProcessingRequest
+	}
+
+	if err := h.setEmailConfirmed(viewerCtx, meowuser); err != nil {
+		return nil, ErrProcessingRequest
+	}
+
+	return meowuser, nil
+}
+
+// setUserOauth marks an existing user as having authenticated with an oauth provider and stores the subject
+func (h *Handler) setUserOauth(ctx context.Context, u *ent.User, sub string) (*ent.User, error) {
+	meowuser, err := transaction.FromContext(ctx).User.UpdateOneID(u.ID).
+		SetOauth(true).
+		SetSub(sub).
+		Save(ctx)
+	if err != nil {
+		h.Logger.Errorw("error linking oauth user", "error", err)
+
+		return nil, err
+	}
+
+	meowuser.Edges.Setting = u.Edges.Setting
+
+	return meowuser, nil
+}
+
+// clientContext adds the provider http client to the context used by the oauth2 library
+func (p *OauthProvider) clientContext(ctx context.Context) context.Context {
+	if p.HTTPClient == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, p.HTTPClient)
+}
+
+func (p *OauthProvider) httpClient() *http.Client {
+	if p.HTTPClient == nil {
+		return http.DefaultClient
+	}
+
+	return p.HTTPClient
+}
+
+// oauthConfig returns the oauth2 config with the endpoints discovered from the provider
+func (p *OauthProvider) oauthConfig(ctx context.Context) (*oauth2.Config, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := p.Config
+	conf.Endpoint = oauth2.Endpoint{
+		AuthURL:  d.AuthorizationEndpoint,
+		TokenURL: d.TokenEndpoint,
+	}
+
+	return &conf, nil
+}
+
+// discover fetches and caches the openid-configuration and signing keys of the provider
+func (p *OauthProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.ProviderURL, "/") + oidcDiscoveryPath
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrOauthDiscovery, resp.Status)
+	}
+
+	d := &oidcDiscovery{}
+	if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(d.Issuer, "/") != strings.TrimSuffix(p.IssuerURL, "/") {
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrOauthDiscovery, d.Issuer)
+	}
+
+	keys, err := jwk.Fetch(ctx, d.JWKSURI, jwk.WithHTTPClient(p.httpClient()))
+	if err != nil {
+		return nil, err
+	}
+
+	p.discovery = d
+	p.keys = keys
+
+	return d, nil
+}
+
+// signingKeys returns the signing keys of the provider, the keys are fetched again when the id token is signed
+// with a key that is not known, e.g. after the provider rotated its keys, at most once per refresh interval
+func (p *OauthProvider) signingKeys(ctx context.Context, d *oidcDiscovery, raw []byte) (jwk.Set, error) {
+	msg, err := jws.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, sig := range msg.Signatures() {
+		kid := sig.ProtectedHeaders().KeyID()
+		if _, ok := p.keys.LookupKeyID(kid); ok || kid == "" {
+			return p.keys, nil
+		}
+	}
+
+	if time.Since(p.keysRefreshedAt) < oauthKeysRefreshInterval {
+		return p.keys, nil
+	}
+
+	keys, err := jwk.Fetch(ctx, d.JWKSURI, jwk.WithHTTPClient(p.httpClient()))
+	if err != nil {
+		return nil, err
+	}
+
+	p.keys = keys
+	p.keysRefreshedAt = time.Now()
+
+	return keys, nil
+}
+
+// verifyIDToken validates the signature, issuer, audience and nonce of the id token returned
+// by the provider and returns the user info contained in the claims
+func (p *OauthProvider) verifyIDToken(ctx context.Context, t *oauth2.Token, nonce string) (*OauthUserInfo, error) {
+	raw, ok := t.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, ErrMissingIDToken
+	}
+
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := p.signingKeys(ctx, d, []byte(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := jwt.Parse([]byte(raw),
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithAudience(p.Config.ClientID),
+		jwt.WithClaimValue("nonce", nonce),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := parsed.AsMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &OauthUserInfo{}
+	if err := json.Unmarshal(b, info); err != nil {
+		return nil, err
+	}
+
+	if info.Subject == "" {
+		return nil, newMissingRequiredFieldError("sub")
+	}
+
+	return info, nil
+}
+
+// readOauthFlow returns the oauth flow state stored by the login request, the state is empty when the cookie
+// is missing or cannot be decoded
+func readOauthFlow(r *http.Request) oauthFlow {
+	var flow oauthFlow
+
+	cookie, err := store.GetCookie(r, oauthFlowCookie)
+	if err != nil {
+		return flow
+	}
+
+	body, err := base64.StdEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return flow
+	}
+
+	if err := json.Unmarshal(body, &flow); err != nil {
+		return oauthFlow{}
+	}
+
+	return flow
+}
+
+// oauthFlowCookieConfig returns the settings of the oauth flow cookie, same site is lax so the cookie is sent
+// when the provider redirects the user back to the callback, the session cookie stays strict
+func oauthFlowCookieConfig() store.Config {
+	return store.Config{
+		Path:     "/",
+		MaxAge:   oauthFlowMaxAge,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		HttpOnly: true,
+	}
+}
@@ -0,0 +1,484 @@
+package handlers_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+const (
+	testOauthClientID = "datum-test-client"
+	testOauthProvider = "test"
+)
+
+// testOIDCIssuer is a local stand-in for an OIDC provider used to test the oauth login flow
+type testOIDCIssuer struct {
+	server *httptest.Server
+	key    jwk.Key
+	public jwk.Set
+
+	mu    sync.Mutex
+	codes map[string]testOIDCGrant
+}
+
+// testOIDCGrant is the state kept by the stand-in issuer for an issued authorization code
+type testOIDCGrant struct {
+	challenge string
+	nonce     string
+	claims    map[string]interface{}
+}
+
+func newTestOIDCIssuer(t *testing.T) *testOIDCIssuer {
+	i := &testOIDCIssuer{
+		codes: map[string]testOIDCGrant{},
+	}
+
+	i.rotate(t, "test-kid")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 i.server.URL,
+			"authorization_endpoint": i.server.URL + "/authorize",
+			"token_endpoint":         i.server.URL + "/token",
+			"jwks_uri":               i.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+
+		_ = json.NewEncoder(w).Encode(i.public)
+	})
+	mux.HandleFunc("/token", i.token(t))
+
+	i.server = httptest.NewServer(mux)
+	t.Cleanup(i.server.Close)
+
+	return i
+}
+
+// rotate replaces the signing key of the issuer with a new key published with the key id
+func (i *testOIDCIssuer) rotate(t *testing.T, kid string) {
+	raw, err := rsa.GenerateKey(rand.Reader, 2048) // nolint: gomnd
+	require.NoError(t, err)
+
+	key, err := jwk.FromRaw(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, kid))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	pub, err := jwk.PublicKeyOf(key)
+	require.NoError(t, err)
+
+	set := jwk.NewSet()
+	require.NoError(t, set.AddKey(pub))
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.key = key
+	i.public = set
+}
+
+// authorize simulates the user authenticating with the provider, returning the code
+// that would be sent to the callback
+func (i *testOIDCIssuer) authorize(t *testing.T, authURL string, claims map[string]interface{}) string {
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+
+	q := u.Query()
+	require.Equal(t, testOauthClientID, q.Get("client_id"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+
+	code := gofakeit.UUID()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.codes[code] = testOIDCGrant{
+		challenge: q.Get("code_challenge"),
+		nonce:     q.Get("nonce"),
+		claims:    claims,
+	}
+
+	return code
+}
+
+// token exchanges the code for an id token after verifying the PKCE verifier
+func (i *testOIDCIssuer) token(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		i.mu.Lock()
+		grant, ok := i.codes[r.Form.Get("code")]
+		delete(i.codes, r.Form.Get("code"))
+		key := i.key
+		i.mu.Unlock()
+
+		sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+
+			return
+		}
+
+		idToken := jwt.New()
+		_ = idToken.Set(jwt.IssuerKey, i.server.URL)
+		_ = idToken.Set(jwt.AudienceKey, testOauthClientID)
+		_ = idToken.Set(jwt.IssuedAtKey, time.Now())
+		_ = idToken.Set(jwt.ExpirationKey, time.Now().Add(time.Hour))
+		_ = idToken.Set("nonce", grant.nonce)
+
+		for k, v := range grant.claims {
+			_ = idToken.Set(k, v)
+		}
+
+		signed, err := jwt.Sign(idToken, jwt.WithKey(jwa.RS256, key))
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": gofakeit.UUID(),
+			"token_type":   "Bearer",
+			"expires_in":   3600, // nolint: gomnd
+			"id_token":     string(signed),
+		})
+	}
+}
+
+func TestOauthHandlers(t *testing.T) {
+	h := handlerSetup(t)
+
+	issuer := newTestOIDCIssuer(t)
+
+	h.OauthProviders = map[string]*handlers.OauthProvider{
+		testOauthProvider: handlers.NewOauthProvider(testOauthProvider, issuer.server.URL, "", testOauthClientID, "",
+			"http://localhost:17608/v1/oauth/test/callback", []string{"openid", "profile", "email"}, nil),
+	}
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	// existing user that will be linked to the oauth login by email
+	existingEmail := "bsmith@datum.net"
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	existingUser := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(existingEmail).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	// existing user with multi-factor authentication enabled
+	mfaEmail := "mrnimbus@datum.net"
+
+	mfaSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SetIsTfaEnabled(true).
+		SaveX(ec)
+
+	mfaUser := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(mfaEmail).
+		SetPassword(validPassword).
+		SetSetting(mfaSetting).
+		SaveX(ctx)
+
+	newEmail := "squanchy@datum.net"
+	otherEmail := "unity@datum.net"
+
+	testCases := []struct {
+		name           string
+		provider       string
+		claims         map[string]interface{}
+		badState       bool
+		badCode        bool
+		rotateKeys     bool
+		mfaRequired    bool
+		expectedErr    error
+		expectedStatus int
+	}{
+		{
+			name:     "happy path, new user",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|12345",
+				"email":          newEmail,
+				"email_verified": true,
+				"given_name":     "Squanchy",
+				"family_name":    "Cat",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "happy path, returning user by sub",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|12345",
+				"email":          newEmail,
+				"email_verified": true,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "happy path, existing user linked by email",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|67890",
+				"email":          existingEmail,
+				"email_verified": true,
+				"name":           "Beth Smith",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "email linked to another account of the provider",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|11111",
+				"email":          existingEmail,
+				"email_verified": true,
+			},
+			expectedStatus: http.StatusConflict,
+			expectedErr:    handlers.ErrOauthIdentityConflict,
+		},
+		{
+			name:     "subject of another user is not linked to the user",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            existingUser.ID,
+				"email":          otherEmail,
+				"email_verified": true,
+				"name":           "Unity Hivemind",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "provider rotated the signing key",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|12345",
+				"email":          newEmail,
+				"email_verified": true,
+			},
+			rotateKeys:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "multi-factor authentication enabled",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|24680",
+				"email":          mfaEmail,
+				"email_verified": true,
+			},
+			mfaRequired:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "email not verified by provider",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|99999",
+				"email":          "jerry@datum.net",
+				"email_verified": false,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrUnverifiedUser,
+		},
+		{
+			name:     "state mismatch",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|12345",
+				"email":          newEmail,
+				"email_verified": true,
+			},
+			badState:       true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrInvalidOauthState,
+		},
+		{
+			name:     "code not issued by provider",
+			provider: testOauthProvider,
+			claims: map[string]interface{}{
+				"sub":            "oauth|12345",
+				"email":          newEmail,
+				"email_verified": true,
+			},
+			badCode:        true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidCredentials,
+		},
+		{
+			name:           "unknown provider",
+			provider:       "meow",
+			expectedStatus: http.StatusNotFound,
+			expectedErr:    handlers.ErrOauthProviderNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// create echo context with middleware
+			e := setupEcho(h.SM)
+			e.GET("oauth/:provider/login", h.OauthLoginHandler)
+			e.GET("oauth/:provider/callback", h.OauthCallbackHandler)
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/"+tc.provider+"/login", nil)
+			recorder := httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			if tc.expectedStatus == http.StatusNotFound {
+				assert.Equal(t, tc.expectedStatus, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), tc.expectedErr.Error())
+
+				return
+			}
+
+			require.Equal(t, http.StatusFound, recorder.Code)
+
+			// the flow state is kept in a lax cookie so it is sent on the redirect back from the provider
+			var flowCookie *http.Cookie
+			for _, c := range recorder.Result().Cookies() {
+				if c.Name == "oauth_flow" {
+					flowCookie = c
+				}
+			}
+
+			require.NotNil(t, flowCookie)
+			assert.Equal(t, http.SameSiteLaxMode, flowCookie.SameSite)
+			assert.True(t, flowCookie.HttpOnly)
+
+			if tc.rotateKeys {
+				issuer.rotate(t, gofakeit.UUID())
+			}
+
+			authURL := recorder.Header().Get("Location")
+			code := issuer.authorize(t, authURL, tc.claims)
+
+			u, err := url.Parse(authURL)
+			require.NoError(t, err)
+
+			state := u.Query().Get("state")
+
+			if tc.badState {
+				state = "not-the-right-state"
+			}
+
+			if tc.badCode {
+				code = "not-the-right-code"
+			}
+
+			callback := "/oauth/" + tc.provider + "/callback?" + url.Values{"code": {code}, "state": {state}}.Encode()
+			req = httptest.NewRequest(http.MethodGet, callback, nil)
+
+			// send the flow cookie set on the login request
+			for _, c := range recorder.Result().Cookies() {
+				req.AddCookie(c)
+			}
+
+			recorder = httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *handlers.Response
+
+			// parse request body
+			if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+				t.Error("error parsing response", err)
+			}
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				assert.Contains(t, out.Message, tc.expectedErr.Error())
+
+				return
+			}
+
+			cookies := map[string]bool{}
+			for _, c := range res.Cookies() {
+				cookies[c.Name] = true
+			}
+
+			// tokens are not issued until the one-time passcode is verified
+			if tc.mfaRequired {
+				assert.Equal(t, handlers.MFARequiredMessage, out.Message)
+				assert.False(t, cookies["access_token"])
+				assert.False(t, cookies["refresh_token"])
+
+				return
+			}
+
+			assert.Equal(t, "success", out.Message)
+			assert.True(t, cookies["access_token"])
+			assert.True(t, cookies["refresh_token"])
+
+			email := tc.claims["email"].(string)
+			oauthUser := EntClient.User.Query().WithSetting().Where(user.Email(email)).OnlyX(ctx)
+
+			assert.True(t, oauthUser.Oauth)
+			assert.True(t, oauthUser.Edges.Setting.EmailConfirmed)
+		})
+	}
+
+	// a new user should have been created with the provider subject qualified by the provider
+	oauthUser := EntClient.User.Query().Where(user.Email(newEmail)).OnlyX(ctx)
+	assert.Equal(t, testOauthProvider+"|oauth|12345", oauthUser.Sub)
+	assert.Equal(t, "Squanchy", oauthUser.FirstName)
+
+	// the subject is stored when the existing user is linked by email
+	linkedUser := EntClient.User.GetX(ctx, existingUser.ID)
+	assert.Equal(t, testOauthProvider+"|oauth|67890", linkedUser.Sub)
+
+	// a provider subject matching the subject of another user creates a new user
+	otherUser := EntClient.User.Query().Where(user.Email(otherEmail)).OnlyX(ctx)
+	assert.NotEqual(t, existingUser.ID, otherUser.ID)
+
+	// cleanup after
+	EntClient.User.DeleteOneID(existingUser.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(mfaUser.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(oauthUser.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(otherUser.ID).ExecX(ctx)
+}
@@ -0,0 +1,827 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+const (
+	// oauthCodeDuration is how long an authorization code can be exchanged for tokens
+	oauthCodeDuration = 10 * time.Minute
+	// oauthConsentConnectorID is the connector of the consent records created by the authorization server
+	oauthConsentConnectorID = "datum"
+	// oauthRefreshReuseReason is recorded when an oauth refresh token is exchanged more than once
+	oauthRefreshReuseReason = "oauth refresh token reuse"
+
+	responseTypeCode = "code"
+	tokenTypeBearer  = "Bearer"
+	pkceMethodS256   = "S256"
+
+	promptConsent = "consent"
+	promptNone    = "none"
+
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+	grantTypeClientCredentials = "client_credentials"
+)
+
+// AuthorizeRequest contains the parameters of an oauth authorization request, they are sent in the query
+// to start the authorization and in the body with the response of the user to the consent prompt
+type AuthorizeRequest struct {
+	ResponseType        string `json:"response_type" query:"response_type"`
+	ClientID            string `json:"client_id" query:"client_id"`
+	RedirectURI         string `json:"redirect_uri" query:"redirect_uri"`
+	Scope               string `json:"scope" query:"scope"`
+	State               string `json:"state" query:"state"`
+	Nonce               string `json:"nonce" query:"nonce"`
+	CodeChallenge       string `json:"code_challenge" query:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method" query:"code_challenge_method"`
+	Prompt              string `json:"prompt" query:"prompt"`
+}
+
+// AuthorizeConsentRequest is the response of the user to the consent prompt of an authorization request
+type AuthorizeConsentRequest struct {
+	AuthorizeRequest
+	// Approve is true when the user grants the requested scopes to the client
+	Approve bool `json:"approve"`
+}
+
+// AuthorizeReply contains the client and scopes the user is asked to consent to, or the url the user is
+// redirected to after responding to the consent prompt
+type AuthorizeReply struct {
+	ConsentRequired   bool     `json:"consent_required,omitempty"`
+	ClientName        string   `json:"client_name,omitempty"`
+	ClientDescription string   `json:"client_description,omitempty"`
+	Scopes            []string `json:"scopes,omitempty"`
+	RedirectTo        string   `json:"redirect_to,omitempty"`
+}
+
+// OauthTokenReply is the response of the token endpoint as defined by RFC 6749 and OpenID Connect
+type OauthTokenReply struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// UserInfoReply contains the claims about the authenticated user returned by the userinfo endpoint
+type UserInfoReply struct {
+	Subject string `json:"sub"`
+	tokens.UserInfo
+}
+
+// authorization is a validated authorization request of the authenticated user
+type authorization struct {
+	client              *ent.OauthClient
+	user                *ent.User
+	redirectURI         string
+	scopes              []string
+	state               string
+	nonce               string
+	codeChallenge       string
+	codeChallengeMethod string
+	prompt              string
+}
+
+// AuthorizeHandler starts the authorization of an oauth client by the logged in user. When the user already
+// consented to the requested scopes an authorization code is issued and the user is redirected back to the
+// client, otherwise the client and scopes are returned so the user can be asked for consent
+func (h *Handler) AuthorizeHandler(ctx echo.Context) error {
+	var in AuthorizeRequest
+
+	if err := echo.BindQueryParams(ctx, &in); err != nil {
+		return ctx.JSON(http.StatusBadRequest, newOauthError(oauthErrInvalidRequest, "invalid query parameters"))
+	}
+
+	user, status, err := h.authorizingUser(ctx)
+	if err != nil {
+		return ctx.JSON(status, ErrorResponse(err))
+	}
+
+	a, oerr := h.validateAuthorizeRequest(ctx, in, user)
+	if a == nil {
+		return ctx.JSON(oauthErrorStatus(oerr), oerr)
+	}
+
+	if oerr != nil {
+		return ctx.Redirect(http.StatusFound, oauthErrorRedirect(a, oerr))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	consent, err := h.getOauthConsent(reqCtx, a.client.ClientID, user.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if consent == nil || a.prompt == promptConsent || !containsScopes(consent.Scopes, a.scopes) {
+		if a.prompt == promptNone {
+			return ctx.Redirect(http.StatusFound, oauthErrorRedirect(a, newOauthError(oauthErrConsentRequired, "the user has not consented to the requested scopes")))
+		}
+
+		out := Response{
+			Message: "consent required",
+			Data: AuthorizeReply{
+				ConsentRequired:   true,
+				ClientName:        a.client.Name,
+				ClientDescription: a.client.Description,
+				Scopes:            a.scopes,
+			},
+		}
+
+		return ctx.JSON(http.StatusOK, out)
+	}
+
+	if err := h.touchOauthConsent(reqCtx, consent); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	redirectTo, err := h.issueAuthorizationCode(ctx, a)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.Redirect(http.StatusFound, redirectTo)
+}
+
+// AuthorizeConsentHandler records the response of the logged in user to the consent prompt. When the user
+// approves, the scopes are added to the consent of the user and an authorization code is issued; the url to
+// redirect the user back to the client, with the code or the error, is returned. The request body must be
+// json so the consent cannot be submitted by a cross site form
+func (h *Handler) AuthorizeConsentHandler(ctx echo.Context) error {
+	if !strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return ctx.JSON(http.StatusUnsupportedMediaType, ErrorResponse(ErrUnsupportedContentType))
+	}
+
+	var in AuthorizeConsentRequest
+
+	// parse request body
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+		h.Logger.Errorw("error parsing request", "error", err)
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+	}
+
+	user, status, err := h.authorizingUser(ctx)
+	if err != nil {
+		return ctx.JSON(status, ErrorResponse(err))
+	}
+
+	a, oerr := h.validateAuthorizeRequest(ctx, in.AuthorizeRequest, user)
+	if a == nil {
+		return ctx.JSON(oauthErrorStatus(oerr), oerr)
+	}
+
+	if oerr != nil {
+		return ctx.JSON(http.StatusOK, Response{Message: oerr.Code, Data: AuthorizeReply{RedirectTo: oauthErrorRedirect(a, oerr)}})
+	}
+
+	if !in.Approve {
+		oerr = newOauthError(oauthErrAccessDenied, "the user denied the authorization request")
+
+		return ctx.JSON(http.StatusOK, Response{Message: oerr.Code, Data: AuthorizeReply{RedirectTo: oauthErrorRedirect(a, oerr)}})
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	consent, err := h.getOauthConsent(reqCtx, a.client.ClientID, user.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if err := h.saveOauthConsent(reqCtx, a, consent); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	redirectTo, err := h.issueAuthorizationCode(ctx, a)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success", Data: AuthorizeReply{RedirectTo: redirectTo}})
+}
+
+// OauthTokenHandler is the token endpoint of the authorization server, the client authenticates with http
+// basic auth or the client_id and client_secret form parameters (public clients only send the client_id)
+// and exchanges an authorization code or refresh token for tokens of the user, or requests a token for
+// itself with the client credentials grant
+func (h *Handler) OauthTokenHandler(ctx echo.Context) error {
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	ctx.Response().Header().Set("Pragma", "no-cache")
+
+	client, oerr := h.authenticateOauthClient(ctx)
+	if oerr != nil {
+		return h.oauthErrorResponse(ctx, oerr)
+	}
+
+	var out *OauthTokenReply
+
+	switch grantType := ctx.FormValue("grant_type"); grantType {
+	case grantTypeAuthorizationCode:
+		out, oerr = h.exchangeAuthorizationCode(ctx, client)
+	case grantTypeRefreshToken:
+		out, oerr = h.exchangeOauthRefreshToken(ctx, client)
+	case grantTypeClientCredentials:
+		out, oerr = h.clientCredentialsGrant(ctx, client)
+	case "":
+		oerr = newOauthError(oauthErrInvalidRequest, "grant_type is required")
+	default:
+		oerr = newOauthError(oauthErrUnsupportedGrantType, "grant type "+grantType+" is not supported")
+	}
+
+	if oerr != nil {
+		return h.oauthErrorResponse(ctx, oerr)
+	}
+
+	return ctx.JSON(http.StatusOK, out)
+}
+
+// UserInfoHandler returns the claims about the authenticated user allowed by the scopes of the access token,
+// tokens issued to oauth clients must have the openid scope
+func (h *Handler) UserInfoHandler(ctx echo.Context) error {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(err))
+	}
+
+	if claims.IsService() {
+		return ctx.JSON(http.StatusForbidden, newOauthError(oauthErrInsufficientScope, "the token was not issued to a user"))
+	}
+
+	scopes := tokens.OpenIDScopes()
+
+	if claims.IsRestricted() {
+		if !slices.Contains(claims.Scopes, tokens.ScopeOpenID) {
+			return ctx.JSON(http.StatusForbidden, newOauthError(oauthErrInsufficientScope, "the openid scope is required"))
+		}
+
+		scopes = claims.Scopes
+	}
+
+	user, err := h.getUserByID(ctx.Request().Context(), claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusOK, UserInfoReply{Subject: user.ID, UserInfo: oauthUserInfo(user, scopes)})
+}
+
+// authorizingUser returns the active user authorizing the oauth client, only users that logged in can
+// authorize clients: api keys, personal access tokens and tokens issued to oauth clients are rejected
+func (h *Handler) authorizingUser(ctx echo.Context) (*ent.User, int, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+
+	if claims.IsService() || claims.ClientID != "" {
+		return nil, http.StatusForbidden, ErrOauthAuthorizeNotAllowed
+	}
+
+	// the access token is only missing from the request when the auth middleware refreshed the login from
+	// the refresh token cookie
+	if token, err := auth.GetAccessToken(ctx); err == nil && !auth.IsJWT(token) {
+		return nil, http.StatusForbidden, ErrOauthAuthorizeNotAllowed
+	}
+
+	user, err := h.getUserByID(ctx.Request().Context(), claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, http.StatusUnauthorized, ErrNoAuthUser
+		}
+
+		return nil, http.StatusInternalServerError, ErrProcessingRequest
+	}
+
+	// ensure the user is still active and not locked
+	if err := checkAccountUsable(user); err != nil {
+		return nil, accountErrorStatus(err, http.StatusUnauthorized), err
+	}
+
+	return user, http.StatusOK, nil
+}
+
+// validateAuthorizeRequest validates the authorization request against the registered client. When the
+// client or redirect uri are invalid no authorization is returned and the error must be shown to the user,
+// the user must not be redirected to an unverified uri. Any other error is returned with the authorization
+// and is sent to the client on the redirect uri
+func (h *Handler) validateAuthorizeRequest(ctx echo.Context, in AuthorizeRequest, user *ent.User) (*authorization, *OauthError) {
+	if in.ClientID == "" {
+		return nil, newOauthError(oauthErrInvalidRequest, "client_id is required")
+	}
+
+	client, err := h.getOauthClient(ctx.Request().Context(), in.ClientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newOauthError(oauthErrInvalidRequest, "client_id is not a registered client")
+		}
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	redirectURI := in.RedirectURI
+
+	// the redirect uri may be omitted when the client registered a single uri
+	if redirectURI == "" && len(client.RedirectUris) == 1 {
+		redirectURI = client.RedirectUris[0]
+	}
+
+	if !slices.Contains(client.RedirectUris, redirectURI) {
+		return nil, newOauthError(oauthErrInvalidRequest, "redirect_uri is not registered for the client")
+	}
+
+	a := &authorization{
+		client:              client,
+		user:                user,
+		redirectURI:         redirectURI,
+		state:               in.State,
+		nonce:               in.Nonce,
+		codeChallenge:       in.CodeChallenge,
+		codeChallengeMethod: in.CodeChallengeMethod,
+		prompt:              in.Prompt,
+	}
+
+	if in.ResponseType != responseTypeCode {
+		return a, newOauthError(oauthErrUnsupportedResponseType, "response_type must be code")
+	}
+
+	// the client is granted all of its scopes when none are requested
+	scopes := strings.Fields(in.Scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+
+	for _, s := range scopes {
+		if !slices.Contains(client.Scopes, s) {
+			return a, newOauthError(oauthErrInvalidScope, "scope "+s+" is not allowed for the client")
+		}
+	}
+
+	a.scopes = mergeScopes(nil, scopes)
+
+	if in.CodeChallenge == "" {
+		if client.Public {
+			return a, newOauthError(oauthErrInvalidRequest, "code_challenge is required for public clients")
+		}
+	} else if in.CodeChallengeMethod != pkceMethodS256 {
+		return a, newOauthError(oauthErrInvalidRequest, "code_challenge_method must be S256")
+	}
+
+	if in.Prompt != "" && in.Prompt != promptConsent && in.Prompt != promptNone {
+		return a, newOauthError(oauthErrInvalidRequest, "prompt must be consent or none")
+	}
+
+	return a, nil
+}
+
+// issueAuthorizationCode records a new authorization code for the authorization and returns the redirect uri
+// of the client with the code and state
+func (h *Handler) issueAuthorizationCode(ctx echo.Context, a *authorization) (string, error) {
+	code := keygen.Secret()
+
+	if err := h.createAuthorizationCode(ctx.Request().Context(), hashAuthorizationCode(code), a, time.Now().Add(oauthCodeDuration)); err != nil {
+		return "", err
+	}
+
+	return oauthRedirectURL(a.redirectURI, url.Values{"code": {code}, "state": {a.state}}), nil
+}
+
+// authenticateOauthClient returns the client authenticated on the token request, confidential clients must
+// present their secret and public clients must not
+func (h *Handler) authenticateOauthClient(ctx echo.Context) (*ent.OauthClient, *OauthError) {
+	clientID, secret, basic := ctx.Request().BasicAuth()
+	if basic {
+		// the client id and secret are form encoded before they are added to the basic auth header
+		var errID, errSecret error
+
+		clientID, errID = url.QueryUnescape(clientID)
+		secret, errSecret = url.QueryUnescape(secret)
+
+		if errID != nil || errSecret != nil {
+			return nil, newOauthError(oauthErrInvalidClient, "client authentication failed")
+		}
+	} else {
+		clientID = ctx.FormValue("client_id")
+		secret = ctx.FormValue("client_secret")
+	}
+
+	if clientID == "" {
+		return nil, newOauthError(oauthErrInvalidClient, "client authentication failed")
+	}
+
+	client, err := h.getOauthClient(ctx.Request().Context(), clientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newOauthError(oauthErrInvalidClient, "client authentication failed")
+		}
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	if client.Public {
+		if secret != "" {
+			return nil, newOauthError(oauthErrInvalidClient, "public clients do not have a secret")
+		}
+
+		return client, nil
+	}
+
+	if secret == "" || !keygen.VerifyOauthClientSecret(secret, client.ClientSecretHash) {
+		return nil, newOauthError(oauthErrInvalidClient, "client authentication failed")
+	}
+
+	return client, nil
+}
+
+// exchangeAuthorizationCode exchanges the authorization code issued to the client for a token pair of the
+// user, with an id token when the openid scope was granted. The code can only be exchanged once and the
+// code verifier must match the code challenge of the authorization request
+func (h *Handler) exchangeAuthorizationCode(ctx echo.Context, client *ent.OauthClient) (*OauthTokenReply, *OauthError) {
+	code := ctx.FormValue("code")
+	if code == "" {
+		return nil, newOauthError(oauthErrInvalidRequest, "code is required")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	ac, err := h.getAuthorizationCode(reqCtx, hashAuthorizationCode(code))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newOauthError(oauthErrInvalidGrant, "invalid authorization code")
+		}
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	if ac.ClientID != client.ID || ac.ExpiresAt.Before(time.Now()) {
+		return nil, newOauthError(oauthErrInvalidGrant, "invalid authorization code")
+	}
+
+	if redirectURI := ctx.FormValue("redirect_uri"); redirectURI != "" && redirectURI != ac.RedirectURI {
+		return nil, newOauthError(oauthErrInvalidGrant, "redirect_uri does not match the authorization request")
+	}
+
+	if ac.CodeChallenge != "" && !verifyCodeChallenge(ctx.FormValue("code_verifier"), ac.CodeChallenge) {
+		return nil, newOauthError(oauthErrInvalidGrant, "invalid code_verifier")
+	}
+
+	// the code may have been exchanged by a concurrent request since it was read
+	exchanged := false
+
+	if ac.UsedAt == nil {
+		if exchanged, err = h.markAuthorizationCodeUsed(reqCtx, ac); err != nil {
+			return nil, newOauthError(oauthErrServerError, "")
+		}
+	}
+
+	if !exchanged {
+		return nil, newOauthError(oauthErrInvalidGrant, "the authorization code has already been used")
+	}
+
+	user, oerr := h.oauthGrantUser(ctx, ac.UserID)
+	if oerr != nil {
+		return nil, oerr
+	}
+
+	return h.issueOauthTokens(ctx, client, user, ac.Scopes, ac.Nonce, nil)
+}
+
+// exchangeOauthRefreshToken exchanges a refresh token issued to the client for a new token pair, the scopes
+// may be narrowed but not extended. Refresh tokens are rotated the same as on login, presenting a refresh
+// token that was already exchanged revokes its token family
+func (h *Handler) exchangeOauthRefreshToken(ctx echo.Context, client *ent.OauthClient) (*OauthTokenReply, *OauthError) {
+	token := ctx.FormValue("refresh_token")
+	if token == "" {
+		return nil, newOauthError(oauthErrInvalidRequest, "refresh_token is required")
+	}
+
+	claims, err := h.TM.Verify(token)
+	if err != nil || claims.ClientID != client.ClientID {
+		return nil, newOauthError(oauthErrInvalidGrant, "invalid refresh token")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	revoked, err := h.IsRevoked(reqCtx, claims.ID)
+	if err != nil {
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	if revoked {
+		return nil, newOauthError(oauthErrInvalidGrant, ErrTokenRevoked.Error())
+	}
+
+	parent, err := h.getRefreshToken(reqCtx, claims.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newOauthError(oauthErrInvalidGrant, "invalid refresh token")
+		}
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	// the refresh token may have been exchanged by a concurrent request since it was read
+	exchanged := false
+
+	if parent.UsedAt == nil {
+		if exchanged, err = h.markRefreshTokenUsed(reqCtx, parent); err != nil {
+			return nil, newOauthError(oauthErrServerError, "")
+		}
+	}
+
+	if !exchanged {
+		h.Logger.Warnw("oauth refresh token reuse detected, revoking token family", "client_id", client.ClientID, "user_id", parent.UserID)
+
+		if err := h.revokeRefreshTokenFamily(reqCtx, parent.FamilyID, oauthRefreshReuseReason); err != nil {
+			return nil, newOauthError(oauthErrServerError, "")
+		}
+
+		return nil, newOauthError(oauthErrInvalidGrant, ErrRefreshTokenReused.Error())
+	}
+
+	scopes := claims.Scopes
+
+	if requested := strings.Fields(ctx.FormValue("scope")); len(requested) > 0 {
+		if !containsScopes(claims.Scopes, requested) {
+			return nil, newOauthError(oauthErrInvalidScope, "the requested scopes exceed the scopes of the refresh token")
+		}
+
+		scopes = mergeScopes(nil, requested)
+	}
+
+	user, oerr := h.oauthGrantUser(ctx, claims.Subject)
+	if oerr != nil {
+		return nil, oerr
+	}
+
+	return h.issueOauthTokens(ctx, client, user, scopes, "", parent)
+}
+
+// clientCredentialsGrant issues an access token to the confidential client itself, the token acts as a
+// service of the organization that owns the client and is limited to the api scopes of the client. There
+// is no user so no refresh token or id token is issued
+func (h *Handler) clientCredentialsGrant(ctx echo.Context, client *ent.OauthClient) (*OauthTokenReply, *OauthError) {
+	if client.Public {
+		return nil, newOauthError(oauthErrUnauthorizedClient, "public clients cannot use the client credentials grant")
+	}
+
+	apiScopes := []string{}
+
+	for _, s := range client.Scopes {
+		if slices.Contains(tokens.Scopes(), s) {
+			apiScopes = append(apiScopes, s)
+		}
+	}
+
+	scopes := apiScopes
+
+	if requested := strings.Fields(ctx.FormValue("scope")); len(requested) > 0 {
+		if !containsScopes(apiScopes, requested) {
+			return nil, newOauthError(oauthErrInvalidScope, "the requested scopes are not allowed for the client")
+		}
+
+		scopes = mergeScopes(nil, requested)
+	}
+
+	// a token without scopes is unrestricted, so the client must be allowed at least one api scope
+	if len(scopes) == 0 {
+		return nil, newOauthError(oauthErrInvalidScope, "the client is not allowed any api scopes")
+	}
+
+	claims := &tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: client.ID,
+		},
+		UserID:      client.ID,
+		OrgID:       client.OwnerID,
+		Scopes:      scopes,
+		SubjectType: tokens.SubjectTypeService,
+		ClientID:    client.ClientID,
+	}
+
+	atk, err := h.TM.CreateAccessToken(claims)
+	if err != nil {
+		h.Logger.Errorw("error creating access token", "error", err)
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	accessToken, err := h.TM.Sign(atk)
+	if err != nil {
+		h.Logger.Errorw("error signing access token", "error", err)
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	return &OauthTokenReply{
+		AccessToken: accessToken,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   expiresIn(claims),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// oauthGrantUser returns the active, unlocked user the grant was issued to
+func (h *Handler) oauthGrantUser(ctx echo.Context, userID string) (*ent.User, *OauthError) {
+	user, err := h.getUserByID(ctx.Request().Context(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newOauthError(oauthErrInvalidGrant, ErrNoAuthUser.Error())
+		}
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	if err := checkAccountUsable(user); err != nil {
+		return nil, newOauthError(oauthErrInvalidGrant, err.Error())
+	}
+
+	return user, nil
+}
+
+// issueOauthTokens creates the token pair of the user limited to the granted scopes, the parent is the
+// refresh token being exchanged or nil to start a new session. An id token is added when the openid scope
+// was granted
+func (h *Handler) issueOauthTokens(ctx echo.Context, client *ent.OauthClient, user *ent.User, scopes []string, nonce string, parent *ent.RefreshToken) (*OauthTokenReply, *OauthError) {
+	claims := createClaims(user)
+	claims.Scopes = scopes
+	claims.ClientID = client.ClientID
+
+	accessToken, refreshToken, err := h.createTokenPair(ctx, claims, parent)
+	if err != nil {
+		h.Logger.Errorw("error creating token pair", "error", err)
+
+		return nil, newOauthError(oauthErrServerError, "")
+	}
+
+	out := &OauthTokenReply{
+		AccessToken:  accessToken,
+		TokenType:    tokenTypeBearer,
+		ExpiresIn:    expiresIn(claims),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(scopes, " "),
+	}
+
+	if slices.Contains(scopes, tokens.ScopeOpenID) {
+		if out.IDToken, err = h.TM.CreateIDToken(user.ID, client.ClientID, nonce, oauthUserInfo(user, scopes)); err != nil {
+			h.Logger.Errorw("error creating id token", "error", err)
+
+			return nil, newOauthError(oauthErrServerError, "")
+		}
+	}
+
+	return out, nil
+}
+
+// oauthErrorResponse writes the oauth error of the token endpoint, clients that fail to authenticate are
+// challenged to use basic auth
+func (h *Handler) oauthErrorResponse(ctx echo.Context, oerr *OauthError) error {
+	if oerr.Code == oauthErrInvalidClient {
+		ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="datum"`)
+	}
+
+	return ctx.JSON(oauthErrorStatus(oerr), oerr)
+}
+
+// oauthErrorStatus returns the http status of the oauth error
+func oauthErrorStatus(oerr *OauthError) int {
+	switch oerr.Code {
+	case oauthErrInvalidClient:
+		return http.StatusUnauthorized
+	case oauthErrInsufficientScope:
+		return http.StatusForbidden
+	case oauthErrServerError:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// oauthUserInfo returns the claims about the user allowed by the scopes
+func oauthUserInfo(user *ent.User, scopes []string) tokens.UserInfo {
+	info := tokens.UserInfo{}
+
+	if slices.Contains(scopes, tokens.ScopeEmail) {
+		verified := user.Edges.Setting != nil && user.Edges.Setting.EmailConfirmed
+
+		info.Email = user.Email
+		info.EmailVerified = &verified
+	}
+
+	if slices.Contains(scopes, tokens.ScopeProfile) {
+		info.Name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+		info.GivenName = user.FirstName
+		info.FamilyName = user.LastName
+		info.PreferredUsername = user.DisplayName
+
+		if user.AvatarRemoteURL != nil {
+			info.Picture = *user.AvatarRemoteURL
+		}
+	}
+
+	return info
+}
+
+// oauthErrorRedirect returns the redirect uri of the authorization with the error and state
+func oauthErrorRedirect(a *authorization, oerr *OauthError) string {
+	return oauthRedirectURL(a.redirectURI, url.Values{
+		"error":             {oerr.Code},
+		"error_description": {oerr.Description},
+		"state":             {a.state},
+	})
+}
+
+// oauthRedirectURL adds the non-empty params to the query of the registered redirect uri, keeping the
+// query the uri was registered with
+func oauthRedirectURL(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+
+	q := u.Query()
+
+	for k, v := range params {
+		if len(v) > 0 && v[0] != "" {
+			q.Set(k, v[0])
+		}
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// hashAuthorizationCode returns the hash of the authorization code that is stored in the database
+func hashAuthorizationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyCodeChallenge verifies the PKCE code verifier against the S256 code challenge
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if verifier == "" {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+// containsScopes returns true when every requested scope is in the granted scopes
+func containsScopes(granted, requested []string) bool {
+	for _, s := range requested {
+		if !slices.Contains(granted, s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeScopes returns the scopes with the added scopes that are not already included
+func mergeScopes(scopes, added []string) []string {
+	merged := slices.Clone(scopes)
+
+	for _, s := range added {
+		if !slices.Contains(merged, s) {
+			merged = append(merged, s)
+		}
+	}
+
+	return merged
+}
+
+// expiresIn returns the seconds until the access token of the claims expires
+func expiresIn(claims *tokens.Claims) int64 {
+	if claims.ExpiresAt == nil {
+		return 0
+	}
+
+	return int64(time.Until(claims.ExpiresAt.Time).Seconds())
+}
@@ -0,0 +1,858 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+const oauthRedirectURI = "https://app.example.com/callback?tenant=acme"
+
+// createOauthClient creates an oauth client of the organization, the secret is empty for public clients
+func createOauthClient(ctx context.Context, t *testing.T, orgID string, public bool, scopes ...string) (*ent.OauthClient, string) {
+	clientID, secret := keygen.OauthClient()
+
+	create := EntClient.OauthClient.Create().
+		SetOwnerID(orgID).
+		SetName(gofakeit.AppName()).
+		SetClientID(clientID).
+		SetRedirectUris([]string{oauthRedirectURI}).
+		SetScopes(scopes).
+		SetPublic(public)
+
+	if public {
+		secret = ""
+	} else {
+		hash, err := keygen.HashOauthClientSecret(secret)
+		require.NoError(t, err)
+
+		create.SetClientSecretHash(hash)
+	}
+
+	return create.SaveX(ctx), secret
+}
+
+// createOauthUser creates an active user with a confirmed email and returns the user with a login access token
+func createOauthUser(ctx context.Context, t *testing.T, h *handlers.Handler) (*ent.User, string) {
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetDisplayName(gofakeit.Username()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	access, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID,
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+	})
+	require.NoError(t, err)
+
+	return user, access
+}
+
+// pkceChallenge returns the S256 code challenge of the verifier
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// oauthTokenRequest posts the form to the token endpoint and returns the recorder
+func oauthTokenRequest(t *testing.T, h http.Handler, form url.Values, clientID, secret string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return oauthClientRequest(t, h, "/oauth/token", form, clientID, secret)
+}
+
+// oauthClientRequest posts the form to the endpoint authenticated with the client credentials and returns the recorder
+func oauthClientRequest(t *testing.T, h http.Handler, target string, form url.Values, clientID, secret string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if clientID != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(secret))
+	}
+
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func TestOauthAuthorizationCodeFlow(t *testing.T) {
+	h := handlerSetup(t)
+
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	require.NoError(t, err)
+
+	h.TM = tm
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	user, userAccess := createOauthUser(ctx, t, h)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	client, secret := createOauthClient(ctx, t, org.ID, false,
+		tokens.ScopeOpenID, tokens.ScopeEmail, tokens.ScopeProfile, "org:read")
+
+	e := setupEcho(h.SM)
+	authMW := auth.Authenticate(auth.WithValidator(h.TM), auth.WithRevocationChecker(h))
+
+	e.GET("oauth/authorize", h.AuthorizeHandler, authMW)
+	e.POST("oauth/authorize", h.AuthorizeConsentHandler, authMW)
+	e.POST("oauth/token", h.OauthTokenHandler)
+	e.GET("userinfo", h.UserInfoHandler, authMW)
+	e.POST("refresh", h.RefreshHandler)
+
+	verifier := keygen.Secret()
+	scope := strings.Join([]string{tokens.ScopeOpenID, tokens.ScopeEmail, tokens.ScopeProfile}, " ")
+
+	authorizeParams := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {client.ClientID},
+		"redirect_uri":          {oauthRedirectURI},
+		"scope":                 {scope},
+		"state":                 {"xyz"},
+		"nonce":                 {"n-0S6_WzA2Mj"},
+		"code_challenge":        {pkceChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+
+	authorize := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+authorizeParams.Encode(), nil)
+		req.Header.Set("Authorization", "Bearer "+userAccess)
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	// the user is asked to consent before the client is authorized
+	recorder := authorize()
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var prompt struct {
+		Message string                  `json:"message"`
+		Data    handlers.AuthorizeReply `json:"data"`
+	}
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&prompt))
+	assert.True(t, prompt.Data.ConsentRequired)
+	assert.Equal(t, client.Name, prompt.Data.ClientName)
+	assert.ElementsMatch(t, strings.Fields(scope), prompt.Data.Scopes)
+
+	consent := func(contentType string, approve bool) *httptest.ResponseRecorder {
+		body, err := json.Marshal(handlers.AuthorizeConsentRequest{
+			AuthorizeRequest: handlers.AuthorizeRequest{
+				ResponseType:        "code",
+				ClientID:            client.ClientID,
+				RedirectURI:         oauthRedirectURI,
+				Scope:               scope,
+				State:               "xyz",
+				Nonce:               "n-0S6_WzA2Mj",
+				CodeChallenge:       pkceChallenge(verifier),
+				CodeChallengeMethod: "S256",
+			},
+			Approve: approve,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/authorize", strings.NewReader(string(body)))
+		req.Header.Set("Authorization", "Bearer "+userAccess)
+		req.Header.Set("Content-Type", contentType)
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	redirectOf := func(recorder *httptest.ResponseRecorder) url.Values {
+		var out struct {
+			Data handlers.AuthorizeReply `json:"data"`
+		}
+
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		u, err := url.Parse(out.Data.RedirectTo)
+		require.NoError(t, err)
+
+		assert.Equal(t, "app.example.com", u.Host)
+		assert.Equal(t, "/callback", u.Path)
+
+		return u.Query()
+	}
+
+	// the consent cannot be submitted by a form
+	recorder = consent("application/x-www-form-urlencoded", true)
+	assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+
+	// the user denies the client
+	recorder = consent("application/json", false)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	q := redirectOf(recorder)
+	assert.Equal(t, "access_denied", q.Get("error"))
+	assert.Equal(t, "xyz", q.Get("state"))
+	assert.Empty(t, q.Get("code"))
+
+	// the user approves the client, the consent is stored with the scopes
+	recorder = consent("application/json", true)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	q = redirectOf(recorder)
+	assert.Equal(t, "xyz", q.Get("state"))
+	assert.Equal(t, "acme", q.Get("tenant"), "the query of the registered redirect uri is kept")
+	require.NotEmpty(t, q.Get("code"))
+
+	record := EntClient.OhAuthTooToken.Query().
+		Where(
+			ohauthtootoken.ClientID(client.ClientID),
+			ohauthtootoken.ClaimsUserID(user.ID),
+		).
+		OnlyX(ctx)
+	assert.ElementsMatch(t, strings.Fields(scope), record.Scopes)
+	assert.Equal(t, user.Email, record.ClaimsEmail)
+
+	// once consented the user is redirected back to the client with a code
+	recorder = authorize()
+	require.Equal(t, http.StatusFound, recorder.Code)
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	require.NoError(t, err)
+
+	code := location.Query().Get("code")
+	require.NotEmpty(t, code)
+	assert.NotEqual(t, q.Get("code"), code)
+	assert.Equal(t, "xyz", location.Query().Get("state"))
+
+	exchange := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {oauthRedirectURI},
+		"code_verifier": {verifier},
+	}
+
+	tokenError := func(recorder *httptest.ResponseRecorder) string {
+		var out handlers.OauthError
+
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		return out.Code
+	}
+
+	// the code verifier must match the challenge
+	badVerifier := url.Values{}
+	for k, v := range exchange {
+		badVerifier[k] = v
+	}
+
+	badVerifier.Set("code_verifier", keygen.Secret())
+
+	recorder = oauthTokenRequest(t, e, badVerifier, client.ClientID, secret)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "invalid_grant", tokenError(recorder))
+
+	// the client must authenticate with its secret
+	recorder = oauthTokenRequest(t, e, exchange, client.ClientID, "notthesecret")
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Equal(t, "invalid_client", tokenError(recorder))
+	assert.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+
+	recorder = oauthTokenRequest(t, e, exchange, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "no-store", recorder.Header().Get("Cache-Control"))
+
+	var reply handlers.OauthTokenReply
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&reply))
+	assert.Equal(t, "Bearer", reply.TokenType)
+	assert.Greater(t, reply.ExpiresIn, int64(0))
+	assert.Equal(t, scope, reply.Scope)
+	require.NotEmpty(t, reply.AccessToken)
+	require.NotEmpty(t, reply.RefreshToken)
+	require.NotEmpty(t, reply.IDToken)
+
+	// the access token is limited to the granted scopes
+	claims, err := h.TM.Verify(reply.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, claims.UserID)
+	assert.Equal(t, client.ClientID, claims.ClientID)
+	assert.ElementsMatch(t, strings.Fields(scope), claims.Scopes)
+
+	// the id token is issued to the client with the nonce and claims allowed by the scopes
+	idClaims := &tokens.IDTokenClaims{}
+
+	_, err = jwt.ParseWithClaims(reply.IDToken, idClaims, func(token *jwt.Token) (interface{}, error) {
+		keys, err := h.TM.Keys()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := keys.LookupKeyID(token.Header["kid"].(string))
+		require.True(t, ok)
+
+		var raw interface{}
+
+		return raw, key.Raw(&raw)
+	}, jwt.WithAudience(client.ClientID), jwt.WithIssuer(h.TM.Issuer()))
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, idClaims.Subject)
+	assert.Equal(t, "n-0S6_WzA2Mj", idClaims.Nonce)
+	assert.Equal(t, user.Email, idClaims.Email)
+	assert.Equal(t, user.FirstName, idClaims.GivenName)
+
+	// the code can only be exchanged once
+	recorder = oauthTokenRequest(t, e, exchange, client.ClientID, secret)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "invalid_grant", tokenError(recorder))
+
+	// the userinfo endpoint returns the claims allowed by the scopes of the access token
+	req := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer "+reply.AccessToken)
+
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var info handlers.UserInfoReply
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&info))
+	assert.Equal(t, user.ID, info.Subject)
+	assert.Equal(t, user.Email, info.Email)
+	require.NotNil(t, info.EmailVerified)
+	assert.True(t, *info.EmailVerified)
+	assert.Equal(t, user.DisplayName, info.PreferredUsername)
+
+	// tokens issued to the client cannot be used to authorize clients
+	req = httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+authorizeParams.Encode(), nil)
+	req.Header.Set("Authorization", "Bearer "+reply.AccessToken)
+
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+	// oauth refresh tokens cannot be used to refresh a login
+	body, err := json.Marshal(handlers.RefreshRequest{RefreshToken: reply.RefreshToken})
+	require.NoError(t, err)
+
+	req = httptest.NewRequest(http.MethodPost, "/refresh", strings.NewReader(string(body)))
+
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// the refresh token is exchanged at the token endpoint, the scopes can be narrowed
+	refresh := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {reply.RefreshToken},
+		"scope":         {tokens.ScopeOpenID + " " + tokens.ScopeEmail},
+	}
+
+	recorder = oauthTokenRequest(t, e, refresh, client.ClientID, secret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var refreshed handlers.OauthTokenReply
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&refreshed))
+	assert.Equal(t, tokens.ScopeOpenID+" "+tokens.ScopeEmail, refreshed.Scope)
+	assert.NotEmpty(t, refreshed.IDToken)
+
+	refreshedClaims, err := h.TM.Verify(refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{tokens.ScopeOpenID, tokens.ScopeEmail}, refreshedClaims.Scopes)
+
+	// the refresh token cannot be exchanged again, the token family is revoked
+	recorder = oauthTokenRequest(t, e, refresh, client.ClientID, secret)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "invalid_grant", tokenError(recorder))
+
+	recorder = oauthTokenRequest(t, e, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshed.RefreshToken},
+	}, client.ClientID, secret)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "invalid_grant", tokenError(recorder))
+
+	// cleanup after
+	EntClient.OhAuthTooToken.DeleteOneID(record.ID).ExecX(ctx)
+	EntClient.OauthClient.DeleteOneID(client.ID).ExecX(ctx)
+	EntClient.Organization.DeleteOneID(org.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
+
+func TestAuthorizeHandlerErrors(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	user, userAccess := createOauthUser(ctx, t, h)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	confidential, _ := createOauthClient(ctx, t, org.ID, false, tokens.ScopeOpenID, tokens.ScopeEmail)
+	public, _ := createOauthClient(ctx, t, org.ID, true, tokens.ScopeOpenID)
+
+	serviceAccess, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ulids.New().String(),
+		},
+		OrgID:       org.ID,
+		SubjectType: tokens.SubjectTypeService,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.GET("oauth/authorize", h.AuthorizeHandler, auth.Authenticate(auth.WithValidator(h.TM)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		params         url.Values
+		expectedStatus int
+		expectedErr    string
+	}{
+		{
+			name:  "unknown client",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {"unknown"},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:  "redirect uri not registered",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {confidential.ClientID},
+				"redirect_uri":  {"https://evil.example.com/callback"},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:  "unsupported response type",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"token"},
+				"client_id":     {confidential.ClientID},
+			},
+			expectedStatus: http.StatusFound,
+			expectedErr:    "unsupported_response_type",
+		},
+		{
+			name:  "scope not allowed for the client",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {confidential.ClientID},
+				"scope":         {"openid profile"},
+			},
+			expectedStatus: http.StatusFound,
+			expectedErr:    "invalid_scope",
+		},
+		{
+			name:  "public client without pkce",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {public.ClientID},
+			},
+			expectedStatus: http.StatusFound,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:  "plain pkce method",
+			token: userAccess,
+			params: url.Values{
+				"response_type":         {"code"},
+				"client_id":             {public.ClientID},
+				"code_challenge":        {"challenge"},
+				"code_challenge_method": {"plain"},
+			},
+			expectedStatus: http.StatusFound,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:  "consent required without prompting",
+			token: userAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {confidential.ClientID},
+				"prompt":        {"none"},
+			},
+			expectedStatus: http.StatusFound,
+			expectedErr:    "consent_required",
+		},
+		{
+			name:  "service token cannot authorize",
+			token: serviceAccess,
+			params: url.Values{
+				"response_type": {"code"},
+				"client_id":     {confidential.ClientID},
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedErr:    handlers.ErrOauthAuthorizeNotAllowed.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.params.Set("state", "xyz")
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+tc.params.Encode(), nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+
+			switch tc.expectedStatus {
+			case http.StatusFound:
+				// errors after the redirect uri is verified are sent to the client
+				location, err := url.Parse(recorder.Header().Get("Location"))
+				require.NoError(t, err)
+
+				assert.Equal(t, "app.example.com", location.Host)
+				assert.Equal(t, tc.expectedErr, location.Query().Get("error"))
+				assert.Equal(t, "xyz", location.Query().Get("state"))
+				assert.Empty(t, location.Query().Get("code"))
+			case http.StatusForbidden:
+				assert.Contains(t, recorder.Body.String(), tc.expectedErr)
+			default:
+				var out handlers.OauthError
+
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+				assert.Equal(t, tc.expectedErr, out.Code)
+			}
+		})
+	}
+
+	// cleanup after
+	EntClient.OauthClient.DeleteOneID(confidential.ID).ExecX(ctx)
+	EntClient.OauthClient.DeleteOneID(public.ID).ExecX(ctx)
+	EntClient.Organization.DeleteOneID(org.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
+
+func TestOauthClientCredentials(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	readScope := "org:read"
+	writeScope := "org:write"
+
+	confidential, secret := createOauthClient(ctx, t, org.ID, false, tokens.ScopeOpenID, readScope, writeScope)
+	oidcOnly, oidcOnlySecret := createOauthClient(ctx, t, org.ID, false, tokens.ScopeOpenID)
+	public, _ := createOauthClient(ctx, t, org.ID, true, readScope)
+
+	e := setupEcho(h.SM)
+	e.POST("oauth/token", h.OauthTokenHandler)
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		secret         string
+		form           url.Values
+		basic          bool
+		expectedScopes []string
+		expectedStatus int
+		expectedErr    string
+	}{
+		{
+			name:           "happy path, basic auth",
+			clientID:       confidential.ClientID,
+			secret:         secret,
+			basic:          true,
+			expectedScopes: []string{readScope, writeScope},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "happy path, client secret in the form",
+			clientID:       confidential.ClientID,
+			secret:         secret,
+			expectedScopes: []string{readScope, writeScope},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "narrowed scopes",
+			clientID:       confidential.ClientID,
+			secret:         secret,
+			basic:          true,
+			form:           url.Values{"scope": {readScope}},
+			expectedScopes: []string{readScope},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "scope not allowed for the client",
+			clientID:       confidential.ClientID,
+			secret:         secret,
+			basic:          true,
+			form:           url.Values{"scope": {tokens.ScopeOpenID}},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_scope",
+		},
+		{
+			name:           "client without api scopes",
+			clientID:       oidcOnly.ClientID,
+			secret:         oidcOnlySecret,
+			basic:          true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_scope",
+		},
+		{
+			name:           "public client",
+			clientID:       public.ClientID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "invalid secret",
+			clientID:       confidential.ClientID,
+			secret:         "notthesecret",
+			basic:          true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    "invalid_client",
+		},
+		{
+			name:           "unknown client",
+			clientID:       "unknown",
+			secret:         secret,
+			basic:          true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    "invalid_client",
+		},
+		{
+			name:           "unsupported grant type",
+			clientID:       confidential.ClientID,
+			secret:         secret,
+			basic:          true,
+			form:           url.Values{"grant_type": {"password"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unsupported_grant_type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			form := url.Values{"grant_type": {"client_credentials"}}
+			for k, v := range tc.form {
+				form[k] = v
+			}
+
+			clientID := ""
+
+			if tc.basic {
+				clientID = tc.clientID
+			} else {
+				form.Set("client_id", tc.clientID)
+
+				if tc.secret != "" {
+					form.Set("client_secret", tc.secret)
+				}
+			}
+
+			recorder := oauthTokenRequest(t, e, form, clientID, tc.secret)
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				var out handlers.OauthError
+
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+				assert.Equal(t, tc.expectedErr, out.Code)
+
+				return
+			}
+
+			var reply handlers.OauthTokenReply
+
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&reply))
+			assert.Empty(t, reply.RefreshToken)
+			assert.Empty(t, reply.IDToken)
+
+			// the token acts as a service of the organization that owns the client
+			claims, err := h.TM.Verify(reply.AccessToken)
+			require.NoError(t, err)
+
+			assert.True(t, claims.IsService())
+			assert.Equal(t, confidential.ID, claims.UserID)
+			assert.Equal(t, org.ID, claims.OrgID)
+			assert.Equal(t, confidential.ClientID, claims.ClientID)
+			assert.Equal(t, tc.expectedScopes, claims.Scopes)
+		})
+	}
+
+	// cleanup after
+	EntClient.OauthClient.DeleteOneID(confidential.ID).ExecX(ctx)
+	EntClient.OauthClient.DeleteOneID(oidcOnly.ID).ExecX(ctx)
+	EntClient.OauthClient.DeleteOneID(public.ID).ExecX(ctx)
+	EntClient.Organization.DeleteOneID(org.ID).ExecX(ctx)
+}
+
+func TestUserInfoHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	user, userAccess := createOauthUser(ctx, t, h)
+
+	tokenWithScopes := func(scopes ...string) string {
+		access, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID:   user.ID,
+			Scopes:   scopes,
+			ClientID: "client",
+		})
+		require.NoError(t, err)
+
+		return access
+	}
+
+	e := setupEcho(h.SM)
+	e.GET("userinfo", h.UserInfoHandler, auth.Authenticate(auth.WithValidator(h.TM)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		expected       handlers.UserInfoReply
+		expectedStatus int
+	}{
+		{
+			name:           "login token returns every claim",
+			token:          userAccess,
+			expected:       handlers.UserInfoReply{Subject: user.ID, UserInfo: tokens.UserInfo{Email: user.Email, Name: user.FirstName + " " + user.LastName, PreferredUsername: user.DisplayName}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "openid scope only returns the subject",
+			token:          tokenWithScopes(tokens.ScopeOpenID),
+			expected:       handlers.UserInfoReply{Subject: user.ID},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "email scope",
+			token:          tokenWithScopes(tokens.ScopeOpenID, tokens.ScopeEmail),
+			expected:       handlers.UserInfoReply{Subject: user.ID, UserInfo: tokens.UserInfo{Email: user.Email}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing openid scope",
+			token:          tokenWithScopes("org:read"),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var out handlers.UserInfoReply
+
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+			assert.Equal(t, tc.expected.Subject, out.Subject)
+			assert.Equal(t, tc.expected.Email, out.Email)
+			assert.Equal(t, tc.expected.Name, out.Name)
+			assert.Equal(t, tc.expected.PreferredUsername, out.PreferredUsername)
+		})
+	}
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
+
+func TestOpenIDConfigurationHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	e := setupEcho(h.SM)
+	e.GET(".well-known/openid-configuration", h.OpenIDConfigurationHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var out handlers.OpenIDConfiguration
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Equal(t, "http://localhost:17608", out.Issuer)
+	assert.Equal(t, "http://localhost:17608/oauth/authorize", out.AuthorizationEndpoint)
+	assert.Equal(t, "http://localhost:17608/oauth/token", out.TokenEndpoint)
+	assert.Equal(t, "http://localhost:17608/userinfo", out.UserInfoEndpoint)
+	assert.Equal(t, "http://localhost:17608/v1/introspect", out.IntrospectionEndpoint)
+	assert.Equal(t, "http://localhost:17608/v1/revoke", out.RevocationEndpoint)
+	assert.Equal(t, "http://localhost:17608/.well-known/jwks.json", out.JWKSURI)
+	assert.Equal(t, []string{"RS256"}, out.IDTokenSigningAlgValuesSupported)
+	assert.Equal(t, []string{"S256"}, out.CodeChallengeMethodsSupported)
+	assert.Contains(t, out.ScopesSupported, tokens.ScopeOpenID)
+	assert.Contains(t, out.GrantTypesSupported, "client_credentials")
+}
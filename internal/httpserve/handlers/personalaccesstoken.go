@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/golang-jwt/jwt/v5"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// ValidatePersonalAccessToken looks up the personal access token presented as a bearer token and returns
+// the claims of the user that owns it so the request is authorized as that user; the token is found by its
+// visible prefix and then compared to the stored hash. The last used time of the token is updated on every
+// successful use
+func (h *Handler) ValidatePersonalAccessToken(ctx context.Context, token string) (*tokens.Claims, error) {
+	pat, err := h.getPersonalAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if pat.ExpiresAt != nil && pat.ExpiresAt.Before(time.Now()) {
+		return nil, auth.ErrExpiredPAT
+	}
+
+	// the token acts as its owner, so it cannot be used while the owner's account is locked or inactive
+	if err := checkAccountUsable(pat.Edges.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := h.DBClient.PersonalAccessToken.UpdateOneID(pat.ID).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		h.Logger.Errorw("error updating personal access token last used", "error", err)
+
+		return nil, err
+	}
+
+	return personalAccessTokenClaims(pat), nil
+}
+
+// getPersonalAccessToken returns the personal access token with its owner and their settings, the token is found by its visible
+// prefix and then compared to the stored hash; expired tokens are returned. Tokens created before tokens were hashed have
+// no visible prefix and are found by the stored plaintext token until they expire
+func (h *Handler) getPersonalAccessToken(ctx context.Context, token string) (*ent.PersonalAccessToken, error) {
+	if token == "" {
+		return nil, auth.ErrInvalidPAT
+	}
+
+	where := personalaccesstoken.Token(token)
+
+	prefix, err := keygen.PersonalAccessTokenPrefixOf(token)
+
+	hashed := err == nil
+	if hashed {
+		where = personalaccesstoken.TokenPrefix(prefix)
+	}
+
+	pat, err := h.DBClient.PersonalAccessToken.Query().
+		Where(where).
+		WithOwner(func(q *ent.UserQuery) {
+			q.WithSetting()
+		}).
+		Only(entcache.Skip(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, auth.ErrInvalidPAT
+		}
+
+		h.Logger.Errorw("error obtaining personal access token", "error", err)
+
+		return nil, err
+	}
+
+	if hashed && !keygen.VerifyPersonalAccessToken(token, pat.TokenHash) {
+		return nil, auth.ErrInvalidPAT
+	}
+
+	return pat, nil
+}
+
+// personalAccessTokenClaims returns the claims of the user that owns the personal access token, restricted to
+// the abilities of the token
+func personalAccessTokenClaims(pat *ent.PersonalAccessToken) *tokens.Claims {
+	user := pat.Edges.Owner
+
+	claims := &tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       pat.ID,
+			Subject:  user.ID,
+			IssuedAt: jwt.NewNumericDate(pat.CreatedAt),
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+		Scopes: pat.Abilities,
+	}
+
+	if pat.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*pat.ExpiresAt)
+	}
+
+	return claims
+}
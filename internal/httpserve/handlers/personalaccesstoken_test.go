@@ -0,0 +1,191 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+func TestValidatePersonalAccessToken(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ec = privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(gofakeit.Password(true, true, true, true, false, 20)).
+		SetSetting(userSetting).
+		SaveX(ec)
+
+	// createPAT stores the hash of a new personal access token and returns the token with the stored row
+	createPAT := func(name string, expiresAt time.Time) (string, *generated.PersonalAccessToken) {
+		token, prefix := keygen.PersonalAccessToken()
+
+		hash, err := keygen.HashPersonalAccessToken(token)
+		require.NoError(t, err)
+
+		pat := EntClient.PersonalAccessToken.Create().
+			SetName(name).
+			SetOwner(user).
+			SetTokenPrefix(prefix).
+			SetTokenHash(hash).
+			SetExpiresAt(expiresAt).
+			SaveX(ec)
+
+		return token, pat
+	}
+
+	// createLegacyPAT stores a personal access token created before tokens were hashed, the plaintext token is
+	// stored and it has no visible prefix
+	createLegacyPAT := func(name string, expiresAt time.Time) string {
+		token := keygen.Secret()
+
+		EntClient.PersonalAccessToken.Create().
+			SetName(name).
+			SetOwner(user).
+			SetTokenPrefix("legacy_" + keygen.Secret()).
+			SetToken(token).
+			SetExpiresAt(expiresAt).
+			ExecX(ec)
+
+		return token
+	}
+
+	token, pat := createPAT("ci", time.Now().Add(time.Hour))
+	expiredToken, _ := createPAT("expired", time.Now().Add(-time.Hour))
+	legacyToken := createLegacyPAT("legacy", time.Now().Add(time.Hour))
+	expiredLegacyToken := createLegacyPAT("expired legacy", time.Now().Add(-time.Hour))
+
+	// a token with a known prefix but the wrong secret
+	forgedToken := pat.TokenPrefix + "_" + keygen.Secret()
+
+	access, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID,
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.GET("whoami", func(c echo.Context) error {
+		userID, err := auth.GetActorUserID(c)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, handlers.Response{Message: userID})
+	}, auth.Authenticate(auth.WithValidator(h.TM), auth.WithPersonalAccessTokenValidator(h)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "personal access token",
+			token:          token,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "access token is still accepted",
+			token:          access,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expired personal access token",
+			token:          expiredToken,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "personal access token created before tokens were hashed",
+			token:          legacyToken,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expired personal access token created before tokens were hashed",
+			token:          expiredLegacyToken,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "forged personal access token",
+			token:          forgedToken,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown personal access token",
+			token:          "notarealtoken",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var out *handlers.Response
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+				assert.Equal(t, user.ID, out.Message)
+			}
+		})
+	}
+
+	// the last used time is recorded when the personal access token is used
+	used := EntClient.PersonalAccessToken.GetX(entcache.Skip(ec), pat.ID)
+	require.NotNil(t, used.LastUsedAt)
+	assert.WithinDuration(t, time.Now(), *used.LastUsedAt, time.Minute)
+
+	// the token cannot be used while the account of its owner is locked
+	EntClient.UserSetting.UpdateOneID(userSetting.ID).SetLocked(true).ExecX(ec)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrAccountLocked.Error())
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ec)
+}
@@ -8,13 +8,19 @@ import (
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
 )
 
+const refreshTokenReuseReason = "refresh token reuse"
+
 type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// RefreshHandler allows users to refresh their access token using their refresh token.
+// RefreshHandler allows users to refresh their access token using their refresh token. Refresh tokens
+// are rotated: each refresh token may only be exchanged once and the new refresh token joins the family
+// of the one it replaced. Presenting a refresh token that was already exchanged means it was likely
+// stolen, so every session of the user is revoked
 func (h *Handler) RefreshHandler(ctx echo.Context) error {
 	var r RefreshRequest
 
@@ -41,8 +47,57 @@ func (h *Handler) RefreshHandler(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
 	}
 
-	// check user in the database, sub == claims subject and ensure only one record is returned
-	user, err := h.getUserBySub(ctx.Request().Context(), claims.Subject)
+	// refresh tokens issued to oauth clients are rotated by the token endpoint of the authorization server
+	if claims.ClientID != "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrOauthRefreshNotAllowed))
+	}
+
+	// ensure the token pair has not been revoked, e.g. on logout
+	revoked, err := h.IsRevoked(ctx.Request().Context(), claims.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if revoked {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrTokenRevoked))
+	}
+
+	parent, err := h.getRefreshToken(ctx.Request().Context(), claims.ID)
+
+	switch {
+	case err == nil:
+		if parent.UsedAt != nil {
+			return h.refreshTokenReused(ctx, parent.UserID)
+		}
+
+		// the refresh token may have been exchanged by a concurrent request since it was read
+		exchanged, err := h.markRefreshTokenUsed(ctx.Request().Context(), parent)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+
+		if !exchanged {
+			return h.refreshTokenReused(ctx, parent.UserID)
+		}
+	case ent.IsNotFound(err) && h.issuedBeforeRefreshTokenCutover(claims):
+		// refresh tokens issued before refresh tokens were recorded are recorded as used, so they can only be
+		// exchanged once, and the new token pair starts a new token family
+		recorded, err := h.recordUntrackedRefreshToken(ctx.Request().Context(), claims)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+		}
+
+		if !recorded {
+			return h.refreshTokenReused(ctx, claims.Subject)
+		}
+	case ent.IsNotFound(err):
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrUnknownRefreshToken))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// check user in the database, the claims subject is the user id (the user sub may be set by an oauth provider)
+	user, err := h.getUserByID(ctx.Request().Context(), claims.Subject)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ctx.JSON(http.StatusNotFound, ErrNoAuthUser)
@@ -51,15 +106,15 @@ func (h *Handler) RefreshHandler(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
 	}
 
-	// ensure the user is still active
-	if user.Edges.Setting.Status != "ACTIVE" {
-		return ctx.JSON(http.StatusNotFound, ErrNoAuthUser)
+	// ensure the user is still active and not locked
+	if err := checkAccountUsable(user); err != nil {
+		return ctx.JSON(accountErrorStatus(err, http.StatusNotFound), ErrorResponse(err))
 	}
 
 	// UserID is not on the refresh token, so we need to set it now
 	claims.UserID = user.ID
 
-	accessToken, refreshToken, err := h.TM.CreateTokenPair(claims)
+	accessToken, refreshToken, err := h.createTokenPair(ctx, claims, parent)
 	if err != nil {
 		h.Logger.Errorw("error creating token pair", "error", err)
 
@@ -78,3 +133,61 @@ func (h *Handler) RefreshHandler(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, Response{Message: "success"})
 }
+
+// issuedBeforeRefreshTokenCutover reports whether the refresh token was issued before refresh tokens were recorded,
+// no refresh token is accepted without being recorded when the cutover is not configured
+func (h *Handler) issuedBeforeRefreshTokenCutover(claims *tokens.Claims) bool {
+	if h.RefreshTokenCutover.IsZero() || claims.IssuedAt == nil {
+		return false
+	}
+
+	return claims.IssuedAt.Before(h.RefreshTokenCutover)
+}
+
+// refreshTokenReused revokes every session of the user after an already exchanged refresh token was
+// presented, the revocations are committed even though the request is rejected
+func (h *Handler) refreshTokenReused(ctx echo.Context, userID string) error {
+	h.Logger.Warnw("refresh token reuse detected, revoking all sessions", "user_id", userID)
+
+	if err := h.revokeUserRefreshTokens(ctx.Request().Context(), userID, refreshTokenReuseReason); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	auth.ClearAuthCookies(ctx, h.CookieDomain)
+
+	if err := h.SM.Destroy(ctx.Request().Context()); err != nil {
+		h.Logger.Errorw("error destroying session", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrRefreshTokenReused))
+}
+
+// createTokenPair creates the access and refresh token pair for the claims and records the refresh
+// token so it can only be exchanged once; the parent is the refresh token being exchanged, or nil on
+// login to start a new token family. Each token family is a session of the user, the session is
+// started on login and its last activity is updated on refresh
+func (h *Handler) createTokenPair(ctx echo.Context, claims *tokens.Claims, parent *ent.RefreshToken) (accessToken, refreshToken string, err error) {
+	if accessToken, refreshToken, err = h.TM.CreateTokenPair(claims); err != nil {
+		return "", "", err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	if err = h.createRefreshToken(reqCtx, claims, parent); err != nil {
+		return "", "", err
+	}
+
+	if parent == nil {
+		err = h.createSession(reqCtx, claims, ctx.Request().UserAgent(), ctx.RealIP())
+	} else {
+		err = h.touchSession(reqCtx, parent.FamilyID, h.TM.PairExpiration(claims))
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"ariga.io/entcache"
 	"github.com/alexedwards/scs/v2"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/golang-jwt/jwt/v5"
@@ -17,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/refreshtoken"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
 	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
@@ -67,19 +69,45 @@ func TestRefreshHandler(t *testing.T) {
 		SetSub(userID). // this is required to parse the refresh token
 		SaveX(ec)
 
-	claims := &tokens.Claims{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject: user.ID,
-		},
-		UserID: user.ID,
-		Email:  user.Email,
-	}
+	// createPair creates a token pair for the user, the refresh token is recorded as the start of a
+	// new token family unless untracked
+	createPair := func(tracked bool) (refresh string, jti string) {
+		claims := &tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID: user.ID,
+			Email:  user.Email,
+		}
 
-	_, refresh, err := tm.CreateTokenPair(claims)
-	if err != nil {
-		t.Error("error creating token pair")
+		_, refresh, err := tm.CreateTokenPair(claims)
+		require.NoError(t, err)
+
+		if tracked {
+			EntClient.RefreshToken.Create().
+				SetJti(claims.ID).
+				SetFamilyID(claims.ID).
+				SetUserID(user.ID).
+				SetExpiresAt(tm.PairExpiration(claims)).
+				ExecX(ec)
+		}
+
+		return refresh, claims.ID
 	}
 
+	refresh, refreshJTI := createPair(true)
+	otherFamilyRefresh, _ := createPair(true)
+	untrackedRefresh, _ := createPair(false)
+
+	// create another token pair and add it to the revocation list
+	revokedRefresh, revokedJTI := createPair(true)
+
+	EntClient.RevokedToken.Create().
+		SetJti(revokedJTI).
+		SetUserID(user.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		ExecX(ec)
+
 	testCases := []struct {
 		name               string
 		refresh            string
@@ -91,6 +119,30 @@ func TestRefreshHandler(t *testing.T) {
 			refresh:        refresh,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:               "refresh token already exchanged",
+			refresh:            refresh,
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "refresh token has already been used",
+		},
+		{
+			name:               "reuse revoked the other sessions of the user",
+			refresh:            otherFamilyRefresh,
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "token has been revoked",
+		},
+		{
+			name:               "untracked refresh",
+			refresh:            untrackedRefresh,
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "refresh token not recognized",
+		},
+		{
+			name:               "revoked refresh",
+			refresh:            revokedRefresh,
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "token has been revoked",
+		},
 		{
 			name:               "empty refresh",
 			refresh:            "",
@@ -142,6 +194,134 @@ func TestRefreshHandler(t *testing.T) {
 		})
 	}
 
+	// the exchanged refresh token was replaced by a child in the same family, which was revoked along
+	// with the rest of the user's sessions when the exchanged refresh token was reused
+	child := EntClient.RefreshToken.Query().
+		Where(refreshtoken.ParentJti(refreshJTI)).
+		OnlyX(entcache.Skip(ec))
+	assert.Equal(t, refreshJTI, child.FamilyID)
+
+	childRevoked, err := h.IsRevoked(ec, child.Jti)
+	require.NoError(t, err)
+	assert.True(t, childRevoked)
+
+	// cleanup after
+	EntClient.User.DeleteOneID(user.ID).ExecX(ec)
+}
+
+func TestRefreshHandlerCutover(t *testing.T) {
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	require.NoError(t, err)
+
+	h := handlers.Handler{
+		TM:           tm,
+		DBClient:     EntClient,
+		Logger:       zap.NewNop().Sugar(),
+		CookieDomain: "datum.net",
+		SM:           scs.New(),
+	}
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ec = privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(gofakeit.Password(true, true, true, true, false, 20)).
+		SetSetting(userSetting).
+		SaveX(ec)
+
+	// the refresh tokens are not recorded, as if they were issued before refresh tokens were recorded
+	createUntracked := func() (string, string) {
+		claims := &tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID: user.ID,
+			Email:  user.Email,
+		}
+
+		_, refresh, err := tm.CreateTokenPair(claims)
+		require.NoError(t, err)
+
+		return refresh, claims.ID
+	}
+
+	untracked, untrackedJTI := createUntracked()
+	afterCutover, _ := createUntracked()
+
+	testCases := []struct {
+		name               string
+		refresh            string
+		cutover            time.Time
+		expectedErrMessage string
+		expectedStatus     int
+	}{
+		{
+			name:           "refresh token issued before the cutover is exchanged",
+			refresh:        untracked,
+			cutover:        time.Now().Add(time.Minute),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:               "refresh token issued before the cutover is only exchanged once",
+			refresh:            untracked,
+			cutover:            time.Now().Add(time.Minute),
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "refresh token has already been used",
+		},
+		{
+			name:               "untracked refresh token issued after the cutover",
+			refresh:            afterCutover,
+			cutover:            time.Now().Add(-time.Hour),
+			expectedStatus:     http.StatusUnauthorized,
+			expectedErrMessage: "refresh token not recognized",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h.RefreshTokenCutover = tc.cutover
+
+			e := setupEcho(h.SM)
+			e.POST("refresh", h.RefreshHandler)
+
+			body, err := json.Marshal(handlers.RefreshRequest{RefreshToken: tc.refresh})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/refresh", strings.NewReader(string(body)))
+			recorder := httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *handlers.Response
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, out.Message, "success")
+			} else {
+				assert.Contains(t, out.Message, tc.expectedErrMessage)
+			}
+		})
+	}
+
+	// the untracked refresh token was recorded as used when it was exchanged
+	rt := EntClient.RefreshToken.Query().
+		Where(refreshtoken.Jti(untrackedJTI)).
+		OnlyX(entcache.Skip(ec))
+	assert.NotNil(t, rt.UsedAt)
+
 	// cleanup after
 	EntClient.User.DeleteOneID(user.ID).ExecX(ec)
 }
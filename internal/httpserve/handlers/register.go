@@ -24,6 +24,8 @@ type RegisterRequest struct {
 	LastName  string `json:"last_name"`
 	Email     string `json:"email"`
 	Password  string `json:"password"`
+	// Token is the token of an invitation to an organization, the user joins the organization when they register
+	Token string `json:"token,omitempty"`
 }
 
 // RegisterReply holds the fields that are sent on a response to the `/register` endpoint
@@ -33,11 +35,15 @@ type RegisterReply struct {
 	Message string `json:"message"`
 	// TODO: remove this before go live, we shouldn't actually return the token here
 	Token string `json:"token"`
+	// JoinedOrgID is the organization the user joined when they registered with an invitation
+	JoinedOrgID string `json:"joined_org_id,omitempty"`
 }
 
 // RegisterHandler handles the registration of a new datum user, creating the user, personal organization
 // and sending an email verification to the email address in the request
-// the user will not be able to authenticate until the email is verified
+// the user will not be able to authenticate until the email is verified. Users registering with the token of
+// an invitation join the organization of the invitation, the email address is verified by the invitation so
+// no email verification is sent
 func (h *Handler) RegisterHandler(ctx echo.Context) error {
 	var in *RegisterRequest
 
@@ -50,6 +56,24 @@ func (h *Handler) RegisterHandler(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
 	}
 
+	if err := h.passwordPolicy().Validate(in.Password); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	var invitation *generated.Invite
+
+	if in.Token != "" {
+		var err error
+
+		if invitation, err = h.verifyInvite(ctx.Request().Context(), in.Token); err != nil {
+			return inviteErrorResponse(ctx, err)
+		}
+
+		if !strings.EqualFold(invitation.Recipient, in.Email) {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrInviteRecipientMismatch))
+		}
+	}
+
 	// create user
 	input := generated.CreateUserInput{
 		FirstName: in.FirstName,
@@ -69,6 +93,10 @@ func (h *Handler) RegisterHandler(ctx echo.Context) error {
 			return ctx.JSON(http.StatusBadRequest, ErrorResponse("user already exists"))
 		}
 
+		if passwd.IsPolicyViolation(err) {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+		}
+
 		if generated.IsValidationError(err) {
 			field := err.(*generated.ValidationError).Name
 			return ctx.JSON(http.StatusBadRequest, ErrorResponse(fmt.Sprintf("%s was invalid", field)))
@@ -80,6 +108,10 @@ func (h *Handler) RegisterHandler(ctx echo.Context) error {
 	// setup viewer context
 	viewerCtx := viewer.NewContext(ctxWithToken, viewer.NewUserViewerFromID(meowuser.ID, true))
 
+	if invitation != nil {
+		return h.registerInvitedUser(ctx, viewerCtx, meowuser, invitation)
+	}
+
 	// create email verification token
 	user := &User{
 		FirstName: in.FirstName,
@@ -105,6 +137,33 @@ func (h *Handler) RegisterHandler(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, out)
 }
 
+// registerInvitedUser adds the newly registered user to the organization of the invitation and confirms their
+// email address, the invitation was emailed to the address so it does not have to be verified again
+func (h *Handler) registerInvitedUser(ctx echo.Context, viewerCtx context.Context, meowuser *generated.User, i *generated.Invite) error {
+	if err := h.acceptInvite(viewerCtx, i, meowuser.ID); err != nil {
+		return inviteErrorResponse(ctx, err)
+	}
+
+	// the user is queried again to load the settings edge
+	entUser, err := h.getUserByEmail(viewerCtx, meowuser.Email)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if err := h.setEmailConfirmed(viewerCtx, entUser); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	out := &RegisterReply{
+		ID:          meowuser.ID,
+		Email:       meowuser.Email,
+		Message:     "Welcome to Datum!",
+		JoinedOrgID: i.OwnerID,
+	}
+
+	return ctx.JSON(http.StatusCreated, out)
+}
+
 func (h *Handler) storeAndSendEmailVerificationToken(ctx context.Context, user *User) (*generated.EmailVerificationToken, error) {
 	if err := h.expireAllVerificationTokensUserByEmail(ctx, user.Email); err != nil {
 		h.Logger.Errorw("error expiring existing tokens", "error", err)
@@ -136,14 +195,15 @@ func (h *Handler) storeAndSendEmailVerificationToken(ctx context.Context, user *
 	return meowtoken, nil
 }
 
-// Validate the register request ensuring that the required fields are available and
-// that the password is valid - an error is returned if the request is not correct. This
-// method also performs some basic data cleanup, trimming whitespace
+// Validate the register request ensuring that the required fields are available - an error
+// is returned if the request is not correct. The password is checked against the password
+// policy by the handler. This method also performs some basic data cleanup, trimming whitespace
 func (r *RegisterRequest) Validate() error {
 	r.FirstName = strings.TrimSpace(r.FirstName)
 	r.LastName = strings.TrimSpace(r.LastName)
 	r.Email = strings.TrimSpace(r.Email)
 	r.Password = strings.TrimSpace(r.Password)
+	r.Token = strings.TrimSpace(r.Token)
 
 	// Required for all requests
 	switch {
@@ -155,8 +215,6 @@ func (r *RegisterRequest) Validate() error {
 		return auth.MissingField("last name")
 	case r.Password == "":
 		return auth.MissingField("password")
-	case passwd.Strength(r.Password) < passwd.Moderate:
-		return auth.ErrPasswordTooWeak
 	}
 
 	return nil
@@ -100,6 +100,16 @@ func TestRegisterHandler(t *testing.T) {
 			expectedErrMessage: "password is too weak",
 			expectedStatus:     http.StatusBadRequest,
 		},
+		{
+			name:               "breached password",
+			email:              "dragon@datum.net",
+			firstName:          "Princess",
+			lastName:           "Fiona",
+			password:           breachedPassword,
+			emailExpected:      false,
+			expectedErrMessage: "password has appeared in a data breach",
+			expectedStatus:     http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -63,6 +63,10 @@ func (h *Handler) ResetPassword(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
 	}
 
+	if err := h.passwordPolicy().Validate(rp.Password); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
 	// lookup user from db based on provided token
 	entUser, err := h.getUserByResetToken(ctx.Request().Context(), rp.Token)
 	if err != nil {
@@ -119,10 +123,12 @@ func (h *Handler) ResetPassword(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
 	}
 
-	// make sure its not the same password as current
-	valid, err := passwd.VerifyDerivedKey(*entUser.Password, rp.Password)
-	if err != nil || valid {
-		return ctx.JSON(http.StatusBadRequest, ErrorResponse(auth.ErrNonUniquePassword))
+	// make sure its not the same password as current, users without a password can set their first one
+	if entUser.Password != nil {
+		valid, err := passwd.VerifyDerivedKey(*entUser.Password, rp.Password)
+		if err != nil || valid {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(auth.ErrNonUniquePassword))
+		}
 	}
 
 	// set context for remaining request based on logged in user
@@ -163,8 +169,6 @@ func (r *ResetPassword) validateResetRequest() error {
 		return newMissingRequiredFieldError("token")
 	case r.Password == "":
 		return newMissingRequiredFieldError("password")
-	case passwd.Strength(r.Password) < passwd.Moderate:
-		return auth.ErrPasswordTooWeak
 	}
 
 	return nil
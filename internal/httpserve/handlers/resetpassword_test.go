@@ -33,6 +33,7 @@ func TestResetPassword(t *testing.T) {
 	testCases := []struct {
 		name                 string
 		email                string
+		changedPassword      string
 		newPassword          string
 		tokenSet             bool
 		tokenProvided        string
@@ -80,6 +81,25 @@ func TestResetPassword(t *testing.T) {
 			expectedResp:   "password was already used",
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:            "previously used password",
+			email:           "spook@datum.net",
+			tokenSet:        true,
+			changedPassword: "6z9Fqc-E-9v32NsJzLNU",
+			newPassword:     validPassword,
+			emailExpected:   false,
+			expectedResp:    "password was already used",
+			expectedStatus:  http.StatusBadRequest,
+		},
+		{
+			name:           "breached password",
+			email:          "marsh@datum.net",
+			tokenSet:       true,
+			newPassword:    breachedPassword,
+			emailExpected:  false,
+			expectedResp:   "password has appeared in a data breach",
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "missing token",
 			email:          "dockson@datum.net",
@@ -113,6 +133,13 @@ func TestResetPassword(t *testing.T) {
 			rt, userID, err := createUserWithResetToken(ec, tc.email, tc.ttl)
 			require.NoError(t, err)
 
+			// the password the user was created with is in the password history after it is changed
+			if tc.changedPassword != "" {
+				EntClient.User.UpdateOneID(userID).
+					SetPassword(tc.changedPassword).
+					ExecX(privacy.DecisionContext(ec, privacy.Allow))
+			}
+
 			// setup request request
 			e.POST("reset-password", h.ResetPassword)
 
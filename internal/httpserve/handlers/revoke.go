@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// oauthClientRevokeReason is recorded when a token is revoked at the revocation endpoint
+const oauthClientRevokeReason = "token revoked by oauth client"
+
+// RevocationHandler revokes the presented token as described in RFC 7009; revoking an access or refresh token
+// revokes the whole token family and its session, personal access tokens and api keys are deleted. Clients
+// can revoke the tokens issued to them, confidential clients with the org:admin scope can also revoke the
+// tokens scoped to the organization that owns the client, such as its api keys, and the tokens of its members.
+// Unknown and inactive tokens are reported as revoked so the response does not disclose whether the token exists
+func (h *Handler) RevocationHandler(ctx echo.Context) error {
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	ctx.Response().Header().Set("Pragma", "no-cache")
+
+	client, oerr := h.authenticateOauthClient(ctx)
+	if oerr != nil {
+		return h.oauthErrorResponse(ctx, oerr)
+	}
+
+	token := ctx.FormValue("token")
+	if token == "" {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrInvalidRequest, "token is required"))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	t, err := h.inspectToken(reqCtx, token)
+	if err != nil {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrServerError, ""))
+	}
+
+	if t == nil || !t.active {
+		return ctx.NoContent(http.StatusOK)
+	}
+
+	allowed, err := h.clientCanRevokeToken(reqCtx, client, t.claims)
+	if err != nil {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrServerError, ""))
+	}
+
+	if !allowed {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrUnauthorizedClient, "the client is not allowed to revoke the token"))
+	}
+
+	if err := h.revokeInspectedToken(reqCtx, t); err != nil {
+		return h.oauthErrorResponse(ctx, newOauthError(oauthErrServerError, ""))
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// clientCanRevokeToken reports whether the client can revoke the token, tokens issued to the client can always
+// be revoked while other tokens require a confidential client with the org:admin scope that can access them
+func (h *Handler) clientCanRevokeToken(ctx context.Context, client *ent.OauthClient, claims *tokens.Claims) (bool, error) {
+	if claims.ClientID == client.ClientID {
+		return true, nil
+	}
+
+	if !clientIsOrgAdmin(client) {
+		return false, nil
+	}
+
+	return h.clientCanAccessToken(ctx, client, claims)
+}
+
+// revokeInspectedToken revokes the token so it is no longer accepted, tokens with a refresh token revoke the
+// token family and the session it belongs to while other access tokens are revoked by their id
+func (h *Handler) revokeInspectedToken(ctx context.Context, t *inspectedToken) error {
+	switch {
+	case t.apiKey != nil:
+		return h.deleteAPIKey(ctx, t.apiKey.ID)
+	case t.pat != nil:
+		return h.deletePersonalAccessToken(ctx, t.pat.ID)
+	case t.refresh != nil:
+		if err := h.revokeRefreshTokenFamily(ctx, t.refresh.FamilyID, oauthClientRevokeReason); err != nil {
+			return err
+		}
+
+		return h.revokeSessionByToken(ctx, t.refresh.FamilyID)
+	default:
+		return h.revokeToken(ctx, t.claims, oauthClientRevokeReason)
+	}
+}
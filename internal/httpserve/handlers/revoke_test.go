@@ -0,0 +1,309 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/revokedtoken"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/keygen"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+func TestRevocationHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	require.NoError(t, err)
+
+	h.TM = tm
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	user, access := createOauthUser(ctx, t, h)
+	_, outsiderAccess := createOauthUser(ctx, t, h)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	admin, adminSecret := createOauthClient(ctx, t, org.ID, false, "org:admin")
+	reader, readerSecret := createOauthClient(ctx, t, org.ID, false, "org:read")
+	public, _ := createOauthClient(ctx, t, org.ID, true, "org:admin")
+
+	// createPair creates a token pair of the user scoped to the organization with the refresh token recorded as
+	// a new token family and a session for the family
+	createPair := func() (string, string, *tokens.Claims, *ent.Session) {
+		claims := &tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID: user.ID,
+			Email:  user.Email,
+			OrgID:  org.ID,
+		}
+
+		access, refresh, err := tm.CreateTokenPair(claims)
+		require.NoError(t, err)
+
+		EntClient.RefreshToken.Create().
+			SetJti(claims.ID).
+			SetFamilyID(claims.ID).
+			SetUserID(user.ID).
+			SetExpiresAt(tm.PairExpiration(claims)).
+			ExecX(ctx)
+
+		session := EntClient.Session.Create().
+			SetSessionToken(claims.ID).
+			SetUserID(user.ID).
+			SetExpiresAt(tm.PairExpiration(claims)).
+			SaveX(ctx)
+
+		return access, refresh, claims, session
+	}
+
+	pairAccess, _, pairClaims, pairSession := createPair()
+	_, pairRefresh, refreshClaims, _ := createPair()
+
+	// the access token is scoped to the organization but has no refresh token
+	orgAccess, _, err := tm.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID,
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+		OrgID:  org.ID,
+	})
+	require.NoError(t, err)
+
+	token, prefix := keygen.PersonalAccessToken()
+
+	hash, err := keygen.HashPersonalAccessToken(token)
+	require.NoError(t, err)
+
+	pat := EntClient.PersonalAccessToken.Create().
+		SetName(gofakeit.AppName()).
+		SetOwner(user).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hash).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	key, keyPrefix := keygen.APIKey()
+
+	keyHash, err := keygen.HashAPIKey(key)
+	require.NoError(t, err)
+
+	apiKey := EntClient.APIKey.Create().
+		SetName(gofakeit.AppName()).
+		SetOwner(org).
+		SetKeyPrefix(keyPrefix).
+		SetKeyHash(keyHash).
+		SetAbilities([]string{"org:read"}).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.POST("oauth/token", h.OauthTokenHandler)
+	e.POST("introspect", h.IntrospectionHandler)
+	e.POST("revoke", h.RevocationHandler)
+
+	// the client credentials token of the reader is issued to the reader itself
+	recorder := oauthTokenRequest(t, e, url.Values{"grant_type": {"client_credentials"}}, reader.ClientID, readerSecret)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var readerTokens handlers.OauthTokenReply
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&readerTokens))
+
+	testCases := []struct {
+		name           string
+		token          string
+		clientID       string
+		secret         string
+		expectedStatus int
+		expectedErr    string
+	}{
+		{
+			name:           "client without the admin scope cannot revoke tokens of the organization",
+			token:          pairAccess,
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "public client cannot revoke tokens of the organization",
+			token:          pairAccess,
+			clientID:       public.ClientID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "client revokes its own token",
+			token:          readerTokens.AccessToken,
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "access token with a refresh token",
+			token:          pairAccess,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "refresh token",
+			token:          pairRefresh,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "access token without a refresh token",
+			token:          orgAccess,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "client without the admin scope cannot revoke tokens of members",
+			token:          access,
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "client without the admin scope cannot revoke personal access tokens of members",
+			token:          token,
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "access token of a user outside the organization",
+			token:          outsiderAccess,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "unauthorized_client",
+		},
+		{
+			name:           "access token of a member",
+			token:          access,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "personal access token of a member",
+			token:          token,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "api key",
+			token:          key,
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "already revoked token",
+			token:          pairAccess,
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown token",
+			token:          keygen.Secret(),
+			clientID:       reader.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing token",
+			clientID:       admin.ClientID,
+			secret:         adminSecret,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "invalid_request",
+		},
+		{
+			name:           "wrong client secret",
+			token:          access,
+			clientID:       admin.ClientID,
+			secret:         readerSecret,
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    "invalid_client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			form := url.Values{}
+			if tc.token != "" {
+				form.Set("token", tc.token)
+			}
+
+			recorder := oauthClientRequest(t, e, "/revoke", form, tc.clientID, tc.secret)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != "" {
+				var out handlers.OauthError
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+				assert.Equal(t, tc.expectedErr, out.Code)
+
+				return
+			}
+
+			if tc.token == "" {
+				return
+			}
+
+			// the revoked token is no longer active
+			recorder = oauthClientRequest(t, e, "/introspect", url.Values{"token": {tc.token}}, admin.ClientID, adminSecret)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var out handlers.IntrospectionReply
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+			assert.False(t, out.Active)
+		})
+	}
+
+	// revoking the token pairs revoked the token families and their sessions
+	for _, jti := range []string{pairClaims.ID, refreshClaims.ID} {
+		assert.True(t, EntClient.RevokedToken.Query().Where(revokedtoken.Jti(jti)).ExistX(ctx))
+	}
+
+	assert.NotNil(t, EntClient.Session.GetX(ctx, pairSession.ID).RevokedAt)
+
+	// the personal access token and api key are deleted
+	_, err = EntClient.PersonalAccessToken.Get(ctx, pat.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = EntClient.APIKey.Get(ctx, apiKey.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,460 @@
+package handlers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"ariga.io/entcache"
+	"github.com/crewjam/saml"
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/privacy/token"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/store"
+)
+
+const (
+	// samlRequestCookie holds the id of the authn request sent to the identity provider, the assertion
+	// is posted back cross-site so this cannot be stored in the strict session cookie
+	samlRequestCookie = "saml_request_id"
+	// samlRequestMaxAge is the number of seconds the user has to authenticate with the identity provider
+	samlRequestMaxAge = 300
+
+	samlMetadataContentType = "application/samlmetadata+xml"
+)
+
+// samlEmailAttributes, samlFirstNameAttributes and samlLastNameAttributes are the assertion
+// attribute names, compared case-insensitively, used to provision the user
+var (
+	samlEmailAttributes = []string{
+		"email", "mail", "emailaddress",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+	}
+	samlFirstNameAttributes = []string{
+		"firstname", "givenname", "first_name", "given_name",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+		"urn:oid:2.5.4.42",
+	}
+	samlLastNameAttributes = []string{
+		"lastname", "surname", "familyname", "last_name", "family_name",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+		"urn:oid:2.5.4.4",
+	}
+)
+
+// SAMLUserInfo contains the user details asserted by the organization identity provider
+type SAMLUserInfo struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// SSOMetadataHandler returns the SAML service provider metadata of the organization, which is
+// uploaded to the identity provider to configure the assertion consumer service
+func (h *Handler) SSOMetadataHandler(ctx echo.Context) error {
+	sp, _, err := h.samlServiceProvider(ctx.Request().Context(), ctx.PathParam("org"))
+	if err != nil {
+		return h.samlErrorResponse(ctx, err)
+	}
+
+	buf, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.Blob(http.StatusOK, samlMetadataContentType, buf)
+}
+
+// SSOLoginHandler redirects the user to the identity provider of the organization with a SAML
+// authn request, the id of the request is stored in a cookie to be validated by the ACS endpoint
+func (h *Handler) SSOLoginHandler(ctx echo.Context) error {
+	sp, _, err := h.samlServiceProvider(ctx.Request().Context(), ctx.PathParam("org"))
+	if err != nil {
+		return h.samlErrorResponse(ctx, err)
+	}
+
+	req, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		h.Logger.Errorw("unable to create saml authn request", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	redirect, err := req.Redirect("", sp)
+	if err != nil {
+		h.Logger.Errorw("unable to create saml authn request redirect", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	store.SetCookie(ctx.Response().Writer, req.ID, samlRequestCookie, samlRequestCookieConfig())
+
+	return ctx.Redirect(http.StatusFound, redirect.String())
+}
+
+// SSOAssertionConsumerHandler validates the SAML response posted by the identity provider against the
+// certificate and issuer of the organization and sets the auth cookies with tokens scoped to the organization.
+// The identity provider is configured by the organization, so the assertion is only trusted for emails on the
+// organization domains: an existing user must already be a member of the organization, and a user that does
+// not exist yet is provisioned into the organization on their first login
+func (h *Handler) SSOAssertionConsumerHandler(ctx echo.Context) error {
+	orgID := ctx.PathParam("org")
+	reqCtx := ctx.Request().Context()
+
+	sp, setting, err := h.samlServiceProvider(reqCtx, orgID)
+	if err != nil {
+		return h.samlErrorResponse(ctx, err)
+	}
+
+	cookie, err := store.GetCookie(ctx.Request(), samlRequestCookie)
+	if err != nil || cookie.Value == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrInvalidSAMLRequest))
+	}
+
+	// the request id is single use
+	store.RemoveCookie(ctx.Response().Writer, samlRequestCookie, samlRequestCookieConfig())
+
+	// only the http post binding is supported, so the response is never resolved from an artifact
+	raw, err := base64.StdEncoding.DecodeString(ctx.FormValue("SAMLResponse"))
+	if err != nil || len(raw) == 0 {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("SAMLResponse")))
+	}
+
+	assertion, err := sp.ParseXMLResponse(raw, []string{cookie.Value})
+	if err != nil {
+		var invalid *saml.InvalidResponseError
+		if errors.As(err, &invalid) {
+			err = invalid.PrivateErr
+		}
+
+		h.Logger.Errorw("invalid saml response", "organization_id", orgID, "error", err)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	info, err := samlUserInfo(assertion)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	if !ssoDomainAllowed(setting.Domains, info.Email) {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrSSODomainNotAllowed))
+	}
+
+	user, err := h.linkOrCreateSAMLUser(reqCtx, orgID, info)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrSSOUserNotMember):
+			return ctx.JSON(http.StatusForbidden, ErrorResponse(err))
+		case errors.Is(err, ErrProcessingRequest):
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+		default:
+			return ctx.JSON(accountErrorStatus(err, http.StatusBadRequest), ErrorResponse(err))
+		}
+	}
+
+	if err := h.SM.RenewToken(reqCtx); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	// set context for remaining request based on logged in user
+	userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(user.ID, true))
+
+	claims := createClaims(user)
+	claims.OrgID = orgID
+
+	access, refresh, err := h.createTokenPair(ctx, claims, nil)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	// set cookies on request with the access and refresh token
+	// when cookie domain is localhost, this is dropped but expected
+	if err := auth.SetAuthCookies(ctx, access, refresh, h.CookieDomain); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	if err := h.updateUserLastSeen(userCtx, user.ID); err != nil {
+		h.Logger.Errorw("unable to update last seen", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	h.SM.Put(reqCtx, "userID", user.ID)
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success"})
+}
+
+// samlServiceProvider returns the SAML service provider of the organization, the identity provider
+// metadata is built from the sso certificate, entrypoint and issuer of the organization settings
+func (h *Handler) samlServiceProvider(ctx context.Context, orgID string) (*saml.ServiceProvider, *ent.OrganizationSetting, error) {
+	if h.SSOBaseURL == "" {
+		return nil, nil, ErrSSONotEnabled
+	}
+
+	// there is no subject on the request, so the lookup bypasses the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	setting, err := h.DBClient.OrganizationSetting.Query().
+		Where(organizationsetting.HasOrganizationWith(organization.ID(orgID))).
+		Only(entcache.Skip(allowCtx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrSSONotConfigured
+		}
+
+		h.Logger.Errorw("error obtaining organization setting", "error", err)
+
+		return nil, nil, err
+	}
+
+	// the domains limit the emails the identity provider can assert, sso is not enabled without them
+	if setting.SSOCert == "" || setting.SSOEntrypoint == "" || setting.SSOIssuer == "" || len(setting.Domains) == 0 {
+		return nil, nil, ErrSSONotConfigured
+	}
+
+	cert, err := parseSSOCert(setting.SSOCert)
+	if err != nil {
+		h.Logger.Errorw("invalid sso certificate", "organization_id", orgID, "error", err)
+
+		return nil, nil, ErrSSONotConfigured
+	}
+
+	base, err := url.Parse(fmt.Sprintf("%s/v1/sso/%s", strings.TrimSuffix(h.SSOBaseURL, "/"), url.PathEscape(orgID)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadataURL := base.JoinPath("metadata")
+	acsURL := base.JoinPath("acs")
+
+	sp := &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+		IDPMetadata: &saml.EntityDescriptor{
+			EntityID: setting.SSOIssuer,
+			IDPSSODescriptors: []saml.IDPSSODescriptor{{
+				SSODescriptor: saml.SSODescriptor{
+					RoleDescriptor: saml.RoleDescriptor{
+						ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol",
+						KeyDescriptors: []saml.KeyDescriptor{{
+							Use: "signing",
+							KeyInfo: saml.KeyInfo{
+								X509Data: saml.X509Data{
+									X509Certificates: []saml.X509Certificate{{
+										Data: base64.StdEncoding.EncodeToString(cert.Raw),
+									}},
+								},
+							},
+						}},
+					},
+				},
+				SingleSignOnServices: []saml.Endpoint{{
+					Binding:  saml.HTTPRedirectBinding,
+					Location: setting.SSOEntrypoint,
+				}},
+			}},
+		},
+	}
+
+	return sp, setting, nil
+}
+
+// samlErrorResponse returns the response for an error building the service provider of the organization
+func (h *Handler) samlErrorResponse(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrSSONotEnabled), errors.Is(err, ErrSSONotConfigured):
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(err))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+}
+
+// linkOrCreateSAMLUser looks up the user by the asserted email, an existing user is only signed in when they
+// are already a member of the organization so the identity provider cannot sign in to accounts it does not
+// manage. A user that does not exist is created along with their personal org and added to the organization
+func (h *Handler) linkOrCreateSAMLUser(ctx context.Context, orgID string, info *SAMLUserInfo) (*ent.User, error) {
+	user, err := h.getUserByEmail(ctx, info.Email)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, ErrProcessingRequest
+	}
+
+	if user == nil {
+		if user, err = h.createSAMLUser(ctx, info); err != nil {
+			return nil, err
+		}
+
+		if err := h.addUserToOrganization(ctx, user.ID, orgID, orgmembership.RoleMember); err != nil {
+			return nil, ErrProcessingRequest
+		}
+
+		return user, nil
+	}
+
+	member, err := h.isOrgMember(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, ErrProcessingRequest
+	}
+
+	if !member {
+		return nil, ErrSSOUserNotMember
+	}
+
+	if err := checkAccountUsable(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// createSAMLUser creates a new user from the asserted user info, the email is already verified by the identity provider
+func (h *Handler) createSAMLUser(ctx context.Context, info *SAMLUserInfo) (*ent.User, error) {
+	firstName, lastName := info.FirstName, info.LastName
+	if firstName == "" {
+		firstName, _, _ = strings.Cut(info.Email, "@")
+	}
+
+	if lastName == "" {
+		lastName = firstName
+	}
+
+	input := ent.CreateUserInput{
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     info.Email,
+	}
+
+	ctxWithToken := token.NewContextWithSignUpToken(ctx, info.Email)
+
+	meowuser, err := h.createUser(ctxWithToken, input)
+	if err != nil {
+		if IsUniqueConstraintError(err) {
+			return nil, ErrDuplicate
+		}
+
+		return nil, ErrProcessingRequest
+	}
+
+	viewerCtx := viewer.NewContext(ctxWithToken, viewer.NewUserViewerFromID(meowuser.ID, true))
+
+	// the user is queried again to load the settings edge
+	meowuser, err = h.getUserByEmail(viewerCtx, info.Email)
+	if err != nil {
+		return nil, ErrProcessingRequest
+	}
+
+	if err := h.setEmailConfirmed(viewerCtx, meowuser); err != nil {
+		return nil, ErrProcessingRequest
+	}
+
+	return meowuser, nil
+}
+
+// samlUserInfo returns the user details from the assertion, the email is taken from the name id when
+// it is an email address and otherwise from the assertion attributes
+func samlUserInfo(assertion *saml.Assertion) (*SAMLUserInfo, error) {
+	info := &SAMLUserInfo{}
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		if addr, err := mail.ParseAddress(assertion.Subject.NameID.Value); err == nil {
+			info.Email = addr.Address
+		}
+	}
+
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			if len(attr.Values) == 0 {
+				continue
+			}
+
+			value := strings.TrimSpace(attr.Values[0].Value)
+
+			switch {
+			case info.Email == "" && samlAttributeIs(attr, samlEmailAttributes):
+				info.Email = value
+			case info.FirstName == "" && samlAttributeIs(attr, samlFirstNameAttributes):
+				info.FirstName = value
+			case info.LastName == "" && samlAttributeIs(attr, samlLastNameAttributes):
+				info.LastName = value
+			}
+		}
+	}
+
+	if info.Email == "" {
+		return nil, newMissingRequiredFieldError("email")
+	}
+
+	return info, nil
+}
+
+// samlAttributeIs returns true when the name or friendly name of the attribute is one of names
+func samlAttributeIs(attr saml.Attribute, names []string) bool {
+	for _, n := range names {
+		if strings.EqualFold(attr.Name, n) || strings.EqualFold(attr.FriendlyName, n) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ssoDomainAllowed returns true when the domain of the email is one of the organization domains
+func ssoDomainAllowed(domains []string, email string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+
+	for _, d := range domains {
+		if strings.EqualFold(strings.TrimPrefix(d, "@"), domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseSSOCert parses the identity provider certificate, which may be PEM encoded or base64 encoded DER
+// as it appears in the identity provider metadata
+func parseSSOCert(raw string) (*x509.Certificate, error) {
+	if block, _ := pem.Decode([]byte(raw)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(raw), ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// samlRequestCookieConfig returns the settings of the authn request cookie, same site must be none
+// for the cookie to be sent when the identity provider posts the response
+func samlRequestCookieConfig() store.Config {
+	return store.Config{
+		Path:     "/",
+		MaxAge:   samlRequestMaxAge,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+		HttpOnly: true,
+	}
+}
@@ -0,0 +1,400 @@
+package handlers_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/crewjam/saml"
+	echo "github.com/datumforge/echox"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+const (
+	testSSOBaseURL   = "http://localhost:17608"
+	testIdPEntityID  = "https://idp.example.com/metadata"
+	testIdPSSOURL    = "https://idp.example.com/sso"
+	testSSOOrgDomain = "meow.net"
+)
+
+// testSAMLIdP is a local stand-in for an organization's SAML identity provider used to test the sso flow
+type testSAMLIdP struct {
+	idp *saml.IdentityProvider
+	sps map[string]*saml.EntityDescriptor
+}
+
+func newTestSAMLIdP(t *testing.T) *testSAMLIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048) // nolint: gomnd
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	metadataURL, _ := url.Parse(testIdPEntityID)
+	ssoURL, _ := url.Parse(testIdPSSOURL)
+
+	i := &testSAMLIdP{
+		sps: map[string]*saml.EntityDescriptor{},
+	}
+
+	i.idp = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		ServiceProviderProvider: i,
+	}
+
+	return i
+}
+
+// GetServiceProvider returns the registered service provider metadata
+func (i *testSAMLIdP) GetServiceProvider(_ *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	sp, ok := i.sps[serviceProviderID]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	return sp, nil
+}
+
+// certPEM returns the signing certificate that is configured on the organization
+func (i *testSAMLIdP) certPEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: i.idp.Certificate.Raw}))
+}
+
+// register adds the service provider metadata served by the metadata endpoint
+func (i *testSAMLIdP) register(t *testing.T, e *echo.Echo, orgID string) {
+	req := httptest.NewRequest(http.MethodGet, "/sso/"+orgID+"/metadata", nil)
+	recorder := httptest.NewRecorder()
+
+	e.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	sp := &saml.EntityDescriptor{}
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), sp))
+
+	i.sps[sp.EntityID] = sp
+}
+
+// authenticate simulates the user signing in to the identity provider, returning the signed
+// response that would be posted to the assertion consumer service
+func (i *testSAMLIdP) authenticate(t *testing.T, authURL string, session *saml.Session) saml.IdpAuthnRequestForm {
+	req, err := saml.NewIdpAuthnRequest(i.idp, httptest.NewRequest(http.MethodGet, authURL, nil))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, session))
+
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+
+	return form
+}
+
+func TestSSOHandlers(t *testing.T) {
+	h := handlerSetup(t)
+	h.SSOBaseURL = testSSOBaseURL
+
+	idp := newTestSAMLIdP(t)
+
+	// a second identity provider with the same issuer but a different signing certificate
+	forger := newTestSAMLIdP(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the orgs without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	setting := EntClient.OrganizationSetting.Create().
+		SetDomains([]string{testSSOOrgDomain}).
+		SetSSOCert(idp.certPEM()).
+		SetSSOEntrypoint(testIdPSSOURL).
+		SetSSOIssuer(testIdPEntityID).
+		SaveX(ctx)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SetSettingID(setting.ID).
+		SaveX(ctx)
+
+	// an organization with the default settings, without sso configured
+	unconfigured := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	// an organization with the identity provider configured but no domains the assertions are trusted for
+	noDomainsSetting := EntClient.OrganizationSetting.Create().
+		SetSSOCert(idp.certPEM()).
+		SetSSOEntrypoint(testIdPSSOURL).
+		SetSSOIssuer(testIdPEntityID).
+		SaveX(ctx)
+
+	noDomains := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SetSettingID(noDomainsSetting.ID).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.GET("sso/:org/metadata", h.SSOMetadataHandler)
+	e.GET("sso/:org/login", h.SSOLoginHandler)
+	e.POST("sso/:org/acs", h.SSOAssertionConsumerHandler)
+
+	idp.register(t, e, org.ID)
+	forger.register(t, e, org.ID)
+
+	newEmail := "rick@" + testSSOOrgDomain
+
+	// an existing user with an email on the organization domain that is not a member of the organization
+	outsider := createTransferUser(ctx, t, "beth@"+testSSOOrgDomain)
+
+	testCases := []struct {
+		name           string
+		org            string
+		idp            *testSAMLIdP
+		session        *saml.Session
+		noCookie       bool
+		expectedErr    error
+		expectedStatus int
+	}{
+		{
+			name: "happy path, new user",
+			org:  org.ID,
+			idp:  idp,
+			session: &saml.Session{
+				NameID:        newEmail,
+				NameIDFormat:  string(saml.EmailAddressNameIDFormat),
+				UserGivenName: "Rick",
+				UserSurname:   "Sanchez",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "happy path, returning user",
+			org:  org.ID,
+			idp:  idp,
+			session: &saml.Session{
+				NameID:       newEmail,
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "email domain not allowed",
+			org:  org.ID,
+			idp:  idp,
+			session: &saml.Session{
+				NameID:       "morty@datum.net",
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedErr:    handlers.ErrSSODomainNotAllowed,
+		},
+		{
+			name: "existing user not a member of the organization",
+			org:  org.ID,
+			idp:  idp,
+			session: &saml.Session{
+				NameID:       outsider.Email,
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedErr:    handlers.ErrSSOUserNotMember,
+		},
+		{
+			name: "response not signed by the organization certificate",
+			org:  org.ID,
+			idp:  forger,
+			session: &saml.Session{
+				NameID:       "summer@" + testSSOOrgDomain,
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedErr:    handlers.ErrInvalidCredentials,
+		},
+		{
+			name: "response without a request started by the user",
+			org:  org.ID,
+			idp:  idp,
+			session: &saml.Session{
+				NameID:       newEmail,
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			noCookie:       true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrInvalidSAMLRequest,
+		},
+		{
+			name:           "sso not configured for the organization",
+			org:            unconfigured.ID,
+			expectedStatus: http.StatusNotFound,
+			expectedErr:    handlers.ErrSSONotConfigured,
+		},
+		{
+			name:           "sso without domains for the organization",
+			org:            noDomains.ID,
+			expectedStatus: http.StatusNotFound,
+			expectedErr:    handlers.ErrSSONotConfigured,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/sso/"+tc.org+"/login", nil)
+			recorder := httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			if tc.expectedStatus == http.StatusNotFound {
+				assert.Equal(t, tc.expectedStatus, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), tc.expectedErr.Error())
+
+				return
+			}
+
+			require.Equal(t, http.StatusFound, recorder.Code)
+
+			authURL := recorder.Header().Get("Location")
+			require.True(t, strings.HasPrefix(authURL, testIdPSSOURL))
+
+			form := tc.idp.authenticate(t, authURL, tc.session)
+			assert.Equal(t, testSSOBaseURL+"/v1/sso/"+tc.org+"/acs", form.URL)
+
+			body := url.Values{"SAMLResponse": {form.SAMLResponse}}.Encode()
+			req = httptest.NewRequest(http.MethodPost, "/sso/"+tc.org+"/acs", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+
+			// send the request cookie set on the login request
+			if !tc.noCookie {
+				for _, c := range recorder.Result().Cookies() {
+					req.AddCookie(c)
+				}
+			}
+
+			recorder = httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				assert.Contains(t, recorder.Body.String(), tc.expectedErr.Error())
+
+				return
+			}
+
+			// the access token is scoped to the organization
+			var accessToken string
+
+			for _, c := range res.Cookies() {
+				if c.Name == auth.AccessTokenCookie {
+					accessToken = c.Value
+				}
+			}
+
+			require.NotEmpty(t, accessToken)
+
+			claims, err := h.TM.Verify(accessToken)
+			require.NoError(t, err)
+			assert.Equal(t, org.ID, claims.OrgID)
+
+			// the user is provisioned into the organization once
+			u, err := EntClient.User.Query().WithSetting().Where(user.Email(newEmail)).Only(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, u.ID, claims.UserID)
+			assert.True(t, u.Edges.Setting.EmailConfirmed)
+			assert.Equal(t, "Rick", u.FirstName)
+			assert.Equal(t, "Sanchez", u.LastName)
+
+			members, err := u.QueryOrganizations().Where(organization.ID(org.ID)).Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, members)
+		})
+	}
+}
+
+func TestSSOMetadataHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	idp := newTestSAMLIdP(t)
+
+	setting := EntClient.OrganizationSetting.Create().
+		SetDomains([]string{testSSOOrgDomain}).
+		SetSSOCert(idp.certPEM()).
+		SetSSOEntrypoint(testIdPSSOURL).
+		SetSSOIssuer(testIdPEntityID).
+		SaveX(ctx)
+
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SetSettingID(setting.ID).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.GET("sso/:org/metadata", h.SSOMetadataHandler)
+
+	doMetadata := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/sso/"+org.ID+"/metadata", nil)
+		recorder := httptest.NewRecorder()
+
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	// sso is disabled when the base url is not configured
+	recorder := doMetadata()
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), handlers.ErrSSONotEnabled.Error())
+
+	h.SSOBaseURL = testSSOBaseURL
+
+	recorder = doMetadata()
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	sp := &saml.EntityDescriptor{}
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), sp))
+
+	assert.Equal(t, testSSOBaseURL+"/v1/sso/"+org.ID+"/metadata", sp.EntityID)
+	require.Len(t, sp.SPSSODescriptors, 1)
+	assert.Equal(t, testSSOBaseURL+"/v1/sso/"+org.ID+"/acs", sp.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+}
@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/session"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+)
+
+func TestLoginSession(t *testing.T) {
+	h := handlerSetup(t)
+
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := createTokenManager(-60 * time.Minute) //nolint:gomnd
+	require.NoError(t, err)
+
+	h.TM = tm
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	email := gofakeit.Email()
+	password := "sup3rs3cu7e!"
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(password).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	e := setupEcho(h.SM)
+	e.POST("login", h.LoginHandler)
+	e.POST("refresh", h.RefreshHandler)
+	e.GET("protected", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, handlers.Response{Message: "success"})
+	}, auth.Authenticate(auth.WithValidator(h.TM), auth.WithRevocationChecker(h)))
+
+	// doRequest returns the status, message and token cookies of the response
+	doRequest := func(method, path, token, body string) (int, string, map[string]string) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("User-Agent", "datum-test")
+
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		var out *handlers.Response
+		if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+			t.Error("error parsing response", err)
+		}
+
+		cookies := map[string]string{}
+		for _, c := range res.Cookies() {
+			cookies[c.Name] = c.Value
+		}
+
+		return recorder.Code, out.Message, cookies
+	}
+
+	refreshBody := func(token string) string {
+		body, err := json.Marshal(handlers.RefreshRequest{RefreshToken: token})
+		require.NoError(t, err)
+
+		return string(body)
+	}
+
+	loginBody, err := json.Marshal(handlers.LoginRequest{Username: email, Password: password})
+	require.NoError(t, err)
+
+	status, _, cookies := doRequest(http.MethodPost, "/login", "", string(loginBody))
+	require.Equal(t, http.StatusOK, status)
+
+	// the login started a session of the user
+	sess, err := EntClient.Session.Query().Where(session.UserID(user.ID)).Only(entcache.Skip(ctx))
+	require.NoError(t, err)
+
+	assert.Equal(t, "datum-test", sess.UserAgent)
+	assert.NotEmpty(t, sess.IPAddress)
+	assert.True(t, sess.ExpiresAt.After(time.Now()))
+	assert.Nil(t, sess.LastSeenAt)
+	assert.Nil(t, sess.RevokedAt)
+
+	// refreshing the tokens records the activity of the same session
+	status, _, cookies = doRequest(http.MethodPost, "/refresh", "", refreshBody(cookies[auth.RefreshTokenCookie]))
+	require.Equal(t, http.StatusOK, status)
+
+	sessions, err := EntClient.Session.Query().Where(session.UserID(user.ID)).All(entcache.Skip(ctx))
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.NotNil(t, sessions[0].LastSeenAt)
+
+	access := cookies[auth.AccessTokenCookie]
+
+	status, _, _ = doRequest(http.MethodGet, "/protected", access, "")
+	assert.Equal(t, http.StatusOK, status)
+
+	// revoking the session rejects the tokens issued for it
+	EntClient.Session.UpdateOneID(sess.ID).SetRevokedAt(time.Now()).ExecX(ctx)
+
+	t.Run("access token of revoked session", func(t *testing.T) {
+		status, _, _ := doRequest(http.MethodGet, "/protected", access, "")
+		assert.NotEqual(t, http.StatusOK, status)
+	})
+
+	t.Run("refresh token of revoked session", func(t *testing.T) {
+		status, msg, _ := doRequest(http.MethodPost, "/refresh", "", refreshBody(cookies[auth.RefreshTokenCookie]))
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Contains(t, msg, "token has been revoked")
+	})
+
+	// cleanup after
+	EntClient.Session.DeleteOneID(sess.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/fga"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/transaction"
+)
+
+// SwitchOrganizationRequest contains the organization the new token pair is scoped to
+type SwitchOrganizationRequest struct {
+	TargetOrganizationID string `json:"target_organization_id"`
+}
+
+// SwitchOrganizationReply contains the organization and entitlement tier of the new token pair
+type SwitchOrganizationReply struct {
+	OrganizationID string `json:"organization_id"`
+	Tier           string `json:"tier"`
+}
+
+// SwitchHandler reissues the access and refresh tokens of the authenticated user scoped to the target
+// organization, with the entitlement tier of the organization. Queries made with the new tokens are
+// filtered to the organization and its child organizations by default. Only users that logged in can
+// switch organizations, api keys are bound to their organization and personal access tokens and tokens
+// issued to oauth clients cannot be exchanged for a login
+func (h *Handler) SwitchHandler(ctx echo.Context) error {
+	var in SwitchOrganizationRequest
+
+	// parse request body
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+		h.Logger.Errorw("error parsing request", "error", err)
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+	}
+
+	if in.TargetOrganizationID == "" {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(newMissingRequiredFieldError("target_organization_id")))
+	}
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(err))
+	}
+
+	// tokens issued to oauth clients are limited to the scopes the user granted the client
+	if claims.IsService() || claims.ClientID != "" {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrSwitchNotAllowed))
+	}
+
+	// api keys and personal access tokens are opaque, the access token is only missing from the request
+	// when the auth middleware refreshed the login from the refresh token cookie
+	if token, err := auth.GetAccessToken(ctx); err == nil && !auth.IsJWT(token) {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrSwitchNotAllowed))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	user, err := h.getUserByID(reqCtx, claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// ensure the user is still active and not locked
+	if err := checkAccountUsable(user); err != nil {
+		return ctx.JSON(accountErrorStatus(err, http.StatusUnauthorized), ErrorResponse(err))
+	}
+
+	org, err := h.getOrgByID(reqCtx, in.TargetOrganizationID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrOrganizationNotFound))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	access, err := h.checkOrgAccess(ctx, org.ID, user.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// organizations the user cannot access are not found, to avoid exposing which organizations exist
+	if !access {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrOrganizationNotFound))
+	}
+
+	tier, err := h.getOrgTier(reqCtx, org.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	newClaims := createClaims(user)
+	newClaims.OrgID = org.ID
+	newClaims.ParentOrgID = org.ParentOrganizationID
+	newClaims.Tier = string(tier)
+
+	// the switch starts a new token family, the same as logging in
+	accessToken, refreshToken, err := h.createTokenPair(ctx, newClaims, nil)
+	if err != nil {
+		h.Logger.Errorw("error creating token pair", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// set cookies on request with the access and refresh token
+	// when cookie domain is localhost, this is dropped but expected
+	if err := auth.SetAuthCookies(ctx, accessToken, refreshToken, h.CookieDomain); err != nil {
+		h.Logger.Errorw("error setting cookies", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(user.ID, true))
+
+	if err := h.updateUserLastSeen(userCtx, user.ID); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	out := Response{
+		Message: "success",
+		Data: SwitchOrganizationReply{
+			OrganizationID: org.ID,
+			Tier:           string(tier),
+		},
+	}
+
+	return ctx.JSON(http.StatusOK, out)
+}
+
+// checkOrgAccess returns true when the user can view the organization, when authorization is not enabled
+// the user must be a member of the organization
+func (h *Handler) checkOrgAccess(ctx echo.Context, orgID, userID string) (bool, error) {
+	reqCtx := ctx.Request().Context()
+
+	tx := transaction.FromContext(reqCtx)
+	if tx.Authz.Ofga == nil {
+		return h.isOrgMember(reqCtx, orgID, userID)
+	}
+
+	access, err := tx.Authz.CheckOrgAccess(reqCtx, userID, fga.UserSubjectType, orgID, fga.CanView)
+	if err != nil {
+		h.Logger.Errorw("error checking organization access", "error", err)
+
+		return false, err
+	}
+
+	return access, nil
+}
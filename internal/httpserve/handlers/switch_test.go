@@ -0,0 +1,208 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+func TestSwitchHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users and orgs without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	user := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	// an organization the user is a member of with a pro entitlement, and a cancelled enterprise entitlement
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	EntClient.Entitlement.Create().
+		SetOwner(org).
+		SetTier(entitlement.TierPro).
+		SaveX(ctx)
+
+	EntClient.Entitlement.Create().
+		SetOwner(org).
+		SetTier(entitlement.TierEnterprise).
+		SetCancelled(true).
+		SaveX(ctx)
+
+	// a child organization without entitlements
+	child := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SetParentID(org.ID).
+		AddUserIDs(user.ID).
+		SaveX(ctx)
+
+	// an organization the user is not a member of
+	otherOrg := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	userAccess, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID,
+		},
+		UserID: user.ID,
+		Email:  user.Email,
+	})
+	require.NoError(t, err)
+
+	serviceAccess, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ulids.New().String(),
+		},
+		OrgID:       org.ID,
+		SubjectType: tokens.SubjectTypeService,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.POST("switch", h.SwitchHandler, auth.Authenticate(auth.WithValidator(h.TM)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		orgID          string
+		expectedParent string
+		expectedTier   string
+		expectedErr    string
+		expectedStatus int
+	}{
+		{
+			name:           "happy path",
+			token:          userAccess,
+			orgID:          org.ID,
+			expectedTier:   "pro",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "child organization",
+			token:          userAccess,
+			orgID:          child.ID,
+			expectedParent: org.ID,
+			expectedTier:   "free",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "not a member of the organization",
+			token:          userAccess,
+			orgID:          otherOrg.ID,
+			expectedErr:    handlers.ErrOrganizationNotFound.Error(),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "organization does not exist",
+			token:          userAccess,
+			orgID:          ulids.New().String(),
+			expectedErr:    handlers.ErrOrganizationNotFound.Error(),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing organization",
+			token:          userAccess,
+			expectedErr:    "target_organization_id is required",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "service account cannot switch",
+			token:          serviceAccess,
+			orgID:          otherOrg.ID,
+			expectedErr:    handlers.ErrSwitchNotAllowed.Error(),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(handlers.SwitchOrganizationRequest{TargetOrganizationID: tc.orgID})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/switch", strings.NewReader(string(body)))
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *handlers.Response
+
+			// parse request body
+			if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+				t.Error("error parsing response", err)
+			}
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				assert.Contains(t, out.Message, tc.expectedErr)
+
+				return
+			}
+
+			assert.Equal(t, "success", out.Message)
+
+			// the new access token is scoped to the organization
+			var access string
+
+			for _, c := range res.Cookies() {
+				if c.Name == auth.AccessTokenCookie {
+					access = c.Value
+				}
+			}
+
+			require.NotEmpty(t, access)
+
+			claims, err := h.TM.Verify(access)
+			require.NoError(t, err)
+
+			assert.Equal(t, user.ID, claims.UserID)
+			assert.Equal(t, tc.orgID, claims.OrgID)
+			assert.Equal(t, tc.expectedParent, claims.ParentOrgID)
+			assert.Equal(t, tc.expectedTier, claims.Tier)
+		})
+	}
+
+	// cleanup after
+	EntClient.Organization.DeleteOneID(child.ID).ExecX(ctx)
+	EntClient.Organization.DeleteOneID(org.ID).ExecX(ctx)
+	EntClient.Organization.DeleteOneID(otherOrg.ID).ExecX(ctx)
+	EntClient.User.DeleteOneID(user.ID).ExecX(ctx)
+}
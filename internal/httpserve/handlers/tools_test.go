@@ -4,8 +4,11 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
 	"log"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,18 +25,22 @@ import (
 	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/internal/httpserve/middleware/session"
 	"github.com/datumforge/datum/internal/httpserve/middleware/transaction"
+	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/testutils"
 	"github.com/datumforge/datum/internal/tokens"
 	"github.com/datumforge/datum/internal/utils/marionette"
 )
 
 var (
-	EntClient   *ent.Client
-	DBContainer *testutils.TC
+	EntClient      *ent.Client
+	DBContainer    *testutils.TC
+	PasswordPolicy *passwd.Policy
 
 	// commonly used vars in tests
 	emptyResponse = "null\n"
 	validPassword = "sup3rs3cu7e!"
+	// breachedPassword is a strong password in the breached password corpus of the password policy
+	breachedPassword = "c0rrect-h0rse-Battery"
 
 	// mock email send settings
 	maxWaitInMillis      = 2000
@@ -75,11 +82,12 @@ func handlerSetup(t *testing.T) *handlers.Handler {
 	sm := scs.New()
 
 	h := &handlers.Handler{
-		TM:           tm,
-		DBClient:     EntClient,
-		Logger:       zaptest.NewLogger(t, zaptest.Level(zap.ErrorLevel)).Sugar(),
-		CookieDomain: "datum.net",
-		SM:           sm,
+		TM:             tm,
+		DBClient:       EntClient,
+		Logger:         zaptest.NewLogger(t, zaptest.Level(zap.ErrorLevel)).Sugar(),
+		CookieDomain:   "datum.net",
+		SM:             sm,
+		PasswordPolicy: PasswordPolicy,
 	}
 
 	if err := h.NewTestEmailManager(); err != nil {
@@ -122,7 +130,9 @@ func setupDB() {
 
 	entConfig := entdb.NewDBConfig(dbconf, logger)
 
-	opts := []ent.Option{ent.Logger(*logger)}
+	PasswordPolicy = newPasswordPolicy()
+
+	opts := []ent.Option{ent.Logger(*logger), ent.PasswordPolicy(PasswordPolicy)}
 
 	c, err := entConfig.NewMultiDriverDBClient(ctx, opts)
 	if err != nil {
@@ -134,6 +144,24 @@ func setupDB() {
 	EntClient = c
 }
 
+// newPasswordPolicy returns the password policy of the tests, the last three passwords cannot be reused and the
+// breached password is in the breached password corpus
+func newPasswordPolicy() *passwd.Policy {
+	sum := sha1.Sum([]byte(breachedPassword)) //nolint:gosec
+
+	corpus, err := passwd.ReadBreachCorpus(strings.NewReader(hex.EncodeToString(sum[:]) + ":42"))
+	errPanic("failed reading breached password corpus", err)
+
+	policy, err := passwd.NewPolicy(passwd.PolicyConfig{
+		MinStrength: passwd.Moderate,
+		MinLength:   8, //nolint:gomnd
+		HistorySize: 3, //nolint:gomnd
+	})
+	errPanic("failed creating password policy", err)
+
+	return policy.WithBreachCorpus(corpus)
+}
+
 func teardownDB() {
 	if EntClient != nil {
 		errPanic("teardown failed to close database connection", EntClient.Close())
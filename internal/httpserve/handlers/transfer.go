@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/marionette"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// Transfer holds the data of an ownership transfer that is emailed to the recipient
+type Transfer struct {
+	ID                 string
+	Token              string
+	Recipient          string
+	RecipientFirstName string
+	RecipientLastName  string
+	RequestorName      string
+	ResourceType       string
+	ResourceName       string
+}
+
+// TransferReply holds the fields that are sent on a response to the `/transfer` endpoint
+type TransferReply struct {
+	ID             string `json:"user_id"`
+	Message        string `json:"message"`
+	OrganizationID string `json:"organization_id"`
+	GroupID        string `json:"group_id,omitempty"`
+}
+
+// TransferHandler accepts the ownership transfer of the token in the request for the authenticated user, who
+// becomes an owner of the organization, or admin of the group, in place of the user who requested the transfer
+func (h *Handler) TransferHandler(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(err))
+	}
+
+	// transfers are sent to users, api keys and oauth clients cannot accept them
+	if claims.IsService() || claims.ClientID != "" {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrTransferAcceptNotAllowed))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	t, err := h.verifyTransfer(reqCtx, reqToken)
+	if err != nil {
+		return transferErrorResponse(ctx, err)
+	}
+
+	if t.RecipientID != claims.UserID {
+		return ctx.JSON(http.StatusForbidden, ErrorResponse(ErrTransferRecipientMismatch))
+	}
+
+	if err := h.acceptTransfer(reqCtx, t); err != nil {
+		return transferErrorResponse(ctx, err)
+	}
+
+	out := &TransferReply{
+		ID:             t.RecipientID,
+		Message:        "The transfer was accepted!",
+		OrganizationID: t.OwnerID,
+		GroupID:        t.GroupID,
+	}
+
+	return ctx.JSON(http.StatusOK, out)
+}
+
+// DeclineTransferHandler declines the ownership transfer of the token in the request so it can no longer be
+// accepted, the token was emailed to the recipient so the request does not have to be authenticated
+func (h *Handler) DeclineTransferHandler(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	t, err := h.verifyTransfer(reqCtx, reqToken)
+	if err != nil {
+		return transferErrorResponse(ctx, err)
+	}
+
+	declined, err := h.setTransferStatus(reqCtx, t, ownershiptransfer.StatusDeclined)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if !declined {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrTransferNotPending))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// verifyTransfer returns the pending transfer of the token after verifying the token with the stored secret,
+// expired transfers are marked as expired so they are no longer listed as pending
+func (h *Handler) verifyTransfer(ctx context.Context, reqToken string) (*ent.OwnershipTransfer, error) {
+	t, err := h.getTransferByToken(ctx, reqToken)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransferTokenInvalid
+		}
+
+		return nil, err
+	}
+
+	if t.Status != ownershiptransfer.StatusPending {
+		return nil, ErrTransferNotPending
+	}
+
+	// Construct the transfer token from the database fields
+	token := &tokens.OwnershipTransferToken{
+		SigningInfo: tokens.SigningInfo{
+			ExpiresAt: t.Expires,
+		},
+	}
+
+	if token.UserID, err = ulids.Parse(t.RecipientID); err != nil {
+		h.Logger.Errorw("unable to parse recipient id", "error", err)
+
+		return nil, err
+	}
+
+	resourceID := t.OwnerID
+	if t.GroupID != "" {
+		resourceID = t.GroupID
+	}
+
+	if token.ResourceID, err = ulids.Parse(resourceID); err != nil {
+		h.Logger.Errorw("unable to parse resource id", "error", err)
+
+		return nil, err
+	}
+
+	// Verify the token with the stored secret
+	if err := token.Verify(reqToken, *t.Secret); err != nil {
+		if errors.Is(err, tokens.ErrTokenExpired) {
+			if _, err := h.setTransferStatus(ctx, t, ownershiptransfer.StatusExpired); err != nil {
+				return nil, err
+			}
+
+			return nil, ErrTransferExpired
+		}
+
+		return nil, ErrTransferTokenInvalid
+	}
+
+	return t, nil
+}
+
+// transferErrorResponse returns the response for the error of an ownership transfer
+func transferErrorResponse(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrTransferTokenInvalid), errors.Is(err, ErrTransferNotPending), errors.Is(err, ErrTransferExpired):
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	case errors.Is(err, hooks.ErrTransferRecipientNotOrgMember), errors.Is(err, hooks.ErrUserNotInGroupOrg):
+		// the recipient left the organization after the transfer was requested
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+}
+
+// SendOwnershipTransferEmail queues the email of the transfer to the recipient, with the link to accept the
+// transfer, it is called when a transfer is created
+func (h *Handler) SendOwnershipTransferEmail(ctx context.Context, t *ent.OwnershipTransfer) error {
+	// the transfer is emailed to a member of the organization who cannot yet edit it, so the lookups
+	// bypass the privacy policy
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	recipient, err := t.QueryRecipient().Only(allowCtx)
+	if err != nil {
+		h.Logger.Errorw("error obtaining recipient of transfer", "error", err)
+
+		return err
+	}
+
+	requestor, err := t.QueryRequestor().Only(allowCtx)
+	if err != nil {
+		h.Logger.Errorw("error obtaining requestor of transfer", "error", err)
+
+		return err
+	}
+
+	data := &Transfer{
+		ID:                 t.ID,
+		Token:              t.Token,
+		Recipient:          recipient.Email,
+		RecipientFirstName: recipient.FirstName,
+		RecipientLastName:  recipient.LastName,
+		RequestorName:      strings.TrimSpace(requestor.FirstName + " " + requestor.LastName),
+	}
+
+	if t.GroupID != "" {
+		g, err := t.QueryGroup().Only(allowCtx)
+		if err != nil {
+			h.Logger.Errorw("error obtaining group of transfer", "error", err)
+
+			return err
+		}
+
+		data.ResourceType = "group"
+		data.ResourceName = g.DisplayName
+	} else {
+		org, err := t.QueryOwner().Only(allowCtx)
+		if err != nil {
+			h.Logger.Errorw("error obtaining organization of transfer", "error", err)
+
+			return err
+		}
+
+		data.ResourceType = "organization"
+		data.ResourceName = org.DisplayName
+	}
+
+	// send emails via TaskMan as to not create blocking operations in the server
+	return h.TaskMan.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+		return h.SendTransferEmail(data)
+	}), marionette.WithRetries(3), // nolint: gomnd
+		marionette.WithBackoff(backoff.NewExponentialBackOff()),
+		marionette.WithErrorf("could not send ownership transfer email for transfer %s", t.ID),
+	)
+}
@@ -0,0 +1,323 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rShetty/asyncwait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/ownershiptransfer"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
+	"github.com/datumforge/datum/internal/utils/emails"
+	"github.com/datumforge/datum/internal/utils/emails/mock"
+	"github.com/datumforge/datum/internal/utils/ulids"
+)
+
+// createTransferUser creates a user with a confirmed email address
+func createTransferUser(ctx context.Context, t *testing.T, email string) *ent.User {
+	t.Helper()
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	return EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+}
+
+// createTransfer creates an organization owned by the requestor, with the recipient as a member, and the
+// transfer of the organization to the recipient
+func createTransfer(t *testing.T, requestorID, recipientID string) *ent.OwnershipTransfer {
+	t.Helper()
+
+	// the requestor of the transfer is the authenticated user of the request
+	userEc, err := auth.NewTestContextWithValidUser(requestorID)
+	require.NoError(t, err)
+
+	reqCtx := context.WithValue(userEc.Request().Context(), echocontext.EchoContextKey, userEc)
+	ctx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	// the user creating the organization is its owner
+	org := EntClient.Organization.Create().
+		SetName(gofakeit.Name()).
+		SaveX(ctx)
+
+	EntClient.OrgMembership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(recipientID).
+		ExecX(ctx)
+
+	return EntClient.OwnershipTransfer.Create().
+		SetOwnerID(org.ID).
+		SetRecipientID(recipientID).
+		SaveX(ctx)
+}
+
+func TestTransferHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users, orgs and transfers without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	requestor := createTransferUser(ctx, t, "lord.farquaad@datum.net")
+	recipient := createTransferUser(ctx, t, "princess.fiona@datum.net")
+	other := createTransferUser(ctx, t, "puss.inboots@datum.net")
+
+	accepted := createTransfer(t, requestor.ID, recipient.ID)
+	another := createTransfer(t, requestor.ID, other.ID)
+
+	expired := createTransfer(t, requestor.ID, recipient.ID)
+	EntClient.OwnershipTransfer.UpdateOne(expired).SetExpires(time.Now().Add(-time.Hour)).ExecX(ctx)
+
+	canceled := createTransfer(t, requestor.ID, recipient.ID)
+	EntClient.OwnershipTransfer.UpdateOne(canceled).SetStatus(ownershiptransfer.StatusCanceled).ExecX(ctx)
+
+	access, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: recipient.ID,
+		},
+		UserID: recipient.ID,
+		Email:  recipient.Email,
+	})
+	require.NoError(t, err)
+
+	serviceAccess, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ulids.New().String(),
+		},
+		OrgID:       accepted.OwnerID,
+		SubjectType: tokens.SubjectTypeService,
+	})
+	require.NoError(t, err)
+
+	e := setupEcho(h.SM)
+	e.GET("transfer", h.TransferHandler, auth.Authenticate(auth.WithValidator(h.TM)))
+
+	testCases := []struct {
+		name           string
+		token          string
+		access         string
+		orgID          string
+		expectedErr    string
+		expectedStatus int
+	}{
+		{
+			name:           "happy path",
+			token:          accepted.Token,
+			access:         access,
+			orgID:          accepted.OwnerID,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "already accepted",
+			token:          accepted.Token,
+			access:         access,
+			expectedErr:    handlers.ErrTransferNotPending.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "transfer to another user",
+			token:          another.Token,
+			access:         access,
+			expectedErr:    handlers.ErrTransferRecipientMismatch.Error(),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "expired transfer",
+			token:          expired.Token,
+			access:         access,
+			expectedErr:    handlers.ErrTransferExpired.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "canceled transfer",
+			token:          canceled.Token,
+			access:         access,
+			expectedErr:    handlers.ErrTransferNotPending.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown token",
+			token:          "bogus",
+			access:         access,
+			expectedErr:    handlers.ErrTransferTokenInvalid.Error(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing token",
+			access:         access,
+			expectedErr:    "token is required",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "service account cannot accept",
+			token:          another.Token,
+			access:         serviceAccess,
+			expectedErr:    handlers.ErrTransferAcceptNotAllowed.Error(),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := "/transfer"
+			if tc.token != "" {
+				target += "?token=" + tc.token
+			}
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.Header.Set("Authorization", "Bearer "+tc.access)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				var out *handlers.Response
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+				assert.Contains(t, out.Message, tc.expectedErr)
+
+				return
+			}
+
+			var out *handlers.TransferReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, recipient.ID, out.ID)
+			assert.Equal(t, tc.orgID, out.OrganizationID)
+			assert.Empty(t, out.GroupID)
+
+			// the recipient is now the owner of the organization, and the requestor an admin
+			roles := map[string]orgmembership.Role{}
+			for _, om := range EntClient.OrgMembership.Query().Where(orgmembership.OrganizationID(tc.orgID)).AllX(ctx) {
+				roles[om.UserID] = om.Role
+			}
+
+			assert.Equal(t, orgmembership.RoleOwner, roles[recipient.ID])
+			assert.Equal(t, orgmembership.RoleAdmin, roles[requestor.ID])
+		})
+	}
+
+	assert.Equal(t, ownershiptransfer.StatusAccepted, EntClient.OwnershipTransfer.GetX(ctx, accepted.ID).Status)
+	assert.Equal(t, ownershiptransfer.StatusExpired, EntClient.OwnershipTransfer.GetX(ctx, expired.ID).Status)
+	assert.Equal(t, ownershiptransfer.StatusPending, EntClient.OwnershipTransfer.GetX(ctx, another.ID).Status)
+}
+
+func TestDeclineTransferHandler(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	requestor := createTransferUser(ctx, t, "gingerbread.man@datum.net")
+	recipient := createTransferUser(ctx, t, "magic.mirror@datum.net")
+
+	ot := createTransfer(t, requestor.ID, recipient.ID)
+
+	e := setupEcho(h.SM)
+	e.POST("transfer/decline", h.DeclineTransferHandler)
+
+	decline := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/transfer/decline?token="+token, nil)
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, req)
+
+		return recorder
+	}
+
+	recorder := decline(ot.Token)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	assert.Equal(t, ownershiptransfer.StatusDeclined, EntClient.OwnershipTransfer.GetX(ctx, ot.ID).Status)
+
+	// declined transfers can no longer be declined, or accepted
+	recorder = decline(ot.Token)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var out *handlers.Response
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+	assert.Contains(t, out.Message, handlers.ErrTransferNotPending.Error())
+
+	// the requestor is still the owner of the organization
+	om := EntClient.OrgMembership.Query().
+		Where(orgmembership.OrganizationID(ot.OwnerID), orgmembership.UserID(requestor.ID)).
+		OnlyX(ctx)
+
+	assert.Equal(t, orgmembership.RoleOwner, om.Role)
+}
+
+func TestSendOwnershipTransferEmail(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	requestor := EntClient.User.Create().
+		SetFirstName("Prince").
+		SetLastName("Charming").
+		SetEmail("prince.charming@datum.net").
+		SetPassword(validPassword).
+		SaveX(ctx)
+
+	recipient := createTransferUser(ctx, t, "fairy.godmother@datum.net")
+
+	ot := createTransfer(t, requestor.ID, recipient.ID)
+	assert.Equal(t, requestor.ID, ot.RequestorID)
+
+	sent := time.Now()
+
+	mock.ResetEmailMock()
+
+	require.NoError(t, h.SendOwnershipTransferEmail(ctx, ot))
+
+	predicate := func() bool {
+		return h.TaskMan.GetQueueLength() == 0
+	}
+	successful := asyncwait.NewAsyncWait(maxWaitInMillis, pollIntervalInMillis).Check(predicate)
+
+	if successful != true {
+		t.Errorf("max wait of email send")
+	}
+
+	mock.CheckEmails(t, []*mock.EmailMetadata{
+		{
+			To:        recipient.Email,
+			From:      h.SendGridConfig.FromEmail,
+			Subject:   fmt.Sprintf(emails.OwnershipTransferRE, "Prince Charming"),
+			Timestamp: sent,
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// UnlockAccount unlocks the account of a user that was locked after repeated failed logins, the unlock
+// token emailed to the user needs to be set in the request and not expired. If the request is successful
+// the user can log in again and a 204 no content is returned
+func (h *Handler) UnlockAccount(ctx echo.Context) error {
+	reqToken := ctx.QueryParam("token")
+
+	if err := validateVerifyRequest(reqToken); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	// lookup user from db based on provided token
+	entUser, err := h.getUserByUnlockToken(ctx.Request().Context(), reqToken)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrUnlockTokenInvalid))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	setting := entUser.Edges.Setting
+
+	if setting.UnlockTokenSecret == nil || setting.UnlockTokenExpiresAt == nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrUnlockTokenInvalid))
+	}
+
+	uid, err := ulid.Parse(entUser.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	// construct token from db fields
+	token := &tokens.UnlockToken{
+		UserID: uid,
+		SigningInfo: tokens.SigningInfo{
+			ExpiresAt: *setting.UnlockTokenExpiresAt,
+		},
+	}
+
+	// Verify the token is valid with the stored secret
+	if err = token.Verify(reqToken, *setting.UnlockTokenSecret); err != nil {
+		if errors.Is(err, tokens.ErrTokenExpired) {
+			user := &User{
+				ID:        entUser.ID,
+				FirstName: entUser.FirstName,
+				LastName:  entUser.LastName,
+				Email:     entUser.Email,
+			}
+
+			// the account stays locked, a new unlock token is emailed to the user
+			if err := h.lockAndSendUnlockToken(ctx.Request().Context(), setting.ID, user); err != nil {
+				return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+			}
+
+			out := &Response{
+				Message: "unlock token is expired, a new token has been sent to your email",
+			}
+
+			return ctx.JSON(http.StatusBadRequest, out)
+		}
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrUnlockTokenInvalid))
+	}
+
+	if err := h.unlockUserSetting(ctx.Request().Context(), setting.ID); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,231 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/rShetty/asyncwait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/utils/emails"
+	"github.com/datumforge/datum/internal/utils/emails/mock"
+)
+
+func TestUnlockAccount(t *testing.T) {
+	h := handlerSetup(t)
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	testCases := []struct {
+		name                 string
+		email                string
+		tokenSet             bool
+		tokenProvided        string
+		expiresAt            time.Time
+		emailExpected        bool
+		expectedEmailSubject string
+		expectedResp         string
+		expectedStatus       int
+		expectedLocked       bool
+	}{
+		{
+			name:           "happy path",
+			email:          "vin@datum.net",
+			tokenSet:       true,
+			expectedResp:   emptyResponse,
+			expectedStatus: http.StatusNoContent,
+			expectedLocked: false,
+		},
+		{
+			name:           "bad token",
+			email:          "elend@datum.net",
+			tokenSet:       true,
+			tokenProvided:  "thisisnotavalidtoken",
+			expectedResp:   "unlock token invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedLocked: true,
+		},
+		{
+			name:           "missing token",
+			email:          "breeze@datum.net",
+			tokenSet:       false,
+			expectedResp:   "token is required",
+			expectedStatus: http.StatusBadRequest,
+			expectedLocked: true,
+		},
+		{
+			name:                 "expired token, new token sent",
+			email:                "hammond@datum.net",
+			tokenSet:             true,
+			expiresAt:            time.Now().Add(-time.Hour),
+			emailExpected:        true,
+			expectedEmailSubject: emails.AccountLockedRE,
+			expectedResp:         "unlock token is expired, a new token has been sent to your email",
+			expectedStatus:       http.StatusBadRequest,
+			expectedLocked:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sent := time.Now()
+			mock.ResetEmailMock()
+
+			// create echo context with middleware
+			e := setupEcho(h.SM)
+			e.GET("unlock", h.UnlockAccount)
+
+			// create locked user in the database
+			setting, userID, err := createLockedUser(ec, tc.email, tc.expiresAt)
+			require.NoError(t, err)
+
+			target := "/unlock"
+			if tc.tokenSet {
+				token := *setting.UnlockToken
+				if tc.tokenProvided != "" {
+					token = tc.tokenProvided
+				}
+
+				target = fmt.Sprintf("%s?token=%s", target, token)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusNoContent {
+				var out *handlers.Response
+
+				// parse request body
+				if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+					t.Error("error parsing response", err)
+				}
+
+				assert.Contains(t, out.Message, tc.expectedResp)
+			}
+
+			ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+			updated := EntClient.UserSetting.GetX(entcache.Skip(ctx), setting.ID)
+			assert.Equal(t, tc.expectedLocked, updated.Locked)
+
+			if tc.expectedLocked {
+				assert.Equal(t, usersetting.StatusSuspended, updated.Status)
+			} else {
+				assert.Equal(t, usersetting.StatusActive, updated.Status)
+				assert.Nil(t, updated.UnlockToken)
+			}
+
+			if tc.emailExpected {
+				// a new unlock token replaces the expired token
+				require.NotNil(t, updated.UnlockToken)
+				assert.NotEqual(t, *setting.UnlockToken, *updated.UnlockToken)
+			}
+
+			messages := []*mock.EmailMetadata{
+				{
+					To:        tc.email,
+					From:      h.SendGridConfig.FromEmail,
+					Subject:   tc.expectedEmailSubject,
+					Timestamp: sent,
+				},
+			}
+
+			// wait for messages
+			predicate := func() bool {
+				return h.TaskMan.GetQueueLength() == 0
+			}
+			successful := asyncwait.NewAsyncWait(maxWaitInMillis, pollIntervalInMillis).Check(predicate)
+
+			if successful != true {
+				t.Errorf("max wait of email send")
+			}
+
+			if tc.emailExpected {
+				mock.CheckEmails(t, messages)
+			} else {
+				mock.CheckEmails(t, nil)
+			}
+
+			// cleanup after
+			EntClient.User.DeleteOneID(userID).ExecX(ctx)
+		})
+	}
+}
+
+// createLockedUser creates a user whose account was locked after failed logins with an unlock token, the token
+// expires at the provided time when set
+func createLockedUser(ec context.Context, email string, expiresAt time.Time) (*ent.UserSetting, string, error) {
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	u := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	user := handlers.User{
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+		ID:        u.ID,
+	}
+
+	// create token
+	if err := user.CreateUnlockToken(); err != nil {
+		return nil, "", err
+	}
+
+	exp, err := time.Parse(time.RFC3339Nano, user.UnlockExpires.String)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// set expiry if provided in test case
+	if !expiresAt.IsZero() {
+		exp = expiresAt
+	}
+
+	// lock the account and store the token in the db
+	setting := EntClient.UserSetting.UpdateOneID(userSetting.ID).
+		SetLocked(true).
+		SetStatus(usersetting.StatusSuspended).
+		SetSuspendedAt(time.Now()).
+		SetFailedLoginAttempts(10). //nolint:gomnd
+		SetUnlockToken(user.UnlockToken.String).
+		SetUnlockTokenSecret(user.UnlockSecret).
+		SetUnlockTokenExpiresAt(exp).
+		SaveX(ctx)
+
+	return setting, user.ID, nil
+}
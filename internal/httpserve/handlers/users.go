@@ -23,6 +23,12 @@ type User struct {
 	PasswordResetExpires     sql.NullString
 	PasswordResetToken       sql.NullString
 	PasswordResetSecret      []byte
+	MagicLinkExpires         sql.NullString
+	MagicLinkToken           sql.NullString
+	MagicLinkSecret          []byte
+	UnlockExpires            sql.NullString
+	UnlockToken              sql.NullString
+	UnlockSecret             []byte
 }
 
 // GetVerificationToken returns the verification token if its valid
@@ -105,3 +111,75 @@ func (u *User) CreatePasswordResetToken() error {
 
 	return nil
 }
+
+// GetMagicLinkToken returns the magic link login token if its valid
+func (u *User) GetMagicLinkToken() string {
+	if u.MagicLinkToken.Valid {
+		return u.MagicLinkToken.String
+	}
+
+	return ""
+}
+
+// GetMagicLinkExpires returns the expiration time of the magic link login token
+func (u *User) GetMagicLinkExpires() (time.Time, error) {
+	if u.MagicLinkExpires.Valid {
+		return time.Parse(time.RFC3339Nano, u.MagicLinkExpires.String)
+	}
+
+	return time.Time{}, nil
+}
+
+// CreateMagicLinkToken creates a new magic link login token for the user
+func (u *User) CreateMagicLinkToken() error {
+	// Create a unique token from the user's email address
+	magic, err := tokens.NewMagicLinkToken(u.Email)
+	if err != nil {
+		return err
+	}
+
+	// Sign the token to ensure that we can verify it later
+	token, secret, err := magic.Sign()
+	if err != nil {
+		return err
+	}
+
+	u.MagicLinkToken = sql.NullString{Valid: true, String: token}
+	u.MagicLinkExpires = sql.NullString{Valid: true, String: magic.ExpiresAt.Format(time.RFC3339Nano)}
+	u.MagicLinkSecret = secret
+
+	return nil
+}
+
+// GetUnlockToken returns the account unlock token if its valid
+func (u *User) GetUnlockToken() string {
+	if u.UnlockToken.Valid {
+		return u.UnlockToken.String
+	}
+
+	return ""
+}
+
+// CreateUnlockToken creates a new account unlock token for the user
+func (u *User) CreateUnlockToken() error {
+	uid, err := ulid.Parse(u.ID)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := tokens.NewUnlockToken(uid)
+	if err != nil {
+		return err
+	}
+
+	token, secret, err := unlock.Sign()
+	if err != nil {
+		return err
+	}
+
+	u.UnlockToken = sql.NullString{Valid: true, String: token}
+	u.UnlockExpires = sql.NullString{Valid: true, String: unlock.ExpiresAt.Format(time.RFC3339Nano)}
+	u.UnlockSecret = secret
+
+	return nil
+}
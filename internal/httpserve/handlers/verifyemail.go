@@ -96,7 +96,7 @@ func (h *Handler) VerifyEmail(ctx echo.Context) error {
 
 	claims := createClaims(entUser)
 
-	access, refresh, err := h.TM.CreateTokenPair(claims)
+	access, refresh, err := h.createTokenPair(ctx, claims, nil)
 	if err != nil {
 		h.Logger.Errorw("error creating token pair", "error", err)
 
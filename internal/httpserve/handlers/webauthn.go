@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	echo "github.com/datumforge/echox"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/privacy/viewer"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+)
+
+const (
+	webauthnRegistrationKey = "webauthn_registration"
+	webauthnLoginKey        = "webauthn_login"
+	webauthnNameKey         = "webauthn_name"
+)
+
+// WebauthnRegistrationRequest is the request to begin registering a new passkey for the authenticated user
+type WebauthnRegistrationRequest struct {
+	Name string `json:"name"`
+}
+
+// NewWebauthn creates the webauthn relying party used for passkey registration and login
+func NewWebauthn(rpID, displayName string, origins []string, timeout time.Duration) (*webauthn.WebAuthn, error) {
+	ceremonyTimeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    timeout,
+		TimeoutUVD: timeout,
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: displayName,
+		RPOrigins:     origins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        ceremonyTimeout,
+			Registration: ceremonyTimeout,
+		},
+	})
+}
+
+// webauthnUser wraps the ent user to satisfy the webauthn.User interface
+type webauthnUser struct {
+	user *ent.User
+}
+
+// WebAuthnID is the user handle, the user id is used so the user can be found on discoverable logins
+func (u *webauthnUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+// WebAuthnName is the human-palatable name of the user account
+func (u *webauthnUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+// WebAuthnDisplayName is the display name of the user account
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	return u.user.DisplayName
+}
+
+// WebAuthnIcon is deprecated by the specification and is always empty
+func (u *webauthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+// WebAuthnCredentials returns the passkeys registered by the user
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, len(u.user.Edges.WebauthnCredentials))
+
+	for i, c := range u.user.Edges.WebauthnCredentials {
+		transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+		for j, t := range c.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+
+		creds[i] = webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				SignCount: uint32(c.SignCount),
+			},
+		}
+	}
+
+	return creds
+}
+
+// BeginWebauthnRegistration starts the registration of a new passkey for the authenticated user, the
+// credential creation options are returned to be passed to the authenticator and the ceremony
+// session is stored to be validated when the registration is finished
+func (h *Handler) BeginWebauthnRegistration(ctx echo.Context) error {
+	if h.WebAuthn == nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrWebauthnNotEnabled))
+	}
+
+	var in WebauthnRegistrationRequest
+
+	// the name of the passkey is optional, an empty body is allowed
+	if ctx.Request().ContentLength > 0 {
+		if err := json.NewDecoder(ctx.Request().Body).Decode(&in); err != nil {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrBadRequest))
+		}
+	}
+
+	user, err := h.getWebauthnUserFromClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+	}
+
+	exclusions := []protocol.CredentialDescriptor{}
+	for _, c := range user.WebAuthnCredentials() {
+		exclusions = append(exclusions, c.Descriptor())
+	}
+
+	// passkeys must be discoverable so they can be used to login without a username
+	options, session, err := h.WebAuthn.BeginRegistration(user,
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		h.Logger.Errorw("unable to begin webauthn registration", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if err := h.putWebauthnSession(ctx, webauthnRegistrationKey, session); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	h.SM.Put(ctx.Request().Context(), webauthnNameKey, in.Name)
+
+	return ctx.JSON(http.StatusOK, options)
+}
+
+// FinishWebauthnRegistration validates the attestation returned by the authenticator against the
+// registration session and stores the new passkey for the authenticated user
+func (h *Handler) FinishWebauthnRegistration(ctx echo.Context) error {
+	if h.WebAuthn == nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrWebauthnNotEnabled))
+	}
+
+	user, err := h.getWebauthnUserFromClaims(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrNoAuthUser))
+	}
+
+	session, err := h.popWebauthnSession(ctx, webauthnRegistrationKey)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	name := h.SM.PopString(ctx.Request().Context(), webauthnNameKey)
+
+	cred, err := h.WebAuthn.FinishRegistration(user, *session, ctx.Request())
+	if err != nil {
+		h.Logger.Errorw("unable to finish webauthn registration", "error", err)
+
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	userCtx := viewer.NewContext(ctx.Request().Context(), viewer.NewUserViewerFromID(user.user.ID, true))
+
+	if _, err := h.createWebauthnCredential(userCtx, user.user.ID, name, cred); err != nil {
+		if ent.IsConstraintError(err) {
+			return ctx.JSON(http.StatusConflict, ErrorResponse(ErrDuplicate))
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Message: "success"})
+}
+
+// BeginWebauthnLogin starts a passkey login, the user is not known until the authenticator returns
+// the user handle so the assertion options allow any discoverable credential for the relying party
+func (h *Handler) BeginWebauthnLogin(ctx echo.Context) error {
+	if h.WebAuthn == nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrWebauthnNotEnabled))
+	}
+
+	options, session, err := h.WebAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		h.Logger.Errorw("unable to begin webauthn login", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	if err := h.putWebauthnSession(ctx, webauthnLoginKey, session); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	return ctx.JSON(http.StatusOK, options)
+}
+
+// FinishWebauthnLogin validates the assertion returned by the authenticator against the login session
+// and the stored passkey of the user, on success the access and refresh tokens are issued
+func (h *Handler) FinishWebauthnLogin(ctx echo.Context) error {
+	if h.WebAuthn == nil {
+		return ctx.JSON(http.StatusNotFound, ErrorResponse(ErrWebauthnNotEnabled))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	session, err := h.popWebauthnSession(ctx, webauthnLoginKey)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse(err))
+	}
+
+	var user *webauthnUser
+
+	// the user handle returned by the authenticator is the user id set on registration
+	findUser := func(_, userHandle []byte) (webauthn.User, error) {
+		userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(string(userHandle), true))
+
+		meowuser, err := h.getUserWithWebauthnCredentials(userCtx, string(userHandle))
+		if err != nil {
+			return nil, err
+		}
+
+		user = &webauthnUser{user: meowuser}
+
+		return user, nil
+	}
+
+	cred, err := h.WebAuthn.FinishDiscoverableLogin(findUser, *session, ctx.Request())
+	if err != nil {
+		h.Logger.Errorw("unable to finish webauthn login", "error", err)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	// a sign count that did not increase indicates the authenticator may have been cloned
+	if cred.Authenticator.CloneWarning {
+		h.Logger.Warnw("webauthn sign count did not increase, possible cloned authenticator", "user_id", user.user.ID)
+
+		return ctx.JSON(http.StatusUnauthorized, ErrorResponse(ErrInvalidCredentials))
+	}
+
+	if err := checkAccountUsable(user.user); err != nil {
+		return ctx.JSON(accountErrorStatus(err, http.StatusBadRequest), ErrorResponse(err))
+	}
+
+	// set context for remaining request based on logged in user
+	userCtx := viewer.NewContext(reqCtx, viewer.NewUserViewerFromID(user.user.ID, true))
+
+	if err := h.updateWebauthnCredentialUse(userCtx, cred); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(ErrProcessingRequest))
+	}
+
+	claims := createClaims(user.user)
+
+	access, refresh, err := h.createTokenPair(ctx, claims, nil)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	// set cookies on request with the access and refresh token
+	// when cookie domain is localhost, this is dropped but expected
+	if err := auth.SetAuthCookies(ctx, access, refresh, h.CookieDomain); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	if err := h.updateUserLastSeen(userCtx, user.user.ID); err != nil {
+		h.Logger.Errorw("unable to update last seen", "error", err)
+
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Message: "success"})
+}
+
+// getWebauthnUserFromClaims returns the authenticated user along with their registered passkeys
+func (h *Handler) getWebauthnUserFromClaims(ctx echo.Context) (*webauthnUser, error) {
+	userID, err := auth.GetActorUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userCtx := viewer.NewContext(ctx.Request().Context(), viewer.NewUserViewerFromID(userID, true))
+
+	user, err := h.getUserWithWebauthnCredentials(userCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthnUser{user: user}, nil
+}
+
+// putWebauthnSession stores the ceremony session data in the user's session
+func (h *Handler) putWebauthnSession(ctx echo.Context, key string, session *webauthn.SessionData) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		h.Logger.Errorw("unable to marshal webauthn session", "error", err)
+
+		return err
+	}
+
+	h.SM.Put(ctx.Request().Context(), key, data)
+
+	return nil
+}
+
+// popWebauthnSession removes the ceremony session data from the user's session so it can only be used once
+func (h *Handler) popWebauthnSession(ctx echo.Context, key string) (*webauthn.SessionData, error) {
+	data := h.SM.PopBytes(ctx.Request().Context(), key)
+	if len(data) == 0 {
+		return nil, ErrInvalidWebauthnSession
+	}
+
+	var session webauthn.SessionData
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, ErrInvalidWebauthnSession
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,358 @@
+package handlers_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	"github.com/brianvoe/gofakeit/v6"
+	echo "github.com/datumforge/echox"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/golang-jwt/jwt/v5"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/webauthncredential"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
+	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:17608"
+)
+
+// testAuthenticator is a software stand-in for a platform authenticator holding a single passkey
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 32) // nolint: gomnd
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &testAuthenticator{
+		key:          key,
+		credentialID: credentialID,
+	}
+}
+
+// authData builds the authenticator data with the user present and verified flags set
+func (a *testAuthenticator) authData(t *testing.T, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+
+	flags := protocol.FlagUserPresent | protocol.FlagUserVerified
+	if attested {
+		flags |= protocol.FlagAttestedCredentialData
+	}
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, byte(flags))
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	if !attested {
+		return data
+	}
+
+	pub, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)), // nolint: gomnd
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)), // nolint: gomnd
+	})
+	require.NoError(t, err)
+
+	data = append(data, make([]byte, 16)...) // nolint: gomnd
+	data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+	data = append(data, a.credentialID...)
+
+	return append(data, pub...)
+}
+
+func clientData(t *testing.T, ceremony string, challenge protocol.URLEncodedBase64) []byte {
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    testOrigin,
+	})
+	require.NoError(t, err)
+
+	return data
+}
+
+// create returns the attestation response for the credential creation options
+func (a *testAuthenticator) create(t *testing.T, options protocol.CredentialCreation) []byte {
+	userID, ok := options.Response.User.ID.(string)
+	require.True(t, ok)
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(userID)
+	require.NoError(t, err)
+
+	a.userHandle = userHandle
+
+	attestation, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authData(t, true),
+	})
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData(t, "webauthn.create", options.Response.Challenge)),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestation),
+		},
+	})
+	require.NoError(t, err)
+
+	return body
+}
+
+// get returns the signed assertion response for the credential request options
+func (a *testAuthenticator) get(t *testing.T, options protocol.CredentialAssertion) []byte {
+	authData := a.authData(t, false)
+	cd := clientData(t, "webauthn.get", options.Response.Challenge)
+
+	cdHash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), cdHash[:]...))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(cd),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(sig),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+		},
+	})
+	require.NoError(t, err)
+
+	return body
+}
+
+// doRequest sends the request with the cookies from the previous response and returns the recorder
+func doRequest(e *echo.Echo, path, accessToken string, body []byte, cookies []*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	if accessToken != "" {
+		req.Header.Set(auth.Authorization, "Bearer "+accessToken)
+	}
+
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+
+	recorder := httptest.NewRecorder()
+
+	e.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func TestWebauthnHandlers(t *testing.T) {
+	h := handlerSetup(t)
+
+	wa, err := handlers.NewWebauthn(testRPID, "Datum", []string{testOrigin}, time.Minute)
+	require.NoError(t, err)
+
+	h.WebAuthn = wa
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	userSetting := EntClient.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	passkeyUser := EntClient.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("birdperson@datum.net").
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	accessToken, _, err := h.TM.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: passkeyUser.ID,
+		},
+		UserID: passkeyUser.ID,
+		Email:  passkeyUser.Email,
+	})
+	require.NoError(t, err)
+
+	authMW := auth.Authenticate(auth.WithValidator(h.TM))
+
+	e := setupEcho(h.SM)
+	e.POST("registration/webauthn/begin", h.BeginWebauthnRegistration, authMW)
+	e.POST("registration/webauthn/finish", h.FinishWebauthnRegistration, authMW)
+	e.POST("login/webauthn/begin", h.BeginWebauthnLogin)
+	e.POST("login/webauthn/finish", h.FinishWebauthnLogin)
+
+	authenticator := newTestAuthenticator(t)
+
+	t.Run("registration requires authentication", func(t *testing.T) {
+		recorder := doRequest(e, "/registration/webauthn/begin", "", nil, nil)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), auth.ErrRefreshDisabled.Error())
+	})
+
+	t.Run("register passkey", func(t *testing.T) {
+		recorder := doRequest(e, "/registration/webauthn/begin", accessToken, []byte(`{"name":"yubikey"}`), nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var options protocol.CredentialCreation
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+
+		assert.Equal(t, testRPID, options.Response.RelyingParty.ID)
+		assert.Equal(t, protocol.ResidentKeyRequirementRequired, options.Response.AuthenticatorSelection.ResidentKey)
+
+		recorder = doRequest(e, "/registration/webauthn/finish", accessToken,
+			authenticator.create(t, options), recorder.Result().Cookies())
+		require.Equal(t, http.StatusCreated, recorder.Code)
+
+		cred := EntClient.WebauthnCredential.Query().
+			Where(webauthncredential.OwnerID(passkeyUser.ID)).
+			OnlyX(ctx)
+
+		assert.Equal(t, "yubikey", cred.Name)
+		assert.Equal(t, authenticator.credentialID, cred.CredentialID)
+		assert.Equal(t, "none", cred.AttestationType)
+	})
+
+	t.Run("finish registration without session", func(t *testing.T) {
+		recorder := doRequest(e, "/registration/webauthn/finish", accessToken, authenticator.create(t, protocol.CredentialCreation{
+			Response: protocol.PublicKeyCredentialCreationOptions{
+				User: protocol.UserEntity{ID: base64.RawURLEncoding.EncodeToString([]byte(passkeyUser.ID))},
+			},
+		}), nil)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidWebauthnSession.Error())
+	})
+
+	testCases := []struct {
+		name           string
+		signCount      uint32
+		expectedStatus int
+	}{
+		{
+			name:           "login with passkey",
+			signCount:      1,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "login with passkey again",
+			signCount:      2,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "sign count did not increase, possible clone",
+			signCount:      2,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := doRequest(e, "/login/webauthn/begin", "", nil, nil)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var options protocol.CredentialAssertion
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+
+			authenticator.signCount = tc.signCount
+
+			recorder = doRequest(e, "/login/webauthn/finish", "", authenticator.get(t, options), recorder.Result().Cookies())
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				assert.Contains(t, recorder.Body.String(), handlers.ErrInvalidCredentials.Error())
+
+				return
+			}
+
+			cookies := map[string]bool{}
+			for _, c := range recorder.Result().Cookies() {
+				cookies[c.Name] = true
+			}
+
+			assert.True(t, cookies["access_token"])
+			assert.True(t, cookies["refresh_token"])
+
+			// skip the query cache to read the updated sign count
+			cred := EntClient.WebauthnCredential.Query().
+				Where(webauthncredential.OwnerID(passkeyUser.ID)).
+				OnlyX(entcache.Skip(ctx))
+
+			assert.Equal(t, int64(tc.signCount), cred.SignCount)
+			assert.NotNil(t, cred.LastUsedAt)
+		})
+	}
+
+	t.Run("login with unknown passkey", func(t *testing.T) {
+		recorder := doRequest(e, "/login/webauthn/begin", "", nil, nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var options protocol.CredentialAssertion
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+
+		unknown := newTestAuthenticator(t)
+		unknown.userHandle = []byte(passkeyUser.ID)
+
+		recorder = doRequest(e, "/login/webauthn/finish", "", unknown.get(t, options), recorder.Result().Cookies())
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("webauthn not enabled", func(t *testing.T) {
+		h.WebAuthn = nil
+		defer func() { h.WebAuthn = wa }()
+
+		recorder := doRequest(e, "/login/webauthn/begin", "", nil, nil)
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.True(t, strings.Contains(recorder.Body.String(), handlers.ErrWebauthnNotEnabled.Error()))
+	})
+
+	// cleanup after
+	EntClient.User.DeleteOneID(passkeyUser.ID).ExecX(ctx)
+}
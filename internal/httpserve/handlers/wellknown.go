@@ -2,11 +2,87 @@ package handlers
 
 import (
 	"net/http"
+	"net/url"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/tokens"
 )
 
-// JWKSWellKnownHandler provides the JWK used to verify all Datum-issued JWTs
+// JWKSWellKnownHandler provides the JWK used to verify all Datum-issued JWTs, the keys are read from the
+// token manager on each request so rotated keys are published without a restart
 func (h *Handler) JWKSWellKnownHandler(ctx echo.Context) error {
-	return ctx.JSON(http.StatusOK, h.JWTKeys)
+	if h.TM == nil {
+		return ctx.JSON(http.StatusOK, h.JWTKeys)
+	}
+
+	keys, err := h.TM.Keys()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	return ctx.JSON(http.StatusOK, keys)
+}
+
+// OpenIDConfiguration is the OpenID Connect discovery document of the authorization server
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OpenIDConfigurationHandler provides the OpenID Connect discovery document, the endpoints are relative to
+// the issuer of the tokens and the signing algorithms are read from the token manager so rotated keys are
+// published without a restart
+func (h *Handler) OpenIDConfigurationHandler(ctx echo.Context) error {
+	issuer := h.TM.Issuer()
+
+	endpoint := func(path string) string {
+		u, err := url.JoinPath(issuer, path)
+		if err != nil {
+			return path
+		}
+
+		return u
+	}
+
+	algs, err := h.TM.SigningAlgorithms()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse(err))
+	}
+
+	out := OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             endpoint("/oauth/authorize"),
+		TokenEndpoint:                     endpoint("/oauth/token"),
+		UserInfoEndpoint:                  endpoint("/userinfo"),
+		IntrospectionEndpoint:             endpoint("/v1/introspect"),
+		RevocationEndpoint:                endpoint("/v1/revoke"),
+		JWKSURI:                           endpoint("/.well-known/jwks.json"),
+		ScopesSupported:                   tokens.OauthScopes(),
+		ResponseTypesSupported:            []string{responseTypeCode},
+		GrantTypesSupported:               []string{grantTypeAuthorizationCode, grantTypeRefreshToken, grantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  algs,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{pkceMethodS256},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce", "email", "email_verified",
+			"name", "given_name", "family_name", "preferred_username", "picture",
+		},
+	}
+
+	return ctx.JSON(http.StatusOK, out)
 }
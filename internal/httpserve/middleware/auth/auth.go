@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	echo "github.com/datumforge/echox"
 
+	"github.com/datumforge/datum/internal/keygen"
 	"github.com/datumforge/datum/internal/tokens"
 )
 
@@ -25,10 +27,13 @@ type ContextKey struct {
 	name string
 }
 
-func Authenticate() echo.MiddlewareFunc {
+// Authenticate is a middleware function that verifies the access token on the request and adds the
+// claims to the echo context, the auth options can be used to override the default validator and to
+// accept personal access tokens and organization api keys as bearer tokens
+func Authenticate(opts ...AuthOption) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			conf := NewAuthOptions()
+			conf := NewAuthOptions(opts...)
 
 			validator, err := conf.Validator()
 			if err != nil {
@@ -52,10 +57,29 @@ func Authenticate() echo.MiddlewareFunc {
 				}
 			}
 
-			// Verify the access token is authorized for use with datum and extract claims.
-			claims, err := validator.Verify(accessToken)
-			if err != nil {
-				return ErrorResponse(err)
+			var claims *tokens.Claims
+
+			// API keys and personal access tokens are opaque secrets rather than JWTs, so they are
+			// looked up instead of verified
+			switch {
+			case conf.apiKeys != nil && keygen.IsAPIKey(accessToken):
+				if claims, err = conf.apiKeys.ValidateAPIKey(c.Request().Context(), accessToken); err != nil {
+					return ErrorResponse(err)
+				}
+			case conf.personalAccessTokens != nil && !IsJWT(accessToken):
+				if claims, err = conf.personalAccessTokens.ValidatePersonalAccessToken(c.Request().Context(), accessToken); err != nil {
+					return ErrorResponse(err)
+				}
+			default:
+				// Verify the access token is authorized for use with datum and extract claims.
+				if claims, err = validator.Verify(accessToken); err != nil {
+					return ErrorResponse(err)
+				}
+
+				// Ensure the access token has not been revoked, e.g. by the user logging out
+				if err := checkRevoked(c, conf, claims); err != nil {
+					return ErrorResponse(err)
+				}
 			}
 
 			// Add claims to context for use in downstream processing and continue handlers
@@ -86,7 +110,13 @@ func Reauthenticate(conf AuthOptions, validator tokens.Validator) func(c echo.Co
 		}
 
 		// Check to ensure the refresh token is still valid.
-		if _, err = validator.Verify(refreshToken); err != nil {
+		claims, err := validator.Verify(refreshToken)
+		if err != nil {
+			return "", err
+		}
+
+		// Check to ensure the refresh token has not been revoked.
+		if err := checkRevoked(c, conf, claims); err != nil {
 			return "", err
 		}
 
@@ -107,6 +137,31 @@ func Reauthenticate(conf AuthOptions, validator tokens.Validator) func(c echo.Co
 	}
 }
 
+// checkRevoked returns an error if the token has been revoked, if no revocation checker is
+// configured the token is not checked
+func checkRevoked(c echo.Context, conf AuthOptions, claims *tokens.Claims) error {
+	if conf.revocations == nil {
+		return nil
+	}
+
+	revoked, err := conf.revocations.IsRevoked(c.Request().Context(), claims.ID)
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return ErrTokenRevoked
+	}
+
+	return nil
+}
+
+// IsJWT reports whether the token has the three dot separated segments of a JWT, personal access
+// tokens and api keys are opaque secrets
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2 //nolint:gomnd
+}
+
 // GetAccessToken retrieves the bearer token from the authorization header and parses it
 // to return only the JWT access token component of the header. Alternatively, if the
 // authorization header is not present, then the token is fetched from cookies. If the
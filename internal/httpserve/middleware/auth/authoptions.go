@@ -48,6 +48,31 @@ type AuthOptions struct {
 	validator tokens.Validator
 	// reauth constructed by the auth options (can be directly supplied by the user).
 	reauth Reauthenticator
+	// revocations is used to reject revoked tokens, when nil revocations are not checked
+	revocations RevocationChecker
+	// personalAccessTokens is used to authenticate bearer tokens that are not JWTs, when nil
+	// personal access tokens are not accepted
+	personalAccessTokens PersonalAccessTokenValidator
+	// apiKeys is used to authenticate organization api keys presented as bearer tokens, when nil
+	// api keys are not accepted
+	apiKeys APIKeyValidator
+}
+
+// RevocationChecker reports whether the token pair with the jti has been revoked before it expired
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// PersonalAccessTokenValidator looks up a personal access token presented as a bearer token and
+// returns the claims of the user that owns it, an error is returned if the token is unknown or expired
+type PersonalAccessTokenValidator interface {
+	ValidatePersonalAccessToken(ctx context.Context, token string) (*tokens.Claims, error)
+}
+
+// APIKeyValidator looks up an organization api key presented as a bearer token and returns the claims
+// of the service account of the key, an error is returned if the key is unknown or expired
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, key string) (*tokens.Claims, error)
 }
 
 // Reauthenticator generates new access and refresh pair given a valid refresh token.
@@ -189,3 +214,27 @@ func WithReauthenticator(reauth Reauthenticator) AuthOption {
 		opts.reauth = reauth
 	}
 }
+
+// WithRevocationChecker allows the user to specify a revocation checker so that revoked
+// access and refresh tokens are rejected before they expire
+func WithRevocationChecker(revocations RevocationChecker) AuthOption {
+	return func(opts *AuthOptions) {
+		opts.revocations = revocations
+	}
+}
+
+// WithPersonalAccessTokenValidator allows the user to specify a validator so that personal access
+// tokens are accepted as bearer tokens in addition to JWT access tokens
+func WithPersonalAccessTokenValidator(pats PersonalAccessTokenValidator) AuthOption {
+	return func(opts *AuthOptions) {
+		opts.personalAccessTokens = pats
+	}
+}
+
+// WithAPIKeyValidator allows the user to specify a validator so that organization api keys are accepted
+// as bearer tokens, authenticating the request as the service account of the organization
+func WithAPIKeyValidator(keys APIKeyValidator) AuthOption {
+	return func(opts *AuthOptions) {
+		opts.apiKeys = keys
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	echo "github.com/datumforge/echox"
 
+	"github.com/datumforge/datum/internal/passwd"
 	"github.com/datumforge/datum/internal/utils/responses"
 )
 
@@ -30,6 +31,11 @@ var (
 	ErrRateLimit        = errors.New("rate limit reached: too many requests")
 	ErrNoRefreshToken   = errors.New("no refresh token available on request")
 	ErrRefreshDisabled  = errors.New("re-authentication with refresh tokens disabled")
+	ErrTokenRevoked     = errors.New("token has been revoked")
+	ErrInvalidPAT       = errors.New("invalid personal access token")
+	ErrExpiredPAT       = errors.New("personal access token has expired")
+	ErrInvalidAPIKey    = errors.New("invalid api key")
+	ErrExpiredAPIKey    = errors.New("api key has expired")
 	ErrShitWentBad      = errors.New("shit went bad")
 )
 
@@ -45,8 +51,8 @@ var (
 	ErrInvalidCredentials = errors.New("datum credentials are missing or invalid")
 	ErrExpiredCredentials = errors.New("datum credentials have expired")
 	ErrPasswordMismatch   = errors.New("passwords do not match")
-	ErrPasswordTooWeak    = errors.New("password is too weak: use a combination of upper and lower case letters, numbers, and special characters")
-	ErrNonUniquePassword  = errors.New("password was already used, please try again")
+	ErrPasswordTooWeak    = passwd.ErrPasswordTooWeak
+	ErrNonUniquePassword  = passwd.ErrPasswordReused
 	ErrMissingID          = errors.New("missing required id")
 	ErrMissingField       = errors.New("missing required field")
 	ErrInvalidField       = errors.New("invalid or unparsable field")
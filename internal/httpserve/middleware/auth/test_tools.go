@@ -44,3 +44,47 @@ func NewTestContextWithValidUser(subject string) (echo.Context, error) {
 
 	return ec, nil
 }
+
+// NewTestContextWithOrgID creates an echo context with a fake subject whose token is scoped to the
+// organization, as if after switching organizations, for testing purposes ONLY
+func NewTestContextWithOrgID(subject, orgID string) (echo.Context, error) {
+	ec := echocontext.NewTestEchoContext()
+
+	claims := newValidClaims(subject)
+	claims.OrgID = orgID
+	claims.ParentOrgID = ""
+
+	ec.Set(ContextUserClaims.name, claims)
+
+	return ec, nil
+}
+
+// NewTestContextWithScopes creates an echo context with a fake subject restricted to the scopes, as if
+// authenticated with a personal access token, for testing purposes ONLY
+func NewTestContextWithScopes(subject string, scopes ...string) (echo.Context, error) {
+	ec := echocontext.NewTestEchoContext()
+
+	claims := newValidClaims(subject)
+	claims.Scopes = scopes
+
+	ec.Set(ContextUserClaims.name, claims)
+
+	return ec, nil
+}
+
+// NewTestContextWithServiceAccount creates an echo context with the service account of an organization
+// api key restricted to the scopes, for testing purposes ONLY
+func NewTestContextWithServiceAccount(keyID, orgID string, scopes ...string) (echo.Context, error) {
+	ec := echocontext.NewTestEchoContext()
+
+	claims := newValidClaims(keyID)
+	claims.Email = ""
+	claims.OrgID = orgID
+	claims.ParentOrgID = ""
+	claims.Scopes = scopes
+	claims.SubjectType = tokens.SubjectTypeService
+
+	ec.Set(ContextUserClaims.name, claims)
+
+	return ec, nil
+}
@@ -6,6 +6,7 @@ import (
 	echo "github.com/datumforge/echox"
 
 	"github.com/datumforge/datum/internal/httpserve/middleware/echocontext"
+	"github.com/datumforge/datum/internal/tokens"
 	"github.com/datumforge/datum/internal/utils/ulids"
 )
 
@@ -34,3 +35,41 @@ func GetUserIDFromContext(ctx context.Context) (string, error) {
 
 	return GetActorUserID(*ec)
 }
+
+// GetClaimsFromContext returns the claims of the authenticated request from the echo context
+func GetClaimsFromContext(ctx context.Context) (*tokens.Claims, error) {
+	ec, err := echocontext.EchoContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetClaims(*ec)
+}
+
+// GetSubjectTypeFromContext returns the type of the authenticated subject, either a user or a service
+// account, used as the subject type of authorization checks
+func GetSubjectTypeFromContext(ctx context.Context) string {
+	claims, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return tokens.SubjectTypeUser
+	}
+
+	return claims.GetSubjectType()
+}
+
+// GetOrganizationIDFromContext returns the organization the token of the request is scoped to, e.g. after
+// switching organizations or with an organization api key; an empty string is returned when the token
+// is not scoped to an organization
+func GetOrganizationIDFromContext(ctx context.Context) string {
+	claims, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	orgID := claims.ParseOrgID()
+	if ulids.IsZero(orgID) {
+		return ""
+	}
+
+	return orgID.String()
+}
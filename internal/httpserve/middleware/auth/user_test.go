@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"testing"
 
 	echo "github.com/datumforge/echox"
@@ -75,3 +76,48 @@ func Test_GetActorUserID(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetOrganizationIDFromContext(t *testing.T) {
+	orgID := ulids.New().String()
+
+	// the claims of test users are not scoped to a valid organization id
+	userCtx, err := auth.NewTestContextWithValidUser(ulids.New().String())
+	if err != nil {
+		t.Fatal()
+	}
+
+	serviceCtx, err := auth.NewTestContextWithServiceAccount(ulids.New().String(), orgID)
+	if err != nil {
+		t.Fatal()
+	}
+
+	testCases := []struct {
+		name     string
+		e        echo.Context
+		expected string
+	}{
+		{
+			name:     "scoped to org",
+			e:        serviceCtx,
+			expected: orgID,
+		},
+		{
+			name:     "not scoped to org",
+			e:        userCtx,
+			expected: "",
+		},
+		{
+			name:     "no claims",
+			e:        echocontext.NewTestEchoContext(),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Get "+tc.name, func(t *testing.T) {
+			ctx := context.WithValue(tc.e.Request().Context(), echocontext.EchoContextKey, tc.e)
+
+			assert.Equal(t, tc.expected, auth.GetOrganizationIDFromContext(ctx))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package authtest
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/json"
@@ -33,29 +34,9 @@ type Server struct {
 // NewServer starts and returns a new authtest server. The caller should call Close
 // when finished, to shut it down.
 func NewServer() (s *Server, err error) {
-	// Setup routes for the mux
-	s = &Server{}
-	s.mux = http.NewServeMux()
-	s.mux.HandleFunc("/.well-known/jwks.json", s.JWKS)
-
-	// Setup httptest Server
-	s.srv = httptest.NewServer(s.mux)
-	s.URL, _ = url.Parse("http://localhost:17608/.well-known/jwks.json")
-
-	// Create token manager
-	keys := map[string]string{}
-
 	// Checks for the file in the root of this repo
 	privFileName := "../../../../private_key.pem"
 
-	conf := tokens.Config{
-		Audience:        Audience,
-		Issuer:          Issuer,
-		AccessDuration:  1 * time.Hour,
-		RefreshDuration: 2 * time.Hour, // nolint: gomnd
-		RefreshOverlap:  -15 * time.Minute,
-	}
-
 	// if the file isn't there, generate with a new key
 	if _, err := os.Stat(privFileName); err != nil {
 		var key *rsa.PrivateKey
@@ -64,21 +45,60 @@ func NewServer() (s *Server, err error) {
 			return nil, err
 		}
 
-		if s.tokens, err = tokens.NewWithKey(key, conf); err != nil {
-			return nil, err
-		}
-	} else {
-		// This is the same KID that the task file uses, so your server and generated
-		// tokens will have same ID
-		keys["01HHAS67AM73778S0QEZ3CEAGE"] = fmt.Sprintf("%v", privFileName)
-		conf.Keys = keys
+		return NewServerWithKey(key)
+	}
 
-		if s.tokens, err = tokens.New(conf); err != nil {
-			return nil, err
-		}
+	conf := tokenConfig()
+
+	// This is the same KID that the task file uses, so your server and generated
+	// tokens will have same ID
+	conf.Keys = map[string]string{
+		"01HHAS67AM73778S0QEZ3CEAGE": fmt.Sprintf("%v", privFileName),
+	}
+
+	tm, err := tokens.New(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return newServer(tm), nil
+}
+
+// NewServerWithKey starts and returns a new authtest server that signs tokens with the key, which
+// can be an RSA, ECDSA P-256 or Ed25519 private key. The caller should call Close when finished,
+// to shut it down.
+func NewServerWithKey(key crypto.Signer) (s *Server, err error) {
+	tm, err := tokens.NewWithKey(key, tokenConfig())
+	if err != nil {
+		return nil, err
 	}
 
-	return s, nil
+	return newServer(tm), nil
+}
+
+// newServer starts the server publishing the keys of the token manager
+func newServer(tm *tokens.TokenManager) *Server {
+	// Setup routes for the mux
+	s := &Server{tokens: tm}
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/.well-known/jwks.json", s.JWKS)
+
+	// Setup httptest Server
+	s.srv = httptest.NewServer(s.mux)
+	s.URL, _ = url.Parse(s.srv.URL)
+
+	return s
+}
+
+// tokenConfig returns the configuration of the token manager of the server
+func tokenConfig() tokens.Config {
+	return tokens.Config{
+		Audience:        Audience,
+		Issuer:          Issuer,
+		AccessDuration:  1 * time.Hour,
+		RefreshDuration: 2 * time.Hour, // nolint: gomnd
+		RefreshOverlap:  -15 * time.Minute,
+	}
 }
 
 func (s *Server) Close() {
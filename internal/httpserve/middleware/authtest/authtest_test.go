@@ -1,8 +1,17 @@
 package authtest_test
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"testing"
+	"time"
 
+	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/stretchr/testify/require"
 
 	authtest "github.com/datumforge/datum/internal/httpserve/middleware/authtest"
@@ -41,3 +50,61 @@ func TestGenerateToken(t *testing.T) {
 	t.Logf("refresh token: %s", refreshToken)
 	t.FailNow()
 }
+
+// This test verifies tokens signed by the authtest server with each supported key type
+// using the keys published by the server
+func TestServerWithKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048) // nolint: gomnd
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{
+			name: "rsa",
+			key:  rsaKey,
+		},
+		{
+			name: "ecdsa p-256",
+			key:  ecKey,
+		},
+		{
+			name: "ed25519",
+			key:  edKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, err := authtest.NewServerWithKey(tc.key)
+			require.NoError(t, err, "could not start authtest server")
+
+			defer srv.Close()
+
+			claims := &tokens.Claims{
+				UserID: ulids.New().String(),
+				Email:  "rustys@datum.net",
+			}
+
+			accessToken, _, err := srv.CreateTokenPair(claims)
+			require.NoError(t, err, "could not generate access token")
+
+			cache := jwk.NewCache(context.Background())
+			cache.Register(srv.KeysURL(), jwk.WithMinRefreshInterval(1*time.Minute)) // nolint: errcheck
+
+			validator, err := tokens.NewCachedJWKSValidator(context.Background(), cache, srv.KeysURL(), authtest.Audience, authtest.Issuer)
+			require.NoError(t, err, "could not create new cached JWKS validator")
+
+			actualClaims, err := validator.Verify(accessToken)
+			require.NoError(t, err, "should have been able to verify the access token")
+			require.Equal(t, claims.UserID, actualClaims.UserID)
+		})
+	}
+}
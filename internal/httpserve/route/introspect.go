@@ -0,0 +1,23 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerIntrospectionHandler lets oauth clients check whether a token issued by datum is still active, the
+// client authenticates with its client credentials
+func registerIntrospectionHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/introspect",
+		Handler: func(c echo.Context) error {
+			return h.IntrospectionHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
@@ -0,0 +1,37 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerInviteHandler accepts an invitation to join an organization by validating the token in the request.
+// This endpoint is intended to be called by frontend applications, with the authenticated user, after the user
+// has followed the link in the invitation email; users without an account register with the token instead
+func registerInviteHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/invite",
+		Handler: func(c echo.Context) error {
+			return h.InviteHandler(c)
+		},
+	}.ForGroup(V1Version, authMW))
+
+	return
+}
+
+// registerDeclineInviteHandler declines an invitation to join an organization by validating the token in the request
+func registerDeclineInviteHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/invite/decline",
+		Handler: func(c echo.Context) error {
+			return h.DeclineInviteHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
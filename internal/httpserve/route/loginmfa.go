@@ -0,0 +1,29 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// LoginMFA completes the login of a user that has enabled multi-factor authentication.
+// When the password is verified on the login endpoint for these users, a short-lived mfa
+// pending token is returned instead of the access and refresh tokens. The mfa pending token
+// along with a one-time passcode from the user's authenticator app (or an unused recovery
+// code) are submitted here, and upon verification the access and refresh tokens are issued.
+// The endpoint is rate limited and failed codes count towards the account lockout, the mfa
+// pending token can only be used to complete a single login.
+
+func registerLoginMFAHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/login/mfa",
+		Handler: func(c echo.Context) error {
+			return h.MFALoginHandler(c)
+		},
+	}.ForGroup(V1Version, restrictedEndpointsMW))
+
+	return
+}
@@ -0,0 +1,24 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerLogoutHandler revokes the access and refresh token pair of the user, sent either as cookies
+// or with the refresh token in the request body, so they can no longer be used to authenticate or to
+// obtain new tokens; the auth cookies are cleared and the session is destroyed
+func registerLogoutHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/logout",
+		Handler: func(c echo.Context) error {
+			return h.LogoutHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
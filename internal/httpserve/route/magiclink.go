@@ -0,0 +1,36 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// MagicLink allows users to log in without a password. The user requests a login link
+// with their email address and a single-use token that expires after 15 minutes is emailed
+// to them. Following the link exchanges the token for an access token and a refresh token
+// with the authorized claims of the user, the same as logging in with a password
+func registerMagicLinkHandlers(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/login/magic",
+		Handler: func(c echo.Context) error {
+			return h.MagicLinkRequestHandler(c)
+		},
+	}.ForGroup(V1Version, restrictedEndpointsMW))
+	if err != nil {
+		return
+	}
+
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/login/magic",
+		Handler: func(c echo.Context) error {
+			return h.MagicLinkLoginHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
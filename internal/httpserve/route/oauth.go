@@ -0,0 +1,39 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// OauthLogin redirects the user to the configured external OIDC provider using the
+// authorization code flow with PKCE. Once the user has authenticated with the provider
+// they are redirected back to the callback endpoint, where the code is exchanged for an
+// id token. The user is linked by subject or email (or created along with their personal
+// organization) and the same access and refresh token cookies are set as the login endpoint.
+
+func registerOauthLoginHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/oauth/:provider/login",
+		Handler: func(c echo.Context) error {
+			return h.OauthLoginHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
+
+func registerOauthCallbackHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/oauth/:provider/callback",
+		Handler: func(c echo.Context) error {
+			return h.OauthCallbackHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
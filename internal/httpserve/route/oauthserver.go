@@ -0,0 +1,69 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerOauthAuthorizeHandler starts the authorization of a third party oauth client by the logged in
+// user, redirecting back to the client with an authorization code when the user already consented
+func registerOauthAuthorizeHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/oauth/authorize",
+		Handler: func(c echo.Context) error {
+			return h.AuthorizeHandler(c)
+		},
+	}.ForGroup(unversioned, authMW))
+
+	return
+}
+
+// registerOauthConsentHandler records the response of the logged in user to the consent prompt of an
+// authorization request
+func registerOauthConsentHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/oauth/authorize",
+		Handler: func(c echo.Context) error {
+			return h.AuthorizeConsentHandler(c)
+		},
+	}.ForGroup(unversioned, authMW))
+
+	return
+}
+
+// registerOauthTokenHandler is the token endpoint where oauth clients exchange authorization codes and
+// refresh tokens, or their client credentials, for tokens
+func registerOauthTokenHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/oauth/token",
+		Handler: func(c echo.Context) error {
+			return h.OauthTokenHandler(c)
+		},
+	}.ForGroup(unversioned, mw))
+
+	return
+}
+
+// registerUserInfoHandler returns the OpenID Connect claims about the user of the access token, both GET
+// and POST are supported as required by the specification
+func registerUserInfoHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if _, err = router.AddRoute(echo.Route{
+			Method: method,
+			Path:   "/userinfo",
+			Handler: func(c echo.Context) error {
+				return h.UserInfoHandler(c)
+			},
+		}.ForGroup(unversioned, authMW)); err != nil {
+			return
+		}
+	}
+
+	return
+}
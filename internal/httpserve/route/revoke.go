@@ -0,0 +1,23 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerRevocationHandler lets oauth clients revoke a token issued by datum, the client authenticates with
+// its client credentials
+func registerRevocationHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/revoke",
+		Handler: func(c echo.Context) error {
+			return h.RevocationHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
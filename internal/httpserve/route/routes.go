@@ -7,6 +7,7 @@ import (
 	"github.com/datumforge/echox/middleware"
 
 	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/internal/httpserve/middleware/auth"
 	"github.com/datumforge/datum/internal/httpserve/middleware/ratelimit"
 	"github.com/datumforge/datum/internal/httpserve/middleware/transaction"
 )
@@ -25,6 +26,7 @@ var (
 		ExpiresIn:  15 * time.Minute, //nolint:gomnd
 	}
 	restrictedEndpointsMW = []echo.MiddlewareFunc{}
+	authMW                = []echo.MiddlewareFunc{}
 )
 
 type Route struct {
@@ -50,6 +52,15 @@ func RegisterRoutes(router *echo.Echo, h *handlers.Handler) error {
 	restrictedEndpointsMW = append(restrictedEndpointsMW, mw...)
 	restrictedEndpointsMW = append(restrictedEndpointsMW, ratelimit.RateLimiterWithConfig(restrictedRateLimit)) // add restricted ratelimit middleware
 
+	// Middleware for endpoints that require an authenticated user
+	authMW = append(authMW, mw...)
+	authMW = append(authMW, auth.Authenticate(
+		auth.WithValidator(h.TM),
+		auth.WithRevocationChecker(h),
+		auth.WithPersonalAccessTokenValidator(h),
+		auth.WithAPIKeyValidator(h),
+	))
+
 	// register handlers
 	if err := registerLivenessHandler(router); err != nil {
 		return err
@@ -67,6 +78,14 @@ func RegisterRoutes(router *echo.Echo, h *handlers.Handler) error {
 		return err
 	}
 
+	if err := registerLoginMFAHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerMagicLinkHandlers(router, h); err != nil {
+		return err
+	}
+
 	if err := registerForgotPasswordHandler(router, h); err != nil {
 		return err
 	}
@@ -79,6 +98,10 @@ func RegisterRoutes(router *echo.Echo, h *handlers.Handler) error {
 		return err
 	}
 
+	if err := registerUnlockHandler(router, h); err != nil {
+		return err
+	}
+
 	if err := registerResendEmailHandler(router, h); err != nil {
 		return err
 	}
@@ -91,6 +114,34 @@ func RegisterRoutes(router *echo.Echo, h *handlers.Handler) error {
 		return err
 	}
 
+	if err := registerLogoutHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerOauthLoginHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerOauthCallbackHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerWebauthnRegistrationHandlers(router, h); err != nil {
+		return err
+	}
+
+	if err := registerWebauthnLoginHandlers(router, h); err != nil {
+		return err
+	}
+
+	if err := registerSSOHandlers(router, h); err != nil {
+		return err
+	}
+
+	if err := registerSwitchHandler(router, h); err != nil {
+		return err
+	}
+
 	if err := registerAuthenticateHandler(router); err != nil {
 		return err
 	}
@@ -99,6 +150,50 @@ func RegisterRoutes(router *echo.Echo, h *handlers.Handler) error {
 		return err
 	}
 
+	if err := registerOpenIDConfigurationHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerOauthAuthorizeHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerOauthConsentHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerOauthTokenHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerUserInfoHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerIntrospectionHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerRevocationHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerInviteHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerDeclineInviteHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerTransferHandler(router, h); err != nil {
+		return err
+	}
+
+	if err := registerDeclineTransferHandler(router, h); err != nil {
+		return err
+	}
+
 	return nil
 }
 
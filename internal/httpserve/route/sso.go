@@ -0,0 +1,50 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// SSO signs users in to an organization with the organization's SAML identity provider. The
+// metadata endpoint returns the service provider metadata to configure the identity provider, the
+// login endpoint redirects the user to the identity provider with an authn request, and the signed
+// response is posted back to the assertion consumer service (acs) endpoint. Users are provisioned
+// into the organization on their first login and the same access and refresh token cookies are set
+// as the login endpoint, with the tokens scoped to the organization.
+
+func registerSSOHandlers(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/sso/:org/metadata",
+		Handler: func(c echo.Context) error {
+			return h.SSOMetadataHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+	if err != nil {
+		return
+	}
+
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/sso/:org/login",
+		Handler: func(c echo.Context) error {
+			return h.SSOLoginHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+	if err != nil {
+		return
+	}
+
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/sso/:org/acs",
+		Handler: func(c echo.Context) error {
+			return h.SSOAssertionConsumerHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
@@ -0,0 +1,25 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// Switch allows an authenticated user to scope their access and refresh tokens to one of their
+// organizations. After checking the user can access the target organization, a new token pair is
+// issued with the organization, its parent organization and its entitlement tier in the claims, and
+// queries made with the new tokens are filtered to that organization by default
+func registerSwitchHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/switch",
+		Handler: func(c echo.Context) error {
+			return h.SwitchHandler(c)
+		},
+	}.ForGroup(V1Version, authMW))
+
+	return
+}
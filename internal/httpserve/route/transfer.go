@@ -0,0 +1,38 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// registerTransferHandler accepts the ownership transfer of an organization or group by validating the token in
+// the request. This endpoint is intended to be called by frontend applications, with the authenticated user,
+// after the recipient has followed the link in the transfer email
+func registerTransferHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/transfer",
+		Handler: func(c echo.Context) error {
+			return h.TransferHandler(c)
+		},
+	}.ForGroup(V1Version, authMW))
+
+	return
+}
+
+// registerDeclineTransferHandler declines the ownership transfer of an organization or group by validating the
+// token in the request
+func registerDeclineTransferHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/transfer/decline",
+		Handler: func(c echo.Context) error {
+			return h.DeclineTransferHandler(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
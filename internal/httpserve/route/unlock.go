@@ -0,0 +1,25 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// UnlockAccount unlocks the account of a user that was locked after repeated failed logins by
+// validating the token in the request. This endpoint is intended to be called by frontend
+// applications after the user has followed the link in the account locked email. The failed
+// logins of the user are reset and the user can log in again
+func registerUnlockHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/unlock",
+		Handler: func(c echo.Context) error {
+			return h.UnlockAccount(c)
+		},
+	}.ForGroup(V1Version, restrictedEndpointsMW))
+
+	return
+}
@@ -0,0 +1,65 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+)
+
+// WebauthnRegistration allows an authenticated user to register a passkey. The begin endpoint
+// returns the credential creation options to pass to the browser's webauthn api, and the
+// attestation returned by the authenticator is submitted to the finish endpoint where it is
+// verified and stored. Registered passkeys can be listed and revoked with the graph api.
+
+func registerWebauthnRegistrationHandlers(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/registration/webauthn/begin",
+		Handler: func(c echo.Context) error {
+			return h.BeginWebauthnRegistration(c)
+		},
+	}.ForGroup(V1Version, authMW))
+	if err != nil {
+		return
+	}
+
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/registration/webauthn/finish",
+		Handler: func(c echo.Context) error {
+			return h.FinishWebauthnRegistration(c)
+		},
+	}.ForGroup(V1Version, authMW))
+
+	return
+}
+
+// WebauthnLogin logs a user in with a passkey without a username or password. The begin endpoint
+// returns the assertion options to pass to the browser's webauthn api, and the assertion signed by
+// the authenticator is submitted to the finish endpoint. Once verified against the stored passkey
+// the same access and refresh token cookies are set as the login endpoint.
+
+func registerWebauthnLoginHandlers(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/login/webauthn/begin",
+		Handler: func(c echo.Context) error {
+			return h.BeginWebauthnLogin(c)
+		},
+	}.ForGroup(V1Version, mw))
+	if err != nil {
+		return
+	}
+
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodPost,
+		Path:   "/login/webauthn/finish",
+		Handler: func(c echo.Context) error {
+			return h.FinishWebauthnLogin(c)
+		},
+	}.ForGroup(V1Version, mw))
+
+	return
+}
@@ -21,3 +21,17 @@ func registerJwksWellKnownHandler(router *echo.Echo, h *handlers.Handler) (err e
 
 	return
 }
+
+// registerOpenIDConfigurationHandler supplies the OpenID Connect discovery document, next to the JWKS
+// endpoint it references
+func registerOpenIDConfigurationHandler(router *echo.Echo, h *handlers.Handler) (err error) {
+	_, err = router.AddRoute(echo.Route{
+		Method: http.MethodGet,
+		Path:   "/.well-known/openid-configuration",
+		Handler: func(c echo.Context) error {
+			return h.OpenIDConfigurationHandler(c)
+		},
+	}.ForGroup(unversioned, mw))
+
+	return
+}
@@ -102,6 +102,13 @@ func (s *Server) StartEchoServer(ctx context.Context) error {
 		return err
 	}
 
+	// rotate the signing keys without a restart when they are loaded from a key directory
+	if s.config.Token.KeyDir != "" {
+		go tm.WatchKeys(ctx, s.config.Token.KeyDir, s.config.Token.KeyRefreshInterval, func(err error) {
+			s.logger.Errorw("unable to reload signing keys", "error", err)
+		})
+	}
+
 	// pass to the REST handlers
 	s.config.Handler.JWTKeys = keys
 	s.config.Handler.TM = tm
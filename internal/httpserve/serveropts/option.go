@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/alexedwards/scs/v2"
-	"github.com/alexedwards/scs/v2/memstore"
 	echo "github.com/datumforge/echox"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
@@ -21,7 +20,11 @@ import (
 	"github.com/datumforge/datum/internal/fga"
 	"github.com/datumforge/datum/internal/graphapi"
 	"github.com/datumforge/datum/internal/httpserve/config"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/internal/httpserve/server"
+	"github.com/datumforge/datum/internal/lockout"
+	"github.com/datumforge/datum/internal/passwd"
+	"github.com/datumforge/datum/internal/store"
 	"github.com/datumforge/datum/internal/tokens"
 	"github.com/datumforge/datum/internal/utils/marionette"
 	"github.com/datumforge/datum/internal/utils/ulids"
@@ -202,6 +205,54 @@ func WithAuth() ServerOption {
 		}
 
 		s.Config.Auth.Providers = []config.AuthProvider{*authProviderConfig}
+
+		// setup the oauth providers for social login, providers without a client id are skipped
+		s.Config.Server.Handler.OauthProviders = map[string]*handlers.OauthProvider{}
+
+		for _, p := range s.Config.Auth.Providers {
+			if p.ClientID == "" {
+				continue
+			}
+
+			s.Config.Server.Handler.OauthProviders[p.Label] = handlers.NewOauthProvider(
+				p.Label, p.ProviderURL, p.IssuerURL, p.ClientID, p.ClientSecret, p.CallbackURL, p.Scopes, p.Options)
+		}
+
+		// setup the webauthn relying party for passkey registration and login
+		if s.Config.Auth.Webauthn.Enabled {
+			wa, err := handlers.NewWebauthn(s.Config.Auth.Webauthn.RelyingPartyID, s.Config.Auth.Webauthn.DisplayName,
+				s.Config.Auth.Webauthn.RequestOrigins, s.Config.Auth.Webauthn.Timeout)
+			if err != nil {
+				panic(err)
+			}
+
+			s.Config.Server.Handler.WebAuthn = wa
+		}
+
+		// setup the service provider urls for SAML single sign-on
+		if s.Config.Auth.SSO.Enabled {
+			s.Config.Server.Handler.SSOBaseURL = s.Config.Auth.SSO.BaseURL
+		}
+
+		// setup failed login tracking to throttle logins and lock accounts
+		if s.Config.Auth.Lockout.Enabled {
+			l, err := lockout.New(s.Config.Auth.Lockout)
+			if err != nil {
+				panic(err)
+			}
+
+			s.Config.Server.Handler.Lockout = l
+		}
+
+		// setup the password policy enforced when passwords are set and on login
+		policy, err := passwd.NewPolicy(s.Config.Auth.Password)
+		if err != nil {
+			panic(err)
+		}
+
+		s.Config.Server.Handler.PasswordPolicy = policy
+
+		s.Config.Server.Handler.RefreshTokenCutover = s.Config.Auth.RefreshTokenCutover
 	})
 }
 
@@ -228,7 +279,9 @@ func WithGraphRoute(srv *server.Server, c *generated.Client, mw []echo.Middlewar
 	return newApplyFunc(func(s *ServerOptions) {
 		// Setup Graph API Handlers
 		r := graphapi.NewResolver(c, s.Config.Auth.Enabled).
-			WithLogger(s.Config.Logger.Named("resolvers"))
+			WithLogger(s.Config.Logger.Named("resolvers")).
+			WithInviteSender(&s.Config.Server.Handler).
+			WithTransferSender(&s.Config.Server.Handler)
 
 		handler := r.Handler(s.Config.Server.Dev, mw...)
 
@@ -275,13 +328,13 @@ func WithTaskManager() ServerOption {
 	})
 }
 
-// WithSessionManager sets up the default session manager with a 15 minute timeout and stale sessions are cleaned every 5 minutes
+// WithSessionManager sets up the default session manager with a 15 minute timeout, the sessions are kept in memory
+// until the database store is added with WithSessionStore
 func WithSessionManager() ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
 		sm := scs.New()
 		sm.Lifetime = time.Hour
-		sm.Store = memstore.NewWithCleanupInterval(5 * time.Minute) // nolint: gomnd
-		sm.IdleTimeout = 15 * time.Minute                           // nolint: gomnd
+		sm.IdleTimeout = 15 * time.Minute // nolint: gomnd
 		sm.Cookie.Name = "__Host-datum"
 		sm.Cookie.HttpOnly = true
 		sm.Cookie.Persist = false
@@ -290,3 +343,17 @@ func WithSessionManager() ServerOption {
 		s.Config.Server.Handler.SM = sm
 	})
 }
+
+// WithSessionStore persists the sessions of the session manager in the database so they survive restarts and are
+// shared across replicas, stale sessions are cleaned every 5 minutes by the task manager
+func WithSessionStore(client *generated.Client) ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		st := store.NewEntStore(client)
+
+		if err := st.StartCleanup(s.Config.Server.Handler.TaskMan, store.DefaultCleanupInterval, s.Config.Logger); err != nil {
+			panic(err)
+		}
+
+		s.Config.Server.Handler.SM.Store = st
+	})
+}
@@ -0,0 +1,43 @@
+package keygen
+
+import (
+	"errors"
+	"strings"
+)
+
+// APIKeyPrefix identifies a bearer token as an organization API key
+const APIKeyPrefix = "dtmk_"
+
+// ErrInvalidAPIKey is returned when an API key is not in the expected format
+var ErrInvalidAPIKey = errors.New("invalid api key format")
+
+// APIKey returns a new organization API key along with its visible prefix; API keys share the format
+// and hashing of personal access tokens and are distinguished by their type prefix
+func APIKey() (key, prefix string) {
+	return prefixedToken(APIKeyPrefix)
+}
+
+// IsAPIKey reports whether the bearer token is an API key rather than a personal access token
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// APIKeyPrefixOf returns the visible prefix of the API key used to look it up
+func APIKeyPrefixOf(key string) (string, error) {
+	prefix, ok := visiblePrefixOf(key, APIKeyPrefix)
+	if !ok {
+		return "", ErrInvalidAPIKey
+	}
+
+	return prefix, nil
+}
+
+// HashAPIKey returns the salted hash of the API key to be stored in place of the key
+func HashAPIKey(key string) (string, error) {
+	return HashPersonalAccessToken(key)
+}
+
+// VerifyAPIKey reports whether the API key matches the stored salted hash
+func VerifyAPIKey(key, hash string) bool {
+	return VerifyPersonalAccessToken(key, hash)
+}
@@ -0,0 +1,31 @@
+package keygen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+func TestAPIKey(t *testing.T) {
+	key, prefix := keygen.APIKey()
+	require.True(t, keygen.IsAPIKey(key))
+	require.Len(t, prefix, len(keygen.APIKeyPrefix)+keygen.PersonalAccessTokenIDLength)
+
+	parsed, err := keygen.APIKeyPrefixOf(key)
+	require.NoError(t, err)
+	require.Equal(t, prefix, parsed)
+
+	hash, err := keygen.HashAPIKey(key)
+	require.NoError(t, err)
+	require.True(t, keygen.VerifyAPIKey(key, hash))
+	require.False(t, keygen.VerifyAPIKey(prefix+"_"+keygen.Secret(), hash))
+
+	// personal access tokens are not api keys
+	pat, _ := keygen.PersonalAccessToken()
+	require.False(t, keygen.IsAPIKey(pat))
+
+	_, err = keygen.APIKeyPrefixOf(pat)
+	require.ErrorIs(t, err, keygen.ErrInvalidAPIKey)
+}
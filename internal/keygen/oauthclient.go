@@ -0,0 +1,20 @@
+package keygen
+
+// OauthClientIDLength is the length of the public identifier of an oauth client
+const OauthClientIDLength = 24
+
+// OauthClient returns a new client id and client secret for an oauth client; the client id is public and
+// the secret is only shown once, only its salted hash is stored
+func OauthClient() (clientID, secret string) {
+	return AlphaNumeric(OauthClientIDLength), Secret()
+}
+
+// HashOauthClientSecret returns the salted hash of the client secret to be stored in place of the secret
+func HashOauthClientSecret(secret string) (string, error) {
+	return HashPersonalAccessToken(secret)
+}
+
+// VerifyOauthClientSecret reports whether the client secret matches the stored salted hash
+func VerifyOauthClientSecret(secret, hash string) bool {
+	return VerifyPersonalAccessToken(secret, hash)
+}
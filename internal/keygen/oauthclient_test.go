@@ -0,0 +1,21 @@
+package keygen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+func TestOauthClient(t *testing.T) {
+	clientID, secret := keygen.OauthClient()
+	require.Len(t, clientID, keygen.OauthClientIDLength)
+	require.Len(t, secret, keygen.SecretLength)
+
+	hash, err := keygen.HashOauthClientSecret(secret)
+	require.NoError(t, err)
+	require.True(t, keygen.VerifyOauthClientSecret(secret, hash))
+	require.False(t, keygen.VerifyOauthClientSecret(keygen.Secret(), hash))
+	require.False(t, keygen.VerifyOauthClientSecret("", hash))
+}
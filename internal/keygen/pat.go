@@ -0,0 +1,94 @@
+package keygen
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	// PersonalAccessTokenPrefix identifies a bearer token as a personal access token
+	PersonalAccessTokenPrefix = "dtmp_"
+	// PersonalAccessTokenIDLength is the length of the random identifier in the visible prefix
+	PersonalAccessTokenIDLength = 8
+
+	patSaltLength = 16
+	patSeparator  = "$"
+)
+
+// ErrInvalidPersonalAccessToken is returned when a personal access token is not in the expected format
+var ErrInvalidPersonalAccessToken = errors.New("invalid personal access token format")
+
+// PersonalAccessToken returns a new personal access token along with its visible prefix; the prefix
+// is stored to identify the token and the full token is only shown to the user once
+func PersonalAccessToken() (token, prefix string) {
+	return prefixedToken(PersonalAccessTokenPrefix)
+}
+
+// PersonalAccessTokenPrefixOf returns the visible prefix of the personal access token used to look it up
+func PersonalAccessTokenPrefixOf(token string) (string, error) {
+	prefix, ok := visiblePrefixOf(token, PersonalAccessTokenPrefix)
+	if !ok {
+		return "", ErrInvalidPersonalAccessToken
+	}
+
+	return prefix, nil
+}
+
+// HashPersonalAccessToken returns the salted sha256 hash of the personal access token to be stored in
+// place of the token; the salt is hex encoded with the hash so the token can be verified later. Tokens
+// are high entropy random values so a fast hash is sufficient
+func HashPersonalAccessToken(token string) (string, error) {
+	salt := make([]byte, patSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(salt) + patSeparator + hashPersonalAccessToken(salt, token), nil
+}
+
+// VerifyPersonalAccessToken reports whether the token matches the stored salted hash using a constant
+// time comparison
+func VerifyPersonalAccessToken(token, hash string) bool {
+	encodedSalt, stored, ok := strings.Cut(hash, patSeparator)
+	if !ok {
+		return false
+	}
+
+	salt, err := hex.DecodeString(encodedSalt)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(hashPersonalAccessToken(salt, token))) == 1
+}
+
+func hashPersonalAccessToken(salt []byte, token string) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(token))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// prefixedToken returns a new secret token that starts with the type prefix and a random identifier,
+// along with the visible prefix used to look the token up
+func prefixedToken(typePrefix string) (token, prefix string) {
+	prefix = typePrefix + AlphaNumeric(PersonalAccessTokenIDLength)
+
+	return prefix + "_" + Secret(), prefix
+}
+
+// visiblePrefixOf returns the visible prefix of a token created by prefixedToken with the type prefix
+func visiblePrefixOf(token, typePrefix string) (string, bool) {
+	n := len(typePrefix) + PersonalAccessTokenIDLength
+
+	if !strings.HasPrefix(token, typePrefix) || len(token) <= n {
+		return "", false
+	}
+
+	return token[:n], true
+}
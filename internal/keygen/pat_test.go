@@ -0,0 +1,44 @@
+package keygen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+func TestPersonalAccessToken(t *testing.T) {
+	token, prefix := keygen.PersonalAccessToken()
+	require.True(t, strings.HasPrefix(token, prefix))
+	require.Len(t, prefix, len(keygen.PersonalAccessTokenPrefix)+keygen.PersonalAccessTokenIDLength)
+
+	parsed, err := keygen.PersonalAccessTokenPrefixOf(token)
+	require.NoError(t, err)
+	require.Equal(t, prefix, parsed)
+
+	hash, err := keygen.HashPersonalAccessToken(token)
+	require.NoError(t, err)
+	require.NotContains(t, hash, token)
+	require.True(t, keygen.VerifyPersonalAccessToken(token, hash))
+
+	// the same token is hashed with a different salt each time
+	other, err := keygen.HashPersonalAccessToken(token)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+	require.True(t, keygen.VerifyPersonalAccessToken(token, other))
+
+	// a different token with the same prefix does not verify
+	forged := prefix + "_" + keygen.Secret()
+	require.False(t, keygen.VerifyPersonalAccessToken(forged, hash))
+
+	// malformed hashes never verify
+	require.False(t, keygen.VerifyPersonalAccessToken(token, "nosalt"))
+	require.False(t, keygen.VerifyPersonalAccessToken(token, "zz$abc"))
+
+	for _, invalid := range []string{"", "dtmp_", "dtmp_abcdefgh", "notatoken"} {
+		_, err := keygen.PersonalAccessTokenPrefixOf(invalid)
+		require.ErrorIs(t, err, keygen.ErrInvalidPersonalAccessToken)
+	}
+}
@@ -0,0 +1,2 @@
+// Package lockout provides failed login tracking with exponential backoff and account lockout thresholds
+package lockout
@@ -0,0 +1,9 @@
+package lockout
+
+import "errors"
+
+// Error constants
+var (
+	// ErrInvalidThreshold is returned when the lock threshold is lower than the backoff threshold
+	ErrInvalidThreshold = errors.New("lock threshold must be greater than or equal to the backoff threshold")
+)
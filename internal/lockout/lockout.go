@@ -0,0 +1,145 @@
+package lockout
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// maxTrackedIPs is the number of ip addresses tracked before stale addresses are pruned
+	maxTrackedIPs = 10000
+	// maxDelay stops the delay from overflowing when no max delay is configured
+	maxDelay = time.Duration(math.MaxInt64 / 2)
+)
+
+// Config contains the thresholds used to throttle failed logins and lock accounts
+type Config struct {
+	// Enabled turns on failed login tracking and account lockout
+	Enabled bool `yaml:"enabled" split_words:"true" default:"true"`
+	// Threshold is the number of consecutive failed logins of a user before further logins are delayed
+	Threshold int `yaml:"threshold" split_words:"true" default:"5"`
+	// LockThreshold is the number of consecutive failed logins of a user after which the account is locked until unlocked
+	LockThreshold int `yaml:"lockThreshold" split_words:"true" default:"10"`
+	// IPThreshold is the number of failed logins from an ip address before further logins from the address are delayed
+	IPThreshold int `yaml:"ipThreshold" split_words:"true" default:"20"`
+	// BaseDelay is the delay after the first failed login over a threshold, it is doubled with each further failure
+	BaseDelay time.Duration `yaml:"baseDelay" split_words:"true" default:"1s"`
+	// MaxDelay is the longest delay between failed logins
+	MaxDelay time.Duration `yaml:"maxDelay" split_words:"true" default:"15m"`
+	// IPResetAfter is how long after the last failed login the failures from an ip address are forgotten
+	IPResetAfter time.Duration `yaml:"ipResetAfter" split_words:"true" default:"1h"`
+}
+
+// Lockout computes login delays from the number of consecutive failures, failures of a user are
+// stored with the user while failures of an ip address are tracked in memory
+type Lockout struct {
+	conf Config
+
+	mu  sync.Mutex
+	ips map[string]*attempts
+}
+
+// attempts are the failed logins from an ip address
+type attempts struct {
+	failures int
+	last     time.Time
+}
+
+// New returns a Lockout with the thresholds of the config
+func New(conf Config) (*Lockout, error) {
+	if conf.LockThreshold < conf.Threshold {
+		return nil, ErrInvalidThreshold
+	}
+
+	return &Lockout{
+		conf: conf,
+		ips:  map[string]*attempts{},
+	}, nil
+}
+
+// Delay returns how long to wait after the failed login for a user with the number of consecutive failures,
+// no delay is returned until the threshold is reached
+func (l *Lockout) Delay(failures int) time.Duration {
+	return l.delay(failures, l.conf.Threshold)
+}
+
+// ShouldLock returns true when the number of consecutive failures of a user reaches the lock threshold
+func (l *Lockout) ShouldLock(failures int) bool {
+	return l.conf.LockThreshold > 0 && failures >= l.conf.LockThreshold
+}
+
+// IPRetryAfter returns how long until a login from the ip address is allowed, zero when it is allowed now
+func (l *Lockout) IPRetryAfter(ip string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.ips[ip]
+	if !ok {
+		return 0
+	}
+
+	if l.stale(a, now) {
+		delete(l.ips, ip)
+
+		return 0
+	}
+
+	if wait := a.last.Add(l.delay(a.failures, l.conf.IPThreshold)).Sub(now); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
+
+// IPFailed records a failed login from the ip address
+func (l *Lockout) IPFailed(ip string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.ips[ip]
+	if !ok || l.stale(a, now) {
+		if len(l.ips) >= maxTrackedIPs {
+			l.prune(now)
+		}
+
+		a = &attempts{}
+		l.ips[ip] = a
+	}
+
+	a.failures++
+	a.last = now
+}
+
+// delay doubles the base delay for each failure at or over the threshold, up to the max delay
+func (l *Lockout) delay(failures, threshold int) time.Duration {
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+
+	d := l.conf.BaseDelay
+
+	for i := threshold; i < failures && d < maxDelay; i++ {
+		d *= 2
+	}
+
+	if l.conf.MaxDelay > 0 && d > l.conf.MaxDelay {
+		return l.conf.MaxDelay
+	}
+
+	return d
+}
+
+// stale returns true when the failures of the ip address should be forgotten
+func (l *Lockout) stale(a *attempts, now time.Time) bool {
+	return l.conf.IPResetAfter > 0 && now.Sub(a.last) > l.conf.IPResetAfter
+}
+
+// prune removes the ip addresses without recent failures, the lock must be held
+func (l *Lockout) prune(now time.Time) {
+	for ip, a := range l.ips {
+		if l.stale(a, now) {
+			delete(l.ips, ip)
+		}
+	}
+}
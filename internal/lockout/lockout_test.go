@@ -0,0 +1,97 @@
+package lockout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/lockout"
+)
+
+var testConfig = lockout.Config{
+	Enabled:       true,
+	Threshold:     3,
+	LockThreshold: 6,
+	IPThreshold:   5,
+	BaseDelay:     time.Second,
+	MaxDelay:      5 * time.Second,
+	IPResetAfter:  time.Hour,
+}
+
+func TestNew(t *testing.T) {
+	_, err := lockout.New(lockout.Config{Threshold: 5, LockThreshold: 3})
+	assert.ErrorIs(t, err, lockout.ErrInvalidThreshold)
+
+	_, err = lockout.New(testConfig)
+	assert.NoError(t, err)
+}
+
+func TestDelay(t *testing.T) {
+	l, err := lockout.New(testConfig)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: 0},
+		{failures: 2, expected: 0},
+		{failures: 3, expected: time.Second},
+		{failures: 4, expected: 2 * time.Second},
+		{failures: 5, expected: 4 * time.Second},
+		{failures: 6, expected: 5 * time.Second},
+		{failures: 100, expected: 5 * time.Second},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, l.Delay(tc.failures), "failures: %d", tc.failures)
+	}
+
+	// without a max delay the delay keeps doubling without overflowing
+	uncapped, err := lockout.New(lockout.Config{Threshold: 1, LockThreshold: 1, BaseDelay: time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, 8*time.Second, uncapped.Delay(4))
+	assert.Positive(t, uncapped.Delay(1000))
+}
+
+func TestShouldLock(t *testing.T) {
+	l, err := lockout.New(testConfig)
+	require.NoError(t, err)
+
+	assert.False(t, l.ShouldLock(5))
+	assert.True(t, l.ShouldLock(6))
+	assert.True(t, l.ShouldLock(7))
+}
+
+func TestIPRetryAfter(t *testing.T) {
+	l, err := lockout.New(testConfig)
+	require.NoError(t, err)
+
+	ip := "10.0.0.1"
+	now := time.Now()
+
+	// failures under the threshold are not delayed
+	for i := 0; i < 4; i++ {
+		l.IPFailed(ip, now)
+		assert.Zero(t, l.IPRetryAfter(ip, now))
+	}
+
+	l.IPFailed(ip, now)
+	assert.Equal(t, time.Second, l.IPRetryAfter(ip, now))
+	assert.Zero(t, l.IPRetryAfter(ip, now.Add(time.Second)))
+
+	l.IPFailed(ip, now)
+	assert.Equal(t, 2*time.Second, l.IPRetryAfter(ip, now))
+
+	// other addresses are not affected
+	assert.Zero(t, l.IPRetryAfter("10.0.0.2", now))
+
+	// failures are forgotten after the reset period
+	later := now.Add(2 * time.Hour)
+	assert.Zero(t, l.IPRetryAfter(ip, later))
+
+	l.IPFailed(ip, later)
+	assert.Zero(t, l.IPRetryAfter(ip, later))
+}
@@ -0,0 +1,2 @@
+// Package otp provides time-based one-time passwords (RFC 6238) and recovery codes for multi-factor authentication
+package otp
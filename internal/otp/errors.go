@@ -0,0 +1,12 @@
+package otp
+
+import "errors"
+
+// Error constants
+var (
+	// ErrInvalidSecret is returned when the provided secret is not valid base32
+	ErrInvalidSecret = errors.New("invalid otp secret")
+
+	// ErrCouldNotGenerate is returned when random bytes for a secret could not be generated
+	ErrCouldNotGenerate = errors.New("could not generate otp secret")
+)
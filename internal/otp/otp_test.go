@@ -0,0 +1,121 @@
+package otp_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/otp"
+)
+
+// rfcSecret is the base32 encoding of the RFC 6238 SHA1 test secret "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 Appendix B test vectors, truncated to 6 digits
+	testCases := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1111111111, expected: "050471"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+	}
+
+	for _, tc := range testCases {
+		code, err := otp.Code(rfcSecret, time.Unix(tc.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, code)
+	}
+
+	_, err := otp.Code("not base32!", time.Now())
+	assert.ErrorIs(t, err, otp.ErrInvalidSecret)
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	code, err := otp.Code(secret, now)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		code     string
+		secret   string
+		at       time.Time
+		expected bool
+	}{
+		{name: "current step", code: code, secret: secret, at: now, expected: true},
+		{name: "previous step", code: code, secret: secret, at: now.Add(otp.Period), expected: true},
+		{name: "next step", code: code, secret: secret, at: now.Add(-otp.Period), expected: true},
+		{name: "outside skew", code: code, secret: secret, at: now.Add(3 * otp.Period), expected: false},
+		{name: "wrong length", code: "1234", secret: secret, at: now, expected: false},
+		{name: "invalid secret", code: code, secret: "!!", at: now, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, otp.Validate(tc.code, tc.secret, tc.at))
+		})
+	}
+}
+
+func TestValidateStep(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := now.Unix() / int64(otp.Period.Seconds())
+
+	code, err := otp.Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	got, ok := otp.ValidateStep(code, rfcSecret, now)
+	assert.True(t, ok)
+	assert.Equal(t, step, got)
+
+	// the step the code was generated for is returned when it is accepted within the skew
+	got, ok = otp.ValidateStep(code, rfcSecret, now.Add(otp.Period))
+	assert.True(t, ok)
+	assert.Equal(t, step, got)
+
+	_, ok = otp.ValidateStep("000000", rfcSecret, now.Add(3*otp.Period))
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := otp.URI(rfcSecret, "Datum", "rick@datum.net")
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Datum:rick@datum.net", u.Path)
+	assert.Equal(t, rfcSecret, u.Query().Get("secret"))
+	assert.Equal(t, "Datum", u.Query().Get("issuer"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes := otp.GenerateRecoveryCodes(otp.RecoveryCodeCount)
+	require.Len(t, codes, otp.RecoveryCodeCount)
+	require.Len(t, hashes, otp.RecoveryCodeCount)
+
+	remaining, ok := otp.ConsumeRecoveryCode(codes[3], hashes)
+	assert.True(t, ok)
+	assert.Len(t, remaining, otp.RecoveryCodeCount-1)
+
+	// codes can only be used once
+	_, ok = otp.ConsumeRecoveryCode(codes[3], remaining)
+	assert.False(t, ok)
+
+	// codes are case insensitive
+	_, ok = otp.ConsumeRecoveryCode(" "+strings.ToUpper(codes[4])+" ", remaining)
+	assert.True(t, ok)
+}
@@ -0,0 +1,58 @@
+package otp
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/datumforge/datum/internal/keygen"
+)
+
+// Recovery code defaults
+const (
+	RecoveryCodeCount  = 10 // the number of recovery codes generated on enrollment
+	recoveryCodeLength = 10 // the number of characters in each half of a recovery code
+)
+
+// GenerateRecoveryCodes returns a set of random one-time recovery codes in plain text along
+// with their hashes; only the hashes should be stored and the plain text codes returned to the user once
+func GenerateRecoveryCodes(n int) (codes, hashes []string) {
+	codes = make([]string, 0, n)
+	hashes = make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		c := strings.ToLower(keygen.AlphaNumeric(recoveryCodeLength) + "-" + keygen.AlphaNumeric(recoveryCodeLength))
+
+		codes = append(codes, c)
+		hashes = append(hashes, HashRecoveryCode(c))
+	}
+
+	return codes, hashes
+}
+
+// HashRecoveryCode returns the hex encoded sha256 hash of the normalized recovery code; recovery codes
+// are high entropy random values so a fast hash is sufficient
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ConsumeRecoveryCode checks the code against the stored hashes and returns the remaining
+// hashes with the matched code removed so that it cannot be used again
+func ConsumeRecoveryCode(code string, hashes []string) (remaining []string, ok bool) {
+	h := HashRecoveryCode(code)
+	remaining = make([]string, 0, len(hashes))
+
+	for _, stored := range hashes {
+		if !ok && subtle.ConstantTimeCompare([]byte(stored), []byte(h)) == 1 {
+			ok = true
+			continue
+		}
+
+		remaining = append(remaining, stored)
+	}
+
+	return remaining, ok
+}
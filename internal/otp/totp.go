@@ -0,0 +1,140 @@
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // sha1 is required by RFC 6238 and supported by all authenticator apps
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP defaults compatible with common authenticator apps
+const (
+	SecretLength = 20               // the number of random bytes in a secret, 160 bits as recommended by RFC 4226
+	Digits       = 6                // the number of digits in a generated code
+	Period       = 30 * time.Second // the time step of a generated code
+	Skew         = 1                // the number of time steps before and after the current step that are accepted
+)
+
+var (
+	b32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+	digitsMod    = uint32(1000000) //nolint:gomnd
+)
+
+// GenerateSecret returns a new random base32 encoded secret
+func GenerateSecret() (string, error) {
+	secret := make([]byte, SecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", ErrCouldNotGenerate
+	}
+
+	return b32NoPadding.EncodeToString(secret), nil
+}
+
+// Code returns the code for the secret at the specified time
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, counter(t)), nil
+}
+
+// Validate checks the code against the secret at the specified time, allowing for
+// clock skew between the server and the authenticator app
+func Validate(passcode, secret string, t time.Time) bool {
+	_, ok := ValidateStep(passcode, secret, t)
+
+	return ok
+}
+
+// ValidateStep checks the code against the secret at the specified time like Validate and returns the
+// time step the code was generated for, callers store the step of the last accepted code and reject
+// codes for the same or an earlier step so a code cannot be replayed
+func ValidateStep(passcode, secret string, t time.Time) (int64, bool) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != Digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	c := int64(counter(t))
+
+	var (
+		step  int64
+		valid int
+	)
+
+	for i := -Skew; i <= Skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(code(key, uint64(c+int64(i)))), []byte(passcode)) == 1 {
+			step = c + int64(i)
+			valid = 1
+		}
+	}
+
+	return step, valid == 1
+}
+
+// URI returns the otpauth URI for the secret that can be rendered as a QR code and scanned by an authenticator app
+// See: https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func URI(secret, issuer, account string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", Digits))
+	v.Set("period", fmt.Sprintf("%d", int(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+
+	return u.String()
+}
+
+// decodeSecret decodes the base32 secret, ignoring case, spaces and padding
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := b32NoPadding.DecodeString(secret)
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return key, nil
+}
+
+// counter returns the number of time steps since the unix epoch
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(Period.Seconds()))
+}
+
+// code computes the HOTP value for the key and counter as described in RFC 4226
+func code(key []byte, c uint64) string {
+	msg := make([]byte, 8) //nolint:gomnd
+	binary.BigEndian.PutUint64(msg, c)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f                                    //nolint:gomnd
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff //nolint:gomnd
+
+	return fmt.Sprintf("%0*d", Digits, value%digitsMod)
+}
@@ -0,0 +1,95 @@
+package passwd
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	// breachPrefixLen is the length of the hash prefix used to look up a range of breached hashes, as used by
+	// the k-anonymity model of the pwned passwords api
+	breachPrefixLen = 5
+	// sha1HexLen is the length of a hex encoded sha1 hash
+	sha1HexLen = 40
+)
+
+// BreachCorpus is a locally loaded corpus of breached password hashes, the hashes are grouped by their prefix
+// so a password is checked by looking up the range of its hash prefix, the same k-anonymity model used by the
+// pwned passwords api, without the password or its full hash ever leaving the process
+type BreachCorpus struct {
+	ranges map[string][]string
+}
+
+// LoadBreachCorpus loads the breached password corpus from the file, see ReadBreachCorpus for the format
+func LoadBreachCorpus(path string) (*BreachCorpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadBreachCorpus(f)
+}
+
+// ReadBreachCorpus reads a breached password corpus with one upper or lower case hex encoded sha1 hash per
+// line, optionally followed by a colon and the number of times it was seen, which is the format of the
+// pwned passwords downloads. Empty lines are skipped
+func ReadBreachCorpus(r io.Reader) (*BreachCorpus, error) {
+	c := &BreachCorpus{
+		ranges: map[string][]string{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		hash = strings.ToUpper(hash)
+
+		if len(hash) != sha1HexLen {
+			return nil, ErrInvalidBreachCorpus
+		}
+
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, ErrInvalidBreachCorpus
+		}
+
+		prefix, suffix := hash[:breachPrefixLen], hash[breachPrefixLen:]
+		c.ranges[prefix] = append(c.ranges[prefix], suffix)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for prefix, suffixes := range c.ranges {
+		slices.Sort(suffixes)
+		c.ranges[prefix] = slices.Compact(suffixes)
+	}
+
+	return c, nil
+}
+
+// Range returns the sorted hash suffixes of the breached passwords with the hash prefix
+func (c *BreachCorpus) Range(prefix string) []string {
+	return c.ranges[strings.ToUpper(prefix)]
+}
+
+// IsBreached returns true when the password is in the corpus
+func (c *BreachCorpus) IsBreached(password string) bool {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	_, found := slices.BinarySearch(c.Range(hash[:breachPrefixLen]), hash[breachPrefixLen:])
+
+	return found
+}
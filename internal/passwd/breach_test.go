@@ -0,0 +1,80 @@
+package passwd_test
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/passwd"
+)
+
+// sha1Hex returns the upper case hex encoded sha1 hash of the password
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func TestBreachCorpus(t *testing.T) {
+	breached := "alda#13k9-32qda2"
+	counted := "a?Lda13k932Qd**A2"
+
+	corpus, err := passwd.ReadBreachCorpus(strings.NewReader(strings.Join([]string{
+		sha1Hex(breached),
+		"",
+		strings.ToLower(sha1Hex(counted)) + ":1337",
+		sha1Hex(counted) + ":1337",
+	}, "\n")))
+	require.NoError(t, err)
+
+	assert.True(t, corpus.IsBreached(breached))
+	assert.True(t, corpus.IsBreached(counted))
+	assert.False(t, corpus.IsBreached("aklxiwoalsddaiwwa"))
+
+	// the range of a prefix only contains the hash suffixes, duplicates are removed
+	hash := sha1Hex(counted)
+	assert.Equal(t, []string{hash[5:]}, corpus.Range(strings.ToLower(hash[:5])))
+	assert.Empty(t, corpus.Range("00000"))
+
+	// the corpus can be loaded from a file
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(sha1Hex(breached)+":3\n"), 0600)) //nolint:gomnd
+
+	corpus, err = passwd.LoadBreachCorpus(path)
+	require.NoError(t, err)
+
+	assert.True(t, corpus.IsBreached(breached))
+	assert.False(t, corpus.IsBreached(counted))
+
+	_, err = passwd.LoadBreachCorpus(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestReadBreachCorpusErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		corpus string
+	}{
+		{
+			name:   "too short",
+			corpus: "5BAA6:10",
+		},
+		{
+			name:   "not hex",
+			corpus: "ZZAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := passwd.ReadBreachCorpus(strings.NewReader(tc.corpus))
+			require.ErrorIs(t, err, passwd.ErrInvalidBreachCorpus)
+		})
+	}
+}
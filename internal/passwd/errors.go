@@ -21,8 +21,29 @@ var (
 
 	// ErrCannotParseEncodedEK is returned when the derived key parts do not match the desired part length
 	ErrCannotParseEncodedEK = errors.New("cannot parse encoded derived key, matched expression does not contain enough subgroups")
+	// ErrPasswordTooWeak is returned when the password does not have the minimum strength of the password policy
+	ErrPasswordTooWeak = errors.New("password is too weak: use a combination of upper and lower case letters, numbers, and special characters")
+	// ErrPasswordTooShort is returned when the password is shorter than the minimum length of the password policy
+	ErrPasswordTooShort = errors.New("password is too short")
+	// ErrPasswordBreached is returned when the password was found in the breached password corpus
+	ErrPasswordBreached = errors.New("password has appeared in a data breach, please choose a different password")
+	// ErrPasswordReused is returned when the password matches the current password or one in the password history
+	ErrPasswordReused = errors.New("password was already used, please try again")
+	// ErrPasswordExpired is returned when the password is older than the max age of the password policy
+	ErrPasswordExpired = errors.New("password has expired, please reset your password")
+	// ErrInvalidBreachCorpus is returned when a line of the breached password corpus is not a sha1 hash
+	ErrInvalidBreachCorpus = errors.New("invalid breached password corpus, expected a sha1 hash per line")
 )
 
+// IsPolicyViolation returns true when the error is returned because the password does not satisfy the
+// password policy, the error can be returned to the user
+func IsPolicyViolation(err error) bool {
+	return errors.Is(err, ErrPasswordTooWeak) ||
+		errors.Is(err, ErrPasswordTooShort) ||
+		errors.Is(err, ErrPasswordBreached) ||
+		errors.Is(err, ErrPasswordReused)
+}
+
 // ParseError is defining a custom error type called `ParseError`. It is a struct
 // that holds intermediary values for comparison in errors
 type ParseError struct {
@@ -0,0 +1,118 @@
+package passwd
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// defaultMinLength is the minimum length of passwords when no policy is configured
+const defaultMinLength = 8
+
+// PolicyConfig contains the requirements passwords must meet when they are set
+type PolicyConfig struct {
+	// MinStrength is the minimum strength of passwords as classified by Strength, from 0 (weak) to 5 (excellent)
+	MinStrength PasswordStrength `yaml:"minStrength" split_words:"true" default:"3"`
+	// MinLength is the minimum number of characters of passwords
+	MinLength int `yaml:"minLength" split_words:"true" default:"8"`
+	// HistorySize is the number of most recent passwords, including the current password, that cannot be reused
+	HistorySize int `yaml:"historySize" split_words:"true" default:"5"`
+	// MaxAge is how long a password can be used before it has to be reset, zero disables password expiry
+	MaxAge time.Duration `yaml:"maxAge" split_words:"true" default:"0s"`
+	// BreachCorpus is the path of the breached password hashes passwords are checked against, see
+	// ReadBreachCorpus for the format; the check is disabled when empty
+	BreachCorpus string `yaml:"breachCorpus" split_words:"true"`
+}
+
+// Policy enforces the password requirements of the config
+type Policy struct {
+	conf     PolicyConfig
+	breached *BreachCorpus
+}
+
+// NewPolicy returns the password policy of the config, loading the breached password corpus if configured
+func NewPolicy(conf PolicyConfig) (*Policy, error) {
+	p := &Policy{conf: conf}
+
+	if conf.BreachCorpus != "" {
+		corpus, err := LoadBreachCorpus(conf.BreachCorpus)
+		if err != nil {
+			return nil, err
+		}
+
+		p.breached = corpus
+	}
+
+	return p, nil
+}
+
+// DefaultPolicy returns the policy used when none is configured, passwords must be of moderate strength and
+// there is no password history, expiry or breach check
+func DefaultPolicy() *Policy {
+	return &Policy{
+		conf: PolicyConfig{
+			MinStrength: Moderate,
+			MinLength:   defaultMinLength,
+		},
+	}
+}
+
+// WithBreachCorpus sets the breached password corpus passwords are checked against
+func (p *Policy) WithBreachCorpus(c *BreachCorpus) *Policy {
+	p.breached = c
+
+	return p
+}
+
+// Validate returns an error when the password is too weak, too short or has been breached
+func (p *Policy) Validate(password string) error {
+	switch {
+	case Strength(password) < p.conf.MinStrength:
+		return ErrPasswordTooWeak
+	case utf8.RuneCountInString(password) < p.conf.MinLength:
+		return ErrPasswordTooShort
+	case p.breached != nil && p.breached.IsBreached(password):
+		return ErrPasswordBreached
+	}
+
+	return nil
+}
+
+// CheckReuse returns an error when the password matches one of the derived keys of the previous passwords,
+// only the most recent passwords within the history size are checked
+func (p *Policy) CheckReuse(password string, previous []string) error {
+	if len(previous) > p.conf.HistorySize {
+		previous = previous[:p.conf.HistorySize]
+	}
+
+	for _, dk := range previous {
+		if dk == "" {
+			continue
+		}
+
+		if ok, err := VerifyDerivedKey(dk, password); err == nil && ok {
+			return ErrPasswordReused
+		}
+	}
+
+	return nil
+}
+
+// History returns the password history after the password with the derived key is set, the most recent
+// password is first and the history is limited to the history size
+func (p *Policy) History(dk string, history []string) []string {
+	if p.conf.HistorySize <= 0 {
+		return []string{}
+	}
+
+	out := append([]string{dk}, history...)
+	if len(out) > p.conf.HistorySize {
+		out = out[:p.conf.HistorySize]
+	}
+
+	return out
+}
+
+// Expired returns true when the password set at the time is older than the max age of the policy
+func (p *Policy) Expired(changedAt time.Time) bool {
+	return p.conf.MaxAge > 0 && time.Since(changedAt) > p.conf.MaxAge
+}
@@ -0,0 +1,126 @@
+package passwd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/passwd"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	breached := "alda#13k9-32qda2"
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(sha1Hex(breached)+"\n"), 0600)) //nolint:gomnd
+
+	policy, err := passwd.NewPolicy(passwd.PolicyConfig{
+		MinStrength:  passwd.Strong,
+		MinLength:    16, //nolint:gomnd
+		BreachCorpus: path,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		policy   *passwd.Policy
+		password string
+		expected error
+	}{
+		{
+			name:     "valid password",
+			policy:   policy,
+			password: "a?Lda13k932Qd**A2",
+		},
+		{
+			name:     "too weak",
+			policy:   policy,
+			password: "alda13k932qda2",
+			expected: passwd.ErrPasswordTooWeak,
+		},
+		{
+			name:     "too short",
+			policy:   policy,
+			password: "aL#13k9-32qda2",
+			expected: passwd.ErrPasswordTooShort,
+		},
+		{
+			name:     "breached",
+			policy:   policy,
+			password: breached,
+			expected: passwd.ErrPasswordBreached,
+		},
+		{
+			name:     "default policy",
+			policy:   passwd.DefaultPolicy(),
+			password: "alda13k932qda2",
+		},
+		{
+			name:     "default policy, too weak",
+			policy:   passwd.DefaultPolicy(),
+			password: "aklxiwoalsddaiwwa",
+			expected: passwd.ErrPasswordTooWeak,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate(tc.password)
+			if tc.expected == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tc.expected)
+			assert.True(t, passwd.IsPolicyViolation(err))
+		})
+	}
+
+	_, err = passwd.NewPolicy(passwd.PolicyConfig{BreachCorpus: filepath.Join(t.TempDir(), "missing.txt")})
+	require.Error(t, err)
+}
+
+func TestPolicyHistory(t *testing.T) {
+	policy, err := passwd.NewPolicy(passwd.PolicyConfig{HistorySize: 2})
+	require.NoError(t, err)
+
+	passwords := []string{"alda13k932qda2", "alda#13k9-32qda2", "a?Lda13k932Qd**A2"}
+
+	var history []string
+
+	for _, password := range passwords {
+		require.NoError(t, policy.CheckReuse(password, history))
+
+		dk, err := passwd.CreateDerivedKey(password)
+		require.NoError(t, err)
+
+		history = policy.History(dk, history)
+	}
+
+	// only the most recent passwords are kept
+	require.Len(t, history, 2)
+
+	require.ErrorIs(t, policy.CheckReuse(passwords[2], history), passwd.ErrPasswordReused)
+	require.ErrorIs(t, policy.CheckReuse(passwords[1], history), passwd.ErrPasswordReused)
+	require.NoError(t, policy.CheckReuse(passwords[0], history))
+
+	// passwords can be reused when there is no history
+	assert.Empty(t, passwd.DefaultPolicy().History(history[0], history))
+	require.NoError(t, passwd.DefaultPolicy().CheckReuse(passwords[2], history))
+}
+
+func TestPolicyExpired(t *testing.T) {
+	policy, err := passwd.NewPolicy(passwd.PolicyConfig{MaxAge: time.Hour})
+	require.NoError(t, err)
+
+	assert.False(t, policy.Expired(time.Now().Add(-time.Minute)))
+	assert.True(t, policy.Expired(time.Now().Add(-2*time.Hour)))
+
+	// passwords do not expire without a max age
+	assert.False(t, passwd.DefaultPolicy().Expired(time.Now().Add(-24*365*time.Hour)))
+}
@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"ariga.io/entcache"
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/sessiondata"
+	"github.com/datumforge/datum/internal/utils/marionette"
+)
+
+// DefaultCleanupInterval is how often expired sessions are removed from the database
+const DefaultCleanupInterval = 5 * time.Minute
+
+// EntStore is a session store for the scs session manager that persists the session data in the database,
+// so sessions survive restarts and are shared across replicas. It implements the scs Store, CtxStore,
+// IterableStore and IterableCtxStore interfaces
+type EntStore struct {
+	client *ent.Client
+}
+
+// NewEntStore returns a session store using the ent client
+func NewEntStore(c *ent.Client) *EntStore {
+	return &EntStore{
+		client: c,
+	}
+}
+
+// Find returns the data for the session token, found is false when the session does not exist or has expired
+func (s *EntStore) Find(token string) (b []byte, found bool, err error) {
+	return s.FindCtx(context.Background(), token)
+}
+
+// FindCtx is the same as Find with a context, the query cache is skipped so the latest data is always returned
+func (s *EntStore) FindCtx(ctx context.Context, token string) (b []byte, found bool, err error) {
+	sd, err := s.client.SessionData.Query().
+		Where(
+			sessiondata.Token(token),
+			sessiondata.ExpiryGT(time.Now()),
+		).
+		Only(entcache.Skip(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return sd.Data, true, nil
+}
+
+// Commit adds the session token and data to the store, the data and expiry of an existing session are overwritten
+func (s *EntStore) Commit(token string, b []byte, expiry time.Time) error {
+	return s.CommitCtx(context.Background(), token, b, expiry)
+}
+
+// CommitCtx is the same as Commit with a context
+func (s *EntStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	updated, err := s.update(ctx, token, b, expiry)
+	if err != nil || updated {
+		return err
+	}
+
+	err = s.client.SessionData.Create().
+		SetToken(token).
+		SetData(b).
+		SetExpiry(expiry).
+		Exec(ctx)
+
+	// the session may have been created by a concurrent request since it was updated
+	if ent.IsConstraintError(err) {
+		_, err = s.update(ctx, token, b, expiry)
+	}
+
+	return err
+}
+
+// Delete removes the session token and its data from the store, deleting a missing session is a no-op
+func (s *EntStore) Delete(token string) error {
+	return s.DeleteCtx(context.Background(), token)
+}
+
+// DeleteCtx is the same as Delete with a context
+func (s *EntStore) DeleteCtx(ctx context.Context, token string) error {
+	_, err := s.client.SessionData.Delete().
+		Where(sessiondata.Token(token)).
+		Exec(ctx)
+
+	return err
+}
+
+// All returns the data of the active sessions keyed by the session token
+func (s *EntStore) All() (map[string][]byte, error) {
+	return s.AllCtx(context.Background())
+}
+
+// AllCtx is the same as All with a context
+func (s *EntStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	sds, err := s.client.SessionData.Query().
+		Where(sessiondata.ExpiryGT(time.Now())).
+		All(entcache.Skip(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make(map[string][]byte, len(sds))
+
+	for _, sd := range sds {
+		sessions[sd.Token] = sd.Data
+	}
+
+	return sessions, nil
+}
+
+// Cleanup removes the expired sessions from the store and returns the number removed
+func (s *EntStore) Cleanup(ctx context.Context) (int, error) {
+	return s.client.SessionData.Delete().
+		Where(sessiondata.ExpiryLTE(time.Now())).
+		Exec(ctx)
+}
+
+// StartCleanup schedules the removal of expired sessions on the task manager, the task schedules itself
+// again after each run until the task manager is stopped
+func (s *EntStore) StartCleanup(tm *marionette.TaskManager, interval time.Duration, logger *zap.SugaredLogger) error {
+	if interval <= 0 {
+		interval = DefaultCleanupInterval
+	}
+
+	var cleanup marionette.TaskFunc
+
+	cleanup = func(ctx context.Context) error {
+		n, err := s.Cleanup(ctx)
+		if err != nil {
+			logger.Errorw("error removing expired sessions", "error", err)
+		} else if n > 0 {
+			logger.Debugw("removed expired sessions", "count", n)
+		}
+
+		if !tm.IsRunning() {
+			return nil
+		}
+
+		return tm.Delay(interval, cleanup)
+	}
+
+	return tm.Delay(interval, cleanup)
+}
+
+// update overwrites the data and expiry of the session and reports whether the session exists
+func (s *EntStore) update(ctx context.Context, token string, b []byte, expiry time.Time) (bool, error) {
+	n, err := s.client.SessionData.Update().
+		Where(sessiondata.Token(token)).
+		SetData(b).
+		SetExpiry(expiry).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
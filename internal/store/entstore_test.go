@@ -0,0 +1,121 @@
+package store_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"ariga.io/entcache"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/entdb"
+	"github.com/datumforge/datum/internal/store"
+	"github.com/datumforge/datum/internal/testutils"
+	"github.com/datumforge/datum/internal/utils/marionette"
+)
+
+func setupEntStore(t *testing.T) (*store.EntStore, *ent.Client) {
+	ctx := context.Background()
+
+	logger := zap.NewNop().Sugar()
+
+	ctr := testutils.GetTestURI(ctx, os.Getenv("TEST_DB_URL"))
+
+	dbconf := entdb.Config{
+		Debug:           true,
+		DriverName:      ctr.Dialect,
+		PrimaryDBSource: ctr.URI,
+	}
+
+	c, err := entdb.NewDBConfig(dbconf, logger).NewMultiDriverDBClient(ctx, []ent.Option{ent.Logger(*logger)})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Schema.Create(ctx))
+
+	t.Cleanup(func() { c.Close() })
+
+	return store.NewEntStore(c), c
+}
+
+func TestEntStore(t *testing.T) {
+	s, _ := setupEntStore(t)
+
+	expiry := time.Now().Add(time.Hour)
+
+	// missing sessions are not found
+	b, found, err := s.Find("missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Nil(t, b)
+
+	require.NoError(t, s.Commit("token", []byte("data"), expiry))
+
+	b, found, err = s.Find("token")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("data"), b)
+
+	// committing an existing session overwrites the data
+	require.NoError(t, s.Commit("token", []byte("updated"), expiry))
+
+	b, found, err = s.Find("token")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("updated"), b)
+
+	// expired sessions are not found or listed
+	require.NoError(t, s.Commit("expired", []byte("data"), time.Now().Add(-time.Minute)))
+
+	_, found, err = s.Find("expired")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	all, err := s.All()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"token": []byte("updated")}, all)
+
+	require.NoError(t, s.Delete("token"))
+
+	_, found, err = s.Find("token")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// deleting a missing session is a no-op
+	require.NoError(t, s.Delete("token"))
+
+	all, err = s.All()
+	require.NoError(t, err)
+	assert.Empty(t, all)
+	assert.NotNil(t, all)
+}
+
+func TestEntStoreCleanup(t *testing.T) {
+	s, c := setupEntStore(t)
+
+	ctx := context.Background()
+
+	require.NoError(t, s.Commit("active", []byte("data"), time.Now().Add(time.Hour)))
+	require.NoError(t, s.Commit("expired", []byte("data"), time.Now().Add(-time.Minute)))
+
+	tm := marionette.New(marionette.Config{Logger: zap.NewNop().Sugar()})
+	tm.Start()
+
+	defer tm.Stop()
+
+	require.NoError(t, s.StartCleanup(tm, 10*time.Millisecond, zap.NewNop().Sugar()))
+
+	// the expired session is removed by the cleanup task and the active session is kept
+	assert.Eventually(t, func() bool {
+		return c.SessionData.Query().CountX(entcache.Skip(ctx)) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	_, found, err := s.Find("active")
+	require.NoError(t, err)
+	assert.True(t, found)
+}
@@ -20,6 +20,33 @@ type Claims struct {
 	ParentOrgID string `json:"parentorg,omitempty"`
 	// Tier the token is valid for
 	Tier string `json:"tier,omitempty"`
+	// Scopes the token is restricted to, when empty the token has the full access of the user
+	Scopes []string `json:"scopes,omitempty"`
+	// SubjectType of the subject the token was issued to, when empty the subject is a user
+	SubjectType string `json:"sub_type,omitempty"`
+	// ClientID of the oauth client the token was issued to, empty for tokens issued on login
+	ClientID string `json:"client_id,omitempty"`
+}
+
+const (
+	// SubjectTypeUser is the subject type of tokens issued to users
+	SubjectTypeUser = "user"
+	// SubjectTypeService is the subject type of organization API keys used by service accounts
+	SubjectTypeService = "service"
+)
+
+// GetSubjectType returns the type of the subject of the claims, defaulting to a user
+func (c Claims) GetSubjectType() string {
+	if c.SubjectType == "" {
+		return SubjectTypeUser
+	}
+
+	return c.SubjectType
+}
+
+// IsService reports whether the claims belong to a service account rather than a user
+func (c Claims) IsService() bool {
+	return c.GetSubjectType() == SubjectTypeService
 }
 
 // ParseUserID returns the ID of the user from the Subject of the claims
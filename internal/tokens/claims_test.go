@@ -32,3 +32,65 @@ func TestClaimsParseUserID(t *testing.T) {
 	claims.UserID = userID.String()
 	require.Equal(t, userID, claims.ParseUserID())
 }
+
+func TestClaimsHasScope(t *testing.T) {
+	// claims without scopes have the full access of the user
+	claims := &tokens.Claims{}
+	require.False(t, claims.IsRestricted())
+	require.True(t, claims.HasScope(tokens.ScopeResourceOrg, tokens.ScopeActionAdmin))
+	require.True(t, claims.CanMutate())
+
+	claims.Scopes = []string{"org:read", "group:write", "user:admin"}
+	require.True(t, claims.IsRestricted())
+
+	require.True(t, claims.HasScope(tokens.ScopeResourceOrg, tokens.ScopeActionRead))
+	require.False(t, claims.HasScope(tokens.ScopeResourceOrg, tokens.ScopeActionWrite))
+
+	require.True(t, claims.HasScope(tokens.ScopeResourceGroup, tokens.ScopeActionRead))
+	require.True(t, claims.HasScope(tokens.ScopeResourceGroup, tokens.ScopeActionWrite))
+	require.False(t, claims.HasScope(tokens.ScopeResourceGroup, tokens.ScopeActionAdmin))
+
+	require.True(t, claims.HasScope(tokens.ScopeResourceUser, tokens.ScopeActionAdmin))
+	require.False(t, claims.HasScope(tokens.ScopeResourceUser, "unknown"))
+	require.True(t, claims.CanMutate())
+
+	claims.Scopes = []string{"org:read", "user:read"}
+	require.False(t, claims.CanMutate())
+}
+
+func TestClaimsCanGrant(t *testing.T) {
+	// claims without scopes can grant any scopes, or the full access of the user
+	claims := &tokens.Claims{}
+	require.NoError(t, claims.CanGrant(nil))
+	require.NoError(t, claims.CanGrant(tokens.Scopes()))
+
+	// restricted claims can grant their scopes, or the actions implied by them
+	claims.Scopes = []string{"org:write", "group:read"}
+	require.NoError(t, claims.CanGrant([]string{"org:write"}))
+	require.NoError(t, claims.CanGrant([]string{"org:read", "group:read"}))
+
+	require.ErrorIs(t, claims.CanGrant(nil), tokens.ErrScopeNotGranted)
+	require.ErrorIs(t, claims.CanGrant([]string{"org:admin"}), tokens.ErrScopeNotGranted)
+	require.ErrorIs(t, claims.CanGrant([]string{"org:read", "user:read"}), tokens.ErrScopeNotGranted)
+	require.ErrorIs(t, claims.CanGrant([]string{"openid"}), tokens.ErrScopeNotGranted)
+}
+
+func TestValidateScopes(t *testing.T) {
+	require.NoError(t, tokens.ValidateScopes(nil))
+	require.NoError(t, tokens.ValidateScopes(tokens.Scopes()))
+	require.Len(t, tokens.Scopes(), 9)
+	require.Contains(t, tokens.Scopes(), tokens.Scope(tokens.ScopeResourceGroup, tokens.ScopeActionWrite))
+
+	require.ErrorIs(t, tokens.ValidateScopes([]string{"org:read", "org:delete"}), tokens.ErrInvalidScope)
+	require.ErrorIs(t, tokens.ValidateScopes([]string{"admin"}), tokens.ErrInvalidScope)
+}
+
+func TestValidateOauthScopes(t *testing.T) {
+	require.NoError(t, tokens.ValidateOauthScopes(tokens.OauthScopes()))
+	require.Len(t, tokens.OauthScopes(), len(tokens.Scopes())+len(tokens.OpenIDScopes()))
+
+	// the openid connect scopes are only valid for oauth clients
+	require.NoError(t, tokens.ValidateOauthScopes([]string{tokens.ScopeOpenID, tokens.ScopeEmail, "org:read"}))
+	require.ErrorIs(t, tokens.ValidateScopes([]string{tokens.ScopeOpenID}), tokens.ErrInvalidScope)
+	require.ErrorIs(t, tokens.ValidateOauthScopes([]string{"offline_access"}), tokens.ErrInvalidScope)
+}
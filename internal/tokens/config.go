@@ -4,14 +4,21 @@ import "time"
 
 // Config defines the configuration settings for authentication tokens used in the server
 type Config struct {
-	// Keys contains the kid as the key and a path to the pem file as the value
-	KID             string            `required:"false"`                  // $DATUM_TOKEN_KID
-	Keys            map[string]string `required:"false"`                  // $DATUM_TOKEN_KEYS
-	Audience        string            `default:"https://datum.net"`       // $DATUM_TOKEN_AUDIENCE
-	RefreshAudience string            `required:"false"`                  // $DATUM_TOKEN_REFRESH_AUDIENCE
-	Issuer          string            `default:"https://auth.datum.net"`  // $DATUM_TOKEN_ISSUER
-	AccessDuration  time.Duration     `split_words:"true" default:"1h"`   // $DATUM_TOKEN_ACCESS_DURATION
-	RefreshDuration time.Duration     `split_words:"true" default:"2h"`   // $DATUM_TOKEN_REFRESH_DURATION
-	RefreshOverlap  time.Duration     `split_words:"true" default:"-15m"` // $DATUM_TOKEN_REFRESH_OVERLAP
-	CookieDomain    string            `default:"datum.net"`               // $DATUM_TOKEN_COOKIE_DOMAIN
+	// Keys contains the kid as the key and a path to the pem file as the value, KeyDir is a directory of pem
+	// files named by kid that is reloaded every KeyRefreshInterval to rotate keys without a restart; keys removed
+	// from the directory, and the Keys other than the current key, are kept to verify tokens for the
+	// KeyGracePeriod, which defaults to the RefreshDuration
+	KID                string            `required:"false"`                    // $DATUM_TOKEN_KID
+	Keys               map[string]string `required:"false"`                    // $DATUM_TOKEN_KEYS
+	Audience           string            `default:"https://datum.net"`         // $DATUM_TOKEN_AUDIENCE
+	RefreshAudience    string            `required:"false"`                    // $DATUM_TOKEN_REFRESH_AUDIENCE
+	Issuer             string            `default:"https://auth.datum.net"`    // $DATUM_TOKEN_ISSUER
+	AccessDuration     time.Duration     `split_words:"true" default:"1h"`     // $DATUM_TOKEN_ACCESS_DURATION
+	RefreshDuration    time.Duration     `split_words:"true" default:"2h"`     // $DATUM_TOKEN_REFRESH_DURATION
+	RefreshOverlap     time.Duration     `split_words:"true" default:"-15m"`   // $DATUM_TOKEN_REFRESH_OVERLAP
+	MFADuration        time.Duration     `split_words:"true" default:"5m"`     // $DATUM_TOKEN_MFA_DURATION
+	CookieDomain       string            `default:"datum.net"`                 // $DATUM_TOKEN_COOKIE_DOMAIN
+	KeyDir             string            `split_words:"true" required:"false"` // $DATUM_TOKEN_KEY_DIR
+	KeyRefreshInterval time.Duration     `split_words:"true" default:"1m"`     // $DATUM_TOKEN_KEY_REFRESH_INTERVAL
+	KeyGracePeriod     time.Duration     `split_words:"true" required:"false"` // $DATUM_TOKEN_KEY_GRACE_PERIOD
 }
@@ -21,6 +21,12 @@ var (
 
 	// ErrUnknownSigningKey returns when the signing key fetched does not match the loaded managed keys
 	ErrUnknownSigningKey = errors.New("unknown signing key")
+
+	// ErrUnsupportedSigningKey returns when a key is not an RSA, ECDSA P-256 or Ed25519 key
+	ErrUnsupportedSigningKey = errors.New("unsupported signing key, keys must be RSA, ECDSA P-256 or Ed25519")
+
+	// ErrInvalidKeyPEM returns when a key file does not contain a PEM encoded key
+	ErrInvalidKeyPEM = errors.New("key is not PEM encoded")
 )
 
 var (
@@ -71,6 +77,24 @@ var (
 	// ErrMissingUserID is returned when a reset token is trying to be created but no user id is provided
 	ErrMissingUserID = errors.New("unable to create reset token, user id is required")
 
+	// ErrMissingUnlockUserID is returned when an unlock token is trying to be created but no user id is provided
+	ErrMissingUnlockUserID = errors.New("unable to create unlock token, user id is required")
+
+	// ErrMissingOrgID is returned when an invite token is trying to be created but no organization id is provided
+	ErrMissingOrgID = errors.New("unable to create invite token, organization id is required")
+
+	// ErrTokenMissingOrgID is returned when the invite token is missing the required organization id
+	ErrTokenMissingOrgID = errors.New("invite token is missing organization id")
+
+	// ErrMissingTransferUserID is returned when a transfer token is trying to be created but no user id is provided
+	ErrMissingTransferUserID = errors.New("unable to create transfer token, user id is required")
+
+	// ErrMissingResourceID is returned when a transfer token is trying to be created but no organization or group id is provided
+	ErrMissingResourceID = errors.New("unable to create transfer token, organization or group id is required")
+
+	// ErrTokenMissingResourceID is returned when the transfer token is missing the required organization or group id
+	ErrTokenMissingResourceID = errors.New("transfer token is missing organization or group id")
+
 	// ErrTokenMissingUserID is returned when the reset token is missing the required user id
 	ErrTokenMissingUserID = errors.New("reset token is missing user id")
 
@@ -0,0 +1,66 @@
+package tokens
+
+import (
+	"strings"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/oklog/ulid/v2"
+)
+
+// UserInfo contains the standard OpenID Connect claims about the user, the claims are included in the
+// id token and returned from the userinfo endpoint depending on the scopes granted to the client
+type UserInfo struct {
+	// Email of the user, requires the email scope
+	Email string `json:"email,omitempty"`
+	// EmailVerified is true when the user has confirmed their email, requires the email scope
+	EmailVerified *bool `json:"email_verified,omitempty"`
+	// Name is the full name of the user, requires the profile scope
+	Name string `json:"name,omitempty"`
+	// GivenName is the first name of the user, requires the profile scope
+	GivenName string `json:"given_name,omitempty"`
+	// FamilyName is the last name of the user, requires the profile scope
+	FamilyName string `json:"family_name,omitempty"`
+	// PreferredUsername is the display name of the user, requires the profile scope
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	// Picture is the url of the avatar of the user, requires the profile scope
+	Picture string `json:"picture,omitempty"`
+}
+
+// IDTokenClaims are the claims of an OpenID Connect id token issued to an oauth client, the audience
+// of the id token is the client so it cannot be used as an access token
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	// Nonce from the authorization request, used by the client to prevent replay attacks
+	Nonce string `json:"nonce,omitempty"`
+	UserInfo
+}
+
+// CreateIDToken returns a signed id token for the subject issued to the oauth client, the id token
+// expires with the access token it is issued with
+func (tm *TokenManager) CreateIDToken(sub, clientID, nonce string, info UserInfo) (_ string, err error) {
+	now := time.Now()
+
+	var kid ulid.ULID
+
+	if kid, err = tm.genKeyID(); err != nil {
+		return "", err
+	}
+
+	issueTime := jwt.NewNumericDate(now)
+	claims := &IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        strings.ToLower(kid.String()),
+			Subject:   sub,
+			Audience:  jwt.ClaimStrings{clientID},
+			Issuer:    tm.issuer,
+			IssuedAt:  issueTime,
+			NotBefore: issueTime,
+			ExpiresAt: jwt.NewNumericDate(now.Add(tm.conf.AccessDuration)),
+		},
+		Nonce:    nonce,
+		UserInfo: info,
+	}
+
+	return tm.Sign(jwt.NewWithClaims(signingMethod, claims))
+}
@@ -0,0 +1,50 @@
+package tokens_test
+
+import (
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// Test that id tokens are issued to the client and cannot be used as access tokens
+func (s *TokenTestSuite) TestCreateIDToken() {
+	require := s.Require()
+
+	tm, err := tokens.New(s.conf)
+	require.NoError(err, "could not initialize token manager")
+
+	verified := true
+
+	tks, err := tm.CreateIDToken("01H6PGFB4T34D4WWEXQMAGJNMK", "client", "nonce", tokens.UserInfo{
+		Email:         "rustys@datum.net",
+		EmailVerified: &verified,
+	})
+	require.NoError(err)
+
+	keys, err := tm.Keys()
+	require.NoError(err)
+
+	claims := &tokens.IDTokenClaims{}
+
+	_, err = jwt.ParseWithClaims(tks, claims, func(token *jwt.Token) (interface{}, error) {
+		key, ok := keys.LookupKeyID(token.Header["kid"].(string))
+		require.True(ok)
+
+		var raw interface{}
+
+		return raw, key.Raw(&raw)
+	}, jwt.WithAudience("client"), jwt.WithIssuer(issuer))
+	require.NoError(err)
+
+	require.Equal("01H6PGFB4T34D4WWEXQMAGJNMK", claims.Subject)
+	require.Equal("nonce", claims.Nonce)
+	require.Equal("rustys@datum.net", claims.Email)
+	require.True(*claims.EmailVerified)
+	require.WithinDuration(time.Now().Add(s.conf.AccessDuration), claims.ExpiresAt.Time, time.Minute)
+
+	// the audience of the id token is the client, not the api
+	_, err = tm.Verify(tks)
+	require.ErrorIs(err, tokens.ErrTokenInvalidAudience)
+}
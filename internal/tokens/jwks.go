@@ -33,7 +33,7 @@ func NewJWKSValidator(keys jwk.Set, audience, issuer string) *JWKSValidator {
 	return validator
 }
 
-// keyFunc is a jwt.KeyFunc that selects the RSA public key from the list of managed
+// keyFunc is a jwt.KeyFunc that selects the public key from the list of managed
 // internal keys based on the kid in the token header
 func (v *JWKSValidator) keyFunc(token *jwt.Token) (publicKey interface{}, err error) {
 	// Fetch the kid from the header
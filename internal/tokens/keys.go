@@ -0,0 +1,71 @@
+package tokens
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// ParsePrivateKeyPEM parses a PEM encoded private key that can be used to sign tokens, the key can be an
+// RSA key (PKCS #1 or PKCS #8), an ECDSA P-256 key (SEC 1 or PKCS #8) or an Ed25519 key (PKCS #8)
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKeyPEM
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedSigningKey
+	}
+
+	// ensure the key can be used with one of the supported signing methods
+	if _, err := signingMethodForKey(signer.Public()); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// signingMethodForKey returns the signing method used with the public key: RS256 for RSA keys, ES256 for
+// ECDSA P-256 keys and EdDSA for Ed25519 keys
+func signingMethodForKey(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, ErrUnsupportedSigningKey
+		}
+
+		return jwt.SigningMethodES256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, ErrUnsupportedSigningKey
+	}
+}
@@ -0,0 +1,194 @@
+package tokens_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// Test that tokens signed with each supported key type are verified and the keys are published with the
+// algorithm and key type of the key
+func (s *TokenTestSuite) TestSigningAlgorithms() {
+	require := s.Require()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:gomnd
+	require.NoError(err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+
+	testCases := []struct {
+		name string
+		key  crypto.Signer
+		alg  jwa.SignatureAlgorithm
+		kty  jwa.KeyType
+	}{
+		{
+			name: "rsa",
+			key:  rsaKey,
+			alg:  jwa.RS256,
+			kty:  jwa.RSA,
+		},
+		{
+			name: "ecdsa p-256",
+			key:  ecKey,
+			alg:  jwa.ES256,
+			kty:  jwa.EC,
+		},
+		{
+			name: "ed25519",
+			key:  edKey,
+			alg:  jwa.EdDSA,
+			kty:  jwa.OKP,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tm, err := tokens.NewWithKey(tc.key, s.conf)
+			require.NoError(err, "could not initialize token manager")
+
+			claims := &tokens.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject: "01H6PGFB4T34D4WWEXQMAGJNMK",
+				},
+				UserID: "Rusty Shackleford",
+				Email:  "rustys@datum.net",
+			}
+
+			atks, rtks, err := tm.CreateTokenPair(claims)
+			require.NoError(err, "could not create token pair")
+
+			token, _, err := jwt.NewParser().ParseUnverified(atks, &tokens.Claims{})
+			require.NoError(err)
+			require.Equal(tc.alg.String(), token.Header["alg"])
+
+			_, err = tm.Verify(atks)
+			require.NoError(err, "could not verify access token")
+
+			_, err = tm.Parse(rtks)
+			require.NoError(err, "could not parse refresh token")
+
+			// the key is published with the algorithm and key type of the key
+			keys, err := tm.Keys()
+			require.NoError(err)
+			require.Equal(1, keys.Len())
+
+			key, ok := keys.Key(0)
+			require.True(ok)
+			require.Equal(tc.alg, key.Algorithm())
+			require.Equal(tc.kty, key.KeyType())
+			require.Equal(tm.CurrentKey().String(), key.KeyID())
+
+			// the published keys can verify the tokens
+			validator := tokens.NewJWKSValidator(keys, audience, issuer)
+
+			_, err = validator.Verify(atks)
+			require.NoError(err, "could not verify access token with the jwks")
+
+			_, err = validator.Parse(rtks)
+			require.NoError(err, "could not parse refresh token with the jwks")
+		})
+	}
+}
+
+// Test that only RSA, ECDSA P-256 and Ed25519 keys are accepted
+func (s *TokenTestSuite) TestUnsupportedSigningKey() {
+	require := s.Require()
+
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(err)
+
+	_, err = tokens.NewWithKey(key, s.conf)
+	require.ErrorIs(err, tokens.ErrUnsupportedSigningKey)
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(err)
+
+	_, err = tokens.ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	require.ErrorIs(err, tokens.ErrUnsupportedSigningKey)
+
+	_, err = tokens.ParsePrivateKeyPEM([]byte("notakey"))
+	require.ErrorIs(err, tokens.ErrInvalidKeyPEM)
+}
+
+// Test that keys of different types are loaded from PEM files and the newest key is used to sign tokens
+func (s *TokenTestSuite) TestMixedKeyTypes() {
+	require := s.Require()
+
+	dir := s.T().TempDir()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(err)
+
+	ecPath := filepath.Join(dir, "ec.pem")
+	edPath := filepath.Join(dir, "ed.pem")
+
+	require.NoError(os.WriteFile(ecPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER}), 0600)) //nolint:gomnd
+	require.NoError(os.WriteFile(edPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER}), 0600))    //nolint:gomnd
+
+	conf := s.conf
+	conf.Keys = map[string]string{
+		"01GE6191AQTGMCJ9BN0QC3CCVG": s.testdata["01GE6191AQTGMCJ9BN0QC3CCVG"],
+		"01GE62EXXR0X0561XD53RDFBQJ": ecPath,
+		"01H6PGFB4T34D4WWEXQMAGJNMK": edPath,
+	}
+
+	tm, err := tokens.New(conf)
+	require.NoError(err, "could not initialize token manager")
+	require.Equal("01H6PGFB4T34D4WWEXQMAGJNMK", tm.CurrentKey().String())
+
+	keys, err := tm.Keys()
+	require.NoError(err)
+	require.Equal(3, keys.Len())
+
+	algs, err := tm.SigningAlgorithms()
+	require.NoError(err)
+	require.Equal([]string{"ES256", "EdDSA", "RS256"}, algs)
+
+	atks, _, err := tm.CreateTokenPair(&tokens.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "01H6PGFB4T34D4WWEXQMAGJNMK"}})
+	require.NoError(err)
+
+	_, err = tokens.NewJWKSValidator(keys, audience, issuer).Verify(atks)
+	require.NoError(err)
+
+	// a token signed with a key must use the algorithm of the key
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, &tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  "01H6PGFB4T34D4WWEXQMAGJNMK",
+			Audience: jwt.ClaimStrings{audience},
+			Issuer:   issuer,
+		},
+	})
+	token.Header["kid"] = "01H6PGFB4T34D4WWEXQMAGJNMK"
+
+	tks, err := token.SignedString(ecKey)
+	require.NoError(err)
+
+	_, err = tm.Verify(tks)
+	require.Error(err)
+}
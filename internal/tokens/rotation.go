@@ -0,0 +1,169 @@
+package tokens
+
+import (
+	"context"
+	"crypto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// keyFileExt is the extension of the PEM encoded private key files in the key directory
+const keyFileExt = ".pem"
+
+// DefaultKeyRefreshInterval is how often the key directory is reloaded when no interval is configured
+const DefaultKeyRefreshInterval = time.Minute
+
+// LoadKeyDir loads the PEM encoded private keys in the directory, each file is named with the ulid of
+// the key, e.g. 01GE62EXXR0X0561XD53RDFBQJ.pem, and other files are ignored. Keys new to the token manager
+// are added and the newest key is used to sign tokens. Keys loaded from the directory that have been removed
+// from it are retired: they are no longer used to sign tokens but are kept to verify tokens until the grace
+// period has passed and they are pruned. Keys from the config, other than the current key, are retired the
+// same way when the directory is loaded. If the directory cannot be read or a key cannot be parsed the keys
+// are left unchanged
+func (tm *TokenManager) LoadKeyDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return newParseError("key dir - read", dir, err)
+	}
+
+	loaded := make(map[ulid.ULID]crypto.Signer)
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, keyFileExt) {
+			continue
+		}
+
+		keyID, err := ulid.Parse(strings.TrimSuffix(name, keyFileExt))
+		if err != nil {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return newParseError("path - read", path, err)
+		}
+
+		key, err := ParsePrivateKeyPEM(data)
+		if err != nil {
+			return newParseError("path - retrieve", path, err)
+		}
+
+		loaded[keyID] = key
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	now := TimeFunc()
+
+	for keyID, key := range loaded {
+		tm.keys[keyID] = key.Public()
+		tm.signingKeys[keyID] = key
+		tm.dirKeys[keyID] = struct{}{}
+
+		// a key that is added back to the directory is no longer retired
+		delete(tm.retired, keyID)
+	}
+
+	for keyID := range tm.dirKeys {
+		if _, ok := loaded[keyID]; ok {
+			continue
+		}
+
+		delete(tm.dirKeys, keyID)
+		delete(tm.signingKeys, keyID)
+		tm.retired[keyID] = now
+	}
+
+	tm.selectCurrentKey()
+
+	// keys from the config are not in the directory, they are retired once the directory has the current key
+	// so they are pruned like the keys removed from the directory
+	for keyID := range tm.signingKeys {
+		if _, ok := tm.dirKeys[keyID]; ok || keyID == tm.currentKeyID {
+			continue
+		}
+
+		delete(tm.signingKeys, keyID)
+		tm.retired[keyID] = now
+	}
+
+	return nil
+}
+
+// PruneKeys removes the retired keys whose grace period has passed, tokens signed with a pruned key can no
+// longer be verified; the ids of the pruned keys are returned
+func (tm *TokenManager) PruneKeys() []ulid.ULID {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	now := TimeFunc()
+	pruned := []ulid.ULID{}
+
+	for keyID, retiredAt := range tm.retired {
+		// the current key is only retired when there is no other key to sign tokens with
+		if keyID == tm.currentKeyID || now.Sub(retiredAt) < tm.keyGracePeriod() {
+			continue
+		}
+
+		delete(tm.retired, keyID)
+		delete(tm.keys, keyID)
+
+		pruned = append(pruned, keyID)
+	}
+
+	return pruned
+}
+
+// WatchKeys reloads the key directory and prunes retired keys on the interval until the context is done,
+// errors loading the keys are passed to the error handler and the previous keys remain in use
+func (tm *TokenManager) WatchKeys(ctx context.Context, dir string, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		interval = DefaultKeyRefreshInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := tm.LoadKeyDir(dir); err != nil && onError != nil {
+				onError(err)
+			}
+
+			tm.PruneKeys()
+		}
+	}
+}
+
+// selectCurrentKey signs tokens with the newest key that has not been retired, the current key is kept if
+// there are no other keys; the lock must be held
+func (tm *TokenManager) selectCurrentKey() {
+	for keyID, key := range tm.signingKeys {
+		if _, ok := tm.signingKeys[tm.currentKeyID]; !ok || keyID.Compare(tm.currentKeyID) > 0 {
+			tm.currentKey = key
+			tm.currentKeyID = keyID
+		}
+	}
+}
+
+// keyGracePeriod returns how long retired keys are kept to verify tokens, by default retired keys are kept
+// until the refresh tokens they signed have expired
+func (tm *TokenManager) keyGracePeriod() time.Duration {
+	if tm.conf.KeyGracePeriod > 0 {
+		return tm.conf.KeyGracePeriod
+	}
+
+	return tm.conf.RefreshDuration
+}
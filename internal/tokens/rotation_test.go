@@ -0,0 +1,187 @@
+package tokens_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+
+	"github.com/datumforge/datum/internal/tokens"
+)
+
+// Test that keys are rotated from the key directory without creating a new token manager
+func (s *TokenTestSuite) TestKeyDirRotation() {
+	require := s.Require()
+
+	const (
+		oldKID = "01GE6191AQTGMCJ9BN0QC3CCVG"
+		newKID = "01GE62EXXR0X0561XD53RDFBQJ"
+	)
+
+	dir := s.T().TempDir()
+
+	addKey := func(kid string) {
+		data, err := os.ReadFile(s.testdata[kid])
+		require.NoError(err)
+		require.NoError(os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0600)) //nolint:gomnd
+	}
+
+	keyCount := func(tm *tokens.TokenManager) int {
+		keys, err := tm.Keys()
+		require.NoError(err)
+
+		return keys.Len()
+	}
+
+	addKey(oldKID)
+
+	// files that are not named by a kid are ignored
+	require.NoError(os.WriteFile(filepath.Join(dir, "README.md"), []byte("keys"), 0600)) //nolint:gomnd
+
+	conf := tokens.Config{
+		KeyDir:          dir,
+		Audience:        audience,
+		Issuer:          issuer,
+		AccessDuration:  1 * time.Hour,
+		RefreshDuration: 2 * time.Hour,
+		RefreshOverlap:  -15 * time.Minute,
+		KeyGracePeriod:  time.Hour,
+	}
+
+	tm, err := tokens.New(conf)
+	require.NoError(err, "could not initialize token manager")
+	require.Equal(oldKID, tm.CurrentKey().String())
+	require.Equal(1, keyCount(tm))
+
+	claims := &tokens.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "01H6PGFB4T34D4WWEXQMAGJNMK"}}
+
+	oldToken, _, err := tm.CreateTokenPair(claims)
+	require.NoError(err)
+
+	// a newer key added to the directory is used to sign tokens
+	addKey(newKID)
+	require.NoError(tm.LoadKeyDir(dir))
+	require.Equal(newKID, tm.CurrentKey().String())
+	require.Equal(2, keyCount(tm))
+
+	newToken, _, err := tm.CreateTokenPair(claims)
+	require.NoError(err)
+
+	_, err = tm.Verify(oldToken)
+	require.NoError(err, "tokens signed with the previous key should still be valid")
+
+	// a key removed from the directory is retired but kept for the grace period
+	require.NoError(os.Remove(filepath.Join(dir, oldKID+".pem")))
+	require.NoError(tm.LoadKeyDir(dir))
+	require.Equal(newKID, tm.CurrentKey().String())
+	require.Equal(2, keyCount(tm))
+	require.Empty(tm.PruneKeys())
+
+	_, err = tm.Verify(oldToken)
+	require.NoError(err, "tokens signed with a retired key should be valid during the grace period")
+
+	// a key that cannot be parsed leaves the keys unchanged
+	require.NoError(os.WriteFile(filepath.Join(dir, "01H6PGFB4T34D4WWEXQMAGJNMK.pem"), []byte("notakey"), 0600)) //nolint:gomnd
+	require.Error(tm.LoadKeyDir(dir))
+	require.Equal(newKID, tm.CurrentKey().String())
+	require.Equal(2, keyCount(tm))
+	require.NoError(os.Remove(filepath.Join(dir, "01H6PGFB4T34D4WWEXQMAGJNMK.pem")))
+
+	// retired keys are pruned after the grace period
+	tokens.TimeFunc = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	pruned := tm.PruneKeys()
+	tokens.TimeFunc = time.Now
+
+	require.Len(pruned, 1)
+	require.Equal(oldKID, pruned[0].String())
+	require.Equal(1, keyCount(tm))
+
+	_, err = tm.Verify(oldToken)
+	require.Error(err, "tokens signed with a pruned key should not be valid")
+
+	_, err = tm.Verify(newToken)
+	require.NoError(err)
+
+	// keys added to the watched directory are loaded without reloading it manually
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go tm.WatchKeys(ctx, dir, 10*time.Millisecond, nil)
+
+	addKey(oldKID)
+
+	require.Eventually(func() bool {
+		return keyCount(tm) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// the newest key is still used to sign tokens
+	require.Equal(newKID, tm.CurrentKey().String())
+}
+
+// Test that keys from the config are retired once a newer key is loaded from the key directory
+func (s *TokenTestSuite) TestKeyDirRotationConfigKeys() {
+	require := s.Require()
+
+	const (
+		oldKID = "01GE6191AQTGMCJ9BN0QC3CCVG"
+		newKID = "01GE62EXXR0X0561XD53RDFBQJ"
+	)
+
+	dir := s.T().TempDir()
+
+	data, err := os.ReadFile(s.testdata[newKID])
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(dir, newKID+".pem"), data, 0600)) //nolint:gomnd
+
+	conf := tokens.Config{
+		Keys:            map[string]string{oldKID: s.testdata[oldKID]},
+		KeyDir:          dir,
+		Audience:        audience,
+		Issuer:          issuer,
+		AccessDuration:  1 * time.Hour,
+		RefreshDuration: 2 * time.Hour,
+		RefreshOverlap:  -15 * time.Minute,
+		KeyGracePeriod:  time.Hour,
+	}
+
+	tm, err := tokens.New(conf)
+	require.NoError(err, "could not initialize token manager")
+	require.Equal(newKID, tm.CurrentKey().String())
+
+	keys, err := tm.Keys()
+	require.NoError(err)
+	require.Equal(2, keys.Len(), "the config key should be kept for the grace period")
+	require.Empty(tm.PruneKeys())
+
+	// the config key is pruned after the grace period
+	tokens.TimeFunc = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	pruned := tm.PruneKeys()
+	tokens.TimeFunc = time.Now
+
+	require.Len(pruned, 1)
+	require.Equal(oldKID, pruned[0].String())
+
+	keys, err = tm.Keys()
+	require.NoError(err)
+	require.Equal(1, keys.Len())
+
+	// a config key newer than the keys of the directory is used to sign tokens and is not retired
+	conf.Keys = map[string]string{newKID: s.testdata[newKID]}
+	conf.KeyDir = s.T().TempDir()
+
+	data, err = os.ReadFile(s.testdata[oldKID])
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(conf.KeyDir, oldKID+".pem"), data, 0600)) //nolint:gomnd
+
+	tm, err = tokens.New(conf)
+	require.NoError(err, "could not initialize token manager")
+	require.Equal(newKID, tm.CurrentKey().String())
+
+	tokens.TimeFunc = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	pruned = tm.PruneKeys()
+	tokens.TimeFunc = time.Now
+
+	require.Empty(pruned)
+}
@@ -0,0 +1,161 @@
+package tokens
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Resources that scopes can be granted on
+const (
+	ScopeResourceOrg   = "org"
+	ScopeResourceGroup = "group"
+	ScopeResourceUser  = "user"
+)
+
+// Actions that can be granted on a resource; each action implies the actions before it so a token with
+// write access can also read and a token with admin access can also write
+const (
+	ScopeActionRead  = "read"
+	ScopeActionWrite = "write"
+	ScopeActionAdmin = "admin"
+)
+
+// OpenID Connect scopes that can be granted to oauth clients in addition to the api scopes, they grant
+// access to the claims about the user in the id token and from the userinfo endpoint
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+const scopeSeparator = ":"
+
+var (
+	// ErrInvalidScope is returned when a scope is not part of the scope vocabulary
+	ErrInvalidScope = errors.New("invalid scope")
+
+	// ErrScopeNotGranted is returned when a token requests scopes beyond the scopes of the token creating it
+	ErrScopeNotGranted = errors.New("scope not granted")
+)
+
+var (
+	scopeResources = []string{ScopeResourceOrg, ScopeResourceGroup, ScopeResourceUser}
+	scopeActions   = []string{ScopeActionRead, ScopeActionWrite, ScopeActionAdmin}
+)
+
+// Scope returns the scope granting the action on the resource, e.g. org:read
+func Scope(resource, action string) string {
+	return resource + scopeSeparator + action
+}
+
+// Scopes returns every valid scope
+func Scopes() []string {
+	scopes := []string{}
+
+	for _, r := range scopeResources {
+		for _, a := range scopeActions {
+			scopes = append(scopes, Scope(r, a))
+		}
+	}
+
+	return scopes
+}
+
+// ValidateScopes returns an error if any of the scopes are not part of the scope vocabulary
+func ValidateScopes(scopes []string) error {
+	valid := Scopes()
+
+	for _, s := range scopes {
+		if !slices.Contains(valid, s) {
+			return fmt.Errorf("%w: %s, must be one of %s", ErrInvalidScope, s, strings.Join(valid, ", "))
+		}
+	}
+
+	return nil
+}
+
+// OpenIDScopes returns the OpenID Connect scopes
+func OpenIDScopes() []string {
+	return []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+}
+
+// OauthScopes returns every scope that can be granted to an oauth client, the OpenID Connect scopes
+// followed by the api scopes
+func OauthScopes() []string {
+	return append(OpenIDScopes(), Scopes()...)
+}
+
+// ValidateOauthScopes returns an error if any of the scopes cannot be granted to an oauth client
+func ValidateOauthScopes(scopes []string) error {
+	valid := OauthScopes()
+
+	for _, s := range scopes {
+		if !slices.Contains(valid, s) {
+			return fmt.Errorf("%w: %s, must be one of %s", ErrInvalidScope, s, strings.Join(valid, ", "))
+		}
+	}
+
+	return nil
+}
+
+// IsRestricted reports whether the claims are restricted to their scopes; claims without scopes, such
+// as those issued when a user logs in, have the full access of the user
+func (c Claims) IsRestricted() bool {
+	return len(c.Scopes) > 0
+}
+
+// HasScope reports whether the claims allow the action on the resource, either directly or through
+// an action that implies it
+func (c Claims) HasScope(resource, action string) bool {
+	if !c.IsRestricted() {
+		return true
+	}
+
+	required := slices.Index(scopeActions, action)
+	if required < 0 {
+		return false
+	}
+
+	for _, s := range c.Scopes {
+		r, a, ok := strings.Cut(s, scopeSeparator)
+		if ok && r == resource && slices.Index(scopeActions, a) >= required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanGrant returns an error when the claims cannot grant the scopes to a token created with them; restricted
+// claims can only grant scopes they have themselves and cannot grant the full access of a token without scopes
+func (c Claims) CanGrant(scopes []string) error {
+	if !c.IsRestricted() {
+		return nil
+	}
+
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: a token restricted to scopes cannot create a token without scopes", ErrScopeNotGranted)
+	}
+
+	for _, s := range scopes {
+		r, a, ok := strings.Cut(s, scopeSeparator)
+		if !ok || !c.HasScope(r, a) {
+			return fmt.Errorf("%w: %s, must be within %s", ErrScopeNotGranted, s, strings.Join(c.Scopes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// CanMutate reports whether the claims allow any mutation; read only claims cannot mutate any resource
+func (c Claims) CanMutate() bool {
+	for _, r := range scopeResources {
+		if c.HasScope(r, ScopeActionWrite) {
+			return true
+		}
+	}
+
+	return false
+}
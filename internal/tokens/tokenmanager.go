@@ -1,13 +1,15 @@
 package tokens
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"fmt"
 	"io"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
@@ -16,15 +18,21 @@ import (
 	"github.com/oklog/ulid/v2"
 )
 
-const DefaultRefreshAudience = "https://auth.datum.net/v1/refresh"
+const (
+	DefaultRefreshAudience = "https://auth.datum.net/v1/refresh"
+	DefaultMFAAudience     = "https://auth.datum.net/v1/login/mfa"
+	DefaultMFADuration     = 5 * time.Minute
+)
 
-// the signing method should match the value returned by the JWKS
+// the signing method of new tokens, the method is replaced by the method of the current key when the token
+// is signed so it always matches the algorithm of the key published in the JWKS
 var (
 	signingMethod = jwt.SigningMethodRS256
 	nilID         = ulid.ULID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
 )
 
-// TokenManager handles the creation and verification of RSA signed JWT tokens. To
+// TokenManager handles the creation and verification of signed JWT tokens; keys can be RSA
+// (RS256), ECDSA P-256 (ES256) or Ed25519 (EdDSA) keys. To
 // facilitate signing key rollover, TokenManager can accept multiple keys identified by
 // a ulid. JWT tokens generated by token managers include a kid ("Key ID") in the header that
 // allows the token manager to verify the key with the specified signature. To sign keys
@@ -33,14 +41,21 @@ var (
 // When the TokenManager creates tokens it will use JWT standard claims as well as
 // extended claims based on Datum usage. The standard claims included are exp, nbf
 // aud, and sub. On token verification, the exp, nbf, iss and aud claims are validated.
+//
+// Keys can be rotated without a restart by loading them from a key directory, see LoadKeyDir
 
 type TokenManager struct {
 	validator
 	refreshAudience string
+	mfaAudience     string
 	conf            Config
+	mu              sync.RWMutex
 	currentKeyID    ulid.ULID
-	currentKey      *rsa.PrivateKey
-	keys            map[ulid.ULID]*rsa.PublicKey
+	currentKey      crypto.Signer
+	keys            map[ulid.ULID]crypto.PublicKey
+	signingKeys     map[ulid.ULID]crypto.Signer
+	dirKeys         map[ulid.ULID]struct{}
+	retired         map[ulid.ULID]time.Time
 	kidEntropy      io.Reader
 }
 
@@ -69,22 +84,12 @@ type Token struct {
 }
 
 // New creates a TokenManager with the specified keys which should be a mapping of ULID
-// strings to paths to files that contain PEM encoded RSA private keys. This input is
+// strings to paths to files that contain PEM encoded RSA, ECDSA P-256 or Ed25519 private keys. This input is
 // specifically designed for the config environment variable so that keys can be loaded
-// from k8s or vault secrets that are mounted as files on disk
+// from k8s or vault secrets that are mounted as files on disk. If a key directory is configured
+// the keys in the directory are loaded as well
 func New(conf Config) (tm *TokenManager, err error) {
-	tm = &TokenManager{
-		validator: validator{
-			audience: conf.Audience,
-			issuer:   conf.Issuer,
-		},
-		conf: conf,
-		keys: make(map[ulid.ULID]*rsa.PublicKey),
-		kidEntropy: &ulid.LockedMonotonicReader{
-			MonotonicReader: ulid.Monotonic(rand.Reader, 0),
-		},
-	}
-	tm.validator.keyFunc = tm.keyFunc
+	tm = newTokenManager(conf)
 
 	for kid, path := range conf.Keys {
 		var keyID ulid.ULID
@@ -100,13 +105,14 @@ func New(conf Config) (tm *TokenManager, err error) {
 			return nil, newParseError("path - read", path, err)
 		}
 
-		var key *rsa.PrivateKey
+		var key crypto.Signer
 
-		if key, err = jwt.ParseRSAPrivateKeyFromPEM(data); err != nil {
+		if key, err = ParsePrivateKeyPEM(data); err != nil {
 			return nil, newParseError("path - retrieve", path, err)
 		}
 
-		tm.keys[keyID] = &key.PublicKey
+		tm.keys[keyID] = key.Public()
+		tm.signingKeys[keyID] = key
 
 		// Set the current key if it is the latest key
 		if tm.currentKey == nil || keyID.Time() > tm.currentKeyID.Time() {
@@ -115,27 +121,26 @@ func New(conf Config) (tm *TokenManager, err error) {
 		}
 	}
 
+	if conf.KeyDir != "" {
+		if err = tm.LoadKeyDir(conf.KeyDir); err != nil {
+			return nil, err
+		}
+	}
+
 	return tm, nil
 }
 
 // NewWithKey is a constructor function that creates a new instance of the TokenManager struct
-// with a specified RSA private key. It takes in the private key as a parameter and initializes the
+// with a specified RSA, ECDSA P-256 or Ed25519 private key. It takes in the private key as a parameter and initializes the
 // TokenManager with the provided key, along with other configuration settings from the TokenConfig
 // struct. It returns the created TokenManager instance or an error if there was a problem
 // initializing the TokenManager.
-func NewWithKey(key *rsa.PrivateKey, conf Config) (tm *TokenManager, err error) {
-	tm = &TokenManager{
-		validator: validator{
-			audience: conf.Audience,
-			issuer:   conf.Issuer,
-		},
-		conf: conf,
-		keys: make(map[ulid.ULID]*rsa.PublicKey),
-		kidEntropy: &ulid.LockedMonotonicReader{
-			MonotonicReader: ulid.Monotonic(rand.Reader, 0),
-		},
+func NewWithKey(key crypto.Signer, conf Config) (tm *TokenManager, err error) {
+	if _, err = signingMethodForKey(key.Public()); err != nil {
+		return nil, err
 	}
-	tm.validator.keyFunc = tm.keyFunc
+
+	tm = newTokenManager(conf)
 
 	var kid ulid.ULID
 
@@ -143,20 +148,59 @@ func NewWithKey(key *rsa.PrivateKey, conf Config) (tm *TokenManager, err error)
 		return nil, err
 	}
 
-	tm.keys[kid] = &key.PublicKey
+	tm.keys[kid] = key.Public()
+	tm.signingKeys[kid] = key
 	tm.currentKey = key
 	tm.currentKeyID = kid
 
 	return tm, nil
 }
 
+// newTokenManager returns a TokenManager for the config without any keys
+func newTokenManager(conf Config) *TokenManager {
+	tm := &TokenManager{
+		validator: validator{
+			audience: conf.Audience,
+			issuer:   conf.Issuer,
+		},
+		refreshAudience: conf.RefreshAudience,
+		mfaAudience:     issuerAudience(conf.Issuer, "/v1/login/mfa", DefaultMFAAudience),
+		conf:            conf,
+		keys:            make(map[ulid.ULID]crypto.PublicKey),
+		signingKeys:     make(map[ulid.ULID]crypto.Signer),
+		dirKeys:         make(map[ulid.ULID]struct{}),
+		retired:         make(map[ulid.ULID]time.Time),
+		kidEntropy: &ulid.LockedMonotonicReader{
+			MonotonicReader: ulid.Monotonic(rand.Reader, 0),
+		},
+	}
+	tm.validator.keyFunc = tm.keyFunc
+
+	// the audiences are set once so they can be read without holding the lock while keys are rotated
+	if tm.refreshAudience == "" {
+		tm.refreshAudience = issuerAudience(conf.Issuer, "/v1/refresh", DefaultRefreshAudience)
+	}
+
+	return tm
+}
+
 // Sign an access or refresh token and return the token
 func (tm *TokenManager) Sign(token *jwt.Token) (string, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
 	if tm.currentKey == nil || tm.currentKeyID.Compare(nilID) == 0 {
 		return "", ErrTokenManagerFailedInit
 	}
 
-	// Add the kid to the header
+	method, err := signingMethodForKey(tm.currentKey.Public())
+	if err != nil {
+		return "", err
+	}
+
+	// Sign with the algorithm of the current key and add the kid to the header
+	token.Method = method
+	token.Header["alg"] = method.Alg()
 	token.Header["kid"] = tm.currentKeyID.String()
 
 	// Return the signed string
@@ -252,17 +296,83 @@ func (tm *TokenManager) CreateRefreshToken(accessToken *jwt.Token) (refreshToken
 		OrgID:       accessClaims.OrgID,
 		ParentOrgID: accessClaims.ParentOrgID,
 		Tier:        accessClaims.Tier,
+		// tokens issued to an oauth client keep the client and its granted scopes when refreshed
+		Scopes:   accessClaims.Scopes,
+		ClientID: accessClaims.ClientID,
 	}
 
 	return tm.CreateToken(claims), nil
 }
 
-// Keys returns the JWKS with public keys for use externally
+// PairExpiration returns when the access and refresh token pair sharing the jti of the claims
+// expires; the refresh token always outlives the access token so its expiration is returned
+func (tm *TokenManager) PairExpiration(claims *Claims) time.Time {
+	if claims.IssuedAt == nil {
+		return TimeFunc().Add(tm.conf.RefreshDuration)
+	}
+
+	return claims.IssuedAt.Add(tm.conf.RefreshDuration)
+}
+
+// CreateMFAPendingToken returns a short-lived signed token for a user that has verified their password but
+// has not yet verified a one-time passcode; the token only contains the mfa audience so it cannot be used
+// as an access or refresh token
+func (tm *TokenManager) CreateMFAPendingToken(sub string) (_ string, err error) {
+	now := time.Now()
+
+	var kid ulid.ULID
+
+	if kid, err = tm.genKeyID(); err != nil {
+		return "", err
+	}
+
+	duration := tm.conf.MFADuration
+	if duration <= 0 {
+		duration = DefaultMFADuration
+	}
+
+	issueTime := jwt.NewNumericDate(now)
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        strings.ToLower(kid.String()),
+			Subject:   sub,
+			Audience:  jwt.ClaimStrings{tm.MFAAudience()},
+			Issuer:    tm.issuer,
+			IssuedAt:  issueTime,
+			NotBefore: issueTime,
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		},
+	}
+
+	return tm.Sign(jwt.NewWithClaims(signingMethod, claims))
+}
+
+// VerifyMFAPendingToken verifies a token created with CreateMFAPendingToken and returns its claims
+func (tm *TokenManager) VerifyMFAPendingToken(tks string) (*Claims, error) {
+	v := &validator{
+		audience: tm.MFAAudience(),
+		issuer:   tm.issuer,
+		keyFunc:  tm.keyFunc,
+	}
+
+	return v.Verify(tks)
+}
+
+// Keys returns the JWKS with public keys for use externally, retired keys are included until they are pruned
 func (tm *TokenManager) Keys() (keys jwk.Set, err error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
 	keys = jwk.NewSet()
 	for kid, pubkey := range tm.keys {
 		var key jwk.Key
 
+		var method jwt.SigningMethod
+
+		if method, err = signingMethodForKey(pubkey); err != nil {
+			return nil, err
+		}
+
 		if key, err = jwk.FromRaw(pubkey); err != nil {
 			return nil, err
 		}
@@ -275,8 +385,8 @@ func (tm *TokenManager) Keys() (keys jwk.Set, err error) {
 			return nil, err
 		}
 
-		// NOTE: the algorithm should match the signing method of this package
-		if err = key.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
+		// the algorithm must match the signing method used with the key
+		if err = key.Set(jwk.AlgorithmKey, jwa.SignatureAlgorithm(method.Alg())); err != nil {
 			return nil, err
 		}
 
@@ -288,35 +398,68 @@ func (tm *TokenManager) Keys() (keys jwk.Set, err error) {
 	return keys, nil
 }
 
-// RefreshAudience returns the refresh audience for the token manager; The refresh audience in plain-human-speak is the URL where the refresh token should be sent for validation (which is our datum endpoint)
-func (tm *TokenManager) RefreshAudience() string {
-	if tm.refreshAudience == "" {
-		if tm.conf.RefreshAudience != "" {
-			tm.refreshAudience = tm.conf.RefreshAudience
+// SigningAlgorithms returns the algorithms of the keys the token manager can verify tokens with, sorted
+func (tm *TokenManager) SigningAlgorithms() (algs []string, err error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	for _, pubkey := range tm.keys {
+		var method jwt.SigningMethod
+
+		if method, err = signingMethodForKey(pubkey); err != nil {
+			return nil, err
 		}
 
-		if aud, err := url.Parse(tm.issuer); err == nil {
-			tm.refreshAudience = aud.ResolveReference(&url.URL{Path: "/v1/refresh"}).String()
-		} else {
-			tm.refreshAudience = DefaultRefreshAudience
+		if !slices.Contains(algs, method.Alg()) {
+			algs = append(algs, method.Alg())
 		}
 	}
 
+	slices.Sort(algs)
+
+	return algs, nil
+}
+
+// RefreshAudience returns the refresh audience for the token manager; The refresh audience in plain-human-speak is the URL where the refresh token should be sent for validation (which is our datum endpoint)
+func (tm *TokenManager) RefreshAudience() string {
 	return tm.refreshAudience
 }
 
+// MFAAudience returns the audience of the mfa pending token, which is the URL where the token should be sent to complete the login
+func (tm *TokenManager) MFAAudience() string {
+	return tm.mfaAudience
+}
+
+// issuerAudience returns the URL of the path on the issuer, or the default audience if the issuer is not a URL
+func issuerAudience(issuer, path, defaultAudience string) string {
+	aud, err := url.Parse(issuer)
+	if err != nil {
+		return defaultAudience
+	}
+
+	return aud.ResolveReference(&url.URL{Path: path}).String()
+}
+
+// Issuer returns the issuer of the tokens created by the token manager
+func (tm *TokenManager) Issuer() string {
+	return tm.issuer
+}
+
+// Audience returns the audience of the access tokens created by the token manager
+func (tm *TokenManager) Audience() string {
+	return tm.audience
+}
+
 // CurrentKey returns the ulid of the current key being used to sign tokens - this is just the identifier of the key, not the key itself
 func (tm *TokenManager) CurrentKey() ulid.ULID {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
 	return tm.currentKeyID
 }
 
-// keyFunc selects the RSA public key from the list of tokenmanager internal keys based on the kid in the token header - if the kid does not exist an error is returned the token is not validated
+// keyFunc selects the public key from the list of tokenmanager internal keys based on the kid in the token header - if the kid does not exist an error is returned the token is not validated
 func (tm *TokenManager) keyFunc(token *jwt.Token) (key interface{}, err error) {
-	// Per JWT security notice: do not forget to validate alg is expected, else haxorz!~
-	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"]) //nolint:goerr113
-	}
-
 	// Fetch that kid
 	kid, ok := token.Header["kid"]
 	if !ok {
@@ -330,12 +473,26 @@ func (tm *TokenManager) keyFunc(token *jwt.Token) (key interface{}, err error) {
 		return nil, ErrFailedParsingKid
 	}
 
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
 	// Fetch the key from the list of managed keys
-	if key, ok = tm.keys[keyID]; !ok {
+	pubkey, ok := tm.keys[keyID]
+	if !ok {
 		return nil, ErrUnknownSigningKey
 	}
 
-	return key, nil
+	// Per JWT security notice: do not forget to validate alg is expected, else haxorz!~
+	method, err := signingMethodForKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"]) //nolint:goerr113
+	}
+
+	return pubkey, nil
 }
 
 // genKeyID generates a ulid for a key (the identifier of the key)
@@ -79,6 +79,54 @@ func (s *TokenTestSuite) TestCreateTokenPair() {
 	require.NoError(err, "could not parse refresh token")
 }
 
+func (s *TokenTestSuite) TestMFAPendingToken() {
+	require := s.Require()
+	tm, err := tokens.New(s.conf)
+	require.NoError(err, "could not initialize token manager")
+
+	sub := "01H6PGFB4T34D4WWEXQMAGJNMK"
+
+	tks, err := tm.CreateMFAPendingToken(sub)
+	require.NoError(err, "could not create mfa pending token")
+
+	claims, err := tm.VerifyMFAPendingToken(tks)
+	require.NoError(err, "could not verify mfa pending token")
+	require.Equal(sub, claims.Subject)
+	require.Equal(jwt.ClaimStrings{"http://localhost:3001/v1/login/mfa"}, claims.Audience)
+	require.WithinDuration(time.Now().Add(tokens.DefaultMFADuration), claims.ExpiresAt.Time, time.Minute)
+
+	// the mfa pending token cannot be used as an access token
+	_, err = tm.Verify(tks)
+	require.ErrorIs(err, tokens.ErrTokenInvalidAudience)
+
+	// an access token cannot be used as an mfa pending token
+	atks, _, err := tm.CreateTokenPair(&tokens.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: sub}})
+	require.NoError(err, "could not create token pair")
+
+	_, err = tm.VerifyMFAPendingToken(atks)
+	require.ErrorIs(err, tokens.ErrTokenInvalidAudience)
+}
+
+func (s *TokenTestSuite) TestPairExpiration() {
+	require := s.Require()
+	tm, err := tokens.New(s.conf)
+	require.NoError(err, "could not initialize token manager")
+
+	atks, rtks, err := tm.CreateTokenPair(&tokens.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "01H6PGFB4T34D4WWEXQMAGJNMK"}})
+	require.NoError(err, "could not create token pair")
+
+	access, err := tm.Verify(atks)
+	require.NoError(err, "could not verify access token")
+
+	refresh, err := tm.Parse(rtks)
+	require.NoError(err, "could not parse refresh token")
+
+	// the pair expires when the refresh token expires
+	require.Equal(access.ID, refresh.ID)
+	require.Equal(refresh.ExpiresAt.Time, tm.PairExpiration(access))
+	require.Equal(refresh.ExpiresAt.Time, tm.PairExpiration(refresh))
+}
+
 func (s *TokenTestSuite) TestTokenManager() {
 	// This is a long running test, skip if in short mode
 	if testing.Short() {
@@ -18,6 +18,8 @@ const (
 	keyLength                   = 64
 	expirationDays              = 7
 	resetTokenExpirationMinutes = 15
+	unlockTokenExpirationHours  = 24
+	magicLinkExpirationMinutes  = 15
 )
 
 // NewVerificationToken creates a token struct from an email address that expires
@@ -83,6 +85,24 @@ func (t *VerificationToken) Verify(signature string, secret []byte) (err error)
 	return t.verifyData(data, signature, secret)
 }
 
+// NewMagicLinkToken creates a verification token from an email address that expires in 15 minutes,
+// the token is emailed to the user to log in without a password
+func NewMagicLinkToken(email string) (token *VerificationToken, err error) {
+	if email == "" {
+		return nil, ErrMissingEmail
+	}
+
+	token = &VerificationToken{
+		Email: email,
+	}
+
+	if token.SigningInfo, err = NewSigningInfo(time.Minute * magicLinkExpirationMinutes); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
 // NewResetToken creates a token struct from a user ID that expires in 15 minutes
 func NewResetToken(id ulid.ULID) (token *ResetToken, err error) {
 	if ulids.IsZero(id) {
@@ -145,6 +165,216 @@ func (t *ResetToken) Verify(signature string, secret []byte) (err error) {
 	return t.verifyData(data, signature, secret)
 }
 
+// NewUnlockToken creates a token struct from a user ID that expires in 24 hours
+func NewUnlockToken(id ulid.ULID) (token *UnlockToken, err error) {
+	if ulids.IsZero(id) {
+		return nil, ErrMissingUnlockUserID
+	}
+
+	token = &UnlockToken{
+		UserID: id,
+	}
+
+	if token.SigningInfo, err = NewSigningInfo(time.Hour * unlockTokenExpirationHours); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// UnlockToken packages a user ID with random data and an expiration time so that it can
+// be serialized and hashed into a token which is sent to users whose account was locked
+type UnlockToken struct {
+	UserID ulid.ULID `msgpack:"unlock_user_id"`
+	SigningInfo
+}
+
+// Sign creates a base64 encoded string from the token data so that it can be sent to
+// users as part of a URL. The returned secret should be stored in the database so that
+// the string can be recomputed when verifying a user provided token
+func (t *UnlockToken) Sign() (string, []byte, error) {
+	data, err := msgpack.Marshal(t)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return t.signData(data)
+}
+
+// Verify checks that a token was signed with the secret and is not expired
+func (t *UnlockToken) Verify(signature string, secret []byte) (err error) {
+	if ulids.IsZero(t.UserID) {
+		return ErrTokenMissingUserID
+	}
+
+	if t.IsExpired() {
+		return ErrTokenExpired
+	}
+
+	if len(secret) != nonceLength+keyLength {
+		return ErrInvalidSecret
+	}
+
+	// Serialize the struct with the nonce from the secret
+	t.Nonce = secret[0:nonceLength]
+
+	var data []byte
+
+	if data, err = msgpack.Marshal(t); err != nil {
+		return err
+	}
+
+	return t.verifyData(data, signature, secret)
+}
+
+// NewOrgInvitationToken creates a token struct from the email address of the invitee and the ID of the
+// organization they are invited to that expires in 7 days
+func NewOrgInvitationToken(email string, orgID ulid.ULID) (token *OrgInviteToken, err error) {
+	if email == "" {
+		return nil, ErrMissingEmail
+	}
+
+	if ulids.IsZero(orgID) {
+		return nil, ErrMissingOrgID
+	}
+
+	token = &OrgInviteToken{
+		Email: email,
+		OrgID: orgID,
+	}
+
+	if token.SigningInfo, err = NewSigningInfo(time.Hour * 24 * expirationDays); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// OrgInviteToken packages the email address of the invitee and the organization they are invited to with
+// random data and an expiration time so that it can be serialized and hashed into a token which can be sent
+// to the invitee
+type OrgInviteToken struct {
+	Email string    `msgpack:"email"`
+	OrgID ulid.ULID `msgpack:"organization_id"`
+	SigningInfo
+}
+
+// Sign creates a base64 encoded string from the token data so that it can be sent to
+// users as part of a URL. The returned secret should be stored in the database so that
+// the string can be recomputed when verifying a user provided token
+func (t *OrgInviteToken) Sign() (string, []byte, error) {
+	data, err := msgpack.Marshal(t)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return t.signData(data)
+}
+
+// Verify checks that a token was signed with the secret and is not expired
+func (t *OrgInviteToken) Verify(signature string, secret []byte) (err error) {
+	if t.Email == "" {
+		return ErrTokenMissingEmail
+	}
+
+	if ulids.IsZero(t.OrgID) {
+		return ErrTokenMissingOrgID
+	}
+
+	if t.IsExpired() {
+		return ErrTokenExpired
+	}
+
+	if len(secret) != nonceLength+keyLength {
+		return ErrInvalidSecret
+	}
+
+	// Serialize the struct with the nonce from the secret
+	t.Nonce = secret[0:nonceLength]
+
+	var data []byte
+
+	if data, err = msgpack.Marshal(t); err != nil {
+		return err
+	}
+
+	return t.verifyData(data, signature, secret)
+}
+
+// NewOwnershipTransferToken creates a token struct from the ID of the recipient of a transfer and the ID of the
+// organization or group that is transferred to them that expires in 7 days
+func NewOwnershipTransferToken(userID, resourceID ulid.ULID) (token *OwnershipTransferToken, err error) {
+	if ulids.IsZero(userID) {
+		return nil, ErrMissingTransferUserID
+	}
+
+	if ulids.IsZero(resourceID) {
+		return nil, ErrMissingResourceID
+	}
+
+	token = &OwnershipTransferToken{
+		UserID:     userID,
+		ResourceID: resourceID,
+	}
+
+	if token.SigningInfo, err = NewSigningInfo(time.Hour * 24 * expirationDays); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// OwnershipTransferToken packages the ID of the recipient of a transfer and the organization or group that is
+// transferred with random data and an expiration time so that it can be serialized and hashed into a token
+// which can be sent to the recipient
+type OwnershipTransferToken struct {
+	UserID     ulid.ULID `msgpack:"user_id"`
+	ResourceID ulid.ULID `msgpack:"resource_id"`
+	SigningInfo
+}
+
+// Sign creates a base64 encoded string from the token data so that it can be sent to
+// users as part of a URL. The returned secret should be stored in the database so that
+// the string can be recomputed when verifying a user provided token
+func (t *OwnershipTransferToken) Sign() (string, []byte, error) {
+	data, err := msgpack.Marshal(t)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return t.signData(data)
+}
+
+// Verify checks that a token was signed with the secret and is not expired
+func (t *OwnershipTransferToken) Verify(signature string, secret []byte) (err error) {
+	if ulids.IsZero(t.UserID) {
+		return ErrTokenMissingUserID
+	}
+
+	if ulids.IsZero(t.ResourceID) {
+		return ErrTokenMissingResourceID
+	}
+
+	if t.IsExpired() {
+		return ErrTokenExpired
+	}
+
+	if len(secret) != nonceLength+keyLength {
+		return ErrInvalidSecret
+	}
+
+	// Serialize the struct with the nonce from the secret
+	t.Nonce = secret[0:nonceLength]
+
+	var data []byte
+
+	if data, err = msgpack.Marshal(t); err != nil {
+		return err
+	}
+
+	return t.verifyData(data, signature, secret)
+}
+
 // NewSigningInfo creates new signing info with a time expiration
 func NewSigningInfo(expires time.Duration) (info SigningInfo, err error) {
 	if expires == 0 {
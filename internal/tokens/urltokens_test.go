@@ -68,6 +68,25 @@ func TestVerificationToken(t *testing.T) {
 	require.NoError(t, verify.Verify(signature, secret), "expected successful verification")
 }
 
+func TestMagicLinkToken(t *testing.T) {
+	t.Run("Valid Magic Link Token", func(t *testing.T) {
+		token, err := tokens.NewMagicLinkToken("leopold@example.com")
+		require.NoError(t, err, "could not create magic link token")
+		require.WithinDuration(t, time.Now().Add(15*time.Minute), token.ExpiresAt, time.Minute)
+
+		signature, secret, err := token.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		// Should be able to verify the token
+		require.NoError(t, token.Verify(signature, secret), "expected successful verification")
+	})
+
+	t.Run("Missing Email", func(t *testing.T) {
+		_, err := tokens.NewMagicLinkToken("")
+		require.ErrorIs(t, err, tokens.ErrMissingEmail, "expected error when token is missing email")
+	})
+}
+
 func TestResetToken(t *testing.T) {
 	t.Run("Valid Reset Token", func(t *testing.T) {
 		// Test that the reset token is created correctly
@@ -161,3 +180,147 @@ func TestResetToken(t *testing.T) {
 		require.ErrorIs(t, token.Verify(signature, otherSecret), tokens.ErrTokenInvalid, "expected error when wrong secret is used")
 	})
 }
+
+func TestUnlockToken(t *testing.T) {
+	t.Run("Valid Unlock Token", func(t *testing.T) {
+		id := ulids.New()
+		token, err := tokens.NewUnlockToken(id)
+		require.NoError(t, err, "could not create unlock token")
+		require.WithinDuration(t, time.Now().Add(24*time.Hour), token.ExpiresAt, time.Minute)
+
+		signature, secret, err := token.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		// Should be able to verify the token
+		require.NoError(t, token.Verify(signature, secret), "expected successful verification")
+	})
+
+	t.Run("Missing ID", func(t *testing.T) {
+		_, err := tokens.NewUnlockToken(ulids.Null)
+		require.ErrorIs(t, err, tokens.ErrMissingUnlockUserID, "expected error when token is missing ID")
+	})
+
+	t.Run("Token Expired", func(t *testing.T) {
+		token := &tokens.UnlockToken{
+			SigningInfo: tokens.SigningInfo{
+				ExpiresAt: time.Now().AddDate(0, 0, -1),
+			},
+			UserID: ulids.New(),
+		}
+		require.ErrorIs(t, token.Verify("", nil), tokens.ErrTokenExpired, "expected error when token is expired")
+	})
+
+	t.Run("Reset Token Is Not An Unlock Token", func(t *testing.T) {
+		// A reset token signed for the same user and expiration should not verify as an unlock token
+		reset, err := tokens.NewResetToken(ulids.New())
+		require.NoError(t, err, "could not create reset token")
+		signature, secret, err := reset.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		token := &tokens.UnlockToken{
+			UserID:      reset.UserID,
+			SigningInfo: tokens.SigningInfo{ExpiresAt: reset.ExpiresAt},
+		}
+		require.ErrorIs(t, token.Verify(signature, secret), tokens.ErrTokenInvalid, "expected error when a reset token is used")
+	})
+}
+
+func TestOrgInviteToken(t *testing.T) {
+	t.Run("Valid Invite Token", func(t *testing.T) {
+		token, err := tokens.NewOrgInvitationToken("rusty.shackleford@gmail.com", ulids.New())
+		require.NoError(t, err, "could not create invite token")
+		require.WithinDuration(t, time.Now().Add(7*24*time.Hour), token.ExpiresAt, time.Minute)
+
+		signature, secret, err := token.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		// Should be able to verify the token
+		require.NoError(t, token.Verify(signature, secret), "expected successful verification")
+	})
+
+	t.Run("Missing Email", func(t *testing.T) {
+		_, err := tokens.NewOrgInvitationToken("", ulids.New())
+		require.ErrorIs(t, err, tokens.ErrMissingEmail, "expected error when token is missing email")
+	})
+
+	t.Run("Missing Organization ID", func(t *testing.T) {
+		_, err := tokens.NewOrgInvitationToken("rusty.shackleford@gmail.com", ulids.Null)
+		require.ErrorIs(t, err, tokens.ErrMissingOrgID, "expected error when token is missing organization id")
+	})
+
+	t.Run("Token Expired", func(t *testing.T) {
+		token := &tokens.OrgInviteToken{
+			SigningInfo: tokens.SigningInfo{
+				ExpiresAt: time.Now().AddDate(0, 0, -1),
+			},
+			Email: "rusty.shackleford@gmail.com",
+			OrgID: ulids.New(),
+		}
+		require.ErrorIs(t, token.Verify("", nil), tokens.ErrTokenExpired, "expected error when token is expired")
+	})
+
+	t.Run("Invite To Another Organization", func(t *testing.T) {
+		// A token signed for one organization should not verify for another organization
+		invite, err := tokens.NewOrgInvitationToken("rusty.shackleford@gmail.com", ulids.New())
+		require.NoError(t, err, "could not create invite token")
+		signature, secret, err := invite.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		token := &tokens.OrgInviteToken{
+			Email:       invite.Email,
+			OrgID:       ulids.New(),
+			SigningInfo: tokens.SigningInfo{ExpiresAt: invite.ExpiresAt},
+		}
+		require.ErrorIs(t, token.Verify(signature, secret), tokens.ErrTokenInvalid, "expected error when the organization does not match")
+	})
+}
+
+func TestOwnershipTransferToken(t *testing.T) {
+	t.Run("Valid Transfer Token", func(t *testing.T) {
+		token, err := tokens.NewOwnershipTransferToken(ulids.New(), ulids.New())
+		require.NoError(t, err, "could not create transfer token")
+		require.WithinDuration(t, time.Now().Add(7*24*time.Hour), token.ExpiresAt, time.Minute)
+
+		signature, secret, err := token.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		// Should be able to verify the token
+		require.NoError(t, token.Verify(signature, secret), "expected successful verification")
+	})
+
+	t.Run("Missing User ID", func(t *testing.T) {
+		_, err := tokens.NewOwnershipTransferToken(ulids.Null, ulids.New())
+		require.ErrorIs(t, err, tokens.ErrMissingTransferUserID, "expected error when token is missing user id")
+	})
+
+	t.Run("Missing Resource ID", func(t *testing.T) {
+		_, err := tokens.NewOwnershipTransferToken(ulids.New(), ulids.Null)
+		require.ErrorIs(t, err, tokens.ErrMissingResourceID, "expected error when token is missing resource id")
+	})
+
+	t.Run("Token Expired", func(t *testing.T) {
+		token := &tokens.OwnershipTransferToken{
+			SigningInfo: tokens.SigningInfo{
+				ExpiresAt: time.Now().AddDate(0, 0, -1),
+			},
+			UserID:     ulids.New(),
+			ResourceID: ulids.New(),
+		}
+		require.ErrorIs(t, token.Verify("", nil), tokens.ErrTokenExpired, "expected error when token is expired")
+	})
+
+	t.Run("Transfer Of Another Resource", func(t *testing.T) {
+		// A token signed for one organization should not verify for another organization
+		transfer, err := tokens.NewOwnershipTransferToken(ulids.New(), ulids.New())
+		require.NoError(t, err, "could not create transfer token")
+		signature, secret, err := transfer.Sign()
+		require.NoError(t, err, "failed to sign token")
+
+		token := &tokens.OwnershipTransferToken{
+			UserID:      transfer.UserID,
+			ResourceID:  ulids.New(),
+			SigningInfo: tokens.SigningInfo{ExpiresAt: transfer.ExpiresAt},
+		}
+		require.ErrorIs(t, token.Verify(signature, secret), tokens.ErrTokenInvalid, "expected error when the resource does not match")
+	})
+}
@@ -140,6 +140,27 @@ type ResetRequestData struct {
 	ResetURL string
 }
 
+// UnlockRequestData is used to complete the account locked email template
+type UnlockRequestData struct {
+	EmailData
+	UnlockURL string
+}
+
+// MagicLinkData is used to complete the magic link login email template
+type MagicLinkData struct {
+	EmailData
+	MagicLinkURL string
+}
+
+// OwnershipTransferData is used to complete the ownership transfer email template
+type OwnershipTransferData struct {
+	EmailData
+	RequestorName string
+	ResourceType  string
+	ResourceName  string
+	TransferURL   string
+}
+
 // WelcomeEmail creates a welcome email for a new user
 func WelcomeEmail(data WelcomeData) (message *mail.SGMailV3, err error) {
 	var text, html string
@@ -210,6 +231,45 @@ func PasswordResetSuccessEmail(data EmailData) (message *mail.SGMailV3, err erro
 	return data.Build(text, html)
 }
 
+// AccountLockedEmail creates an email to send to users when their account is locked after repeated failed logins
+func AccountLockedEmail(data UnlockRequestData) (message *mail.SGMailV3, err error) {
+	var text, html string
+
+	if text, html, err = Render("account_locked", data); err != nil {
+		return nil, err
+	}
+
+	data.Subject = AccountLockedRE
+
+	return data.Build(text, html)
+}
+
+// MagicLinkEmail creates an email with a link to log in without a password
+func MagicLinkEmail(data MagicLinkData) (message *mail.SGMailV3, err error) {
+	var text, html string
+
+	if text, html, err = Render("magic_link", data); err != nil {
+		return nil, err
+	}
+
+	data.Subject = MagicLinkRE
+
+	return data.Build(text, html)
+}
+
+// OwnershipTransferEmail creates an email to ask the recipient to accept the transfer of an organization or group
+func OwnershipTransferEmail(data OwnershipTransferData) (message *mail.SGMailV3, err error) {
+	var text, html string
+
+	if text, html, err = Render("ownership_transfer", data); err != nil {
+		return nil, err
+	}
+
+	data.Subject = fmt.Sprintf(OwnershipTransferRE, data.RequestorName)
+
+	return data.Build(text, html)
+}
+
 // Render returns the text and html executed templates for the specified name and data - while Sendgrid does have "rich" templates that could have been used instead, it seemed better not to explicitly reference SG ID's specific to Datum's account wherever possible so these files are rendered exports of templates created within that system and can be customized to suite purpose
 func Render(name string, data interface{}) (text, html string, err error) {
 	if text, err = render(name+".txt", data); err != nil {
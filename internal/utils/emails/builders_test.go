@@ -83,6 +83,36 @@ func TestEmailBuilders(t *testing.T) {
 	require.NoError(t, err, "expected no error when building password reset success email")
 	require.Equal(t, emails.PasswordResetSuccessRE, mail.Subject, "expected password reset success email subject to match")
 	generateMIME(t, mail, "reset_success.mime")
+
+	unlockData := emails.UnlockRequestData{
+		EmailData: data,
+		UnlockURL: "https://datum.net/unlock?token=1234567890",
+	}
+	mail, err = emails.AccountLockedEmail(unlockData)
+	require.NoError(t, err, "expected no error when building account locked email")
+	require.Equal(t, emails.AccountLockedRE, mail.Subject, "expected account locked email subject to match")
+	generateMIME(t, mail, "account_locked.mime")
+
+	magicLinkData := emails.MagicLinkData{
+		EmailData:    data,
+		MagicLinkURL: "https://datum.net/login/magic?token=1234567890",
+	}
+	mail, err = emails.MagicLinkEmail(magicLinkData)
+	require.NoError(t, err, "expected no error when building magic link email")
+	require.Equal(t, emails.MagicLinkRE, mail.Subject, "expected magic link email subject to match")
+	generateMIME(t, mail, "magic_link.mime")
+
+	transferData := emails.OwnershipTransferData{
+		EmailData:     data,
+		RequestorName: "Matt Anderson",
+		ResourceType:  "organization",
+		ResourceName:  "Kids R Us",
+		TransferURL:   "https://datum.net/transfer?token=1234567890",
+	}
+	mail, err = emails.OwnershipTransferEmail(transferData)
+	require.NoError(t, err, "expected no error when building ownership transfer email")
+	require.Equal(t, fmt.Sprintf(emails.OwnershipTransferRE, "Matt Anderson"), mail.Subject, "expected ownership transfer email subject to match")
+	generateMIME(t, mail, "ownership_transfer.mime")
 }
 
 func TestEmailData(t *testing.T) {
@@ -32,6 +32,9 @@ const (
 	InviteRE               = "Join Your Teammate %s on Datum!"
 	PasswordResetRequestRE = "Datum Password Reset - Action Required"
 	PasswordResetSuccessRE = "Datum Password Reset Confirmation"
+	AccountLockedRE        = "Your Datum Account Has Been Locked"
+	MagicLinkRE            = "Your Datum Login Link"
+	OwnershipTransferRE    = "%s Wants to Transfer Ownership to You on Datum"
 )
 
 // Validate the from and admin emails are present if the SendGrid API is enabled